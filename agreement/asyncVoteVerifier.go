@@ -57,6 +57,9 @@ type AsyncVoteVerifier struct {
 	execpoolOut     chan interface{}
 	ctx             context.Context
 	ctxCancel       context.CancelFunc
+
+	// credentials caches the verified vote credentials, nil caching nothing
+	credentials *credentialCache
 }
 
 // MakeAsyncVoteVerifier creates an AsyncVoteVerifier with workers as the number of CPUs
@@ -86,9 +89,15 @@ func MakeAsyncVoteVerifier(verificationPool execpool.BacklogPool) *AsyncVoteVeri
 func (avv *AsyncVoteVerifier) worker() {
 	defer close(avv.workerWaitCh)
 	for res := range avv.execpoolOut {
-		asyncResponse := res.(*asyncVerifyVoteResponse)
-		if asyncResponse != nil {
-			asyncResponse.req.out <- *asyncResponse
+		switch asyncResponse := res.(type) {
+		case *asyncVerifyVoteResponse:
+			if asyncResponse != nil {
+				asyncResponse.req.out <- *asyncResponse
+			}
+		case []asyncVerifyVoteResponse:
+			for i := range asyncResponse {
+				asyncResponse[i].req.out <- asyncResponse[i]
+			}
 		}
 		avv.wg.Done()
 	}
@@ -103,7 +112,8 @@ func (avv *AsyncVoteVerifier) executeVoteVerification(task interface{}) interfac
 		return &asyncVerifyVoteResponse{err: req.ctx.Err(), cancelled: true, req: &req, index: req.index}
 	default:
 		// request was not cancelled, so we verify it here and return the result on the channel
-		v, err := req.uv.verify(req.l)
+		votes, errs := verifyVotes(req.l, []unauthenticatedVote{*req.uv}, avv.credentials)
+		v, err := votes[0], errs[0]
 		req.message.Vote = v
 
 		var e *LedgerDroppedRoundError
@@ -113,6 +123,39 @@ func (avv *AsyncVoteVerifier) executeVoteVerification(task interface{}) interfac
 	}
 }
 
+func (avv *AsyncVoteVerifier) executeVoteBatchVerification(task interface{}) interface{} {
+	reqs := task.([]asyncVerifyVoteRequest)
+	responses := make([]asyncVerifyVoteResponse, len(reqs))
+
+	// verify the requests that were not cancelled together
+	uvs := make([]unauthenticatedVote, 0, len(reqs))
+	pending := make([]int, 0, len(reqs))
+	for i := range reqs {
+		select {
+		case <-reqs[i].ctx.Done():
+			responses[i] = asyncVerifyVoteResponse{err: reqs[i].ctx.Err(), cancelled: true, req: &reqs[i], index: reqs[i].index}
+		default:
+			uvs = append(uvs, *reqs[i].uv)
+			pending = append(pending, i)
+		}
+	}
+	if len(uvs) == 0 {
+		return responses
+	}
+
+	votes, errs := verifyVotes(reqs[pending[0]].l, uvs, avv.credentials)
+	for j, i := range pending {
+		req := &reqs[i]
+		req.message.Vote = votes[j]
+
+		var e *LedgerDroppedRoundError
+		cancelled := errors.As(errs[j], &e)
+
+		responses[i] = asyncVerifyVoteResponse{v: votes[j], index: req.index, message: req.message, err: errs[j], cancelled: cancelled, req: req}
+	}
+	return responses
+}
+
 func (avv *AsyncVoteVerifier) executeEqVoteVerification(task interface{}) interface{} {
 	req := task.(asyncVerifyVoteRequest)
 
@@ -151,6 +194,23 @@ func (avv *AsyncVoteVerifier) verifyVote(verctx context.Context, l LedgerReader,
 	return nil
 }
 
+// verifyVotes verifies votes in a single task, batching the verification of their signatures and credentials.
+// All the votes must be verified against the same ledger. The results are written to the out channel of each
+// request, one per vote, as with verifyVote.
+func (avv *AsyncVoteVerifier) verifyVotes(reqs []asyncVerifyVoteRequest) error {
+	select {
+	case <-avv.ctx.Done(): // if we're quitting, don't enqueue the requests
+	default:
+		avv.wg.Add(1)
+		if err := avv.backlogExecPool.EnqueueBacklog(avv.ctx, avv.executeVoteBatchVerification, reqs, avv.execpoolOut); err != nil {
+			// the task won't get to the verification function, see verifyVote
+			avv.wg.Done()
+			return err
+		}
+	}
+	return nil
+}
+
 func (avv *AsyncVoteVerifier) verifyEqVote(verctx context.Context, l LedgerReader, uev unauthenticatedEquivocationVote, index uint64, message message, out chan<- asyncVerifyVoteResponse) error {
 	select {
 	case <-avv.ctx.Done(): // if we're quitting, don't enqueue the request
@@ -134,6 +134,10 @@ func makeBundle(proto config.ConsensusParams, targetProposal proposalValue, vote
 	}
 }
 
+// bundleVoteBatchSize is the number of votes of a bundle verified together in a single task.
+// It trades the speedup of batched verification for the parallelism of verifying a bundle.
+const bundleVoteBatchSize = 32
+
 // verify checks that the bundle is valid, i.e.:
 //
 // - all the votes in the bundle are valid
@@ -192,18 +196,27 @@ func (b unauthenticatedBundle) verifyAsync(ctx context.Context, l LedgerReader,
 	// make a buffer large enough to queue all results so we never wait
 	results := make(chan asyncVerifyVoteResponse, len(b.Votes)+len(b.EquivocationVotes))
 
-	// create verification requests for votes
-	for i, auth := range b.Votes {
+	// create verification requests for votes, verified in batches
+	for start := 0; start < len(b.Votes); start += bundleVoteBatchSize {
 		select {
 		case <-ctx.Done():
 			return termErrorFn(ctx.Err())
 		default:
 		}
 
-		rv := rawVote{Sender: auth.Sender, Round: b.Round, Period: b.Period, Step: b.Step, Proposal: b.Proposal}
-		uv := unauthenticatedVote{R: rv, Cred: auth.Cred, Sig: auth.Sig}
+		end := start + bundleVoteBatchSize
+		if end > len(b.Votes) {
+			end = len(b.Votes)
+		}
+		reqs := make([]asyncVerifyVoteRequest, 0, end-start)
+		for i := start; i < end; i++ {
+			auth := b.Votes[i]
+			rv := rawVote{Sender: auth.Sender, Round: b.Round, Period: b.Period, Step: b.Step, Proposal: b.Proposal}
+			uv := unauthenticatedVote{R: rv, Cred: auth.Cred, Sig: auth.Sig}
+			reqs = append(reqs, asyncVerifyVoteRequest{ctx: ctx, l: l, uv: &uv, index: uint64(i), out: results})
+		}
 
-		avv.verifyVote(ctx, l, uv, uint64(i), message{}, results) //nolint:errcheck // verifyVote will call EnqueueBacklog, which blocks until the verify task is queued, or returns an error when ctx.Done(), which we are already checking
+		avv.verifyVotes(reqs) //nolint:errcheck // verifyVotes will call EnqueueBacklog, which blocks until the verify task is queued, or returns an error when ctx.Done(), which we are already checking
 	}
 
 	// create verification requests for equivocation votes
@@ -20,7 +20,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
 )
 
 // A Certificate contains a cryptographic proof that agreement was reached on a
@@ -62,3 +66,191 @@ func (c Certificate) claimsToAuthenticate(e bookkeeping.Block) error {
 	}
 	return nil
 }
+
+// A SortitionLedger provides the subset of LedgerReader needed to
+// re-evaluate the sortition of a past round.
+type SortitionLedger interface {
+	Seed(basics.Round) (committee.Seed, error)
+	LookupAgreement(basics.Round, basics.Address) (basics.OnlineAccountData, error)
+	Circulation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error)
+	ConsensusParams(basics.Round) (config.ConsensusParams, error)
+}
+
+// CertificateSortition describes the committee selection behind a
+// Certificate: the parameters of the sortition and the VRF evaluation of
+// each of its votes.
+type CertificateSortition struct {
+	Seed         committee.Seed
+	SeedRound    basics.Round
+	BalanceRound basics.Round
+	TotalMoney   basics.MicroAlgos
+
+	// Message is the byte string the VRF proofs of the votes are
+	// evaluated over, that is the domain-separated encoding of the
+	// committee selector.
+	Message []byte
+
+	Votes []SortitionVote
+}
+
+// SortitionVote is the VRF evaluation of a single certificate vote.
+type SortitionVote struct {
+	Sender      basics.Address
+	SelectionID crypto.VRFVerifier
+	Stake       basics.MicroAlgos
+	Proof       crypto.VrfProof
+	Output      crypto.VrfOutput
+	Weight      uint64
+}
+
+// Sortition re-evaluates the VRF credentials of the Certificate votes against
+// the ledger, returning the outputs and weights so that the committee
+// selection of the round can be audited.
+//
+// It returns an error if any credential fails to verify. The ledger must
+// still hold the balances of the balance round of the certificate.
+func (c Certificate) Sortition(l SortitionLedger) (s CertificateSortition, err error) {
+	proto, err := l.ConsensusParams(ParamsRound(c.Round))
+	if err != nil {
+		return
+	}
+	s.SeedRound = seedRound(c.Round, proto)
+	s.BalanceRound = balanceRound(c.Round, proto)
+
+	s.Seed, err = l.Seed(s.SeedRound)
+	if err != nil {
+		return CertificateSortition{}, fmt.Errorf("Certificate.Sortition (r=%d): failed to obtain seed in round %d: %w", c.Round, s.SeedRound, err)
+	}
+	s.TotalMoney, err = l.Circulation(s.BalanceRound, c.Round)
+	if err != nil {
+		return CertificateSortition{}, fmt.Errorf("Certificate.Sortition (r=%d): failed to obtain total circulation in round %d: %w", c.Round, s.BalanceRound, err)
+	}
+
+	sel := selector{Seed: s.Seed, Round: c.Round, Period: c.Period, Step: c.Step}
+	s.Message = crypto.HashRep(sel)
+
+	evaluate := func(sender basics.Address, cred committee.UnauthenticatedCredential) error {
+		record, err := l.LookupAgreement(s.BalanceRound, sender)
+		if err != nil {
+			return fmt.Errorf("Certificate.Sortition (r=%d): failed to obtain balance record for address %v in round %d: %w", c.Round, sender, s.BalanceRound, err)
+		}
+		m := committee.Membership{
+			Record:     committee.BalanceRecord{OnlineAccountData: record, Addr: sender},
+			Selector:   sel,
+			TotalMoney: s.TotalMoney,
+		}
+		ok, out := record.SelectionID.Verify(cred.Proof, sel)
+		if !ok {
+			return cred.ProofError(m)
+		}
+		verified, err := cred.VerifyOutput(proto, m, out)
+		if err != nil {
+			return err
+		}
+		s.Votes = append(s.Votes, SortitionVote{
+			Sender:      sender,
+			SelectionID: record.SelectionID,
+			Stake:       record.MicroAlgosWithRewards,
+			Proof:       cred.Proof,
+			Output:      out,
+			Weight:      verified.Weight,
+		})
+		return nil
+	}
+
+	for _, v := range c.Votes {
+		err = evaluate(v.Sender, v.Cred)
+		if err != nil {
+			return CertificateSortition{}, err
+		}
+	}
+	for _, v := range c.EquivocationVotes {
+		err = evaluate(v.Sender, v.Cred)
+		if err != nil {
+			return CertificateSortition{}, err
+		}
+	}
+	return s, nil
+}
+
+// AccountSelection is the sortition of an account in the round a Certificate
+// was agreed on, evaluated with the VRF secrets of one of its participation
+// keys, against what the Certificate records of the account.
+type AccountSelection struct {
+	// ProposerWeight is the weight the account was selected with to
+	// propose a block in the period of the agreed proposal, 0 if it was not
+	// selected. Several accounts are selected to propose in every period, so
+	// a selected account seeing another proposal agreed on is expected.
+	ProposerWeight uint64
+
+	// Proposed is set if the agreed proposal is from the account.
+	Proposed bool
+
+	// VoterWeight is the weight the account was selected with to cast a
+	// vote of the certificate step, 0 if it was not selected.
+	VoterWeight uint64
+
+	// Voted is set if a vote of the account is part of the Certificate.
+	// A Certificate only holds the votes needed to reach the threshold, so
+	// votes which arrived late may be missing although they were cast.
+	Voted bool
+}
+
+// Selection evaluates the sortition of an account in the round of the
+// Certificate, as it would have been with the given VRF secrets: the
+// proposer selection in the period of the agreed proposal, and the voter
+// selection in the period and step of the Certificate. An account is not
+// selected if the secrets are not the ones it registered for the round.
+//
+// The ledger must still hold the balances of the balance round of the
+// certificate.
+func (c Certificate) Selection(l SortitionLedger, addr basics.Address, vrf *crypto.VRFSecrets) (s AccountSelection, err error) {
+	proto, err := l.ConsensusParams(ParamsRound(c.Round))
+	if err != nil {
+		return
+	}
+	seedRnd := seedRound(c.Round, proto)
+	balanceRnd := balanceRound(c.Round, proto)
+
+	seed, err := l.Seed(seedRnd)
+	if err != nil {
+		return AccountSelection{}, fmt.Errorf("Certificate.Selection (r=%d): failed to obtain seed in round %d: %w", c.Round, seedRnd, err)
+	}
+	total, err := l.Circulation(balanceRnd, c.Round)
+	if err != nil {
+		return AccountSelection{}, fmt.Errorf("Certificate.Selection (r=%d): failed to obtain total circulation in round %d: %w", c.Round, balanceRnd, err)
+	}
+	record, err := l.LookupAgreement(balanceRnd, addr)
+	if err != nil {
+		return AccountSelection{}, fmt.Errorf("Certificate.Selection (r=%d): failed to obtain balance record for address %v in round %d: %w", c.Round, addr, balanceRnd, err)
+	}
+
+	weight := func(p period, st step) uint64 {
+		m := committee.Membership{
+			Record:     committee.BalanceRecord{OnlineAccountData: record, Addr: addr},
+			Selector:   selector{Seed: seed, Round: c.Round, Period: p, Step: st},
+			TotalMoney: total,
+		}
+		cred, err := committee.MakeCredential(&vrf.SK, m.Selector).Verify(proto, m)
+		if err != nil {
+			// either the secrets are not the registered ones, or the weight is 0
+			return 0
+		}
+		return cred.Weight
+	}
+
+	s.ProposerWeight = weight(c.Proposal.OriginalPeriod, propose)
+	s.Proposed = c.Proposal.OriginalProposer == addr
+	s.VoterWeight = weight(c.Period, c.Step)
+	for _, v := range c.Votes {
+		if v.Sender == addr {
+			s.Voted = true
+		}
+	}
+	for _, v := range c.EquivocationVotes {
+		if v.Sender == addr {
+			s.Voted = true
+		}
+	}
+	return s, nil
+}
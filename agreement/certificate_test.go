@@ -26,6 +26,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -366,3 +367,93 @@ func TestCertificateDupVote(t *testing.T) {
 	require.Error(t, verifyBundleAgainstLedger(bundle, ledger, avv))
 	require.Error(t, cert.Authenticate(block, ledger, avv))
 }
+
+func TestCertificateSortition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+	period := period(0)
+	block := makeRandomBlock(1)
+
+	votes := make([]vote, 0)
+	weights := make(map[basics.Address]uint64)
+	for j, addr := range addresses {
+		vote, err := makeVoteTesting(addr, vrfSecrets[j], otSecrets[j], ledger, round, period, cert, block.Digest())
+		if err == nil {
+			votes = append(votes, vote)
+			weights[addr] = vote.Cred.Weight
+		}
+	}
+
+	cert := makeCertTesting(block.Digest(), votes, nil)
+	s, err := cert.Sortition(ledger)
+	require.NoError(t, err)
+	require.Len(t, s.Votes, len(votes))
+
+	seed, err := ledger.Seed(s.SeedRound)
+	require.NoError(t, err)
+	require.Equal(t, seed, s.Seed)
+
+	for _, v := range s.Votes {
+		require.Equal(t, weights[v.Sender], v.Weight)
+		ok, out := v.SelectionID.VerifyBytes(v.Proof, s.Message)
+		require.True(t, ok)
+		require.Equal(t, out, v.Output)
+	}
+
+	// a proof that does not match the selector must be rejected
+	cert.Votes[0].Cred.Proof[0]++
+	_, err = cert.Sortition(ledger)
+	require.Error(t, err)
+}
+
+func TestCertificateSelection(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+	period := period(0)
+	block := makeRandomBlock(1)
+
+	votes := make([]vote, 0)
+	voterWeights := make(map[basics.Address]uint64)
+	proposerWeights := make(map[basics.Address]uint64)
+	for j, addr := range addresses {
+		vote, err := makeVoteTesting(addr, vrfSecrets[j], otSecrets[j], ledger, round, period, cert, block.Digest())
+		if err == nil {
+			voterWeights[addr] = vote.Cred.Weight
+			// leave the first selected voter out of the certificate
+			if len(voterWeights) > 1 {
+				votes = append(votes, vote)
+			}
+		}
+		vote, err = makeVoteTesting(addr, vrfSecrets[j], otSecrets[j], ledger, round, period, propose, block.Digest())
+		if err == nil {
+			proposerWeights[addr] = vote.Cred.Weight
+		}
+	}
+	require.NotEmpty(t, proposerWeights)
+
+	cert := makeCertTesting(block.Digest(), votes, nil)
+	cert.Proposal.OriginalProposer = addresses[0]
+
+	missing := 0
+	for j, addr := range addresses {
+		s, err := cert.Selection(ledger, addr, vrfSecrets[j])
+		require.NoError(t, err)
+		require.Equal(t, proposerWeights[addr], s.ProposerWeight)
+		require.Equal(t, voterWeights[addr], s.VoterWeight)
+		require.Equal(t, j == 0, s.Proposed)
+		if s.VoterWeight != 0 && !s.Voted {
+			missing++
+		}
+
+		// secrets other than the registered ones are never selected
+		s, err = cert.Selection(ledger, addr, vrfSecrets[(j+1)%len(addresses)])
+		require.NoError(t, err)
+		require.Zero(t, s.ProposerWeight)
+		require.Zero(t, s.VoterWeight)
+	}
+	require.Equal(t, 1, missing)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var credentialCacheHits = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_credential_cache_hits_total", Description: "Number of vote credentials found verified in the credential cache"})
+var credentialCacheMisses = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_credential_cache_misses_total", Description: "Number of vote credentials not found in the credential cache, and verified"})
+
+// credentialCacheKey identifies the selection of an account for a step: a credential is a VRF proof over the
+// selector of the step, which holds the seed, with the selection key of the account.
+type credentialCacheKey struct {
+	sender basics.Address
+	round  basics.Round
+	period period
+	step   step
+	seed   committee.Seed
+}
+
+// credentialCache holds the credentials verified recently, so that the credential of a committee member arriving in
+// several votes and bundles, as relays see it, is verified once. The credentials of a key are only taken from the
+// cache when they carry the same proof as the verified one.
+//
+// It keeps two generations of credentials of at most half its size each: once the current generation is full, it
+// becomes the previous one, dropping the previous generation.
+type credentialCache struct {
+	mu       deadlock.Mutex
+	current  map[credentialCacheKey]committee.Credential
+	previous map[credentialCacheKey]committee.Credential
+	maxSize  int
+}
+
+// makeCredentialCache creates a credential cache holding up to size credentials, or returns nil, which caches
+// nothing, when size is 0.
+func makeCredentialCache(size int) *credentialCache {
+	if size <= 0 {
+		return nil
+	}
+	generation := (size + 1) / 2
+	return &credentialCache{
+		current: make(map[credentialCacheKey]committee.Credential, generation),
+		maxSize: generation,
+	}
+}
+
+// get returns the verified credential of key if it carries the proof of uc.
+func (c *credentialCache) get(key credentialCacheKey, uc committee.UnauthenticatedCredential) (committee.Credential, bool) {
+	if c == nil {
+		return committee.Credential{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cred, ok := c.current[key]
+	if !ok {
+		cred, ok = c.previous[key]
+	}
+	if !ok || cred.UnauthenticatedCredential != uc {
+		credentialCacheMisses.Inc(nil)
+		return committee.Credential{}, false
+	}
+	credentialCacheHits.Inc(nil)
+	return cred, true
+}
+
+// put adds a verified credential of key to the cache.
+func (c *credentialCache) put(key credentialCacheKey, cred committee.Credential) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.current) >= c.maxSize {
+		c.previous = c.current
+		c.current = make(map[credentialCacheKey]committee.Credential, c.maxSize)
+	}
+	c.current[key] = cred
+}
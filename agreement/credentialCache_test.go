@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCredentialCacheGenerations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Nil(t, makeCredentialCache(0))
+	var disabled *credentialCache
+	disabled.put(credentialCacheKey{}, committee.Credential{})
+	_, ok := disabled.get(credentialCacheKey{}, committee.UnauthenticatedCredential{})
+	require.False(t, ok)
+
+	c := makeCredentialCache(4)
+	key := func(i int) credentialCacheKey {
+		return credentialCacheKey{round: basics.Round(i)}
+	}
+	cred := func(i int) committee.Credential {
+		var cred committee.Credential
+		cred.Proof[0] = byte(i)
+		cred.Weight = uint64(i)
+		return cred
+	}
+	for i := 1; i <= 4; i++ {
+		c.put(key(i), cred(i))
+	}
+	// the first generation was dropped once the third one started
+	_, ok = c.get(key(1), cred(1).UnauthenticatedCredential)
+	require.False(t, ok)
+	for i := 2; i <= 4; i++ {
+		got, ok := c.get(key(i), cred(i).UnauthenticatedCredential)
+		require.True(t, ok, "credential %d", i)
+		require.Equal(t, cred(i), got)
+	}
+	// a credential carrying another proof than the verified one is not taken from the cache
+	_, ok = c.get(key(4), cred(5).UnauthenticatedCredential)
+	require.False(t, ok)
+}
+
+func TestVerifyVotesCredentialCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+	credentials := makeCredentialCache(1000)
+
+	var uvs []unauthenticatedVote
+	var seconds []unauthenticatedVote
+	for i, address := range addresses {
+		var proposal proposalValue
+		proposal.BlockDigest = randomBlockHash()
+		uv, err := makeVote(rawVote{Sender: address, Round: round, Period: 0, Step: soft, Proposal: proposal}, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+		if _, err := uv.verify(ledger); err != nil {
+			continue
+		}
+		uvs = append(uvs, uv)
+
+		// the same credential, in a vote for another proposal
+		proposal.BlockDigest = randomBlockHash()
+		uv, err = makeVote(rawVote{Sender: address, Round: round, Period: 0, Step: soft, Proposal: proposal}, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+		seconds = append(seconds, uv)
+	}
+	require.Greater(t, len(uvs), 2)
+
+	misses := credentialCacheMisses.GetUint64Value()
+	hits := credentialCacheHits.GetUint64Value()
+	votes, errs := verifyVotes(ledger, uvs, credentials)
+	for i := range uvs {
+		require.NoError(t, errs[i])
+	}
+	require.Equal(t, misses+uint64(len(uvs)), credentialCacheMisses.GetUint64Value())
+
+	// the other votes with the same credentials are verified from the cache, but not a broken signature
+	seconds[0].Sig.Sig[0]++
+	secondVotes, errs := verifyVotes(ledger, seconds, credentials)
+	require.Error(t, errs[0])
+	for i := 1; i < len(seconds); i++ {
+		require.NoError(t, errs[i])
+		require.Equal(t, votes[i].Cred, secondVotes[i].Cred)
+		expected, err := seconds[i].verify(ledger)
+		require.NoError(t, err)
+		require.Equal(t, expected, secondVotes[i])
+	}
+	require.Equal(t, hits+uint64(len(seconds)), credentialCacheHits.GetUint64Value())
+
+	// nor a broken credential
+	broken := uvs[1]
+	broken.Cred.Proof[0]++
+	_, errs = verifyVotes(ledger, []unauthenticatedVote{broken}, credentials)
+	require.Error(t, errs[0])
+}
@@ -18,7 +18,10 @@ package agreement
 
 import (
 	"context"
+	"runtime"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -33,6 +36,21 @@ const (
 	voteParallelism     = 16
 	proposalParallelism = 4
 	bundleParallelism   = 2
+
+	// voteVerificationBatchSize is the maximal number of waiting votes verified together in a single task
+	voteVerificationBatchSize = 8
+
+	// verificationSlotsPerWorker is the number of votes or bundles handed to the vote verifier per worker, the
+	// others waiting in the verificationQueue to be handed by priority.
+	verificationSlotsPerWorker = 2
+
+	// queuedVotesPerWorker is the number of votes held in the verificationQueue per worker, before
+	// ChannelFull reports the votes channel as full.
+	queuedVotesPerWorker = 2 * voteVerificationBatchSize
+
+	// maxQueuedBundles is the number of bundles held in the verificationQueue, before ChannelFull reports the
+	// bundles channel as full.
+	maxQueuedBundles = bundleParallelism
 )
 
 type (
@@ -120,12 +138,27 @@ type (
 	}
 
 	// A poolCryptoVerifier uses asynchronous goroutines to implement cryptoVerifier.
+	//
+	// The votes and bundles are held in a verificationQueue, and handed to the vote verifier by priority as
+	// verification slots free up, so that a burst of future-round votes or of bundles does not delay the
+	// verification of the votes of the round being agreed on.
 	poolCryptoVerifier struct {
 		voteVerifier *AsyncVoteVerifier
 		votes        voteChanPair
 		proposals    proposalChanPair
 		bundles      bundleChanPair
 
+		// queue is only accessed by voteFillWorker; queuedVotes and queuedBundles mirror its length for ChannelFull.
+		queue          verificationQueue
+		queuedVotes    atomic.Int32
+		queuedBundles  atomic.Int32
+		maxQueuedVotes int
+
+		// slots holds a token per vote or bundle being verified, and voteResults the verified votes on their way
+		// to votes.out, each releasing its token.
+		slots       chan struct{}
+		voteResults chan asyncVerifyVoteResponse
+
 		validator        BlockValidator
 		ledger           LedgerReader
 		proposalContexts pendingRequestsContext
@@ -159,13 +192,21 @@ type (
 	}
 )
 
-func makeCryptoVerifier(l LedgerReader, v BlockValidator, voteVerifier *AsyncVoteVerifier, logger logging.Logger) cryptoVerifier {
+// makeCryptoVerifier creates a cryptoVerifier verifying up to verificationSlotsPerWorker votes or bundles per
+// worker at once; the number of workers is parallelism, or GOMAXPROCS when parallelism is 0.
+func makeCryptoVerifier(l LedgerReader, v BlockValidator, voteVerifier *AsyncVoteVerifier, parallelism int, logger logging.Logger) cryptoVerifier {
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
 	c := &poolCryptoVerifier{
 		ledger:           l,
 		validator:        v,
 		proposalContexts: makePendingRequestsContext(),
+		maxQueuedVotes:   queuedVotesPerWorker * parallelism,
+		slots:            make(chan struct{}, verificationSlotsPerWorker*parallelism),
 		quit:             make(chan struct{}),
 	}
+	c.voteResults = make(chan asyncVerifyVoteResponse, cap(c.slots))
 	c.votes = voteChanPair{
 		in:  make(chan cryptoVoteRequest, voteVerifier.Parallelism()),
 		out: make(chan asyncVerifyVoteResponse, 3*voteVerifier.Parallelism()),
@@ -178,17 +219,18 @@ func makeCryptoVerifier(l LedgerReader, v BlockValidator, voteVerifier *AsyncVot
 	// Allocate enough outbound space to absorb one proposal per pending vote.
 	// TODO We want proper backpressure from the proposalTable into the network.
 	baseBuffer := 3
-	maxVotes := cap(c.votes.in) + cap(c.votes.out) + voteVerifier.Parallelism()
+	maxVotes := cap(c.votes.in) + c.maxQueuedVotes + cap(c.votes.out) + cap(c.slots)
 	c.proposals = proposalChanPair{
 		in:  make(chan cryptoProposalRequest, 1),
 		out: make(chan cryptoResult, maxVotes+baseBuffer),
 	}
 
-	c.wg.Add(3)
+	c.wg.Add(4)
 
 	bundleFutures := make(chan bundleFuture)
 	go c.voteFillWorker(bundleFutures)
 	go c.bundleWaitWorker(bundleFutures)
+	go c.voteResultWorker()
 	go c.proposalVerifyWorker()
 
 	c.voteVerifier = voteVerifier
@@ -196,65 +238,169 @@ func makeCryptoVerifier(l LedgerReader, v BlockValidator, voteVerifier *AsyncVot
 	return c
 }
 
+// voteFillWorker queues the incoming votes and bundles, and hands them to the vote verifier by priority as
+// verification slots free up.
 func (c *poolCryptoVerifier) voteFillWorker(toBundleWait chan<- bundleFuture) {
-	votesin := c.votes.in
-	bundlesin := c.bundles.in
 	defer close(toBundleWait)
 	defer c.wg.Done()
 
 	for {
-		select {
-		case votereq, ok := <-votesin:
-			if !ok {
-				votesin = nil
-				if bundlesin == nil {
+		if c.queue.votes()+c.queue.bundles() == 0 {
+			select {
+			case votereq, ok := <-c.votes.in:
+				if !ok {
+					return
+				}
+				c.enqueueVote(votereq)
+			case bundlereq, ok := <-c.bundles.in:
+				if !ok {
 					return
 				}
-				continue
+				c.enqueueBundle(bundlereq)
+			case <-c.quit:
+				return
 			}
+		}
 
-			uv := votereq.message.UnauthenticatedVote
-			err := c.voteVerifier.verifyVote(votereq.ctx, c.ledger, uv, votereq.TaskIndex, votereq.message, c.votes.out)
-			if err != nil && c.votes.out != nil {
-				select {
-				case c.votes.out <- asyncVerifyVoteResponse{index: votereq.TaskIndex, err: err, cancelled: true}:
-				default:
-					voteVerifierOutFullCounter.Inc(nil)
-					c.log.Infof("poolCryptoVerifier.voteFillWorker unable to write failed enqueue response to output channel")
+		// wait for a verification slot, and then take in the requests which arrived meanwhile
+		select {
+		case c.slots <- struct{}{}:
+		case <-c.quit:
+			return
+		}
+	drain:
+		for {
+			select {
+			case votereq, ok := <-c.votes.in:
+				if !ok {
+					return
 				}
-			}
-		case bundlereq, ok := <-bundlesin:
-			if !ok {
-				bundlesin = nil
-				if votesin == nil {
+				c.enqueueVote(votereq)
+			case bundlereq, ok := <-c.bundles.in:
+				if !ok {
 					return
 				}
-				continue
+				c.enqueueBundle(bundlereq)
+			default:
+				break drain
 			}
+		}
+		c.queue.advance(c.ledger.NextRound())
 
-			// this sends messages down c.voteVerifier
-			fn := bundlereq.message.UnauthenticatedBundle.verifyAsync(bundlereq.ctx, c.ledger, c.voteVerifier)
-			future := bundleFuture{
-				message: bundlereq.message,
-				index:   bundlereq.TaskIndex,
-				wait:    fn,
-				ctx:     bundlereq.ctx,
+		// batch the verification of the votes which are to be verified next, with as many slots as are free
+		slots := 1
+	batch:
+		for slots < voteVerificationBatchSize {
+			select {
+			case c.slots <- struct{}{}:
+				slots++
+			default:
+				break batch
 			}
+		}
+
+		now := time.Now()
+		task, _ := c.queue.pop(now)
+		if task.class != verificationClassBundle {
+			reqs := append([]cryptoVoteRequest{task.vote}, c.queue.popVotes(now, slots-1)...)
+			c.releaseSlots(slots - len(reqs))
+			c.updateQueued()
+			c.verifyVotes(reqs)
+			continue
+		}
+		c.releaseSlots(slots - 1)
+		c.updateQueued()
+
+		// this sends messages down c.voteVerifier
+		bundlereq := task.bundle
+		fn := bundlereq.message.UnauthenticatedBundle.verifyAsync(bundlereq.ctx, c.ledger, c.voteVerifier)
+		future := bundleFuture{
+			message: bundlereq.message,
+			index:   bundlereq.TaskIndex,
+			wait:    fn,
+			ctx:     bundlereq.ctx,
+		}
+		select {
+		case toBundleWait <- future:
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+func (c *poolCryptoVerifier) enqueueVote(req cryptoVoteRequest) {
+	c.queue.pushVote(req, time.Now())
+	c.updateQueued()
+}
+
+func (c *poolCryptoVerifier) enqueueBundle(req cryptoBundleRequest) {
+	c.queue.pushBundle(req, time.Now())
+	c.updateQueued()
+}
+
+// updateQueued records the length of the queue for ChannelFull.
+func (c *poolCryptoVerifier) updateQueued() {
+	c.queuedVotes.Store(int32(c.queue.votes()))
+	c.queuedBundles.Store(int32(c.queue.bundles()))
+}
+
+func (c *poolCryptoVerifier) releaseSlots(n int) {
+	for i := 0; i < n; i++ {
+		<-c.slots
+	}
+}
+
+// voteResultWorker forwards the verified votes to votes.out, releasing their verification slots.
+func (c *poolCryptoVerifier) voteResultWorker() {
+	defer c.wg.Done()
+	for {
+		select {
+		case res := <-c.voteResults:
 			select {
-			case toBundleWait <- future:
+			case c.votes.out <- res:
 			case <-c.quit:
 				return
 			}
+			c.releaseSlots(1)
 		case <-c.quit:
 			return
 		}
 	}
 }
 
+// verifyVotes sends vote verification requests to the vote verifier, in a single batch when there are several.
+func (c *poolCryptoVerifier) verifyVotes(reqs []cryptoVoteRequest) {
+	var err error
+	if len(reqs) == 1 {
+		uv := reqs[0].message.UnauthenticatedVote
+		err = c.voteVerifier.verifyVote(reqs[0].ctx, c.ledger, uv, reqs[0].TaskIndex, reqs[0].message, c.voteResults)
+	} else {
+		batch := make([]asyncVerifyVoteRequest, len(reqs))
+		for i, votereq := range reqs {
+			uv := votereq.message.UnauthenticatedVote
+			batch[i] = asyncVerifyVoteRequest{ctx: votereq.ctx, l: c.ledger, uv: &uv, index: votereq.TaskIndex, message: votereq.message, out: c.voteResults}
+		}
+		err = c.voteVerifier.verifyVotes(batch)
+	}
+	if err == nil {
+		return
+	}
+	for _, votereq := range reqs {
+		select {
+		case c.voteResults <- asyncVerifyVoteResponse{index: votereq.TaskIndex, err: err, cancelled: true}:
+		default:
+			c.releaseSlots(1)
+			voteVerifierOutFullCounter.Inc(nil)
+			c.log.Infof("poolCryptoVerifier.voteFillWorker unable to write failed enqueue response to output channel")
+		}
+	}
+}
+
 func (c *poolCryptoVerifier) bundleWaitWorker(fromVoteFill <-chan bundleFuture) {
 	defer c.wg.Done()
 	for future := range fromVoteFill {
 		b, err := future.wait()
+		c.releaseSlots(1)
 		res := cryptoResult{
 			message:   future.message,
 			TaskIndex: future.index,
@@ -344,9 +490,9 @@ func (c *poolCryptoVerifier) ChannelFull(tag protocol.Tag) (ret bool) {
 	case protocol.ProposalPayloadTag:
 		return len(c.proposals.in) == cap(c.proposals.in) || len(c.proposals.out) > 1 // TODO we want proper backpressue from the proposalTable eventually
 	case protocol.AgreementVoteTag:
-		return len(c.votes.in) == cap(c.votes.in) || cap(c.votes.out)-len(c.votes.out) < c.voteVerifier.Parallelism()+len(c.votes.in)
+		return len(c.votes.in) == cap(c.votes.in) || int(c.queuedVotes.Load()) >= c.maxQueuedVotes || cap(c.votes.out)-len(c.votes.out) < c.voteVerifier.Parallelism()+len(c.votes.in)
 	case protocol.VoteBundleTag:
-		return len(c.bundles.in) == cap(c.bundles.in) || cap(c.bundles.out)-len(c.bundles.out) < 2
+		return len(c.bundles.in) == cap(c.bundles.in) || int(c.queuedBundles.Load()) >= maxQueuedBundles || cap(c.bundles.out)-len(c.bundles.out) < 2
 	default:
 		logging.Base().Panicf("ChannelFull called on bad type: %v", tag)
 		return false
@@ -147,7 +147,7 @@ func TestCryptoVerifierBuffers(t *testing.T) {
 	ledger, addresses, selections, votings := readOnlyFixture100()
 	ctx := context.Background()
 
-	verifier := makeCryptoVerifier(ledger, testBlockValidator{}, MakeAsyncVoteVerifier(nil), logging.Base())
+	verifier := makeCryptoVerifier(ledger, testBlockValidator{}, MakeAsyncVoteVerifier(nil), 0, logging.Base())
 
 	msgTypes := []protocol.Tag{protocol.AgreementVoteTag, protocol.ProposalPayloadTag, protocol.VoteBundleTag}
 
@@ -284,7 +284,7 @@ func BenchmarkCryptoVerifierVoteVertification(b *testing.B) {
 	ledger, addresses, selections, votings := readOnlyFixture100()
 	ctx := context.Background()
 
-	verifier := makeCryptoVerifier(ledger, testBlockValidator{}, MakeAsyncVoteVerifier(nil), logging.Base())
+	verifier := makeCryptoVerifier(ledger, testBlockValidator{}, MakeAsyncVoteVerifier(nil), 0, logging.Base())
 	c := verifier.Verified(protocol.AgreementVoteTag)
 
 	senderIdx := findSender(ledger, basics.Round(300), 0, 0, addresses, selections)
@@ -329,7 +329,7 @@ func BenchmarkCryptoVerifierProposalVertification(b *testing.B) {
 
 	ctx := context.Background()
 
-	verifier := makeCryptoVerifier(ledger, testBlockValidator{}, MakeAsyncVoteVerifier(nil), logging.Base())
+	verifier := makeCryptoVerifier(ledger, testBlockValidator{}, MakeAsyncVoteVerifier(nil), 0, logging.Base())
 	c := verifier.Verified(protocol.ProposalPayloadTag)
 	request := cryptoProposalRequest{
 		message: message{
@@ -354,7 +354,7 @@ func BenchmarkCryptoVerifierProposalVertification(b *testing.B) {
 func BenchmarkCryptoVerifierBundleVertification(b *testing.B) {
 	ledger, addresses, selections, votings := readOnlyFixture7000()
 	ctx := context.Background()
-	verifier := makeCryptoVerifier(ledger, testBlockValidator{}, MakeAsyncVoteVerifier(nil), logging.Base())
+	verifier := makeCryptoVerifier(ledger, testBlockValidator{}, MakeAsyncVoteVerifier(nil), 0, logging.Base())
 	c := verifier.Verified(protocol.VoteBundleTag)
 
 	Step := step(5)
@@ -399,7 +399,7 @@ func TestCryptoVerifierVerificationFailures(t *testing.T) {
 	voteVerifier := MakeAsyncVoteVerifier(&expiredExecPool{mainPool})
 	defer voteVerifier.Quit()
 
-	cryptoVerifier := makeCryptoVerifier(nil, nil, voteVerifier, logging.TestingLog(t))
+	cryptoVerifier := makeCryptoVerifier(makeTestLedger(nil), nil, voteVerifier, 0, logging.TestingLog(t))
 	defer cryptoVerifier.Quit()
 
 	cryptoVerifier.VerifyVote(context.Background(), cryptoVoteRequest{message: message{Tag: protocol.AgreementVoteTag}, Round: basics.Round(8), TaskIndex: uint64(14)})
@@ -75,6 +75,9 @@ type demuxParams struct {
 	processingMonitor EventsProcessingMonitor
 	log               logging.Logger
 	monitor           *coserviceMonitor
+
+	// verificationParallelism is the number of workers of the cryptoVerifier, 0 for GOMAXPROCS
+	verificationParallelism int
 }
 
 // makeDemux initializes the goroutines needed to process external events, setting up the appropriate channels.
@@ -82,7 +85,7 @@ type demuxParams struct {
 // It must be called before other methods are called.
 func makeDemux(params demuxParams) (d *demux) {
 	d = new(demux)
-	d.crypto = makeCryptoVerifier(params.ledger, params.validator, params.voteVerifier, params.log)
+	d.crypto = makeCryptoVerifier(params.ledger, params.validator, params.voteVerifier, params.verificationParallelism, params.log)
 	d.log = params.log
 	d.ledger = params.ledger
 	d.monitor = params.monitor
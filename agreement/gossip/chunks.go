@@ -0,0 +1,454 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gossip
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/util/erasure"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// ProposalChunkHeaderSize is the size of the fixed header preceding the
+// Merkle proof and the shard in a ProposalChunkTag message. The header holds
+// the digest of the whole proposal payload, the Merkle root of its shards,
+// the payload length (4 bytes, big-endian), the number of data and parity
+// shards (1 byte each) and the index of the shard (1 byte). The proof of the
+// shard against the root follows, one digest per level of the tree.
+//
+// A chunk message is handrolled rather than msgp-encoded so that its size is
+// known up front and so that it can be parsed without decoding the shard.
+const ProposalChunkHeaderSize = 2*crypto.DigestSize + 4 + 3
+
+// proposalChunkMaxShards is the largest total number of shards a proposal
+// may be split into, bounded by the one-byte shard index.
+const proposalChunkMaxShards = 255
+
+// proposalChunkMaxProofSize is the size of the Merkle proof of a shard of a
+// proposal split into proposalChunkMaxShards shards.
+const proposalChunkMaxProofSize = 8 * crypto.DigestSize
+
+const (
+	// proposalChunkMaxPending bounds the number of proposals reassembled at
+	// once; the oldest partial proposal is dropped to make room.
+	proposalChunkMaxPending = 8
+	// proposalChunkMaxPendingPerSender bounds the number of proposals whose
+	// reassembly a single peer started; the oldest one it started is dropped
+	// to make room, so that a peer cannot evict the proposals of the others.
+	proposalChunkMaxPendingPerSender = 3
+	// proposalChunkMaxCompleted bounds the number of recently reassembled
+	// proposals remembered so that their remaining chunks are ignored, as
+	// well as the number of remembered chunk sets which failed to reassemble.
+	proposalChunkMaxCompleted = 64
+)
+
+// The leaves and the inner nodes of the Merkle tree of the shards are hashed
+// with distinct prefixes, so that a node cannot be passed off as a shard.
+const (
+	chunkLeafPrefix byte = 0
+	chunkNodePrefix byte = 1
+)
+
+var proposalChunksSent = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_proposal_chunks_sent_total", Description: "Number of proposal payload chunks broadcast or relayed"})
+var proposalChunksReceived = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_proposal_chunks_received_total", Description: "Number of proposal payload chunks received"})
+var proposalChunksInvalid = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_proposal_chunks_invalid_total", Description: "Number of malformed proposal payload chunks received"})
+var proposalsReassembled = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_proposals_reassembled_total", Description: "Number of proposal payloads reassembled from chunks"})
+var proposalReassemblyFailed = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_proposal_reassembly_failed_total", Description: "Number of proposal payloads whose chunks failed to reassemble"})
+var proposalReassemblyEvicted = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_proposal_reassembly_evicted_total", Description: "Number of partially received proposal payloads dropped to bound memory"})
+
+var errChunkDigestMismatch = errors.New("reassembled proposal does not match its digest")
+var errChunkProof = errors.New("shard does not match the Merkle root of its chunks")
+var errChunkRejected = errors.New("chunk belongs to chunks which failed to reassemble")
+
+// proposalChunk is one shard of an erasure-coded proposal payload.
+type proposalChunk struct {
+	digest       crypto.Digest
+	root         crypto.Digest
+	length       uint32
+	dataShards   uint8
+	parityShards uint8
+	index        uint8
+	proof        []crypto.Digest
+	shard        []byte
+}
+
+// ProposalChunkMaxSize returns the maximum size of a ProposalChunkTag
+// message: a single shard holding a whole proposal payload, with the
+// longest proof.
+func ProposalChunkMaxSize() int {
+	return ProposalChunkHeaderSize + proposalChunkMaxProofSize + agreement.TransmittedPayloadMaxSize()
+}
+
+func (c proposalChunk) encode() []byte {
+	proofSize := len(c.proof) * crypto.DigestSize
+	buf := make([]byte, ProposalChunkHeaderSize+proofSize+len(c.shard))
+	copy(buf, c.digest[:])
+	copy(buf[crypto.DigestSize:], c.root[:])
+	binary.BigEndian.PutUint32(buf[2*crypto.DigestSize:], c.length)
+	buf[2*crypto.DigestSize+4] = c.dataShards
+	buf[2*crypto.DigestSize+5] = c.parityShards
+	buf[2*crypto.DigestSize+6] = c.index
+	for i, d := range c.proof {
+		copy(buf[ProposalChunkHeaderSize+i*crypto.DigestSize:], d[:])
+	}
+	copy(buf[ProposalChunkHeaderSize+proofSize:], c.shard)
+	return buf
+}
+
+// decodeProposalChunk parses a chunk, and checks its shard against the
+// Merkle root of the chunks of the proposal.
+func decodeProposalChunk(data []byte) (c proposalChunk, err error) {
+	if len(data) < ProposalChunkHeaderSize {
+		return c, fmt.Errorf("chunk of %d bytes is shorter than its header", len(data))
+	}
+	copy(c.digest[:], data)
+	copy(c.root[:], data[crypto.DigestSize:])
+	c.length = binary.BigEndian.Uint32(data[2*crypto.DigestSize:])
+	c.dataShards = data[2*crypto.DigestSize+4]
+	c.parityShards = data[2*crypto.DigestSize+5]
+	c.index = data[2*crypto.DigestSize+6]
+
+	total := int(c.dataShards) + int(c.parityShards)
+	switch {
+	case c.length == 0 || int(c.length) > agreement.TransmittedPayloadMaxSize():
+		return c, fmt.Errorf("invalid proposal length %d", c.length)
+	case c.dataShards == 0 || total > proposalChunkMaxShards:
+		return c, fmt.Errorf("invalid shard counts %d data, %d parity", c.dataShards, c.parityShards)
+	case int(c.index) >= total:
+		return c, fmt.Errorf("shard index %d out of range for %d shards", c.index, total)
+	}
+
+	proofSize := chunkTreeDepth(total) * crypto.DigestSize
+	shardSize := (int(c.length) + int(c.dataShards) - 1) / int(c.dataShards)
+	if len(data) != ProposalChunkHeaderSize+proofSize+shardSize {
+		return c, fmt.Errorf("chunk of %d bytes, expected %d", len(data), ProposalChunkHeaderSize+proofSize+shardSize)
+	}
+	c.proof = make([]crypto.Digest, proofSize/crypto.DigestSize)
+	for i := range c.proof {
+		copy(c.proof[i][:], data[ProposalChunkHeaderSize+i*crypto.DigestSize:])
+	}
+	c.shard = data[ProposalChunkHeaderSize+proofSize:]
+
+	if !c.verify() {
+		return c, errChunkProof
+	}
+	return c, nil
+}
+
+// chunkTreeDepth returns the depth of the Merkle tree over total shards,
+// which is padded to a power of two leaves.
+func chunkTreeDepth(total int) int {
+	depth := 0
+	for 1<<depth < total {
+		depth++
+	}
+	return depth
+}
+
+func chunkLeafHash(shard []byte) (d crypto.Digest) {
+	h := crypto.NewHash()
+	h.Write([]byte{chunkLeafPrefix})
+	h.Write(shard)
+	copy(d[:], h.Sum(nil))
+	return d
+}
+
+func chunkNodeHash(left, right crypto.Digest) (d crypto.Digest) {
+	h := crypto.NewHash()
+	h.Write([]byte{chunkNodePrefix})
+	h.Write(left[:])
+	h.Write(right[:])
+	copy(d[:], h.Sum(nil))
+	return d
+}
+
+// verify checks the shard of the chunk against its Merkle root.
+func (c proposalChunk) verify() bool {
+	d := chunkLeafHash(c.shard)
+	index := int(c.index)
+	for _, sibling := range c.proof {
+		if index&1 == 0 {
+			d = chunkNodeHash(d, sibling)
+		} else {
+			d = chunkNodeHash(sibling, d)
+		}
+		index >>= 1
+	}
+	return d == c.root
+}
+
+// chunkTree returns the Merkle root of the shards, and the proof of each
+// shard against it.
+func chunkTree(shards [][]byte) (crypto.Digest, [][]crypto.Digest) {
+	depth := chunkTreeDepth(len(shards))
+	level := make([]crypto.Digest, 1<<depth)
+	for i, shard := range shards {
+		level[i] = chunkLeafHash(shard)
+	}
+
+	proofs := make([][]crypto.Digest, len(shards))
+	for len(level) > 1 {
+		for i := range proofs {
+			proofs[i] = append(proofs[i], level[(i>>len(proofs[i]))^1])
+		}
+		next := make([]crypto.Digest, len(level)/2)
+		for i := range next {
+			next[i] = chunkNodeHash(level[2*i], level[2*i+1])
+		}
+		level = next
+	}
+	return level[0], proofs
+}
+
+// makeProposalChunks splits payload into the encoded chunk messages
+// produced by coder. The chunks are a deterministic function of the payload
+// and the shard counts, so every relay of a proposal produces the same
+// chunks and receivers may combine them regardless of where they came from.
+func makeProposalChunks(coder *erasure.Coder, payload []byte) (crypto.Digest, [][]byte) {
+	digest := crypto.Hash(payload)
+	return digest, encodeProposalChunks(digest, uint32(len(payload)), uint8(coder.DataShards()), uint8(coder.ParityShards()), coder.Encode(payload))
+}
+
+// encodeProposalChunks encodes the shards of a proposal as chunk messages,
+// each carrying the proof of its shard.
+func encodeProposalChunks(digest crypto.Digest, length uint32, dataShards, parityShards uint8, shards [][]byte) [][]byte {
+	root, proofs := chunkTree(shards)
+	out := make([][]byte, len(shards))
+	for i, shard := range shards {
+		out[i] = proposalChunk{
+			digest:       digest,
+			root:         root,
+			length:       length,
+			dataShards:   dataShards,
+			parityShards: parityShards,
+			index:        uint8(i),
+			proof:        proofs[i],
+			shard:        shard,
+		}.encode()
+	}
+	return out
+}
+
+// chunkAssemblyKey identifies the chunks which may be combined. The shard
+// counts and the Merkle root are part of the key so that a peer sending
+// chunks with bogus parameters or shards cannot prevent the reassembly of
+// correctly split proposals.
+type chunkAssemblyKey struct {
+	digest       crypto.Digest
+	root         crypto.Digest
+	length       uint32
+	dataShards   uint8
+	parityShards uint8
+}
+
+// chunkAssembly collects the shards of one proposal payload.
+type chunkAssembly struct {
+	// sender is the peer whose chunk started the assembly, which counts
+	// against its bound of pending assemblies.
+	sender   network.Peer
+	shards   [][]byte
+	received int
+	// reconstructing is set once enough shards were received, while the
+	// payload is reconstructed without the lock held.
+	reconstructing bool
+}
+
+// chunkAssembler reassembles proposal payloads from chunks received from
+// any number of peers.
+type chunkAssembler struct {
+	mu deadlock.Mutex
+
+	pending         map[chunkAssemblyKey]*chunkAssembly
+	pendingOrder    []chunkAssemblyKey
+	pendingBySender map[network.Peer]int
+
+	completed      map[crypto.Digest]struct{}
+	completedOrder []crypto.Digest
+
+	// rejected are the chunks whose shards matched their root, but which
+	// did not reassemble into the proposal of their digest.
+	rejected      map[chunkAssemblyKey]struct{}
+	rejectedOrder []chunkAssemblyKey
+}
+
+func makeChunkAssembler() *chunkAssembler {
+	return &chunkAssembler{
+		pending:         make(map[chunkAssemblyKey]*chunkAssembly),
+		pendingBySender: make(map[network.Peer]int),
+		completed:       make(map[crypto.Digest]struct{}),
+		rejected:        make(map[chunkAssemblyKey]struct{}),
+	}
+}
+
+// add records a chunk received from sender, which decodeProposalChunk
+// verified. It returns the proposal payload once enough chunks of it were
+// received, and nil otherwise. Chunks of a proposal which was already
+// reassembled are ignored. An error is returned when the chunks fail to
+// reassemble into the proposal of their digest, or belong to chunks which
+// did: they were not produced by an honest node.
+func (a *chunkAssembler) add(sender network.Peer, c proposalChunk) ([]byte, error) {
+	key := chunkAssemblyKey{digest: c.digest, root: c.root, length: c.length, dataShards: c.dataShards, parityShards: c.parityShards}
+	a.mu.Lock()
+	asm, err := a.addLocked(sender, key, c)
+	a.mu.Unlock()
+	if asm == nil {
+		return nil, err
+	}
+
+	// Reconstructing a large proposal takes a while, and does not hold up
+	// the chunks of the other proposals.
+	payload, err := asm.reconstruct(key)
+	if err == nil && crypto.Hash(payload) != c.digest {
+		err = errChunkDigestMismatch
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.pending[key] == asm {
+		a.forget(key)
+	}
+	if err != nil {
+		proposalReassemblyFailed.Inc(nil)
+		a.markRejected(key)
+		return nil, err
+	}
+	a.markCompleted(c.digest)
+	proposalsReassembled.Inc(nil)
+	return payload, nil
+}
+
+// addLocked records the shard of the chunk in its assembly. It returns the
+// assembly once it holds enough shards to be reconstructed, and nil
+// otherwise.
+func (a *chunkAssembler) addLocked(sender network.Peer, key chunkAssemblyKey, c proposalChunk) (*chunkAssembly, error) {
+	if _, done := a.completed[c.digest]; done {
+		return nil, nil
+	}
+	if _, bad := a.rejected[key]; bad {
+		return nil, errChunkRejected
+	}
+
+	asm := a.pending[key]
+	if asm == nil {
+		if a.pendingBySender[sender] >= proposalChunkMaxPendingPerSender {
+			for _, k := range a.pendingOrder {
+				if a.pending[k].sender == sender {
+					a.forget(k)
+					proposalReassemblyEvicted.Inc(nil)
+					break
+				}
+			}
+		}
+		if len(a.pendingOrder) >= proposalChunkMaxPending {
+			a.forget(a.pendingOrder[0])
+			proposalReassemblyEvicted.Inc(nil)
+		}
+		asm = &chunkAssembly{sender: sender, shards: make([][]byte, int(c.dataShards)+int(c.parityShards))}
+		a.pending[key] = asm
+		a.pendingOrder = append(a.pendingOrder, key)
+		a.pendingBySender[sender]++
+	}
+
+	if asm.reconstructing || asm.shards[c.index] != nil {
+		return nil, nil
+	}
+	asm.shards[c.index] = c.shard
+	asm.received++
+	if asm.received < int(c.dataShards) {
+		return nil, nil
+	}
+	// The assembly is left pending so that the remaining chunks are
+	// ignored while it is reconstructed.
+	asm.reconstructing = true
+	return asm, nil
+}
+
+// markCompleted records that the proposal with the given digest needs no
+// further chunks, either because it was reassembled or because this node
+// sent it.
+func (a *chunkAssembler) markCompleted(digest crypto.Digest) {
+	if _, done := a.completed[digest]; done {
+		return
+	}
+	if len(a.completedOrder) >= proposalChunkMaxCompleted {
+		delete(a.completed, a.completedOrder[0])
+		a.completedOrder = a.completedOrder[1:]
+	}
+	a.completed[digest] = struct{}{}
+	a.completedOrder = append(a.completedOrder, digest)
+}
+
+// markRejected records that the chunks with the given key failed to
+// reassemble, so that their remaining chunks are refused.
+func (a *chunkAssembler) markRejected(key chunkAssemblyKey) {
+	if _, bad := a.rejected[key]; bad {
+		return
+	}
+	if len(a.rejectedOrder) >= proposalChunkMaxCompleted {
+		delete(a.rejected, a.rejectedOrder[0])
+		a.rejectedOrder = a.rejectedOrder[1:]
+	}
+	a.rejected[key] = struct{}{}
+	a.rejectedOrder = append(a.rejectedOrder, key)
+}
+
+// sent marks a proposal this node split into chunks as completed, so that
+// its chunks echoed back by peers are ignored.
+func (a *chunkAssembler) sent(digest crypto.Digest) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for key := range a.pending {
+		if key.digest == digest {
+			a.forget(key)
+		}
+	}
+	a.markCompleted(digest)
+}
+
+// forget drops a partial assembly.
+func (a *chunkAssembler) forget(key chunkAssemblyKey) {
+	asm := a.pending[key]
+	if asm == nil {
+		return
+	}
+	delete(a.pending, key)
+	a.pendingBySender[asm.sender]--
+	if a.pendingBySender[asm.sender] == 0 {
+		delete(a.pendingBySender, asm.sender)
+	}
+	for i, k := range a.pendingOrder {
+		if k == key {
+			a.pendingOrder = append(a.pendingOrder[:i], a.pendingOrder[i+1:]...)
+			break
+		}
+	}
+}
+
+func (asm *chunkAssembly) reconstruct(key chunkAssemblyKey) ([]byte, error) {
+	coder, err := erasure.MakeCoder(int(key.dataShards), int(key.parityShards))
+	if err != nil {
+		return nil, err
+	}
+	return coder.Reconstruct(asm.shards, int(key.length))
+}
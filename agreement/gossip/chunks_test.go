@@ -0,0 +1,409 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gossip
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/erasure"
+)
+
+func randomPayload(t *testing.T, n int) []byte {
+	payload := make([]byte, n)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestProposalChunkEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	coder, err := erasure.MakeCoder(4, 2)
+	require.NoError(t, err)
+	payload := randomPayload(t, 1001)
+
+	digest, chunks := makeProposalChunks(coder, payload)
+	require.Equal(t, crypto.Hash(payload), digest)
+	require.Len(t, chunks, 6)
+	shards := coder.Encode(payload)
+	var root crypto.Digest
+	for i, data := range chunks {
+		require.Len(t, data, ProposalChunkHeaderSize+3*crypto.DigestSize+coder.ShardSize(len(payload)))
+		c, err := decodeProposalChunk(data)
+		require.NoError(t, err)
+		require.Equal(t, digest, c.digest)
+		require.Equal(t, uint32(len(payload)), c.length)
+		require.Equal(t, uint8(4), c.dataShards)
+		require.Equal(t, uint8(2), c.parityShards)
+		require.Equal(t, uint8(i), c.index)
+		require.Len(t, c.proof, 3)
+		require.Equal(t, shards[i], c.shard)
+		require.Equal(t, data, c.encode())
+		if i > 0 {
+			require.Equal(t, root, c.root)
+		}
+		root = c.root
+	}
+
+	// the chunks only depend on the payload and the shard counts
+	_, again := makeProposalChunks(coder, payload)
+	require.Equal(t, chunks, again)
+}
+
+func TestProposalChunkDecodeInvalid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	coder, err := erasure.MakeCoder(2, 1)
+	require.NoError(t, err)
+	_, chunks := makeProposalChunks(coder, randomPayload(t, 10))
+	valid, err := decodeProposalChunk(chunks[2])
+	require.NoError(t, err)
+
+	_, err = decodeProposalChunk(chunks[2][:ProposalChunkHeaderSize-1])
+	require.Error(t, err)
+
+	for _, change := range []func(c *proposalChunk){
+		func(c *proposalChunk) { c.length = 0; c.shard = nil },
+		func(c *proposalChunk) {
+			c.length = uint32(agreement.TransmittedPayloadMaxSize()) + 1
+			c.dataShards = 255
+			c.parityShards = 0
+			c.shard = make([]byte, 20580)
+		},
+		func(c *proposalChunk) { c.dataShards = 0 },
+		func(c *proposalChunk) { c.dataShards = 200; c.parityShards = 56; c.shard = c.shard[:1] },
+		func(c *proposalChunk) { c.index = 3 },
+		func(c *proposalChunk) { c.shard = make([]byte, 6) },
+		func(c *proposalChunk) { c.proof = c.proof[1:] },
+	} {
+		c := valid
+		c.proof = append([]crypto.Digest{}, valid.proof...)
+		change(&c)
+		_, err = decodeProposalChunk(c.encode())
+		require.Error(t, err, "%+v", c)
+	}
+
+	// every shard is checked against the Merkle root of the chunks
+	for _, change := range []func(c *proposalChunk){
+		func(c *proposalChunk) { c.shard[0] ^= 1 },
+		func(c *proposalChunk) { c.proof[0][0] ^= 1 },
+		func(c *proposalChunk) { c.root[0] ^= 1 },
+		func(c *proposalChunk) { c.index = 1 },
+	} {
+		c := valid
+		c.shard = append([]byte{}, valid.shard...)
+		c.proof = append([]crypto.Digest{}, valid.proof...)
+		change(&c)
+		_, err = decodeProposalChunk(c.encode())
+		require.ErrorIs(t, err, errChunkProof)
+	}
+}
+
+func decodeChunks(t *testing.T, chunks [][]byte) []proposalChunk {
+	out := make([]proposalChunk, len(chunks))
+	for i, data := range chunks {
+		c, err := decodeProposalChunk(data)
+		require.NoError(t, err)
+		out[i] = c
+	}
+	return out
+}
+
+func TestChunkAssemblerAnySubset(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	coder, err := erasure.MakeCoder(4, 3)
+	require.NoError(t, err)
+	payload := randomPayload(t, 5000)
+	_, encoded := makeProposalChunks(coder, payload)
+	chunks := decodeChunks(t, encoded)
+
+	for _, subset := range [][]int{
+		{0, 1, 2, 3},
+		{6, 5, 4, 3},
+		{0, 6, 2, 4},
+		{1, 1, 5, 3, 5, 0},
+	} {
+		a := makeChunkAssembler()
+		var out []byte
+		for n, idx := range subset {
+			require.Nil(t, out, "reassembled before the last chunk")
+			out, err = a.add(n, chunks[idx])
+			require.NoError(t, err)
+			if n < len(subset)-1 {
+				require.Nil(t, out)
+			}
+		}
+		require.Equal(t, payload, out)
+
+		// late chunks of a reassembled proposal are ignored
+		out, err = a.add(0, chunks[2])
+		require.NoError(t, err)
+		require.Nil(t, out)
+		require.Empty(t, a.pending)
+		require.Empty(t, a.pendingBySender)
+	}
+}
+
+func TestChunkAssemblerCorrupted(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	coder, err := erasure.MakeCoder(2, 1)
+	require.NoError(t, err)
+	payload := randomPayload(t, 100)
+	digest, encoded := makeProposalChunks(coder, payload)
+	chunks := decodeChunks(t, encoded)
+
+	// shards matching a root of their own do not reassemble into the
+	// proposal of their digest, and their remaining chunks are refused
+	shards := coder.Encode(payload)
+	shards[2][0] ^= 1
+	forged := decodeChunks(t, encodeProposalChunks(digest, uint32(len(payload)), 2, 1, shards))
+	a := makeChunkAssembler()
+	out, err := a.add(1, forged[2])
+	require.NoError(t, err)
+	require.Nil(t, out)
+	_, err = a.add(1, forged[0])
+	require.ErrorIs(t, err, errChunkDigestMismatch)
+	_, err = a.add(2, forged[1])
+	require.ErrorIs(t, err, errChunkRejected)
+	require.Empty(t, a.pending)
+
+	// the genuine chunks still reassemble
+	out, err = a.add(2, chunks[2])
+	require.NoError(t, err)
+	require.Nil(t, out)
+	out, err = a.add(3, chunks[0])
+	require.NoError(t, err)
+	require.Equal(t, payload, out)
+
+	// chunks claiming different shard counts do not mix with genuine ones
+	a = makeChunkAssembler()
+	bogus := chunks[1]
+	bogus.dataShards = 1
+	bogus.parityShards = 2
+	_, err = a.add(1, bogus)
+	require.ErrorIs(t, err, erasure.ErrShardSize)
+	bogus.dataShards = 3
+	bogus.parityShards = 0
+	out, err = a.add(1, bogus)
+	require.NoError(t, err)
+	require.Nil(t, out)
+	out, err = a.add(2, chunks[0])
+	require.NoError(t, err)
+	require.Nil(t, out)
+	out, err = a.add(2, chunks[2])
+	require.NoError(t, err)
+	require.Equal(t, payload, out)
+}
+
+func TestChunkAssemblerBounded(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	coder, err := erasure.MakeCoder(2, 0)
+	require.NoError(t, err)
+	a := makeChunkAssembler()
+
+	var first []proposalChunk
+	for i := 0; i < proposalChunkMaxPending+1; i++ {
+		_, encoded := makeProposalChunks(coder, randomPayload(t, 64))
+		chunks := decodeChunks(t, encoded)
+		if i == 0 {
+			first = chunks
+		}
+		out, err := a.add(i, chunks[0])
+		require.NoError(t, err)
+		require.Nil(t, out)
+	}
+	require.Len(t, a.pending, proposalChunkMaxPending)
+	require.Len(t, a.pendingOrder, proposalChunkMaxPending)
+	require.Len(t, a.pendingBySender, proposalChunkMaxPending)
+
+	// the oldest partial proposal was evicted and starts over
+	out, err := a.add(0, first[1])
+	require.NoError(t, err)
+	require.Nil(t, out)
+
+	for i := 0; i < proposalChunkMaxCompleted+1; i++ {
+		a.sent(crypto.Hash([]byte{byte(i)}))
+	}
+	require.Len(t, a.completed, proposalChunkMaxCompleted)
+	require.Len(t, a.completedOrder, proposalChunkMaxCompleted)
+}
+
+func TestChunkAssemblerBoundedPerSender(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	coder, err := erasure.MakeCoder(2, 0)
+	require.NoError(t, err)
+	a := makeChunkAssembler()
+
+	payload := randomPayload(t, 64)
+	_, encoded := makeProposalChunks(coder, payload)
+	honest := decodeChunks(t, encoded)
+	out, err := a.add("honest", honest[0])
+	require.NoError(t, err)
+	require.Nil(t, out)
+
+	// a peer starting many reassemblies only evicts its own
+	var first []proposalChunk
+	for i := 0; i < proposalChunkMaxPending; i++ {
+		_, encoded := makeProposalChunks(coder, randomPayload(t, 64))
+		chunks := decodeChunks(t, encoded)
+		if i == 0 {
+			first = chunks
+		}
+		out, err := a.add("flooder", chunks[0])
+		require.NoError(t, err)
+		require.Nil(t, out)
+	}
+	require.Len(t, a.pending, proposalChunkMaxPendingPerSender+1)
+	require.Equal(t, proposalChunkMaxPendingPerSender, a.pendingBySender["flooder"])
+	require.Equal(t, 1, a.pendingBySender["honest"])
+
+	out, err = a.add("other", honest[1])
+	require.NoError(t, err)
+	require.Equal(t, payload, out)
+
+	// the first reassembly of the flooder was evicted and starts over
+	out, err = a.add("other", first[1])
+	require.NoError(t, err)
+	require.Nil(t, out)
+}
+
+// chunkRecorder is a network.GossipNode which records what it is asked to
+// send.
+type chunkRecorder struct {
+	network.GossipNode
+	tags [][]protocol.Tag
+	data [][][]byte
+}
+
+func (r *chunkRecorder) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	return r.BroadcastArray(ctx, []protocol.Tag{tag}, [][]byte{data}, wait, except)
+}
+
+func (r *chunkRecorder) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except network.Peer) error {
+	r.tags = append(r.tags, tags)
+	r.data = append(r.data, data)
+	return nil
+}
+
+func (r *chunkRecorder) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	return r.Broadcast(ctx, tag, data, wait, except)
+}
+
+func (r *chunkRecorder) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except network.Peer) error {
+	return r.BroadcastArray(ctx, tags, data, wait, except)
+}
+
+func TestNetworkProposalChunking(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.ProposalChunkingThreshold = 1000
+	cfg.ProposalChunkDataShards = 5
+	cfg.ProposalChunkParityShards = 2
+
+	sender := &chunkRecorder{}
+	senderImpl := WrapNetwork(sender, logging.TestingLog(t), cfg).(*networkImpl)
+
+	// small payloads and other tags are sent unchanged
+	require.NoError(t, senderImpl.Broadcast(protocol.ProposalPayloadTag, randomPayload(t, 1000)))
+	require.NoError(t, senderImpl.Broadcast(protocol.AgreementVoteTag, randomPayload(t, 2000)))
+	require.Equal(t, [][]protocol.Tag{{protocol.ProposalPayloadTag}, {protocol.AgreementVoteTag}}, sender.tags)
+
+	payload := randomPayload(t, 10000)
+	require.NoError(t, senderImpl.Broadcast(protocol.ProposalPayloadTag, payload))
+	require.Len(t, sender.tags, 3)
+	require.Len(t, sender.tags[2], 7)
+	for _, tag := range sender.tags[2] {
+		require.Equal(t, protocol.ProposalChunkTag, tag)
+	}
+	chunks := sender.data[2]
+
+	// the receiver takes chunks from different peers, some lost
+	receiver := &chunkRecorder{}
+	receiverImpl := WrapNetwork(receiver, logging.TestingLog(t), config.GetDefaultLocal()).(*networkImpl)
+	for i, idx := range []int{6, 0, 3, 5, 1} {
+		out := receiverImpl.processProposalChunkMessage(network.IncomingMessage{Sender: i, Tag: protocol.ProposalChunkTag, Data: chunks[idx]})
+		require.Equal(t, network.Ignore, out.Action)
+	}
+	var msg agreement.Message
+	select {
+	case msg = <-receiverImpl.Messages(protocol.ProposalPayloadTag):
+	default:
+		require.FailNow(t, "proposal was not reassembled")
+	}
+	require.Equal(t, payload, msg.Data)
+	metadata := messageMetadataFromHandle(msg.MessageHandle)
+	require.Equal(t, protocol.ProposalPayloadTag, metadata.raw.Tag)
+	require.Equal(t, 4, metadata.raw.Sender)
+
+	// remaining chunks are ignored
+	receiverImpl.processProposalChunkMessage(network.IncomingMessage{Sender: 5, Tag: protocol.ProposalChunkTag, Data: chunks[2]})
+	require.Empty(t, receiverImpl.Messages(protocol.ProposalPayloadTag))
+
+	// a malformed chunk disconnects its sender
+	out := receiverImpl.processProposalChunkMessage(network.IncomingMessage{Sender: 6, Tag: protocol.ProposalChunkTag, Data: chunks[0][:ProposalChunkHeaderSize]})
+	require.Equal(t, network.Disconnect, out.Action)
+
+	// so does a chunk whose shard does not match its root
+	corrupted := append([]byte{}, chunks[0]...)
+	corrupted[len(corrupted)-1] ^= 1
+	out = receiverImpl.processProposalChunkMessage(network.IncomingMessage{Sender: 6, Tag: protocol.ProposalChunkTag, Data: corrupted})
+	require.Equal(t, network.Disconnect, out.Action)
+
+	// a receiver without chunking enabled relays the proposal whole
+	require.NoError(t, receiverImpl.Relay(msg.MessageHandle, protocol.ProposalPayloadTag, msg.Data))
+	require.Equal(t, [][]protocol.Tag{{protocol.ProposalPayloadTag}}, receiver.tags)
+
+	// the sender ignores its own chunks echoed back
+	senderImpl.processProposalChunkMessage(network.IncomingMessage{Sender: 1, Tag: protocol.ProposalChunkTag, Data: chunks[0]})
+	require.Empty(t, senderImpl.chunks.pending)
+}
+
+func TestNetworkProposalChunkingInvalidConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.ProposalChunkingThreshold = 1000
+	cfg.ProposalChunkDataShards = 200
+	cfg.ProposalChunkParityShards = 100
+
+	impl := WrapNetwork(&chunkRecorder{}, logging.TestingLog(t), cfg).(*networkImpl)
+	require.Nil(t, impl.chunkCoder)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/erasure"
 	"github.com/algorand/go-algorand/util/metrics"
 )
 
@@ -58,6 +59,13 @@ type networkImpl struct {
 	log logging.Logger
 
 	trace messagetracer.MessageTracer
+
+	// chunks reassembles proposal payloads received as chunks.
+	chunks *chunkAssembler
+	// chunkCoder splits proposal payloads larger than chunkThreshold into
+	// chunks; it is nil if chunking is disabled.
+	chunkCoder     *erasure.Coder
+	chunkThreshold uint64
 }
 
 // WrapNetwork adapts a network.GossipNode into an agreement.Network.
@@ -71,6 +79,22 @@ func WrapNetwork(net network.GossipNode, log logging.Logger, cfg config.Local) a
 	i.net = net
 	i.log = log
 
+	i.chunks = makeChunkAssembler()
+	if cfg.ProposalChunkingThreshold > 0 {
+		if cfg.ProposalChunkDataShards+cfg.ProposalChunkParityShards > proposalChunkMaxShards {
+			log.Errorf("agreement: proposal chunking disabled: %d data and %d parity chunks exceed the limit of %d",
+				cfg.ProposalChunkDataShards, cfg.ProposalChunkParityShards, proposalChunkMaxShards)
+		} else {
+			coder, err := erasure.MakeCoder(int(cfg.ProposalChunkDataShards), int(cfg.ProposalChunkParityShards))
+			if err != nil {
+				log.Errorf("agreement: proposal chunking disabled: %v", err)
+			} else {
+				i.chunkCoder = coder
+				i.chunkThreshold = cfg.ProposalChunkingThreshold
+			}
+		}
+	}
+
 	return i
 }
 
@@ -84,6 +108,7 @@ func (i *networkImpl) Start() {
 	handlers := []network.TaggedMessageHandler{
 		{Tag: protocol.AgreementVoteTag, MessageHandler: network.HandlerFunc(i.processVoteMessage)},
 		{Tag: protocol.ProposalPayloadTag, MessageHandler: network.HandlerFunc(i.processProposalMessage)},
+		{Tag: protocol.ProposalChunkTag, MessageHandler: network.HandlerFunc(i.processProposalChunkMessage)},
 		{Tag: protocol.VoteBundleTag, MessageHandler: network.HandlerFunc(i.processBundleMessage)},
 	}
 	i.net.RegisterHandlers(handlers)
@@ -107,6 +132,35 @@ func (i *networkImpl) processProposalMessage(raw network.IncomingMessage) networ
 	return i.processMessage(raw, i.proposalCh, agreementProposalMessageType)
 }
 
+// processProposalChunkMessage collects proposal chunks and hands the
+// proposal to agreement as if it was received in a single message once enough
+// chunks arrived. The handle of the resulting message refers to the peer
+// which sent the last chunk.
+func (i *networkImpl) processProposalChunkMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	proposalChunksReceived.Inc(nil)
+	chunk, err := decodeProposalChunk(raw.Data)
+	if err != nil {
+		proposalChunksInvalid.Inc(nil)
+		i.log.Debugf("agreement: disconnecting peer %v after malformed proposal chunk: %v", raw.Sender, err)
+		return network.OutgoingMessage{Action: network.Disconnect}
+	}
+
+	// Honest nodes only send the chunks of proposals they split themselves,
+	// which reassemble into the proposal of their digest.
+	payload, err := i.chunks.add(raw.Sender, chunk)
+	if err != nil {
+		i.log.Infof("agreement: disconnecting peer %v after chunks not reassembling into proposal %v: %v", raw.Sender, chunk.digest, err)
+		return network.OutgoingMessage{Action: network.Disconnect}
+	}
+	if payload == nil {
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+
+	raw.Tag = protocol.ProposalPayloadTag
+	raw.Data = payload
+	return i.processProposalMessage(raw)
+}
+
 func (i *networkImpl) processBundleMessage(raw network.IncomingMessage) network.OutgoingMessage {
 	return i.processMessage(raw, i.bundleCh, agreementBundleMessageType)
 }
@@ -145,8 +199,29 @@ func (i *networkImpl) Messages(t protocol.Tag) <-chan agreement.Message {
 	}
 }
 
+// split returns the chunks to send in place of a proposal payload above the
+// chunking threshold, along with their tags. It returns nil if the message
+// should be sent as is.
+func (i *networkImpl) split(t protocol.Tag, data []byte) ([]protocol.Tag, [][]byte) {
+	if t != protocol.ProposalPayloadTag || i.chunkCoder == nil || uint64(len(data)) <= i.chunkThreshold {
+		return nil, nil
+	}
+	digest, chunks := makeProposalChunks(i.chunkCoder, data)
+	i.chunks.sent(digest)
+	tags := make([]protocol.Tag, len(chunks))
+	for j := range tags {
+		tags[j] = protocol.ProposalChunkTag
+	}
+	proposalChunksSent.AddUint64(uint64(len(chunks)), nil)
+	return tags, chunks
+}
+
 func (i *networkImpl) Broadcast(t protocol.Tag, data []byte) (err error) {
-	err = i.net.Broadcast(context.Background(), t, data, false, nil)
+	if tags, chunks := i.split(t, data); chunks != nil {
+		err = i.net.BroadcastArray(context.Background(), tags, chunks, false, nil)
+	} else {
+		err = i.net.Broadcast(context.Background(), t, data, false, nil)
+	}
 	if err != nil {
 		i.log.Infof("agreement: could not broadcast message with tag %v: %v", t, err)
 	}
@@ -155,13 +230,22 @@ func (i *networkImpl) Broadcast(t protocol.Tag, data []byte) (err error) {
 
 func (i *networkImpl) Relay(h agreement.MessageHandle, t protocol.Tag, data []byte) (err error) {
 	metadata := messageMetadataFromHandle(h)
+	tags, chunks := i.split(t, data)
 	if metadata == nil { // synthentic loopback
-		err = i.net.Broadcast(context.Background(), t, data, false, nil)
+		if chunks != nil {
+			err = i.net.BroadcastArray(context.Background(), tags, chunks, false, nil)
+		} else {
+			err = i.net.Broadcast(context.Background(), t, data, false, nil)
+		}
 		if err != nil {
 			i.log.Infof("agreement: could not (pseudo)relay message with tag %v: %v", t, err)
 		}
 	} else {
-		err = i.net.Relay(context.Background(), t, data, false, metadata.raw.Sender)
+		if chunks != nil {
+			err = i.net.RelayArray(context.Background(), tags, chunks, false, metadata.raw.Sender)
+		} else {
+			err = i.net.Relay(context.Background(), t, data, false, metadata.raw.Sender)
+		}
 		if err != nil {
 			i.log.Infof("agreement: could not relay message from %v with tag %v: %v", metadata.raw.Sender, t, err)
 		}
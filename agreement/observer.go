@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// An Observer is notified of the progress of the agreement service, giving
+// external monitors consensus-level visibility without parsing its logs.
+//
+// The methods of an Observer are called by the state machine of the agreement
+// service, one at a time. They must return quickly and must not block: an
+// Observer forwarding the events elsewhere should buffer them, and drop them
+// when it falls behind.
+type Observer interface {
+	// OnRoundStart is called when the service enters a round, after
+	// certifying the previous round or catching up with the ledger.
+	OnRoundStart(RoundStartEvent)
+	// OnProposalObserved is called when the service accepts a proposal for
+	// a period of its round, before the proposed block is validated.
+	OnProposalObserved(ProposalObservedEvent)
+	// OnVoteObserved is called for each soft, cert and next vote the service
+	// accepts.
+	OnVoteObserved(VoteObservedEvent)
+	// OnCertified is called once per round, when the service learns the
+	// certificate of the round, whether it holds the certified block or
+	// waits for the ledger to fetch it.
+	OnCertified(CertifiedEvent)
+}
+
+// RoundStartEvent reports the agreement service entering a round.
+type RoundStartEvent struct {
+	Round basics.Round
+}
+
+// ProposalObservedEvent reports a proposal accepted by the agreement service.
+type ProposalObservedEvent struct {
+	Round  basics.Round
+	Period uint64
+	// Proposer is the account which originally proposed the block, and Sender
+	// the account whose proposal-vote carried it. They differ when the block
+	// is reproposed in a later period.
+	Proposer    basics.Address
+	Sender      basics.Address
+	BlockDigest crypto.Digest
+}
+
+// VoteObservedEvent reports a vote accepted by the agreement service.
+type VoteObservedEvent struct {
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+	Sender basics.Address
+	// BlockDigest is zero for the next votes for no block.
+	BlockDigest crypto.Digest
+	// Weight is the weight of the vote, and ProposalWeight the total weight of
+	// the votes the service accepted for the same block in the same step.
+	Weight         uint64
+	ProposalWeight uint64
+}
+
+// CertifiedEvent reports the certificate of a round learned by the agreement
+// service.
+type CertifiedEvent struct {
+	Round       basics.Round
+	Period      uint64
+	Proposer    basics.Address
+	BlockDigest crypto.Digest
+}
+
+// observerSet holds the Observers registered with the agreement service. The
+// methods of a nil observerSet do nothing.
+type observerSet struct {
+	mu        deadlock.RWMutex
+	observers map[uint64]Observer
+	nextID    uint64
+}
+
+func makeObserverSet() *observerSet {
+	return &observerSet{observers: make(map[uint64]Observer)}
+}
+
+// register adds o to the set, and returns the function removing it.
+func (set *observerSet) register(o Observer) (unregister func()) {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	id := set.nextID
+	set.nextID++
+	set.observers[id] = o
+	return func() {
+		set.mu.Lock()
+		defer set.mu.Unlock()
+		delete(set.observers, id)
+	}
+}
+
+// notify calls fn on each registered Observer.
+func (set *observerSet) notify(fn func(Observer)) {
+	if set == nil {
+		return
+	}
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for _, o := range set.observers {
+		fn(o)
+	}
+}
+
+func (set *observerSet) roundStart(r round) {
+	set.notify(func(o Observer) {
+		o.OnRoundStart(RoundStartEvent{Round: r})
+	})
+}
+
+func (set *observerSet) proposalObserved(sender basics.Address, prop proposalValue, r round, p period) {
+	set.notify(func(o Observer) {
+		o.OnProposalObserved(ProposalObservedEvent{
+			Round:       r,
+			Period:      uint64(p),
+			Proposer:    prop.OriginalProposer,
+			Sender:      sender,
+			BlockDigest: prop.BlockDigest,
+		})
+	})
+}
+
+func (set *observerSet) voteObserved(v vote, proposalWeight uint64) {
+	set.notify(func(o Observer) {
+		o.OnVoteObserved(VoteObservedEvent{
+			Round:          v.R.Round,
+			Period:         uint64(v.R.Period),
+			Step:           uint64(v.R.Step),
+			Sender:         v.R.Sender,
+			BlockDigest:    v.R.Proposal.BlockDigest,
+			Weight:         v.Cred.Weight,
+			ProposalWeight: proposalWeight,
+		})
+	})
+}
+
+func (set *observerSet) certified(cert Certificate) {
+	set.notify(func(o Observer) {
+		o.OnCertified(CertifiedEvent{
+			Round:       cert.Round,
+			Period:      uint64(cert.Period),
+			Proposer:    cert.Proposal.OriginalProposer,
+			BlockDigest: cert.Proposal.BlockDigest,
+		})
+	})
+}
+
+// RegisterObserver registers o to be notified of the progress of the service,
+// and returns the function unregistering it. Observers may be registered
+// before the service starts, and while it runs.
+func (s *Service) RegisterObserver(o Observer) (unregister func()) {
+	return s.observers.register(o)
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// recordingObserver records the events it is notified of
+type recordingObserver struct {
+	mu          deadlock.Mutex
+	roundStarts []RoundStartEvent
+	proposals   []ProposalObservedEvent
+	votes       []VoteObservedEvent
+	certified   []CertifiedEvent
+}
+
+func (o *recordingObserver) OnRoundStart(e RoundStartEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.roundStarts = append(o.roundStarts, e)
+}
+
+func (o *recordingObserver) OnProposalObserved(e ProposalObservedEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.proposals = append(o.proposals, e)
+}
+
+func (o *recordingObserver) OnVoteObserved(e VoteObservedEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.votes = append(o.votes, e)
+}
+
+func (o *recordingObserver) OnCertified(e CertifiedEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.certified = append(o.certified, e)
+}
+
+func TestObserverSetRegistration(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// a nil set notifies no one
+	var set *observerSet
+	set.roundStart(1)
+
+	set = makeObserverSet()
+	o1 := &recordingObserver{}
+	o2 := &recordingObserver{}
+	unregister1 := set.register(o1)
+	set.register(o2)
+	set.roundStart(1)
+
+	unregister1()
+	set.roundStart(2)
+	require.Equal(t, []RoundStartEvent{{Round: 1}}, o1.roundStarts)
+	require.Equal(t, []RoundStartEvent{{Round: 1}, {Round: 2}}, o2.roundStarts)
+}
+
+func TestObserverNotifiedOfRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 3
+	numRounds := 3
+	_, baseLedger, cleanupFn, services, clocks, ledgers, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	startRound := baseLedger.NextRound()
+	defer cleanupFn()
+
+	observer := &recordingObserver{}
+	services[0].RegisterObserver(observer)
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(clocks, 0)
+	for j := 0; j < numRounds; j++ {
+		version, _ := baseLedger.ConsensusVersion(ParamsRound(startRound + basics.Round(j)))
+		zeroes = runRound(clocks, activityMonitor, zeroes, FilterTimeout(0, version))
+	}
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+	sanityCheck(startRound, round(numRounds), ledgers)
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	// each round is certified with the block the ledger committed, and the next round starts
+	require.Len(t, observer.certified, numRounds)
+	require.GreaterOrEqual(t, len(observer.roundStarts), numRounds)
+	roundStarts := observer.roundStarts[len(observer.roundStarts)-numRounds:]
+	for j := 0; j < numRounds; j++ {
+		r := startRound + basics.Round(j)
+		require.Equal(t, r, observer.certified[j].Round)
+		require.Equal(t, ledgers[0].(*testLedger).entries[r].Digest(), observer.certified[j].BlockDigest)
+		require.Equal(t, r+1, roundStarts[j].Round)
+	}
+
+	require.NotEmpty(t, observer.proposals)
+	require.NotEmpty(t, observer.votes)
+	for _, v := range observer.votes {
+		require.GreaterOrEqual(t, v.ProposalWeight, v.Weight)
+		require.NotZero(t, v.Weight)
+	}
+}
@@ -316,6 +316,23 @@ func (p *asyncPersistenceLoop) Enqueue(clock timers.Clock, round basics.Round, p
 	return eventsChannel
 }
 
+// TryEnqueue enqueues a checkpoint of the agreement state, which nothing waits for, unless the persistence loop
+// is busy: the checkpoint is then dropped rather than delaying the agreement, and the next one supersedes it.
+func (p *asyncPersistenceLoop) TryEnqueue(clock timers.Clock, round basics.Round, period period, step step, raw []byte) bool {
+	select {
+	case p.pending <- persistentRequest{
+		round:  round,
+		period: period,
+		step:   step,
+		raw:    raw,
+		clock:  clock,
+	}:
+		return true
+	default:
+		return false
+	}
+}
+
 func (p *asyncPersistenceLoop) Start() {
 	p.wg.Add(1)
 	ctx, ctxExit := context.WithCancel(context.Background())
@@ -348,14 +365,17 @@ func (p *asyncPersistenceLoop) loop(ctx context.Context) {
 		// store the state.
 		err := persist(p.log, p.crashDb, s.round, s.period, s.step, s.raw)
 
-		s.events <- checkpointEvent{
-			Round:  s.round,
-			Period: s.period,
-			Step:   s.step,
-			Err:    makeSerErr(err),
-			done:   s.done,
+		// checkpoints have no completion events, as nothing waits for them.
+		if s.events != nil {
+			s.events <- checkpointEvent{
+				Round:  s.round,
+				Period: s.period,
+				Step:   s.step,
+				Err:    makeSerErr(err),
+				done:   s.done,
+			}
+			close(s.events)
 		}
-		close(s.events)
 
 		// sanity check; we check it after the fact, since it's not expected to ever happen.
 		// performance-wise, it takes approximitly 300000ns to execute, and we don't want it to
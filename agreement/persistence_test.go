@@ -113,6 +113,41 @@ func TestAgreementPersistence(t *testing.T) {
 	require.Equalf(t, raw[:], raw2[:], "raw data was persisted incorrectly.")
 }
 
+func TestAgreementCheckpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accessor, err := db.MakeAccessor(t.Name()+"_crash.db", false, true)
+	require.NoError(t, err)
+	defer accessor.Close()
+
+	accessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return agreeInstallDatabase(tx)
+	}) // ignore error
+
+	log := makeServiceLogger(logging.Base())
+	clock := timers.MakeMonotonicClock(time.Date(2015, 1, 2, 5, 6, 7, 8, time.UTC))
+	status := player{Round: 1, Period: 2, Step: next, Deadline: time.Duration(23) * time.Second}
+	raw := encode(clock, makeRootRouter(status), status, []action{}, false)
+
+	loop := makeAsyncPersistenceLoop(log, accessor, makeTestLedger(readOnlyGenesis10))
+	require.True(t, loop.TryEnqueue(clock, status.Round, status.Period, status.Step, raw))
+	// the loop isn't running yet, so the second checkpoint is dropped instead of blocking.
+	require.False(t, loop.TryEnqueue(clock, status.Round, status.Period, status.Step, raw))
+
+	loop.Start()
+	defer loop.Quit()
+	require.Eventually(t, func() bool {
+		raw2, err := restore(log, accessor)
+		return err == nil && string(raw2) == string(raw)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	raw2, err := restore(log, accessor)
+	require.NoError(t, err)
+	_, _, status2, _, err := decode(raw2, clock, log, false)
+	require.NoError(t, err)
+	require.Equal(t, status, status2)
+}
+
 func BenchmarkAgreementPersistence(b *testing.B) {
 
 	// temporary skip now until we implement more meaningfull test.
@@ -275,12 +275,14 @@ func (p *player) handleThresholdEvent(r routerHandle, e thresholdEvent) []action
 			cert := Certificate(e.Bundle)
 			a0 := ensureAction{Payload: res.Payload, Certificate: cert}
 			actions = append(actions, a0)
+			r.t.observeCertified(cert)
 			as := p.enterRound(r, e, p.Round+1)
 			return append(actions, as...)
 		}
 		// we don't have the block! We need to ensure we will be able to receive the block.
 		// In addition, hint to the ledger to fetch by digest.
 		actions = append(actions, stageDigestAction{Certificate: Certificate(e.Bundle)})
+		r.t.observeCertified(Certificate(e.Bundle))
 		if p.Period < e.Period {
 			actions = append(actions, p.enterPeriod(r, e, e.Period)...)
 		}
@@ -308,6 +310,7 @@ func (p *player) handleThresholdEvent(r routerHandle, e thresholdEvent) []action
 		if p.Period > e.Period {
 			return nil
 		}
+		r.t.logNextBundle(e)
 		return p.enterPeriod(r, e, e.Period+1)
 	default:
 		panic("bad event")
@@ -321,6 +324,7 @@ func (p *player) enterPeriod(r routerHandle, source thresholdEvent, target perio
 	// TODO might be better passing through the old period explicitly in the {soft,next}Threshold event
 	e := r.dispatch(*p, source, proposalMachine, p.Round, p.Period, 0)
 	r.t.logPeriodConcluded(*p, target, source.Proposal)
+	r.t.logPeriodEntered(*p, source, target)
 
 	p.LastConcluding = p.Step
 	p.Period = target
@@ -365,6 +369,7 @@ func (p *player) enterRound(r routerHandle, source event, target round) []action
 	}
 	// this happens here so that the proposalMachine contract does not complain
 	e := r.dispatch(*p, newRoundEvent, proposalMachine, target, 0, 0)
+	r.t.logRoundConcluded(*p, source)
 
 	p.LastConcluding = p.Step
 	p.Round = target
@@ -385,6 +390,7 @@ func (p *player) enterRound(r routerHandle, source event, target round) []action
 	// update tracer state to match player
 	r.t.setMetadata(tracerMetadata{p.Round, p.Period, p.Step})
 	r.t.resetTimingWithPipeline(target)
+	r.t.observers.roundStart(target)
 
 	// do proposal-related actions
 	as := pseudonodeAction{T: assemble, Round: p.Round, Period: 0}
@@ -419,6 +425,7 @@ func (p *player) partitionPolicy(r routerHandle) (actions []action) {
 	if !p.partitioned() {
 		return
 	}
+	r.t.logResynchronization(*p)
 
 	res := r.dispatch(*p, freshestBundleRequestEvent{}, voteMachineRound, p.Round, 0, 0)
 	bundleResponse := res.(freshestBundleEvent) // panic if violate postcondition
@@ -594,6 +601,7 @@ func (p *player) handleMessageEvent(r routerHandle, e messageEvent) (actions []a
 				cert := Certificate(freshestRes.Event.Bundle)
 				a0 := ensureAction{Payload: e.Input.Proposal, Certificate: cert}
 				actions = append(actions, a0)
+				r.t.observeCertified(cert)
 				as := p.enterRound(r, delegatedE, cert.Round+1)
 				return append(actions, as...)
 			}
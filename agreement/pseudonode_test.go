@@ -288,7 +288,7 @@ func makeSerializedPseudonode(factory BlockFactory, validator BlockValidator, ke
 }
 
 func (n serializedPseudonode) MakeProposals(ctx context.Context, r round, p period) (outChan <-chan externalEvent, err error) {
-	verifier := makeCryptoVerifier(n.ledger, n.validator, MakeAsyncVoteVerifier(nil), n.log)
+	verifier := makeCryptoVerifier(n.ledger, n.validator, MakeAsyncVoteVerifier(nil), 0, n.log)
 	defer verifier.Quit()
 
 	n.loadRoundParticipationKeys(n.ledger.NextRound())
@@ -345,7 +345,7 @@ func (n serializedPseudonode) MakeProposals(ctx context.Context, r round, p peri
 }
 
 func (n serializedPseudonode) MakeVotes(ctx context.Context, r round, p period, s step, prop proposalValue, persistStateDone chan error) (outChan chan externalEvent, err error) {
-	verifier := makeCryptoVerifier(n.ledger, n.validator, MakeAsyncVoteVerifier(nil), n.log)
+	verifier := makeCryptoVerifier(n.ledger, n.validator, MakeAsyncVoteVerifier(nil), 0, n.log)
 	defer verifier.Quit()
 
 	n.loadRoundParticipationKeys(r)
@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// The classifications of the cause of a recovery episode, which only rely on how the episode started so that no
+// proposer or voter is blamed.
+const (
+	// recoveryCauseNoProposal is the network next-voting the empty value, no proposal being soft-voted in time.
+	recoveryCauseNoProposal = "no-proposal"
+	// recoveryCauseNoCertification is the network next-voting a proposal it did not certify in time.
+	recoveryCauseNoCertification = "no-certification"
+	// recoveryCauseFastForward is the node lagging behind the network, and catching up with a later period.
+	recoveryCauseFastForward = "fast-forward"
+)
+
+// The ways a recovery episode is resolved.
+const (
+	recoveryResolutionCertificate = "certificate"
+	recoveryResolutionCatchup     = "catchup"
+)
+
+var periodsEnteredCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_periods_entered_total", Description: "Number of periods past 0 entered, by the threshold which made the agreement enter them: next, soft or cert"})
+var nextBundlesCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_next_bundles_total", Description: "Number of next-vote bundles formed for the current period or a later one, by value: bottom or proposal"})
+var resynchronizationsCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_resynchronizations_total", Description: "Number of resynchronization attempts made by the agreement to recover from a partition"})
+var recoveryEpisodesCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_recovery_episodes_total", Description: "Number of rounds concluded after entering a period past 0, by cause"})
+var recoveryPeriodsCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_recovery_periods_total", Description: "Number of periods traversed by the recovery episodes"})
+var periodGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_agreement_period", Description: "The current period of the agreement"})
+
+// recoveryEpisode is the recovery of the agreement from a round it could not conclude in the period it started it in.
+type recoveryEpisode struct {
+	round              round
+	startPeriod        period
+	start              time.Time
+	cause              string
+	resynchronizations uint64
+}
+
+// recoveryTracker follows the recovery episodes of the agreement. Its zero value is ready to use.
+type recoveryTracker struct {
+	episode *recoveryEpisode
+}
+
+// periodEntered records the agreement entering the period target of round rnd from the period from, due to the
+// threshold source.
+func (rt *recoveryTracker) periodEntered(rnd round, from, target period, source thresholdEvent, now time.Time) {
+	periodGauge.Set(uint64(target))
+	threshold := "next"
+	switch source.t() {
+	case softThreshold:
+		threshold = "soft"
+	case certThreshold:
+		threshold = "cert"
+	}
+	periodsEnteredCounter.Inc(map[string]string{"threshold": threshold})
+
+	if rt.episode != nil && rt.episode.round == rnd {
+		return
+	}
+	cause := recoveryCauseFastForward
+	if source.t() == nextThreshold && target == from+1 {
+		cause = recoveryCauseNoCertification
+		if source.Proposal == bottom {
+			cause = recoveryCauseNoProposal
+		}
+	}
+	rt.episode = &recoveryEpisode{round: rnd, startPeriod: from, start: now, cause: cause}
+}
+
+// nextBundleFormed records a next-vote bundle formed for the current period of the agreement or a later one.
+func (rt *recoveryTracker) nextBundleFormed(e thresholdEvent) {
+	value := "proposal"
+	if e.Proposal == bottom {
+		value = "bottom"
+	}
+	nextBundlesCounter.Inc(map[string]string{"value": value})
+}
+
+// resynchronized records a resynchronization attempt in round rnd.
+func (rt *recoveryTracker) resynchronized(rnd round) {
+	resynchronizationsCounter.Inc(nil)
+	if rt.episode != nil && rt.episode.round == rnd {
+		rt.episode.resynchronizations++
+	}
+}
+
+// roundConcluded records the agreement concluding round rnd in period per, with a certificate or by catching up
+// as source is a roundInterruption, returning the summary of the recovery episode of the round if there was one.
+func (rt *recoveryTracker) roundConcluded(rnd round, per period, source eventType, now time.Time) (details telemetryspec.PartitionRecoveredEventDetails, recovered bool) {
+	periodGauge.Set(0)
+	episode := rt.episode
+	rt.episode = nil
+	if episode == nil || episode.round != rnd {
+		return
+	}
+
+	details = telemetryspec.PartitionRecoveredEventDetails{
+		Round:              uint64(rnd),
+		FinalPeriod:        uint64(per),
+		Resynchronizations: episode.resynchronizations,
+		Duration:           now.Sub(episode.start),
+		Cause:              episode.cause,
+		Resolution:         recoveryResolutionCertificate,
+	}
+	if per > episode.startPeriod {
+		details.PeriodsTraversed = uint64(per - episode.startPeriod)
+	}
+	if source == roundInterruption {
+		details.Resolution = recoveryResolutionCatchup
+	}
+	recoveryEpisodesCounter.Inc(map[string]string{"cause": episode.cause})
+	recoveryPeriodsCounter.AddUint64(details.PeriodsTraversed, nil)
+	return details, true
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRecoveryTrackerEpisode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var rt recoveryTracker
+	start := time.Unix(1000, 0)
+	proposal := proposalValue{BlockDigest: crypto.Digest{1}}
+	resyncs := resynchronizationsCounter.GetUint64Value()
+	bottomBundles := nextBundlesCounter.GetUint64ValueForLabels(map[string]string{"value": "bottom"})
+
+	// a round concluded in period 0 is not an episode
+	_, recovered := rt.roundConcluded(10, 0, certThreshold, start)
+	require.False(t, recovered)
+
+	next := thresholdEvent{T: nextThreshold, Round: 11, Period: 0, Proposal: bottom}
+	rt.nextBundleFormed(next)
+	rt.periodEntered(11, 0, 1, next, start)
+	rt.resynchronized(11)
+	next = thresholdEvent{T: nextThreshold, Round: 11, Period: 1, Proposal: proposal}
+	rt.periodEntered(11, 1, 2, next, start.Add(5*time.Second))
+	rt.resynchronized(11)
+
+	details, recovered := rt.roundConcluded(11, 2, certThreshold, start.Add(12*time.Second))
+	require.True(t, recovered)
+	require.Equal(t, uint64(11), details.Round)
+	require.Equal(t, uint64(2), details.FinalPeriod)
+	require.Equal(t, uint64(2), details.PeriodsTraversed)
+	require.Equal(t, uint64(2), details.Resynchronizations)
+	require.Equal(t, 12*time.Second, details.Duration)
+	require.Equal(t, recoveryCauseNoProposal, details.Cause)
+	require.Equal(t, recoveryResolutionCertificate, details.Resolution)
+	require.Equal(t, resyncs+2, resynchronizationsCounter.GetUint64Value())
+	require.Equal(t, bottomBundles+1, nextBundlesCounter.GetUint64ValueForLabels(map[string]string{"value": "bottom"}))
+
+	// the episode ends with its round
+	_, recovered = rt.roundConcluded(12, 0, certThreshold, start.Add(15*time.Second))
+	require.False(t, recovered)
+}
+
+func TestRecoveryTrackerCauses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	start := time.Unix(1000, 0)
+	proposal := proposalValue{BlockDigest: crypto.Digest{1}}
+	tests := []struct {
+		source     thresholdEvent
+		target     period
+		concluded  eventType
+		cause      string
+		resolution string
+	}{
+		{thresholdEvent{T: nextThreshold, Proposal: proposal}, 1, certThreshold, recoveryCauseNoCertification, recoveryResolutionCertificate},
+		{thresholdEvent{T: nextThreshold, Period: 2, Proposal: bottom}, 3, certThreshold, recoveryCauseFastForward, recoveryResolutionCertificate},
+		{thresholdEvent{T: softThreshold, Period: 1, Proposal: proposal}, 1, payloadVerified, recoveryCauseFastForward, recoveryResolutionCertificate},
+		{thresholdEvent{T: nextThreshold, Proposal: bottom}, 1, roundInterruption, recoveryCauseNoProposal, recoveryResolutionCatchup},
+	}
+	for _, test := range tests {
+		var rt recoveryTracker
+		rt.periodEntered(20, 0, test.target, test.source, start)
+		details, recovered := rt.roundConcluded(20, test.target, test.concluded, start.Add(time.Second))
+		require.True(t, recovered)
+		require.Equal(t, test.cause, details.Cause)
+		require.Equal(t, test.resolution, details.Resolution)
+		require.Equal(t, uint64(test.target), details.PeriodsTraversed)
+	}
+}
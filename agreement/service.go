@@ -54,9 +54,14 @@ type Service struct {
 
 	monitor *coserviceMonitor
 
+	// observers are notified of the progress of the service
+	observers *observerSet
+
 	persistRouter  rootRouter
 	persistStatus  player
 	persistActions []action
+	// persistCheckpoint is set when the persisted state above is a checkpoint of a new step to enqueue.
+	persistCheckpoint bool
 }
 
 // Parameters holds the parameters necessary to run the agreement protocol.
@@ -103,6 +108,8 @@ func MakeService(p Parameters) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	s.observers = makeObserverSet()
+	s.tracer.observers = s.observers
 
 	s.persistenceLoop = makeAsyncPersistenceLoop(s.log, s.Accessor, s.Ledger)
 
@@ -124,14 +131,16 @@ func (s *Service) Start() {
 	s.done = make(chan struct{})
 
 	s.voteVerifier = MakeAsyncVoteVerifier(s.BacklogPool)
+	s.voteVerifier.credentials = makeCredentialCache(int(s.Local.AgreementCredentialCacheSize))
 	s.demux = makeDemux(demuxParams{
-		net:               s.Network,
-		ledger:            s.Ledger,
-		validator:         s.BlockValidator,
-		voteVerifier:      s.voteVerifier,
-		processingMonitor: s.EventsProcessingMonitor,
-		log:               s.log,
-		monitor:           s.monitor,
+		net:                     s.Network,
+		ledger:                  s.Ledger,
+		validator:               s.BlockValidator,
+		voteVerifier:            s.voteVerifier,
+		processingMonitor:       s.EventsProcessingMonitor,
+		log:                     s.log,
+		monitor:                 s.monitor,
+		verificationParallelism: s.Local.AgreementVerificationParallelism,
 	})
 	s.loopback = makePseudonode(pseudonodeParams{
 		factory:      s.BlockFactory,
@@ -165,6 +174,7 @@ func (s *Service) Shutdown() {
 func (s *Service) demuxLoop(ctx context.Context, input chan<- externalEvent, output <-chan []action, ready <-chan externalDemuxSignals) {
 	for a := range output {
 		s.do(ctx, a)
+		s.checkpoint()
 		extSignals := <-ready
 		e, ok := s.demux.next(s, extSignals.Deadline, extSignals.FastRecoveryDeadline, extSignals.CurrentRound)
 		if !ok {
@@ -185,7 +195,7 @@ func (s *Service) demuxLoop(ctx context.Context, input chan<- externalEvent, out
 // 1. Execute all pending actions.
 // 2. Obtain an input event from the demultiplexer.
 // 3. Drive the state machine with this input to obtain a slice of pending actions.
-// 4. If necessary, persist state to disk.
+// 4. If necessary, persist state to disk, or checkpoint it when it moved to a new step.
 func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, ready chan<- externalDemuxSignals) {
 	// setup
 	var clock timers.Clock
@@ -223,6 +233,7 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 	} else {
 		s.Clock = clock
 	}
+	checkpointed := status
 
 	for {
 		output <- a
@@ -238,11 +249,31 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 			s.persistRouter = router
 			s.persistStatus = status
 			s.persistActions = a
+			checkpointed = status
+		} else if s.Local.EnableAgreementCheckpoints && (status.Round != checkpointed.Round || status.Period != checkpointed.Period || status.Step != checkpointed.Step) {
+			s.persistRouter = router
+			s.persistStatus = status
+			s.persistActions = a
+			s.persistCheckpoint = true
+			checkpointed = status
 		}
 	}
 	close(output)
 }
 
+// checkpoint enqueues the state checkpointed by the main loop, if any, to the persistence loop. Unlike
+// persistState, nothing waits for the checkpoint to be written, and it is dropped if the persistence loop is busy.
+func (s *Service) checkpoint() {
+	if !s.persistCheckpoint {
+		return
+	}
+	s.persistCheckpoint = false
+	raw := encode(s.Clock, s.persistRouter, s.persistStatus, s.persistActions, false)
+	if !s.persistenceLoop.TryEnqueue(s.Clock, s.persistStatus.Round, s.persistStatus.Period, s.persistStatus.Step, raw) {
+		s.log.Debugf("checkpoint at (%v, %v, %v) skipped: persistence loop busy", s.persistStatus.Round, s.persistStatus.Period, s.persistStatus.Step)
+	}
+}
+
 // persistState encodes the existing state of the agreement service and enqueue the
 // encoded state to the persistence loop so it will get stored asynchronously.
 // the done channel would get closed once operation complete successfully, or return an
@@ -92,7 +92,17 @@ func (c *testingClock) Encode() []byte {
 }
 
 func (c *testingClock) Decode([]byte) (timers.Clock, error) {
-	return makeTestingClock(nil), nil // TODO
+	// the testing clock isn't encoded: a restored service keeps the clock it was made with.
+	return c, nil
+}
+
+// waitingOn reports whether a timeout was requested at d since the clock was last zeroed.
+func (c *testingClock) waitingOn(d time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.TA[d]
+	return ok
 }
 
 func (c *testingClock) prepareToFire() {
@@ -2404,3 +2414,83 @@ func TestAgreementServiceStartDeadline(t *testing.T) {
 	require.Equal(t, testConsensusParams.AgreementFilterTimeoutPeriod0, demuxSignal.Deadline)
 	require.Equal(t, baseLedger.NextRound(), demuxSignal.CurrentRound)
 }
+
+func TestAgreementServiceCheckpointRestart(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	crashDBName := t.Name() + "_crash.db"
+	os.Remove(crashDBName)
+	accessor, err := db.MakeAccessor(crashDBName, false, true)
+	require.NoError(t, err)
+	defer os.Remove(crashDBName)
+	defer accessor.Close()
+
+	// without participation keys the node never votes, so the filter timeout moves it to the cert step with
+	// nothing to persist: only the checkpoint records the new step.
+	_, balances := createTestAccountsAndBalances(t, 1, (&[32]byte{})[:])
+	ledger := makeTestLedger(balances)
+	version, err := ledger.ConsensusVersion(ledger.NextRound())
+	require.NoError(t, err)
+	endpoint := makeTestingNetwork(1, 1000, testBlockValidator{}).testingNetworkEndpoint(nodeID(0))
+	log := logging.TestingLog(t)
+
+	makeCheckpointingService := func(clock *testingClock) *Service {
+		s, err := MakeService(Parameters{
+			Logger:         log,
+			Ledger:         ledger,
+			Network:        endpoint,
+			KeyManager:     makeRecordingKeyManager(nil),
+			BlockValidator: testBlockValidator{},
+			BlockFactory:   testBlockFactory{Owner: 0},
+			Clock:          clock,
+			Accessor:       accessor,
+			Local:          config.Local{EnableAgreementCheckpoints: true},
+			RandomSource:   &testingRand{},
+		})
+		require.NoError(t, err)
+		return s
+	}
+	crashState := func() (status player, ok bool) {
+		raw, err := restore(log, accessor)
+		if err != nil {
+			return player{}, false
+		}
+		_, _, status, _, err = decode(raw, makeTestingClock(nil), makeServiceLogger(log), false)
+		return status, err == nil
+	}
+	waitForStep := func(s step) {
+		require.Eventually(t, func() bool {
+			status, ok := crashState()
+			return ok && status.Step == s
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	clock := makeTestingClock(nil)
+	s := makeCheckpointingService(clock)
+	s.Start()
+	require.Eventually(t, func() bool { return clock.waitingOn(FilterTimeout(0, version)) }, 10*time.Second, 10*time.Millisecond)
+	clock.fire(FilterTimeout(0, version))
+	waitForStep(cert)
+	s.Shutdown()
+
+	status, ok := crashState()
+	require.True(t, ok)
+	require.Equal(t, ledger.NextRound(), status.Round)
+	require.Equal(t, period(0), status.Period)
+	require.Equal(t, cert, status.Step)
+
+	// the restarted service resumes in the cert step, waiting on its deadline rather than on the filter timeout.
+	clock = makeTestingClock(nil)
+	s = makeCheckpointingService(clock)
+	s.Start()
+	defer s.Shutdown()
+	require.Eventually(t, func() bool { return clock.waitingOn(deadlineTimeout) }, 10*time.Second, 10*time.Millisecond)
+	require.False(t, clock.waitingOn(FilterTimeout(0, version)))
+
+	clock.fire(deadlineTimeout)
+	waitForStep(next)
+	status, ok = crashState()
+	require.True(t, ok)
+	require.Equal(t, ledger.NextRound(), status.Round)
+	require.Equal(t, period(0), status.Period)
+}
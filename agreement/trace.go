@@ -70,6 +70,16 @@ type tracer struct {
 	verboseReports bool
 	// if timingReports is true, telemetrize more fine-grained agreement timing data
 	timingReports bool
+
+	// recovery follows the recovery episodes of the player
+	recovery recoveryTracker
+
+	// observers are notified of the rounds, proposals, votes and
+	// certificates. Optional.
+	observers *observerSet
+	// certifiedRound is the last round whose certificate the observers were
+	// notified of
+	certifiedRound round
 }
 
 const cadaverSizeMinimum = 100 * 1024 // 100 KB
@@ -128,6 +138,16 @@ func (t *tracer) timeRPlus1() *timingInfoGenerator {
 	return t.tRPlus1
 }
 
+// observeCertified notifies the observers of the certificate, once per round: the certificate of a round
+// certified before its block is received is learned again with the block.
+func (t *tracer) observeCertified(cert Certificate) {
+	if cert.Round <= t.certifiedRound {
+		return
+	}
+	t.certifiedRound = cert.Round
+	t.observers.certified(cert)
+}
+
 // setMetadata configures tracer to print round/period/step information.
 // optional.
 func (t *tracer) setMetadata(metadata tracerMetadata) {
@@ -245,6 +265,34 @@ func (t *tracer) logPeriodConcluded(p player, target period, prop proposalValue)
 	})
 }
 
+// logPeriodEntered records the player entering the period target from the threshold source, and starts the
+// recovery episode of the round if it is the first period past the one it started the round in.
+func (t *tracer) logPeriodEntered(p player, source thresholdEvent, target period) {
+	t.recovery.periodEntered(p.Round, p.Period, target, source, time.Now())
+}
+
+// logNextBundle records a next-vote bundle formed for the current period of the player or a later one.
+func (t *tracer) logNextBundle(e thresholdEvent) {
+	t.recovery.nextBundleFormed(e)
+}
+
+// logResynchronization records the player attempting to resynchronize from a partition.
+func (t *tracer) logResynchronization(p player) {
+	t.recovery.resynchronized(p.Round)
+}
+
+// logRoundConcluded records the player concluding its round from source, and sends the summary of the recovery
+// episode of the round if there was one.
+func (t *tracer) logRoundConcluded(p player, source event) {
+	details, recovered := t.recovery.roundConcluded(p.Round, p.Period, source.t(), time.Now())
+	if !recovered {
+		return
+	}
+	t.log.Infof("recovered in round %v after %v periods and %v resynchronizations (%s, resolved by %s)",
+		p.Round, details.PeriodsTraversed, details.Resynchronizations, details.Cause, details.Resolution)
+	t.log.EventWithDetails(telemetryspec.Agreement, telemetryspec.PartitionRecoveredEvent, details)
+}
+
 func (t *tracer) logRoundStart(p player, target round) {
 	// Log timing telemetry.
 	if t.tR != nil && t.timingReports {
@@ -347,11 +395,12 @@ func (t *tracer) logProposalManagerResult(p player, input messageEvent, output e
 		t.log.with(logEvent).Infof("pipelined block for (%v, %v): %v", pipelinedRound, pipelinedPeriod, output.(payloadProcessedEvent).Err)
 
 	case proposalAccepted:
+		uv := input.Input.UnauthenticatedVote
+		pev := output.(proposalAcceptedEvent)
+		t.observers.proposalObserved(uv.R.Sender, pev.Proposal, pev.Round, pev.Period)
 		if !t.log.IsLevelEnabled(logging.Info) {
 			return
 		}
-		uv := input.Input.UnauthenticatedVote
-		pev := output.(proposalAcceptedEvent)
 		logEvent := logspec.AgreementEvent{
 			Type:         logspec.ProposalAccepted,
 			Round:        uint64(p.Round),
@@ -462,6 +511,7 @@ func (t *tracer) logVoteAggregatorResult(input filterableMessageEvent, output ev
 }
 
 func (t *tracer) logVoteTrackerResult(p player, input voteAcceptedEvent, output thresholdEvent, weight uint64, inputTotal uint64, outputTotal uint64, proto config.ConsensusParams) {
+	t.observers.voteObserved(input.Vote, inputTotal)
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -508,17 +558,20 @@ func makeServiceLogger(log logging.Logger) serviceLogger {
 
 func (log serviceLogger) with(e logspec.AgreementEvent) serviceLogger {
 	fields := logging.Fields{
-		"Type":         e.Type.String(),
-		"Round":        e.Round,
-		"Period":       e.Period,
-		"Step":         e.Step,
-		"Hash":         e.Hash,
-		"Sender":       e.Sender,
-		"ObjectRound":  e.ObjectRound,
-		"ObjectPeriod": e.ObjectPeriod,
-		"ObjectStep":   e.ObjectStep,
-		"Weight":       e.Weight,
-		"WeightTotal":  e.WeightTotal,
+		// the fields of the structured logs, followed by the ones the existing log parsers expect
+		logging.EventField: e.Type.String(),
+		logging.RoundField: e.Round,
+		"Type":             e.Type.String(),
+		"Round":            e.Round,
+		"Period":           e.Period,
+		"Step":             e.Step,
+		"Hash":             e.Hash,
+		"Sender":           e.Sender,
+		"ObjectRound":      e.ObjectRound,
+		"ObjectPeriod":     e.ObjectPeriod,
+		"ObjectStep":       e.ObjectStep,
+		"Weight":           e.Weight,
+		"WeightTotal":      e.WeightTotal,
 	}
 	return serviceLogger{log.Logger.WithFields(fields)}
 }
@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"time"
+)
+
+// A verificationClass is the kind of an agreement message waiting to be verified, which sets the priority it
+// starts with.
+type verificationClass int
+
+const (
+	// verificationClassBundle is the class of the bundles, which are mostly useful to catch up with the network.
+	verificationClassBundle verificationClass = iota
+	// verificationClassFutureVote is the class of the votes of the rounds ahead of the ledger.
+	verificationClassFutureVote
+	// verificationClassVote is the class of the votes of the round being agreed on.
+	verificationClassVote
+
+	numVerificationClasses
+)
+
+const (
+	// verificationAgingStep is how long a task waits before being raised by a priority level, so that a steady
+	// stream of own-round votes does not starve the other tasks.
+	verificationAgingStep = 250 * time.Millisecond
+
+	// verificationTopPriority is the priority reached by the tasks past their deadline, above the one of the
+	// fresh own-round votes.
+	verificationTopPriority = int(numVerificationClasses)
+)
+
+// A verificationTask is a vote or a bundle waiting in the verificationQueue.
+type verificationTask struct {
+	vote   cryptoVoteRequest
+	bundle cryptoBundleRequest
+	class  verificationClass
+
+	enqueued time.Time
+	// deadline is the time the task reaches verificationTopPriority.
+	deadline time.Time
+}
+
+// priority returns the priority of the task at time now, raised by a level for every verificationAgingStep waited.
+func (t *verificationTask) priority(now time.Time) int {
+	p := int(t.class) + int(now.Sub(t.enqueued)/verificationAgingStep)
+	if p > verificationTopPriority {
+		p = verificationTopPriority
+	}
+	return p
+}
+
+func (t *verificationTask) setClass(class verificationClass) {
+	t.class = class
+	t.deadline = t.enqueued.Add(time.Duration(verificationTopPriority-int(class)) * verificationAgingStep)
+}
+
+// A verificationQueue orders the votes and bundles waiting to be verified, by decreasing priority and then by
+// earliest deadline.
+//
+// The tasks of a class all age at the same pace, so each class is kept in arrival order and only its oldest task
+// competes with the other classes.
+//
+// A verificationQueue is not safe for concurrent use.
+type verificationQueue struct {
+	classes [numVerificationClasses][]verificationTask
+
+	// nextRound is the round of the own-round votes.
+	nextRound round
+}
+
+func (q *verificationQueue) pushVote(req cryptoVoteRequest, now time.Time) {
+	class := verificationClassVote
+	if req.Round > q.nextRound {
+		class = verificationClassFutureVote
+	}
+	q.push(verificationTask{vote: req}, class, now)
+}
+
+func (q *verificationQueue) pushBundle(req cryptoBundleRequest, now time.Time) {
+	q.push(verificationTask{bundle: req}, verificationClassBundle, now)
+}
+
+func (q *verificationQueue) push(t verificationTask, class verificationClass, now time.Time) {
+	t.enqueued = now
+	t.setClass(class)
+	q.classes[class] = append(q.classes[class], t)
+}
+
+// advance moves the queue to the given round, turning the queued votes of the rounds ahead into own-round votes
+// once the ledger reaches them.
+func (q *verificationQueue) advance(nextRound round) {
+	if nextRound <= q.nextRound {
+		return
+	}
+	q.nextRound = nextRound
+
+	future := q.classes[verificationClassFutureVote]
+	kept := future[:0]
+	var promoted []verificationTask
+	for _, t := range future {
+		if t.vote.Round > nextRound {
+			kept = append(kept, t)
+			continue
+		}
+		t.setClass(verificationClassVote)
+		promoted = append(promoted, t)
+	}
+	if len(promoted) == 0 {
+		return
+	}
+	for i := len(kept); i < len(future); i++ {
+		future[i] = verificationTask{}
+	}
+	q.classes[verificationClassFutureVote] = kept
+
+	// merge the promoted votes with the own-round ones, keeping the arrival order
+	own := q.classes[verificationClassVote]
+	merged := make([]verificationTask, 0, len(own)+len(promoted))
+	for len(own) > 0 && len(promoted) > 0 {
+		if promoted[0].enqueued.Before(own[0].enqueued) {
+			merged = append(merged, promoted[0])
+			promoted = promoted[1:]
+		} else {
+			merged = append(merged, own[0])
+			own = own[1:]
+		}
+	}
+	merged = append(merged, own...)
+	q.classes[verificationClassVote] = append(merged, promoted...)
+}
+
+// next returns the class of the task to verify next, or false when the queue is empty.
+func (q *verificationQueue) next(now time.Time) (verificationClass, bool) {
+	best := verificationClass(-1)
+	bestPriority := -1
+	for class := range q.classes {
+		if len(q.classes[class]) == 0 {
+			continue
+		}
+		head := &q.classes[class][0]
+		p := head.priority(now)
+		if p > bestPriority || (p == bestPriority && head.deadline.Before(q.classes[best][0].deadline)) {
+			best = verificationClass(class)
+			bestPriority = p
+		}
+	}
+	return best, bestPriority >= 0
+}
+
+func (q *verificationQueue) take(class verificationClass) verificationTask {
+	t := q.classes[class][0]
+	q.classes[class][0] = verificationTask{}
+	q.classes[class] = q.classes[class][1:]
+	return t
+}
+
+// pop removes the task to verify next, or returns false when the queue is empty.
+func (q *verificationQueue) pop(now time.Time) (verificationTask, bool) {
+	class, ok := q.next(now)
+	if !ok {
+		return verificationTask{}, false
+	}
+	return q.take(class), true
+}
+
+// popVotes removes up to max votes, for as long as votes are the tasks to verify next.
+func (q *verificationQueue) popVotes(now time.Time, max int) []cryptoVoteRequest {
+	var votes []cryptoVoteRequest
+	for len(votes) < max {
+		class, ok := q.next(now)
+		if !ok || class == verificationClassBundle {
+			break
+		}
+		votes = append(votes, q.take(class).vote)
+	}
+	return votes
+}
+
+// votes returns the number of queued votes.
+func (q *verificationQueue) votes() int {
+	return len(q.classes[verificationClassVote]) + len(q.classes[verificationClassFutureVote])
+}
+
+// bundles returns the number of queued bundles.
+func (q *verificationQueue) bundles() int {
+	return len(q.classes[verificationClassBundle])
+}
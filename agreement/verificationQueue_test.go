@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func popTaskIndexes(q *verificationQueue, now time.Time) (indexes []uint64) {
+	for {
+		t, ok := q.pop(now)
+		if !ok {
+			return indexes
+		}
+		if t.class == verificationClassBundle {
+			indexes = append(indexes, t.bundle.TaskIndex)
+		} else {
+			indexes = append(indexes, t.vote.TaskIndex)
+		}
+	}
+}
+
+func TestVerificationQueuePriorities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Now()
+	q := verificationQueue{nextRound: 10}
+	q.pushBundle(cryptoBundleRequest{TaskIndex: 1, Round: 10}, now)
+	q.pushVote(cryptoVoteRequest{TaskIndex: 2, Round: 11}, now)
+	q.pushVote(cryptoVoteRequest{TaskIndex: 3, Round: 10}, now)
+	q.pushVote(cryptoVoteRequest{TaskIndex: 4, Round: 12}, now)
+	q.pushVote(cryptoVoteRequest{TaskIndex: 5, Round: 10}, now)
+	require.Equal(t, 4, q.votes())
+	require.Equal(t, 1, q.bundles())
+
+	// own-round votes first, then future-round votes, then bundles
+	require.Equal(t, []uint64{3, 5, 2, 4, 1}, popTaskIndexes(&q, now))
+	require.Zero(t, q.votes()+q.bundles())
+}
+
+func TestVerificationQueueAging(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	start := time.Now()
+	q := verificationQueue{nextRound: 10}
+	q.pushBundle(cryptoBundleRequest{TaskIndex: 1}, start)
+	q.pushVote(cryptoVoteRequest{TaskIndex: 2, Round: 11}, start)
+
+	// a bundle waiting for three aging steps passes the fresh own-round votes
+	now := start.Add(3 * verificationAgingStep)
+	q.pushVote(cryptoVoteRequest{TaskIndex: 3, Round: 10}, now)
+	require.Equal(t, verificationTopPriority, q.classes[verificationClassBundle][0].priority(now))
+
+	// the future-round vote reached the top priority first, by its deadline
+	require.Equal(t, []uint64{2, 1, 3}, popTaskIndexes(&q, now))
+}
+
+func TestVerificationQueueAdvance(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	start := time.Now()
+	q := verificationQueue{nextRound: 10}
+	q.pushVote(cryptoVoteRequest{TaskIndex: 1, Round: 11}, start)
+	q.pushVote(cryptoVoteRequest{TaskIndex: 2, Round: 10}, start.Add(time.Millisecond))
+	q.pushVote(cryptoVoteRequest{TaskIndex: 3, Round: 12}, start.Add(2*time.Millisecond))
+	q.pushVote(cryptoVoteRequest{TaskIndex: 4, Round: 11}, start.Add(3*time.Millisecond))
+	q.pushBundle(cryptoBundleRequest{TaskIndex: 5}, start.Add(4*time.Millisecond))
+
+	// the votes of round 11 are now own-round votes, kept in their arrival order
+	q.advance(11)
+	require.Len(t, q.classes[verificationClassVote], 3)
+	require.Len(t, q.classes[verificationClassFutureVote], 1)
+
+	now := start.Add(5 * time.Millisecond)
+	votes := q.popVotes(now, voteVerificationBatchSize)
+	require.Len(t, votes, 4)
+	for i, index := range []uint64{1, 2, 4, 3} {
+		require.Equal(t, index, votes[i].TaskIndex)
+	}
+	require.Empty(t, q.popVotes(now, voteVerificationBatchSize))
+	require.Equal(t, 1, q.bundles())
+
+	// going back does not demote the votes
+	q.advance(10)
+	require.Equal(t, round(11), q.nextRound)
+}
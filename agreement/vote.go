@@ -19,6 +19,7 @@ package agreement
 import (
 	"fmt"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
@@ -90,55 +91,124 @@ type (
 
 // verify verifies that a vote that was received from the network is valid.
 func (uv unauthenticatedVote) verify(l LedgerReader) (vote, error) {
+	votes, errs := verifyVotes(l, []unauthenticatedVote{uv}, nil)
+	return votes[0], errs[0]
+}
+
+// pendingVote is a vote whose signature and credential were enqueued into a batch, waiting for the batch to be verified.
+//
+//msgp:ignore pendingVote
+type pendingVote struct {
+	uv    unauthenticatedVote
+	proto config.ConsensusParams
+	m     committee.Membership
+
+	// sigItem is the batch item of the signature of the vote, and is followed by the item of its credential
+	// unless the credential was found verified in the credential cache
+	sigItem int
+	vrfOut  int
+
+	credKey credentialCacheKey
+	cached  bool
+	cred    committee.Credential
+}
+
+// verifyVotes verifies that votes received from the network are valid, verifying their signatures and
+// credentials together with batched operations. It returns an error for each invalid vote, and the
+// other votes are unaffected by them. The credentials found in credentials, which may be nil, are not verified
+// again, and the credentials verified are added to it.
+func verifyVotes(l LedgerReader, uvs []unauthenticatedVote, credentials *credentialCache) ([]vote, []error) {
+	votes := make([]vote, len(uvs))
+	errs := make([]error, len(uvs))
+	pending := make([]pendingVote, len(uvs))
+
+	bv := crypto.MakeBatchVerification(2 * len(uvs))
+	for i := range uvs {
+		pending[i], errs[i] = uvs[i].batchPrep(l, bv, credentials)
+	}
+	failed, _ := bv.Verify()
+	for i := range pending {
+		if errs[i] == nil {
+			votes[i], errs[i] = pending[i].finish(bv, failed, credentials)
+		}
+	}
+	return votes, errs
+}
+
+// batchPrep checks the vote against the membership parameters of its sender, and enqueues its signature and credential into bv,
+// unless its credential is found in credentials.
+func (uv unauthenticatedVote) batchPrep(l LedgerReader, bv *crypto.BatchVerification, credentials *credentialCache) (pendingVote, error) {
 	rv := uv.R
 	m, err := membership(l, rv.Sender, rv.Round, rv.Period, rv.Step)
 	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
+		return pendingVote{}, fmt.Errorf("unauthenticatedVote.verify: could not get membership parameters: %w", err)
 	}
 
 	switch rv.Step {
 	case propose:
 		if rv.Period == rv.Proposal.OriginalPeriod && rv.Sender != rv.Proposal.OriginalProposer {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote sender mismatches with proposal-value: %v != %v", rv.Sender, rv.Proposal.OriginalProposer)
+			return pendingVote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote sender mismatches with proposal-value: %v != %v", rv.Sender, rv.Proposal.OriginalProposer)
 		}
 		// The following check could apply to all steps, but it's sufficient to only check in the propose step.
 		if rv.Proposal.OriginalPeriod > rv.Period {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote in period %d claims to repropose block from future period %d", rv.Period, rv.Proposal.OriginalPeriod)
+			return pendingVote{}, fmt.Errorf("unauthenticatedVote.verify: proposal-vote in period %d claims to repropose block from future period %d", rv.Period, rv.Proposal.OriginalPeriod)
 		}
 		fallthrough
 	case soft:
 		fallthrough
 	case cert:
 		if rv.Proposal == bottom {
-			return vote{}, fmt.Errorf("unauthenticatedVote.verify: votes from step %d cannot validate bottom", rv.Step)
+			return pendingVote{}, fmt.Errorf("unauthenticatedVote.verify: votes from step %d cannot validate bottom", rv.Step)
 		}
 	}
 
 	proto, err := l.ConsensusParams(ParamsRound(rv.Round))
 	if err != nil {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
+		return pendingVote{}, fmt.Errorf("unauthenticatedVote.verify: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
 	}
 
 	if rv.Round < m.Record.VoteFirstValid {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d before VoteFirstValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteFirstValid, uv)
+		return pendingVote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d before VoteFirstValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteFirstValid, uv)
 	}
 
 	if m.Record.VoteLastValid != 0 && rv.Round > m.Record.VoteLastValid {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d after VoteLastValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteLastValid, uv)
+		return pendingVote{}, fmt.Errorf("unauthenticatedVote.verify: vote by %v in round %d after VoteLastValid %d: %+v", rv.Sender, rv.Round, m.Record.VoteLastValid, uv)
 	}
 
 	ephID := basics.OneTimeIDForRound(rv.Round, m.Record.KeyDilution(proto))
-	voteID := m.Record.VoteID
-	if !voteID.Verify(ephID, rv, uv.Sig) {
-		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not verify FS signature on vote by %v given %v: %+v", rv.Sender, voteID, uv)
+	p := pendingVote{uv: uv, proto: proto, m: m}
+	p.sigItem = bv.StartItem()
+	bv.EnqueueOneTimeSignature(m.Record.VoteID, ephID, rv, uv.Sig)
+	p.credKey = credentialCacheKey{sender: rv.Sender, round: rv.Round, period: rv.Period, step: rv.Step, seed: m.Selector.(selector).Seed}
+	if p.cred, p.cached = credentials.get(p.credKey, uv.Cred); p.cached {
+		return p, nil
 	}
+	bv.StartItem()
+	p.vrfOut = uv.Cred.BatchPrep(m, bv)
+	return p, nil
+}
 
-	cred, err := uv.Cred.Verify(proto, m)
+// finish completes the verification of the vote once bv was verified, given the items that failed, adding its
+// credential to credentials once verified.
+func (p pendingVote) finish(bv *crypto.BatchVerification, failed []bool, credentials *credentialCache) (vote, error) {
+	uv := p.uv
+	if failed[p.sigItem] {
+		return vote{}, fmt.Errorf("unauthenticatedVote.verify: could not verify FS signature on vote by %v given %v: %+v", uv.R.Sender, p.m.Record.VoteID, uv)
+	}
+	if p.cached {
+		return vote{R: uv.R, Cred: p.cred, Sig: uv.Sig}, nil
+	}
+	if failed[p.sigItem+1] {
+		return vote{}, fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", uv.Cred.ProofError(p.m))
+	}
+
+	cred, err := uv.Cred.VerifyOutput(p.proto, p.m, bv.VrfOutput(p.vrfOut))
 	if err != nil {
 		return vote{}, fmt.Errorf("unauthenticatedVote.verify: got a vote, but sender was not selected: %v", err)
 	}
+	credentials.put(p.credKey, cred)
 
-	return vote{R: rv, Cred: cred, Sig: uv.Sig}, nil
+	return vote{R: uv.R, Cred: cred, Sig: uv.Sig}, nil
 }
 
 // makeVote creates a new unauthenticated vote from its constituent components.
@@ -196,15 +266,14 @@ func (pair unauthenticatedEquivocationVote) verify(l LedgerReader) (equivocation
 	uv0 := unauthenticatedVote{R: rv0, Cred: pair.Cred, Sig: pair.Sigs[0]}
 	uv1 := unauthenticatedVote{R: rv1, Cred: pair.Cred, Sig: pair.Sigs[1]}
 
-	v0, err := uv0.verify(l)
-	if err != nil {
-		return equivocationVote{}, fmt.Errorf("unauthenticatedEquivocationVote.verify: failed to verify pair 0: %w", err)
+	votes, errs := verifyVotes(l, []unauthenticatedVote{uv0, uv1}, nil)
+	if errs[0] != nil {
+		return equivocationVote{}, fmt.Errorf("unauthenticatedEquivocationVote.verify: failed to verify pair 0: %w", errs[0])
 	}
-
-	_, err = uv1.verify(l)
-	if err != nil {
-		return equivocationVote{}, fmt.Errorf("unauthenticatedEquivocationVote.verify: failed to verify pair 1: %w", err)
+	if errs[1] != nil {
+		return equivocationVote{}, fmt.Errorf("unauthenticatedEquivocationVote.verify: failed to verify pair 1: %w", errs[1])
 	}
+	v0 := votes[0]
 
 	return equivocationVote{
 		Sender:    pair.Sender,
@@ -117,6 +117,52 @@ func TestVoteValidation(t *testing.T) {
 	require.True(t, processedVote, "No votes were processed")
 }
 
+func TestVerifyVotes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	round := ledger.NextRound()
+
+	// collect the votes selected to participate, and break some of them
+	var uvs []unauthenticatedVote
+	var expected []vote
+	var broken []bool
+	for i, address := range addresses {
+		var proposal proposalValue
+		proposal.BlockDigest = randomBlockHash()
+		rv := rawVote{Sender: address, Round: round, Period: 0, Step: soft, Proposal: proposal}
+		uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+
+		v, err := uv.verify(ledger)
+		if err != nil {
+			continue
+		}
+		switch len(uvs) % 5 {
+		case 1:
+			uv.Sig.Sig[0]++
+		case 3:
+			uv.Cred.Proof[0]++
+		}
+		broken = append(broken, len(uvs)%5 == 1 || len(uvs)%5 == 3)
+		uvs = append(uvs, uv)
+		expected = append(expected, v)
+	}
+	require.Greater(t, len(uvs), 5)
+
+	votes, errs := verifyVotes(ledger, uvs, nil)
+	for i := range uvs {
+		if broken[i] {
+			require.Error(t, errs[i], "vote %d", i)
+			_, err := uvs[i].verify(ledger)
+			require.Equal(t, err.Error(), errs[i].Error())
+			continue
+		}
+		require.NoError(t, errs[i], "vote %d", i)
+		require.Equal(t, expected[i], votes[i])
+	}
+}
+
 func TestVoteReproposalValidation(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -0,0 +1,364 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/util/execpool"
+)
+
+// BlockArchiveManifestFilename is the name of the manifest file of a block archive.
+const BlockArchiveManifestFilename = "manifest.json"
+
+const blockArchiveFormat = "algorand-block-archive"
+const blockArchiveVersion = 1
+const blockArchiveEncoding = "msgpack stream of {block, cert} objects, as served by GET /v2/blocks/{round}?format=msgpack"
+
+// DefaultBlockArchiveChunkRounds is the default number of blocks held by each chunk of a block archive.
+const DefaultBlockArchiveChunkRounds = 1000
+
+// BlockArchiveManifest describes a block archive: a directory of chunk files, each holding the blocks and
+// certificates of consecutive rounds, along with this manifest. The chunks are checksummed with SHA-256, and
+// chained: the chain hash of a chunk is the SHA-256 of the chain hash of the previous chunk followed by the
+// checksum of the chunk, starting from the genesis hash, so that the archive cannot be reordered or truncated
+// without the ChainHash of the manifest changing.
+type BlockArchiveManifest struct {
+	Format      string
+	Version     int
+	Encoding    string
+	GenesisID   string
+	GenesisHash string // base32, as the catchpoint labels
+	FirstRound  basics.Round
+	LastRound   basics.Round
+	ChainHash   string // hex, the chain hash of the last chunk
+	Chunks      []BlockArchiveChunk
+}
+
+// BlockArchiveChunk describes a chunk file of a block archive.
+type BlockArchiveChunk struct {
+	File       string
+	FirstRound basics.Round
+	LastRound  basics.Round
+	Size       int64
+	SHA256     string // hex
+	ChainHash  string // hex
+}
+
+func blockArchiveChainHash(prev []byte, checksum []byte) []byte {
+	h := sha256.New()
+	h.Write(prev)
+	h.Write(checksum)
+	return h.Sum(nil)
+}
+
+// BlockArchiveWriter writes the blocks of consecutive rounds into a block archive.
+type BlockArchiveWriter struct {
+	dir         string
+	chunkRounds uint64
+	manifest    BlockArchiveManifest
+	chainHash   []byte
+	nextRound   basics.Round
+
+	file   *os.File
+	hasher hash.Hash
+	chunk  BlockArchiveChunk
+}
+
+// CreateBlockArchive creates a block archive in dir, which must not hold one already, for the blocks of the
+// given network, writing at most chunkRounds blocks into each chunk file.
+func CreateBlockArchive(dir string, genesisID string, genesisHash crypto.Digest, chunkRounds uint64) (*BlockArchiveWriter, error) {
+	if chunkRounds == 0 {
+		chunkRounds = DefaultBlockArchiveChunkRounds
+	}
+	if _, err := os.Stat(filepath.Join(dir, BlockArchiveManifestFilename)); !os.IsNotExist(err) {
+		return nil, fmt.Errorf("a block archive already exists in %s", dir)
+	}
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockArchiveWriter{
+		dir:         dir,
+		chunkRounds: chunkRounds,
+		manifest: BlockArchiveManifest{
+			Format:      blockArchiveFormat,
+			Version:     blockArchiveVersion,
+			Encoding:    blockArchiveEncoding,
+			GenesisID:   genesisID,
+			GenesisHash: genesisHash.String(),
+		},
+		chainHash: genesisHash[:],
+	}, nil
+}
+
+// Add appends an encoded block and certificate to the archive. The blocks must be added in consecutive rounds.
+func (w *BlockArchiveWriter) Add(encodedBlockCert []byte) error {
+	var blockCert rpcs.EncodedBlockCert
+	err := protocol.Decode(encodedBlockCert, &blockCert)
+	if err != nil {
+		return fmt.Errorf("unable to decode the block: %w", err)
+	}
+	round := blockCert.Block.Round()
+	if blockCert.Block.GenesisHash().String() != w.manifest.GenesisHash {
+		return fmt.Errorf("block %d is not a block of the genesis %s", round, w.manifest.GenesisHash)
+	}
+	if len(w.manifest.Chunks) > 0 || w.file != nil {
+		if round != w.nextRound {
+			return fmt.Errorf("block %d added to the archive, expected block %d", round, w.nextRound)
+		}
+	} else {
+		w.manifest.FirstRound = round
+	}
+
+	if w.file == nil {
+		name := fmt.Sprintf("blocks-%012d.msgp", round)
+		w.file, err = os.OpenFile(filepath.Join(w.dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return err
+		}
+		w.hasher = sha256.New()
+		w.chunk = BlockArchiveChunk{File: name, FirstRound: round}
+	}
+	_, err = io.MultiWriter(w.file, w.hasher).Write(encodedBlockCert)
+	if err != nil {
+		return err
+	}
+	w.chunk.LastRound = round
+	w.chunk.Size += int64(len(encodedBlockCert))
+	w.nextRound = round + 1
+	if uint64(w.chunk.LastRound-w.chunk.FirstRound)+1 >= w.chunkRounds {
+		return w.closeChunk()
+	}
+	return nil
+}
+
+func (w *BlockArchiveWriter) closeChunk() error {
+	err := w.file.Close()
+	w.file = nil
+	if err != nil {
+		return err
+	}
+	checksum := w.hasher.Sum(nil)
+	w.chainHash = blockArchiveChainHash(w.chainHash, checksum)
+	w.chunk.SHA256 = hex.EncodeToString(checksum)
+	w.chunk.ChainHash = hex.EncodeToString(w.chainHash)
+	w.manifest.Chunks = append(w.manifest.Chunks, w.chunk)
+	w.manifest.LastRound = w.chunk.LastRound
+	w.manifest.ChainHash = w.chunk.ChainHash
+	return nil
+}
+
+// Close completes the archive, writing its manifest, and returns it.
+func (w *BlockArchiveWriter) Close() (BlockArchiveManifest, error) {
+	if w.file != nil {
+		err := w.closeChunk()
+		if err != nil {
+			return BlockArchiveManifest{}, err
+		}
+	}
+	if len(w.manifest.Chunks) == 0 {
+		return BlockArchiveManifest{}, errors.New("the block archive is empty")
+	}
+	encoded, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return BlockArchiveManifest{}, err
+	}
+	err = os.WriteFile(filepath.Join(w.dir, BlockArchiveManifestFilename), encoded, 0600)
+	return w.manifest, err
+}
+
+// LoadBlockArchiveManifest loads the manifest of the block archive in dir, checking that its chunks cover
+// consecutive rounds and that their chain hashes are consistent.
+func LoadBlockArchiveManifest(dir string) (BlockArchiveManifest, error) {
+	var m BlockArchiveManifest
+	encoded, err := os.ReadFile(filepath.Join(dir, BlockArchiveManifestFilename))
+	if err != nil {
+		return m, err
+	}
+	err = json.Unmarshal(encoded, &m)
+	if err != nil {
+		return m, fmt.Errorf("unable to decode the block archive manifest: %w", err)
+	}
+	if m.Format != blockArchiveFormat || m.Version != blockArchiveVersion {
+		return m, fmt.Errorf("unsupported block archive format %s version %d", m.Format, m.Version)
+	}
+	genesisHash, err := crypto.DigestFromString(m.GenesisHash)
+	if err != nil {
+		return m, fmt.Errorf("invalid genesis hash %s in the block archive manifest: %w", m.GenesisHash, err)
+	}
+	if len(m.Chunks) == 0 {
+		return m, errors.New("the block archive has no chunks")
+	}
+	chainHash := genesisHash[:]
+	next := m.FirstRound
+	for _, chunk := range m.Chunks {
+		if chunk.FirstRound != next || chunk.LastRound < chunk.FirstRound {
+			return m, fmt.Errorf("chunk %s of the block archive covers rounds %d to %d, expected from round %d", chunk.File, chunk.FirstRound, chunk.LastRound, next)
+		}
+		if filepath.Base(chunk.File) != chunk.File {
+			return m, fmt.Errorf("chunk %s of the block archive is not in the archive directory", chunk.File)
+		}
+		checksum, err := hex.DecodeString(chunk.SHA256)
+		if err != nil {
+			return m, fmt.Errorf("invalid checksum of the chunk %s: %w", chunk.File, err)
+		}
+		chainHash = blockArchiveChainHash(chainHash, checksum)
+		if hex.EncodeToString(chainHash) != chunk.ChainHash {
+			return m, fmt.Errorf("chain hash mismatch at the chunk %s of the block archive", chunk.File)
+		}
+		next = chunk.LastRound + 1
+	}
+	if m.LastRound != next-1 || m.ChainHash != m.Chunks[len(m.Chunks)-1].ChainHash {
+		return m, errors.New("the block archive manifest does not match its last chunk")
+	}
+	return m, nil
+}
+
+// readBlockArchiveChunk reads a chunk of the block archive in dir, checking its size and checksum.
+func readBlockArchiveChunk(dir string, chunk BlockArchiveChunk) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(dir, chunk.File))
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(data)
+	if int64(len(data)) != chunk.Size || hex.EncodeToString(checksum[:]) != chunk.SHA256 {
+		return nil, fmt.Errorf("the chunk %s of the block archive is corrupted", chunk.File)
+	}
+	return data, nil
+}
+
+// importBlockArchive imports the block archive of CatchupBlockArchiveDir into the ledger, before the blocks
+// are fetched from the network.
+func (s *Service) importBlockArchive() {
+	atomic.StoreInt64(&s.syncStartNS, time.Now().UnixNano())
+	defer atomic.StoreInt64(&s.syncStartNS, 0)
+
+	start := s.ledger.LastRound()
+	last, err := importBlockArchive(s.ctx, s.log, s.cfg, s.ledger, s.auth, s.blockValidationPool, s.cfg.CatchupBlockArchiveDir)
+	if err != nil {
+		s.log.Warnf("catchup: stopped importing the block archive %s at round %d: %v", s.cfg.CatchupBlockArchiveDir, last, err)
+		return
+	}
+	s.log.Infof("catchup: imported the block archive %s from round %d to round %d", s.cfg.CatchupBlockArchiveDir, start, last)
+}
+
+// importBlockArchive adds the blocks of the block archive in dir which follow the last round of the ledger to
+// it. Each block is authenticated against its certificate, and validated as the blocks fetched from the
+// network are. It returns the last round of the ledger once done.
+func importBlockArchive(ctx context.Context, log logging.Logger, cfg config.Local, ledger Ledger, auth BlockAuthenticator, pool execpool.BacklogPool, dir string) (basics.Round, error) {
+	m, err := LoadBlockArchiveManifest(dir)
+	if err != nil {
+		return 0, err
+	}
+	last := ledger.LastRound()
+	lastBlock, err := ledger.Block(last)
+	if err != nil {
+		return last, err
+	}
+	if lastBlock.GenesisHash().String() != m.GenesisHash {
+		return last, fmt.Errorf("the block archive holds the blocks of the genesis %s, not %s", m.GenesisHash, lastBlock.GenesisHash().String())
+	}
+	if m.LastRound <= last {
+		return last, nil
+	}
+	if m.FirstRound > last+1 {
+		return last, fmt.Errorf("the block archive starts at round %d, after the next round %d of the ledger", m.FirstRound, last+1)
+	}
+
+	log.Infof("importing the blocks %d to %d of the block archive %s", last+1, m.LastRound, dir)
+	for _, chunk := range m.Chunks {
+		if chunk.LastRound <= last {
+			continue
+		}
+		data, err := readBlockArchiveChunk(dir, chunk)
+		if err != nil {
+			return last, err
+		}
+		dec := protocol.NewMsgpDecoderBytes(data)
+		for round := chunk.FirstRound; round <= chunk.LastRound; round++ {
+			var blockCert rpcs.EncodedBlockCert
+			err = dec.Decode(&blockCert)
+			if err != nil {
+				return last, fmt.Errorf("unable to decode block %d from the chunk %s: %w", round, chunk.File, err)
+			}
+			if blockCert.Block.Round() != round {
+				return last, fmt.Errorf("the chunk %s holds block %d in place of block %d", chunk.File, blockCert.Block.Round(), round)
+			}
+			if round <= last {
+				continue
+			}
+			err = importArchivedBlock(ctx, cfg, ledger, auth, pool, &blockCert)
+			if err != nil {
+				return last, fmt.Errorf("unable to import block %d: %w", round, err)
+			}
+			last = round
+		}
+	}
+	return last, nil
+}
+
+func importArchivedBlock(ctx context.Context, cfg config.Local, ledger Ledger, auth BlockAuthenticator, pool execpool.BacklogPool, blockCert *rpcs.EncodedBlockCert) error {
+	block := &blockCert.Block
+	round := block.Round()
+	if !block.ContentsMatchHeader() {
+		return errors.New("block contents do not match its header")
+	}
+
+	// the certificates of the archived blocks are always checked, as the archive could come from anywhere.
+	proto, err := ledger.ConsensusParams(round.SubSaturate(1))
+	if err != nil {
+		return err
+	}
+	select {
+	case <-ledger.Wait(round.SubSaturate(basics.Round(proto.MaxBalLookback))):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	err = auth.Authenticate(block, &blockCert.Certificate)
+	if err != nil {
+		return fmt.Errorf("certificate did not authenticate the block: %w", err)
+	}
+
+	if cfg.CatchupVerifyTransactionSignatures() || cfg.CatchupVerifyApplyData() {
+		var vb *ledgercore.ValidatedBlock
+		vb, err = ledger.Validate(ctx, *block, pool)
+		if err != nil {
+			return err
+		}
+		return ledger.AddValidatedBlock(*vb, blockCert.Certificate)
+	}
+	return ledger.AddBlock(*block, blockCert.Certificate)
+}
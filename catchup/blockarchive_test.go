@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeArchiveTestBlocks(t *testing.T, genesisHash crypto.Digest, count int) []bookkeeping.Block {
+	var blk bookkeeping.Block
+	blk.CurrentProtocol = protocol.ConsensusCurrentVersion
+	blk.GenesisHash = genesisHash
+	blocks := []bookkeeping.Block{blk}
+	for i := 1; i < count; i++ {
+		blk = bookkeeping.MakeBlock(blk.BlockHeader)
+		var err error
+		blk.TxnCommitments, err = blk.PaysetCommit()
+		require.NoError(t, err)
+		blocks = append(blocks, blk)
+	}
+	return blocks
+}
+
+func writeTestBlockArchive(t *testing.T, dir string, genesisHash crypto.Digest, blocks []bookkeeping.Block, chunkRounds uint64) BlockArchiveManifest {
+	writer, err := CreateBlockArchive(dir, "test-v1", genesisHash, chunkRounds)
+	require.NoError(t, err)
+	for _, blk := range blocks {
+		encoded := protocol.Encode(&rpcs.EncodedBlockCert{Block: blk, Certificate: agreement.Certificate{Round: blk.Round()}})
+		require.NoError(t, writer.Add(encoded))
+	}
+	manifest, err := writer.Close()
+	require.NoError(t, err)
+	return manifest
+}
+
+func TestBlockArchiveWriteLoad(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisHash := crypto.Hash([]byte("genesis"))
+	blocks := makeArchiveTestBlocks(t, genesisHash, 26)
+	dir := t.TempDir()
+	manifest := writeTestBlockArchive(t, dir, genesisHash, blocks[1:], 10)
+	require.Equal(t, basics.Round(1), manifest.FirstRound)
+	require.Equal(t, basics.Round(25), manifest.LastRound)
+	require.Len(t, manifest.Chunks, 3)
+	require.Equal(t, basics.Round(20), manifest.Chunks[1].LastRound)
+	require.Equal(t, basics.Round(25), manifest.Chunks[2].LastRound)
+
+	loaded, err := LoadBlockArchiveManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, loaded)
+	for _, chunk := range loaded.Chunks {
+		_, err = readBlockArchiveChunk(dir, chunk)
+		require.NoError(t, err)
+	}
+
+	// the blocks must be added in consecutive rounds, and a second archive cannot be written over the first one
+	_, err = CreateBlockArchive(dir, "test-v1", genesisHash, 10)
+	require.ErrorContains(t, err, "already exists")
+	writer, err := CreateBlockArchive(t.TempDir(), "test-v1", genesisHash, 10)
+	require.NoError(t, err)
+	require.NoError(t, writer.Add(protocol.Encode(&rpcs.EncodedBlockCert{Block: blocks[1]})))
+	require.ErrorContains(t, writer.Add(protocol.Encode(&rpcs.EncodedBlockCert{Block: blocks[3]})), "expected block 2")
+	require.ErrorContains(t, writer.Add(protocol.Encode(&rpcs.EncodedBlockCert{Block: makeArchiveTestBlocks(t, crypto.Digest{}, 3)[2]})), "not a block of the genesis")
+
+	// reordered chunks break the chain hashes
+	reordered := loaded
+	reordered.Chunks = []BlockArchiveChunk{loaded.Chunks[0], loaded.Chunks[1], loaded.Chunks[2]}
+	reordered.Chunks[0], reordered.Chunks[1] = reordered.Chunks[1], reordered.Chunks[0]
+	reordered.Chunks[0].FirstRound, reordered.Chunks[1].FirstRound = reordered.Chunks[1].FirstRound, reordered.Chunks[0].FirstRound
+	reordered.Chunks[0].LastRound, reordered.Chunks[1].LastRound = reordered.Chunks[1].LastRound, reordered.Chunks[0].LastRound
+	encoded, err := json.Marshal(reordered)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, BlockArchiveManifestFilename), encoded, 0600))
+	_, err = LoadBlockArchiveManifest(dir)
+	require.ErrorContains(t, err, "chain hash mismatch")
+
+	// corrupted chunks are detected
+	chunkFile := filepath.Join(dir, loaded.Chunks[0].File)
+	data, err := os.ReadFile(chunkFile)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(chunkFile, data, 0600))
+	_, err = readBlockArchiveChunk(dir, loaded.Chunks[0])
+	require.ErrorContains(t, err, "corrupted")
+}
+
+func TestImportBlockArchive(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisHash := crypto.Hash([]byte("genesis"))
+	blocks := makeArchiveTestBlocks(t, genesisHash, 31)
+	dir := t.TempDir()
+	writeTestBlockArchive(t, dir, genesisHash, blocks[1:21], 8)
+
+	cfg := config.GetDefaultLocal()
+	ledger := &mockedLedger{blocks: blocks[:6]}
+	auth := &mockedAuthenticator{errorRound: -1}
+	last, err := importBlockArchive(context.Background(), logging.TestingLog(t), cfg, ledger, auth, nil, dir)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(20), last)
+	require.Equal(t, basics.Round(20), ledger.LastRound())
+
+	// importing again is a no-op
+	last, err = importBlockArchive(context.Background(), logging.TestingLog(t), cfg, ledger, auth, nil, dir)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(20), last)
+
+	// a block whose certificate does not authenticate stops the import
+	ledger = &mockedLedger{blocks: blocks[:1]}
+	auth = &mockedAuthenticator{errorRound: 12}
+	last, err = importBlockArchive(context.Background(), logging.TestingLog(t), cfg, ledger, auth, nil, dir)
+	require.ErrorContains(t, err, "unable to import block 12")
+	require.Equal(t, basics.Round(11), last)
+	require.Equal(t, basics.Round(11), ledger.LastRound())
+
+	// an archive of another network, or one that does not reach the ledger, is rejected
+	ledger = &mockedLedger{blocks: makeArchiveTestBlocks(t, crypto.Digest{}, 1)}
+	_, err = importBlockArchive(context.Background(), logging.TestingLog(t), cfg, ledger, auth, nil, dir)
+	require.ErrorContains(t, err, "blocks of the genesis")
+	laterDir := t.TempDir()
+	writeTestBlockArchive(t, laterDir, genesisHash, blocks[25:], 8)
+	ledger = &mockedLedger{blocks: blocks[:6]}
+	_, err = importBlockArchive(context.Background(), logging.TestingLog(t), cfg, ledger, auth, nil, laterDir)
+	require.ErrorContains(t, err, "after the next round 6")
+}
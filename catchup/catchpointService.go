@@ -102,6 +102,8 @@ type CatchpointCatchupService struct {
 	abortCtxFunc context.CancelFunc
 	// blocksDownloadPeerSelector is the peer selector used for downloading blocks.
 	blocksDownloadPeerSelector *peerSelector
+	// peerFilter restricts the peers the catchpoint and the blocks are downloaded from to the catchup peer lists.
+	peerFilter *peerFilter
 }
 
 // MakeResumedCatchpointCatchupService creates a catchpoint catchup service for a node that is already in catchpoint catchup mode
@@ -118,6 +120,10 @@ func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCat
 		ledger:         accessor.Ledger(),
 		config:         cfg,
 	}
+	service.peerFilter, err = parsePeerFilter(cfg)
+	if err != nil {
+		return nil, err
+	}
 	l := accessor.Ledger()
 	service.lastBlockHeader, err = l.BlockHdr(l.Latest())
 	if err != nil {
@@ -150,6 +156,10 @@ func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNo
 		ledger:         accessor.Ledger(),
 		config:         cfg,
 	}
+	service.peerFilter, err = parsePeerFilter(cfg)
+	if err != nil {
+		return nil, err
+	}
 	l := accessor.Ledger()
 	service.lastBlockHeader, err = l.BlockHdr(l.Latest())
 	if err != nil {
@@ -294,7 +304,7 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 	}
 
 	// download balances file.
-	peerSelector := makePeerSelector(cs.net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
+	peerSelector := makePeerSelector(filterPeers(cs.net, cs.peerFilter), []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
 	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
 	attemptsCount := 0
 
@@ -678,6 +688,7 @@ func (cs *CatchpointCatchupService) fetchBlock(round basics.Round, retryCount ui
 		return nil, time.Duration(0), psp, true, cs.abort(fmt.Errorf("fetchBlock: recurring non-HTTP peer was provided by the peer selector"))
 	}
 	fetcher := makeUniversalBlockFetcher(cs.log, cs.net, cs.config)
+	fetcher.filter = cs.peerFilter
 	blk, _, downloadDuration, err = fetcher.fetchBlock(cs.ctx, round, httpPeer)
 	if err != nil {
 		if cs.ctx.Err() != nil {
@@ -802,14 +813,14 @@ func (cs *CatchpointCatchupService) updateBlockRetrievalStatistics(acquiredBlock
 func (cs *CatchpointCatchupService) initDownloadPeerSelector() {
 	if cs.config.EnableCatchupFromArchiveServers {
 		cs.blocksDownloadPeerSelector = makePeerSelector(
-			cs.net,
+			filterPeers(cs.net, cs.peerFilter),
 			[]peerClass{
 				{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookArchivers},
 				{initialRank: peerRankInitialSecondPriority, peerClass: network.PeersPhonebookRelays},
 			})
 	} else {
 		cs.blocksDownloadPeerSelector = makePeerSelector(
-			cs.net,
+			filterPeers(cs.net, cs.peerFilter),
 			[]peerClass{
 				{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays},
 			})
@@ -824,7 +835,7 @@ func (cs *CatchpointCatchupService) checkLedgerDownload() error {
 	if err != nil {
 		return fmt.Errorf("failed to parse catchpoint label : %v", err)
 	}
-	peerSelector := makePeerSelector(cs.net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
+	peerSelector := makePeerSelector(filterPeers(cs.net, cs.peerFilter), []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
 	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
 	for i := 0; i < checkLedgerDownloadRetries; i++ {
 		psp, peerError := peerSelector.getNextPeer()
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+)
+
+// peerPatterns is a list of peer patterns: networks in the CIDR notation, IP addresses, and host names in which
+// * matches any sequence of characters, such as *.example.com.
+type peerPatterns struct {
+	networks []*net.IPNet
+	hosts    []string
+}
+
+// parsePeerPatterns parses a comma delimited list of peer patterns.
+func parsePeerPatterns(list string) (patterns peerPatterns, err error) {
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			_, ipNet, err := net.ParseCIDR(entry)
+			if err != nil {
+				return peerPatterns{}, fmt.Errorf("invalid catchup peer network %q: %w", entry, err)
+			}
+			patterns.networks = append(patterns.networks, ipNet)
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			patterns.networks = append(patterns.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		if _, err := path.Match(entry, ""); err != nil {
+			return peerPatterns{}, fmt.Errorf("invalid catchup peer host pattern %q: %w", entry, err)
+		}
+		patterns.hosts = append(patterns.hosts, entry)
+	}
+	return patterns, nil
+}
+
+func (p peerPatterns) empty() bool {
+	return len(p.networks) == 0 && len(p.hosts) == 0
+}
+
+// match reports whether a host, a host name or an IP address, matches one of the patterns. The host names are
+// only matched against the host patterns, as they are not resolved.
+func (p peerPatterns) match(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		for _, ipNet := range p.networks {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		}
+	}
+	host = strings.ToLower(host)
+	for _, pattern := range p.hosts {
+		if matched, _ := path.Match(pattern, host); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// peerFilter restricts the peers catchup fetches blocks from to the peers of CatchupPeerAllowList, if any, which
+// are not in CatchupPeerDenyList. A nil peerFilter allows every peer.
+type peerFilter struct {
+	allow peerPatterns
+	deny  peerPatterns
+}
+
+// parsePeerFilter parses the catchup peer lists of the config, returning nil when they are both empty.
+func parsePeerFilter(cfg config.Local) (*peerFilter, error) {
+	allow, err := parsePeerPatterns(cfg.CatchupPeerAllowList)
+	if err != nil {
+		return nil, err
+	}
+	deny, err := parsePeerPatterns(cfg.CatchupPeerDenyList)
+	if err != nil {
+		return nil, err
+	}
+	if allow.empty() && deny.empty() {
+		return nil, nil
+	}
+	return &peerFilter{allow: allow, deny: deny}, nil
+}
+
+// ValidatePeerFilter returns an error if the catchup peer lists of the config are invalid.
+func ValidatePeerFilter(cfg config.Local) error {
+	_, err := parsePeerFilter(cfg)
+	return err
+}
+
+// makePeerFilter makes the peer filter of the catchup peer lists of the config. Invalid lists, which
+// ValidatePeerFilter reports when the node starts, deny every peer rather than being ignored.
+func makePeerFilter(cfg config.Local, log logging.Logger) *peerFilter {
+	filter, err := parsePeerFilter(cfg)
+	if err != nil {
+		log.Errorf("catchup: not fetching blocks from any peer: %v", err)
+		return &peerFilter{deny: peerPatterns{hosts: []string{"*"}}}
+	}
+	return filter
+}
+
+// allowed reports whether blocks may be fetched from the peer at address.
+func (f *peerFilter) allowed(address string) bool {
+	if f == nil {
+		return true
+	}
+	host := peerHost(address)
+	if f.deny.match(host) {
+		return false
+	}
+	return f.allow.empty() || f.allow.match(host)
+}
+
+// peerHost returns the host of a peer address, which is either a url or a host and port.
+func peerHost(address string) string {
+	if strings.Contains(address, "://") {
+		if u, err := url.Parse(address); err == nil {
+			return u.Hostname()
+		}
+	}
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return strings.Trim(address, "[]")
+}
+
+// filteredPeers is a peersRetriever returning only the peers allowed by a peer filter.
+type filteredPeers struct {
+	net    peersRetriever
+	filter *peerFilter
+}
+
+// filterPeers returns a peersRetriever returning the peers of net allowed by filter.
+func filterPeers(net peersRetriever, filter *peerFilter) peersRetriever {
+	if filter == nil {
+		return net
+	}
+	return &filteredPeers{net: net, filter: filter}
+}
+
+// GetPeers implements peersRetriever
+func (fp *filteredPeers) GetPeers(options ...network.PeerOption) []network.Peer {
+	peers := fp.net.GetPeers(options...)
+	allowed := peers[:0:0]
+	for _, peer := range peers {
+		if fp.filter.allowed(peerAddress(peer)) {
+			allowed = append(allowed, peer)
+		}
+	}
+	return allowed
+}
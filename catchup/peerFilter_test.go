@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPeerHost(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, "r1.example.com", peerHost("r1.example.com:4160"))
+	require.Equal(t, "r1.example.com", peerHost("http://r1.example.com:4160"))
+	require.Equal(t, "10.0.0.1", peerHost("https://10.0.0.1"))
+	require.Equal(t, "10.0.0.1", peerHost("10.0.0.1"))
+	require.Equal(t, "::1", peerHost("[::1]:4160"))
+	require.Equal(t, "::1", peerHost("http://[::1]:4160"))
+}
+
+func TestPeerFilter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	filter, err := parsePeerFilter(cfg)
+	require.NoError(t, err)
+	require.Nil(t, filter)
+	require.True(t, filter.allowed("anyone.example.com:4160"))
+
+	cfg.CatchupPeerAllowList = "10.1.0.0/16, 192.168.1.7, *.archive.example.com, FD00::/8"
+	cfg.CatchupPeerDenyList = "10.1.2.0/24,bad.archive.example.com"
+	filter, err = parsePeerFilter(cfg)
+	require.NoError(t, err)
+	require.NoError(t, ValidatePeerFilter(cfg))
+
+	for address, allowed := range map[string]bool{
+		"http://10.1.0.5:4160":               true,
+		"10.1.2.5:4160":                      false,
+		"10.2.0.5:4160":                      false,
+		"192.168.1.7:4160":                   true,
+		"192.168.1.8:4160":                   false,
+		"r1.archive.example.com:4160":        true,
+		"http://R2.Archive.Example.com:4160": true,
+		"bad.archive.example.com:4160":       false,
+		"archive.example.com:4160":           false,
+		"r1.relay.example.com:4160":          false,
+		"[fd00::1]:4160":                     true,
+		"":                                   false,
+	} {
+		require.Equal(t, allowed, filter.allowed(address), address)
+	}
+
+	// without an allow list, only the denied peers are filtered out
+	cfg.CatchupPeerAllowList = ""
+	filter, err = parsePeerFilter(cfg)
+	require.NoError(t, err)
+	require.True(t, filter.allowed("r1.relay.example.com:4160"))
+	require.False(t, filter.allowed("10.1.2.5:4160"))
+
+	for _, invalid := range []string{"10.0.0.0/33", "10.0.0.1/", "[a-.example.com"} {
+		cfg.CatchupPeerDenyList = invalid
+		require.Error(t, ValidatePeerFilter(cfg), invalid)
+
+		// invalid lists deny every peer rather than being ignored
+		filter = makePeerFilter(cfg, logging.TestingLog(t))
+		require.False(t, filter.allowed("r1.relay.example.com:4160"))
+		require.False(t, filter.allowed("10.0.0.1:4160"))
+	}
+}
+
+func TestFilteredPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	net := makePeersRetrieverStub(func(options ...network.PeerOption) []network.Peer {
+		return []network.Peer{
+			&mockHTTPPeer{address: "http://archive.example.com:4160"},
+			&mockHTTPPeer{address: "http://relay.example.com:4160"},
+			&mockUnicastPeer{address: "archive.example.com:4160"},
+		}
+	})
+	require.Equal(t, net, filterPeers(net, nil))
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchupPeerAllowList = "archive.example.com"
+	filter, err := parsePeerFilter(cfg)
+	require.NoError(t, err)
+	peers := filterPeers(net, filter).GetPeers(network.PeersPhonebookRelays)
+	require.Len(t, peers, 2)
+	for _, peer := range peers {
+		require.Equal(t, "archive.example.com", peerHost(peerAddress(peer)))
+	}
+
+	// the peer selector only selects the allowed peers
+	selector := makePeerSelector(filterPeers(net, filter), []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
+	for i := 0; i < 10; i++ {
+		psp, err := selector.getNextPeer()
+		require.NoError(t, err)
+		require.Equal(t, "archive.example.com", peerHost(peerAddress(psp.Peer)))
+	}
+
+	// the universal fetcher refuses to fetch from the other peers
+	fetcher := makeUniversalBlockFetcher(logging.TestingLog(t), nil, cfg)
+	fetcher.filter = filter
+	_, _, _, err = fetcher.fetchBlock(context.Background(), 1, &mockHTTPPeer{address: "http://relay.example.com:4160"})
+	require.ErrorContains(t, err, "not allowed by the catchup peer lists")
+}
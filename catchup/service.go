@@ -82,6 +82,8 @@ type Service struct {
 	parallelBlocks      uint64
 	deadlineTimeout     time.Duration
 	blockValidationPool execpool.BacklogPool
+	// peerFilter restricts the peers blocks are fetched from to the catchup peer lists.
+	peerFilter *peerFilter
 
 	// suspendForCatchpointWriting defines whether we've run into a state where the ledger is currently busy writing the
 	// catchpoint file. If so, we want to suspend the catchup process until the catchpoint file writing is complete,
@@ -126,6 +128,7 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.deadlineTimeout = agreement.DeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
 	s.syncNow = make(chan struct{}, 1)
+	s.peerFilter = makePeerFilter(config, s.log)
 
 	return s
 }
@@ -214,6 +217,7 @@ func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeepin
 
 	ctx, cf := context.WithCancel(s.ctx)
 	fetcher := makeUniversalBlockFetcher(s.log, s.net, s.cfg)
+	fetcher.filter = s.peerFilter
 	defer cf()
 	stopWaitingForLedgerRound := make(chan struct{})
 	defer close(stopWaitingForLedgerRound)
@@ -248,13 +252,14 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 	if dontSyncRound := s.GetDisableSyncRound(); dontSyncRound != 0 && r >= basics.Round(dontSyncRound) {
 		return false
 	}
+	log := s.log.With(logging.RoundField, r)
 	i := 0
 	hasLookback := false
 	for true {
 		i++
 		select {
 		case <-s.ctx.Done():
-			s.log.Debugf("fetchAndWrite(%v): Aborted", r)
+			log.Debugf("fetchAndWrite(%v): Aborted", r)
 			return false
 		default:
 		}
@@ -265,16 +270,16 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			if _, initialSync := s.IsSynchronizing(); initialSync {
 				// on the initial sync, it's completly expected that we won't be able to get all the "next" blocks.
 				// Therefore, info should suffice.
-				s.log.Info(loggedMessage)
+				log.Info(loggedMessage)
 			} else {
 				// On any subsequent sync, we might be looking for multiple rounds into the future, so it's completely
 				// reasonable that we would fail retrieving the future block.
 				// Generate a warning here only if we're failing to retrieve X+1 or below.
 				// All other block retrievals should not generate a warning.
 				if r > s.ledger.NextRound() {
-					s.log.Info(loggedMessage)
+					log.Info(loggedMessage)
 				} else {
-					s.log.Warn(loggedMessage)
+					log.Warn(loggedMessage)
 				}
 			}
 			return false
@@ -282,7 +287,7 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 
 		psp, getPeerErr := peerSelector.getNextPeer()
 		if getPeerErr != nil {
-			s.log.Debugf("fetchAndWrite: was unable to obtain a peer to retrieve the block from")
+			log.Debugf("fetchAndWrite: was unable to obtain a peer to retrieve the block from")
 			break
 		}
 		peer := psp.Peer
@@ -294,10 +299,10 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			if err == errLedgerAlreadyHasBlock {
 				// ledger already has the block, no need to request this block.
 				// only the agreement could have added this block into the ledger, catchup is complete
-				s.log.Infof("fetchAndWrite(%d): the block is already in the ledger. The catchup is complete", r)
+				log.Infof("fetchAndWrite(%d): the block is already in the ledger. The catchup is complete", r)
 				return false
 			}
-			s.log.Debugf("fetchAndWrite(%v): Could not fetch: %v (attempt %d)", r, err, i)
+			log.Debugf("fetchAndWrite(%v): Could not fetch: %v (attempt %d)", r, err, i)
 			peerSelector.rankPeer(psp, peerRankDownloadFailed)
 			// we've just failed to retrieve a block; wait until the previous block is fetched before trying again
 			// to avoid the usecase where the first block doesn't exist, and we're making many requests down the chain
@@ -305,11 +310,11 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			if !hasLookback {
 				select {
 				case <-s.ctx.Done():
-					s.log.Infof("fetchAndWrite(%d): Aborted while waiting for lookback block to ledger after failing once : %v", r, err)
+					log.Infof("fetchAndWrite(%d): Aborted while waiting for lookback block to ledger after failing once : %v", r, err)
 					return false
 				case hasLookback = <-lookbackComplete:
 					if !hasLookback {
-						s.log.Infof("fetchAndWrite(%d): lookback block doesn't exist, won't try to retrieve block again : %v", r, err)
+						log.Infof("fetchAndWrite(%d): lookback block doesn't exist, won't try to retrieve block again : %v", r, err)
 						return false
 					}
 				}
@@ -319,7 +324,7 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			// someone already wrote the block to the ledger, we should stop syncing
 			return false
 		}
-		s.log.Debugf("fetchAndWrite(%v): Got block and cert contents: %v %v", r, block, cert)
+		log.Debugf("fetchAndWrite(%v): Got block and cert contents: %v %v", r, block, cert)
 
 		// Check that the block's contents match the block header (necessary with an untrusted block because b.Hash() only hashes the header)
 		if s.cfg.CatchupVerifyPaysetHash() {
@@ -327,11 +332,11 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 				peerSelector.rankPeer(psp, peerRankInvalidDownload)
 				// Check if this mismatch is due to an unsupported protocol version
 				if _, ok := config.Consensus[block.BlockHeader.CurrentProtocol]; !ok {
-					s.log.Errorf("fetchAndWrite(%v): unsupported protocol version detected: '%v'", r, block.BlockHeader.CurrentProtocol)
+					log.Errorf("fetchAndWrite(%v): unsupported protocol version detected: '%v'", r, block.BlockHeader.CurrentProtocol)
 					return false
 				}
 
-				s.log.Warnf("fetchAndWrite(%v): block contents do not match header (attempt %d)", r, i)
+				log.Warnf("fetchAndWrite(%v): block contents do not match header (attempt %d)", r, i)
 				continue // retry the fetch
 			}
 		}
@@ -340,11 +345,11 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 		if !hasLookback {
 			select {
 			case <-s.ctx.Done():
-				s.log.Debugf("fetchAndWrite(%v): Aborted while waiting for lookback block to ledger", r)
+				log.Debugf("fetchAndWrite(%v): Aborted while waiting for lookback block to ledger", r)
 				return false
 			case hasLookback = <-lookbackComplete:
 				if !hasLookback {
-					s.log.Warnf("fetchAndWrite(%v): lookback block doesn't exist, cannot authenticate new block", r)
+					log.Warnf("fetchAndWrite(%v): lookback block doesn't exist, cannot authenticate new block", r)
 					return false
 				}
 			}
@@ -352,7 +357,7 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 		if s.cfg.CatchupVerifyCertificate() {
 			err = s.auth.Authenticate(block, cert)
 			if err != nil {
-				s.log.Warnf("fetchAndWrite(%v): cert did not authenticate block (attempt %d): %v", r, i, err)
+				log.Warnf("fetchAndWrite(%v): cert did not authenticate block (attempt %d): %v", r, i, err)
 				peerSelector.rankPeer(psp, peerRankInvalidDownload)
 				continue // retry the fetch
 			}
@@ -360,19 +365,19 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 
 		peerRank := peerSelector.peerDownloadDurationToRank(psp, blockDownloadDuration)
 		r1, r2 := peerSelector.rankPeer(psp, peerRank)
-		s.log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
+		log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
 
 		// Write to ledger, noting that ledger writes must be in order
 		select {
 		case <-s.ctx.Done():
-			s.log.Debugf("fetchAndWrite(%v): Aborted while waiting to write to ledger", r)
+			log.Debugf("fetchAndWrite(%v): Aborted while waiting to write to ledger", r)
 			return false
 		case prevFetchSuccess := <-prevFetchCompleteChan:
 			if prevFetchSuccess {
 				// make sure the ledger wrote enough of the account data to disk, since we don't want the ledger to hold a large amount of data in memory.
 				proto, err := s.ledger.ConsensusParams(r.SubSaturate(1))
 				if err != nil {
-					s.log.Errorf("fetchAndWrite(%d): Unable to determine consensus params for round %d: %v", r, r-1, err)
+					log.Errorf("fetchAndWrite(%d): Unable to determine consensus params for round %d: %v", r, r-1, err)
 					return false
 				}
 				ledgerBacklogRound := r.SubSaturate(basics.Round(proto.MaxBalLookback))
@@ -380,7 +385,7 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 				case <-s.ledger.Wait(ledgerBacklogRound):
 					// i.e. round r-320 is no longer in the blockqueue, so it's account data is either being currently written, or it was already written.
 				case <-s.ctx.Done():
-					s.log.Debugf("fetchAndWrite(%d): Aborted while waiting for ledger to complete writing up to round %d", r, ledgerBacklogRound)
+					log.Debugf("fetchAndWrite(%d): Aborted while waiting for ledger to complete writing up to round %d", r, ledgerBacklogRound)
 					return false
 				}
 
@@ -395,10 +400,10 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 						if errNSBE, ok := err.(ledgercore.ErrNonSequentialBlockEval); ok && errNSBE.EvaluatorRound <= errNSBE.LatestRound {
 							// the block was added to the ledger from elsewhere after fetching it here
 							// only the agreement could have added this block into the ledger, catchup is complete
-							s.log.Infof("fetchAndWrite(%d): after fetching the block, it is already in the ledger. The catchup is complete", r)
+							log.Infof("fetchAndWrite(%d): after fetching the block, it is already in the ledger. The catchup is complete", r)
 							return false
 						}
-						s.log.Warnf("fetchAndWrite(%d): failed to validate block : %v", r, err)
+						log.Warnf("fetchAndWrite(%d): failed to validate block : %v", r, err)
 						return false
 					}
 					err = s.ledger.AddValidatedBlock(*vb, *cert)
@@ -409,12 +414,12 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 				if err != nil {
 					switch err.(type) {
 					case ledgercore.ErrNonSequentialBlockEval:
-						s.log.Infof("fetchAndWrite(%d): no need to re-evaluate historical block", r)
+						log.Infof("fetchAndWrite(%d): no need to re-evaluate historical block", r)
 						return true
 					case ledgercore.BlockInLedgerError:
 						// the block was added to the ledger from elsewhere after fetching it here
 						// only the agreement could have added this block into the ledger, catchup is complete
-						s.log.Infof("fetchAndWrite(%d): after fetching the block, it is already in the ledger. The catchup is complete", r)
+						log.Infof("fetchAndWrite(%d): after fetching the block, it is already in the ledger. The catchup is complete", r)
 						return false
 					case protocol.Error:
 						if !s.protocolErrorLogged {
@@ -422,15 +427,15 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 							s.protocolErrorLogged = true
 						}
 					default:
-						s.log.Errorf("fetchAndWrite(%v): ledger write failed: %v", r, err)
+						log.Errorf("fetchAndWrite(%v): ledger write failed: %v", r, err)
 					}
 
 					return false
 				}
-				s.log.Debugf("fetchAndWrite(%v): Wrote block to ledger", r)
+				log.Debugf("fetchAndWrite(%v): Wrote block to ledger", r)
 				return true
 			}
-			s.log.Warnf("fetchAndWrite(%v): previous block doesn't exist (perhaps fetching block %v failed)", r, r-1)
+			log.Warnf("fetchAndWrite(%v): previous block doesn't exist (perhaps fetching block %v failed)", r, r-1)
 			return false
 		}
 	}
@@ -472,7 +477,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 		close(completed)
 	}()
 
-	peerSelector := createPeerSelector(s.net, s.cfg, true)
+	peerSelector := createPeerSelector(filterPeers(s.net, s.peerFilter), s.cfg, true)
 
 	if _, err := peerSelector.getNextPeer(); err == errPeerSelectorNoPeerPoolsAvailable {
 		s.log.Debugf("pipelinedFetch: was unable to obtain a peer to retrieve the block from")
@@ -570,6 +575,9 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 // periodicSync periodically asks the network for its latest round and syncs if we've fallen behind (also if our ledger stops advancing)
 func (s *Service) periodicSync() {
 	defer close(s.done)
+	if s.cfg.CatchupBlockArchiveDir != "" {
+		s.importBlockArchive()
+	}
 	// if the catchup is disabled in the config file, just skip it.
 	if s.parallelBlocks != 0 && !s.cfg.DisableNetworking {
 		// The following request might be redundant, but it ensures we wait long enough for the DNS records to be loaded,
@@ -707,7 +715,7 @@ func (s *Service) fetchRound(cert agreement.Certificate, verifier *agreement.Asy
 	}
 
 	blockHash := bookkeeping.BlockHash(cert.Proposal.BlockDigest) // semantic digest (i.e., hash of the block header), not byte-for-byte digest
-	peerSelector := createPeerSelector(s.net, s.cfg, false)
+	peerSelector := createPeerSelector(filterPeers(s.net, s.peerFilter), s.cfg, false)
 	for s.ledger.LastRound() < cert.Round {
 		psp, getPeerErr := peerSelector.getNextPeer()
 		if getPeerErr != nil {
@@ -809,7 +817,7 @@ func (s *Service) handleUnsupportedRound(nextUnsupportedRound basics.Round) {
 	}
 }
 
-func createPeerSelector(net network.GossipNode, cfg config.Local, pipelineFetch bool) *peerSelector {
+func createPeerSelector(net peersRetriever, cfg config.Local, pipelineFetch bool) *peerSelector {
 	var peerClasses []peerClass
 	if cfg.EnableCatchupFromArchiveServers {
 		if pipelineFetch {
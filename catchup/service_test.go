@@ -181,6 +181,10 @@ func (cl *periodicSyncLogger) Warnf(s string, args ...interface{}) {
 	cl.Logger.Warnf(s, args...)
 }
 
+func (cl *periodicSyncLogger) With(key string, value interface{}) logging.Logger {
+	return &periodicSyncLogger{Logger: cl.Logger.With(key, value), WarnfCallback: cl.WarnfCallback}
+}
+
 func TestSyncRound(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
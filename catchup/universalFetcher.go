@@ -41,6 +41,8 @@ type universalBlockFetcher struct {
 	config config.Local
 	net    network.GossipNode
 	log    logging.Logger
+	// filter, when set, restricts the peers blocks are fetched from.
+	filter *peerFilter
 }
 
 // makeUniversalFetcher returns a fetcher for http and ws peers.
@@ -55,6 +57,10 @@ func makeUniversalBlockFetcher(log logging.Logger, net network.GossipNode, confi
 func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Round, peer network.Peer) (blk *bookkeeping.Block,
 	cert *agreement.Certificate, downloadDuration time.Duration, err error) {
 
+	if !uf.filter.allowed(peerAddress(peer)) {
+		return nil, nil, time.Duration(0), fmt.Errorf("fetchBlock: peer %s is not allowed by the catchup peer lists", peerAddress(peer))
+	}
+
 	var fetchedBuf []byte
 	var address string
 	blockDownloadStartTime := time.Now()
@@ -228,6 +234,7 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 	defer requestCancel()
 	request = request.WithContext(requestCtx)
 	network.SetUserAgentHeader(request.Header)
+	request.Header.Set("Accept-Encoding", rpcs.BlockResponseContentEncoding)
 	response, err := hf.client.Do(request)
 	if err != nil {
 		hf.log.Debugf("GET %#v : %s", blockURL, err)
@@ -270,7 +277,18 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 		return nil, errHTTPResponseContentType{contentTypeCount: 1, contentType: contentTypes[0]}
 	}
 
-	return rpcs.ResponseBytes(response, hf.log, fetcherMaxBlockBytes)
+	contentEncoding := response.Header.Get("Content-Encoding")
+	if contentEncoding != "" && contentEncoding != rpcs.BlockResponseContentEncoding {
+		hf.log.Warnf("http block fetcher response has an invalid content encoding : %s", contentEncoding)
+		response.Body.Close()
+		return nil, errHTTPResponseContentEncoding{contentEncoding: contentEncoding}
+	}
+
+	data, err = rpcs.ResponseBytes(response, hf.log, fetcherMaxBlockBytes)
+	if err != nil || contentEncoding == "" {
+		return data, err
+	}
+	return rpcs.DecompressBlockBytes(data, fetcherMaxBlockBytes)
 }
 
 // Address is part of FetcherClient interface.
@@ -383,3 +401,11 @@ func (cte errHTTPResponseContentType) Error() string {
 	}
 	return fmt.Sprintf("HTTPFetcher.getBlockBytes: invalid content type count: %d", cte.contentTypeCount)
 }
+
+type errHTTPResponseContentEncoding struct {
+	contentEncoding string
+}
+
+func (cee errHTTPResponseContentEncoding) Error() string {
+	return fmt.Sprintf("HTTPFetcher.getBlockBytes: invalid content encoding: %s", cee.contentEncoding)
+}
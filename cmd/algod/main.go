@@ -22,6 +22,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -55,9 +56,17 @@ var listenIP = flag.String("l", "", "Override config.EndpointAddress (REST liste
 var sessionGUID = flag.String("s", "", "Telemetry Session GUID to use")
 var telemetryOverride = flag.String("t", "", `Override telemetry setting if supported (Use "true", "false", "0" or "1")`)
 var seed = flag.String("seed", "", "input to math/rand.Seed()")
+var serviceAction = flag.String("service", "", `Install or uninstall the Windows service running the node of the data directory ("install" or "uninstall")`)
+var serviceName = flag.String("service-name", "algod", "Name of the Windows service and of its event log source")
 
 func main() {
 	flag.Parse()
+	if *serviceAction != "" {
+		os.Exit(controlService(*serviceAction))
+	}
+	if exitCode, ok := runAsService(run); ok {
+		os.Exit(exitCode)
+	}
 	exitCode := run()
 	os.Exit(exitCode)
 }
@@ -112,6 +121,16 @@ func run() int {
 		return 1
 	}
 
+	// A data directory listing several networks hosts them all in this process.
+	networks, err := config.LoadNetworksConfig(absolutePath)
+	if err == nil {
+		return runNetworks(absolutePath, networks, baseHeartbeatEvent)
+	}
+	if !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Cannot load %s: %v\n", config.NetworksFilename, err)
+		return 1
+	}
+
 	genesisPath := *genesisFile
 	if genesisPath == "" {
 		genesisPath = filepath.Join(dataDir, config.GenesisJSONFile)
@@ -176,39 +195,10 @@ func run() int {
 		log.Fatalf("Unable to load optional consensus protocols file: %v", err)
 	}
 
-	// Enable telemetry hook in daemon to send logs to cloud
-	// If ALGOTEST env variable is set, telemetry is disabled - allows disabling telemetry for tests
-	isTest := os.Getenv("ALGOTEST") != ""
-	remoteTelemetryEnabled := false
-	if !isTest {
-		telemetryConfig, err := logging.EnsureTelemetryConfig(&dataDir, genesis.ID())
-		if err != nil {
-			fmt.Fprintln(os.Stdout, "error loading telemetry config", err)
-		}
-		if os.IsPermission(err) {
-			fmt.Fprintf(os.Stderr, "Permission error on accessing telemetry config: %v", err)
-			return 1
-		}
-		fmt.Fprintf(os.Stdout, "Telemetry configured from '%s'\n", telemetryConfig.FilePath)
-
-		telemetryConfig.SendToLog = telemetryConfig.SendToLog || cfg.TelemetryToLog
-
-		// Apply telemetry override.
-		telemetryConfig.Enable = logging.TelemetryOverride(*telemetryOverride, &telemetryConfig)
-		remoteTelemetryEnabled = telemetryConfig.Enable
-
-		if telemetryConfig.Enable || telemetryConfig.SendToLog {
-			// If session GUID specified, use it.
-			if *sessionGUID != "" {
-				if len(*sessionGUID) == 36 {
-					telemetryConfig.SessionGUID = *sessionGUID
-				}
-			}
-			err = log.EnableTelemetry(telemetryConfig)
-			if err != nil {
-				fmt.Fprintln(os.Stdout, "error creating telemetry hook", err)
-			}
-		}
+	remoteTelemetryEnabled, err := setupTelemetry(log, dataDir, genesis.ID(), cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Permission error on accessing telemetry config: %v", err)
+		return 1
 	}
 
 	s := algod.Server{
@@ -337,62 +327,105 @@ func run() int {
 			toolsnet.StartTelemetryURIUpdateService(time.Minute, cfg, s.Genesis.Network, log, done)
 		}
 
-		currentVersion := config.GetCurrentVersion()
-		var overrides []telemetryspec.NameValue
-		for name, val := range config.GetNonDefaultConfigValues(cfg, startupConfigCheckFields) {
-			overrides = append(overrides, telemetryspec.NameValue{Name: name, Value: val})
-		}
-		startupDetails := telemetryspec.StartupEventDetails{
-			Version:      currentVersion.String(),
-			CommitHash:   currentVersion.CommitHash,
-			Branch:       currentVersion.Branch,
-			Channel:      currentVersion.Channel,
-			InstanceHash: crypto.Hash([]byte(absolutePath)).String(),
-			Overrides:    overrides,
-		}
+		startTelemetryReporting(log, cfg, absolutePath, baseHeartbeatEvent, done)
+	}
 
-		log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.StartupEvent, startupDetails)
-
-		// Send a heartbeat event every 10 minutes as a sign of life
-		go func() {
-			var interval time.Duration
-			defaultIntervalSecs := config.GetDefaultLocal().HeartbeatUpdateInterval
-			switch {
-			case cfg.HeartbeatUpdateInterval <= 0: // use default
-				interval = time.Second * time.Duration(defaultIntervalSecs)
-			case cfg.HeartbeatUpdateInterval < 60: // min frequency 1 minute
-				interval = time.Minute
-			default:
-				interval = time.Second * time.Duration(cfg.HeartbeatUpdateInterval)
-			}
-			ticker := time.NewTicker(interval)
-			defer ticker.Stop()
+	s.Start()
+	return 0
+}
 
-			sendHeartbeat := func() {
-				values := make(map[string]float64)
-				metrics.DefaultRegistry().AddMetrics(values)
+// setupTelemetry enables the telemetry hook of log to send logs to cloud, as configured in the
+// telemetry config of dataDir. It only fails on a permission error accessing the telemetry config.
+func setupTelemetry(log logging.Logger, dataDir string, genesisID string, cfg config.Local) (remoteTelemetryEnabled bool, err error) {
+	// If ALGOTEST env variable is set, telemetry is disabled - allows disabling telemetry for tests
+	if os.Getenv("ALGOTEST") != "" {
+		return false, nil
+	}
 
-				heartbeatDetails := baseHeartbeatEvent
-				heartbeatDetails.Metrics = values
+	telemetryConfig, err := logging.EnsureTelemetryConfig(&dataDir, genesisID)
+	if err != nil {
+		fmt.Fprintln(os.Stdout, "error loading telemetry config", err)
+	}
+	if os.IsPermission(err) {
+		return false, err
+	}
+	fmt.Fprintf(os.Stdout, "Telemetry configured from '%s'\n", telemetryConfig.FilePath)
 
-				log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.HeartbeatEvent, heartbeatDetails)
-			}
+	telemetryConfig.SendToLog = telemetryConfig.SendToLog || cfg.TelemetryToLog
 
-			// Send initial heartbeat, followed by one every 10 minutes.
-			sendHeartbeat()
-			for {
-				select {
-				case <-ticker.C:
-					sendHeartbeat()
-				case <-done:
-					return
-				}
+	// Apply telemetry override.
+	telemetryConfig.Enable = logging.TelemetryOverride(*telemetryOverride, &telemetryConfig)
+
+	if telemetryConfig.Enable || telemetryConfig.SendToLog {
+		// If session GUID specified, use it.
+		if *sessionGUID != "" {
+			if len(*sessionGUID) == 36 {
+				telemetryConfig.SessionGUID = *sessionGUID
 			}
-		}()
+		}
+		err = log.EnableTelemetry(telemetryConfig)
+		if err != nil {
+			fmt.Fprintln(os.Stdout, "error creating telemetry hook", err)
+		}
 	}
+	return telemetryConfig.Enable, nil
+}
 
-	s.Start()
-	return 0
+// startTelemetryReporting sends the startup event of the node running in instancePath, then sends
+// a heartbeat event periodically until done is closed.
+func startTelemetryReporting(log logging.Logger, cfg config.Local, instancePath string, baseHeartbeatEvent telemetryspec.HeartbeatEventDetails, done <-chan struct{}) {
+	currentVersion := config.GetCurrentVersion()
+	var overrides []telemetryspec.NameValue
+	for name, val := range config.GetNonDefaultConfigValues(cfg, startupConfigCheckFields) {
+		overrides = append(overrides, telemetryspec.NameValue{Name: name, Value: val})
+	}
+	startupDetails := telemetryspec.StartupEventDetails{
+		Version:      currentVersion.String(),
+		CommitHash:   currentVersion.CommitHash,
+		Branch:       currentVersion.Branch,
+		Channel:      currentVersion.Channel,
+		InstanceHash: crypto.Hash([]byte(instancePath)).String(),
+		Overrides:    overrides,
+	}
+
+	log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.StartupEvent, startupDetails)
+
+	// Send a heartbeat event every 10 minutes as a sign of life
+	go func() {
+		var interval time.Duration
+		defaultIntervalSecs := config.GetDefaultLocal().HeartbeatUpdateInterval
+		switch {
+		case cfg.HeartbeatUpdateInterval <= 0: // use default
+			interval = time.Second * time.Duration(defaultIntervalSecs)
+		case cfg.HeartbeatUpdateInterval < 60: // min frequency 1 minute
+			interval = time.Minute
+		default:
+			interval = time.Second * time.Duration(cfg.HeartbeatUpdateInterval)
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		sendHeartbeat := func() {
+			values := make(map[string]float64)
+			metrics.DefaultRegistry().AddMetrics(values)
+
+			heartbeatDetails := baseHeartbeatEvent
+			heartbeatDetails.Metrics = values
+
+			log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.HeartbeatEvent, heartbeatDetails)
+		}
+
+		// Send initial heartbeat, followed by one every 10 minutes.
+		sendHeartbeat()
+		for {
+			select {
+			case <-ticker.C:
+				sendHeartbeat()
+			case <-done:
+				return
+			}
+		}
+	}()
 }
 
 var startupConfigCheckFields = []string{
@@ -428,5 +461,10 @@ func resolveDataDir() string {
 	} else {
 		dir = *dataDirectory
 	}
+	if runtime.GOOS == "windows" {
+		// a quoted directory ending with a backslash, as in -d "C:\node\", has its closing
+		// quote escaped into the argument by the Windows command line parsing
+		dir = strings.TrimSuffix(dir, `"`)
+	}
 	return dir
 }
@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/gofrs/flock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+	toolsnet "github.com/algorand/go-algorand/tools/network"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+// runNetworks runs a node for each of the networks listed in the data directory root, in this
+// process. The networks share the process log output, telemetry, metrics registry and consensus
+// protocols, which are set up from root, while each of them keeps its own data directory, with its
+// genesis, config, ledger, tokens and listeners, and its own logger, whose level, subsystems and
+// additional output file are set at runtime through its REST API. As the metrics are process-wide,
+// they are served by a single network.
+func runNetworks(root string, networks config.NetworksConfig, baseHeartbeatEvent telemetryspec.HeartbeatEventDetails) int {
+	if *genesisFile != "" || *peerOverride != "" || *listenIP != "" {
+		fmt.Fprintf(os.Stderr, "The -g, -p and -l options cannot be used with a data directory listing networks in %s\n", config.NetworksFilename)
+		return 1
+	}
+
+	type networkDir struct {
+		dir         string
+		genesis     bookkeeping.Genesis
+		genesisText []byte
+	}
+	var dirs []networkDir
+	genesisIDs := make(map[string]string)
+	for _, dir := range networks.DataDirs(root) {
+		genesisPath := filepath.Join(dir, config.GenesisJSONFile)
+		genesisText, err := os.ReadFile(genesisPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read genesis file %s: %v\n", genesisPath, err)
+			return 1
+		}
+
+		var genesis bookkeeping.Genesis
+		err = protocol.DecodeJSON(genesisText, &genesis)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot parse genesis file %s: %v\n", genesisPath, err)
+			return 1
+		}
+		if other, ok := genesisIDs[genesis.ID()]; ok {
+			fmt.Fprintf(os.Stderr, "Data directories %s and %s both host network %s\n", other, dir, genesis.ID())
+			return 1
+		}
+		genesisIDs[genesis.ID()] = dir
+		dirs = append(dirs, networkDir{dir: dir, genesis: genesis, genesisText: genesisText})
+	}
+
+	if *genesisPrint {
+		for _, d := range dirs {
+			fmt.Println(d.genesis.ID())
+		}
+		return 0
+	}
+
+	// before doing anything further, attempt to acquire the algod lock of the process data
+	// directory, and of each network data directory, to ensure no other node runs against them
+	for _, dir := range append([]string{root}, networks.DataDirs(root)...) {
+		fileLock := flock.New(filepath.Join(dir, "algod.lock"))
+		locked, err := fileLock.TryLock()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unexpected failure in establishing algod.lock: %s \n", err.Error())
+			return 1
+		}
+		if !locked {
+			fmt.Fprintf(os.Stderr, "failed to lock algod.lock; is an instance of algod already running in the data directory %s?\n", dir)
+			return 1
+		}
+		defer fileLock.Unlock()
+	}
+
+	log := logging.Base()
+	rootCfg, err := config.LoadConfigFromDisk(root)
+	if err != nil && !os.IsNotExist(err) {
+		// log is not setup yet, this will log to stderr
+		log.Fatalf("Cannot load config: %v", err)
+	}
+
+	// Consensus protocols are process-wide, so custom protocols are only loaded from the process
+	// data directory.
+	err = config.LoadConfigurableConsensusProtocols(root)
+	if err != nil {
+		// log is not setup yet, this will log to stderr
+		log.Fatalf("Unable to load optional consensus protocols file: %v", err)
+	}
+
+	// Telemetry is keyed by the first network, as the process reports a single telemetry session.
+	remoteTelemetryEnabled, err := setupTelemetry(log, root, dirs[0].genesis.ID(), rootCfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Permission error on accessing telemetry config: %v", err)
+		return 1
+	}
+
+	if strings.ToLower(config.DefaultDeadlock) == "enable" {
+		deadlock.Opts.Disable = false
+	} else if strings.ToLower(config.DefaultDeadlock) == "disable" {
+		deadlock.Opts.Disable = true
+	} else if config.DefaultDeadlock != "" {
+		log.Fatalf("DefaultDeadlock is somehow not set to an expected value (enable / disable): %s", config.DefaultDeadlock)
+	}
+
+	if logToStdout != nil && *logToStdout {
+		rootCfg.LogSizeLimit = 0
+	}
+	algod.SetupLogOutput(log, root, rootCfg)
+
+	// The metrics are served by the network whose config reports them or serves them on their own
+	// listener, or by the first network, as they would be reported twice by several networks.
+	cfgs := make([]config.Local, len(dirs))
+	metricsNetwork := -1
+	for i, d := range dirs {
+		cfg, err := config.LoadConfigFromDisk(d.dir)
+		if err != nil && !os.IsNotExist(err) {
+			log.Fatalf("Cannot load config of %s: %v", d.dir, err)
+		}
+		if cfg.EnableMetricReporting || cfg.MetricsListenAddress != "" {
+			if metricsNetwork >= 0 {
+				fmt.Fprintf(os.Stderr, "Data directories %s and %s both serve the metrics, which are shared by the networks of the process: set EnableMetricReporting and MetricsListenAddress in one of them only\n", dirs[metricsNetwork].dir, d.dir)
+				return 1
+			}
+			metricsNetwork = i
+		}
+		cfgs[i] = cfg
+	}
+	if metricsNetwork < 0 {
+		metricsNetwork = 0
+	}
+
+	var servers []*algod.Server
+	for i, d := range dirs {
+		cfg := cfgs[i]
+		_, err = cfg.ValidateDNSBootstrapArray(d.genesis.Network)
+		if err != nil {
+			log.Fatalf("Error validating DNSBootstrap input of %s: %v", d.dir, err)
+		}
+
+		apiToken, wroteNewToken, err := tokens.ValidateOrGenerateAPIToken(d.dir, tokens.AlgodTokenFilename)
+		if err != nil {
+			log.Fatalf("API token error: %v", err)
+		}
+		if wroteNewToken {
+			fmt.Printf("No REST API Token found for %s. Generated token: %s\n", d.genesis.ID(), apiToken)
+		}
+
+		adminAPIToken, wroteNewToken, err := tokens.ValidateOrGenerateAPIToken(d.dir, tokens.AlgodAdminTokenFilename)
+		if err != nil {
+			log.Fatalf("Admin API token error: %v", err)
+		}
+		if wroteNewToken {
+			fmt.Printf("No Admin REST API Token found for %s. Generated token: %s\n", d.genesis.ID(), adminAPIToken)
+		}
+
+		phonebookAddresses, err := config.LoadPhonebook(d.dir)
+		if err != nil {
+			log.Debugf("Cannot load static phonebook from %s dir: %v", d.dir, err)
+		}
+
+		s := &algod.Server{
+			RootPath:  d.dir,
+			Genesis:   d.genesis,
+			Log:       log.With("network", d.genesis.ID()).Fork(),
+			NoMetrics: i != metricsNetwork,
+		}
+		err = s.Initialize(cfg, phonebookAddresses, string(d.genesisText))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			log.Error(err)
+			return 1
+		}
+		servers = append(servers, s)
+	}
+
+	if *initAndExit {
+		return 0
+	}
+
+	deadlockState := "enabled"
+	if deadlock.Opts.Disable {
+		deadlockState = "disabled"
+	}
+	fmt.Fprintf(os.Stdout, "Deadlock detection is set to: %s (Default state is '%s')\n", deadlockState, config.DefaultDeadlock)
+
+	if log.GetTelemetryEnabled() {
+		done := make(chan struct{})
+		defer close(done)
+
+		// If the telemetry URI is not set, periodically check SRV records for new telemetry URI
+		if remoteTelemetryEnabled && log.GetTelemetryURI() == "" {
+			toolsnet.StartTelemetryURIUpdateService(time.Minute, rootCfg, dirs[0].genesis.Network, log, done)
+		}
+
+		startTelemetryReporting(log, rootCfg, root, baseHeartbeatEvent, done)
+	}
+
+	(&algod.MultiServer{Servers: servers, Log: log}).Start()
+	return 0
+}
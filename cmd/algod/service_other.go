@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// controlService fails, as services can only be installed on Windows; other platforms run the
+// node under their own service managers, such as systemd.
+func controlService(action string) int {
+	fmt.Fprintf(os.Stderr, "Cannot %s service %s: services are only supported on Windows\n", action, *serviceName)
+	return 1
+}
+
+// runAsService returns false, as the node only runs as a service on Windows.
+func runAsService(run func() int) (int, bool) {
+	return 0, false
+}
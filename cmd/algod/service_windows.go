@@ -0,0 +1,205 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build windows
+// +build windows
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/eventlog"
+	"golang.org/x/sys/windows/svc/mgr"
+
+	"github.com/algorand/go-algorand/daemon/algod"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// serviceRestartDelay is how long the service control manager waits before restarting a failed node.
+const serviceRestartDelay = time.Minute
+
+// controlService installs or uninstalls the Windows service running the node of the data directory.
+func controlService(action string) int {
+	var err error
+	switch action {
+	case "install":
+		err = installService(*serviceName)
+	case "uninstall":
+		err = uninstallService(*serviceName)
+	default:
+		err = fmt.Errorf(`unknown service action %q, expected "install" or "uninstall"`, action)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot %s service %s: %v\n", action, *serviceName, err)
+		return 1
+	}
+	fmt.Printf("Service %s %sed\n", *serviceName, action)
+	return 0
+}
+
+func installService(name string) error {
+	dataDir := resolveDataDir()
+	if dataDir == "" {
+		return fmt.Errorf("data directory not specified, please use -d or set ALGORAND_DATA")
+	}
+	// services start in the system directory, so the data directory has to be absolute
+	dataDir, err := filepath.Abs(dataDir)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(dataDir); err != nil {
+		return fmt.Errorf("data directory %s does not appear to be valid: %w", dataDir, err)
+	}
+	exePath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(name)
+	if err == nil {
+		s.Close()
+		return fmt.Errorf("service %s already exists", name)
+	}
+	serviceConfig := mgr.Config{
+		DisplayName: "Algorand node (" + name + ")",
+		Description: "Runs the Algorand node of " + dataDir,
+		StartType:   mgr.StartAutomatic,
+	}
+	s, err = m.CreateService(name, exePath, serviceConfig, "-d", dataDir, "-service-name", name)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	recovery := []mgr.RecoveryAction{{Type: mgr.ServiceRestart, Delay: serviceRestartDelay}}
+	err = s.SetRecoveryActions(recovery, uint32((24 * time.Hour).Seconds()))
+	if err != nil {
+		s.Delete()
+		return err
+	}
+
+	err = eventlog.InstallAsEventCreate(name, eventlog.Error|eventlog.Warning|eventlog.Info)
+	if err != nil {
+		s.Delete()
+		return fmt.Errorf("cannot register event log source: %w", err)
+	}
+	return nil
+}
+
+func uninstallService(name string) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(name)
+	if err != nil {
+		return fmt.Errorf("service %s is not installed: %w", name, err)
+	}
+	defer s.Close()
+
+	err = s.Delete()
+	if err != nil {
+		return err
+	}
+	err = eventlog.Remove(name)
+	if err != nil {
+		return fmt.Errorf("cannot remove event log source: %w", err)
+	}
+	return nil
+}
+
+// runAsService runs the node under the Windows service control manager if the process was started
+// by it, and returns the exit code of the node. It returns false if the process isn't a service.
+func runAsService(run func() int) (int, bool) {
+	isService, err := svc.IsWindowsService()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot determine if running as a service: %v\n", err)
+		return 1, true
+	}
+	if !isService {
+		return 0, false
+	}
+
+	hook, err := logging.NewEventLogHook(*serviceName)
+	if err == nil {
+		logging.Base().AddHook(hook)
+	} else {
+		logging.Base().Warnf("Cannot write to the event log: %v", err)
+	}
+
+	handler := &serviceHandler{run: run}
+	err = svc.Run(*serviceName, handler)
+	if err != nil {
+		logging.Base().Errorf("Cannot run service %s: %v", *serviceName, err)
+		return 1, true
+	}
+	return handler.exitCode, true
+}
+
+// serviceHandler runs the node while reporting its state to the service control manager, and stops
+// it on the stop and shutdown requests of the manager.
+type serviceHandler struct {
+	run      func() int
+	exitCode int
+}
+
+func (h *serviceHandler) Execute(args []string, requests <-chan svc.ChangeRequest, status chan<- svc.Status) (bool, uint32) {
+	status <- svc.Status{State: svc.StartPending}
+
+	done := make(chan int, 1)
+	go func() {
+		done <- h.run()
+	}()
+
+	// the node can be stopped while it initializes, the stop is handled once it starts serving
+	accepts := svc.AcceptStop | svc.AcceptShutdown
+	status <- svc.Status{State: svc.Running, Accepts: accepts}
+	for {
+		select {
+		case h.exitCode = <-done:
+			status <- svc.Status{State: svc.StopPending}
+			return false, uint32(h.exitCode)
+		case req := <-requests:
+			switch req.Cmd {
+			case svc.Interrogate:
+				status <- req.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				status <- svc.Status{State: svc.StopPending}
+				algod.RequestStop("service " + serviceCommandName(req.Cmd) + " request")
+			}
+		}
+	}
+}
+
+func serviceCommandName(cmd svc.Cmd) string {
+	if cmd == svc.Shutdown {
+		return "shutdown"
+	}
+	return "stop"
+}
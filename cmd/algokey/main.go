@@ -49,6 +49,9 @@ func init() {
 	rootCmd.AddCommand(signCmd)
 	rootCmd.AddCommand(multisigCmd)
 	rootCmd.AddCommand(partCmd)
+	rootCmd.AddCommand(stateProofCmd)
+	rootCmd.AddCommand(msgpackCmd)
+	rootCmd.AddCommand(vrfCmd)
 	rootCmd.Flags().BoolVarP(&versionCheck, "version", "v", false, "Display and write current build version and exit")
 }
 
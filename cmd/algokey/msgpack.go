@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var msgpackInfile string
+var msgpackOutfile string
+var msgpackReffile string
+var msgpackBase64 bool
+
+// msgpackContextBytes is the number of bytes shown around an offset
+const msgpackContextBytes = 8
+
+func init() {
+	msgpackCmd.AddCommand(msgpackVerifyCmd)
+	msgpackCmd.AddCommand(msgpackDiffCmd)
+
+	msgpackVerifyCmd.Flags().StringVarP(&msgpackInfile, "infile", "i", "", "Encoded objects input filename, or - for stdin")
+	msgpackVerifyCmd.MarkFlagRequired("infile")
+	msgpackVerifyCmd.Flags().StringVarP(&msgpackOutfile, "outfile", "o", "", "Write the canonical encoding of the objects to this file, or - for stdout")
+	msgpackVerifyCmd.Flags().BoolVarP(&msgpackBase64, "base64", "b", false, "The input and output are base64 encoded")
+
+	msgpackDiffCmd.Flags().StringVarP(&msgpackInfile, "infile", "i", "", "Encoded objects input filename, or - for stdin")
+	msgpackDiffCmd.MarkFlagRequired("infile")
+	msgpackDiffCmd.Flags().StringVarP(&msgpackReffile, "reference", "r", "", "Reference encoding filename")
+	msgpackDiffCmd.MarkFlagRequired("reference")
+	msgpackDiffCmd.Flags().BoolVarP(&msgpackBase64, "base64", "b", false, "The input and reference are base64 encoded")
+}
+
+var msgpackCmd = &cobra.Command{
+	Use:   "msgpack",
+	Short: "Debug the msgpack encoding of objects",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var msgpackVerifyCmd = &cobra.Command{
+	Use:   "verify -i [input file]",
+	Short: "Check that objects are in the canonical msgpack encoding",
+	Long:  "Check that the msgpack encoded objects of a file, such as signed transactions, are in the canonical encoding that signatures are computed over, and point out the first byte which is not. With --outfile, the canonical encoding of the objects is written out.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		data := readMsgpackFile(msgpackInfile)
+
+		// Keep stdout for the canonical encoding when it is written there
+		report := os.Stdout
+		if msgpackOutfile == stdoutFilenameValue {
+			report = os.Stderr
+		}
+
+		canonical := true
+		err := protocol.CheckCanonical(data)
+		var cerr *protocol.CanonicalError
+		switch {
+		case err == nil:
+			fmt.Fprintln(report, "The encoding is canonical")
+		case errors.As(err, &cerr):
+			canonical = false
+			fmt.Fprintf(report, "Object %d is not canonical at byte %d", cerr.Object, cerr.Offset)
+			if cerr.Path != "" {
+				fmt.Fprintf(report, ", in field %s", cerr.Path)
+			}
+			fmt.Fprintf(report, ": %s\n", cerr.Reason)
+			fmt.Fprintf(report, "  %s\n", msgpackContext(data, cerr.Offset))
+		default:
+			fmt.Fprintf(os.Stderr, "Cannot parse input: %v\n", err)
+			os.Exit(1)
+		}
+
+		if msgpackOutfile != "" {
+			out, err := protocol.Canonicalize(data)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Cannot canonicalize input: %v\n", err)
+				os.Exit(1)
+			}
+			if msgpackBase64 {
+				out = []byte(base64.StdEncoding.EncodeToString(out) + "\n")
+			}
+			err = writeFile(msgpackOutfile, out, 0600)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Cannot write canonical encoding to %s: %v\n", msgpackOutfile, err)
+				os.Exit(1)
+			}
+		}
+
+		if !canonical {
+			os.Exit(1)
+		}
+	},
+}
+
+var msgpackDiffCmd = &cobra.Command{
+	Use:   "diff -i [input file] -r [reference file]",
+	Short: "Find the first difference between two msgpack encodings",
+	Long:  "Compare the msgpack encoding of objects with a reference encoding of the same objects, such as the one produced by goal, and point out the first byte and the field at which they differ.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		data := readMsgpackFile(msgpackInfile)
+		ref := readMsgpackFile(msgpackReffile)
+
+		offset, path := protocol.DiffEncodings(data, ref)
+		if offset < 0 {
+			fmt.Println("The encodings are identical")
+			return
+		}
+
+		fmt.Printf("The encodings differ at byte %d", offset)
+		if path != "" {
+			fmt.Printf(", in field %s", path)
+		}
+		fmt.Println()
+		fmt.Printf("  input:     %s\n", msgpackContext(data, offset))
+		fmt.Printf("  reference: %s\n", msgpackContext(ref, offset))
+		os.Exit(1)
+	},
+}
+
+// readMsgpackFile reads encoded objects from filename, decoding them from
+// base64 if --base64 was passed
+func readMsgpackFile(filename string) []byte {
+	data, err := readFile(filename)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot read %s: %v\n", filename, err)
+		os.Exit(1)
+	}
+	if msgpackBase64 {
+		data, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot decode base64 from %s: %v\n", filename, err)
+			os.Exit(1)
+		}
+	}
+	return data
+}
+
+// msgpackContext formats the bytes of data around offset in hex, with the
+// byte at offset in brackets
+func msgpackContext(data []byte, offset int) string {
+	var parts []string
+	start := offset - msgpackContextBytes
+	if start > 0 {
+		parts = append(parts, "...")
+	} else {
+		start = 0
+	}
+	for i := start; i < len(data) && i <= offset+msgpackContextBytes; i++ {
+		if i == offset {
+			parts = append(parts, fmt.Sprintf("[%02x]", data[i]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%02x", data[i]))
+		}
+	}
+	if offset >= len(data) {
+		parts = append(parts, "[end]")
+	} else if offset+msgpackContextBytes+1 < len(data) {
+		parts = append(parts, "...")
+	}
+	return strings.Join(parts, " ")
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/util"
@@ -36,6 +37,7 @@ var partFirstRound uint64
 var partLastRound uint64
 var partKeyDilution uint64
 var partParent string
+var partDataDir string
 
 var partCmd = &cobra.Command{
 	Use:   "part",
@@ -71,6 +73,26 @@ var partGenerateCmd = &cobra.Command{
 			}
 		}
 
+		var keySigner crypto.KeySigner
+		if partDataDir != "" {
+			cfg, err := config.LoadConfigFromDisk(partDataDir)
+			if err != nil && !os.IsNotExist(err) {
+				fmt.Fprintf(os.Stderr, "Cannot load the config of %s: %v\n", partDataDir, err)
+				os.Exit(1)
+			}
+			signer, err := account.OpenKeySigner(cfg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Cannot open the key signer of %s: %v\n", partDataDir, err)
+				os.Exit(1)
+			}
+			if signer != nil {
+				// the subkeys are destroyed through the global key signer when the key cannot be generated
+				crypto.SetKeySigner(signer)
+				defer signer.Close()
+				keySigner = signer
+			}
+		}
+
 		partdb, err := db.MakeErasableAccessor(partKeyfile)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Cannot open partkey database %s: %v\n", partKeyfile, err)
@@ -82,7 +104,7 @@ var partGenerateCmd = &cobra.Command{
 
 		var partkey account.PersistedParticipation
 		participationGen := func() {
-			partkey, err = account.FillDBWithParticipationKeys(partdb, parent, basics.Round(partFirstRound), basics.Round(partLastRound), partKeyDilution)
+			partkey, err = account.FillDBWithParticipationKeysWithSigner(partdb, parent, basics.Round(partFirstRound), basics.Round(partLastRound), partKeyDilution, keySigner)
 		}
 
 		util.RunFuncWithSpinningCursor(participationGen)
@@ -190,6 +212,7 @@ func init() {
 	partGenerateCmd.Flags().Uint64Var(&partLastRound, "last", 0, "Last round for participation key")
 	partGenerateCmd.Flags().Uint64Var(&partKeyDilution, "dilution", 0, "Key dilution for two-level participation keys (defaults to sqrt of validity window)")
 	partGenerateCmd.Flags().StringVar(&partParent, "parent", "", "Address of parent account")
+	partGenerateCmd.Flags().StringVarP(&partDataDir, "datadir", "d", "", "Data directory of the node the key is generated for, whose ParticipationKeySignerProvider holds the voting keys")
 	partGenerateCmd.MarkFlagRequired("first")
 	partGenerateCmd.MarkFlagRequired("last")
 	partGenerateCmd.MarkFlagRequired("keyfile")
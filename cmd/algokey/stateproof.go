@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util"
+)
+
+var stateProofKeyfile string
+var stateProofOutfile string
+var stateProofSeed string
+var stateProofFirstRound uint64
+var stateProofLastRound uint64
+var stateProofKeyLifetime uint64
+var stateProofSignRound uint64
+var stateProofMsgfile string
+
+var stateProofCmd = &cobra.Command{
+	Use:   "stateproof",
+	Short: "Manage state proof keys",
+	Long:  "Manage Falcon state proof keys independently of participation keys. Keys are derived from a seed and stored as a JSON backup holding the seed, the validity range, the key lifetime and the resulting commitment.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var stateProofGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate state proof keys",
+	Long:  "Generate state proof keys for the given rounds. The keys are derived from --seed (base64, 48 bytes) when given, otherwise from a random seed.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		if stateProofLastRound < stateProofFirstRound {
+			fmt.Fprintf(os.Stderr, "Last round %d < first round %d\n", stateProofLastRound, stateProofFirstRound)
+			os.Exit(1)
+		}
+
+		var seed crypto.FalconSeed
+		if stateProofSeed != "" {
+			seedbytes, err := base64.StdEncoding.DecodeString(stateProofSeed)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Cannot decode seed: %v\n", err)
+				os.Exit(1)
+			}
+			if len(seedbytes) != len(seed) {
+				fmt.Fprintf(os.Stderr, "Seed has %d bytes, expected %d\n", len(seedbytes), len(seed))
+				os.Exit(1)
+			}
+			copy(seed[:], seedbytes)
+		} else {
+			crypto.RandBytes(seed[:])
+		}
+
+		backup := generateStateProofKeys(seed, stateProofFirstRound, stateProofLastRound, stateProofKeyLifetime)
+		writeStateProofBackup(stateProofOutfile, backup)
+		printStateProofBackup(backup)
+	},
+}
+
+var stateProofInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Print state proof key information",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		printStateProofBackup(loadStateProofBackup(stateProofKeyfile))
+	},
+}
+
+var stateProofRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Generate state proof keys from a fresh seed, taking over from existing keys",
+	Long:  "Generate state proof keys from a fresh random seed. Unless given, the first round is the round after the existing keys expire and the validity range and key lifetime match the existing keys.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		old := loadStateProofBackup(stateProofKeyfile)
+
+		first := old.LastValid + 1
+		if cmd.Flags().Changed("first") {
+			first = stateProofFirstRound
+		}
+		last := first + (old.LastValid - old.FirstValid)
+		if cmd.Flags().Changed("last") {
+			last = stateProofLastRound
+		}
+		if last < first {
+			fmt.Fprintf(os.Stderr, "Last round %d < first round %d\n", last, first)
+			os.Exit(1)
+		}
+		lifetime := old.KeyLifetime
+		if cmd.Flags().Changed("lifetime") {
+			lifetime = stateProofKeyLifetime
+		}
+
+		var seed crypto.FalconSeed
+		crypto.RandBytes(seed[:])
+
+		backup := generateStateProofKeys(seed, first, last, lifetime)
+		writeStateProofBackup(stateProofOutfile, backup)
+		printStateProofBackup(backup)
+	},
+}
+
+var stateProofSignCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "Sign a message with the state proof key of a round",
+	Long:  "Sign the contents of a file with the state proof key valid for the given round. The msgpack encoded signature is written to the output file.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		backup := loadStateProofBackup(stateProofKeyfile)
+		secrets := restoreStateProofSecrets(backup)
+
+		msg, err := os.ReadFile(stateProofMsgfile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read message from %s: %v\n", stateProofMsgfile, err)
+			os.Exit(1)
+		}
+
+		signer := secrets.GetSigner(stateProofSignRound)
+		if signer.SigningKey == nil {
+			fmt.Fprintf(os.Stderr, "No state proof key for round %d\n", stateProofSignRound)
+			os.Exit(1)
+		}
+		sig, err := signer.SignBytes(msg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot sign message: %v\n", err)
+			os.Exit(1)
+		}
+
+		err = os.WriteFile(stateProofOutfile, protocol.Encode(&sig), 0600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot write signature to %s: %v\n", stateProofOutfile, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func generateStateProofKeys(seed crypto.FalconSeed, first, last, lifetime uint64) *merklesignature.KeysBackup {
+	fmt.Println("Please stand by while generating keys. This might take a few minutes...")
+
+	var backup *merklesignature.KeysBackup
+	var err error
+	util.RunFuncWithSpinningCursor(func() {
+		_, backup, err = merklesignature.NewWithBackup(seed, first, last, lifetime)
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot generate state proof keys: %v\n", err)
+		os.Exit(1)
+	}
+	return backup
+}
+
+func restoreStateProofSecrets(backup *merklesignature.KeysBackup) *merklesignature.Secrets {
+	var secrets *merklesignature.Secrets
+	var err error
+	util.RunFuncWithSpinningCursor(func() {
+		secrets, err = backup.Restore()
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot restore state proof keys: %v\n", err)
+		os.Exit(1)
+	}
+	return secrets
+}
+
+func loadStateProofBackup(keyfile string) *merklesignature.KeysBackup {
+	data, err := os.ReadFile(keyfile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot read state proof keys from %s: %v\n", keyfile, err)
+		os.Exit(1)
+	}
+	var backup merklesignature.KeysBackup
+	err = json.Unmarshal(data, &backup)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot decode state proof keys from %s: %v\n", keyfile, err)
+		os.Exit(1)
+	}
+	return &backup
+}
+
+func writeStateProofBackup(keyfile string, backup *merklesignature.KeysBackup) {
+	data, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot encode state proof keys: %v\n", err)
+		os.Exit(1)
+	}
+	err = os.WriteFile(keyfile, append(data, '\n'), 0600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot write state proof keys to %s: %v\n", keyfile, err)
+		os.Exit(1)
+	}
+}
+
+func printStateProofBackup(backup *merklesignature.KeysBackup) {
+	fmt.Printf("State proof key:          %s\n", base64.StdEncoding.EncodeToString(backup.Commitment))
+	fmt.Printf("State proof key lifetime: %d\n", backup.KeyLifetime)
+	fmt.Printf("First round:              %d\n", backup.FirstValid)
+	fmt.Printf("Last round:               %d\n", backup.LastValid)
+	fmt.Printf("Backup format version:    %d\n", backup.Version)
+}
+
+func init() {
+	stateProofCmd.AddCommand(stateProofGenerateCmd)
+	stateProofCmd.AddCommand(stateProofInfoCmd)
+	stateProofCmd.AddCommand(stateProofRotateCmd)
+	stateProofCmd.AddCommand(stateProofSignCmd)
+
+	stateProofGenerateCmd.Flags().StringVar(&stateProofOutfile, "outfile", "", "State proof keys output filename")
+	stateProofGenerateCmd.Flags().StringVar(&stateProofSeed, "seed", "", "Base64 encoded 48 bytes seed to derive the keys from (random if not set)")
+	stateProofGenerateCmd.Flags().Uint64Var(&stateProofFirstRound, "first", 0, "First round for the state proof keys")
+	stateProofGenerateCmd.Flags().Uint64Var(&stateProofLastRound, "last", 0, "Last round for the state proof keys")
+	stateProofGenerateCmd.Flags().Uint64Var(&stateProofKeyLifetime, "lifetime", merklesignature.KeyLifetimeDefault, "Number of rounds each state proof key is valid for")
+	stateProofGenerateCmd.MarkFlagRequired("outfile")
+	stateProofGenerateCmd.MarkFlagRequired("first")
+	stateProofGenerateCmd.MarkFlagRequired("last")
+
+	stateProofInfoCmd.Flags().StringVar(&stateProofKeyfile, "keyfile", "", "State proof keys filename")
+	stateProofInfoCmd.MarkFlagRequired("keyfile")
+
+	stateProofRotateCmd.Flags().StringVar(&stateProofKeyfile, "keyfile", "", "Existing state proof keys filename")
+	stateProofRotateCmd.Flags().StringVar(&stateProofOutfile, "outfile", "", "New state proof keys output filename")
+	stateProofRotateCmd.Flags().Uint64Var(&stateProofFirstRound, "first", 0, "First round for the new keys (defaults to the round after the existing keys expire)")
+	stateProofRotateCmd.Flags().Uint64Var(&stateProofLastRound, "last", 0, "Last round for the new keys (defaults to the validity length of the existing keys)")
+	stateProofRotateCmd.Flags().Uint64Var(&stateProofKeyLifetime, "lifetime", 0, "Number of rounds each new key is valid for (defaults to the existing key lifetime)")
+	stateProofRotateCmd.MarkFlagRequired("keyfile")
+	stateProofRotateCmd.MarkFlagRequired("outfile")
+
+	stateProofSignCmd.Flags().StringVar(&stateProofKeyfile, "keyfile", "", "State proof keys filename")
+	stateProofSignCmd.Flags().Uint64Var(&stateProofSignRound, "round", 0, "Round whose state proof key signs the message")
+	stateProofSignCmd.Flags().StringVar(&stateProofMsgfile, "msgfile", "", "Message input filename")
+	stateProofSignCmd.Flags().StringVar(&stateProofOutfile, "outfile", "", "Signature output filename")
+	stateProofSignCmd.MarkFlagRequired("keyfile")
+	stateProofSignCmd.MarkFlagRequired("round")
+	stateProofSignCmd.MarkFlagRequired("msgfile")
+	stateProofSignCmd.MarkFlagRequired("outfile")
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+var vrfKeyfile string
+var vrfPubkeyfile string
+var vrfSeed string
+var vrfPubkey string
+var vrfProof string
+var vrfMessage string
+var vrfMsgfile string
+
+var vrfCmd = &cobra.Command{
+	Use:   "vrf",
+	Short: "Evaluate and verify VRF proofs",
+	Long:  "Generate VRF keys, and evaluate or verify VRF proofs. Together with the sortition details served by algod (GET /v2/blocks/{round}/sortition), this allows auditing the committee selection of past rounds.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var vrfGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate a VRF key",
+	Long:  "Generate a VRF key. The key is derived from --seed (base64, 32 bytes) when given, otherwise from a random seed. The seed is written to the key file.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		var seed crypto.Seed
+		if vrfSeed != "" {
+			copy(seed[:], decodeVrfArg("seed", vrfSeed, len(seed)))
+		} else {
+			crypto.RandBytes(seed[:])
+		}
+
+		pk, _ := crypto.VrfKeygenFromSeed(seed)
+		writePrivateKey(vrfKeyfile, seed)
+		encoded := base64.StdEncoding.EncodeToString(pk[:])
+		if vrfPubkeyfile != "" {
+			writePublicKey(vrfPubkeyfile, encoded)
+		}
+		fmt.Printf("VRF public key: %s\n", encoded)
+	},
+}
+
+var vrfProveCmd = &cobra.Command{
+	Use:   "prove",
+	Short: "Evaluate the VRF on a message",
+	Long:  "Compute the VRF proof and output of a message with the given key. The message is used as is, without domain separation.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		_, sk := crypto.VrfKeygenFromSeed(loadKeyfile(vrfKeyfile))
+		msg := loadVrfMessage()
+
+		proof, ok := sk.ProveBytes(msg)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Cannot compute VRF proof: malformed key\n")
+			os.Exit(1)
+		}
+		output, ok := proof.Hash()
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Cannot compute VRF output\n")
+			os.Exit(1)
+		}
+		fmt.Printf("Proof:  %s\n", base64.StdEncoding.EncodeToString(proof[:]))
+		fmt.Printf("Output: %s\n", base64.StdEncoding.EncodeToString(output[:]))
+	},
+}
+
+var vrfVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify a VRF proof",
+	Long:  "Verify a VRF proof of a message against a VRF public key, and print the VRF output. Exits with an error if the proof is invalid.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		var pk crypto.VrfPubkey
+		copy(pk[:], decodeVrfArg("public key", vrfPubkey, len(pk)))
+		var proof crypto.VrfProof
+		copy(proof[:], decodeVrfArg("proof", vrfProof, len(proof)))
+		msg := loadVrfMessage()
+
+		ok, output := pk.VerifyBytes(proof, msg)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "VRF proof is invalid\n")
+			os.Exit(1)
+		}
+		fmt.Printf("Output: %s\n", base64.StdEncoding.EncodeToString(output[:]))
+	},
+}
+
+func decodeVrfArg(name string, arg string, size int) []byte {
+	data, err := base64.StdEncoding.DecodeString(arg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot decode %s: %v\n", name, err)
+		os.Exit(1)
+	}
+	if len(data) != size {
+		fmt.Fprintf(os.Stderr, "The %s has %d bytes, expected %d\n", name, len(data), size)
+		os.Exit(1)
+	}
+	return data
+}
+
+func loadVrfMessage() []byte {
+	if (vrfMessage == "") == (vrfMsgfile == "") {
+		fmt.Fprintf(os.Stderr, "Must specify exactly one of message or msgfile\n")
+		os.Exit(1)
+	}
+	if vrfMsgfile != "" {
+		msg, err := readFile(vrfMsgfile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read message from %s: %v\n", vrfMsgfile, err)
+			os.Exit(1)
+		}
+		return msg
+	}
+	msg, err := base64.StdEncoding.DecodeString(vrfMessage)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot decode message: %v\n", err)
+		os.Exit(1)
+	}
+	return msg
+}
+
+func init() {
+	vrfCmd.AddCommand(vrfGenerateCmd)
+	vrfCmd.AddCommand(vrfProveCmd)
+	vrfCmd.AddCommand(vrfVerifyCmd)
+
+	vrfGenerateCmd.Flags().StringVarP(&vrfKeyfile, "keyfile", "f", "", "VRF key seed output filename")
+	vrfGenerateCmd.Flags().StringVarP(&vrfPubkeyfile, "pubkeyfile", "p", "", "VRF public key output filename")
+	vrfGenerateCmd.Flags().StringVar(&vrfSeed, "seed", "", "Base64 encoded 32 bytes seed to derive the key from (random if not set)")
+	vrfGenerateCmd.MarkFlagRequired("keyfile")
+
+	vrfProveCmd.Flags().StringVarP(&vrfKeyfile, "keyfile", "k", "", "VRF key seed filename")
+	vrfProveCmd.Flags().StringVarP(&vrfMessage, "message", "m", "", "Base64 encoded message")
+	vrfProveCmd.Flags().StringVar(&vrfMsgfile, "msgfile", "", "Message input filename (use - for stdin)")
+	vrfProveCmd.MarkFlagRequired("keyfile")
+
+	vrfVerifyCmd.Flags().StringVar(&vrfPubkey, "pk", "", "Base64 encoded VRF public key")
+	vrfVerifyCmd.Flags().StringVar(&vrfProof, "proof", "", "Base64 encoded VRF proof")
+	vrfVerifyCmd.Flags().StringVarP(&vrfMessage, "message", "m", "", "Base64 encoded message, such as the vrf-message of the sortition details of a block")
+	vrfVerifyCmd.Flags().StringVar(&vrfMsgfile, "msgfile", "", "Message input filename (use - for stdin)")
+	vrfVerifyCmd.MarkFlagRequired("pk")
+	vrfVerifyCmd.MarkFlagRequired("proof")
+}
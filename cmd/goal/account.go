@@ -256,7 +256,7 @@ var accountCmd = &cobra.Command{
 			// Set the account with this name to be default
 			accountList.setDefault(defaultAccountName)
 			reportInfof(infoSetAccountToDefault, defaultAccountName)
-			os.Exit(0)
+			exit(0)
 		}
 
 		// Return the help text
@@ -503,7 +503,7 @@ var listCmd = &cobra.Command{
 		// Special response if there are no addresses
 		if len(addrs) == 0 {
 			reportInfoln(infoNoAccounts)
-			os.Exit(0)
+			exit(0)
 		}
 
 		accountInfoError := false
@@ -533,7 +533,7 @@ var listCmd = &cobra.Command{
 		}
 
 		if accountInfoError {
-			os.Exit(1)
+			exit(1)
 		}
 	},
 }
@@ -553,7 +553,7 @@ var infoCmd = &cobra.Command{
 
 		hasError := printAccountInfo(client, accountAddress, onlyShowAssetIds, response)
 		if hasError {
-			os.Exit(1)
+			exit(1)
 		}
 	},
 }
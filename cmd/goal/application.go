@@ -51,6 +51,8 @@ var (
 
 	method           string
 	methodArgs       []string
+	contractFile     string
+	prettyReturn     bool
 	methodCreatesApp bool
 
 	approvalProgRawFile string
@@ -125,7 +127,9 @@ func init() {
 	updateAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to send update transaction from")
 	methodAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to call method from")
 
-	methodAppCmd.Flags().StringVar(&method, "method", "", "Method to be called")
+	methodAppCmd.Flags().StringVar(&method, "method", "", "Method to be called, as a signature, or as a name with --contract")
+	methodAppCmd.Flags().StringVar(&contractFile, "contract", "", "ARC-4 contract description (contract.json) of the application, used to look up methods by name and the application ID of the network")
+	methodAppCmd.Flags().BoolVar(&prettyReturn, "pretty", false, "Pretty-print the decoded return value, with its description from --contract")
 	methodAppCmd.Flags().StringArrayVar(&methodArgs, "arg", nil, "Args to pass in for calling a method")
 	methodAppCmd.Flags().StringVar(&onCompletion, "on-completion", "NoOp", "OnCompletion action for application transaction")
 	methodAppCmd.Flags().BoolVar(&methodCreatesApp, "create", false, "Create an application in this method call")
@@ -1266,6 +1270,34 @@ var methodAppCmd = &cobra.Command{
 
 		onCompletionEnum := mustParseOnCompletion(onCompletion)
 
+		// Look up the method, and the application if none is given, in the
+		// contract description
+		var contractMethod *arc4Method
+		if contractFile != "" {
+			data, err := readFile(contractFile)
+			if err != nil {
+				reportErrorf(fileReadError, contractFile, err)
+			}
+			contract, err := parseARC4Contract(data)
+			if err != nil {
+				reportErrorf("cannot parse contract %s: %v", contractFile, err)
+			}
+			m, err := contract.method(method)
+			if err != nil {
+				reportErrorf("%v", err)
+			}
+			contractMethod = &m
+			method = m.signature()
+
+			if appIdx == 0 && !methodCreatesApp {
+				params, err := client.SuggestedParams()
+				if err != nil {
+					reportErrorf(errorNodeStatus, err)
+				}
+				appIdx = contract.appID(params.GenesisHash)
+			}
+		}
+
 		if methodCreatesApp {
 			if appIdx != 0 {
 				reportErrorf("--app-id and --create are mutually exclusive, only provide one")
@@ -1316,6 +1348,9 @@ var methodAppCmd = &cobra.Command{
 		}
 
 		if len(methodArgs) != len(argTypes) {
+			if contractMethod != nil {
+				reportErrorf("incorrect number of arguments, method expected %d (%s) but got %d", len(argTypes), contractMethod.argNames(), len(methodArgs))
+			}
 			reportErrorf("incorrect number of arguments, method expected %d but got %d", len(argTypes), len(methodArgs))
 		}
 
@@ -1512,7 +1547,21 @@ var methodAppCmd = &cobra.Command{
 				reportErrorf("method %s succeed but its return value could not be converted to JSON.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
 			}
 
-			reportInfof("method %s succeeded with output: %s", method, string(decodedJSON))
+			if !prettyReturn {
+				reportInfof("method %s succeeded with output: %s", method, string(decodedJSON))
+				return
+			}
+
+			var indented bytes.Buffer
+			err = json.Indent(&indented, decodedJSON, "", "  ")
+			if err != nil {
+				reportErrorf("method %s succeed but its return value could not be formatted: %s", method, err)
+			}
+			reportInfof("method %s succeeded with output:", method)
+			if contractMethod != nil && contractMethod.Returns.Desc != "" {
+				reportInfof("%s (%s)", contractMethod.Returns.Desc, retTypeStr)
+			}
+			reportInfoln(indented.String())
 		}
 	},
 }
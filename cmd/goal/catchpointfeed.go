@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// maxCatchpointFeedBytes bounds the size of the catchpoint feeds fetched.
+const maxCatchpointFeedBytes = 1 << 20
+
+var catchpointFeedKeyFile string
+var catchpointFeedFile string
+var catchpointFeedOutFile string
+var catchpointFeedMaxLabels int
+var catchpointFeedCosign bool
+
+func init() {
+	nodeCmd.AddCommand(catchpointFeedCmd)
+
+	catchpointFeedCmd.Flags().StringVarP(&catchpointFeedKeyFile, "keyfile", "k", "", "Private key file of the publisher, as written by algokey generate")
+	catchpointFeedCmd.Flags().StringVarP(&catchpointFeedFile, "feed", "f", "", "Previous feed, whose labels are listed after the latest catchpoint of the node")
+	catchpointFeedCmd.Flags().StringVarP(&catchpointFeedOutFile, "outfile", "o", "", "Filename of the signed feed (default: standard output)")
+	catchpointFeedCmd.Flags().IntVar(&catchpointFeedMaxLabels, "max-labels", 10, "Maximum number of labels listed by the feed")
+	catchpointFeedCmd.Flags().BoolVar(&catchpointFeedCosign, "cosign", false, "Add a signature to the previous feed as is, without querying the node")
+	catchpointFeedCmd.MarkFlagRequired("keyfile")
+}
+
+var catchpointFeedCmd = &cobra.Command{
+	Use:   "catchpoint-feed",
+	Short: "Produce a signed catchpoint feed from an archival node",
+	Long:  "Produce a signed catchpoint feed listing the latest catchpoint of an archival node, followed by the labels of the previous feed. Nodes configured with the feed in CatchpointFeedURL, and with the address of the key in CatchpointFeedPublishers, verify the feed before catching up to its latest label with goal node catchup. They refuse feeds issued more than two days ago, so the feed has to be produced again regularly. With --cosign, another publisher adds its signature to an existing feed.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		seed, err := os.ReadFile(catchpointFeedKeyFile)
+		if err != nil {
+			reportErrorf("Cannot read the key file %s: %v", catchpointFeedKeyFile, err)
+		}
+		var keySeed crypto.Seed
+		if len(seed) != len(keySeed) {
+			reportErrorf("The key file %s holds %d bytes, expected %d", catchpointFeedKeyFile, len(seed), len(keySeed))
+		}
+		copy(keySeed[:], seed)
+
+		var previous ledgercore.SignedCatchpointFeed
+		if catchpointFeedFile != "" {
+			data, err := os.ReadFile(catchpointFeedFile)
+			if err != nil {
+				reportErrorf("Cannot read the feed %s: %v", catchpointFeedFile, err)
+			}
+			err = protocol.DecodeJSON(data, &previous)
+			if err != nil {
+				reportErrorf("Cannot parse the feed %s: %v", catchpointFeedFile, err)
+			}
+		}
+
+		var feed ledgercore.SignedCatchpointFeed
+		if catchpointFeedCosign {
+			if catchpointFeedFile == "" {
+				reportErrorf("--cosign requires the feed to sign with --feed")
+			}
+			feed = previous
+		} else {
+			dataDir := datadir.EnsureSingleDataDir()
+			feed.Feed = makeCatchpointFeed(dataDir, previous.Feed)
+		}
+		feed.Sign(crypto.GenerateSignatureSecrets(keySeed))
+
+		data := protocol.EncodeJSON(&feed)
+		if catchpointFeedOutFile == "" {
+			fmt.Println(string(data))
+			return
+		}
+		err = os.WriteFile(catchpointFeedOutFile, data, 0644)
+		if err != nil {
+			reportErrorf("Cannot write the feed %s: %v", catchpointFeedOutFile, err)
+		}
+		reportInfof("Wrote the catchpoint feed %s listing %d labels, the latest %s", catchpointFeedOutFile, len(feed.Feed.Labels), feed.Feed.Labels[0])
+	},
+}
+
+// makeCatchpointFeed lists the latest catchpoint of the node of dataDir, followed by the labels of
+// the previous feed of the same network.
+func makeCatchpointFeed(dataDir string, previous ledgercore.CatchpointFeed) ledgercore.CatchpointFeed {
+	client := ensureAlgodClient(dataDir)
+	vers, err := client.AlgodVersions()
+	if err != nil {
+		reportErrorf(errorNodeStatus, err)
+	}
+	stat, err := client.Status()
+	if err != nil {
+		reportErrorf(errorNodeStatus, err)
+	}
+	if stat.LastCatchpoint == nil || *stat.LastCatchpoint == "" {
+		reportErrorf("The node has not generated a catchpoint yet; catchpoints are generated by archival nodes")
+	}
+
+	feed := ledgercore.CatchpointFeed{
+		GenesisID: vers.GenesisID,
+		Issued:    time.Now().Unix(),
+		Labels:    []string{*stat.LastCatchpoint},
+	}
+	copy(feed.GenesisHash[:], vers.GenesisHash)
+	if previous.GenesisID != "" && (previous.GenesisID != feed.GenesisID || previous.GenesisHash != feed.GenesisHash) {
+		reportErrorf("The previous feed is of network %s, while the node is of %s", previous.GenesisID, feed.GenesisID)
+	}
+	for _, label := range previous.Labels {
+		if len(feed.Labels) >= catchpointFeedMaxLabels {
+			break
+		}
+		if label != feed.Labels[0] {
+			feed.Labels = append(feed.Labels, label)
+		}
+	}
+	return feed
+}
+
+// getSignedCatchpointLabel fetches the signed catchpoint feed at URL and returns its latest label, once
+// verified to be a feed of the given network signed by one of the publishers.
+func getSignedCatchpointLabel(URL string, publishers []basics.Address, genesisID string, genesisHash crypto.Digest) (label string, err error) {
+	if len(publishers) == 0 {
+		return "", errors.New("CatchpointFeedPublishers lists no publisher to verify the catchpoint feed with")
+	}
+	resp, err := http.Get(URL)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		err = errors.New(resp.Status)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCatchpointFeedBytes))
+	if err != nil {
+		return
+	}
+
+	var feed ledgercore.SignedCatchpointFeed
+	err = protocol.DecodeJSON(body, &feed)
+	if err != nil {
+		return
+	}
+	err = feed.Verify(genesisID, genesisHash, publishers, time.Now())
+	if err != nil {
+		return
+	}
+	return feed.Feed.Latest()
+}
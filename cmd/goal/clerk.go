@@ -53,6 +53,8 @@ var (
 	closeToAddress     string
 	noProgramOutput    bool
 	writeSourceMap     bool
+	analyzeProgram     bool
+	analyzeOutFilename string
 	signProgram        bool
 	programSource      string
 	argB64Strings      []string
@@ -74,6 +76,15 @@ var (
 	simulateAllowMoreOpcodeBudget bool
 	simulateExtraOpcodeBudget     uint64
 	simulateEnableRequestTrace    bool
+	simulateStackChange           bool
+	simulateScratchChange         bool
+	simulateStateChange           bool
+	simulateSourceMaps            []string
+	simulateCoverageFilename      string
+	simulateProfile               bool
+
+	inspectContractFile string
+	inspectJSON         bool
 )
 
 func init() {
@@ -121,6 +132,11 @@ func init() {
 	signCmd.Flags().StringVarP(&logicSigFile, "logic-sig", "L", "", "LogicSig to apply to transaction")
 	signCmd.Flags().StringSliceVar(&argB64Strings, "argb64", nil, "Base64 encoded args to pass to transaction logic")
 	signCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "Consensus protocol version id string")
+	signCmd.Flags().BoolVar(&signOffline, "offline", false, "Sign an offline bundle or transaction file without contacting kmd or algod, with the key of --keyfile or of a mnemonic")
+	signCmd.Flags().StringVar(&offlineKeyfile, "keyfile", "", "With --offline, file holding the private key seed to sign with, as written by algokey")
+	signCmd.Flags().BoolVar(&offlineUseMnemonic, "mnemonic", false, "With --offline, prompt for the mnemonic of the account to sign with")
+	signCmd.Flags().BoolVar(&offlineQR, "qr", false, "With --offline, write the signed transactions as text frames suitable for QR codes")
+	signCmd.Flags().IntVar(&offlineFrameSize, "qr-frame-size", defaultOfflineFrameSize, "Maximum number of characters of each QR frame")
 	signCmd.MarkFlagRequired("infile")
 	signCmd.MarkFlagRequired("outfile")
 
@@ -140,6 +156,8 @@ func init() {
 	compileCmd.Flags().BoolVarP(&signProgram, "sign", "s", false, "Sign program, output is a binary signed LogicSig record")
 	compileCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename to write program bytes or signed LogicSig to")
 	compileCmd.Flags().StringVarP(&account, "account", "a", "", "Account address to sign the program (If not specified, uses default account)")
+	compileCmd.Flags().BoolVar(&analyzeProgram, "analyze", false, "Check the program for unreachable code, stack depth errors, unchecked logicsig transaction fields and deprecated opcodes, and fail if anything is found")
+	compileCmd.Flags().StringVar(&analyzeOutFilename, "analyze-out", "", "Filename for writing the findings of the static analysis as JSON, implies --analyze")
 
 	dryrunCmd.Flags().StringVarP(&txFilename, "txfile", "t", "", "Transaction or transaction-group to test")
 	dryrunCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "Consensus protocol version id string")
@@ -163,6 +181,15 @@ func init() {
 	simulateCmd.Flags().BoolVar(&simulateAllowMoreOpcodeBudget, "allow-more-opcode-budget", false, "Apply max extra opcode budget for apps per transaction group (default 320000) during simulation")
 	simulateCmd.Flags().Uint64Var(&simulateExtraOpcodeBudget, "extra-opcode-budget", 0, "Apply extra opcode budget for apps per transaction group during simulation")
 	simulateCmd.Flags().BoolVar(&simulateEnableRequestTrace, "trace", false, "Enable simulation time execution trace of app calls")
+	simulateCmd.Flags().BoolVar(&simulateStackChange, "stack", false, "Report stack changes in the execution trace (implies --trace)")
+	simulateCmd.Flags().BoolVar(&simulateScratchChange, "scratch", false, "Report scratch slot changes in the execution trace (implies --trace)")
+	simulateCmd.Flags().BoolVar(&simulateStateChange, "state", false, "Report global, local and box state changes in the execution trace (implies --trace)")
+	simulateCmd.Flags().StringArrayVar(&simulateSourceMaps, "source-map", nil, "Annotate the execution trace of a program with source locations, given as PROGRAM_FILE=SOURCE_MAP_FILE, where PROGRAM_FILE is the compiled program (implies --trace)")
+	simulateCmd.Flags().StringVar(&simulateCoverageFilename, "coverage", "", "Filename for writing an lcov coverage report of the programs evaluated by the simulation, reported against their sources when given with --source-map")
+	simulateCmd.Flags().BoolVar(&simulateProfile, "profile", false, "Report the opcode budget and resources used by each app call, and the headroom left against the limits of the group")
+
+	inspectCmd.Flags().StringVar(&inspectContractFile, "contract", "", "ARC-4 contract description (contract.json) used to decode application call arguments")
+	inspectCmd.Flags().BoolVar(&inspectJSON, "json", false, "Print the decoded objects as JSON, one document per input")
 }
 
 var clerkCmd = &cobra.Command{
@@ -461,6 +488,20 @@ var sendCmd = &cobra.Command{
 			}
 		} else {
 			signTx := sign || (outFilename == "")
+			if signerAddress == "" && msigParams == "" {
+				// Use the signer recorded by goal account rekey, if any
+				if entry, ok := registeredSigner(accountList, client, fromAddressResolved); ok {
+					if entry.Multisig != nil {
+						if signTx {
+							reportErrorf(errorRekeyedToMultisig, fromAddressResolved, entry.AuthAddr)
+						}
+						msigParams = entry.Multisig.params()
+					} else if signTx {
+						signerAddress = entry.AuthAddr
+						reportInfof(infoRekeyRegistrySigner, signerAddress, fromAddressResolved)
+					}
+				}
+			}
 			var authAddr basics.Address
 			if signerAddress != "" {
 				if !signTx {
@@ -551,14 +592,14 @@ var sendCmd = &cobra.Command{
 var rawsendCmd = &cobra.Command{
 	Use:   "rawsend",
 	Short: "Send raw transactions",
-	Long:  `Send raw transactions.  The transactions must be stored in a file, encoded using msgpack as transactions.SignedTxn. Multiple transactions can be concatenated together in a file.`,
+	Long:  `Send raw transactions.  The transactions must be stored in a file, encoded using msgpack as transactions.SignedTxn. Multiple transactions can be concatenated together in a file, which may also hold the QR frames written by "goal clerk sign --offline --qr".`,
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, args []string) {
 		if rejectsFilename == "" {
 			rejectsFilename = txFilename + ".rej"
 		}
 
-		data, err := readFile(txFilename)
+		data, err := readOfflinePayload(txFilename)
 		if err != nil {
 			reportErrorf(fileReadError, txFilename, err)
 		}
@@ -676,39 +717,54 @@ var rawsendCmd = &cobra.Command{
 			f.Close()
 			fmt.Printf("Rejected transactions written to %s\n", rejectsFilename)
 
-			os.Exit(1)
+			exit(1)
 		}
 	},
 }
 
 var inspectCmd = &cobra.Command{
-	Use:   "inspect [input file 1] [input file 2]...",
-	Short: "Print a transaction file",
-	Long:  `Loads a transaction file, attempts to decode the transaction, and displays the decoded information.`,
+	Use:   "inspect [input file or catchpoint label 1] [input 2]...",
+	Short: "Print a transaction, block, certificate or catchpoint label",
+	Long: `Loads a file, attempts to decode it, and displays the decoded information.
+Files may contain signed transactions, a block with or without its certificate (as written by goal ledger block --raw), a certificate, or a catchpoint label. Arguments that are not files are inspected as catchpoint labels.
+With --contract, application calls to methods of the contract have their arguments decoded as ABI values. With --json, each input is printed as a JSON document with a stable structure suitable for tooling.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		for _, txFilename := range args {
-			data, err := readFile(txFilename)
+		var contract *arc4Contract
+		if inspectContractFile != "" {
+			data, err := readFile(inspectContractFile)
 			if err != nil {
-				reportErrorf(fileReadError, txFilename, err)
+				reportErrorf(fileReadError, inspectContractFile, err)
 			}
+			contract, err = parseARC4Contract(data)
+			if err != nil {
+				reportErrorf("cannot parse contract %s: %v", inspectContractFile, err)
+			}
+		}
 
-			dec := protocol.NewMsgpDecoderBytes(data)
-			count := 0
-			for {
-				var txn transactions.SignedTxn
-				err = dec.Decode(&txn)
-				if err == io.EOF {
-					break
-				}
+		for _, input := range args {
+			var data []byte
+			if _, err := os.Stat(input); err != nil && input != stdinFileNameValue {
+				// not a file, try it as a catchpoint label
+				data = []byte(input)
+			} else {
+				data, err = readFile(input)
 				if err != nil {
-					reportErrorf(txDecodeError, txFilename, err)
+					reportErrorf(fileReadError, input, err)
 				}
-				sti, err := inspectTxn(txn)
+			}
+
+			obj, err := inspectData(input, data, contract)
+			if err != nil {
+				reportErrorf(txDecodeError, input, err)
+			}
+			if inspectJSON {
+				out, err := json.MarshalIndent(obj, "", "  ")
 				if err != nil {
-					reportErrorf(txDecodeError, txFilename, err)
+					reportErrorf(txDecodeError, input, err)
 				}
-				fmt.Printf("%s[%d]\n%s\n\n", txFilename, count, string(protocol.EncodeJSON(sti)))
-				count++
+				fmt.Println(string(out))
+			} else {
+				fmt.Print(obj.String())
 			}
 		}
 	},
@@ -748,7 +804,7 @@ func getProto(versArg string) (protocol.ConsensusVersion, config.ConsensusParams
 		for xvers := range config.Consensus {
 			fmt.Fprintf(os.Stderr, "\t%s\n", xvers)
 		}
-		os.Exit(1)
+		exit(1)
 	}
 	return cvers, proto
 }
@@ -759,6 +815,11 @@ var signCmd = &cobra.Command{
 	Long:  `Sign the passed transaction file, which may contain one or more transactions. If the infile and the outfile are the same, this overwrites the file with the new, signed data.`,
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, _ []string) {
+		if signOffline {
+			signOfflineFile()
+			return
+		}
+
 		data, err := readFile(txFilename)
 		if err != nil {
 			reportErrorf(fileReadError, txFilename, err)
@@ -996,9 +1057,38 @@ func assembleFile(fname string, printWarnings bool) (program []byte) {
 	return ops.Program
 }
 
-func assembleFileWithMap(fname string, printWarnings bool) ([]byte, logic.SourceMap) {
+func assembleFileWithMap(fname string, printWarnings bool) (*logic.OpStream, logic.SourceMap) {
 	ops := assembleFileImpl(fname, printWarnings)
-	return ops.Program, logic.GetSourceMap([]string{fname}, ops.OffsetToLine)
+	return ops, logic.GetSourceMap([]string{fname}, ops.OffsetToLine)
+}
+
+// analysisFinding is a finding of the static analysis of a program, as written by --analyze-out
+type analysisFinding struct {
+	File string `json:"file"`
+	logic.Finding
+}
+
+// analyzeProgramFile runs the static analysis over an assembled program, reports its findings as
+// warnings and returns them.
+func analyzeProgramFile(fname string, ops *logic.OpStream) []analysisFinding {
+	mode := logic.ModeSig
+	if ops.HasStatefulOps {
+		mode = logic.ModeApp
+	}
+	findings, err := logic.Analyze(ops, mode)
+	if err != nil {
+		reportErrorf("%s: %s", fname, err)
+	}
+	result := make([]analysisFinding, len(findings))
+	for i, finding := range findings {
+		if finding.Line != 0 {
+			reportWarnRawf("%s:%d: %s: %s", fname, finding.Line, finding.Check, finding.Message)
+		} else {
+			reportWarnRawf("%s: %s: %s", fname, finding.Check, finding.Message)
+		}
+		result[i] = analysisFinding{File: fname, Finding: finding}
+	}
+	return result
 }
 
 func disassembleFile(fname, outname string) {
@@ -1042,6 +1132,7 @@ var compileCmd = &cobra.Command{
 	Short: "Compile a contract program",
 	Long:  "Reads a TEAL contract program and compiles it to binary output and contract address.",
 	Run: func(cmd *cobra.Command, args []string) {
+		findings := make([]analysisFinding, 0)
 		for _, fname := range args {
 			if disassemble {
 				disassembleFile(fname, outFilename)
@@ -1056,7 +1147,11 @@ var compileCmd = &cobra.Command{
 				}
 			}
 			shouldPrintAdditionalInfo := outname != stdoutFilenameValue
-			program, sourceMap := assembleFileWithMap(fname, true)
+			ops, sourceMap := assembleFileWithMap(fname, true)
+			program := ops.Program
+			if analyzeProgram || analyzeOutFilename != "" {
+				findings = append(findings, analyzeProgramFile(fname, ops)...)
+			}
 			outblob := program
 			if signProgram {
 				dataDir := datadir.EnsureSingleDataDir()
@@ -1107,6 +1202,23 @@ var compileCmd = &cobra.Command{
 				fmt.Printf("%s: %s\n", fname, addr.String())
 			}
 		}
+		if analyzeOutFilename != "" {
+			data, err := json.MarshalIndent(findings, "", "  ")
+			if err != nil {
+				reportErrorf("%s: %s", analyzeOutFilename, err)
+			}
+			err = writeFile(analyzeOutFilename, data, 0666)
+			if err != nil {
+				reportErrorf("%s: %s", analyzeOutFilename, err)
+			}
+		}
+		if len(findings) != 0 {
+			plural := "s"
+			if len(findings) == 1 {
+				plural = ""
+			}
+			reportErrorf("static analysis found %d potential problem%s", len(findings), plural)
+		}
 	},
 }
 
@@ -1279,6 +1391,9 @@ var simulateCmd = &cobra.Command{
 				AllowMoreLogging:     simulateAllowMoreLogging,
 				ExtraOpcodeBudget:    simulateExtraOpcodeBudget,
 				ExecTraceConfig:      traceCmdOptionToSimulateTraceConfigModel(),
+				SourceMaps:           sourceMapCmdOptionToSimulateSourceMaps(),
+				Coverage:             simulateCoverageFilename != "",
+				Profile:              simulateProfile,
 			}
 			err := writeFile(requestOutFilename, protocol.EncodeJSON(simulateRequest), 0600)
 			if err != nil {
@@ -1303,6 +1418,9 @@ var simulateCmd = &cobra.Command{
 				AllowMoreLogging:     simulateAllowMoreLogging,
 				ExtraOpcodeBudget:    simulateExtraOpcodeBudget,
 				ExecTraceConfig:      traceCmdOptionToSimulateTraceConfigModel(),
+				SourceMaps:           sourceMapCmdOptionToSimulateSourceMaps(),
+				Coverage:             simulateCoverageFilename != "",
+				Profile:              simulateProfile,
 			}
 			simulateResponse, responseErr = client.SimulateTransactions(simulateRequest)
 		} else {
@@ -1317,6 +1435,13 @@ var simulateCmd = &cobra.Command{
 			reportErrorf("simulation error: %s", responseErr.Error())
 		}
 
+		if simulateCoverageFilename != "" {
+			err := writeFile(simulateCoverageFilename, []byte(simulateResponse.CoverageReport), 0600)
+			if err != nil {
+				reportErrorf("write file error: %s", err.Error())
+			}
+		}
+
 		encodedResponse := protocol.EncodeJSON(&simulateResponse)
 		if outFilename != "" {
 			err := writeFile(outFilename, encodedResponse, 0600)
@@ -1365,6 +1490,41 @@ func decodeTxnsFromFile(file string) []transactions.SignedTxn {
 
 func traceCmdOptionToSimulateTraceConfigModel() simulation.ExecTraceConfig {
 	return simulation.ExecTraceConfig{
-		Enable: simulateEnableRequestTrace,
+		Enable:  simulateEnableRequestTrace || simulateStackChange || simulateScratchChange || simulateStateChange || len(simulateSourceMaps) > 0,
+		Stack:   simulateStackChange,
+		Scratch: simulateScratchChange,
+		State:   simulateStateChange,
+	}
+}
+
+// sourceMapCmdOptionToSimulateSourceMaps reads the --source-map program and source map files,
+// and keys the source maps by the hash of their program.
+func sourceMapCmdOptionToSimulateSourceMaps() []v2.PreEncodedSimulateSourceMap {
+	if len(simulateSourceMaps) == 0 {
+		return nil
+	}
+	sourceMaps := make([]v2.PreEncodedSimulateSourceMap, len(simulateSourceMaps))
+	for i, option := range simulateSourceMaps {
+		programFile, sourceMapFile, ok := strings.Cut(option, "=")
+		if !ok {
+			reportErrorf("--source-map must be of the form PROGRAM_FILE=SOURCE_MAP_FILE, got %s", option)
+		}
+		program, err := readFile(programFile)
+		if err != nil {
+			reportErrorf(fileReadError, programFile, err)
+		}
+		data, err := readFile(sourceMapFile)
+		if err != nil {
+			reportErrorf(fileReadError, sourceMapFile, err)
+		}
+		var sourceMap logic.SourceMap
+		if err := json.Unmarshal(data, &sourceMap); err != nil {
+			reportErrorf("could not decode source map %s: %v", sourceMapFile, err)
+		}
+		sourceMaps[i] = v2.PreEncodedSimulateSourceMap{
+			ProgramHash: basics.Address(logic.HashProgram(program)).String(),
+			SourceMap:   sourceMap,
+		}
 	}
+	return sourceMaps
 }
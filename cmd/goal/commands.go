@@ -92,6 +92,9 @@ func init() {
 	// application.go
 	rootCmd.AddCommand(appCmd)
 
+	// stateproof.go
+	rootCmd.AddCommand(stateProofCmd)
+
 	// Config
 	defaultDataDirValue := []string{""}
 	rootCmd.PersistentFlags().StringArrayVarP(&datadir.DataDirs, "datadir", "d", defaultDataDirValue, "Data directory for the node")
@@ -164,7 +167,7 @@ var versionCmd = &cobra.Command{
 			response, err := ensureAlgodClient(dataDir).AlgodVersions()
 			if err != nil {
 				fmt.Println(err)
-				os.Exit(1)
+				exit(1)
 			}
 			if !verboseVersionPrint {
 				fmt.Println(response.Versions)
@@ -199,7 +202,7 @@ var reportCmd = &cobra.Command{
 		data, err := exec.Command("uname", "-a").CombinedOutput()
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			exit(1)
 		}
 		fmt.Println(string(data))
 
@@ -212,7 +215,7 @@ var reportCmd = &cobra.Command{
 			genesis, err := readGenesis(dir)
 			if err != nil {
 				fmt.Println(err)
-				os.Exit(1)
+				exit(1)
 			}
 			fmt.Printf("Genesis ID from genesis.json: %s\n", genesis.ID())
 		}
@@ -501,11 +504,12 @@ func reportErrorf(format string, args ...interface{}) {
 }
 
 func exit(code int) {
-	if flag.Lookup("test.v") == nil {
+	if flag.Lookup("test.v") == nil && !consoleRunning {
 		// normal run
 		os.Exit(code)
 	} else {
-		// testing run. panic, so we can require.Panic
+		// testing or console run. panic, so we can require.Panic, or the console can
+		// carry on with the next command
 		panic(code)
 	}
 }
@@ -0,0 +1,709 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/libgoal"
+)
+
+// consoleRunning is set while goal console runs a command, so that its exits are
+// recovered by the console instead of terminating it.
+var consoleRunning bool
+
+const (
+	consolePrompt          = "goal> "
+	consoleHistoryFilename = "console_history"
+	consoleVarsFilename    = "console_vars.json"
+	consoleMaxHistory      = 1000
+	// consoleLastVar is the variable holding the last line printed, which is not saved
+	consoleLastVar = "last"
+	// consoleRedacted replaces the values of the secret flags in the history
+	consoleRedacted = "<redacted>"
+)
+
+// consoleSecretFlags are the flags whose values are secrets, which the history does not keep.
+var consoleSecretFlags = map[string]bool{"mnemonic": true, "password": true, "passphrase": true, "token": true}
+
+func init() {
+	rootCmd.AddCommand(consoleCmd)
+}
+
+var consoleCmd = &cobra.Command{
+	Use:   "console",
+	Short: "Run goal commands in an interactive console",
+	Long: `Run goal commands in an interactive console, without the leading "goal". The commands run against the node of the data directory of the console, unless they are given another one with -d.
+Tab completes the commands, their flags, the console variables and the accounts, assets and applications of the accounts of the goal account list, fetched from the node.
+Variables are referenced as $name or ${name} and are kept across sessions, except $last. After each command, the console sets $last to the last line it printed, $txid to the last transaction ID it printed and $addr to the last address it printed, unless the command prints secrets, like account export does.
+The history does not keep the values of the flags passing secrets, like the --mnemonic of account import.
+Built-in commands:
+  set NAME VALUE   set a variable
+  unset NAME       delete a variable
+  vars             list the variables
+  history          list the command history, kept across sessions
+  !N, !!           run command N of the history, or the previous command
+  refresh          refetch the accounts, assets and applications used for completion
+  help             show this help
+  exit, quit       leave the console`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		session := makeConsoleSession(dataDir, ensureCacheDir(dataDir))
+		session.run()
+	},
+}
+
+// consoleSession holds the state of an interactive console.
+type consoleSession struct {
+	dataDir     string
+	historyFile string
+	varsFile    string
+	history     []string
+	vars        map[string]string
+	completions consoleCompletions
+}
+
+// consoleCompletions holds the values fetched from the node to complete command arguments.
+type consoleCompletions struct {
+	loaded    bool
+	addresses []string
+	assets    []string
+	apps      []string
+}
+
+func makeConsoleSession(dataDir string, cacheDir string) *consoleSession {
+	s := &consoleSession{
+		dataDir:     dataDir,
+		historyFile: filepath.Join(cacheDir, consoleHistoryFilename),
+		varsFile:    filepath.Join(cacheDir, consoleVarsFilename),
+		vars:        make(map[string]string),
+	}
+	if data, err := os.ReadFile(s.historyFile); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			if line != "" {
+				s.history = append(s.history, line)
+			}
+		}
+	}
+	if data, err := os.ReadFile(s.varsFile); err == nil {
+		if err := json.Unmarshal(data, &s.vars); err != nil {
+			reportWarnf(warnConsoleVars, s.varsFile, err)
+		}
+		// older versions kept $last as well
+		delete(s.vars, consoleLastVar)
+	}
+	return s
+}
+
+func (s *consoleSession) run() {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if !s.handle(scanner.Text()) {
+				return
+			}
+		}
+		return
+	}
+
+	t := terminal.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, consolePrompt)
+	t.AutoCompleteCallback = s.complete
+	reportInfoln(infoConsoleWelcome)
+	for {
+		if width, height, err := terminal.GetSize(fd); err == nil {
+			t.SetSize(width, height)
+		}
+		state, err := terminal.MakeRaw(fd)
+		if err != nil {
+			reportErrorf(errorConsoleTerminal, err)
+		}
+		line, err := t.ReadLine()
+		terminal.Restore(fd, state)
+		if err == io.EOF {
+			fmt.Println()
+			return
+		}
+		if err != nil {
+			reportErrorf(errorConsoleTerminal, err)
+		}
+		if !s.handle(line) {
+			return
+		}
+	}
+}
+
+// handle runs a line of input, and returns false once the console should exit.
+func (s *consoleSession) handle(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return true
+	}
+
+	if strings.HasPrefix(line, "!") {
+		recalled, err := s.recall(line)
+		if err != nil {
+			reportWarnRawln(err)
+			return true
+		}
+		fmt.Println(recalled)
+		line = recalled
+	}
+	s.addHistory(line)
+
+	words, err := splitConsoleLine(line, s.vars)
+	if err != nil {
+		reportWarnRawln(err)
+		return true
+	}
+	if words[0] == "goal" && len(words) > 1 {
+		words = words[1:]
+	}
+
+	switch words[0] {
+	case "exit", "quit":
+		return false
+	case "help":
+		fmt.Println(consoleCmd.Long)
+	case "set":
+		if len(words) < 3 || !consoleVarName.MatchString(words[1]) {
+			reportWarnRawln(errorConsoleSetUsage)
+			break
+		}
+		s.vars[words[1]] = strings.Join(words[2:], " ")
+		s.saveVars()
+	case "unset":
+		if len(words) != 2 {
+			reportWarnRawln(errorConsoleUnsetUsage)
+			break
+		}
+		delete(s.vars, words[1])
+		s.saveVars()
+	case "vars":
+		names := make([]string, 0, len(s.vars))
+		for name := range s.vars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("%s=%s\n", name, s.vars[name])
+		}
+	case "history":
+		for i, entry := range s.history {
+			fmt.Printf("%5d  %s\n", i+1, entry)
+		}
+	case "refresh":
+		s.completions = consoleCompletions{}
+		s.loadCompletions()
+	case consoleCmd.Name():
+		reportWarnRawln(errorConsoleNested)
+	default:
+		output := s.runCommand(words)
+		if !printsSecrets(words) {
+			captureConsoleVars(output, s.vars)
+			s.saveVars()
+		}
+	}
+	return true
+}
+
+// recall returns the history entry referenced by a !N or !! line.
+func (s *consoleSession) recall(line string) (string, error) {
+	if len(s.history) == 0 {
+		return "", errors.New(errorConsoleNoHistory)
+	}
+	if line == "!!" {
+		return s.history[len(s.history)-1], nil
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < 1 || n > len(s.history) {
+		return "", fmt.Errorf(errorConsoleHistoryEntry, line)
+	}
+	return s.history[n-1], nil
+}
+
+func (s *consoleSession) addHistory(line string) {
+	line = redactConsoleLine(line)
+	if len(s.history) > 0 && s.history[len(s.history)-1] == line {
+		return
+	}
+	s.history = append(s.history, line)
+	if len(s.history) > consoleMaxHistory {
+		s.history = s.history[len(s.history)-consoleMaxHistory:]
+	}
+	err := os.WriteFile(s.historyFile, []byte(strings.Join(s.history, "\n")+"\n"), 0600)
+	if err != nil {
+		reportWarnf(warnConsoleHistory, s.historyFile, err)
+	}
+}
+
+// saveVars writes the variables to the variables file, but $last, which may hold anything
+// a command printed.
+func (s *consoleSession) saveVars() {
+	vars := make(map[string]string, len(s.vars))
+	for name, value := range s.vars {
+		if name != consoleLastVar {
+			vars[name] = value
+		}
+	}
+	data, err := json.MarshalIndent(vars, "", "  ")
+	if err == nil {
+		err = os.WriteFile(s.varsFile, data, 0600)
+	}
+	if err != nil {
+		reportWarnf(warnConsoleVars, s.varsFile, err)
+	}
+}
+
+// runCommand runs a goal command, and returns what it printed to stdout.
+func (s *consoleSession) runCommand(words []string) (output []byte) {
+	args := words
+	if !hasDataDirFlag(words) {
+		args = append([]string{"-d", s.dataDir}, words...)
+	}
+	resetCommandFlags(rootCmd)
+	rootCmd.SetArgs(args)
+
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		reportWarnRawln(err)
+		return nil
+	}
+	var buf bytes.Buffer
+	copied := make(chan struct{})
+	go func() {
+		io.Copy(io.MultiWriter(stdout, &buf), r)
+		close(copied)
+	}()
+	os.Stdout = w
+	restore := func() {
+		os.Stdout = stdout
+		w.Close()
+		<-copied
+		r.Close()
+		output = buf.Bytes()
+	}
+	consoleRunning = true
+	defer func() {
+		consoleRunning = false
+		if rec := recover(); rec != nil {
+			// the command exited; anything else is a genuine panic
+			if _, ok := rec.(int); !ok {
+				restore()
+				panic(rec)
+			}
+		}
+		restore()
+	}()
+
+	rootCmd.Execute()
+	return
+}
+
+// hasDataDirFlag returns whether the words of a command set its data directory.
+func hasDataDirFlag(words []string) bool {
+	for _, word := range words {
+		if word == "-d" || word == "--datadir" || strings.HasPrefix(word, "-d=") || strings.HasPrefix(word, "--datadir=") {
+			return true
+		}
+	}
+	return false
+}
+
+// resetCommandFlags restores the flags of cmd and its subcommands to their defaults, since
+// their values are kept in package variables which would otherwise carry over between commands.
+func resetCommandFlags(cmd *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			// a slice flag replaces its value on its first Set only; clear it so that
+			// the values given to the next command are not appended to the previous ones
+			if f.Changed {
+				sv.Replace(nil)
+			}
+		} else {
+			f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	cmd.Flags().VisitAll(reset)
+	cmd.PersistentFlags().VisitAll(reset)
+	for _, sub := range cmd.Commands() {
+		resetCommandFlags(sub)
+	}
+}
+
+var (
+	consoleVarName   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	consoleVarRef    = regexp.MustCompile(`^\$(?:\{([A-Za-z_][A-Za-z0-9_]*)\}|([A-Za-z_][A-Za-z0-9_]*))`)
+	consoleTxIDRegex = regexp.MustCompile(`\b[A-Z2-7]{52}\b`)
+	consoleAddrRegex = regexp.MustCompile(`\b[A-Z2-7]{58}\b`)
+)
+
+// splitConsoleLine splits a console line into words, honoring single and double quotes
+// and backslash escapes, and expanding the variable references outside of single quotes.
+func splitConsoleLine(line string, vars map[string]string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				word.WriteByte(c)
+			}
+		case c == '\\' && i+1 < len(line):
+			i++
+			word.WriteByte(line[i])
+			inWord = true
+		case c == '$':
+			m := consoleVarRef.FindStringSubmatch(line[i:])
+			if m == nil {
+				word.WriteByte(c)
+				inWord = true
+				break
+			}
+			name := m[1] + m[2]
+			value, ok := vars[name]
+			if !ok {
+				return nil, fmt.Errorf(errorConsoleUnknownVar, name)
+			}
+			word.WriteString(value)
+			inWord = true
+			i += len(m[0]) - 1
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			} else {
+				word.WriteByte(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inWord = true
+		case c == ' ' || c == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New(errorConsoleUnterminatedQuote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	if len(words) == 0 {
+		return nil, errors.New(errorConsoleEmptyLine)
+	}
+	return words, nil
+}
+
+// captureConsoleVars sets the automatic variables from the output of a command.
+func captureConsoleVars(output []byte, vars map[string]string) {
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+		vars[consoleLastVar] = last
+	}
+	if txids := consoleTxIDRegex.FindAll(output, -1); len(txids) > 0 {
+		vars["txid"] = string(txids[len(txids)-1])
+	}
+	if addrs := consoleAddrRegex.FindAll(output, -1); len(addrs) > 0 {
+		vars["addr"] = string(addrs[len(addrs)-1])
+	}
+}
+
+// printsSecrets returns whether the command of words prints secrets, like keys, mnemonics
+// or API tokens, which must not be captured into the console variables.
+func printsSecrets(words []string) bool {
+	cmd, _, err := rootCmd.Find(words)
+	return err == nil && (cmd == exportCmd || cmd == newWalletCmd || cmd == generateTokenCmd)
+}
+
+// consoleWordSpans returns the start and end offsets of the words of a console line, as
+// splitConsoleLine splits them, but without expanding the variables.
+func consoleWordSpans(line string) [][2]int {
+	var spans [][2]int
+	start := -1
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == ' ' || c == '\t':
+			if start >= 0 {
+				spans = append(spans, [2]int{start, i})
+				start = -1
+			}
+		default:
+			if start < 0 {
+				start = i
+			}
+			if c == '\\' {
+				i++
+			} else if c == '\'' || c == '"' {
+				quote = c
+			}
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(line)})
+	}
+	return spans
+}
+
+// redactConsoleLine returns the line with the values of its secret flags redacted, as it
+// is kept in the history.
+func redactConsoleLine(line string) string {
+	spans := consoleWordSpans(line)
+	words := make([]string, len(spans))
+	for i, span := range spans {
+		words[i] = strings.Trim(line[span[0]:span[1]], `'"`)
+	}
+	cmdWords := words
+	if len(cmdWords) > 0 && cmdWords[0] == "goal" {
+		cmdWords = cmdWords[1:]
+	}
+	cmd, _, err := rootCmd.Find(cmdWords)
+	if err != nil {
+		return line
+	}
+
+	// the replacements of the spans, applied from the end so that the offsets stay valid
+	replaced := make(map[int]string)
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if len(word) < 2 || word[0] != '-' || word == "--" {
+			continue
+		}
+		var f *pflag.Flag
+		var prefix string
+		attached := false
+		if strings.HasPrefix(word, "--") {
+			name := word[2:]
+			if eq := strings.IndexByte(name, '='); eq >= 0 {
+				name, attached = name[:eq], true
+			}
+			f = cmd.Flags().Lookup(name)
+			prefix = "--" + name + "="
+		} else {
+			f = cmd.Flags().ShorthandLookup(word[1:2])
+			attached = len(word) > 2
+			prefix = word[:2] + "="
+		}
+		if f == nil || !consoleSecretFlags[f.Name] || f.Value.Type() == "bool" {
+			continue
+		}
+		if attached {
+			replaced[i] = prefix + consoleRedacted
+		} else if i+1 < len(words) {
+			replaced[i+1] = consoleRedacted
+			i++
+		}
+	}
+	for i := len(spans) - 1; i >= 0; i-- {
+		if value, ok := replaced[i]; ok {
+			line = line[:spans[i][0]] + value + line[spans[i][1]:]
+		}
+	}
+	return line
+}
+
+// loadCompletions fetches the accounts of the goal account list, and their assets and
+// applications, from the node. Completion is best effort, so errors are ignored.
+func (s *consoleSession) loadCompletions() {
+	if s.completions.loaded {
+		return
+	}
+	s.completions.loaded = true
+	// loading the account list or the client exits on errors, which must not end the console
+	consoleRunning = true
+	defer func() {
+		consoleRunning = false
+		if rec := recover(); rec != nil {
+			if _, ok := rec.(int); !ok {
+				panic(rec)
+			}
+		}
+	}()
+	accountList := makeAccountsList(s.dataDir)
+	client, err := getGoalClient(s.dataDir, libgoal.AlgodClient)
+	assets := make(map[string]bool)
+	apps := make(map[string]bool)
+	for addr, name := range accountList.Accounts {
+		s.completions.addresses = append(s.completions.addresses, addr, name)
+		if err != nil {
+			continue
+		}
+		info, infoErr := client.AccountInformation(addr, true)
+		if infoErr != nil {
+			continue
+		}
+		if info.Assets != nil {
+			for _, holding := range *info.Assets {
+				assets[strconv.FormatUint(holding.AssetID, 10)] = true
+			}
+		}
+		if info.CreatedAssets != nil {
+			for _, asset := range *info.CreatedAssets {
+				assets[strconv.FormatUint(asset.Index, 10)] = true
+			}
+		}
+		if info.CreatedApps != nil {
+			for _, app := range *info.CreatedApps {
+				apps[strconv.FormatUint(app.Id, 10)] = true
+			}
+		}
+		if info.AppsLocalState != nil {
+			for _, local := range *info.AppsLocalState {
+				apps[strconv.FormatUint(local.Id, 10)] = true
+			}
+		}
+	}
+	for id := range assets {
+		s.completions.assets = append(s.completions.assets, id)
+	}
+	for id := range apps {
+		s.completions.apps = append(s.completions.apps, id)
+	}
+	sort.Strings(s.completions.addresses)
+	sort.Strings(s.completions.assets)
+	sort.Strings(s.completions.apps)
+}
+
+// complete is the tab completion callback of the console terminal.
+func (s *consoleSession) complete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' || pos != len(line) {
+		return "", 0, false
+	}
+	start := strings.LastIndexAny(line, " \t") + 1
+	prefix := line[start:]
+	candidates := s.completionCandidates(strings.Fields(line[:start]), prefix)
+
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			matches = append(matches, c)
+		}
+	}
+	if len(matches) == 0 {
+		return "", 0, false
+	}
+	completed := longestCommonPrefix(matches)
+	if len(matches) == 1 {
+		completed += " "
+	}
+	if completed == prefix {
+		return "", 0, false
+	}
+	newLine := line[:start] + completed
+	return newLine, len(newLine), true
+}
+
+// completionCandidates returns the completions of the word following the given ones.
+func (s *consoleSession) completionCandidates(words []string, prefix string) []string {
+	if strings.HasPrefix(prefix, "$") {
+		var names []string
+		for name := range s.vars {
+			names = append(names, "$"+name)
+		}
+		return names
+	}
+
+	cmd, rest, err := rootCmd.Find(words)
+	if err != nil {
+		return nil
+	}
+	if strings.HasPrefix(prefix, "-") {
+		var flags []string
+		addFlag := func(f *pflag.Flag) {
+			if !f.Hidden {
+				flags = append(flags, "--"+f.Name)
+			}
+		}
+		cmd.Flags().VisitAll(addFlag)
+		cmd.InheritedFlags().VisitAll(addFlag)
+		return flags
+	}
+
+	// subcommands, unless arguments were already given to the command
+	if len(rest) == 0 && cmd.HasAvailableSubCommands() {
+		var names []string
+		if cmd == rootCmd {
+			names = append(names, "set", "unset", "vars", "history", "refresh", "help", "exit", "quit")
+		}
+		for _, sub := range cmd.Commands() {
+			if sub.IsAvailableCommand() && sub != consoleCmd {
+				names = append(names, sub.Name())
+			}
+		}
+		return names
+	}
+
+	s.loadCompletions()
+	if len(words) > 0 {
+		prev := strings.ToLower(words[len(words)-1])
+		switch {
+		case strings.HasPrefix(prev, "-") && strings.Contains(prev, "asset"):
+			return s.completions.assets
+		case strings.HasPrefix(prev, "-") && strings.Contains(prev, "app"):
+			return s.completions.apps
+		}
+	}
+	return s.completions.addresses
+}
+
+func longestCommonPrefix(words []string) string {
+	prefix := words[0]
+	for _, w := range words[1:] {
+		for !strings.HasPrefix(w, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
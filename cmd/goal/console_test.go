@@ -0,0 +1,178 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestConsoleSplitLine(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	vars := map[string]string{"addr": "AAAA", "amt": "1000"}
+	testcases := []struct {
+		line  string
+		words []string
+	}{
+		{`clerk send -a $amt -f $addr -t BBBB`, []string{"clerk", "send", "-a", "1000", "-f", "AAAA", "-t", "BBBB"}},
+		{`  account   list  `, []string{"account", "list"}},
+		{`clerk send --note "hello world"`, []string{"clerk", "send", "--note", "hello world"}},
+		{`clerk send --note 'cost: $amt'`, []string{"clerk", "send", "--note", "cost: $amt"}},
+		{`clerk send --note "cost: ${amt}µA"`, []string{"clerk", "send", "--note", "cost: 1000µA"}},
+		{`echo a\ b \$amt $ ""`, []string{"echo", "a b", "$amt", "$", ""}},
+	}
+	for _, tc := range testcases {
+		words, err := splitConsoleLine(tc.line, vars)
+		require.NoError(t, err, tc.line)
+		require.Equal(t, tc.words, words, tc.line)
+	}
+
+	_, err := splitConsoleLine(`clerk send -f $missing`, vars)
+	require.ErrorContains(t, err, "missing")
+	_, err = splitConsoleLine(`clerk send --note "open`, vars)
+	require.Error(t, err)
+	_, err = splitConsoleLine(`   `, vars)
+	require.Error(t, err)
+}
+
+func TestConsoleCaptureVars(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := "7ZUECA7HFLZTXENRV24SHLU4AVPUTMTTDUFUBNBD64C73F3UHRTHAIOF6Q"
+	txid := "KXRFJ3QYRRUHEJ7D2NEGIRHW7ZXZQ5MEU3FNFZKX2S4CWZ34RPDQ"
+	vars := make(map[string]string)
+	captureConsoleVars([]byte("Sent 1000 MicroAlgos from account "+addr+" to address B, transaction ID: "+txid+". Fee set to 1000\nTransaction "+txid+" committed in round 12\n"), vars)
+	require.Equal(t, addr, vars["addr"])
+	require.Equal(t, txid, vars["txid"])
+	require.Equal(t, "Transaction "+txid+" committed in round 12", vars["last"])
+
+	captureConsoleVars(nil, vars)
+	require.Equal(t, addr, vars["addr"])
+}
+
+func TestConsoleCompletion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s := &consoleSession{
+		vars:        map[string]string{"txid": "X", "total": "Y"},
+		completions: consoleCompletions{loaded: true, addresses: []string{"alice"}, assets: []string{"31566704"}, apps: []string{"1001", "1002"}},
+	}
+	complete := func(line string) string {
+		newLine, pos, ok := s.complete(line, len(line), '\t')
+		if !ok {
+			return line
+		}
+		require.Equal(t, len(newLine), pos)
+		return newLine
+	}
+
+	require.Equal(t, "node ", complete("nod"))
+	require.Equal(t, "node sta", complete("node sta"))
+	require.Equal(t, "node status ", complete("node stat"))
+	require.Equal(t, "node status --watch ", complete("node status --wat"))
+	require.Equal(t, "clerk send -t $t", complete("clerk send -t $t"))
+	require.Equal(t, "clerk send -t $txid ", complete("clerk send -t $tx"))
+	require.Equal(t, "clerk send -f alice ", complete("clerk send -f al"))
+	require.Equal(t, "asset info --assetid 31566704 ", complete("asset info --assetid 3"))
+	require.Equal(t, "app read --app-id 100", complete("app read --app-id 1"))
+	require.Equal(t, "exit ", complete("exi"))
+
+	_, _, ok := s.complete("nod", 1, '\t')
+	require.False(t, ok)
+	_, _, ok = s.complete("nod", 3, 'x')
+	require.False(t, ok)
+}
+
+func TestConsoleRecall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s := &consoleSession{}
+	_, err := s.recall("!!")
+	require.Error(t, err)
+
+	s.history = []string{"node status", "account list"}
+	line, err := s.recall("!!")
+	require.NoError(t, err)
+	require.Equal(t, "account list", line)
+	line, err = s.recall("!1")
+	require.NoError(t, err)
+	require.Equal(t, "node status", line)
+	_, err = s.recall("!3")
+	require.Error(t, err)
+	_, err = s.recall("!x")
+	require.Error(t, err)
+}
+
+func TestConsoleRedactHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testcases := []struct {
+		line     string
+		redacted string
+	}{
+		{`account import -m "abandon ability able" -w w1`, `account import -m <redacted> -w w1`},
+		{`goal account import --mnemonic='abandon ability able'`, `goal account import --mnemonic=<redacted>`},
+		{`account import -mabandon`, `account import -m=<redacted>`},
+		{`account import -w w1 -m $phrase`, `account import -w w1 -m <redacted>`},
+		{`account participationkey migrate --partkeyid X --token abc`, `account participationkey migrate --partkeyid X --token <redacted>`},
+		{`clerk compile -m prog.teal`, `clerk compile -m prog.teal`},
+		{`clerk sign --mnemonic --offline -i tx`, `clerk sign --mnemonic --offline -i tx`},
+		{`set phrase "abandon ability"`, `set phrase "abandon ability"`},
+	}
+	for _, tc := range testcases {
+		require.Equal(t, tc.redacted, redactConsoleLine(tc.line), tc.line)
+	}
+}
+
+func TestConsoleSecretsNotSaved(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.True(t, printsSecrets([]string{"account", "export", "-a", "X"}))
+	require.True(t, printsSecrets([]string{"wallet", "new", "w1"}))
+	require.False(t, printsSecrets([]string{"account", "list"}))
+
+	cacheDir := t.TempDir()
+	s := makeConsoleSession("", cacheDir)
+	s.vars["last"] = "abandon ability able"
+	s.vars["addr"] = "AAAA"
+	s.saveVars()
+	data, err := os.ReadFile(filepath.Join(cacheDir, consoleVarsFilename))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "abandon")
+
+	// $last is dropped from the files written by older versions too
+	require.NoError(t, os.WriteFile(s.varsFile, []byte(`{"last": "abandon", "addr": "AAAA"}`), 0600))
+	s = makeConsoleSession("", cacheDir)
+	require.Equal(t, map[string]string{"addr": "AAAA"}, s.vars)
+
+	s.addHistory(`account import -m "abandon ability able"`)
+	data, err = os.ReadFile(filepath.Join(cacheDir, consoleHistoryFilename))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "abandon")
+}
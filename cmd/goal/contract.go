@@ -0,0 +1,297 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// arc4Contract is the ARC-4 description of the interface of an application,
+// usually distributed as contract.json
+type arc4Contract struct {
+	Name     string                       `json:"name"`
+	Desc     string                       `json:"desc,omitempty"`
+	Networks map[string]arc4ContractAppID `json:"networks,omitempty"`
+	Methods  []arc4Method                 `json:"methods"`
+}
+
+// arc4ContractAppID is the application implementing a contract on a network,
+// keyed by the base64 genesis hash of the network
+type arc4ContractAppID struct {
+	AppID uint64 `json:"appID"`
+}
+
+// arc4Method describes a method of an ARC-4 contract
+type arc4Method struct {
+	Name    string      `json:"name"`
+	Desc    string      `json:"desc,omitempty"`
+	Args    []arc4Arg   `json:"args"`
+	Returns arc4Returns `json:"returns"`
+}
+
+// arc4Arg describes an argument of an ARC-4 method
+type arc4Arg struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// arc4Returns describes the return value of an ARC-4 method
+type arc4Returns struct {
+	Type string `json:"type"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// signature returns the method signature, as hashed into its selector
+func (m arc4Method) signature() string {
+	argTypes := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		argTypes[i] = arg.Type
+	}
+	returns := m.Returns.Type
+	if returns == "" {
+		returns = abi.VoidReturnType
+	}
+	return fmt.Sprintf("%s(%s)%s", m.Name, strings.Join(argTypes, ","), returns)
+}
+
+// argNames lists the arguments of the method with their types, for messages
+func (m arc4Method) argNames() string {
+	names := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		names[i] = strings.TrimSpace(arg.Type + " " + arg.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// parseARC4Contract decodes and checks a contract description
+func parseARC4Contract(data []byte) (*arc4Contract, error) {
+	var contract arc4Contract
+	err := json.Unmarshal(data, &contract)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range contract.Methods {
+		err = abi.VerifyMethodSignature(m.signature())
+		if err != nil {
+			return nil, fmt.Errorf("method %s of contract %s: %w", m.Name, contract.Name, err)
+		}
+	}
+	return &contract, nil
+}
+
+// method finds the method called nameOrSignature. A bare name must match
+// exactly one method of the contract.
+func (c *arc4Contract) method(nameOrSignature string) (arc4Method, error) {
+	var matches []arc4Method
+	for _, m := range c.Methods {
+		if m.Name == nameOrSignature || m.signature() == nameOrSignature {
+			matches = append(matches, m)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return arc4Method{}, fmt.Errorf("contract %s has no method %s", c.Name, nameOrSignature)
+	case 1:
+		return matches[0], nil
+	default:
+		sigs := make([]string, len(matches))
+		for i, m := range matches {
+			sigs[i] = m.signature()
+		}
+		return arc4Method{}, fmt.Errorf("method name %s is ambiguous in contract %s, use one of the signatures %s", nameOrSignature, c.Name, strings.Join(sigs, ", "))
+	}
+}
+
+// appID returns the application implementing the contract on the network
+// with the given genesis hash, or 0 if the contract does not name one
+func (c *arc4Contract) appID(genesisHash []byte) uint64 {
+	return c.Networks[base64.StdEncoding.EncodeToString(genesisHash)].AppID
+}
+
+// selector returns the 4-byte method selector passed as the first application argument
+func (m arc4Method) selector() []byte {
+	hash := sha512.Sum512_256([]byte(m.signature()))
+	return hash[:4]
+}
+
+// decodedMethodCall is an application call decoded against an ARC-4 contract
+type decodedMethodCall struct {
+	Method string             `json:"method"`
+	Args   []decodedMethodArg `json:"args"`
+}
+
+// decodedMethodArg is a single argument of a decoded method call. Value is
+// omitted for transaction arguments, which are the preceding transactions of
+// the group rather than application arguments.
+type decodedMethodArg struct {
+	Name  string          `json:"name,omitempty"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// String formats the call like a method invocation, e.g. add(a: 1, b: 2)
+func (call decodedMethodCall) String() string {
+	args := make([]string, len(call.Args))
+	for i, arg := range call.Args {
+		value := string(arg.Value)
+		if value == "" {
+			value = "<" + arg.Type + " txn>"
+		}
+		if arg.Name != "" {
+			value = arg.Name + ": " + value
+		}
+		args[i] = value
+	}
+	name, _, _ := strings.Cut(call.Method, "(")
+	return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+}
+
+// decodeMethodCall decodes the arguments of an application call made to one
+// of the contract's methods. It returns nil if tx does not call a method of
+// the contract.
+func (c *arc4Contract) decodeMethodCall(tx transactions.Transaction) (*decodedMethodCall, error) {
+	if tx.Type != protocol.ApplicationCallTx || len(tx.ApplicationArgs) == 0 {
+		return nil, nil
+	}
+
+	var method *arc4Method
+	for i := range c.Methods {
+		if bytes.Equal(c.Methods[i].selector(), tx.ApplicationArgs[0]) {
+			method = &c.Methods[i]
+			break
+		}
+	}
+	if method == nil {
+		return nil, nil
+	}
+
+	call := &decodedMethodCall{Method: method.signature()}
+
+	// Collect the argument types as encoded in the application arguments:
+	// transaction arguments are skipped and references are uint8 indices
+	var argIndices []int
+	var abiTypes []abi.Type
+	for i, arg := range method.Args {
+		call.Args = append(call.Args, decodedMethodArg{Name: arg.Name, Type: arg.Type})
+		if abi.IsTransactionType(arg.Type) {
+			continue
+		}
+		typeString := arg.Type
+		if abi.IsReferenceType(typeString) {
+			typeString = "uint8"
+		}
+		abiType, err := abi.TypeOf(typeString)
+		if err != nil {
+			return nil, err
+		}
+		argIndices = append(argIndices, i)
+		abiTypes = append(abiTypes, abiType)
+	}
+
+	values := make([]interface{}, 0, len(abiTypes))
+	encodedArgs := tx.ApplicationArgs[1:]
+	if len(abiTypes) > maxAppArgs-1 {
+		// arguments past the threshold are packed in a tuple in the last slot
+		tupleType, err := abi.MakeTupleType(abiTypes[methodArgsTupleThreshold:])
+		if err != nil {
+			return nil, err
+		}
+		if len(encodedArgs) != methodArgsTupleThreshold+1 {
+			return nil, fmt.Errorf("method %s expects %d application arguments but the call has %d", call.Method, methodArgsTupleThreshold+2, len(tx.ApplicationArgs))
+		}
+		for i := 0; i < methodArgsTupleThreshold; i++ {
+			value, err := abiTypes[i].Decode(encodedArgs[i])
+			if err != nil {
+				return nil, fmt.Errorf("argument %d of method %s: %w", i, call.Method, err)
+			}
+			values = append(values, value)
+		}
+		tuple, err := tupleType.Decode(encodedArgs[methodArgsTupleThreshold])
+		if err != nil {
+			return nil, fmt.Errorf("argument tuple of method %s: %w", call.Method, err)
+		}
+		values = append(values, tuple.([]interface{})...)
+	} else {
+		if len(encodedArgs) != len(abiTypes) {
+			return nil, fmt.Errorf("method %s expects %d application arguments but the call has %d", call.Method, len(abiTypes)+1, len(tx.ApplicationArgs))
+		}
+		for i, abiType := range abiTypes {
+			value, err := abiType.Decode(encodedArgs[i])
+			if err != nil {
+				return nil, fmt.Errorf("argument %d of method %s: %w", i, call.Method, err)
+			}
+			values = append(values, value)
+		}
+	}
+
+	for i, value := range values {
+		arg := &call.Args[argIndices[i]]
+		var encoded []byte
+		var err error
+		if abi.IsReferenceType(arg.Type) {
+			encoded, err = resolveMethodReference(tx, arg.Type, int(value.(uint8)))
+		} else {
+			encoded, err = abiTypes[i].MarshalToJSON(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("argument %s of method %s: %w", arg.Name, call.Method, err)
+		}
+		arg.Value = encoded
+	}
+	return call, nil
+}
+
+// resolveMethodReference returns the JSON encoding of the account, asset or
+// application a reference argument points to in the foreign arrays of tx
+func resolveMethodReference(tx transactions.Transaction, refType string, index int) ([]byte, error) {
+	switch refType {
+	case abi.AccountReferenceType:
+		if index == 0 {
+			return json.Marshal(tx.Sender.String())
+		}
+		if index > len(tx.Accounts) {
+			return nil, fmt.Errorf("account index %d out of range", index)
+		}
+		return json.Marshal(tx.Accounts[index-1].String())
+	case abi.ApplicationReferenceType:
+		if index == 0 {
+			return json.Marshal(uint64(tx.ApplicationID))
+		}
+		if index > len(tx.ForeignApps) {
+			return nil, fmt.Errorf("application index %d out of range", index)
+		}
+		return json.Marshal(uint64(tx.ForeignApps[index-1]))
+	case abi.AssetReferenceType:
+		if index >= len(tx.ForeignAssets) {
+			return nil, fmt.Errorf("asset index %d out of range", index)
+		}
+		return json.Marshal(uint64(tx.ForeignAssets[index]))
+	}
+	return nil, fmt.Errorf("unknown reference type %s", refType)
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testContractJSON = `{
+	"name": "Calculator",
+	"networks": {
+		"SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=": {"appID": 1234}
+	},
+	"methods": [
+		{"name": "add", "args": [{"type": "uint64", "name": "a"}, {"type": "uint64", "name": "b"}], "returns": {"type": "uint64", "desc": "The sum"}},
+		{"name": "mul", "args": [{"type": "uint64"}, {"type": "uint64"}], "returns": {"type": "uint64"}},
+		{"name": "mul", "args": [{"type": "uint128"}, {"type": "uint128"}], "returns": {"type": "uint128"}},
+		{"name": "reset", "args": [{"type": "pay", "name": "fee"}], "returns": {"type": "void"}}
+	]
+}`
+
+func TestARC4ContractMethod(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	contract, err := parseARC4Contract([]byte(testContractJSON))
+	require.NoError(t, err)
+
+	// By name
+	m, err := contract.method("add")
+	require.NoError(t, err)
+	require.Equal(t, "add(uint64,uint64)uint64", m.signature())
+	require.Equal(t, "uint64 a, uint64 b", m.argNames())
+	require.Equal(t, "The sum", m.Returns.Desc)
+
+	// By signature, to tell overloaded methods apart
+	_, err = contract.method("mul")
+	require.ErrorContains(t, err, "ambiguous")
+	m, err = contract.method("mul(uint128,uint128)uint128")
+	require.NoError(t, err)
+	require.Equal(t, "uint128", m.Args[0].Type)
+
+	m, err = contract.method("reset")
+	require.NoError(t, err)
+	require.Equal(t, "reset(pay)void", m.signature())
+
+	_, err = contract.method("sub")
+	require.Error(t, err)
+}
+
+func TestARC4ContractAppID(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	contract, err := parseARC4Contract([]byte(testContractJSON))
+	require.NoError(t, err)
+
+	mainnet := []byte{0x48, 0x63, 0xb5, 0x18, 0xa4, 0xb3, 0xc8, 0x4e, 0xc8, 0x10, 0xf2, 0x2d, 0x4f, 0x10, 0x81, 0xcb, 0x0f, 0x71, 0xf0, 0x59, 0xa7, 0xac, 0x20, 0xde, 0xc6, 0x2f, 0x7f, 0x70, 0xe5, 0x09, 0x3a, 0x22}
+	require.Equal(t, uint64(1234), contract.appID(mainnet))
+	require.Zero(t, contract.appID(make([]byte, 32)))
+}
+
+func TestARC4ContractInvalid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, err := parseARC4Contract([]byte(`{"name": "Bad", "methods": [{"name": "f", "args": [{"type": "uint7"}], "returns": {"type": "void"}}]}`))
+	require.Error(t, err)
+}
+
+func TestARC4ContractDecodeMethodCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	contract, err := parseARC4Contract([]byte(`{
+		"name": "Registry",
+		"methods": [
+			{"name": "add", "args": [{"type": "uint64", "name": "a"}, {"type": "uint64", "name": "b"}], "returns": {"type": "uint64"}},
+			{"name": "register", "args": [{"type": "pay", "name": "payment"}, {"type": "account", "name": "owner"}, {"type": "asset"}, {"type": "string", "name": "label"}], "returns": {"type": "void"}}
+		]
+	}`))
+	require.NoError(t, err)
+
+	uint64Arg := func(v uint64) []byte {
+		return binary.BigEndian.AppendUint64(nil, v)
+	}
+
+	add, err := contract.method("add")
+	require.NoError(t, err)
+	tx := transactions.Transaction{Type: protocol.ApplicationCallTx}
+	tx.ApplicationArgs = [][]byte{add.selector(), uint64Arg(2), uint64Arg(40)}
+	call, err := contract.decodeMethodCall(tx)
+	require.NoError(t, err)
+	require.Equal(t, "add(uint64,uint64)uint64", call.Method)
+	require.Equal(t, "add(a: 2, b: 40)", call.String())
+
+	var owner basics.Address
+	crypto.RandBytes(owner[:])
+	register, err := contract.method("register")
+	require.NoError(t, err)
+	tx.Accounts = []basics.Address{owner}
+	tx.ForeignAssets = []basics.AssetIndex{31566704}
+	tx.ApplicationArgs = [][]byte{register.selector(), {1}, {0}, append([]byte{0, 3}, "foo"...)}
+	call, err = contract.decodeMethodCall(tx)
+	require.NoError(t, err)
+	require.Len(t, call.Args, 4)
+	require.Nil(t, call.Args[0].Value)
+	require.JSONEq(t, `"`+owner.String()+`"`, string(call.Args[1].Value))
+	require.JSONEq(t, `31566704`, string(call.Args[2].Value))
+	require.JSONEq(t, `"foo"`, string(call.Args[3].Value))
+	require.Equal(t, `register(payment: <pay txn>, owner: "`+owner.String()+`", 31566704, label: "foo")`, call.String())
+
+	// wrong number of arguments
+	tx.ApplicationArgs = tx.ApplicationArgs[:3]
+	_, err = contract.decodeMethodCall(tx)
+	require.ErrorContains(t, err, "expects 4 application arguments")
+
+	// reference out of range
+	tx.ApplicationArgs = [][]byte{register.selector(), {2}, {0}, append([]byte{0, 3}, "foo"...)}
+	_, err = contract.decodeMethodCall(tx)
+	require.ErrorContains(t, err, "account index 2 out of range")
+
+	// calls that are not to a method of the contract are not decoded
+	tx.ApplicationArgs = [][]byte{[]byte("noop")}
+	call, err = contract.decodeMethodCall(tx)
+	require.NoError(t, err)
+	require.Nil(t, call)
+	call, err = contract.decodeMethodCall(transactions.Transaction{Type: protocol.PaymentTx})
+	require.NoError(t, err)
+	require.Nil(t, call)
+}
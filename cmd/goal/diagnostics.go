@@ -0,0 +1,448 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/util"
+)
+
+var (
+	diagnosticsOutFile  string
+	diagnosticsMaxSize  uint64
+	diagnosticsLogSize  uint64
+	diagnosticsScrubPII bool
+	diagnosticsTimeout  uint64
+)
+
+const diagnosticsManifestName = "manifest.json"
+
+func init() {
+	nodeCmd.AddCommand(diagnosticsCmd)
+
+	diagnosticsCmd.Flags().StringVarP(&diagnosticsOutFile, "outfile", "o", "", "Filename of the diagnostics tarball (default algod-diagnostics-<time>.tar.gz)")
+	diagnosticsCmd.Flags().Uint64Var(&diagnosticsMaxSize, "max-size", 100, "Maximum size, in MB, of the uncompressed contents of the tarball")
+	diagnosticsCmd.Flags().Uint64Var(&diagnosticsLogSize, "log-size", 10, "Maximum size, in MB, collected from the end of each log and cadaver file")
+	diagnosticsCmd.Flags().BoolVar(&diagnosticsScrubPII, "scrub", false, "Replace IP addresses, account addresses and home directory paths in the collected text")
+	diagnosticsCmd.Flags().Uint64Var(&diagnosticsTimeout, "timeout", 30, "Time (in seconds) to wait for each request to the node")
+}
+
+var diagnosticsCmd = &cobra.Command{
+	Use:   "diagnostics",
+	Short: "Gather node diagnostics into a tarball for support escalations",
+	Long: `Gather the status, peers, metrics and goroutine/heap profiles of the node, the end of its logs and cadaver file, its config with the secrets redacted, and disk and ledger statistics into a single tarball.
+The node does not need to be running: the items which cannot be collected are listed in the manifest of the tarball along with the reason. The profiles are only served by nodes with EnableProfiler set.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		outFile := diagnosticsOutFile
+		if outFile == "" {
+			outFile = fmt.Sprintf("algod-diagnostics-%s.tar.gz", time.Now().UTC().Format("20060102-150405"))
+		}
+		f, err := os.OpenFile(outFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, outFile, err)
+		}
+		bundle := makeDiagnosticsBundle(f, int64(diagnosticsMaxSize)<<20, diagnosticsScrubPII)
+		collectDiagnostics(bundle, dataDir, int64(diagnosticsLogSize)<<20, time.Duration(diagnosticsTimeout)*time.Second)
+		err = bundle.close()
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			os.Remove(outFile)
+			reportErrorf(fileWriteError, outFile, err)
+		}
+		reportInfof(infoNodeDiagnosticsWritten, outFile, bundle.collected(), len(bundle.manifest))
+	},
+}
+
+// diagnosticsItem is the manifest entry of an item of the diagnostics tarball.
+type diagnosticsItem struct {
+	Name      string `json:"name"`
+	Size      int    `json:"size,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// diagnosticsBundle writes the items of a diagnostics tarball, within a budget
+// for the total size of their uncompressed contents.
+type diagnosticsBundle struct {
+	gz       *gzip.Writer
+	tw       *tar.Writer
+	budget   int64
+	written  int64
+	scrub    bool
+	now      time.Time
+	manifest []diagnosticsItem
+}
+
+func makeDiagnosticsBundle(w io.Writer, budget int64, scrub bool) *diagnosticsBundle {
+	gz := gzip.NewWriter(w)
+	return &diagnosticsBundle{
+		gz:     gz,
+		tw:     tar.NewWriter(gz),
+		budget: budget,
+		scrub:  scrub,
+		now:    time.Now(),
+	}
+}
+
+// remaining returns how many bytes can still be added to the bundle.
+func (b *diagnosticsBundle) remaining() int64 {
+	if b.written >= b.budget {
+		return 0
+	}
+	return b.budget - b.written
+}
+
+// add writes an item to the bundle. Text items are scrubbed of PII when requested;
+// items which do not fit in the remaining budget are skipped.
+func (b *diagnosticsBundle) add(name string, data []byte, text bool, truncated bool) {
+	if text && b.scrub {
+		data = scrubPII(data)
+	}
+	if int64(len(data)) > b.remaining() {
+		b.fail(name, fmt.Errorf("%d bytes exceed the remaining size limit of %d bytes", len(data), b.remaining()))
+		return
+	}
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: b.now,
+	}
+	err := b.tw.WriteHeader(hdr)
+	if err == nil {
+		_, err = b.tw.Write(data)
+	}
+	if err != nil {
+		b.fail(name, err)
+		return
+	}
+	b.written += int64(len(data))
+	b.manifest = append(b.manifest, diagnosticsItem{Name: name, Size: len(data), Truncated: truncated})
+}
+
+// addJSON writes the indented json encoding of obj to the bundle, or records err when obj could not be fetched.
+func (b *diagnosticsBundle) addJSON(name string, obj interface{}, err error) {
+	if err != nil {
+		b.fail(name, err)
+		return
+	}
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		b.fail(name, err)
+		return
+	}
+	b.add(name, data, true, false)
+}
+
+// fail records in the manifest that an item could not be collected.
+func (b *diagnosticsBundle) fail(name string, err error) {
+	msg := err.Error()
+	if b.scrub {
+		msg = string(scrubPII([]byte(msg)))
+	}
+	b.manifest = append(b.manifest, diagnosticsItem{Name: name, Error: msg})
+}
+
+// collected returns the number of items written to the bundle.
+func (b *diagnosticsBundle) collected() (n int) {
+	for _, item := range b.manifest {
+		if item.Error == "" {
+			n++
+		}
+	}
+	return
+}
+
+// close writes the manifest, which is exempt from the size limit, and flushes the tarball.
+func (b *diagnosticsBundle) close() error {
+	data, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = b.tw.WriteHeader(&tar.Header{Name: diagnosticsManifestName, Mode: 0600, Size: int64(len(data)), ModTime: b.now})
+	if err != nil {
+		return err
+	}
+	_, err = b.tw.Write(data)
+	if err != nil {
+		return err
+	}
+	err = b.tw.Close()
+	if err != nil {
+		return err
+	}
+	return b.gz.Close()
+}
+
+// collectDiagnostics adds to the bundle everything which can be gathered about the node of dataDir,
+// starting with the small items so that the large ones are the ones dropped by the size limit.
+func collectDiagnostics(b *diagnosticsBundle, dataDir string, logLimit int64, timeout time.Duration) {
+	cfg, err := config.LoadConfigFromDisk(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		b.fail(config.ConfigFilename, err)
+		cfg = config.GetDefaultLocal()
+	}
+
+	for _, name := range []string{config.ConfigFilename, "logging.config"} {
+		data, err := os.ReadFile(filepath.Join(dataDir, name))
+		if err == nil {
+			data, err = redactSecrets(data)
+		}
+		if err != nil {
+			if !os.IsNotExist(err) {
+				b.fail(name, err)
+			}
+			continue
+		}
+		b.add(name, data, true, false)
+	}
+
+	disk, err := collectDiskStats(dataDir)
+	b.addJSON("disk.json", disk, err)
+
+	client, err := getGoalClient(dataDir, libgoal.AlgodClient)
+	if err != nil {
+		b.fail("status.json", err)
+	} else {
+		status, err := client.Status()
+		b.addJSON("status.json", status, err)
+		peers, err := client.GetPeers()
+		b.addJSON("peers.json", peers, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		metrics, err := client.GetMetrics(ctx)
+		cancel()
+		if err != nil {
+			b.fail("metrics.txt", err)
+		} else {
+			b.add("metrics.txt", []byte(metrics), true, false)
+		}
+
+		if cfg.EnableProfiler {
+			for _, profile := range []struct {
+				name  string
+				file  string
+				debug int
+				text  bool
+			}{
+				{"goroutine", "goroutine.txt", 2, true},
+				{"heap", "heap.pprof", 0, false},
+			} {
+				ctx, cancel := context.WithTimeout(context.Background(), timeout)
+				data, err := client.GetProfile(ctx, profile.name, profile.debug)
+				cancel()
+				if err != nil {
+					b.fail(profile.file, err)
+					continue
+				}
+				b.add(profile.file, data, profile.text, false)
+			}
+		} else {
+			b.fail("goroutine.txt", fmt.Errorf("the profiler is not enabled in the node config"))
+		}
+	}
+
+	cadaverDir := cfg.CadaverDirectory
+	if cadaverDir == "" {
+		cadaverDir = dataDir
+	}
+	logs := []diagnosticsFile{
+		{filepath.Join(dataDir, "node.log"), true},
+		{filepath.Join(cadaverDir, "agreement.cdv"), false},
+	}
+	if cfg.LogArchiveName != "" {
+		logs = append(logs, diagnosticsFile{filepath.Join(dataDir, cfg.LogArchiveName), true})
+	}
+	for _, log := range logs {
+		name := filepath.Base(log.path)
+		limit := logLimit
+		if remaining := b.remaining(); remaining < limit {
+			limit = remaining
+		}
+		data, truncated, err := readFileTail(log.path, limit)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				b.fail(name, err)
+			}
+			continue
+		}
+		if truncated && log.text {
+			// drop the partial first line
+			if idx := bytes.IndexByte(data, '\n'); idx >= 0 {
+				data = data[idx+1:]
+			}
+		}
+		b.add(name, data, log.text, truncated)
+	}
+}
+
+// diagnosticsFile is a file of which the end is collected, such as a log.
+type diagnosticsFile struct {
+	path string
+	text bool
+}
+
+// diagnosticsDiskStats reports the space of the filesystem holding the data directory,
+// and the size of the ledger and other files of each network directory.
+type diagnosticsDiskStats struct {
+	Available   uint64           `json:"available"`
+	Total       uint64           `json:"total"`
+	Directories map[string]int64 `json:"directories"`
+	Files       map[string]int64 `json:"files"`
+}
+
+func collectDiskStats(dataDir string) (stats diagnosticsDiskStats, err error) {
+	stats.Available, stats.Total, err = util.GetDiskSpace(dataDir)
+	if err != nil {
+		return
+	}
+	stats.Directories = make(map[string]int64)
+	stats.Files = make(map[string]int64)
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(dataDir, entry.Name())
+		err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+			rel, _ := filepath.Rel(dataDir, path)
+			stats.Directories[entry.Name()] += info.Size()
+			// the files directly in the network directories hold the ledger databases
+			if filepath.Dir(rel) == entry.Name() {
+				stats.Files[rel] = info.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// readFileTail reads at most limit bytes from the end of the file at path.
+func readFileTail(path string, limit int64) (data []byte, truncated bool, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, false, err
+	}
+	offset := int64(0)
+	if info.Size() > limit {
+		offset = info.Size() - limit
+		truncated = true
+	}
+	data = make([]byte, info.Size()-offset)
+	_, err = f.ReadAt(data, offset)
+	if err != nil && err != io.EOF {
+		return nil, false, err
+	}
+	return data, truncated, nil
+}
+
+var secretKeyPattern = regexp.MustCompile(`(?i)(token|password|secret|credential|mnemonic|passphrase|privatekey|apikey)`)
+
+const redactedValue = "[REDACTED]"
+
+// redactSecrets replaces the values of the json object keys naming secrets.
+func redactSecrets(data []byte) ([]byte, error) {
+	var obj map[string]interface{}
+	err := json.Unmarshal(data, &obj)
+	if err != nil {
+		return nil, err
+	}
+	redactValue(obj)
+	return json.MarshalIndent(obj, "", "  ")
+}
+
+func redactValue(v interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			if secretKeyPattern.MatchString(k) {
+				if s, ok := child.(string); ok && s == "" {
+					continue
+				}
+				val[k] = redactedValue
+				continue
+			}
+			redactValue(child)
+		}
+	case []interface{}:
+		for _, child := range val {
+			redactValue(child)
+		}
+	}
+}
+
+var piiPatterns = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	// account addresses
+	{regexp.MustCompile(`\b[A-Z2-7]{58}\b`), "<address>"},
+	// IPv6 addresses, both full and compressed, leaving out loopback
+	{regexp.MustCompile(`(?i)\b(?:[0-9a-f]{1,4}:){7}[0-9a-f]{1,4}\b|\b(?:[0-9a-f]{1,4}:){1,6}:(?:[0-9a-f]{1,4}:){0,5}[0-9a-f]{1,4}\b`), "<ip>"},
+	// IPv4 addresses
+	{regexp.MustCompile(`\b(?:25[0-5]|2[0-4]\d|1?\d?\d)(?:\.(?:25[0-5]|2[0-4]\d|1?\d?\d)){3}\b`), "<ip>"},
+}
+
+// scrubPII replaces the IP addresses, account addresses and home directory paths in data.
+func scrubPII(data []byte) []byte {
+	for _, p := range piiPatterns {
+		data = p.re.ReplaceAll(data, []byte(p.replacement))
+	}
+	if home, err := os.UserHomeDir(); err == nil && len(home) > 1 {
+		data = bytes.ReplaceAll(data, []byte(home), []byte("~"))
+	}
+	return data
+}
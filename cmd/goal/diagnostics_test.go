@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDiagnosticsRedactSecrets(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	data, err := redactSecrets([]byte(`{"Archival": true, "GossipFanout": 4, "TelemetryToken": "abc", "Nested": {"Password": "hunter2", "UserName": "me"}, "EmptySecret": ""}`))
+	require.NoError(t, err)
+	var obj map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &obj))
+	require.Equal(t, true, obj["Archival"])
+	require.Equal(t, float64(4), obj["GossipFanout"])
+	require.Equal(t, redactedValue, obj["TelemetryToken"])
+	require.Equal(t, redactedValue, obj["Nested"].(map[string]interface{})["Password"])
+	require.Equal(t, "me", obj["Nested"].(map[string]interface{})["UserName"])
+	require.Equal(t, "", obj["EmptySecret"])
+
+	_, err = redactSecrets([]byte(`not json`))
+	require.Error(t, err)
+}
+
+func TestDiagnosticsScrubPII(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := "7ZUECA7HFLZTXENRV24SHLU4AVPUTMTTDUFUBNBD64C73F3UHRTHAIOF6Q"
+	in := `{"msg":"connected to 192.168.1.20:4160 and [2001:db8::ff00:42:8329]:4160 from ` + addr + ` at 12:34:56, version 3.16.2"}`
+	out := string(scrubPII([]byte(in)))
+	require.NotContains(t, out, "192.168.1.20")
+	require.NotContains(t, out, "2001:db8")
+	require.NotContains(t, out, addr)
+	require.Contains(t, out, "<ip>:4160")
+	require.Contains(t, out, "<address>")
+	require.Contains(t, out, "12:34:56")
+	require.Contains(t, out, "3.16.2")
+}
+
+func TestDiagnosticsReadFileTail(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "node.log")
+	require.NoError(t, os.WriteFile(path, []byte("0123456789"), 0600))
+
+	data, truncated, err := readFileTail(path, 4)
+	require.NoError(t, err)
+	require.True(t, truncated)
+	require.Equal(t, "6789", string(data))
+
+	data, truncated, err = readFileTail(path, 100)
+	require.NoError(t, err)
+	require.False(t, truncated)
+	require.Equal(t, "0123456789", string(data))
+
+	_, _, err = readFileTail(filepath.Join(t.TempDir(), "missing.log"), 4)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDiagnosticsBundleSizeLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var buf bytes.Buffer
+	b := makeDiagnosticsBundle(&buf, 11, true)
+	b.add("a.txt", []byte("from 10.0.0.1"), true, false)
+	b.add("b.txt", []byte("0123456789"), false, false)
+	b.add("c.bin", []byte("01"), false, true)
+	require.NoError(t, b.close())
+	require.Equal(t, 2, b.collected())
+
+	gz, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(data)
+	}
+	require.Equal(t, "from <ip>", files["a.txt"])
+	require.NotContains(t, files, "b.txt")
+	require.Equal(t, "01", files["c.bin"])
+
+	var manifest []diagnosticsItem
+	require.NoError(t, json.Unmarshal([]byte(files[diagnosticsManifestName]), &manifest))
+	require.Len(t, manifest, 3)
+	require.Equal(t, diagnosticsItem{Name: "a.txt", Size: 9}, manifest[0])
+	require.Equal(t, "b.txt", manifest[1].Name)
+	require.NotEmpty(t, manifest[1].Error)
+	require.Equal(t, diagnosticsItem{Name: "c.bin", Size: 2, Truncated: true}, manifest[2])
+}
@@ -17,14 +17,22 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"reflect"
+	"strings"
+	"time"
 
+	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
 )
 
 // inspectSignedTxn is isomorphic to SignedTxn but uses different
@@ -183,3 +191,235 @@ func lsigFromInspect(lsig inspectLogicSig) transactions.LogicSig {
 		Args:  lsig.Args,
 	}
 }
+
+// Kinds of objects recognized by goal clerk inspect
+const (
+	inspectKindTransactions = "transactions"
+	inspectKindBlock        = "block"
+	inspectKindCertificate  = "certificate"
+	inspectKindCatchpoint   = "catchpoint-label"
+)
+
+// inspectedObject is the decoded content of an inspected file or argument.
+// It is the stable output format of goal clerk inspect --json: new fields may
+// be added, but existing ones keep their names and meaning.
+type inspectedObject struct {
+	Source       string                    `json:"source"`
+	Kind         string                    `json:"kind"`
+	Transactions []inspectedTxn            `json:"transactions,omitempty"`
+	Block        *inspectedBlock           `json:"block,omitempty"`
+	Certificate  *inspectedCertificate     `json:"certificate,omitempty"`
+	Catchpoint   *inspectedCatchpointLabel `json:"catchpoint,omitempty"`
+}
+
+// inspectedTxn is a signed transaction, with its apply data when it comes from a block
+type inspectedTxn struct {
+	Index      int                `json:"index"`
+	TxID       string             `json:"txid"`
+	Type       protocol.TxType    `json:"type"`
+	SignedTxn  json.RawMessage    `json:"signed-txn"`
+	ApplyData  json.RawMessage    `json:"apply-data,omitempty"`
+	MethodCall *decodedMethodCall `json:"method-call,omitempty"`
+}
+
+// inspectedBlock summarizes a block header and holds its transactions in Source's Transactions
+type inspectedBlock struct {
+	Round       uint64                    `json:"round"`
+	Timestamp   int64                     `json:"timestamp"`
+	GenesisID   string                    `json:"genesis-id"`
+	Protocol    protocol.ConsensusVersion `json:"protocol"`
+	Hash        string                    `json:"hash"`
+	Header      json.RawMessage           `json:"header"`
+	Certificate *inspectedCertificate     `json:"certificate,omitempty"`
+}
+
+// inspectedCertificate summarizes an agreement certificate
+type inspectedCertificate struct {
+	Round            uint64 `json:"round"`
+	Period           uint64 `json:"period"`
+	Step             uint64 `json:"step"`
+	BlockDigest      string `json:"block-digest"`
+	OriginalProposer string `json:"original-proposer"`
+	OriginalPeriod   uint64 `json:"original-period"`
+	Votes            int    `json:"votes"`
+	Equivocations    int    `json:"equivocation-votes,omitempty"`
+}
+
+// inspectedCatchpointLabel is a parsed catchpoint label
+type inspectedCatchpointLabel struct {
+	Label string `json:"label"`
+	Round uint64 `json:"round"`
+	Hash  string `json:"hash"`
+}
+
+// inspectData decodes data as a catchpoint label, a stream of signed
+// transactions, a block with its certificate, a bare block or a certificate,
+// in that order. contract, if not nil, is used to decode application calls.
+func inspectData(source string, data []byte, contract *arc4Contract) (inspectedObject, error) {
+	obj := inspectedObject{Source: source}
+
+	if label := strings.TrimSpace(string(data)); label != "" && !strings.ContainsAny(label, "\n\x00") {
+		if round, hash, err := ledgercore.ParseCatchpointLabel(label); err == nil {
+			obj.Kind = inspectKindCatchpoint
+			obj.Catchpoint = &inspectedCatchpointLabel{Label: label, Round: uint64(round), Hash: hash.String()}
+			return obj, nil
+		}
+	}
+
+	txns, txnErr := decodeSignedTxns(data)
+	if txnErr == nil {
+		obj.Kind = inspectKindTransactions
+		for i, txn := range txns {
+			itxn, err := makeInspectedTxn(i, txn, nil, contract)
+			if err != nil {
+				return obj, err
+			}
+			obj.Transactions = append(obj.Transactions, itxn)
+		}
+		return obj, nil
+	}
+
+	var blockCert rpcs.EncodedBlockCert
+	if err := protocol.Decode(data, &blockCert); err == nil && blockCert.Block.Round() != 0 {
+		return inspectBlock(obj, blockCert.Block, &blockCert.Certificate, contract)
+	}
+
+	var block bookkeeping.Block
+	if err := protocol.Decode(data, &block); err == nil && block.Round() != 0 {
+		return inspectBlock(obj, block, nil, contract)
+	}
+
+	var cert agreement.Certificate
+	if err := protocol.Decode(data, &cert); err == nil && cert.Round != 0 {
+		obj.Kind = inspectKindCertificate
+		obj.Certificate = summarizeCertificate(cert)
+		return obj, nil
+	}
+
+	// report the transaction error, as transactions are the most common input
+	return obj, txnErr
+}
+
+// decodeSignedTxns decodes a stream of concatenated signed transactions
+func decodeSignedTxns(data []byte) (txns []transactions.SignedTxn, err error) {
+	dec := protocol.NewMsgpDecoderBytes(data)
+	for {
+		var txn transactions.SignedTxn
+		err = dec.Decode(&txn)
+		if err == io.EOF {
+			return txns, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		txns = append(txns, txn)
+	}
+}
+
+func makeInspectedTxn(index int, stxn transactions.SignedTxn, ad *transactions.ApplyData, contract *arc4Contract) (inspectedTxn, error) {
+	sti, err := inspectTxn(stxn)
+	if err != nil {
+		return inspectedTxn{}, err
+	}
+	itxn := inspectedTxn{
+		Index:     index,
+		TxID:      stxn.ID().String(),
+		Type:      stxn.Txn.Type,
+		SignedTxn: protocol.EncodeJSON(sti),
+	}
+	if ad != nil && !ad.Equal(transactions.ApplyData{}) {
+		itxn.ApplyData = protocol.EncodeJSON(ad)
+	}
+	if contract != nil {
+		itxn.MethodCall, err = contract.decodeMethodCall(stxn.Txn)
+		if err != nil {
+			return inspectedTxn{}, err
+		}
+	}
+	return itxn, nil
+}
+
+func inspectBlock(obj inspectedObject, block bookkeeping.Block, cert *agreement.Certificate, contract *arc4Contract) (inspectedObject, error) {
+	obj.Kind = inspectKindBlock
+	obj.Block = &inspectedBlock{
+		Round:     uint64(block.Round()),
+		Timestamp: block.TimeStamp,
+		GenesisID: block.GenesisID(),
+		Protocol:  block.CurrentProtocol,
+		Hash:      crypto.Digest(block.Hash()).String(),
+		Header:    protocol.EncodeJSON(block.BlockHeader),
+	}
+	if cert != nil && cert.Round != 0 {
+		obj.Block.Certificate = summarizeCertificate(*cert)
+	}
+
+	payset, err := block.DecodePaysetFlat()
+	if err != nil {
+		return obj, err
+	}
+	for i := range payset {
+		itxn, err := makeInspectedTxn(i, payset[i].SignedTxn, &payset[i].ApplyData, contract)
+		if err != nil {
+			return obj, err
+		}
+		obj.Transactions = append(obj.Transactions, itxn)
+	}
+	return obj, nil
+}
+
+func summarizeCertificate(cert agreement.Certificate) *inspectedCertificate {
+	return &inspectedCertificate{
+		Round:            uint64(cert.Round),
+		Period:           uint64(cert.Period),
+		Step:             uint64(cert.Step),
+		BlockDigest:      cert.Proposal.BlockDigest.String(),
+		OriginalProposer: cert.Proposal.OriginalProposer.String(),
+		OriginalPeriod:   uint64(cert.Proposal.OriginalPeriod),
+		Votes:            len(cert.Votes),
+		Equivocations:    len(cert.EquivocationVotes),
+	}
+}
+
+// String renders the object for goal clerk inspect without --json
+func (obj inspectedObject) String() string {
+	var sb strings.Builder
+	switch obj.Kind {
+	case inspectKindCatchpoint:
+		fmt.Fprintf(&sb, "%s: catchpoint label for round %d, hash %s\n", obj.Source, obj.Catchpoint.Round, obj.Catchpoint.Hash)
+	case inspectKindCertificate:
+		fmt.Fprintf(&sb, "%s: certificate\n%s", obj.Source, obj.Certificate)
+	case inspectKindBlock:
+		b := obj.Block
+		fmt.Fprintf(&sb, "%s: block %d (%s)\n", obj.Source, b.Round, b.Hash)
+		fmt.Fprintf(&sb, "Timestamp:    %s\n", time.Unix(b.Timestamp, 0).UTC().Format(time.RFC3339))
+		fmt.Fprintf(&sb, "Genesis ID:   %s\n", b.GenesisID)
+		fmt.Fprintf(&sb, "Protocol:     %s\n", b.Protocol)
+		fmt.Fprintf(&sb, "Transactions: %d\n", len(obj.Transactions))
+		fmt.Fprintf(&sb, "Header:\n%s\n", b.Header)
+		if b.Certificate != nil {
+			fmt.Fprintf(&sb, "Certificate:\n%s", b.Certificate)
+		}
+		sb.WriteString("\n")
+	}
+	for _, txn := range obj.Transactions {
+		fmt.Fprintf(&sb, "%s[%d]\n%s\n", obj.Source, txn.Index, txn.SignedTxn)
+		if txn.ApplyData != nil {
+			fmt.Fprintf(&sb, "Apply data: %s\n", txn.ApplyData)
+		}
+		if txn.MethodCall != nil {
+			fmt.Fprintf(&sb, "Method call: %s\n", txn.MethodCall)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// String renders the certificate summary, one field per line
+func (c *inspectedCertificate) String() string {
+	s := fmt.Sprintf("  Round:             %d\n  Period:            %d\n  Step:              %d\n  Block digest:      %s\n  Original proposer: %s\n  Original period:   %d\n  Votes:             %d\n",
+		c.Round, c.Period, c.Step, c.BlockDigest, c.OriginalProposer, c.OriginalPeriod, c.Votes)
+	if c.Equivocations > 0 {
+		s += fmt.Sprintf("  Equivocation votes: %d\n", c.Equivocations)
+	}
+	return s
+}
@@ -17,14 +17,18 @@
 package main
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -91,3 +95,99 @@ func TestInspect(t *testing.T) {
 	_, err = inspectTxn(full)
 	require.NoError(t, err)
 }
+
+func TestInspectData(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var sender basics.Address
+	crypto.RandBytes(sender[:])
+
+	var payment transactions.SignedTxn
+	payment.Txn.Type = protocol.PaymentTx
+	payment.Txn.Sender = sender
+	payment.Txn.Amount.Raw = 1000
+	payment.Txn.FirstValid = 5
+	payment.Txn.LastValid = 1005
+
+	t.Run("transactions", func(t *testing.T) {
+		t.Parallel()
+		keyreg := payment
+		keyreg.Txn.Type = protocol.KeyRegistrationTx
+		keyreg.Txn.Amount.Raw = 0
+		data := append(protocol.Encode(&payment), protocol.Encode(&keyreg)...)
+
+		obj, err := inspectData("txns", data, nil)
+		require.NoError(t, err)
+		require.Equal(t, inspectKindTransactions, obj.Kind)
+		require.Len(t, obj.Transactions, 2)
+		require.Equal(t, payment.ID().String(), obj.Transactions[0].TxID)
+		require.Equal(t, protocol.KeyRegistrationTx, obj.Transactions[1].Type)
+		require.Contains(t, obj.String(), "txns[1]\n")
+	})
+
+	t.Run("block", func(t *testing.T) {
+		t.Parallel()
+		var block bookkeeping.Block
+		block.BlockHeader.Round = 7
+		block.BlockHeader.TimeStamp = 1700000000
+		block.BlockHeader.GenesisID = "test-v1"
+		block.BlockHeader.CurrentProtocol = protocol.ConsensusCurrentVersion
+		crypto.RandBytes(block.BlockHeader.GenesisHash[:])
+
+		stxn := payment
+		stxn.Txn.GenesisHash = block.BlockHeader.GenesisHash
+		stib, err := block.EncodeSignedTxn(stxn, transactions.ApplyData{ClosingAmount: basics.MicroAlgos{Raw: 5}})
+		require.NoError(t, err)
+		block.Payset = []transactions.SignedTxnInBlock{stib}
+
+		var cert agreement.Certificate
+		cert.Round = 7
+		cert.Step = 2
+		cert.Proposal.OriginalProposer = sender
+		cert.Proposal.BlockDigest = crypto.Digest(block.Hash())
+
+		for _, data := range [][]byte{protocol.Encode(&block), protocol.Encode(&rpcs.EncodedBlockCert{Block: block, Certificate: cert})} {
+			obj, err := inspectData("block", data, nil)
+			require.NoError(t, err)
+			require.Equal(t, inspectKindBlock, obj.Kind)
+			require.Equal(t, uint64(7), obj.Block.Round)
+			require.Equal(t, "test-v1", obj.Block.GenesisID)
+			require.Len(t, obj.Transactions, 1)
+			require.Equal(t, stxn.ID().String(), obj.Transactions[0].TxID)
+			require.Contains(t, string(obj.Transactions[0].ApplyData), `"ca":5`)
+			require.Contains(t, obj.String(), "Transactions: 1\n")
+		}
+
+		obj, err := inspectData("block", protocol.Encode(&rpcs.EncodedBlockCert{Block: block, Certificate: cert}), nil)
+		require.NoError(t, err)
+		require.NotNil(t, obj.Block.Certificate)
+		require.Equal(t, sender.String(), obj.Block.Certificate.OriginalProposer)
+
+		obj, err = inspectData("cert", protocol.Encode(&cert), nil)
+		require.NoError(t, err)
+		require.Equal(t, inspectKindCertificate, obj.Kind)
+		require.Equal(t, uint64(2), obj.Certificate.Step)
+		require.Equal(t, crypto.Digest(block.Hash()).String(), obj.Certificate.BlockDigest)
+
+		// the JSON output keeps its field names
+		out, err := json.Marshal(obj)
+		require.NoError(t, err)
+		require.Contains(t, string(out), `"kind":"certificate"`)
+		require.Contains(t, string(out), `"original-proposer":"`+sender.String()+`"`)
+	})
+
+	t.Run("catchpoint label", func(t *testing.T) {
+		t.Parallel()
+		obj, err := inspectData("label", []byte("5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA\n"), nil)
+		require.NoError(t, err)
+		require.Equal(t, inspectKindCatchpoint, obj.Kind)
+		require.Equal(t, uint64(5894690), obj.Catchpoint.Round)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		t.Parallel()
+		_, err := inspectData("garbage", []byte("not a transaction"), nil)
+		require.Error(t, err)
+	})
+}
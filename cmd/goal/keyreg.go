@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	keyregPartKeyID string
+	keyregTxFile    string
+)
+
+func init() {
+	accountCmd.AddCommand(keyregCmd)
+
+	keyregCmd.Flags().StringVar(&keyregPartKeyID, "partkeyid", "", "ID of the installed participation key to register (required)")
+	keyregCmd.MarkFlagRequired("partkeyid")
+	keyregCmd.Flags().StringVarP(&keyregTxFile, "txfile", "t", "", "Write the unsigned keyreg transaction to this file (required)")
+	keyregCmd.MarkFlagRequired("txfile")
+	keyregCmd.Flags().Uint64VarP(&transactionFee, "fee", "f", 0, "The Fee to set on the keyreg transaction (defaults to suggested fee)")
+	keyregCmd.Flags().Uint64Var(&firstValid, "firstvalid", 0, "FirstValid for the keyreg transaction (0 for current)")
+	keyregCmd.Flags().Uint64VarP(&numValidRounds, "validrounds", "v", 0, "The validity period for the keyreg transaction")
+	keyregCmd.Flags().Uint64Var(&lastValid, "lastvalid", 0, "The last round where the transaction may be committed to the ledger")
+}
+
+var keyregCmd = &cobra.Command{
+	Use:   "keyreg",
+	Short: "Write the keyreg transaction registering an installed participation key",
+	Long:  `Write the unsigned keyreg transaction registering a participation key installed on the node. The node fills the voting, selection and state proof keys, the key dilution and the voting rounds from the installed key, so that they need not be copied by hand. The transaction can then be signed offline, with goal clerk sign, and sent with goal clerk rawsend.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		key, err := client.GetParticipationKeyByID(keyregPartKeyID)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		first, last, latest, err := client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
+		if err != nil {
+			reportErrorf(err.Error())
+		}
+		if key.Key.VoteLastValid < latest {
+			reportErrorf(errorKeyregPartKeyExpired, key.Id, key.Key.VoteLastValid, latest)
+		}
+		if key.EffectiveFirstValid != nil {
+			reportWarnf(warnKeyregAlreadyEffective, key.Id, *key.EffectiveFirstValid)
+		}
+
+		tx, err := client.ParticipationKeyregTransaction(keyregPartKeyID, transactionFee, first, last)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		err = checkKeyregMatchesKey(tx, key)
+		if err != nil {
+			reportErrorf(errorKeyregMismatch, key.Id, err)
+		}
+
+		err = writeSignedTxnsToFile([]transactions.SignedTxn{{Txn: tx}}, keyregTxFile)
+		if err != nil {
+			reportErrorf(fileWriteError, keyregTxFile, err)
+		}
+		reportInfof(infoKeyregWritten, key.Address, keyregTxFile, key.Id, tx.VoteFirst, tx.VoteLast, tx.VoteKeyDilution)
+	},
+}
+
+// checkKeyregMatchesKey verifies that the keyreg transaction registers the participation key,
+// as reported by the node, from the account that generated it.
+func checkKeyregMatchesKey(tx transactions.Transaction, key model.ParticipationKey) error {
+	if tx.Type != protocol.KeyRegistrationTx {
+		return fmt.Errorf("transaction type is %s", tx.Type)
+	}
+	if tx.Sender.String() != key.Address {
+		return fmt.Errorf("sender is %s, not %s", tx.Sender, key.Address)
+	}
+	if !bytes.Equal(tx.VotePK[:], key.Key.VoteParticipationKey) {
+		return fmt.Errorf("voting key differs")
+	}
+	if !bytes.Equal(tx.SelectionPK[:], key.Key.SelectionParticipationKey) {
+		return fmt.Errorf("selection key differs")
+	}
+	if key.Key.StateProofKey != nil && !tx.StateProofPK.IsEmpty() && !bytes.Equal(tx.StateProofPK[:], *key.Key.StateProofKey) {
+		return fmt.Errorf("state proof key differs")
+	}
+	if uint64(tx.VoteFirst) != key.Key.VoteFirstValid || uint64(tx.VoteLast) != key.Key.VoteLastValid {
+		return fmt.Errorf("voting rounds are %d to %d, not %d to %d", tx.VoteFirst, tx.VoteLast, key.Key.VoteFirstValid, key.Key.VoteLastValid)
+	}
+	if tx.VoteKeyDilution != key.Key.VoteKeyDilution {
+		return fmt.Errorf("key dilution is %d, not %d", tx.VoteKeyDilution, key.Key.VoteKeyDilution)
+	}
+	return nil
+}
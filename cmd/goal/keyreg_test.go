@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCheckKeyregMatchesKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var sender basics.Address
+	crypto.RandBytes(sender[:])
+	tx := transactions.Transaction{
+		Type:   protocol.KeyRegistrationTx,
+		Header: transactions.Header{Sender: sender},
+		KeyregTxnFields: transactions.KeyregTxnFields{
+			VoteFirst:       100,
+			VoteLast:        3000100,
+			VoteKeyDilution: 1733,
+		},
+	}
+	crypto.RandBytes(tx.VotePK[:])
+	crypto.RandBytes(tx.SelectionPK[:])
+	crypto.RandBytes(tx.StateProofPK[:])
+
+	stateProofKey := append([]byte{}, tx.StateProofPK[:]...)
+	key := model.ParticipationKey{
+		Id:      "ID",
+		Address: sender.String(),
+		Key: model.AccountParticipation{
+			VoteParticipationKey:      append([]byte{}, tx.VotePK[:]...),
+			SelectionParticipationKey: append([]byte{}, tx.SelectionPK[:]...),
+			StateProofKey:             &stateProofKey,
+			VoteFirstValid:            100,
+			VoteLastValid:             3000100,
+			VoteKeyDilution:           1733,
+		},
+	}
+	require.NoError(t, checkKeyregMatchesKey(tx, key))
+
+	mismatches := map[string]func(tx *transactions.Transaction){
+		"type":       func(tx *transactions.Transaction) { tx.Type = protocol.PaymentTx },
+		"sender":     func(tx *transactions.Transaction) { tx.Sender = basics.Address{} },
+		"voting":     func(tx *transactions.Transaction) { tx.VotePK[0]++ },
+		"selection":  func(tx *transactions.Transaction) { tx.SelectionPK[0]++ },
+		"stateproof": func(tx *transactions.Transaction) { tx.StateProofPK[0]++ },
+		"rounds":     func(tx *transactions.Transaction) { tx.VoteLast++ },
+		"dilution":   func(tx *transactions.Transaction) { tx.VoteKeyDilution++ },
+	}
+	for name, mismatch := range mismatches {
+		mismatched := tx
+		mismatch(&mismatched)
+		require.Error(t, checkKeyregMatchesKey(mismatched, key), name)
+	}
+}
@@ -18,13 +18,20 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/protocol/transcode"
+	"github.com/algorand/go-algorand/rpcs"
 )
 
 var (
@@ -32,6 +39,18 @@ var (
 	rawBlock       bool
 	base32Encoding bool
 	strictJSON     bool
+	watchFrom      uint64
+	watchCount     uint64
+	watchAccounts  []string
+	watchApps      []uint
+	watchAssets    []uint
+	watchAllTxns   bool
+	watchJSON      bool
+
+	exportFrom        uint64
+	exportTo          uint64
+	exportDir         string
+	exportChunkRounds uint64
 )
 
 func init() {
@@ -42,6 +61,22 @@ func init() {
 	blockCmd.Flags().BoolVarP(&rawBlock, "raw", "r", false, "Format block as msgpack")
 	blockCmd.Flags().BoolVar(&base32Encoding, "b32", false, "Encode binary blobs using base32 instead of base64")
 	blockCmd.Flags().BoolVar(&strictJSON, "strict", false, "Strict JSON decode: turn all keys into strings")
+
+	ledgerCmd.AddCommand(watchCmd)
+	watchCmd.Flags().Uint64Var(&watchFrom, "from", 0, "First round to print (if not set, the next round to be committed)")
+	watchCmd.Flags().Uint64VarP(&watchCount, "count", "n", 0, "Number of rounds to print before exiting (if not set, print rounds until interrupted)")
+	watchCmd.Flags().StringSliceVarP(&watchAccounts, "account", "a", nil, "Print the transactions involving this account (can be repeated)")
+	watchCmd.Flags().UintSliceVar(&watchApps, "app-id", nil, "Print the transactions involving this application (can be repeated)")
+	watchCmd.Flags().UintSliceVar(&watchAssets, "asset", nil, "Print the transactions involving this asset (can be repeated)")
+	watchCmd.Flags().BoolVar(&watchAllTxns, "txns", false, "Print every transaction of the blocks")
+	watchCmd.Flags().BoolVar(&watchJSON, "json", false, "Print the rounds as JSON lines")
+
+	ledgerCmd.AddCommand(exportBlocksCmd)
+	exportBlocksCmd.Flags().Uint64Var(&exportFrom, "from", 0, "First round to export")
+	exportBlocksCmd.Flags().Uint64Var(&exportTo, "to", 0, "Last round to export (if not set, the last round of the node)")
+	exportBlocksCmd.Flags().StringVarP(&exportDir, "out", "o", "", "The directory to write the block archive into")
+	exportBlocksCmd.Flags().Uint64Var(&exportChunkRounds, "chunk-rounds", catchup.DefaultBlockArchiveChunkRounds, "Number of blocks per chunk file")
+	exportBlocksCmd.MarkFlagRequired("out")
 }
 
 var ledgerCmd = &cobra.Command{
@@ -111,3 +146,228 @@ var blockCmd = &cobra.Command{
 		}
 	},
 }
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Print a summary of each new block, like tail -f for the chain",
+	Long:  "Print the round, timestamp, proposer and number of transactions of each block as the node commits it. The transactions involving the accounts, applications or assets given as filters are printed below their block, including those which only involve them through inner transactions.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		filter := watchFilter{
+			all:      watchAllTxns,
+			accounts: make(map[basics.Address]bool),
+			apps:     make(map[basics.AppIndex]bool),
+			assets:   make(map[basics.AssetIndex]bool),
+		}
+		accountList := makeAccountsList(dataDir)
+		for _, account := range watchAccounts {
+			addr, err := basics.UnmarshalChecksumAddress(accountList.getAddressByName(account))
+			if err != nil {
+				reportErrorf(errorParseAddr, err)
+			}
+			filter.accounts[addr] = true
+		}
+		for _, app := range watchApps {
+			filter.apps[basics.AppIndex(app)] = true
+		}
+		for _, asset := range watchAssets {
+			filter.assets[basics.AssetIndex(asset)] = true
+		}
+
+		status, err := client.Status()
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		lastRound := status.LastRound
+		round := watchFrom
+		if round == 0 {
+			round = lastRound + 1
+		}
+		for printed := uint64(0); watchCount == 0 || printed < watchCount; printed++ {
+			for lastRound < round {
+				status, err = client.WaitForRound(lastRound)
+				if err != nil {
+					reportErrorf(errorNodeStatus, err)
+				}
+				lastRound = status.LastRound
+			}
+			blockCert, err := client.EncodedBlockCert(round)
+			if err != nil {
+				reportErrorf(errorRequestFail, err)
+			}
+			summary, err := summarizeWatchedBlock(blockCert, filter)
+			if err != nil {
+				reportErrorf(errorRequestFail, err)
+			}
+			if watchJSON {
+				line, err := json.Marshal(summary)
+				if err != nil {
+					reportErrorf(errEncodingBlockAsJSON, err)
+				}
+				fmt.Println(string(line))
+			} else {
+				fmt.Print(summary.String())
+			}
+			round++
+		}
+	},
+}
+
+var exportBlocksCmd = &cobra.Command{
+	Use:   "export-blocks",
+	Short: "Export a range of blocks into a verifiable block archive",
+	Long:  "Export the blocks and certificates of a range of rounds into a block archive: a directory of checksummed msgpack chunk files, along with a manifest chaining their checksums. A new node catches up from the archive when its CatchupBlockArchiveDir is set to the archive directory, authenticating each block against its certificate.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		status, err := client.Status()
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		to := exportTo
+		if to == 0 {
+			to = status.LastRound
+		}
+		if exportFrom > to || to > status.LastRound {
+			reportErrorf(errExportBlocksRange, exportFrom, to, status.LastRound)
+		}
+
+		var writer *catchup.BlockArchiveWriter
+		for round := exportFrom; round <= to; round++ {
+			encoded, err := client.RawBlock(round)
+			if err != nil {
+				reportErrorf(errExportBlocks, round, err)
+			}
+			if writer == nil {
+				var blockCert rpcs.EncodedBlockCert
+				err = protocol.Decode(encoded, &blockCert)
+				if err != nil {
+					reportErrorf(errExportBlocks, round, err)
+				}
+				writer, err = catchup.CreateBlockArchive(exportDir, blockCert.Block.GenesisID(), blockCert.Block.GenesisHash(), exportChunkRounds)
+				if err != nil {
+					reportErrorf(errExportBlocks, round, err)
+				}
+			}
+			err = writer.Add(encoded)
+			if err != nil {
+				reportErrorf(errExportBlocks, round, err)
+			}
+		}
+		manifest, err := writer.Close()
+		if err != nil {
+			reportErrorf(errExportBlocks, to, err)
+		}
+		reportInfof(infoExportedBlocks, manifest.FirstRound, manifest.LastRound, len(manifest.Chunks), manifest.ChainHash)
+	},
+}
+
+// watchFilter selects the transactions printed by goal ledger watch.
+type watchFilter struct {
+	all      bool
+	accounts map[basics.Address]bool
+	apps     map[basics.AppIndex]bool
+	assets   map[basics.AssetIndex]bool
+}
+
+// matches returns whether the transaction, or one of its inner transactions, involves the filtered
+// accounts, applications or assets.
+func (f watchFilter) matches(stxn transactions.SignedTxnWithAD) bool {
+	if f.all {
+		return true
+	}
+	tx := stxn.Txn
+	addrs := []basics.Address{tx.Sender, tx.Receiver, tx.CloseRemainderTo, tx.AssetSender, tx.AssetReceiver, tx.AssetCloseTo, tx.FreezeAccount, tx.RekeyTo}
+	addrs = append(addrs, tx.Accounts...)
+	for _, addr := range addrs {
+		if f.accounts[addr] && !addr.IsZero() {
+			return true
+		}
+	}
+	apps := append([]basics.AppIndex{tx.ApplicationID, stxn.ApplyData.ApplicationID}, tx.ForeignApps...)
+	for _, app := range apps {
+		if f.apps[app] && app != 0 {
+			return true
+		}
+	}
+	assets := append([]basics.AssetIndex{tx.XferAsset, tx.ConfigAsset, tx.FreezeAsset, stxn.ApplyData.ConfigAsset}, tx.ForeignAssets...)
+	for _, asset := range assets {
+		if f.assets[asset] && asset != 0 {
+			return true
+		}
+	}
+	for _, inner := range stxn.ApplyData.EvalDelta.InnerTxns {
+		if f.matches(inner) {
+			return true
+		}
+	}
+	return false
+}
+
+// watchedBlock is the summary of a block printed by goal ledger watch.
+type watchedBlock struct {
+	Round     uint64       `json:"round"`
+	Timestamp int64        `json:"timestamp"`
+	Proposer  string       `json:"proposer,omitempty"`
+	Txns      int          `json:"txns"`
+	Matches   []watchedTxn `json:"matches,omitempty"`
+}
+
+// watchedTxn is a transaction of a block selected by the filter of goal ledger watch.
+type watchedTxn struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Sender string `json:"sender"`
+	Inner  int    `json:"inner,omitempty"`
+}
+
+func summarizeWatchedBlock(blockCert rpcs.EncodedBlockCert, filter watchFilter) (watchedBlock, error) {
+	block := blockCert.Block
+	summary := watchedBlock{
+		Round:     uint64(block.Round()),
+		Timestamp: block.TimeStamp,
+		Txns:      len(block.Payset),
+	}
+	if proposer := blockCert.Certificate.Proposal.OriginalProposer; !proposer.IsZero() {
+		summary.Proposer = proposer.String()
+	}
+	payset, err := block.DecodePaysetFlat()
+	if err != nil {
+		return watchedBlock{}, err
+	}
+	for _, stxn := range payset {
+		if !filter.matches(stxn) {
+			continue
+		}
+		summary.Matches = append(summary.Matches, watchedTxn{
+			ID:     stxn.Txn.ID().String(),
+			Type:   string(stxn.Txn.Type),
+			Sender: stxn.Txn.Sender.String(),
+			Inner:  len(stxn.ApplyData.EvalDelta.InnerTxns),
+		})
+	}
+	return summary, nil
+}
+
+// String formats the summary as a line, followed by a line per selected transaction.
+func (b watchedBlock) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Round %d [%s]", b.Round, time.Unix(b.Timestamp, 0).UTC().Format(time.RFC3339))
+	if b.Proposer != "" {
+		fmt.Fprintf(&buf, " proposer %s", b.Proposer)
+	}
+	fmt.Fprintf(&buf, ", %d transactions\n", b.Txns)
+	for _, txn := range b.Matches {
+		fmt.Fprintf(&buf, "  %s %-5s from %s", txn.ID, txn.Type, txn.Sender)
+		if txn.Inner > 0 {
+			fmt.Fprintf(&buf, " (%d inner)", txn.Inner)
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeWatchedBlock(t *testing.T) rpcs.EncodedBlockCert {
+	alice := basics.Address{1}
+	bob := basics.Address{2}
+	carol := basics.Address{3}
+
+	pay := transactions.SignedTxnWithAD{SignedTxn: transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:             protocol.PaymentTx,
+		Header:           transactions.Header{Sender: alice},
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: bob},
+	}}}
+	call := transactions.SignedTxnWithAD{SignedTxn: transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:                     protocol.ApplicationCallTx,
+		Header:                   transactions.Header{Sender: bob},
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{ApplicationID: 5},
+	}}}
+	call.ApplyData.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{{SignedTxn: transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:                   protocol.AssetTransferTx,
+		Header:                 transactions.Header{Sender: basics.AppIndex(5).Address()},
+		AssetTransferTxnFields: transactions.AssetTransferTxnFields{XferAsset: 9, AssetReceiver: carol},
+	}}}}
+
+	block := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+		Round:        7,
+		TimeStamp:    1700000000,
+		GenesisID:    "test",
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusCurrentVersion},
+	}}
+	for _, stxn := range []transactions.SignedTxnWithAD{pay, call} {
+		stib, err := block.EncodeSignedTxn(stxn.SignedTxn, stxn.ApplyData)
+		require.NoError(t, err)
+		block.Payset = append(block.Payset, stib)
+	}
+
+	var cert agreement.Certificate
+	cert.Proposal.OriginalProposer = carol
+	return rpcs.EncodedBlockCert{Block: block, Certificate: cert}
+}
+
+func TestLedgerWatchFilter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	blockCert := makeWatchedBlock(t)
+	filterOf := func(accounts []basics.Address, apps []basics.AppIndex, assets []basics.AssetIndex) watchFilter {
+		f := watchFilter{accounts: map[basics.Address]bool{}, apps: map[basics.AppIndex]bool{}, assets: map[basics.AssetIndex]bool{}}
+		for _, a := range accounts {
+			f.accounts[a] = true
+		}
+		for _, a := range apps {
+			f.apps[a] = true
+		}
+		for _, a := range assets {
+			f.assets[a] = true
+		}
+		return f
+	}
+	types := func(f watchFilter) (res []string) {
+		summary, err := summarizeWatchedBlock(blockCert, f)
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), summary.Round)
+		require.Equal(t, 2, summary.Txns)
+		require.Equal(t, basics.Address{3}.String(), summary.Proposer)
+		for _, m := range summary.Matches {
+			res = append(res, m.Type)
+		}
+		return
+	}
+
+	require.Empty(t, types(filterOf(nil, nil, nil)))
+	require.Equal(t, []string{"pay"}, types(filterOf([]basics.Address{{1}}, nil, nil)))
+	require.Equal(t, []string{"pay", "appl"}, types(filterOf([]basics.Address{{2}}, nil, nil)))
+	// through the inner transaction only
+	require.Equal(t, []string{"appl"}, types(filterOf([]basics.Address{{3}}, nil, nil)))
+	require.Equal(t, []string{"appl"}, types(filterOf(nil, nil, []basics.AssetIndex{9})))
+	require.Equal(t, []string{"appl"}, types(filterOf(nil, []basics.AppIndex{5}, nil)))
+	require.Empty(t, types(filterOf([]basics.Address{{}}, []basics.AppIndex{0}, []basics.AssetIndex{0})))
+	require.Equal(t, []string{"pay", "appl"}, types(watchFilter{all: true}))
+}
+
+func TestLedgerWatchOutput(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	summary, err := summarizeWatchedBlock(makeWatchedBlock(t), watchFilter{all: true})
+	require.NoError(t, err)
+	require.Len(t, summary.Matches, 2)
+	require.Equal(t, 1, summary.Matches[1].Inner)
+
+	text := summary.String()
+	require.Contains(t, text, "Round 7 [2023-11-14T22:13:20Z] proposer "+basics.Address{3}.String()+", 2 transactions\n")
+	require.Contains(t, text, summary.Matches[0].ID+" pay   from "+basics.Address{1}.String()+"\n")
+	require.Contains(t, text, "(1 inner)\n")
+
+	data, err := json.Marshal(summary)
+	require.NoError(t, err)
+	var decoded watchedBlock
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, summary, decoded)
+}
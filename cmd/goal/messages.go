@@ -78,11 +78,30 @@ const (
 	errorNodeRunning                        = "Node must be stopped before writing APIToken"
 	errorNodeFailGenToken                   = "Cannot generate API token: %s"
 	errorNodeCreation                       = "Error during node creation: %v"
+	errorNodeAddNetwork                     = "Cannot add network: %v"
+	infoNodeAddedNetwork                    = "Added network %s in %s, restart the node to start it"
 	errorNodeManagedBySystemd               = "This node is using systemd and should be managed with systemctl. For additional information refer to https://developer.algorand.org/docs/run-a-node/setup/install/#installing-algod-as-a-systemd-service"
 	errorKill                               = "Cannot kill node: %s"
 	errorCloningNode                        = "Error cloning the node: %s"
 	infoNodeCloned                          = "Node cloned successfully to: %s"
 	infoNodeWroteToken                      = "Successfully wrote new API token: %s"
+	infoNodeCreatedNamedToken               = "Created API token '%s' with scopes %s: %s"
+	infoNodeRevokedNamedToken               = "Revoked API token '%s'"
+	infoNodeNoNamedTokens                   = "No named API tokens"
+	errorNodeNamedToken                     = "Cannot manage API tokens: %s"
+	infoNodeDiagnosticsWritten              = "Wrote diagnostics to %s: %d of %d items collected, see manifest.json for the missing ones"
+	infoConsoleWelcome                      = "goal console: type help for the built-in commands, exit or Ctrl-D to leave"
+	errorConsoleTerminal                    = "Console terminal error: %v"
+	errorConsoleSetUsage                    = "usage: set NAME VALUE, where NAME is made of letters, digits and underscores"
+	errorConsoleUnsetUsage                  = "usage: unset NAME"
+	errorConsoleNested                      = "Already in the goal console"
+	errorConsoleNoHistory                   = "The history is empty"
+	errorConsoleHistoryEntry                = "No history entry %s"
+	errorConsoleUnknownVar                  = "Unknown variable $%s"
+	errorConsoleUnterminatedQuote           = "Unterminated quote"
+	errorConsoleEmptyLine                   = "Nothing to run"
+	warnConsoleVars                         = "Cannot save or load the console variables in %s: %v"
+	warnConsoleHistory                      = "Cannot save the console history in %s: %v"
 	infoNodePendingTxnsDescription          = "Pending Transactions (Truncated max=%d, Total in pool=%d): "
 	infoNodeNoPendingTxnsDescription        = "None"
 	infoDataDir                             = "[Data Directory: %s]"
@@ -134,6 +153,40 @@ const (
 	infoAutoFeeSet             = "Automatically set fee to %d MicroAlgos"
 	errorTransactionExpired    = "Transaction %s expired before it could be included in a block"
 
+	errorRekeyNoTarget           = "Must specify one of --to or --msig-params"
+	errorRekeyMsigMismatch       = "The multisig parameters define address %s, not %s"
+	errorRekeyAlreadyAuthorized  = "%s is already authorized to sign for %s"
+	errorRekeyCurrentAuthNotHeld = "The wallet does not hold the key of %s, which is authorized to sign for %s"
+	errorRekeyTestSign           = "Test signature with the new authorized address failed: %s"
+	errorRekeyDryRunFailed       = "Simulated rekey failed: %s"
+	errorRekeyRegistryLoad       = "Ignoring unreadable rekey registry %s: %v"
+	errorRekeyRegistrySave       = "Rekey succeeded but could not be recorded in the rekey registry: %v"
+	errorRekeyedToMultisig       = "Account %s is rekeyed to multisig %s: write the transaction with -o and sign it with goal clerk multisig sign"
+	warnRekeyTargetNotHeld       = "Warning: the wallet does not hold the key of %s. Unless you control it elsewhere (e.g. it is a multisig or logic signature address), the account will become unusable."
+	warnRekeyUnknownSigners      = "Warning: the wallet does not hold %d of the %d multisig keys: %s"
+	warnRekeyBelowThreshold      = "Warning: the wallet holds only %d multisig keys but %d signatures are required"
+	warnRekeyRegistryStale       = "Warning: the rekey registry entry of %s no longer matches the node, ignoring it"
+	infoRekeyTestSigned          = "Test signature with %s succeeded"
+	infoRekeyDryRun              = "Dry run: rekeying %s to %s would succeed. No transaction was sent."
+	infoRekeyConfirm             = "Rekey %s to %s? Future transactions from the account must be signed by the new address. [y/N] "
+	infoRekeyAborted             = "Rekey aborted"
+	infoRekeyIssued              = "Rekeying %s to %s, transaction ID: %s"
+	infoRekeyRegistrySigner      = "Signing with %s, which %s was rekeyed to"
+
+	errorKeyregPartKeyExpired  = "Participation key %s expired at round %d, the latest round is %d"
+	errorKeyregMismatch        = "The keyreg transaction returned by the node does not match participation key %s: %s"
+	warnKeyregAlreadyEffective = "Warning: participation key %s is already registered, effective since round %d"
+	infoKeyregWritten          = "Unsigned keyreg transaction of %s written to %s, registering participation key %s for rounds %d to %d with key dilution %d. Sign it with goal clerk sign."
+
+	errorMigrateTarget            = "Invalid destination %s: %v"
+	errorMigrateTargetUnusable    = "Destination %s does not accept participation keys: %v"
+	errorMigrateImportFail        = "Import on %s failed, the participation key is still installed on this node: %v"
+	errorMigrateAlreadyRegistered = "Destination %s already has participation key %s"
+	errorMigrateUnconfirmed       = "Cannot confirm that %s registered participation key %[2]s, which is still installed on this node: check GET /v2/participation/%[2]s on the destination, and remove the key from one of the nodes before the next vote: %[3]v"
+	errorMigrateRemoveFail        = "Participation key %s is registered on %s but could not be removed from this node, which now votes with the same key: remove it with goal account deletepartkey. %v"
+	errorMigrateIDMismatch        = "Destination %s installed participation key %s instead of %s"
+	infoMigrateDone               = "Participation key %s moved to %s"
+
 	loggingNotConfigured = "Remote logging is not currently configured and won't be enabled"
 	loggingNotEnabled    = "Remote logging is current disabled"
 	loggingEnabled       = "Remote logging is enabled.  Node = %s, Guid = %s"
@@ -146,6 +199,8 @@ const (
 	infoNetworkStarted       = "Network Started under %s"
 	infoNetworkStopped       = "Network Stopped under %s"
 	infoNetworkDeleted       = "Network Deleted under %s"
+	infoNetworkDeployed      = "Created %s with ID %d"
+	errorDeployingNetwork    = "Error creating network template assets and applications: %s"
 
 	multisigProgramCollision = "should have at most one of --program/-p | --program-bytes/-P | --lsig/-L"
 
@@ -171,6 +226,7 @@ const (
 	// Wallet
 	infoRecoveryPrompt           = "Please type your recovery mnemonic below, and hit return when you are done: "
 	infoChoosePasswordPrompt     = "Please choose a password for wallet '%s': "
+	infoBIP39PassphrasePrompt    = "Please type the BIP-39 passphrase of the mnemonic, or hit return if it has none: "
 	infoPasswordConfirmation     = "Please confirm the password: "
 	infoCreatingWallet           = "Creating wallet..."
 	infoCreatedWallet            = "Created wallet '%s'"
@@ -186,6 +242,7 @@ const (
 	errorPasswordConfirmation    = "Password confirmation did not match"
 	errorBadMnemonic             = "Problem with mnemonic: %s"
 	errorBadRecoveredKey         = "Recovered invalid key"
+	errorBIP39NeedsRecover       = "--bip39 requires recovering the wallet with -r, and --hd-account requires --bip39"
 	errorFailedToReadResponse    = "Couldn't read response: %s"
 	errorFailedToReadPassword    = "Couldn't read password: %s"
 
@@ -207,4 +264,14 @@ const (
 	errParsingRoundNumber  = "Error parsing round number: %s"
 	errBadBlockArgs        = "Cannot combine --b32=true or --strict=true with --raw"
 	errEncodingBlockAsJSON = "Error encoding block as json: %s"
+	errExportBlocksRange   = "Invalid round range %d-%d: the last round of the node is %d"
+	errExportBlocks        = "Error exporting block %d: %s"
+	infoExportedBlocks     = "Exported blocks %d-%d into %d chunks, chain hash %s"
+
+	// State proofs
+	errStateProofNoCheckpoint     = "No checkpoint found in %s: specify the trusted voters round to start from with --start"
+	errStateProofCheckpoint       = "Cannot make a checkpoint from the header of round %d: %s"
+	errStateProofDecodeCheckpoint = "Cannot decode checkpoint %s: %s"
+	errStateProofVerify           = "State proof verification failed: %s"
+	infoStateProofVerified        = "Verified state proof for rounds %d-%d"
 )
@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -81,8 +82,10 @@ var defaultNetworkTemplate string
 var networkCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a private named network from a template",
-	Long:  `Creates a collection of folders under the specified root directory that make up the entire private network named 'private' (simplifying cleanup).`,
-	Args:  validateNoPosArgsFn,
+	Long: `Creates a collection of folders under the specified root directory that make up the entire private network named 'private' (simplifying cleanup).
+
+Templates may fix genesis accounts to a Mnemonic, set per-node ConfigOverrides, and declare Assets and Applications that are created by their genesis wallets once the network is started.`,
+	Args: validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, _ []string) {
 		networkRootDir, err := filepath.Abs(networkRootDir)
 		if err != nil {
@@ -143,6 +146,7 @@ var networkCreateCmd = &cobra.Command{
 				reportErrorf(errorStartingNetwork, err)
 			}
 			reportInfof(infoNetworkStarted, networkRootDir)
+			deployNetworkTemplate(&network, binDir)
 		}
 	},
 }
@@ -163,6 +167,22 @@ func getNetworkAndBinDir() (netdeploy.Network, string) {
 	return network, binDir
 }
 
+// deployNetworkTemplate creates the template's assets and applications that don't exist yet
+func deployNetworkTemplate(network *netdeploy.Network, binDir string) {
+	created, err := network.Deploy(binDir)
+	names := make([]string, 0, len(created))
+	for name := range created {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		reportInfof(infoNetworkDeployed, name, created[name])
+	}
+	if err != nil {
+		reportErrorf(errorDeployingNetwork, err)
+	}
+}
+
 var networkStartCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Start a deployed private network",
@@ -176,6 +196,7 @@ var networkStartCmd = &cobra.Command{
 				reportErrorf(errorStartingNetwork, err)
 			}
 			reportInfof(infoNetworkStarted, networkRootDir)
+			deployNetworkTemplate(&network, binDir)
 		} else {
 			err := network.StartNode(binDir, startNode, false)
 			if err != nil {
@@ -198,6 +219,7 @@ var networkRestartCmd = &cobra.Command{
 			reportErrorf(errorStartingNetwork, err)
 		}
 		reportInfof(infoNetworkStarted, networkRootDir)
+		deployNetworkTemplate(&network, binDir)
 	},
 }
 
@@ -36,7 +36,9 @@ import (
 
 	"github.com/algorand/go-algorand/cmd/util/datadir"
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/libgoal"
 	"github.com/algorand/go-algorand/network"
@@ -58,9 +60,12 @@ var newNodeDestination string
 var newNodeArchival bool
 var newNodeRelay string
 var newNodeFullConfig bool
+var newNetworkName string
 var watchMillisecond uint64
 var abortCatchup bool
 var fastCatchupForce bool
+var tokenName string
+var tokenScopes []string
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -75,7 +80,9 @@ func init() {
 	nodeCmd.AddCommand(pendingTxnsCmd)
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
+	nodeCmd.AddCommand(addNetworkCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(tokenCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -110,6 +117,14 @@ func init() {
 	createCmd.MarkFlagRequired("destination")
 	createCmd.MarkFlagRequired("network")
 
+	addNetworkCmd.Flags().StringVar(&newNodeNetwork, "network", "", "Network to add")
+	addNetworkCmd.Flags().StringVar(&newNetworkName, "name", "", "Name of the data directory of the network within the data directory (default: the network)")
+	addNetworkCmd.Flags().BoolVarP(&newNodeArchival, "archival", "a", localDefaults.Archival, "Make the network archival, storing all blocks")
+	addNetworkCmd.Flags().StringVar(&newNodeRelay, "relay", localDefaults.NetAddress, "Configure as a relay of the network with specified listening address (NetAddress)")
+	addNetworkCmd.Flags().StringVar(&listenIP, "api", "", "REST API Endpoint of the network")
+	addNetworkCmd.Flags().BoolVar(&newNodeFullConfig, "full-config", false, "Store full config file")
+	addNetworkCmd.MarkFlagRequired("network")
+
 	pendingTxnsCmd.Flags().Uint64VarP(&maxPendingTransactions, "maxPendingTxn", "m", 0, "Cap the number of txns to fetch")
 	waitCmd.Flags().Uint32VarP(&waitSec, "waittime", "w", 5, "Time (in seconds) to wait for node to make progress")
 	statusCmd.Flags().Uint64VarP(&watchMillisecond, "watch", "w", 0, "Time (in milliseconds) between two successive status updates")
@@ -117,6 +132,16 @@ func init() {
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
 	catchupCmd.Flags().BoolVar(&fastCatchupForce, "force", false, "Forces fast catchup with implicit catchpoint to start without a consent prompt")
 
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenCmd.AddCommand(tokenCreateCmd)
+	tokenCmd.AddCommand(tokenRevokeCmd)
+	tokenCreateCmd.Flags().StringVarP(&tokenName, "name", "n", "", "Name of the token")
+	tokenCreateCmd.Flags().StringSliceVarP(&tokenScopes, "scope", "s", nil, "Endpoint groups granted to the token: read, submit, participation or admin")
+	tokenCreateCmd.MarkFlagRequired("name")
+	tokenCreateCmd.MarkFlagRequired("scope")
+	tokenRevokeCmd.Flags().StringVarP(&tokenName, "name", "n", "", "Name of the token")
+	tokenRevokeCmd.MarkFlagRequired("name")
+
 }
 
 var nodeCmd = &cobra.Command{
@@ -154,10 +179,31 @@ func getMissingCatchpointLabel(URL string) (label string, err error) {
 	return
 }
 
+// lookupCatchpointLabel returns the latest catchpoint label of the signed catchpoint feed configured in
+// CatchpointFeedURL, or else of the public catchpoints service.
+func lookupCatchpointLabel(dataDir string, vers common.Version) (string, error) {
+	cfg, err := config.LoadConfigFromDisk(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		reportErrorf(errLoadingConfig, dataDir, err)
+	}
+	if cfg.CatchpointFeedURL != "" {
+		publishers, err := ledgercore.ParseCatchpointFeedPublishers(cfg.CatchpointFeedPublishers)
+		if err != nil {
+			return "", err
+		}
+		var genesisHash crypto.Digest
+		copy(genesisHash[:], vers.GenesisHash)
+		return getSignedCatchpointLabel(cfg.CatchpointFeedURL, publishers, vers.GenesisID, genesisHash)
+	}
+	genesis := strings.Split(vers.GenesisID, "-")[0]
+	URL := fmt.Sprintf(catchpointURL, genesis)
+	return getMissingCatchpointLabel(URL)
+}
+
 var catchupCmd = &cobra.Command{
 	Use:     "catchup",
 	Short:   "Catchup the Algorand node to a specific catchpoint",
-	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round. Using external catchpoints is not a secure practice and should not be done for consensus participating nodes.\nIf no catchpoint is provided, this command attempts to lookup the latest catchpoint from algorand-catchpoints.s3.us-east-2.amazonaws.com.",
+	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round. Using external catchpoints is not a secure practice and should not be done for consensus participating nodes.\nIf no catchpoint is provided, this command attempts to lookup the latest catchpoint from algorand-catchpoints.s3.us-east-2.amazonaws.com, or from the signed catchpoint feed of CatchpointFeedURL when configured, verifying it is signed by one of the CatchpointFeedPublishers.",
 	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
@@ -168,9 +214,7 @@ var catchupCmd = &cobra.Command{
 				if err != nil {
 					reportErrorf(errorNodeStatus, err)
 				}
-				genesis := strings.Split(vers.GenesisID, "-")[0]
-				URL := fmt.Sprintf(catchpointURL, genesis)
-				label, err := getMissingCatchpointLabel(URL)
+				label, err := lookupCatchpointLabel(dataDir, vers)
 				if err != nil {
 					reportErrorf(errorCatchpointLabelMissing, errorUnableToLookupCatchpointLabel, err.Error())
 				}
@@ -629,7 +673,7 @@ var waitCmd = &cobra.Command{
 					reportErrorf(errorNodeStatus, err)
 				}
 				if startRound != stat.LastRound {
-					os.Exit(0)
+					exit(0)
 				}
 			}
 		}
@@ -655,66 +699,165 @@ var createCmd = &cobra.Command{
 	Short: "Create a node at the desired data directory for the desired network",
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, _ []string) {
+		createNodeDataDir(newNodeDestination, newNodeNetwork)
+	},
+}
 
-		// validate network input
-		validNetworks := map[string][]byte{
-			"mainnet": genesisMainnet,
-			"testnet": genesisTestnet,
-			"betanet": genesisBetanet,
-			"devnet":  genesisDevnet,
+var addNetworkCmd = &cobra.Command{
+	Use:   "add-network",
+	Short: "Add a network to the networks hosted by the node of the data directory",
+	Long:  "Creates a data directory for the network inside the data directory, and lists it in " + config.NetworksFilename + ". The node hosts every listed network in a single algod process, each with its own ledger, config and REST API, once restarted. The data directory itself only holds the settings of the process, such as logging and telemetry.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		if newNetworkName == "" {
+			newNetworkName = newNodeNetwork
 		}
-		var genesisContent []byte
-		var ok bool
-		if genesisContent, ok = validNetworks[newNodeNetwork]; !ok {
-			reportErrorf(errorNodeCreation, "passed network name invalid")
+		if filepath.IsAbs(newNetworkName) || filepath.Base(newNetworkName) != newNetworkName {
+			reportErrorf(errorNodeAddNetwork, "network name must be a plain directory name")
 		}
 
-		// validate and store passed options
-		localConfig := config.GetDefaultLocal()
-		if newNodeRelay != "" {
-			if isValidIP(newNodeRelay) {
-				localConfig.NetAddress = newNodeRelay
-			} else {
-				reportErrorf(errorNodeCreationIPFailure, newNodeRelay)
-			}
+		networks, err := config.LoadNetworksConfig(dataDir)
+		if err != nil && !os.IsNotExist(err) {
+			reportErrorf(errorNodeAddNetwork, err)
 		}
-		if listenIP != "" {
-			if isValidIP(listenIP) {
-				localConfig.EndpointAddress = listenIP
-			} else {
-				reportErrorf(errorNodeCreationIPFailure, listenIP)
+		for _, dir := range networks.DataDirs(dataDir) {
+			if dir == filepath.Join(dataDir, newNetworkName) {
+				reportErrorf(errorNodeAddNetwork, fmt.Sprintf("%s already lists %s", config.NetworksFilename, newNetworkName))
 			}
 		}
-		localConfig.Archival = newNodeArchival || newNodeRelay != ""
-		localConfig.RunHosted = runUnderHost
-		localConfig.EnableLedgerService = localConfig.Archival
-		localConfig.EnableBlockService = localConfig.Archival
 
-		// verify destination does not exist, and attempt to create destination folder
-		if util.FileExists(newNodeDestination) {
-			reportErrorf(errorNodeCreation, "destination folder already exists")
-		}
-		destPath := filepath.Join(newNodeDestination, "genesis.json")
-		err := os.MkdirAll(newNodeDestination, 0766)
+		createNodeDataDir(filepath.Join(dataDir, newNetworkName), newNodeNetwork)
+
+		networks.Networks = append(networks.Networks, config.NetworkEntry{DataDir: newNetworkName})
+		err = networks.SaveToDisk(dataDir)
 		if err != nil {
-			reportErrorf(errorNodeCreation, "could not create destination folder")
+			reportErrorf(errorNodeAddNetwork, err)
+		}
+		reportInfof(infoNodeAddedNetwork, newNodeNetwork, filepath.Join(dataDir, newNetworkName))
+	},
+}
+
+// createNodeDataDir creates the data directory of a node for the given network at destination,
+// configured from the node creation flags.
+func createNodeDataDir(destination string, network string) {
+	// validate network input
+	validNetworks := map[string][]byte{
+		"mainnet": genesisMainnet,
+		"testnet": genesisTestnet,
+		"betanet": genesisBetanet,
+		"devnet":  genesisDevnet,
+	}
+	var genesisContent []byte
+	var ok bool
+	if genesisContent, ok = validNetworks[network]; !ok {
+		reportErrorf(errorNodeCreation, "passed network name invalid")
+	}
+
+	// validate and store passed options
+	localConfig := config.GetDefaultLocal()
+	if newNodeRelay != "" {
+		if isValidIP(newNodeRelay) {
+			localConfig.NetAddress = newNodeRelay
+		} else {
+			reportErrorf(errorNodeCreationIPFailure, newNodeRelay)
 		}
+	}
+	if listenIP != "" {
+		if isValidIP(listenIP) {
+			localConfig.EndpointAddress = listenIP
+		} else {
+			reportErrorf(errorNodeCreationIPFailure, listenIP)
+		}
+	}
+	localConfig.Archival = newNodeArchival || newNodeRelay != ""
+	localConfig.RunHosted = runUnderHost
+	localConfig.EnableLedgerService = localConfig.Archival
+	localConfig.EnableBlockService = localConfig.Archival
+
+	// verify destination does not exist, and attempt to create destination folder
+	if util.FileExists(destination) {
+		reportErrorf(errorNodeCreation, "destination folder already exists")
+	}
+	destPath := filepath.Join(destination, "genesis.json")
+	err := os.MkdirAll(destination, 0766)
+	if err != nil {
+		reportErrorf(errorNodeCreation, "could not create destination folder")
+	}
 
-		// copy genesis block to destination
-		err = os.WriteFile(destPath, genesisContent, 0644)
+	// copy genesis block to destination
+	err = os.WriteFile(destPath, genesisContent, 0644)
+	if err != nil {
+		reportErrorf(errorNodeCreation, err)
+	}
+
+	// save config to destination
+	if newNodeFullConfig {
+		err = localConfig.SaveAllToDisk(destination)
+	} else {
+		err = localConfig.SaveToDisk(destination)
+	}
+	if err != nil {
+		reportErrorf(errorNodeCreation, err)
+	}
+}
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage the named API tokens of a running node",
+	Long:  "Named API tokens are accepted by the node in addition to its algod.token and algod.admin.token, and are scoped to groups of endpoints: read (read-only endpoints), submit (transaction submission, simulation and program compilation), participation (participation key management, the debugging endpoints excepted) and admin (every endpoint). They can be created and revoked without restarting the node.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//Fall back
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the named API tokens of the node",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		client := ensureAlgodClient(datadir.EnsureSingleDataDir())
+		resp, err := client.ListAPITokens()
 		if err != nil {
-			reportErrorf(errorNodeCreation, err)
+			reportErrorf(errorNodeNamedToken, err)
 		}
+		if len(resp.Tokens) == 0 {
+			reportInfoln(infoNodeNoNamedTokens)
+			return
+		}
+		for _, t := range resp.Tokens {
+			fmt.Printf("%s\t%s\n", t.Name, strings.Join(t.Scopes, ","))
+		}
+	},
+}
 
-		// save config to destination
-		if newNodeFullConfig {
-			err = localConfig.SaveAllToDisk(newNodeDestination)
-		} else {
-			err = localConfig.SaveToDisk(newNodeDestination)
+var tokenCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a named API token",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		client := ensureAlgodClient(datadir.EnsureSingleDataDir())
+		resp, err := client.CreateAPIToken(tokenName, tokenScopes)
+		if err != nil {
+			reportErrorf(errorNodeNamedToken, err)
 		}
+		reportInfof(infoNodeCreatedNamedToken, resp.Name, strings.Join(resp.Scopes, ","), resp.Token)
+	},
+}
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke a named API token",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		client := ensureAlgodClient(datadir.EnsureSingleDataDir())
+		err := client.RevokeAPIToken(tokenName)
 		if err != nil {
-			reportErrorf(errorNodeCreation, err)
+			reportErrorf(errorNodeNamedToken, err)
 		}
+		reportInfof(infoNodeRevokedNamedToken, tokenName)
 	},
 }
 
@@ -0,0 +1,476 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha512"
+	"encoding/base32"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Offline bundles carry unsigned transactions to an air-gapped machine,
+// along with what is needed to review and sign them there without a node.
+// They can be written as text frames for QR codes, which only use the
+// characters of the QR alphanumeric mode.
+const (
+	offlineBundleVersion    = 1
+	offlineFramePrefix      = "ALGOOFFLINE:"
+	defaultOfflineFrameSize = 1000
+)
+
+var offlineBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+var (
+	offlineMethods     []string
+	offlineQR          bool
+	offlineFrameSize   int
+	signOffline        bool
+	offlineKeyfile     string
+	offlineUseMnemonic bool
+)
+
+func init() {
+	clerkCmd.AddCommand(constructCmd)
+
+	constructCmd.Flags().StringVarP(&txFilename, "infile", "i", "", "File storing the unsigned transactions to construct the offline bundle from")
+	constructCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the offline bundle")
+	constructCmd.Flags().StringArrayVar(&offlineMethods, "method", nil, "ABI method signature called by the application call transactions, recorded so the arguments can be reviewed offline. Can be repeated")
+	constructCmd.Flags().BoolVar(&offlineQR, "qr", false, "Write the bundle as text frames suitable for QR codes")
+	constructCmd.Flags().IntVar(&offlineFrameSize, "qr-frame-size", defaultOfflineFrameSize, "Maximum number of characters of each QR frame")
+	constructCmd.MarkFlagRequired("infile")
+	constructCmd.MarkFlagRequired("outfile")
+}
+
+// offlineMethodInfo describes the ABI method called by a transaction of an
+// offline bundle
+type offlineMethodInfo struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	TxnIndex  uint64   `codec:"txn-index"`
+	Signature string   `codec:"signature"`
+	Args      []string `codec:"args"`
+}
+
+// offlineBundle is the signing context embedded with the transactions
+type offlineBundle struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version          uint64                   `codec:"version"`
+	GenesisID        string                   `codec:"genesis-id"`
+	GenesisHash      crypto.Digest            `codec:"genesis-hash"`
+	ConsensusVersion string                   `codec:"consensus-version"`
+	Fee              uint64                   `codec:"fee"`
+	MinFee           uint64                   `codec:"min-fee"`
+	LastRound        uint64                   `codec:"last-round"`
+	Txns             []transactions.SignedTxn `codec:"txns"`
+	Methods          []offlineMethodInfo      `codec:"methods"`
+}
+
+var constructCmd = &cobra.Command{
+	Use:   "construct -i [input file] -o [output file]",
+	Short: "Construct an offline bundle of unsigned transactions",
+	Long:  `Wrap unsigned transactions in an offline bundle, embedding the genesis and suggested parameters of the network and the ABI methods called, so that they can be reviewed and signed on a machine without any node connection with "goal clerk sign --offline".`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+		params, err := client.SuggestedParams()
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+
+		bundle := offlineBundle{
+			Version:          offlineBundleVersion,
+			GenesisID:        params.GenesisId,
+			ConsensusVersion: params.ConsensusVersion,
+			Fee:              params.Fee,
+			MinFee:           params.MinFee,
+			LastRound:        params.LastRound,
+			Txns:             decodeTxnsFromFile(txFilename),
+		}
+		copy(bundle.GenesisHash[:], params.GenesisHash)
+
+		for i, stxn := range bundle.Txns {
+			if stxn.Txn.GenesisHash != bundle.GenesisHash {
+				reportErrorf("Transaction #%d with ID of %s is not for network %s", i, stxn.ID().String(), bundle.GenesisID)
+			}
+			method, err := offlineMethodOf(uint64(i), stxn.Txn, offlineMethods)
+			if err != nil {
+				reportErrorf("Transaction #%d with ID of %s: %v", i, stxn.ID().String(), err)
+			}
+			if method != nil {
+				bundle.Methods = append(bundle.Methods, *method)
+			}
+		}
+
+		err = writeOfflinePayload(outFilename, protocol.EncodeJSON(&bundle), offlineQR)
+		if err != nil {
+			reportErrorf(fileWriteError, outFilename, err)
+		}
+		reportInfof("Wrote an offline bundle of %d transactions to %s", len(bundle.Txns), outFilename)
+	},
+}
+
+// offlineMethodOf matches the method selector of an application call with
+// one of the method signatures, and decodes its arguments
+func offlineMethodOf(index uint64, txn transactions.Transaction, signatures []string) (*offlineMethodInfo, error) {
+	if txn.Type != protocol.ApplicationCallTx || len(txn.ApplicationArgs) == 0 {
+		return nil, nil
+	}
+
+	for _, sig := range signatures {
+		selector := sha512.Sum512_256([]byte(sig))
+		if !bytes.Equal(selector[:4], txn.ApplicationArgs[0]) {
+			continue
+		}
+
+		_, argTypes, _, err := abi.ParseMethodSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		info := &offlineMethodInfo{TxnIndex: index, Signature: sig}
+		appArg := 1
+		for _, argType := range argTypes {
+			if abi.IsTransactionType(argType) {
+				continue
+			}
+			// Arguments past the 15th are packed in a tuple, which is left
+			// for the signer to check against the raw arguments
+			if appArg >= len(txn.ApplicationArgs) || appArg > methodArgsTupleThreshold {
+				break
+			}
+			raw := txn.ApplicationArgs[appArg]
+			appArg++
+
+			if abi.IsReferenceType(argType) {
+				info.Args = append(info.Args, fmt.Sprintf("%s #%d", argType, raw))
+				continue
+			}
+			t, err := abi.TypeOf(argType)
+			if err != nil {
+				return nil, err
+			}
+			value, err := t.Decode(raw)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode argument %d of %s: %w", appArg-1, sig, err)
+			}
+			encoded, err := t.MarshalToJSON(value)
+			if err != nil {
+				return nil, err
+			}
+			info.Args = append(info.Args, string(encoded))
+		}
+		return info, nil
+	}
+	return nil, nil
+}
+
+// signOfflineFile implements goal clerk sign --offline, signing the
+// transactions of an offline bundle or of a transaction file with a key
+// read from a file or a mnemonic, without contacting kmd or algod
+func signOfflineFile() {
+	if programSource != "" || logicSigFile != "" {
+		reportErrorln("goal clerk sign --offline signs with a key, not with --program/-p or --logic-sig/-L")
+	}
+
+	data, err := readOfflinePayload(txFilename)
+	if err != nil {
+		reportErrorf(fileReadError, txFilename, err)
+	}
+
+	var bundle offlineBundle
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		err = protocol.DecodeJSON(trimmed, &bundle)
+		if err != nil {
+			reportErrorf(txDecodeError, txFilename, err)
+		}
+		if bundle.Version != offlineBundleVersion {
+			reportErrorf("%s: unsupported offline bundle version %d", txFilename, bundle.Version)
+		}
+		bundle.Methods, err = decodeOfflineMethods(bundle)
+		if err != nil {
+			reportErrorf("%s: %v", txFilename, err)
+		}
+	} else {
+		dec := protocol.NewMsgpDecoderBytes(data)
+		for {
+			var stxn transactions.SignedTxn
+			err = dec.Decode(&stxn)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				reportErrorf(txDecodeError, txFilename, err)
+			}
+			bundle.Txns = append(bundle.Txns, stxn)
+		}
+	}
+
+	seed := loadOfflineSeed()
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	keyAddr := basics.Address(secrets.SignatureVerifier)
+
+	var outData []byte
+	signed := 0
+	for i, stxn := range bundle.Txns {
+		printOfflineTxn(i, stxn.Txn, bundle.Methods)
+
+		if bundle.Version != 0 && stxn.Txn.GenesisHash != bundle.GenesisHash {
+			reportErrorf("Transaction #%d with ID of %s is not for the network of the bundle", i, stxn.ID().String())
+		}
+
+		// Sign the transactions the key can authorize, and keep the others
+		// for their own signers
+		authAddr := stxn.Txn.Sender
+		if !stxn.AuthAddr.IsZero() {
+			authAddr = stxn.AuthAddr
+		}
+		if signerAddress != "" {
+			authAddr, err = basics.UnmarshalChecksumAddress(signerAddress)
+			if err != nil {
+				reportErrorf("Signer invalid (%s): %v", signerAddress, err)
+			}
+		}
+		if authAddr == keyAddr {
+			stxn = stxn.Txn.Sign(secrets)
+			if keyAddr != stxn.Txn.Sender {
+				stxn.AuthAddr = keyAddr
+			}
+			signed++
+		}
+		outData = append(outData, protocol.Encode(&stxn)...)
+	}
+	reportInfof("Signed %d of %d transactions with %s", signed, len(bundle.Txns), keyAddr.String())
+
+	err = writeOfflinePayload(outFilename, outData, offlineQR)
+	if err != nil {
+		reportErrorf(fileWriteError, outFilename, err)
+	}
+}
+
+// loadOfflineSeed reads the signing key from --keyfile, or prompts for the
+// mnemonic of the account
+func loadOfflineSeed() (seed crypto.Seed) {
+	if offlineKeyfile != "" && offlineUseMnemonic {
+		reportErrorln("goal clerk sign --offline should have at most one of --keyfile or --mnemonic")
+	}
+
+	var key []byte
+	if offlineKeyfile != "" {
+		data, err := readFile(offlineKeyfile)
+		if err != nil {
+			reportErrorf(fileReadError, offlineKeyfile, err)
+		}
+		key = data
+	} else {
+		fmt.Printf("Please type the mnemonic of the signing account: ")
+		mnemonic := string(ensurePassword())
+		data, err := passphrase.MnemonicToKey(strings.TrimSpace(mnemonic))
+		if err != nil {
+			reportErrorf(errorBadMnemonic, err)
+		}
+		key = data
+	}
+
+	if len(key) != len(seed) {
+		reportErrorln(errorBadRecoveredKey)
+	}
+	copy(seed[:], key)
+	return
+}
+
+// decodeOfflineMethods decodes the arguments of the methods recorded in an
+// offline bundle from the application arguments of their transactions, so
+// that the arguments reviewed are the ones signed. It fails when the methods
+// recorded differ from the transactions they describe.
+func decodeOfflineMethods(bundle offlineBundle) ([]offlineMethodInfo, error) {
+	var methods []offlineMethodInfo
+	described := make(map[uint64]bool)
+	for _, m := range bundle.Methods {
+		if m.TxnIndex >= uint64(len(bundle.Txns)) {
+			return nil, fmt.Errorf("the bundle describes the method of transaction #%d, out of its %d transactions", m.TxnIndex, len(bundle.Txns))
+		}
+		if described[m.TxnIndex] {
+			return nil, fmt.Errorf("the bundle describes the method of transaction #%d more than once", m.TxnIndex)
+		}
+		described[m.TxnIndex] = true
+
+		info, err := offlineMethodOf(m.TxnIndex, bundle.Txns[m.TxnIndex].Txn, []string{m.Signature})
+		if err != nil {
+			return nil, fmt.Errorf("transaction #%d: %w", m.TxnIndex, err)
+		}
+		if info == nil {
+			return nil, fmt.Errorf("transaction #%d does not call the method %s the bundle describes", m.TxnIndex, m.Signature)
+		}
+		if len(info.Args) != len(m.Args) {
+			return nil, fmt.Errorf("the arguments of %s the bundle describes for transaction #%d differ from the ones of the transaction", m.Signature, m.TxnIndex)
+		}
+		for i := range info.Args {
+			if info.Args[i] != m.Args[i] {
+				return nil, fmt.Errorf("the arguments of %s the bundle describes for transaction #%d differ from the ones of the transaction", m.Signature, m.TxnIndex)
+			}
+		}
+		methods = append(methods, *info)
+	}
+	return methods, nil
+}
+
+// printOfflineTxn displays a transaction for review before signing, with the
+// arguments of its method decoded by decodeOfflineMethods
+func printOfflineTxn(index int, txn transactions.Transaction, methods []offlineMethodInfo) {
+	reportInfof("Transaction #%d: %s %s from %s, fee %d, valid rounds %d to %d on %s",
+		index, txn.Type, txn.ID().String(), txn.Sender.String(), txn.Fee.Raw, txn.FirstValid, txn.LastValid, txn.GenesisID)
+	switch txn.Type {
+	case protocol.PaymentTx:
+		reportInfof("  pay %d microAlgos to %s", txn.Amount.Raw, txn.Receiver.String())
+		if !txn.CloseRemainderTo.IsZero() {
+			reportInfof("  close the account to %s", txn.CloseRemainderTo.String())
+		}
+	case protocol.AssetTransferTx:
+		reportInfof("  transfer %d of asset %d to %s", txn.AssetAmount, txn.XferAsset, txn.AssetReceiver.String())
+	case protocol.ApplicationCallTx:
+		reportInfof("  call application %d with on-completion %s", txn.ApplicationID, txn.OnCompletion.String())
+	}
+	if !txn.RekeyTo.IsZero() {
+		reportInfof("  rekey the account to %s", txn.RekeyTo.String())
+	}
+	for _, m := range methods {
+		if m.TxnIndex == uint64(index) {
+			reportInfof("  method %s(%s)", m.Signature, strings.Join(m.Args, ", "))
+		}
+	}
+}
+
+// writeOfflinePayload writes data to filename, as QR frames if qr is set
+func writeOfflinePayload(filename string, data []byte, qr bool) error {
+	if !qr {
+		return writeFile(filename, data, 0600)
+	}
+	if offlineFrameSize <= len(offlineFramePrefix)+32 {
+		return fmt.Errorf("QR frame size %d is too small", offlineFrameSize)
+	}
+	return writeFile(filename, []byte(strings.Join(encodeOfflineFrames(data, offlineFrameSize), "\n")+"\n"), 0600)
+}
+
+// encodeOfflineFrames splits the base32 encoding of data into frames of at
+// most frameSize characters. Each frame is labelled with its position and the
+// checksum of the whole payload: ALGOOFFLINE:<index>/<count>/<CRC32>:<data>
+func encodeOfflineFrames(data []byte, frameSize int) []string {
+	encoded := offlineBase32.EncodeToString(data)
+	checksum := fmt.Sprintf("%08X", crc32.ChecksumIEEE(data))
+	// The header is at most the prefix, two 5-digit counters, the checksum
+	// and the separators
+	chunk := frameSize - len(offlineFramePrefix) - 2*5 - len(checksum) - 3
+
+	var chunks []string
+	for len(encoded) > chunk {
+		chunks = append(chunks, encoded[:chunk])
+		encoded = encoded[chunk:]
+	}
+	chunks = append(chunks, encoded)
+
+	frames := make([]string, len(chunks))
+	for i, c := range chunks {
+		frames[i] = fmt.Sprintf("%s%d/%d/%s:%s", offlineFramePrefix, i+1, len(chunks), checksum, c)
+	}
+	return frames
+}
+
+// decodeOfflineFrames reassembles the payload of QR frames, which may be
+// listed in any order
+func decodeOfflineFrames(frames []string) ([]byte, error) {
+	var checksum string
+	var chunks []string
+	for _, frame := range frames {
+		header, chunk, ok := strings.Cut(strings.TrimPrefix(frame, offlineFramePrefix), ":")
+		if !ok {
+			return nil, fmt.Errorf("malformed QR frame %q", frame)
+		}
+		parts := strings.Split(header, "/")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("malformed QR frame header %q", header)
+		}
+		index, err1 := strconv.Atoi(parts[0])
+		count, err2 := strconv.Atoi(parts[1])
+		if err1 != nil || err2 != nil || count < 1 || index < 1 || index > count {
+			return nil, fmt.Errorf("malformed QR frame header %q", header)
+		}
+		if chunks == nil {
+			chunks = make([]string, count)
+			checksum = parts[2]
+		}
+		if count != len(chunks) || parts[2] != checksum {
+			return nil, fmt.Errorf("QR frame %s belongs to another payload", header)
+		}
+		chunks[index-1] = chunk
+	}
+
+	for i, c := range chunks {
+		if c == "" {
+			return nil, fmt.Errorf("QR frame %d of %d is missing", i+1, len(chunks))
+		}
+	}
+	data, err := offlineBase32.DecodeString(strings.Join(chunks, ""))
+	if err != nil {
+		return nil, err
+	}
+	if fmt.Sprintf("%08X", crc32.ChecksumIEEE(data)) != checksum {
+		return nil, fmt.Errorf("QR frames checksum mismatch")
+	}
+	return data, nil
+}
+
+// readOfflinePayload reads filename, reassembling the payload if the file
+// holds QR frames
+func readOfflinePayload(filename string) ([]byte, error) {
+	data, err := readFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, []byte(offlineFramePrefix)) {
+		return data, nil
+	}
+
+	var frames []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			frames = append(frames, line)
+		}
+	}
+	return decodeOfflineFrames(frames)
+}
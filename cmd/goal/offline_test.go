@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"crypto/sha512"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestOfflineFramesRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	data := make([]byte, 3000)
+	crypto.RandBytes(data)
+
+	frames := encodeOfflineFrames(data, 200)
+	require.Greater(t, len(frames), 1)
+	for _, f := range frames {
+		require.LessOrEqual(t, len(f), 200)
+		// Only characters of the QR alphanumeric mode
+		require.Equal(t, strings.ToUpper(f), f)
+	}
+
+	// Frames may be scanned in any order
+	frames[0], frames[len(frames)-1] = frames[len(frames)-1], frames[0]
+	decoded, err := decodeOfflineFrames(frames)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	// Missing frames are detected
+	_, err = decodeOfflineFrames(frames[1:])
+	require.ErrorContains(t, err, "missing")
+
+	// So are frames of other payloads
+	other := encodeOfflineFrames(data[1:], 200)
+	_, err = decodeOfflineFrames(append(frames[1:], other[0]))
+	require.ErrorContains(t, err, "another payload")
+}
+
+func TestOfflineMethodOf(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sig := "add(uint64,uint64)uint64"
+	selector := sha512.Sum512_256([]byte(sig))
+	txn := transactions.Transaction{
+		Type: protocol.ApplicationCallTx,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID: 1,
+			ApplicationArgs: [][]byte{
+				selector[:4],
+				{0, 0, 0, 0, 0, 0, 0, 1},
+				{0, 0, 0, 0, 0, 0, 0, 2},
+			},
+		},
+	}
+
+	info, err := offlineMethodOf(3, txn, []string{"other()void", sig})
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	require.Equal(t, uint64(3), info.TxnIndex)
+	require.Equal(t, sig, info.Signature)
+	require.Equal(t, []string{"1", "2"}, info.Args)
+
+	// Unknown methods are not described
+	info, err = offlineMethodOf(3, txn, []string{"other()void"})
+	require.NoError(t, err)
+	require.Nil(t, info)
+}
+
+func TestDecodeOfflineMethods(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sig := "add(uint64,uint64)uint64"
+	selector := sha512.Sum512_256([]byte(sig))
+	call := transactions.Transaction{
+		Type: protocol.ApplicationCallTx,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID: 1,
+			ApplicationArgs: [][]byte{
+				selector[:4],
+				{0, 0, 0, 0, 0, 0, 0, 1},
+				{0, 0, 0, 0, 0, 0, 0, 2},
+			},
+		},
+	}
+	bundle := offlineBundle{
+		Txns:    []transactions.SignedTxn{{Txn: transactions.Transaction{Type: protocol.PaymentTx}}, {Txn: call}},
+		Methods: []offlineMethodInfo{{TxnIndex: 1, Signature: sig, Args: []string{"1", "2"}}},
+	}
+
+	methods, err := decodeOfflineMethods(bundle)
+	require.NoError(t, err)
+	require.Equal(t, bundle.Methods, methods)
+
+	// arguments differing from the ones of the transaction are refused
+	tampered := bundle
+	tampered.Methods = []offlineMethodInfo{{TxnIndex: 1, Signature: sig, Args: []string{"1", "20"}}}
+	_, err = decodeOfflineMethods(tampered)
+	require.ErrorContains(t, err, "differ")
+	tampered.Methods = []offlineMethodInfo{{TxnIndex: 1, Signature: sig, Args: []string{"1"}}}
+	_, err = decodeOfflineMethods(tampered)
+	require.ErrorContains(t, err, "differ")
+
+	// and so are methods the transaction does not call
+	tampered.Methods = []offlineMethodInfo{{TxnIndex: 1, Signature: "sub(uint64,uint64)uint64", Args: []string{"1", "2"}}}
+	_, err = decodeOfflineMethods(tampered)
+	require.ErrorContains(t, err, "does not call")
+	tampered.Methods = []offlineMethodInfo{{TxnIndex: 0, Signature: sig, Args: []string{"1", "2"}}}
+	_, err = decodeOfflineMethods(tampered)
+	require.ErrorContains(t, err, "does not call")
+
+	// or methods of missing or already described transactions
+	tampered.Methods = []offlineMethodInfo{{TxnIndex: 2, Signature: sig, Args: []string{"1", "2"}}}
+	_, err = decodeOfflineMethods(tampered)
+	require.ErrorContains(t, err, "out of")
+	tampered.Methods = append(bundle.Methods, bundle.Methods...)
+	_, err = decodeOfflineMethods(tampered)
+	require.ErrorContains(t, err, "more than once")
+}
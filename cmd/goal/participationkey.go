@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	apiClient "github.com/algorand/go-algorand/daemon/algod/api/client"
+)
+
+var (
+	migratePartKeyID   string
+	migrateTarget      string
+	migrateTargetToken string
+	migrateInsecure    bool
+)
+
+func init() {
+	accountCmd.AddCommand(participationKeyCmd)
+	participationKeyCmd.AddCommand(partkeyMigrateCmd)
+
+	partkeyMigrateCmd.Flags().StringVar(&migratePartKeyID, "partkeyid", "", "Participation Key ID to migrate (required)")
+	partkeyMigrateCmd.MarkFlagRequired("partkeyid")
+	partkeyMigrateCmd.Flags().StringVar(&migrateTarget, "to", "", "Admin endpoint of the destination node, as host:port or URL (required)")
+	partkeyMigrateCmd.MarkFlagRequired("to")
+	partkeyMigrateCmd.Flags().StringVar(&migrateTargetToken, "token", "", "Admin API token of the destination node (required)")
+	partkeyMigrateCmd.MarkFlagRequired("token")
+	partkeyMigrateCmd.Flags().BoolVar(&migrateInsecure, "insecure", false, "Allow a plain http destination, only accepted by a destination on the local host setting AdminAllowPlaintextParticipationKeyTransfer")
+}
+
+var participationKeyCmd = &cobra.Command{
+	Use:   "participationkey",
+	Short: "Manage the participation keys installed on the node",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		// Return the help text
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+// parseMigrationTarget returns the URL of the destination node of a participation key migration.
+// The scheme defaults to https, and plain http is refused unless allowHTTP is set.
+func parseMigrationTarget(target string, allowHTTP bool) (url.URL, error) {
+	if !strings.Contains(target, "://") {
+		target = "https://" + target
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return url.URL{}, err
+	}
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !allowHTTP {
+			return url.URL{}, fmt.Errorf("refusing to send participation keys over plain http to %s, use https or --insecure", u.Host)
+		}
+	default:
+		return url.URL{}, fmt.Errorf("unsupported scheme %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return url.URL{}, fmt.Errorf("missing host in %s", target)
+	}
+	if u.Path != "" && u.Path != "/" {
+		return url.URL{}, fmt.Errorf("unexpected path %s in %s", u.Path, target)
+	}
+	u.Path = ""
+	return *u, nil
+}
+
+// migrationTargetHasKey returns whether the destination node of a participation key migration has the participation
+// key registered, answering the GET /v2/participation/{participation-id} request.
+func migrationTargetHasKey(remote apiClient.RestClient, partKeyID string) (bool, error) {
+	resp, err := remote.GetParticipationKeyByID(partKeyID)
+	if err != nil {
+		var httpErr apiClient.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return resp.Id == partKeyID, nil
+}
+
+var partkeyMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Move a participation key to another node",
+	Long: `Move an installed participation key to another node, for example before retiring or upgrading this one. The key secrets are exported from this node and imported on the destination node, and the key is only deleted from this node once the destination reports it registered. Nothing is deleted from this node when the import fails.
+The destination must be the admin endpoint of a participating node, served over TLS (see AdminTLSCertFile), and the admin API tokens of both nodes are needed. The account registration is unchanged, so no keyreg transaction is needed.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		target, err := parseMigrationTarget(migrateTarget, migrateInsecure)
+		if err != nil {
+			reportErrorf(errorMigrateTarget, migrateTarget, err)
+		}
+
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+		remote := apiClient.MakeRestClient(target, migrateTargetToken)
+
+		// Make sure the destination accepts participation keys and does not have the key yet
+		registered, err := migrationTargetHasKey(remote, migratePartKeyID)
+		if err != nil {
+			reportErrorf(errorMigrateTargetUnusable, target.String(), err)
+		}
+		if registered {
+			reportErrorf(errorMigrateAlreadyRegistered, target.String(), migratePartKeyID)
+		}
+
+		secrets, err := client.ExportParticipationKey(migratePartKeyID)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+
+		resp, importErr := remote.ImportParticipationKey(secrets)
+		if importErr == nil && resp.PartId != migratePartKeyID {
+			reportErrorf(errorMigrateIDMismatch, target.String(), resp.PartId, migratePartKeyID)
+		}
+		// The import may have succeeded even if its response was lost, so the destination is asked either way
+		registered, err = migrationTargetHasKey(remote, migratePartKeyID)
+		if err != nil {
+			reportErrorf(errorMigrateUnconfirmed, target.String(), migratePartKeyID, err)
+		}
+		if !registered {
+			if importErr == nil {
+				importErr = fmt.Errorf("participation key %s is not registered", migratePartKeyID)
+			}
+			reportErrorf(errorMigrateImportFail, target.String(), importErr)
+		}
+
+		if err = client.RemoveParticipationKey(migratePartKeyID); err != nil {
+			reportErrorf(errorMigrateRemoveFail, migratePartKeyID, target.String(), err)
+		}
+		reportInfof(infoMigrateDone, migratePartKeyID, target.String())
+	},
+}
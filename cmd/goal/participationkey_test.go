@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiClient "github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseMigrationTarget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	u, err := parseMigrationTarget("node2.example.com:8443", false)
+	require.NoError(t, err)
+	require.Equal(t, "https://node2.example.com:8443", u.String())
+
+	u, err = parseMigrationTarget("https://10.0.0.2:8443/", false)
+	require.NoError(t, err)
+	require.Equal(t, "https://10.0.0.2:8443", u.String())
+
+	_, err = parseMigrationTarget("http://127.0.0.1:8081", false)
+	require.ErrorContains(t, err, "plain http")
+	u, err = parseMigrationTarget("http://127.0.0.1:8081", true)
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:8081", u.String())
+
+	_, err = parseMigrationTarget("ftp://node2:21", true)
+	require.ErrorContains(t, err, "unsupported scheme")
+	_, err = parseMigrationTarget("https://node2:8443/v2/participation", false)
+	require.ErrorContains(t, err, "unexpected path")
+	_, err = parseMigrationTarget("https://", false)
+	require.ErrorContains(t, err, "missing host")
+}
+
+func TestMigrationTargetHasKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code := int(status.Load())
+		if r.URL.Path != "/v2/participation/PARTID" {
+			code = http.StatusBadRequest
+		}
+		w.WriteHeader(code)
+		switch code {
+		case http.StatusOK:
+			w.Write([]byte(`{"id":"PARTID","address":"","key":{}}`))
+		default:
+			w.Write([]byte(`{"message":"failed"}`))
+		}
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	remote := apiClient.MakeRestClient(*u, "token")
+
+	registered, err := migrationTargetHasKey(remote, "PARTID")
+	require.NoError(t, err)
+	require.True(t, registered)
+
+	// the key is only reported missing when the destination says so
+	status.Store(http.StatusNotFound)
+	registered, err = migrationTargetHasKey(remote, "PARTID")
+	require.NoError(t, err)
+	require.False(t, registered)
+
+	status.Store(http.StatusInternalServerError)
+	_, err = migrationTargetHasKey(remote, "PARTID")
+	require.Error(t, err)
+}
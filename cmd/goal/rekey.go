@@ -0,0 +1,382 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/crypto"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	rekeyAccount    string
+	rekeyTarget     string
+	rekeyMsigParams string
+	rekeyTestSign   bool
+	rekeyDryRun     bool
+	rekeyYes        bool
+)
+
+const rekeyRegistryFileName = "rekeyRegistry.json"
+
+func init() {
+	accountCmd.AddCommand(rekeyCmd)
+
+	rekeyCmd.Flags().StringVarP(&rekeyAccount, "address", "a", "", "Account to rekey (required)")
+	rekeyCmd.MarkFlagRequired("address")
+	rekeyCmd.Flags().StringVarP(&rekeyTarget, "to", "t", "", "Address that will be authorized to sign for the account (required unless --msig-params is given)")
+	rekeyCmd.Flags().StringVar(&rekeyMsigParams, "msig-params", "", "Multisig pre image parameters of the new authorized address - [threshold] [Address 1] [Address 2] ...")
+	rekeyCmd.Flags().BoolVar(&rekeyTestSign, "test-sign", false, "Sign a test transaction, which is never sent, with the new authorized key(s) to prove the wallet controls them")
+	rekeyCmd.Flags().BoolVar(&rekeyDryRun, "dry-run", false, "Simulate the rekey transaction instead of sending it")
+	rekeyCmd.Flags().BoolVarP(&rekeyYes, "yes", "y", false, "Rekey without asking for confirmation")
+	rekeyCmd.Flags().StringVarP(&signerAddress, "signer", "S", "", "Address currently authorized to sign for the account (looked up from the node by default)")
+	rekeyCmd.Flags().Uint64Var(&fee, "fee", 0, "The transaction fee (automatically determined by default), in microAlgos")
+	rekeyCmd.Flags().Uint64Var(&firstValid, "firstvalid", 0, "The first round where the transaction may be committed to the ledger")
+	rekeyCmd.Flags().Uint64Var(&numValidRounds, "validrounds", 0, "The number of rounds for which the transaction will be valid")
+	rekeyCmd.Flags().Uint64Var(&lastValid, "lastvalid", 0, "The last round where the transaction may be committed to the ledger")
+	rekeyCmd.Flags().BoolVarP(&noWaitAfterSend, "no-wait", "N", false, "Don't wait for transaction to commit")
+}
+
+var rekeyCmd = &cobra.Command{
+	Use:   "rekey",
+	Short: "Change the address authorized to sign for an account",
+	Long: `Rekey an account so that transactions from it must be signed by another address, which may be a single key or a multisig account.
+Before sending the rekey transaction, goal checks which of the new authorized keys the wallet holds and warns if the account could become unusable from this wallet. With --test-sign it also proves the wallet can sign with them, and with --dry-run the transaction is only simulated.
+Successful rekeys are recorded locally, so that goal clerk send signs with the right key without needing --signer.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		checkTxValidityPeriodCmdFlags(cmd)
+
+		dataDir := datadir.EnsureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		client := ensureFullClient(dataDir)
+		addr := accountList.getAddressByName(rekeyAccount)
+
+		var msig *rekeyMultisig
+		target := rekeyTarget
+		if rekeyMsigParams != "" {
+			parsed, msigAddr, err := parseRekeyMultisig(rekeyMsigParams)
+			if err != nil {
+				reportErrorf(msigParseError, err)
+			}
+			if target != "" && target != msigAddr.String() {
+				reportErrorf(errorRekeyMsigMismatch, msigAddr, target)
+			}
+			target = msigAddr.String()
+			msig = &parsed
+		}
+		if target == "" {
+			reportErrorf(errorRekeyNoTarget)
+		}
+		targetAddr, err := basics.UnmarshalChecksumAddress(target)
+		if err != nil {
+			reportErrorf(failDecodeAddressError, err)
+		}
+
+		// Find who is authorized to sign for the account today
+		currentAuth := signerAddress
+		if currentAuth == "" {
+			info, err := client.AccountInformation(addr, false)
+			if err != nil {
+				reportErrorf(errorRequestFail, err)
+			}
+			currentAuth = addr
+			if info.AuthAddr != nil {
+				currentAuth = *info.AuthAddr
+			}
+		}
+		if currentAuth == target {
+			reportErrorf(errorRekeyAlreadyAuthorized, target, addr)
+		}
+
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
+		held, err := client.ListAddresses(wh)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		if msig == nil && target != addr {
+			if info, lookupErr := client.LookupMultisigAccount(wh, target); lookupErr == nil {
+				msig = &rekeyMultisig{Threshold: info.Threshold, Addresses: info.PKs}
+			}
+		}
+		if !containsString(held, currentAuth) {
+			reportErrorf(errorRekeyCurrentAuthNotHeld, currentAuth, addr)
+		}
+
+		for _, warning := range rekeyTargetWarnings(target, msig, held) {
+			reportWarnRawln(warning)
+		}
+
+		if rekeyTestSign {
+			err = testSignRekeyTarget(client, wh, pw, target, msig, held)
+			if err != nil {
+				reportErrorf(errorRekeyTestSign, err)
+			}
+			reportInfof(infoRekeyTestSigned, target)
+		}
+
+		firstValid, lastValid, _, err = client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
+		if err != nil {
+			reportErrorf(err.Error())
+		}
+		tx, err := client.ConstructPayment(addr, addr, fee, 0, nil, "", [32]byte{}, basics.Round(firstValid), basics.Round(lastValid))
+		if err != nil {
+			reportErrorf(errorConstructingTX, err)
+		}
+		tx.RekeyTo = targetAddr
+		if cmd.Flags().Changed("fee") {
+			tx.Fee = basics.MicroAlgos{Raw: fee}
+		}
+
+		signer := ""
+		if currentAuth != addr {
+			signer = currentAuth
+		}
+		stx, err := client.SignTransactionWithWalletAndSigner(wh, pw, signer, tx)
+		if err != nil {
+			reportErrorf(errorSigningTX, err)
+		}
+
+		if rekeyDryRun {
+			result, err := client.SimulateTransactions(v2.PreEncodedSimulateRequest{
+				TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: []transactions.SignedTxn{stx}}},
+			})
+			if err != nil {
+				reportErrorf(errorRequestFail, err)
+			}
+			if len(result.TxnGroups) > 0 && result.TxnGroups[0].FailureMessage != nil {
+				reportErrorf(errorRekeyDryRunFailed, *result.TxnGroups[0].FailureMessage)
+			}
+			reportInfof(infoRekeyDryRun, addr, target)
+			return
+		}
+
+		if !rekeyYes {
+			fmt.Printf(infoRekeyConfirm, addr, target)
+			reader := bufio.NewReader(os.Stdin)
+			resp, err := reader.ReadString('\n')
+			if err != nil {
+				reportErrorf(errorFailedToReadResponse, err)
+			}
+			if resp = strings.ToLower(strings.TrimSpace(resp)); resp != "y" && resp != "yes" {
+				reportInfoln(infoRekeyAborted)
+				return
+			}
+		}
+
+		txid, err := client.BroadcastTransaction(stx)
+		if err != nil {
+			reportErrorf(errorBroadcastingTX, err)
+		}
+		reportInfof(infoRekeyIssued, addr, target, txid)
+		if !noWaitAfterSend {
+			_, err = waitForCommit(client, txid, lastValid)
+			if err != nil {
+				reportErrorf(err.Error())
+			}
+		}
+
+		registry := loadRekeyRegistry(filepath.Join(filepath.Dir(accountList.accountListFileName()), rekeyRegistryFileName))
+		if target == addr {
+			delete(registry.Accounts, addr)
+		} else {
+			registry.Accounts[addr] = rekeyRegistryEntry{AuthAddr: target, Multisig: msig, TxID: txid}
+		}
+		err = registry.save()
+		if err != nil {
+			reportWarnf(errorRekeyRegistrySave, err)
+		}
+	},
+}
+
+// rekeyMultisig is the pre image of a multisig address an account is rekeyed to
+type rekeyMultisig struct {
+	Threshold uint8
+	Addresses []string
+}
+
+// params formats the multisig as the --msig-params of goal clerk send
+func (m rekeyMultisig) params() string {
+	return strconv.Itoa(int(m.Threshold)) + " " + strings.Join(m.Addresses, " ")
+}
+
+// parseRekeyMultisig parses multisig params of the form "threshold addr1 addr2 ..."
+// and returns them with the multisig address they define
+func parseRekeyMultisig(params string) (msig rekeyMultisig, addr basics.Address, err error) {
+	fields := strings.Fields(params)
+	if len(fields) < 3 {
+		return msig, addr, fmt.Errorf("expected a threshold and at least 2 addresses")
+	}
+	threshold, err := strconv.ParseUint(fields[0], 10, 8)
+	if err != nil || threshold < 1 || int(threshold) > len(fields)-1 {
+		return msig, addr, fmt.Errorf("threshold must be a number between 1 and the number of addresses")
+	}
+	pks := make([]crypto.PublicKey, len(fields)-1)
+	for i, addrStr := range fields[1:] {
+		pk, err := basics.UnmarshalChecksumAddress(addrStr)
+		if err != nil {
+			return msig, addr, err
+		}
+		pks[i] = crypto.PublicKey(pk)
+	}
+	digest, err := crypto.MultisigAddrGen(1, uint8(threshold), pks)
+	if err != nil {
+		return msig, addr, err
+	}
+	return rekeyMultisig{Threshold: uint8(threshold), Addresses: fields[1:]}, basics.Address(digest), nil
+}
+
+// rekeyTargetWarnings lists the reasons the wallet holding the keys held may
+// not be able to sign for an account once it is rekeyed to target
+func rekeyTargetWarnings(target string, msig *rekeyMultisig, held []string) (warnings []string) {
+	if msig == nil {
+		if !containsString(held, target) {
+			warnings = append(warnings, fmt.Sprintf(warnRekeyTargetNotHeld, target))
+		}
+		return
+	}
+
+	var unknown []string
+	for _, signer := range msig.Addresses {
+		if !containsString(held, signer) {
+			unknown = append(unknown, signer)
+		}
+	}
+	if len(unknown) > 0 {
+		warnings = append(warnings, fmt.Sprintf(warnRekeyUnknownSigners, len(unknown), len(msig.Addresses), strings.Join(unknown, ", ")))
+	}
+	if len(msig.Addresses)-len(unknown) < int(msig.Threshold) {
+		warnings = append(warnings, fmt.Sprintf(warnRekeyBelowThreshold, len(msig.Addresses)-len(unknown), msig.Threshold))
+	}
+	return
+}
+
+// testSignRekeyTarget signs a transaction, which is never sent, with each key
+// of target held by the wallet, and checks enough of them can sign
+func testSignRekeyTarget(client libgoal.Client, wh, pw []byte, target string, msig *rekeyMultisig, held []string) error {
+	signers := []string{target}
+	threshold := 1
+	if msig != nil {
+		signers = msig.Addresses
+		threshold = int(msig.Threshold)
+	}
+
+	signed := 0
+	for _, signer := range signers {
+		if !containsString(held, signer) {
+			continue
+		}
+		signerAddr, err := basics.UnmarshalChecksumAddress(signer)
+		if err != nil {
+			return err
+		}
+		tx := transactions.Transaction{
+			Type:   protocol.PaymentTx,
+			Header: transactions.Header{Sender: signerAddr, Note: []byte("goal account rekey test signature")},
+		}
+		stx, err := client.SignTransactionWithWallet(wh, pw, tx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", signer, err)
+		}
+		if !crypto.SignatureVerifier(signerAddr).Verify(tx, stx.Sig) {
+			return fmt.Errorf("%s: the wallet produced an invalid signature", signer)
+		}
+		signed++
+	}
+	if signed < threshold {
+		return fmt.Errorf("the wallet could only sign with %d of the %d keys required", signed, threshold)
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// rekeyRegistry records the accounts rekeyed by goal, so that their
+// transactions can be signed by the right key
+type rekeyRegistry struct {
+	Accounts map[string]rekeyRegistryEntry
+	fileName string
+}
+
+// rekeyRegistryEntry is the address an account was rekeyed to
+type rekeyRegistryEntry struct {
+	AuthAddr string
+	Multisig *rekeyMultisig `json:",omitempty"`
+	TxID     string
+}
+
+// loadRekeyRegistry reads the registry from fileName. A missing or corrupt
+// file yields an empty registry.
+func loadRekeyRegistry(fileName string) *rekeyRegistry {
+	registry := &rekeyRegistry{fileName: fileName}
+	data, err := os.ReadFile(fileName)
+	if err == nil {
+		err = json.Unmarshal(data, registry)
+		if err != nil {
+			reportWarnf(errorRekeyRegistryLoad, fileName, err)
+		}
+	}
+	if registry.Accounts == nil {
+		registry.Accounts = make(map[string]rekeyRegistryEntry)
+	}
+	return registry
+}
+
+func (r *rekeyRegistry) save() error {
+	data, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.fileName, data, 0600)
+}
+
+// registeredSigner looks up the address sender was rekeyed to with goal account
+// rekey, and checks the node still agrees with it
+func registeredSigner(accountList *AccountsList, client libgoal.Client, sender string) (entry rekeyRegistryEntry, ok bool) {
+	registry := loadRekeyRegistry(filepath.Join(filepath.Dir(accountList.accountListFileName()), rekeyRegistryFileName))
+	entry, ok = registry.Accounts[sender]
+	if !ok {
+		return
+	}
+	info, err := client.AccountInformation(sender, false)
+	if err != nil || info.AuthAddr == nil || *info.AuthAddr != entry.AuthAddr {
+		reportWarnf(warnRekeyRegistryStale, sender)
+		return entry, false
+	}
+	return entry, true
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func randomAddresses(n int) []string {
+	addrs := make([]string, n)
+	for i := range addrs {
+		var addr basics.Address
+		crypto.RandBytes(addr[:])
+		addrs[i] = addr.String()
+	}
+	return addrs
+}
+
+func TestParseRekeyMultisig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addrs := randomAddresses(3)
+	msig, addr, err := parseRekeyMultisig("2 " + addrs[0] + " " + addrs[1] + "  " + addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, uint8(2), msig.Threshold)
+	require.Equal(t, addrs, msig.Addresses)
+
+	pks := make([]crypto.PublicKey, len(addrs))
+	for i, a := range addrs {
+		pk, err := basics.UnmarshalChecksumAddress(a)
+		require.NoError(t, err)
+		pks[i] = crypto.PublicKey(pk)
+	}
+	expected, err := crypto.MultisigAddrGen(1, 2, pks)
+	require.NoError(t, err)
+	require.Equal(t, basics.Address(expected), addr)
+
+	// params round trips
+	_, again, err := parseRekeyMultisig(msig.params())
+	require.NoError(t, err)
+	require.Equal(t, addr, again)
+
+	_, _, err = parseRekeyMultisig("2 " + addrs[0])
+	require.Error(t, err)
+	_, _, err = parseRekeyMultisig("4 " + addrs[0] + " " + addrs[1] + " " + addrs[2])
+	require.ErrorContains(t, err, "threshold")
+	_, _, err = parseRekeyMultisig("1 " + addrs[0] + " notanaddress")
+	require.Error(t, err)
+}
+
+func TestRekeyTargetWarnings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addrs := randomAddresses(3)
+	held := []string{addrs[0], addrs[1]}
+
+	require.Empty(t, rekeyTargetWarnings(addrs[0], nil, held))
+	require.Len(t, rekeyTargetWarnings(addrs[2], nil, held), 1)
+
+	msig := &rekeyMultisig{Threshold: 2, Addresses: addrs}
+	warnings := rekeyTargetWarnings("", msig, held)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], addrs[2])
+
+	msig.Threshold = 3
+	warnings = rekeyTargetWarnings("", msig, held)
+	require.Len(t, warnings, 2)
+	require.Contains(t, warnings[1], "3 signatures are required")
+}
+
+func TestRekeyRegistry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	fileName := filepath.Join(t.TempDir(), rekeyRegistryFileName)
+	registry := loadRekeyRegistry(fileName)
+	require.Empty(t, registry.Accounts)
+
+	addrs := randomAddresses(4)
+	registry.Accounts[addrs[0]] = rekeyRegistryEntry{AuthAddr: addrs[1], TxID: "TX1"}
+	registry.Accounts[addrs[2]] = rekeyRegistryEntry{AuthAddr: addrs[3], Multisig: &rekeyMultisig{Threshold: 1, Addresses: addrs[:2]}}
+	require.NoError(t, registry.save())
+
+	loaded := loadRekeyRegistry(fileName)
+	require.Equal(t, registry.Accounts, loaded.Accounts)
+
+	info, err := os.Stat(fileName)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
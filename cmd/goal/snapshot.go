@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/nodecontrol"
+	"github.com/algorand/go-algorand/util"
+)
+
+var (
+	snapshotCreateWithPartKeys bool
+	snapshotRestoreForce       bool
+)
+
+func init() {
+	nodeCmd.AddCommand(snapshotCmd)
+	snapshotCmd.AddCommand(snapshotCreateCmd)
+	snapshotCmd.AddCommand(snapshotRestoreCmd)
+
+	snapshotCreateCmd.Flags().BoolVar(&snapshotCreateWithPartKeys, "with-partkeys", false, "Copy the participation keys of the node into the snapshot as well")
+	snapshotRestoreCmd.Flags().BoolVarP(&snapshotRestoreForce, "force", "f", false, "Replace the ledger of the data directory, if any")
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Take and restore consistent snapshots of the data directory",
+	Long:  "Take consistent snapshots of the data directory of a running node, and restore them into a data directory of a stopped node, such as a new one.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create [snapshot directory]",
+	Short: "Take a consistent snapshot of the data directory of a running node",
+	Long:  "Take a consistent snapshot of the data directory of a running node into a new directory: its ledger, copied while the commits of the ledger trackers are paused, and its configuration files. The node keeps running meanwhile. With --with-partkeys, its participation keys are copied as well: a node restored from such a snapshot must never run alongside this one, or both vote with the same keys.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		path, err := filepath.Abs(args[0])
+		if err != nil {
+			reportErrorf("Cannot resolve the snapshot directory %s: %v", args[0], err)
+		}
+		client := ensureAlgodClient(datadir.EnsureSingleDataDir())
+		start := time.Now()
+		resp, err := client.CreateSnapshot(path, snapshotCreateWithPartKeys)
+		if err != nil {
+			reportErrorf("Cannot take the snapshot: %v", err)
+		}
+		reportInfof("Took the snapshot of round %d into %s in %v", resp.Round, path, time.Since(start).Round(time.Second))
+		if snapshotCreateWithPartKeys {
+			reportWarnln("The snapshot holds the participation keys of the node: keep it safe, and never run a node restored from it alongside this one")
+		}
+	},
+}
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore [snapshot directory]",
+	Short: "Restore a snapshot into the data directory of a stopped node",
+	Long:  "Restore a snapshot into the data directory of a stopped node, which is created if needed. The configuration files of the snapshot are only restored when the data directory has none. With --force, the ledger of the data directory is replaced by the one of the snapshot.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		binDir, err := util.ExeDir()
+		if err != nil {
+			panic(err)
+		}
+		dataDir := datadir.EnsureSingleDataDir()
+		nc := nodecontrol.MakeNodeController(binDir, dataDir)
+		manifest, err := nc.RestoreSnapshot(args[0], snapshotRestoreForce)
+		if err != nil {
+			reportErrorf("Cannot restore the snapshot: %v", err)
+		}
+		reportInfof("Restored the snapshot of %s at round %d into %s", manifest.GenesisID, manifest.Round, dataDir)
+		if manifest.ParticipationKeys {
+			reportWarnRawln("WARNING: the snapshot holds the participation keys of the node it was taken from. Running this node while that one runs, or restoring it into more than one data directory, makes them vote twice with the same keys, which can get the accounts flagged as equivocating. Remove the participation keys from one of the nodes before starting this one.")
+		}
+	},
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/stateproof/follower"
+)
+
+var (
+	stateProofCheckpointFile string
+	stateProofStartRound     uint64
+)
+
+func init() {
+	stateProofCmd.AddCommand(stateProofVerifyCmd)
+
+	stateProofVerifyCmd.Flags().StringVarP(&stateProofCheckpointFile, "checkpoint", "c", "", "Checkpoint file, read to start from and updated with the latest verified state proof")
+	stateProofVerifyCmd.Flags().Uint64Var(&stateProofStartRound, "start", 0, "Round whose block header is trusted to start from when the checkpoint file does not exist (a multiple of the state proof interval)")
+	stateProofVerifyCmd.MarkFlagRequired("checkpoint")
+}
+
+var stateProofCmd = &cobra.Command{
+	Use:   "stateproof",
+	Short: "Verify state proofs",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var stateProofVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Follow and verify the chain of state proofs",
+	Long:  "Follow the chain of state proofs committed on the node, verifying each of them from a trusted checkpoint, and update the checkpoint with the latest verified state proof. The node itself is not trusted. When the checkpoint file does not exist, the chain is followed from the voters of the block header of the --start round, which is trusted instead.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		var checkpoint follower.Checkpoint
+		data, err := os.ReadFile(stateProofCheckpointFile)
+		switch {
+		case err == nil:
+			err = protocol.DecodeJSON(data, &checkpoint)
+			if err != nil {
+				reportErrorf(errStateProofDecodeCheckpoint, stateProofCheckpointFile, err)
+			}
+		case os.IsNotExist(err):
+			if !cmd.Flags().Changed("start") {
+				reportErrorf(errStateProofNoCheckpoint, stateProofCheckpointFile)
+			}
+			block, err := client.BookkeepingBlock(stateProofStartRound)
+			if err != nil {
+				reportErrorf(errorRequestFail, err)
+			}
+			checkpoint, err = follower.CheckpointFromVotersHeader(&block.BlockHeader)
+			if err != nil {
+				reportErrorf(errStateProofCheckpoint, stateProofStartRound, err)
+			}
+		default:
+			reportErrorf(fileReadError, stateProofCheckpointFile, err)
+		}
+
+		f := follower.MakeFollower(follower.ClientSource{Client: &client}, checkpoint)
+		verifyErr := f.CatchUp(context.Background(), func(msg *stateproofmsg.Message) {
+			reportInfof(infoStateProofVerified, msg.FirstAttestedRound, msg.LastAttestedRound)
+		})
+
+		// Save the progress made even if a state proof failed to verify.
+		checkpoint = f.Checkpoint()
+		err = writeFile(stateProofCheckpointFile, protocol.EncodeJSON(&checkpoint), 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, stateProofCheckpointFile, err)
+		}
+		if verifyErr != nil {
+			reportErrorf(errStateProofVerify, verifyErr)
+		}
+
+		fmt.Printf("Last verified round: %d\n", checkpoint.LastAttestedRound)
+		if len(checkpoint.BlockHeadersCommitment) != 0 {
+			fmt.Printf("Block headers commitment: %s\n", base64.StdEncoding.EncodeToString(checkpoint.BlockHeadersCommitment))
+		}
+	},
+}
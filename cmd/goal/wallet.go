@@ -33,6 +33,8 @@ import (
 
 var (
 	recoverWallet     bool
+	recoverBIP39      bool
+	hdAccount         uint32
 	defaultWalletName string
 )
 
@@ -45,6 +47,8 @@ func init() {
 
 	// Should we recover the wallet?
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
+	newWalletCmd.Flags().BoolVar(&recoverBIP39, "bip39", false, "Recover the wallet from a BIP-39 mnemonic of another wallet, deriving accounts at the paths m/44'/283'/account'/0'/index'")
+	newWalletCmd.Flags().Uint32Var(&hdAccount, "hd-account", 0, "The account index of the BIP-39 derivation paths the keys of the recovered wallet are derived at")
 }
 
 var walletCmd = &cobra.Command{
@@ -72,7 +76,7 @@ var walletCmd = &cobra.Command{
 			// Set this wallet to be the default
 			accountList.setDefaultWalletID(wid)
 			reportInfof(infoSetWalletToDefault, defaultWalletName)
-			os.Exit(0)
+			exit(0)
 		}
 		cmd.HelpFunc()(cmd, args)
 	},
@@ -92,9 +96,28 @@ var newWalletCmd = &cobra.Command{
 
 		reader := bufio.NewReader(os.Stdin)
 
+		if (recoverBIP39 && !recoverWallet) || (cmd.Flags().Changed("hd-account") && !recoverBIP39) {
+			reportErrorln(errorBIP39NeedsRecover)
+		}
+
 		// Check if we should recover the wallet from a mnemonic
 		var mdk crypto.MasterDerivationKey
-		if recoverWallet {
+		var bip39Mnemonic, bip39Passphrase string
+		if recoverWallet && recoverBIP39 {
+			fmt.Println(infoRecoveryPrompt)
+			resp, err := reader.ReadString('\n')
+			if err != nil {
+				reportErrorf(errorFailedToReadResponse, err)
+			}
+			bip39Mnemonic = strings.TrimSpace(resp)
+			err = passphrase.ValidateBIP39Mnemonic(bip39Mnemonic)
+			if err != nil {
+				reportErrorf(errorBadMnemonic, err)
+			}
+
+			fmt.Printf(infoBIP39PassphrasePrompt)
+			bip39Passphrase = string(ensurePassword())
+		} else if recoverWallet {
 			fmt.Println(infoRecoveryPrompt)
 			resp, err := reader.ReadString('\n')
 			resp = strings.TrimSpace(resp)
@@ -128,7 +151,12 @@ var newWalletCmd = &cobra.Command{
 
 		// Create the wallet
 		reportInfoln(infoCreatingWallet)
-		walletID, err := client.CreateWallet(walletName, walletPassword, mdk)
+		var walletID []byte
+		if bip39Mnemonic != "" {
+			walletID, err = client.CreateWalletFromMnemonic(walletName, walletPassword, bip39Mnemonic, bip39Passphrase, hdAccount)
+		} else {
+			walletID, err = client.CreateWallet(walletName, walletPassword, mdk)
+		}
 		if err != nil {
 			reportErrorf(errorCouldntCreateWallet, err)
 		}
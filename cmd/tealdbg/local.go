@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -249,12 +250,40 @@ func (e *evaluation) eval(gi int, ep *logic.EvalParams) (pass bool, err error) {
 // LocalRunner runs local eval
 type LocalRunner struct {
 	debugger  *Debugger
+	coverage  *logic.CoverageTracer
 	proto     config.ConsensusParams
 	protoName string
 	txnGroup  []transactions.SignedTxn
 	runs      []evaluation
 }
 
+// coverageTracer records the coverage of the programs run and forwards
+// program and opcode events to the debugger adaptor, if any.
+type coverageTracer struct {
+	logic.EvalTracer
+	coverage *logic.CoverageTracer
+}
+
+func (t *coverageTracer) BeforeProgram(cx *logic.EvalContext) {
+	t.coverage.BeforeProgram(cx)
+	t.EvalTracer.BeforeProgram(cx)
+}
+
+func (t *coverageTracer) AfterProgram(cx *logic.EvalContext, evalError error) {
+	t.coverage.AfterProgram(cx, evalError)
+	t.EvalTracer.AfterProgram(cx, evalError)
+}
+
+func (t *coverageTracer) BeforeOpcode(cx *logic.EvalContext) {
+	t.coverage.BeforeOpcode(cx)
+	t.EvalTracer.BeforeOpcode(cx)
+}
+
+func (t *coverageTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
+	t.coverage.AfterOpcode(cx, evalError)
+	t.EvalTracer.AfterOpcode(cx, evalError)
+}
+
 func makeAppState() (states AppState) {
 	states.global = make(map[basics.AppIndex]basics.TealKeyValue)
 	states.locals = make(map[basics.Address]map[basics.AppIndex]basics.TealKeyValue)
@@ -317,6 +346,10 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 	if len(dp.Proto) != 0 {
 		protoString = dp.Proto
 	}
+	if len(dp.CoverageFile) != 0 {
+		r.coverage = logic.MakeCoverageTracer()
+	}
+
 	r.protoName, r.proto, err = protoFromString(protoString)
 	if err != nil {
 		return
@@ -539,6 +572,12 @@ func (r *LocalRunner) RunAll() error {
 		if r.debugger != nil {
 			ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(r.debugger)
 		}
+		if r.coverage != nil {
+			if ep.Tracer == nil {
+				ep.Tracer = logic.NullEvalTracer{}
+			}
+			ep.Tracer = &coverageTracer{EvalTracer: ep.Tracer, coverage: r.coverage}
+		}
 	}
 
 	txngroup := transactions.WrapSignedTxnsWithAD(r.txnGroup)
@@ -568,3 +607,19 @@ func (r *LocalRunner) RunAll() error {
 	}
 	return nil
 }
+
+// WriteCoverage writes the coverage of the programs run as an lcov tracefile.
+// Programs with source information are reported against their sources.
+func (r *LocalRunner) WriteCoverage(w io.Writer) error {
+	if r.coverage == nil {
+		return fmt.Errorf("coverage was not enabled")
+	}
+	sourceMaps := make(map[crypto.Digest]logic.SourceMap)
+	for _, run := range r.runs {
+		if len(run.offsetToLine) == 0 {
+			continue
+		}
+		sourceMaps[logic.HashProgram(run.program)] = logic.GetSourceMap([]string{run.name}, run.offsetToLine)
+	}
+	return r.coverage.WriteLcov(w, sourceMaps)
+}
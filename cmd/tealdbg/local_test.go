@@ -1843,3 +1843,47 @@ func TestRunAllGloads(t *testing.T) {
 	err = local.RunAll()
 	a.NoError(err)
 }
+
+func TestLocalCoverage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+
+	source := `#pragma version 8
+pushint 1
+bnz yes
+err
+yes:
+pushint 1`
+
+	dp := DebugParams{
+		ProgramNames: []string{"test.teal"},
+		ProgramBlobs: [][]byte{[]byte(source)},
+		TxnBlob:      []byte(txnSample),
+		Proto:        string(protocol.ConsensusCurrentVersion),
+		RunMode:      "signature",
+		CoverageFile: "coverage.info",
+	}
+
+	local := MakeLocalRunner(nil) // no debugger
+	err := local.Setup(&dp)
+	a.NoError(err)
+	a.NotNil(local.coverage)
+
+	r := runAllResultFromInvocation(*local)
+	a.Equal(allPassing(len(local.runs)), r)
+
+	var report strings.Builder
+	err = local.WriteCoverage(&report)
+	a.NoError(err)
+	a.Contains(report.String(), "SF:test.teal\n")
+	a.Contains(report.String(), "BRDA:3,0,1,1\n")
+	a.Contains(report.String(), "DA:2,1\nDA:3,1\nDA:4,0\nDA:6,1\n")
+
+	local = MakeLocalRunner(nil)
+	dp.CoverageFile = ""
+	err = local.Setup(&dp)
+	a.NoError(err)
+	a.Error(local.WriteCoverage(&report))
+}
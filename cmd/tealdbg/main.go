@@ -121,6 +121,7 @@ var verbose bool
 var painless bool
 var appID uint64
 var listenForDrReq bool
+var coverageFile string
 
 func init() {
 	rootCmd.PersistentFlags().VarP(&frontend, "frontend", "f", "Frontend to use: "+frontend.AllowedString())
@@ -146,6 +147,7 @@ func init() {
 	debugCmd.Flags().StringVarP(&indexerURL, "indexer-url", "i", "", "URL for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().StringVarP(&indexerToken, "indexer-token", "", "", "API token for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().BoolVarP(&listenForDrReq, "listen-dr-req", "q", false, "Listen for upcoming debugging dryrun request objects instead of taking program(s) from command line")
+	debugCmd.Flags().StringVar(&coverageFile, "coverage", "", "Write an lcov coverage report of the evaluated program(s) to the given file")
 
 	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(remoteCmd)
@@ -255,6 +257,7 @@ func debugLocal(args []string) {
 		AppID:            appID,
 		Painless:         painless,
 		ListenForDrReq:   listenForDrReq,
+		CoverageFile:     coverageFile,
 	}
 
 	ds := makeDebugServer(iface, port, &frontend, &dp)
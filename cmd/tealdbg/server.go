@@ -22,6 +22,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -96,6 +97,7 @@ type DebugParams struct {
 	AppID            uint64
 	Painless         bool
 	ListenForDrReq   bool
+	CoverageFile     string
 }
 
 // FrontendFactory interface for attaching debug frontends
@@ -198,6 +200,12 @@ func (ds *DebugServer) startDebug() (err error) {
 
 	ds.frontend.WaitForCompletion()
 
+	if len(ds.params.CoverageFile) != 0 {
+		if err = writeCoverage(local, ds.params.CoverageFile); err != nil {
+			return
+		}
+	}
+
 	if ds.params.ListenForDrReq {
 		// It is possible URL fetcher routine does not return anything and stuck in the loop.
 		// By this point all executions are done and a message urlFetcherCh must be available.
@@ -243,3 +251,12 @@ func (ds *DebugServer) dryrunReqHander(w http.ResponseWriter, r *http.Request) {
 	close(ds.spinoffCh)
 	return
 }
+
+func writeCoverage(local *LocalRunner, filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return local.WriteCoverage(f)
+}
@@ -256,6 +256,7 @@ const (
 	dnssecSRV = 1 << iota
 	dnssecRelayAddr
 	dnssecTelemetryAddr
+	dnssecUpstreamsOnly
 )
 
 const (
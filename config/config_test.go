@@ -165,6 +165,31 @@ func TestLoadPhonebookMissing(t *testing.T) {
 	require.True(t, os.IsNotExist(err))
 }
 
+func TestNetworksConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	_, err := LoadNetworksConfig(tempDir)
+	require.True(t, os.IsNotExist(err))
+
+	nc := NetworksConfig{Networks: []NetworkEntry{{DataDir: "mainnet"}, {DataDir: "/var/lib/devnet"}}}
+	require.NoError(t, nc.SaveToDisk(tempDir))
+	loaded, err := LoadNetworksConfig(tempDir)
+	require.NoError(t, err)
+	require.Equal(t, nc, loaded)
+	require.Equal(t, []string{filepath.Join(tempDir, "mainnet"), "/var/lib/devnet"}, loaded.DataDirs(tempDir))
+
+	nc.Networks = append(nc.Networks, NetworkEntry{DataDir: "./mainnet/"})
+	require.NoError(t, nc.SaveToDisk(tempDir))
+	_, err = LoadNetworksConfig(tempDir)
+	require.ErrorContains(t, err, "more than once")
+
+	require.NoError(t, NetworksConfig{}.SaveToDisk(tempDir))
+	_, err = LoadNetworksConfig(tempDir)
+	require.ErrorContains(t, err, "no network")
+}
+
 func TestArchivalIfRelay(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -18,6 +18,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -61,6 +62,13 @@ type ConsensusParams struct {
 	// in a block must not exceed MaxTxnBytesPerBlock.
 	MaxTxnBytesPerBlock int
 
+	// MaxTxnsPerBlockByType caps the number of top-level transactions of
+	// each type in a block. The types not listed are only limited by
+	// MaxTxnBytesPerBlock. It is unset on the built-in protocols, and
+	// meant for private networks shaping their block payloads through
+	// consensus.json.
+	MaxTxnsPerBlockByType map[protocol.TxType]int
+
 	// MaxTxnBytesPerBlock is the maximum size of a transaction's Note field.
 	MaxTxnNoteBytes int
 
@@ -707,6 +715,13 @@ func (cp ConsensusProtocols) DeepCopy() ConsensusProtocols {
 			}
 			consensusParams.ApprovedUpgrades = newApprovedUpgrades
 		}
+		if consensusParams.MaxTxnsPerBlockByType != nil {
+			newMaxTxnsPerBlockByType := make(map[protocol.TxType]int, len(consensusParams.MaxTxnsPerBlockByType))
+			for txType, maxTxns := range consensusParams.MaxTxnsPerBlockByType {
+				newMaxTxnsPerBlockByType[txType] = maxTxns
+			}
+			consensusParams.MaxTxnsPerBlockByType = newMaxTxnsPerBlockByType
+		}
 		staticConsensus[consensusVersion] = consensusParams
 	}
 	return staticConsensus
@@ -775,9 +790,50 @@ func PreloadConfigurableConsensusProtocols(dataDirectory string) (ConsensusProto
 	if err != nil {
 		return nil, err
 	}
+	for consensusVersion, consensusParams := range configurableConsensus {
+		if consensusParams.ApprovedUpgrades == nil {
+			// a deleted consensus version
+			continue
+		}
+		err = consensusParams.ValidateBlockPayload()
+		if err != nil {
+			return nil, fmt.Errorf("invalid consensus protocol %s in %s: %w", consensusVersion, consensusProtocolPath, err)
+		}
+	}
 	return Consensus.Merge(configurableConsensus), nil
 }
 
+// knownTxTypes are the transaction types that MaxTxnsPerBlockByType can cap.
+var knownTxTypes = map[protocol.TxType]bool{
+	protocol.PaymentTx:         true,
+	protocol.KeyRegistrationTx: true,
+	protocol.AssetConfigTx:     true,
+	protocol.AssetTransferTx:   true,
+	protocol.AssetFreezeTx:     true,
+	protocol.ApplicationCallTx: true,
+	protocol.StateProofTx:      true,
+}
+
+// ValidateBlockPayload checks the block payload limits of the consensus parameters: a block must have room for
+// transactions, and the per type caps must be on known transaction types, and not negative.
+func (cp ConsensusParams) ValidateBlockPayload() error {
+	if cp.MaxTxnBytesPerBlock <= 0 {
+		return fmt.Errorf("MaxTxnBytesPerBlock must be positive, not %d", cp.MaxTxnBytesPerBlock)
+	}
+	if cp.MaxTxnBytesPerBlock < cp.MaxTxnNoteBytes {
+		return fmt.Errorf("MaxTxnBytesPerBlock %d is smaller than MaxTxnNoteBytes %d", cp.MaxTxnBytesPerBlock, cp.MaxTxnNoteBytes)
+	}
+	for txType, maxTxns := range cp.MaxTxnsPerBlockByType {
+		if !knownTxTypes[txType] {
+			return fmt.Errorf("MaxTxnsPerBlockByType caps the unknown transaction type %q", txType)
+		}
+		if maxTxns < 0 {
+			return fmt.Errorf("MaxTxnsPerBlockByType cap %d on %s transactions is negative", maxTxns, txType)
+		}
+	}
+	return nil
+}
+
 func initConsensusProtocols() {
 	// WARNING: copying a ConsensusParams by value into a new variable
 	// does not copy the ApprovedUpgrades map.  Make sure that each new
@@ -17,8 +17,12 @@
 package config
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -69,3 +73,50 @@ func TestConsensusStateProofParams(t *testing.T) {
 		}
 	}
 }
+
+func TestConsensusBlockPayload(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for proto, params := range Consensus {
+		require.NoError(t, params.ValidateBlockPayload(), "Version :%v", proto)
+	}
+
+	params := Consensus[protocol.ConsensusCurrentVersion]
+	params.MaxTxnsPerBlockByType = map[protocol.TxType]int{protocol.ApplicationCallTx: 10, protocol.PaymentTx: 0}
+	require.NoError(t, params.ValidateBlockPayload())
+	params.MaxTxnsPerBlockByType[protocol.UnknownTx] = 1
+	require.ErrorContains(t, params.ValidateBlockPayload(), "unknown transaction type")
+	params.MaxTxnsPerBlockByType = map[protocol.TxType]int{protocol.AssetTransferTx: -1}
+	require.ErrorContains(t, params.ValidateBlockPayload(), "negative")
+	params.MaxTxnsPerBlockByType = nil
+	params.MaxTxnBytesPerBlock = 0
+	require.ErrorContains(t, params.ValidateBlockPayload(), "must be positive")
+}
+
+func TestPreloadConfigurableConsensusProtocolsValidation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	write := func(params ConsensusParams) {
+		encoded, err := json.Marshal(ConsensusProtocols{"private": params})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ConfigurableConsensusProtocolsFilename), encoded, 0600))
+	}
+
+	params := Consensus[protocol.ConsensusCurrentVersion]
+	params.MaxTxnBytesPerBlock = 100000
+	params.MaxTxnsPerBlockByType = map[protocol.TxType]int{protocol.ApplicationCallTx: 10}
+	write(params)
+	loaded, err := PreloadConfigurableConsensusProtocols(dir)
+	require.NoError(t, err)
+	require.Equal(t, 100000, loaded["private"].MaxTxnBytesPerBlock)
+	require.Equal(t, map[protocol.TxType]int{protocol.ApplicationCallTx: 10}, loaded["private"].MaxTxnsPerBlockByType)
+	require.Nil(t, Consensus[protocol.ConsensusCurrentVersion].MaxTxnsPerBlockByType)
+
+	params.MaxTxnsPerBlockByType = map[protocol.TxType]int{"xfer": 10}
+	write(params)
+	_, err = PreloadConfigurableConsensusProtocols(dir)
+	require.ErrorContains(t, err, "invalid consensus protocol private")
+}
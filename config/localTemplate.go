@@ -103,14 +103,21 @@ type Local struct {
 	// outgoing broadcast messages from this node.
 	PriorityPeers map[string]bool `version[4]:""`
 
-	// To make sure the algod process does not run out of FDs, algod ensures
-	// that RLIMIT_NOFILE >= IncomingConnectionsLimit + RestConnectionsHardLimit +
-	// ReservedFDs. ReservedFDs are meant to leave room for short-lived FDs like
-	// DNS queries, SQLite files, etc. This parameter shouldn't be changed.
-	// If RLIMIT_NOFILE < IncomingConnectionsLimit + RestConnectionsHardLimit + ReservedFDs
-	// then either RestConnectionsHardLimit or IncomingConnectionsLimit decreased.
+	// To make sure the algod process does not run out of FDs, algod raises
+	// RLIMIT_NOFILE, as far as its hard limit permits, to cover IncomingConnectionsLimit +
+	// RestConnectionsHardLimit + the SQLite files + ReservedFDs. ReservedFDs are meant
+	// to leave room for short-lived FDs like DNS queries and outgoing requests.
+	// This parameter shouldn't be changed. When RLIMIT_NOFILE falls short, the
+	// RestConnectionsHardLimit and IncomingConnectionsLimit are decreased in proportion,
+	// down to a minimum, and algod fails to start if even the minimums don't fit.
 	ReservedFDs uint64 `version[2]:"256"`
 
+	// ResourceUsageWarningPercent is the usage of the file descriptors, the memory and the
+	// threads of the algod process, in percent of their limits, past which a warning is
+	// logged and reported to telemetry. 0 disables the warnings. Regardless, new incoming
+	// connections are refused while 95% of the file descriptors are open.
+	ResourceUsageWarningPercent uint64 `version[28]:"80"`
+
 	// local server
 	// API endpoint address
 	EndpointAddress string `version[0]:"127.0.0.1:0"`
@@ -166,6 +173,7 @@ type Local struct {
 	// exponential increase factor of transaction pool's fee threshold, should always be 2 in production
 	TxPoolExponentialIncreaseFactor uint64 `version[0]:"2"`
 
+	// SuggestedFeeBlockHistory is the number of recent blocks whose congestion is considered when estimating fees.
 	SuggestedFeeBlockHistory int `version[0]:"3"`
 
 	// TxBacklogServiceRateWindowSeconds is the window size used to determine the service rate of the txBacklog
@@ -258,6 +266,8 @@ type Local struct {
 	ConnectionsRateLimitingCount uint `version[4]:"60"`
 
 	// EnableRequestLogger enabled the logging of the incoming requests to the telemetry server.
+	// It only applies to the gossip network listener; the access logs of the REST API are configured
+	// with RestAccessLogSampling and AdminAccessLogSampling.
 	EnableRequestLogger bool `version[4]:"false"`
 
 	// PeerConnectionsUpdateInterval defines the interval at which the peer connections information is being sent to the
@@ -268,7 +278,8 @@ type Local struct {
 	// telemetry ( when enabled ). Defined in seconds. Minimum value is 60.
 	HeartbeatUpdateInterval int `version[27]:"600"`
 
-	// EnableProfiler enables the go pprof endpoints, should be false if
+	// EnableProfiler enables the go pprof endpoints, and the admin endpoints
+	// capturing profiles on demand under /v2/debug/profiles, should be false if
 	// the algod api will be exposed to untrusted individuals
 	EnableProfiler bool `version[0]:"false"`
 
@@ -284,6 +295,7 @@ type Local struct {
 	// 0x01 (dnssecSRV) - validate SRV response
 	// 0x02 (dnssecRelayAddr) - validate relays' names to addresses resolution
 	// 0x04 (dnssecTelemetryAddr) - validate telemetry and metrics names to addresses resolution
+	// 0x08 (dnssecUpstreamsOnly) - validate DNS responses from the DNSSecureResolvers alone
 	// ...
 	DNSSecurityFlags uint32 `version[6]:"1"`
 
@@ -334,7 +346,8 @@ type Local struct {
 	EnableDeveloperAPI bool `version[9]:"false"`
 
 	// OptimizeAccountsDatabaseOnStartup controls whether the accounts database would be optimized
-	// on algod startup.
+	// on algod startup. The optimization runs in the background once the ledger is loaded, as described
+	// for AccountsDatabaseOptimizeInterval, rather than delaying the startup.
 	OptimizeAccountsDatabaseOnStartup bool `version[10]:"false"`
 
 	// CatchpointTracking determines if catchpoints are going to be tracked. The value is interpreted as follows:
@@ -520,6 +533,367 @@ type Local struct {
 	// BlockServiceMemCap is the memory capacity in bytes which is allowed for the block service to use for HTTP block requests.
 	// When it exceeds this capacity, it redirects the block requests to a different node
 	BlockServiceMemCap uint64 `version[28]:"500000000"`
+
+	// MetricsListenAddress, when set, is the address on which algod serves its metrics in the Prometheus
+	// exposition format, at /metrics. Unlike the node exporter, this listener is served by the algod process itself.
+	MetricsListenAddress string `version[28]:""`
+
+	// MetricsTLSCertFile and MetricsTLSKeyFile, when both set, make the metrics listener serve HTTPS using this certificate.
+	MetricsTLSCertFile string `version[28]:""`
+	MetricsTLSKeyFile  string `version[28]:""`
+
+	// MetricsRequireAuth requires the requests to the metrics listener to provide an API token: the algod token,
+	// the admin token, or a named token granted the read scope.
+	MetricsRequireAuth bool `version[28]:"false"`
+
+	// MetricsDisabledSubsystems is a comma delimited list of the subsystems whose metrics are left out of /metrics and
+	// of the telemetry heartbeat, such as "network,ledger". The subsystem of a metric is the word following the algod_
+	// prefix of its name; the Go runtime metrics are in the go subsystem.
+	MetricsDisabledSubsystems string `version[28]:""`
+
+	// MetricsMaxLabelSets is the maximum number of distinct sets of labels, that is of series, each metric reports.
+	// The observations which would add a series past it are dropped, and counted by
+	// algod_metrics_label_sets_dropped_total. 0 means no limit.
+	MetricsMaxLabelSets uint64 `version[28]:"1000"`
+
+	// GRPCListenAddress, when set, is the address on which algod serves its gRPC interface, described in
+	// daemon/algod/api/grpc/algod.proto. It uses the same API tokens as the REST API.
+	GRPCListenAddress string `version[28]:""`
+
+	// GRPCTLSCertFile and GRPCTLSKeyFile, when both set, make the gRPC listener serve TLS using this certificate.
+	GRPCTLSCertFile string `version[28]:""`
+	GRPCTLSKeyFile  string `version[28]:""`
+
+	// AdminEndpointAddress, when set, is the address of a dedicated listener serving the admin and participation
+	// endpoints of the REST API, which are then no longer served on EndpointAddress. The admin listener serves the
+	// public endpoints as well, so that clients using the admin token can use it for every request.
+	AdminEndpointAddress string `version[28]:""`
+
+	// RestCORSAllowedOrigins is a comma delimited list of the origins allowed to make cross-origin requests to the
+	// REST API on EndpointAddress, "*" allowing any origin. When empty, the CORS requests are not answered.
+	RestCORSAllowedOrigins string `version[28]:"*"`
+
+	// AdminCORSAllowedOrigins is the RestCORSAllowedOrigins of the admin listener on AdminEndpointAddress.
+	AdminCORSAllowedOrigins string `version[28]:""`
+
+	// RestAccessLogSampling is the number of REST requests on EndpointAddress for each request written to the
+	// access logs, 0 disabling the access logs. The requests failing with a server error are always logged.
+	RestAccessLogSampling uint64 `version[28]:"1"`
+
+	// AdminAccessLogSampling is the RestAccessLogSampling of the admin listener on AdminEndpointAddress.
+	AdminAccessLogSampling uint64 `version[28]:"1"`
+
+	// AdminTLSCertFile and AdminTLSKeyFile, when both set, make the admin listener on AdminEndpointAddress serve HTTPS
+	// using this certificate. The participation key secrets are only exported and imported over TLS.
+	AdminTLSCertFile string `version[28]:""`
+	AdminTLSKeyFile  string `version[28]:""`
+
+	// AdminAllowPlaintextParticipationKeyTransfer allows exporting and importing the participation key secrets over plain
+	// HTTP, from the local host only. It must not be set when a proxy on the local host forwards remote requests to algod.
+	AdminAllowPlaintextParticipationKeyTransfer bool `version[28]:"false"`
+
+	// EnableParticipationKeyRenewal enables the renewal of the participation keys installed on the node: ahead of the
+	// expiration of the key registered for an online account, a successor key is generated, installed and registered
+	// with a keyreg transaction signed by ParticipationKeyRenewalSigner or by the ParticipationKeyRenewalWallet kmd wallet.
+	// The expired keys are then deleted.
+	EnableParticipationKeyRenewal bool `version[28]:"false"`
+
+	// ParticipationKeyRenewalLeadRounds is the number of rounds before the expiration of a registered participation key
+	// at which its renewal starts.
+	ParticipationKeyRenewalLeadRounds uint64 `version[28]:"100000"`
+
+	// ParticipationKeyRenewalValidRounds is the number of rounds the participation keys generated by the renewal are valid for.
+	ParticipationKeyRenewalValidRounds uint64 `version[28]:"3000000"`
+
+	// ParticipationKeyRenewalSigner, when set, is the command signing the renewal keyreg transactions. It is given the
+	// msgpack encoded transaction on its standard input, and writes the msgpack encoded signed transaction to its standard output.
+	ParticipationKeyRenewalSigner string `version[28]:""`
+
+	// ParticipationKeyRenewalWallet is the name of the kmd wallet signing the renewal keyreg transactions when no
+	// ParticipationKeyRenewalSigner is set. The wallet must have an empty password, and kmd must be running in the data directory.
+	ParticipationKeyRenewalWallet string `version[28]:""`
+
+	// ParticipationKeySignerProvider selects where the voting keys of the participation keys are held. When empty, they
+	// are held in the participation database. When "pkcs11", they are generated in and signed with the PKCS#11 token
+	// configured by PKCS11ModulePath, PKCS11TokenLabel and PKCS11PinFile: by the node when it renews the keys, by goal
+	// when it generates keys for the node, and by algokey when given the data directory of the node. The node then
+	// refuses to install participation keys whose voting keys are not held by the token, and does not start when the
+	// token cannot hold the keys: PKCS#11 has no falcon mechanism, so the state proof keys cannot be held by the token,
+	// and the node only starts when PKCS11StateProofKeysInDatabase is set.
+	ParticipationKeySignerProvider string `version[28]:""`
+
+	// PKCS11ModulePath is the path of the PKCS#11 module (shared library) of the token holding the participation keys.
+	PKCS11ModulePath string `version[28]:""`
+
+	// PKCS11StateProofKeysInDatabase lets the node start with a PKCS#11 token which cannot hold the falcon state proof
+	// keys, keeping them in the participation database while the voting keys are held by the token.
+	PKCS11StateProofKeysInDatabase bool `version[28]:"false"`
+
+	// PKCS11TokenLabel is the label of the PKCS#11 token holding the participation keys.
+	PKCS11TokenLabel string `version[28]:""`
+
+	// PKCS11PinFile is the path of a file holding the user PIN of the PKCS#11 token holding the participation keys.
+	PKCS11PinFile string `version[28]:""`
+
+	// StateProofSigningThreads is the number of threads signing state proof messages with the participation keys of
+	// the node. It bounds the CPU taken by the signing, which on low-power nodes would otherwise compete with agreement.
+	// 0 is handled as 1.
+	StateProofSigningThreads uint64 `version[28]:"1"`
+
+	// StateProofRemoteSigner, when set, is the URL of a remote signer state proof messages are posted to for signing,
+	// instead of signing them on the node. The node verifies the signatures it returns with its state proof keys, and
+	// signs the message itself when the remote signer fails.
+	StateProofRemoteSigner string `version[28]:""`
+
+	// ShutdownDeadline is the time the node is given to drain its in-flight work when stopped: finishing the current
+	// agreement action, flushing the participation registry and committing the account updates being written before
+	// closing its databases. Work still in flight once it elapses is abandoned. 0 waits for the drain to complete.
+	ShutdownDeadline time.Duration `version[28]:"30000000000"`
+
+	// FollowerMaxDeltaRetention is the maximum number of rounds a follower node retains the state deltas of past its
+	// sync round, that is the rounds not yet acknowledged by its consumer. Once the consumer falls that many rounds
+	// behind, the node advances the sync round on its own, dropping the deltas of the oldest rounds, instead of stalling.
+	// It is capped by MaxAcctLookback. 0 makes the node wait for the consumer.
+	FollowerMaxDeltaRetention uint64 `version[28]:"0"`
+
+	// DiskSpaceWarningThreshold is the disk space left on the data directory, in bytes, below which the node emits
+	// telemetry warnings. 0 disables the warnings.
+	DiskSpaceWarningThreshold uint64 `version[28]:"10737418240"`
+
+	// DiskSpaceCatchpointThreshold is the disk space left on the data directory, in bytes, below which the node pauses
+	// writing catchpoint files. 0 never pauses it.
+	DiskSpaceCatchpointThreshold uint64 `version[28]:"5368709120"`
+
+	// DiskSpaceHaltThreshold is the disk space left on the data directory, in bytes, below which the node stops adding
+	// blocks to its ledger, so that the disk does not fill up in the middle of a database commit. The node resumes once
+	// space is freed. 0 never stops it.
+	DiskSpaceHaltThreshold uint64 `version[28]:"1073741824"`
+
+	// PerformanceTrackedAccounts is a comma delimited list of the accounts whose proposal and vote performance is
+	// tracked: for each round the node holds a participation key of an account for, whether the account was selected
+	// to propose and to vote, against what the block certificate records of it. It is reported by the REST API and
+	// the metrics.
+	PerformanceTrackedAccounts string `version[28]:""`
+
+	// StructuredLogging makes the node log in a structured JSON format, where the top level of every line holds the
+	// time, level, message and source location of the entry and the fields with a stable meaning: subsystem, round,
+	// peer and event. Any other field is nested under "fields". Otherwise all the fields are kept at the top level.
+	StructuredLogging bool `version[28]:"false"`
+
+	// EnableTxnTracing traces the lifecycle of the transactions submitted to the node: their submission, admission to
+	// the transaction pool, broadcast, the assembly or validation of the block including them, and its commit to the
+	// ledger. The traces are identified by the first 16 bytes of the transaction IDs, and exported through the
+	// telemetry when it exports to an OTLP collector.
+	EnableTxnTracing bool `version[28]:"false"`
+
+	// WatchdogSlowRoundThreshold is the time a round may take to be added to the ledger before the node emits a
+	// SlowRound telemetry event. 0 disables the check.
+	WatchdogSlowRoundThreshold time.Duration `version[28]:"10000000000"`
+
+	// WatchdogStallThreshold is the time without any round added to the ledger, that is without any certificate
+	// received, after which the node emits a LedgerStall telemetry event. 0 disables the check.
+	WatchdogStallThreshold time.Duration `version[28]:"60000000000"`
+
+	// WatchdogCommitBacklogThreshold is the number of rounds added to the ledger but not yet committed to its
+	// database past which the node emits a CommitBacklog telemetry event. 0 disables the check.
+	WatchdogCommitBacklogThreshold uint64 `version[28]:"1000"`
+
+	// AgreementCredentialCacheSize is the number of verified vote credentials the agreement keeps, keyed by the
+	// sender, round, period, step and seed of their selection, so that a credential seen in several votes and
+	// bundles is verified once. 0 disables the cache.
+	AgreementCredentialCacheSize uint64 `version[28]:"50000"`
+
+	// ProposalChunkingThreshold is the size in bytes above which proposal payloads this node broadcasts or relays
+	// are split into erasure-coded chunks sent under the PC tag, letting peers reassemble a proposal from chunks
+	// received from different peers. Chunks are always accepted; peers running a version without chunk support
+	// cannot reassemble them, so enable this only when neighbouring relays support it. 0 disables chunking.
+	ProposalChunkingThreshold uint64 `version[28]:"0"`
+
+	// ProposalChunkDataShards is the number of chunks a proposal payload above ProposalChunkingThreshold is split
+	// into. Any ProposalChunkDataShards chunks are enough to reassemble the proposal.
+	ProposalChunkDataShards uint64 `version[28]:"16"`
+
+	// ProposalChunkParityShards is the number of Reed-Solomon parity chunks sent in addition to the data chunks
+	// of a proposal payload. 0 sends the data chunks only. The total number of chunks may not exceed 255.
+	ProposalChunkParityShards uint64 `version[28]:"4"`
+
+	// BlockServiceCompressedCacheSize is the number of recently requested rounds for which the block service keeps
+	// its zstd-compressed block and certificate response, served to HTTP clients sending "Accept-Encoding: zstd".
+	// 0 disables compressed responses.
+	BlockServiceCompressedCacheSize uint64 `version[28]:"32"`
+
+	// AccountsDatabaseOptimizeInterval is the time between two background optimizations of the accounts database.
+	// An optimization refreshes the statistics of the query planner, and releases the unused pages of the database
+	// back to the file system a batch at a time, between the commits of the ledger. 0 disables the scheduled
+	// optimizations; they can still be requested through the REST API.
+	AccountsDatabaseOptimizeInterval time.Duration `version[28]:"86400000000000"`
+
+	// AccountsDatabaseOptimizeWindow restricts the scheduled optimizations of the accounts database to a daily time
+	// window in local time, formatted as "HH:MM-HH:MM", for example "02:00-05:00". A window ending before it starts
+	// spans midnight. An empty window allows the optimizations at any time.
+	AccountsDatabaseOptimizeWindow string `version[28]:""`
+
+	// AccountsDatabaseIncrementalVacuumPages is the maximal number of unused pages of the accounts database released
+	// by an optimization. 0 releases all of them.
+	AccountsDatabaseIncrementalVacuumPages uint64 `version[28]:"0"`
+
+	// LedgerCommitPipelineWindow is the number of ledger commits whose merkle trie update may still be in flight
+	// while the following rounds are serialized and written to the accounts database. The trie of a commit is then
+	// updated in a transaction of its own, after the one writing its accounts, except for the commits reaching a
+	// catchpoint round which wait for the trie to be up to date. If the node stops while trie updates are in flight,
+	// the trie is rebuilt on startup. 0 updates the trie in the transaction writing the accounts.
+	LedgerCommitPipelineWindow uint64 `version[28]:"0"`
+
+	// DNSSecureResolvers is a comma separated list of DNSSEC-aware DNS servers queried by the DNS lookups validated
+	// as of DNSSecurityFlags, after the system and fallback resolvers failed, and before the default public ones.
+	// A server is either ip:port for plain DNS, tls://host:port for DNS-over-TLS or https://host/path for
+	// DNS-over-HTTPS, which work around local resolvers stripping DNSSEC records or blocked UDP DNS.
+	// Setting the 0x08 flag of DNSSecurityFlags queries these servers alone.
+	DNSSecureResolvers string `version[28]:""`
+
+	// GossipBandwidthBudget is the egress, in bytes per second, the node aims to stay within, for example 1250000
+	// for a 10 Mbps uplink. The number of peers the relayed messages and the transactions are broadcast to is then
+	// tuned to the measured egress, down to GossipAdaptiveFanoutMin peers, while the votes and proposals the node
+	// originates are still broadcast to all its peers. 0 disables the tuning, leaving BroadcastConnectionsLimit
+	// as the only limit.
+	GossipBandwidthBudget uint64 `version[28]:"0"`
+
+	// GossipAdaptiveFanoutMin is the number of peers the broadcasts are never limited below when tuned to the
+	// GossipBandwidthBudget.
+	GossipAdaptiveFanoutMin int `version[28]:"2"`
+
+	// TxPoolPackByValue makes the transaction pool evaluate the pending transaction groups with the highest fee
+	// per byte first once they no longer fit in a block, so that the blocks assembled within ProposalAssemblyTime
+	// pack the most valuable groups during congestion. The groups referencing a common account, application or
+	// asset are still evaluated in their arrival order.
+	TxPoolPackByValue bool `version[28]:"false"`
+
+	// SystemdReadyMaxLag is how recent the latest block must be, by its timestamp, for algod to notify systemd that
+	// it is ready when it runs as a service of Type=notify. The watchdog keepalives of a service configured with
+	// WatchdogSec are only sent while the ledger is neither stalled nor backlogged, as checked with
+	// WatchdogStallThreshold and WatchdogCommitBacklogThreshold, so that systemd restarts a hung node.
+	SystemdReadyMaxLag time.Duration `version[28]:"60000000000"`
+
+	// CatchpointFeedURL is the URL of the signed catchpoint feed that goal node catchup looks the latest catchpoint
+	// label up from when none is given, instead of the unsigned label published for the network. The feed must be
+	// signed by one of the CatchpointFeedPublishers, and issued less than two days ago. Feeds are produced from archival
+	// nodes with goal node catchpoint-feed.
+	CatchpointFeedURL string `version[28]:""`
+
+	// CatchpointFeedPublishers is the comma separated list of the addresses of the keys trusted to sign the catchpoint
+	// feed of CatchpointFeedURL.
+	CatchpointFeedPublishers string `version[28]:""`
+
+	// BlockVaultBucket is the bucket of the block vault, an S3-compatible object storage that archival nodes move
+	// the blocks older than BlockVaultAfterRounds rounds to, keeping only their headers locally. Vaulted blocks are
+	// fetched back transparently when requested. An empty bucket disables the block vault.
+	BlockVaultBucket string `version[28]:""`
+
+	// BlockVaultEndpoint is the endpoint of the object storage of the block vault, such as
+	// https://storage.googleapis.com for GCS. An empty endpoint selects AWS S3.
+	BlockVaultEndpoint string `version[28]:""`
+
+	// BlockVaultRegion is the region of the bucket of the block vault. An empty region selects us-east-1, or the
+	// S3_REGION environment variable when set.
+	BlockVaultRegion string `version[28]:""`
+
+	// BlockVaultPrefix is prepended to the keys of the blocks in the block vault, which are named after the genesis
+	// hash of the network and the round of the block.
+	BlockVaultPrefix string `version[28]:""`
+
+	// BlockVaultCredentialsFile is the AWS shared credentials file holding the credentials to the block vault. When
+	// empty, the credentials are looked up as usual, e.g. from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+	// environment variables.
+	BlockVaultCredentialsFile string `version[28]:""`
+
+	// BlockVaultAfterRounds is the number of rounds after which the blocks of an archival node are moved to the
+	// block vault. Zero keeps every block locally, while still fetching the blocks already moved to the vault.
+	BlockVaultAfterRounds uint64 `version[28]:"0"`
+
+	// TorProxyAddress is the address of the SOCKS5 proxy of a Tor daemon, such as 127.0.0.1:9050, through which the
+	// peers with an onion address are dialed. When empty, the onion addresses of the phonebook cannot be dialed.
+	TorProxyAddress string `version[28]:""`
+
+	// TorDialAllPeers routes every outgoing peer connection through the Tor proxy of TorProxyAddress, not only the
+	// connections to onion addresses, so that the peers do not learn the network address of the node.
+	TorDialAllPeers bool `version[28]:"false"`
+
+	// TorControlAddress is the address of the control port of a Tor daemon, such as 127.0.0.1:9051. When set on a
+	// node listening on NetAddress, the gossip endpoint is published as an onion service, whose address is announced
+	// to the peers in place of PublicAddress. The Tor daemon must accept cookie authentication, or no authentication.
+	TorControlAddress string `version[28]:""`
+
+	// TorOnionKeyFile is the file the private key of the onion service published through TorControlAddress is kept
+	// in, so that its onion address survives restarts. When empty, a new onion address is published on every start.
+	TorOnionKeyFile string `version[28]:""`
+
+	// CatchupBlockArchiveDir is the directory of a block archive, written by goal ledger export-blocks, which the
+	// catchup imports on startup before fetching the following blocks from the network. The archived blocks are
+	// authenticated against their certificates, so that new archival nodes can be bootstrapped from offline media.
+	CatchupBlockArchiveDir string `version[28]:""`
+
+	// AgreementVerificationParallelism is the number of agreement votes and bundles verified at once, which are
+	// otherwise held by the agreement to be verified by priority: the votes of the round being agreed on first, then
+	// the votes of future rounds, then the bundles, with the tasks waiting for long raised in priority. 0 uses the
+	// number of CPUs available to the process, as of GOMAXPROCS.
+	AgreementVerificationParallelism int `version[28]:"0"`
+
+	// EnableCreatableHolderIndex maintains an index of the accounts holding each asset and opted in each application,
+	// which the REST API pages through by address. It is only honored on archival nodes and follower nodes, and costs
+	// an extra write for every asset opt-in or opt-out. Building the index of an existing ledger takes a while on the
+	// first start; disabling it drops the index.
+	EnableCreatableHolderIndex bool `version[28]:"false"`
+
+	// EndpointUnixSocket, when set, is the path of a unix domain socket on which algod serves the REST API as it
+	// does on EndpointAddress, in addition to it. A relative path is relative to the data directory.
+	EndpointUnixSocket string `version[28]:""`
+
+	// EndpointUnixSocketMode is the octal permissions of the EndpointUnixSocket file. Only the users allowed to
+	// write to the socket can connect to it, and they still need an API token.
+	EndpointUnixSocketMode string `version[28]:"0660"`
+
+	// RestTokenRequestsPerSecond is the rate at which the request budget of each API token used on the REST
+	// listeners refills, in request costs per second. The requests exceeding the budget are answered with
+	// 429 Too Many Requests and a Retry-After header. The admin API token is not limited. 0 disables the budgets.
+	RestTokenRequestsPerSecond uint64 `version[28]:"0"`
+
+	// RestTokenRequestsBurst is the size of the request budget of each API token, 0 meaning one second of
+	// RestTokenRequestsPerSecond.
+	RestTokenRequestsBurst uint64 `version[28]:"0"`
+
+	// RestTokenConcurrentRequests is the number of requests each API token but the admin one may have in flight on
+	// the REST listeners, the requests past it being answered with 429 Too Many Requests. 0 means no limit.
+	RestTokenConcurrentRequests uint64 `version[28]:"0"`
+
+	// RestEndpointCosts is a comma delimited list of the costs of the REST endpoints charged to the request budget
+	// of the tokens, each endpoint not listed costing 1. The entries are of the form "GET /v2/accounts/:address=5",
+	// the endpoint being named as in the endpoint label of algod_rest_request_duration_seconds.
+	RestEndpointCosts string `version[28]:""`
+
+	// CatchupPeerAllowList is a comma delimited list of the peers catchup fetches blocks and catchpoints from, when not empty, so that
+	// a node can catch up from a trusted set of archival nodes while gossiping with any peer. The entries are
+	// networks in the CIDR notation, IP addresses, or host names in which * matches any sequence of characters, such
+	// as *.example.com. Host names are matched against the peer addresses as they are, without resolving them.
+	CatchupPeerAllowList string `version[28]:""`
+
+	// CatchupPeerDenyList is a comma delimited list of the peers catchup never fetches blocks or catchpoints from, in the format of
+	// CatchupPeerAllowList, taking precedence over it.
+	CatchupPeerDenyList string `version[28]:""`
+
+	// EnableAgreementCheckpoints persists the agreement state whenever it moves to a new round, period or step, in addition
+	// to before casting votes, so that a restarted node resumes from the step it was at instead of rejoining the round.
+	EnableAgreementCheckpoints bool `version[28]:"true"`
+
+	// EnablePrivateTxSubmission enables the POST /v2/transactions/private endpoint, which adds the transactions to the
+	// transaction pool without gossiping them, and forwards them to the PrivateTxRelays. The private transactions are kept
+	// out of the pending transactions served to the peers, and only become public once included in a block.
+	EnablePrivateTxSubmission bool `version[28]:"false"`
+
+	// PrivateTxRelays is a comma delimited list of the REST endpoints of the relays the private transactions are forwarded
+	// to, each one given the API token the relay granted to the node, with the submit scope, as the user of its URL, as in
+	// https://token@relay.example.com:8080. The relays should enable EnablePrivateTxSubmission, and be or forward to block
+	// proposers for the private transactions to be included.
+	PrivateTxRelays string `version[28]:""`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
@@ -594,6 +968,11 @@ func (cfg Local) DNSSecurityTelemeryAddrEnforced() bool {
 	return cfg.DNSSecurityFlags&dnssecTelemetryAddr != 0
 }
 
+// DNSSecurityUpstreamsOnly returns true if the validated DNS lookups query the DNSSecureResolvers alone
+func (cfg Local) DNSSecurityUpstreamsOnly() bool {
+	return cfg.DNSSecurityFlags&dnssecUpstreamsOnly != 0
+}
+
 // CatchupVerifyCertificate returns true if certificate verification is needed
 func (cfg Local) CatchupVerifyCertificate() bool {
 	return cfg.CatchupBlockValidateMode&catchupValidationModeCertificate == 0
@@ -20,123 +20,204 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    28,
-	AccountUpdatesStatsInterval:                5000000000,
-	AccountsRebuildSynchronousMode:             1,
-	AgreementIncomingBundlesQueueLength:        15,
-	AgreementIncomingProposalsQueueLength:      50,
-	AgreementIncomingVotesQueueLength:          20000,
-	AnnounceParticipationKey:                   true,
-	Archival:                                   false,
-	BaseLoggerDebugLevel:                       4,
-	BlockServiceCustomFallbackEndpoints:        "",
-	BlockServiceMemCap:                         500000000,
-	BroadcastConnectionsLimit:                  -1,
-	CadaverDirectory:                           "",
-	CadaverSizeTarget:                          0,
-	CatchpointFileHistoryLength:                365,
-	CatchpointInterval:                         10000,
-	CatchpointTracking:                         0,
-	CatchupBlockDownloadRetryAttempts:          1000,
-	CatchupBlockValidateMode:                   0,
-	CatchupFailurePeerRefreshRate:              10,
-	CatchupGossipBlockFetchTimeoutSec:          4,
-	CatchupHTTPBlockFetchTimeoutSec:            4,
-	CatchupLedgerDownloadRetryAttempts:         50,
-	CatchupParallelBlocks:                      16,
-	ConnectionsRateLimitingCount:               60,
-	ConnectionsRateLimitingWindowSeconds:       1,
-	DNSBootstrapID:                             "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)",
-	DNSSecurityFlags:                           1,
-	DeadlockDetection:                          0,
-	DeadlockDetectionThreshold:                 30,
-	DisableLedgerLRUCache:                      false,
-	DisableLocalhostConnectionRateLimit:        true,
-	DisableNetworking:                          false,
-	DisableOutgoingConnectionThrottling:        false,
-	EnableAccountUpdatesStats:                  false,
-	EnableAgreementReporting:                   false,
-	EnableAgreementTimeMetrics:                 false,
-	EnableAssembleStats:                        false,
-	EnableBlockService:                         false,
-	EnableBlockServiceFallbackToArchiver:       true,
-	EnableCatchupFromArchiveServers:            false,
-	EnableDeveloperAPI:                         false,
-	EnableExperimentalAPI:                      false,
-	EnableFollowMode:                           false,
-	EnableGossipBlockService:                   true,
-	EnableIncomingMessageFilter:                false,
-	EnableLedgerService:                        false,
-	EnableMetricReporting:                      false,
-	EnableOutgoingNetworkMessageFiltering:      true,
-	EnablePingHandler:                          true,
-	EnableProcessBlockStats:                    false,
-	EnableProfiler:                             false,
-	EnableRequestLogger:                        false,
-	EnableRuntimeMetrics:                       false,
-	EnableTopAccountsReporting:                 false,
-	EnableTxBacklogRateLimiting:                false,
-	EnableTxnEvalTracer:                        false,
-	EnableUsageLog:                             false,
-	EnableVerbosedTransactionSyncLogging:       false,
-	EndpointAddress:                            "127.0.0.1:0",
-	FallbackDNSResolverAddress:                 "",
-	ForceFetchTransactions:                     false,
-	ForceRelayMessages:                         false,
-	GossipFanout:                               4,
-	HeartbeatUpdateInterval:                    600,
-	IncomingConnectionsLimit:                   2400,
-	IncomingMessageFilterBucketCount:           5,
-	IncomingMessageFilterBucketSize:            512,
-	LedgerSynchronousMode:                      2,
-	LogArchiveMaxAge:                           "",
-	LogArchiveName:                             "node.archive.log",
-	LogSizeLimit:                               1073741824,
-	MaxAPIBoxPerApplication:                    100000,
-	MaxAPIResourcesPerAccount:                  100000,
-	MaxAcctLookback:                            4,
-	MaxCatchpointDownloadDuration:              43200000000000,
-	MaxConnectionsPerIP:                        15,
-	MinCatchpointFileDownloadBytesPerSecond:    20480,
-	NetAddress:                                 "",
-	NetworkMessageTraceServer:                  "",
-	NetworkProtocolVersion:                     "",
-	NodeExporterListenAddress:                  ":9100",
-	NodeExporterPath:                           "./node_exporter",
-	OptimizeAccountsDatabaseOnStartup:          false,
-	OutgoingMessageFilterBucketCount:           3,
-	OutgoingMessageFilterBucketSize:            128,
-	ParticipationKeysRefreshInterval:           60000000000,
-	PeerConnectionsUpdateInterval:              3600,
-	PeerPingPeriodSeconds:                      0,
-	PriorityPeers:                              map[string]bool{},
-	ProposalAssemblyTime:                       500000000,
-	PublicAddress:                              "",
-	ReconnectTime:                              60000000000,
-	ReservedFDs:                                256,
-	RestConnectionsHardLimit:                   2048,
-	RestConnectionsSoftLimit:                   1024,
-	RestReadTimeoutSeconds:                     15,
-	RestWriteTimeoutSeconds:                    120,
-	RunHosted:                                  false,
-	StorageEngine:                              "sqlite",
-	SuggestedFeeBlockHistory:                   3,
-	SuggestedFeeSlidingWindowSize:              50,
-	TLSCertFile:                                "",
-	TLSKeyFile:                                 "",
-	TelemetryToLog:                             true,
-	TransactionSyncDataExchangeRate:            0,
-	TransactionSyncSignificantMessageThreshold: 0,
-	TxBacklogReservedCapacityPerPeer:           20,
-	TxBacklogServiceRateWindowSeconds:          10,
-	TxBacklogSize:                              26000,
-	TxIncomingFilterMaxSize:                    500000,
-	TxIncomingFilteringFlags:                   1,
-	TxPoolExponentialIncreaseFactor:            2,
-	TxPoolSize:                                 75000,
-	TxSyncIntervalSeconds:                      60,
-	TxSyncServeResponseSize:                    1000000,
-	TxSyncTimeoutSeconds:                       30,
-	UseXForwardedForAddressField:               "",
-	VerifiedTranscationsCacheSize:              150000,
+	Version:                                     28,
+	AccountUpdatesStatsInterval:                 5000000000,
+	AccountsDatabaseIncrementalVacuumPages:      0,
+	AccountsDatabaseOptimizeInterval:            86400000000000,
+	AccountsDatabaseOptimizeWindow:              "",
+	AccountsRebuildSynchronousMode:              1,
+	AdminAccessLogSampling:                      1,
+	AdminAllowPlaintextParticipationKeyTransfer: false,
+	AdminCORSAllowedOrigins:                     "",
+	AdminEndpointAddress:                        "",
+	AdminTLSCertFile:                            "",
+	AdminTLSKeyFile:                             "",
+	AgreementCredentialCacheSize:                50000,
+	AgreementIncomingBundlesQueueLength:         15,
+	AgreementIncomingProposalsQueueLength:       50,
+	AgreementIncomingVotesQueueLength:           20000,
+	AgreementVerificationParallelism:            0,
+	AnnounceParticipationKey:                    true,
+	Archival:                                    false,
+	BaseLoggerDebugLevel:                        4,
+	BlockServiceCompressedCacheSize:             32,
+	BlockServiceCustomFallbackEndpoints:         "",
+	BlockServiceMemCap:                          500000000,
+	BlockVaultAfterRounds:                       0,
+	BlockVaultBucket:                            "",
+	BlockVaultCredentialsFile:                   "",
+	BlockVaultEndpoint:                          "",
+	BlockVaultPrefix:                            "",
+	BlockVaultRegion:                            "",
+	BroadcastConnectionsLimit:                   -1,
+	CadaverDirectory:                            "",
+	CadaverSizeTarget:                           0,
+	CatchpointFeedPublishers:                    "",
+	CatchpointFeedURL:                           "",
+	CatchpointFileHistoryLength:                 365,
+	CatchpointInterval:                          10000,
+	CatchpointTracking:                          0,
+	CatchupBlockArchiveDir:                      "",
+	CatchupBlockDownloadRetryAttempts:           1000,
+	CatchupBlockValidateMode:                    0,
+	CatchupFailurePeerRefreshRate:               10,
+	CatchupGossipBlockFetchTimeoutSec:           4,
+	CatchupHTTPBlockFetchTimeoutSec:             4,
+	CatchupLedgerDownloadRetryAttempts:          50,
+	CatchupParallelBlocks:                       16,
+	CatchupPeerAllowList:                        "",
+	CatchupPeerDenyList:                         "",
+	ConnectionsRateLimitingCount:                60,
+	ConnectionsRateLimitingWindowSeconds:        1,
+	DNSBootstrapID:                              "<network>.algorand.network?backup=<network>.algorand.net&dedup=<name>.algorand-<network>.(network|net)",
+	DNSSecureResolvers:                          "",
+	DNSSecurityFlags:                            1,
+	DeadlockDetection:                           0,
+	DeadlockDetectionThreshold:                  30,
+	DisableLedgerLRUCache:                       false,
+	DisableLocalhostConnectionRateLimit:         true,
+	DisableNetworking:                           false,
+	DisableOutgoingConnectionThrottling:         false,
+	DiskSpaceCatchpointThreshold:                5368709120,
+	DiskSpaceHaltThreshold:                      1073741824,
+	DiskSpaceWarningThreshold:                   10737418240,
+	EnableAccountUpdatesStats:                   false,
+	EnableAgreementCheckpoints:                  true,
+	EnableAgreementReporting:                    false,
+	EnableAgreementTimeMetrics:                  false,
+	EnableAssembleStats:                         false,
+	EnableBlockService:                          false,
+	EnableBlockServiceFallbackToArchiver:        true,
+	EnableCatchupFromArchiveServers:             false,
+	EnableCreatableHolderIndex:                  false,
+	EnableDeveloperAPI:                          false,
+	EnableExperimentalAPI:                       false,
+	EnableFollowMode:                            false,
+	EnableGossipBlockService:                    true,
+	EnableIncomingMessageFilter:                 false,
+	EnableLedgerService:                         false,
+	EnableMetricReporting:                       false,
+	EnableOutgoingNetworkMessageFiltering:       true,
+	EnableParticipationKeyRenewal:               false,
+	EnablePingHandler:                           true,
+	EnablePrivateTxSubmission:                   false,
+	EnableProcessBlockStats:                     false,
+	EnableProfiler:                              false,
+	EnableRequestLogger:                         false,
+	EnableRuntimeMetrics:                        false,
+	EnableTopAccountsReporting:                  false,
+	EnableTxBacklogRateLimiting:                 false,
+	EnableTxnEvalTracer:                         false,
+	EnableTxnTracing:                            false,
+	EnableUsageLog:                              false,
+	EnableVerbosedTransactionSyncLogging:        false,
+	EndpointAddress:                             "127.0.0.1:0",
+	EndpointUnixSocket:                          "",
+	EndpointUnixSocketMode:                      "0660",
+	FallbackDNSResolverAddress:                  "",
+	FollowerMaxDeltaRetention:                   0,
+	ForceFetchTransactions:                      false,
+	ForceRelayMessages:                          false,
+	GRPCListenAddress:                           "",
+	GRPCTLSCertFile:                             "",
+	GRPCTLSKeyFile:                              "",
+	GossipAdaptiveFanoutMin:                     2,
+	GossipBandwidthBudget:                       0,
+	GossipFanout:                                4,
+	HeartbeatUpdateInterval:                     600,
+	IncomingConnectionsLimit:                    2400,
+	IncomingMessageFilterBucketCount:            5,
+	IncomingMessageFilterBucketSize:             512,
+	LedgerCommitPipelineWindow:                  0,
+	LedgerSynchronousMode:                       2,
+	LogArchiveMaxAge:                            "",
+	LogArchiveName:                              "node.archive.log",
+	LogSizeLimit:                                1073741824,
+	MaxAPIBoxPerApplication:                     100000,
+	MaxAPIResourcesPerAccount:                   100000,
+	MaxAcctLookback:                             4,
+	MaxCatchpointDownloadDuration:               43200000000000,
+	MaxConnectionsPerIP:                         15,
+	MetricsDisabledSubsystems:                   "",
+	MetricsListenAddress:                        "",
+	MetricsMaxLabelSets:                         1000,
+	MetricsRequireAuth:                          false,
+	MetricsTLSCertFile:                          "",
+	MetricsTLSKeyFile:                           "",
+	MinCatchpointFileDownloadBytesPerSecond:     20480,
+	NetAddress:                                  "",
+	NetworkMessageTraceServer:                   "",
+	NetworkProtocolVersion:                      "",
+	NodeExporterListenAddress:                   ":9100",
+	NodeExporterPath:                            "./node_exporter",
+	OptimizeAccountsDatabaseOnStartup:           false,
+	OutgoingMessageFilterBucketCount:            3,
+	OutgoingMessageFilterBucketSize:             128,
+	PKCS11ModulePath:                            "",
+	PKCS11PinFile:                               "",
+	PKCS11StateProofKeysInDatabase:              false,
+	PKCS11TokenLabel:                            "",
+	ParticipationKeyRenewalLeadRounds:           100000,
+	ParticipationKeyRenewalSigner:               "",
+	ParticipationKeyRenewalValidRounds:          3000000,
+	ParticipationKeyRenewalWallet:               "",
+	ParticipationKeySignerProvider:              "",
+	ParticipationKeysRefreshInterval:            60000000000,
+	PeerConnectionsUpdateInterval:               3600,
+	PeerPingPeriodSeconds:                       0,
+	PerformanceTrackedAccounts:                  "",
+	PriorityPeers:                               map[string]bool{},
+	PrivateTxRelays:                             "",
+	ProposalAssemblyTime:                        500000000,
+	ProposalChunkDataShards:                     16,
+	ProposalChunkParityShards:                   4,
+	ProposalChunkingThreshold:                   0,
+	PublicAddress:                               "",
+	ReconnectTime:                               60000000000,
+	ReservedFDs:                                 256,
+	ResourceUsageWarningPercent:                 80,
+	RestAccessLogSampling:                       1,
+	RestCORSAllowedOrigins:                      "*",
+	RestConnectionsHardLimit:                    2048,
+	RestConnectionsSoftLimit:                    1024,
+	RestEndpointCosts:                           "",
+	RestReadTimeoutSeconds:                      15,
+	RestTokenConcurrentRequests:                 0,
+	RestTokenRequestsBurst:                      0,
+	RestTokenRequestsPerSecond:                  0,
+	RestWriteTimeoutSeconds:                     120,
+	RunHosted:                                   false,
+	ShutdownDeadline:                            30000000000,
+	StateProofRemoteSigner:                      "",
+	StateProofSigningThreads:                    1,
+	StorageEngine:                               "sqlite",
+	StructuredLogging:                           false,
+	SuggestedFeeBlockHistory:                    3,
+	SuggestedFeeSlidingWindowSize:               50,
+	SystemdReadyMaxLag:                          60000000000,
+	TLSCertFile:                                 "",
+	TLSKeyFile:                                  "",
+	TelemetryToLog:                              true,
+	TorControlAddress:                           "",
+	TorDialAllPeers:                             false,
+	TorOnionKeyFile:                             "",
+	TorProxyAddress:                             "",
+	TransactionSyncDataExchangeRate:             0,
+	TransactionSyncSignificantMessageThreshold:  0,
+	TxBacklogReservedCapacityPerPeer:            20,
+	TxBacklogServiceRateWindowSeconds:           10,
+	TxBacklogSize:                               26000,
+	TxIncomingFilterMaxSize:                     500000,
+	TxIncomingFilteringFlags:                    1,
+	TxPoolExponentialIncreaseFactor:             2,
+	TxPoolPackByValue:                           false,
+	TxPoolSize:                                  75000,
+	TxSyncIntervalSeconds:                       60,
+	TxSyncServeResponseSize:                     1000000,
+	TxSyncTimeoutSeconds:                        30,
+	UseXForwardedForAddressField:                "",
+	VerifiedTranscationsCacheSize:               150000,
+	WatchdogCommitBacklogThreshold:              1000,
+	WatchdogSlowRoundThreshold:                  10000000000,
+	WatchdogStallThreshold:                      60000000000,
 }
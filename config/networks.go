@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// NetworksFilename is the name of the file listing the networks hosted by a single algod process,
+// in the data directory of the process. The process-wide settings, such as logging, telemetry and
+// custom consensus protocols, are taken from the directory holding it, and each network has its
+// own data directory.
+const NetworksFilename = "networks.json"
+
+// NetworkEntry is a network hosted by an algod process.
+type NetworkEntry struct {
+	// DataDir is the data directory of the network, relative to the data directory of the process
+	// unless absolute.
+	DataDir string
+}
+
+// NetworksConfig lists the networks hosted by an algod process.
+type NetworksConfig struct {
+	Networks []NetworkEntry
+}
+
+// LoadNetworksConfig loads the networks hosted by the algod process of the given data directory.
+// The error satisfies os.IsNotExist when the process hosts a single network.
+func LoadNetworksConfig(root string) (NetworksConfig, error) {
+	var nc NetworksConfig
+	f, err := os.Open(filepath.Join(root, NetworksFilename))
+	if err != nil {
+		return nc, err
+	}
+	defer f.Close()
+
+	err = json.NewDecoder(f).Decode(&nc)
+	if err != nil {
+		return nc, fmt.Errorf("error decoding %s: %w", NetworksFilename, err)
+	}
+	if len(nc.Networks) == 0 {
+		return nc, fmt.Errorf("%s lists no network", NetworksFilename)
+	}
+	dirs := make(map[string]bool, len(nc.Networks))
+	for _, dir := range nc.DataDirs(root) {
+		if dirs[dir] {
+			return nc, fmt.Errorf("%s lists the data directory %s more than once", NetworksFilename, dir)
+		}
+		dirs[dir] = true
+	}
+	return nc, nil
+}
+
+// SaveToDisk writes the networks into the NetworksFilename file of the given data directory.
+func (nc NetworksConfig) SaveToDisk(root string) error {
+	f, err := os.OpenFile(filepath.Join(root, NetworksFilename), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return codecs.NewFormattedJSONEncoder(f).Encode(nc)
+}
+
+// DataDirs returns the data directories of the networks, resolved against the data directory of
+// the process.
+func (nc NetworksConfig) DataDirs(root string) []string {
+	dirs := make([]string, len(nc.Networks))
+	for i, network := range nc.Networks {
+		dirs[i] = network.DataDir
+		if !filepath.IsAbs(dirs[i]) {
+			dirs[i] = filepath.Join(root, dirs[i])
+		}
+		dirs[i] = filepath.Clean(dirs[i])
+	}
+	return dirs
+}
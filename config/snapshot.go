@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// SnapshotManifestFilename is the name of the file describing a snapshot of a data directory, at the root of
+// the snapshot. Its presence marks a complete snapshot, as it is written last.
+const SnapshotManifestFilename = "snapshot.json"
+
+// SnapshotManifest describes a snapshot of a data directory, taken by a running node with goal node snapshot
+// create, and restored into a data directory with goal node snapshot restore.
+type SnapshotManifest struct {
+	// GenesisID is the genesis ID of the network of the node.
+	GenesisID string
+	// Round is the latest round of the ledger when the snapshot was taken, which the snapshot holds at least.
+	Round uint64
+	// Created is the time the snapshot was taken, in seconds since the epoch.
+	Created int64
+	// ParticipationKeys is whether the participation keys of the node were copied into the snapshot.
+	ParticipationKeys bool `json:",omitempty"`
+}
+
+// LoadSnapshotManifest loads the manifest of the snapshot of the given directory.
+func LoadSnapshotManifest(dir string) (SnapshotManifest, error) {
+	var m SnapshotManifest
+	f, err := os.Open(filepath.Join(dir, SnapshotManifestFilename))
+	if err != nil {
+		return m, err
+	}
+	defer f.Close()
+
+	err = json.NewDecoder(f).Decode(&m)
+	if err != nil {
+		return m, fmt.Errorf("error decoding %s: %w", SnapshotManifestFilename, err)
+	}
+	if m.GenesisID == "" {
+		return m, fmt.Errorf("%s has no genesis ID", SnapshotManifestFilename)
+	}
+	return m, nil
+}
+
+// SaveToDisk writes the manifest into the SnapshotManifestFilename file of the given snapshot directory.
+func (m SnapshotManifest) SaveToDisk(dir string) error {
+	f, err := os.OpenFile(filepath.Join(dir, SnapshotManifestFilename), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return codecs.NewFormattedJSONEncoder(f).Encode(m)
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// SignatureBatch is a batch that ed25519 signatures can be enqueued into, for verification at a later time.
+// It is implemented by both BatchVerifier and BatchVerification.
+type SignatureBatch interface {
+	EnqueueSignature(sigVerifier SignatureVerifier, message Hashable, sig Signature)
+	GetNumberOfEnqueuedSignatures() int
+}
+
+// BatchVerification accumulates ed25519 signatures, one-time signatures and VRF proofs, grouped into
+// items, and verifies them at once. An item is the unit failures are reported for, e.g. a vote or
+// a transaction group, and is valid when all of its checks are.
+// The ed25519 signatures are verified with a single batched operation, which reports the failed
+// signatures by itself. The VRF proofs have no batched verification, and are verified one by one,
+// skipping the items already known to be invalid.
+type BatchVerification struct {
+	sigMessages [][]byte
+	sigKeys     []SignatureVerifier
+	sigs        []Signature
+
+	vrfKeys     []VrfPubkey
+	vrfProofs   []VrfProof
+	vrfMessages [][]byte
+	vrfOutputs  []VrfOutput
+
+	// itemSigs[i] and itemVrfs[i] are the index of the first signature and proof of item i
+	itemSigs []int
+	itemVrfs []int
+}
+
+// MakeBatchVerification creates a BatchVerification, with room for hint items without expanding.
+func MakeBatchVerification(hint int) *BatchVerification {
+	if hint < minBatchVerifierAlloc {
+		hint = minBatchVerifierAlloc
+	}
+	return &BatchVerification{
+		sigMessages: make([][]byte, 0, hint),
+		sigKeys:     make([]SignatureVerifier, 0, hint),
+		sigs:        make([]Signature, 0, hint),
+		itemSigs:    make([]int, 0, hint),
+		itemVrfs:    make([]int, 0, hint),
+	}
+}
+
+// StartItem starts a new item, which the checks enqueued next belong to, and returns its index.
+func (b *BatchVerification) StartItem() int {
+	b.itemSigs = append(b.itemSigs, len(b.sigs))
+	b.itemVrfs = append(b.itemVrfs, len(b.vrfProofs))
+	return len(b.itemSigs) - 1
+}
+
+// NumItems returns the number of items started in the batch.
+func (b *BatchVerification) NumItems() int {
+	return len(b.itemSigs)
+}
+
+func (b *BatchVerification) ensureItem() {
+	if len(b.itemSigs) == 0 {
+		b.StartItem()
+	}
+}
+
+// EnqueueSignature enqueues an ed25519 signature into the current item.
+func (b *BatchVerification) EnqueueSignature(sigVerifier SignatureVerifier, message Hashable, sig Signature) {
+	b.ensureItem()
+	b.sigMessages = append(b.sigMessages, HashRep(message))
+	b.sigKeys = append(b.sigKeys, sigVerifier)
+	b.sigs = append(b.sigs, sig)
+}
+
+// GetNumberOfEnqueuedSignatures returns the number of ed25519 signatures enqueued into the batch,
+// including the three signatures making up each one-time signature.
+func (b *BatchVerification) GetNumberOfEnqueuedSignatures() int {
+	return len(b.sigs)
+}
+
+// EnqueueOneTimeSignature enqueues a one-time signature into the current item.
+func (b *BatchVerification) EnqueueOneTimeSignature(v OneTimeSignatureVerifier, id OneTimeSignatureIdentifier, message Hashable, sig OneTimeSignature) {
+	b.ensureItem()
+	messages, keys, sigs := v.batchChecks(id, message, sig)
+	b.sigMessages = append(b.sigMessages, messages...)
+	b.sigKeys = append(b.sigKeys, keys...)
+	b.sigs = append(b.sigs, sigs...)
+}
+
+// EnqueueVrfProof enqueues a VRF proof into the current item, and returns the index of its output.
+func (b *BatchVerification) EnqueueVrfProof(pk VrfPubkey, proof VrfProof, message Hashable) int {
+	b.ensureItem()
+	b.vrfMessages = append(b.vrfMessages, HashRep(message))
+	b.vrfKeys = append(b.vrfKeys, pk)
+	b.vrfProofs = append(b.vrfProofs, proof)
+	b.vrfOutputs = append(b.vrfOutputs, VrfOutput{})
+	return len(b.vrfProofs) - 1
+}
+
+// VrfOutput returns the output of the VRF proof with the given index. It is only meaningful
+// once Verify returned, for the proofs of the items that did not fail.
+func (b *BatchVerification) VrfOutput(i int) VrfOutput {
+	return b.vrfOutputs[i]
+}
+
+// Verify verifies all the enqueued checks. If all items are valid, nil is returned for err
+// (and failed has all false), otherwise true is set in failed at the indexes of the invalid
+// items, and err is ErrBatchHasFailedSigs.
+func (b *BatchVerification) Verify() (failed []bool, err error) {
+	if b.NumItems() == 0 {
+		return nil, nil
+	}
+	failed = make([]bool, b.NumItems())
+
+	if len(b.sigs) != 0 {
+		allValid, failedSigs := batchVerificationImpl(b.sigMessages, b.sigKeys, b.sigs)
+		if !allValid {
+			item := 0
+			for i, f := range failedSigs {
+				item = itemOf(b.itemSigs, item, i)
+				failed[item] = failed[item] || f
+			}
+		}
+	}
+
+	item := 0
+	for i := range b.vrfProofs {
+		item = itemOf(b.itemVrfs, item, i)
+		if failed[item] {
+			continue
+		}
+		var ok bool
+		ok, b.vrfOutputs[i] = b.vrfKeys[i].VerifyBytes(b.vrfProofs[i], b.vrfMessages[i])
+		if !ok {
+			failed[item] = true
+		}
+	}
+
+	for _, f := range failed {
+		if f {
+			return failed, ErrBatchHasFailedSigs
+		}
+	}
+	return failed, nil
+}
+
+// itemOf returns the item check i belongs to, given the index of the first check of every
+// item in starts. The checks are looked up in increasing order, from the item of the previous one.
+func itemOf(starts []int, item int, i int) int {
+	for item+1 < len(starts) && starts[item+1] <= i {
+		item++
+	}
+	return item
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// enqueueBatchItem enqueues an item made of a signature, a one-time signature and a VRF proof,
+// breaking one of them when invalid is set, and returns the expected output of the proof.
+func enqueueBatchItem(t *testing.T, b *BatchVerification, i int, invalid bool) (proofIdx int, out VrfOutput) {
+	var s Seed
+	RandBytes(s[:])
+	sigSecrets := GenerateSignatureSecrets(s)
+	msg := randString()
+	sig := sigSecrets.Sign(msg)
+
+	otss := GenerateOneTimeSignatureSecrets(0, 1)
+	id := OneTimeSignatureIdentifier{Batch: 0, Offset: 0}
+	otsig := otss.Sign(id, msg)
+
+	vrfPK, vrfSK := VrfKeygen()
+	proof, ok := vrfSK.Prove(msg)
+	require.True(t, ok)
+	ok, out = vrfPK.Verify(proof, msg)
+	require.True(t, ok)
+
+	if invalid {
+		switch i % 3 {
+		case 0:
+			sig[0]++
+		case 1:
+			otsig.Sig[0]++
+		case 2:
+			proof[0]++
+		}
+	}
+
+	b.StartItem()
+	b.EnqueueSignature(sigSecrets.SignatureVerifier, msg, sig)
+	b.EnqueueOneTimeSignature(otss.OneTimeSignatureVerifier, id, msg, otsig)
+	return b.EnqueueVrfProof(vrfPK, proof, msg), out
+}
+
+func TestBatchVerificationEmpty(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	failed, err := MakeBatchVerification(0).Verify()
+	require.NoError(t, err)
+	require.Empty(t, failed)
+}
+
+func TestBatchVerificationValid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	n := 20
+	b := MakeBatchVerification(n)
+	outputs := make(map[int]VrfOutput)
+	for i := 0; i < n; i++ {
+		idx, out := enqueueBatchItem(t, b, i, false)
+		outputs[idx] = out
+	}
+	require.Equal(t, n, b.NumItems())
+	require.Equal(t, 4*n, b.GetNumberOfEnqueuedSignatures())
+
+	failed, err := b.Verify()
+	require.NoError(t, err)
+	require.Equal(t, make([]bool, n), failed)
+	for idx, out := range outputs {
+		require.Equal(t, out, b.VrfOutput(idx))
+	}
+}
+
+func TestBatchVerificationFailures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, invalid := range [][]int{{0}, {19}, {7, 8}, {0, 5, 6, 13, 19}, {1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}} {
+		n := 20
+		b := MakeBatchVerification(n)
+		expected := make([]bool, n)
+		for _, i := range invalid {
+			expected[i] = true
+		}
+		outputs := make(map[int]VrfOutput)
+		for i := 0; i < n; i++ {
+			idx, out := enqueueBatchItem(t, b, i, expected[i])
+			if !expected[i] {
+				outputs[idx] = out
+			}
+		}
+
+		failed, err := b.Verify()
+		require.ErrorIs(t, err, ErrBatchHasFailedSigs)
+		require.Equal(t, expected, failed, "invalid items %v", invalid)
+		for idx, out := range outputs {
+			require.Equal(t, out, b.VrfOutput(idx))
+		}
+	}
+}
+
+func TestBatchVerificationEmptyItems(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	b := MakeBatchVerification(0)
+	b.StartItem()
+	enqueueBatchItem(t, b, 2, true)
+	b.StartItem()
+	vrfPK, vrfSK := VrfKeygen()
+	msg := randString()
+	proof, ok := vrfSK.Prove(msg)
+	require.True(t, ok)
+	b.StartItem()
+	b.EnqueueVrfProof(vrfPK, proof, msg)
+
+	failed, err := b.Verify()
+	require.ErrorIs(t, err, ErrBatchHasFailedSigs)
+	require.Equal(t, []bool{false, true, false, false}, failed)
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// #cgo CFLAGS: -Wall -std=c99
+// #cgo darwin,amd64 CFLAGS: -I${SRCDIR}/libs/darwin/amd64/include
+// #cgo darwin,amd64 LDFLAGS: ${SRCDIR}/libs/darwin/amd64/lib/libsodium.a
+// #cgo darwin,arm64 CFLAGS: -I${SRCDIR}/libs/darwin/arm64/include
+// #cgo darwin,arm64 LDFLAGS: ${SRCDIR}/libs/darwin/arm64/lib/libsodium.a
+// #cgo linux,amd64 CFLAGS: -I${SRCDIR}/libs/linux/amd64/include
+// #cgo linux,amd64 LDFLAGS: ${SRCDIR}/libs/linux/amd64/lib/libsodium.a
+// #cgo linux,arm64 CFLAGS: -I${SRCDIR}/libs/linux/arm64/include
+// #cgo linux,arm64 LDFLAGS: ${SRCDIR}/libs/linux/arm64/lib/libsodium.a
+// #cgo linux,arm CFLAGS: -I${SRCDIR}/libs/linux/arm/include
+// #cgo linux,arm LDFLAGS: ${SRCDIR}/libs/linux/arm/lib/libsodium.a
+// #cgo windows,amd64 CFLAGS: -I${SRCDIR}/libs/windows/amd64/include
+// #cgo windows,amd64 LDFLAGS: ${SRCDIR}/libs/windows/amd64/lib/libsodium.a
+// #include <stdint.h>
+// #include "sodium.h"
+import "C"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+)
+
+// BIP32Hardened is the first index of the hardened children of a BIP32Ed25519Key.
+const BIP32Hardened = 0x80000000
+
+// bip32Ed25519TruncatedBits is the number of high bits of zL dropped when
+// deriving a child scalar. ARC-52 drops 9 bits rather than the 32 bits of the
+// original paper, so that about 2^20 derivation levels stay in range.
+const bip32Ed25519TruncatedBits = 9
+
+// ErrBIP32Ed25519Derivation is returned when a derived scalar falls out of the range of ed25519 scalars.
+var ErrBIP32Ed25519Derivation = errors.New("the derived BIP32-Ed25519 key is out of range")
+
+// An ExtendedSecretKey is the secret key of BIP32-Ed25519: the scalar of the
+// public key followed by the key from which signature nonces are derived.
+// Unlike PrivateKey, it does not hold a seed, since derived keys have none.
+type ExtendedSecretKey [64]byte
+
+// A BIP32Ed25519Key is an extended private key of BIP32-Ed25519, as specified
+// by ARC-52 and used by the Ledger and Pera wallets: a secret key and the chain
+// code needed to derive its children.
+type BIP32Ed25519Key struct {
+	SK        ExtendedSecretKey
+	ChainCode [32]byte
+}
+
+// BIP32Ed25519MasterKey derives the root key of a BIP-39 seed.
+func BIP32Ed25519MasterKey(seed []byte) BIP32Ed25519Key {
+	var k BIP32Ed25519Key
+	sum := sha512.Sum512(seed)
+	// The third highest bit of the scalar must be clear, so hash again until it is
+	for sum[31]&0x20 != 0 {
+		mac := hmac.New(sha512.New, sum[:32])
+		mac.Write(sum[32:])
+		copy(sum[:], mac.Sum(nil))
+	}
+	sum[0] &= 0xf8
+	sum[31] &= 0x7f
+	sum[31] |= 0x40
+	copy(k.SK[:], sum[:])
+
+	k.ChainCode = sha256.Sum256(append([]byte{0x01}, seed...))
+	return k
+}
+
+// Child derives the child of k at index. Indexes of BIP32Hardened and above
+// derive hardened children, whose public keys cannot be derived from the
+// public key of k.
+func (k BIP32Ed25519Key) Child(index uint32) (BIP32Ed25519Key, error) {
+	var data []byte
+	var zPrefix, ccPrefix byte
+	if index >= BIP32Hardened {
+		data = append(data, k.SK[:]...)
+		zPrefix, ccPrefix = 0x00, 0x01
+	} else {
+		pk, err := k.SK.PublicKey()
+		if err != nil {
+			return BIP32Ed25519Key{}, err
+		}
+		data = append(data, pk[:]...)
+		zPrefix, ccPrefix = 0x02, 0x03
+	}
+	data = binary.LittleEndian.AppendUint32(data, index)
+
+	z := bip32HMAC(k.ChainCode[:], zPrefix, data)
+	cc := bip32HMAC(k.ChainCode[:], ccPrefix, data)
+
+	var child BIP32Ed25519Key
+	copy(child.ChainCode[:], cc[32:])
+
+	// kL' = kL + 8 * trunc(zL), which must stay below 2^255 for the scalar
+	// to be used as is by libsodium
+	var zl [32]byte
+	copy(zl[:], z[:32])
+	for bits, i := bip32Ed25519TruncatedBits, 31; bits > 0; i-- {
+		if bits >= 8 {
+			zl[i] = 0
+		} else {
+			zl[i] &= 0xff >> bits
+		}
+		bits -= 8
+	}
+	var carry uint16
+	for i := 0; i < 32; i++ {
+		shifted := uint16(zl[i]) << 3
+		if i > 0 {
+			shifted |= uint16(zl[i-1]) >> 5
+		}
+		carry += uint16(k.SK[i]) + shifted&0xff
+		child.SK[i] = byte(carry)
+		carry >>= 8
+	}
+	if carry != 0 || child.SK[31]&0x80 != 0 {
+		return BIP32Ed25519Key{}, ErrBIP32Ed25519Derivation
+	}
+
+	// kR' = kR + zR mod 2^256
+	carry = 0
+	for i := 32; i < 64; i++ {
+		carry += uint16(k.SK[i]) + uint16(z[i])
+		child.SK[i] = byte(carry)
+		carry >>= 8
+	}
+	return child, nil
+}
+
+// DerivePath derives the descendant of k along path, one child index per level.
+func (k BIP32Ed25519Key) DerivePath(path []uint32) (BIP32Ed25519Key, error) {
+	var err error
+	for _, index := range path {
+		k, err = k.Child(index)
+		if err != nil {
+			return BIP32Ed25519Key{}, err
+		}
+	}
+	return k, nil
+}
+
+func bip32HMAC(key []byte, prefix byte, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write([]byte{prefix})
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// PublicKey returns the public key of sk.
+func (sk ExtendedSecretKey) PublicKey() (PublicKey, error) {
+	var pk PublicKey
+	result := C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&pk[0]), (*C.uchar)(&sk[0]))
+	if result != 0 {
+		return pk, errors.New("failed to compute the public key of an extended secret key")
+	}
+	return pk, nil
+}
+
+// ExtendedSignatureSecrets are the counterpart of SignatureSecrets for
+// ExtendedSecretKeys. Their signatures are plain ed25519 signatures, verified
+// by SignatureVerifier.
+type ExtendedSignatureSecrets struct {
+	SignatureVerifier
+	SK ExtendedSecretKey
+}
+
+// SignatureSecrets returns the signature secrets of sk.
+func (sk ExtendedSecretKey) SignatureSecrets() (*ExtendedSignatureSecrets, error) {
+	pk, err := sk.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &ExtendedSignatureSecrets{SignatureVerifier: pk, SK: sk}, nil
+}
+
+// Sign produces a cryptographic Signature of a Hashable message.
+func (s *ExtendedSignatureSecrets) Sign(message Hashable) Signature {
+	return s.SignBytes(HashRep(message))
+}
+
+// SignBytes signs a message directly, without first hashing.
+// Caller is responsible for domain separation.
+func (s *ExtendedSignatureSecrets) SignBytes(message []byte) (sig Signature) {
+	// &message[0] will make Go panic if msg is zero length
+	d := (*C.uchar)(C.NULL)
+	if len(message) != 0 {
+		d = (*C.uchar)(&message[0])
+	}
+	C.crypto_sign_ed25519_extended_detached((*C.uchar)(&sig[0]), (*C.ulonglong)(C.NULL), d, C.ulonglong(len(message)), (*C.uchar)(&s.SK[0]), (*C.uchar)(&s.SignatureVerifier[0]))
+	return
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBIP32Ed25519Vectors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Test vectors from the reference implementation of ARC-52
+	mnemonic := "salon zoo engage submit smile frost later decide wing sight chaos renew lizard rely canal coral scene hobby scare step bus leaf tobacco slice"
+	seed, err := passphrase.BIP39MnemonicToSeed(mnemonic, "")
+	require.NoError(t, err)
+
+	const h = BIP32Hardened
+	vectors := []struct {
+		path []uint32
+		pk   string
+	}{
+		{[]uint32{44 + h, 283 + h, 0 + h, 0, 0}, "7bda7ac12627b2c259f1df6875d30c10b35f55b33ad2cc8ea2736eaa3ebcfab9"},
+		{[]uint32{44 + h, 283 + h, 0 + h, 0, 1}, "5bae8828f111064637ac5061bd63bc4fcfe4a833252305f25eeab9c64ecdf519"},
+		{[]uint32{44 + h, 283 + h, 0 + h, 0, 2}, "00a72635e97cba966529e9bfb4baf4a32d7b8cd2fcd8e2476ce5be1177848cb3"},
+		{[]uint32{44 + h, 283 + h, 1 + h, 0, 0}, "358d8c4382992849a764438e02b1c45c2ca4e86bbcfe10fd5b963f3610012bc9"},
+		{[]uint32{44 + h, 283 + h, 1 + h, 0, 1}, "d5635a7b2b12c3044ee60af567da2e37add807bb793c71c7a5bec9826feccefb"},
+		{[]uint32{44 + h, 283 + h, 2 + h, 0, 1}, "1f0f75fbbca12b22523973191061b2f96522740e139a3420c730717ac5b0dfc0"},
+		{[]uint32{44 + h, 283 + h, 3 + h, 0, 0}, "f035316f915b342ea5fe78dccb59d907b93805732219d436a1bd8488ff4e5b1b"},
+		{[]uint32{44 + h, 0 + h, 0 + h, 0, 0}, "ff8b1863ef5e40d0a48c245f26a6dbdf5da94dc75a1851f51d8a04e547bd5f5a"},
+	}
+	for _, v := range vectors {
+		key, err := BIP32Ed25519MasterKey(seed).DerivePath(v.path)
+		require.NoError(t, err)
+		pk, err := key.SK.PublicKey()
+		require.NoError(t, err)
+		require.Equal(t, v.pk, hex.EncodeToString(pk[:]))
+	}
+}
+
+func TestBIP32Ed25519SignVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	seed := make([]byte, 64)
+	RandBytes(seed)
+	key, err := BIP32Ed25519MasterKey(seed).DerivePath([]uint32{44 + BIP32Hardened, 283 + BIP32Hardened, BIP32Hardened, 0, 7})
+	require.NoError(t, err)
+	secrets, err := key.SK.SignatureSecrets()
+	require.NoError(t, err)
+
+	// Signatures verify as plain ed25519 signatures
+	msg := TestingHashable{[]byte("hello")}
+	sig := secrets.Sign(msg)
+	require.True(t, secrets.SignatureVerifier.Verify(msg, sig))
+	require.False(t, secrets.SignatureVerifier.Verify(TestingHashable{[]byte("world")}, sig))
+
+	sig = secrets.SignBytes(nil)
+	require.True(t, secrets.SignatureVerifier.VerifyBytes(nil, sig))
+
+	// Another key of the wallet does not verify the signature
+	other, err := BIP32Ed25519MasterKey(seed).DerivePath([]uint32{44 + BIP32Hardened, 283 + BIP32Hardened, BIP32Hardened, 0, 8})
+	require.NoError(t, err)
+	otherPK, err := other.SK.PublicKey()
+	require.NoError(t, err)
+	require.NotEqual(t, secrets.SignatureVerifier, otherPK)
+	require.False(t, otherPK.VerifyBytes(nil, sig))
+}
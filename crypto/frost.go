@@ -0,0 +1,566 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// #cgo CFLAGS: -Wall -std=c99
+// #cgo darwin,amd64 CFLAGS: -I${SRCDIR}/libs/darwin/amd64/include
+// #cgo darwin,amd64 LDFLAGS: ${SRCDIR}/libs/darwin/amd64/lib/libsodium.a
+// #cgo darwin,arm64 CFLAGS: -I${SRCDIR}/libs/darwin/arm64/include
+// #cgo darwin,arm64 LDFLAGS: ${SRCDIR}/libs/darwin/arm64/lib/libsodium.a
+// #cgo linux,amd64 CFLAGS: -I${SRCDIR}/libs/linux/amd64/include
+// #cgo linux,amd64 LDFLAGS: ${SRCDIR}/libs/linux/amd64/lib/libsodium.a
+// #cgo linux,arm64 CFLAGS: -I${SRCDIR}/libs/linux/arm64/include
+// #cgo linux,arm64 LDFLAGS: ${SRCDIR}/libs/linux/arm64/lib/libsodium.a
+// #cgo linux,arm CFLAGS: -I${SRCDIR}/libs/linux/arm/include
+// #cgo linux,arm LDFLAGS: ${SRCDIR}/libs/linux/arm/lib/libsodium.a
+// #cgo windows,amd64 CFLAGS: -I${SRCDIR}/libs/windows/amd64/include
+// #cgo windows,amd64 LDFLAGS: ${SRCDIR}/libs/windows/amd64/lib/libsodium.a
+// #include <stdint.h>
+// #include "sodium.h"
+import "C"
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+// This file implements the FROST(Ed25519, SHA-512) threshold signature scheme
+// of RFC 9591. A key is split between n participants so that any t of them can
+// sign together, in two rounds: each signer first publishes a commitment to
+// fresh nonces, then computes its signature share once it knows the
+// commitments of all the signers. The shares aggregate into a plain ed25519
+// signature, verified by SignatureVerifier like any other.
+
+// frostContextString is the context string of the FROST(Ed25519, SHA-512) ciphersuite
+const frostContextString = "FROST-ED25519-SHA512-v1"
+
+// Errors of the FROST threshold signature scheme
+var (
+	ErrFrostThreshold      = errors.New("FROST threshold must be at least 2 and at most the number of participants")
+	ErrFrostKeyShare       = errors.New("invalid FROST key share")
+	ErrFrostCommitments    = errors.New("FROST commitments must come from distinct participants, at least as many as the threshold, the signer included")
+	ErrFrostNonces         = errors.New("FROST nonces do not match the commitment of the signer")
+	ErrFrostElement        = errors.New("invalid ed25519 group element")
+	ErrFrostScalar         = errors.New("invalid ed25519 scalar")
+	ErrFrostSignatureShare = errors.New("FROST signature share does not verify under the verifying share of its signer")
+	ErrFrostSignature      = errors.New("aggregated FROST signature does not verify under the group key")
+)
+
+// FrostScalar is a scalar of the ed25519 group, in little-endian encoding.
+type FrostScalar [32]byte
+
+// FrostElement is an element of the ed25519 group, in compressed encoding.
+type FrostElement [32]byte
+
+// FrostKeyShare is the share of a FROST key held by one participant, along
+// with the public keys it signs with.
+//
+//msgp:ignore FrostKeyShare
+type FrostKeyShare struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Identifier is the index of the participant, from 1 to the number of participants.
+	Identifier uint16 `codec:"id"`
+
+	// Threshold is the number of participants needed to sign.
+	Threshold uint16 `codec:"thr"`
+
+	// SecretShare is the share of the secret key of the participant.
+	SecretShare FrostScalar `codec:"sk"`
+
+	// VerifyingShare is the public key of SecretShare, which verifies the
+	// signature shares of the participant.
+	VerifyingShare PublicKey `codec:"vk"`
+
+	// GroupKey is the ed25519 public key which verifies the aggregated signatures.
+	GroupKey PublicKey `codec:"gk"`
+}
+
+// FrostNonces are the secret nonces of a signer for one signature. They must
+// never be used for more than one signature share.
+//
+//msgp:ignore FrostNonces
+type FrostNonces struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Hiding  FrostScalar `codec:"h"`
+	Binding FrostScalar `codec:"b"`
+}
+
+// FrostCommitment is the commitment of a signer to its nonces, published to
+// the other signers in the first round.
+//
+//msgp:ignore FrostCommitment
+type FrostCommitment struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Identifier uint16       `codec:"id"`
+	Hiding     FrostElement `codec:"h"`
+	Binding    FrostElement `codec:"b"`
+}
+
+// FrostSignatureShare is the share of a signer of a signature, computed in
+// the second round.
+//
+//msgp:ignore FrostSignatureShare
+type FrostSignatureShare struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Identifier uint16      `codec:"id"`
+	Share      FrostScalar `codec:"z"`
+}
+
+// FrostTrustedDealerKeygen generates a random key and splits it between the
+// given number of participants, threshold of which are needed to sign.
+func FrostTrustedDealerKeygen(threshold, participants uint16) ([]FrostKeyShare, error) {
+	secret, err := frostRandomScalar()
+	if err != nil {
+		return nil, err
+	}
+	return frostSplit(secret, threshold, participants)
+}
+
+// FrostSplitSecretKey splits an ed25519 secret key between the given number
+// of participants, threshold of which are needed to sign. The account of the
+// key can then be controlled by the participants without rekeying it; the
+// secret key itself should be destroyed once the shares are distributed.
+func FrostSplitSecretKey(sk PrivateKey, threshold, participants uint16) ([]FrostKeyShare, error) {
+	seed, err := SecretKeyToSeed(sk)
+	if err != nil {
+		return nil, err
+	}
+
+	// ed25519 signs with the clamped first half of the hash of the seed
+	h := sha512.Sum512(seed[:])
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+	var wide [64]byte
+	copy(wide[:], h[:32])
+	return frostSplit(frostReduce(wide), threshold, participants)
+}
+
+// frostSplit shares secret with Shamir's secret sharing: participant i gets
+// f(i) for a random polynomial f of degree threshold-1 with f(0) = secret.
+func frostSplit(secret FrostScalar, threshold, participants uint16) ([]FrostKeyShare, error) {
+	if threshold < 2 || threshold > participants {
+		return nil, ErrFrostThreshold
+	}
+
+	groupKey, err := frostScalarBaseMult(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	coefficients := make([]FrostScalar, threshold)
+	coefficients[0] = secret
+	for i := 1; i < len(coefficients); i++ {
+		coefficients[i], err = frostRandomScalar()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	shares := make([]FrostKeyShare, participants)
+	for i := range shares {
+		id := uint16(i + 1)
+		x := frostIdentifierScalar(id)
+
+		// evaluate the polynomial at x with Horner's method
+		y := coefficients[threshold-1]
+		for j := int(threshold) - 2; j >= 0; j-- {
+			y = y.mul(x).add(coefficients[j])
+		}
+
+		verifyingShare, err := frostScalarBaseMult(y)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = FrostKeyShare{
+			Identifier:     id,
+			Threshold:      threshold,
+			SecretShare:    y,
+			VerifyingShare: PublicKey(verifyingShare),
+			GroupKey:       PublicKey(groupKey),
+		}
+	}
+	return shares, nil
+}
+
+// Validate checks that the key share is consistent, in particular that its
+// verifying share is the public key of its secret share.
+func (k *FrostKeyShare) Validate() error {
+	if k.Threshold < 2 {
+		return ErrFrostThreshold
+	}
+	if k.Identifier == 0 {
+		return ErrFrostKeyShare
+	}
+	if !frostIsCanonical(k.SecretShare) {
+		return ErrFrostScalar
+	}
+	verifyingShare, err := frostScalarBaseMult(k.SecretShare)
+	if err != nil {
+		return err
+	}
+	if PublicKey(verifyingShare) != k.VerifyingShare {
+		return ErrFrostKeyShare
+	}
+	return nil
+}
+
+// Commit generates the nonces of the participant for a new signature, and the
+// commitment to them to publish to the other signers.
+func (k *FrostKeyShare) Commit() (FrostNonces, FrostCommitment, error) {
+	nonces := FrostNonces{
+		Hiding:  k.nonce(),
+		Binding: k.nonce(),
+	}
+	commitment, err := nonces.commitment(k.Identifier)
+	if err != nil {
+		return FrostNonces{}, FrostCommitment{}, err
+	}
+	return nonces, commitment, nil
+}
+
+// nonce mixes fresh randomness with the secret share, so that a weak random
+// number generator does not leak the secret share through the nonces
+func (k *FrostKeyShare) nonce() FrostScalar {
+	var random [32]byte
+	RandBytes(random[:])
+	return frostHashToScalar([]byte(frostContextString), []byte("nonce"), random[:], k.SecretShare[:])
+}
+
+func (n *FrostNonces) commitment(id uint16) (FrostCommitment, error) {
+	hiding, err := frostScalarBaseMult(n.Hiding)
+	if err != nil {
+		return FrostCommitment{}, err
+	}
+	binding, err := frostScalarBaseMult(n.Binding)
+	if err != nil {
+		return FrostCommitment{}, err
+	}
+	return FrostCommitment{Identifier: id, Hiding: hiding, Binding: binding}, nil
+}
+
+// Sign computes the signature share of the participant over msg, with the
+// nonces it generated with Commit and the commitments of all the signers,
+// its own included. The nonces must not be used again afterwards.
+func (k *FrostKeyShare) Sign(nonces FrostNonces, commitments []FrostCommitment, msg []byte) (FrostSignatureShare, error) {
+	commitments, err := frostSortCommitments(commitments)
+	if err != nil {
+		return FrostSignatureShare{}, err
+	}
+	if len(commitments) < int(k.Threshold) {
+		return FrostSignatureShare{}, ErrFrostCommitments
+	}
+
+	idx := sort.Search(len(commitments), func(i int) bool { return commitments[i].Identifier >= k.Identifier })
+	if idx == len(commitments) || commitments[idx].Identifier != k.Identifier {
+		return FrostSignatureShare{}, ErrFrostCommitments
+	}
+	own, err := nonces.commitment(k.Identifier)
+	if err != nil {
+		return FrostSignatureShare{}, err
+	}
+	if own != commitments[idx] {
+		return FrostSignatureShare{}, ErrFrostNonces
+	}
+
+	bindingFactors := frostBindingFactors(k.GroupKey, commitments, msg)
+	groupCommitment, err := frostGroupCommitment(commitments, bindingFactors)
+	if err != nil {
+		return FrostSignatureShare{}, err
+	}
+	lambda, err := frostLagrangeCoefficient(k.Identifier, commitments)
+	if err != nil {
+		return FrostSignatureShare{}, err
+	}
+	challenge := frostChallenge(groupCommitment, k.GroupKey, msg)
+
+	// z = hiding + binding * rho + lambda * secret * c
+	z := nonces.Hiding.add(nonces.Binding.mul(bindingFactors[idx])).add(lambda.mul(k.SecretShare).mul(challenge))
+	return FrostSignatureShare{Identifier: k.Identifier, Share: z}, nil
+}
+
+// FrostVerifySignatureShare checks the signature share of a signer against
+// its verifying share, to find out which signer is at fault when the
+// aggregated signature does not verify.
+func FrostVerifySignatureShare(groupKey, verifyingShare PublicKey, commitments []FrostCommitment, share FrostSignatureShare, msg []byte) error {
+	commitments, err := frostSortCommitments(commitments)
+	if err != nil {
+		return err
+	}
+	idx := sort.Search(len(commitments), func(i int) bool { return commitments[i].Identifier >= share.Identifier })
+	if idx == len(commitments) || commitments[idx].Identifier != share.Identifier {
+		return ErrFrostCommitments
+	}
+	if !frostIsCanonical(share.Share) {
+		return ErrFrostScalar
+	}
+
+	bindingFactors := frostBindingFactors(groupKey, commitments, msg)
+	groupCommitment, err := frostGroupCommitment(commitments, bindingFactors)
+	if err != nil {
+		return err
+	}
+	lambda, err := frostLagrangeCoefficient(share.Identifier, commitments)
+	if err != nil {
+		return err
+	}
+	challenge := frostChallenge(groupCommitment, groupKey, msg)
+
+	// z * B == hiding + binding * rho + verifying share * (c * lambda)
+	l, err := frostScalarBaseMult(share.Share)
+	if err != nil {
+		return ErrFrostSignatureShare
+	}
+	r, err := frostCommitmentShare(commitments[idx], bindingFactors[idx])
+	if err != nil {
+		return err
+	}
+	pkTerm, err := frostScalarMult(challenge.mul(lambda), FrostElement(verifyingShare))
+	if err != nil {
+		return err
+	}
+	r, err = frostAdd(r, pkTerm)
+	if err != nil {
+		return err
+	}
+	if l != r {
+		return ErrFrostSignatureShare
+	}
+	return nil
+}
+
+// FrostAggregate combines the signature shares of the signers into an
+// ed25519 signature of msg, and checks it under the group key.
+func FrostAggregate(groupKey PublicKey, commitments []FrostCommitment, shares []FrostSignatureShare, msg []byte) (Signature, error) {
+	commitments, err := frostSortCommitments(commitments)
+	if err != nil {
+		return Signature{}, err
+	}
+	if len(shares) != len(commitments) {
+		return Signature{}, ErrFrostCommitments
+	}
+
+	shares = append([]FrostSignatureShare(nil), shares...)
+	sort.Slice(shares, func(i, j int) bool { return shares[i].Identifier < shares[j].Identifier })
+	var z FrostScalar
+	for i, share := range shares {
+		if share.Identifier != commitments[i].Identifier {
+			return Signature{}, ErrFrostCommitments
+		}
+		if !frostIsCanonical(share.Share) {
+			return Signature{}, ErrFrostScalar
+		}
+		z = z.add(share.Share)
+	}
+
+	bindingFactors := frostBindingFactors(groupKey, commitments, msg)
+	groupCommitment, err := frostGroupCommitment(commitments, bindingFactors)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	var sig Signature
+	copy(sig[:32], groupCommitment[:])
+	copy(sig[32:], z[:])
+	if !SignatureVerifier(groupKey).VerifyBytes(msg, sig) {
+		return Signature{}, ErrFrostSignature
+	}
+	return sig, nil
+}
+
+// frostSortCommitments returns a copy of commitments sorted by identifier,
+// after checking that the identifiers are valid and distinct
+func frostSortCommitments(commitments []FrostCommitment) ([]FrostCommitment, error) {
+	sorted := append([]FrostCommitment(nil), commitments...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Identifier < sorted[j].Identifier })
+	for i, c := range sorted {
+		if c.Identifier == 0 || (i > 0 && c.Identifier == sorted[i-1].Identifier) {
+			return nil, ErrFrostCommitments
+		}
+	}
+	return sorted, nil
+}
+
+// frostBindingFactors computes the binding factor of each signer, which binds
+// its binding nonce to the message and to the commitments of all the signers
+func frostBindingFactors(groupKey PublicKey, commitments []FrostCommitment, msg []byte) []FrostScalar {
+	encoded := make([]byte, 0, len(commitments)*(32+2*32))
+	for _, c := range commitments {
+		id := frostIdentifierScalar(c.Identifier)
+		encoded = append(encoded, id[:]...)
+		encoded = append(encoded, c.Hiding[:]...)
+		encoded = append(encoded, c.Binding[:]...)
+	}
+	msgHash := frostHash([]byte(frostContextString), []byte("msg"), msg)
+	commitmentsHash := frostHash([]byte(frostContextString), []byte("com"), encoded)
+
+	factors := make([]FrostScalar, len(commitments))
+	for i, c := range commitments {
+		id := frostIdentifierScalar(c.Identifier)
+		factors[i] = frostHashToScalar([]byte(frostContextString), []byte("rho"), groupKey[:], msgHash[:], commitmentsHash[:], id[:])
+	}
+	return factors
+}
+
+// frostCommitmentShare is the contribution of a signer to the group commitment
+func frostCommitmentShare(c FrostCommitment, bindingFactor FrostScalar) (FrostElement, error) {
+	if C.crypto_core_ed25519_is_valid_point((*C.uchar)(&c.Hiding[0])) != 1 {
+		return FrostElement{}, ErrFrostElement
+	}
+	binding, err := frostScalarMult(bindingFactor, c.Binding)
+	if err != nil {
+		return FrostElement{}, err
+	}
+	return frostAdd(c.Hiding, binding)
+}
+
+// frostGroupCommitment computes the commitment R of the aggregated signature
+func frostGroupCommitment(commitments []FrostCommitment, bindingFactors []FrostScalar) (FrostElement, error) {
+	var r FrostElement
+	for i, c := range commitments {
+		share, err := frostCommitmentShare(c, bindingFactors[i])
+		if err != nil {
+			return FrostElement{}, err
+		}
+		if i == 0 {
+			r = share
+			continue
+		}
+		r, err = frostAdd(r, share)
+		if err != nil {
+			return FrostElement{}, err
+		}
+	}
+	return r, nil
+}
+
+// frostLagrangeCoefficient computes the coefficient of the share of signer id
+// in the interpolation of the secret from the shares of all the signers
+func frostLagrangeCoefficient(id uint16, commitments []FrostCommitment) (FrostScalar, error) {
+	x := frostIdentifierScalar(id)
+	numerator := frostIdentifierScalar(1)
+	denominator := frostIdentifierScalar(1)
+	for _, c := range commitments {
+		if c.Identifier == id {
+			continue
+		}
+		xj := frostIdentifierScalar(c.Identifier)
+		numerator = numerator.mul(xj)
+		denominator = denominator.mul(xj.sub(x))
+	}
+	inverse, err := denominator.invert()
+	if err != nil {
+		return FrostScalar{}, err
+	}
+	return numerator.mul(inverse), nil
+}
+
+// frostChallenge is the ed25519 challenge H(R || A || M), so that the
+// aggregated signature verifies as an ed25519 signature
+func frostChallenge(groupCommitment FrostElement, groupKey PublicKey, msg []byte) FrostScalar {
+	return frostHashToScalar(groupCommitment[:], groupKey[:], msg)
+}
+
+func frostHash(parts ...[]byte) [64]byte {
+	h := sha512.New()
+	for _, part := range parts {
+		h.Write(part)
+	}
+	var sum [64]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+func frostHashToScalar(parts ...[]byte) FrostScalar {
+	return frostReduce(frostHash(parts...))
+}
+
+func frostRandomScalar() (FrostScalar, error) {
+	var wide [64]byte
+	RandBytes(wide[:])
+	s := frostReduce(wide)
+	if s == (FrostScalar{}) {
+		return FrostScalar{}, ErrFrostScalar
+	}
+	return s, nil
+}
+
+func frostIdentifierScalar(id uint16) (s FrostScalar) {
+	binary.LittleEndian.PutUint16(s[:], id)
+	return
+}
+
+func frostReduce(wide [64]byte) (s FrostScalar) {
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&s[0]), (*C.uchar)(&wide[0]))
+	return
+}
+
+// frostIsCanonical reports whether s is reduced modulo the group order
+func frostIsCanonical(s FrostScalar) bool {
+	var wide [64]byte
+	copy(wide[:], s[:])
+	return frostReduce(wide) == s
+}
+
+func (s FrostScalar) add(t FrostScalar) (r FrostScalar) {
+	C.crypto_core_ed25519_scalar_add((*C.uchar)(&r[0]), (*C.uchar)(&s[0]), (*C.uchar)(&t[0]))
+	return
+}
+
+func (s FrostScalar) sub(t FrostScalar) (r FrostScalar) {
+	C.crypto_core_ed25519_scalar_sub((*C.uchar)(&r[0]), (*C.uchar)(&s[0]), (*C.uchar)(&t[0]))
+	return
+}
+
+func (s FrostScalar) mul(t FrostScalar) (r FrostScalar) {
+	C.crypto_core_ed25519_scalar_mul((*C.uchar)(&r[0]), (*C.uchar)(&s[0]), (*C.uchar)(&t[0]))
+	return
+}
+
+func (s FrostScalar) invert() (r FrostScalar, err error) {
+	if C.crypto_core_ed25519_scalar_invert((*C.uchar)(&r[0]), (*C.uchar)(&s[0])) != 0 {
+		err = ErrFrostScalar
+	}
+	return
+}
+
+func frostScalarBaseMult(s FrostScalar) (p FrostElement, err error) {
+	if C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&p[0]), (*C.uchar)(&s[0])) != 0 {
+		err = ErrFrostScalar
+	}
+	return
+}
+
+func frostScalarMult(s FrostScalar, q FrostElement) (p FrostElement, err error) {
+	if C.crypto_scalarmult_ed25519_noclamp((*C.uchar)(&p[0]), (*C.uchar)(&s[0]), (*C.uchar)(&q[0])) != 0 {
+		err = ErrFrostElement
+	}
+	return
+}
+
+func frostAdd(p, q FrostElement) (r FrostElement, err error) {
+	if C.crypto_core_ed25519_add((*C.uchar)(&r[0]), (*C.uchar)(&p[0]), (*C.uchar)(&q[0])) != 0 {
+		err = ErrFrostElement
+	}
+	return
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func frostSignWith(t *testing.T, shares []FrostKeyShare, msg []byte) ([]FrostCommitment, []FrostSignatureShare) {
+	nonces := make([]FrostNonces, len(shares))
+	commitments := make([]FrostCommitment, len(shares))
+	for i := range shares {
+		var err error
+		nonces[i], commitments[i], err = shares[i].Commit()
+		require.NoError(t, err)
+	}
+
+	sigShares := make([]FrostSignatureShare, len(shares))
+	for i := range shares {
+		var err error
+		sigShares[i], err = shares[i].Sign(nonces[i], commitments, msg)
+		require.NoError(t, err)
+	}
+	return commitments, sigShares
+}
+
+func TestFrostSign(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	shares, err := FrostTrustedDealerKeygen(3, 5)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+	for _, share := range shares {
+		require.NoError(t, share.Validate())
+	}
+
+	msg := []byte("threshold signed message")
+	for _, signers := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 2, 3, 4}, {0, 1, 2, 3, 4}} {
+		var subset []FrostKeyShare
+		for _, i := range signers {
+			subset = append(subset, shares[i])
+		}
+		commitments, sigShares := frostSignWith(t, subset, msg)
+		for i := range subset {
+			require.NoError(t, FrostVerifySignatureShare(subset[i].GroupKey, subset[i].VerifyingShare, commitments, sigShares[i], msg))
+		}
+
+		sig, err := FrostAggregate(shares[0].GroupKey, commitments, sigShares, msg)
+		require.NoError(t, err)
+		require.True(t, SignatureVerifier(shares[0].GroupKey).VerifyBytes(msg, sig))
+		require.False(t, SignatureVerifier(shares[0].GroupKey).VerifyBytes([]byte("other message"), sig))
+	}
+
+	// the signers must be at least as many as the threshold
+	nonces, c0, err := shares[0].Commit()
+	require.NoError(t, err)
+	_, c1, err := shares[1].Commit()
+	require.NoError(t, err)
+	_, err = shares[0].Sign(nonces, []FrostCommitment{c0, c1}, msg)
+	require.ErrorIs(t, err, ErrFrostCommitments)
+}
+
+func TestFrostSplitSecretKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var seed Seed
+	RandBytes(seed[:])
+	secrets := GenerateSignatureSecrets(seed)
+
+	shares, err := FrostSplitSecretKey(PrivateKey(secrets.SK), 2, 3)
+	require.NoError(t, err)
+	for _, share := range shares {
+		require.Equal(t, secrets.SignatureVerifier, share.GroupKey)
+	}
+
+	msg := []byte("signed for an existing account")
+	commitments, sigShares := frostSignWith(t, []FrostKeyShare{shares[2], shares[0]}, msg)
+	sig, err := FrostAggregate(secrets.SignatureVerifier, commitments, sigShares, msg)
+	require.NoError(t, err)
+	require.True(t, secrets.SignatureVerifier.VerifyBytes(msg, sig))
+}
+
+func TestFrostBadShare(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	shares, err := FrostTrustedDealerKeygen(2, 3)
+	require.NoError(t, err)
+
+	msg := []byte("message")
+	commitments, sigShares := frostSignWith(t, shares[:2], msg)
+	sigShares[1].Share = sigShares[1].Share.add(frostIdentifierScalar(1))
+
+	_, err = FrostAggregate(shares[0].GroupKey, commitments, sigShares, msg)
+	require.ErrorIs(t, err, ErrFrostSignature)
+	require.NoError(t, FrostVerifySignatureShare(shares[0].GroupKey, shares[0].VerifyingShare, commitments, sigShares[0], msg))
+	require.ErrorIs(t, FrostVerifySignatureShare(shares[1].GroupKey, shares[1].VerifyingShare, commitments, sigShares[1], msg), ErrFrostSignatureShare)
+
+	// nonces only sign for the commitment they were generated with
+	nonces, commitment, err := shares[0].Commit()
+	require.NoError(t, err)
+	_, other, err := shares[1].Commit()
+	require.NoError(t, err)
+	commitment.Binding = other.Binding
+	_, err = shares[0].Sign(nonces, []FrostCommitment{commitment, other}, msg)
+	require.ErrorIs(t, err, ErrFrostNonces)
+
+	share := shares[0]
+	share.SecretShare = share.SecretShare.add(frostIdentifierScalar(1))
+	require.ErrorIs(t, share.Validate(), ErrFrostKeyShare)
+
+	_, err = FrostTrustedDealerKeygen(4, 3)
+	require.ErrorIs(t, err, ErrFrostThreshold)
+}
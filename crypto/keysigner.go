@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/algorand/go-algorand/logging"
+)
+
+// SignatureAlgorithm identifies the signature algorithm of the keys held by a KeySigner.
+type SignatureAlgorithm int
+
+const (
+	// Ed25519Algorithm is the algorithm of the one-time signature subkeys used for voting
+	Ed25519Algorithm SignatureAlgorithm = iota
+	// FalconAlgorithm is the algorithm of the state proof keys
+	FalconAlgorithm
+)
+
+// String returns the name of the algorithm.
+func (alg SignatureAlgorithm) String() string {
+	switch alg {
+	case Ed25519Algorithm:
+		return "ed25519"
+	case FalconAlgorithm:
+		return "falcon"
+	default:
+		return fmt.Sprintf("SignatureAlgorithm(%d)", int(alg))
+	}
+}
+
+// ErrKeySignerUnsupported is returned by a KeySigner asked to use an algorithm it does not support.
+var ErrKeySignerUnsupported = errors.New("the key signer does not support this algorithm")
+
+// A KeySigner keeps private keys out of the process memory, e.g. in an HSM or a cloud KMS, and signs with them.
+// Its keys are generated inside of it and identified by their public key.
+type KeySigner interface {
+	// Supports tells whether the signer can generate keys of alg and sign with them.
+	Supports(alg SignatureAlgorithm) bool
+
+	// GenerateKey generates a key of alg, and returns its public key.
+	GenerateKey(alg SignatureAlgorithm) (publicKey []byte, err error)
+
+	// Sign signs data with the key of alg identified by publicKey.
+	Sign(alg SignatureAlgorithm, publicKey []byte, data []byte) (sig []byte, err error)
+
+	// DestroyKey destroys the key of alg identified by publicKey, so that it can no longer sign.
+	DestroyKey(alg SignatureAlgorithm, publicKey []byte) error
+}
+
+var keySigner struct {
+	mu     sync.RWMutex
+	signer KeySigner
+}
+
+// SetKeySigner sets the KeySigner holding the keys that are not held in memory, or clears it when nil.
+// The one-time signature secrets sign with the KeySigner when their subkeys were generated in it.
+func SetKeySigner(signer KeySigner) {
+	keySigner.mu.Lock()
+	defer keySigner.mu.Unlock()
+	keySigner.signer = signer
+}
+
+// GetKeySigner returns the KeySigner set by SetKeySigner, or nil.
+func GetKeySigner() KeySigner {
+	keySigner.mu.RLock()
+	defer keySigner.mu.RUnlock()
+	return keySigner.signer
+}
+
+// errNoKeySigner is returned when signing with a key held by a KeySigner, while no KeySigner is set.
+var errNoKeySigner = errors.New("the key is held by a key signer, but no key signer is configured")
+
+// heldBySigner tells whether the secret key of the subkey is held by a KeySigner rather than in memory.
+func (k ephemeralSubkey) heldBySigner() bool {
+	return k.SK == ed25519PrivateKey{}
+}
+
+// sign signs data with the subkey, in memory or with the KeySigner holding it.
+func (k ephemeralSubkey) sign(data []byte) (ed25519Signature, error) {
+	if !k.heldBySigner() {
+		return ed25519Sign(k.SK, data), nil
+	}
+	signer := GetKeySigner()
+	if signer == nil {
+		return ed25519Signature{}, errNoKeySigner
+	}
+	raw, err := signer.Sign(Ed25519Algorithm, k.PK[:], data)
+	if err != nil {
+		return ed25519Signature{}, err
+	}
+	var sig ed25519Signature
+	if len(raw) != len(sig) {
+		return ed25519Signature{}, fmt.Errorf("the key signer returned a %d bytes ed25519 signature", len(raw))
+	}
+	copy(sig[:], raw)
+	return sig, nil
+}
+
+// generateSignerSubkey generates a subkey in signer, leaving its SK empty.
+func generateSignerSubkey(signer KeySigner) (ephemeralSubkey, error) {
+	raw, err := signer.GenerateKey(Ed25519Algorithm)
+	if err != nil {
+		return ephemeralSubkey{}, err
+	}
+	var k ephemeralSubkey
+	if len(raw) != len(k.PK) {
+		return ephemeralSubkey{}, fmt.Errorf("the key signer returned a %d bytes ed25519 public key", len(raw))
+	}
+	copy(k.PK[:], raw)
+	return k, nil
+}
+
+// destroySignerSubkeys destroys the subkeys held by the KeySigner. Failures are only logged,
+// since the subkeys are forgotten anyway.
+func destroySignerSubkeys(keys []ephemeralSubkey) {
+	var signer KeySigner
+	for _, k := range keys {
+		if !k.heldBySigner() {
+			continue
+		}
+		if signer == nil {
+			if signer = GetKeySigner(); signer == nil {
+				return
+			}
+		}
+		if err := signer.DestroyKey(Ed25519Algorithm, k.PK[:]); err != nil {
+			logging.Base().Warnf("could not destroy one-time signature subkey %x in the key signer: %v", k.PK, err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// memKeySigner is a KeySigner holding its ed25519 keys in a map, standing for an HSM.
+type memKeySigner struct {
+	mu      sync.Mutex
+	keys    map[ed25519PublicKey]ed25519PrivateKey
+	failGen bool
+}
+
+func makeMemKeySigner() *memKeySigner {
+	return &memKeySigner{keys: make(map[ed25519PublicKey]ed25519PrivateKey)}
+}
+
+func (m *memKeySigner) Supports(alg SignatureAlgorithm) bool {
+	return alg == Ed25519Algorithm
+}
+
+func (m *memKeySigner) GenerateKey(alg SignatureAlgorithm) ([]byte, error) {
+	if alg != Ed25519Algorithm {
+		return nil, ErrKeySignerUnsupported
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failGen && len(m.keys) == 3 {
+		return nil, errors.New("token full")
+	}
+	pk, sk := ed25519GenerateKeyRNG(SystemRNG)
+	m.keys[pk] = sk
+	return pk[:], nil
+}
+
+func (m *memKeySigner) Sign(alg SignatureAlgorithm, publicKey []byte, data []byte) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sk, ok := m.keys[ed25519PublicKey(publicKey)]
+	if !ok {
+		return nil, errors.New("no such key")
+	}
+	sig := ed25519Sign(sk, data)
+	return sig[:], nil
+}
+
+func (m *memKeySigner) DestroyKey(alg SignatureAlgorithm, publicKey []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.keys[ed25519PublicKey(publicKey)]; !ok {
+		return errors.New("no such key")
+	}
+	delete(m.keys, ed25519PublicKey(publicKey))
+	return nil
+}
+
+func (m *memKeySigner) numKeys() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.keys)
+}
+
+func TestOneTimeSignatureWithSigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	signer := makeMemKeySigner()
+	c, err := GenerateOneTimeSignatureSecretsWithSigner(0, 10, signer)
+	require.NoError(t, err)
+	require.True(t, c.HeldBySigner())
+	require.Equal(t, 10, signer.numKeys())
+	require.False(t, GenerateOneTimeSignatureSecrets(0, 10).HeldBySigner())
+
+	id := OneTimeSignatureIdentifier{Batch: 2, Offset: 5}
+	s := randString()
+
+	// no signer configured: signing fails instead of using an empty key
+	require.False(t, c.Verify(id, s, c.Sign(id, s)))
+
+	SetKeySigner(signer)
+	defer SetKeySigner(nil)
+	sig := c.Sign(id, s)
+	require.True(t, c.Verify(id, s, sig))
+	require.False(t, c.Verify(id, randString(), sig))
+
+	// the secrets round trip without the signer held keys
+	snapshot := c.Snapshot()
+	var c2 OneTimeSignatureSecrets
+	require.NoError(t, protocol.Decode(protocol.Encode(&snapshot), &c2))
+	require.True(t, c2.HeldBySigner())
+	require.True(t, c2.Verify(id, s, c2.Sign(id, s)))
+
+	// deleting up to the next batch destroys the keys of the batches before it, and
+	// the batch in progress keeps signing with its batch subkey
+	next := OneTimeSignatureIdentifier{Batch: 3, Offset: 7}
+	c.DeleteBeforeFineGrained(next, 256)
+	require.Equal(t, 7, signer.numKeys())
+	require.False(t, c.Verify(id, s, c.Sign(id, s)))
+	require.True(t, c.Verify(next, s, c.Sign(next, s)))
+	later := OneTimeSignatureIdentifier{Batch: 3, Offset: 200}
+	require.True(t, c.Verify(later, s, c.Sign(later, s)))
+
+	c.DestroySignerSubkeys()
+	require.Zero(t, signer.numKeys())
+	require.False(t, c.Verify(next, s, c.Sign(next, s)))
+}
+
+func TestOneTimeSignatureWithSignerErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, err := GenerateOneTimeSignatureSecretsWithSigner(0, 10, unsupportedKeySigner{})
+	require.ErrorIs(t, err, ErrKeySignerUnsupported)
+
+	// the keys generated before a failure are destroyed
+	signer := makeMemKeySigner()
+	signer.failGen = true
+	_, err = GenerateOneTimeSignatureSecretsWithSigner(0, 10, signer)
+	require.Error(t, err)
+	require.Zero(t, signer.numKeys())
+}
+
+type unsupportedKeySigner struct{}
+
+func (unsupportedKeySigner) Supports(alg SignatureAlgorithm) bool {
+	return false
+}
+
+func (unsupportedKeySigner) GenerateKey(alg SignatureAlgorithm) ([]byte, error) {
+	return nil, ErrKeySignerUnsupported
+}
+
+func (unsupportedKeySigner) Sign(alg SignatureAlgorithm, publicKey []byte, data []byte) ([]byte, error) {
+	return nil, ErrKeySignerUnsupported
+}
+
+func (unsupportedKeySigner) DestroyKey(alg SignatureAlgorithm, publicKey []byte) error {
+	return ErrKeySignerUnsupported
+}
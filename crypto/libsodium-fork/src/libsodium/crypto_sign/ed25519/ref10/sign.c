@@ -116,6 +116,49 @@ crypto_sign_ed25519_detached(unsigned char *sig, unsigned long long *siglen_p,
     return _crypto_sign_ed25519_detached(sig, siglen_p, m, mlen, sk, 0);
 }
 
+/* Signs with an extended secret key, the scalar followed by the nonce key, as
+ * derived by BIP32-Ed25519. Unlike the secret keys of crypto_sign_ed25519,
+ * the scalar is not the hash of a seed, and is used as is. */
+int
+crypto_sign_ed25519_extended_detached(unsigned char *sig,
+                                      unsigned long long *siglen_p,
+                                      const unsigned char *m,
+                                      unsigned long long mlen,
+                                      const unsigned char *esk,
+                                      const unsigned char *pk)
+{
+    crypto_hash_sha512_state hs;
+    unsigned char            nonce[64];
+    unsigned char            hram[64];
+    ge25519_p3               R;
+
+    crypto_hash_sha512_init(&hs);
+    crypto_hash_sha512_update(&hs, esk + 32, 32);
+    crypto_hash_sha512_update(&hs, m, mlen);
+    crypto_hash_sha512_final(&hs, nonce);
+
+    memmove(sig + 32, pk, 32);
+
+    sc25519_reduce(nonce);
+    ge25519_scalarmult_base(&R, nonce);
+    ge25519_p3_tobytes(sig, &R);
+
+    crypto_hash_sha512_init(&hs);
+    crypto_hash_sha512_update(&hs, sig, 64);
+    crypto_hash_sha512_update(&hs, m, mlen);
+    crypto_hash_sha512_final(&hs, hram);
+
+    sc25519_reduce(hram);
+    sc25519_muladd(sig + 32, hram, esk, nonce);
+
+    sodium_memzero(nonce, sizeof nonce);
+
+    if (siglen_p != NULL) {
+        *siglen_p = 64U;
+    }
+    return 0;
+}
+
 int
 crypto_sign_ed25519(unsigned char *sm, unsigned long long *smlen_p,
                     const unsigned char *m, unsigned long long mlen,
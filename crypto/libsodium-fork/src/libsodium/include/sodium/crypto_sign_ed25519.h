@@ -59,6 +59,15 @@ int crypto_sign_ed25519_detached(unsigned char *sig,
                                  const unsigned char *sk)
             __attribute__ ((nonnull(1, 3)));
 
+SODIUM_EXPORT
+int crypto_sign_ed25519_extended_detached(unsigned char *sig,
+                                          unsigned long long *siglen_p,
+                                          const unsigned char *m,
+                                          unsigned long long mlen,
+                                          const unsigned char *esk,
+                                          const unsigned char *pk)
+            __attribute__ ((nonnull(1, 5, 6)));
+
 SODIUM_EXPORT
 int crypto_sign_ed25519_verify_detached(const unsigned char *sig,
                                         const unsigned char *m,
@@ -135,6 +135,9 @@ void ge25519_from_uniform(unsigned char s[32], const unsigned char r[32]);
  where l = 2^252 + 27742317777372353535851937790883648493.
  */
 
+void sc25519_mul(unsigned char s[32], const unsigned char a[32],
+                 const unsigned char b[32]);
+
 void sc25519_invert(unsigned char recip[32], const unsigned char s[32]);
 
 void sc25519_reduce(unsigned char s[64]);
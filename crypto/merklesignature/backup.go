@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merklesignature
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// KeysBackupVersion is the version of the KeysBackup format written by this package.
+const KeysBackupVersion = 1
+
+// Errors for restoring secrets from a backup
+var (
+	ErrBackupVersionUnsupported = errors.New("unsupported state proof keys backup version")
+	ErrBackupCommitmentMismatch = errors.New("state proof keys regenerated from backup do not match the backed up commitment")
+)
+
+// KeysBackup is the backup format of secrets created by NewFromSeed.
+// Since every key is derived from the seed, the seed together with the validity
+// parameters is enough to regenerate the secrets. When encoded as JSON the byte
+// fields are base64 strings:
+//
+//	{
+//	  "version": 1,
+//	  "seed": "<base64 of the 48 bytes seed>",
+//	  "first-valid": <first round>,
+//	  "last-valid": <last round>,
+//	  "key-lifetime": <rounds per key>,
+//	  "commitment": "<base64 of the merkle signature scheme commitment>"
+//	}
+//
+// The commitment lets a restore detect a corrupted or mismatching backup.
+//
+//msgp:ignore KeysBackup
+type KeysBackup struct {
+	Version     uint64 `json:"version"`
+	Seed        []byte `json:"seed"`
+	FirstValid  uint64 `json:"first-valid"`
+	LastValid   uint64 `json:"last-valid"`
+	KeyLifetime uint64 `json:"key-lifetime"`
+	Commitment  []byte `json:"commitment"`
+}
+
+// NewWithBackup creates secrets from the given seed, as NewFromSeed does, and returns the backup needed to restore them.
+func NewWithBackup(seed crypto.FalconSeed, firstValid, lastValid, keyLifetime uint64) (*Secrets, *KeysBackup, error) {
+	secrets, err := NewFromSeed(seed, firstValid, lastValid, keyLifetime)
+	if err != nil {
+		return nil, nil, err
+	}
+	commitment := secrets.GetVerifier().Commitment
+	return secrets, &KeysBackup{
+		Version:     KeysBackupVersion,
+		Seed:        append([]byte(nil), seed[:]...),
+		FirstValid:  firstValid,
+		LastValid:   lastValid,
+		KeyLifetime: keyLifetime,
+		Commitment:  commitment[:],
+	}, nil
+}
+
+// Restore regenerates the secrets described by the backup and checks them against the backed up commitment.
+func (b *KeysBackup) Restore() (*Secrets, error) {
+	if b.Version != KeysBackupVersion {
+		return nil, fmt.Errorf("%w: %d", ErrBackupVersionUnsupported, b.Version)
+	}
+	var seed crypto.FalconSeed
+	if len(b.Seed) != len(seed) {
+		return nil, fmt.Errorf("backup seed has %d bytes, expected %d", len(b.Seed), len(seed))
+	}
+	copy(seed[:], b.Seed)
+
+	secrets, err := NewFromSeed(seed, b.FirstValid, b.LastValid, b.KeyLifetime)
+	if err != nil {
+		return nil, err
+	}
+	commitment := secrets.GetVerifier().Commitment
+	if !bytes.Equal(commitment[:], b.Commitment) {
+		return nil, ErrBackupCommitmentMismatch
+	}
+	return secrets, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merklesignature
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestNewFromSeedDeterministic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var seed crypto.FalconSeed
+	crypto.RandBytes(seed[:])
+
+	s1, err := NewFromSeed(seed, 1, 1000, 100)
+	a.NoError(err)
+	s2, err := NewFromSeed(seed, 1, 1000, 100)
+	a.NoError(err)
+	a.Equal(s1.GetVerifier(), s2.GetVerifier())
+	a.Equal(s1.GetAllKeys(), s2.GetAllKeys())
+
+	seed[0]++
+	s3, err := NewFromSeed(seed, 1, 1000, 100)
+	a.NoError(err)
+	a.NotEqual(s1.GetVerifier(), s3.GetVerifier())
+
+	_, err = NewFromSeed(crypto.FalconSeed{}, 1, 1000, 100)
+	a.ErrorIs(err, ErrSeedIsZero)
+}
+
+func TestKeysBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var seed crypto.FalconSeed
+	crypto.RandBytes(seed[:])
+
+	secrets, backup, err := NewWithBackup(seed, 50, 700, 128)
+	a.NoError(err)
+	a.Equal(uint64(KeysBackupVersion), backup.Version)
+
+	restored, err := backup.Restore()
+	a.NoError(err)
+	a.Equal(secrets.GetVerifier(), restored.GetVerifier())
+
+	msg := []byte("state proof message")
+	sig, err := restored.GetSigner(256).SignBytes(msg)
+	a.NoError(err)
+	a.NoError(secrets.GetVerifier().VerifyBytes(256, msg, &sig))
+
+	backup.Commitment[0]++
+	_, err = backup.Restore()
+	a.ErrorIs(err, ErrBackupCommitmentMismatch)
+
+	backup.Version++
+	_, err = backup.Restore()
+	a.ErrorIs(err, ErrBackupVersionUnsupported)
+}
@@ -18,14 +18,52 @@ package merklesignature
 
 import (
 	"context"
+	"crypto/sha512"
+	"encoding/binary"
 	"runtime"
 	"sync"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
 )
 
+// keyGenerator creates the falcon key stored at the given index of the keys slice.
+type keyGenerator func(idx uint64) (*crypto.FalconSigner, error)
+
 // KeysBuilder Responsible for generate slice of falcon keys
 func KeysBuilder(numberOfKeys uint64) ([]crypto.FalconSigner, error) {
+	return buildKeys(numberOfKeys, func(uint64) (*crypto.FalconSigner, error) {
+		return crypto.NewFalconSigner()
+	})
+}
+
+// KeysBuilderFromSeed generates a slice of falcon keys deterministically from the given seed.
+// The key at index i is generated from a seed derived from the given seed and i, so the same
+// seed always yields the same keys.
+func KeysBuilderFromSeed(seed crypto.FalconSeed, numberOfKeys uint64) ([]crypto.FalconSigner, error) {
+	return buildKeys(numberOfKeys, func(idx uint64) (*crypto.FalconSigner, error) {
+		signer, err := crypto.GenerateFalconSigner(deriveKeySeed(seed, idx))
+		return &signer, err
+	})
+}
+
+// deriveKeySeed computes the falcon seed of the key at index idx as
+// SHA-512("spk" || seed || uint64be(idx)) truncated to the falcon seed size.
+func deriveKeySeed(seed crypto.FalconSeed, idx uint64) crypto.FalconSeed {
+	var idxBytes [8]byte
+	binary.BigEndian.PutUint64(idxBytes[:], idx)
+
+	h := sha512.New()
+	h.Write([]byte(protocol.StateProofKeySeed))
+	h.Write(seed[:])
+	h.Write(idxBytes[:])
+
+	var keySeed crypto.FalconSeed
+	copy(keySeed[:], h.Sum(nil))
+	return keySeed
+}
+
+func buildKeys(numberOfKeys uint64, generate keyGenerator) ([]crypto.FalconSigner, error) {
 	numOfKeysPerRoutine, _ := calculateRanges(numberOfKeys)
 
 	ctx, ctxCancel := context.WithCancel(context.Background())
@@ -49,7 +87,7 @@ func KeysBuilder(numberOfKeys uint64) ([]crypto.FalconSigner, error) {
 		wg.Add(1)
 		go func(startIdx, endIdx uint64, keys []crypto.FalconSigner) {
 			defer wg.Done()
-			if err := generateKeysForRange(ctx, startIdx, endIdx, keys); err != nil {
+			if err := generateKeysForRange(ctx, startIdx, endIdx, keys, generate); err != nil {
 				// write to the error channel, if it's not full already.
 				select {
 				case errors <- err:
@@ -81,12 +119,12 @@ func calculateRanges(numberOfKeys uint64) (numOfKeysPerRoutine uint64, numOfRout
 	return
 }
 
-func generateKeysForRange(ctx context.Context, startIdx uint64, endIdx uint64, keys []crypto.FalconSigner) error {
+func generateKeysForRange(ctx context.Context, startIdx uint64, endIdx uint64, keys []crypto.FalconSigner, generate keyGenerator) error {
 	for k := startIdx; k < endIdx; k++ {
 		if ctx.Err() != nil {
 			return nil //nolint:nilerr // we don't need to return the ctx error, since the other goroutine will report it.
 		}
-		sigAlgo, err := crypto.NewFalconSigner()
+		sigAlgo, err := generate(k)
 		if err != nil {
 			return err
 		}
@@ -97,6 +97,7 @@ type (
 var (
 	ErrStartBiggerThanEndRound           = errors.New("cannot create Merkle Signature Scheme because end round is smaller then start round")
 	ErrKeyLifetimeIsZero                 = errors.New("received zero KeyLifetime")
+	ErrSeedIsZero                        = errors.New("received an all-zero seed")
 	ErrNoStateProofKeyForRound           = errors.New("no stateproof key exists for this round")
 	ErrSignatureSchemeVerificationFailed = errors.New("merkle signature verification failed")
 	ErrSignatureSaltVersionMismatch      = errors.New("the signature's salt version does not match")
@@ -106,6 +107,22 @@ var (
 // This function generates one key for each round within the participation period [firstValid, lastValid] (inclusive bounds)
 // which holds round % interval == 0.
 func New(firstValid, lastValid, keyLifetime uint64) (*Secrets, error) {
+	return newSecrets(firstValid, lastValid, keyLifetime, KeysBuilder)
+}
+
+// NewFromSeed creates secrets needed for the merkle signature scheme, deriving every key from the given seed.
+// Calling it again with the same seed and parameters yields the same secrets, which allows backing up
+// the secrets as the seed alone.
+func NewFromSeed(seed crypto.FalconSeed, firstValid, lastValid, keyLifetime uint64) (*Secrets, error) {
+	if seed == (crypto.FalconSeed{}) {
+		return nil, ErrSeedIsZero
+	}
+	return newSecrets(firstValid, lastValid, keyLifetime, func(numberOfKeys uint64) ([]crypto.FalconSigner, error) {
+		return KeysBuilderFromSeed(seed, numberOfKeys)
+	})
+}
+
+func newSecrets(firstValid, lastValid, keyLifetime uint64, builder func(numberOfKeys uint64) ([]crypto.FalconSigner, error)) (*Secrets, error) {
 	if firstValid > lastValid {
 		return nil, ErrStartBiggerThanEndRound
 	}
@@ -120,7 +137,7 @@ func New(firstValid, lastValid, keyLifetime uint64) (*Secrets, error) {
 		numberOfKeys = lastValid/keyLifetime + 1 // add 1 for round zero
 	}
 
-	keys, err := KeysBuilder(numberOfKeys)
+	keys, err := builder(numberOfKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +123,12 @@ func MultisigAddrGenWithSubsigs(version uint8, threshold uint8,
 
 // MultisigSign is for each device individually signs the digest
 func MultisigSign(msg Hashable, addr Digest, version, threshold uint8, pk []PublicKey, sk SecretKey) (sig MultisigSig, err error) {
+	return MultisigSignWith(msg, addr, version, threshold, pk, sk.SignatureVerifier, sk.Sign)
+}
+
+// MultisigSignWith is MultisigSign for keys that are not SignatureSecrets: sign
+// signs msg with the secret key of signer.
+func MultisigSignWith(msg Hashable, addr Digest, version, threshold uint8, pk []PublicKey, signer PublicKey, sign func(Hashable) Signature) (sig MultisigSig, err error) {
 	if version != 1 {
 		err = errUnknownVersion
 		return
@@ -144,10 +150,10 @@ func MultisigSign(msg Hashable, addr Digest, version, threshold uint8, pk []Publ
 	sig.Threshold = threshold
 	sig.Subsigs = make([]MultisigSubsig, len(pk))
 
-	// check if sk.pk exist in the pk list
+	// check if signer exist in the pk list
 	keyexist := len(pk)
 	for i := 0; i < len(pk); i++ {
-		if sk.SignatureVerifier == pk[i] {
+		if signer == pk[i] {
 			keyexist = i
 		}
 	}
@@ -159,8 +165,8 @@ func MultisigSign(msg Hashable, addr Digest, version, threshold uint8, pk []Publ
 	// form the multisig
 	for i := 0; i < len(pk); i++ {
 		sig.Subsigs[i].Key = pk[i]
-		if sk.SignatureVerifier == pk[i] {
-			sig.Subsigs[i].Sig = sk.Sign(msg)
+		if signer == pk[i] {
+			sig.Subsigs[i].Sig = sign(msg)
 		}
 	}
 	return
@@ -228,7 +234,7 @@ func MultisigVerify(msg Hashable, addr Digest, sig MultisigSig) (err error) {
 
 // MultisigBatchPrep performs checks on the assembled MultisigSig and adds to the batch.
 // The caller must call batchVerifier.verify() to verify it.
-func MultisigBatchPrep(msg Hashable, addr Digest, sig MultisigSig, batchVerifier *BatchVerifier) (err error) {
+func MultisigBatchPrep(msg Hashable, addr Digest, sig MultisigSig, batchVerifier SignatureBatch) (err error) {
 	// short circuit: if msig doesn't have subsigs or if Subsigs are empty
 	// then terminate (the upper layer should now verify the unisig)
 	if (len(sig.Subsigs) == 0 || sig.Subsigs[0] == MultisigSubsig{}) {
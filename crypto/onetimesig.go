@@ -233,6 +233,53 @@ func GenerateOneTimeSignatureSecrets(startBatch uint64, numBatches uint64) *OneT
 	return GenerateOneTimeSignatureSecretsRNG(startBatch, numBatches, SystemRNG)
 }
 
+// GenerateOneTimeSignatureSecretsWithSigner is a version of GenerateOneTimeSignatureSecrets
+// that generates the batch subkeys in signer, so that their secrets never are in memory.
+//
+// The batch subkeys are not expanded into offset subkeys: the batch subkey certifies a fresh
+// subkey for every signed message, and is only destroyed once the batch is over. This trades
+// forward security within a batch for keeping the long lived secrets in the signer.
+func GenerateOneTimeSignatureSecretsWithSigner(startBatch uint64, numBatches uint64, signer KeySigner) (*OneTimeSignatureSecrets, error) {
+	if !signer.Supports(Ed25519Algorithm) {
+		return nil, ErrKeySignerUnsupported
+	}
+	s := new(OneTimeSignatureSecrets)
+
+	master, ephemeralSec := ed25519GenerateKeyRNG(SystemRNG)
+
+	subkeys := make([]ephemeralSubkey, 0, numBatches)
+	for i := uint64(0); i < numBatches; i++ {
+		subkey, err := generateSignerSubkey(signer)
+		if err != nil {
+			for _, k := range subkeys {
+				signer.DestroyKey(Ed25519Algorithm, k.PK[:]) //nolint:errcheck // best effort, the subkeys were never used
+			}
+			return nil, fmt.Errorf("could not generate one-time signature subkey %d in the key signer: %w", i, err)
+		}
+		newid := OneTimeSignatureSubkeyBatchID{SubKeyPK: subkey.PK, Batch: startBatch + i}
+		subkey.PKSigNew = ed25519Sign(ephemeralSec, HashRep(newid))
+		subkeys = append(subkeys, subkey)
+	}
+
+	s.OneTimeSignatureVerifier = OneTimeSignatureVerifier(master)
+	s.FirstBatch = startBatch
+	s.Batches = subkeys
+	return s, nil
+}
+
+// HeldBySigner tells whether the subkeys of the secrets are held by a KeySigner, rather than in memory.
+func (s *OneTimeSignatureSecrets) HeldBySigner() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, k := range s.Batches {
+		if k.heldBySigner() {
+			return true
+		}
+	}
+	return false
+}
+
 // getRNG returns the RNG for OneTimeSignatureSecrets.
 // If we serialized and de-serialized the OneTimeSignatureSecrets,
 // the private rng field might be nil.  Since rng is used only
@@ -253,7 +300,11 @@ func (s *OneTimeSignatureSecrets) Sign(id OneTimeSignatureIdentifier, message Ha
 	// Check if we already have a partial batch of subkeys.
 	if id.Batch+1 == s.FirstBatch && id.Offset >= s.FirstOffset && id.Offset-s.FirstOffset < uint64(len(s.Offsets)) {
 		offidx := id.Offset - s.FirstOffset
-		sig := ed25519Sign(s.Offsets[offidx].SK, HashRep(message))
+		sig, err := s.Offsets[offidx].sign(HashRep(message))
+		if err != nil {
+			logging.Base().Errorf("tried to sign %v with one-time identifier %v: %v", message, id, err)
+			return OneTimeSignature{}
+		}
 		return OneTimeSignature{
 			Sig:    sig,
 			PK:     s.Offsets[offidx].PK,
@@ -278,10 +329,15 @@ func (s *OneTimeSignatureSecrets) Sign(id OneTimeSignatureIdentifier, message Ha
 			Batch:    id.Batch,
 			Offset:   id.Offset,
 		}
+		pk1sig, err := s.Batches[batchidx].sign(HashRep(pk1id))
+		if err != nil {
+			logging.Base().Errorf("tried to sign %v with one-time identifier %v: %v", message, id, err)
+			return OneTimeSignature{}
+		}
 		return OneTimeSignature{
 			Sig:    sig,
 			PK:     pk,
-			PK1Sig: ed25519Sign(s.Batches[batchidx].SK, HashRep(pk1id)),
+			PK1Sig: pk1sig,
 			PK2:    s.Batches[batchidx].PK,
 			PK2Sig: pksig,
 		}
@@ -309,6 +365,14 @@ func (s *OneTimeSignatureSecrets) Sign(id OneTimeSignatureIdentifier, message Ha
 //
 // It returns true if this is the case; otherwise, it returns false.
 func (v OneTimeSignatureVerifier) Verify(id OneTimeSignatureIdentifier, message Hashable, sig OneTimeSignature) bool {
+	allValid, _ := batchVerificationImpl(v.batchChecks(id, message, sig))
+	return allValid
+}
+
+// batchChecks returns the three ed25519 signatures making up a one-time signature:
+// the batch subkey signed by the verifier, the offset subkey signed by the batch subkey, and the message
+// signed by the offset subkey.
+func (v OneTimeSignatureVerifier) batchChecks(id OneTimeSignatureIdentifier, message Hashable, sig OneTimeSignature) ([][]byte, []PublicKey, []Signature) {
 	offsetID := OneTimeSignatureSubkeyOffsetID{
 		SubKeyPK: sig.PK,
 		Batch:    id.Batch,
@@ -319,12 +383,17 @@ func (v OneTimeSignatureVerifier) Verify(id OneTimeSignatureIdentifier, message
 		Batch:    id.Batch,
 	}
 
-	allValid, _ := batchVerificationImpl(
-		[][]byte{HashRep(batchID), HashRep(offsetID), HashRep(message)},
+	return [][]byte{HashRep(batchID), HashRep(offsetID), HashRep(message)},
 		[]PublicKey{PublicKey(v), PublicKey(batchID.SubKeyPK), PublicKey(offsetID.SubKeyPK)},
-		[]Signature{Signature(sig.PK2Sig), Signature(sig.PK1Sig), Signature(sig.Sig)},
-	)
-	return allValid
+		[]Signature{Signature(sig.PK2Sig), Signature(sig.PK1Sig), Signature(sig.Sig)}
+}
+
+// DestroySignerSubkeys destroys the subkeys held by the KeySigner, when the secrets are deleted.
+func (s *OneTimeSignatureSecrets) DestroySignerSubkeys() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	destroySignerSubkeys(s.Batches)
 }
 
 // DeleteBeforeFineGrained deletes ephemeral keys before (but not including) the given id.
@@ -367,11 +436,13 @@ func (s *OneTimeSignatureSecrets) DeleteBeforeFineGrained(current OneTimeSignatu
 		// bother bumping FirstBatch, so that we don't make
 		// irrelevant changes to expired keys.
 		if s.Batches != nil {
+			destroySignerSubkeys(s.Batches)
 			s.FirstBatch = current.Batch
 			s.Batches = nil
 		}
 		return
 	}
+	destroySignerSubkeys(s.Batches[:jump])
 	s.FirstBatch += jump
 	s.Batches = s.Batches[jump:]
 
@@ -380,6 +451,11 @@ func (s *OneTimeSignatureSecrets) DeleteBeforeFineGrained(current OneTimeSignatu
 		// We ran out of whole batches.
 		return
 	}
+	if s.Batches[0].heldBySigner() {
+		// The batch subkey stays in the key signer until the batch is over, and Sign
+		// certifies a fresh subkey with it for every message.
+		return
+	}
 
 	s.OffsetsPK2 = s.Batches[0].PK
 	s.OffsetsPK2Sig = s.Batches[0].PKSigNew
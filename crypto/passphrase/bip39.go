@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// BIP-39 mnemonics encode 128 to 256 bits of entropy, followed by a checksum
+// of one bit per 32 bits of entropy. Unlike the mnemonics of this package,
+// they are not keys themselves: they are stretched into a 64-byte seed, from
+// which keys are derived.
+const (
+	bip39MinWords       = 12
+	bip39MaxWords       = 24
+	bip39SeedIterations = 2048
+	bip39SeedLenBytes   = 64
+	bip39SaltPrefix     = "mnemonic"
+)
+
+// ValidateBIP39Mnemonic checks that mnemonic is a BIP-39 mnemonic made of
+// words of the English list, and that its checksum is valid
+func ValidateBIP39Mnemonic(mnemonic string) error {
+	_, err := bip39Entropy(strings.Fields(mnemonic))
+	return err
+}
+
+// BIP39MnemonicToSeed validates a BIP-39 mnemonic and converts it, along with
+// an optional passphrase, into the 64-byte seed keys are derived from
+func BIP39MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	_, err := bip39Entropy(words)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := norm.NFKD.String(strings.Join(words, sepStr))
+	salt := norm.NFKD.String(bip39SaltPrefix + passphrase)
+	return pbkdf2.Key([]byte(normalized), []byte(salt), bip39SeedIterations, bip39SeedLenBytes, sha512.New), nil
+}
+
+// bip39Entropy decodes the entropy encoded by words, checking its checksum
+func bip39Entropy(words []string) ([]byte, error) {
+	if len(words) < bip39MinWords || len(words) > bip39MaxWords || len(words)%3 != 0 {
+		return nil, errWrongBIP39MnemonicLen
+	}
+
+	// Unpack the 11-bit word indices into a bit string
+	bits := make([]byte, 0, len(words)*bitsPerWord)
+	for _, w := range words {
+		idx := indexOf(wordlist, w)
+		if idx == -1 || w == "" {
+			return nil, fmt.Errorf("%s is not in the words list", w)
+		}
+		for i := bitsPerWord - 1; i >= 0; i-- {
+			bits = append(bits, byte(idx>>uint(i))&1)
+		}
+	}
+
+	checksumBits := len(bits) / 33
+	entropyBits := len(bits) - checksumBits
+	entropy := make([]byte, entropyBits/8)
+	for i := 0; i < entropyBits; i++ {
+		entropy[i/8] |= bits[i] << uint(7-i%8)
+	}
+
+	// The checksum is the first bits of the SHA-256 hash of the entropy
+	hash := sha256.Sum256(entropy)
+	for i := 0; i < checksumBits; i++ {
+		if (hash[i/8]>>uint(7-i%8))&1 != bits[entropyBits+i] {
+			return nil, errWrongChecksum
+		}
+	}
+	return entropy, nil
+}
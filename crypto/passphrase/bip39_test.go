@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBIP39MnemonicToSeed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Test vectors from the reference implementation of BIP-39
+	vectors := []struct {
+		mnemonic string
+		seed     string
+	}{
+		{
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+	}
+	for _, v := range vectors {
+		seed, err := BIP39MnemonicToSeed(v.mnemonic, "TREZOR")
+		require.NoError(t, err)
+		require.Equal(t, v.seed, hex.EncodeToString(seed))
+	}
+
+	// Extra whitespace is ignored
+	seed, err := BIP39MnemonicToSeed("  abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon   about ", "TREZOR")
+	require.NoError(t, err)
+	require.Equal(t, vectors[0].seed, hex.EncodeToString(seed))
+}
+
+func TestBIP39Validate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.NoError(t, ValidateBIP39Mnemonic("zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong"))
+
+	// Wrong checksum
+	err := ValidateBIP39Mnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+	require.ErrorIs(t, err, errWrongChecksum)
+
+	// Wrong number of words
+	err = ValidateBIP39Mnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	require.ErrorIs(t, err, errWrongBIP39MnemonicLen)
+
+	// Word not in the list
+	err = ValidateBIP39Mnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon algorand")
+	require.Error(t, err)
+}
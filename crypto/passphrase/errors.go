@@ -23,3 +23,4 @@ import (
 var errWrongKeyLen = fmt.Errorf("key length must be %d bytes", keyLenBytes)
 var errWrongMnemonicLen = fmt.Errorf("mnemonic must be %d words", mnemonicLenWords)
 var errWrongChecksum = fmt.Errorf("checksum failed to validate")
+var errWrongBIP39MnemonicLen = fmt.Errorf("BIP-39 mnemonic must be %d to %d words, in multiples of 3", bip39MinWords, bip39MaxWords)
@@ -0,0 +1,609 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows
+// +build !windows
+
+// Package pkcs11 implements a crypto.KeySigner holding its keys in a PKCS#11 token, e.g. an HSM.
+package pkcs11
+
+// #cgo linux LDFLAGS: -ldl
+// #include <dlfcn.h>
+// #include <stdlib.h>
+// #include <string.h>
+//
+// typedef unsigned long ck_ulong;
+// typedef ck_ulong ck_rv;
+//
+// typedef struct {
+//	ck_ulong type;
+//	void *value;
+//	ck_ulong len;
+// } ck_attribute;
+//
+// typedef struct {
+//	ck_ulong mechanism;
+//	void *param;
+//	ck_ulong len;
+// } ck_mechanism;
+//
+// typedef struct {
+//	void *create_mutex;
+//	void *destroy_mutex;
+//	void *lock_mutex;
+//	void *unlock_mutex;
+//	ck_ulong flags;
+//	void *reserved;
+// } ck_initialize_args;
+//
+// // The function list is a version followed by the function pointers, in the order of the
+// // specification. The functions are called by their index in it.
+// typedef struct {
+//	unsigned char major;
+//	unsigned char minor;
+//	void *fn[68];
+// } ck_function_list;
+//
+// enum {
+//	fnInitialize = 0,
+//	fnFinalize = 1,
+//	fnGetSlotList = 4,
+//	fnGetTokenInfo = 6,
+//	fnGetMechanismList = 7,
+//	fnOpenSession = 12,
+//	fnCloseSession = 13,
+//	fnLogin = 18,
+//	fnDestroyObject = 22,
+//	fnGetAttributeValue = 24,
+//	fnSetAttributeValue = 25,
+//	fnFindObjectsInit = 26,
+//	fnFindObjects = 27,
+//	fnFindObjectsFinal = 28,
+//	fnSignInit = 42,
+//	fnSign = 43,
+//	fnGenerateKeyPair = 59,
+// };
+//
+// static ck_rv p11_load(const char *path, void **module, ck_function_list **fl) {
+//	*module = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+//	if (*module == NULL) {
+//		return (ck_rv)-1;
+//	}
+//	ck_rv (*get_function_list)(ck_function_list **) = dlsym(*module, "C_GetFunctionList");
+//	if (get_function_list == NULL) {
+//		dlclose(*module);
+//		return (ck_rv)-1;
+//	}
+//	ck_rv rv = get_function_list(fl);
+//	if (rv != 0) {
+//		dlclose(*module);
+//	}
+//	return rv;
+// }
+//
+// static void p11_unload(void *module) {
+//	dlclose(module);
+// }
+//
+// static ck_rv p11_initialize(ck_function_list *fl) {
+//	ck_initialize_args args;
+//	memset(&args, 0, sizeof(args));
+//	args.flags = 2; // CKF_OS_LOCKING_OK
+//	return ((ck_rv (*)(void *))fl->fn[fnInitialize])(&args);
+// }
+//
+// static ck_rv p11_finalize(ck_function_list *fl) {
+//	return ((ck_rv (*)(void *))fl->fn[fnFinalize])(NULL);
+// }
+//
+// static ck_rv p11_get_slot_list(ck_function_list *fl, ck_ulong *slots, ck_ulong *count) {
+//	return ((ck_rv (*)(unsigned char, ck_ulong *, ck_ulong *))fl->fn[fnGetSlotList])(1, slots, count);
+// }
+//
+// static ck_rv p11_get_token_label(ck_function_list *fl, ck_ulong slot, unsigned char *label) {
+//	// CK_TOKEN_INFO starts with the 32 bytes label, followed by fields well under 512 bytes
+//	unsigned char info[512];
+//	ck_rv rv = ((ck_rv (*)(ck_ulong, void *))fl->fn[fnGetTokenInfo])(slot, info);
+//	if (rv == 0) {
+//		memcpy(label, info, 32);
+//	}
+//	return rv;
+// }
+//
+// static ck_rv p11_get_mechanism_list(ck_function_list *fl, ck_ulong slot, ck_ulong *mechanisms, ck_ulong *count) {
+//	return ((ck_rv (*)(ck_ulong, ck_ulong *, ck_ulong *))fl->fn[fnGetMechanismList])(slot, mechanisms, count);
+// }
+//
+// static ck_rv p11_open_session(ck_function_list *fl, ck_ulong slot, ck_ulong *session) {
+//	// CKF_SERIAL_SESSION | CKF_RW_SESSION
+//	return ((ck_rv (*)(ck_ulong, ck_ulong, void *, void *, ck_ulong *))fl->fn[fnOpenSession])(slot, 4 | 2, NULL, NULL, session);
+// }
+//
+// static ck_rv p11_close_session(ck_function_list *fl, ck_ulong session) {
+//	return ((ck_rv (*)(ck_ulong))fl->fn[fnCloseSession])(session);
+// }
+//
+// static ck_rv p11_login(ck_function_list *fl, ck_ulong session, unsigned char *pin, ck_ulong len) {
+//	// CKU_USER
+//	return ((ck_rv (*)(ck_ulong, ck_ulong, unsigned char *, ck_ulong))fl->fn[fnLogin])(session, 1, pin, len);
+// }
+//
+// static ck_rv p11_destroy_object(ck_function_list *fl, ck_ulong session, ck_ulong object) {
+//	return ((ck_rv (*)(ck_ulong, ck_ulong))fl->fn[fnDestroyObject])(session, object);
+// }
+//
+// static ck_rv p11_get_attribute_value(ck_function_list *fl, ck_ulong session, ck_ulong object, ck_attribute *attrs, ck_ulong count) {
+//	return ((ck_rv (*)(ck_ulong, ck_ulong, ck_attribute *, ck_ulong))fl->fn[fnGetAttributeValue])(session, object, attrs, count);
+// }
+//
+// static ck_rv p11_set_attribute_value(ck_function_list *fl, ck_ulong session, ck_ulong object, ck_attribute *attrs, ck_ulong count) {
+//	return ((ck_rv (*)(ck_ulong, ck_ulong, ck_attribute *, ck_ulong))fl->fn[fnSetAttributeValue])(session, object, attrs, count);
+// }
+//
+// static ck_rv p11_find_objects(ck_function_list *fl, ck_ulong session, ck_attribute *attrs, ck_ulong count, ck_ulong *objects, ck_ulong max, ck_ulong *found) {
+//	ck_rv rv = ((ck_rv (*)(ck_ulong, ck_attribute *, ck_ulong))fl->fn[fnFindObjectsInit])(session, attrs, count);
+//	if (rv != 0) {
+//		return rv;
+//	}
+//	rv = ((ck_rv (*)(ck_ulong, ck_ulong *, ck_ulong, ck_ulong *))fl->fn[fnFindObjects])(session, objects, max, found);
+//	ck_rv final = ((ck_rv (*)(ck_ulong))fl->fn[fnFindObjectsFinal])(session);
+//	return rv != 0 ? rv : final;
+// }
+//
+// static ck_rv p11_sign(ck_function_list *fl, ck_ulong session, ck_ulong mechanism, ck_ulong key, unsigned char *data, ck_ulong len, unsigned char *sig, ck_ulong *siglen) {
+//	ck_mechanism mech = {mechanism, NULL, 0};
+//	ck_rv rv = ((ck_rv (*)(ck_ulong, ck_mechanism *, ck_ulong))fl->fn[fnSignInit])(session, &mech, key);
+//	if (rv != 0) {
+//		return rv;
+//	}
+//	return ((ck_rv (*)(ck_ulong, unsigned char *, ck_ulong, unsigned char *, ck_ulong *))fl->fn[fnSign])(session, data, len, sig, siglen);
+// }
+//
+// static ck_rv p11_generate_key_pair(ck_function_list *fl, ck_ulong session, ck_ulong mechanism,
+//	ck_attribute *pub, ck_ulong pubcount, ck_attribute *priv, ck_ulong privcount, ck_ulong *pubkey, ck_ulong *privkey) {
+//	ck_mechanism mech = {mechanism, NULL, 0};
+//	return ((ck_rv (*)(ck_ulong, ck_mechanism *, ck_attribute *, ck_ulong, ck_attribute *, ck_ulong, ck_ulong *, ck_ulong *))fl->fn[fnGenerateKeyPair])(
+//		session, &mech, pub, pubcount, priv, privcount, pubkey, privkey);
+// }
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+const (
+	ckrOK                         = 0x0
+	ckrUserAlreadyLoggedIn        = 0x100
+	ckrCryptokiAlreadyInitialized = 0x191
+
+	ckoPublicKey  = 0x2
+	ckoPrivateKey = 0x3
+
+	ckkECEdwards = 0x40
+
+	ckaClass       = 0x0
+	ckaToken       = 0x1
+	ckaPrivate     = 0x2
+	ckaLabel       = 0x3
+	ckaKeyType     = 0x100
+	ckaID          = 0x102
+	ckaSensitive   = 0x103
+	ckaSign        = 0x108
+	ckaVerify      = 0x10A
+	ckaExtractable = 0x162
+	ckaECParams    = 0x180
+	ckaECPoint     = 0x181
+
+	ckmECEdwardsKeyPairGen = 0x1055
+	ckmEdDSA               = 0x1057
+)
+
+// ed25519Params are the DER encoded CKA_EC_PARAMS of ed25519 keys, the OID 1.3.101.112.
+var ed25519Params = []byte{0x06, 0x03, 0x2B, 0x65, 0x70}
+
+// keyLabel is the CKA_LABEL of the keys generated by the Signer.
+const keyLabel = "algorand-participation"
+
+// Error is a PKCS#11 function failure.
+type Error struct {
+	Function string
+	RV       uint64
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("pkcs11: %s failed with 0x%x", e.Function, e.RV)
+}
+
+func check(function string, rv C.ck_rv) error {
+	if rv == ckrOK {
+		return nil
+	}
+	return &Error{Function: function, RV: uint64(rv)}
+}
+
+// ErrTokenNotFound is returned by Open when no token has the requested label.
+var ErrTokenNotFound = errors.New("pkcs11: no token with this label")
+
+// ErrKeyNotFound is returned when the token holds no key with the requested public key.
+var ErrKeyNotFound = errors.New("pkcs11: no key with this public key in the token")
+
+// errClosed is returned when using a Signer after Close.
+var errClosed = errors.New("pkcs11: the signer is closed")
+
+// A Signer is a crypto.KeySigner generating and using ed25519 keys in a PKCS#11 token.
+// Its operations are serialized on a single logged in session.
+type Signer struct {
+	mu         sync.Mutex
+	module     unsafe.Pointer
+	functions  *C.ck_function_list
+	session    C.ck_ulong
+	mechanisms map[uint64]bool
+}
+
+// Open loads the PKCS#11 module at modulePath, and logs in with pin to its token labeled tokenLabel.
+func Open(modulePath, tokenLabel, pin string) (*Signer, error) {
+	s := &Signer{}
+	cpath := C.CString(modulePath)
+	defer C.free(unsafe.Pointer(cpath))
+	if rv := C.p11_load(cpath, &s.module, &s.functions); rv != ckrOK {
+		return nil, fmt.Errorf("pkcs11: could not load the module %s: %w", modulePath, check("C_GetFunctionList", rv))
+	}
+	rv := C.p11_initialize(s.functions)
+	if rv != ckrOK && rv != ckrCryptokiAlreadyInitialized {
+		C.p11_unload(s.module)
+		return nil, check("C_Initialize", rv)
+	}
+
+	err := s.openSession(tokenLabel, pin)
+	if err != nil {
+		C.p11_finalize(s.functions)
+		C.p11_unload(s.module)
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Signer) openSession(tokenLabel, pin string) error {
+	slot, err := s.findSlot(tokenLabel)
+	if err != nil {
+		return err
+	}
+	if err = s.loadMechanisms(slot); err != nil {
+		return err
+	}
+	if err = check("C_OpenSession", C.p11_open_session(s.functions, slot, &s.session)); err != nil {
+		return err
+	}
+
+	cpin := C.CBytes([]byte(pin))
+	defer C.free(cpin)
+	rv := C.p11_login(s.functions, s.session, (*C.uchar)(cpin), C.ck_ulong(len(pin)))
+	if rv != ckrOK && rv != ckrUserAlreadyLoggedIn {
+		C.p11_close_session(s.functions, s.session)
+		return check("C_Login", rv)
+	}
+	return nil
+}
+
+// findSlot returns the slot of the token labeled tokenLabel.
+func (s *Signer) findSlot(tokenLabel string) (C.ck_ulong, error) {
+	var count C.ck_ulong
+	if err := check("C_GetSlotList", C.p11_get_slot_list(s.functions, nil, &count)); err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, ErrTokenNotFound
+	}
+	slots := (*C.ck_ulong)(C.malloc(C.size_t(count) * C.sizeof_ck_ulong))
+	defer C.free(unsafe.Pointer(slots))
+	if err := check("C_GetSlotList", C.p11_get_slot_list(s.functions, slots, &count)); err != nil {
+		return 0, err
+	}
+
+	label := (*C.uchar)(C.malloc(32))
+	defer C.free(unsafe.Pointer(label))
+	for _, slot := range unsafe.Slice(slots, count) {
+		if err := check("C_GetTokenInfo", C.p11_get_token_label(s.functions, slot, label)); err != nil {
+			return 0, err
+		}
+		// the label is padded with blanks
+		if strings.TrimRight(C.GoStringN((*C.char)(unsafe.Pointer(label)), 32), " ") == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, ErrTokenNotFound
+}
+
+func (s *Signer) loadMechanisms(slot C.ck_ulong) error {
+	var count C.ck_ulong
+	if err := check("C_GetMechanismList", C.p11_get_mechanism_list(s.functions, slot, nil, &count)); err != nil {
+		return err
+	}
+	s.mechanisms = make(map[uint64]bool, count)
+	if count == 0 {
+		return nil
+	}
+	mechanisms := (*C.ck_ulong)(C.malloc(C.size_t(count) * C.sizeof_ck_ulong))
+	defer C.free(unsafe.Pointer(mechanisms))
+	if err := check("C_GetMechanismList", C.p11_get_mechanism_list(s.functions, slot, mechanisms, &count)); err != nil {
+		return err
+	}
+	for _, m := range unsafe.Slice(mechanisms, count) {
+		s.mechanisms[uint64(m)] = true
+	}
+	return nil
+}
+
+// Close logs out of the token and unloads the module.
+func (s *Signer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.functions == nil {
+		return
+	}
+	C.p11_close_session(s.functions, s.session)
+	C.p11_finalize(s.functions)
+	C.p11_unload(s.module)
+	s.functions = nil
+}
+
+// Supports implements crypto.KeySigner. PKCS#11 defines no mechanism for falcon, so
+// only ed25519 is supported, by the tokens implementing the EdDSA mechanisms.
+func (s *Signer) Supports(alg crypto.SignatureAlgorithm) bool {
+	switch alg {
+	case crypto.Ed25519Algorithm:
+		return s.mechanisms[ckmECEdwardsKeyPairGen] && s.mechanisms[ckmEdDSA]
+	default:
+		return false
+	}
+}
+
+// GenerateKey implements crypto.KeySigner. The key pair is generated in the token, and
+// the public key is set as the CKA_ID of both of its objects, to find them again.
+func (s *Signer) GenerateKey(alg crypto.SignatureAlgorithm) (publicKey []byte, err error) {
+	if !s.Supports(alg) {
+		return nil, crypto.ErrKeySignerUnsupported
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.functions == nil {
+		return nil, errClosed
+	}
+
+	pubTemplate := newTemplate()
+	defer pubTemplate.free()
+	pubTemplate.addBool(ckaToken, true)
+	pubTemplate.addBool(ckaVerify, true)
+	pubTemplate.addBytes(ckaECParams, ed25519Params)
+	pubTemplate.addBytes(ckaLabel, []byte(keyLabel))
+
+	privTemplate := newTemplate()
+	defer privTemplate.free()
+	privTemplate.addBool(ckaToken, true)
+	privTemplate.addBool(ckaPrivate, true)
+	privTemplate.addBool(ckaSensitive, true)
+	privTemplate.addBool(ckaExtractable, false)
+	privTemplate.addBool(ckaSign, true)
+	privTemplate.addBytes(ckaLabel, []byte(keyLabel))
+
+	var pub, priv C.ck_ulong
+	err = check("C_GenerateKeyPair", C.p11_generate_key_pair(s.functions, s.session, ckmECEdwardsKeyPairGen,
+		pubTemplate.attrs(), pubTemplate.count(), privTemplate.attrs(), privTemplate.count(), &pub, &priv))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			C.p11_destroy_object(s.functions, s.session, pub)
+			C.p11_destroy_object(s.functions, s.session, priv)
+		}
+	}()
+
+	point, err := s.getAttribute(pub, ckaECPoint)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err = parseECPoint(point)
+	if err != nil {
+		return nil, err
+	}
+
+	idTemplate := newTemplate()
+	defer idTemplate.free()
+	idTemplate.addBytes(ckaID, publicKey)
+	for _, object := range []C.ck_ulong{pub, priv} {
+		err = check("C_SetAttributeValue", C.p11_set_attribute_value(s.functions, s.session, object, idTemplate.attrs(), idTemplate.count()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return publicKey, nil
+}
+
+// parseECPoint returns the ed25519 public key of a CKA_EC_POINT, which tokens return either
+// raw or wrapped in a DER octet string.
+func parseECPoint(point []byte) ([]byte, error) {
+	switch {
+	case len(point) == 32:
+		return point, nil
+	case len(point) == 34 && point[0] == 0x04 && point[1] == 32:
+		return point[2:], nil
+	default:
+		return nil, fmt.Errorf("pkcs11: unexpected ed25519 CKA_EC_POINT %x", point)
+	}
+}
+
+// Sign implements crypto.KeySigner.
+func (s *Signer) Sign(alg crypto.SignatureAlgorithm, publicKey []byte, data []byte) ([]byte, error) {
+	if !s.Supports(alg) {
+		return nil, crypto.ErrKeySignerUnsupported
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.functions == nil {
+		return nil, errClosed
+	}
+
+	keys, err := s.findKeys(ckoPrivateKey, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, ErrKeyNotFound
+	}
+
+	cdata := C.CBytes(data)
+	defer C.free(cdata)
+	siglen := C.ck_ulong(64)
+	sig := C.malloc(C.size_t(siglen))
+	defer C.free(sig)
+	err = check("C_Sign", C.p11_sign(s.functions, s.session, ckmEdDSA, keys[0], (*C.uchar)(cdata), C.ck_ulong(len(data)), (*C.uchar)(sig), &siglen))
+	if err != nil {
+		return nil, err
+	}
+	return C.GoBytes(sig, C.int(siglen)), nil
+}
+
+// DestroyKey implements crypto.KeySigner. Both the private and public key objects are destroyed.
+func (s *Signer) DestroyKey(alg crypto.SignatureAlgorithm, publicKey []byte) error {
+	if !s.Supports(alg) {
+		return crypto.ErrKeySignerUnsupported
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.functions == nil {
+		return errClosed
+	}
+
+	var objects []C.ck_ulong
+	for _, class := range []uint64{ckoPrivateKey, ckoPublicKey} {
+		keys, err := s.findKeys(class, publicKey)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, keys...)
+	}
+	if len(objects) == 0 {
+		return ErrKeyNotFound
+	}
+	for _, object := range objects {
+		if err := check("C_DestroyObject", C.p11_destroy_object(s.functions, s.session, object)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findKeys returns the ed25519 key objects of class with publicKey as CKA_ID.
+func (s *Signer) findKeys(class uint64, publicKey []byte) ([]C.ck_ulong, error) {
+	template := newTemplate()
+	defer template.free()
+	template.addULong(ckaClass, class)
+	template.addULong(ckaKeyType, ckkECEdwards)
+	template.addBytes(ckaID, publicKey)
+
+	const maxObjects = 4
+	objects := (*C.ck_ulong)(C.malloc(maxObjects * C.sizeof_ck_ulong))
+	defer C.free(unsafe.Pointer(objects))
+	var found C.ck_ulong
+	err := check("C_FindObjects", C.p11_find_objects(s.functions, s.session, template.attrs(), template.count(), objects, maxObjects, &found))
+	if err != nil {
+		return nil, err
+	}
+	return append([]C.ck_ulong(nil), unsafe.Slice(objects, found)...), nil
+}
+
+// getAttribute returns the value of the attribute typ of object.
+func (s *Signer) getAttribute(object C.ck_ulong, typ uint64) ([]byte, error) {
+	template := newTemplate()
+	defer template.free()
+	template.addBytes(typ, nil)
+
+	attr := template.attrs()
+	if err := check("C_GetAttributeValue", C.p11_get_attribute_value(s.functions, s.session, object, attr, 1)); err != nil {
+		return nil, err
+	}
+	value := C.malloc(C.size_t(attr.len))
+	defer C.free(value)
+	attr.value = value
+	if err := check("C_GetAttributeValue", C.p11_get_attribute_value(s.functions, s.session, object, attr, 1)); err != nil {
+		return nil, err
+	}
+	return C.GoBytes(value, C.int(attr.len)), nil
+}
+
+// template is a PKCS#11 attribute template. It is allocated in C memory, so that it can be passed to the
+// module, and must be freed.
+type template struct {
+	attributes []C.ck_attribute
+	c          *C.ck_attribute
+}
+
+func newTemplate() *template {
+	return &template{}
+}
+
+func (t *template) addBytes(typ uint64, value []byte) {
+	var p unsafe.Pointer
+	if len(value) > 0 {
+		p = C.CBytes(value)
+	}
+	t.attributes = append(t.attributes, C.ck_attribute{_type: C.ck_ulong(typ), value: p, len: C.ck_ulong(len(value))})
+}
+
+func (t *template) addBool(typ uint64, value bool) {
+	var b byte
+	if value {
+		b = 1
+	}
+	t.addBytes(typ, []byte{b})
+}
+
+func (t *template) addULong(typ uint64, value uint64) {
+	p := C.malloc(C.sizeof_ck_ulong)
+	*(*C.ck_ulong)(p) = C.ck_ulong(value)
+	t.attributes = append(t.attributes, C.ck_attribute{_type: C.ck_ulong(typ), value: p, len: C.sizeof_ck_ulong})
+}
+
+// attrs returns the template in C memory. It must not be added to afterwards.
+func (t *template) attrs() *C.ck_attribute {
+	if t.c == nil {
+		t.c = (*C.ck_attribute)(C.malloc(C.size_t(len(t.attributes)+1) * C.sizeof_ck_attribute))
+		copy(unsafe.Slice(t.c, len(t.attributes)), t.attributes)
+	}
+	return t.c
+}
+
+func (t *template) count() C.ck_ulong {
+	return C.ck_ulong(len(t.attributes))
+}
+
+func (t *template) free() {
+	for _, a := range t.attributes {
+		if a.value != nil {
+			C.free(a.value)
+		}
+	}
+	if t.c != nil {
+		C.free(unsafe.Pointer(t.c))
+	}
+}
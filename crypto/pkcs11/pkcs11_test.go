@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows
+// +build !windows
+
+package pkcs11
+
+import (
+	"crypto/ed25519"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type testHashable []byte
+
+func (h testHashable) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.TestHashable, h
+}
+
+func TestParseECPoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	raw := make([]byte, 32)
+	raw[0] = 7
+	pk, err := parseECPoint(raw)
+	require.NoError(t, err)
+	require.Equal(t, raw, pk)
+
+	pk, err = parseECPoint(append([]byte{0x04, 32}, raw...))
+	require.NoError(t, err)
+	require.Equal(t, raw, pk)
+
+	_, err = parseECPoint(append([]byte{0x04, 33}, raw...))
+	require.Error(t, err)
+	_, err = parseECPoint(raw[:31])
+	require.Error(t, err)
+}
+
+// TestSigner runs against a real token, e.g. SoftHSM:
+// ALGORAND_TEST_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so ALGORAND_TEST_PKCS11_TOKEN=test ALGORAND_TEST_PKCS11_PIN=1234
+func TestSigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	module := os.Getenv("ALGORAND_TEST_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("ALGORAND_TEST_PKCS11_MODULE is not set")
+	}
+	s, err := Open(module, os.Getenv("ALGORAND_TEST_PKCS11_TOKEN"), os.Getenv("ALGORAND_TEST_PKCS11_PIN"))
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.False(t, s.Supports(crypto.FalconAlgorithm))
+	_, err = s.GenerateKey(crypto.FalconAlgorithm)
+	require.ErrorIs(t, err, crypto.ErrKeySignerUnsupported)
+	if !s.Supports(crypto.Ed25519Algorithm) {
+		t.Skip("the token does not support EdDSA")
+	}
+
+	pk, err := s.GenerateKey(crypto.Ed25519Algorithm)
+	require.NoError(t, err)
+	require.Len(t, pk, ed25519.PublicKeySize)
+
+	data := []byte("participation")
+	sig, err := s.Sign(crypto.Ed25519Algorithm, pk, data)
+	require.NoError(t, err)
+	require.True(t, ed25519.Verify(pk, data, sig))
+
+	require.NoError(t, s.DestroyKey(crypto.Ed25519Algorithm, pk))
+	_, err = s.Sign(crypto.Ed25519Algorithm, pk, data)
+	require.ErrorIs(t, err, ErrKeyNotFound)
+	require.ErrorIs(t, s.DestroyKey(crypto.Ed25519Algorithm, pk), ErrKeyNotFound)
+
+	// voting keys generated in the token
+	secrets, err := crypto.GenerateOneTimeSignatureSecretsWithSigner(0, 2, s)
+	require.NoError(t, err)
+	crypto.SetKeySigner(s)
+	defer crypto.SetKeySigner(nil)
+	id := crypto.OneTimeSignatureIdentifier{Batch: 1, Offset: 3}
+	msg := testHashable(data)
+	require.True(t, secrets.OneTimeSignatureVerifier.Verify(id, msg, secrets.Sign(id, msg)))
+	secrets.DestroySignerSubkeys()
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pkcs11
+
+import (
+	"errors"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// errUnsupportedPlatform is returned by Open on the platforms where PKCS#11 modules are not loaded.
+var errUnsupportedPlatform = errors.New("pkcs11: PKCS#11 key signers are not supported on windows")
+
+// A Signer is a crypto.KeySigner generating and using ed25519 keys in a PKCS#11 token.
+type Signer struct{}
+
+// Open loads the PKCS#11 module at modulePath, and logs in with pin to its token labeled tokenLabel.
+func Open(modulePath, tokenLabel, pin string) (*Signer, error) {
+	return nil, errUnsupportedPlatform
+}
+
+// Close logs out of the token and unloads the module.
+func (s *Signer) Close() {}
+
+// Supports implements crypto.KeySigner.
+func (s *Signer) Supports(alg crypto.SignatureAlgorithm) bool {
+	return false
+}
+
+// GenerateKey implements crypto.KeySigner.
+func (s *Signer) GenerateKey(alg crypto.SignatureAlgorithm) ([]byte, error) {
+	return nil, errUnsupportedPlatform
+}
+
+// Sign implements crypto.KeySigner.
+func (s *Signer) Sign(alg crypto.SignatureAlgorithm, publicKey []byte, data []byte) ([]byte, error) {
+	return nil, errUnsupportedPlatform
+}
+
+// DestroyKey implements crypto.KeySigner.
+func (s *Signer) DestroyKey(alg crypto.SignatureAlgorithm, publicKey []byte) error {
+	return errUnsupportedPlatform
+}
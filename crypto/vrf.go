@@ -100,7 +100,10 @@ func (sk VrfPrivkey) Pubkey() (pk VrfPubkey) {
 	return pk
 }
 
-func (sk VrfPrivkey) proveBytes(msg []byte) (proof VrfProof, ok bool) {
+// ProveBytes constructs a VRF Proof for the raw message bytes, without domain
+// separation. It lets callers reproduce proofs over messages obtained elsewhere,
+// such as the encoded sortition selector of a past round.
+func (sk VrfPrivkey) ProveBytes(msg []byte) (proof VrfProof, ok bool) {
 	// &msg[0] will make Go panic if msg is zero length
 	m := (*C.uchar)(C.NULL)
 	if len(msg) != 0 {
@@ -113,7 +116,7 @@ func (sk VrfPrivkey) proveBytes(msg []byte) (proof VrfProof, ok bool) {
 // Prove constructs a VRF Proof for a given Hashable.
 // ok will be false if the private key is malformed.
 func (sk VrfPrivkey) Prove(message Hashable) (proof VrfProof, ok bool) {
-	return sk.proveBytes(HashRep(message))
+	return sk.ProveBytes(HashRep(message))
 }
 
 // Hash converts a VRF proof to a VRF output without verifying the proof.
@@ -123,7 +126,9 @@ func (proof VrfProof) Hash() (hash VrfOutput, ok bool) {
 	return hash, ret == 0
 }
 
-func (pk VrfPubkey) verifyBytes(proof VrfProof, msg []byte) (bool, VrfOutput) {
+// VerifyBytes checks a VRF proof over the raw message bytes. If the proof is
+// valid the pseudorandom VrfOutput will be returned.
+func (pk VrfPubkey) VerifyBytes(proof VrfProof, msg []byte) (bool, VrfOutput) {
 	var out VrfOutput
 	// &msg[0] will make Go panic if msg is zero length
 	m := (*C.uchar)(C.NULL)
@@ -139,5 +144,5 @@ func (pk VrfPubkey) verifyBytes(proof VrfProof, msg []byte) (bool, VrfOutput) {
 // However, given a public key and message, all valid proofs will yield the same output.
 // Moreover, the output is indistinguishable from random to anyone without the proof or the secret key.
 func (pk VrfPubkey) Verify(p VrfProof, message Hashable) (bool, VrfOutput) {
-	return pk.verifyBytes(p, HashRep(message))
+	return pk.VerifyBytes(p, HashRep(message))
 }
@@ -25,7 +25,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 
@@ -50,7 +52,10 @@ var rawRequestPaths = map[string]bool{
 	"/v2/teal/dryrun":           true,
 	"/v2/teal/compile":          true,
 	"/v2/participation":         true,
+	"/v2/participation/import":  true,
 	"/v2/transactions/simulate": true,
+	"/v2/transactions/batch":    true,
+	"/v2/transactions/private":  true,
 }
 
 // unauthorizedRequestError is generated when we receive 401 error from the server. This error includes the inner error
@@ -82,6 +87,8 @@ func (e HTTPError) Error() string {
 type RestClient struct {
 	serverURL url.URL
 	apiToken  string
+	// timeout bounds the requests of the client, when non-zero
+	timeout time.Duration
 }
 
 // MakeRestClient is the factory for constructing a RestClient for a given endpoint
@@ -92,6 +99,12 @@ func MakeRestClient(url url.URL, apiToken string) RestClient {
 	}
 }
 
+// WithTimeout returns a copy of the client whose requests fail once they take longer than timeout.
+func (client RestClient) WithTimeout(timeout time.Duration) RestClient {
+	client.timeout = timeout
+	return client
+}
+
 // filterASCII filter out the non-ascii printable characters out of the given input string.
 // It's used as a security qualifier before adding network provided data into an error message.
 // The function allows only characters in the range of [32..126], which excludes all the
@@ -217,7 +230,7 @@ func (client RestClient) submitForm(
 		req.Header.Set(authHeader, client.apiToken)
 	}
 
-	httpClient := &http.Client{}
+	httpClient := &http.Client{Timeout: client.timeout}
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return err
@@ -383,6 +396,21 @@ type proofParams struct {
 	HashType string `url:"hashtype"`
 }
 
+type participationSummaryParams struct {
+	Window uint64 `url:"window,omitempty"`
+}
+
+type stateProofStatusParams struct {
+	MaxMissing uint64 `url:"max-missing,omitempty"`
+}
+
+type participationKeyregParams struct {
+	Fee        uint64 `url:"fee,omitempty"`
+	FirstValid uint64 `url:"first-valid,omitempty"`
+	LastValid  uint64 `url:"last-valid,omitempty"`
+	Format     string `url:"format"`
+}
+
 type accountInformationParams struct {
 	Format  string `url:"format"`
 	Exclude string `url:"exclude"`
@@ -415,6 +443,25 @@ func (client RestClient) ApplicationInformation(index uint64) (response model.Ap
 	return
 }
 
+type accountResourcesPageParams struct {
+	Limit uint64 `url:"limit,omitempty"`
+	Next  string `url:"next,omitempty"`
+}
+
+// AccountCreatedAssets gets a page of the assets created by an account. next is the next-token
+// of the previous page, empty for the first page.
+func (client RestClient) AccountCreatedAssets(accountAddress string, limit uint64, next string) (response model.AccountCreatedAssetsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/created-assets", accountAddress), accountResourcesPageParams{limit, next})
+	return
+}
+
+// AccountCreatedApplications gets a page of the applications created by an account. next is the
+// next-token of the previous page, empty for the first page.
+func (client RestClient) AccountCreatedApplications(accountAddress string, limit uint64, next string) (response model.AccountCreatedApplicationsResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/created-applications", accountAddress), accountResourcesPageParams{limit, next})
+	return
+}
+
 type applicationBoxesParams struct {
 	Max uint64 `url:"max,omitempty"`
 }
@@ -447,6 +494,13 @@ func (client RestClient) AccountInformation(address string, includeCreatables bo
 	return
 }
 
+// AccountPendingInformation gets the balance, minimum balance and asset holdings of an account projected after
+// the transactions pending in the transaction pool of the node
+func (client RestClient) AccountPendingInformation(address string) (response model.AccountPendingResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/pending", address), nil)
+	return
+}
+
 // Blob represents arbitrary blob of data satisfying RawResponse interface
 type Blob []byte
 
@@ -540,6 +594,37 @@ func (client RestClient) SendRawTransactionGroup(txgroup []transactions.SignedTx
 	return client.post(&response, "/v2/transactions", nil, enc, false)
 }
 
+// SendPrivateTransactionGroup gets a SignedTxn group and submits it privately to the private relays of the node
+func (client RestClient) SendPrivateTransactionGroup(txgroup []transactions.SignedTxn) (response model.PostPrivateTransactionsResponse, err error) {
+	var enc []byte
+	for _, tx := range txgroup {
+		enc = append(enc, protocol.Encode(&tx)...)
+	}
+	err = client.post(&response, "/v2/transactions/private", nil, enc, false)
+	return
+}
+
+// transactionBatchGroup mirrors model.TransactionBatchGroup, with the transactions msgpack encoded
+type transactionBatchGroup struct {
+	Txns []transactions.SignedTxn `codec:"txns"`
+}
+
+// transactionBatchRequest mirrors model.TransactionBatchRequest
+type transactionBatchRequest struct {
+	TxnGroups []transactionBatchGroup `codec:"txn-groups"`
+}
+
+// SendRawTransactionBatch gets a batch of independent SignedTxn groups and broadcasts them to the network.
+// The response holds the admission result of each of the groups, in order.
+func (client RestClient) SendRawTransactionBatch(txgroups [][]transactions.SignedTxn) (response model.TransactionBatchResponse, err error) {
+	request := transactionBatchRequest{TxnGroups: make([]transactionBatchGroup, len(txgroups))}
+	for i, txgroup := range txgroups {
+		request.TxnGroups[i].Txns = txgroup
+	}
+	err = client.post(&response, "/v2/transactions/batch", nil, protocol.EncodeReflect(&request), false)
+	return
+}
+
 // Block gets the block info for the given round
 func (client RestClient) Block(round uint64) (response model.BlockResponse, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d", round), nil)
@@ -554,6 +639,22 @@ func (client RestClient) RawBlock(round uint64) (response []byte, err error) {
 	return
 }
 
+// RawBlockHeader gets the msgpack encoded header of the block for the given round
+func (client RestClient) RawBlockHeader(round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/blocks/%d/header", round), rawFormat{Format: "msgpack"})
+	response = blob
+	return
+}
+
+// RawBlockCertificate gets the msgpack encoded agreement certificate of the block for the given round
+func (client RestClient) RawBlockCertificate(round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/blocks/%d/certificate", round), rawFormat{Format: "msgpack"})
+	response = blob
+	return
+}
+
 // Shutdown requests the node to shut itself down
 func (client RestClient) Shutdown() (err error) {
 	response := 1
@@ -573,6 +674,42 @@ func (client RestClient) Catchup(catchpointLabel string) (response model.Catchpo
 	return
 }
 
+// apiTokenRequest is the body of a named API token creation request. It is only sent as
+// the json encoded body, hence the omitted url encoding.
+type apiTokenRequest struct {
+	Scopes []string `json:"scopes" url:"-"`
+}
+
+// ListAPITokens lists the named API tokens of the node
+func (client RestClient) ListAPITokens() (response model.APITokensResponse, err error) {
+	err = client.get(&response, "/v2/tokens", nil)
+	return
+}
+
+// CreateAPIToken creates a named API token granted the given scopes
+func (client RestClient) CreateAPIToken(name string, scopes []string) (response model.APITokenResponse, err error) {
+	err = client.post(&response, fmt.Sprintf("/v2/tokens/%s", name), apiTokenRequest{Scopes: scopes}, nil, false)
+	return
+}
+
+// RevokeAPIToken revokes a named API token
+func (client RestClient) RevokeAPIToken(name string) (err error) {
+	err = client.delete(nil, fmt.Sprintf("/v2/tokens/%s", name), nil, true)
+	return
+}
+
+// GetPeers returns the peers the node is connected to
+func (client RestClient) GetPeers() (response model.PeersResponse, err error) {
+	err = client.get(&response, "/v2/peers", nil)
+	return
+}
+
+// GetMetrics returns the metrics registered by the node
+func (client RestClient) GetMetrics() (response model.MetricsResponse, err error) {
+	err = client.get(&response, "/v2/metrics", nil)
+	return
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -584,6 +721,45 @@ func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string,
 	return
 }
 
+// GetProfile gets the named pprof profile of the node, such as "goroutine" or "heap", rendered at the given debug level
+func (client RestClient) GetProfile(ctx context.Context, name string, debug int) (profile []byte, err error) {
+	query := make(map[string]string)
+	query["debug"] = strconv.Itoa(debug)
+
+	result, err := client.doGetWithQuery(ctx, "/debug/pprof/"+name, query)
+	return []byte(result), err
+}
+
+type profileCaptureParams struct {
+	Kind    string `url:"kind"`
+	Seconds uint64 `url:"seconds,omitempty"`
+}
+
+// StartProfileCapture captures a profile of the node, such as "cpu", "trace" or "heap". CPU profiles
+// and execution traces are captured in the background for the given seconds, 0 for the default duration.
+func (client RestClient) StartProfileCapture(kind string, seconds uint64) (response model.ProfileCaptureResponse, err error) {
+	err = client.post(&response, "/v2/debug/profiles", profileCaptureParams{Kind: kind, Seconds: seconds}, nil, false)
+	return
+}
+
+// ProfileCaptures lists the profile captures kept by the node
+func (client RestClient) ProfileCaptures() (response model.ProfileCapturesResponse, err error) {
+	err = client.get(&response, "/v2/debug/profiles", nil)
+	return
+}
+
+// DownloadProfileCapture downloads a finished profile capture
+func (client RestClient) DownloadProfileCapture(captureID uint64) (profile []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/debug/profiles/%d", captureID), nil)
+	return blob, err
+}
+
+// GetMetrics gets the metrics of the node, in the prometheus text format
+func (client RestClient) GetMetrics(ctx context.Context) (metrics string, err error) {
+	return client.doGetWithQuery(ctx, "/metrics", nil)
+}
+
 type compileParams struct {
 	SourceMap bool `url:"sourcemap,omitempty"`
 }
@@ -649,7 +825,7 @@ func (client RestClient) doGetWithQuery(ctx context.Context, path string, queryA
 
 	req.Header.Set(authHeader, client.apiToken)
 
-	httpClient := http.Client{}
+	httpClient := http.Client{Timeout: client.timeout}
 	resp, err := httpClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return
@@ -697,6 +873,12 @@ func (client RestClient) LightBlockHeaderProof(round uint64) (response model.Lig
 	return
 }
 
+// BlockSortition gets the seed and the sortition details of the certificate of the block for the given round.
+func (client RestClient) BlockSortition(round uint64) (response model.BlockSortitionResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d/sortition", round), nil)
+	return
+}
+
 // TransactionProof gets a Merkle proof for a transaction in a block.
 func (client RestClient) TransactionProof(txid string, round uint64, hashType crypto.HashType) (response model.TransactionProofResponse, err error) {
 	txid = stripTransaction(txid)
@@ -722,6 +904,49 @@ func (client RestClient) GetParticipationKeyByID(participationID string) (respon
 	return
 }
 
+// GetParticipationSummary gets the participation summary of the installed participation keys,
+// over a window of recent rounds, or the server's default window when 0.
+func (client RestClient) GetParticipationSummary(window uint64) (response model.ParticipationSummaryResponse, err error) {
+	err = client.get(&response, "/v2/participation/summary", participationSummaryParams{window})
+	return
+}
+
+// GetAccountPerformance gets the proposal and vote performance of the accounts tracked by the node.
+func (client RestClient) GetAccountPerformance() (response model.AccountPerformanceResponse, err error) {
+	err = client.get(&response, "/v2/participation/performance", nil)
+	return
+}
+
+// GetStateProofStatus gets the progress of the node towards forming state proofs, reporting at most
+// maxMissing non-signing voters for every state proof, or the server's default when 0.
+func (client RestClient) GetStateProofStatus(maxMissing uint64) (response model.StateProofStatusResponse, err error) {
+	err = client.get(&response, "/v2/stateproofs/status", stateProofStatusParams{maxMissing})
+	return
+}
+
+// RawParticipationKeyreg gets the msgpack encoded unsigned keyreg transaction registering a participation key.
+// The fee and validity rounds default to the server's when 0.
+func (client RestClient) RawParticipationKeyreg(participationID string, fee, firstValid, lastValid uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/participation/%s/keyreg", participationID), participationKeyregParams{fee, firstValid, lastValid, "msgpack"})
+	response = blob
+	return
+}
+
+// RawExportParticipationKey gets the msgpack encoded secrets of a participation key, to import them on another node.
+func (client RestClient) RawExportParticipationKey(participationID string) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/participation/%s/export", participationID), nil)
+	response = blob
+	return
+}
+
+// ImportParticipationKey sends participation key secrets exported by another node to the node.
+func (client RestClient) ImportParticipationKey(secrets []byte) (response model.PostParticipationResponse, err error) {
+	err = client.post(&response, "/v2/participation/import", nil, secrets, false)
+	return
+}
+
 // RemoveParticipationKeyByID removes a particiption key by its ID
 func (client RestClient) RemoveParticipationKeyByID(participationID string) (err error) {
 	err = client.delete(nil, fmt.Sprintf("/v2/participation/%s", participationID), nil, true)
@@ -736,6 +961,12 @@ func (client RestClient) SetSyncRound(round uint64) (err error) {
 	return
 }
 
+// AcknowledgeSyncRound acknowledges that the consumer processed the round, advancing the sync round past it
+func (client RestClient) AcknowledgeSyncRound(round uint64) (response model.GetSyncRoundResponse, err error) {
+	err = client.post(&response, fmt.Sprintf("/v2/ledger/sync/%d/ack", round), nil, nil, false)
+	return
+}
+
 // UnsetSyncRound deletes the sync round constraint
 func (client RestClient) UnsetSyncRound() (err error) {
 	err = client.delete(nil, "/v2/ledger/sync", nil, true)
@@ -777,3 +1008,28 @@ func (client RestClient) GetBlockTimestampOffset() (response model.GetBlockTimeS
 	err = client.get(&response, "/v2/devmode/blocks/offset", nil)
 	return
 }
+
+type devModeAdvanceParams struct {
+	Rounds uint64  `url:"rounds,omitempty"`
+	Offset *uint64 `url:"offset,omitempty"`
+}
+
+// AdvanceDevModeRounds produces rounds blocks right away when in devmode, their timestamps offset by offset
+// seconds from their previous block when not nil, and returns the round of the last block produced
+func (client RestClient) AdvanceDevModeRounds(rounds uint64, offset *uint64) (response model.DevModeAdvanceResponse, err error) {
+	err = client.post(&response, "/v2/devmode/advance", devModeAdvanceParams{Rounds: rounds, Offset: offset}, nil, false)
+	return
+}
+
+type snapshotParams struct {
+	Path         string `url:"path"`
+	WithPartKeys bool   `url:"with-partkeys,omitempty"`
+}
+
+// CreateSnapshot writes a consistent copy of the data directory of the node into the directory at path, which must not
+// exist, and returns the round of the ledger in the snapshot. The participation keys of the node are only copied when
+// withPartKeys is set.
+func (client RestClient) CreateSnapshot(path string, withPartKeys bool) (response model.SnapshotResponse, err error) {
+	err = client.post(&response, "/v2/snapshot", snapshotParams{Path: path, WithPartKeys: withPartKeys}, nil, false)
+	return
+}
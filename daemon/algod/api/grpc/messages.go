@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The conversions of the ledger objects to the messages of algod.proto. Zero addresses and
+// digests are left empty, as they are omitted in the msgpack encoding.
+
+func addressString(a basics.Address) string {
+	if a.IsZero() {
+		return ""
+	}
+	return a.String()
+}
+
+func digestBytes(d crypto.Digest) []byte {
+	if d.IsZero() {
+		return nil
+	}
+	return d[:]
+}
+
+func blockHeaderMessage(hdr *bookkeeping.BlockHeader) *BlockHeader {
+	return &BlockHeader{
+		Round:                   uint64(hdr.Round),
+		PreviousBlockHash:       digestBytes(crypto.Digest(hdr.Branch)),
+		Seed:                    digestBytes(crypto.Digest(hdr.Seed)),
+		TransactionsRoot:        digestBytes(hdr.NativeSha512_256Commitment),
+		TransactionsRootSha256:  digestBytes(hdr.Sha256Commitment),
+		Timestamp:               hdr.TimeStamp,
+		GenesisId:               hdr.GenesisID,
+		GenesisHash:             digestBytes(hdr.GenesisHash),
+		FeeSink:                 addressString(hdr.FeeSink),
+		RewardsPool:             addressString(hdr.RewardsPool),
+		RewardsLevel:            hdr.RewardsLevel,
+		RewardsRate:             hdr.RewardsRate,
+		RewardsResidue:          hdr.RewardsResidue,
+		RewardsCalculationRound: uint64(hdr.RewardsRecalculationRound),
+		CurrentProtocol:         string(hdr.CurrentProtocol),
+		NextProtocol:            string(hdr.NextProtocol),
+		NextProtocolApprovals:   hdr.NextProtocolApprovals,
+		NextProtocolVoteBefore:  uint64(hdr.NextProtocolVoteBefore),
+		NextProtocolSwitchOn:    uint64(hdr.NextProtocolSwitchOn),
+		TxnCounter:              hdr.TxnCounter,
+	}
+}
+
+func signedTransactionMessage(stxn *transactions.SignedTxn) *SignedTransaction {
+	return &SignedTransaction{
+		Transaction: transactionMessage(&stxn.Txn),
+		AuthAddress: addressString(stxn.AuthAddr),
+		SignedTxn:   protocol.Encode(stxn),
+	}
+}
+
+func transactionMessage(txn *transactions.Transaction) *Transaction {
+	msg := &Transaction{
+		TxId:        txn.ID().String(),
+		Type:        string(txn.Type),
+		Sender:      addressString(txn.Sender),
+		Fee:         txn.Fee.Raw,
+		FirstValid:  uint64(txn.FirstValid),
+		LastValid:   uint64(txn.LastValid),
+		Note:        txn.Note,
+		GenesisId:   txn.GenesisID,
+		GenesisHash: digestBytes(txn.GenesisHash),
+		Group:       digestBytes(txn.Group),
+		RekeyTo:     addressString(txn.RekeyTo),
+	}
+	if txn.Lease != ([32]byte{}) {
+		msg.Lease = txn.Lease[:]
+	}
+
+	switch txn.Type {
+	case protocol.PaymentTx:
+		msg.Fields = &Transaction_Payment{Payment: &PaymentFields{
+			Receiver:         addressString(txn.Receiver),
+			Amount:           txn.Amount.Raw,
+			CloseRemainderTo: addressString(txn.CloseRemainderTo),
+		}}
+	case protocol.AssetTransferTx:
+		msg.Fields = &Transaction_AssetTransfer{AssetTransfer: &AssetTransferFields{
+			AssetId:     uint64(txn.XferAsset),
+			Amount:      txn.AssetAmount,
+			AssetSender: addressString(txn.AssetSender),
+			Receiver:    addressString(txn.AssetReceiver),
+			CloseTo:     addressString(txn.AssetCloseTo),
+		}}
+	}
+	return msg
+}
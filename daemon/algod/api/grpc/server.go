@@ -0,0 +1,297 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package grpc serves the gRPC interface of algod described in algod.proto.
+package grpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative algod.proto
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/algorand/go-algorand/config"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+// TokenMetadata is the gRPC metadata key holding the API token.
+const TokenMetadata = "x-algo-api-token"
+
+// MaxMessageSize is the largest request message accepted by the server.
+const MaxMessageSize = 10 * 1024 * 1024
+
+// methodScopes is the token scope required by each method of the Node service.
+var methodScopes = map[string]tokens.Scope{
+	Node_GetBlocks_FullMethodName:          tokens.ScopeRead,
+	Node_SubmitTransactions_FullMethodName: tokens.ScopeSubmit,
+	Node_SubscribeStatus_FullMethodName:    tokens.ScopeRead,
+}
+
+// NodeInterface is the subset of the node used by the gRPC server.
+type NodeInterface interface {
+	LedgerForAPI() v2.LedgerForAPI
+	Status() (s node.StatusReport, err error)
+	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
+}
+
+// Server serves the Node service of algod.proto.
+type Server struct {
+	UnimplementedNodeServer
+
+	node       NodeInterface
+	log        logging.Logger
+	shutdown   <-chan struct{}
+	apiTokens  [][]byte
+	tokenStore *tokens.Store
+	server     *grpc.Server
+}
+
+// MakeServer creates a gRPC server for the node, serving TLS when tlsConfig is not nil.
+// Requests are authenticated with the api or admin tokens, or with the named tokens of
+// tokenStore granting the read scope for the streaming methods and the submit scope for
+// SubmitTransactions.
+func MakeServer(log logging.Logger, node NodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, tokenStore *tokens.Store, tlsConfig *tls.Config) *Server {
+	s := &Server{
+		node:       node,
+		log:        log,
+		shutdown:   shutdown,
+		apiTokens:  [][]byte{[]byte(apiToken), []byte(adminAPIToken)},
+		tokenStore: tokenStore,
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(MaxMessageSize),
+		grpc.UnaryInterceptor(s.authorizeUnary),
+		grpc.StreamInterceptor(s.authorizeStream),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s.server = grpc.NewServer(opts...)
+	RegisterNodeServer(s.server, s)
+	return s
+}
+
+// Serve accepts the connections of listener until Stop is called.
+func (s *Server) Serve(listener net.Listener) error {
+	return s.server.Serve(listener)
+}
+
+// Stop closes the listeners and the connections of the server.
+func (s *Server) Stop() {
+	s.server.Stop()
+}
+
+func (s *Server) authorizeUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) authorizeStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (s *Server) authorize(ctx context.Context, method string) error {
+	scope, ok := methodScopes[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(TokenMetadata); len(values) > 0 {
+			token = values[0]
+		}
+	}
+	for _, t := range s.apiTokens {
+		if subtle.ConstantTimeCompare([]byte(token), t) == 1 {
+			return nil
+		}
+	}
+	if s.tokenStore != nil && s.tokenStore.Authorize(token, scope) {
+		return nil
+	}
+	return status.Error(codes.Unauthenticated, "invalid API token")
+}
+
+// unavailableDuringCatchup returns the node status, or an error if the node is catching up
+// to a catchpoint, during which the ledger cannot serve blocks nor accept transactions.
+func (s *Server) unavailableDuringCatchup() (node.StatusReport, error) {
+	stat, err := s.node.Status()
+	if err != nil {
+		return stat, status.Errorf(codes.Internal, "failed retrieving node status: %v", err)
+	}
+	if stat.Catchpoint != "" {
+		return stat, status.Error(codes.Unavailable, "operation not available during catchup")
+	}
+	return stat, nil
+}
+
+// GetBlocks implements NodeServer
+func (s *Server) GetBlocks(req *BlocksRequest, stream Node_GetBlocksServer) error {
+	if _, err := s.unavailableDuringCatchup(); err != nil {
+		return err
+	}
+
+	ledger := s.node.LedgerForAPI()
+	rnd := basics.Round(req.Round)
+	for sent := uint64(0); req.Count == 0 || sent < req.Count; sent++ {
+		if rnd > ledger.Latest() {
+			select {
+			case <-ledger.Wait(rnd):
+			case <-stream.Context().Done():
+				return stream.Context().Err()
+			case <-s.shutdown:
+				return status.Error(codes.Unavailable, "service is shutting down")
+			}
+		}
+
+		resp, err := s.blockResponse(ledger, rnd, req.IncludeEncoded)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(resp)
+		if err != nil {
+			return err
+		}
+		rnd++
+	}
+	return nil
+}
+
+func (s *Server) blockResponse(ledger v2.LedgerForAPI, rnd basics.Round, includeEncoded bool) (*BlockResponse, error) {
+	encodedBlock, encodedCert, err := ledger.EncodedBlockCert(rnd)
+	if err != nil {
+		var noEntry ledgercore.ErrNoEntry
+		if errors.As(err, &noEntry) {
+			return nil, status.Errorf(codes.NotFound, "block %d is not available: %v", rnd, err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed retrieving block %d: %v", rnd, err)
+	}
+
+	var blk bookkeeping.Block
+	err = protocol.Decode(encodedBlock, &blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed decoding block %d: %v", rnd, err)
+	}
+	payset, err := blk.DecodePaysetFlat()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed decoding the transactions of block %d: %v", rnd, err)
+	}
+
+	resp := &BlockResponse{
+		Round:        uint64(rnd),
+		Header:       blockHeaderMessage(&blk.BlockHeader),
+		Transactions: make([]*SignedTransaction, len(payset)),
+	}
+	for i := range payset {
+		resp.Transactions[i] = signedTransactionMessage(&payset[i].SignedTxn)
+	}
+	if includeEncoded {
+		resp.Block = encodedBlock
+		resp.Certificate = encodedCert
+	}
+	return resp, nil
+}
+
+// SubmitTransactions implements NodeServer
+func (s *Server) SubmitTransactions(ctx context.Context, req *SubmitTransactionsRequest) (*SubmitTransactionsResponse, error) {
+	stat, err := s.unavailableDuringCatchup()
+	if err != nil {
+		return nil, err
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	if len(req.SignedTxns) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txgroup")
+	}
+	if len(req.SignedTxns) > proto.MaxTxGroupSize {
+		return nil, status.Errorf(codes.InvalidArgument, "max group size is %d", proto.MaxTxGroupSize)
+	}
+	txgroup := make([]transactions.SignedTxn, len(req.SignedTxns))
+	for i, encoded := range req.SignedTxns {
+		err = protocol.Decode(encoded, &txgroup[i])
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to decode transaction %d: %v", i, err)
+		}
+	}
+
+	err = s.node.BroadcastSignedTxGroup(txgroup)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &SubmitTransactionsResponse{TxIds: make([]string, len(txgroup))}
+	for i := range txgroup {
+		resp.TxIds[i] = txgroup[i].ID().String()
+	}
+	return resp, nil
+}
+
+// SubscribeStatus implements NodeServer
+func (s *Server) SubscribeStatus(req *StatusRequest, stream Node_SubscribeStatusServer) error {
+	ledger := s.node.LedgerForAPI()
+	for {
+		stat, err := s.node.Status()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed retrieving node status: %v", err)
+		}
+		err = stream.Send(&StatusResponse{
+			LastRound:                 uint64(stat.LastRound),
+			LastVersion:               string(stat.LastVersion),
+			NextVersion:               string(stat.NextVersion),
+			NextVersionRound:          uint64(stat.NextVersionRound),
+			NextVersionSupported:      stat.NextVersionSupported,
+			TimeSinceLastRound:        int64(stat.TimeSinceLastRound()),
+			CatchupTime:               int64(stat.CatchupTime),
+			StoppedAtUnsupportedRound: stat.StoppedAtUnsupportedRound,
+			Catchpoint:                stat.Catchpoint,
+		})
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ledger.Wait(stat.LastRound + 1):
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-s.shutdown:
+			return status.Error(codes.Unavailable, "service is shutting down")
+		}
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/algorand/go-algorand/crypto"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testToken = "test-token"
+
+var testReceiver = basics.Address{1}
+var testGenesisHash = crypto.Digest{9}
+
+type mockLedger struct {
+	v2.LedgerForAPI
+	latest basics.Round
+}
+
+func (l *mockLedger) Latest() basics.Round {
+	return l.latest
+}
+
+func (l *mockLedger) Wait(r basics.Round) chan struct{} {
+	ch := make(chan struct{})
+	if r <= l.latest {
+		close(ch)
+	}
+	return ch
+}
+
+// EncodedBlockCert returns blocks holding a payment of the round number of microAlgos.
+func (l *mockLedger) EncodedBlockCert(rnd basics.Round) ([]byte, []byte, error) {
+	if rnd == 0 || rnd > l.latest {
+		return nil, nil, ledgercore.ErrNoEntry{Round: rnd, Latest: l.latest, Committed: l.latest}
+	}
+	blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+		Round:       rnd,
+		TimeStamp:   int64(rnd) * 1000,
+		GenesisID:   "test",
+		GenesisHash: testGenesisHash,
+	}}
+	blk.CurrentProtocol = protocol.ConsensusCurrentVersion
+	stxn := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:      basics.Address{2},
+			Fee:         basics.MicroAlgos{Raw: 1000},
+			FirstValid:  rnd,
+			LastValid:   rnd + 10,
+			GenesisID:   "test",
+			GenesisHash: testGenesisHash,
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: testReceiver, Amount: basics.MicroAlgos{Raw: uint64(rnd)}},
+	}}
+	stib, err := blk.EncodeSignedTxn(stxn, transactions.ApplyData{})
+	if err != nil {
+		return nil, nil, err
+	}
+	blk.Payset = transactions.Payset{stib}
+	return protocol.Encode(&blk), []byte{byte(rnd)}, nil
+}
+
+type mockNode struct {
+	ledger    *mockLedger
+	broadcast chan []transactions.SignedTxn
+}
+
+func (n *mockNode) LedgerForAPI() v2.LedgerForAPI {
+	return n.ledger
+}
+
+func (n *mockNode) Status() (node.StatusReport, error) {
+	return node.StatusReport{LastRound: n.ledger.latest, LastVersion: protocol.ConsensusCurrentVersion}, nil
+}
+
+func (n *mockNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error {
+	n.broadcast <- txgroup
+	return nil
+}
+
+// setupTestServer serves a Node service, over TLS when tlsConfig is not nil, and returns its address.
+func setupTestServer(t *testing.T, shutdown chan struct{}, tlsConfig *tls.Config) (*mockNode, string) {
+	n := &mockNode{ledger: &mockLedger{latest: 3}, broadcast: make(chan []transactions.SignedTxn, 1)}
+	srv := MakeServer(logging.TestingLog(t), n, shutdown, testToken, "admin-token", nil, tlsConfig)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+	return n, listener.Addr().String()
+}
+
+func dial(t *testing.T, addr string, creds credentials.TransportCredentials) NodeClient {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return NewNodeClient(conn)
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), TokenMetadata, token)
+}
+
+func receiveBlocks(stream Node_GetBlocksClient) ([]*BlockResponse, error) {
+	var blocks []*BlockResponse
+	for {
+		blk, err := stream.Recv()
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return blocks, err
+		}
+		blocks = append(blocks, blk)
+	}
+}
+
+func TestGetBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, addr := setupTestServer(t, make(chan struct{}), nil)
+	client := dial(t, addr, insecure.NewCredentials())
+
+	stream, err := client.GetBlocks(withToken(testToken), &BlocksRequest{Round: 2, Count: 2})
+	require.NoError(t, err)
+	blocks, err := receiveBlocks(stream)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	for i, blk := range blocks {
+		rnd := uint64(2 + i)
+		require.Equal(t, rnd, blk.Round)
+		require.Equal(t, rnd, blk.Header.Round)
+		require.Equal(t, int64(rnd)*1000, blk.Header.Timestamp)
+		require.Equal(t, "test", blk.Header.GenesisId)
+		require.Equal(t, string(protocol.ConsensusCurrentVersion), blk.Header.CurrentProtocol)
+		require.Len(t, blk.Transactions, 1)
+
+		txn := blk.Transactions[0].Transaction
+		require.Equal(t, string(protocol.PaymentTx), txn.Type)
+		require.Equal(t, basics.Address{2}.String(), txn.Sender)
+		require.Equal(t, uint64(1000), txn.Fee)
+		require.Equal(t, "test", txn.GenesisId)
+		require.Equal(t, testGenesisHash[:], txn.GenesisHash)
+		require.Equal(t, testReceiver.String(), txn.GetPayment().Receiver)
+		require.Equal(t, rnd, txn.GetPayment().Amount)
+		require.Empty(t, txn.GetPayment().CloseRemainderTo)
+
+		var stxn transactions.SignedTxn
+		require.NoError(t, protocol.Decode(blk.Transactions[0].SignedTxn, &stxn))
+		require.Equal(t, stxn.ID().String(), txn.TxId)
+
+		// the msgpack encoded block is only sent on request
+		require.Empty(t, blk.Block)
+		require.Empty(t, blk.Certificate)
+	}
+
+	stream, err = client.GetBlocks(withToken(testToken), &BlocksRequest{Round: 3, Count: 1, IncludeEncoded: true})
+	require.NoError(t, err)
+	blocks, err = receiveBlocks(stream)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	var blk bookkeeping.Block
+	require.NoError(t, protocol.Decode(blocks[0].Block, &blk))
+	require.Equal(t, basics.Round(3), blk.Round())
+	require.Equal(t, []byte{3}, blocks[0].Certificate)
+
+	// the block of round 0 is not available
+	stream, err = client.GetBlocks(withToken(testToken), &BlocksRequest{Round: 0, Count: 1})
+	require.NoError(t, err)
+	blocks, err = receiveBlocks(stream)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Empty(t, blocks)
+
+	stream, err = client.GetBlocks(withToken("bad-token"), &BlocksRequest{Round: 2, Count: 1})
+	require.NoError(t, err)
+	blocks, err = receiveBlocks(stream)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Empty(t, blocks)
+}
+
+func TestSubmitTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	n, addr := setupTestServer(t, make(chan struct{}), nil)
+	client := dial(t, addr, insecure.NewCredentials())
+
+	stxn := transactions.SignedTxn{Txn: transactions.Transaction{Type: protocol.PaymentTx}}
+	resp, err := client.SubmitTransactions(withToken(testToken), &SubmitTransactionsRequest{SignedTxns: [][]byte{protocol.Encode(&stxn)}})
+	require.NoError(t, err)
+	require.Equal(t, []string{stxn.ID().String()}, resp.TxIds)
+	require.Equal(t, []transactions.SignedTxn{stxn}, <-n.broadcast)
+
+	_, err = client.SubmitTransactions(withToken(testToken), &SubmitTransactionsRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.SubmitTransactions(withToken(testToken), &SubmitTransactionsRequest{SignedTxns: [][]byte{{0xff}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.SubmitTransactions(withToken("bad-token"), &SubmitTransactionsRequest{SignedTxns: [][]byte{protocol.Encode(&stxn)}})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Empty(t, n.broadcast)
+}
+
+func TestSubscribeStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	shutdown := make(chan struct{})
+	close(shutdown)
+	_, addr := setupTestServer(t, shutdown, nil)
+	client := dial(t, addr, insecure.NewCredentials())
+
+	// the status is sent once before the stream ends on shutdown
+	stream, err := client.SubscribeStatus(withToken(testToken), &StatusRequest{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.LastRound)
+	require.Equal(t, string(protocol.ConsensusCurrentVersion), resp.LastVersion)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServeTLS(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// borrow the certificate of httptest, which is valid for 127.0.0.1
+	certServer := httptest.NewTLSServer(nil)
+	cert := certServer.TLS.Certificates[0]
+	roots := x509.NewCertPool()
+	roots.AddCert(certServer.Certificate())
+	certServer.Close()
+
+	_, addr := setupTestServer(t, make(chan struct{}), &tls.Config{Certificates: []tls.Certificate{cert}})
+	client := dial(t, addr, credentials.NewTLS(&tls.Config{RootCAs: roots}))
+	stream, err := client.GetBlocks(withToken(testToken), &BlocksRequest{Round: 1, Count: 1})
+	require.NoError(t, err)
+	blocks, err := receiveBlocks(stream)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+
+	// the certificate is not trusted by default
+	untrusted := dial(t, addr, credentials.NewTLS(&tls.Config{}))
+	_, err = untrusted.SubmitTransactions(withToken(testToken), &SubmitTransactionsRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// nor can a plaintext client talk to the server
+	plaintext := dial(t, addr, insecure.NewCredentials())
+	_, err = plaintext.SubmitTransactions(withToken(testToken), &SubmitTransactionsRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
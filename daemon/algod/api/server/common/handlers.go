@@ -49,7 +49,7 @@ func GenesisJSON(ctx lib.ReqContext, context echo.Context) {
 	w := context.Response().Writer
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(lib.GenesisJSONText))
+	w.Write([]byte(lib.GenesisJSONText(ctx.Node.GenesisID())))
 }
 
 // SwaggerJSON is an httpHandler for route GET /swagger.json
@@ -78,6 +78,7 @@ func HealthCheck(ctx lib.ReqContext, context echo.Context) {
 	// swagger:operation GET /health HealthCheck
 	//---
 	//     Summary: Returns OK if healthy.
+	//     Description: The response details the health of each component of the node.
 	//     Produces:
 	//     - application/json
 	//     Schemes:
@@ -89,7 +90,7 @@ func HealthCheck(ctx lib.ReqContext, context echo.Context) {
 	w := context.Response().Writer
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(nil)
+	json.NewEncoder(w).Encode(ctx.Node.Health())
 }
 
 // Ready is a httpHandler for route GET /ready
@@ -98,6 +99,7 @@ func Ready(ctx lib.ReqContext, context echo.Context) {
 	// swagger:operation GET /ready Ready
 	//---
 	//     Summary: Returns OK if healthy and fully caught up.
+	//     Description: The response details the health of each component of the node.
 	//     Produces:
 	//     - application/json
 	//     Schemes:
@@ -146,7 +148,7 @@ func Ready(ctx lib.ReqContext, context echo.Context) {
 	}
 
 	w.WriteHeader(code)
-	_ = json.NewEncoder(w).Encode(nil)
+	_ = json.NewEncoder(w).Encode(ctx.Node.Health())
 }
 
 // VersionsHandler is an httpHandler for route GET /versions
@@ -17,6 +17,7 @@
 package test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -58,9 +59,9 @@ func TestMockNodeStatus(t *testing.T) {
 }
 
 func readyEndpointTestHelper(
-	t *testing.T, node *mockNode, expectedCode int) {
+	t *testing.T, mockNodeInstance *mockNode, expectedCode int) {
 	reqCtx := lib.ReqContext{
-		Node:     node,
+		Node:     mockNodeInstance,
 		Log:      logging.NewLogger(),
 		Shutdown: make(chan struct{}),
 	}
@@ -72,6 +73,11 @@ func readyEndpointTestHelper(
 
 	common.Ready(reqCtx, c)
 	require.Equal(t, expectedCode, rec.Code)
+
+	// the response details the health of the components
+	var report node.HealthReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.Equal(t, mockNodeInstance.Health(), report)
 }
 
 func TestReadyEndpoint(t *testing.T) {
@@ -86,3 +92,28 @@ func TestReadyEndpoint(t *testing.T) {
 	mockNodeInstance.catchupStatus = StoppedAtUnsupported
 	readyEndpointTestHelper(t, mockNodeInstance, http.StatusInternalServerError)
 }
+
+func TestHealthEndpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockNodeInstance := makeMockNode(CatchingUpFast)
+	reqCtx := lib.ReqContext{
+		Node:     mockNodeInstance,
+		Log:      logging.NewLogger(),
+		Shutdown: make(chan struct{}),
+	}
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/health", nil), rec)
+
+	// a degraded node is still healthy
+	common.HealthCheck(reqCtx, c)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var report node.HealthReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.Equal(t, node.HealthDegraded, report.Status)
+	require.Len(t, report.Components, 1)
+	require.Equal(t, []string{node.HealthReasonCatchpointCatchup}, report.Components[0].Reasons)
+}
@@ -134,6 +134,15 @@ func (m *mockNode) Status() (s node.StatusReport, err error) {
 	return
 }
 
+func (m *mockNode) Health() node.HealthReport {
+	ledger := node.ComponentHealth{Name: node.HealthComponentLedger, Status: node.HealthOK}
+	if m.catchupStatus == CatchingUpFast {
+		ledger.Status = node.HealthDegraded
+		ledger.Reasons = []string{node.HealthReasonCatchpointCatchup}
+	}
+	return node.HealthReport{Status: ledger.Status, Components: []node.ComponentHealth{ledger}}
+}
+
 func (m *mockNode) GenesisID() string { panic("not implemented") }
 
 func (m *mockNode) GenesisHash() crypto.Digest { panic("not implemented") }
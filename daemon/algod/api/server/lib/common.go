@@ -18,6 +18,7 @@ package lib
 
 import (
 	"net/http"
+	"sync"
 
 	"github.com/labstack/echo/v4"
 
@@ -26,14 +27,28 @@ import (
 	"github.com/algorand/go-algorand/node"
 )
 
-// GenesisJSONText is initialized when the node starts.
-var GenesisJSONText string
+// genesisJSONTexts maps the genesis ID of each network served by the process to its genesis file,
+// set when its node starts.
+var genesisJSONTexts sync.Map
+
+// SetGenesisJSONText sets the genesis file of a network served by the process.
+func SetGenesisJSONText(genesisID string, text string) {
+	genesisJSONTexts.Store(genesisID, text)
+}
+
+// GenesisJSONText returns the genesis file of a network served by the process.
+func GenesisJSONText(genesisID string) string {
+	text, _ := genesisJSONTexts.Load(genesisID)
+	s, _ := text.(string)
+	return s
+}
 
 // NodeInterface defines the node's methods required by the common APIs
 type NodeInterface interface {
 	GenesisHash() crypto.Digest
 	GenesisID() string
 	Status() (s node.StatusReport, err error)
+	Health() node.HealthReport
 }
 
 // HandlerFunc defines a wrapper for http.HandlerFunc that includes a context
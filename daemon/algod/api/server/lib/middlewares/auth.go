@@ -35,6 +35,15 @@ const urlAuthFormatter = "/urlAuth/%s"
 // InvalidTokenMessage is the message set when an invalid / missing token is found.
 const InvalidTokenMessage = "Invalid API Token"
 
+// authenticatedTokenKey is the context key of the token the request was authenticated with.
+const authenticatedTokenKey = "authenticatedToken"
+
+// AuthenticatedToken returns the token the request was authenticated with, if any.
+func AuthenticatedToken(ctx echo.Context) string {
+	token, _ := ctx.Get(authenticatedTokenKey).(string)
+	return token
+}
+
 // AuthMiddleware provides some data to the handler.
 type AuthMiddleware struct {
 	// Header is the token header which needs to be provided. For example 'X-Algod-API-Token'.
@@ -42,18 +51,31 @@ type AuthMiddleware struct {
 
 	// Tokens is the set of tokens which can be set to allow access.
 	tokens [][]byte
+
+	// Authorizer optionally allows access with tokens which are not part of the static set.
+	authorizer TokenAuthorizer
 }
 
+// TokenAuthorizer reports whether the provided token may be used for the request.
+type TokenAuthorizer func(ctx echo.Context, token string) bool
+
 // MakeAuth constructs the auth middleware function
 func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
+	return MakeScopedAuth(header, tokens, nil)
+}
+
+// MakeScopedAuth constructs an auth middleware function which accepts the given tokens,
+// as well as the tokens allowed by the authorizer, if any.
+func MakeScopedAuth(header string, tokens []string, authorizer TokenAuthorizer) echo.MiddlewareFunc {
 	apiTokenBytes := make([][]byte, 0)
 	for _, token := range tokens {
 		apiTokenBytes = append(apiTokenBytes, []byte(token))
 	}
 
 	auth := AuthMiddleware{
-		header: header,
-		tokens: apiTokenBytes,
+		header:     header,
+		tokens:     apiTokenBytes,
+		authorizer: authorizer,
 	}
 
 	return auth.handler
@@ -97,10 +119,16 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 		for _, tokenBytes := range auth.tokens {
 			if subtle.ConstantTimeCompare(providedToken, tokenBytes) == 1 {
 				// Token was correct, keep serving request
+				ctx.Set(authenticatedTokenKey, string(providedToken))
 				return next(ctx)
 			}
 		}
 
+		if auth.authorizer != nil && len(providedToken) > 0 && auth.authorizer(ctx, string(providedToken)) {
+			ctx.Set(authenticatedTokenKey, string(providedToken))
+			return next(ctx)
+		}
+
 		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
 	}
 }
@@ -189,3 +189,35 @@ func TestAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestScopedAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	authorizer := func(ctx echo.Context, token string) bool {
+		return token == "scoped" && ctx.Request().Method == "GET"
+	}
+	handler := MakeScopedAuth(testAPIHeader, []string{"token1"}, authorizer)(success)
+
+	tests := []struct {
+		token          string
+		method         string
+		expectResponse error
+	}{
+		{"token1", "GET", errSuccess},
+		{"token1", "POST", errSuccess},
+		{"scoped", "GET", errSuccess},
+		{"scoped", "POST", invalidTokenError},
+		{"invalid_token", "GET", invalidTokenError},
+		{"", "GET", invalidTokenError},
+	}
+
+	for _, test := range tests {
+		req, _ := http.NewRequest(test.method, "N/A", nil)
+		req.Header.Set(testAPIHeader, test.token)
+		ctx := e.NewContext(req, nil)
+		ctx.SetPath("")
+
+		err := handler(ctx)
+		require.Equal(t, test.expectResponse, err, "%s %s", test.method, test.token)
+	}
+}
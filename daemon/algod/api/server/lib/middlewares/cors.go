@@ -23,11 +23,18 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 )
 
-// MakeCORS sets up CORS with a token header.
-func MakeCORS(tokenHeader string) echo.MiddlewareFunc {
+// MakeCORS sets up CORS with a token header, allowing the cross-origin requests from the given
+// origins, "*" allowing any origin. When no origin is allowed, CORS requests are not answered.
+func MakeCORS(tokenHeader string, allowedOrigins []string) echo.MiddlewareFunc {
+	if len(allowedOrigins) == 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
 	return middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowHeaders: []string{tokenHeader, "Content-Type"},
-		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
+		AllowOrigins:  allowedOrigins,
+		AllowHeaders:  []string{tokenHeader, "Content-Type", RequestIDHeader},
+		AllowMethods:  []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
+		ExposeHeaders: []string{RequestIDHeader},
 	})
 }
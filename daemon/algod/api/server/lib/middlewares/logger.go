@@ -17,7 +17,8 @@
 package middlewares
 
 import (
-	"strconv"
+	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -28,12 +29,19 @@ import (
 // LoggerMiddleware provides some extra state to the logger middleware
 type LoggerMiddleware struct {
 	log log.Logger
+
+	// sampling is the number of requests for each logged request, 0 disabling the logs
+	sampling uint64
+	requests atomic.Uint64
 }
 
-// MakeLogger initializes the logger middleware function
-func MakeLogger(log log.Logger) echo.MiddlewareFunc {
-	logger := LoggerMiddleware{
-		log: log,
+// MakeLogger initializes the access logger middleware function. One of every sampling requests
+// is logged, and sampling 0 disables the logs, except for the requests failing with a server
+// error which are always logged.
+func MakeLogger(log log.Logger, sampling uint64) echo.MiddlewareFunc {
+	logger := &LoggerMiddleware{
+		log:      log,
+		sampling: sampling,
 	}
 
 	return logger.handler
@@ -53,20 +61,30 @@ func (logger *LoggerMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc
 			ctx.Error(err)
 		}
 
-		logger.log.Infof("%s %s %s [%v] \"%s %s %s\" %d %s \"%s\" %s",
-			req.RemoteAddr,
-			"-",
-			"-",
-			start,
-			req.Method,
-			req.RequestURI,
-			req.Proto, // string "HTTP/1.1"
-			res.Status,
-			strconv.FormatInt(res.Size, 10), // bytes_out
-			req.UserAgent(),
-			time.Since(start),
-		)
+		if !logger.sampled() && res.Status < http.StatusInternalServerError {
+			return
+		}
+
+		logger.log.WithFields(log.Fields{
+			"remoteAddr": req.RemoteAddr,
+			"requestID":  RequestID(ctx),
+			"method":     req.Method,
+			"uri":        req.RequestURI,
+			"proto":      req.Proto,
+			"status":     res.Status,
+			"bytesOut":   res.Size,
+			"userAgent":  req.UserAgent(),
+			"duration":   time.Since(start).String(),
+		}).Infof("%s %s %d", req.Method, req.RequestURI, res.Status)
 
 		return
 	}
 }
+
+// sampled returns true if the current request is one of the sampled requests.
+func (logger *LoggerMiddleware) sampled() bool {
+	if logger.sampling == 0 {
+		return false
+	}
+	return logger.requests.Add(1)%logger.sampling == 0
+}
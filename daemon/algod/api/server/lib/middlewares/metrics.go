@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var restRequestDuration = metrics.MakeHistogram(metrics.RestRequestDuration, "endpoint", metrics.DefaultLatencyBuckets)
+
+// unmatchedEndpoint is the endpoint label of the requests which did not match any route,
+// so that unknown paths do not each create a new label value.
+const unmatchedEndpoint = "unmatched"
+
+// MakeRequestMetrics constructs a middleware recording the latency of each request,
+// labeled by the method and route of the endpoint it was served by.
+func MakeRequestMetrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+
+			endpoint := unmatchedEndpoint
+			if path := ctx.Path(); path != "" && path != "/*" {
+				endpoint = ctx.Request().Method + " " + path
+			}
+			restRequestDuration.ObserveSince(endpoint, start)
+			return err
+		}
+	}
+}
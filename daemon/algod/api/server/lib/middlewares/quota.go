@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var restQuotaRejected = metrics.MakeCounter(metrics.RestQuotaRejectedTotal)
+
+// QuotaExceededMessage is the message of the requests rejected for exceeding the request budget of their token.
+const QuotaExceededMessage = "Request quota exceeded"
+
+// ConcurrencyExceededMessage is the message of the requests rejected for exceeding the concurrent requests of their token.
+const ConcurrencyExceededMessage = "Too many concurrent requests"
+
+// QuotaConfig configures the quotas of the API tokens.
+type QuotaConfig struct {
+	// RequestsPerSecond is the rate at which the request budget of a token refills, 0 disabling the budgets.
+	RequestsPerSecond uint64
+
+	// Burst is the size of the request budget of a token, RequestsPerSecond when 0.
+	Burst uint64
+
+	// ConcurrentRequests is the number of requests a token may have in flight, 0 meaning no limit.
+	ConcurrentRequests uint64
+
+	// EndpointCosts are the costs charged to the request budget by the endpoints, keyed by method and route
+	// as in "GET /v2/accounts/:address". The other endpoints cost 1.
+	EndpointCosts map[string]uint64
+}
+
+// Enabled reports whether the config limits the requests of the tokens at all.
+func (cfg QuotaConfig) Enabled() bool {
+	return cfg.RequestsPerSecond > 0 || cfg.ConcurrentRequests > 0
+}
+
+// ParseEndpointCosts parses a comma delimited list of endpoint costs of the form "GET /v2/accounts/:address=5".
+func ParseEndpointCosts(list string) (map[string]uint64, error) {
+	costs := make(map[string]uint64)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		i := strings.LastIndex(entry, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid endpoint cost %q: expected the form \"GET /v2/status=2\"", entry)
+		}
+		method, path, found := strings.Cut(strings.TrimSpace(entry[:i]), " ")
+		path = strings.TrimSpace(path)
+		if !found || method == "" || !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("invalid endpoint cost %q: expected the form \"GET /v2/status=2\"", entry)
+		}
+		cost, err := strconv.ParseUint(strings.TrimSpace(entry[i+1:]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint cost %q: %w", entry, err)
+		}
+		costs[strings.ToUpper(method)+" "+path] = cost
+	}
+	return costs, nil
+}
+
+// tokenQuota is the state of the quota of a token.
+type tokenQuota struct {
+	budget   float64
+	updated  time.Time
+	inFlight uint64
+}
+
+// QuotaLimiter admits the requests of each API token within its request budget and concurrent requests.
+// A limiter is shared by the listeners serving the same tokens, so that a token gets the same quota over all of them.
+type QuotaLimiter struct {
+	cfg    QuotaConfig
+	exempt [][]byte
+
+	mu     deadlock.Mutex
+	quotas map[string]*tokenQuota
+}
+
+// MakeQuotaLimiter makes a quota limiter with the given config, which does not limit the exempt tokens.
+func MakeQuotaLimiter(cfg QuotaConfig, exempt ...string) *QuotaLimiter {
+	if cfg.Burst == 0 {
+		cfg.Burst = cfg.RequestsPerSecond
+	}
+	limiter := &QuotaLimiter{
+		cfg:    cfg,
+		quotas: make(map[string]*tokenQuota),
+	}
+	for _, token := range exempt {
+		limiter.exempt = append(limiter.exempt, []byte(token))
+	}
+	return limiter
+}
+
+// Middleware makes an echo middleware enforcing the quotas of the tokens the requests were authenticated with,
+// which must come after the auth middleware. The rejected requests are answered with 429 Too Many Requests,
+// and a Retry-After header telling when to retry.
+func (l *QuotaLimiter) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			token := AuthenticatedToken(ctx)
+			if token == "" || l.isExempt(token) {
+				return next(ctx)
+			}
+
+			cost := uint64(1)
+			if c, ok := l.cfg.EndpointCosts[ctx.Request().Method+" "+ctx.Path()]; ok {
+				cost = c
+			}
+			retryAfter, message := l.admit(token, cost, time.Now())
+			if message != "" {
+				restQuotaRejected.Inc(nil)
+				ctx.Response().Header().Set("Retry-After", strconv.FormatUint(retryAfter, 10))
+				return echo.NewHTTPError(http.StatusTooManyRequests, message)
+			}
+			if l.cfg.ConcurrentRequests > 0 {
+				defer l.release(token)
+			}
+			return next(ctx)
+		}
+	}
+}
+
+func (l *QuotaLimiter) isExempt(token string) bool {
+	for _, exempt := range l.exempt {
+		if subtle.ConstantTimeCompare([]byte(token), exempt) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// admit charges the cost of a request to the quota of token. When the request is rejected, it returns
+// the message to answer it with and the number of seconds after which to retry.
+func (l *QuotaLimiter) admit(token string, cost uint64, now time.Time) (retryAfter uint64, message string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	quota, ok := l.quotas[token]
+	if !ok {
+		quota = &tokenQuota{budget: float64(l.cfg.Burst), updated: now}
+		l.quotas[token] = quota
+	}
+
+	if l.cfg.ConcurrentRequests > 0 && quota.inFlight >= l.cfg.ConcurrentRequests {
+		return 1, ConcurrencyExceededMessage
+	}
+
+	if l.cfg.RequestsPerSecond > 0 {
+		rate := float64(l.cfg.RequestsPerSecond)
+		if elapsed := now.Sub(quota.updated); elapsed > 0 {
+			quota.budget = math.Min(float64(l.cfg.Burst), quota.budget+elapsed.Seconds()*rate)
+			quota.updated = now
+		}
+		// a request costing more than the whole budget takes all of it, rather than never being admitted.
+		charge := math.Min(float64(cost), float64(l.cfg.Burst))
+		if quota.budget < charge {
+			return uint64(math.Ceil((charge - quota.budget) / rate)), QuotaExceededMessage
+		}
+		quota.budget -= charge
+	}
+
+	if l.cfg.ConcurrentRequests > 0 {
+		quota.inFlight++
+	}
+	return 0, ""
+}
+
+func (l *QuotaLimiter) release(token string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if quota, ok := l.quotas[token]; ok && quota.inFlight > 0 {
+		quota.inFlight--
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const quotaTestHeader = "X-Algod-API-Token"
+
+func makeQuotaTestRouter(limiter *middlewares.QuotaLimiter, handler echo.HandlerFunc) *echo.Echo {
+	e := echo.New()
+	auth := middlewares.MakeAuth(quotaTestHeader, []string{"admin", "tenant1", "tenant2"})
+	e.GET("/v2/status", handler, auth, limiter.Middleware())
+	e.GET("/v2/accounts/:address", handler, auth, limiter.Middleware())
+	return e
+}
+
+func quotaTestRequest(e *echo.Echo, path string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(quotaTestHeader, token)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestParseEndpointCosts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	costs, err := middlewares.ParseEndpointCosts("")
+	require.NoError(t, err)
+	require.Empty(t, costs)
+
+	costs, err = middlewares.ParseEndpointCosts("GET /v2/accounts/:address=5, post /v2/teal/dryrun = 20,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"GET /v2/accounts/:address": 5, "POST /v2/teal/dryrun": 20}, costs)
+
+	for _, invalid := range []string{"GET /v2/status", "/v2/status=2", "GET v2/status=2", "GET /v2/status=-1", "GET /v2/status=x"} {
+		_, err = middlewares.ParseEndpointCosts(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestQuotaLimiterRequestBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	limiter := middlewares.MakeQuotaLimiter(middlewares.QuotaConfig{
+		RequestsPerSecond: 1,
+		Burst:             3,
+		EndpointCosts:     map[string]uint64{"GET /v2/accounts/:address": 2},
+	}, "admin")
+	e := makeQuotaTestRouter(limiter, func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	require.Equal(t, http.StatusOK, quotaTestRequest(e, "/v2/accounts/A", "tenant1").Code)
+	require.Equal(t, http.StatusOK, quotaTestRequest(e, "/v2/status", "tenant1").Code)
+	rec := quotaTestRequest(e, "/v2/status", "tenant1")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.Contains(t, rec.Body.String(), middlewares.QuotaExceededMessage)
+
+	// the budgets are per token, and the admin token has none
+	require.Equal(t, http.StatusOK, quotaTestRequest(e, "/v2/accounts/A", "tenant2").Code)
+	for i := 0; i < 10; i++ {
+		require.Equal(t, http.StatusOK, quotaTestRequest(e, "/v2/status", "admin").Code)
+	}
+
+	// the requests which are not authenticated are not charged
+	require.Equal(t, http.StatusUnauthorized, quotaTestRequest(e, "/v2/status", "unknown").Code)
+}
+
+func TestQuotaLimiterConcurrentRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	limiter := middlewares.MakeQuotaLimiter(middlewares.QuotaConfig{ConcurrentRequests: 2}, "admin")
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+	e := makeQuotaTestRouter(limiter, func(ctx echo.Context) error {
+		if middlewares.AuthenticatedToken(ctx) == "tenant1" {
+			started <- struct{}{}
+			<-release
+		}
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	var wg sync.WaitGroup
+	codes := make([]int, 2)
+	for i := range codes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i] = quotaTestRequest(e, "/v2/status", "tenant1").Code
+		}(i)
+		<-started
+	}
+
+	rec := quotaTestRequest(e, "/v2/status", "tenant1")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.Contains(t, rec.Body.String(), middlewares.ConcurrencyExceededMessage)
+
+	// another token is not limited by the requests of the first one
+	require.Equal(t, http.StatusOK, quotaTestRequest(e, "/v2/status", "tenant2").Code)
+
+	close(release)
+	wg.Wait()
+	require.Equal(t, []int{http.StatusOK, http.StatusOK}, codes)
+
+	// the requests in flight are released once served
+	require.Equal(t, http.StatusOK, quotaTestRequest(e, "/v2/status", "tenant1").Code)
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestIDHeader is the header carrying the ID of a request, which is echoed in the response
+// and included in the access logs so that a request can be traced across proxies and logs.
+const RequestIDHeader = echo.HeaderXRequestID
+
+// requestIDContextKey is the key of the request ID in the echo context.
+const requestIDContextKey = "requestID"
+
+// maxRequestIDLength bounds the length of the request IDs accepted from the clients.
+const maxRequestIDLength = 128
+
+// MakeRequestID constructs a middleware propagating the request ID provided by the client,
+// or generating a new one when it is missing or invalid.
+func MakeRequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			id := ctx.Request().Header.Get(RequestIDHeader)
+			if !validRequestID(id) {
+				id = newRequestID()
+			}
+			ctx.Set(requestIDContextKey, id)
+			ctx.Response().Header().Set(RequestIDHeader, id)
+			return next(ctx)
+		}
+	}
+}
+
+// RequestID returns the ID of the request set by the request ID middleware, if any.
+func RequestID(ctx echo.Context) string {
+	id, _ := ctx.Get(requestIDContextKey).(string)
+	return id
+}
+
+// validRequestID only accepts printable ASCII IDs of bounded length, so that the IDs
+// provided by the clients can safely be logged.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	var b [16]byte
+	_, err := rand.Read(b[:])
+	if err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b[:])
+}
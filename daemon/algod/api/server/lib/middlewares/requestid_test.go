@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRequestID(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	e := echo.New()
+	var seen string
+	handler := middlewares.MakeRequestID()(func(ctx echo.Context) error {
+		seen = middlewares.RequestID(ctx)
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	// the ID provided by the client is propagated
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(middlewares.RequestIDHeader, "client-id")
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler(e.NewContext(req, rec)))
+	require.Equal(t, "client-id", seen)
+	require.Equal(t, "client-id", rec.Header().Get(middlewares.RequestIDHeader))
+
+	// missing or invalid IDs are replaced
+	for _, id := range []string{"", "bad id", strings.Repeat("a", 129)} {
+		req = httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(middlewares.RequestIDHeader, id)
+		rec = httptest.NewRecorder()
+		require.NoError(t, handler(e.NewContext(req, rec)))
+		require.NotEqual(t, id, seen)
+		require.Len(t, seen, 32)
+		require.Equal(t, seen, rec.Header().Get(middlewares.RequestIDHeader))
+	}
+}
+
+func TestCORS(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	e := echo.New()
+	handler := func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	}
+
+	request := func(mw echo.MiddlewareFunc, origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(echo.HeaderOrigin, origin)
+		rec := httptest.NewRecorder()
+		require.NoError(t, mw(handler)(e.NewContext(req, rec)))
+		return rec
+	}
+
+	allowlist := middlewares.MakeCORS("X-Algo-API-Token", []string{"https://allowed.example"})
+	rec := request(allowlist, "https://allowed.example")
+	require.Equal(t, "https://allowed.example", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	rec = request(allowlist, "https://other.example")
+	require.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+
+	disabled := middlewares.MakeCORS("X-Algo-API-Token", nil)
+	rec = request(disabled, "https://allowed.example")
+	require.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -53,6 +53,8 @@ const (
 	TokenHeader = "X-Algo-API-Token"
 	// MaxRequestBodyBytes is the maximum request body size that we allow in our APIs.
 	MaxRequestBodyBytes = "10MB"
+	// DeadlockReportsPath is the path of the debug endpoint serving the reports of the recent potential deadlocks.
+	DeadlockReportsPath = "/debug/deadlocks"
 )
 
 // wrapCtx passes a common context to each request without a global variable.
@@ -71,8 +73,114 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 	}
 }
 
+// commonRoutes returns the common routes served under policy.
+func commonRoutes(policy ListenerPolicy) lib.Routes {
+	if !policy.NoMetrics {
+		return common.Routes
+	}
+	var routes lib.Routes
+	for _, route := range common.Routes {
+		if route.Name != "metrics" {
+			routes = append(routes, route)
+		}
+	}
+	return routes
+}
+
+// operationScopes are the scopes required of the named tokens by the endpoints which need another scope than the
+// one of their group and method, keyed by method and route path.
+var operationScopes = map[string]tokens.Scope{
+	// the debugging endpoints of the participation group reveal the internal state of the node
+	http.MethodGet + " /v2/debug/replay-rejections": tokens.ScopeAdmin,
+	http.MethodGet + " /v2/stateproofs/status":      tokens.ScopeAdmin,
+}
+
+// scopedTokens returns an authorizer accepting the named tokens of the store granted readScope
+// for GET requests, and writeScope for any other request, unless operationScopes requires another
+// scope for the endpoint.
+func scopedTokens(store *tokens.Store, readScope, writeScope tokens.Scope) middlewares.TokenAuthorizer {
+	if store == nil {
+		return nil
+	}
+	return func(ctx echo.Context, token string) bool {
+		scope := writeScope
+		if ctx.Request().Method == http.MethodGet || ctx.Request().Method == http.MethodHead {
+			scope = readScope
+		}
+		if s, ok := operationScopes[ctx.Request().Method+" "+ctx.Path()]; ok {
+			scope = s
+		}
+		return store.Authorize(token, scope)
+	}
+}
+
+// publicAuth returns the auth middleware of the public endpoints: the api and admin tokens are accepted, as well as
+// the named tokens of the store granted the read scope for GET requests. The public endpoints taking other methods
+// create state, such as the simulation sessions, or are costly to compute, such as the compilation and the dryrun of
+// programs, and take the named tokens granted the submit scope.
+func publicAuth(apiToken string, adminAPIToken string, store *tokens.Store) echo.MiddlewareFunc {
+	return middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken, apiToken}, scopedTokens(store, tokens.ScopeRead, tokens.ScopeSubmit))
+}
+
+// participationAuth returns the auth middleware of the participation endpoints, which accepts the admin token and the
+// tokens allowed by participationTokens.
+func participationAuth(adminAPIToken string, store *tokens.Store) echo.MiddlewareFunc {
+	return middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken}, participationTokens(store))
+}
+
+// participationKeyTransferPaths are the participation endpoints transferring the voting secrets of
+// participation keys between nodes.
+var participationKeyTransferPaths = map[string]bool{
+	"/v2/participation/import":                   true,
+	"/v2/participation/:participation-id/export": true,
+}
+
+// participationTokens returns the authorizer of the participation endpoints: the named tokens of the store
+// granted the participation scope are accepted, except by the endpoints transferring the voting secrets,
+// which only accept the admin API token.
+func participationTokens(store *tokens.Store) middlewares.TokenAuthorizer {
+	if store == nil {
+		return nil
+	}
+	authorize := scopedTokens(store, tokens.ScopeParticipation, tokens.ScopeParticipation)
+	return func(ctx echo.Context, token string) bool {
+		if participationKeyTransferPaths[ctx.Path()] {
+			return false
+		}
+		return authorize(ctx, token)
+	}
+}
+
+// ListenerPolicy configures the endpoints served by a REST listener and how its requests are handled.
+type ListenerPolicy struct {
+	// PublicOnly excludes the admin and participation endpoints, which are then served by a dedicated admin listener.
+	PublicOnly bool
+
+	// CORSAllowedOrigins are the origins allowed to make cross-origin requests, "*" allowing any origin.
+	// CORS requests are not answered when empty.
+	CORSAllowedOrigins []string
+
+	// AccessLogSampling is the number of requests for each logged request, 0 disabling the access logs.
+	// The requests failing with a server error are always logged.
+	AccessLogSampling uint64
+
+	// Quotas, when set, limit the requests of each token to the public endpoints.
+	Quotas *middlewares.QuotaLimiter
+
+	// NoMetrics excludes the metrics, which are served by another server of the process.
+	NoMetrics bool
+}
+
+// DefaultListenerPolicy serves every endpoint, allows cross-origin requests from any origin and logs every request.
+var DefaultListenerPolicy = ListenerPolicy{
+	CORSAllowedOrigins: []string{"*"},
+	AccessLogSampling:  1,
+}
+
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
+// In addition to the api and admin tokens, the named tokens of tokenStore are accepted
+// by the endpoints their scopes grant access to.
+func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, tokenStore *tokens.Store, listener net.Listener, numConnectionsLimit uint64, policy ListenerPolicy) *echo.Echo {
 	if err := tokens.ValidateAPIToken(apiToken); err != nil {
 		logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
 	}
@@ -80,11 +188,28 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		logger.Errorf("Invalid adminAPIToken was passed to NewRouter ('%s'): %v", adminAPIToken, err)
 	}
 	adminMiddleware := []echo.MiddlewareFunc{
-		middlewares.MakeAuth(TokenHeader, []string{adminAPIToken}),
+		middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken}, scopedTokens(tokenStore, tokens.ScopeAdmin, tokens.ScopeAdmin)),
+	}
+	participationMiddleware := []echo.MiddlewareFunc{
+		participationAuth(adminAPIToken, tokenStore),
 	}
-	publicMiddleware := []echo.MiddlewareFunc{
+	readMiddleware := []echo.MiddlewareFunc{
 		middleware.BodyLimit(MaxRequestBodyBytes),
-		middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken}),
+		publicAuth(apiToken, adminAPIToken, tokenStore),
+	}
+	submitMiddleware := []echo.MiddlewareFunc{
+		middleware.BodyLimit(MaxRequestBodyBytes),
+		middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken, apiToken}, scopedTokens(tokenStore, tokens.ScopeRead, tokens.ScopeSubmit)),
+	}
+	followerMiddleware := []echo.MiddlewareFunc{
+		middleware.BodyLimit(MaxRequestBodyBytes),
+		middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken, apiToken}, scopedTokens(tokenStore, tokens.ScopeRead, tokens.ScopeAdmin)),
+	}
+	if policy.Quotas != nil {
+		quotaMiddleware := policy.Quotas.Middleware()
+		readMiddleware = append(readMiddleware, quotaMiddleware)
+		submitMiddleware = append(submitMiddleware, quotaMiddleware)
+		followerMiddleware = append(followerMiddleware, quotaMiddleware)
 	}
 
 	e := echo.New()
@@ -96,8 +221,10 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		middlewares.MakeConnectionLimiter(numConnectionsLimit),
 		middleware.RemoveTrailingSlash())
 	e.Use(
-		middlewares.MakeLogger(logger),
-		middlewares.MakeCORS(TokenHeader),
+		middlewares.MakeRequestID(),
+		middlewares.MakeLogger(logger, policy.AccessLogSampling),
+		middlewares.MakeRequestMetrics(),
+		middlewares.MakeCORS(TokenHeader, policy.CORSAllowedOrigins),
 	)
 
 	// Request Context
@@ -107,35 +234,69 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 
 	// Route pprof requests to DefaultServeMux.
 	// The auth middleware removes /urlAuth/:token so that it can be routed correctly.
-	if node.Config().EnableProfiler {
+	if node.Config().EnableProfiler && !policy.PublicOnly {
 		e.GET("/debug/pprof/*", echo.WrapHandler(http.DefaultServeMux), adminMiddleware...)
 		e.GET(fmt.Sprintf("%s/debug/pprof/*", middlewares.URLAuthPrefix), echo.WrapHandler(http.DefaultServeMux), adminMiddleware...)
 	}
+	// The reports of the recent potential deadlocks are served by algod on DefaultServeMux.
+	if !policy.PublicOnly {
+		e.GET(DeadlockReportsPath, echo.WrapHandler(http.DefaultServeMux), adminMiddleware...)
+	}
 	// Registering common routes (no auth)
-	registerHandlers(e, "", common.Routes, ctx)
+	registerHandlers(e, "", commonRoutes(policy), ctx)
 
 	// Registering v1 routes
-	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, publicMiddleware...)
+	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, readMiddleware...)
 
 	// Registering v2 routes
 	v2Handler := v2.Handlers{
-		Node:     node,
-		Log:      logger,
-		Shutdown: shutdown,
+		Node:       node,
+		Log:        logger,
+		Shutdown:   shutdown,
+		TokenStore: tokenStore,
+		Contracts:  v2.MakeContractEvents(),
+	}
+	if node.Config().EnableProfiler {
+		v2Handler.Profiles = v2.MakeProfileCaptures()
+	}
+	nppublic.RegisterHandlers(e, &v2Handler, readMiddleware...)
+	ppublic.RegisterHandlers(e, &v2Handler, submitMiddleware...)
+	// The agreement events are streamed over a WebSocket, which the generated routes cannot describe.
+	// Browsers cannot set the token header on a WebSocket, so the token may be given in the path instead.
+	e.GET(v2.AgreementEventsPath, v2Handler.AgreementEvents, readMiddleware...)
+	e.GET(middlewares.URLAuthPrefix+v2.AgreementEventsPath, v2Handler.AgreementEvents, readMiddleware...)
+	if !policy.PublicOnly {
+		npprivate.RegisterHandlers(e, &v2Handler, adminMiddleware...)
+		pprivate.RegisterHandlers(e, &v2Handler, participationMiddleware...)
 	}
-	nppublic.RegisterHandlers(e, &v2Handler, publicMiddleware...)
-	npprivate.RegisterHandlers(e, &v2Handler, adminMiddleware...)
-	ppublic.RegisterHandlers(e, &v2Handler, publicMiddleware...)
-	pprivate.RegisterHandlers(e, &v2Handler, adminMiddleware...)
 
 	if node.Config().EnableFollowMode {
-		data.RegisterHandlers(e, &v2Handler, publicMiddleware...)
+		data.RegisterHandlers(e, &v2Handler, followerMiddleware...)
 	}
 
 	if node.Config().EnableExperimentalAPI {
-		experimental.RegisterHandlers(e, &v2Handler, publicMiddleware...)
+		experimental.RegisterHandlers(e, &v2Handler, readMiddleware...)
+	}
+
+	return e
+}
+
+// NewMetricsRouter builds and returns a router serving only the metrics, for the dedicated metrics listener.
+// When requireAuth is set, the api and admin tokens, as well as the named tokens of tokenStore granted
+// the read scope, are accepted.
+func NewMetricsRouter(logger logging.Logger, apiToken string, adminAPIToken string, tokenStore *tokens.Store, requireAuth bool) *echo.Echo {
+	var metricsMiddleware []echo.MiddlewareFunc
+	if requireAuth {
+		metricsMiddleware = append(metricsMiddleware,
+			middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken, apiToken}, scopedTokens(tokenStore, tokens.ScopeRead, tokens.ScopeRead)))
 	}
 
+	e := echo.New()
+	e.HideBanner = true
+	e.Pre(middleware.RemoveTrailingSlash())
+
+	ctx := lib.ReqContext{Log: logger}
+	e.GET("/metrics", wrapCtx(ctx, common.Metrics), metricsMiddleware...)
 	return e
 }
 
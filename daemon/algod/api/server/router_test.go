@@ -22,11 +22,17 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v1/routes"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	nppublic "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/nonparticipating/public"
+	pprivate "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/participating/private"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 func setupRouter() *echo.Echo {
@@ -76,3 +82,132 @@ func TestGetTransactionV1Sunset(t *testing.T) {
 		assert.Equal(t, http.StatusGone, rec.Code)
 	}
 }
+
+func TestMetricsRouter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	apiToken := "api-token"
+	e := NewMetricsRouter(logging.TestingLog(t), apiToken, "admin-token", nil, true)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req.Header.Set(TokenHeader, apiToken)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "# TYPE "+metrics.RestRequestDuration.Name+" histogram")
+
+	// without auth, no token is needed
+	e = NewMetricsRouter(logging.TestingLog(t), apiToken, "admin-token", nil, false)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestCommonRoutesNoMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	names := func(routes lib.Routes) map[string]bool {
+		m := make(map[string]bool)
+		for _, route := range routes {
+			m[route.Name] = true
+		}
+		return m
+	}
+	all := names(commonRoutes(ListenerPolicy{}))
+	require.True(t, all["metrics"])
+
+	withoutMetrics := names(commonRoutes(ListenerPolicy{NoMetrics: true}))
+	require.False(t, withoutMetrics["metrics"])
+	delete(all, "metrics")
+	require.Equal(t, all, withoutMetrics)
+}
+
+func TestParticipationTokens(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	store, err := tokens.LoadStore(t.TempDir(), tokens.AlgodTokenStoreFilename)
+	require.NoError(t, err)
+	participation, err := store.Create("participation", []tokens.Scope{tokens.ScopeParticipation})
+	require.NoError(t, err)
+	admin, err := store.Create("admin", []tokens.Scope{tokens.ScopeAdmin})
+	require.NoError(t, err)
+	read, err := store.Create("read", []tokens.Scope{tokens.ScopeRead})
+	require.NoError(t, err)
+
+	authorize := participationTokens(store)
+	e := echo.New()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/v2/participation", nil), httptest.NewRecorder())
+	ctx.SetPath("/v2/participation")
+	require.True(t, authorize(ctx, participation.Token))
+	require.True(t, authorize(ctx, admin.Token))
+	require.False(t, authorize(ctx, read.Token))
+
+	// the voting secrets only leave or enter the node with the admin API token
+	for path := range participationKeyTransferPaths {
+		ctx.SetPath(path)
+		require.False(t, authorize(ctx, participation.Token), path)
+		require.False(t, authorize(ctx, admin.Token), path)
+	}
+}
+
+func TestScopedTokensPerOperation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	store, err := tokens.LoadStore(t.TempDir(), tokens.AlgodTokenStoreFilename)
+	require.NoError(t, err)
+	read, err := store.Create("read", []tokens.Scope{tokens.ScopeRead})
+	require.NoError(t, err)
+	submit, err := store.Create("submit", []tokens.Scope{tokens.ScopeSubmit})
+	require.NoError(t, err)
+	participation, err := store.Create("participation", []tokens.Scope{tokens.ScopeParticipation})
+	require.NoError(t, err)
+
+	// the requests are refused before reaching the handlers, which have no node
+	e := echo.New()
+	nppublic.RegisterHandlers(e, &v2.Handlers{}, publicAuth("api-token", "admin-token", store))
+	pprivate.RegisterHandlers(e, &v2.Handlers{}, participationAuth("admin-token", store))
+
+	testCases := []struct {
+		method string
+		path   string
+		route  string
+		token  tokens.NamedToken
+		scoped tokens.NamedToken
+	}{
+		{http.MethodPost, "/v2/teal/compile", "/v2/teal/compile", read, submit},
+		{http.MethodPost, "/v2/teal/disassemble", "/v2/teal/disassemble", read, submit},
+		{http.MethodPost, "/v2/teal/dryrun", "/v2/teal/dryrun", read, submit},
+		{http.MethodPost, "/v2/transactions/simulate", "/v2/transactions/simulate", read, submit},
+		{http.MethodPost, "/v2/transactions/simulate/sessions", "/v2/transactions/simulate/sessions", read, submit},
+		{http.MethodPost, "/v2/transactions/simulate/sessions/1", "/v2/transactions/simulate/sessions/:session-id", read, submit},
+		{http.MethodDelete, "/v2/transactions/simulate/sessions/1", "/v2/transactions/simulate/sessions/:session-id", read, submit},
+		{http.MethodGet, "/v2/debug/replay-rejections", "/v2/debug/replay-rejections", participation, tokens.NamedToken{}},
+		{http.MethodGet, "/v2/stateproofs/status", "/v2/stateproofs/status", participation, tokens.NamedToken{}},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+		req.Header.Set(TokenHeader, tc.token.Token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusUnauthorized, rec.Code, "%s %s", tc.method, tc.path)
+
+		if tc.scoped.Token != "" {
+			ctx := e.NewContext(httptest.NewRequest(tc.method, tc.path, nil), httptest.NewRecorder())
+			ctx.SetPath(tc.route)
+			require.True(t, scopedTokens(store, tokens.ScopeRead, tokens.ScopeSubmit)(ctx, tc.scoped.Token), "%s %s", tc.method, tc.path)
+		}
+	}
+
+	// the reads remain open to the read tokens, and the participation endpoints to the participation tokens
+	authorize := scopedTokens(store, tokens.ScopeRead, tokens.ScopeSubmit)
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/v2/transactions/simulate/sessions/1", nil), httptest.NewRecorder())
+	ctx.SetPath("/v2/transactions/simulate/sessions/:session-id")
+	require.True(t, authorize(ctx, read.Token))
+	ctx = e.NewContext(httptest.NewRequest(http.MethodGet, "/v2/participation", nil), httptest.NewRecorder())
+	ctx.SetPath("/v2/participation")
+	require.True(t, participationTokens(store)(ctx, participation.Token))
+}
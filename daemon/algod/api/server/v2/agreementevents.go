@@ -0,0 +1,217 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/agreement"
+)
+
+// AgreementEventsPath is the path of the WebSocket streaming the events of the agreement service of the node.
+const AgreementEventsPath = "/v2/agreement/events"
+
+const (
+	// agreementEventsBuffer is the number of events buffered for a client, the events being dropped when the
+	// client falls further behind.
+	agreementEventsBuffer = 4096
+
+	// agreementEventsWriteTimeout bounds the time to send an event to a client.
+	agreementEventsWriteTimeout = 10 * time.Second
+)
+
+// The types of the agreement events.
+const (
+	agreementEventRoundStart = "round-start"
+	agreementEventProposal   = "proposal"
+	agreementEventVote       = "vote"
+	agreementEventCertified  = "certified"
+)
+
+// AgreementEvent is an event of the agreement service, sent to the clients of the agreement events
+// WebSocket as a JSON text message.
+type AgreementEvent struct {
+	// Type is round-start, proposal, vote or certified.
+	Type   string `json:"type"`
+	Round  uint64 `json:"round"`
+	Period uint64 `json:"period"`
+	Step   uint64 `json:"step"`
+	// Sender is the account which sent the vote, or the proposal-vote carrying the proposal.
+	Sender string `json:"sender,omitempty"`
+	// Proposer is the account which originally proposed the block.
+	Proposer  string `json:"proposer,omitempty"`
+	BlockHash string `json:"block-hash,omitempty"`
+	// Weight is the weight of the vote, and ProposalWeight the total weight of the votes for the same block
+	// in the same step.
+	Weight         uint64 `json:"weight,omitempty"`
+	ProposalWeight uint64 `json:"proposal-weight,omitempty"`
+	// Dropped is the number of events dropped before this one, as the client fell behind.
+	Dropped uint64 `json:"dropped,omitempty"`
+}
+
+// agreementEventStream is an agreement.Observer buffering the events for a WebSocket client.
+type agreementEventStream struct {
+	votes   bool
+	events  chan AgreementEvent
+	dropped atomic.Uint64
+}
+
+func makeAgreementEventStream(votes bool) *agreementEventStream {
+	return &agreementEventStream{
+		votes:  votes,
+		events: make(chan AgreementEvent, agreementEventsBuffer),
+	}
+}
+
+// send buffers the event, or drops it when the buffer is full, as the agreement service must not wait.
+func (s *agreementEventStream) send(e AgreementEvent) {
+	select {
+	case s.events <- e:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// OnRoundStart implements agreement.Observer
+func (s *agreementEventStream) OnRoundStart(e agreement.RoundStartEvent) {
+	s.send(AgreementEvent{
+		Type:  agreementEventRoundStart,
+		Round: uint64(e.Round),
+	})
+}
+
+// OnProposalObserved implements agreement.Observer
+func (s *agreementEventStream) OnProposalObserved(e agreement.ProposalObservedEvent) {
+	s.send(AgreementEvent{
+		Type:      agreementEventProposal,
+		Round:     uint64(e.Round),
+		Period:    e.Period,
+		Sender:    e.Sender.String(),
+		Proposer:  e.Proposer.String(),
+		BlockHash: e.BlockDigest.String(),
+	})
+}
+
+// OnVoteObserved implements agreement.Observer
+func (s *agreementEventStream) OnVoteObserved(e agreement.VoteObservedEvent) {
+	if !s.votes {
+		return
+	}
+	vote := AgreementEvent{
+		Type:           agreementEventVote,
+		Round:          uint64(e.Round),
+		Period:         e.Period,
+		Step:           e.Step,
+		Sender:         e.Sender.String(),
+		Weight:         e.Weight,
+		ProposalWeight: e.ProposalWeight,
+	}
+	if !e.BlockDigest.IsZero() {
+		vote.BlockHash = e.BlockDigest.String()
+	}
+	s.send(vote)
+}
+
+// OnCertified implements agreement.Observer
+func (s *agreementEventStream) OnCertified(e agreement.CertifiedEvent) {
+	s.send(AgreementEvent{
+		Type:      agreementEventCertified,
+		Round:     uint64(e.Round),
+		Period:    e.Period,
+		Proposer:  e.Proposer.String(),
+		BlockHash: e.BlockDigest.String(),
+	})
+}
+
+// next returns the next buffered event, with the number of events dropped before it.
+func (s *agreementEventStream) next(e AgreementEvent) AgreementEvent {
+	e.Dropped = s.dropped.Swap(0)
+	return e
+}
+
+var agreementEventsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 4096,
+}
+
+// AgreementEvents streams the events of the agreement service of the node over a WebSocket, as JSON text
+// messages, replacing the parsing of the agreement logs by the monitoring tools. The votes, which number
+// in the thousands each round, are only streamed when requested with votes=true. The events are dropped
+// when the client falls behind, the next event sent counting them.
+// (GET /v2/agreement/events)
+func (v2 *Handlers) AgreementEvents(ctx echo.Context) error {
+	votes := false
+	if param := ctx.QueryParam("votes"); param != "" {
+		var err error
+		votes, err = strconv.ParseBool(param)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseVotes, v2.Log)
+		}
+	}
+
+	stream := makeAgreementEventStream(votes)
+	unregister, err := v2.Node.RegisterAgreementObserver(stream)
+	if err != nil {
+		return notFound(ctx, err, errAgreementEventsNotAvailable, v2.Log)
+	}
+	defer unregister()
+
+	conn, err := agreementEventsUpgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
+	if err != nil {
+		// The upgrader already answered the request with an error
+		v2.Log.Debugf("agreement events upgrade failed: %v", err)
+		return nil
+	}
+	defer conn.Close()
+
+	// The clients send nothing, but reading notices them closing the connection
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case e := <-stream.events:
+			data, err := json.Marshal(stream.next(e))
+			if err != nil {
+				return nil
+			}
+			conn.SetWriteDeadline(time.Now().Add(agreementEventsWriteTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return nil
+			}
+		case <-closed:
+			return nil
+		case <-v2.Shutdown:
+			deadline := time.Now().Add(time.Second)
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), deadline)
+			return nil
+		}
+	}
+}
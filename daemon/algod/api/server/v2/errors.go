@@ -24,16 +24,34 @@ var (
 	errBoxDoesNotExist                         = "box not found"
 	errFailedLookingUpLedger                   = "failed to retrieve information from the ledger"
 	errFailedLookingUpTransactionPool          = "failed to retrieve information from the transaction pool"
+	errFailedEvaluatingSortition               = "failed to evaluate the sortition of the block certificate"
 	errFailedRetrievingStateDelta              = "failed retrieving State Delta: %v"
+	errFailedRetrievingAppStateChanges         = "failed retrieving the state changes of the watched applications: %v"
+	errInvalidAppID                            = "invalid application ID"
 	errFailedRetrievingNodeStatus              = "failed retrieving node status"
 	errFailedRetrievingLatestBlockHeaderStatus = "failed retrieving latest block header"
 	errFailedRetrievingTimeStampOffset         = "failed retrieving timestamp offset from node: %v"
 	errFailedSettingTimeStampOffset            = "failed to set timestamp offset on the node: %v"
+	errFailedAdvancingDevModeRounds            = "failed to advance rounds on the node: %v"
 	errFailedRetrievingSyncRound               = "failed retrieving sync round from ledger"
 	errFailedSettingSyncRound                  = "failed to set sync round on the ledger"
 	errFailedParsingFormatOption               = "failed to parse the format option"
 	errFailedToParseAddress                    = "failed to parse the address"
 	errFailedToParseExclude                    = "failed to parse exclude"
+	errFailedToParseNextToken                  = "failed to parse the next token"
+	errRekeyHistoryNotKept                     = "the rekey history is only kept by archival nodes"
+	errTotalsHistoryNotKept                    = "the totals history is only kept by archival nodes"
+	errTotalsRoundsNotAvailable                = "the totals of the requested rounds are not kept, the history starts with round %d"
+	errInvalidTotalsRange                      = "min-round must be at most max-round"
+	errTooManyTotalsRounds                     = "interval must be at least 1, and at most %d rounds can be requested"
+	errAbsenceProofsNotBuilt                   = "absence proofs are only built by archival nodes tracking catchpoints"
+	errAbsenceProofScope                       = "at most one of asset-id and application-id, other than 0, can be given"
+	errAbsenceProofRoundNotAvailable           = "the absence of accounts is only proven at the accounts round of the latest catchpoint, and of holders at the rounds kept in memory"
+	errAbsenceProofRateLimited                 = "too many absence commitments of assets and applications built, retry later"
+	errAccountExists                           = "the account exists"
+	errCreatableHolderIndexDisabled            = "asset holders and application opt-ins are not indexed, set EnableCreatableHolderIndex on an archival node"
+	errFailedToParseFields                     = "failed to parse the fields option"
+	errFieldsRequireJSON                       = "the fields option is only supported with the json format"
 	errFailedToEncodeResponse                  = "failed to encode response"
 	errInternalFailure                         = "internal failure"
 	errNoValidTxnSpecified                     = "no valid transaction ID was specified"
@@ -48,4 +66,31 @@ var (
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
 	errFailedRetrievingTracer                  = "failed retrieving the expected tracer from ledger"
+	errSimulationSessionNotFound               = "simulation session not found"
+	errTokenStoreNotAvailable                  = "named API tokens are not available"
+	errFailedToCreateToken                     = "failed to create API token"
+	errFailedToRevokeToken                     = "failed to revoke API token"
+	errPeersNotAvailable                       = "peer list is not available"
+	errInvalidParticipationSummaryWindow       = "window must be between 1 and %d"
+	errInvalidStateProofMaxMissing             = "max-missing must be at most %d"
+	errFailedRetrievingStateProofStatus        = "failed retrieving state proof status"
+	errFailedRetrievingAccountPerformance      = "failed retrieving account performance"
+	errFailedRetrievingProposalAssemblies      = "failed retrieving proposal assemblies"
+	errFailedRetrievingReplayRejections        = "failed retrieving replay rejections"
+	errInvalidLogLevel                         = "level must be between 0 and %d"
+	errUnknownLogSubsystem                     = "unknown subsystem %s, expected one of %s"
+	errLogOutputFileNotAbsolute                = "the path of the log output file must be absolute"
+	errInvalidKeyregValidity                   = "last-valid must be between first-valid and first-valid + %d"
+	errParticipationKeyTransferInsecure        = "participation keys are only transferred over TLS, or from the local host when AdminAllowPlaintextParticipationKeyTransfer is set"
+	errContractEventsNotAvailable              = "contract events are not available"
+	errInvalidContractDescription              = "invalid contract description: %v"
+	errNoContractApplications                  = "the contract lists no application for this network, and no application-id was given"
+	errProfilerNotEnabled                      = "profile captures are only available when EnableProfiler is set"
+	errInvalidProfileDuration                  = "seconds must be between 1 and %d"
+	errFailedCapturingProfile                  = "failed capturing profile: %v"
+	errSnapshotPathNotAbsolute                 = "the path of the snapshot must be absolute"
+	errFailedCreatingSnapshot                  = "failed creating snapshot: %v"
+	errFailedToParseVotes                      = "failed to parse the votes option"
+	errAgreementEventsNotAvailable             = "agreement events are not available"
+	errPrivateTxSubmissionDisabled             = "private transaction submission is only available when EnablePrivateTxSubmission is set"
 )
@@ -0,0 +1,264 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// maxContractApps bounds the number of applications contract events are registered for.
+const maxContractApps = 1024
+
+// arc28Contract is the part of an ARC-4 contract description that lists its ARC-28 events,
+// either at the top level or under the methods emitting them.
+type arc28Contract struct {
+	Name     string                  `json:"name"`
+	Networks map[string]arc28Network `json:"networks"`
+	Events   []arc28Event            `json:"events"`
+	Methods  []struct {
+		Events []arc28Event `json:"events"`
+	} `json:"methods"`
+}
+
+type arc28Network struct {
+	AppID uint64 `json:"appID"`
+}
+
+type arc28Event struct {
+	Name string `json:"name"`
+	Args []struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"args"`
+}
+
+// eventDecoder decodes the log entries of a single event.
+type eventDecoder struct {
+	name      string
+	signature string
+	selector  [4]byte
+	names     []string
+	types     []string
+	tuple     abi.Type
+}
+
+func makeEventDecoder(event arc28Event) (eventDecoder, error) {
+	if event.Name == "" {
+		return eventDecoder{}, errors.New("event without a name")
+	}
+	dec := eventDecoder{
+		name:  event.Name,
+		names: make([]string, len(event.Args)),
+		types: make([]string, len(event.Args)),
+	}
+	argTypes := make([]abi.Type, len(event.Args))
+	for i, arg := range event.Args {
+		t, err := abi.TypeOf(arg.Type)
+		if err != nil {
+			return eventDecoder{}, fmt.Errorf("event %s: %w", event.Name, err)
+		}
+		argTypes[i] = t
+		dec.names[i] = arg.Name
+		dec.types[i] = t.String()
+	}
+	tuple, err := abi.MakeTupleType(argTypes)
+	if err != nil {
+		return eventDecoder{}, fmt.Errorf("event %s: %w", event.Name, err)
+	}
+	dec.tuple = tuple
+	dec.signature = event.Name + "(" + strings.Join(dec.types, ",") + ")"
+	hash := sha512.Sum512_256([]byte(dec.signature))
+	copy(dec.selector[:], hash[:4])
+	return dec, nil
+}
+
+// decode decodes a log entry emitting the event, returning false if it does not.
+func (dec *eventDecoder) decode(entry []byte, logIndex int) (model.ApplicationEvent, bool) {
+	if len(entry) < len(dec.selector) || !bytes.Equal(entry[:len(dec.selector)], dec.selector[:]) {
+		return model.ApplicationEvent{}, false
+	}
+	decoded, err := dec.tuple.Decode(entry[len(dec.selector):])
+	if err != nil {
+		return model.ApplicationEvent{}, false
+	}
+	encoded, err := dec.tuple.MarshalToJSON(decoded)
+	if err != nil {
+		return model.ApplicationEvent{}, false
+	}
+	var values []interface{}
+	d := json.NewDecoder(bytes.NewReader(encoded))
+	d.UseNumber()
+	if err := d.Decode(&values); err != nil || len(values) != len(dec.types) {
+		return model.ApplicationEvent{}, false
+	}
+
+	event := model.ApplicationEvent{
+		Name:      dec.name,
+		Signature: dec.signature,
+		LogIndex:  uint64(logIndex),
+		Fields:    make([]model.ApplicationEventField, len(values)),
+	}
+	for i, value := range values {
+		event.Fields[i] = model.ApplicationEventField{
+			Name:  omitEmpty(dec.names[i]),
+			Type:  dec.types[i],
+			Value: convertEventValue(value),
+		}
+	}
+	return event, true
+}
+
+// convertEventValue replaces the numbers of a value decoded from JSON with integers, or with decimal
+// strings for the integers which do not fit in 64 bits.
+func convertEventValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return n
+		}
+		return string(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = convertEventValue(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// ContractEvents holds the ARC-28 events of the contracts registered for applications, used to
+// decode the logs of the calls to these applications. A nil ContractEvents decodes no events.
+type ContractEvents struct {
+	mu   deadlock.RWMutex
+	apps map[basics.AppIndex][]eventDecoder
+}
+
+// MakeContractEvents creates an empty registry of contract events.
+func MakeContractEvents() *ContractEvents {
+	return &ContractEvents{apps: make(map[basics.AppIndex][]eventDecoder)}
+}
+
+// parseContractEvents parses the events of an ARC-4 contract description, and the applications
+// implementing it on the network with the given genesis hash.
+func parseContractEvents(description []byte, genesisHash string) ([]eventDecoder, []basics.AppIndex, error) {
+	var contract arc28Contract
+	if err := json.Unmarshal(description, &contract); err != nil {
+		return nil, nil, err
+	}
+
+	events := contract.Events
+	for _, method := range contract.Methods {
+		events = append(events, method.Events...)
+	}
+	decoders := make([]eventDecoder, 0, len(events))
+	signatures := make(map[string]bool, len(events))
+	for _, event := range events {
+		dec, err := makeEventDecoder(event)
+		if err != nil {
+			return nil, nil, err
+		}
+		// methods may list the events they share
+		if signatures[dec.signature] {
+			continue
+		}
+		signatures[dec.signature] = true
+		decoders = append(decoders, dec)
+	}
+	if len(decoders) == 0 {
+		return nil, nil, errors.New("the contract has no events")
+	}
+
+	var apps []basics.AppIndex
+	if network, ok := contract.Networks[genesisHash]; ok && network.AppID != 0 {
+		apps = append(apps, basics.AppIndex(network.AppID))
+	}
+	return decoders, apps, nil
+}
+
+// register registers the events of a contract for the given applications, replacing the events
+// previously registered for them.
+func (ce *ContractEvents) register(decoders []eventDecoder, apps []basics.AppIndex) error {
+	ce.mu.Lock()
+	defer ce.mu.Unlock()
+	added := 0
+	for _, app := range apps {
+		if _, ok := ce.apps[app]; !ok {
+			added++
+		}
+	}
+	if len(ce.apps)+added > maxContractApps {
+		return fmt.Errorf("contracts are registered for at most %d applications", maxContractApps)
+	}
+	for _, app := range apps {
+		ce.apps[app] = decoders
+	}
+	return nil
+}
+
+// decodeLogs decodes the events of the registered contract of an application from its logs.
+func (ce *ContractEvents) decodeLogs(app basics.AppIndex, logs [][]byte) []model.ApplicationEvent {
+	if ce == nil || app == 0 || len(logs) == 0 {
+		return nil
+	}
+	ce.mu.RLock()
+	decoders := ce.apps[app]
+	ce.mu.RUnlock()
+
+	var events []model.ApplicationEvent
+	for i, entry := range logs {
+		for j := range decoders {
+			if event, ok := decoders[j].decode(entry, i); ok {
+				events = append(events, event)
+				break
+			}
+		}
+	}
+	return events
+}
+
+// decodeTxnEvents sets the events decoded from the logs of an application call and its inner
+// transactions.
+func (ce *ContractEvents) decodeTxnEvents(txn *PreEncodedTxInfo) {
+	if txn.Inners != nil {
+		for i := range *txn.Inners {
+			ce.decodeTxnEvents(&(*txn.Inners)[i])
+		}
+	}
+	if txn.Logs == nil {
+		return
+	}
+	app := txn.Txn.Txn.ApplicationID
+	if app == 0 && txn.ApplicationIndex != nil {
+		app = basics.AppIndex(*txn.ApplicationIndex)
+	}
+	if events := ce.decodeLogs(app, *txn.Logs); len(events) > 0 {
+		txn.Events = &events
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testContract = `{
+	"name": "Token",
+	"networks": {"testnet-hash": {"appID": 5}, "other-hash": {"appID": 6}},
+	"events": [
+		{"name": "Transfer", "args": [{"type": "address", "name": "to"}, {"type": "uint64", "name": "amount"}]}
+	],
+	"methods": [
+		{"name": "mint", "events": [
+			{"name": "Minted", "args": [{"type": "uint128"}]},
+			{"name": "Transfer", "args": [{"type": "address", "name": "to"}, {"type": "uint64", "name": "amount"}]}
+		]}
+	]
+}`
+
+func eventLog(signature string, args ...byte) []byte {
+	hash := sha512.Sum512_256([]byte(signature))
+	return append(hash[:4:4], args...)
+}
+
+func TestParseContractEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	decoders, apps, err := parseContractEvents([]byte(testContract), "testnet-hash")
+	require.NoError(t, err)
+	require.Equal(t, []basics.AppIndex{5}, apps)
+	require.Len(t, decoders, 2)
+	require.Equal(t, "Transfer(address,uint64)", decoders[0].signature)
+	require.Equal(t, "Minted(uint128)", decoders[1].signature)
+
+	_, apps, err = parseContractEvents([]byte(testContract), "unknown-hash")
+	require.NoError(t, err)
+	require.Empty(t, apps)
+
+	_, _, err = parseContractEvents([]byte(`{"name": "Empty"}`), "testnet-hash")
+	require.ErrorContains(t, err, "no events")
+
+	_, _, err = parseContractEvents([]byte(`{"events": [{"name": "Bad", "args": [{"type": "uint7"}]}]}`), "testnet-hash")
+	require.ErrorContains(t, err, "event Bad")
+}
+
+func TestDecodeContractEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	decoders, apps, err := parseContractEvents([]byte(testContract), "testnet-hash")
+	require.NoError(t, err)
+	contracts := MakeContractEvents()
+	require.NoError(t, contracts.register(decoders, apps))
+
+	var to basics.Address
+	to[0] = 1
+	amount := make([]byte, 8)
+	binary.BigEndian.PutUint64(amount, 100)
+	minted := make([]byte, 16)
+	minted[7] = 1 // 2^64
+
+	logs := [][]byte{
+		eventLog("Transfer(address,uint64)", append(to[:], amount...)...),
+		[]byte("not an event"),
+		eventLog("Minted(uint128)", minted...),
+		eventLog("Transfer(address,uint64)", 1, 2, 3),
+	}
+	events := contracts.decodeLogs(5, logs)
+	require.Equal(t, []model.ApplicationEvent{
+		{
+			Name:      "Transfer",
+			Signature: "Transfer(address,uint64)",
+			LogIndex:  0,
+			Fields: []model.ApplicationEventField{
+				{Name: omitEmpty("to"), Type: "address", Value: to.String()},
+				{Name: omitEmpty("amount"), Type: "uint64", Value: uint64(100)},
+			},
+		},
+		{
+			Name:      "Minted",
+			Signature: "Minted(uint128)",
+			LogIndex:  2,
+			Fields: []model.ApplicationEventField{
+				{Type: "uint128", Value: "18446744073709551616"},
+			},
+		},
+	}, events)
+
+	// only the registered applications are decoded
+	require.Empty(t, contracts.decodeLogs(6, logs))
+	var none *ContractEvents
+	require.Empty(t, none.decodeLogs(5, logs))
+
+	// events are decoded from inner transactions, and from the calls creating applications
+	created := uint64(5)
+	inner := PreEncodedTxInfo{ApplicationIndex: &created, Logs: &[][]byte{logs[2]}}
+	outer := PreEncodedTxInfo{
+		Txn:    transactions.SignedTxn{Txn: transactions.Transaction{ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{ApplicationID: 7}}},
+		Logs:   &[][]byte{logs[0]},
+		Inners: &[]PreEncodedTxInfo{inner},
+	}
+	contracts.decodeTxnEvents(&outer)
+	require.Nil(t, outer.Events)
+	require.NotNil(t, (*outer.Inners)[0].Events)
+	require.Equal(t, "Minted", (*(*outer.Inners)[0].Events)[0].Name)
+	require.Zero(t, (*(*outer.Inners)[0].Events)[0].LogIndex)
+}
+
+func TestRegisterContractEventsLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	decoders, _, err := parseContractEvents([]byte(testContract), "testnet-hash")
+	require.NoError(t, err)
+	contracts := MakeContractEvents()
+	apps := make([]basics.AppIndex, maxContractApps)
+	for i := range apps {
+		apps[i] = basics.AppIndex(i + 1)
+	}
+	require.NoError(t, contracts.register(decoders, apps))
+	// registering again replaces the events of the applications
+	require.NoError(t, contracts.register(decoders[:1], apps[:1]))
+	require.Len(t, contracts.apps[1], 1)
+	require.ErrorContains(t, contracts.register(decoders, []basics.AppIndex{maxContractApps + 1}), "at most")
+}
@@ -0,0 +1,245 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/algorand/go-codec/codec"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// fieldSelection is the set of response fields requested with the fields query parameter.
+// Each selected field maps to the selection of its own sub-fields, which is nil when the
+// whole field is selected. A nil fieldSelection selects every field.
+type fieldSelection map[string]fieldSelection
+
+// parseFieldSelection parses a comma separated list of dot separated field paths.
+func parseFieldSelection(fields *string) (fieldSelection, error) {
+	if fields == nil || strings.TrimSpace(*fields) == "" {
+		return nil, nil
+	}
+
+	selection := fieldSelection{}
+	for _, path := range strings.Split(*fields, ",") {
+		path = strings.TrimSpace(path)
+		segments := strings.Split(path, ".")
+		for _, segment := range segments {
+			if segment == "" {
+				return nil, fmt.Errorf("invalid field '%s'", path)
+			}
+		}
+
+		node := selection
+		for i, segment := range segments {
+			if i == len(segments)-1 {
+				node[segment] = nil
+				break
+			}
+			child, ok := node[segment]
+			if ok && child == nil {
+				// the whole field is already selected
+				break
+			}
+			if !ok {
+				child = fieldSelection{}
+				node[segment] = child
+			}
+			node = child
+		}
+	}
+	return selection, nil
+}
+
+// selects returns true if the field, or some of its sub-fields, are selected.
+func (fs fieldSelection) selects(name string) bool {
+	if fs == nil {
+		return true
+	}
+	_, ok := fs[name]
+	return ok
+}
+
+// jsonTags names the fields of the structs encoded with encoding/json, and codecTags the fields
+// of the structs encoded with go-codec, which falls back to the json tag.
+var (
+	jsonTags  = []string{"json"}
+	codecTags = []string{"codec", "json"}
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	codecSelferType   = reflect.TypeOf((*codec.Selfer)(nil)).Elem()
+)
+
+// project returns the selected fields of v, so that the response is encoded once and the
+// fields which are not selected are never encoded. Structs and string keyed maps become maps
+// of the selected fields, named by the first of the given struct tags which is set, and the
+// selection applies to each element of slices and arrays. Values with their own encoding are
+// kept whole.
+func (fs fieldSelection) project(v interface{}, tags []string) interface{} {
+	if fs == nil {
+		return v
+	}
+	return fs.projectValue(reflect.ValueOf(v), tags)
+}
+
+func (fs fieldSelection) projectValue(v reflect.Value, tags []string) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if fs == nil || encodesItself(v.Type()) {
+		return v.Interface()
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return fs.projectValue(v.Elem(), tags)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface()
+		}
+		projected := make([]interface{}, v.Len())
+		for i := range projected {
+			projected[i] = fs.projectValue(v.Index(i), tags)
+		}
+		return projected
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return v.Interface()
+		}
+		projected := make(map[string]interface{}, len(fs))
+		for name, sub := range fs {
+			field := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+			if field.IsValid() {
+				projected[name] = sub.projectValue(field, tags)
+			}
+		}
+		return projected
+	case reflect.Struct:
+		projected := make(map[string]interface{}, len(fs))
+		fs.projectStruct(v, tags, projected)
+		return projected
+	default:
+		return v.Interface()
+	}
+}
+
+// projectStruct adds the selected fields of a struct to projected. The fields of embedded
+// structs without a tag are promoted, as both encoders do, and the options of a _struct
+// field apply to every field, as go-codec does.
+func (fs fieldSelection) projectStruct(v reflect.Value, tags []string, projected map[string]interface{}) {
+	t := v.Type()
+	var omitAll bool
+	if field, ok := t.FieldByName("_struct"); ok {
+		_, omitAll = fieldTag(field, tags)
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty := fieldTag(field, tags)
+		omitEmpty = omitEmpty || omitAll
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			fs.projectStruct(v.Field(i), tags, projected)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		sub, ok := fs[name]
+		if !ok {
+			continue
+		}
+		value := v.Field(i)
+		if omitEmpty && isEmptyValue(value) {
+			continue
+		}
+		projected[name] = sub.projectValue(value, tags)
+	}
+}
+
+// fieldTag returns the encoded name of a struct field, empty if the tag does not name it,
+// and whether the field is omitted when empty.
+func fieldTag(field reflect.StructField, tags []string) (name string, omitEmpty bool) {
+	for _, key := range tags {
+		tag, ok := field.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+		options := strings.Split(tag, ",")
+		for _, option := range options[1:] {
+			if option == "omitempty" {
+				omitEmpty = true
+			}
+		}
+		return options[0], omitEmpty
+	}
+	return "", false
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// encodesItself returns true if values of the type control their own encoding.
+func encodesItself(t reflect.Type) bool {
+	for _, marshaler := range []reflect.Type{jsonMarshalerType, textMarshalerType, codecSelferType} {
+		if t.Implements(marshaler) || reflect.PointerTo(t).Implements(marshaler) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldSelectionForFormat parses the fields parameter of a request, which is only supported
+// by json responses.
+func fieldSelectionForFormat(fields *string, handle codec.Handle) (fieldSelection, error) {
+	fs, err := parseFieldSelection(fields)
+	if err != nil {
+		return nil, err
+	}
+	if fs != nil && handle == protocol.CodecHandle {
+		return nil, errors.New(errFieldsRequireJSON)
+	}
+	return fs, nil
+}
+
+// respondWithFields writes the selected fields of a response as json.
+func (v2 *Handlers) respondWithFields(ctx echo.Context, response interface{}, fs fieldSelection) error {
+	return ctx.JSON(http.StatusOK, fs.project(response, jsonTags))
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseFieldSelection(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	parse := func(fields string) fieldSelection {
+		fs, err := parseFieldSelection(&fields)
+		require.NoError(t, err)
+		return fs
+	}
+
+	fs, err := parseFieldSelection(nil)
+	require.NoError(t, err)
+	require.Nil(t, fs)
+	require.Nil(t, parse(" "))
+
+	require.Equal(t, fieldSelection{"amount": nil, "round": nil}, parse("amount, round"))
+	require.Equal(t, fieldSelection{"block": fieldSelection{"rnd": nil, "ts": nil}}, parse("block.rnd,block.ts"))
+	// selecting a whole field takes precedence over its sub-fields
+	require.Equal(t, fieldSelection{"block": nil}, parse("block,block.rnd"))
+	require.Equal(t, fieldSelection{"block": nil}, parse("block.rnd,block"))
+
+	for _, invalid := range []string{"amount,", "block.", ".rnd", "a..b"} {
+		_, err = parseFieldSelection(&invalid)
+		require.Error(t, err, invalid)
+	}
+
+	fields := "amount"
+	_, err = fieldSelectionForFormat(&fields, protocol.CodecHandle)
+	require.Error(t, err)
+	fs, err = fieldSelectionForFormat(&fields, protocol.JSONStrictHandle)
+	require.NoError(t, err)
+	require.True(t, fs.selects("amount"))
+	require.False(t, fs.selects("assets"))
+}
+
+func TestFieldSelectionProject(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	type header struct {
+		Fee   uint64 `codec:"fee"`
+		Round uint64 `codec:"rnd,omitempty"`
+	}
+	type txn struct {
+		_struct struct{} `codec:",omitempty,omitemptyarray"`
+		header
+		Amount   uint64         `codec:"amt"`
+		Receiver basics.Address `codec:"rcv"`
+		Note     []byte         `codec:"note"`
+	}
+	type signedTxn struct {
+		Sig string `codec:"sig"`
+		Txn *txn   `codec:"txn"`
+	}
+	value := struct {
+		TopTransactions   []signedTxn `json:"top-transactions"`
+		TotalTransactions uint64      `json:"total-transactions"`
+		Ignored           uint64      `json:"-"`
+	}{
+		TopTransactions: []signedTxn{
+			{Sig: "a", Txn: &txn{header: header{Fee: 1000}, Amount: 1, Receiver: basics.Address{1}, Note: []byte{1}}},
+			{Sig: "b", Txn: &txn{header: header{Fee: 1000}, Amount: 2}},
+		},
+		TotalTransactions: 2,
+	}
+
+	project := func(fields string) interface{} {
+		fs, err := parseFieldSelection(&fields)
+		require.NoError(t, err)
+		return fs.project(value, codecTags)
+	}
+
+	require.Equal(t, map[string]interface{}{
+		"total-transactions": uint64(2),
+		"top-transactions": []interface{}{
+			map[string]interface{}{"txn": map[string]interface{}{"amt": uint64(1), "fee": uint64(1000)}},
+			map[string]interface{}{"txn": map[string]interface{}{"amt": uint64(2), "fee": uint64(1000)}},
+		},
+	}, project("total-transactions,top-transactions.txn.amt,top-transactions.txn.fee,missing,Ignored"))
+
+	// values with their own encoding and byte slices are kept whole, and empty fields are omitted
+	require.Equal(t, map[string]interface{}{
+		"top-transactions": []interface{}{
+			map[string]interface{}{"txn": map[string]interface{}{"rcv": basics.Address{1}, "note": []byte{1}}},
+			map[string]interface{}{"txn": map[string]interface{}{}},
+		},
+	}, project("top-transactions.txn.rcv.x,top-transactions.txn.note.x,top-transactions.txn.rnd"))
+
+	var all fieldSelection
+	require.Equal(t, value, all.project(value, codecTags))
+
+	account := model.Account{Address: "addr", Amount: 10, Status: "Offline"}
+	fs := fieldSelection{"address": nil, "amount": nil, "assets": nil}
+	require.Equal(t, map[string]interface{}{"address": "addr", "amount": uint64(10)}, fs.project(account, jsonTags))
+}
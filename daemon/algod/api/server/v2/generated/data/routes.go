@@ -30,6 +30,9 @@ type ServerInterface interface {
 	// Given a round, tells the ledger to keep that round in its cache.
 	// (POST /v2/ledger/sync/{round})
 	SetSyncRound(ctx echo.Context, round uint64) error
+	// Acknowledge a round processed by the consumer of the ledger.
+	// (POST /v2/ledger/sync/{round}/ack)
+	AcknowledgeSyncRound(ctx echo.Context, round uint64) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -77,6 +80,24 @@ func (w *ServerInterfaceWrapper) SetSyncRound(ctx echo.Context) error {
 	return err
 }
 
+// AcknowledgeSyncRound converts echo context to params.
+func (w *ServerInterfaceWrapper) AcknowledgeSyncRound(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AcknowledgeSyncRound(ctx, round)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -108,191 +129,392 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/v2/ledger/sync", wrapper.UnsetSyncRound, m...)
 	router.GET(baseURL+"/v2/ledger/sync", wrapper.GetSyncRound, m...)
 	router.POST(baseURL+"/v2/ledger/sync/:round", wrapper.SetSyncRound, m...)
+	router.POST(baseURL+"/v2/ledger/sync/:round/ack", wrapper.AcknowledgeSyncRound, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a5PcNpLgX0HUboQsXbFbL3tHipjYa0u2p8+yrVC3Pbcr6WwUmVWFaRbAAcDuKuv0",
-	"3y+QCZAgCVaxH5ZmLuaT1EU8EolEIl/I/DDL1aZSEqQ1s+cfZhXXfAMWNP7F81zV0maicH8VYHItKiuU",
-	"nD0P35ixWsjVbD4T7teK2/VsPpN8A20b138+0/D3WmgoZs+trmE+M/kaNtwNbHeVa92MtM1WKvNDnNAQ",
-	"py9nH/d84EWhwZghlD/JcseEzMu6AGY1l4bn7pNhV8KumV0Lw3xnJiRTEphaMrvuNGZLAWVhjsIi/16D",
-	"3kWr9JOPL+ljC2KmVQlDOF+ozUJICFBBA1SzIcwqVsASG625ZW4GB2toaBUzwHW+ZkulD4BKQMTwgqw3",
-	"s+dvZwZkARp3Kwdxif9daoDfIbNcr8DO3s9Ti1ta0JkVm8TSTj32NZi6tIZhW1zjSlyCZK7XEfuhNpYt",
-	"gHHJ3nz7gj158uSZW8iGWwuFJ7LRVbWzx2ui7rPns4JbCJ+HtMbLldJcFlnT/s23L3D+M7/Aqa24MZA+",
-	"LCfuCzt9ObaA0DFBQkJaWOE+dKjf9UgcivbnBSyVhol7Qo3vdFPi+T/rruTc5utKCWkT+8LwK6PPSR4W",
-	"dd/HwxoAOu0rhyntBn37MHv2/sOj+aOHH//t7Un23/7PL598nLj8F824BzCQbJjXWoPMd9lKA8fTsuZy",
-	"iI83nh7MWtVlwdb8Ejefb5DV+77M9SXWecnL2tGJyLU6KVfKMO7JqIAlr0vLwsSslqVjU240T+1MGFZp",
-	"dSkKKOaO+16tRb5mOTc0BLZjV6IsHQ3WBooxWkuvbs9h+hijxMF1I3zggv5xkdGu6wAmYIvcIMtLZSCz",
-	"6sD1FG4cLgsWXyjtXWWud1mx8zUwnNx9oMsWcScdTZfljlnc14JxwzgLV9OciSXbqZpd4eaU4gL7+9U4",
-	"rG2YQxpuTucedYd3DH0DZCSQt1CqBC4ReeHcDVEml2JVazDsag127e88DaZS0gBTi79Bbt22/6+zn35k",
-	"SrMfwBi+gtc8v2Agc1VAccROl0wqG5GGpyXEoes5tg4PV+qS/5tRjiY2ZlXx/CJ9o5diIxKr+oFvxabe",
-	"MFlvFqDdloYrxCqmwdZajgFEIx4gxQ3fDic917XMcf/baTuynKM2YaqS7xBhG77988O5B8cwXpasAlkI",
-	"uWJ2K0flODf3YfAyrWpZTBBzrNvT6GI1FeRiKaBgzSh7IPHTHIJHyOvB0wpfEThhkFFwmlkOgCNhm6AZ",
-	"d7rdF1bxFUQkc8R+9swNv1p1AbIhdLbY4adKw6VQtWk6jcCIU++XwKWykFUaliJBY2ceHY7BUBvPgTde",
-	"BsqVtFxIKBxzRqCVBWJWozBFE+7Xd4a3+IIb+Orp2B3ffp24+0vV3/W9Oz5pt7FRRkcycXW6r/7ApiWr",
-	"Tv8J+mE8txGrjH4ebKRYnbvbZilKvIn+5vYvoKE2yAQ6iAh3kxEryW2t4fk7+cD9xTJ2ZrksuC7cLxv6",
-	"6Ye6tOJMrNxPJf30Sq1EfiZWI8hsYE0qXNhtQ/+48dLs2G6TesUrpS7qKl5Q3lFcFzt2+nJsk2nM6xLm",
-	"SaPtxorH+TYoI9ftYbfNRo4AOYq7iruGF7DT4KDl+RL/2S6RnvhS/+7+qarS9bbVMoVaR8f+SkbzgTcr",
-	"nFRVKXLukPjGf3ZfHRMAUiR42+IYL9TnHyIQK60q0FbQoLyqslLlvMyM5RZH+ncNy9nz2b8dt/aXY+pu",
-	"jqPJX7leZ9jJiawkBmW8qq4xxmsn+pg9zMIxaPyEbILYHgpNQtImOlISjgWXcMmlPWpVlg4/aA7wWz9T",
-	"i2+SdgjfPRVsFOGMGi7AkARMDe8ZFqGeIVoZohUF0lWpFs0PX5xUVYtB/H5SVYQPlB5BoGAGW2GsuY/L",
-	"5+1Jiuc5fXnEvovHRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnJbE9DgxPy7oDhUK9aqdFLP",
-	"QVpxjf/i28Zk5n6f1Pmfg8Ri3I4TFypaHnOk4+AvkXLzRY9yhoTjzT1H7KTf92Zk40ZJE8yNaGXvftK4",
-	"e/DYoPBK84oA9F/oLhUSlTRqRLDekptOZHRJmKMzHNEaQnXjs3bwPCQhQVLowfB1qfKLv3CzvoMzvwhj",
-	"DY8fTsPWwAvQbM3N+miWkjLi49WONuWIuYao4LNFNNVRs8S7Wt6BpRXc8mhpHt60WEKox37I9EAndJef",
-	"8D+8ZO6zO9uO9dOwR+wcGZih4+ydDIXT9klBoJlcA7RCKLYhBZ85rftaUL5oJ0/v06Q9+oZsCn6H/CJw",
-	"h9T2zo/B12qbguFrtR0cAbUFcxf04cZBMdLCxkyA76WHTOH+e/RxrfluiGQcewqS3QKd6GrwNMj4xnez",
-	"tMbZk4XSN+M+PbYiWWtyZtyNGjHfeQ9J2LSuMk+KCbMVNegN1Hr59jON/vApjHWwcGb5H4AF40a9Cyx0",
-	"B7prLKhNJUq4A9JfJ5n+ght48pid/eXky0ePf3385VeOJCutVppv2GJnwbAvvG7GjN2VcH+4MtSO6tKm",
-	"R//qaTBUdsdNjWNUrXPY8Go4FBlASQSiZsy1G2Kti2ZcdQPglMN5Do6TE9oZ2fYdaC+FcRLWZnEnmzGG",
-	"sKKdpWAekgIOEtN1l9dOs4uXqHe6vgtVFrRWOmFfwyNmVa7K7BK0ESrhTXntWzDfIoi3Vf93gpZdccPc",
-	"3Gj6rSUKFAnKsls5ne/T0Odb2eJmL+en9SZW5+edsi9d5AdLomEV6MxuJStgUa86mtBSqw3jrMCOeEd/",
-	"BxZFgXOxgTPLN9VPy+XdqIoKB0qobGIDxs3EqIWT6w3kSlIkxAHtzI86BT19xAQTnR0HwGPkbCdztDPe",
-	"xbEdV1w3QqLTw+xkHmmxDsYSilWHLG+vrY6hg6a6ZxLgOHS8ws9o6HgJpeXfKn3eWgK/06qu7lzI6885",
-	"dTncL8abUgrXN+jQQq7KbvTNysF+lFrjZ1nQi3B8/RoQeqTIV2K1tpFa8Vortbx7GFOzpADFD6SUla7P",
-	"UDX7URWOmdja3IEI1g7WcjhHtzFf4wtVW8aZVAXg5tcmLZyNxGugoxj92zaW9+ya9KwFOOrKee1WW1cM",
-	"vbeD+6LtmPGcTmiGqDEjvqvG6UitaDqKBSg18GLHFgCSqYV3EHnXFS6So+vZBvHGi4YJftGBq9IqB2Og",
-	"yLxh6iBooR1dHXYPnhBwBLiZhRnFllzfGtiLy4NwXsAuw0AJw774/hdz/zPAa5Xl5QHEYpsUehs133sB",
-	"h1BPm34fwfUnj8mOa2DhXmFWoTRbgoUxFF4LJ6P714dosIu3R8slaPTH/aEUHya5HQE1oP7B9H5baOtq",
-	"JPzPq7dOwnMbJrlUQbBKDVZyY7NDbNk16ujgbgURJ0xxYhx4RPB6xY0lH7KQBZq+6DrBeUgIc1OMAzyq",
-	"hriRfwkayHDs3N2D0tSmUUdMXVVKWyhSa5Cw3TPXj7Bt5lLLaOxG57GK1QYOjTyGpWh8jyxaCSGI28bV",
-	"4oMshotDh4S753dJVHaAaBGxD5Cz0CrCbhwCNQKIMC2iiXCE6VFOE3c1nxmrqspxC5vVsuk3hqYzan1i",
-	"f27bDomL2/beLhQYjLzy7T3kV4RZCn5bc8M8HGzDL5zsgWYQcnYPYXaHMTNC5pDto3xU8Vyr+AgcPKR1",
-	"tdK8gKyAku+Gg/5Mnxl93jcA7nir7ioLGUUxpTe9peQQNLJnaIXjmZTwyPALy90RdKpASyC+94GRC8Cx",
-	"U8zJ09G9ZiicK7lFYTxcNm11YkS8DS+VdTvu6QFB9hx9CsAjeGiGvjkqsHPW6p79Kf4LjJ+gkSOuP8kO",
-	"zNgS2vGvtYARG6oPEI/OS4+99zhwkm2OsrEDfGTsyI4YdF9zbUUuKtR1vofdnat+/QmSbkZWgOWihIJF",
-	"H0gNrOL+jOJv+mPeTBWcZHsbgj8wviWWUwqDIk8X+AvYoc79mgI7I1PHXeiyiVHd/cQlQ0BDuJgTweMm",
-	"sOW5LXdOULNr2LEr0MBMvdgIaylgu6vqWlVl8QBJv8aeGb0Tj4Iiww5M8Sqe4VDR8oZbMZ+RTrAfvvOe",
-	"YtBBh9cFKqXKCRayATKSEEyK92CVcrsufOx4iB4OlNQB0jNt9OA21/8900EzroD9l6pZziWqXLWFRqZR",
-	"GgUFFCDdDE4Ea+b0kR0thqCEDZAmiV8ePOgv/MEDv+fCsCVchQcXrmEfHQ8eoB3ntTK2c7juwB7qjttp",
-	"4vpAh4+7+LwW0ucphyML/MhTdvJ1b/DGS+TOlDGecN3yb80AeidzO2XtMY1Mi6rAcSf5cqKhU+vGfT8T",
-	"m7rk9i68VnDJy0xdgtaigIOc3E8slPzmkpc/Nd3wMQnkjkZzyHJ8AjFxLDh3fejVxCHdsI0mE5sNFIJb",
-	"KHes0pADRfk7kc80MB4xiv/L11yuUNLXql75ADQaBzl1bcimoms5GCIpDdmtzNA6neLcPug4PPRwchBw",
-	"p4v1TdukeVzxZj7/tmfKlRohr2/qT3q35rNRVdUh9bJVVQk53dcqE7h4R1CL8NNOPNEHgqhzQssQX/G2",
-	"uFPgNvePsbW3Q6egHE4chcS1H8ei4pyeXO7uQFqhgZiGSoPBuyW2Lxn6qpbxyzR/+ZidsbAZmuCp668j",
-	"x+/NqKKnZCkkZBslYZd8jC0k/IAfk8cJ77eRzihpjPXtKw8d+HtgdeeZQo23xS/udv+E9l1N5lul78qX",
-	"SQNOlssnuA4P+sn9lDd1cPKyTPgE/buVPgMw8+advNCMG6NygcLWaWHmdNC8G9E/cumi/3UTjXsHZ68/",
-	"bs/5FT+JROMulBXjLC8Fmn6VNFbXuX0nORqXoqUmopaCFj1ubnwRmqTtmwnzox/qneQYsdaYnJKRFktI",
-	"2Fe+BQhWR1OvVmBsT0lZAryTvpWQrJbC4lwbd1wyOi8VaAwdOqKWG75jS0cTVrHfQSu2qG1XbMdnWcaK",
-	"svSeODcNU8t3kltWAjeW/SDk+RaHC976cGQl2CulLxospG/3FUgwwmTp6Krv6CsGvvrlr30QLD6jp8/k",
-	"u3Hjt2+3dmh7ap+G/58v/vP525Psv3n2+8Ps2f84fv/h6cf7DwY/Pv745z//3+5PTz7++f5//ntqpwLs",
-	"qUdDHvLTl16lPX2JekvrvBnA/skM9xshsySRxWEYPdpiX+ADWU9A97tWLbuGd9JupSOkS16KwvGWm5BD",
-	"/4YZnEU6HT2q6WxEz4oV1npNbeAWXIYlmEyPNd5YihoGJKaf56E30b+4w/OyrCVtZZC+6fVJCAxTy3nz",
-	"BJOyszxn+D5vzUNUo//z8Zdfzebtu7rm+2w+81/fJyhZFNvU68kCtiklzx8QPBj3DKv4zoBNcw+EPRkD",
-	"R0EZ8bAb2CxAm7WoPj2nMFYs0hwuxPR7Y9FWnkoKtnfnB32TO+/yUMtPD7fVAAVUdp3K2tAR1LBVu5sA",
-	"vXiRSqtLkHMmjuCob6wpnL7oo/FK4EvMHoDap5qiDTXngAgtUEWE9XghkywiKfpBkcdz64/zmb/8zZ2r",
-	"Q37gFFz9ORtHZPjbKnbvu2/O2bFnmOYePeSloaOnlwlV2r8u6kQSOW5GuWpIyHsn38mXsBRSuO/P38mC",
-	"W3684Ebk5rg2oL/mJZc5HK0Uex4eLL3klr+TA0lrNJ1U9FSMVfWiFDm7iBWSljwpRchwhHfv3vJypd69",
-	"ez8IqhiqD36qJH+hCTInCKvaZj7BQabhiuuU08o0D9xxZMpgsm9WErJVTZbNkEDBj5/mebyqTP+h63D5",
-	"VVW65UdkaPwzTrdlzFilgyziBBSCBvf3R+UvBs2vgl2lNmDYbxtevRXSvmfZu/rhwyfAOi8/f/NXvqPJ",
-	"XQWTrSujD3H7RhVcOKmVsLWaZxVfpXxj7969tcAr3H2Ulzdo4yhLht06L05DRD0O1S4g4GN8AwiOa7+e",
-	"w8WdUa+QzCq9BPyEW4htnLjReuxvul/RG9Qbb1fvHetgl2q7ztzZTq7KOBIPO9PkuFk5ISuEURixQm3V",
-	"pwNaAMvXkF/4PC2wqexu3ukeInW8oBlYhzCUwYdekGEOCfQsLIDVVcG9KM7lrv+Y34C1IR74DVzA7ly1",
-	"KSiu83q/+5jcjB1UpNRIunTEGh9bP0Z/8304GCr2VRXeZOPjvEAWzxu6CH3GDzKJvHdwiFNE0XnsPIYI",
-	"rhOIIOIfQcENFurGuxXpp5bntIwF3XyJbD6B9zPfpFWefORWvBq0utP3DWA6MHVl2II7uV35TFb0YDri",
-	"YrXhKxiRkGPnzsRnyR2HEA5y6N5L3nRq2b/QBvdNEmRqnLk1JykF3BdHKqjM9OL1wkzkP/SeCUxQ6RG2",
-	"KFFMagIbielw3XGyUca9MdDSBAxatgJHAKOLkViyWXMTkmxhLrJwlifJAH9gAoB9aV9Oo1CzKOFYk9Ql",
-	"8Nz+OR1olz75S8j4EtK8xKrlhJQtTsLH6PbUdiiJAlABJaxo4dQ4EEqbjKDdIAfHT8tlKSSwLBW1FplB",
-	"o2vGzwFOPn7AGFng2eQRUmQcgY1+cRyY/ajisylX1wFS+mQKPIyNHvXob0i/+6I4bifyqMqxcDHi1coD",
-	"B+A+1LG5v3oBtzgME3LOHJu75KVjc17jawcZZB9BsbWXa8RHZtwfE2f3OEDoYrnWmugquslqYpkpAJ0W",
-	"6PZAvFDbjB5+JiXexXbh6D0Z2o7PUFMHk/K83DNsobYY7YNXC4VSH4BlHI4ARqThb4VBesV+Y7c5AbNv",
-	"2v3SVIoKDZKMN+c15DImTkyZekSCGSOXL6LULTcCoGfsaPMge+X3oJLaFU+Gl3l7q83blGTh1VDq+I8d",
-	"oeQujeBvaIVpkq287kssSTtFN2ilm2cmEiFTRO/YxNBJM3QFGSgBlYKsI0RlFynPqdNtAG+cs9AtMl5g",
-	"Nhsud/ejSCgNK2EstEb0ECfxOcyTHJPoKbUcX52t9NKt741SzTVFbkTs2FnmJ18BhhIvhTY2Qw9Ecgmu",
-	"0bcGlepvXdO0rNSNtaKUs6JI8wac9gJ2WSHKOk2vft7vX7ppf2xYoqkXyG+FpICVBaZITkZg7pmagnT3",
-	"LvgVLfgVv7P1TjsNrqmbWDty6c7xT3Iuepx3HztIEGCKOIa7NorSPQwyejk75I6R3BT5+I/2WV8Hh6kI",
-	"Yx+M2gnvd8fuKBopuZbIYLB3FQLdRE4sETbKMDx80jpyBnhViWLbs4XSqKMaM7+WwSPkZethAXfXD3YA",
-	"A5HdM/WqRoPppuBrBXzKFd3JgHM0CTPn3UR5MUOIpxImVDoYIqp5dXcIV+fAy+9h94tri8uZfZzPbmc6",
-	"TeHaj3gA16+b7U3iGV3zZErreEKuiXJeVVpd8jLzBuYx0tTq0pMmNg/26E/M6tJmzPNvTl699uB/nM/y",
-	"ErjOGlFhdFXYrvqnWRVl+xs5ICGTutP5gsxOomS0+U2KstgofbUGn5I6kkYHuTNbh0N0FL2RepmOEDpo",
-	"cva+EVriHh8JVI2LpDXfkYek6xXhl1yUwW4WoB2J5sHFTUvAmuQK8QC39q5ETrLsTtnN4HSnT0dLXQd4",
-	"UjzXnqTZG8oLb5iSfRc6xjzvKu9133DMfElWkSFzkvUGLQmZKUWetrHKhXHEIcl35hozbDwijLoRazHi",
-	"ipW1iMZyzabktukBGc2RRKZJptdpcbdQvuZPLcXfa2CiAGndJ42nsndQMU2Kt7YPr1MnOwzn8gOThb4d",
-	"/jYyRpz1tX/jIRD7BYzYUzcA92WjMoeFNhYp90PkkriGwz+ecXAl7nHWe/rw1EzBi+uuxy0u0TPkf44w",
-	"KFf74fpAQXn16WdH5kjW+xEmW2r1O6T1PFSPEw+WQp5bgVEuv0P80CGuctFhMY11py1b1M4+ut1j0k1s",
-	"heoGKYxQPe585JbDhJvBQs0lbTU9JOnEuqUJJo4qPabxW4LxMA8icUt+teCpbKROyHAwnbQO4I4t3SoW",
-	"Ogfcm+a1Bc3OIl9y01bQY/QKdPuWcJjY5oYCA007WVRoJQOk2lgmmJP/rzQqMUwtr7ikKi6uHx0l39sA",
-	"Gb9cryulMZWESZv9C8jFhpdpyaHIhybeQqwEFSipDUQVMPxAVPyJqMhXEWneEHnUnC7Zw3lUhsfvRiEu",
-	"hRGLErDFI2qx4AY5eWOIarq45YG0a4PNH09ovq5loaGwa0OINYo1Qh2qN43zagH2CkCyh9ju0TP2Bbrt",
-	"jLiE+w6L/n6ePX/0DI2u9MfD1AXgC8zs4yYFspO/enaSpmP0W9IYjnH7UY+Sr+6pwtw449pzmqjrlLOE",
-	"LT2vO3yWNlzyFaQjRTYHYKK+uJtoSOvhRRZUHslYrXZM2PT8YLnjTyPR5479ERgsV5uNsBvv3DFq4+ip",
-	"LW9Bk4bhqNaSz0wc4Aof0UdaBRdRT4n8tEZTut9Sq0ZP9o98A120zhmn/CGlaKMXQr50dhrSE2Gq5iZD",
-	"M+HGzeWWjmIOBjMsWaWFtKhY1HaZ/Ynla6557tjf0Ri42eKrp4n01N00qfJ6gH9yvGswoC/TqNcjZB9k",
-	"CN+XfSGVzDaOoxT329ce0akcdeam3XZjvsP9Q08Vytwo2Si51R1y4xGnvhXhyT0D3pIUm/Vcix6vvbJP",
-	"Tpm1TpMHr90O/fzmlZcyNkqncg62x91LHBqsFnCJsXvpTXJj3nIvdDlpF24D/ef1PASRMxLLwllOKQJf",
-	"q4R2GlKmN5Z0H6uesA6MHVP3wZHBwg81Z9301J+ej95NFFTa0xUM20PHlvsS8IB/9BHxmckFN7D15dNK",
-	"RgglSs+fJJmi+R752Dn7Wm2nEk7vFAbi+QdAURIltSiLX9qXn73qB5rLfJ30mS1cx1/bOm3N4ugOTKYP",
-	"XHMpoUwOR/Lmr0EuTUjOf1NT59kIObFtvyADLbe3uBbwLpgBqDChQ6+wpZsgxmr3UV0TtF2uVMFwnjZX",
-	"XXtch4U8onTrf6/B2NQDJfxAgWNoG3XsgLJ9M5AFaqRH7DsqxbwG1klEhJpgyBTRfTVdV6XixRwzWJx/",
-	"c/KK0azUh6oNUbbxFSpC3VX0bGJRGs5pIcihcFD6ecT0cfbHa7tVG5s1ycFTD1BdizZ9uej5CVBFirFz",
-	"xF5GRVXpraobgmECE71xWl0zGslHSBPuP9byfI1qX4e1jpP89DT5gSpNVJqyKTHV5KbEc+fg9pnyKVH+",
-	"nCmnm18JQxV44RK6b16bB+De7BDewHaXp2spiVKOrnHLNZkor4v2ABxdkcGVkISsh/hrCv1UZeK6VQPO",
-	"sFcyVVa/BMGgJiW9oGxKB4XK6jmXSoocE1WlrmhfqneKn21CTq++ITcccX9CE4crWfigCcXzWBwthRAY",
-	"oUfc0NAffXWbStRBf1qsCbvmlq3AGs/ZoJiH+h3e1iikAZ9rFAs7R3xS6Y7vEjlk0h2eNW6Ta5IRPr0Z",
-	"UR6/dd9+9KYFjEm/EBKVCI82L/iRNRAriVqneQjLVgqMX0/3/bF56/oc4VPcArbvj0LlURyDXH9u2eTn",
-	"Hg51Erze3svs2r5wbX2CpObnTpQzTXpSVX7S8eouSXnAbuUoghPeyyy4jyLkNuPHo+0ht73hKnifOkKD",
-	"S3R2Q4X38IAwmkonvSpaTmglisIWjMLEklkShEyA8UpIaOviJi6IPHkl4MbgeR3pZ3LNLYmAk3jaOfAS",
-	"Pdwphmasd2/cdqh+eiiHElxjmGN8G9siLSOMo2nQCm5c7ppyvI66I2HiBdYB94gcllxBqcoLUQW+WugV",
-	"YUkxDse4Q5mn7gUwPAZDmYi6Y660695EYw9RF3WxApvxokilfv0avzL8yooaJQfYQl43KUKriuWYd6Wb",
-	"iGZIbX6iXElTb/bMFRrccrqoqlGCGuLKSmGH8aHLYof/pvJjju+MD/S4dqhhiOoorpd9aRg6mZJ6HU1n",
-	"Rqyy6ZjAO+X26Ginvhmht/3vlNJLteoC8onTT+zjcvEepfjbN+7iiLMzDJK+0tXSJE/AwD4ValGi2tg8",
-	"++1yJbzKBllg0aHU1Lrbb4AYr1o3x8tvJLw3SrrB6X4lD+VYkG8+GpPOrX8dZznby4JGXxxRhBC9LUIo",
-	"0tbZsaggCgpynwe9p0mGAznbphMfRggN4WZDgL4Psays4sK731tmMcSsj3ofvkOYEg/bbnB/ET6WfNRi",
-	"9/3lWNx3SMaG3/tVrS7AP5mvNFwKVQfHdoh8Cioh/dqpEdVE3ifXPzS84lSf1xw6arw999UFaJleJ//+",
-	"F4qTYyCt3v0DmHIHmz6olzWUdsk81TZhTWLqSYmqO7filESFqZx4XjbsVOw6UG9sQFYvp4gDw/ph89lp",
-	"ca0LM5VXcUajpI5duhrYeNqpNtUUHrFKGdHmh0+VCZsYYniOlb6itFnDsUJ8zyXkFosCtHELGuA6SbTc",
-	"ZFHh0X+lnxpRp5tITJ91al+qqWElgAN3/OA1WPSikbKoH01PrHTSRKchn8ZsyCuQvvZn953H5Gjz5RJy",
-	"Ky4PvL776xpk9LJrHuwyVMM7eownmuhlTN5yfatjC9C+x3F74YmSKN4anLG3Nxewu2dYhxqSad3n4aq9",
-	"Sd4OxAByh8yRiDKp6A8yJHuHvDANZSAWQrQVdYc2A9poRajoLekN5wok6S6O9n3pninTJWkmzeW6XuvV",
-	"NQbijj3QG1a0GNc/XmIBEdNUawx5P2ItnZ0OsyNe+bwh+Fay8Z2EDCJgwm/hYTTNUooLiGtWoafqiusi",
-	"tEiaXoJVJ9tzHw1e1YVqDH2gl83Moo2NHb6jSuTbwgjovFROjMjGwsi74ahNLMc9Q0E3lP4dA20dXEvQ",
-	"vrYfyr+lMpBZFWJp98GxDxUUWXQjJJjRHJcE3GjmmTdtah3M9csx0wz3AUXxApmGDXfQ6SgBzvic+5D9",
-	"gr6Hh0Mh1+tBC1NDr4eLDoSoaGEGSIypfsn8bXn4QdJNjE1CSqofbVLZcCTorjek0qqoc7qg44PRGOQm",
-	"55raw0qSdpp8uMqejhC96ryA3TEpQaFaQ9jBGGiSnAj0KItCb5Pv1PxmUnCv7gS8z2m5ms8qpcpsxNlx",
-	"Okzh06f4C5FfQMHcTRGiB0cq6LAv0MbeeLOv1ruQsqaqQEJx/4ixE0nx2sGx3c0h3Ztc3rP75t/irEVN",
-	"WbW8Ue3onUwHvmK+K31LbhaG2c/DDDhWd8upaJADCWK2I+mDNL9K1JM6mqqVD13N/Ro/LVERFCmZpC1f",
-	"cyBOpgmRaSt/tGEyQ+mgLNVVhlSUNfm/UjqHa9dlkiHjadvNYXsBUbwNN/4C3bE1L1iutIY87pF+4kBA",
-	"bZSGrFQYfpPyDC6tk4c2GNcsWalWTFVOzaU0esGHkixLE811VyV46LkuQZCRw2ckIQIY/zzXg0uNh/Du",
-	"qYJz/Qo75+uE3QY3LOzWtcvoeIK7dvWLCMwJhH7YZnWSqhLUXVe/XtVY9TirNiJPo/ufK1plNMYkRb0p",
-	"VPgEtPQADpvhAY95SuOcxNMzRDNIvihTHhvmj5930iCdu//iDdYfly3BM5cRfpZ4gLlv1anKT4ldbaby",
-	"hanCm8oRCkk6vPf7l6ka4GKql7nJOD2RGUQAjPudOzBM8j5fF4wlVtfMeALJp43MP+8UPxY9jheyAdLJ",
-	"zjnp/Gtgbuxag3/jR2UAe3WHKm7XQQZwzYeaudPywOADPCqewg3ZkYI9y9cg7AtXqspKuISOO94/PKzz",
-	"HIwRlxDXL6TOrACo0Lrb1zlSfuaYt/cEUb/2LPJUTsFuUjIlxNJOsQNiZ1JI3sqMjomZepQcRJeiqHkH",
-	"f+YWldzGirglLp8A6/tpnOLaTCK9uH0s4mBkCNJ88lzKdGBI/O61MSnhbEVjeiYibE+2qfiVHFfBhkTZ",
-	"yk7TayBGiP1mCzneQ93Ih9vjhOFgzPTetI8KTbrZ4Zuq8qNUto/IBhUhk1KbgVDRN04/EwRf3zch7ZLR",
-	"UZjEAMK0vAHjKKGN04uabfiOFWK5BE1uFWO5LLgu4uZCshy05cLpmDtzcwXDQatrmB/UMRynxkEDs0pp",
-	"G2ghJEDKnVfexuT/CXI7+tASMjtd21aNFasc7Er6YQffOj0HI9xGiMA/SUcthw6rkihisg2/gGvOY8Tv",
-	"sH8aTBTjrbBW4axTpvi4l9Z/QtThgf9ZCruX2kn064cckk+IiDHQoFy1jmnanCENpqJEz6lkUhwp2q9A",
-	"EPaaDFQ0H4xkVPS8M0Oeava4fMFEtZJyb7IbigMDZkzAzH0E7bWkhb65IT/AlJIseuRMdGV1tUTqxE2h",
-	"iwnjBhp2PO9HtHSvoGbbsfpnXmsUoq747nBitvYaSgcD08hBnQkxDg3UfquJwAwVlEjmPbuOeJKg+VRN",
-	"hWHGqbtfDEW5t364P2453tKeXkBcoX0/vbWCfCCVBK1xuUsdnWBLvsECx6STCXGad7ZVzWn5IzYoyaJv",
-	"loh0EmjDmL0ENqPKwfvDKOI8xe0DaE2hn+h2DfpQn1/80OpJ02oYhw4HwIuja6IqxsHR4cH5zC+Jf2iQ",
-	"Ei3l/RgldJZ/KGDHL7BVLKMt8rKatUBZ4+n1WXdfomgs86IJchoruN2PhcKkxE44KMtEDBWJj1TiNiIc",
-	"d0/qS15++jgozFZ9gviA4s245zQOpImRTKg0N3vG94pPmjsKmrm7qeVrjNv6K7g9Sl4LfiivsQ6YPwr/",
-	"vCQr/zLUu7wEya5wTAr6fvQVW/g0J5WGXJi+JnwVSlE1cSNYmdE/ndzaA4Eqh9b5i7K3IONlMCyxH9uy",
-	"NmjIXskWwvaIfmamMnJyk1Seor4BWSTwl+JRcb7RA9fFRScavJXqohtNabjjqPDofdc1o8KHmVSnLo8i",
-	"n92lUxsYrnPybd3BbeKibtc29UnDELn7ap9MeYmQLmnkuuNTCEII1gNjCCr77dFvTMMSC/4q9uABTvDg",
-	"wdw3/e1x97M7zg8eJJW8T/YIgnDkx/Dzpijml7Fn8fT0eyQDQ28/alEWhwijk0+jLZmNGSN+9Vl7PkvR",
-	"7l8pMHN4VH3h1FtEkxNiEmvtTB5NFWXKmJAkw3dLpMTAoIe81sLuMJlw0HjFr8nnGt81ob8+dLwx4fm7",
-	"z6oLaNJRt4HCtQm363eKl3gfkWVRultIlUfsmy3fVCX4g/Lne4v/gCd/elo8fPLoPxZ/evjlwxyefvns",
-	"4UP+7Cl/9OzJI3j8py+fPoRHy6+eLR4Xj58+Xjx9/PSrL5/lT54+Wjz96tl/3HN8yIFMgM5C6rrZ/8bK",
-	"9tnJ69Ps3AHb4oRX4nvYURFdR8ahPC/P8STChoty9jz89D/DCTvK1aYdPvw685mxZmtrK/P8+Pjq6uoo",
-	"7nK8wsjAzKo6Xx+HeQb1e09enzYuSDL6445SUongzAmkcILf3nxzds5OXp8etQQzez57ePTw6JEbX1Ug",
-	"eSVmz2dP8Cc8PWvc92NPbLPnHz7OZ8dr4CUG0rs/NmC1yMMnDbzY+f+bK75agT7yNYvdT5ePj4NYcfzB",
-	"R0h+3PftOC7/dfyhE0haHOiJ5YGOP4Sst/tbd9LK+gDaqMNEKPY1O15gMq2pTcFEjceXgsqGOf6A4vLo",
-	"78c++0/6I6otdB6OQ7R1umUHSx/s1sHa65Fzm6/r6vgD/gfpMwKL3toe2608RvP08YfOavznwWq6v7fd",
-	"4xaXG1VAAFgtl5TFe9/n4w/0bzQRbCvQwgl+GN/uf6V3SMeYW283/HknvXG3hFT0+M/SACmmIffPTubt",
-	"a7jmyJ4WofHZTuZBQg1vSvEgPn74kKZ/iv+5myrh3detiVrhZw28mIgVw4sRhkefDoZTic8vHP9ixJ8/",
-	"zmdffkosnDqdXfKSYUua/skn3ATQlyIHdg6bSmmuRbljP8smY0+UCThFgRdSXckAubvc682G6x0KzRt1",
-	"CYb5JMMRcTINTkwhZxI6PFoaxtuFrwwa87EG02xOb5nfo2BkUzJCsNcMZwq2qnbw7qn47uCZmL4LXdFz",
-	"T/D4JDgPvPag4Ydy83B/m0L8PfcETXUvtUGzfzGCfzGCO2QEttZy9IhG9xe+gILKB8vlPF/DPn4wvC2j",
-	"C35WqVQk8dkeZuHzjI3xirMur4jKfD1/Oy2/p3cwkO24ACN86RPUG5xQ3Ir1uuFI4cxjBEG01/uSt398",
-	"/w9xv7/gMpznzo5TED7XpQDdUAGXw9Rv/+IC/99wAcphyWlf58xCWZr47FuFZ5+cLf5hqyQn2EQ+0C8j",
-	"n/r5+EO3jGFHSTDr2hbqKuqLJnPy9wx1h6awd+fv4ysubLZU2j9qxTITw84WeHnsM9j1fm2Txgy+YCac",
-	"6Mc4vDD563FTxSf5sa+Opr56dWykUYhQCp9b01Rs6kEO2Rh53r53/AlzxHvm2Vounh8f40OxtTL2ePZx",
-	"/qFn1Yg/vm9IIiT2nVVaXGKeoPcf/18AAAD//3zWrY3S0gAA",
+	"H4sIAAAAAAACA+29abPbRrIo+FcQei/CtoY4R976thXR8eZYst167UUhye77xvLYIFkkYYEAL5az2OP/",
+	"PrnVBlQBIA8td9/wF1uHqCUrKysrK9dfH6yq/aEqVdk2Dx7/+uCQ1dletaqmv7LVqurKNs3X+NdaNas6",
+	"P7R5VT54rL8lTVvn5fbB4kGOvx6ydgf/LmEQ2wb7Lx7U6r+6vFYwVFt3avGgWe3UPsOB27sDtjYj3abb",
+	"KpUhrniIZ08f/DbyIVuva9U0Qyi/KYu7JC9XRbdWSVtnZZOt8FOT3OTtLml3eZNIZ2iWACKSagM/e42T",
+	"Ta6KdXOhF/lfnarvnFXK5PEl/WZBTOuqUEM4n1T7ZQ6TC1TKAGU2JGmrZK021GiXtQnOgLDqhvC5UVm9",
+	"2iWbqp4AlYFw4VVlt3/w+PsHjSrXqqbdWqn8mv65qZX6RaVtVm9V++CHRWhxG4AwbfN9YGnPBPswcVe0",
+	"gO4NrQbWuIUJygR7XSRfdU2bLGHdZfLi8yfJhx9++AkuZJ+1rVoLkUVXZWd318Td4fs6a5X+PKS1rNhW",
+	"sNfr1LQHAGj+l7LAua2yplHhw3KFXxKg1cgCdMcACeVlq7a0Dx71Y4/AobA/LxVAqmbuCTc+66a48/+h",
+	"u7LK2tXuUAEeA/uS0NeEPwd5mNN9jIcZALz2B8RUjYN+/yj95Idf31+8/+i3//H9Vfr/yJ8ff/jbzOU/",
+	"MeNOYCDYcNXVtSpXd+m2Vhmdll1WDvHxQuih2VVdsU522TVtfrYnVi99E+zLrPM6Kzqkk3xVV1cACZxu",
+	"ISNgVRkMleiJk64skE3haELtCQxwqKvrfK3WC+S+N7sc9mKVNTwEtQOOWBRIg12j1jFaC69u5DD95qIE",
+	"4ToJH7Sgf11k2HVNYGKtVtVapepaSwE+Ev65A4YAsy8IkKLaNvqOXGVFQTdPdjgUOVC+vVkz4C3bvIHN",
+	"AE6xqkq4Tldt4gxMyMmKBm81nB4wUMJIOOzViyfpB39NGB4zl4wRW7a/iMCKlxVceoAMXLG6Jf6Xroqq",
+	"ASZUTVzI+o6Fc5a4V6i9nZvjrufkFa4IJ8cPLF4QQko8xQXILC1RMkzXECr5MgbC2CR3VZfcEDkW+Rvq",
+	"L6tBNO1xo5gcPckB2VUMcwNkTCCPwQ2ibJ/B/Dgxgl7A9uvdAxyAlAnLlbUCSLVqu7pcJBV8r/XvSwUM",
+	"K6n2Od4wF8nXqsGRHAQ1qlAr/E2obF21zpTIuhdJ0wGaAXE/LYtq9eaiLtc/XSQkCTbd4VDVpjtC9r9f",
+	"fvO1XGoxBMmCx+U7zX+HWCk3+bYDBABhKFqrh5Bq+TMsCI8/QVLVyVdAL9lWPc9WbxI4yHg2LpJnG6CN",
+	"1mERwlMIldgzCjzDFRL2fm4q5A37ZnuAucKSXZHDXgxX9VV2m++7fQIjLWFFsMtalDA7GwOIR5xgSfvs",
+	"djjpq7orV7TPdlpPpsczmDeHIrsjhMEgf3u0EHCAfIB3HkC+RQprb8uoPI9zT4MHDKAr1zPE3Rb31BGw",
+	"moNa5UBS68SMMgKJTDMFT14eB48Vwh1w9CBRcMwsE+CU6jZAM8jz8Auc0q1ySOYi+VYuOfraVm/gvtGE",
+	"nizv6NOhVtd51TWmUwRGmnr8pMI5UimMt8kDNPZS0IFsl9vITbwXWRjvoQzYPN5XDDQMxxwqCpMz4fi7",
+	"dyjNLUEA+MtHMVnPfp25+9Czt+ujOz5rt6lRykcyIELhVzmwYQnb6z9DT+DO3QCvhHnCj66kAR5VkFSS",
+	"SEN4g12EoXBGmq+rIBDybcq/Dmgp375CMWCTFyQi/IwkpHeia4gPeXuhhQYYssyAaanHr8uH+FeSgiwP",
+	"O5/Va/xlzz99BQPlMAn+VPBPX1bbfAU/RfbTwBp8+1O3Pf8PxwvfCO1tENtfVtWb7uAuaOXpUOAcO7jv",
+	"wcVjHns2rozixX0Dv7rV7+JjewAUeiMjQEZxd8iw4Rt1B1Iv/CNbbeh/txsi6WxT/4L/AykZe7eHTQi1",
+	"eJREKiDp6ur5s1fIC1/Ij/gbch/FL1lH5r6kmxx+s4AB/zyous15KF5BkCHDF6PywtkuBu9RpPEVjEYj",
+	"5a3aN4GDYDpldQ24wL9xtPCkzOLhthYur1npf6b4bkph4SmtPNmpbK3qAEi/uWf0e16fAVPPbXHMQhbj",
+	"uM8kSnUDkuFK5G0caZ0ABBob0ENvRHOGnaBRfUz+T7gZAJL/cWlVsZfcvbnUUw8R3MOAjDtnyXrbnWWa",
+	"V1YJ0iavedmA7Kme11W1OcOyi+oGnuVRNS09h+D5QGK/1X3iu2et2aT8CpK8NLvBd6mnK6X2iiW9NjSk",
+	"auQRq7KNvDX4TQvQJUu6qcxzE7vuYakXMxU0mreg0Eyrzcu1ug2vlT4Np2K4wsu2UJj7b4FIrjbhKUSq",
+	"5xcEPap4tow3NqGuCxFudhU9yrCbqt8U8tXwhZsKIbtWBIUVPu5aFWIW0DUi/eGXwKrl2W/2yIDKKn7z",
+	"NwkKCxRf+w0QfoMw0pRgo0NrpTSHWPWiWazEdaLyRtV8B2dbkO6aVp7oFkhos+zgItcCKZ4UeI/TB3qZ",
+	"ZbWzNjgtZQPSatMCV5mJtaAA90qv29s8URYB/WdtmDLgoXv8gdtUBZDu/APH7Y8/bwTcGc8bL/bU8xZc",
+	"9QL+8XO2csiTmQTw5xDCe9zYZQD6lMrBWBih2oV6Dt8mXoz6xpYUh2J/22VIf6giqmo+HHyY4TdzEqRN",
+	"Ua2ygkmSVFOlfypwXP0cwBuAJ7iyTc5wD0DblMBICYzJ688O/SX2ekmdUHnL13UK4x0xxnNUiTWzThs+",
+	"lDSbyNE2yHSHkmyOj9BCXWdlO4MUeKZZokgU4aI3XarGZXvvNO7+ebuLisptUS3ND+/CqBaD9B1+YXyQ",
+	"VlHlpJpSt3nTNu/R8jMryLvzgBSffOGOTUrpCtVrSyXKFuS7G81g+R1vrKxNnx/DOmg70Wzp0B0S7zko",
+	"jhTsckFM0go2/ru0dckMf5/V+d+DxFzcxomL2Ie+Wkn3Tb84Su93e5QzJBwxfF4kV/2+p5ENjjJCMM0z",
+	"i8VzEc8R0rqP3qqrVyr0NEIlVRp5H33bKCaNQ7bNSyOwwM27z97wRrDGHClANUYlzETELyuj3BZ1m+D8",
+	"4iS542xkKshcnEivoa3V2jjS1olW0ZAJiIWqWOP9px93ILrZm9OlnSfcwGG953jrOZfUETRkJ/iTdDTp",
+	"eJg8gYBG9jdKQq5JczYBEd2dk3SOZEB0T/1JNn2yOZnzBPd1gutMEstzNkKd434ae9/pq9N/LAe3hB0a",
+	"wsPAjD+zyRVEvgxfn/6A+MLeo88DPtFQmCSTA9JNDsjQBrda3WT1OqLGsFQ+Nr0nj/SXhS+fNTkbINq1",
+	"1IHq5tnnxpH7+scHLV+y+ikooSlZRucgK4yNyfMhQ5J1m6UlbdV0jaEBP8ZDVRXsd4E0hgaHatF7/hZA",
+	"umilcCcq1HrrneXoDW/U60JQC3vpuyg85iD62PUer4JStmW6xObiwT94NbESgOEch0+0TxEdd52t3iDV",
+	"SiufQK1S7xjB0oF/UiFsoJupWjhUDbzoUMq/RoPmwU410LbppTmaMBfLL9Qbdfd3eA1U9d2/Eo/r2h0p",
+	"xMZHYvepFp4l2KGq81/00QidLj1hQEemD3zDrktwyhhlSKjVTZkAkk5Qde0Yr6g+qdt0hFVs8tqc41V1",
+	"rSkPQZMxHosjCgBi1qOyGhhDzf20S1KbvCkRYlGE8X4HOReNFdE27rJy6+h2HeSGNxEOTLFGXkQrOfao",
+	"EBGGWPlbfO8YbhjaNIOtE6SS0AmzQgnpOJmKY2h2uaeolgVG/IoUKtvln+sTzvKMrRpdqCzups4OvDL5",
+	"wrZyuE0z40rlwto8zdoMvSO+gRH3+S/n4PfoB5+ivBehcOuU1JXooEmy4dCYsRbImFUUGVD4XmVNV7NL",
+	"5/BUgUBVK1RXZ0XQOdP4lA2nIPqlb84gSBTVj9fZqgMxZU9mDKZ33HcP9FVWOhqaAqAkg4oDpvELXDzA",
+	"laQqdrbQ0VsEDPQK5F3hU4UhB+zz2ijYGmA7TY7XDvGjQ7Xaudw1LxQaXequjEhPDEZdVxE+T58ikGyy",
+	"vBDfQFKKZuVd8CqhOegwH71Y6jWx3OC66AUHy46sivg2TEZTa2MuvsWQmtcdLsuFYx66c3Q6LImMzDAR",
+	"Q+ScY3Gm8yBSXvwsINW6OL/JGv12RYEMGN5NljvOUCzfil2IvAiB3vN1ocKErk/CCbzAHCK5iQf0sUia",
+	"CsiwnkPp8KU8Cg/MDYCpbXsWZXdxXTm5JHdQlG72h0KRcG7oSDCKiC+qbB3eyd516bBXn+dp6rI7P9gD",
+	"iwxZwdyXBb4nOkOTS5Brt/L08dYYo1m+cuwdArfNs7OoDt23xbFqQwLiTyXQ4CF0lKAV29Oo7sf4Kmiv",
+	"A9+82qeU8wtTrt54dEEuLntQkXXwqSrarDmPsdeoUMNbzc/53tPgBgOYkFF7QSUcDkMtjVOHcQixD2vf",
+	"UDn70RBCwUmvB1mDt7A5ROqi6lj1yHwssrxsTfxa7aXqc+z3jkc6TlvN0//JrjQlaCQexa0C+zjJqKxh",
+	"l/WlliLOz5vYLBEBvM+PPkW95hMkqw1Od46n20qFRHVnDoMdEI1I7oB3j1WyXiTPSJGj9of2zoiNW1Wq",
+	"Bt3CqMmD/j6FVTn9xQ1tFgjqrE03oK78dWQaIo3Lv2fN7gxIXOqxhpikacRbF72NdtMuu3a0OYvFhs7a",
+	"jGOwWSL9fbZF0mgTy0QZ8Khdl1HDiOBvc1DhArEgJlZ1bVRB2SOFc2Ho98LNInJUv6F/ZIVP6zQsemrm",
+	"5CBSOckeHE0sz0SPWEXefHsOsEvQP/Zs55bRMmcDP+OYPqFkWYTZoZcVzHEm9xWxt8TUxByac7PDMFTA",
+	"HcauSg+Qt0CiojBjGzKkAQu/RnmAdA+s/y5wUVaoeJJJQF55Ext8YSxkV3ELGSwxr0IRP4YlcgsbR23t",
+	"ZfgmFSKKKhjEGpLG5nk+OnpV5yiTYBQvjzQ+T4jRXAU14uz7qcd0j/fRZoRRivBZRwjyRqlA75dK+Z0X",
+	"kU3GRgWlEmA7Q8hB2qZO+H/f/V+PMWVClv7yKP3k/7r84dePfnvv4eDHD37729/+P/+nD3/723v/638G",
+	"A1oA1DnHAtvRph5zFDhGGYPJRvAUxwzp7x02p72q1R+AplYdxo4Zfg+BjCbEyNmlT+OyGDUZUOGsJ4Xh",
+	"nt9VbdD17rrepML/A1HNcjHgvN+9+JyjAwwkDBaaxBSmeiAfDzSsve1t6V88HpPvMWLDK4dczeE/1ied",
+	"CNY7HgNyFqrQO+mjdM79Z/YoWSt4oRRNiIL6cmx1e/ZXCYwZlK+q28GLpLpV53ggL3Gc2c9jmPWpQFbV",
+	"k/Z+HnuWAAkLxPivZhgEgLPY/DFXS9ipk5bd4xdlYrPiJBmO6hjtFv23GjbtDvFT+oQb9AayicjGj0t/",
+	"+BDGPCy8RIvN2bFAdqBzYMEf6NxYAKrMi3O8wHfBdyPq0D/8IHn596uP3//gxw8+/guZidBAke0TZKVN",
+	"8q6WhZr2rlDvBZUzFFIdHv0vH+kcGv64wduOHLb3WeDK49wcotujZgm2G2Ktp8zBVRsAZ+nzFD5yGO0J",
+	"px9C0J6q669gEVfr6zM5L81VZJKFimVbGGDdrWbZco7TX05PiBjIG1RT7ZdnIccYyaztLOtE9mKtJo/T",
+	"sRtsp7lzN7m+q7tzvPuM+XtA4tCurVZVkYLc0uRVQLP6XFok0kJHXhz6vzO0/OKBuUkc6sp1RIGKCVdm",
+	"33w89Kvb0uJm9O7j9QZWJ/PO2Rcf+VZ7ijF6MAjIKstu6+l1N3W1xwxE1JFo9HOlPmvafH8epaWSoSK2",
+	"k40CQVQ3oWczGU0zyitBJhE6UpS10XlnzdoAZyEhIZr8H2YFrBoAWaGAU3VkhI+EraKXKCwsEtIsrrVe",
+	"ak7AwrsUf4sRpcDZ30s0ZejX1esS96+tMEFbjlkHzbNLe0mVqr2p6jeGxmdwOLs5HjrsCubQ3OfuFkro",
+	"1EbdsA6LDhlvH7thfaFa0hC9yvcKhJL94ZvN5jwxchUNFEA6zNTgTAm3cJxGZqBIRp2DiP6xMy6XcQAE",
+	"Iy/vyhU92H/fO1GTXgPTORafo7yj51+KMXTwVO80AXAQHV/SZ2vA/LyqX9mj8gW0O5z9EdWfc+5yMu0j",
+	"wsbLNfbVwYPwvfAd19Ep43ARWuMfsqAn+nKQNRD0jQWPtJ1nCQNiBjXGa49z2D/RgZizw+CiSP/xRh1a",
+	"3KzH7ocZHsRhiLh/LFMMfvNM/DZh1ZybzN2QSTnCR3fMb1fgnSvdWvRQzIe/Rxh0f8gae0EjDeXbXetY",
+	"RU7PwTKKmNAs8bQDpI8psM/QAvdltd2eLZ4pZ0NPWnUtiArpJi9UjD4LZfzROS0p3PHo4SCD0J8teqBt",
+	"qfG4S6e6VkXkhOEnN0EOjgh79zh5BHtX5qtF8n6yyWCXF8kH7F+6SD4EwbhGTrdIPiKpkfwOP2YxMqI2",
+	"7pbNXaOJOuDmYb6LcrpgvJOb7lLRowLfLd5pQUXP/MPCA77UE00eGMaaB/rsR19Xkq+qWYTkycxcNbgJ",
+	"LflKwU6tzsFSMadikS1VkcZjzvaD7JaNqjEnoMowEyDBAmImphIF3vSI7i3Mr4GZLSNyLcMfEZdtplxp",
+	"d/oWMqKeOnNM7WEPIRbWuTsp7d1l9CODvoZ/vCRXyzMo0uxgfsyD+zbLlmgUz/i4spNnWMUWSQz+ynkd",
+	"OFo7MsDlOk3tKuuQH2LWuyrEU2zHNFsxwlNinpMettyKp+Ok0wW87NboiK/gcCwlA6WDZsx366UgYgVf",
+	"kBgduAAjK8zTs07Ho9ksaMZ/iJ6/7QieCHAC2MyivZvvC+yb60k436i7lDJyN8m7//gOU5q8dXjp5p9A",
+	"LLUJodf4MYiv4xDqedOPEVx/cpfsUHAzL2m4SbWbdwyFR+Ekun99iAa7eH+06ExfvyvFm3Ri9yIgA+rv",
+	"TO/3hbY7ROpMiJEC9Qi4YWVWVvr5Ho3dmWLLpCB2LSm4AocTRgN2Im+dL+Ebm/zzck3Gx8Yqovn5g1PE",
+	"AY6qUnHk77QWdTi2zRKnVaomPXloDeQOG53ra/iq54Jts2MbvS2c4a5RUyPHsOSML8hqrAs2vmKsJwy5",
+	"0w4XR/l+8J6/i8c3aSAsIsYAeWmyuVvsujnWI4CgI5jpSYRDKfxcynECYuAFeDggt2jTrjT9Ymh6ya2v",
+	"2m9t2yFxZa29t9eV4gSC0l4gvzEP6HJN+dsEDu3frKN4gjDjYUwpGicdVdWiIhFbuUdg8pB2h20NT790",
+	"rYos4On1LX9O+PPYALTjVmWPSbI5TXp40y0lm4d4fOgqjfiZfF2JH8cKjyAK7pZApPfEyPAfHCHEnISO",
+	"3jFD0VzBLdLj0bJ5q2Nec9CEMmgwPRDIwtHnABzBgxn6dFRQ59Q+JfpT/B8YmifwNPLHTXIHU0SWYMc/",
+	"agERS7hUIvJ0+R5773HgINuMsrEJPhI7shGz/HO4nPNVfqC3zj/U3We3h3meGrrUw8j7+OCOHUl24jZB",
+	"wYNDKVDXAoyjVm0z16vWW8hL7jvYIR+iWeksJgFc6CjPEh6HlJkBH40SM2KerX08n10J158gnKGa3aQA",
+	"Rrd8Dink/J3gLOb9MeFZfo681bcRYgBizrf4GOXk567afi4VvKQBHFPFMLv17byN/zYOjFFPsPVhQMPB",
+	"DT9NXTFLUTPc+oGeJkAKuqbOAPxmAP7Lbr/PzpKBRSf1OGldAkbIjCyeiuQOPsdlfDGZPSlMXx18Hq2S",
+	"4dusG4aYPcVHDdZT092A0FfdBIQQWzWHL3XW5zr+j2Ivb1ZZWQY9bsan7h0fyTPi4dv6fAqUxzNWjSh5",
+	"JlpeOqROPl3qPHGGcEtW+2DkO91OispxoZC9zrNCHOV10pp5NAxDPKkA86tYGsaqa7fVJAhaxCcwzjZ7",
+	"b3MNNhyoZifW8gHlXEUlp9pqK9k0irl3uPM5dLiBUXF29EbFReoiKAiG20Tdwr8wNRMBfSeHpFtKvbCB",
+	"ihdkrtQdIOiVOTKjROf4jjMnXmmhChmoCxuH71VPIeahQ3RgmNZthv/BABlBCOYVzThUuOu5lKrTZblM",
+	"xTcXSJtQyxRMoidSPzHdRfJ/qo5sWcJ0zVuerCv8MKYZUPVg5pSEwRZDqqDYBIOdhw/7C3/4UPYcBtqo",
+	"G13REhv20fHwIR+Cqmk93ncGLoZM8lngMuoXbOjLeNOhlTLy8Qz92VPj44pnigoimeXX+TUg/N58oO+H",
+	"WWR304ljeO6EW4uqKVutFOVa6Gc5JGcR2v18H0lU1t7Owbw76LygVlmOTDCLCztzWPTrBdttWJhEXXPR",
+	"kreGdM+8ab8X9u6JNIssXnhdodX/SXbASlqYD2zG0iu4+jC1U62yvY8CG+GTl1l99yBYvylgROTpyfuc",
+	"nRDQkIdqx20FV2xVJAf8QoUb9C+YX9E6KapbterYnwF/bwKLO/+71Bs+cgVwG73CAFhn8YCSoSImWvl6",
+	"Yq7A/jKn3IM0LEfkLXVR1LDjVN84rpNvXolH+DnuFhkyljWTZ8rvgzgP5EnUWYDm4s7CqM/3vqLyeVST",
+	"xVgqRXK1gbaMUFIUiyr6jH54WgUdTQLMCmq3XtHbTidiVKcz9tDFUtQZzqz5mIwkkS3o1ZoIWKm04pw9",
+	"LFC9S6o5QIfJQGf9uGDaF6LVeSLloc+btijN17Ej5CcnEncs9nfUBa1NgpmRSta9R4Uro/ReC7Hy3L6o",
+	"FJ9qfgYiWrWZcPaBdTHirzqzFcDpxeZ4Cm2kiMcLhWV7X6if1RllSz1YzO1Kfz+RC/ZgnmSCDkCzfasc",
+	"nue9qngs6wNUEyx0jtTKJBx7yQVQz8ICMdQ526qU/d4itnloJY5xlgS5nxVtKQqvcYKoZQm2WiudcJOx",
+	"MchBsXeKQ9f5Wk2HhZuhP4N+35huVAperVKSrFJ2QZw5Fr6EVoqre8+Picn3e7XOoTclh7B1/tAmbWC8",
+	"SF56Gb3aHXTeylXA49j0HFhvuysHQ4Q9THHYdJ1vNvMRxp7w2IWjuFJ6XoU0KVLaVlc0xyuM/CWHbzNS",
+	"zqF6VQA+QjnmYL8f2BCMFFs8iLpM4K5cW5cJxq5fln1OqTfXYOjgx048M+KDUIe8cogvd1/tqSaTGb13",
+	"znXpqXV0d/1bZgAiulSs0Gdu06FKyNYX4JOt5Fl2UrkDZwQqfJijShwV8tPHJHNOiaa1sL7AXJmjsI6V",
+	"m0aA4TFqDqXaoGKp6rG2wPiR13BvR9wcDQaIWYGNRuTKgnAgQZXZodlV7duMK+bwB9HRNQLA7xxaHJkT",
+	"MYCU9PsEWtihg8kwBhM7uc7tx1i6c9viHu7V/g6ul2mT/xIsZ/4LgcBx+V6iSy6jarPiHm0p4yims8U5",
+	"TU1HXhp8GxLoY15vDhfScaLq9oBWAfEhwFQvjS744yLkBMCoqmvdhBzLzLB9g01B6VlAjDBl48lLaEFZ",
+	"hU6JmbJE9ZzAYdKaFG814fR2M4ptu9qZL8qtmxOL1t9WVFWIUrwRBhws8fno0OHwDAYrHghlWwCC3jWu",
+	"a23DXwG0r0zqMsNnOJRmEVYp/DiWhOqERGvf0NevJPlPJNhuNEtbrG9YPfBjJO2QO8+spED3xC/ttiMU",
+	"fopuXWdLFDHf/SEAwpwMBnqaWda3tai5ReCWJ/YSpwsLZwuNK5MWoKcr70vT/SDY5vOqPleUNQ84G6Ez",
+	"gponsStTnhp6jeWth9HKUj8ggGyt98nRk76pVjm9cp+teR9MgLPNw+ws6LkpkHoGptUftxcw5RRj5YAA",
+	"VRxQVwNiZ8mO023drdrXZUYOyT3Prr56QFR5cRf1J7pJ2Cc+oAyUoQAAonHjphzUCATTRmCGBfFUb7rt",
+	"lu/pXv6I16W0gs3pypzPE7kZpcxodGqJC265z+6SDZU8r5JfVA0yQNdTzuy7BjM8ocM7R29RmopqAwuh",
+	"muPw9asc85vgcKcko1g8kOTDaTiv0hf8lbLmyvJ3kkE3mLn47WYV1LCHXlECOTyk2B0E/oE2fxvwE8u6",
+	"/PsHe/zb5CYZnkU+HT2q8TbiHllMzsNlkgCT6bHGk59nw1RkvKhgBBo+tOCc0HnZdCVvpX7Vc21PrchE",
+	"uy2d9SUm0MVuj5PX5cOk2WU6n5n8Cf9EjXrZ7XGD7Hd8zvPXHwKUnK9vh0A+K9fqNmRkz52M5e9gBNcd",
+	"ZXWPZJ6tNsHcTxzq7w67V6j1aXb54Y/IP5ovwxxOJwQXR6vb8lnJGajx/FA8252EyfA77O3C3dZKrdWh",
+	"DQD+wpdwqZXdTaV6Mcb0REKF+IW66Ds6rVHrJVmo4FbZaG0TrHlWpQF9DpjQNFU4WHcXMvONNqQfEnls",
+	"Jk+5/Juz61lk4BBc/TlN8Jr+GxD3zhefvUouhWE27yCo/+QSGmeuCj5dFSVUuiOY4OsoC+JYxZGTbHyS",
+	"IdnTwWaU5Is0EoM0AHieea8Q3Kvnz16Fa3tcUcaDdQItuFLHImlW1YEZsFXWqnJNAaDNUBjF/hEVdmbL",
+	"o9HYQQmCpotsjp5WQ2JGgh8yPNUZ6cHht8fk6rMQ/9RFz5EPUx3BQ64M7aHDSMa2kJZpoB3u4cIg+QXb",
+	"0kLciMuj0K2nRY8e+vnSk0p9tPYhxv9NMDaGKilGOSRHU7nZSS+B4so2B84sr7jX8Eh5qjYgBOL3x69L",
+	"1IVeLuGsrppLEB7qTzlL88W2Sh7rGpbok/a6HOAyWvDWTQV/6JZwEtG3/ph63q9ff4/ax9evfxhE2g8V",
+	"KzJVuGI3TZBK9YlUCrOlUuV7OHFzUCsMMefd596js/qVLeZVET8cmhTumaxgZWN4+cDBcPkeJ6NOpL7G",
+	"MNtaPzbyxtT3xf39uhLJr85utJETtrZJftpnh+8BkB+S9HX36NGHKoEr40sck9QWP8nBwksHgD6lKJUd",
+	"LGThpIWzwk3dwtUbK0wIy29VdqDd5zgXzkpVJNTNK56lk+Vy3UKzgGGB5f4GMBxH1yyjxb3kXiMV4XEH",
+	"8RNtIbXB94QN4z51v5z67ydv10QN+ZH607CqBklc74yu1Jtt8RWlY+tRvU9KbjgWuGS8dRUW4r5Inm24",
+	"NtHC6649MHQtYFuRGp8zUjcFDiUMJimkusM6M+Xr7jwxDjAM62t1KkIqQfyq4u4nFKDgh9Q6RZqJHVSi",
+	"VOf5iMTqHlsZo7/5kiOENHeHQ7ItqqWcbkMWjw1d6D7xg8xv2jMc4hBRGDSM0DtgIIAIJv4ICk5YKI53",
+	"L9IPLQ/VCFKfIFBtQfN+XXXGakdEcHRXwx5b9J1KT4AwcQOPJKqmWkkJM/KRd7lYh8nNYxVre3HOMypV",
+	"e9ESTina+L0XvOnQdd+/0Ab3TaS8OjZOcc1BSlH4BUmFtBW9JC56Jg6uET+jb8rC1ExcFvQOMtluJJyg",
+	"9iJQyu0YaGEChme2FTg0GD5GXMlmR9V6VwqkKyqTrM/yLBngd3WsBQachhVHz5z8I1lrdEjGIKt5bv+c",
+	"DtRHpC7Kt/i/vfy/gP+7uiP6a8//o28/BBUnZLINbUdVkgC0hqVuTU1qpwiugPZO42wQwvHNZkOhuGko",
+	"lYlj53CuGZlDoXz8MEnYNpnMHiFExg7YFDRGAyfA6p67RHoMkKXKuVqyHpvCzZy/VTihOSf3QpGHSr6m",
+	"ecTFbKU5QCb5bxwnWS8Lk64cu0iQzV1nBTl9VmIc0YM43M0RW9/1JE4dtvheTJwdMQ3zxXLUmvgqOmU1",
+	"rsykgQ4LdCMQL6vblGs6BCXe5e0S6T2Y74w8WUIHE6gfMA3/hcEpgJmuFs6vNQFLHA4NhqPCu80bolfq",
+	"F7vNGZixacelqRAVNkQyoq835BITJ+ZM3cTzaYbI5V3a+3sA0NdniWxpHr+Tj1RfPBle5vZWc3zvdCrJ",
+	"0PGPHaHgLkXwN6Ka0LVZqfxIVE/htXLiLTC02pITSUu2AuxafnFkzHdzIE/mjESl7+ns3PySX0kPjkft",
+	"KTDwSyqjH/ts4s4h0+CVTKivAg0+5v0RspFCtkG3q22Vsl6QB+JkKR72Z4HKFDskPw32yAY+H0+tE2rV",
+	"C5hx9ifEtZDPD83oAW2dqejlScHpm5BTED5OFYkML3U3R/tEdAJvxfecOG8/vMPxx/0jDEjW6yy6uvZQ",
+	"b3B9L6qqDfk1ust86yugBGEUl5KSjTi4BGz0eUNakc+dtO09YddXp8IPNGC8TB9iLF3nRRemV5n3H09x",
+	"WpvSpOmWdGECLZL7v/FLCmUFiU7NqbdGF/wlL/jL7GzrnXcasClOjGa23hz/JueirxUfYQcBAgwRx3DX",
+	"oigdY5CqJjQE1QU65pQlMdTtHWxzXcpOF0qtM1SMuQaoBdVqtHGZjhctCZyBlFD98qu4aZSiLcmlgFso",
+	"50hUff+K7fUE2FCumas4m/JucYMm+lVF9UIw9MaGX+XlaY7KXE0S3QjTOqhuf7lD7YEbDNsMwGDaY9ue",
+	"BPFyXV38B1Vj14WSc06TadLaahTCtYQRZoXVXOCskXGxqbhDo3+0pDqGgcuUXLlwIVTpnTy8lX8y1xUc",
+	"byelIMvxLjaadC+Rt7GET+6SGlvLt6fwOn0/mlSvfEbaqTmboSuun0QlHLkY8ZhyQhun4MnLafv3aKQm",
+	"ju9yl4CuMpaEM4i13/NoEd8847Gi7JjZoD6vPWbuF5OOFqVr6EUt/TXOPBOcHhTXcW9aPPsKkiu/2Cyq",
+	"QbF54xT6LdHzRUK5Mklt3O6ADWNDyzwKW7GYgc+wPFPTnJC1TePsTCc4jrX75pOzb+3ANTDghkHmZHiD",
+	"OXgDwu+R0AA7I4IEWadCniXsp2LIQdvZunZX1fkvpuyY68zrSRaB6z5u1nt1xBSenMFXnIliJE09pzjD",
+	"nFilDe23uSZ031q1XV0yAZAr8w2JMq4i3QzhEs6q6NWpv2cleT98U9DeVtWbRG02aIkNUuHcYD+z0U6d",
+	"ruFuO6pSx29/VGIbPL/WeuzJgCldLSx2VHik4FocG+HoKnJy/UT5F7fWKgMGK4q8mrLDIV/f9twfeNSo",
+	"kSw7ysYZ0Y7Qe0AGm8AAVb0O7ycpYwc1qxdJtmlJfy8h9q2Qm75+e+GNCqtYBPDzTydzPE6Ep0IaXwRT",
+	"eM/zMoOh3r7agxTVkYh3/OQAt0i6skAOlbf9Jf+BT1LC7QSlfHYdlC2vyuTqxZP0g79yApJECefE6EyP",
+	"cOCCLIqFydaiHWmrbQLd6jubvsUkL3HzLw8e85535JDuyFEiVsqVvpkKegi2DtnKawna0tFu2qR4igcC",
+	"YexznCxY1bXapsQLwkDmrt+5xZKBWKjHIjPMUuadGhox7JupERApkmZMrj42WU4kp/4DICu/1YEfeiHT",
+	"Afmygy6iFsYH00A1h2h5CwIMjp1xmMW5RHyqiyuNt9A5MoN0jM2bJA8jOx4mcfXpM2PmNjNdHMmLNLV4",
+	"PEnDzPROhdeREeFnvPX0xI+1aCVex+a4kFMU+rZhrwXZ+DjdJ7fzi8JzWkX9GaWV7lDIiPIrgwUD68Si",
+	"F8kzJmedZbhixURO+ncYe5m3JhFUvs+KhJHRXAwzHrL7PaNognIcH8BQ2RGM+maDMMs6jrGbGJnP+y5m",
+	"iQw9l29Xt+pOlTecxil03E1ZosnAWZUV/1B332FbWs4D4y9+qhthSAiREWfjOiKLUEYCBwW++pHc5+4h",
+	"o4yqEI2OswdCjqQaPIGzRB49LD8EEvS81U8Ln2yGgtA99ngxjlcjnIQhvIiK2xP7+82hfVaGk0HLJNp9",
+	"YfLcjO+V74kZ1fqy82zfefkE5+C4Ubw3/ASCnhvBP8hoKBCT/So9t/gjeU6GuU8xkZd4G8ceLdBIHi3U",
+	"XDsnv2UZNfwUfvXZ1ZfPBXw0KMOhqG2yi+iqqN3h32ZVaCavJlQcdBlqSzybpZ3NZ29jCbzSXW52mMes",
+	"Z9nGa1iIizmb9T73hHfyWN6E48En1RbiKM9LHHGYVwfjL299Odld3neRz66zvNBOlBraSOw2LW7eOQ9e",
+	"i+4A93a1d7hCetb7dnC6w6fDUtcET5q6j/1INMqJEYikkyRKmmzGUn9OC0TtLhQAF8z8A2uJOIe94oe4",
+	"9lQUweD0EsAhZUrguTdPKnClvymFyP3oOiQKeGxgKI7Qy8ZXhWn0XfRIu5mU4UbQL+zqfpE7kY0YTcM6",
+	"60h8AwQcUx6iH6Z8NdEk/q38TiNYviRcXKLqi/AROB2xELnPgUG7oqgchmA0ihaw+rJCT5w9gaWjiMOs",
+	"JfJsledq1tcLXyREhslP25/wgnr40CW7hw8XyU+FfHAApN+X8jsdX6wVERDsgpYIpD3yEsGT/Z7JyxHd",
+	"iLerPizVzXyBnnBHianidGhIlONKNL5vBH03dS4IXcsvzGeCGB0eGHfXGd8uMHOO0MtY1i8Ttigl4ZtE",
+	"uL7jw0vGQaQt4h+YHWapxPE6oLfp9uSsnDYAQDiMo1w2KHKUHJ5H+gtqHHGXwhG7PBLtWXa5M1anw6Un",
+	"zC49IJ05gsjUvpMx3C0rOd9dmf8X7Hu+xtox8Kk2RS0cBk5uphLQM9RShPWTMjC7pNrh72PTGPH11Lq/",
+	"MYOG9mpV9egz07rgWt/V3+l9GQkJ9h3STf4jiZdQreOOfx//lLxJN3X1Syje35U3ND5ySmzxiwpqHKY9",
+	"v+1so5sTLOD11HOZ9lDg2IGPDPh2Zxzs8Eiwthxeb3d2vqZ17g4c7Vh9jB/1yPbCMsi7NlDN6aTdNt79",
+	"ej2ztjum0HCjEPwg9QhLop13wjLJmq4jlFBtj404LbiXzChMMK4bwCWPbwlGYB6kWiuym6VUcR3qFRCm",
+	"K8sWvFgqdAqRzhr3jUl9zbMnTiyxaSuudwCDLbQ2YDCn6gh42tnaAasMIKp11QCizC+aKjBMV95kpYkR",
+	"kKMkvTEdjzY43FQ11ZdvVESXSir9sLJgvRqG+Kzzbc6pYjv0piM1MAeysW2AU2MQFa3zBpP4m4zwghrY",
+	"kEcLhyHLbqzz67zJl4WiFu9zC7Ru0Np8Hs7J7TBbza6h5h/MaL4DlMKhgy6MWECr0eOwxUYHLy5Ve4Mx",
+	"X4+o3fufJO+St0mTX6v3EIsiPD14/P4nFHTDfzwK3c5rtcm6oh3jJmtiJ/rWCNMxvcN5DGTcMmr42bqp",
+	"lfpFxRnXyGnirnPOErUUXjd9lvZZmW1VOFPAfgIm7ku7SX74PbyU1AirXdTVXczuB2ctQ/4USS+I7I/B",
+	"wHBiWMdegvuaak8VgYWR6sOmh7ugs8F3k4FLf6QY2YMOEezpjd/y+ydoXMVVUyTz18bCqtG6QK8/SlKb",
+	"W2deYYhoLkTXK4pTL+4c9yvCDZlrc47LZrsGFXYrW9Ildu0m/Su+p9FuC+zvIgZuuoRbfgDyp56x0zEN",
+	"zwL8reMdE6PV12HU1xGy1zKE9MWEi2W6R46yfs8VZ82pjAbzhsM2Y7Gj40PPFcpwlDRKbp1HbpnDqe9F",
+	"eOXIgPckRbOeo+jx6JW9dcrs6jB5ZB3u0LcvvhQpY1+RK4JjElvqHEaevFIrGFpdU+6W8CbhmPfci7qY",
+	"tQv3gf6P9RLTIqcjlumzHHoIfFoFVAfwI9OhdquUtH5zXW5QyQIfkAyWMtSi52Ty9vnoebJghAPlwu48",
+	"GBeHXzQe6I8+Iv4VnAptLHfc64bMJry60IMGSWZtvrsx1smn7O05h3B6p1ATz7+o3+WnXV6sv7Opvf0V",
+	"LuF+W+2CDtRL7PijhIW4dVT5DgyRGJoPSlUEh2N580ctlwYk55+rufOAlDCzbQ9Lstze4izgPpgaKD0h",
+	"ojdvC5zAxaqfNdkk7QLhAYgD25liVc5xdRi/3asnWp35d5UVoRy0yAh29E1X55MObnGNkOs0wBd6+poJ",
+	"bXaAvcqajlM1NZjQEVMJSerHfK8kjLKXeVunn7QFjpV/t9klxt0fzVoeS87+XhrJhc6ovYDbrF3t+P2N",
+	"xzhvwvnEMeQsmqt1n612mNQGE1fS1Sytbb4arGeZuaFiBkKLF4IEc090hwUafQoqc7lRNymhICH72k2K",
+	"IKbNIVupY3JgxrMB+XTgwXbhpByq3tANS4U5kXF2JXe6C6QeiqQoZQB+CBKr5CswlQ+ekPUwGL1jcxvo",
+	"xuLYy/oIbYRn56NBUdGLWBGWGZ7ydkLRE+kDarMszHZuNmORjJuX/fIHXqK3bWUeEJZebPUAoIyvsltM",
+	"5cLuFE+qJvzEwSI8p6wT+7mLDJeBsZ7QNE9oo5/Wd3U3nYmWVAEmHe2aOtnEs8kXlHQVAXOLmrN+ShcT",
+	"9It1dIeiwqSyOA66NSU8K/fhcCk4pctuuyX1jM9bgwbw+cVLdFLZSNLO+eOMZxGUekvIWWHN+0MoMhxb",
+	"vNINqLiC67BEihsXOxfJU9aZNVojIwW4qEhmjQmCzXTyaqObCv/Rthl53bSVJ/DFL2JNXvHaIbqesL4r",
+	"rareyUij70cmeoSbrcCoklrjaatQY3iTY421Hfys8wH0j7K5BaX0gr88oKOSKeWY0s5SdOJ4tGvgdCjg",
+	"CGQ9xB+piuCMQfNpks/zS85GFCDK9rb0B+tXk5PE/brUZvKVaJPhkVmVOQbn3AUfDpRgfZ53iExiOcW0",
+	"/40+4nJCA4crQK9OgijBoqw/zghfRtI4uV9xU5k6+M8WeTGZULaYQos5G0oKuD15ocMZQIZUNbsvIxF5",
+	"kaZ1wP0l5AZnI4GOJCMK34iotCju5WtReFKmxDc5V/MTtMlzlG0UmNwQqb1Ed/ktRnXzenrRs99jnwuq",
+	"AAEQ/3DxZbXNV7DxNAb7IFKKKHK4HQ51pd1v5QLFtk+wrVQXNT97jkM8KfSVSYORSmaHhwLabRlFcMjB",
+	"RXscOMg147ujjZDbaOAI3adIaJi6AKhCHegeHhCGquvQg/gzTnhAKeKxhRRoDxbnAWE5cD2hCG2eUYEL",
+	"YhW8Emhj6LxG+kF7lKznV29z/ZkCUjQbXe87VL+CMKKE1qjniG8jkLlUlIswDtPAPicxk7M+FEjdjjDx",
+	"BBPyaT9mEoJ89R9KVSJErclFUoIkWSwLMw5k3Cnwykb7VM9/p5juVI/72Jsolh592YE02GKMfsjb9VP6",
+	"mtDXZN2R5IA1wTv9ZMMc0isq9+XXPwv49/JEKMl3+5G5dIN7TgevQdTK7pdFwMHwqfkI8+gdpvSryzv6",
+	"/3EvSHHtPToaXvvgro8r+jeM7g/Hq+arFJPyzscE3Sn3R4ed+jRCt/3PSukwrA/IW656NFod1tmjEH/7",
+	"DC8Ot7zOwGeOrxZTs4ce+RV911lwTRBqT2+VMdEO5pTNC2xZD3jdMAg4XH6RqASn1lPG9yu/tWN5KFbR",
+	"RHtZKzmbYZWjLCiaB5edSjnjLUERthnFHEnZjxQ/D3qflkJmFXXONQjV8QFDgP6hIw6TQ5aLU5BlFkPM",
+	"ig92PDZ57NDZDe4vQhLkRe0Inyv1WQMPh6Do9cqrSYm1AnWZQMm06lZf4ErTuTYVIu1THEvZSwgUyDug",
+	"VAp/kj/vMUAsYuUwRxKvN1PupZK1SMDXFqheGTubfa237Bmey95qDVShvWHdOJzQqm7naEY5hyCDLH5q",
+	"7Cpmm7H1UNNPqEaq/jab4fdV+fdS7mqt/hn0us5SRrW7/7iOJnORKrj03a22K45LCymyqK7zqtMOZ9pd",
+	"XCtF+FdJTOxV1Y1wgGAUxh9tphzNkYBFMT0N7T++4+ACTlvxL2BiHWx6v2Rz4L3HClrbRJRAA1NPRK3j",
+	"yYVzKkSHihHL60hri/ly9WhpUNx5QFZP5wjEA3wA0M/WR4mMoYLWD3iUH6I7QOXcY4lI6RvnZJeaJKTk",
+	"ZVxQUszISVrl9Qr17GiuitWRh5H8yl6mU1U+lgJw6E5F/ReJCfGXZOwGHLyQYC/e0MHvFTKh8g/Buwgv",
+	"AewVCbWUYjP9KQBKC3Mk1KZqU68YykwEBEIoxAvUHcw3k3pLn7fuIXhSMYau8Yg/CLXoxX1EQQzPW3Fl",
+	"kBOQIT3NjOPjn7aamXOUpy6h9EbviQjyFW7IN2p04hPXVs5YmhBOWqhrVUyuTZfeIUHQmVNM9lIyenwm",
+	"JNFZh8+l6TkHUE9QRyVqZyXw4gRuuuxandwrNCNF79W9JALHFBQyxUSRn44VyjiOtEgDtBvf2r5MNmDM",
+	"DiuMM69JvtE/4JED2TtDYcruU2OPZno7bM0qLgqDF16+3bVUABoE5bWqn08UuLZFrYm/HqrGZPMFgQAH",
+	"k7THOxruYm4g4jBR2mAsbVi/BtDRLuE40NdKHVOum4ursvvYn4Wu4wfDxGtKfeuxotZAShV5Arzsls0d",
+	"vIr3wbeL/ijuJwX30f6xqOsidSMssIbTm+8DRjZVUpvxeEiU8XMrD9l5l6qobrgJqcXoOFHUC8Jyv5SV",
+	"ZpbHnK58T75K5KOE/kna+IzuWrdlc1eupqO09WIXcQ/Dr9CpePXUhWyI+D01cpMvejWmhx5rI6NxBjqb",
+	"CU9Wz1MEtWOztkxAJD9aVC6gVVaTuo6BxCvc7qUJ6BKqgSUxMpqn8pMhxuY++8qQYWYLAoA+wZiHIrM6",
+	"p2yrDWphm6aqczWp5uFWLjYYFY3FBEXGYe2rVZtQPSj4ociAqiN3aBM/jq+8g+Gt9bHW06DLcwEnRisY",
+	"yGfiR0kFqXU3OsvKEWkQ3RSIekrB3yLZZt1WLZIdrJMMDgtEr3zFTXBYw/jpcedd9M+S2RQXSTJi6Jx5",
+	"9YX+ERJJPLX1sCpG10wdu2jM+pUJA+X0F5hSBiXKmty2/CSCszM5UT5pEFnHq+T8Ex0JLN9YaFcDyTlp",
+	"i+bkJodDd1oSdwvQWBGbUXgcp9h7gxPLYwT4f6dJPGrgYl6xDCanFEglDJD0k+ok8DHfKIl8AQxoyiAs",
+	"6LDGXj2KMJeg6ZyaTyfOpUkSNUG2DtTIlJiZ/sS5sGvssVOCMFxMob5/nl9It3iaBIqZj5XiiQ0X4BL0",
+	"wakeGmIWvTTJIB/5b9aFuSXhA1XWIfV/XptoFDEW8OONp4Rrbx01eMR8VEhM0lI5nS8ZDQXk/aE93ptv",
+	"3mCz/e9iHjpO7iuZhcrEEpa41qlmp+gDyBFbFX9WjvaI4csbI2dQ7VS4MiQ/dirtcL9qz2G96VYroBxA",
+	"n6Zg04vGoLHNCH7rbdU6NEDNNxm6qknrEPKkhWuq0IvlS47nfiBHhAOsqEu4Fq4GKJj8oscAg2sOCwW3",
+	"Qc7qqIztaDAGIMHTJ2ukaIlkasJ+za5oFs/+AX7Z7fdZfTexcpifmsXOMWYOo7AE44L6r3Tzk34teHbe",
+	"9Gu/kF2TzJmk+F7YiEs5QQ61nnD3i+XS3HajBYWkCpQACMLpWt50XjUhxxiqL8EtecHTK8ItBtTXNs6o",
+	"0nN/6YAuwPjl7tQk0xk2eJ38cmbNCK7zfLWwRq7kCWjcWjlidW7iRZBOLdF1NEmcDzWWuMcfsXIWmKiU",
+	"e4tjoNouyymtBmmives8/DzlqzrF506REiu/m1HbiJrbS4LkaF/pSrbbR1pwkCvv5LpLYyA5hNHfHFtY",
+	"5zyEEhXb+uwuyG1EunN/cPY8vBV6/cHbRKn6SVWWahVTyazMV+RQGQXtjccRjqY0e/a8n9WsVntEq7L7",
+	"bqcMZyLKDtkyL/I2qqowbmMbRVUbsNoXSCq9dJS0EvHRpHRVsF/Ab98oitvKyhJwuVKGk/wn+cfQpiLW",
+	"0s/12KJDTiR4hRxt5BOlqSEK4NREpIP4m4QVHOeTaJGSAszZiM6rq3vxiLoj3Y2Ngh/WETXMumPfYXxs",
+	"F0BT6WpOVjmKnbAopXRLWSJOdVyUA5Pp0IiiIcJS8pjLCeMq76hHGCAdGRZZap6RGpYJamGeIF27rUhf",
+	"O05IJPTAFqdx/RoCTyTALUWppldqaSRERpHUO4QV9JjNIuaiDOtdbvFgoLXnWhlMUh/axDIrK9nImav2",
+	"zQ1c1G3W5urWIDTeYTyKhsYxJRrrGyLl4sQQMMoHXDVNfrCBWjriK3Z6w4I7FfJo67t028XEH9Mm+eJb",
+	"EOPvs6GO0D/ztDivhJNwSXX0Zk1F99UJc0TvqBATCl8rJV6Zzmsp7vr7lMPO5WmLFwz19RzkMdan70iI",
+	"QiUXGSzubNjiwgp28psutM6zFPkbZStrSpAoWcGlxUTW8LhdcFBzTdfl6QO9MTPnNlnesJbCcOM5JSLW",
+	"x0Aj7ryMnya5yzsN2/mJ/95Q5j2Ea6Pqmh8fdFdg7Y0U73mbEjsGxxgqONXQSUiIZFqi2gsInFiQQwor",
+	"Nv177z9Gam+BKHJkCF1NIk1UkJM5x5D9hL/r4gG6VNhkcIeh13TSA0GnSUR5vYdEl+qRU6uRi/RaO6YG",
+	"ij05paj8Gl+6hpZ+R9ccxc1C+EnlyUaiT1ixe0IMSg5MqU51QKq/vmf4zQ+ShNO97laS+t05tCZOZ/bi",
+	"RthcMHxjNVxl7/3qZFmH18+ln/cgUIhOrOsMun5B+pQyf6tmRuU0Ibi3ZwHvjwxogdngSZtG9MvPYKdX",
+	"toxAiKW9yan2uymtRHbftXrHP7fkmfQuhd6ZIPeb3R0PuwPcKXhBvHeRJBgSg8kldbx77kAwmLx8px2b",
+	"/5ZmXXcUlZ5JrM3F6zKEFYuFFBlBLA3L2tFaY0qUe6IE3lxFhdVrUb6zIDxOKhQCUSZYAFsvU/EwW/BF",
+	"IXlEFwlHXGF46wK9FuB8YU4IcurFAsN7dG5Y6PcGXF2pTh5TAPAKf+gahSlZ8Par0qK6WTAUmw6LSKIL",
+	"1Y0qCnrOs5BB+orUqUdYc/6BSI4ZEr3qe95eepjxOwsugfW9p+JBxicCREcuruyGKjXicMGbcNz9exjV",
+	"3xNInYPKUARl0LqCJ6d6kh3C5SSv8CLAFq42I1lxc1KErYEthlyk11UoyNnVr8koCeaDxgckB+5r96Hq",
+	"puTI/rA67cjnvUxln/aoM9O8uCmzQ7OrYr6jEWb3yvgdeYthww6e0IWEgIef1RFxxqkL4MMeKS2Yx+Qi",
+	"nVWAI0hoDx8nq0O3SCjccmEyTZn8k+KKcYnJADa6j3XI3qnsgNwBbmjAHtAjMKy8xPx2pL6G4fZwfd1G",
+	"Cpb+Eq1V+ovqrXRtaI40rK2izbrZ0S/kTBbzj2mzWBwTJdxy94naMpPSJOEk5FKHarWb8egjIneIUduV",
+	"c86DgavWYEVOH6kNrqKBu/qhgF95lxjb5ihqtXjgRSbdKGvHCFZwR9vWMjUzHcghfQAxeU5ftzIShJkW",
+	"+T5vY0nDKB+2cd4sVLl1nSN71efHPB4wljxfhxX6cbUC3Xsm9RTd/V5aYsKBkElMETjGgwzJmcGw9Ps8",
+	"7KlbjvYbWw/hxS49tLZCbVq3iiXhEMvBijgyX4YXOvjsllKahzMwgVwQq+0MXzBK1Rhv+tvrAOdduKe4",
+	"+kigZBoJA3YdhsdpzgAV3iMzESP7BNI7Yo7wuy06w5yx53r1x4aY4csxyY2t5dM8mvsHZA5XxsmqQ8pE",
+	"HRI67vqnuoInBOnEe0o0k3KKIHvM/xP5FqGGwyQyr+S0r2rRLctajJkSJQCdODi0MyzPy5h6NBp5qXY5",
+	"Z4JEUT2tgjWl+9pHj9n77NdjkN5lZdiMHN7B6RlSeZ8mnaxPtN/+VoxdepaXHH9yXMaWOaZh2gLeDsvh",
+	"+gHIR6qfecqYe1qY4l6ZF99jCW4g5y7Y0ayGTbI/oYQo9h25iPSbSwy58vjidx1sknlVYVcUNkl1YHMP",
+	"mfRuJOAhnlJ8HGJr9K+Wlogrtanq/ilEQteCoTktNdtNtBFrmhhXUp1mnAjIevEtZ6WcSHTZy2EJyMnp",
+	"FcGKE1Tg01OEE3thDAUrTfH2WneFWser7k4yVfFF4IA2MzoV8mJgsCTIJotYIcw0qcXP+HymhzdHqdS6",
+	"kUwAGoixGadcG/S4uOe6h+RNQ+XDjUWdfSQBQ1vtUvTa6kWKuT746pb8YGi7wks1m+nm8BN4Gj9rn5+4",
+	"04ybYkZ8cpccW6RoURJp3AQSeSZX3IQ3d40p0fCMSA98ayAvw5yC8zFgcoemopCMqYYCmU6dNK7oobjZ",
+	"EIlzWRkvH7L4M/ZW843wFFvjwP2uIywM8oyeUTsozEzGEElVG8rKoBE2JmUMAUXHjiZhTw/gdgttHdFE",
+	"mzcTZ4Bsf8zfpmZmZ5XSG1+h1JG1I2MflehD7vu4j3PE8WaEDxmON48PRWoSS37HPoNyeEj/QMcPorvZ",
+	"/gb4KBtzs3mhsPLTC/Vz3M/Gdx79mX0C5U1RU3ciI+3JgZmF4eQ/e8qMTi5a70IWQ6oJCKLblprbgncV",
+	"sxRfSdeXKuYpgWVtj4da1kDCG+YHc6F3lbTRwWYvL5B0vtwU+aqdtsVJDm2B0oVB16lhOUdD4khD5cLc",
+	"N/xdzyr5rIPDNnHrIQEccVLeKcc5uarzbY7OUM64Ys57A/ehJGyw7Q3xeVl72kYVG7MC4ycktcP8EQIW",
+	"/AB+9Fi6dQ8rYWdUbBMp9Uzdh+/dKNpD0bThCdmRLlpj2vqc2llyoUJyxBsFIeArwwgZF3NiuyrHSh8a",
+	"eYAi+0AxiBxxBd+udgieTTxwVlpCDEsBbBiIaDzZaDBCSCe4gU/o+MJuITzzaQ/uOYdq3tqGr4c1WTVl",
+	"gw0yZZEydfACQfp6rmpCULmKaZxVIa6a9HhEbTia37S7OaUUq6WUolQLv9HWTH4puR7SeqlE2jVgsOYr",
+	"Pw8Uz9Hv1HGiFXW6O+4813BXCuXGdcqLnaxw77ja6x7j8WZ9x+aR++BEV+QJkKN7gDx02l/dgR8b3hdR",
+	"K2RvmQdUT96LlXI1NBHaNKdOT8V/94ANnYKXnO83XkGCKo0npjg4p4HOEskTnDRFFSr8dFI5dBwrcgqd",
+	"2QiiVpVzqnIbMGTwIAakCMJknQVTYkHKJpCDiy6zMNQZYPA0a+qsSTsU5lNQWgJX56eVgbabPOZtvQaU",
+	"VYla78jrFxhJje8f2yNMvQwUFi1LJQFDKLP0BiVbVAZS7XZoCNtPgmnHPrPsx2mxEJ5rVbHTbSiTWIEU",
+	"KaKfuOZa4+OWSgs7uidmYXa+hVuIAt7d5FqeFDCUhEZe6BzkprCJpIPfZwcy4dFfKfzF/uQmoFKzcJgv",
+	"r3WK9fDy0AGHc9Gm5DO2nXSwks17hX243DGPA4OkjOCU8yEHiIQ8CTCyCRrr3eDGw+0gGqUsPQPFZNST",
+	"GA2oIconFGd9s74PAG+GzkevY90JAJ2aeeHEfCBZ5QMXsohkZDcqEKtm9rQRr2rech2PaA6LNw+VprT5",
+	"o2kHdZ/Gob+MQkHhuWN9pIWI0OnMwDxLDaK3niH+KjvEslKqFBU50Y2gZZEnGzYLSOa8y/z80C5u2jAj",
+	"ieJIN0y2VPuL1GyXmryLYcEDLv2yrG6xDgCG17+BY5F87SmI8FxZGmxA1BzZV1orHv06X6u5+IPxyJPu",
+	"G9NPCgOM2dQC9gAsIyI7cvQWymUwSJcY2NCuJCaq1lOnmznu8GBpeUQOEVYFq4yvXI6JkBpTYFKqh6FE",
+	"c0NlWnb5dod7haUhvsE6mSCNqIOn8VtjBhy8s5Kr588oiyznyZghiDhYn3GnTieXvBruU3+b/Os1bOK8",
+	"AmbVVvt8FWZ9/16FVaLlUIbsJJRwyd542uzFGVV0GgXN3IbMMMrsBg8UHDFS8VqH+vQuduZhFjZTCpL0",
+	"b3ynO9VruUqLJ2ZFqobgJRERHj1MOLAs9INd6mu7XswTs+vNGMrnFiUeZGP76EoEIZLmHnzyWdYgmdAV",
+	"Q/0tjKXOCtGJnHAJ8CPZAf/J2ozeuDYKLyICBzg9S+5i35gBAEHK5amRFki0caV/fa211ZZfWkStfUBn",
+	"yqhUPOR+sOEIZwcKo5HuAdTg/jYAvsuBAQs618VCX+v6+3s2GOwk4H8bp3LvEohVZbGXPUqVWJdFovFi",
+	"nD1YU2W8hAnl+TXy62QhE+P3NVOgdgCIlzbxYJhV4ORYMNi7NM0CSH5mYlsWjhe8pJd3ldSSiIdv5FXG",
+	"l8eOPVfRzxTEabSw4QWGzyg3f+Eha3faQUb7HvgRaBjNJCG9v6i6osTc64WTX4piCknN5jnqVwdOhulr",
+	"asmIzzlBMMRT+jamM9w16kDZJEOPj37YsKu97Luu8dpTpxjGHOwGoxwYseIHPBHCEM6pUqZ8TJq5Rwkh",
+	"us7Xna/pbo6VhP0QHTzKMwQaA+sP8zjF0UwivLgxFjFZfIhoPnguy3DtIT4THGhkQidptrV5HjMR2pPd",
+	"HLKbMh7OE3L61PqH+a8nB7GfQXeSO/ziOvfHCceNJFSJbmoNjuLh6AVILIJ/Bu4TXRYl1jFahREkxutp",
+	"8OH+yjzEe+9wMsLqNIj+g3yhaUvUx3u016PtNazSsDfAETkjegkjhirvuaYkgTnNNm3MhNXLYK2XRT3C",
+	"/HDar9eZecx1IzK148122txO2NTYynsbe24MuFCMYWECjHtiIxrZ7iNosGEhLAbXNOv4aZ3QJB04B0uk",
+	"qd3dAZ0a2twGihIj80Xd3+FwoaQ4EnEe3q777IjMNoHQw2EcmRZFZS9Cfy46SQfLGbneqDsWAFEDKcIi",
+	"f2HPNpYG2emE2r4BIU70X9wiUox1PSOlQMQjzA2enmPA0lW3qDRoLyw55uFgo5I5YkUXkKgOrc2P00tf",
+	"cIx4Bl0o8tlTmE7X8mXMTRIIBzTPuPEyyoslFMx4jdGPeyM66Bme2X63U+/HVcS5+3P09HAAkLcxU2L4",
+	"bKMGvVr10qk4Y5xwsZprZbb59L5kj/1Z9xDJ3iyZm4flkBOCIflp+xNqkR8+dDf64cNF8lMhHxyUPHwY",
+	"ZIj2Hpu97rFCVcYYpHdhwj0pfB4GuIkng3WOCRpV/s42lbnCoXbpEponswx53f17CIN8v4xJRJLUw82i",
+	"wl5dI7LQCS4hAsiYUBSFZEwcOgUUzuyyHk3rEjmRdBmkeTnPzURfHU5kZkQpy+NWXXvMwE5kYHToCcnD",
+	"SHn+/jhIGj9Sn1a38y4dVGmeelJmc1F+zsFMWFmi3PbCEdwqU9FcaNgbv86pSRcMkx47a26wNE40+tyo",
+	"+uEA2AMdl9aqUNGYVgJh7JT1YRh/a0SBkGxG89zVmWNHq2Z45DQu6WKNRVIhGqI6UsalLgZ8dNnJWxs6",
+	"om7zpv03osA/rKIjAkmf/wVqN8aJbayurSU6rI37jev4EPI5JU7rxPqgYlh7ZEnfgEGGU7rlTWCAvLEa",
+	"6RZPlxME6DTDugkSSkS5qjBF4xozuTnNsTYhECsmtL3J7prTPd8Q2hr3dMr5jVxxcFCtIg+5wVH+NQYE",
+	"Y3bJ2h7z3JrhccVeQkNvKzYWYbb4oIPVcFfCmpvsFh3wUtL9jmc8QPc7VhFjWmH09kDvmyPniWfU0NNQ",
+	"FWUJIILV4azzppjt5WK3e9LRhWs+5JvWKplPtjuGH8JTj/Leq9O8yvtvz38BFdIbXdn7BFVFVF6zg45z",
+	"s29oH5/AgkECvntSNW0sfbC738baqStC0le5ZlcyWCBsSr6MTqEbkT8JnUmrT5Em62rVoWkwGwlfuvdC",
+	"OH2MXcpUKUK9Npl8DtrJfvNtGcuZIrcAm+/zku9J5FTIQyVgkri8Zu6UkFsK+fJKAk4/q2igLrn3mGJN",
+	"Gy8IVaNNOQHoUaHScxmJHBByS2/4teP6hxzhlOl5voccMtl7R3tAHeGkSB2/1P1wKLbupWT1a0aKIFra",
+	"EV8sNtgOEi72zYWMXzeH1BH2bPaCwfQWbAgOgieBxnz/+dOaBGc4zmz8ezqcMEiH6jAvK688UsQbR0D1",
+	"gYwmgTK+NtHM5xL60Lju4Ta82l4H7zSi0T0llSffT3quSU0tnMNxFtEjwoAfkSZszR3FT06HoDoug+jd",
+	"XXhpjh2fOoz+zddO7L90C4W9Ft0+Et6N/h8p+X8k3KwHFZZMiHi5BF3XHcc/TkxGEkXjKNftEsJxkXmp",
+	"JkGlQg7+eDjbHH7PuBDwZbqJHZ2jdO/XJWdhZJYuZEEOj533AGehlrxq1DqYGkMMFxExRps1pHSJtmjV",
+	"A5skQ78+wczh2t8DnMRobpuokrhxBNC+3zu7RNHjQuKOEHpHKXIstK7xJAQuacK0K/6Y0lC3ERAFgW6k",
+	"BUskmFaaX5D4b3haNaeA3VdmB0Cn8IDoA97HpWhEiLXA2wTjaM+BXa0gnLRzaar16GOAfb2mGedy5El/",
+	"5T8PvIPZhiMUyCBqX7l87dBr41Bk1iTcuJXOsK0Omz7J5f+Yg61jR1zb5elnN26fpP2JwCBWJtKN9M2p",
+	"9iA3px3SMZBGCN3VGFKz04h4bHbeZ0rdBQveH+KB3PS5Rx42b95RAdyz0jhMEnJeXiRPdZ4DX6ClXcTw",
+	"eu5Tkqe3olhrIl6KZEkiiezHn/9Br7eIwsf3ecaUiVlBjqvi60eJMoyH24L2Gw6n5ra+V595elGCMRAY",
+	"yS/1JruLZ5hKdQRBG4byuY6ioJF1RACC0Eh0OkEtcjk/8hqruKA5XAHtSNLsvztDRXVgF2tbUfT3WUxC",
+	"s9hSo7/fciQRfngBGG5Ens8A5Ti9Wd9oTSoBWoOXW+itp1O9n7DAmMNnIAW9OICef6vMafk9Nmj2yX8e",
+	"i3F9NTOe1XH27ctYgT0jdtV0Ok8a6QhVtq4reD9ROkL3Kak1+1xTe8QuKd65XGsjGLw9XA3NJg/0obo6",
+	"aBOM+FtWt5iocTRVqpOBCq1S2BAzG67jI5Ie59ghRzIAOKUr5qkOQpund66lI7kGGWo3MVcztSmjE4b3",
+	"Bo0xTZsXBQO0MKYOvCAxxwzlIakrqRE6EgiCJoB5KCb0YmoA/yUxdFifmGg+OnhKN0IwENYMO1msBRm7",
+	"7FoJiDGvCbJDoBq1OVaJi+vTIeCU+rinVT6Zh3kq8jlueIOzPjiBwwM0JH5378Pb08NX8KlTARfCpXwX",
+	"rBB6Nchk0ouDY+2IjGHKRpWkUUDWVKtUq4SDmXAmS+Q6SfNOqYoLb+JDyBfmuxefJ/zNCRytNgsnNwVl",
+	"S6W5642OB3r7pvNI5XaEnz65CMKnN1UNyIq3D6jJppQGnfYI4G4J7wPXd4+2VafV1PoikyzoLS8gXDb5",
+	"G6eM8DTY1oX7Ku7cf6Py7a4drbAqWcXQ0pNJzB1P6iUc8itFTzuJy2nQVNXfNI0DA2GQY9iCR+FcJl5w",
+	"KlVums1Z0YGChta2zJBASwA8Dx+LFzZMMbNPCJK/1ySBUTYdDAuiu09H+fW50lc2+m+yrhZBojtMgOem",
+	"nLftjHb9D2IyPXL5yiDFWUqUErzlj+2Hs0AbLulskfiCtKjKIOm7Gt4Wn6KG4+9UQLZ5QkcEbb0RoxZA",
+	"WdVykijSFGZpMesRPt8LpG/JsMYVadk9hZ41LuHgoaqv/wiG+jmGyV4RPtT6RVxJw+G0VlWjkcyojDiO",
+	"Tbm2YmnwGXP3sjmeZ2p80F2r8p8RLnlFicpwKInDHLy/ybkI1bHV1rndMfCE+RqLLu//JVnK2wz6r/Km",
+	"H995Q5LpUpm0GiAQ5Js7m6jYoZMT1okS1+lkvNHh0snX1hRDaVW2Ts5ne0T/YKYSOblBKg9R34AsAvib",
+	"4FHw0gLInmfATVb5IQsh/Mo7+nLhknnLVi7bZezhucRk3CtOdwbkcaf+AOk2JkiIzCLU7k5y37IqUdFi",
+	"SmKgPaAdrPHvLpKrR9wCLV7F+4bSs3Mu496pG5g24EmPdo80hhzO1qOR07uFJMF+jsYqqXi6VNbYIteW",
+	"Z485/uTvmRTTg6XFADrgtFznOA1tXKPzBVyzBl0KxgHZLfAC45Z0Fcx/x46djVDBx1Fwv/bqKNeNb8g6",
+	"lUky347u5T+dTbTUs8cUT+oW7dq2aoGzx7ypccfzKaAiFpMv7WWYCfMyCQ7vhQTe6ygSONuIf9ib0FmK",
+	"JoafBUA9Z9ND3NLnlCdM3+IC09nMjswzmuGdhQ6HJuYek4mc6d6Z6ZOzUzLH22GL8N7aQ9zVdf2ceBAZ",
+	"z08swVUPfV6xykUdcAyUB33crX+mL1gPjfzkjLuiDp1a5y6P1kFHH1PgD9Z5lB/b2FPUrm0cslefXX3J",
+	"QuUQuRHl7evX37fL169/ECWq6Rwpyxvq3mJ3Rgg2MqGc7/8EIvOGrpQqefiQJsBQTm760wf+ZzwDDx+G",
+	"/cPzkBAFU3c5To2fB4CfdOC0klMCNGneIMVYvfKn6AB6XEbCJQhFa0zg/GdKwjGkzk+mzNqxthdM0XRL",
+	"YpajKZanUn+iRy0mhscHUTgNqLeb8057kHrmZD4aSZc5xF446xFHBmjESOIjCTQgr+0hyXLkGg4ak4On",
+	"spgPx6QCI1raNfGm/fLTsazQ96h/bad3yhFQTB389t+krnU0qdirE/ESvhBunwW2nfiJxJ+3vfydrEFy",
+	"Z9VZHXp2x1isnCa20AH4DjYu6KWMTGuNOpWGHZVNEEPgjuzyYj11fD/FRno2TPWhStXkzY+40h+XcKtA",
+	"/7erwdMQcMTnUHxiWGmFfZf+/v0eYT6MmMBavcmdqXCH8hadJfTGWGcPz/Xd3ZxwctQG3Z7y9u4l4l87",
+	"MuQ/Bq0/XwAwNVnVWNlqAxNF49ZWb/CRwAmPt6Z112id3hcVvHFQC8bxkiXqvrDW2We32f5QSAxD8rd3",
+	"lv+hPvzrR+tHH77/H8u/Pvr40Up99PEnjx5ln3yUvf/Jh++rD/768UeP1Pubv3yy/GD9wUcfLD/64KO/",
+	"fPzJ6sOP3l9+9JdP/uMdsrQCyAyoDgB9/OA/U7TopFfPn6WvEFiLE1g1CImAE/Ia2FTsDw9IXRGfR3Ns",
+	"Ac3kp/9bX9YXsBo7vP4VxZsam+/a9tA8vry8ubm5cLtcoukYOFVbdavdpZ4HixD7ssnzZ+Z6ZcUJ7aiN",
+	"eKBNFVK4om8vPnv5Cn1HLyzBwLdHF48u3mfbuyphqfDTh/QTnZ4d7fulEBv8GxpeAuqKdid/wC7X+Up/",
+	"Is4r/25usi1w34ufuXIm/nT9waVWZl7+Ksql38a+XWZLELJX5I9GFpeRlo7jKPxs/0rz9cQceMk0M5rA",
+	"D5LtYHxAUT2kLkjzOkxDYushxdvUCt9KcO5aDrmLt3R9WQJDz0TpWLPLZXV7RFPVzG2sM2ykjruz7jiy",
+	"W/1Pl+gyzsoPacIV+C5/pcf7b7HfLx3PiWgbya8d+cgcKPaZTFjc5rJH/b2WxkEj2sLb5l+xrtJv/TFX",
+	"KDx1h8tf6R/EV5y1Ix+qYQAHTWu17LaXkgcy+juOd0DRyd8GbsSluFJTLc8bpWjh0hcX8uGPI+QorUBA",
+	"vCQB5/LX0OfB9vq/h2c2+NRjuy2u9yAGX2braylJ1fsgW1JtNg3FWI99vvyV/++Ap26BtecU+1rYX1nA",
+	"xbOQ7zlQ3P+ANR6Ku+HPd6UEg2K4xvA+/7aknG5OLBJ0sPpwc8ugJMqNX0IDbcqpJTEn3R0fPHrE039E",
+	"/3ggbvbiF6l38FIuiQcs7U06EtR1VTv5PwfX40sDL2vT0XpDMLz/9mB4JvWb8apmkQKafPw2sfAMjdtY",
+	"Fo9a8vQfvsVNUPV1vlLJKwV966zOi7vk2zK7BnmJUtpTh00WfC9/W1I5Rg05yqMdCId4nT14ofbwXmxM",
+	"IJolTnxYozjC+fB1xCPTMAlEGQZmff+APZWwRnrWZg9+IFm+DYm12rFhOJMtYqgH90/FF5NnYv4u+K+l",
+	"kYCOWXBOuBPx8MOn3nB/9d73Qyl4qndCG/TgT0bwJyM4IyPAsgnRI+rcXzlnH5XaGKsMIB/jB8Pb0pEX",
+	"HhyCKSZejjALUfzEeMVLn1fYrD4AWzxuC0+2pP3fiSceO1lBBzzMF/qpi+84+xKtDUfSZ55yuzh7LQt4",
+	"8PhRgFn88C9xvz/JSn2evR3H2jiJyuoih03XVJD5VdVFjPmTC/w34QJfUM5hU8u1VZiGyDn7QBSSeTjT",
+	"9vu8ZG/R0/kAPKbfxHnB1QrBpW5O8QiJVqhZs7+pKC9Wzb4vcMVj9Qv0QTFFaekloS9Vh8oP6IiQt25J",
+	"MXGgUfB0k5AM8g/jYuwXiYWHM8XwOEu1y4VNOqMXKrvmWnFdqUP7k3/qKGSaB/Pi6WJGkjX3c1nNV9kt",
+	"efgCa0aPPUq01MpSBDKfL5KLHuxspUNZDZY2GW9khqlTyztZjEA9ZKIOzo9ipo6Po90EU0aKYXmbrPRP",
+	"sfAt3h+ZJRpzLBzWoZ0Ba0wZp/68NP77XBpXgY2PHn8/Mcv8C4Ocexwtki3c6/9wSSWRLn+l//02/Gyi",
+	"nHq/N92yuWvQtHT5q/m309/XzcMPxl3J1xZ6P1/miOk29vXglV0PN6H61t7K/QZmG8Kff/X+9JV7Uy1R",
+	"TzYCfaDDG3VXK2dPDsrTBje7rl0DFTm/lNmh2VXOHOR7xlEO9O+uCX8b6BxDjbvmsjts62ytBr/fZHmL",
+	"xumUlIWcQHg4aKuy4lIKIPZ+XecNKr73y+GX+q7unEWSwa7p/335K9587lxuFargr5fkwRD5hpZ4jAfZ",
+	"e0p03yiB93ds7IHFIvRVFN6xRnV+HZ9dJ8CY+Hypa+bObQfnlf/lUre1F7v2VxJbjOX1+x9QbGiAK2qJ",
+	"xpoTH19eUjaVHcikl8AZf+2ZGt2PPxge9quWZjQmfvvht/8fL/DdiCrXAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
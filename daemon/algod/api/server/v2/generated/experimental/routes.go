@@ -75,181 +75,377 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/ZPbNrLgv4LSe1X+OHE0/kjeeqpS7ya2k52Lk7jsSfbes30JRLYk7JAAFwA1Unz+",
-	"36/QAEiQBClqZmLvVt1P9oj4aDQajf5C98dZKopScOBazc4+zkoqaQEaJP5F01RUXCcsM39loFLJSs0E",
-	"n535b0Rpyfh6Np8x82tJ9WY2n3FaQNPG9J/PJPyjYhKy2ZmWFcxnKt1AQc3Ael+a1vVIu2QtEjfEuR3i",
-	"4sXs08gHmmUSlOpD+TPP94TxNK8yIFpSrmhqPilyzfSG6A1TxHUmjBPBgYgV0ZtWY7JikGfqxC/yHxXI",
-	"fbBKN/nwkj41ICZS5NCH87koloyDhwpqoOoNIVqQDFbYaEM1MTMYWH1DLYgCKtMNWQl5AFQLRAgv8KqY",
-	"nb2bKeAZSNytFNgW/7uSAH9Aoqlcg559mMcWt9IgE82KyNIuHPYlqCrXimBbXOOabYET0+uE/FgpTZZA",
-	"KCdvvntOnjx58swspKBaQ+aIbHBVzezhmmz32dksoxr85z6t0XwtJOVZUrd/891znP+tW+DUVlQpiB+W",
-	"c/OFXLwYWoDvGCEhxjWscR9a1G96RA5F8/MSVkLCxD2xje90U8L5v+iupFSnm1IwriP7QvArsZ+jPCzo",
-	"PsbDagBa7UuDKWkGfXeaPPvw8dH80emnf3t3nvy3+/OrJ58mLv95Pe4BDEQbppWUwNN9spZA8bRsKO/j",
-	"442jB7URVZ6RDd3i5tMCWb3rS0xfyzq3NK8MnbBUivN8LRShjowyWNEq18RPTCqeGzZlRnPUTpgipRRb",
-	"lkE2N9z3esPSDUmpskNgO3LN8tzQYKUgG6K1+OpGDtOnECUGrhvhAxf0z4uMZl0HMAE75AZJmgsFiRYH",
-	"rid/41CekfBCae4qddxlRS43QHBy88Fetog7bmg6z/dE475mhCpCib+a5oStyF5U5Bo3J2dX2N+txmCt",
-	"IAZpuDmte9Qc3iH09ZARQd5SiBwoR+T5c9dHGV+xdSVBkesN6I278ySoUnAFRCz/Dqk22/6/3v78ExGS",
-	"/AhK0TW8pukVAZ6KDLITcrEiXOiANBwtIQ5Nz6F1OLhil/zflTA0Uah1SdOr+I2es4JFVvUj3bGiKgiv",
-	"iiVIs6X+CtGCSNCV5EMA2REPkGJBd/1JL2XFU9z/ZtqWLGeojakyp3tEWEF335zOHTiK0DwnJfCM8TXR",
-	"Oz4ox5m5D4OXSFHxbIKYo82eBherKiFlKwYZqUcZgcRNcwgexo+DpxG+AnD8IIPg1LMcAIfDLkIz5nSb",
-	"L6SkawhI5oT84pgbftXiCnhN6GS5x0+lhC0Tlao7DcCIU49L4FxoSEoJKxahsbcOHYbB2DaOAxdOBkoF",
-	"15RxyAxzRqCFBsusBmEKJhzXd/q3+JIq+Prp0B3ffJ24+yvR3fXRHZ+029gosUcycnWar+7AxiWrVv8J",
-	"+mE4t2LrxP7c20i2vjS3zYrleBP93eyfR0OlkAm0EOHvJsXWnOpKwtl7/tD8RRLyVlOeUZmZXwr7049V",
-	"rtlbtjY/5fanV2LN0rdsPYDMGtaowoXdCvuPGS/OjvUuqle8EuKqKsMFpS3FdbknFy+GNtmOeSxhntfa",
-	"bqh4XO68MnJsD72rN3IAyEHcldQ0vIK9BAMtTVf4z26F9ERX8g/zT1nmprcuVzHUGjp2VzKaD5xZ4bws",
-	"c5ZSg8Q37rP5apgAWEWCNi0WeKGefQxALKUoQWpmB6VlmeQipXmiNNU40r9LWM3OZv+2aOwvC9tdLYLJ",
-	"X5leb7GTEVmtGJTQsjxijNdG9FEjzMIwaPyEbMKyPRSaGLebaEiJGRacw5ZyfdKoLC1+UB/gd26mBt9W",
-	"2rH47qhggwgntuESlJWAbcN7igSoJ4hWgmhFgXSdi2X9w/3zsmwwiN/Py9LiA6VHYCiYwY4prR7g8mlz",
-	"ksJ5Ll6ckO/DsVEUFzzfm8vBihrmbli5W8vdYrVtya2hGfGeIridQp6YrfFoMGL+XVAcqhUbkRup5yCt",
-	"mMZ/dW1DMjO/T+r8r0FiIW6HiQsVLYc5q+PgL4Fyc79DOX3CceaeE3Le7XszsjGjxAnmRrQyup923BE8",
-	"1ii8lrS0ALov9i5lHJU028jCektuOpHRRWEOznBAawjVjc/awfMQhQRJoQPDt7lIr/5K1eYOzvzSj9U/",
-	"fjgN2QDNQJINVZuTWUzKCI9XM9qUI2YaooJPlsFUJ/US72p5B5aWUU2DpTl442KJRT32Q6YHMqK7/Iz/",
-	"oTkxn83ZNqzfDntCLpGBKXucnZMhM9q+VRDsTKYBWiEEKayCT4zWfRSUz5vJ4/s0aY9eWpuC2yG3CNwh",
-	"sbvzY/Ct2MVg+FbsekdA7EDdBX2YcVCM1FCoCfC9cJAJ3H+HPiol3feRjGNPQbJZoBFdFZ4GHt74ZpbG",
-	"OHu+FPJm3KfDVjhpTM6EmlED5jvvIAmbVmXiSDFitrINOgM1Xr5xptEdPoaxFhbeavonYEGZUe8CC+2B",
-	"7hoLoihZDndA+pso019SBU8ek7d/Pf/q0ePfHn/1tSHJUoq1pAVZ7jUoct/pZkTpfQ4P+itD7ajKdXz0",
-	"r596Q2V73Ng4SlQyhYKW/aGsAdSKQLYZMe36WGujGVddAzjlcF6C4eQW7cTa9g1oL5gyElaxvJPNGEJY",
-	"1sySEQdJBgeJ6djlNdPswyXKvazuQpUFKYWM2NfwiGmRijzZglRMRLwpr10L4lp48bbs/m6hJddUETM3",
-	"mn4rjgJFhLL0jk/n+3boyx1vcDPK+e16I6tz807ZlzbyvSVRkRJkonecZLCs1i1NaCVFQSjJsCPe0d+D",
-	"RlHgkhXwVtOi/Hm1uhtVUeBAEZWNFaDMTMS2MHK9glRwGwlxQDtzo05BTxcx3kSnhwFwGHm75ynaGe/i",
-	"2A4rrgXj6PRQe54GWqyBMYds3SLL22urQ+iwU91TEXAMOl7hZzR0vIBc0++EvGwsgd9LUZV3LuR155y6",
-	"HOoW40wpmenrdWjG13k7+mZtYD+JrfGLLOi5P75uDQg9UuQrtt7oQK14LYVY3T2MsVligOIHq5Tlpk9f",
-	"NftJZIaZ6ErdgQjWDNZwOEO3IV+jS1FpQgkXGeDmVyounA3Ea6CjGP3bOpT39MbqWUsw1JXSyqy2Kgl6",
-	"b3v3RdMxoak9oQmiRg34rmqno21lp7OxALkEmu3JEoATsXQOIue6wkVSdD1rL9440TDCL1pwlVKkoBRk",
-	"iTNMHQTNt7NXhx7BEwKOANezECXIispbA3u1PQjnFewTDJRQ5P4Pv6oHXwBeLTTNDyAW28TQW6v5zgvY",
-	"h3ra9GME1508JDsqgfh7hWiB0mwOGoZQeBROBvevC1FvF2+Pli1I9Mf9qRTvJ7kdAdWg/sn0fltoq3Ig",
-	"/M+pt0bCMxvGKRdesIoNllOlk0Ns2TRq6eBmBQEnjHFiHHhA8HpFlbY+ZMYzNH3Z6wTnsUKYmWIY4EE1",
-	"xIz8q9dA+mOn5h7kqlK1OqKqshRSQxZbA4fdyFw/wa6eS6yCsWudRwtSKTg08hCWgvEdsuxKLIKorl0t",
-	"Lsiivzh0SJh7fh9FZQuIBhFjgLz1rQLshiFQA4Aw1SDaEg5THcqp467mM6VFWRpuoZOK1/2G0PTWtj7X",
-	"vzRt+8RFdXNvZwIURl659g7ya4tZG/y2oYo4OEhBr4zsgWYQ6+zuw2wOY6IYTyEZo3xU8Uyr8AgcPKRV",
-	"uZY0gySDnO77g/5iPxP7eWwA3PFG3RUaEhvFFN/0hpJ90MjI0ALHUzHhkeAXkpojaFSBhkBc7wMjZ4Bj",
-	"x5iTo6N79VA4V3SL/Hi4bLvVkRHxNtwKbXbc0QOC7Dj6FIAH8FAPfXNUYOek0T27U/wXKDdBLUccP8ke",
-	"1NASmvGPWsCADdUFiAfnpcPeOxw4yjYH2dgBPjJ0ZAcMuq+p1CxlJeo6P8D+zlW/7gRRNyPJQFOWQ0aC",
-	"D1YNLMP+xMbfdMe8mSo4yfbWB79nfIssJ2cKRZ428FewR537tQ3sDEwdd6HLRkY19xPlBAH14WJGBA+b",
-	"wI6mOt8bQU1vYE+uQQJR1bJgWtuA7baqq0WZhANE/RojMzonng2K9Dswxav4FocKltffivnM6gTj8F12",
-	"FIMWOpwuUAqRT7CQ9ZARhWBSvAcphdl15mLHffSwp6QWkI5powe3vv7vqRaacQXkv0RFUspR5ao01DKN",
-	"kCgooABpZjAiWD2ni+xoMAQ5FGA1Sfzy8GF34Q8fuj1niqzg2j+4MA276Hj4EO04r4XSrcN1B/ZQc9wu",
-	"ItcHOnzMxee0kC5PORxZ4EaespOvO4PXXiJzppRyhGuWf2sG0DmZuylrD2lkWlQFjjvJlxMMHVs37vtb",
-	"VlQ51XfhtYItzROxBSlZBgc5uZuYCf5yS/Of6274mARSQ6MpJCk+gZg4FlyaPvbVxCHdsIkmY0UBGaMa",
-	"8j0pJaRgo/yNyKdqGE+Ijf9LN5SvUdKXolq7ADQ7DnLqSlmbiqx4b4ioNKR3PEHrdIxzu6Bj/9DDyEFA",
-	"jS7WNW1bzeOa1vO5tz1TrtQAeV1Tf9S7NZ8NqqoGqdtGVbXIab9WmcDFW4JagJ9m4ok+EESdEVr6+Aq3",
-	"xZwCs7l/jq29GToGZX/iICSu+TgUFWf05Hx/B9KKHYhIKCUovFtC+5KyX8UqfJnmLh+1VxqKvgnedv1t",
-	"4Pi9GVT0BM8Zh6QQHPbRx9iMw4/4MXqc8H4b6IySxlDfrvLQgr8DVnueKdR4W/zibndPaNfVpL4T8q58",
-	"mXbAyXL5BNfhQT+5m/KmDk6a5xGfoHu30mUAal6/k2eSUKVEylDYusjU3B4050Z0j1za6H9dR+Pewdnr",
-	"jttxfoVPItG4C3lJKElzhqZfwZWWVarfc4rGpWCpkaglr0UPmxuf+yZx+2bE/OiGes8pRqzVJqdopMUK",
-	"IvaV7wC81VFV6zUo3VFSVgDvuWvFOKk40zhXYY5LYs9LCRJDh05sy4LuycrQhBbkD5CCLCvdFtvxWZbS",
-	"LM+dJ85MQ8TqPaea5ECVJj8yfrnD4by33h9ZDvpayKsaC/HbfQ0cFFNJPLrqe/sVA1/d8jcuCBaf0dvP",
-	"1ndjxm/ebu3R9tQ8Df8/9//z7N158t80+eM0efY/Fh8+Pv304GHvx8efvvnm/7Z/evLpmwf/+e+xnfKw",
-	"xx4NOcgvXjiV9uIF6i2N86YH+2cz3BeMJ1EiC8MwOrRF7uMDWUdAD9pWLb2B91zvuCGkLc1ZZnjLTcih",
-	"e8P0zqI9HR2qaW1Ex4rl13qkNnALLkMiTKbDGm8sRfUDEuPP89Cb6F7c4XlZVdxupZe+7esTHxgmVvP6",
-	"CabNznJG8H3ehvqoRvfn46++ns2bd3X199l85r5+iFAyy3ax15MZ7GJKnjsgeDDuKVLSvQId5x4IezQG",
-	"zgZlhMMWUCxBqg0rPz+nUJot4xzOx/Q7Y9GOX3AbbG/OD/om987lIVafH24tATIo9SaWtaElqGGrZjcB",
-	"OvEipRRb4HPCTuCka6zJjL7oovFyoCvMHoDap5iiDdXnwBKap4oA6+FCJllEYvSDIo/j1p/mM3f5qztX",
-	"h9zAMbi6c9aOSP+3FuTe9y8vycIxTHXPPuS1QwdPLyOqtHtd1IokMtzM5qqxQt57/p6/gBXjzHw/e88z",
-	"quliSRVL1aJSIL+lOeUpnKwFOfMPll5QTd/znqQ1mE4qeCpGymqZs5RchQpJQ542RUh/hPfv39F8Ld6/",
-	"/9ALquirD26qKH+xEyRGEBaVTlyCg0TCNZUxp5WqH7jjyDaDydisVsgWlbVs+gQKbvw4z6NlqboPXfvL",
-	"L8vcLD8gQ+WecZotI0oL6WURI6BYaHB/fxLuYpD02ttVKgWK/F7Q8h3j+gNJ3lenp0+AtF5+/u6ufEOT",
-	"+xImW1cGH+J2jSq4cKtWwk5LmpR0HfONvX//TgMtcfdRXi7QxpHnBLu1Xpz6iHocqlmAx8fwBlg4jn49",
-	"h4t7a3v5ZFbxJeAn3EJsY8SNxmN/0/0K3qDeeLs671h7u1TpTWLOdnRVypC435k6x83aCFk+jEKxNWqr",
-	"Lh3QEki6gfTK5WmBotT7eau7j9RxgqZnHUzZDD72BRnmkEDPwhJIVWbUieKU77uP+RVo7eOB38AV7C9F",
-	"k4LimNf77cfkauigIqUG0qUh1vDYujG6m+/CwVCxL0v/Jhsf53myOKvpwvcZPshW5L2DQxwjitZj5yFE",
-	"UBlBhCX+ARTcYKFmvFuRfmx5RstY2psvks3H837imjTKk4vcCleDVnf7vQBMByauFVlSI7cLl8nKPpgO",
-	"uFil6BoGJOTQuTPxWXLLIYSDHLr3ojedWHUvtN59EwXZNk7MmqOUAuaLIRVUZjrxen4m6z90nglMUOkQ",
-	"tsxRTKoDGy3TobLlZLMZ94ZAixMwSN4IHB6MNkZCyWZDlU+yhbnI/FmeJAP8iQkAxtK+XAShZkHCsTqp",
-	"i+e53XPa0y5d8hef8cWneQlVywkpW4yEj9Htse0QHAWgDHJY24Xbxp5QmmQEzQYZOH5erXLGgSSxqLXA",
-	"DBpcM24OMPLxQ0KsBZ5MHiFGxgHY6BfHgclPIjybfH0MkNwlU6B+bPSoB39D/N2XjeM2Io8oDQtnA16t",
-	"1HMA6kId6/urE3CLwxDG58SwuS3NDZtzGl8zSC/7CIqtnVwjLjLjwZA4O+IAsRfLUWuyV9FNVhPKTB7o",
-	"uEA3AvFS7BL78DMq8S53S0Pv0dB2fIYaO5g2z8s9RZZih9E+eLXYUOoDsAzD4cEINPwdU0iv2G/oNrfA",
-	"jE07Lk3FqFAhyThzXk0uQ+LElKkHJJghcrkfpG65EQAdY0eTB9kpvweV1LZ40r/Mm1tt3qQk86+GYsd/",
-	"6AhFd2kAf30rTJ1s5XVXYonaKdpBK+08M4EIGSN6wyb6Tpq+K0hBDqgUJC0hKrmKeU6NbgN447z13QLj",
-	"BWazoXz/IIiEkrBmSkNjRPdxEl/CPEkxiZ4Qq+HV6VKuzPreCFFfU9aNiB1by/zsK8BQ4hWTSifogYgu",
-	"wTT6TqFS/Z1pGpeV2rFWNuUsy+K8Aae9gn2SsbyK06ub94cXZtqfapaoqiXyW8ZtwMoSUyRHIzBHprZB",
-	"uqMLfmUX/Ire2XqnnQbT1EwsDbm05/gXORcdzjvGDiIEGCOO/q4NonSEQQYvZ/vcMZCbAh//yZj1tXeY",
-	"Mj/2wagd/3536I6yI0XXEhgMRlfB0E1kxBKmgwzD/SetA2eAliXLdh1bqB11UGOmRxk8fF62DhZwd91g",
-	"BzAQ2D1jr2okqHYKvkbAt7miWxlwTiZh5rKdKC9kCOFUTPlKB31E1a/uDuHqEmj+A+x/NW1xObNP89nt",
-	"TKcxXLsRD+D6db29UTyja96a0lqekCNRTstSii3NE2dgHiJNKbaONLG5t0d/ZlYXN2Nevjx/9dqB/2k+",
-	"S3OgMqlFhcFVYbvyX2ZVNtvfwAHxmdSNzudlditKBptfpygLjdLXG3ApqQNptJc7s3E4BEfRGalX8Qih",
-	"gyZn5xuxSxzxkUBZu0ga8531kLS9InRLWe7tZh7agWgeXNy0BKxRrhAOcGvvSuAkS+6U3fROd/x0NNR1",
-	"gCeFc40kzS5sXnhFBO+60DHmeV86r3tBMfOltYr0mROvCrQkJCpnadzGypfKEAe3vjPTmGDjAWHUjFix",
-	"AVcsr1gwlmk2JbdNB8hgjigyVTS9ToO7pXA1fyrO/lEBYRlwbT5JPJWdg4ppUpy1vX+dGtmhP5cb2Fro",
-	"m+FvI2OEWV+7Nx4CMS5ghJ66HrgvapXZL7S2SJkfApfEEQ7/cMbelTjirHf04ajZBi9u2h63sERPn/8Z",
-	"wrC52g/XB/LKq0s/OzBHtN4PU8lKij8gruehehx5sOTz3DKMcvkDwocOYZWLFouprTtN2aJm9sHtHpJu",
-	"QitUO0hhgOpx5wO3HCbc9BZqyu1W24ckrVi3OMGEUaULO35DMA7mXiRuTq+XNJaN1AgZBqbzxgHcsqVr",
-	"QXxnj3tVv7aws5PAl1y3ZfYxegmyeUvYT2xzQ4HBTjtZVGgkA6TaUCaYW/9frkRkmIpfU26ruJh+9ii5",
-	"3gqs8cv0uhYSU0mouNk/g5QVNI9LDlnaN/FmbM1sgZJKQVABww1kiz9ZKnJVROo3RA41FytyOg/K8Ljd",
-	"yNiWKbbMAVs8si2WVCEnrw1RdRezPOB6o7D54wnNNxXPJGR6oyxilSC1UIfqTe28WoK+BuDkFNs9ekbu",
-	"o9tOsS08MFh09/Ps7NEzNLraP05jF4ArMDPGTTJkJ39z7CROx+i3tGMYxu1GPYm+urcV5oYZ18hpsl2n",
-	"nCVs6Xjd4bNUUE7XEI8UKQ7AZPvibqIhrYMXntnySEpLsSdMx+cHTQ1/Gog+N+zPgkFSURRMF865o0Rh",
-	"6Kkpb2En9cPZWksuM7GHy39EH2npXUQdJfLzGk3t/RZbNXqyf6IFtNE6J9TmD8lZE73g86WTC5+eCFM1",
-	"1xmaLW7MXGbpKOZgMMOKlJJxjYpFpVfJX0i6oZKmhv2dDIGbLL9+GklP3U6Tyo8D/LPjXYICuY2jXg6Q",
-	"vZchXF9ynwueFIajZA+a1x7BqRx05sbddkO+w/GhpwplZpRkkNyqFrnRgFPfivD4yIC3JMV6PUfR49Er",
-	"++yUWck4edDK7NAvb145KaMQMpZzsDnuTuKQoCWDLcbuxTfJjHnLvZD5pF24DfRf1vPgRc5ALPNnOaYI",
-	"fCsi2qlPmV5b0l2sesQ6MHRMzQdDBks31Jy001N/fj56N1FQcU+XN2z3HVvmi8cD/tFFxBcmF9zAxpdv",
-	"VzJAKEF6/ijJZPX3wMdOybdiN5VwOqfQE88/AYqiKKlYnv3avPzsVD+QlKebqM9saTr+1tRpqxdn78Bo",
-	"+sAN5Rzy6HBW3vzNy6URyfnvYuo8BeMT23YLMtjldhbXAN4G0wPlJzToZTo3E4RYbT+qq4O287XICM7T",
-	"5Kprjmu/kEeQbv0fFSgde6CEH2zgGNpGDTuw2b4J8Aw10hPyvS3FvAHSSkSEmqDPFNF+NV2VuaDZHDNY",
-	"XL48f0XsrLaPrTZks42vURFqr6JjEwvScE4LQfaFg+LPI6aPMx6vbVatdFInB489QDUtmvTlrOMnQBUp",
-	"xM4JeREUVbVvVc0QBBOYyMJodfVoVj5CmjD/0ZqmG1T7Wqx1mOSnp8n3VKmC0pR1iak6NyWeOwO3y5Rv",
-	"E+XPiTC6+TVTtgIvbKH95rV+AO7MDv4NbHt5suLcUsrJEbdcnYnyWLR74OwV6V0JUcg6iD9S6LdVJo6t",
-	"GvAWe0VTZXVLEPRqUtoXlHXpIF9ZPaVccJZioqrYFe1K9U7xs03I6dU15Poj7k5o5HBFCx/UoXgOi4Ol",
-	"EDwjdIjrG/qDr2ZTLXXYPzXWhN1QTdagleNskM19/Q5na2Rcgcs1ioWdAz4pZMt3iRwy6g5ParfJkWSE",
-	"T28GlMfvzLefnGkBY9KvGEclwqHNCX7WGoiVRLXRPJgmawHKraf9/li9M31O8CluBrsPJ77yKI5hXX9m",
-	"2dbP3R/q3Hu9nZfZtH1u2roESfXPrShnO+l5WbpJh6u7ROUBveODCI54LxPvPgqQW48fjjZCbqPhKnif",
-	"GkKDLTq7ocR7uEcYdaWTThUtI7RaisIWxIaJRbMkMB4B4xXj0NTFjVwQafRKwI3B8zrQT6WSaisCTuJp",
-	"l0Bz9HDHGJrSzr1x26G66aEMSnCNfo7hbWyKtAwwjrpBI7hRvq/L8RrqDoSJ51gH3CGyX3IFpSonRGX4",
-	"aqFThCXGOAzj9mWe2hdA/xj0ZSLbHXOlHXsTDT1EXVbZGnRCsyyW+vVb/ErwK8kqlBxgB2lVpwgtS5Ji",
-	"3pV2Ipo+tbmJUsFVVYzM5RvccrqgqlGEGsLKSn6H8aHLco//xvJjDu+MC/Q4OtTQR3Vkx2Vf6odOxqRe",
-	"Q9OJYutkOibwTrk9Opqpb0boTf87pfRcrNuAfOb0E2NcLtyjGH97aS6OMDtDL+mrvVrq5AkY2Cd8LUpU",
-	"G+tnv22uhFdZLwssOpTqWnfjBojhqnVzvPwGwnuDpBvU3q/WQzkU5JsOxqRT7V7HaUpGWdDgiyMbIWTf",
-	"FiEUcevsUFSQDQoyn3u9p0mGPTlbxxMfBgj14WZ9gH7wsaykpMy53xtm0cesi3rvv0OYEg/bbHB3ES6W",
-	"fNBi98N2KO7bJ2PD792qVlfgnsyXErZMVN6x7SOfvEpof23ViKoj76Pr7xtecaovaw4dNN5euuoCdplO",
-	"J//hVxsnR4Bruf8nMOX2Nr1XL6sv7VrzVNOE1ImpJyWqbt2KUxIVxnLiOdmwVbHrQL2xHlm9mCIO9OuH",
-	"zWcX2VEXZiyv4syOEjt28Wpgw2mnmlRTeMRKoViTHz5WJmxiiOElVvoK0mb1x/LxPVtINRYFaOIWJMAx",
-	"SbTMZEHh0f+ffmpAna4jMV3WqbFUU/1KAAfu+N5rsOBFo82ifjI9sdJ5HZ2GfBqzIa+Bu9qf7Xcek6PN",
-	"VytINdseeH33tw3w4GXX3NtlbA3v4DEeq6OXMXnL8VbHBqCxx3Gj8ARJFG8NztDbmyvY31OkRQ3RtO5z",
-	"f9XeJG8HYgC5Q2JIRKhY9Ic1JDuHPFM1ZSAWfLSV7Q5NBrTBilDBW9IbzuVJ0lwczfvSkSnjJWkmzWW6",
-	"HvXqGgNxhx7o9StaDOsfL7CAiKqrNfq8H6GWTi762RGvXd4QfCtZ+058BhFQ/jf/MNrOkrMrCGtWoafq",
-	"msrMt4iaXrxVJxm5j3qv6nw1hi7Qq3pm1sTG9t9RRfJtYQR0mgsjRiRDYeTtcNQ6luOeskE3Nv07Btoa",
-	"uFYgXW0/lH9zoSDRwsfSjsExhgobWXQjJKjBHJcWuMHMM2+a1DqY65diphnqAorCBRIJBTXQySABzvCc",
-	"Y8h+br/7h0M+1+tBC1NNr4eLDvioaKZ6SAypfkXcbXn4QdJNjE2Mc1s/WsWy4XCQbW9IKUVWpfaCDg9G",
-	"bZCbnGtqhJVE7TRpf5UdHSF41XkF+4VVgny1Br+DIdBWcrKgB1kUOpt8p+Y3FYN7fSfgfUnL1XxWCpEn",
-	"A86Oi34Kny7FX7H0CjJibgofPThQQYfcRxt77c2+3ux9ypqyBA7ZgxNCzrmN1/aO7XYO6c7k/J4em3+H",
-	"s2aVzarljGon73k88BXzXclbcjM/zDgPU2BY3S2nsoMcSBCzG0gfJOl1pJ7UyVStvO9q7tb4aYjKQhGT",
-	"SZryNQfiZOoQmabyRxMm05cO8lxcJ0hFSZ3/K6ZzmHZtJukznjbdDLaXEMTbUOUu0D3Z0IykQkpIwx7x",
-	"Jw4WqEJISHKB4Tcxz+BKG3mowLhmTnKxJqI0aq5No+d9KNGyNMFcd1WCxz7XtRAk1uEzkBABlHue68C1",
-	"jfvwjlTBOb7CzuUmYrfBDfO7dXQZHUdwR1e/CMCcQOiHbVbnsSpB7XV161UNVY/TomBpHN3/WtEqgzEm",
-	"MeqNocIloLUP4LAZHvCQp9TOSTw9fTQDp8s85rEh7vg5Jw3Sufkv3mDdcckKHHMZ4GeRB5hjq45Vfors",
-	"aj2VK0zl31QOUEjU4T3uX7bVAJdTvcx1xumJzCAAYNjv3IJhkvf5WDBWWF0zoREkX9Qy/7xV/Jh1OJ7P",
-	"BmhPdkqtzr8BYsauJLg3frYMYKfuUEn1xssApnlfMzdaHih8gGeLp1Bl7UjenuVqEHaFK1EmOWyh5Y53",
-	"Dw+rNAWl2BbC+oW2M8kASrTudnWOmJ855O0dQdStPQk8lVOwG5VMLWLtTpEDYmdUSN7xxB4TNfUoGYi2",
-	"LKtoC3/qFpXchoq4RS4fD+uHaZziaCYRX9wYizgYGYI0Hz2XPB4YEr57rU1KOFtWm54tETYnW5X0mg+r",
-	"YH2ibGSn6TUQA8S+3EGK91A78uH2OCE4GFGdN+2DQpOsd/imqvwglY0RWa8iZFRqU+Ar+obpZ7zg6/pG",
-	"pF1rdGQqMgBTDW/AOEpo4vSCZgXdk4ytViCtW0VpyjMqs7A54yQFqSkzOuZe3VzBMNDKCuYHdQzDqXFQ",
-	"z6xi2gZaCC0g+d4pb0Py/wS5HX1oEZndXttaDBWr7O1K/GEH3Rk9ByPcBojAPUlHLcceVsFRxCQFvYIj",
-	"51HsDxifBhPFOCusFjjrlCk+jdL6z4g6PPC/cKZHqd2Kft2QQ+sTssToaZCvG8e03Zw+DcaiRC9tyaQw",
-	"UrRbgcDvtTVQ2flgIKOi450J8lQ14vIFFdRKSp3Jri8O9JixBWbuImiPkha65ob0AFOKsuiBM9GW1cUK",
-	"qRM3xV5MGDdQs+N5N6KlfQXV247VP9NKohB1TfeHE7M111A8GNiO7NUZH+NQQ+222hKYsgUlonnPjhFP",
-	"IjQfq6nQzzh194uxUe6NH+7PW46ztMcXEFZoH6e3RpD3pBKhNcr3saPjbck3WOCQdDIhTvPOtqo+LX/G",
-	"BkVZ9M0SkU4CrR+zF8FmUDl4PIwizFPcPICWNvQT3a5eH+ryix8bPWlaDWPf4QB4YXRNUMXYOzocOF/4",
-	"JfGPNVKCpXwYooTW8g8F7LgFNoplsEVOVtMabNZ4+/qsvS9BNJZ6Xgc5DRXc7sZCYVJiIxzkeSSGyoqP",
-	"tsRtQDjmnpRbmn/+OCjMVn2O+IDszbDnNAykCZFsUalu9ozvFZ00dxA0c3dT89cYt/U3MHsUvRbcUE5j",
-	"7TF/FP5pbq38K1/vcgucXOOYNuj70ddk6dKclBJSprqa8LUvRVXHjWBlRvd0cqcPBKocWuevQt+CjFfe",
-	"sER+asraoCF7zRsImyP6hZnKwMmNUnmM+npkEcFfjEeF+UYPXBdXrWjwRqoLbjQh4Y6jwoP3XUdGhfcz",
-	"qU5dno18NpdOpaC/zsm3dQu3kYu6WdvUJw195I7VPpnyEiFe0sh0x6cQFiFYD4wgqOT3R78TCSss+CvI",
-	"w4c4wcOHc9f098ftz+Y4P3wYVfI+2yMIiyM3hps3RjG/Dj2Lt0+/BzIwdPajYnl2iDBa+TSaktmYMeI3",
-	"l7XnixTt/s0GZvaPqiuceotocouYyFpbkwdTBZkyJiTJcN0iKTEw6CGtJNN7TCbsNV72W/S5xvd16K8L",
-	"Ha9NeO7u0+IK6nTUTaBwpfzt+r2gOd5H1rLIzS0k8hPyckeLMgd3UL65t/wPePKXp9npk0f/sfzL6Ven",
-	"KTz96tnpKX32lD569uQRPP7LV09P4dHq62fLx9njp4+XTx8//fqrZ+mTp4+WT79+9h/3DB8yIFtAZz51",
-	"3ex/Y2X75Pz1RXJpgG1wQkv2A+xtEV1Dxr48L03xJEJBWT478z/9T3/CTlJRNMP7X2cuM9Zso3WpzhaL",
-	"6+vrk7DLYo2RgYkWVbpZ+Hl69XvPX1/ULkhr9McdtUklvDPHk8I5fnvz8u0lOX99cdIQzOxsdnpyevLI",
-	"jC9K4LRks7PZE/wJT88G933hiG129vHTfLbYAM0xkN78UYCWLPWfJNBs7/6vrul6DfLE1Sw2P20fL7xY",
-	"sfjoIiQ/jX1bhOW/Fh9bgaTZgZ5YHmjx0We9HW/dSivrAmiDDhOhGGu2WGIyralNQQWNh5eCyoZafERx",
-	"efD3hcv+E/+Iaos9DwsfbR1v2cLSR70zsHZ6pFSnm6pcfMT/IH0GYNm3tgu94ws0Ty8+tlbjPvdW0/69",
-	"6R622BYiAw+wWK1sFu+xz4uP9t9gItiVIJkR/Gx8uzPF18fqIpudzV4GjZ5vIL3CwlfWD4Pn5fHpaSQR",
-	"QdCL2ONLlzlk5uw9PX06oQMXOuzkUrT2O/7Cr7i45gSfrVpeXhUFlXuUkXQluSI//0DYikB3Cqb8DMg/",
-	"6FqhuRar7MzmsxZ6PnxySLPPtBaYenDf4NL/vOdp9Mf+NncrjMZ+XnxsV7hp0Y/aVDoT10Ff1KasKaA/",
-	"X13zsfX34poybeQj994BMxD3O2ug+cIlN+n82rwn7n3BR9LBj6HnOfrrok7wHv3Y5VSxr+6kDjTyziv/",
-	"uZFaQilgdvYuuP/fffj0wXyTW/Q0vPsYXGpniwXGEG+E0ovZp/nHzoUXfvxQ05jP+TYrJdviE/IPn/5f",
-	"AAAA///KktDr7cgAAA==",
+	"H4sIAAAAAAACA+29abPbRrIg+lcQmomwrSHOkZfu21ZEx7xjyXZr2rYUkuy+82yPDZJFEhYI8GI5i/30",
+	"319utQFVAMhDy90T/cXWIWrJysrKyszK5bcHq2p/qEpVts2Dx789OGR1tletqumvbLWqurJN8zX+tVbN",
+	"qs4PbV6VDx7rb0nT1nm5fbB4kOOvh6zdwb9LGMS2wf6LB7X6ry6vFQzV1p1aPGhWO7XPcOD27oCtzUi3",
+	"6bZKZYgrHuLZ0wdvRz5k63WtmmYI5fOyuEvyclV0a5W0dVY22Qo/NclN3u6Sdpc3iXSGZgkgIqk28LPX",
+	"ONnkqlg3F3qR/9Wp+s5ZpUweX9JbC2JaV4Uawvmk2i9zmFygUgYosyFJWyVrtaFGu6xNcAaEVTeEz43K",
+	"6tUu2VT1BKgMhAuvKrv9g8ffP2hUuVY17dZK5df0z02t1K8qbbN6q9oHPy5Ci9sAhGmb7wNLeybYh4m7",
+	"ogV0b2g1sMYtTFAm2Osi+bpr2mQJ6y6Tl188ST7++ONPcSH7rG3VWogsuio7u7sm7g7f11mr9OchrWXF",
+	"toK9XqemPQBA87+SBc5tlTWNCh+WK/ySAK1GFqA7BkgoL1u1pX3wqB97BA6F/XmpAFI1c0+48Vk3xZ3/",
+	"D92VVdaudocK8BjYl4S+Jvw5yMOc7mM8zADgtT8gpmoc9PtH6ac//vbh4sNHb//b91fp/yt//unjtzOX",
+	"/8SMO4GBYMNVV9eqXN2l21pldFp2WTnEx0uhh2ZXdcU62WXXtPnZnli99E2wL7PO66zokE7yVV1dASRw",
+	"uoWMgFVlMFSiJ066skA2haMJtScwwKGurvO1Wi+Q+97sctiLVdbwENQOOGJRIA12jVrHaC28upHD9NZF",
+	"CcJ1Ej5oQf+8yLDrmsDEWq2qtUrVtZYCfCT8YwcMAWZfECBFtW30HbnKioJunuxwKHKgfHuzZsBbtnkD",
+	"mwGcYlWVcJ2u2sQZmJCTFQ3eajg9YKCEkXDYq5dP0o/+kjA8Zi4ZI7ZsfxGBFS8ruPQAGbhidUv8L10V",
+	"VQNMqJq4kPUdC+csca9Qezs3x13PyWtcEU6OH1i8IISUeIoLkFlaomSYriFU8mUMhLFJ7qouuSFyLPI3",
+	"1F9Wg2ja40YxOXqSA7KrGOYGyJhAHoMbRNk+g/lxYgS9gO3Xuwc4ACkTlitrBZBq1XZ1uUgq+F7r35cK",
+	"GFZS7XO8YS6Sb1SDIzkIalShVvibUNm6ap0pkXUvkqYDNAPifl4W1erNRV2uf75ISBJsusOhqk13hOx/",
+	"vXr+jVxqMQTJgsflO81/h1gpN/m2AwQAYShaq4eQavkLLAiPP0FS1cnXQC/ZVr3IVm8SOMh4Ni6SZxug",
+	"jdZhEcJTCJXYMwo8wxUS9n5pKuQN+2Z7gLnCkl2Rw14MV/V1dpvvu30CIy1hRbDLWpQwOxsDiEecYEn7",
+	"7HY46eu6K1e0z3ZaT6bHM5g3hyK7I4TBIH99tBBwgHyAdx5AvkUKa2/LqDyPc0+DBwygK9czxN0W99QR",
+	"sJqDWuVAUuvEjDICiUwzBU9eHgePFcIdcPQgUXDMLBPglOo2QDPI8/ALnNKtckjmIvlWLjn62lZv4L7R",
+	"hJ4s7+jToVbXedU1plMERpp6/KTCOVIpjLfJAzT2StCBbJfbyE28F1kY76EM2DzeVww0DMccKgqTM+G4",
+	"3juU5pYgAPz5k5isZ7/O3H3o2dv10R2ftdvUKOUjGRCh8Ksc2LCE7fWfYSdw526AV8I8YaUraYBHFSSV",
+	"JNIQdLCLMBTOSPNtFQRCvk351wEt5dvXKAZs8oJEhF+QhPROdA3xIW8vtNAAQ5YZMC31+IfyIf6VpCDL",
+	"w85n9Rp/2fNPX8NAOUyCPxX801fVNl/BT5H9NLAGdX/qtuf/4XjhG6G9DWL7q6p60x3cBa08GwqcYwf3",
+	"Pbh4zGPPxpUxvLg68OtbrRcf2wOg0BsZATKKu0OGDd+oO5B64R/ZakP/u90QSWeb+lf8H0jJ2Ls9bEKo",
+	"xaMkUgFJV1cvnr1GXvhSfsTfkPso1mQdmfuSbnL4zQIG/POg6jbnoXgFQYYMX4zJC2e7GOijSOMrGI1G",
+	"ylu1bwIHwXTK6hpwgX/jaOFJmcXDbS1cXrPS/0xRb0ph4SmtPNmpbK3qAEhv3TP6Pa/PgKnntjhmIYtx",
+	"3GcSpboByXAl8jaOtE4AAo0N6KE3ojnDTtCoPib/O9wMAMl/u7Sm2Evu3lzqqYcI7mFAxp2zZL3tzjKN",
+	"llWCtMlrXjYge6oXdVVtzrDsoroBtTxqpiV1CNQHEvut7RP1nrVmk/IrSPLS7Ab1Us9WSu0VS3ptaEjV",
+	"iBKrso3oGqzTAnTJkm4qo25i1z0s9WKmgUbzFhSaabV5uVa34bXSp+FUDFd42RYKc/8tEMnVJjyFSPWs",
+	"QZBSxbNlvLEJdV2IcLOrSCnDbqp+U8hXwxduKoTsWhEUVvi4a1WIWUDXiPSHXwKrFrXf7JEBlU385m8S",
+	"FBYovvYbIPwGYWQpwUaH1kppDrHqRbNYietE442q+Q7OtiDdNa2o6BZIaLPs4CLXAimeFNDH6QNpZlnt",
+	"rA1OS9mAtNq0wFVmYi0owL3W6/Y2T4xFQP9ZG6YMUHSPP3CbqgDSnX/guP3x542AO+N548Weet6Cq17A",
+	"P37JVg55MpMA/hxCeI8buwxAn1I5GAsjVLtQz+HbxIvR3tiS4VDe33YZ0h+aiKqaDwcfZvjNnARpU1Sr",
+	"rGCSJNNU6Z8KHFerA3gD8ARXtskZ7gFomxIYKYExef3Zob/CXq+oExpv+bpOYbwjxniBJrFm1mlDRUmz",
+	"iRzfBpnuUJLNUQkt1HVWtjNIgWeaJYpEES5206VqXLb3XuPun7e7aKjcFtXS/PA+jGoxSN/hF8YHWRVV",
+	"TqYpdZs3bfMBLT+zgrw7D0jxyZfu2GSUrtC8tlRibEG+u9EMlvV488ra9PkxrIO2E58tHbpD4j0HxZGB",
+	"XS6ISVrBxn+Tti6Z4e+zOv9rkJiL2zhxEfvQVyvZvukXx+j9fo9yhoQjD58XyVW/72lkg6OMEEzzzGLx",
+	"XMRzhLTuo7fq6pUKqUZopEoj+tG3jWLSOGTbvDQCC9y8++wNbwRbzJECVGNMwkxErFkZ47aY2wTnFyfJ",
+	"HWcjU0Hm4kR6DW2ttsaRtU6sioZMQCxUxRrvP63cgehmb06Xdp5wA4f1nkPXcy6pI2jITvBv0tGk42Hy",
+	"BAIa2d8oCblPmrMJiOjunKRzJAOie+rfZNMnm5M5T3BfJ7jOJLG84Eeoc9xPY/qdvjp9ZTm4JezQEB4G",
+	"ZvyFn1xB5MtQ+/QHRA17jz4PqKKhMElPDkg3OSBDP7jV6iar1xEzhqXysek9eaS/LNR81uRsgGjXUgea",
+	"m2efG0fu6x8ffPmS1U9BCU3pZXQOssLYmDwfMiS9brO0pF813cfQgB/joaoK9rtAGsMHh2rRU38LIF18",
+	"pXAnKtR6653l6A1vzOtCUAt76bsoPOYg+tj1lFdBKb9lusTm4sE/eDWxEoDhHIdPrE8RG3edrd4g1Uor",
+	"n0CtUe8YwdKBf9IgbKCbaVo4VA1odCjlX+OD5sFONbC26aU5ljAXyy/VG3X3N9AGqvrun4nHde2ODGLj",
+	"I7H7VAtqCXao6vxXfTRCp0tPGLCR6QPfsOsSnDJGGRJqdVMmgKQTTF07xiuaT+o2HWEVm7w253hVXWvK",
+	"Q9BkjMfiiAKAmPWorAbGUHM/7ZLUJm9KhFgMYbzfQc5FY0Wsjbus3Dq2XQe54U2EA1OskRfRSo49KkSE",
+	"IVb+DvUdww1Dm2awdYJUEjphVighGydTcQzNLvcU07LAiF+RQmW7/HN9wlmesVWjC5XF3dTZgVcmX/it",
+	"HG7TzLhSubA2T7M2Q++I5zDiPv/1HPwe/eBTlPciFG6dkroSHTRJNhw+ZqwFMmYVRQYUvldZ09Xs0jk8",
+	"VSBQ1QrN1VkRdM40PmXDKYh+6ZszCBJF9dN1tupATNnTMwbTO+67B/oqKx0LTQFQ0oOKA6bxC1w8wJWk",
+	"Kna20NFbBAz0CuRd4VOFIQfs89oo2BpgO02O1w7xo0O12rncNS8UPrrUXRmRnhiMuq4ifJ4+RSDZZHkh",
+	"voFkFM3Ku+BVQnPQYT56sdRrYrnBdZEGB8uOrIr4NkxGU+vHXNTFkJrXHS7LhWMeunN0OiyJjMwwkYfI",
+	"OcfiTOdBpLz4WUCqdXF+kzVad0WBDBjeTZY7zlAs38q7EHkRAr3n60KFCV2fhBN4gTlEchMP6GORNBWQ",
+	"YT2H0uFLeRQemBsAU9v2XpTdxXXl5JLcQVG62R8KRcK5oSPBKCK+qLJ1eCd716XDXn2ep6nL7vxgDywy",
+	"ZAVzNQvUJzpDk0uQa7ei+nhrjNEsXzn2DoHb5tlZTIeubnGs2ZCA+LcRaKAIHSVoxfY0avsxvgra68B/",
+	"Xu1TyvmFKdduPLogF5c9qOh18Kkq2qw5z2OvMaGGt5rV+Z5qcIMBTMiovaASDoehlsapwziEWMXaf6ic",
+	"rTSEUHCS9iBr8BY2h0hdVB1rHpmPRZaX7RO/Nnup+hz7veORjrNW8/T/ZleaEjQSj+JWgX2cZFT2YZft",
+	"pZYizs+b+FkiAnifH32Gds0nSFYbnO4cqttKhUR1Zw6DHRCNSO4AvccaWS+SZ2TIUftDe2fExq0qVYNu",
+	"YdTkQX+fwqac/uKGbxYI6qxNN6Cu/HVkGiKNy79lze4MSFzqsYaYpGnEWxe9jXbTLrt2tDmLxYbO2oxj",
+	"sFki/X22RdJoE8tEGfCoXZdRw4jgb3NQ4QKxICZWdW3UQNkjhXNh6PfCzSJyVJ/TP7LCp3UaFj01c3IQ",
+	"qZxkD44llmciJVaRN9+eA+wS9I8927lltMzZwM85pk8oWRZhduhVBXOcyX1F3ltiZmIOzbnZYRgq4A5j",
+	"V6UHyFsgUVGYsQ0Z0oCFtVEeIN0D678LXJQVGp5kEpBX3sQGX5gXsqv4CxksMa9CET+GJXILG0dt38tQ",
+	"JxUiihoY5DUkjc3zYnT0qs5RJsEoXh5pfJ4Qo7kKWsTZ91OP6R7vo58RRinCZx0hyBulAr1fKeV3XkQ2",
+	"GRsVlEqA3xlCDtI2dcL/ef9/PsaUCVn666P00/9x+eNvn7z94OHgx4/e/vWv/5//08dv//rB//zvwYAW",
+	"AHXOscB2tKnHHAWOUcZgshE8xTFD9nuHzWmvavUHoKlVh7Fjht9DIOMTYuTs0qdxWYyaDKhwlkphuOd3",
+	"VRt0vbuuN6nw/0BUs1wMOO93L7/g6AADCYOFT2IKUz2Qjwc+rL3rbelfPB6T7zFiwyuHXM3hP9YnnQjW",
+	"Ox4Dchaq0Dvpo3TO/Wf2KFkr0FCKJkRBfTm2uj27VgJjBuWr6nagkVS36hwK8hLHma0ew6xPBbKqnnzv",
+	"57FnCZCwQIz/aoZBADiLzR9ztYSdOmnZPX5RJjYrTpLhqM6j3aKvq2HT7hA/pU+4QW8gm4hs/Lj0hw9h",
+	"zMPCK3yxOTsW6B3oHFjwBzo3FoAq8+IcGvguqDeiDf3jj5JXf7v604cf/fTRn/5Mz0T4QJHtE2SlTfK+",
+	"loWa9q5QHwSNMxRSHR79z5/oHBr+uMHbjhy291ngyuPcHGLbo2YJthtirWfMwVUbAGfZ8xQqOYz2hNMP",
+	"IWhP1fXXsIir9fWZnJfmGjLphYplWxhg3a1mveUcZ7+cnhAxkDdoptovz0KOMZJZ21nWiezFWk0ep2M3",
+	"2E5z525yfVd359D7zPP3gMShXVutqiIFuaXJq4Bl9YW0SKSFjrw49H9naFnjgblJHOrKdcSAiglXZt98",
+	"PPTr29LiZvTu4/UGVifzztkXH/nWeooxejAIyCrLbuvZdTd1tccMRNSRaPQLpT5v2nx/HqOlkqEibycb",
+	"BYKobkJqMz2aZpRXgp5E6EhR1kZHz5q1Ac5CQkI0+T/MClg1ALJBAafq6BE+EraKXqKwsEhIs7jWeqk5",
+	"AQvvU/wtRpQCZ/8g0ZShtasfSty/tsIEbTlmHTRql/aSKlV7U9VvDI3P4HB2czx02BXMobkv3C2U0KmN",
+	"umEbFh0y3j52w/pStWQhep3vFQgl+8PzzeY8MXIVDRRAOszU4EwJt3CcRmagSEadg4j+sTMul3EABCOv",
+	"7soVKey/752oSa+B6ZwXn6O8o+dfijF08FTvNQFwEB1f0Wf7gPlFVb+2R+VLaHc4uxLVn3PucjLtI8KP",
+	"l2vsq4MH4XvhO66jU8bhIrTGP2RBT/TlIGsg6BsLHlk7zxIGxAxqjNce57B/ogMxZ4fBRZH94406tLhZ",
+	"j90PMzyIwxBx/1imGPzmPfHbhFVzbjJ3QyblCB/dMb9dgXeudGvRQzEf/h5h0P0ha+wFjTSUb3et8ypy",
+	"eg6WUcSEZomnHSB7TIF9hi9wX1Xb7dnimXJ+6EmrrgVRId3khYrRZ6GMPzqnJYU7Hj0cZBD6s0UPtC01",
+	"HnfpVNeqiJww/OQmyMERYe8eJ49g78p8tUg+TDYZ7PIi+Yj9SxfJxyAY18jpFsknJDWS3+GfWIyMmI27",
+	"ZXPXaKIOuHmY72KcLhjv5Ka7VKRUoN7inRY09Mw/LDzgKz3R5IFhrHmgz1b6upJ8Vc0iJE9m5prBTWjJ",
+	"1wp2anUOloo5FYtsqYo0HnO2H2S3bFSNOQFVhpkACRYQMzGVKPCmR3RvYX4NzGwZkWsZ/oi4bDPlSrvT",
+	"t5AR9dSZY2oPewixsM7dSWnvLqMfGfQN/OMVuVqewZBmB/NjHlzdLFvio3jGx5WdPMMmtkhi8NeOduBY",
+	"7egBLtdpaldZh/wQs95VIZ5iO6bZihGeEvOc9LDlVjwdJ50uQLNboyO+gsOxlAyUDpox362XgogNfEFi",
+	"dOACjKwwT886HY9ms6AZ/yFSf9sRPBHgBLCZRXs33xfYN9eTcL5Rdyll5G6S9//+HaY0eefw0s0/gVhq",
+	"E0Kv8WMQX8ch1POmHyO4/uQu2aHgZjRpuEm1m3cMhUfhJLp/fYgGu3h/tOhMX78rxZt0YvciIAPq70zv",
+	"94W2O0TqTMgjBdoRcMPKrKy0+h6N3Zliy2Qgdl9ScAUOJ4wG7ER0na/gGz/55+WaHh8ba4hm9QeniAMc",
+	"NaXiyN9pK+pwbJslTptUTXry0BrIHTY61zfwVc8F22bHNnZbOMNdo6ZGjmHJGV+Q1VgXbNRirCcMudMO",
+	"F0f5fvCev4vHN2kgLCLGAHllsrlb7Lo51iOAoCOY6UmEQyn8XMpxAmJAAzwckFu0aVeafjE0veLWV+23",
+	"tu2QuLLW3tvrSnECQWkvkN8YBbpcU/42gUP7N+soniDMeBhTisZJR021aEjEVu4RmDyk3WFbg+qXrlWR",
+	"BTy9vuXPCX8eG4B23JrsMUk2p0kPb7qlZKOIx4eu0oifyTeV+HGs8Aii4G4JRHpPjAz/wRFCzEno6D0z",
+	"FM0V3CI9Hi2btzrmNQdNKIMG0wOBLBx9DsARPJihT0cFdU6tKtGf4n/D0DyBZ5E/bpI7mCKyBDv+UQuI",
+	"vIRLJSLPlu+x9x4HDrLNKBub4COxIxt5ln8Bl3O+yg+k6/xd3X1+e5jnqaFLPYzoxwd37EiyE7cJCh4c",
+	"SoG2FmActWqbuV613kJecd/BDvkQzUpnMQngQkd5lqAcUmYGVBolZsSorX08n90I158gnKGa3aQARrd8",
+	"Dhnk/J3gLOb9MUEtP0fe6tsIMQAx51tURjn5uWu2n0sFr2gA56limN36dt7GfxsHxpgn+PVhQMPBDT/N",
+	"XDHLUDPc+oGdJkAKuqbOAPxmAP6rbr/PzpKBRSf1OGldAkboGVk8FckdfI7L+GIye1KYvjr4PFolw3+z",
+	"bhhi9hQffbCemu4GhL7qJiCE2Ko5fKmzPdfxf5T38maVlWXQ42Z86t7xkTwjHr6tz6dAeTxj1YgSNdHy",
+	"0iF18ulS54kzhFuy2gcj3+l2UlSOC4XsdZ4V4iivk9bMo2EY4kkFmF/F0jBWXbutJkHQIj6BcbbZe5tr",
+	"sOFANTuxlg8o5yoqOdVWW8mmUcy9w53PYcMNjIqzozcqLlIXQUEw3CbqFv6FqZkI6Ds5JN1S6oUNTLwg",
+	"c6XuAEGvzJEZJTrHd5w58UoLVchAW9g4fK97BjEPHWIDw7RuM/wPBsgIQjCvaMahwl3PpVSdLstlKr65",
+	"QNqEWqZgEqlI/cR0F8n/rjp6yxKma3R5el1hxZhmQNODmVMSBlsMqYJiEwx2Hj7sL/zhQ9lzGGijbnRF",
+	"S2zYR8fDh3wIqqb1eN8ZuBgyyWeBy6hfsKEv402HVsrIxzP0Z0+NjyueKSqIZJZf59eA8Hvzgb4fZpHd",
+	"TSeO4bkTbi2mpmy1UpRroZ/lkJxFaPfzfSRRWXs7B/PuoPOCWmU5MsEsLuzMYdGvF2y3YWESdc1FS94a",
+	"0j3zpv1e2Lsn0iyyeOF1ha/+T7IDVtLCfGAzll7B1YepnWqV7X0U2AifvMzquwfB+k2BR0SenrzP2QkB",
+	"H/LQ7Lit4IqtiuSAX6hwg/4F8ytaJ0V1q1Yd+zPg701gcefXS73hI1cAt9ErDIB1Fg8oGSryRCtfT8wV",
+	"2F/mlHuQhuWIvKUuihp2nOo/juvkm1fiEX6Ou0WGjGXN5Jny+yDOA3kSdRagubizMOrzva+ofB7VZDEv",
+	"lSK52kBbRigZisUUfUY/PG2CjiYBZgO1W6/oXacTMabTGXvoYinqDGfWfExGksgW9GpNBF6ptOGcPSzQ",
+	"vEumOUCHyUBn/bhg2pdi1Xki5aHPm7YozdexI+QnJxJ3LPZ31AWtTYKZkUrWPaXClVF62kKsPLcvKsWn",
+	"mp+BiFZtJpx9YF2M+KvObAVw0tgcT6GNFPF4qbBs70v1izqjbKkHi7ld6e8ncsEezJNM0AFotm+Vw/M8",
+	"rYrHsj5ANcFC50itTMKxV1wA9SwsEEOds61K2e8t8jYPrcQxzpIg97OiLUXhNU4QtSzBVmulE24yNgY5",
+	"KPZOceg6X6vpsHAz9OfQ77npRqXg1SolySplF8SZY6EmtFJc3Xt+TEy+36t1Dr0pOYSt84dv0gbGi+SV",
+	"l9Gr3UHnrVwFPI5Nz4H1trtyMETYwxSHTdf5ZjMfYewJj104iisl9SpkSZHStrqiOV5h5C851M3IOIfm",
+	"VQH4COOYg/1+YEMwUmzxIOoygbtybV0mGLt+WfY5pd7cB0MHP3bimREfhDrklUN8uftqTzU9mZG+c65L",
+	"T62ju+vfMgMQ0aVihT5zmw5NQra+AJ9sJWrZSeUOnBGo8GGOJnE0yE8fk8w5JZrWwvYCc2WOwjpWbhoB",
+	"BmXUHEq1QcNS1WNtgfEj2nBvR9wcDQaIWYGNRuTKgnAgQZXZodlV7buMK+bwB7HRNQLA7xxaHJkTMYCU",
+	"9PsEWtihg8kwBhM7uc7tx1i6c9viHu7V/g6ul2mT/xosZ/4rgcBx+V6iSy6jarPiHv1SxlFMZ4tzmpqO",
+	"vDT4NiTQx7zeHC6k40TV7QFfBcSHAFO9NLrgj4uQEwCjqq51E3IsM8P2H2wKSs8CYoQpG09eQgvKKnRK",
+	"zJQlqhcEDpPWpHirCae3m1Fs29XO1Ci3bk4sWn9bUVUhSvFGGHCwxOejQ4fDMzxY8UAo2wIQpNe4rrUN",
+	"fwXQvjapywyf4VCaRdik8NNYEqoTEq09p69fS/KfSLDdaJa2WN+weeCnSNohd55ZSYHuiV/abUco/Azd",
+	"us6WKGK++0MAhDkZDPQ0s17f1mLmFoFbVOwlThcWzhYaVyYtQM9W3pem+0GwzRdVfa4oax5wNkJnBDVP",
+	"YlemPDX0GstbD6OVpX5AANna7pOjJ31TrXLScp+teR9MgLPNw+ws6IUpkHoGptUftxcw5RRj5YAAVRzQ",
+	"VgNiZ8mO023drdofyowcknueXX3zgJjy4i7qT3STsE98wBgoQwEAROPGTTloEQimjcAMC+Kp3nTbLd/T",
+	"vfwRP5TSCjanK3M+T+RmlDKj0aklLrjlPrtLNlTyvEp+VTXIAF3POLPvGszwhA7vHL1FaSqqDSyEao7D",
+	"169zzG+Cw52SjGLxQJIPp+G8Sl/yV8qaK8vfSQbdYObid5tVUMMe0qIEclCk2B0E/oFv/jbgJ5Z1+fcP",
+	"9viXyU0yPIt8OnpU423EPbKYnIfLJAEm02ONJ6tnw1RkvKhgBBoqWnBO6LxsupK3Umv1XNtTGzLx3ZbO",
+	"+hIT6GK3x8kP5cOk2WU6n5n8Cf9Ei3rZ7XGD7HdU5/nrjwFKzte3QyCflWt1G3pkz52M5e9hBNcdZXWP",
+	"ZJ6tNsHcTxzq7w67V2j1aXb54Y/IP5ovwxxOJwQXR6vb8lnJGajx/FA8252EybAe9m7hbmul1urQBgB/",
+	"6Uu41MruplK9GGNSkdAgfqEu+o5Oa7R6SRYquFU22toEa55VaUCfAyY0TRUO1t2FzNTRhvRDIo/N5CmX",
+	"f3N2O4sMHIKrP6cJXtN/A+Le+/Lz18mlMMzmPQT1H1xC48xVwaerooRKdwQTfB31gjhWceSkNz7JkOzZ",
+	"YDNK8kUWiUEaADzPvFcI7tWLZ6/DtT2uKOPBOoEWXKljkTSr6sAM2BprVbmmANBmKIxi/4gJO7Pl0Wjs",
+	"oARB00U2R0+rITEjwQ8ZnuqM7ODw22Ny9VmIf+qi58iHqY5AkStDe+gwkrEtpGUaaId7uDBIfslvaSFu",
+	"xOVR6NbTokcP/XzpSaU+WvsQ4/8iGBtDlRSjHJKjqdzspJdAcWWbA2cWLe4HUFKeqg0Igfj98Q8l2kIv",
+	"l3BWV80lCA/1Z5yl+WJbJY91DUv0SfuhHOAyWvDWTQV/6JZwEtG3/ph63j/88D1aH3/44cdBpP3QsCJT",
+	"hSt20wSpVJ9IpTBbKlW+hxM3B7XCEHPefe49Oqtf2WJeFfHDoUnhnskKNjaGlw8cDJfvcTLqROZrDLOt",
+	"tbKRN6a+L+7vN5VIfnV2ox85YWub5Od9dvgeAPkxSX/oHj36WCVwZXyFY5LZ4mc5WHjpANCnFKWyg4Ve",
+	"OGnhbHBTt3D1xgoTwvJblR1o9znOhbNSFQl184pn6WS5XLfQLGBYYLm/AQzH0TXLaHGvuNdIRXjcQfxE",
+	"W0htUJ+wYdyn7pdT//3k7ZqoIT9SfxpW1SCJ653RlXqzLWpROrYezftk5IZjgUvGW1dhIe6L5NmGaxMt",
+	"vO7aA0PXArYVqVGdkbopcChhMEkh1R3WmSlfd+eJcYBhWF+rUxFSCeLXFXc/oQAFK1LrFGkmdlCJUh31",
+	"EYnVPbYyRn/zJUcIWe4Oh2RbVEs53YYsHhu60H3iB5l12jMc4hBRGDSM0DtgIIAIJv4ICk5YKI53L9IP",
+	"LQ/NCFKfIFBtQfN+XXXGWkdEcHRXwx5b9J1KT4AwcQNKElVTraSEGfnIu1ysw+TmsYq1vTjnGZWqvWgJ",
+	"pxRt/N4L3nTouu9faIP7JlJeHRunuOYgpSj8gqRC1opeEhc9EwfXiJ/R87IwNROXBelBJtuNhBPUXgRK",
+	"uR0DLUzAoGZbgUOD4WPElWx2VK13pUC6ojLJ+izPkgF+V8daYMBp2HD0zMk/krXGhmQeZDXP7Z/TgfmI",
+	"zEX5Fv+3l/8X8H/XdkR/7fl/9O3HoOGEnmxD21GVJACtYalbU5PaKYIroL3XOBuEcDzfbCgUNw2lMnHe",
+	"OZxrRuZQKB8/TBJ+m0xmjxAiYwdsChqjgRNgdS9cIj0GyFLlXC1Zj03hZs7fKpzQnJN7ochDJV/TPOJi",
+	"ttIcIJP8N46TrJeFSVeOXSTI5q6zgpw+K3kc0YM43M0RW9/3JE4dtvhBTJwdeRrmi+WoNfFVdMpqXJlJ",
+	"Ax0W6EYgXla3Kdd0CEq8y9sl0nsw3xl5soQOJlA/YBr+C4NTADNdLZxfawKWOBwaDMeEd5s3RK/UL3ab",
+	"MzBj045LUyEqbIhkxF5vyCUmTsyZuonn0wyRy/u09/cAoG/PEtnSKL+TSqovngwvc3urOb53OpVk6PjH",
+	"jlBwlyL4GzFN6NqsVH4kaqfwWjnxFhhabcmJpCVbAXYtvzgy5vs5kCdzRqLSD3R2btbkV9KD41F7Bgz8",
+	"ksrox6pN3Dn0NHglE+qrQIOPeX+EbKSQbdDtalulbBfkgThZiof9WaAyxQ7JT4M9soEvxlPrhFr1Amac",
+	"/QlxLeTzw2f0gLXOVPTypOD0TcgpCJVTRSLDK93NsT4RnYCu+IET5+2Hdzj+uH/EA5L1Oouurj3UG1zf",
+	"y6pqQ36N7jLf+QooQRjFpaT0RhxcAjb6oiGryBdO2vaesOubU+EHGjBepg8xlq7zogvTq8z796c4rU1p",
+	"0nRLujCBFsn93/glhbKCRKfm1FujC/6KF/xVdrb1zjsN2BQnxme23hz/IueibxUfYQcBAgwRx3DXoigd",
+	"Y5CqJjQEzQU65pQlMbTtHWxzXcpOF0qtMzSMuQ9QC6rVaOMyHS9aEjgDKaH65Vdx0yhFW5JLAbdQzpGo",
+	"+f41v9cTYEO5Zq7hbMq7xQ2a6FcV1QvB0BsbfpWXpzkqczVJdCNM66C5/dUOrQduMGwzAINpj9/2JIiX",
+	"6+riP6gauy6UnHOaTJPWVqMQriWMMCus5QJnjYyLTcUdGv2jJdUxDFym5MqFC6FK7+ThrfyTua7geDsp",
+	"BVmOd7HRpHuJvI0lfHKX1Nhavj2D1+n70aR65TPSTs3ZDF1x/SQq4cjFiMeUE9o4BU9eTr9/j0Zq4vgu",
+	"dwnYKmNJOINY+z2PFvHNMx4ryo6ZDerz2mPmfjHpaFG6hl7U0l/jzDPB6UFxHfemxbOvILnyi82iGRSb",
+	"N06h3xI9XySUK5PUxu0O2DA2tMyjsBWLGfgMyzM1zQlZ2zTOznSC41i7bz45q2sHroEBNwwyJ8MbzMEb",
+	"EH6PhAbYGREk6HUq5FnCfiqGHPQ7W9fuqjr/1ZQdc515PckicN3Hn/VeHzGFJ2fwFWeiGMlSzynOMCdW",
+	"aUP7ba4J3bdWbVeXTADkynxDooxrSDdDuISzKnp16u9ZSd4P3xS0t1X1JlGbDb7EBqlwbrCf2WinTtdw",
+	"tx1TqeO3PyqxDdSvtR57MmBKVwuLHRUeKbgW541wdBU5uX6i/Itba40BgxVFtKbscMjXtz33Bx41+kiW",
+	"HfXGGbGOkD4gg01ggKpeh/eTjLGDmtWLJNu0ZL+XEPtWyE1fv73wRoVVLAL4+YeTOR4nwlMhjS+CKbzn",
+	"eZnBUO/e7EGG6kjEO35ygFskXVkgh8rb/pL/QJWUcDtBKZ9fB2XLqzK5evkk/egvnIAkUcI5MTrTIxy4",
+	"IItiYbK1aEfaaptAt/rOpm8xyUvc/MsDZd7zjhzSHTlKxEq50jdTQQ/B1iFbeS1BWzraTT8pnuKBQBj7",
+	"AicLVnWttinxgjCQuet3brFkIBbqscgMs5R5p4ZGDPtmagREiqSZJ1cfmywnklP/AZCV3+rAD72Q6YB8",
+	"2UEXUQvjg2mgmkO0vAUBBsfOOMziXCI+1cWVxlvoHJlBOsbmTZKHkR0Pk7j67Jl55jYzXRzJizS1eDxJ",
+	"w8z0ToXXkRHhZ7z19MSPtWglXsfmuJBTFPq2Ya8FvfFxuk9u5xeF57SK+jNKK92hkBHlVwYLBtaJRS+S",
+	"Z0zOOstwxYaJnOzvMPYyb00iqHyfFQkjo7kYZjxk93tG0QTlOD6AobIjGPXND8Is6ziP3cTIfN53MUtk",
+	"6Ll8u7ZVd6q84TROoeNuyhJNBs6qrPi7uvsO29JyHhh/8VPdCENCiIw4G9cRWYQyEjgo8M2P5D53Dxll",
+	"1IRobJw9EHIk1eAJnCXy6GFZEUjQ81arFj7ZDAWhe+zxYhyvRjgJQ3gRFbcn9vf5oX1WhpNByyTafWHy",
+	"3Izvle+JGbX6svNs33n5BOfg+KN4b/gJBL0wgn+Q0VAgJvtVem7xR/KcDHOfYiIv8TaOKS3QSJQWaq6d",
+	"k9+xjBpWhV9/fvXVCwEfH5ThUNQ22UV0VdTu8C+zKnwmryZMHHQZ6pd4fpZ2Np+9jSXwSne52WEes97L",
+	"Nl7DQlzM2az3uSe8k8fyJhwPPmm2EEd5XuKIw7w6GH9568vJ7vK+i3x2neWFdqLU0EZit2lx88558Fp0",
+	"B7i3q73DFdKz3reD0x0+HZa6JnjS1H3sR6JRToxAJJ0kUdJkM5b6c1oganehALhg5h9YS8Q57DUr4tpT",
+	"UQSD00sAh4wpAXVvnlTgSn9TBpH70XVIFPDYwFAcIc3GN4Vp9F30SLuZlOFG0C/s6n6RO5GNGE3DOutI",
+	"PAcCjhkP0Q9TvppoEv9Wfq8RLF8SLi7R9EX4CJyOWIjcF8CgXVFUDkMwGkULWH1ZoSfOnsDSUcRh1hJR",
+	"W0Vdzfp24YuEyDD5efszXlAPH7pk9/DhIvm5kA8OgPT7Un6n44u1IgKCXfAlAmmPvETwZH9g8nJEN+Ld",
+	"mg9LdTNfoCfcUWKqOB0aEuW4Eo3vG0HfTZ0LQtfyC/OZIEaHB8bddca3C8ycI/QqlvXLhC1KSfgmEa7v",
+	"+PDS4yDSFvEPzA6zVOJ4HbDbdHtyVk4bACAcxlEuGxQ5Sg7PI/sFNY64S+GIXR6J9iy73Bmr0+HSE88u",
+	"PSCdOYLI1L6TMdwtKznfXZn/F+x7vsbaMfCpNkUtHAZObqYS0DO0UoTtkzIwu6Ta4e/zpjHi66ltf2MP",
+	"GtqrVdWjaqZ1wbW+q7+TfhkJCfYd0k3+I4mXUK3jjn8f/5S8STd19Wso3t+VNzQ+ckps8asKWhymPb/t",
+	"bKObEyzg9dRzmfZQ4LwDHxnw7c442OGRYG05vN7u7HxL69wdONqx+hg/6pHthWWQd22gmtNJu228+/V6",
+	"Zm13zKDhRiH4QeoRlkQ774Rl0mu6jlBCsz024rTgXjKjMMG4bgCXPL4lGIF5kGqtyG6WUsV1aFdAmK4s",
+	"W/BiqdApRDpr3Dcm9TXPnjixxKatuN4BDLbQ2oDBnGoj4GlnWwesMYCo1jUDiDG/aKrAMF15k5UmRkCO",
+	"kvTGdDz6weGmqqm+fKMitlQy6YeNBevVMMRnnW9zThXboTcdmYE5kI3fBjg1BlHROm8wib/JCC+ogQ15",
+	"tHAYsuzGOr/Om3xZKGrxIbfA1w1am8/DObkdZqvZNdT8oxnNd4BSOHTQhRELaDV2HH6x0cGLS9XeYMzX",
+	"I2r34afJ++Rt0uTX6gPEoghPDx5/+CkF3fAfj0K381ptsq5ox7jJmtiJvjXCdEx6OI+BjFtGDautm1qp",
+	"X1WccY2cJu465yxRS+F102dpn5XZVoUzBewnYOK+tJvkh9/DS0mNsNpFXd3F3v3grGXInyLpBZH9MRgY",
+	"Tgzr2EtwX1PtqSKwMFJ92PRwF3Q2+G4ycOmPFCN70CGCPbvxO9Z/go+ruGqKZP7GvLBqtC7Q64+S1ObW",
+	"mVcYIj4XousVxakXd477FeGGnmtzjsvmdw0q7Fa2ZEvs2k36F9Sn8d0W2N9FDNx0Cbf8AOTPvMdO52l4",
+	"FuDvHO+YGK2+DqO+jpC9liGkLyZcLNM9cpT1B644a05lNJg3HLYZix0dH3quUIajpFFy6zxyyxxOfS/C",
+	"K0cGvCcpmvUcRY9Hr+ydU2ZXh8kj63CHvn35lUgZ+4pcEZwnsaXOYeTJK7WCodU15W4JbxKOec+9qItZ",
+	"u3Af6P9YLzEtcjpimT7LIUXgsypgOoAfmQ61W6Wk9ZvrcoNGFviAZLCUoRY9J5N3z0fPkwUjHCgXdufB",
+	"uDj8ovFAf/QR8c/gVGhjueNeN/RswqsLKTRIMmvz3Y2xTj5jb885hNM7hZp4/kn9Lj/r8mL9nU3t7a9w",
+	"Cffbahd0oF5ix58kLMSto8p3YIjE8PmgVEVwOJY3f9JyaUBy/qWaOw9ICTPb9rAky+0tzgLug6mB0hMi",
+	"evO2wAlcrPpZk03SLhAegDiwnSlW5RxXh/HbvXqizZl/U1kRykGLjGBH33R1PungFtcIuU4DfCHV10xo",
+	"swPsVdZ0nKqpwYSOmEpIUj/meyVhlL3M2zr9pC1wrPy7zS4x7v5o1vJYcvb30kgudEbtBdxm7WrH+jce",
+	"47wJ5xPHkLNortZ9ttphUhtMXElXs7S2+WqwnmXmhooZCC1eCBLMPdEdFvjoU1CZy426SQkFCb2v3aQI",
+	"YtocspU6JgdmPBuQTwcebBdOyqHqDd2wVJgTGWdXcqe7QOqhSIpSBuDHILFKvgJT+eAJvR4Go3dsbgPd",
+	"WBx72R6hH+HZ+WhQVPQiVoRlhqe8nVDsRPqA2iwLs52bzVgk4+Zlv/yBl+htWxkFwtKLrR4AlPF1doup",
+	"XNid4knVhFUcLMJzyjqxn7vIcBkY6wlN84Q2+ml9V3fTmWjJFGDS0a6pk008m3xJSVcRMLeoOdundDFB",
+	"v1hHdygqTCqL46BbU8Kzch8Ol4JTuuy2WzLP+Lw1+AA+v3iJTiobSdo5f5zxLIJSbwk5K6x5fwhFhmOL",
+	"17oBFVdwHZbIcONi5yJ5yjazRltkpAAXFcmsMUGwmU60Nrqp8B9tm5HXTVt5Al/8ItbkFa8dousJ67vS",
+	"muqdjDT6fmSiR7j5FRhNUms8bRVaDG9yrLG2g591PoD+UTa3oJRe8JcHdFQypRxT2lmKThyPdg2cDgUc",
+	"gayH+CNNEZwxaD5N8nl+xdmIAkTZ3pb+YP1qcpK4X5faTL4WazIomVWZY3DOXVBxoATr87xDZBLLKab9",
+	"b/QRlxMaOFwBenUSRAkWZf1xRvgqksbJ/YqbytTBf7bIi+kJZYsptJizoaSA25MXOpwBZEhVs/syEpEX",
+	"aVoH3F9CbnA2EuhIMqLwjYhJi+JevhGDJ2VKfJNzNT9Bm6ij/EaByQ2R2kt0l99iVDevpxc9+z32uaAK",
+	"EADxjxdfVdt8BRtPY7APIqWIIofb4VBX2v1WLlBs+wTbSnVR87PnOMSTQl+ZNBipZHZ4KKDdllEEhxxc",
+	"tMeBg1wzvjvaCLmNBo7QfYqEhqkLgCrUge7hAWGoug4pxJ9zwgNKEY8tpEB7sDgPCMuB6wlFaKNGBS6I",
+	"VfBKoI2h8xrpB+1Rsp5fvc31ZwpI0fzoet+h+hWEESW0Rj1HfBuBzKWiXIRxmAZWncRMzvpQIHU7wsQT",
+	"TMin/ZhJCPLNfyhViRC1JhdJCZJksSzMOJBxp8ArG+1TPV9PMd2pHvexN1EsPfqyA2mwxRj9kLfrZ/Q1",
+	"oa/JuiPJAWuCd1plwxzSKyr35dc/C/j38kQoyXf7kbl0g3tOB9ogWmX3yyLgYPjUfIR59A5T+tXlHf3/",
+	"OA1SXHuPjobXPrjr44r+DaP7w/Gq+SrFpLzzMUF3yv3RYac+jdBt/7NSOgzrA/KOqx6NVod19ijE3z7H",
+	"i8MtrzPwmeOrxdTsISW/ou86C64JQu3ZrTIm2sGcsnmBLesBrxsGAYfLLxKV4NR6yvh+ZV07lodiFU20",
+	"l7WSsxlWOcqConlw2amUM94SFOE3o5gjKfuR4udB79NSyKyizrkGoTo+YAjQ33XEYXLIcnEKssxiiFnx",
+	"wY7HJo8dOrvB/UVIgrzoO8IXSn3egOIQFL1eezUpsVagLhMomVbd6gtcaTrXT4VI+xTHUvYSAgXyDiiV",
+	"wp/kz3sMEItYOcyRxOvNlHupZC0S8PULVK+Mnc2+1lv2DM9lb7UGqtDesG0cTmhVt3Mso5xDkEEWPzV2",
+	"FbPN+PVQ00+oRqr+Npvh90359zLuaqv+Gey6zlJGrbt/v44mc5EquPTdrbYrjksLKbKorvOq0w5n2l1c",
+	"G0X4V0lM7FXVjXCAYBTGH/1MOZojAYtiehbav3/HwQWctuKf4Il1sOn9ks0BfY8NtLaJGIEGTz0Rs44n",
+	"F86pEB0qRizakbYW8+Xq0dKguPOArJ7OEYgH+ACgn62PEhlDBa0f8Cg/RneAyrnHEpHSN87JLjVJyMjL",
+	"uKCkmJGTtMrrFdrZ8bkqVkceRvIre5lOVflYCsChOxX1XyQmxF+SsRtw8EKCvXhDB79XyITKPwTvIrwE",
+	"sFck1FKKzfSnACgtzJFQm6pNvWIoMxEQCKEQL1B3MP+Z1Fv6vHUPwZOKMXSNR/xBqEUv7iMKYnjeiiuD",
+	"nIAM6WlmHB//tNXMnKM8dQmlN3pPRJCvcEO+UaMTn7i2csbShHDSQl2rYnJtuvQOCYLOnPJkLyWjx2dC",
+	"Ep11+FyannMA9QR1VKJ2VgIaJ3DTZdfq5F6hGSl6r+4lETimoJApJor8dKxQxnGkRRag3fjW9mWyAWN2",
+	"WGGceU3yjf4BjxzI3hkKU3afGns009th+6ziojB44eXbXUsFoEFQXqv6xUSBa1vUmvjroWpMNl8QCHAw",
+	"SXu8o+Eu5gYiDhOlDcbSD+vXADq+SzgO9LVSx5Tr5uKq7D7270LX8YNh4jWlvvVYUWsgpYo8AV51y+YO",
+	"tOJ9UHfRH8X9pOA+2j8WbV1kboQF1nB6833gkU2V1GY8HhJl/NzKQ3bepSqqG25CZjE6ThT1grDcL2Wl",
+	"meUxpyvfk68S+Sihf5J+fEZ3rduyuStX01HaerGLuIfh1+hUvHrqQjZE/J4auckXvRrTQ4+1kdE4A53N",
+	"hCer5ymC1rFZWyYgkh8tGhfwVVaTuo6BxCvc7qUJ6BKqgSUxMpqn8pMhxuY++8qQYWYLAoA+wZiHIrM2",
+	"p2yrH9TCb5qqztWkmYdbudhgVDQWExQZh7WvVm1C9aDghyIDqo7coU38OL72Doa31sfaToMuzwWcGG1g",
+	"IJ+JnyQVpLbd6CwrR6RBdFMg6ikFf4tkm3VbtUh2sE56cFggeuUrboLDGsZPjzvvon+WzKa4SJIRQ+fM",
+	"qy/095BI4pmth1Uxumbq2EVj1q9MGCinv8CUMihR1uS25ScRnJ3JifJJg8g6XiXnH+hIYPnGQrsaSM5J",
+	"WzQnNzkcutOSuFuAxorYjMLjOMXeG5xYHiPA/3tN4lEDF/OKZTA5pUAqYYCkn1QngY/5RknkC2BAUwZh",
+	"QYc19upRhLkETefUfDpxLk2SaAmydaBGpsTM9CfOhV1jyk4JwnAxhfr+eX4p3eJpEihmPlaKJzZcgEvQ",
+	"B6d6aIhZ9NIkg3zk66wLc0vCB6qsQ+b/vDbRKPJYwMobTwnX3jr64BHzUSExSUvldL5kNBSQ94f2eG++",
+	"eYPN9r+Leeg4ua9kFioTS1jiWqeanaIPIEdsVfxZOdYjhi9vjJxBtVPhypD82Km0w/2qPYf1plutgHIA",
+	"fZqCTS8ag8Y2I/itt1Xr0AA132ToqiatQ8iTFu5ThV4sX3I89wM5IhxgRV3CtXA1QMHkFz0GGFxzWCi4",
+	"DXJWx2RsR4MxAAmePVkjRUskUxP2a3ZFs3j2D/Crbr/P6ruJlcP81Cx2jjFzGIUlGBfUf6abn+xrwbPz",
+	"pl/7hd416TmTDN8LG3EpJ8ih1hPufnm5NLfdaEEhqQIlAIJwuhadzqsm5DyG6ktwS17wpEW4xYD61sYZ",
+	"VXruLx3QBRi/3J2aZDrDBq+TNWe2jOA6z1cLa+RKnoDGrZUjr85NvAjSqSW6jiaJ86HGEve4EitngYlK",
+	"ubc4BqrtspzSapAl2rvOw+opX9UpqjtFSqz8bkZtI2puLwmSo32jK73dPtKCg1x5J9ddGgPJIYz+5tjC",
+	"OuchlKjY1md3QW4j0p37g7Pn4a3Q6w/eJkrVT6qyVKuYSWZlviKHyihobzyOcDSl2bMX/axmtdojWpXd",
+	"dztlOBNRdsiWeZG3UVOFcRvbKKragNW+QFLppaOklYiPJqWrgv0CfvtGUdxWVpaAy5UynOQ/yT+GNhWx",
+	"ln6hxxYbciLBK+RoI58oTQ1RAKcmIhvEXyWs4DifRIuUFGDORmxeXd2LR9Qd6W5sFPywjphh1h37DqOy",
+	"XQBNpas5WeUodsKilNItZYk41XFRDkymQyOKhQhLyWMuJ4yrvKMeYYB0ZFhkqXlGZlgmqIVRQbp2W5G9",
+	"dpyQSOiBLU7j9jUEnkiAW4pRTa/U0kiIjCKpdwgr6DGbRZ6LMqx3ucWDga8918pgkvrQJpZZWclGzly1",
+	"/9zARd1mba5uDULjHcajaGicp0Tz+oZIuTgxBIzyAVdNkx9soJaO+Iqd3rDgToU82vou3XYx8ce0Sb78",
+	"FsT4+2yoI/TPPC2OlnASLqmO3qyp6L46YY7oHRViQuFrpcQr09GW4q6/TznsXFRbvGCor+cgj7E+fUdC",
+	"FCq5yGBxZ8MWF1awk990oXWepcjfKFtZU4JE6RVcWkxkDY+/Cw5qrum6PH2gN2bm3CbLG9ZSGG48p0TE",
+	"+hj4iDsv46dJ7vJew+/8xH9vKPMewrVRdc3KB90VWHsjxXvepsSOwTGGCk41dBISIpmWqPYCAicvyCGD",
+	"FT/9e/ofI7W3QBQ5MoSuJpEmKsjJnGPIfsLfdfEAXSpsMrjD0Gs66YGg0ySivN5Dokv1yKnVyEV6rR1T",
+	"A8WenFJUfo0vXUNL69E1R3GzEH5SebKR6BM27J4Qg5IDU6pTHZDqr+8ZfvODJOF0r7uVpH53Dq2J05m9",
+	"uBE2FwzfWA1X2dNfnSzroP1c+nkPAoXo5HWdQdcapE8p87dqZlROE4J7exbw/siAFpgNVNo0Yl9+Bju9",
+	"smUEQiztTU61301pJXr3Xav3/HNLnknvU+idCXK/2d3xsDvAnQIN4oOLJMGQGEwuqePdcweCweTle+3Y",
+	"/Lc067qjqPRMYm0ufihDWLFYSJERxNKwrB2rNaZEuSdKQOcqKqxei/KdBeFxUqEQiDLBAth6mYqH2YIv",
+	"Cskjukg44grDWxfotQDnC3NCkFMvFhjeo3PDQusbcHWlOnlMAcAr/KFrFKZkwduvSovqZsFQbDosIoku",
+	"VDeqKEidZyGD7BWpU4+w5vwDkRwzJHrV97y99DDjdxZcAut7T8WDjE8EiI5cXNkNVWrE4YI34bj79zCq",
+	"vyeQOgeVoQjKoHUFKqd6kh3C5SSv8CLAFq41I1lxczKErYEthlyk11UoyNm1r8koCeaDRgWSA/e1+1B1",
+	"U3Jkf9icdqR6L1NZ1R5tZpoXN2V2aHZVzHc0wuxeG78jbzH8sIMndCEh4GG1OiLOOHUBfNgjpQXzmFyk",
+	"swpwBAnt4eNkdegWCYVbLkymKZN/UlwxLjEZwEb3sQ7ZO5UdkDvADQ3YA3oEhpWXmN+OzNcw3B6ur9tI",
+	"wdJfo7VKf1W9la4NzZGFtVW0WTc7+oWcyWL+MW0Wi2OihFvuPlFbZlKaJJyEXOpQrXYzlD4icocY9bty",
+	"znkwcNUarMjpI7PBVTRwVysK+JV3ibFtjqI2iwc0MulGWTtGsII72raWqZnpQA7pA4jJc/q2lZEgzLTI",
+	"93kbSxpG+bCN82ahyq3rHNmrPj/m8YCx5Pk6bNCPmxXo3jOpp+ju99ISEw6ETGKGwDEeZEjODIal3+dh",
+	"T91ytN/YeggvdumhtRVq07pVLAmHWA5WxJH5MrzQwee3lNI8nIEJ5IJYbWf4glGq5vGmv70OcN6Fe4qr",
+	"jwRKppEwYNdheJzmDFDhPTITMbJPIL0j5gjrbdEZ5ow916s/NsQMX45JbmxfPo3S3D8gc7gyTlYdUibq",
+	"kNBx1z/VFagQZBPvGdFMyimC7DH/T+RbhBoOk8i8ktO+qsW2LGsxz5QoAejEwaGdYXlextSj0chLtcs5",
+	"EySK6mkVrCndtz56zN5nvx6D9C4rw2bk8A5Oz5DK+zTpZH2i/fa3YuzSs7zk+JPjMrbMeRqmLeDtsByu",
+	"H4B8pPmZp4y5p4Up7rXR+B5LcAM5d8GOZjVskv0JJUR535GLSOtc8pAryhfrdbBJRqvCrihskunA5h4y",
+	"6d1IwEM8pagcYmv0r5aWiCu1qer+KURC14KhOS01v5voR6xpYlxJdZpxIqDXi285K+VEosteDktATk5a",
+	"BBtO0IBPqggn9sIYCjaa4u217gq1jlfdnWSq4ovAAW1mdCrkxcBgSZBNFnmFMNOkFj/j85ke3hylUutG",
+	"MgFoIMZmnHJt0OPinusekjcNjQ83FnVWSQKGttql6LXVixRzffDVLfnB0HaFl2o2083hJ/A0ftY+P3Gn",
+	"GTfFjPjkLjm2SLGiJNK4CSTyTK64CW/uGlOi4RmRHqhrIC/DnILzMWByh6ZikIyZhgKZTp00ruihuNkQ",
+	"iXNZGS8fsvgz9lbzXHiKrXHgftcRFgZ5xs6oHRRmJmOIpKoNZWXQCBuTMoaAomNHk7CnB3C7hX4d0USb",
+	"NxNngN7+mL9NzczOKqU3vkKpI2tHxj4q0Yfc93Ef54jjzQgfMhxvHh+K1CSW/I59BuXwkP6Bjh9Ed7P9",
+	"DfBRNuZm81Jh5aeX6pe4n43vPPoL+wSKTlFTdyIj7cmBmYXh5D97yoxOLlrvQpaHVBMQRLctNbcF7ypm",
+	"Kb6Rri9VzDMCy9oeD62sgYQ3zA/mQu8aaaODzV5eIOl8uSnyVTv9Fic5tAVKFwZdp4blHA2JIw2VC3Pf",
+	"8Hc9q+SzDg7bxF8PCeCIk/JOOc7JVZ1vc3SGcsaV57w3cB9Kwgbb3hCfl7WnbVSxMSswfkJSO8wfIfCC",
+	"H8CPHku37mEl7IyKbSKlnqn7UN+Noj0UTRuekB3pojWmrc+pnSUXKiRHvFEQAr4yjJBxMSe2q3Ks9KER",
+	"BRTZB4pB5Igr+HatQ6A28cBZaQkxLAXww0DE4smPBiOEdIIb+ISNL+wWwjOfpnDPOVTz1jbUHtb0qikb",
+	"bJApi5SpgxcI0tcLVROCylXM4qwKcdUk5RGt4fj8pt3NKaVYLaUUpVr4jX7NZE3J9ZDWSyXSrgGDNV/5",
+	"eaB4jtZTx4lWzOnuuPNcw10plBvXKS92ssK942qve4zHm/Udm0fugxNdkSdAju4B8tBpf3UHfmx4X0St",
+	"kL1lHlA9eS9WytXQRGjTnDo9Ff/dAzZ0Cl5xvt94BQmqNJ6Y4uCcBjpLJE9w0hRVqPDTSeXQcazIKXRm",
+	"I4haVc6pym3AkMGDGJAiCJN1FkyJBSmbQA4uuszC0GaAwdNsqbNP2qEwn4LSErg2P20MtN1Embf1GlBW",
+	"JWq9I69fYCQ16j+2R5h6GSgsWpZKAoZQZukNSrZoDKTa7dAQtp8E0459ZtmP02IhPNeqYqfbUCaxAilS",
+	"RD9xzbWPj1sqLezYnpiF2fkWbiEK0LvJtTwpYCgJjbzQOchNYRNJB7/PDvSER3+l8Bf7k5uASs3CYb68",
+	"1inWw8tDBxzORZuSz9h20sFKNu819uFyxzwODJIyglPOhxwgEvIkwMgmaKx3gxsPt4NolLL0DAyTUU9i",
+	"fEANUT6hOOs/6/sA8GbofPQ61p0A0KmZF07MB5JVPnAhi0hGdqMCsWpmTxvxquYt1/GI5rB481BpSps/",
+	"mnZQ92kc+ssoFBTUHesjLUSETmcG5llmEL31DPHX2SGWlVKlaMiJbgQtizzZsFlAMuddZvVDu7jphxlJ",
+	"FEe2YXpLtb9IzXapybsYFjzg0i/L6hbrAGB4/Rs4Fsk3noEIz5WlwQZEzZF9pbXi0a/ztZqLPxiPPOme",
+	"m35SGGDsTS3wHoBlRGRHjt5CuQwG6RIDG9qVxETVeup0M8cdHiwtj8ghwqpglfGVyzERUmMKTEr1MJRo",
+	"bqhMyy7f7nCvsDTEc6yTCdKIOngWvzVmwME7K7l68YyyyHKejBmCiIP1GXfqdHLJq+E+9bfJv17DT5xX",
+	"wKzaap+vwqzvX6uwSrQcypCdhBIu2RtPP3txRhWdRkEztyEzjDK7gYKCI0YqXutQn97FzjzMwmZKQZL9",
+	"je90p3otV2nxxKxI1RC8JCLCo4cJB5aFVtilvrbrxTwxu96MoXxuUeJBNraPrkQQImnuwSefZQ2SCV0x",
+	"1N/CWOqsEJ3ICZcAP5Id8J9szeiNa6PwIiJwgNOz5C7vGzMAIEi5PDXSAok2rvSvr7W22rKmRdTaB3Sm",
+	"jErFQ+4HG45wdqAwGukeQA3ubwPg+xwYsKBzXSz0ta6/f2CDwU4C/u04lXuXQKwqi73sUarEuiwSjRfj",
+	"7MGaKuMlTCjPr5FfJwuZGL+vmQK1A0C8tIkHw6wCJ8eCwd6laRZA8jMT27JwvOAlvbxrpJZEPHwjrzK+",
+	"PHbsuYp+piBO4wsbXmCoRrn5Cw9Zu9MOMtr3wI9Aw2gmCen9VdUVJeZeL5z8UhRTSGY2z1G/OnAyTN9S",
+	"S4/4nBMEQzylb2M6w12jDpRNMqR89MOGXetl33WN1546xTDmYDcY5cCIFT/giRCGcE6VMuVj0sw9SgjR",
+	"db7ufEt3c6wk7Ifo4FGeIdAYWH+cxymOZhLhxY2xiMniQ0TzwXNZhmsP8ZngQCMTOkmzrY16zERoT3Zz",
+	"yG7KeDhPyOlT2x/ma08OYj+H7iR3+MV17o8TjhtJqBLd1Bocw8PRC5BYBP8M3Ce6LEqsY7QKI0iM19Og",
+	"4v7aKOI9PZweYXUaRF8hX2jaEvPxHt/r8e01bNKwN8AROSN6CSOGJu+5T0kCc5pt2tgTVi+DtV4W9Qjz",
+	"w2m/XmfmMdeNyNSON9tpczthU2Mr723suTHgQjGGhQkw7omNaGS7j6DBhoWwGFzTrOOnbUKTdOAcLJGm",
+	"dncHdGpocxsoSozMF3V/h8OFkuJIxHl4u+6zIzLbBEIPh3FkWhSVvQj9uegkGyxn5Hqj7lgARAukCIv8",
+	"hT3bWBpkpxNq+waEOLF/cYtIMdb1jJQCEY8wN3h6zgOWrrpFpUF7YckxDwcblcwRK7qARHVobX6cXvqC",
+	"Y8Qz6EKRz57BdLqWL2NukkA4oHnGjZdRXiyhYMZrjH7cG9FBz/DM9rudej+uIs7dX6CnhwOA6MZMieGz",
+	"jRb0atVLp+KMccLFaq6V2c+n9yV77M+2h0j2ZsncPCyHnBAMyc/bn9GK/PChu9EPHy6Snwv54KDk4cMg",
+	"Q7T32Ox1jxWqMo9Behcm3JPC52GAm3gyWOeY4KPK3/hNZa5wqF26hObpWYa87v41hEG+X8YkIknq4WZR",
+	"Ya+uEVnoBJcQAWRMKIpCMiYOnQIKZ3ZZj6Z1iZxIugzSvJznZqKvDicyM2KU5XGrrj1mYCcyMDr0hORh",
+	"pDx/fxwkjR+pz6rbeZcOmjRPPSmzuSirczATVpYot71wBLfKVDQXGvbGr3Nq0gXDpMfOmhssjRONqhtV",
+	"PxwAe6Dj0loVKhrTSiCMnbI+DOO6RhQIyWY0z12dOXa0aoZHTuOSLtZYJBOiIaojZVzqYsBHl528taEj",
+	"6jZv2n8hCvzDKjoikPT5n6B2Y5zYxuraWqLD2rjPXceHkM8pcVon1gcNw9ojS/oGHmQ4pVveBAbIG2uR",
+	"bvF0OUGATjOsmyChRJSrClM0rjGTm9McaxMCsWJC25vsrjnd8w2hrXFPp5zfyBUHB9Um8pAbHOVfY0Aw",
+	"Zpde22OeWzM8rthLaOhtxY9FmC0+6GA13JWw5Sa7RQe8lGy/4xkP0P2OTcSYVhi9PdD75sh54hk19DRU",
+	"RVkCiGB1OOu8KWZ7udjtnnR04ZoP+aa1RuaT3x3DivCUUt7TOo1W3tc9/wlMSG90Ze8TTBVRec0OOs7N",
+	"ntM+PoEFgwR896Rq2lj6YHe/zWunrghJX+WaXclggbAp+TI6hW5E/iR0Jq09RZqsq1WHT4PZSPjSvRfC",
+	"6WPsUqZKEeq1yeRz0E7vN9+WsZwpcgvw831e8j2JnAp5qARMEpfXzJ0SckshX15JwOlnFQ3UJfceU6xp",
+	"4wWharQpJwA9KlR6LiORA0Ju6Q1rO65/yBFOmZ7ne8ghk713tAfUEU6K1PEr3Q+H4te9lF79mpEiiJZ2",
+	"xBeLH2wHCRf7z4WMXzeH1BHv2ewFg+kt+CE4CJ4EGvP9509rEpzhOLPx79lwwiAdqsO8rLyipIg3joDq",
+	"AxlNAmV8baKZzyX0oXHdw214tb0O3mvEontKKk++n/Rck5ZaOIfjLKJHhAE/Ik3YmjuKn5wOQXVcBtG7",
+	"u/DSHDs+dRj9m6+d2H/pFgp7Lbp9JLwb/T9S8v9IuFkPKiyZEPFyCbquO45/nJiMJIrGMa7bJYTjIvNS",
+	"TYJKhRz88XC2OfyecSHgy3QTOzrH6N6vS87CyCxbyIIcHjtPAWehlrxq1DqYGkMeLiJijH7WkNIl+kWr",
+	"HrxJMvTrE5453Pf3ACcxltsmaiRuHAG07/fOLlGkXEjcEULvGEWOhdZ9PAmBS5Yw7Yo/ZjTUbQREQaAb",
+	"acESCaaVZg0S/w2qVXMK2H1jdgB0Cg+IKvA+LsUiQqwFdBOMoz0HdrWBcPKdS1OtRx8D7Os1zTiXIyr9",
+	"la8eeAezDUco0IOo1XL52iFt41Bk9km4cSudYVsdNn2Sy/8xB1vHjrhvl6ef3fj7JO1PBAZ5ZSLbSP85",
+	"1R7k5rRDOgbSCKG7FkNqdhoRj83O+0ypu2DB+0M8kJs+98jD5s07KoB7VhqHSULOy4vkqc5z4Au0tIsY",
+	"Xs99SvL0VhRrTcRLkSxJJJH9uPof9HqLGHx8n2dMmZgV5Lgqvn6UKMN4uC1ov+Fwam7re/UZ1YsSjIHA",
+	"SH6pN9ldPMNUqiMI2jCUL3QUBY2sIwIQhEai0wlqkctZyWus4YLmcAW0I0mzr3eGiurALta2oujvs5iE",
+	"ZrGlRn+/5Ugi/PACMNyIPJ8BynF6s77RmlQCtAaaW0jX06neT1hgzOEzkIJeHEDPv1XmtPweGzT75L+I",
+	"xbi+nhnP6jj79mWswJ4Ru2o6nSeNbIQqW9cV6E+UjtBVJbVln2tqj7xLincu19oIBm8PV0OziYI+NFcH",
+	"3wQj/pbVLSZqHE2V6mSgwlcpbIiZDdfxEcmOc+yQIxkAnNIV80wHoc3TO9fSkVyDDLWbmKuZ2pTRCcN7",
+	"g48xTZsXBQO0ME8deEFijhnKQ1JXUiN0JBAEnwDmoZjQi6kBfE1i6LA+MdF8dPCUboRgIKwZdrJYCzJ2",
+	"2bUSEGNeE/QOgWbU5lgjLq5Ph4BT6uOeVflkHuaZyOe44Q3O+uAEDg/QkPjdvQ9vTw9fQVWnAi6ES/ku",
+	"WCH0apDJpBcHx9YRGcOUjSrJooCsqVapNgkHM+FMlsh1kuadUhUXdOJDyBfmu5dfJPzNCRytNgsnNwVl",
+	"S6W5642OB3r3T+eRyu0IP31yEYSqN1UNyIp3D6jJppQGnfYI4G4J+oHru0fbqtNqanuRSRb0jhcQLpv8",
+	"3CkjPA22deG+ijv336h8u2tHK6xKVjF86ckk5o4n9RIO+ZWip53E5TRoqupvmsaBgTDIMWzBo3AuEy84",
+	"lSo3zeas6EBBQ+u3zJBASwC8CB+LlzZMMbMqBMnfa5LAKJsOhgXR3aej/Ppc6Wsb/TdZV4sg0R0mwHNT",
+	"ztt2xrr+BzGZHrl8bZDiLCVKCd7yx/bDWaANl3S2SHxBWjRlkPRdDW+Lz9DC8TcqINs8oSOCb72RRy2A",
+	"sqrlJFGkKczSYtYjVN8LpG/JsMYVadk9hdQal3DwUNXXfwRD/QLDZK8IH2r9Mm6k4XBaa6rRSGZURhzH",
+	"plxbsTT4jLl72RzPMzUqdNeq/EeES15RojIcSuIwB/o3ORehObbaOrc7Bp4wX2PR5cM/J0vRzaD/Km/6",
+	"8Z03JJkulUmrAQJBvrmziYodOjlhnShxnU7GGx0unXxjn2IorcrWyflsj+gfzFQiJzdI5SHqG5BFAH8T",
+	"PAo0LYDsRQbcZJUfshDCr7yjLxcuPW/ZymW7jD08l5iMe8XpzoA87tQfIN3GBAmRWYTa3UnuW1YlKlpM",
+	"SQy0B7SDNf7dRXL1iFugxat431B6ds5l3Dt1g6cNUOnx3SONIYez9Wjk9G4hSbCf42OVVDxdKvvYIteW",
+	"9x5z/MnfMymmB0uLAXTAabnOcRrauEbnC7hmC7oUjAOyW+AFxi3pKpivx46djVDBx1Fwv/HqKNeN/5B1",
+	"KpNkvh3dy384m2ipZ48pntQtvmvbqgXOHvOmxh3Pp4CKvJh8ZS/DTJiXSXB4LyTwXkeRwNlG/MPehM5S",
+	"NDH8LADqOZse4pY+pzxh+hYXmM5mdvQ8oxneWehw+MTcYzKRM907M31ydkrmeDtsEd5be4i7uq6fEwqR",
+	"8fzEElz10OcVq1zUAcdAUejjbv0zfcF6aGSVM+6KOnRqnbs8WgcdfUyBP1jnUX5sY6qoXds4ZK8/v/qK",
+	"hcohciPG2x9++L5d/vDDj2JENZ0jZXlD3VvszgjBRiaU88OfQWTe0JVSJQ8f0gQYyslNf/7I/4xn4OHD",
+	"sH94HhKiYOoux6nx8wDwkw6cNnJKgCbNG6QYa1f+DB1Aj8tIuAShaI0JnP+dknAMqfOTKbN1rO0FUzTd",
+	"kpjlaIrlqdSf6FGLieFRIQqnAfV2c95pD1LPnMxHI+kyh9gLZz3iyACNGEl8JIEG5LU9JFmOXMNBY3Lw",
+	"VBbz4ZhUYERLuybetF9+OpYV+h71r+30TjkCiqmD3/4vqWsdTSr2+kS8hC+E22eBbSd+IvHnbS9/J1uQ",
+	"3Fl1Vofeu2MsVk4TW+gAfAcbF/RSRqa1RptKw47KJoghcEd2ebGeOr6fYSM9G6b6UKVq8uYnXOlPS7hV",
+	"oP+7teBpCDjicyg+May0wr5Lf/9+jzAfRkxgrd7kzlS4Q3mLzhJ6Y6yzh+f67m5OODlqg25PeXv3CvGv",
+	"HRnyn4KvP18CMDW9qrGx1QYmisWtrd6gksAJj7emdddom96XFeg4aAXjeMkSbV9Y6+zz22x/KCSGIfnr",
+	"e8v/UB//5ZP1o48//I/lXx796dFKffKnTx89yj79JPvw048/VB/95U+fPFIfbv786fKj9UeffLT85KNP",
+	"/vynT1cff/Lh8pM/f/of79FLK4DMgOoA0McP/jPFF5306sWz9DUCa3ECqwYhEXBCXgObiv3hAakr4vP4",
+	"HFtAM/np/9GX9QWsxg6vf0Xxpsbmu7Y9NI8vL29ubi7cLpf4dAycqq261e5Sz4NFiH3Z5MUzc72y4YR2",
+	"1EY80KYKKVzRt5efv3qNvqMXlmDg26OLRxcf8tu7KmGp8NPH9BOdnh3t+6UQG/wbGl4C6op2J3/ALtf5",
+	"Sn8iziv/bm6yLXDfi1+4cib+dP3RpTZmXv4mxqW3Y98usyUI2SvyR6MXl5GWjuMo/Gz/SvP1xBx4yTQz",
+	"msAPku1gfEAxPaQuSPM6TENi6yHF29QKdSU4dy2H3MVbur4sgaFnonSs2eWyuj2iqWrmNtYZNlLH3Vl3",
+	"HNmt/qdLdBln44c04Qp8l7+R8v429vul4zkRbSP5tSMfmQPFPtMTFre57FF/r6Vx0Ii28Lb5N6yr9LY/",
+	"5gqFp+5w+Rv9g/iKs3bkQzUM4KBprZbd9lLyQEZ/x/EOKDr528CNuBRXaqrleaMULVz64kI+/HGEHKUV",
+	"CIiXJOBc/hb6PNhe//fwzAafemy3xfUexODLbH0tJal6H2RLqs2moRjrsc+Xv/H/HfDULbD2nGJfqeCq",
+	"BGobho9C4YPPnUZP0AebivNymkzi5B89ejS8vd1eCV8sFFmAt8Injz6Z0YFSYthOa7XJglrQtyUV2Us+",
+	"r+uKHRmaDq58ZFJSKKNJnv8dBWDVn6JX4yDDCJvvH7DLCVW4dtDz41tBGsv/yCryPcfR+x+wBEZxN/z5",
+	"rlwFfxxSTeAj8Nk3gwZk53MIytbw8X+4pOzIl7/R/94OPxuHp97voHU3dw1KmZe/mX87/f1rGn4wlkuf",
+	"cXg/X+Z7rBAS+3rwKrCFm0gN8NjEl2b/w59/8/70z/lUSzwyI9AHOsCtWStnTw7KuxiaXdeugXydX0Bi",
+	"anaVMweZodnhgf7dNeFvA0IKNe6ay87UzfZ/v8nyFvXUlAvXkzPwcNAWJLVLqYXQ+3WdN1L4e/Clvqs7",
+	"Z5Ekuzf9vy9/Q8nWnctNSB389ZKMGZFvqJSja8jeu099+QSVitjYA+El9FXuvlijOr+Oz65jYSY+X+ry",
+	"OXPbwXnlf7nUbVVHVxUDjucoYd//+PZH/FZfE5XCJ6tZgGJBgVW7qmkvgSX/1tM63I8/Gnb6m9ZWNCbe",
+	"/vj2/wdd/fVCNccBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
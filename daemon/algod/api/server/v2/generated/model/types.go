@@ -21,6 +21,28 @@ const (
 	AccountSigTypeSig  AccountSigType = "sig"
 )
 
+// Defines values for ComponentHealthStatus.
+const (
+	ComponentHealthStatusDegraded  ComponentHealthStatus = "degraded"
+	ComponentHealthStatusOk        ComponentHealthStatus = "ok"
+	ComponentHealthStatusUnhealthy ComponentHealthStatus = "unhealthy"
+)
+
+// Defines values for HealthReportStatus.
+const (
+	HealthReportStatusDegraded  HealthReportStatus = "degraded"
+	HealthReportStatusOk        HealthReportStatus = "ok"
+	HealthReportStatusUnhealthy HealthReportStatus = "unhealthy"
+)
+
+// Defines values for ParticipationKeyRenewalState.
+const (
+	ParticipationKeyRenewalStateExpiring  ParticipationKeyRenewalState = "expiring"
+	ParticipationKeyRenewalStateFailed    ParticipationKeyRenewalState = "failed"
+	ParticipationKeyRenewalStateRenewed   ParticipationKeyRenewalState = "renewed"
+	ParticipationKeyRenewalStateSubmitted ParticipationKeyRenewalState = "submitted"
+)
+
 // Defines values for AddressRole.
 const (
 	AddressRoleFreezeTarget AddressRole = "freeze-target"
@@ -94,6 +116,18 @@ const (
 	GetBlockParamsFormatMsgpack GetBlockParamsFormat = "msgpack"
 )
 
+// Defines values for GetBlockCertificateParamsFormat.
+const (
+	GetBlockCertificateParamsFormatJson    GetBlockCertificateParamsFormat = "json"
+	GetBlockCertificateParamsFormatMsgpack GetBlockCertificateParamsFormat = "msgpack"
+)
+
+// Defines values for GetBlockHeaderParamsFormat.
+const (
+	GetBlockHeaderParamsFormatJson    GetBlockHeaderParamsFormat = "json"
+	GetBlockHeaderParamsFormatMsgpack GetBlockHeaderParamsFormat = "msgpack"
+)
+
 // Defines values for GetTransactionProofParamsHashtype.
 const (
 	GetTransactionProofParamsHashtypeSha256    GetTransactionProofParamsHashtype = "sha256"
@@ -124,6 +158,12 @@ const (
 	GetTransactionGroupLedgerStateDeltasForRoundParamsFormatMsgpack GetTransactionGroupLedgerStateDeltasForRoundParamsFormat = "msgpack"
 )
 
+// Defines values for GetParticipationKeyregParamsFormat.
+const (
+	GetParticipationKeyregParamsFormatJson    GetParticipationKeyregParamsFormat = "json"
+	GetParticipationKeyregParamsFormatMsgpack GetParticipationKeyregParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsParamsFormat.
 const (
 	GetPendingTransactionsParamsFormatJson    GetPendingTransactionsParamsFormat = "json"
@@ -142,6 +182,33 @@ const (
 	SimulateTransactionParamsFormatMsgpack SimulateTransactionParamsFormat = "msgpack"
 )
 
+// Defines values for GetSimulationSessionDeltaParamsFormat.
+const (
+	GetSimulationSessionDeltaParamsFormatJson    GetSimulationSessionDeltaParamsFormat = "json"
+	GetSimulationSessionDeltaParamsFormatMsgpack GetSimulationSessionDeltaParamsFormat = "msgpack"
+)
+
+// Defines values for SimulateTransactionInSessionParamsFormat.
+const (
+	SimulateTransactionInSessionParamsFormatJson    SimulateTransactionInSessionParamsFormat = "json"
+	SimulateTransactionInSessionParamsFormatMsgpack SimulateTransactionInSessionParamsFormat = "msgpack"
+)
+
+// APIToken A named API token, scoped to groups of endpoints.
+type APIToken struct {
+	// Name The name of the token.
+	Name string `json:"name"`
+
+	// Scopes The endpoint groups the token grants access to: read, submit, participation or admin.
+	Scopes []string `json:"scopes"`
+}
+
+// APITokenRequest Request type for the named API token creation endpoint.
+type APITokenRequest struct {
+	// Scopes The endpoint groups the token grants access to: read, submit, participation or admin.
+	Scopes []string `json:"scopes"`
+}
+
 // Account Account information at a given round.
 //
 // Definition:
@@ -242,6 +309,26 @@ type Account struct {
 // * lsig
 type AccountSigType string
 
+// AccountAssetResource AccountAssetResource describes an account's asset holding and asset parameters (if either exist) for a specific asset ID.
+type AccountAssetResource struct {
+	// AssetHolding Describes an asset held by an account.
+	//
+	// Definition:
+	// data/basics/userBalance.go : AssetHolding
+	AssetHolding *AssetHolding `json:"asset-holding,omitempty"`
+
+	// AssetId Asset ID of the holding or created asset.
+	AssetID uint64 `json:"asset-id"`
+
+	// CreatedAsset AssetParams specifies the parameters for an asset.
+	//
+	// \[apar\] when part of an AssetConfig transaction.
+	//
+	// Definition:
+	// data/transactions/asset.go : AssetParams
+	CreatedAsset *AssetParams `json:"created-asset,omitempty"`
+}
+
 // AccountParticipation AccountParticipation describes the parameters used by this account in consensus protocol.
 type AccountParticipation struct {
 	// SelectionParticipationKey \[sel\] Selection public key (if any) currently registered for this round.
@@ -263,6 +350,48 @@ type AccountParticipation struct {
 	VoteParticipationKey []byte `json:"vote-participation-key"`
 }
 
+// AccountPerformance Proposal and vote performance of an account tracked by the node, over the rounds the node held a participation key of the account for since it started.
+type AccountPerformance struct {
+	// Address The tracked account.
+	Address string `json:"address"`
+
+	// LastRound Last round the sortition of the account was evaluated in.
+	LastRound *uint64 `json:"last-round,omitempty"`
+
+	// ProposalMissRate Share of the rounds the account was selected to propose in whose agreed block is not from the account. Several accounts are selected to propose in every round, so that a non-zero rate is expected.
+	ProposalMissRate float64 `json:"proposal-miss-rate"`
+
+	// ProposalsMade Number of agreed blocks proposed by the account.
+	ProposalsMade uint64 `json:"proposals-made"`
+
+	// ProposalsSelected Number of rounds the account was selected to propose a block in.
+	ProposalsSelected uint64 `json:"proposals-selected"`
+
+	// Recent The most recent rounds the account was selected in, in increasing order.
+	Recent []RoundPerformance `json:"recent"`
+
+	// Rounds Number of rounds the sortition of the account was evaluated in.
+	Rounds uint64 `json:"rounds"`
+
+	// VoteMissRate Share of the rounds the account was selected to cast a certificate vote in whose certificate does not hold a vote of the account.
+	VoteMissRate float64 `json:"vote-miss-rate"`
+
+	// VotesMissed Number of rounds the account was selected to cast a certificate vote in whose certificate does not hold a vote of the account. A certificate only holds the votes needed to reach the threshold, so that late votes count as missed.
+	VotesMissed uint64 `json:"votes-missed"`
+
+	// VotesSelected Number of rounds the account was selected to cast a certificate vote in.
+	VotesSelected uint64 `json:"votes-selected"`
+}
+
+// AccountRekey A change of the address authorizing the transactions of an account.
+type AccountRekey struct {
+	// AuthAddr The address authorizing the transactions of the account from this round on. Omitted when the control of the account returned to its own key, including when the account was closed.
+	AuthAddr *string `json:"auth-addr,omitempty"`
+
+	// Round The round the change took effect in.
+	Round uint64 `json:"round"`
+}
+
 // AccountStateDelta Application state delta.
 type AccountStateDelta struct {
 	Address string `json:"address"`
@@ -280,6 +409,45 @@ type Application struct {
 	Params ApplicationParams `json:"params"`
 }
 
+// ApplicationBoxDelta A box of an application, after a round it changed in.
+type ApplicationBoxDelta struct {
+	// Deleted Whether the box was deleted.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// Name The name of the box.
+	Name []byte `json:"name"`
+
+	// Value The value of the box, unless it was deleted.
+	Value *[]byte `json:"value,omitempty"`
+}
+
+// ApplicationEvent An ARC-28 event emitted by an application call, decoded from a log entry with the contract description registered for the application.
+type ApplicationEvent struct {
+	// Fields The fields of the event, in their order in the signature.
+	Fields []ApplicationEventField `json:"fields"`
+
+	// LogIndex The index of the log entry the event was decoded from.
+	LogIndex uint64 `json:"log-index"`
+
+	// Name The name of the event.
+	Name string `json:"name"`
+
+	// Signature The signature of the event, whose hash prefixes the log entry.
+	Signature string `json:"signature"`
+}
+
+// ApplicationEventField A field of an ARC-28 event.
+type ApplicationEventField struct {
+	// Name The name of the field, if the contract description names it.
+	Name *string `json:"name,omitempty"`
+
+	// Type The ABI type of the field.
+	Type string `json:"type"`
+
+	// Value The decoded value of the field, in the JSON form of its ABI type: addresses are in their checksum form, byte arrays are base64 encoded, and arrays and tuples are arrays of their elements. Integers that do not fit in 64 bits are decimal strings.
+	Value interface{} `json:"value"`
+}
+
 // ApplicationLocalState Stores local state associated with an application.
 type ApplicationLocalState struct {
 	// Id The application which this local state is for.
@@ -292,6 +460,27 @@ type ApplicationLocalState struct {
 	Schema ApplicationStateSchema `json:"schema"`
 }
 
+// ApplicationLocalStateDelta The local state of an account in an application, after a round it changed in.
+type ApplicationLocalStateDelta struct {
+	// Address The account the local state is of.
+	Address string `json:"address"`
+
+	// Deleted Whether the account closed out of the application.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// KeyValue Represents a key-value store for use in an application.
+	KeyValue *TealKeyValueStore `json:"key-value,omitempty"`
+}
+
+// ApplicationOptIn An account opted in an application.
+type ApplicationOptIn struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// AppLocalState Stores local state associated with an application.
+	AppLocalState ApplicationLocalState `json:"app-local-state"`
+}
+
 // ApplicationParams Stores the global information associated with an application.
 type ApplicationParams struct {
 	// ApprovalProgram \[approv\] approval program.
@@ -316,6 +505,42 @@ type ApplicationParams struct {
 	LocalStateSchema *ApplicationStateSchema `json:"local-state-schema,omitempty"`
 }
 
+// ApplicationStateDelta The state changes of a watched application in a round.
+type ApplicationStateDelta struct {
+	// ApplicationId The application the state changes are of.
+	ApplicationId uint64 `json:"application-id"`
+
+	// Boxes The boxes that changed, ordered by name.
+	Boxes *[]ApplicationBoxDelta `json:"boxes,omitempty"`
+
+	// Deleted Whether the application was deleted.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// GlobalState Represents a key-value store for use in an application.
+	GlobalState *TealKeyValueStore `json:"global-state,omitempty"`
+
+	// LocalStates The local states that changed, ordered by address.
+	LocalStates *[]ApplicationLocalStateDelta `json:"local-states,omitempty"`
+}
+
+// ApplicationStateOperation An operation against an application's global/local/box state.
+type ApplicationStateOperation struct {
+	// Account For local state changes, the address of the account associated with the local state.
+	Account *string `json:"account,omitempty"`
+
+	// AppStateType Type of application state. Value `g` is **global state**, `l` is **local state**, `b` is **boxes**.
+	AppStateType string `json:"app-state-type"`
+
+	// Key The key (name) of the global/local/box state.
+	Key []byte `json:"key"`
+
+	// NewValue Represents a TEAL value.
+	NewValue *TealValue `json:"new-value,omitempty"`
+
+	// Operation Operation type. Value `w` is **write**, `d` is **delete**.
+	Operation string `json:"operation"`
+}
+
 // ApplicationStateSchema Specifies maximums on the number of each type that may be stored.
 type ApplicationStateSchema struct {
 	// NumByteSlice \[nbs\] num of byte slices.
@@ -339,6 +564,18 @@ type Asset struct {
 	Params AssetParams `json:"params"`
 }
 
+// AssetHolder An account holding an asset.
+type AssetHolder struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// Amount The number of units of the asset held by the account.
+	Amount uint64 `json:"amount"`
+
+	// IsFrozen Whether the holding is frozen.
+	IsFrozen bool `json:"is-frozen"`
+}
+
 // AssetHolding Describes an asset held by an account.
 //
 // Definition:
@@ -435,6 +672,36 @@ type BuildVersion struct {
 	Minor       uint64 `json:"minor"`
 }
 
+// ComponentHealth The health of a component of the node.
+type ComponentHealth struct {
+	// Details Component specific measurements, such as the time since the last round or the number of peers.
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// Name The component: ledger, participation, network, catchpoint or disk.
+	Name string `json:"name"`
+
+	// Reasons The machine readable reasons of the degradation of the component, such as catching-up, stalled, few-peers or low-disk-space.
+	Reasons *[]string `json:"reasons,omitempty"`
+
+	// Status The health of the component.
+	Status ComponentHealthStatus `json:"status"`
+}
+
+// ComponentHealthStatus The health of the component.
+type ComponentHealthStatus string
+
+// ConsensusParameterChange A consensus parameter whose value changes with a protocol upgrade.
+type ConsensusParameterChange struct {
+	// Current The value of the parameter in the current protocol.
+	Current interface{} `json:"current"`
+
+	// Name The name of the parameter, as in the consensus parameters of go-algorand, such as MinTxnFee or MaxAppProgramCost.
+	Name string `json:"name"`
+
+	// Next The value of the parameter in the next protocol.
+	Next interface{} `json:"next"`
+}
+
 // DryrunRequest Request data type for dryrun endpoint. Given the Transactions and simulated ledger state upload, run TEAL scripts and return debugging information.
 type DryrunRequest struct {
 	Accounts []Account     `json:"accounts"`
@@ -527,6 +794,26 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// FeeEstimate The suggested fee per byte for a transaction to be included within a number of rounds.
+type FeeEstimate struct {
+	// FeePerByte The suggested fee per byte, in units of micro-Algos.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// Rounds The number of rounds within which the transaction is expected to be included.
+	Rounds uint64 `json:"rounds"`
+}
+
+// HealthReport The health of the node, which is the worst health of its components.
+type HealthReport struct {
+	Components []ComponentHealth `json:"components"`
+
+	// Status The health of the node.
+	Status HealthReportStatus `json:"status"`
+}
+
+// HealthReportStatus The health of the node.
+type HealthReportStatus string
+
 // KvDelta A single Delta containing the key, the previous value and the current value for a single round.
 type KvDelta struct {
 	// Key The key, base64 encoded.
@@ -546,6 +833,48 @@ type LedgerStateDeltaForTransactionGroup struct {
 	Ids   []string         `json:"Ids"`
 }
 
+// LedgerTotals The totals of algos of the ledger at a round.
+type LedgerTotals struct {
+	// CirculatingMoney The MicroAlgos in circulation: the total money, less the balances of the fee sink and of the rewards pool.
+	CirculatingMoney uint64 `json:"circulating-money"`
+
+	// FeeSink The balance of the fee sink in MicroAlgos.
+	FeeSink uint64 `json:"fee-sink"`
+
+	// NotParticipatingMoney The MicroAlgos held by the accounts not participating, such as the fee sink and the rewards pool.
+	NotParticipatingMoney uint64 `json:"not-participating-money"`
+
+	// NotParticipatingRewardUnits The reward units of the accounts not participating.
+	NotParticipatingRewardUnits uint64 `json:"not-participating-reward-units"`
+
+	// OfflineMoney The MicroAlgos held by the offline accounts.
+	OfflineMoney uint64 `json:"offline-money"`
+
+	// OfflineRewardUnits The reward units of the offline accounts.
+	OfflineRewardUnits uint64 `json:"offline-reward-units"`
+
+	// OnlineMoney The MicroAlgos held by the online accounts, which is the online stake.
+	OnlineMoney uint64 `json:"online-money"`
+
+	// OnlineRewardUnits The reward units of the online accounts.
+	OnlineRewardUnits uint64 `json:"online-reward-units"`
+
+	// RewardsLevel The MicroAlgos received per reward unit since genesis.
+	RewardsLevel uint64 `json:"rewards-level"`
+
+	// RewardsPool The balance of the rewards pool in MicroAlgos.
+	RewardsPool uint64 `json:"rewards-pool"`
+
+	// RewardsRate The MicroAlgos distributed from the rewards pool in each round.
+	RewardsRate uint64 `json:"rewards-rate"`
+
+	// Round The round of the totals.
+	Round uint64 `json:"round"`
+
+	// TotalMoney The MicroAlgos held by all the accounts.
+	TotalMoney uint64 `json:"total-money"`
+}
+
 // LightBlockHeaderProof Proof of membership and position of a light block header.
 type LightBlockHeaderProof struct {
 	// Index The index of the light block header in the vector commitment tree
@@ -558,6 +887,36 @@ type LightBlockHeaderProof struct {
 	Treedepth uint64 `json:"treedepth"`
 }
 
+// LoggingSubsystem A subsystem whose logging can be disabled at runtime.
+type LoggingSubsystem struct {
+	// Enabled Whether the entries of the subsystem below the error level are logged.
+	Enabled bool `json:"enabled"`
+
+	// Name The name of the subsystem: agreement, catchup, network or txnsync.
+	Name string `json:"name"`
+}
+
+// MetricDescription A metric registered by the node.
+type MetricDescription struct {
+	// Description The description of the metric.
+	Description string `json:"description"`
+
+	// Enabled Whether the metric is reported, that is whether its subsystem is not disabled by MetricsDisabledSubsystems.
+	Enabled bool `json:"enabled"`
+
+	// Name The name of the metric, or its name template for a tag counter.
+	Name string `json:"name"`
+
+	// Series The number of series the metric reports, that is of distinct sets of labels.
+	Series uint64 `json:"series"`
+
+	// Subsystem The subsystem of the metric: the word following the algod_ prefix of its name.
+	Subsystem string `json:"subsystem"`
+
+	// Type The type of the metric: counter, gauge, histogram, tagcounter or runtime.
+	Type string `json:"type"`
+}
+
 // ParticipationKey Represents a participation key used by the node.
 type ParticipationKey struct {
 	// Address Address the key was generated for.
@@ -583,6 +942,110 @@ type ParticipationKey struct {
 
 	// LastVote Round when this key was last used to vote.
 	LastVote *uint64 `json:"last-vote,omitempty"`
+
+	// Renewal Renewal status of a participation key registered for an online account, reported once its expiration is within the renewal lead rounds.
+	Renewal *ParticipationKeyRenewal `json:"renewal,omitempty"`
+}
+
+// ParticipationKeyRenewal Renewal status of a participation key registered for an online account, reported once its expiration is within the renewal lead rounds.
+type ParticipationKeyRenewal struct {
+	// Error Error of the last renewal attempt.
+	Error *string `json:"error,omitempty"`
+
+	// Round Round of the last renewal attempt.
+	Round *uint64 `json:"round,omitempty"`
+
+	// State State of the renewal:
+	// * expiring - the key is about to expire and the renewal is disabled.
+	// * submitted - the registration of the successor key was submitted.
+	// * renewed - the successor key got registered.
+	// * failed - the last renewal attempt failed.
+	State ParticipationKeyRenewalState `json:"state"`
+
+	// SuccessorId ParticipationID of the successor key.
+	SuccessorId *string `json:"successor-id,omitempty"`
+
+	// Txid Transaction ID of the keyreg transaction registering the successor key.
+	Txid *string `json:"txid,omitempty"`
+}
+
+// ParticipationKeyRenewalState State of the renewal:
+// * expiring - the key is about to expire and the renewal is disabled.
+// * submitted - the registration of the successor key was submitted.
+// * renewed - the successor key got registered.
+// * failed - the last renewal attempt failed.
+type ParticipationKeyRenewalState string
+
+// ParticipationKeySummary Participation summary of a participation key installed on the node.
+type ParticipationKeySummary struct {
+	// Address Address the key was generated for.
+	Address string `json:"address"`
+
+	// EffectiveStake Stake of the account, in microalgos, when the key is registered.
+	EffectiveStake uint64 `json:"effective-stake"`
+
+	// ExpectedProposals Number of blocks of the window the account was expected to propose given its share of the online stake.
+	ExpectedProposals float64 `json:"expected-proposals"`
+
+	// Id The key's ParticipationID.
+	Id string `json:"id"`
+
+	// LastProposal Last round in the window whose block was proposed by the account.
+	LastProposal *uint64 `json:"last-proposal,omitempty"`
+
+	// LastVote Last round in the window whose certificate includes a vote of the account.
+	LastVote *uint64 `json:"last-vote,omitempty"`
+
+	// Proposals Number of blocks of the window proposed by the account.
+	Proposals uint64 `json:"proposals"`
+
+	// Registered Whether the key is the one registered on chain by its online account.
+	Registered bool `json:"registered"`
+
+	// RoundsUntilExpiry Number of rounds until the last valid round of the key, 0 once it expired.
+	RoundsUntilExpiry uint64 `json:"rounds-until-expiry"`
+
+	// Votes Number of certificates of the window including a vote of the account.
+	Votes uint64 `json:"votes"`
+}
+
+// PeerConnection A connection to a peer of the node.
+type PeerConnection struct {
+	// Address The IP address of the remote end of the connection.
+	Address string `json:"address"`
+
+	// Capabilities The optional features negotiated with the peer during the handshake, as announced in the X-Algorand-Peer-Features header. Versioned features are formatted as name=version.
+	Capabilities *[]string `json:"capabilities,omitempty"`
+
+	// ConnectionDuration The duration of the connection, in seconds.
+	ConnectionDuration uint64 `json:"connection-duration"`
+
+	// DuplicateFilterCount The number of times the peer sent a message hash to filter that it had already sent.
+	DuplicateFilterCount *uint64 `json:"duplicate-filter-count,omitempty"`
+
+	// Endpoint The dialed address, for an outgoing connection.
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// InstanceName The hashed instance name the peer announced during the handshake.
+	InstanceName *string `json:"instance-name,omitempty"`
+
+	// MessageDelay The average relative message delay, in nanoseconds, for an outgoing connection.
+	MessageDelay *uint64 `json:"message-delay,omitempty"`
+
+	// ProposalCount The number of proposal payload messages received from the peer.
+	ProposalCount *uint64 `json:"proposal-count,omitempty"`
+
+	// ProtocolVersion The gossip protocol version negotiated with the peer.
+	ProtocolVersion *string `json:"protocol-version,omitempty"`
+
+	// TelemetryGuid The telemetry GUID the peer announced during the handshake.
+	TelemetryGuid *string `json:"telemetry-guid,omitempty"`
+
+	// TransactionCount The number of transaction messages received from the peer.
+	TransactionCount *uint64 `json:"transaction-count,omitempty"`
+
+	// VoteCount The number of vote messages received from the peer.
+	VoteCount *uint64 `json:"vote-count,omitempty"`
 }
 
 // PendingTransactionResponse Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
@@ -605,6 +1068,9 @@ type PendingTransactionResponse struct {
 	// ConfirmedRound The round where this transaction was confirmed, if present.
 	ConfirmedRound *uint64 `json:"confirmed-round,omitempty"`
 
+	// Events The ARC-28 events decoded from the logs, when requested.
+	Events *[]ApplicationEvent `json:"events,omitempty"`
+
 	// GlobalStateDelta Application state delta.
 	GlobalStateDelta *StateDelta `json:"global-state-delta,omitempty"`
 
@@ -620,6 +1086,9 @@ type PendingTransactionResponse struct {
 	// PoolError Indicates that the transaction was kicked out of this node's transaction pool (and specifies why that happened).  An empty string indicates the transaction wasn't kicked out of this node's txpool due to an error.
 	PoolError string `json:"pool-error"`
 
+	// PoolErrorCode The code of the reason the transaction was kicked out of this node's transaction pool, along with pool-error: overspend, min-balance, asset-frozen, logic-eval, txn-dead, group-mismatch, already-in-ledger, lease-in-use, fee-too-low, pool-full, not-well-formed, invalid-signature or other.
+	PoolErrorCode *string `json:"pool-error-code,omitempty"`
+
 	// ReceiverRewards Rewards in microalgos applied to the receiver account.
 	ReceiverRewards *uint64 `json:"receiver-rewards,omitempty"`
 
@@ -630,82 +1099,412 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
-// SimulateRequest Request type for simulation endpoint.
-type SimulateRequest struct {
-	// AllowEmptySignatures Allow transactions without signatures to be simulated as if they had correct signatures.
-	AllowEmptySignatures *bool `json:"allow-empty-signatures,omitempty"`
+// ProfileCapture A profile of the node captured on demand.
+type ProfileCapture struct {
+	// Done Whether the capture finished, and can be downloaded.
+	Done bool `json:"done"`
 
-	// AllowMoreLogging Lifts limits on log opcode usage during simulation.
-	AllowMoreLogging *bool `json:"allow-more-logging,omitempty"`
+	// Duration The duration of the capture in seconds, 0 for the snapshots.
+	Duration uint64 `json:"duration"`
 
-	// ExecTraceConfig An object that configures simulation execution trace.
-	ExecTraceConfig *SimulateTraceConfig `json:"exec-trace-config,omitempty"`
+	// Error The error the capture failed with, if any.
+	Error *string `json:"error,omitempty"`
 
-	// ExtraOpcodeBudget Applies extra opcode budget during simulation for each transaction group.
-	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
+	// Id The identifier of the capture.
+	Id uint64 `json:"id"`
 
-	// TxnGroups The transaction groups to simulate.
-	TxnGroups []SimulateRequestTransactionGroup `json:"txn-groups"`
-}
+	// Kind The kind of the profile: cpu, trace, or the name of a runtime/pprof profile, such as heap, allocs, goroutine, block or mutex.
+	Kind string `json:"kind"`
 
-// SimulateRequestTransactionGroup A transaction group to simulate.
-type SimulateRequestTransactionGroup struct {
-	// Txns An atomic transaction group.
-	Txns []json.RawMessage `json:"txns"`
-}
+	// Size The size of the captured profile in bytes, 0 while it runs.
+	Size uint64 `json:"size"`
 
-// SimulateTraceConfig An object that configures simulation execution trace.
-type SimulateTraceConfig struct {
-	// Enable A boolean option for opting in execution trace features simulation endpoint.
-	Enable *bool `json:"enable,omitempty"`
+	// Start The time the capture started, in seconds since the epoch.
+	Start uint64 `json:"start"`
 }
 
-// SimulateTransactionGroupResult Simulation result for an atomic transaction group
-type SimulateTransactionGroupResult struct {
-	// AppBudgetAdded Total budget added during execution of app calls in the transaction group.
-	AppBudgetAdded *uint64 `json:"app-budget-added,omitempty"`
+// ProposalAssembly The assembly of a block the node proposed.
+type ProposalAssembly struct {
+	// AssemblyTime The time allotted to the assembly by ProposalAssemblyTime, in nanoseconds.
+	AssemblyTime uint64 `json:"assembly-time"`
 
-	// AppBudgetConsumed Total budget consumed during execution of app calls in the transaction group.
-	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
+	// BytesLimit The maximum encoded length of the transactions of a block.
+	BytesLimit uint64 `json:"bytes-limit"`
 
-	// FailedAt If present, indicates which transaction in this group caused the failure. This array represents the path to the failing transaction. Indexes are zero based, the first element indicates the top-level transaction, and successive elements indicate deeper inner transactions.
-	FailedAt *[]uint64 `json:"failed-at,omitempty"`
+	// Considered The number of transaction groups in the pool when the assembly started.
+	Considered uint64 `json:"considered"`
 
-	// FailureMessage If present, indicates that the transaction group failed and specifies why that happened
-	FailureMessage *string `json:"failure-message,omitempty"`
+	// Duration The time the assembly took, in nanoseconds.
+	Duration uint64 `json:"duration"`
 
-	// TxnResults Simulation result for individual transactions
-	TxnResults []SimulateTransactionResult `json:"txn-results"`
-}
+	// Excluded The numbers of considered transaction groups left out of the block, by reason.
+	Excluded []ProposalExclusion `json:"excluded"`
 
-// SimulateTransactionResult Simulation result for an individual transaction
-type SimulateTransactionResult struct {
-	// AppBudgetConsumed Budget used during execution of an app call transaction. This value includes budged used by inner app calls spawned by this transaction.
-	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
+	// Fees The fees paid by the transactions of the block, in microalgos.
+	Fees uint64 `json:"fees"`
 
-	// ExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
-	ExecTrace *SimulationTransactionExecTrace `json:"exec-trace,omitempty"`
+	// IncludedBytes The encoded length of the transactions of the block.
+	IncludedBytes uint64 `json:"included-bytes"`
 
-	// LogicSigBudgetConsumed Budget used during execution of a logic sig transaction.
-	LogicSigBudgetConsumed *uint64 `json:"logic-sig-budget-consumed,omitempty"`
+	// IncludedGroups The number of transaction groups of the block.
+	IncludedGroups uint64 `json:"included-groups"`
 
-	// TxnResult Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
-	TxnResult PendingTransactionResponse `json:"txn-result"`
-}
+	// IncludedTxns The number of transactions of the block.
+	IncludedTxns uint64 `json:"included-txns"`
 
-// SimulationEvalOverrides The set of parameters and limits override during simulation. If this set of parameters is present, then evaluation parameters may differ from standard evaluation in certain ways.
-type SimulationEvalOverrides struct {
-	// AllowEmptySignatures If true, transactions without signatures are allowed and simulated as if they were properly signed.
-	AllowEmptySignatures *bool `json:"allow-empty-signatures,omitempty"`
+	// Round The round of the block.
+	Round uint64 `json:"round"`
 
-	// ExtraOpcodeBudget The extra opcode budget added to each transaction group during simulation
-	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
+	// Start The time the block was requested, in nanoseconds since the epoch.
+	Start uint64 `json:"start"`
 
-	// MaxLogCalls The maximum log calls one can make during simulation
-	MaxLogCalls *uint64 `json:"max-log-calls,omitempty"`
+	// StopReason Why the assembly stopped adding transactions to the block: block-full, timeout, pool-empty, or, for a block proposed without the transactions of the pool, timeout-empty, pool-behind or eval-old.
+	StopReason string `json:"stop-reason"`
+}
 
-	// MaxLogSize The maximum byte number to log during simulation
+// ProposalExclusion The number of transaction groups left out of a proposed block for a reason.
+type ProposalExclusion struct {
+	// Count The number of transaction groups.
+	Count uint64 `json:"count"`
+
+	// Reason The reason: committed or early-committed if already in the ledger, expired, lease, min-fee, invalid if failing evaluation otherwise, or left-over if not evaluated before the block was full or the assembly ran out of time.
+	Reason string `json:"reason"`
+}
+
+// ProtocolUpgrade A consensus protocol upgrade, either being voted on or approved and scheduled.
+type ProtocolUpgrade struct {
+	// Approvals The number of blocks which approved the upgrade so far.
+	Approvals uint64 `json:"approvals"`
+
+	// ApprovalsRequired The number of approvals the upgrade needs to be approved.
+	ApprovalsRequired uint64 `json:"approvals-required"`
+
+	// Approved Whether the upgrade was approved, and is now scheduled for the switch-on round.
+	Approved bool `json:"approved"`
+
+	// NextProtocol The protocol the network upgrades to.
+	NextProtocol string `json:"next-protocol"`
+
+	// NextProtocolSupported Whether this node supports the next protocol. A node which does not support it stops at the switch-on round.
+	NextProtocolSupported bool `json:"next-protocol-supported"`
+
+	// ParameterChanges The consensus parameters whose values differ between the current and the next protocol. Omitted when the next protocol is not supported by this node.
+	ParameterChanges *[]ConsensusParameterChange `json:"parameter-changes,omitempty"`
+
+	// SwitchOn The round the next protocol takes effect in, if the upgrade is approved.
+	SwitchOn uint64 `json:"switch-on"`
+
+	// VoteBefore The round the vote on the upgrade ends at.
+	VoteBefore uint64 `json:"vote-before"`
+
+	// VoteRounds The number of rounds of the vote.
+	VoteRounds uint64 `json:"vote-rounds"`
+
+	// Votes The number of blocks which voted on the upgrade so far.
+	Votes uint64 `json:"votes"`
+}
+
+// ReplayRejection A transaction rejected by the replay protection, as its ID was already committed or pending, or its lease was held by another transaction.
+type ReplayRejection struct {
+	// Code The code of the rejection: already-in-ledger for a transaction whose ID was already committed or pending, or lease-in-use for a transaction whose lease was held by another transaction.
+	Code string `json:"code"`
+
+	// ConflictRound The round the committed transaction holding the lease was committed in, for the lease conflicts with committed transactions.
+	ConflictRound *uint64 `json:"conflict-round,omitempty"`
+
+	// ConflictTxid The ID of the original transaction, when known: the ID of the rejected transaction itself for the duplicates, and the ID of the pending transaction holding the lease for the pending lease conflicts.
+	ConflictTxid *string `json:"conflict-txid,omitempty"`
+
+	// Lease The lease of the transaction, for the lease conflicts.
+	Lease *[]byte `json:"lease,omitempty"`
+
+	// LeaseExpires The last round the lease is held until, for the lease conflicts.
+	LeaseExpires *uint64 `json:"lease-expires,omitempty"`
+
+	// Pending Whether the original transaction, whose ID or lease was replayed, was pending in the pool rather than committed.
+	Pending bool `json:"pending"`
+
+	// Sender The sender of the rejected transaction.
+	Sender string `json:"sender"`
+
+	// Time The time the transaction was rejected, in nanoseconds since the epoch.
+	Time uint64 `json:"time"`
+
+	// Txid The ID of the rejected transaction.
+	Txid string `json:"txid"`
+}
+
+// RoundPerformance The selection of a tracked account in a round, against what the block certificate of the round records of it.
+type RoundPerformance struct {
+	// Proposed Whether the block of the round was proposed by the account.
+	Proposed bool `json:"proposed"`
+
+	// ProposerSelected Whether the account was selected to propose a block.
+	ProposerSelected bool `json:"proposer-selected"`
+
+	// Round The round.
+	Round uint64 `json:"round"`
+
+	// Voted Whether the certificate of the round holds a vote of the account.
+	Voted bool `json:"voted"`
+
+	// VoterSelected Whether the account was selected to cast a certificate vote.
+	VoterSelected bool `json:"voter-selected"`
+}
+
+// ScratchChange A write operation into a scratch slot.
+type ScratchChange struct {
+	// NewValue Represents a TEAL value.
+	NewValue TealValue `json:"new-value"`
+
+	// Slot The scratch slot written.
+	Slot uint64 `json:"slot"`
+}
+
+// SimulateRequest Request type for simulation endpoint.
+type SimulateRequest struct {
+	// AllowEmptySignatures Allow transactions without signatures to be simulated as if they had correct signatures.
+	AllowEmptySignatures *bool `json:"allow-empty-signatures,omitempty"`
+
+	// AllowMoreLogging Lifts limits on log opcode usage during simulation.
+	AllowMoreLogging *bool `json:"allow-more-logging,omitempty"`
+
+	// Coverage Collect the coverage of the programs evaluated by the simulation, and return it as an lcov report. Programs with a source map in source-maps are reported against their sources.
+	Coverage *bool `json:"coverage,omitempty"`
+
+	// ExecTraceConfig An object that configures simulation execution trace.
+	ExecTraceConfig *SimulateTraceConfig `json:"exec-trace-config,omitempty"`
+
+	// ExtraOpcodeBudget Applies extra opcode budget during simulation for each transaction group.
+	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
+
+	// Profile Return a profile of the opcode budget and resources used by each app call, including its inner transactions.
+	Profile *bool `json:"profile,omitempty"`
+
+	// SourceMaps Source maps of programs of the simulated transactions. The execution traces of these programs are annotated with source locations.
+	SourceMaps *[]SimulateSourceMap `json:"source-maps,omitempty"`
+
+	// StateDiff Return the state diff of the transaction group: the changes of the balances, minimum balances, asset holdings, application states and boxes it makes. Not supported in simulation sessions.
+	StateDiff *bool `json:"state-diff,omitempty"`
+
+	// StateOverrides A hypothetical ledger state the transaction groups are simulated against, in place of the state of the latest round. Only accepted when the developer API is enabled.
+	StateOverrides *SimulationStateOverrides `json:"state-overrides,omitempty"`
+
+	// TxnGroups The transaction groups to simulate.
+	TxnGroups []SimulateRequestTransactionGroup `json:"txn-groups"`
+
+	// UnlimitedOpcodeBudget Lifts the opcode budget of the app calls, so that their cost can be measured however high it is. Only accepted when the developer API is enabled.
+	UnlimitedOpcodeBudget *bool `json:"unlimited-opcode-budget,omitempty"`
+}
+
+// SimulateRequestTransactionGroup A transaction group to simulate.
+type SimulateRequestTransactionGroup struct {
+	// Txns An atomic transaction group.
+	Txns []json.RawMessage `json:"txns"`
+}
+
+// SimulateSourceMap A source map of a program, used to annotate execution traces with source locations.
+type SimulateSourceMap struct {
+	// ProgramHash The hash of the program the source map describes, as returned by the compile endpoint.
+	ProgramHash string `json:"program-hash"`
+
+	// SourceMap The source map of the program, in the format produced by the compile endpoint.
+	SourceMap map[string]interface{} `json:"source-map"`
+}
+
+// SimulateTraceConfig An object that configures simulation execution trace.
+type SimulateTraceConfig struct {
+	// Enable A boolean option for opting in execution trace features simulation endpoint.
+	Enable *bool `json:"enable,omitempty"`
+
+	// ScratchChange A boolean option enabling returning scratch slot changes together with execution trace during simulation.
+	ScratchChange *bool `json:"scratch-change,omitempty"`
+
+	// StackChange A boolean option enabling returning stack changes together with execution trace during simulation.
+	StackChange *bool `json:"stack-change,omitempty"`
+
+	// StateChange A boolean option enabling returning application state changes (global, local, and box changes) with the execution trace during simulation.
+	StateChange *bool `json:"state-change,omitempty"`
+}
+
+// SimulateTransactionGroupResult Simulation result for an atomic transaction group
+type SimulateTransactionGroupResult struct {
+	// AppBudgetAdded Total budget added during execution of app calls in the transaction group.
+	AppBudgetAdded *uint64 `json:"app-budget-added,omitempty"`
+
+	// AppBudgetConsumed Total budget consumed during execution of app calls in the transaction group.
+	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
+
+	// FailedAt If present, indicates which transaction in this group caused the failure. This array represents the path to the failing transaction. Indexes are zero based, the first element indicates the top-level transaction, and successive elements indicate deeper inner transactions.
+	FailedAt *[]uint64 `json:"failed-at,omitempty"`
+
+	// FailureMessage If present, indicates that the transaction group failed and specifies why that happened
+	FailureMessage *string `json:"failure-message,omitempty"`
+
+	// TxnResults Simulation result for individual transactions
+	TxnResults []SimulateTransactionResult `json:"txn-results"`
+}
+
+// SimulateTransactionResult Simulation result for an individual transaction
+type SimulateTransactionResult struct {
+	// AppBudgetConsumed Budget used during execution of an app call transaction. This value includes budged used by inner app calls spawned by this transaction.
+	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
+
+	// ExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
+	ExecTrace *SimulationTransactionExecTrace `json:"exec-trace,omitempty"`
+
+	// LogicSigBudgetConsumed Budget used during execution of a logic sig transaction.
+	LogicSigBudgetConsumed *uint64 `json:"logic-sig-budget-consumed,omitempty"`
+
+	// Profile The opcode budget and resources used by an app call, including the inner transactions it issued, and the headroom left against the limits of its group.
+	Profile *SimulationTransactionProfile `json:"profile,omitempty"`
+
+	// TxnResult Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
+	TxnResult PendingTransactionResponse `json:"txn-result"`
+}
+
+// SimulationAccountDiff The change of the balance, or of the minimum balance, of an account made by a simulated transaction group.
+type SimulationAccountDiff struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// BalanceAfter The balance of the account after the transaction group, in microalgos.
+	BalanceAfter uint64 `json:"balance-after"`
+
+	// BalanceBefore The balance of the account before the transaction group, in microalgos.
+	BalanceBefore uint64 `json:"balance-before"`
+
+	// MinBalanceAfter The minimum balance of the account after the transaction group, in microalgos.
+	MinBalanceAfter uint64 `json:"min-balance-after"`
+
+	// MinBalanceBefore The minimum balance of the account before the transaction group, in microalgos.
+	MinBalanceBefore uint64 `json:"min-balance-before"`
+}
+
+// SimulationAccountOverride The balance of an account in the hypothetical state of a simulation.
+type SimulationAccountOverride struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// Amount The balance of the account, in microalgos.
+	Amount uint64 `json:"amount"`
+}
+
+// SimulationAppOverride The state of an application in the hypothetical state of a simulation. The given keys are set to the given values, the other keys keep their values.
+type SimulationAppOverride struct {
+	// AppId The application id.
+	AppId uint64 `json:"app-id"`
+
+	// GlobalState Represents a key-value store for use in an application.
+	GlobalState *TealKeyValueStore `json:"global-state,omitempty"`
+
+	// LocalStates The local states of accounts opted in the application.
+	LocalStates *[]SimulationLocalStateOverride `json:"local-states,omitempty"`
+}
+
+// SimulationAppStateDiff The change of a key of the global state of an application, or of the local state of an account in an application, made by a simulated transaction group.
+type SimulationAppStateDiff struct {
+	// Account For local state changes, the address of the account associated with the local state.
+	Account *string `json:"account,omitempty"`
+
+	// After Represents a TEAL value.
+	After *TealValue `json:"after,omitempty"`
+
+	// AppId The application id.
+	AppId uint64 `json:"app-id"`
+
+	// AppStateType Type of application state. Value `g` is **global state**, `l` is **local state**.
+	AppStateType string `json:"app-state-type"`
+
+	// Before Represents a TEAL value.
+	Before *TealValue `json:"before,omitempty"`
+
+	// Key The key of the state.
+	Key []byte `json:"key"`
+}
+
+// SimulationAssetHoldingDiff The change of the holding of an asset by an account made by a simulated transaction group.
+type SimulationAssetHoldingDiff struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// AmountAfter The amount of the asset held after the transaction group.
+	AmountAfter uint64 `json:"amount-after"`
+
+	// AmountBefore The amount of the asset held before the transaction group.
+	AmountBefore uint64 `json:"amount-before"`
+
+	// AssetId The asset id.
+	AssetId uint64 `json:"asset-id"`
+
+	// OptedIn Whether the account opted into the asset.
+	OptedIn *bool `json:"opted-in,omitempty"`
+
+	// OptedOut Whether the account opted out of the asset.
+	OptedOut *bool `json:"opted-out,omitempty"`
+}
+
+// SimulationBoxDiff The change of a box made by a simulated transaction group.
+type SimulationBoxDiff struct {
+	// AppId The application id the box belongs to.
+	AppId uint64 `json:"app-id"`
+
+	// Name The box name, base64 encoded.
+	Name []byte `json:"name"`
+
+	// SizeAfter The size of the box after the transaction group, omitted when the box was deleted.
+	SizeAfter *uint64 `json:"size-after,omitempty"`
+
+	// SizeBefore The size of the box before the transaction group, omitted when the box was created.
+	SizeBefore *uint64 `json:"size-before,omitempty"`
+}
+
+// SimulationBoxOverride The contents of a box in the hypothetical state of a simulation. The box is created if it does not exist.
+type SimulationBoxOverride struct {
+	// AppId The application id the box belongs to.
+	AppId uint64 `json:"app-id"`
+
+	// Name The box name, base64 encoded.
+	Name []byte `json:"name"`
+
+	// Value The box value, base64 encoded.
+	Value []byte `json:"value"`
+}
+
+// SimulationEvalOverrides The set of parameters and limits override during simulation. If this set of parameters is present, then evaluation parameters may differ from standard evaluation in certain ways.
+type SimulationEvalOverrides struct {
+	// AllowEmptySignatures If true, transactions without signatures are allowed and simulated as if they were properly signed.
+	AllowEmptySignatures *bool `json:"allow-empty-signatures,omitempty"`
+
+	// ExtraOpcodeBudget The extra opcode budget added to each transaction group during simulation
+	ExtraOpcodeBudget *uint64 `json:"extra-opcode-budget,omitempty"`
+
+	// MaxLogCalls The maximum log calls one can make during simulation
+	MaxLogCalls *uint64 `json:"max-log-calls,omitempty"`
+
+	// MaxLogSize The maximum byte number to log during simulation
 	MaxLogSize *uint64 `json:"max-log-size,omitempty"`
+
+	// UnlimitedOpcodeBudget If true, the opcode budget of the app calls was lifted during simulation.
+	UnlimitedOpcodeBudget *bool `json:"unlimited-opcode-budget,omitempty"`
+}
+
+// SimulationLocalStateOverride The local state of an account opted in an application, in the hypothetical state of a simulation.
+type SimulationLocalStateOverride struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// KeyValue Represents a key-value store for use in an application.
+	KeyValue TealKeyValueStore `json:"key-value"`
+}
+
+// SimulationOpcodeCategoryCost The opcode budget consumed by the opcodes of a category.
+type SimulationOpcodeCategoryCost struct {
+	// Category The opcode category, as grouped in the opcode documentation.
+	Category string `json:"category"`
+
+	// Cost The opcode budget consumed by the opcodes of the category.
+	Cost uint64 `json:"cost"`
 }
 
 // SimulationOpcodeTraceUnit The set of trace information and effect from evaluating a single opcode.
@@ -713,8 +1512,68 @@ type SimulationOpcodeTraceUnit struct {
 	// Pc The program counter of the current opcode being evaluated.
 	Pc uint64 `json:"pc"`
 
+	// ScratchChanges The writes into scratch slots.
+	ScratchChanges *[]ScratchChange `json:"scratch-changes,omitempty"`
+
+	// SourceLocation A location in the source code of a program, resolved from the source map provided for the program.
+	SourceLocation *SimulationSourceLocation `json:"source-location,omitempty"`
+
 	// SpawnedInners The indexes of the traces for inner transactions spawned by this opcode, if any.
 	SpawnedInners *[]uint64 `json:"spawned-inners,omitempty"`
+
+	// StackAdditions The values added by this opcode to the stack.
+	StackAdditions *[]TealValue `json:"stack-additions,omitempty"`
+
+	// StackPopCount The number of deleted stack values by this opcode.
+	StackPopCount *uint64 `json:"stack-pop-count,omitempty"`
+
+	// StateChanges The operations against the current application's states.
+	StateChanges *[]ApplicationStateOperation `json:"state-changes,omitempty"`
+}
+
+// SimulationSourceLocation A location in the source code of a program, resolved from the source map provided for the program.
+type SimulationSourceLocation struct {
+	// Column The zero-based column in the source line.
+	Column uint64 `json:"column"`
+
+	// File The source file, as listed in the source map.
+	File string `json:"file"`
+
+	// Line The zero-based line in the source file.
+	Line uint64 `json:"line"`
+}
+
+// SimulationStateDiff The changes of the ledger state made by a simulated transaction group, computed when the group succeeded.
+type SimulationStateDiff struct {
+	// Accounts The accounts whose balance or minimum balance changed.
+	Accounts []SimulationAccountDiff `json:"accounts"`
+
+	// AppStates The keys of the application states which were written or deleted.
+	AppStates []SimulationAppStateDiff `json:"app-states"`
+
+	// AssetHoldings The asset holdings which changed, including the opt-ins and opt-outs.
+	AssetHoldings []SimulationAssetHoldingDiff `json:"asset-holdings"`
+
+	// Boxes The boxes which were created, resized, written or deleted.
+	Boxes []SimulationBoxDiff `json:"boxes"`
+}
+
+// SimulationStateOverrides A hypothetical ledger state the transaction groups are simulated against, in place of the state of the latest round. Only accepted when the developer API is enabled.
+type SimulationStateOverrides struct {
+	// Accounts The balances of accounts.
+	Accounts *[]SimulationAccountOverride `json:"accounts,omitempty"`
+
+	// Apps The global and local states of applications.
+	Apps *[]SimulationAppOverride `json:"apps,omitempty"`
+
+	// Boxes The contents of boxes.
+	Boxes *[]SimulationBoxOverride `json:"boxes,omitempty"`
+
+	// LatestTimestamp The timestamp of the latest block, in seconds since the epoch.
+	LatestTimestamp *uint64 `json:"latest-timestamp,omitempty"`
+
+	// Round The round the transaction groups are simulated in. Duplicate transactions and leases are not checked when it is set.
+	Round *uint64 `json:"round,omitempty"`
 }
 
 // SimulationTransactionExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
@@ -732,6 +1591,54 @@ type SimulationTransactionExecTrace struct {
 	LogicSigTrace *[]SimulationOpcodeTraceUnit `json:"logic-sig-trace,omitempty"`
 }
 
+// SimulationTransactionProfile The opcode budget and resources used by an app call, including the inner transactions it issued, and the headroom left against the limits of its group.
+type SimulationTransactionProfile struct {
+	// AppBudgetRemaining The opcode budget left to the transaction group after the transaction.
+	AppBudgetRemaining uint64 `json:"app-budget-remaining"`
+
+	// BoxReadBytes The number of box bytes read.
+	BoxReadBytes uint64 `json:"box-read-bytes"`
+
+	// BoxWriteBytes The number of box bytes written.
+	BoxWriteBytes uint64 `json:"box-write-bytes"`
+
+	// InnerTxnCount The number of inner transactions issued, at any depth.
+	InnerTxnCount uint64 `json:"inner-txn-count"`
+
+	// InnerTxnsRemaining The number of inner transactions the transaction group may still issue, if they are pooled across the group.
+	InnerTxnsRemaining *uint64 `json:"inner-txns-remaining,omitempty"`
+
+	// LogBytes The number of bytes logged, including by inner app calls.
+	LogBytes uint64 `json:"log-bytes"`
+
+	// LogBytesRemaining The number of bytes the program of the transaction could still have logged.
+	LogBytesRemaining uint64 `json:"log-bytes-remaining"`
+
+	// OpcodeCosts The opcode budget consumed by app programs, by opcode category.
+	OpcodeCosts []SimulationOpcodeCategoryCost `json:"opcode-costs"`
+}
+
+// SortitionVote A certificate vote together with the sortition details needed to re-evaluate it.
+type SortitionVote struct {
+	// Address Address of the voter.
+	Address string `json:"address"`
+
+	// Output VRF output of the proof, evaluated over the vrf-message.
+	Output []byte `json:"output"`
+
+	// Proof VRF proof of the vote credential.
+	Proof []byte `json:"proof"`
+
+	// SelectionKey VRF public key of the voter at the balance round.
+	SelectionKey []byte `json:"selection-key"`
+
+	// Stake Online stake of the voter at the balance round, in microAlgos.
+	Stake uint64 `json:"stake"`
+
+	// Weight Number of committee seats the voter was selected for.
+	Weight uint64 `json:"weight"`
+}
+
 // StateDelta Application state delta.
 type StateDelta = []EvalDeltaKeyValue
 
@@ -762,6 +1669,42 @@ type StateProofMessage struct {
 	VotersCommitment []byte `json:"VotersCommitment"`
 }
 
+// StateProofMissingParticipant A state proof voter whose signature has not been collected yet.
+type StateProofMissingParticipant struct {
+	// Address Address of the voter.
+	Address string `json:"address"`
+
+	// Weight Online weight of the voter, in microalgos.
+	Weight uint64 `json:"weight"`
+}
+
+// StateProofProverStatus Signatures collected by the node for a state proof.
+type StateProofProverStatus struct {
+	// AcceptableWeight Signed weight the state proof needs in order to be accepted at the latest round.
+	AcceptableWeight uint64 `json:"acceptable-weight"`
+
+	// MissingParticipants Heaviest voters that have not signed yet, heaviest first.
+	MissingParticipants []StateProofMissingParticipant `json:"missing-participants"`
+
+	// Participants Number of voters of the state proof.
+	Participants uint64 `json:"participants"`
+
+	// ProvenWeight Weight the signatures must exceed for the state proof to be created.
+	ProvenWeight uint64 `json:"proven-weight"`
+
+	// Round Last round attested by the state proof.
+	Round uint64 `json:"round"`
+
+	// SignedWeight Total weight of the signatures collected so far.
+	SignedWeight uint64 `json:"signed-weight"`
+
+	// Signers Number of voters whose signature has been collected.
+	Signers uint64 `json:"signers"`
+
+	// TotalWeight Online weight of all the voters of the state proof.
+	TotalWeight uint64 `json:"total-weight"`
+}
+
 // TealKeyValue Represents a key-value pair in an application store.
 type TealKeyValue struct {
 	Key string `json:"key"`
@@ -785,6 +1728,33 @@ type TealValue struct {
 	Uint uint64 `json:"uint"`
 }
 
+// TransactionBatchGroup A transaction group to broadcast.
+type TransactionBatchGroup struct {
+	// Txns An atomic transaction group.
+	Txns []json.RawMessage `json:"txns"`
+}
+
+// TransactionBatchRequest Request type for the batch transaction submission endpoint.
+type TransactionBatchRequest struct {
+	// TxnGroups The independent transaction groups to broadcast.
+	TxnGroups []TransactionBatchGroup `json:"txn-groups"`
+}
+
+// TransactionBatchResult The admission result of a single transaction group of a batch.
+type TransactionBatchResult struct {
+	// Accepted Whether the transaction group was accepted into the transaction pool.
+	Accepted bool `json:"accepted"`
+
+	// Code The code of the reason the transaction group was rejected, if it was: overspend, min-balance, asset-frozen, logic-eval, txn-dead, group-mismatch, already-in-ledger, lease-in-use, fee-too-low, pool-full, not-well-formed, invalid-signature or other.
+	Code *string `json:"code,omitempty"`
+
+	// Message The reason the transaction group was rejected, if it was.
+	Message *string `json:"message,omitempty"`
+
+	// TxId encoding of the hash of the first transaction of the group.
+	TxId *string `json:"txId,omitempty"`
+}
+
 // Version algod version information.
 type Version struct {
 	Build          BuildVersion `json:"build"`
@@ -820,9 +1790,15 @@ type CurrencyGreaterThan uint64
 // CurrencyLessThan defines model for currency-less-than.
 type CurrencyLessThan uint64
 
+// DecodeEvents defines model for decode-events.
+type DecodeEvents = bool
+
 // ExcludeCloseTo defines model for exclude-close-to.
 type ExcludeCloseTo = bool
 
+// Fields defines model for fields.
+type Fields = string
+
 // Format defines model for format.
 type Format string
 
@@ -850,6 +1826,9 @@ type Round uint64
 // RoundNumber defines model for round-number.
 type RoundNumber uint64
 
+// SessionId defines model for session-id.
+type SessionId = string
+
 // SigType defines model for sig-type.
 type SigType string
 
@@ -859,6 +1838,45 @@ type TxID = string
 // TxType defines model for tx-type.
 type TxType string
 
+// APITokenResponse defines model for APITokenResponse.
+type APITokenResponse struct {
+	// Name The name of the token.
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+
+	// Token The token to provide in the X-Algo-API-Token header.
+	Token string `json:"token"`
+}
+
+// APITokensResponse defines model for APITokensResponse.
+type APITokensResponse struct {
+	Tokens []APIToken `json:"tokens"`
+}
+
+// AbsenceProofResponse defines model for AbsenceProofResponse.
+type AbsenceProofResponse struct {
+	// LowerAddress The committed address preceding the address. Omitted when the address precedes all the committed addresses, the leaf being the lower bound of the commitment.
+	LowerAddress *string `json:"lower-address,omitempty"`
+
+	// LowerIndex The index of the commitment leaf preceding the address.
+	LowerIndex uint64 `json:"lower-index"`
+
+	// Proof The msgpack encoding of the absence proof, which holds the merkle proof of the two leaves.
+	Proof []byte `json:"proof"`
+
+	// Root The root of the commitment to the addresses of the accounts of the round, or of the accounts holding the asset or opted in the application, which the proof is verified against. The commitment is built by the node, it is not part of the consensus state.
+	Root []byte `json:"root"`
+
+	// Round The round the absence is proven at.
+	Round uint64 `json:"round"`
+
+	// UpperAddress The committed address following the address. Omitted when the address follows all the committed addresses, the leaf being the upper bound of the commitment.
+	UpperAddress *string `json:"upper-address,omitempty"`
+
+	// UpperIndex The index of the commitment leaf following the address, adjacent to the lower one.
+	UpperIndex uint64 `json:"upper-index"`
+}
+
 // AccountApplicationResponse defines model for AccountApplicationResponse.
 type AccountApplicationResponse struct {
 	// AppLocalState Stores local state associated with an application.
@@ -891,24 +1909,178 @@ type AccountAssetResponse struct {
 	Round uint64 `json:"round"`
 }
 
+// AccountAssetsInformationResponse defines model for AccountAssetsInformationResponse.
+type AccountAssetsInformationResponse struct {
+	Assets []AccountAssetResource `json:"assets"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountCreatedApplicationsResponse defines model for AccountCreatedApplicationsResponse.
+type AccountCreatedApplicationsResponse struct {
+	CreatedApps []Application `json:"created-apps"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountCreatedAssetsResponse defines model for AccountCreatedAssetsResponse.
+type AccountCreatedAssetsResponse struct {
+	CreatedAssets []Asset `json:"created-assets"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountPendingResponse defines model for AccountPendingResponse.
+type AccountPendingResponse struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// Amount The projected balance of the account in microalgos, including its pending rewards.
+	Amount uint64 `json:"amount"`
+
+	// Assets The projected asset holdings of the account, ordered by asset ID.
+	Assets []AssetHolding `json:"assets"`
+
+	// MinBalance The projected minimum balance of the account in microalgos.
+	MinBalance uint64 `json:"min-balance"`
+
+	// Round The round of the block the pending transactions of the transaction pool are applied to, following the latest round of the ledger.
+	Round uint64 `json:"round"`
+}
+
+// AccountPerformanceResponse defines model for AccountPerformanceResponse.
+type AccountPerformanceResponse struct {
+	// Accounts The tracked accounts, ordered by address.
+	Accounts []AccountPerformance `json:"accounts"`
+}
+
 // AccountResponse Account information at a given round.
 //
 // Definition:
 // data/basics/userBalance.go : AccountData
 type AccountResponse = Account
 
+// AccountRekeyHistoryResponse defines model for AccountRekeyHistoryResponse.
+type AccountRekeyHistoryResponse struct {
+	// Address The address of the account.
+	Address string `json:"address"`
+
+	// AuthAddr The address currently authorizing the transactions of the account. Omitted when the account is controlled by its own key.
+	AuthAddr *string `json:"auth-addr,omitempty"`
+
+	// HistoryStartRound The first round covered by the history: the rekeys of the earlier rounds are not known to the node.
+	HistoryStartRound uint64 `json:"history-start-round"`
+
+	// Rekeys The changes of the authorizing address of the account, oldest first.
+	Rekeys []AccountRekey `json:"rekeys"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountsDatabaseOptimizeResponse defines model for AccountsDatabaseOptimizeResponse.
+type AccountsDatabaseOptimizeResponse struct {
+	// FreePages The number of unused pages of the accounts database when last measured.
+	FreePages uint64 `json:"free-pages"`
+
+	// Incremental Whether the accounts database is in the incremental auto_vacuum mode, which its unused pages can only be released in.
+	Incremental bool `json:"incremental"`
+
+	// LastEnd The time the last optimization ended, in seconds since the epoch. Omitted while it runs.
+	LastEnd *uint64 `json:"last-end,omitempty"`
+
+	// LastError The error the last optimization failed with, if any.
+	LastError *string `json:"last-error,omitempty"`
+
+	// LastStart The time the last optimization started, in seconds since the epoch.
+	LastStart *uint64 `json:"last-start,omitempty"`
+
+	// NextRun The earliest time of the next scheduled optimization, in seconds since the epoch. Omitted if none is scheduled.
+	NextRun *uint64 `json:"next-run,omitempty"`
+
+	// Pages The number of pages of the accounts database when last measured.
+	Pages uint64 `json:"pages"`
+
+	// Pending Whether an optimization was requested and waits for the ledger commits to be idle.
+	Pending bool `json:"pending"`
+
+	// ReleasedPages The number of unused pages released by the last optimization, so far while it runs.
+	ReleasedPages uint64 `json:"released-pages"`
+
+	// Running Whether an optimization is in progress.
+	Running bool `json:"running"`
+
+	// Runs The number of optimizations completed since the ledger was loaded.
+	Runs uint64 `json:"runs"`
+}
+
 // ApplicationResponse Application index and its parameters
 type ApplicationResponse = Application
 
+// ApplicationOptInsResponse defines model for ApplicationOptInsResponse.
+type ApplicationOptInsResponse struct {
+	Accounts []ApplicationOptIn `json:"accounts"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// ApplicationStateDeltasResponse defines model for ApplicationStateDeltasResponse.
+type ApplicationStateDeltasResponse struct {
+	// Applications The state changes of the watched applications which changed in the round, ordered by application ID.
+	Applications []ApplicationStateDelta `json:"applications"`
+
+	// Round The round of the state changes.
+	Round uint64 `json:"round"`
+}
+
+// AssetHoldersResponse defines model for AssetHoldersResponse.
+type AssetHoldersResponse struct {
+	Holders []AssetHolder `json:"holders"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
 // AssetResponse Specifies both the unique identifier and the parameters for an asset
 type AssetResponse = Asset
 
+// BlockCertificateResponse defines model for BlockCertificateResponse.
+type BlockCertificateResponse struct {
+	// Cert Certificate of the agreement on the block. It is empty for the genesis block.
+	Cert map[string]interface{} `json:"cert"`
+}
+
 // BlockHashResponse defines model for BlockHashResponse.
 type BlockHashResponse struct {
 	// BlockHash Block header hash.
 	BlockHash string `json:"blockHash"`
 }
 
+// BlockHeaderResponse defines model for BlockHeaderResponse.
+type BlockHeaderResponse struct {
+	// BlockHeader Block header data.
+	BlockHeader map[string]interface{} `json:"blockHeader"`
+}
+
 // BlockResponse defines model for BlockResponse.
 type BlockResponse struct {
 	// Block Block header data.
@@ -918,6 +2090,45 @@ type BlockResponse struct {
 	Cert *map[string]interface{} `json:"cert,omitempty"`
 }
 
+// BlockSortitionResponse defines model for BlockSortitionResponse.
+type BlockSortitionResponse struct {
+	// BalanceRound Round whose online balances were used for the sortition.
+	BalanceRound uint64 `json:"balance-round"`
+
+	// OnlineMoney Total online stake used for the sortition, in microAlgos.
+	OnlineMoney uint64 `json:"online-money"`
+
+	// Period Agreement period in which the block was certified.
+	Period uint64 `json:"period"`
+
+	// ProposalPeriod Period in which the block was originally proposed.
+	ProposalPeriod uint64 `json:"proposal-period"`
+
+	// Proposer Address of the account that proposed the block.
+	Proposer string `json:"proposer"`
+
+	// Round Round of the block.
+	Round uint64 `json:"round"`
+
+	// Seed Seed of the block, used for the sortition of later rounds.
+	Seed []byte `json:"seed"`
+
+	// SeedRound Round whose seed was used for the sortition.
+	SeedRound uint64 `json:"seed-round"`
+
+	// SelectionSeed Seed used for the sortition of the certificate committee.
+	SelectionSeed []byte `json:"selection-seed"`
+
+	// Step Agreement step of the certificate votes.
+	Step uint64 `json:"step"`
+
+	// Votes Certificate votes of the block.
+	Votes []SortitionVote `json:"votes"`
+
+	// VrfMessage Message the VRF proofs of the votes are evaluated over.
+	VrfMessage []byte `json:"vrf-message"`
+}
+
 // BoxResponse Box name and its content.
 type BoxResponse = Box
 
@@ -950,6 +2161,12 @@ type CompileResponse struct {
 	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
+// DevModeAdvanceResponse defines model for DevModeAdvanceResponse.
+type DevModeAdvanceResponse struct {
+	// Round The round of the last block produced.
+	Round uint64 `json:"round"`
+}
+
 // DisassembleResponse defines model for DisassembleResponse.
 type DisassembleResponse struct {
 	// Result disassembled Teal code
@@ -965,6 +2182,19 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// FeeEstimateResponse defines model for FeeEstimateResponse.
+type FeeEstimateResponse struct {
+	// Estimates The fee estimates, in increasing order of target rounds.
+	Estimates []FeeEstimate `json:"estimates"`
+
+	// LastRound The round the estimates were computed at.
+	LastRound uint64 `json:"last-round"`
+
+	// MinFee The minimum transaction fee (not per byte) required for the
+	// txn to validate for the current network protocol.
+	MinFee uint64 `json:"min-fee"`
+}
+
 // GetBlockTimeStampOffsetResponse defines model for GetBlockTimeStampOffsetResponse.
 type GetBlockTimeStampOffsetResponse struct {
 	// Offset Timestamp offset in seconds.
@@ -983,9 +2213,42 @@ type LedgerStateDeltaForTransactionGroupResponse = LedgerStateDelta
 // LedgerStateDeltaResponse Ledger StateDelta object
 type LedgerStateDeltaResponse = LedgerStateDelta
 
+// LedgerTotalsResponse defines model for LedgerTotalsResponse.
+type LedgerTotalsResponse struct {
+	// CurrentRound The latest round of the ledger.
+	CurrentRound uint64 `json:"current-round"`
+
+	// HistoryStartRound The first round the totals are kept for: the totals of the earlier rounds are not known.
+	HistoryStartRound uint64 `json:"history-start-round"`
+
+	// Totals The totals, ordered by round.
+	Totals []LedgerTotals `json:"totals"`
+}
+
 // LightBlockHeaderProofResponse Proof of membership and position of a light block header.
 type LightBlockHeaderProofResponse = LightBlockHeaderProof
 
+// LoggingResponse defines model for LoggingResponse.
+type LoggingResponse struct {
+	// AdditionalOutputFile The file the node logs to in addition to its log file, if any.
+	AdditionalOutputFile *string `json:"additional-output-file,omitempty"`
+
+	// Level The level of the node logger: 0 panic, 1 fatal, 2 error, 3 warning, 4 info and 5 debug.
+	Level uint64 `json:"level"`
+
+	// Subsystems The subsystems whose logging can be disabled, ordered by name.
+	Subsystems []LoggingSubsystem `json:"subsystems"`
+}
+
+// MetricsResponse defines model for MetricsResponse.
+type MetricsResponse struct {
+	// MaxLabelSets The maximum number of series each metric reports, 0 for no limit.
+	MaxLabelSets uint64 `json:"max-label-sets"`
+
+	// Metrics The registered metrics, ordered by name.
+	Metrics []MetricDescription `json:"metrics"`
+}
+
 // NodeStatusResponse NodeStatus contains the information about a node status
 type NodeStatusResponse struct {
 	// Catchpoint The current catchpoint that is being caught up to
@@ -1067,12 +2330,47 @@ type NodeStatusResponse struct {
 	UpgradeYesVotes *uint64 `json:"upgrade-yes-votes,omitempty"`
 }
 
+// ParticipationKeyExportResponse defines model for ParticipationKeyExportResponse.
+type ParticipationKeyExportResponse struct {
+	// Participation The participation key with its secrets.
+	Participation map[string]interface{} `json:"participation"`
+}
+
 // ParticipationKeyResponse Represents a participation key used by the node.
 type ParticipationKeyResponse = ParticipationKey
 
+// ParticipationKeyregResponse defines model for ParticipationKeyregResponse.
+type ParticipationKeyregResponse struct {
+	// Txn The unsigned keyreg transaction.
+	Txn map[string]interface{} `json:"txn"`
+}
+
 // ParticipationKeysResponse defines model for ParticipationKeysResponse.
 type ParticipationKeysResponse = []ParticipationKey
 
+// ParticipationSummaryResponse defines model for ParticipationSummaryResponse.
+type ParticipationSummaryResponse struct {
+	Keys []ParticipationKeySummary `json:"keys"`
+
+	// OnlineStake Total online stake, in microalgos.
+	OnlineStake uint64 `json:"online-stake"`
+
+	// Round The round the summary was computed at.
+	Round uint64 `json:"round"`
+
+	// Window Number of rounds whose certificates were scanned.
+	Window uint64 `json:"window"`
+}
+
+// PeersResponse defines model for PeersResponse.
+type PeersResponse struct {
+	// Incoming The peers that dialed the node.
+	Incoming []PeerConnection `json:"incoming"`
+
+	// Outgoing The peers the node dialed.
+	Outgoing []PeerConnection `json:"outgoing"`
+}
+
 // PendingTransactionsResponse PendingTransactions is an array of signed transactions exactly as they were submitted.
 type PendingTransactionsResponse struct {
 	// TopTransactions An array of signed transaction objects.
@@ -1088,14 +2386,68 @@ type PostParticipationResponse struct {
 	PartId string `json:"partId"`
 }
 
+// PostPrivateTransactionsResponse defines model for PostPrivateTransactionsResponse.
+type PostPrivateTransactionsResponse struct {
+	// Relays The number of private relays which accepted the transaction group in time.
+	Relays uint64 `json:"relays"`
+
+	// TxId encoding of the transaction hash.
+	TxId string `json:"txId"`
+}
+
 // PostTransactionsResponse defines model for PostTransactionsResponse.
 type PostTransactionsResponse struct {
 	// TxId encoding of the transaction hash.
 	TxId string `json:"txId"`
 }
 
+// ProfileCaptureResponse A profile of the node captured on demand.
+type ProfileCaptureResponse = ProfileCapture
+
+// ProfileCapturesResponse defines model for ProfileCapturesResponse.
+type ProfileCapturesResponse struct {
+	// Captures The captures, oldest first.
+	Captures []ProfileCapture `json:"captures"`
+}
+
+// ProposalAssemblyResponse defines model for ProposalAssemblyResponse.
+type ProposalAssemblyResponse struct {
+	// Proposals The assemblies, oldest first.
+	Proposals []ProposalAssembly `json:"proposals"`
+}
+
+// ProtocolUpgradeResponse defines model for ProtocolUpgradeResponse.
+type ProtocolUpgradeResponse struct {
+	// CurrentProtocol The protocol of the round.
+	CurrentProtocol string `json:"current-protocol"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+
+	// Upgrade A consensus protocol upgrade, either being voted on or approved and scheduled.
+	Upgrade *ProtocolUpgrade `json:"upgrade,omitempty"`
+}
+
+// RegisterContractResponse defines model for RegisterContractResponse.
+type RegisterContractResponse struct {
+	// ApplicationIds The applications whose logs are decoded with the events of the contract.
+	ApplicationIds []uint64 `json:"application-ids"`
+
+	// Events The number of events of the contract.
+	Events uint64 `json:"events"`
+}
+
+// ReplayRejectionsResponse defines model for ReplayRejectionsResponse.
+type ReplayRejectionsResponse struct {
+	// Rejections The rejections, oldest first.
+	Rejections []ReplayRejection `json:"rejections"`
+}
+
 // SimulateResponse defines model for SimulateResponse.
 type SimulateResponse struct {
+	// CoverageReport The lcov report of the coverage of the programs evaluated by the simulation, if requested.
+	CoverageReport *string `json:"coverage-report,omitempty"`
+
 	// EvalOverrides The set of parameters and limits override during simulation. If this set of parameters is present, then evaluation parameters may differ from standard evaluation in certain ways.
 	EvalOverrides *SimulationEvalOverrides `json:"eval-overrides,omitempty"`
 
@@ -1105,6 +2457,9 @@ type SimulateResponse struct {
 	// LastRound The round immediately preceding this simulation. State changes through this round were used to run this simulation.
 	LastRound uint64 `json:"last-round"`
 
+	// StateDiff The changes of the ledger state made by a simulated transaction group, computed when the group succeeded.
+	StateDiff *SimulationStateDiff `json:"state-diff,omitempty"`
+
 	// TxnGroups A result object for each transaction group that was simulated.
 	TxnGroups []SimulateTransactionGroupResult `json:"txn-groups"`
 
@@ -1112,9 +2467,42 @@ type SimulateResponse struct {
 	Version uint64 `json:"version"`
 }
 
+// SimulationSessionResponse defines model for SimulationSessionResponse.
+type SimulationSessionResponse struct {
+	// AppliedGroups The number of transaction groups successfully applied to the session.
+	AppliedGroups uint64 `json:"applied-groups"`
+
+	// Round The round the session is pinned at. State changes through this round are used to simulate the transaction groups of the session.
+	Round uint64 `json:"round"`
+
+	// SessionId The ID used to refer to the simulation session.
+	SessionId string `json:"session-id"`
+}
+
 // StateProofResponse Represents a state proof and its corresponding message
 type StateProofResponse = StateProof
 
+// SnapshotResponse defines model for SnapshotResponse.
+type SnapshotResponse struct {
+	// Round The round of the ledger in the snapshot.
+	Round uint64 `json:"round"`
+}
+
+// StateProofStatusResponse defines model for StateProofStatusResponse.
+type StateProofStatusResponse struct {
+	// DbSize Size in bytes of the state proof database.
+	DbSize uint64 `json:"db-size"`
+
+	// LatestRound The latest round of the ledger.
+	LatestRound uint64 `json:"latest-round"`
+
+	// NextStateProofRound Next round the network expects to be attested by a state proof.
+	NextStateProofRound uint64 `json:"next-state-proof-round"`
+
+	// Provers State proofs the node is collecting signatures for, sorted by round.
+	Provers []StateProofProverStatus `json:"provers"`
+}
+
 // SupplyResponse Supply represents the current supply of MicroAlgos in the system
 type SupplyResponse struct {
 	// CurrentRound Round
@@ -1127,6 +2515,11 @@ type SupplyResponse struct {
 	TotalMoney uint64 `json:"total-money"`
 }
 
+// TransactionBatchResponse defines model for TransactionBatchResponse.
+type TransactionBatchResponse struct {
+	Results []TransactionBatchResult `json:"results"`
+}
+
 // TransactionGroupLedgerStateDeltasForRoundResponse defines model for TransactionGroupLedgerStateDeltasForRoundResponse.
 type TransactionGroupLedgerStateDeltasForRoundResponse struct {
 	Deltas []LedgerStateDeltaForTransactionGroup `json:"Deltas"`
@@ -1187,11 +2580,20 @@ type TransactionProofResponseHashtype string
 // VersionsResponse algod version information.
 type VersionsResponse = Version
 
+// WatchedApplicationsResponse defines model for WatchedApplicationsResponse.
+type WatchedApplicationsResponse struct {
+	// Applications The watched applications, in increasing order.
+	Applications []uint64 `json:"applications"`
+}
+
 // AccountInformationParams defines parameters for AccountInformation.
 type AccountInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *AccountInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 
+	// Fields Comma separated list of the response fields to return, other fields being omitted. Nested fields are selected with a dot separated path, such as `block.rnd`. Only supported with the JSON format.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Exclude When set to `all` will exclude asset holdings, application local state, created asset parameters, any created application parameters. Defaults to `none`.
 	Exclude *AccountInformationParamsExclude `form:"exclude,omitempty" json:"exclude,omitempty"`
 }
@@ -1202,6 +2604,18 @@ type AccountInformationParamsFormat string
 // AccountInformationParamsExclude defines parameters for AccountInformation.
 type AccountInformationParamsExclude string
 
+// AccountAbsenceProofParams defines parameters for AccountAbsenceProof.
+type AccountAbsenceProofParams struct {
+	// Round The round the absence is proven at. The absence of an account is only proven at the accounts round of the latest catchpoint, its default. The absence of a holder is proven at the recent rounds kept in memory by the node, the latest round by default.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+
+	// AssetId Prove that the account holds no such asset instead.
+	AssetId *uint64 `form:"asset-id,omitempty" json:"asset-id,omitempty"`
+
+	// ApplicationId Prove that the account has no local state of this application instead.
+	ApplicationId *uint64 `form:"application-id,omitempty" json:"application-id,omitempty"`
+}
+
 // AccountApplicationInformationParams defines parameters for AccountApplicationInformation.
 type AccountApplicationInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
@@ -1211,6 +2625,15 @@ type AccountApplicationInformationParams struct {
 // AccountApplicationInformationParamsFormat defines parameters for AccountApplicationInformation.
 type AccountApplicationInformationParamsFormat string
 
+// AccountAssetsInformationParams defines parameters for AccountAssetsInformation.
+type AccountAssetsInformationParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
 // AccountAssetInformationParams defines parameters for AccountAssetInformation.
 type AccountAssetInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
@@ -1220,6 +2643,24 @@ type AccountAssetInformationParams struct {
 // AccountAssetInformationParamsFormat defines parameters for AccountAssetInformation.
 type AccountAssetInformationParamsFormat string
 
+// AccountCreatedApplicationsParams defines parameters for AccountCreatedApplications.
+type AccountCreatedApplicationsParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// AccountCreatedAssetsParams defines parameters for AccountCreatedAssets.
+type AccountCreatedAssetsParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
 // GetPendingTransactionsByAddressParams defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParams struct {
 	// Max Truncated number of transactions to display. If max=0, returns all pending txns.
@@ -1227,6 +2668,9 @@ type GetPendingTransactionsByAddressParams struct {
 
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *GetPendingTransactionsByAddressParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Fields Comma separated list of the response fields to return, other fields being omitted. Nested fields are selected with a dot separated path, such as `block.rnd`. Only supported with the JSON format.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // GetPendingTransactionsByAddressParamsFormat defines parameters for GetPendingTransactionsByAddress.
@@ -1244,15 +2688,54 @@ type GetApplicationBoxesParams struct {
 	Max *uint64 `form:"max,omitempty" json:"max,omitempty"`
 }
 
+// GetApplicationOptInsParams defines parameters for GetApplicationOptIns.
+type GetApplicationOptInsParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// GetAssetHoldersParams defines parameters for GetAssetHolders.
+type GetAssetHoldersParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
 // GetBlockParams defines parameters for GetBlock.
 type GetBlockParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *GetBlockParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Fields Comma separated list of the response fields to return, other fields being omitted. Nested fields are selected with a dot separated path, such as `block.rnd`. Only supported with the JSON format.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // GetBlockParamsFormat defines parameters for GetBlock.
 type GetBlockParamsFormat string
 
+// GetBlockCertificateParams defines parameters for GetBlockCertificate.
+type GetBlockCertificateParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetBlockCertificateParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBlockCertificateParamsFormat defines parameters for GetBlockCertificate.
+type GetBlockCertificateParamsFormat string
+
+// GetBlockHeaderParams defines parameters for GetBlockHeader.
+type GetBlockHeaderParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetBlockHeaderParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBlockHeaderParamsFormat defines parameters for GetBlockHeader.
+type GetBlockHeaderParamsFormat string
+
 // GetTransactionProofParams defines parameters for GetTransactionProof.
 type GetTransactionProofParams struct {
 	// Hashtype The type of hash function used to create the proof, must be one of:
@@ -1270,6 +2753,30 @@ type GetTransactionProofParamsHashtype string
 // GetTransactionProofParamsFormat defines parameters for GetTransactionProof.
 type GetTransactionProofParamsFormat string
 
+// RegisterContractJSONBody defines parameters for RegisterContract.
+type RegisterContractJSONBody = map[string]interface{}
+
+// RegisterContractParams defines parameters for RegisterContract.
+type RegisterContractParams struct {
+	// ApplicationId An application implementing the contract, in addition to the applications listed in its networks.
+	ApplicationId *uint64 `form:"application-id,omitempty" json:"application-id,omitempty"`
+}
+
+// StartProfileCaptureParams defines parameters for StartProfileCapture.
+type StartProfileCaptureParams struct {
+	// Kind The kind of the profile: cpu for a CPU profile, trace for an execution trace, or the name of a runtime/pprof profile, such as heap, allocs, goroutine, block or mutex, for a snapshot.
+	Kind string `form:"kind" json:"kind"`
+
+	// Seconds The duration of a CPU profile or an execution trace, 30 seconds by default and at most 600.
+	Seconds *uint64 `form:"seconds,omitempty" json:"seconds,omitempty"`
+}
+
+// GetReplayRejectionsParams defines parameters for GetReplayRejections.
+type GetReplayRejectionsParams struct {
+	// Address Only include the transactions sent by this account.
+	Address *string `form:"address,omitempty" json:"address,omitempty"`
+}
+
 // GetLedgerStateDeltaForTransactionGroupParams defines parameters for GetLedgerStateDeltaForTransactionGroup.
 type GetLedgerStateDeltaForTransactionGroupParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
@@ -1297,11 +2804,77 @@ type GetTransactionGroupLedgerStateDeltasForRoundParams struct {
 // GetTransactionGroupLedgerStateDeltasForRoundParamsFormat defines parameters for GetTransactionGroupLedgerStateDeltasForRound.
 type GetTransactionGroupLedgerStateDeltasForRoundParamsFormat string
 
+// AdvanceDevModeRoundsParams defines parameters for AdvanceDevModeRounds.
+type AdvanceDevModeRoundsParams struct {
+	// Rounds The number of blocks to produce, 1 by default and at most 1000.
+	Rounds *uint64 `form:"rounds,omitempty" json:"rounds,omitempty"`
+
+	// Offset The timestamp offset in seconds of each block produced from its previous block, overriding the timestamp offset for these blocks only.
+	Offset *uint64 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetLedgerTotalsParams defines parameters for GetLedgerTotals.
+type GetLedgerTotalsParams struct {
+	// MinRound The first round of the range, max-round by default.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound The last round of the range, the latest round by default.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Interval The number of rounds between two returned rounds, 1 by default.
+	Interval *uint64 `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// SetLogOutputFileParams defines parameters for SetLogOutputFile.
+type SetLogOutputFileParams struct {
+	// Path The absolute path of the file, which is appended to. It must be in the data or log directory of the node, and may not be a symbolic link. The file is created with mode 0600.
+	Path string `form:"path" json:"path"`
+}
+
+// GetParticipationSummaryParams defines parameters for GetParticipationSummary.
+type GetParticipationSummaryParams struct {
+	// Window Number of recent rounds whose certificates are scanned for votes and proposals, 1000 by default and at most 10000.
+	Window *uint64 `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// GetParticipationKeyregParams defines parameters for GetParticipationKeyreg.
+type GetParticipationKeyregParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetParticipationKeyregParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Fee Flat fee of the transaction, in microalgos. Defaults to the suggested fee.
+	Fee *uint64 `form:"fee,omitempty" json:"fee,omitempty"`
+
+	// FirstValid First valid round of the transaction. Defaults to the round after the latest round.
+	FirstValid *uint64 `form:"first-valid,omitempty" json:"first-valid,omitempty"`
+
+	// LastValid Last valid round of the transaction. Defaults to the maximal validity period from the first valid round.
+	LastValid *uint64 `form:"last-valid,omitempty" json:"last-valid,omitempty"`
+}
+
+// GetParticipationKeyregParamsFormat defines parameters for GetParticipationKeyreg.
+type GetParticipationKeyregParamsFormat string
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
 }
 
+// CreateSnapshotParams defines parameters for CreateSnapshot.
+type CreateSnapshotParams struct {
+	// Path The absolute path of the directory the snapshot is written to, which must not exist.
+	Path string `form:"path" json:"path"`
+
+	// WithPartkeys When set to `true`, the participation keys of the node are copied into the snapshot as well. A node restored from such a snapshot must not run while the node it was taken from does, as both would vote with the same keys. Defaults to `false`.
+	WithPartkeys *bool `form:"with-partkeys,omitempty" json:"with-partkeys,omitempty"`
+}
+
+// GetStateProofStatusParams defines parameters for GetStateProofStatus.
+type GetStateProofStatusParams struct {
+	// MaxMissing Maximum number of non-signing voters reported for every state proof, 10 by default and at most 1000.
+	MaxMissing *uint64 `form:"max-missing,omitempty" json:"max-missing,omitempty"`
+}
+
 // TealCompileTextBody defines parameters for TealCompile.
 type TealCompileTextBody = openapi_types.File
 
@@ -1318,6 +2891,9 @@ type GetPendingTransactionsParams struct {
 
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *GetPendingTransactionsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Fields Comma separated list of the response fields to return, other fields being omitted. Nested fields are selected with a dot separated path, such as `block.rnd`. Only supported with the JSON format.
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // GetPendingTransactionsParamsFormat defines parameters for GetPendingTransactions.
@@ -1327,6 +2903,9 @@ type GetPendingTransactionsParamsFormat string
 type PendingTransactionInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *PendingTransactionInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DecodeEvents When set, the logs of the calls to applications with a registered contract description are also decoded into the ARC-28 events of the contract.
+	DecodeEvents *bool `form:"decode-events,omitempty" json:"decode-events,omitempty"`
 }
 
 // PendingTransactionInformationParamsFormat defines parameters for PendingTransactionInformation.
@@ -1336,16 +2915,49 @@ type PendingTransactionInformationParamsFormat string
 type SimulateTransactionParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *SimulateTransactionParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DecodeEvents When set, the logs of the calls to applications with a registered contract description are also decoded into the ARC-28 events of the contract.
+	DecodeEvents *bool `form:"decode-events,omitempty" json:"decode-events,omitempty"`
 }
 
 // SimulateTransactionParamsFormat defines parameters for SimulateTransaction.
 type SimulateTransactionParamsFormat string
 
+// GetSimulationSessionDeltaParams defines parameters for GetSimulationSessionDelta.
+type GetSimulationSessionDeltaParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetSimulationSessionDeltaParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetSimulationSessionDeltaParamsFormat defines parameters for GetSimulationSessionDelta.
+type GetSimulationSessionDeltaParamsFormat string
+
+// SimulateTransactionInSessionParams defines parameters for SimulateTransactionInSession.
+type SimulateTransactionInSessionParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *SimulateTransactionInSessionParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// SimulateTransactionInSessionParamsFormat defines parameters for SimulateTransactionInSession.
+type SimulateTransactionInSessionParamsFormat string
+
+// RegisterContractJSONRequestBody defines body for RegisterContract for application/json ContentType.
+type RegisterContractJSONRequestBody = RegisterContractJSONBody
+
 // TealCompileTextRequestBody defines body for TealCompile for text/plain ContentType.
 type TealCompileTextRequestBody = TealCompileTextBody
 
 // TealDryrunJSONRequestBody defines body for TealDryrun for application/json ContentType.
 type TealDryrunJSONRequestBody = DryrunRequest
 
+// CreateAPITokenJSONRequestBody defines body for CreateAPIToken for application/json ContentType.
+type CreateAPITokenJSONRequestBody = APITokenRequest
+
+// RawTransactionBatchJSONRequestBody defines body for RawTransactionBatch for application/json ContentType.
+type RawTransactionBatchJSONRequestBody = TransactionBatchRequest
+
 // SimulateTransactionJSONRequestBody defines body for SimulateTransaction for application/json ContentType.
 type SimulateTransactionJSONRequestBody = SimulateRequest
+
+// SimulateTransactionInSessionJSONRequestBody defines body for SimulateTransactionInSession for application/json ContentType.
+type SimulateTransactionInSessionJSONRequestBody = SimulateRequest
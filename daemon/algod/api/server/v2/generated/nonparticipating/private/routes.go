@@ -21,15 +21,84 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get a proof of the absence of an account.
+	// (GET /v2/accounts/{address}/absence-proof)
+	AccountAbsenceProof(ctx echo.Context, address string, params AccountAbsenceProofParams) error
 	// Aborts a catchpoint catchup.
 	// (DELETE /v2/catchup/{catchpoint})
 	AbortCatchup(ctx echo.Context, catchpoint string) error
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Register the events of a contract.
+	// (POST /v2/contracts)
+	RegisterContract(ctx echo.Context, params RegisterContractParams) error
+	// List the profile captures.
+	// (GET /v2/debug/profiles)
+	GetProfileCaptures(ctx echo.Context) error
+	// Capture a profile.
+	// (POST /v2/debug/profiles)
+	StartProfileCapture(ctx echo.Context, params StartProfileCaptureParams) error
+	// Download a profile capture.
+	// (GET /v2/debug/profiles/{capture-id})
+	GetProfileCapture(ctx echo.Context, captureId uint64) error
+	// Get the watched applications.
+	// (GET /v2/deltas/apps)
+	GetWatchedApplications(ctx echo.Context) error
+	// Stop watching the state changes of an application.
+	// (DELETE /v2/deltas/apps/{application-id})
+	UnwatchApplicationStateDeltas(ctx echo.Context, applicationId uint64) error
+	// Watch the state changes of an application.
+	// (POST /v2/deltas/apps/{application-id})
+	WatchApplicationStateDeltas(ctx echo.Context, applicationId uint64) error
+	// Produce blocks in dev mode.
+	// (POST /v2/devmode/advance)
+	AdvanceDevModeRounds(ctx echo.Context, params AdvanceDevModeRoundsParams) error
+	// Get the accounts database optimization status.
+	// (GET /v2/ledger/optimize)
+	GetAccountsDatabaseOptimizeStatus(ctx echo.Context) error
+	// Optimize the accounts database.
+	// (POST /v2/ledger/optimize)
+	OptimizeAccountsDatabase(ctx echo.Context) error
+	// Get the runtime logging configuration.
+	// (GET /v2/logging)
+	GetLoggingConfig(ctx echo.Context) error
+	// Set the log level.
+	// (POST /v2/logging/level/{level})
+	SetLogLevel(ctx echo.Context, level uint64) error
+	// Stop logging to an additional file.
+	// (DELETE /v2/logging/output)
+	RemoveLogOutputFile(ctx echo.Context) error
+	// Log to an additional file.
+	// (POST /v2/logging/output)
+	SetLogOutputFile(ctx echo.Context, params SetLogOutputFileParams) error
+	// Disable the logging of a subsystem.
+	// (DELETE /v2/logging/subsystems/{subsystem})
+	DisableSubsystemLogging(ctx echo.Context, subsystem string) error
+	// Enable the logging of a subsystem.
+	// (POST /v2/logging/subsystems/{subsystem})
+	EnableSubsystemLogging(ctx echo.Context, subsystem string) error
+	// List the registered metrics.
+	// (GET /v2/metrics)
+	GetMetrics(ctx echo.Context) error
+	// Gets the connected peers.
+	// (GET /v2/peers)
+	GetPeers(ctx echo.Context) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
+	// Snapshot the data directory.
+	// (POST /v2/snapshot)
+	CreateSnapshot(ctx echo.Context, params CreateSnapshotParams) error
+	// Lists the named API tokens.
+	// (GET /v2/tokens)
+	ListAPITokens(ctx echo.Context) error
+	// Revokes a named API token.
+	// (DELETE /v2/tokens/{name})
+	RevokeAPIToken(ctx echo.Context, name string) error
+	// Creates a named API token.
+	// (POST /v2/tokens/{name})
+	CreateAPIToken(ctx echo.Context, name string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -37,6 +106,47 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// AccountAbsenceProof converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountAbsenceProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountAbsenceProofParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "application-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountAbsenceProof(ctx, address, params)
+	return err
+}
+
 // AbortCatchup converts echo context to params.
 func (w *ServerInterfaceWrapper) AbortCatchup(ctx echo.Context) error {
 	var err error
@@ -73,6 +183,296 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// RegisterContract converts echo context to params.
+func (w *ServerInterfaceWrapper) RegisterContract(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RegisterContractParams
+	// ------------- Optional query parameter "application-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RegisterContract(ctx, params)
+	return err
+}
+
+// GetProfileCaptures converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileCaptures(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProfileCaptures(ctx)
+	return err
+}
+
+// StartProfileCapture converts echo context to params.
+func (w *ServerInterfaceWrapper) StartProfileCapture(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartProfileCaptureParams
+	// ------------- Required query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "kind", ctx.QueryParams(), &params.Kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Optional query parameter "seconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "seconds", ctx.QueryParams(), &params.Seconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seconds: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartProfileCapture(ctx, params)
+	return err
+}
+
+// GetProfileCapture converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileCapture(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "capture-id" -------------
+	var captureId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "capture-id", runtime.ParamLocationPath, ctx.Param("capture-id"), &captureId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter capture-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProfileCapture(ctx, captureId)
+	return err
+}
+
+// GetWatchedApplications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWatchedApplications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetWatchedApplications(ctx)
+	return err
+}
+
+// UnwatchApplicationStateDeltas converts echo context to params.
+func (w *ServerInterfaceWrapper) UnwatchApplicationStateDeltas(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnwatchApplicationStateDeltas(ctx, applicationId)
+	return err
+}
+
+// WatchApplicationStateDeltas converts echo context to params.
+func (w *ServerInterfaceWrapper) WatchApplicationStateDeltas(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WatchApplicationStateDeltas(ctx, applicationId)
+	return err
+}
+
+// AdvanceDevModeRounds converts echo context to params.
+func (w *ServerInterfaceWrapper) AdvanceDevModeRounds(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdvanceDevModeRoundsParams
+	// ------------- Optional query parameter "rounds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rounds", ctx.QueryParams(), &params.Rounds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rounds: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdvanceDevModeRounds(ctx, params)
+	return err
+}
+
+// GetAccountsDatabaseOptimizeStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountsDatabaseOptimizeStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountsDatabaseOptimizeStatus(ctx)
+	return err
+}
+
+// OptimizeAccountsDatabase converts echo context to params.
+func (w *ServerInterfaceWrapper) OptimizeAccountsDatabase(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.OptimizeAccountsDatabase(ctx)
+	return err
+}
+
+// GetLoggingConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLoggingConfig(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLoggingConfig(ctx)
+	return err
+}
+
+// SetLogLevel converts echo context to params.
+func (w *ServerInterfaceWrapper) SetLogLevel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "level" -------------
+	var level uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "level", runtime.ParamLocationPath, ctx.Param("level"), &level)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter level: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetLogLevel(ctx, level)
+	return err
+}
+
+// RemoveLogOutputFile converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveLogOutputFile(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RemoveLogOutputFile(ctx)
+	return err
+}
+
+// SetLogOutputFile converts echo context to params.
+func (w *ServerInterfaceWrapper) SetLogOutputFile(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetLogOutputFileParams
+	// ------------- Required query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "path", ctx.QueryParams(), &params.Path)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetLogOutputFile(ctx, params)
+	return err
+}
+
+// DisableSubsystemLogging converts echo context to params.
+func (w *ServerInterfaceWrapper) DisableSubsystemLogging(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "subsystem" -------------
+	var subsystem string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subsystem", runtime.ParamLocationPath, ctx.Param("subsystem"), &subsystem)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subsystem: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DisableSubsystemLogging(ctx, subsystem)
+	return err
+}
+
+// EnableSubsystemLogging converts echo context to params.
+func (w *ServerInterfaceWrapper) EnableSubsystemLogging(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "subsystem" -------------
+	var subsystem string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subsystem", runtime.ParamLocationPath, ctx.Param("subsystem"), &subsystem)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subsystem: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.EnableSubsystemLogging(ctx, subsystem)
+	return err
+}
+
+// GetMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMetrics(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetMetrics(ctx)
+	return err
+}
+
+// GetPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeers(ctx)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	var err error
@@ -93,6 +493,80 @@ func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	return err
 }
 
+// CreateSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSnapshot(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSnapshotParams
+	// ------------- Required query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "path", ctx.QueryParams(), &params.Path)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
+
+	// ------------- Optional query parameter "with-partkeys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "with-partkeys", ctx.QueryParams(), &params.WithPartkeys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter with-partkeys: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateSnapshot(ctx, params)
+	return err
+}
+
+// ListAPITokens converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAPITokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListAPITokens(ctx)
+	return err
+}
+
+// RevokeAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeAPIToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RevokeAPIToken(ctx, name)
+	return err
+}
+
+// CreateAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAPIToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateAPIToken(ctx, name)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -121,195 +595,486 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v2/accounts/:address/absence-proof", wrapper.AccountAbsenceProof, m...)
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.POST(baseURL+"/v2/contracts", wrapper.RegisterContract, m...)
+	router.GET(baseURL+"/v2/debug/profiles", wrapper.GetProfileCaptures, m...)
+	router.POST(baseURL+"/v2/debug/profiles", wrapper.StartProfileCapture, m...)
+	router.GET(baseURL+"/v2/debug/profiles/:capture-id", wrapper.GetProfileCapture, m...)
+	router.GET(baseURL+"/v2/deltas/apps", wrapper.GetWatchedApplications, m...)
+	router.DELETE(baseURL+"/v2/deltas/apps/:application-id", wrapper.UnwatchApplicationStateDeltas, m...)
+	router.POST(baseURL+"/v2/deltas/apps/:application-id", wrapper.WatchApplicationStateDeltas, m...)
+	router.POST(baseURL+"/v2/devmode/advance", wrapper.AdvanceDevModeRounds, m...)
+	router.GET(baseURL+"/v2/ledger/optimize", wrapper.GetAccountsDatabaseOptimizeStatus, m...)
+	router.POST(baseURL+"/v2/ledger/optimize", wrapper.OptimizeAccountsDatabase, m...)
+	router.GET(baseURL+"/v2/logging", wrapper.GetLoggingConfig, m...)
+	router.POST(baseURL+"/v2/logging/level/:level", wrapper.SetLogLevel, m...)
+	router.DELETE(baseURL+"/v2/logging/output", wrapper.RemoveLogOutputFile, m...)
+	router.POST(baseURL+"/v2/logging/output", wrapper.SetLogOutputFile, m...)
+	router.DELETE(baseURL+"/v2/logging/subsystems/:subsystem", wrapper.DisableSubsystemLogging, m...)
+	router.POST(baseURL+"/v2/logging/subsystems/:subsystem", wrapper.EnableSubsystemLogging, m...)
+	router.GET(baseURL+"/v2/metrics", wrapper.GetMetrics, m...)
+	router.GET(baseURL+"/v2/peers", wrapper.GetPeers, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
+	router.POST(baseURL+"/v2/snapshot", wrapper.CreateSnapshot, m...)
+	router.GET(baseURL+"/v2/tokens", wrapper.ListAPITokens, m...)
+	router.DELETE(baseURL+"/v2/tokens/:name", wrapper.RevokeAPIToken, m...)
+	router.POST(baseURL+"/v2/tokens/:name", wrapper.CreateAPIToken, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNrLgv4Ka96oc+4aS/JVdq2rrnWInWV0cx2Up2XvP9mUxZM8MVhyAS4Cjmfj0",
-	"v1+hGyBBEpzhSIq9qXs/2Rrio9FoNPoL3Z8mqVoVSoI0enL6aVLwkq/AQIl/8TRVlTSJyOxfGei0FIUR",
-	"Sk5O/TemTSnkYjKdCPtrwc1yMp1IvoKmje0/nZTwz0qUkE1OTVnBdKLTJay4HdhsC9u6HmmTLFTihjij",
-	"Ic5fTW52fOBZVoLWfSh/kvmWCZnmVQbMlFxqntpPml0Ls2RmKTRznZmQTElgas7MstWYzQXkmT7yi/xn",
-	"BeU2WKWbfHhJNw2ISaly6MP5Uq1mQoKHCmqg6g1hRrEM5thoyQ2zM1hYfUOjmAZepks2V+UeUAmIEF6Q",
-	"1Wpy+n6iQWZQ4m6lINb433kJ8BskhpcLMJOP09ji5gbKxIhVZGnnDvsl6Co3mmFbXONCrEEy2+uI/Vhp",
-	"w2bAuGTvvnvJnj59+sIuZMWNgcwR2eCqmtnDNVH3yekk4wb85z6t8XyhSi6zpG7/7ruXOP+FW+DYVlxr",
-	"iB+WM/uFnb8aWoDvGCEhIQ0scB9a1G97RA5F8/MM5qqEkXtCje91U8L5v+iupNyky0IJaSL7wvAro89R",
-	"HhZ038XDagBa7QuLqdIO+v4kefHx0+Pp45Obf3t/lvyX+/P505uRy39Zj7sHA9GGaVWWINNtsiiB42lZ",
-	"ctnHxztHD3qpqjxjS77GzecrZPWuL7N9iXWueV5ZOhFpqc7yhdKMOzLKYM6r3DA/MatkbtmUHc1ROxOa",
-	"FaVaiwyyqeW+10uRLlnKNQ2B7di1yHNLg5WGbIjW4qvbcZhuQpRYuG6FD1zQvy4ymnXtwQRskBskaa40",
-	"JEbtuZ78jcNlxsILpbmr9GGXFbtcAsPJ7Qe6bBF30tJ0nm+ZwX3NGNeMM381TZmYs62q2DVuTi6usL9b",
-	"jcXailmk4ea07lF7eIfQ10NGBHkzpXLgEpHnz10fZXIuFlUJml0vwSzdnVeCLpTUwNTsH5Aau+3/6+Kn",
-	"N0yV7EfQmi/gLU+vGMhUZZAdsfM5k8oEpOFoCXFoew6tw8EVu+T/oZWliZVeFDy9it/ouViJyKp+5Bux",
-	"qlZMVqsZlHZL/RViFCvBVKUcAohG3EOKK77pT3pZVjLF/W+mbclyltqELnK+RYSt+OYvJ1MHjmY8z1kB",
-	"MhNywcxGDspxdu794CWlqmQ2Qswxdk+Di1UXkIq5gIzVo+yAxE2zDx4hD4OnEb4CcPwgg+DUs+wBR8Im",
-	"QjP2dNsvrOALCEjmiP3smBt+NeoKZE3obLbFT0UJa6EqXXcagBGn3i2BS2UgKUqYiwiNXTh0WAZDbRwH",
-	"XjkZKFXScCEhs8wZgVYGiFkNwhRMuFvf6d/iM67h62dDd3zzdeTuz1V313fu+KjdxkYJHcnI1Wm/ugMb",
-	"l6xa/Ufoh+HcWiwS+rm3kWJxaW+bucjxJvqH3T+PhkojE2ghwt9NWiwkN1UJpx/kI/sXS9iF4TLjZWZ/",
-	"WdFPP1a5ERdiYX/K6afXaiHSC7EYQGYNa1Thwm4r+seOF2fHZhPVK14rdVUV4YLSluI627LzV0ObTGMe",
-	"SphntbYbKh6XG6+MHNrDbOqNHAByEHcFtw2vYFuChZanc/xnM0d64vPyN/tPUeS2tynmMdRaOnZXMpoP",
-	"nFnhrChykXKLxHfus/1qmQCQIsGbFsd4oZ5+CkAsSlVAaQQNyosiyVXK80QbbnCkfy9hPjmd/NtxY385",
-	"pu76OJj8te11gZ2syEpiUMKL4oAx3lrRR+9gFpZB4ydkE8T2UGgSkjbRkpKwLDiHNZfmqFFZWvygPsDv",
-	"3UwNvknaIXx3VLBBhDNqOANNEjA1fKBZgHqGaGWIVhRIF7ma1T98dVYUDQbx+1lRED5QegSBghlshDb6",
-	"IS6fNycpnOf81RH7PhwbRXEl8629HEjUsHfD3N1a7harbUtuDc2IDzTD7VTlkd0ajwYr5t8HxaFasVS5",
-	"lXr20opt/FfXNiQz+/uozn8MEgtxO0xcqGg5zJGOg78Eys1XHcrpE44z9xyxs27f25GNHSVOMLeilZ37",
-	"SePuwGONwuuSFwSg+0J3qZCopFEjgvWO3HQko4vCHJzhgNYQqluftb3nIQoJkkIHhm9ylV79levlPZz5",
-	"mR+rf/xwGrYEnkHJllwvjyYxKSM8Xs1oY46YbYgKPpsFUx3VS7yv5e1ZWsYND5bm4I2LJYR67IdMD8qI",
-	"7vIT/ofnzH62Z9uyfhr2iF0iA9N0nJ2TIbPaPikINJNtgFYIxVak4DOrdR8E5ctm8vg+jdqjb8mm4HbI",
-	"LQJ3SG3u/Rh8ozYxGL5Rm94RUBvQ90EfdhwUIw2s9Aj4XjnIFO6/Qx8vS77tIxnHHoNku0Arumo8DTK8",
-	"8e0sjXH2bKbK23GfDluRrDE5M25HDZjvtIMkbFoViSPFiNmKGnQGarx8u5lGd/gYxlpYuDD8d8CCtqPe",
-	"BxbaA903FtSqEDncA+kvo0x/xjU8fcIu/nr2/PGTX588/9qSZFGqRclXbLY1oNlXTjdj2mxzeNhfGWpH",
-	"VW7io3/9zBsq2+PGxtGqKlNY8aI/FBlASQSiZsy262OtjWZcdQ3gmMN5CZaTE9oZ2fYtaK+EthLWanYv",
-	"mzGEsKyZJWMOkgz2EtOhy2um2YZLLLdldR+qLJSlKiP2NTxiRqUqT9ZQaqEi3pS3rgVzLbx4W3R/J2jZ",
-	"NdfMzo2m30qiQBGhLLOR4/k+DX25kQ1udnJ+Wm9kdW7eMfvSRr63JGpWQJmYjWQZzKpFSxOal2rFOMuw",
-	"I97R34NBUeBSrODC8FXx03x+P6qiwoEiKptYgbYzMWph5XoNqZIUCbFHO3OjjkFPFzHeRGeGAXAYudjK",
-	"FO2M93FshxXXlZDo9NBbmQZarIUxh2zRIsu7a6tD6KCpHugIOBYdr/EzGjpeQW74d6q8bCyB35eqKu5d",
-	"yOvOOXY53C3GmVIy29fr0EIu8nb0zcLCfhRb4xdZ0Et/fN0aEHqkyNdisTSBWvG2VGp+/zDGZokBih9I",
-	"Kcttn75q9kZllpmYSt+DCNYM1nA4S7chX+MzVRnGmVQZ4OZXOi6cDcRroKMY/dsmlPfMkvSsGVjqSnll",
-	"V1sVDL23vfui6ZjwlE5ogqjRA76r2ulIrWg6igXIS+DZls0AJFMz5yByritcJEfXs/HijRMNI/yiBVdR",
-	"qhS0hixxhqm9oPl2dHWYHXhCwBHgehamFZvz8s7AXq33wnkF2wQDJTT76odf9MMvAK9Rhud7EIttYuit",
-	"1XznBexDPW76XQTXnTwkO14C8/cKMwql2RwMDKHwIJwM7l8Xot4u3h0tayjRH/e7Uryf5G4EVIP6O9P7",
-	"XaGtioHwP6feWgnPbpjkUnnBKjZYzrVJ9rFl26ilg9sVBJwwxolx4AHB6zXXhnzIQmZo+qLrBOchIcxO",
-	"MQzwoBpiR/7FayD9sVN7D0pd6Vod0VVRqNJAFluDhM2Oud7App5LzYOxa53HKFZp2DfyEJaC8R2yaCWE",
-	"IG5qV4sLsugvDh0S9p7fRlHZAqJBxC5ALnyrALthCNQAIEI3iCbCEbpDOXXc1XSijSoKyy1MUsm63xCa",
-	"Lqj1mfm5adsnLm6aeztToDHyyrV3kF8TZin4bck1c3CwFb+ysgeaQcjZ3YfZHsZEC5lCsovyUcWzrcIj",
-	"sPeQVsWi5BkkGeR82x/0Z/rM6POuAXDHG3VXGUgoiim+6Q0l+6CRHUMrHE/HhEeGX1hqj6BVBRoCcb33",
-	"jJwBjh1jTo6OHtRD4VzRLfLj4bJpqyMj4m24VsbuuKMHBNlx9DEAD+ChHvr2qMDOSaN7dqf4T9BuglqO",
-	"OHySLeihJTTjH7SAARuqCxAPzkuHvXc4cJRtDrKxPXxk6MgOGHTf8tKIVBSo6/wA23tX/boTRN2MLAPD",
-	"RQ4ZCz6QGliE/RnF33THvJ0qOMr21ge/Z3yLLCcXGkWeNvBXsEWd+y0FdgamjvvQZSOj2vuJS4aA+nAx",
-	"K4KHTWDDU5NvraBmlrBl11AC09VsJYyhgO22qmtUkYQDRP0aO2Z0TjwKivQ7MMareIFDBcvrb8V0QjrB",
-	"bvguO4pBCx1OFyiUykdYyHrIiEIwKt6DFcruunCx4z562FNSC0jHtNGDW1//D3QLzbgC9p+qYimXqHJV",
-	"BmqZRpUoKKAAaWewIlg9p4vsaDAEOayANEn88uhRd+GPHrk9F5rN4do/uLANu+h49AjtOG+VNq3DdQ/2",
-	"UHvcziPXBzp87MXntJAuT9kfWeBGHrOTbzuD114ie6a0doRrl39nBtA5mZsxaw9pZFxUBY47ypcTDB1b",
-	"N+77hVhVOTf34bWCNc8TtYayFBns5eRuYqHkt2ue/1R3w8ckkFoaTSFJ8QnEyLHg0vahVxP7dMMmmkys",
-	"VpAJbiDfsqKEFCjK34p8uobxiFH8X7rkcoGSfqmqhQtAo3GQU1eabCplJXtDRKUhs5EJWqdjnNsFHfuH",
-	"HlYOAm51sa5pmzSPa17P5972jLlSA+R1Tf1R79Z0MqiqWqSuG1WVkNN+rTKCi7cEtQA/zcQjfSCIOiu0",
-	"9PEVbos9BXZzfx9bezN0DMr+xEFIXPNxKCrO6sn59h6kFRqIlVCUoPFuCe1Lmr6qefgyzV0+eqsNrPom",
-	"eOr668Dxezeo6CmZCwnJSknYRh9jCwk/4sfoccL7baAzShpDfbvKQwv+DljtecZQ413xi7vdPaFdV5P+",
-	"TpX35cukAUfL5SNch3v95G7K2zo4eZ5HfILu3UqXAehp/U5elIxrrVKBwtZ5pqd00Jwb0T1yaaP/bR2N",
-	"ew9nrztux/kVPolE4y7kBeMszQWafpXUpqxS80FyNC4FS41ELXktetjc+NI3ids3I+ZHN9QHyTFirTY5",
-	"RSMt5hCxr3wH4K2OulosQJuOkjIH+CBdKyFZJYXBuVb2uCR0XgooMXToiFqu+JbNLU0YxX6DUrFZZdpi",
-	"Oz7L0kbkufPE2WmYmn+Q3LAcuDbsRyEvNzic99b7IyvBXKvyqsZC/HZfgAQtdBKPrvqevmLgq1v+0gXB",
-	"4jN6+ky+Gzt+83Zri7an5mn4//nqP07fnyX/xZPfTpIX/+P446dnNw8f9X58cvOXv/zf9k9Pb/7y8D/+",
-	"PbZTHvbYoyEH+fkrp9Kev0K9pXHe9GD/bIb7lZBJlMjCMIwObbGv8IGsI6CHbauWWcIHaTbSEtKa5yKz",
-	"vOU25NC9YXpnkU5Hh2paG9GxYvm1HqgN3IHLsAiT6bDGW0tR/YDE+PM89Ca6F3d4XuaVpK300je9PvGB",
-	"YWo+rZ9gUnaWU4bv85bcRzW6P588/3oybd7V1d8n04n7+jFCySLbxF5PZrCJKXnugODBeKBZwbcaTJx7",
-	"IOzRGDgKygiHXcFqBqVeiuLzcwptxCzO4XxMvzMWbeS5pGB7e37QN7l1Lg81//xwmxIgg8IsY1kbWoIa",
-	"tmp2E6ATL1KUag1yysQRHHWNNZnVF100Xg58jtkDUPtUY7Sh+hwQoXmqCLAeLmSURSRGPyjyOG59M524",
-	"y1/fuzrkBo7B1Z2zdkT6v41iD77/9pIdO4apH9BDXho6eHoZUaXd66JWJJHlZpSrhoS8D/KDfAVzIYX9",
-	"fvpBZtzw4xnXItXHlYbyG55zmcLRQrFT/2DpFTf8g+xJWoPppIKnYqyoZrlI2VWokDTkSSlC+iN8+PCe",
-	"5wv14cPHXlBFX31wU0X5C02QWEFYVSZxCQ6SEq55GXNa6fqBO45MGUx2zUpCtqrIsukTKLjx4zyPF4Xu",
-	"PnTtL78ocrv8gAy1e8Zpt4xpo0ovi1gBhaDB/X2j3MVQ8mtvV6k0aPb3FS/eC2k+suRDdXLyFFjr5eff",
-	"3ZVvaXJbwGjryuBD3K5RBRdOaiVsTMmTgi9ivrEPH94b4AXuPsrLK7Rx5DnDbq0Xpz6iHodqFuDxMbwB",
-	"BMfBr+dwcRfUyyezii8BP+EWYhsrbjQe+9vuV/AG9dbb1XnH2tulyiwTe7ajq9KWxP3O1DluFlbI8mEU",
-	"WixQW3XpgGbA0iWkVy5PC6wKs522uvtIHSdoetYhNGXwoRdkmEMCPQszYFWRcSeKc7ntPubXYIyPB34H",
-	"V7C9VE0KikNe77cfk+uhg4qUGkiXlljDY+vG6G6+CwdDxb4o/JtsfJznyeK0pgvfZ/ggk8h7D4c4RhSt",
-	"x85DiOBlBBFE/AMouMVC7Xh3Iv3Y8qyWMaObL5LNx/N+5po0ypOL3ApXg1Z3+r4CTAemrjWbcSu3K5fJ",
-	"ih5MB1ys0nwBAxJy6NwZ+Sy55RDCQfbde9GbTs27F1rvvomCTI0Tu+YopYD9YkkFlZlOvJ6fifyHzjOB",
-	"CSodwmY5ikl1YCMxHV62nGyUcW8ItDgBQykbgcOD0cZIKNksufZJtjAXmT/Lo2SA3zEBwK60L+dBqFmQ",
-	"cKxO6uJ5bvec9rRLl/zFZ3zxaV5C1XJEyhYr4WN0e2w7lEQBKIMcFrRwauwJpUlG0GyQheOn+TwXElgS",
-	"i1oLzKDBNePmACsfP2KMLPBs9AgxMg7ARr84DszeqPBsysUhQEqXTIH7sdGjHvwN8XdfFMdtRR5VWBYu",
-	"BrxaqecA3IU61vdXJ+AWh2FCTpllc2ueWzbnNL5mkF72ERRbO7lGXGTGwyFxdocDhC6Wg9ZEV9FtVhPK",
-	"TB7ouEC3A+KZ2iT08DMq8c42M0vv0dB2fIYaO5iU5+WBZjO1wWgfvFoolHoPLMNweDACDX8jNNIr9hu6",
-	"zQmYXdPulqZiVKiRZJw5ryaXIXFizNQDEswQuXwVpG65FQAdY0eTB9kpv3uV1LZ40r/Mm1tt2qQk86+G",
-	"Ysd/6AhFd2kAf30rTJ1s5W1XYonaKdpBK+08M4EIGSN6yyb6Tpq+K0hDDqgUJC0hKrmKeU6tbgN441z4",
-	"boHxArPZcLl9GERClbAQ2kBjRPdxEl/CPMkxiZ5S8+HVmaKc2/W9U6q+psiNiB1by/zsK8BQ4rkotUnQ",
-	"AxFdgm30nUal+jvbNC4rtWOtKOWsyOK8Aae9gm2SibyK06ub94dXdto3NUvU1Qz5rZAUsDLDFMnRCMwd",
-	"U1OQ7s4Fv6YFv+b3tt5xp8E2tROXllzac/xBzkWH8+5iBxECjBFHf9cGUbqDQQYvZ/vcMZCbAh//0S7r",
-	"a+8wZX7svVE7/v3u0B1FI0XXEhgMdq5CoJvIiiXCBBmG+09aB84ALwqRbTq2UBp1UGPmBxk8fF62DhZw",
-	"d91gezAQ2D1jr2pK0O0UfI2AT7miWxlwjkZh5rKdKC9kCOFUQvtKB31E1a/u9uHqEnj+A2x/sW1xOZOb",
-	"6eRuptMYrt2Ie3D9tt7eKJ7RNU+mtJYn5ECU86Io1ZrniTMwD5FmqdaONLG5t0d/ZlYXN2Nefnv2+q0D",
-	"/2Y6SXPgZVKLCoOrwnbFH2ZVlO1v4ID4TOpW5/MyO4mSwebXKcpCo/T1ElxK6kAa7eXObBwOwVF0Rup5",
-	"PEJor8nZ+UZoiTt8JFDULpLGfEcekrZXhK+5yL3dzEM7EM2DixuXgDXKFcIB7uxdCZxkyb2ym97pjp+O",
-	"hrr28KRwrh1Js1eUF14zJbsudIx53hbO677imPmSrCJ95iSrFVoSEp2LNG5jlTNtiUOS78w2Zth4QBi1",
-	"I1ZiwBUrKxGMZZuNyW3TATKYI4pMHU2v0+BuplzNn0qKf1bARAbS2E8lnsrOQcU0Kc7a3r9OrezQn8sN",
-	"TBb6Zvi7yBhh1tfujYdA7BYwQk9dD9xXtcrsF1pbpOwPgUviAId/OGPvStzhrHf04aiZgheXbY9bWKKn",
-	"z/8sYVCu9v31gbzy6tLPDswRrfcjdDIv1W8Q1/NQPY48WPJ5bgVGufwG4UOHsMpFi8XU1p2mbFEz++B2",
-	"D0k3oRWqHaQwQPW484FbDhNuegs1l7TV9JCkFesWJ5gwqvSYxm8IxsHci8TN+fWMx7KRWiHDwnTWOIBb",
-	"tnSjmO/sca/r1xY0Owt8yXVbQY/RCyibt4T9xDa3FBho2tGiQiMZINWGMsGU/H+5VpFhKnnNJVVxsf3o",
-	"KLneGsj4ZXtdqxJTSei42T+DVKx4HpccsrRv4s3EQlCBkkpDUAHDDUTFn4iKXBWR+g2RQ835nJ1MgzI8",
-	"bjcysRZazHLAFo+pxYxr5OS1IaruYpcH0iw1Nn8yovmyklkJmVlqQqxWrBbqUL2pnVczMNcAkp1gu8cv",
-	"2FfottNiDQ8tFt39PDl9/AKNrvTHSewCcAVmdnGTDNnJ3xw7idMx+i1pDMu43ahH0Vf3VGFumHHtOE3U",
-	"dcxZwpaO1+0/Sysu+QLikSKrPTBRX9xNNKR18CIzKo+kTam2TJj4/GC45U8D0eeW/REYLFWrlTAr59zR",
-	"amXpqSlvQZP64ajWkstM7OHyH9FHWngXUUeJ/LxGU7rfYqtGT/YbvoI2WqeMU/6QXDTRCz5fOjv36Ykw",
-	"VXOdoZlwY+eyS0cxB4MZ5qwohTSoWFRmnvyZpUte8tSyv6MhcJPZ188i6anbaVLlYYB/dryXoKFcx1Ff",
-	"DpC9lyFcX/aVVDJZWY6SPWxeewSnctCZG3fbDfkOdw89ViizoySD5Fa1yI0HnPpOhCd3DHhHUqzXcxA9",
-	"Hryyz06ZVRknD17ZHfr53WsnZaxUGcs52Bx3J3GUYEoBa4zdi2+SHfOOe1Hmo3bhLtB/Wc+DFzkDscyf",
-	"5Zgi8I2KaKc+ZXptSXex6hHrwNAxtR8sGczcUFPWTk/9+fno/URBxT1d3rDdd2zZLx4P+EcXEV+YXHAD",
-	"G18+rWSAUIL0/FGSyervgY+ds2/UZizhdE6hJ55/ARRFUVKJPPulefnZqX5Qcpkuoz6zme34a1OnrV4c",
-	"3YHR9IFLLiXk0eFI3vzVy6URyfkfauw8KyFHtu0WZKDldhbXAN4G0wPlJ7ToFSa3E4RYbT+qq4O284XK",
-	"GM7T5Kprjmu/kEeQbv2fFWgTe6CEHyhwDG2jlh1Qtm8GMkON9Ih9T6WYl8BaiYhQE/SZItqvpqsiVzyb",
-	"YgaLy2/PXjOalfpQtSHKNr5ARai9io5NLEjDOS4E2RcOij+PGD/O7nhtu2ptkjo5eOwBqm3RpC8XHT8B",
-	"qkghdo7Yq6CoKr1VtUMwTGBSrqxWV49G8hHShP2PMTxdotrXYq3DJD8+Tb6nSh2UpqxLTNW5KfHcWbhd",
-	"pnxKlD9lyurm10JTBV5YQ/vNa/0A3Jkd/BvY9vLKSkqilKMDbrk6E+WhaPfA0RXpXQlRyDqIP1DopyoT",
-	"h1YNuMBe0VRZ3RIEvZqU9IKyLh3kK6unXCopUkxUFbuiXaneMX62ETm9uoZcf8TdCY0crmjhgzoUz2Fx",
-	"sBSCZ4QOcX1Df/DVbipRB/1psCbskhu2AKMdZ4Ns6ut3OFujkBpcrlEs7BzwSVW2fJfIIaPu8KR2mxxI",
-	"Rvj0ZkB5/M5+e+NMCxiTfiUkKhEObU7wI2sgVhI1VvMQhi0UaLee9vtj/d72OcKnuBlsPh75yqM4Brn+",
-	"7LLJz90f6sx7vZ2X2bZ9adu6BEn1z60oZ5r0rCjcpMPVXaLygNnIQQRHvJeJdx8FyK3HD0fbQW47w1Xw",
-	"PrWEBmt0dkOB93CPMOpKJ50qWlZoJYrCFozCxKJZEoSMgPFaSGjq4kYuiDR6JeDG4Hkd6KfTkhsSAUfx",
-	"tEvgOXq4YwxNG+feuOtQ3fRQFiW4Rj/H8DY2RVoGGEfdoBHcuNzW5XgtdQfCxEusA+4Q2S+5glKVE6Iy",
-	"fLXQKcISYxyWcfsyT+0LoH8M+jIRdcdcaYfeREMPUWdVtgCT8CyLpX79Br8y/MqyCiUH2EBa1SlCi4Kl",
-	"mHelnYimT21uolRJXa12zOUb3HG6oKpRhBrCykp+h/Ghy2yL/8byYw7vjAv0ODjU0Ed1ZIdlX+qHTsak",
-	"XkvTiRaLZDwm8E65OzqaqW9H6E3/e6X0XC3agHzm9BO7uFy4RzH+9q29OMLsDL2kr3S11MkTMLBP+VqU",
-	"qDbWz37bXAmvsl4WWHQo1bXudhsghqvWTfHyGwjvDZJucLpfyUM5FOSbDsakc+NexxnOdrKgwRdHFCFE",
-	"b4sQirh1digqiIKC7Ode73GSYU/ONvHEhwFCfbhZH6AffCwrK7hw7veGWfQx66Le++8QxsTDNhvcXYSL",
-	"JR+02P2wHor79snY8Hu3qtUVuCfzRQlroSrv2PaRT14lpF9bNaLqyPvo+vuGV5zqy5pDB423l666AC3T",
-	"6eQ//EJxcgykKbf/Aqbc3qb36mX1pV0yTzVNWJ2YelSi6tatOCZRYSwnnpMNWxW79tQb65HVqzHiQL9+",
-	"2HRynh10YcbyKk5olNixi1cDG0471aSawiNWKC2a/PCxMmEjQwwvsdJXkDarP5aP71lDarAoQBO3UAIc",
-	"kkTLThYUHv3v9FMD6nQdiemyTu1KNdWvBLDnju+9BgteNFIW9aPxiZXO6ug05NOYDXkB0tX+bL/zGB1t",
-	"Pp9DasR6z+u7vy1BBi+7pt4uQzW8g8d4oo5exuQth1sdG4B2PY7bCU+QRPHO4Ay9vbmC7QPNWtQQTes+",
-	"9VftbfJ2IAaQOySWRJSORX+QIdk55IWuKQOx4KOtqDs0GdAGK0IFb0lvOZcnSXtxNO9Ld0wZL0kzai7b",
-	"9aBX1xiIO/RAr1/RYlj/eIUFRHRdrdHn/Qi1dHbez4547fKG4FvJ2nfiM4iA9r/5h9E0Sy6uIKxZhZ6q",
-	"a15mvkXU9OKtOsmO+6j3qs5XY+gCPa9nFk1sbP8dVSTfFkZAp7myYkQyFEbeDketYzkeaAq6ofTvGGhr",
-	"4ZpD6Wr7ofybKw2JUT6Wdhccu1BBkUW3QoIezHFJwA1mnnnXpNbBXL8cM81wF1AULpCVsOIWujJIgDM8",
-	"5y5kv6Tv/uGQz/W618JU0+v+ogM+KlroHhJDqp8zd1vuf5B0G2OTkJLqR+tYNhwJZdsbUpQqq1K6oMOD",
-	"URvkRuea2sFKonaatL/Kjo4QvOq8gu0xKUG+WoPfwRBokpwI9CCLQmeT79X8pmNwL+4FvC9puZpOCqXy",
-	"ZMDZcd5P4dOl+CuRXkHG7E3howcHKuiwr9DGXnuzr5dbn7KmKEBC9vCIsTNJ8dresd3OId2ZXD4wu+bf",
-	"4KxZRVm1nFHt6IOMB75ivqvyjtzMD7Obh2mwrO6OU9EgexLEbAbSB5X8OlJP6misVt53NXdr/DRERVDE",
-	"ZJKmfM2eOJk6RKap/NGEyfSlgzxX1wlSUVLn/4rpHLZdm0n6jKdNN4vtGQTxNly7C3TLljxjqSpLSMMe",
-	"8ScOBNRKlZDkCsNvYp7BubHy0ArjmiXL1YKpwqq5lEbP+1CiZWmCue6rBA891yUIEnL4DCREAO2e5zpw",
-	"qXEf3h1VcA6vsHO5jNhtcMP8bh1cRscR3MHVLwIwRxD6fpvVWaxKUHtd3XpVQ9XjjFqJNI7uP1a0ymCM",
-	"SYx6Y6hwCWjpARw2wwMe8pTaOYmnp49mkHyWxzw2zB0/56RBOrf/xRusOy6bg2MuA/ws8gBz16pjlZ8i",
-	"u1pP5QpT+TeVAxQSdXjv9i9TNcDZWC9znXF6JDMIABj2O7dgGOV9PhSMOVbXTHgEyee1zD9tFT8WHY7n",
-	"swHSyU456fxLYHbsqgT3xo/KAHbqDhXcLL0MYJv3NXOr5YHGB3hUPIVrsiN5e5arQdgVrlSR5LCGljve",
-	"PTys0hS0FmsI6xdSZ5YBFGjd7eocMT9zyNs7gqhbexJ4KsdgNyqZEmJpp9gesTMqJG9kQsdEjz1KFqK1",
-	"yCrewp++QyW3oSJukcvHw/pxHKc4mEnEF7eLReyNDEGaj55LGQ8MCd+91iYlnC2rTc9EhM3J1gW/lsMq",
-	"WJ8oG9lpfA3EALHfbiDFe6gd+XB3nDAcjOnOm/ZBoamsd/i2qvwgle0isl5FyKjUpsFX9A3Tz3jB1/WN",
-	"SLtkdBQ6MoDQDW/AOEpo4vSCZiu+ZZmYz6Ekt4o2XGa8zMLmQrIUSsOF1TG3+vYKhoW2rGC6V8ewnBoH",
-	"9cwqpm2ghZAAybdOeRuS/0fI7ehDi8jsdG0bNVSssrcr8YcdfGP1HIxwGyAC9yQdtRw6rEqiiMlW/AoO",
-	"nEeL32D3NJgoxllhjcJZx0xxs5PWf0LU4YH/WQqzk9pJ9OuGHJJPiIjR06BcNI5p2pw+DcaiRC+pZFIY",
-	"KdqtQOD3mgxUNB8MZFR0vDNBnqp3uHxBB7WSUmey64sDPWZMwExdBO1B0kLX3JDuYUpRFj1wJtqyupoj",
-	"deKm0MWEcQM1O552I1raV1C97Vj9M61KFKKu+XZ/YrbmGooHA9PIXp3xMQ411G6ricA0FZSI5j07RDyJ",
-	"0HyspkI/49T9L4ai3Bs/3O+3HGdpjy8grNC+m94aQd6TSoTWuNzGjo63Jd9igUPSyYg4zXvbqvq0/B4b",
-	"FGXRt0tEOgq0fsxeBJtB5eDdYRRhnuLmAXRJoZ/odvX6UJdf/NjoSeNqGPsOe8ALo2uCKsbe0eHA+cIv",
-	"iX+skRIs5eMQJbSWvy9gxy2wUSyDLXKymjFAWePp9Vl7X4JoLP2yDnIaKrjdjYXCpMRWOMjzSAwViY9U",
-	"4jYgHHtPlmuef/44KMxWfYb4gOzdsOc0DKQJkUyo1Ld7xveaj5o7CJq5v6nlW4zb+hvYPYpeC24op7H2",
-	"mD8K/zwnK//c17tcg2TXOCYFfT/+ms1cmpOihFToriZ87UtR1XEjWJnRPZ3cmD2BKvvW+YsydyDjuTcs",
-	"sTdNWRs0ZC9kA2FzRL8wUxk4uVEqj1Ffjywi+IvxqDDf6J7r4qoVDd5IdcGNpkq456jw4H3XgVHh/Uyq",
-	"Y5dHkc/20qk09Nc5+rZu4TZyUTdrG/ukoY/cXbVPxrxEiJc0st3xKQQhBOuBMQSV/f3x31kJcyz4q9ij",
-	"RzjBo0dT1/TvT9qf7XF+9Ciq5H22RxCEIzeGmzdGMb8MPYunp98DGRg6+1GJPNtHGK18Gk3JbMwY8avL",
-	"2vNFinb/SoGZ/aPqCqfeIZqcEBNZa2vyYKogU8aIJBmuWyQlBgY9pFUpzBaTCXuNV/wafa7xfR3660LH",
-	"axOeu/uMuoI6HXUTKFxpf7t+r3iO9xFZFqW9hVR+xL7d8FWRgzsof3kw+xM8/fOz7OTp4z/N/nzy/CSF",
-	"Z89fnJzwF8/44xdPH8OTPz9/dgKP51+/mD3Jnjx7Mnv25NnXz1+kT589nj37+sWfHlg+ZEEmQCc+dd3k",
-	"f2Nl++Ts7XlyaYFtcMIL8QNsqYiuJWNfnpeneBJhxUU+OfU//U9/wo5StWqG979OXGasydKYQp8eH19f",
-	"Xx+FXY4XGBmYGFWly2M/T69+79nb89oFSUZ/3FFKKuGdOZ4UzvDbu28vLtnZ2/OjhmAmp5OTo5Ojx3Z8",
-	"VYDkhZicTp7iT3h6lrjvx47YJqefbqaT4yXwHAPp7R8rMKVI/acSeLZ1/9fXfLGA8sjVLLY/rZ8ce7Hi",
-	"+JOLkLzZ9e04LP91/KkVSJrt6YnlgY4/+ay3u1u30sq6ANqgw0godjU7nmEyrbFNQQeNh5eCyoY+/oTi",
-	"8uDvxy77T/wjqi10Ho59tHW8ZQtLn8zGwtrpkXKTLqvi+BP+B+nzhhhGDrHYakqaw1nTfMqEYXymSkw3",
-	"a9Kl5RE+z6XQQcsJUi0R/HlmCd32ekkQ+IzWVOLj9H0/BgAHYn4k5AqW5JtD25qp4cvoJAiqTtS3Tqt9",
-	"c/e8P0lefPz0ePr45Obf7N3i/nz+9GZk8MXLelx2UV8cIxt+xCSR6CPCs/zk5OSg+uI9NalZJG1S/eq1",
-	"f687Whj2ELut6gzEamTsSWbXGT5Wj/1mOnl24Ip32pJaL4EjddW/4Rnz8XE49+PPN/e5xCcqlsczusNu",
-	"ppPnn3P159KSPM8ZtgyyE/e3/md5JdW19C2twFGtVrzc+mOsW0yBuc3Ga40vNHoRSrHmKOdJJVslVycf",
-	"MVA2FqM4wG+04bfgNxe213/zm8/Fb3CT7oPftAe6Z37z5MAz/8df8f/fHPbZyZ8/HwQ+xPpSrEBV5o/K",
-	"4S+I3d6JwzuBk9K3HJuNPMaIh+NPLQHZfe4JyO3fm+5hi/VKZeBlYDWfU2GYXZ+PP9G/wUSwKaAUK5CU",
-	"MNv9Sk/bjzFd87b/81am0R/76+hWZY/9fPypXRWwhSC9rEymrilHafTKxNI3PHd58tFcXKueRjE/QPOO",
-	"mP3kUp/kW7SRiwwYx5yMqjKNbcB2rqMSa++NHYHppTOTL4TECdAMj7NQQQgevNDTkCpJ9c0717OD7I3K",
-	"oH894wX8zwrKbXMDOxgn0xZ/dgQeKb9w5+uuz05vDiN/dBeQr6tPHHVR89bfx9dcGHuJuwe9iNF+ZwM8",
-	"P3bZ+zq/Nglzel8wC1DwYxhaGf31uK5gFP3YVcVjX50qOtDIR2f5z41ZLjRzIUnUBq73H+3OYn58Ry2N",
-	"1eb0+BgfyS2VNseTm+mnjkUn/Pix3kyf1Lje1JuPN/8vAAD//9JMvfjO0wAA",
+	"H4sIAAAAAAACA+29a5PbRpIo+lcQ2o2wrUt0S37tWBET57Yl2aM7sqWQZHvPHfvYIFlkww0CXADsh331",
+	"32++6gVUASCbku3d/jBjNQFUZWVlZmXl8/d7i2qzrUpVts29R7/f22Z1tlGtqumvbLGodmWb5kv8a6ma",
+	"RZ1v27wq7z3Sz5KmrfNyfW92L8dft1l7Dv8uYRD7Dn4/u1er/9rltYKh2nqnZveaxbnaZDhwe7PFt81I",
+	"1+m6SmWIMx7i2ZN7bwceZMtlrZqmD+WLsrhJ8nJR7JYqaeusbLIFPmqSq7w9T9rzvEnkY3gtAUQk1Qp+",
+	"9l5OVrkqls2JXuR/7VR946xSJo8v6a0FMa2rQvXhfFxt5jlMLlApA5TZkKStkqVa0UvnWZvgDAirfhEe",
+	"NyqrF+fJqqpHQGUgXHhVudvce/Sve40ql6qm3Vqo/JL+uaqV+k2lbVavVXvvp1locSuAMG3zTWBpzwT7",
+	"MPGuaAHdK1oNrHENE5QJfnWSfLNr2mQO6y6TV189Tj755JMvcCGbrG3VUogsuio7u7sm/hyeL7NW6cd9",
+	"WsuKdQV7vUzN+wAAzf9aFjj1raxpVJhZzvBJArQaWYD+MEBCedmqNe2DR/34RYAp7M9zBZCqiXvCLx91",
+	"U9z5/9BdWWTt4nxbAR4D+5LQ04QfB2WY8/mQDDMAeO9vEVM1DvqvB+kXP/3+cPbwwdt/+9dZ+v/Kn599",
+	"8nbi8h+bcUcwEHxxsatrVS5u0nWtMuKW86zs4+OV0ENzXu2KZXKeXdLmZxsS9fJtgt+y6LzMih3SSb6o",
+	"qzOABLhbyAhEVQZDJXriZFcWKKZwNKH2BAbY1tVlvlTLGUrfq/Mc9mKRNTwEvQcSsSiQBneNWsZoLby6",
+	"AWZ666IE4ToIH7SgPy8y7LpGMLFUi2qpUnWptQAfCT+cg0CA2WcESFGtG31GLrKioJMn226LHCjfnqwZ",
+	"yJZ13sBmgKRYVCUcp4s2cQYm5GRFg6caTg8YKGEkHPbs1eP0478lDI+ZS8aILdtfRGDF8woOPUAGrlhd",
+	"k/xLF0XVgBCqRg5kfcYCnyXuEWpP52a/4zl5gyvCyfEBqxeEkBK5uACdpSVKhukaQiUfxkAYq+Sm2iVX",
+	"RI5FfkHfy2oQTRvcKCZHT3NAcRXDXA8ZI8hjcIMo22QwP06MoBew/Xr3AAegZcJyZa0AUq3aXV3Okgqe",
+	"1/r3uQKBlVSbHE+Yk+Rb1eBIDoIaVagF/iZUtqxaZ0oU3bOk2QGaAXG/zItqcXFSl8tfThLSBJvddlvV",
+	"5nOE7P95/eJbOdRiCJIFD+t3Wv72sVKu8vUOEACEoWitHkKq+a+wIGR/gqSqk2+AXrK1epktLhJgZOSN",
+	"k+TZCmijdUSEyBRCJX4ZBZ7hCil7vzYVyoZNs97CXGHNrshhL/qr+ia7zje7TQIjzWFFsMtalTA7GwOI",
+	"RxwRSZvsuj/pm3pXLmif7bSeTo88mDfbIrshhMEgf38wE3CAfEB2bkG/RQprr8uoPo9zj4MHAmBXLieo",
+	"uy3uqaNgNVu1yIGklokZZQASmWYMnrzcDx6rhDvg6EGi4JhZRsAp1XWAZlDm4RPg0rVySOYk+U4OOXra",
+	"Vhdw3mhCT+Y39Ghbq8u82jXmowiMNPUwpwIfqRTGW+UBGnst6ECxy+/ISbwRXRjPoQzEPJ5XDDQMxxIq",
+	"CpMz4fC9t6/NzUEB+PzTmK5nn07cffiys+uDOz5pt+mllFkyoELhU2HYsIbtfT/BTuDO3YCshHnCl66k",
+	"ARlVkFaSyItwBzsJQ+GMNN1WQSDk65R/7dFSvn6DasAqL0hF+BVJSO/EriE55O2FVhpgyDIDoaUe/Vje",
+	"x7+SFHR52PmsXuIvG/7pGxgoh0nwp4J/el6t8wX8FNlPA2vw7k+fbfg/OF74RGivg9h+XlUXu627oIVn",
+	"QwE+dnDfgYvH3Jc3zozhxb0Dv7nW9+J9vwAo9EZGgIzibpvhixfqBrRe+Ee2WNF/rldE0tmq/g3/A1oy",
+	"ft1uVyHUIiuJVkDa1dnLZ29QFr6SH/E3lD6Kb7KOzn1KJzn8ZgED+blVdZvzULyCoECGJ8bkhbOd9O6j",
+	"SOMLGI1Gylu1aQKMYD7K6hpwgX/jaOFJWcTDaS1SXovS/0zx3pTCwlNaeXKusqWqAyC9dXn0X7w+A6ae",
+	"2+KYlSzGcVdIlOoKNMOF6Ns40jIBCDQ24Au9Ec0RdoJG9TH573AyACT/dmpNsaf8eXOqp+4juIMBGXfK",
+	"kvW2O8s0t6wStE1e87wB3VO9rKtqdYRlF9UVXMujZlq6DsH1gdR+a/vEe89Si0n5FTR5ee0K76WerZTe",
+	"V6zptaEhVSOXWJWt5K7Bd1qALpnTSWWum/jpBpZ6MtFAo2ULKs202rxcquvwWulRfyqGK7xsC4U5/2aI",
+	"5GoVnkK0er5B0KWKZ8t4YxP6dCbKzXlFlzL8TNUXhTw1cuGqQsguFUFhlY+bVoWEBXwa0f7wSWDVcu03",
+	"e2RAZRO/+ZsUhRmqr90XEH6DMLKU4Evb1mppDrHqRbNaietE442q+QzO1qDdNa1c0S2Q8M58Bwe5VkiR",
+	"U+A+Tg/oZpbVztqAW8oGtNWmBakyEWtBBe6NXre3eWIsAvrP2jBlwEV3f4ZbVQWQ7nSG4/f35zcC7oj8",
+	"xos9lN+Cq57BP37NFg55spAA+RxCeEcauwJAc6kwxswo1S7UU+Q2yWK0N7ZkOBT/23mG9Icmoqpm5mBm",
+	"ht8MJ8g7RbXICiZJMk2VPlfguPo6gCcAT3BmXznCOQDvpgRGSmCMHn926Of41Wv6CI23fFynMN4eY7xE",
+	"k1gzidvwoqTFRI6+QaY71GRzvIQW6jIr2wmkwDNNUkWiCBe76Vw1rtj7oHH3z9tdNFSui2pufvgQRrUY",
+	"pOfwC+ODrIoqJ9OUus6btvmIlp9ZRd6dB7T45Gt3bDJKV2hemysxtqDcXWkBy/d442VtuvIY1kHbiW5L",
+	"h+6QeI9BcWRglwNilFbw5X/Iuy6Z4e+TPv5rkJiL2zhxkfjQRyvZvukXx+j9YYdy+oQjjs+T5Kz77WFk",
+	"g6MMEEzzzGLxWMSzh7buo7fa1QsVuhqhkSqN3I++axSTxjZb56VRWODk3WQXvBFsMUcKUI0xCTMR8c3K",
+	"GLfF3CY4PzlI7zgamQoyZwfSa2hrtTWOrHViVTRkAmqhKpZ4/unLHahu9uR0aecxv+CI3mPc9ZxDag8a",
+	"shPckY4mHQ+TBxDQwP5GSch1aU4mIKK7Y5LOngKIzqk7sumSzcGSJ7ivI1JnlFheshPqGOfT0P1OH53+",
+	"ZTm4JRzQEB4GZvyVXa6g8mV4+/QHxBv2BmMe8IqGyiS5HJBuckCGdrjV6iqrlxEzhqXyoek9faS7LLz5",
+	"LCnYANGutQ40N0/mG0fv67IPer5k9WNQwqvkGZ2CrDA2RvlDhiTvNmtL2qvpOkMDcYzbqio47gJpDB0O",
+	"1axz/S2AdNFL4U5UqOXa4+XoCW/M60JQM3vouyjchxF97HqXV0Ep+zJdYnPx4DNeTaIEYDgG84n1KWLj",
+	"rrPFBVKtvOUTqDXq7aNYOvCPGoQNdBNNC9uqgRsdavmX6NDc2ql61ja9NMcS5mL5lbpQN/+A20BV3/yZ",
+	"ZNyuPSeD2PBIHD7VwrUEP6jq/DfNGiHu0hMGbGSa4RsOXQIuY5QhoVZXZQJIOsDUdc54RfNJ3aYDomKV",
+	"14aPF9WlpjwETcZ4JIEoAIhZj8pqEAw1f6dDktrkokSIxRDG+x2UXDRWxNp4npVrx7brIDe8icAwxRJl",
+	"Ea1kX1YhIgyJ8vd43zHSMLRpBlsHaCUhDrNKCdk4mYpjaHalp5iWBUZ8ihQq2+Xz9QG8PGGrBhcqi7uq",
+	"sy2vTJ6wrxxO08yEUrmwNk+yNsPoiBcw4ib/7RjyHuPgU9T3IhRug5J2JQZokm7Yd2YsBTIWFUUGFL5R",
+	"WbOrOaSzz1WgUNUKzdVZEQzONDFl/SmIfumZMwgSRfXzZbbYgZqyITcG0zvuuwf6IisdC00BUJJDxQHT",
+	"xAXO7uFKUhXjLQz0FgUDowJ5V5irMOWAY14bBVsDYqfJ8dghebStFueudM0LhU6XeldGtCcGo66riJyn",
+	"RxFIVlleSGwgGUWz8iZ4lNAcxMx7L5a+GllucF10g4NlR1ZFchsmo6m1MxfvYkjNyx0uy4VjGrpzDDos",
+	"iYzMMBFH5BS2OBI/iJYX5wWkWhfnV1mj766okIHAu8pyJxiK9VvxC1EUIdB7vixUmNA1JxwgCwwTyUnc",
+	"o49Z0lRAhvUUSocn5V54YGkAQm3d8Si7i9uVo0tyB0XtZrMtFCnnho4Eo4j4osqW4Z3sHJeOePVlnqYu",
+	"u/O9PbDIkBVMvVngfWJnaHIOeu1arj7eGmM0y0eOPUPgtHl2FNOhe7fY12xIQNwZgXoXob0UrdieRm0/",
+	"JlZBRx347tUupRxfmXLtxoMLcnHZgYq8g09U0WbNcZy9xoQa3mq+zneuBleYwISC2ksq4XQYetMEdZiA",
+	"EHux9h2Vky8NIRQcdHuQNXgLm0KkLqr2NY9MxyLry9bFr81eqj7Gfp/zSPtZq3n6O3GlKUEjcS9pFdjH",
+	"UUFlHbtsL7UUcXzZxG6JCOBdefQl2jUfI1mtcLpjXN0WKqSqO3MY7IBqRHoH3HuskfUkeUaGHLXZtjdG",
+	"bVyrUjUYFkav3OvuU9iU011c32eBoE7adAPqwl9HpiHSuPxH1pwfAYlzPVYfkzSNROtitNH5eMiuHW3K",
+	"YvFFZ20mMNgskf4+2iJptJFlog64167LqGFE8LMpqHCBmJEQq3Zt1EDZIYVjYehd4WYWYdUX9I+s8Gmd",
+	"hsVIzZwCRCqn2INjieWZ6BKrKJpvwwl2CcbHHo1vGS1TNvAp5/QJJcsizA69rmCOI4WviL8lZibm1Jyr",
+	"c0xDBdxh7qp8AfoWaFSUZmxThjRg4dsoD5BuQPTfBA7KCg1PMgnoKxexwWfGQ3YW95DBEvMqlPFjRCK/",
+	"YfOorb8M76RCRFEDg3hD0tg8LwdHr+ocdRLM4uWRhucJCZqzoEWcYz/1mC577+1GGKQIX3SEIG+UCnz9",
+	"Win/41lkk/GlgkoJsJ8hFCBtSyf8nw//1yMsmZClvz1Iv/i/Tn/6/dO3H93v/fjx27///f/zf/rk7d8/",
+	"+l//HkxoAVCnsAW+R5u6DytwjjImkw3gKY4Zst87Yk5HVas/AE2t2g6xGT4PgYwuxAjv0qNhXYxe6VHh",
+	"pCuFkZ7fV20w9O6yXqUi/wNZzXIw4Lzfv/qKswMMJAwWusQUlnqgGA90rL3vbekePJ6Q7whiIyv7Us2R",
+	"PzYmnQjWY48eOQtV6J30UTrl/DN7lCwV3FCKJkRBXT22uj76rQTGDOpX1XXvRlJdq2NckOc4zuTrMcz6",
+	"RCCr6lF/P489SYGEBWL+V9NPAsBZbP2Yszns1EHL7siLMrFVcZIMR3WcdrPuXQ1f3W3jXPqYX+gMZAuR",
+	"DbNLd/gQxjwsvEaPzdGxQH6gY2DBH+jYWACqzItj3MDPg/dGtKF/8nHy+h9nnz38+OePP/uc3ETooMg2",
+	"CYrSJvlQ60JNe1Ooj4LGGUqpDo/++ae6hoY/bvC0o4DtTRY48rg2h9j26LUE3+tjrWPMwVUbACfZ8xRe",
+	"chjtCZcfQtCeqMtvYBFny8sjBS9NNWSSh4p1WxhguVtM8uXsZ78cnxAxkDdoptrMj0KOMZJZ2lmWiezF",
+	"Uo2y074bbKe5cTe5vql3x7j3Gfd3j8ThvbZaVEUKekuTVwHL6kt5I5E3dObFtvs7Q8s3Hpib1KFduYwY",
+	"ULHgyuSTj4d+c11a3AyefbzewOpk3in74iPfWk8xRw8GAV1lvlt7dt1VXW2wAhF9SDT6lVJPmzbfHMdo",
+	"qWSoiO9kpUAR1a/QtZmcphnVlSCXCLEUVW107lmTNsBZSEiJpviHSQmrBkA2KOBUO3LCR9JWMUoUFhZJ",
+	"aZbQWq80J2DhQ8q/xYxSkOwfJZoy9O3qxxL3r62wQFuOVQfNtUtHSZWqvarqC0PjEySc3RwPHXYFU2ju",
+	"K3cLJXVqpa7YhkVMxtvHYVhfq5YsRG/yjQKlZLN9sVodJ0euooECSIeZGpwp4TecoJEJKJJRpyCiy3Ym",
+	"5DIOgGDk9U25oAv7uz0TNek1MJ3j8dkrOnr6oRhDB0/1QRMAB9HxnB5bB+ZXVf3GssrX8N726Jeo7pxT",
+	"l5PpGBF2Xi7xW508CM8LP3AdgzK2J6E1/iELeqwPB1kDQd9Y8MjaeZQ0IBZQQ7J2v4D9AwOIuToMLors",
+	"Hxdq2+JmPXIfTIggDkPE38cqxeAzz8VvC1ZNOcncDRnVI3x0x+J2Bd6p2q1FD+V8+HuESffbrLEHNNJQ",
+	"vj5vHa/I4TVYBhETmiVedoDsMQV+0/fAPa/W66PlM+Xs6EmrXQuqQrrKCxWjz0KZeHQuSwpnPEY4yCD0",
+	"Z4sRaGt6eTikU12qIsJh+MgtkIMjwt49Sh7A3pX5YpY8TFYZ7PIs+ZjjS2fJJ6AY1yjpZsmnpDVS3OFn",
+	"rEZGzMa7eXPTaKIOhHmY52KcLhjvFKY7V3SpwHuLxy1o6JnOLDzgaz3RKMMw1jzQJ1/6diXFqppFSJ3M",
+	"zDWDm9SSbxTs1OIYIhVrKhbZXBVpPOds06tu2agaawKqDCsBEiygZmIpUZBND+jcwvoaWNkyotcy/BF1",
+	"2VbKlfcO30JG1BNnjrE97CDEwjp1J+V9dxndzKBv4R+vKdTyCIY0O5if8+DezbI5OsUzZlcO8gyb2CKF",
+	"wd84twPHakcOuFyXqV1kO5SHWPWuCskU+2GaLRjhKQnP0Qhbfoun46LTBdzslhiIr4A55lKB0kEz1rv1",
+	"ShCxgS9IjA5cgJEF1ulZpsPZbBY0Ez9E1992AE8EOAFsZtHRzbcF9uJyFM4LdZNSRe4m+fCf32NJk/cO",
+	"L538I4ild0LoNXEMEuvYh3ra9EME153cJTtU3MxNGk5SHeYdQ+FeOInuXxei3i7eHi260tc7pXhTTuxW",
+	"BGRAfcf0fltod9tInwlxUqAdATeszMpKX9+juTtjYpkMxK4nBVfgSMJowk7krvMcnrHLPy+X5HxsrCGa",
+	"rz84RRzgqCkVR/5eW1H7Y9sqcdqkasqTh9ZA4bDRub6Fp3ou2DY7trHbAg/vGjU2cgxLzviCrMaGYOMt",
+	"xkbCUDhtf3FU7wfP+Zt4fpMGwiJiCJDXppq7xa5bYz0CCAaCmS+JcKiEn0s5TkIM3AC3W5QWbborzXcx",
+	"NL3mt8/a7+y7feLKWntuLyvFBQTlfYH8ylygyyXVbxM4dHyzzuIJwozMmFI2TjpoqkVDIr7lssAok+62",
+	"6xqufulSFVkg0us7fpzw46EBaMetyR6LZHOZ9PCmW0o2F/H40FUaiTP5tpI4jgWyICrulkDk65GR4f9w",
+	"hJBwEjr6wAxFcwW3SI9Hy+atjkXNwStUQYPpgUAWiT4F4AgezNCHo4I+Tu1VojvF/4aheQLPIr/fJDcw",
+	"RWQJdvy9FhDxhEsnIs+W74n3jgQOis2oGBuRIzGWjbjlX8LhnC/yLd11/qlunl5vp0Vq6FYPA/fjrTt2",
+	"pNiJ+woqHpxKgbYWEBy1apupUbXeQl7zt70d8iGaVM5iFMCZzvIs4XJIlRnw0ig5I+ba2sXz0Y1w3QnC",
+	"Fao5TApgdNvnkEHO3wmuYt4dE67lx6hbfR0hBiDmfI2XUS5+7prtp1LBaxrAcVX0q1tfT9v47+LAGPME",
+	"ex96NBzc8MPMFZMMNf2t79lpAqSge+r0wG964L/ebTbZUSqw6KIeB61LwAi5kSVSkcLBp4SMz0arJ4Xp",
+	"awePB7tk+D7rhiHmSPFBh/XYdFeg9FVXASXEds3hQ53tuU78o/jLm0VWlsGIm+GpO+wjdUY8fNuYT4Fy",
+	"f8GqESXXRCtL+9TJ3KWOk2cIp2S1CWa+0+mkqB0XKtnLPCskUF4XrZlGwzDE4wowv4iVYax27boaBUGr",
+	"+ATG0WbvbK7BhgPV5MJaPqBcq6jkUlttJZtGOfeOdD6GDTcwKs6O0ai4SN0EBcFwX1HX8C8szURA3wiT",
+	"7ObSL6xn4gWdK3UHCEZlDswo2Tl+4MyBR1qoQwbawobhe9MxiHnoEBsYlnWbEH/QQ0YQgmlNM7YV7nou",
+	"rep0Wy7T8c0F0hbUMg2T6IrULUx3kvzvake+LBG65i5P3hW+GNMMaHowc0rBYIshVVBugsHO/fvdhd+/",
+	"L3sOA63Ule5oiS920XH/PjNB1bSe7DuCFEMh+SxwGHUbNnR1vPHUShl5f4H+7ImJcUWeooZIZvl1fgkI",
+	"v7Uc6MZhFtnNeOEYnjvht8XUlC0WimotdKscUrAI7X6+iRQqa6+nYN4ddFpSqyxHJpgkhZ05LPr1gu02",
+	"zEyhrqloyVtDukfetHeFvVsizSKLF15X6PV/nG2xkxbWA5uw9AqOPiztVKts46PAZvjkZVbf3Av2bwo4",
+	"EXl6ij7nIAR05KHZcV3BEVsVyRafUOMG/QvWV7RBiupaLXYcz4C/N4HFHf9e6g0fOQL4Hb3CAFhHiYCS",
+	"oSIuWnl6YK3A7jLHwoM0LHvULXVR1HDgVNc5rotvnklE+DHOFhkyVjWTZ8pvgzgP5FHUWYCm4s7CqPl7",
+	"U1H7POrJYjyVornaRFtGKBmKxRR9xDg8bYKOFgFmA7Xbr+h9lxMxptMJe+hiKRoMZ9a8T0WSyBZ0ek0E",
+	"vFTacM4RFmjeJdMcoMNUoLNxXDDtK7HqPJb20MctW5TmyxgL+cWJJByL4x11Q2tTYGagk3XnUuHqKJ3b",
+	"Qqw9t68qxaeaXoGIVm0mnMywLkb8VWe2Azjd2JxIoZU08XilsG3vK/WrOqJuqQeLhV3p5wdKwQ7Mo0LQ",
+	"AWhybJUj87xbFY9lY4BqgoX4SC1MwbHX3AD1KCIQU52ztUo57i3im4e3JDDOkiB/Z1VbysJrnCRqWYLt",
+	"1kocbio2BiUofp3i0HW+VONp4Wbop/DdC/MZtYJXi5Q0q5RDECeOhTehheLu3tNzYvLNRi1z+JqKQ9g+",
+	"f+iTNjCeJK+9il7tOXy8lqOAx7HlObDf9q7sDRGOMMVh02W+Wk1HGEfC4yecxZXS9SpkSZHWtrqjOR5h",
+	"FC/Zv5uRcQ7NqwLwHsYxB/vdxIZgptjsXjRkAnfl0oZMMHb9tuxTWr25DkMHP3biiRkfhDqUlX18uftq",
+	"uZpcZnTfOdahp5bR3fVPmR6IGFKxwJi51Q5NQra/AHO2kmvZQe0OnBGo8WGOJnE0yI+zSeZwiaa1sL3A",
+	"HJmDsA61m0aA4TJqmFKt0LBUdURbYPzIbbizI26NBgPEpMRGo3JlQTiQoMps25xX7fvMK+b0B7HRNQLA",
+	"O04tjsyJGEBKejeJFnboYDGM3sROrXP7MFbu3L5xi/BqfweX87TJfwu2M/+NQOC8fK/QJbdRtVVx9/aU",
+	"cRbT0fKcxqajKA0+DQn0oag3RwrpPFF1vUWvgMQQYKmXRjf8cRFyAGDU1bVuQoFlZtiuw6ag8iygRpi2",
+	"8RQlNKOqQofkTFmiekngMGmNqreacDq7GcW2Xe3EG+XarYlF628r6ipEJd4IAw6WmD92GHB4BIcVD4S6",
+	"LQBB9xo3tLbhpwDaN6Z0mZEznEozC5sUfh4qQnVAobUX9PQbKf4TSbYbrNIW+zZsHvg5UnbInWdSUaBb",
+	"4pd221EKv8SwrqMVipge/hAAYUoFAz3NJO/bUszconDLFXuO04WVs5nGlSkL0LGVd7XpbhJs81VVHyvL",
+	"mgecjNAJSc2j2JUpD029xvbW/Wxl6R8QQLa2++QYSd9Ui5xuuc+WvA8mwdnWYXYW9NI0SD2C0OqO20mY",
+	"cpqxckKAKrZoqwG1s+TA6bbeLdofy4wCkjuRXV3zgJjy4iHqj/Ur4Zj4gDFQhgIAiMZNmHLQIhAsG4EV",
+	"FiRSvdmt13xOd+pH/FjKW7A5uzJnfqIwo5QFjS4tccJvbrKbZEUtz6vkN1WDDrDrGGc2uwYrPGHAO2dv",
+	"UZmKagULoZ7j8PSbHOub4HCHFKOY3ZPiw2m4rtLX/JSq5sryz6WCbrBy8futKqhhD92iBHK4SHE4CPwD",
+	"ff424SdWdfndJ3v8ZWqT9HmRuaNDNd5G3KKKyXGkTBIQMh3RePD1rF+KjBcVzEDDixbwCfHLalfyVupb",
+	"Pff21IZM9NsSr8+xgC5+9ij5sbyfNOeZrmcmf8I/0aJe7ja4QfY5Xuf56U8BSs6X130gn5VLdR1ysudO",
+	"xfIPMIPrhqq6RyrPVqtg7SdO9XeH3Si0+jTn+faPqD+az8MSThcEl0Cr6/JZyRWokX8on+1G0mT4HvZ+",
+	"4W5rpZZq2wYAf+VruPSW3U2lOjnGdEVCg/iJOukGOi3R6iVVqOBUWWlrE6x5UqcBzQdMaJoqHKy7C5l4",
+	"R+vTD6k8tpKnHP7N0e0sMnAIru6cJnlN/w2I++Drp2+SUxGYzQcI6g/cQuPIXcHHu6KEWncEC3zt5UEc",
+	"6jhykI9PKiR7NtiMinyRRaJXBgD5mfcKwT17+exNuLfHGVU8WCbwBnfqmCXNotqyALbGWlUuKQG06Suj",
+	"+H3EhJ3Z9mg0dlCDoOkim6On1ZCYkeCHDLk6Izs4/PaIQn1mEp866wTyYakjuMiVoT10BMnQFtIyDbT9",
+	"PZwZJL9iX1pIGnF7FDr1tOrRQT8fetKpj9bex/hfBGNDqJJmlH1yNJ2bnfISqK6sc5DMcov7ES4pT9QK",
+	"lEB8/ujHEm2hp3Pg1UVzCspD/SVXaT5ZV8kj3cMSY9J+LHu4jDa8dUvBb3dz4ESMrd+nn/ePP/4LrY8/",
+	"/vhTL9O+b1iRqcIdu2mCVLpPpNKYLZUu3/2Jm61aYIo57z5/PTir39liWhfx7bZJ4ZzJCjY2hpcPEgyX",
+	"70ky+ojM15hmW+vLRt6Y/r64v99WovnV2ZV2csLWNskvm2z7LwDkpyT9cffgwScqgSPjOY5JZotfhLHw",
+	"0AGgD2lKZQcLeThp4WxwU9dw9MYaE8LyW5Vtafc5z4WrUhUJfeY1z9LFcrlvoVlAv8FydwMYjr17ltHi",
+	"XvNXAx3hcQfxEW0hvYP3CZvGfeh+Of3fD96ukR7yA/2nYVUNkrjeGd2pN1vjLUrn1qN5n4zcwBa4ZDx1",
+	"FTbiPkmerbg30cz7XEdg6F7AtiM1XmekbwowJQwmJaR222Vm2tfdeGocYBjW1+pShNSC+E3Fnx/QgIIv",
+	"UssUaSbGqESpzvURidVlWxmju/lSI4Qsd9ttsi6quXC3IYtHhi70N3FG5jvtEZg4RBQGDQP0DhgIIIKJ",
+	"P4KCAxaK492K9EPLQzOC9CcIdFvQsl93nbHWEVEc3dVwxBY9p9YToExcwSWJuqlW0sKMYuRdKbbD4uax",
+	"jrWdPOcJnaq9bAmnFW383AuedBi67x9ovfMm0l4dX05xzUFKUfgESYWsFZ0iLnomTq6ROKMXZWF6Js4L",
+	"ugeZajeSTlB7GSjlegi0MAHDNdsqHBoMHyOuZnNO3XoXCrQrapOseXmSDvBOA2tBAKdhw9Ezp/5I1hob",
+	"knHIapnb5dOe+YjMRfka/7OR/xbwX9d2RH9t+D/07Keg4YRctqHtqEpSgJaw1LXpSe00wRXQPmicDUI4",
+	"XqxWlIqbhkqZOH4O55iRORTqx/eThH2TyeQRQmTsgE1JYzRwAqLupUuk+wBZqpy7JeuxKd3M+VuFC5pz",
+	"cS9Ueajla5pHQswWWgJkUv/GCZL1qjDpzrGzBMXcZVZQ0GclzhE9iCPdHLX1Q0/j1GmLH8XU2QHXMB8s",
+	"e62Jj6JDVuPqTBrosEI3APG8uk65p0NQ451fz5Heg/XOKJIlxJhA/YBp+H8YnBKY6Wjh+lojsMTh0GA4",
+	"JrzrvCF6pe9ipzkDMzTtsDYVosKGSEbs9YZcYurElKmbeD3NELl8SHt/CwC69izRLc3ld/SS6qsn/cPc",
+	"nmpO7J0uJRli/xgLBXcpgr8B04TuzUrtR6J2Cu8tJ98CU6stOZG2ZDvALuUXR8f8MAfyZMlIVPqRrs7N",
+	"N/mFfMH5qB0DBj5JZfR9r038ccg1eCYT6qNAg491f4RspJFtMOxqXaVsF+SBuFiKh/1JoDLF9slPgz2w",
+	"gS+HS+uE3uokzDj7E5JaKOf7bvSAtc509PK04PQiFBSEl1NFKsNr/ZljfSI6gbviR06et5/e4cTj/hEO",
+	"JBt1Fl1du61XuL5XVdWG4hrdZb73FVCBMMpLSclHHFwCvvRVQ1aRr5yy7R1l1zenwg80YLxNH2IsXebF",
+	"LkyvMu8/n+C0tqRJs5vTgQm0SOH/Ji4pVBUkOjWX3hpc8HNe8PPsaOudxg34Kk6MbrbOHH8RvuhaxQfE",
+	"QYAAQ8TR37UoSocEpKoJDUFzgc45ZU0MbXtb+7puZacbpdYZGsZcB9SMejXavEwnipYUzkBJqG77Vdw0",
+	"KtGW5NLALVRzJGq+f8P+egKsr9dMNZyNRbe4SRPdrqJ6IZh6Y9Ov8vKwQGXuJolhhGkdNLe/PkfrgZsM",
+	"2/TAYNpj354k8XJfXfwHdWPXjZJzLpNpytpqFMKxhBlmhbVc4KyRcfFVCYfG+GgpdQwDlymFcuFCqNM7",
+	"RXgrnzOXFbC3U1KQ9XgXG026kczbWMEnd0mN7eXbMXgdvh9Nqlc+oezUlM3QHdcPohLOXIxETDmpjWPw",
+	"5OW4/3swUxPHd6VLwFYZK8IZxNq7ZC2Sm0dkK6qOmfX681o2c5+YcrSoXcNX9Ka/xok8weVBcR23psWj",
+	"ryA585vNohkUX2+cRr8lRr5IKlcmpY3bcxDD+KIVHoXtWMzAZ9ieqWkOqNqmcXYkDo5j7bb15OxdO3AM",
+	"9KRhUDgZ2WAYr0f4HRLqYWdAkSDvVCiyhONUDDloP9uuPa/q/DfTdswN5vU0i8BxH3frvdljCk/P4CPO",
+	"ZDGSpZ5LnGFNrNKm9ttaE/rbWrW7umQCoFDmK1JlXEO6GcIlnEXR6VN/y07yfvqmoL2tqotErVboiQ1S",
+	"4dRkP7PRTp+u/m47plInbn9QY+tdv5Z67NGEKd0tLMYqPFJwLY6PcHAVOYV+ov6LW2uNAb0VRW5N2Xab",
+	"L6874Q88atRJlu3l44xYR+g+IIONYIC6Xof3k4yxvZ7VsyRbtWS/lxT7VshNH7+d9EaFXSwC+PnBqRyP",
+	"EyFXyMsnwRLe06LMYKj3b/YgQ3Uk4x0fOcDNkl1ZoITK2+6S/8ArKeF2hFKeXgZ1y7MyOXv1OP34b1yA",
+	"JFEiOTE70yMcOCCLYmaqtehA2mqdwGf1jS3fYoqXuPWXe5d5LzqyT3cUKBFr5UrPTAc9BFunbOW1JG3p",
+	"bDftUjwkAoEw9hVOFuzqWq1TkgVhIHM37txiyUAs1GORGRYp07iGRgzHZmoERJqkGZerj03WEymofwvI",
+	"yq914odeyHhCvuygi6iZicE0UE0hWt6CgIDjYBwWcS4RHxriSuPNdI3MIB3j602Sh5EdT5M4+/KZcXOb",
+	"mU72lEWaWjyZpGFmeqfG6yiI8DGeenriR1q1kqhjwy4UFIWxbfjVjHx8XO6T3/ObwnNZRf0YtZXdtpAR",
+	"5VcGCwbWhUVPkmdMzrrKcMWGiZzs7zD2PG9NIah8kxUJI6M56Vc85PB7RtEI5TgxgKG2I5j1zQ5h1nUc",
+	"ZzcJMl/2nUxSGToh365t1Z0qb7iMU4jdTVui0cRZlRX/VDff47u0nHsmXvzQMMKQEiIjTsZ1RBehigQO",
+	"CnzzI4XP3UJHGTQhGhtnB4QcSTXIgZNUHj0sXwQSjLzVVwufbPqK0C32eDaMV6OchCE8iarbI/v7Yts+",
+	"K8PFoGUSHb4wyjfDe+VHYkatvhw82w1ePiA4OO4U7ww/gqCXRvEPChpKxOS4Si8sfk+Zk2HtUyzkJdHG",
+	"sUsLvCSXFnpdBye/Zx01fBV+8/Ts+UsBHx3KwBS1LXYRXRW9t/3LrArd5NWIiYMOQ+2JZ7e0s/kcbSyJ",
+	"V/qTq3OsY9bxbOMxLMTFks1Gn3vKO0Usr8L54KNmCwmU5yUOBMyrrYmXt7GcHC7vh8hnl1le6CBKDW0k",
+	"d5sWN43Pg8eiO8CtQ+0dqZAe9bztcXeYOyx1jciksfPYz0SjmhiBTDopoqTJZqj057hC1J6HEuCClX9g",
+	"LZHgsDd8EdeRiqIYHN4COGRMCVz3pmkFrvY3ZhC5HV2HVAFPDPTVEbrZ+KYwjb6TDmk3ozrcAPpFXN0u",
+	"cyeyEYNlWCexxAsg4JjxEOMw5anJJvFP5Q8awfIp4eIUTV+EjwB3xFLkvgIB7aqiwgzBbBStYHV1hY46",
+	"e4BIRxWHRUvk2irX1axrFz5JiAyTX9a/4AF1/75Ldvfvz5JfCnngAEi/z+V3Yl/sFRFQ7IKeCKQ9ihJB",
+	"zv7I1OWIbsT7NR+W6mq6Qk+4o8JUcTo0JMp5JRrfV4K+qzoXhC7lF5YzQYz2Gcbddca3C8wUFnodq/pl",
+	"0halJXyTiNR3YnjJOYi0RfIDq8PMlQReB+w2uw0FK6cNABBO4yjnDaocJafnkf2CXo6ES+GIuzyS7Vnu",
+	"cmesnU6XHnG7dIB05ggiU8dOxnA3r4S/d2X+X7Dv+RJ7x8Cj2jS1cAQ4hZlKQk/fShG2T8rAHJJqh7+N",
+	"T2Mg1lPb/oYcGjqqVdWD10wbgmtjV9/R/TKSEuwHpJv6R5IvoVonHP828Sl5k67q6rdQvr+rb2h85FTY",
+	"4jcVtDiMR37b2QY3J9jA64kXMu2hwPED75nw7c7Y2+GBZG1hXm93zn1L69Qd2Duwep846oHthWVQdG2g",
+	"m9NBu22i+/V6Jm13zKDhZiH4SeoRkUQ776RlkjddZyih2R5f4rLgXjGjMMG4YQCnPL4lGIG5V2qtyK7m",
+	"0sW1b1dAmM6sWPByqTAoRD7WuG9M6WuePXFyic27EnoHMNhGaz0Bc6iNgKedbB2wxgCiWtcMIMb8oqkC",
+	"w+zKq6w0OQLCSvI1luPRDoerqqb+8o2K2FLJpB82FiwX/RSfZb7OuVTsDqPpyAzMiWzsG+DSGERFy7zB",
+	"Iv6mIrygBjbkwcwRyLIby/wyb/J5oeiNh/wGejdobb4M5+J2WK3mvKHXP57w+jmgFJgOPmHEAlqNHYc9",
+	"Njp5ca7aK8z5ekDvPfwi+ZCiTZr8Un2EWBTl6d6jh19Q0g3/8SB0Oi/VKtsV7ZA0WZI40adGmI7pHs5j",
+	"oOCWUcPX1lWt1G8qLrgGuIk/ncJL9KbIunFe2mRltlbhSgGbEZj4W9pNisPv4KWkl7DbRV3dxPx+wGsZ",
+	"yqdIeUEUfwwGphPDOjaS3NdUG+oILIJUM5se7oR4g88mA5d+SDmyW50i2LEbv+f7T9C5iqumTOZvjYdV",
+	"o3WGUX9UpDa3wbwiENFdiKFXlKde3DjhV4QbctfmnJfNfg1q7Fa2ZEvctav0b3ifRr8tiL+TGLjpHE75",
+	"Hshfes5OxzU8CfD3jncsjFZfhlFfR8he6xDyLRZcLNMNSpTlR646a7gymswbTtuM5Y4ODz1VKcNR0ii5",
+	"7TxyyxxJfSvCKwcGvCUpmvXsRY97r+y9U+auDpNHtsMd+u7Vc9EyNhWFIjgusbmuYeTpK7WCodUl1W4J",
+	"bxKOecu9qItJu3Ab6P/YKDGtcjpqmebl0EXgyypgOoAfmQ51WKWU9ZsacoNGFniAZDCXoWadIJP3L0eP",
+	"UwUjnCgXDufBvDh8ovFAf3QR8WcIKrS53PGoG3Kb8OpCFxokmaV57uZYJ19ytOcUwulwoSaeP2nc5Ze7",
+	"vFh+b0t7+yucw/m2OA8GUM/xw58lLcTto8pnYIjE0H1QqiI4HOubP2u9NKA5/1pNnQe0hInvdrAky+0s",
+	"zgLug6mB0hMievO2wAlcrPpVk03RLlAegDjwPdOsymFXR/DbvXqszZn/UFkRqkGLguCcnunufPKB21wj",
+	"FDoN8IWuvmZCWx1go7Jmx6WaGizoiKWEpPRjvlGSRtmpvK3LT9oGx8o/2+wS4+GPZi2PpGZ/p4zkTFfU",
+	"nsFp1i7O+f6NbJw34XrimHIWrdW6yRbnWNQGC1fS0Sxv23o12M8yc1PFDIQWLwQJ1p7YbWfo9CmozeVK",
+	"XaWEgoT8a1cpgpg222yh9qmBGa8G5NOBB9uJU3KouqATlhpzouDclfzRTaD0UKREKQPwU5BYpV6B6Xzw",
+	"mLyHwewdW9tAvyyBvWyP0E54Dj7qNRU9iTVhmRApbycUO5FmUFtlYXJwsxmLdNy87LY/8Aq9rStzgbD0",
+	"YrsHAGV8k11jKRcOp3hcNeErDjbhOWSd+J27yHAbGBsJTfOENvpJfVPvxivRkinAlKNd0ke28GzyNRVd",
+	"RcDcpuZsn9LNBP1mHbttUWFRWRwHw5oSnpW/4XQp4NL5br0m84wvW4MO8OnNS3RR2UjRzunjDFcRlH5L",
+	"KFlhzZttKDMc33ijX6DmCm7AEhluXOycJE/YZtZoi4w04KImmTUWCDbTya2NTir8R9tmFHXTVp7CFz+I",
+	"NXnFe4fofsL6rLSmeqcijT4fmegRbvYCo0lqidxWocXwKscea+fws64H0GVlcwpK6wV/eUBHJVPKPq2d",
+	"penE/mjXwOlUwAHIOojf0xTBFYOm0yTz82uuRhQgyva69AfrdpOTwv261WbyjViT4ZJZlTkm59wELw5U",
+	"YH1adIhMYiXFePyNZnHh0ABzBejVKRAlWJT1xwXh60gZJ/cpbipTB//ZoiwmF8oaS2ixZENNAbcnL3Q6",
+	"A+iQqubwZSQiL9O0DoS/hMLgbCbQnmRE6RsRkxblvXwrBk+qlHiRczc/QZtcR9lHgcUNkdpLDJdfY1Y3",
+	"r6eTPfsv/OaEOkAAxD+dPK/W+QI2nsbgGEQqEUUBt/2hznT4rRyg+O5jfFe6i5qfvcAhnhS+lUmDmUpm",
+	"h/sK2nUZRXAowEVHHDjINeO7ow2Q22DiCJ2nSGhYugCoQm3pHO4Rhqrr0IX4KRc8oBLx+IY0aA825wFl",
+	"OXA8oQptrlGBA2IRPBJoY4hfI9/B+6hZT+/e5sYzBbRodrredqhuB2FECa1RzxHfRiBz6SgXERzmBXud",
+	"xErOmimQuh1l4jEW5NNxzKQE+eY/1KpEiVpSiKQkSbJaFhYcKLhTkJWNjqmefk8xn1M/7n1Polh59PkO",
+	"tMEWc/RD0a5f0tOEnibLHWkO2BN8p69sWEN6Qe2+/P5ngfhengg1+d1mYC79wi2ng9sgWmU38yIQYPjE",
+	"PIR59A5T+dX5Df13vxukhPbunQ2vY3CX+zX962f3h/NV80WKRXmnY4LOlNujw059GKHb749K6TCsD8h7",
+	"7no02B3W2aOQfHuKB4fbXqcXM8dHi+nZQ5f8ip7rKrgmCbVjt8qYaHtzyuYFtqwDvH4xCDgcfpGsBKfX",
+	"U8bnK9+1Y3UoFtFCe1krNZthlYMiKFoHl4NKueItQRH2GcUCSTmOFB/3vj6shMwiGpxrEKrzA/oA/VNn",
+	"HCbbLJegICss+piVGOx4bvIQ09kN7i5CCuRF/QhfKfW0gYtDUPV64/WkxF6Buk2gVFp1uy9wp+lcuwqR",
+	"9imPpewUBArUHVAqhT8pnncfIGaxdpgDhdebsfBSqVok4GsPVKeNna2+1ln2hMhlb7UGqtDesG0cOLSq",
+	"2ymWUa4hyCBLnBqHitnX2Huo6SfUI1U/myzwu6b8Wxl3tVX/CHZdZymD1t1/XkaLuUgXXHrudtuVwKWZ",
+	"NFlUl3m10wFnOlxcG0X4VylM7HXVjUiAYBbGH+2mHKyRgE0xPQvtP7/n5AIuW/EncLH2Nr3bsjlw32MD",
+	"rX1FjEA9V0/ErOPphVM6RIeaEcvtSFuL+XD1aKnX3LlHVk+mKMQ9fADQz5Z7qYyhhtb3eJSfojtA7dxj",
+	"hUjpGddkl54kZORlXFBRzAgnLfJ6gXZ2dFfF+sjDSH5nL/NRVT6SBnAYTkXfzxKT4i/F2A04eCDBXlwQ",
+	"43camVD7h+BZhIcAfhVJtZRmM90pAEoLcyTVpmpTrxnKRAQEUigkCtQdzHeTekuftu4+eNIxho7xSDwI",
+	"vdHJ+4iCGJ634s4gByBDvjQzDo9/2GomzlEeuoTSG72jIshTOCEv1ODEB66tnLA0IZy0UJeqGF2bbr1D",
+	"iqAzp7jspWX08ExIopOYz6XpKQyoJ6ijGrWzErhxgjSd71pd3Cs0I2Xv1Z0iAvs0FDLNRFGeDjXK2I+0",
+	"yAJ0Pry1XZ2sJ5gdURgXXqNyo8vgEYbs8FCYsrvU2KGZzg5bt4qLwuCBl6/PW2oADYryUtUvRxpc26bW",
+	"JF+3VWOq+YJCgINJ2eNzGu5kaiJiv1BabyztWL8E0NEv4QTQ10rt066bm6ty+Nhdo+s4Y5h8TelvPdTU",
+	"GkipokiA17t5cwO34k3w7qIfSvhJwd/o+Fi0dZG5ERZYA/fmm4CTTZX0znA+JOr4udWH7LxzVVRX/AqZ",
+	"xYidKOsFYbldyUozyyMuV76hWCWKUcL4JO18xnCt67K5KRfjWdp6sbN4hOE3GFS8eOJC1kf8hl5yiy96",
+	"Pab7EWsDo3EFOlsJT1bPUwStY5O2TECkOFo0LqBXVpO6zoHEI9zupUnoEqqBJTEymifykyHG5jb7ypBh",
+	"ZQsCgB7BmNsiszanbK0damGfpqpzNWrm4bdcbDAqGosJyozD3leLNqF+UPBDkQFVR87QJs6ObzzG8Nb6",
+	"SNtpMOS5AI7RBgaKmfhZSkFq242usrJHGUS3BKKeUvA3S9bZbq1myTmskxwOM0SvPMVNcETDMPe48866",
+	"vGQ2xUWSjBjiM6+/0D9DKolntu53xdg1Y2wXzVk/M2mgXP4CS8qgRllT2JZfRHByJSeqJw0q63CXnB8w",
+	"kMDKjZkONZCak7ZpTm5qOOwOK+JuARpqYjMIjxMUe2twYnWMAP8fNIlHDdzMK1bB5JAGqYQB0n5SXQQ+",
+	"FhslmS+AAU0ZhAWd1tjpRxGWEjSd0/PpwLk0SaIlyPaBGpgSK9MfOBd+GrvslKAMF2Oo7/LzK/ksXiaB",
+	"cuZjrXhiwwWkBD1wuoeGhEWnTDLoR/6ddWZOSXhAnXXI/J/XJhtFnAV8eeMp4dhbRh0esRgVUpO0Vk78",
+	"JaOhgrzZtvtH800bbHL8XSxCx6l9JbNQm1jCEvc61eIUYwA5Y6vix8qxHjF8eWP0DOqdCkeG1MdO5T3c",
+	"r9oLWG92iwVQDqBPU7D5isagsc0I/tvrqnVogF5fZRiqJm+HkCdvuK4KvVg+5Hjue8IinGBFn4R74WqA",
+	"gsUvOgIwuOawUnAdlKyOydiOBmMAEjx7skaK1kjGJuz27IpW8ewy8OvdZpPVNyMrh/nptRgfY+UwSksw",
+	"Iah/ppOf7GtB3rno9n4hvya5M8nwPbMZl8JBDrUecPaL59KcdoMNhaQLlAAIyulS7nReNyHHGaoPwTVF",
+	"wdMtwm0G1LU2TujSc3vtgA7A+OHu9CTTFTZ4nXxzZssIrvN4vbAGjuQRaNxeOeJ1buJNkA5t0bU3SRwP",
+	"NZa4hy+xwgtMVMo9xTFR7TzLqawGWaK94zx8PeWjOsXrTpGSKL+Z0NuIXreHBOnRvtGVfLcPtOIgR97B",
+	"fZeGQHIIo7s5trHOcQglqrZ1xV1Q2oh25/7g7Hl4K/T6g6eJUvXjqizVImaSWZinKKEyStobziMcLGn2",
+	"7GW3qlmtNohWZffdThmuRJRts3le5G3UVGHCxlaKujZgty/QVDrlKGklEqNJ5apgv0DeXijK28rKEnC5",
+	"UEaS/CfFx9CmItbSr/TYYkNOJHmFAm3kEZWpIQrg0kRkg/i7pBXsF5NokZICzNmAzWtXd/IR9Yd0NjYK",
+	"flhGzDDLHccO42W7AJpKF1OqylHuhEUplVvKEgmq46YcWEyHRhQLEbaSx1pOmFd5Q1+EAdKZYZGl5hmZ",
+	"YZmgZuYKsmvXFdlrhwmJlB7Y4jRuX0PgiQT4TTGq6ZVaGgmRUaT0DmEFI2aziLsow36Xa2QM9PZcKoNJ",
+	"+oY2sczKSjZy4qp9dwM3dZu0ufptUBpvMB9FQ+O4Eo33DZFycmAKGNUDrpom39pELZ3xFePesOJOjTza",
+	"+iZd72Lqj3kn+fo7UONvs6GO0j+RW5xbwkG4pD56k6ai8+qAOaJnVEgIhY+VEo9M57YUD/19wmnncrXF",
+	"A4a+9QLkMdenG0iISiU3GSxubNrizCp28ptutM6zFPmFsp01JUmUvODyxkjV8LhfsNdzTffl6QK9MjPn",
+	"tlhev5dCf+O5JCL2x0An7rSKn6a4ywcN+/lJ/l5R5T2Ea6Xqmi8fdFZg740Uz3lbEjsGxxAquNTQQUiI",
+	"VFqi3gsInHiQQwYrdv179z9GameBqHJkCF1NKk1UkZM5h5D9mJ/r5gG6Vdhocoeh13Q0AkGXSUR9vYNE",
+	"l+pRUquBg/RSB6YGmj05raj8Hl+6h5a+R9ecxc1K+EHtyQayT9iwe0AOSg5CqU51Qqq/vmf4zE+SBO5e",
+	"7hZS+t1hWpOnM3lxA2IumL6x6K+yc391qqzD7efUr3sQaEQn3nUGXd8gfUqZvlUTs3KaENzro4D3Rya0",
+	"wGxwpU0j9uVnsNML20YgJNIucur9blorkd93qT7w+ZYikz6k1DuT5H51fsPDngPuFNwgPjpJEkyJweKS",
+	"Ot89dyDoTV5+0A7Nf02zLneUlZ5Jrs3Jj2UIKxYLKQqCWBmWpWO1xpIot0QJ3LmKCrvXon5nQXiUVKgE",
+	"ok4wA7FephJhNuODQuqIzhLOuML01hlGLQB/YU0ICurFBsMbDG6Y6fsGHF2pLh5TAPAKf9g1Ckuy4OlX",
+	"pUV1NWMoVjtsIokhVFeqKOg6z0oG2StSpx9hzfUHIjVmSPWqb3l66WGGzyw4BJa3nooHGZ4IEB05uLIr",
+	"6tSIwwVPwuHw735Wf0chdRiVoQjqoHUFV071ONuG20me4UGAb7jWjGTBr5MhbAliMRQivaxCSc6ufU1G",
+	"SbAeNF4gOXFfhw9VVyVn9ofNaXte72Uqe7VHm5mWxU2ZbZvzKhY7GhF2b0zckbcYduwgh84kBTx8rY6o",
+	"M05fAB/2SGvBPKYX6aoCnEFCe/goWWx3s4TSLWem0pSpPymhGKdYDGClv7EB2ecq26J0gBMasAf0CAIr",
+	"L7G+HZmvYbgNHF/XkYalv0V7lf6mOitdGpojC2uraLOuzukXCiaLxce0WSyPiQpuuftE77KQ0iThFORS",
+	"22pxPuHSR0TuEKP2K+dcBwNXrcGKcB+ZDc6iibv6ooBPeZcY24YVtVk8cCOTz6hqxwBWcEfb1go1Mx3o",
+	"IV0AsXhO17YykISZFvkmb2NFw6getgneLFS5doMjO93nhyIeMJc8X4YN+nGzAp17pvQUnf1eWWLCgZBJ",
+	"zBA4JIMMyZnBsPX7NOypa872G1oP4cUuPbS2Qq1at4sl4RDbwYo6Ml2HFzp4ek0lzcMVmEAviPV2hieY",
+	"pWqcN93tdYDzDtxDQn0kUTKNpAG7AcPDNGeACu+RmYiRfQDp7TFH+N4WnWHK2FOj+mNDTIjlGJXG1vNp",
+	"Ls1dBpkilXGyapsyUYeUjpsuV1dwhSCbeMeIZkpOEWSP+D+i3yLUwEyi80pN+6oW27KsxbgpUQPQhYND",
+	"O8P6vIypR6OR5+o850qQqKqnVbCndNf66Al7X/x6AtI7rIyYEebtcU+fyrs06VR9ov32t2Lo0LOyZH/O",
+	"cQVb5riGaQt4O6yE6yYg72l+5ilj4WlhintjbnyPJLmBgrtgR7MaNsn+hBqi+HfkINJ3LnHkyuWL73Ww",
+	"SeZWhZ+iskmmA1t7yJR3IwUP8ZTi5RDfxvhqeRNxpVZV3eVCJHStGBpuqdlvop1Y48S4kO40w0RA3ovv",
+	"uCrlSKHLTg1LQE5Otwg2nKABn64iXNgLcyjYaIqn13JXqGW86+6oUJVYBE5oM6NTIy8GBluCrLKIF8JM",
+	"k1r8DM9nvvDmKJVaNlIJQAMxNONYaIMeF/dcfyF109D4cGVRZy9JINAW5ylGbXUyxdwYfHVNcTC0XeGl",
+	"ms10a/gJPI1ftc8v3GnGTbEiPoVLDi1SrCiJvNwECnkmZ/wKb+4SS6Ihj8gXeNdAWYY1BadjwNQOTcUg",
+	"GTMNBSqdOmVcMUJxtSIS57YyXj1kiWfsrOaFyBTb48B9rjMsDPKMnVEHKEwsxhApVRuqyqARNqRl9AHF",
+	"wI4m4UgPkHYz7R3RRJs3IzxAvj+Wb2Mzc7BK6Y2vUOvI2oGx9yr0Ied9PMY5EngzIIeMxJsmhyI9iaW+",
+	"Y1dAOTKky9BxRnQ3298AH2VDYTavFHZ+eqV+jcfZ+MGjv3JMoNwpavqcyEhHcmBlYeD8Z09Y0MlB6x3I",
+	"4kg1CUF02tLrtuFdxSLFN9J1tYppRmBZ26O+lTVQ8IblwVToXSNtdLDJywsUnS9XRb5ox31xUkNboHRh",
+	"0H1qWM/RkDjaUDkz5w0/17NKPevgsE3ce0gAR4KUz5UTnFzV+TrHYChnXHHnXcB5KAUb7PuG+LyqPW2j",
+	"ipVZgYkTkt5h/ggBD34AP3os/XYHK+FgVHwn0uqZPu/fd6NoD2XThifkQLpoj2kbc2pnyYUKKRBvEIRA",
+	"rAwjZFjNie2qsJVmGrmAovhANYgCcQXfrnUIrk08cFZaQgxrAewYiFg82WkwQEgHhIGP2PjCYSE882EX",
+	"7ilMNW1t/dvDkryassEGmbJImTp4gCB9vVQ1IahcxCzOqpBQTbo8ojUc3W863JxKitXSSlG6hV9pbybf",
+	"lNwIab1UIu0aMFjzkZ8Hmufoe+ow0Yo53R13Wmi4q4Xyy3XKix3tcO+E2usvhvPNuoHNA+fBgaHIIyBH",
+	"9wBl6Hi8ugM/vnhbRC1QvGUeUB19L9bK1dBEaNOcPj0V/90BNsQFr7neb7yDBHUaT0xzcC4DnSVSJzhp",
+	"iirU+Omgdug4VoQLndkIolaVU7pyGzBk8CAGpAnCaJ8F02JB2iZQgItus9C3GWDyNFvqrEs7lOZTUFkC",
+	"1+anjYH2M7nM234NqKsStd5Q1C8IkhrvP/aLMPUyUNi0LJUCDKHK0ivUbNEYSL3b4UXYflJMdxwzy3Gc",
+	"FgvhuRYVB92GKokVSJGi+klornU+rqm1sGN7YhFm55u5jSjg3k2h5UkBQ0lq5ImuQW4am0g5+E22JRce",
+	"/ZXCXxxPbhIqtQiH+fJal1gPLw8DcLgWbUoxY+vRACvZvDf4Dbc75nFgkJQRnHI95ACRUCQBZjbBy3o3",
+	"+OX+dhCNUpWenmEyGkmMDtQQ5ROKs65b3weAN0PXo9e57gSALs08c3I+kKzyXghZRDOyGxXIVTN72khU",
+	"NW+5zkc0zOLNQ60pbf1o2kH9TePQX0apoHDdsTHSQkQYdGZgnmQG0VvPEH+TbWNVKVWKhpzoRtCyKJIN",
+	"Xwto5rzLfP3QIW7aMSOF4sg2TL5U+4v0bJeevLN+wwNu/TKvrrEPAKbXXwBbJN96BiLkK0uDDaiaA/tK",
+	"a0XWr/Olmoo/GI8i6V6Y76QxwJBPLeAPwDYisiN7b6EcBr1yiYEN3ZUkRNVyjLtZ4vYZS+sjwkTYFawy",
+	"sXI5FkJqTINJ6R6GGs0VtWk5z9fnuFfYGuIF9skEbURtPYvfEivg4JmVnL18RlVkuU7GBEXEwfqEM3W8",
+	"uORZf5+62+Qfr2EX5xkIq7ba5Iuw6PtrNVaJtkPpi5NQwSV74mm3F1dU0WUUtHDrC8OosOtdUHDESMdr",
+	"nerTOdhZhlnYTCtIsr/xme50r+UuLZ6aFekagodERHn0MOHAMtMXdumv7UYxj8yuN6Ovn1uUeJAN7aOr",
+	"EYRImr9gzmddg3RCVw31tzBWOitEJ8LhkuBHugP+k60ZnXFtFl5EBQ5Ietbcxb8xAQCClNtTIy2QauNq",
+	"//pYa6s137SIWruATtRRqXnI7WDDEY4OFGYj3QKo3vltAPyQEwNmxNfFTB/r+vlHNhnsIODfDlO5dwjE",
+	"urLYwx61SuzLItl4Mcke7Kky3MKE6vwa/XW0kYmJ+5qoUDsAxFubeDBManCyLxgcXZpmASQ/M7ktMycK",
+	"XsrLu0ZqKcTDJ/Ii48PjnCNXMc4U1Gn0sOEBhtcot37hNmvPdYCMjj3wM9Awm0lSen9TdUWFuZczp74U",
+	"5RSSmc0L1K+2XAzTt9SSE59rgmCKp3zbmI/hrFFbqiYZunx004Zd62U3dI3XnjrNMKZgN5jlwIiVOOCR",
+	"FIZwTZUyZTZpprISQnSZL3e+pbvZVxP2U3SQlScoNAbWn6ZJir2FRHhxQyJitPkQ0XyQL8tw7yHmCU40",
+	"MqmTNNvSXI+ZCC1nN9vsqoyn84SCPrX9YfrtyUHsU/ic9A6/uc7tccJ5Iwl1ohtbg2N42HsBkovg88Bt",
+	"ssuixDpEqzCC5Hg9CV7c35iLeOceTk5YXQbRv5DPNG2J+XiD/nr0vYZNGvYE2KNmRKdgRN/kPdWVJDCn",
+	"2aqNubA6Faz1suiLsDwcj+t1Zh4K3YhM7USzHTa3kzY1tPLOxh4bAy4UQ1gYAeOW2IhmtvsI6m1YCIvB",
+	"NU1iP20TGqUDh7FEmzq/2WJQQ5vbRFESZL6q+w6YCzXFgYzz8HbdZkdkthGEbrfDyLQoKjsZ+lPRSTZY",
+	"rsh1oW5YAUQLpCiL/IQj21gb5KATevcClDixf/EbkWasywklBSIRYW7y9BQHlu66Ra1BO2nJsQgHm5XM",
+	"GSu6gUS1bW19nE75gn3UM/iEMp89g+l4L1/G3CiBcELzhBMvo7pYQsGM1xj9uCeig54+z3Y/O/R8XESC",
+	"u7/CSA8HALkbMyWGeRst6NWiU07FGeOAg9UcK5Pdp7cle/yebQ+R6s1SubnfDjkhGJJf1r+gFfn+fXej",
+	"79+fJb8U8sBByf37QYFoz7HJ6x5qVGWcQXoXRsKTwvzQw028GKzDJuhU+Qf7VKYqhzqkS2ie3DIUdffX",
+	"UAb5fBnSiKSoh1tFhaO6BnShA0JCBJAhpSgKyZA6dAgoXNllOVjWJcKRdBikeTktzEQfHU5mZsQoy+NW",
+	"u3afgZ3MwOjQI5qH0fL8/XGQNMxSX1bX0w4dNGkeyimTpShf52Am7CxRrjvpCG6XqWgtNPwan07pSRdM",
+	"kx7iNTdZGicavG5U3XQA/AIDl5aqUNGcVgJhiMu6MAzfNaJASDWjaeHqLLGjXTM8chrWdLHHIpkQDVHt",
+	"qePSJwZ8DNnJW5s6oq7zpv0LUeAf1tERgaTHf4LejXFiG+pra4kOe+O+cAMfQjGnJGmdXB80DOuILPk2",
+	"4JDhkm55Exggb6xFukXucpIAndewb4KkElGtKizRuMRKbs7r2JsQiBUL2l5lN83hkW8IbY17Ohb8RqE4",
+	"OKg2kYfC4Kj+GgOCObvkbY9Fbk2IuOIooX60FTuLsFp8MMCqvythy012jQF4Kdl+hyseYPgdm4ixrDBG",
+	"e2D0zZ7zxCtq6Gmoi7IkEMHqcNZpU0yOcrHbPRrowj0f8lVrjcwH+x3DF+GxS3nn1mlu5d2755/AhHSh",
+	"O3sfYKqI6mt20GFp9oL28TEsGDTgm8dV08bKB7v7bbyduiMkPZVjdiGDBdKm5MngFPoliichnrT2FHll",
+	"WS126BrMBtKXbr0QLh9jlzLWilCvTSafgnby33xXxmqmyCnA7vu85HMSJRXKUEmYJCmvhTsV5JZGvryS",
+	"QNDPIpqoS+E9plnTyktC1WhTTgJ6VKn0QkYiDEJh6Q3fdtz4kD2CMr3I91BAJkfv6AioPYIU6cPn+jsc",
+	"ir17KXn9moEmiJZ2JBaLHba9gotddyHj160htYc/m6NgsLwFO4KD4EmiMZ9//rSmwBmOMxn/ng0nDNK2",
+	"2k6ryiuXFInGEVB9IKNFoEysTbTyuaQ+NG54uE2vtsfBB41YdA8p5cnnk55r1FILfDgsIjpEGIgj0oSt",
+	"paPEyekUVCdkEKO7C6/MsRNTh9m/+dLJ/ZfPQmmvxW4TSe/G+I+U4j8Sfq0DFbZMiES5BEPXncA/LkxG",
+	"GkXjGNftEsJ5kXmpRkGlRg7+eDjbFHnPuBDwZbqRHZ1idO/2JWdlZJItZEYBjzvvAs5KLUXVqGWwNIY4",
+	"LiJqjHZrSOsS7dGqez5Jhn55gJvD9b8HJImx3DZRI3HjKKDduHcOiaLLheQdIfSOUWRfaF3nSQhcsoTp",
+	"UPwho6F+R0AUBLqZFqyRYFlpvkHiv+Fq1RwCdteYHQCd0gOiF3gfl2IRIdECdxPMoz0GdrWBcNTPpanW",
+	"o48e9vWaJvDlwJX+zL8eeIzZhjMUyCFqb7l87NBtY1tk1iXcuJ3O8F2dNn1QyP8+jK1zR1zf5eG8G/dP",
+	"0v5EYBAvE9lGuu5Uy8jNYUw6BNIAobsWQ3rtMCIemp33mUp3wYI323giNz3ukIetm7dXAvekMg6jhJyX",
+	"J8kTXefAV2hpFzG9nr8pKdJbUa41ES9lsiSRQvbD1/9g1FvE4OPHPGPJxKygwFWJ9aNCGSbCbUb7Dcyp",
+	"pa0f1WeuXlRgDBRGiku9ym7iFaZSnUHQhqF8qbMoaGSdEYAgNJKdTlCLXs6XvMYaLmgOV0HbkzS7985Q",
+	"Ux3Yxdp2FH03i0loFttq9N0tRwrhhxeA6UYU+QxQDtObjY3WpBKgNbi5he56utT7AQuMBXwGStBLAOjx",
+	"t8pwy7vYoMmc/zKW4/pmYj6rE+zb1bECe0biqtnpOmlkI1TZsq7g/kTlCN2rpLbsc0/tAb+kROdyr41g",
+	"8nZ/NTSbXND75uqgTzASb1ldY6HGwVKpTgUq9Erhi1jZcBkfkew4+w45UAHAaV0xzXQQ2jy9cy2x5BJ0",
+	"qPORuZqxTRmcMLw36Ixp2rwoGKCZcXXgAYk1ZqgOSV1Jj9CBRBB0AUxDMaEXSwP4N4l+wPrIRNPRwVO6",
+	"GYKBtGbYyWIpyDjPLpWAGIuaID8EmlGbfY24uD6dAk6ljztW5YNlmGcinxKG1+P1Hgf2GahP/O7eh7en",
+	"g6/gVacCKYRL+T7YIfSsV8mkkwfH1hEZw7SNKsmigKKpVqk2CQcr4Yy2yHWK5h3SFRfuxNtQLMz3r75K",
+	"+JmTOFqtZk5tCqqWSnPXK50P9P5d55HO7Qg/PXIRhFdv6hqQFe8fUFNNKQ0G7RHAuzncD9zYPdpWXVZT",
+	"24tMsaD3vIBw2+QXThvhcbBtCPdZPLj/SuXr83aww6pUFUNPTyY5dzypV3DI7xQ9HiQu3KCpqrtpGgcG",
+	"wqDEsA2PwrVMvORU6tw0WbJiAAUNrX2ZIYWWAHgZZotXNk0xs1cI0r+XpIFRNR1MC6KzT2f5daXSNzb7",
+	"b7SvFkGiPxgBzy05b98z1vU/SMh0yOUbgxRnKVFK8JY/tB/OAm26pLNFEgvSoimDtO+qf1p8iRaOf1AD",
+	"2eYxsQj6eiNOLYCyqoWTKNMUZmmx6hFe3wukb6mwxh1pOTyFrjUu4SBT1Zd/hED9CtNkzwgfavkqbqTh",
+	"dFprqtFIZlRGAsfGQluxNfiEuTvVHI8zNV7oLlX5Q0RKnlGhMhxK8jB7928KLkJzbLV2TndMPGG5xqrL",
+	"w8+TudzN4PtF3nTzO69IM50rU1YDFIJ8dWMLFTt0csA6UeM6nIxXOl06+da6Yqisytqp+WxZ9A8WKhHO",
+	"DVJ5iPp6ZBHA34iMgpsWQPYyA2myyLdZCOFnHuvLgUvuLdu57DzjCM85FuNecLkzII8b9QdotzFFQnQW",
+	"oXZ3ktu2VYmqFmMaA+0B7WCNf+8itXokLNDiVaJvqDw71zLucF3PtQFXevR7pDHkcLUejZzOKSQF9nN0",
+	"VknH07myzhY5tjx/zP6cv2FSTLeWFgPoAG65zHEa2rhG1wu4ZAu6NIwDspvhAcZv0lEw/R47xBuhho+D",
+	"4H7r9VGuG9+RdaiQZLkd3csfnE201LPBEk/qGv3atmuBs8e8qfHA8zGgIh6T5/YwzER4mQKHt0IC73UU",
+	"CVxtxGf2JsRL0cLwkwCop2x6SFr6kvKA6VtcYDpZ2JF7Rgu8o9Bh38XcETIRnu7wTJecnZY53g5bhHfW",
+	"HpKubujnyIXIRH5iC666H/OKXS7qQGCgXOjjYf0TY8E6aOQrZzwUtR/UOnV5tA5ifSyB31vnXnFsQ1dR",
+	"u7ZhyN48PXvOSmUfuRHj7Y8//qud//jjT2JENR9H2vKGPm/xc0YIvmRSOR/+Airzio6UKrl/nybAVE5+",
+	"9ZeP/cfIA/fvh+PD85ASBVPvcpwaH/cAP4jhtJFTEjRp3iDFWLvylxgAul9FwjkoRUss4HxXknAIqdOL",
+	"KbN1rO0kUzS7OQnLwRLLY6U/MaIWC8PjhShcBtTbzWncHqSeKZWPBspl9rEXrnrEmQEaMVL4SBINKGq7",
+	"T7KcuYaDxvTgsSrm/TGpwYjWdk2+abf9dKwq9C36X9vpnXYElFMHv/036WsdLSr25kC8hA+E62eBbSd5",
+	"Ivnnbad+J1uQ3Fl1VYeO3zGWK6eJLcQA38PGBaOUUWgt0abScKCySWIInJG7vFiOse+X+JKeDUt9qFI1",
+	"efMzrvTnOZwq8P37teBpCDjjs68+May0wm5If/d8jwgfRkxgrd7kzlS4Q3mLwRJ6Y2ywhxf67m5OuDhq",
+	"g2FPeXvzGvGvAxnyn4Pen68BmJq8amxstYmJYnFrqwu8JHDB47V5e9dom97XFdxx0ArG+ZIl2r6w19nT",
+	"62yzLSSHIfn7B/P/UJ/87dPlg08e/sf8bw8+e7BQn372xYMH2RefZg+/+OSh+vhvn336QD1cff7F/OPl",
+	"x59+PP/0408//+yLxSefPpx/+vkX//EBeVoBZAZUJ4A+uvefKXp00rOXz9I3CKzFCawalETACUUNrCqO",
+	"hwekLkjOozu2gNfkp/9bH9YnsBo7vP4V1ZsaXz9v223z6PT06urqxP3kFF3HIKnaarc4P9XzYBNiXzd5",
+	"+cwcr2w4oR21GQ+0qUIKZ/Ts1dPXbzB29MQSDDx7cPLg5CH73lUJS4WfPqGfiHvOad9Phdjg3/DiKaCu",
+	"aM/lD9jlOl/oRyR55d/NVbYG6XvyK3fOxJ8uPz7VxszT38W49Hbo2Wk2ByV7QfFo7HEJJiiS4UlMKE7u",
+	"H9vypFsLxYRoLx4gjPuHwGOOycZf+PVALqFzh6KQRsznxNKaWO3Ilvae1/kSw/iRzOkmyJHpvIJOWiKS",
+	"Odp5fkXdkOvqS5dUTj9nS0atLpVuEdleAajLX7MF8i8caLhgGLioDA/pzENycmxUfVEo17Csi0vwW6qb",
+	"odjM2CYm0RT8FgPjD6Lv38YsnWOJxBz1GVwnRigv8CQm2pxpm5p52+kdZt5KimyuCpkHpYWNvbJzm5a9",
+	"uHNy8zelVqTOrLNR6G7SYOmuwwTNBmuv4w5isfWb5OEDHU+rQ68vgaHx9k/OpHpxnmPkZUnZgdRECPFt",
+	"YZemXyunm2rLQbFuKDPTzVMK2X6Mlin8xz9oIVRL1QmRNTyM5/w9ibM+Yyp6KY5dm/gNx0To3iJkZl3y",
+	"Wu4hUztiyRh47bFDqb73+NilvEFziOLBCKfiT79/9re3Ia1hOMZYMwIltJO7JmuZvsIsgvlfuB/m5RAd",
+	"+SHSLt0hIS3VKgNFuz+L0JAHi6ivC3bCUEvHC9B7yKKuNhWQimOsnvUMxfhUz6eRDURHeaGCbWMOMrjt",
+	"u/aDos16VI1o09Kr2WGzDq5FUzatROyFZjeVWo4DQFBYUtaeX1luGCb76ihkPyGRcslPOpU+fvBAH8Xi",
+	"XnNGO5VTx6VjT+fEmgR1OpjJbZsQasmKjkS17MjbQENW/30MjBeZ2RtSFycDgb6SHFsuQHaFPWFdEreC",
+	"8ABPEq+W8lQHUlj7UzFc4WXH6sLGIiM2zXqLOZ7dq4rmS4nQ4lQjpm92OtNB5kVD4VnIR+CkcjvoUo1l",
+	"QFhn6x5HpdeJzK1+Z15wOzyaEypWIVAv2sSpoVwib3Ruc4h6R7E5dj2xxNkWqFqgbdquTbchnlpObWLi",
+	"SFCoh62J2+3+DLeqsHjIdIbj9/fnNwLuiPzGiz2U34KrnlntT8iThURVTkladQWAjQ8jxrBuChfq/l3/",
+	"be8SwuFNg1r3LVTtmaOxnyBSP91T4A+GotV1VTsVpHtr+yYr2AxkC3o411pSlr3zkCpCNQzmw/cH5jM2",
+	"UVFOIN9bCYJP3x8EZ674ltgJK5ak7Tihi6UGvmBUbIT2s/e5rc8w3gs7xdKbPP0n72/6N1WVbDAzwb3W",
+	"ELr4ik/qY19ifFdSc2INNFpndptNhvVU7n2NeS/+8RjUp0lIZJiYjM1u4E5DUr+sSuPDxACrez+9HbiM",
+	"Oxca+NnT3Uau8XQrmvAK/CBq6vCA4t1PXZCmfTAOiW05HH+nVuiOBPJuuapN/E03XSQw9ESUDr12Oq+u",
+	"93hVNVNf1kUsUyejWH84sFvdR6dyYbevcJP7099JIryN/X7qJCdE35EWVpGHbOSLPaYoUX7nVCuu4TdN",
+	"DkT0DW+bf8fWxW+7Y9LtdLc9/d1eU98yr2PSfMCqSsW0s86tFrgbgGn4V9QR2LtJota+2bcj4FePGYJR",
+	"AwIPlOiRAoYDb6a47cDoRt77jkWBzAkPZw8fvP03Y114OPvsk7cTda7H1ob02miuE1+87YWyZ3VxDFq0",
+	"SYkePlgQC3ci3ohGtqozUGKQMdJbuzN8SJt73xrVl9ky0c7lP4Wa9AcrHged9mfM/K5QSGSzJx/wQIvB",
+	"ImkReQPq+QHy5jV+dSdv3pe8oU06hrzxBzqyvPl4T57/66/4f7aE/fTB394fBDo+6U2+UVgg/S8q4V+z",
+	"uL2VhNcKJ6y7Bp2Uyz9W4aCuNZZWq9nWevbqcfrx39Axpktnl/Tbp4keKnE+95oaY3COUz0TS8FqK6rr",
+	"BrP2SB5OQgKWirPmKCSJp9f2Tnkxkz7qoUGlOpx2wZWqvarqCz0X5RbkJTmE5JH47LymNW4AaaLRwoU1",
+	"LRBoisWEpIIqPOKIAmzv/NMjPJZvpzjtXL8JRj1snKQnDQNleOiCj0PY6Kz4aB4YYrIvK3LzT+esUVvi",
+	"2TCl4aI0KnpU6uLnpKcfvD2q38jHVzNa613HzBN7oE9ak7pt1xpdxx6FQHmQsYoN8anGa/N7qzYTTjET",
+	"9zDir9rhLyowVwvn6ATo932K6pMsRIh3F5dDjzUtD6ObP/VwQ3UbOWitsMsjSaN0DuIo1TcFLXD1KbhU",
+	"8936VBpWNtEYoud505pqJvQqHE5bjPpcJlT7gkLT2FGHfEyxAd30tSUsv6gy6YSLZi+ONWnE17QEWbJV",
+	"y16kiRMdIuWO6mhEyNeqlXceM3wcvnE0CbfQo4Z9dPJ0ple3X1KaD/loAKSBZaqckb0zYPa26eR/oIvG",
+	"kBS1LuGykX9VOYJs6nKp2elbmz80N7E3hcZ2NMhH8PPjl9/ZRxRw1ikFN7MiQ7z882xxsWbHl1ZPRWhx",
+	"gwTbPy9LmjLbNueV5AXUuxIrQZ5i6b+Vnnam4310XbKt9kOuK5gFVqh0GBtGuLkAUxnZsl+9DmWZgZo8",
+	"rjitaN/8O4oiLdm05vL10zdJX7qibZs+Qbs/xwMu0UF9G3lHF6KO3BhRpqk6cG59+QLeo2Sx3UmasYOa",
+	"mWBiFdlTfa2A+SZtDW7LjNqeLEBMmo2ZST0KLKG8a9X1TCc8y7bHNHRcyKDRa1IcnqY1XsEESv7ExEU6",
+	"kW0c1ygxlJ8/eBCDWb70rhPSr+TeI/iOusXyXw9nxw/12ucEClxFurLl/eugf0JLzt159V4jBc6M8NUJ",
+	"zih+SKhhiCXnOKEkKsUIfdBpKlxgj7x9TUwD4j+qaT+RowRP2hWIgeYcDpUey42qvVMOgZzqpa1yp62J",
+	"HT/oZtDgT5G4hwqsatGqNgV5rbKNTzI2KC8vMxKoXeEe1HrN+W3OIiQPlNvrihJpEjqq6CjTv9gzL1DT",
+	"trlTk1nsEMo0J+LvK4qV+58WtuTrglzN87ayR4sBR992mHN/IURm2ZuU8yj9eCAqU9ec6oL0Qan0SsHU",
+	"YpwK2O+8htKsNIeK2FC3EseCRbryyxevtbJs4OhF2wQl3g9o+FfLMzfK6V2ZM2PtmhgEDydkhM5LjKmi",
+	"7CNKHNrLYhm3Lx5oU9xrl07u7Hi3CTdsI2SxP9/G2WEoMOp1W21N4RvtFfC3vhdNLBdaTRmiP1nqoHYH",
+	"LNdIEHBtBp8hvytp1d3WT1TSstnXwWM0k0hmVtcvc2tt5O4O817Dot295rBoYZm/rmO62vIaprLcbS1y",
+	"1jndS8JFYc9dZGaSRYCWCawzP/EIIM2XOsHaHBFJ5zGt0qjGItrMRHxw/XYtH3A0awUjSaaDQVGiiXWH",
+	"ckrgZeuE81IM+0LmhzsRcxdS+D75+gcu4XNEfvaP9/a6PKVKI6e/e3HiPs/EftdKe/ihGdt943ID/H2a",
+	"LS+xhkw89AWue8sdhnFwJHdSU4G57AqbwmAYJAyU4Eg6hGJmXfZeCwiJ6dcWfywoo7t5mi7DXIKFLOFe",
+	"iyfjixcYOPOL53DaQK0wbQr/RxC4q5QodH7l9Hf+79tZsisLzFnKSv21Llegb/yNnjOQcs6Ye6Iuv4Ep",
+	"qEBrM8XY4nSG4NW0Fd7sEMmz5GHMivzwQdyMXOu5A1Zk/G7EjDwba6/FmLENtTBEAo8ENtQL7NI7E4Np",
+	"trW6zKtdo3txSUd3fR72hu7imhLaY4vlb/zF6tU9mL3rfOjRbE+Taa9bkRn8TIgf4dGnXuzGJ/wDDxqy",
+	"CKE6l5dGRNwdPocePiKENX84ON3/tAlIxP6xEBaY9j11DUyRU4/xwv7KhcQwISrf5L+pSUakhuogGwFv",
+	"HcEyih8RaTLHl1gbJMM6ZRxrApsEbG4PaAyEW5jPSIZga0nu8UP9r6jaGac1l1TbfJs53WX1+EF7k1Qb",
+	"aZ7ISy9kwVLT+agSB5alUgJtLGwttIwevtinTNJiAwhAc3is89OiVmaDB8rn9afIG32gO4Mk2a6tfr7M",
+	"FrvdhihX7xyeGB7oGOxKJU3mSm8TdVYMltvDlaQqJpPxqLHi0aUoLAvFxeuifSKd/Hm0uuYt2nJjfaEI",
+	"DOLccL8LfBSBZJXlhdhA3f7m/YbNOAeF4e+9WPpqZLnBdeHdLoVlR1aV1QWV3KapdRgINQXAK/wOl+XC",
+	"MQ3dgIJSSkeZYSJlNKawxZH4weakRngBqdbFOVcr1EEsFBWXIa1rX5K0ybXVnJDe86XXXNshdM0JB8gC",
+	"w0Ryse/Rx0yKYk+hdO3PmIwHlgbUc8yvh+IubleOLsk/D/AwpibKDh0JRhHxHIMzQeVyxKsv8zR12Z3v",
+	"7YFFhqxgquZ2yzPvzi5/a7t8Xw505SVs0BEsc8T+TY8nRrWZXdkEYuOw61WFt+4mJEHQ6obyA93a66xe",
+	"Fk5vDdikVb4m57eWqZzbgYL7Ki+X1VVf09FqTVfduVNw7hScOwXnTsG5U3DuFJw7Bee/SSBln6i1998h",
+	"bhPlLZQoLlsmxoOVMq1nxIhhPwuXGKGaHSDrpmebam7KRfDHvocl8PAUKLn3AjWncZwv2LpZBMio9atQ",
+	"l6rwUnCp8XPNmVHNbt7cNBgsZNMj1+REaWzknS4BTKFh7jAkcjtpsCiIsSGgDmLtmbae8wyPSV88chCV",
+	"wJEVKbdgTeMN6/dezLBigViO9G6MbMCj5AEcLmW+QJ/MCkixmCUfs5YzSz4BEVyjXJwln1LNftqEzxKK",
+	"sAufKnYrw3BEt1qnfueNibQ05afRJTI5p0x29rWeaDTajLHmgT7ZNcGpH3YRcv3wbj93IWZHucoO4npv",
+	"4cmDnNLen/5O/3kbdw6/Vu2wHEsQuML+WiuukzNLvgTR/pzeeYJc89wdgJcgyaCk53FJAXhWBjKfSGo9",
+	"F2od97+qKwZ3TxaHk8/h8ECgiOaXeHyI8cp+NnufTss7wXsneCOC9y4K6S8WXahMGRnmoEMFPAuC8fBh",
+	"TUi21reIEpYUJtrGBO/7E4QqvmyqS5T8L+iFr1AS3Um7O2l3p2b+ieOZHSmQlV0hcGs3yTfZhWo8zqCJ",
+	"mDsHmDGuXHLtKac0fE9o6Ri14oZjDXkuNmfUVat7Ioc0TU9wjaqb2bypih023QVl0faaK6x7gFqBkLUe",
+	"FniSPGu5dfNcaScDWkJQ/cR1L4FtFlhN2SVvNgJsshvpyY7p8jebeYVNbYq8vPCXKDWeWW5T6OaDgRx1",
+	"0XGn59Xf6a530vxOd707Sfo1aarbHiAdHdbS2Onv5t+DKXFPmCEarUOvpcFOZlkpdqg84jqGJcgHqlOl",
+	"A9DZ8ckSgQrXSe2q3uEhcxtWEtaacoYY4B4l2bpW5MGZ6XKXM1vJscZ+r2ivjlgozDh3Av1OoN8J9DuB",
+	"fjuBLhJtQJje+mrAxa8G5bXmCdZnnzx9/vTN02T8mOgLaJ7rTj7fyec7+Xwnn/8byGcWaMcQz6J42y7W",
+	"45EN8q5b5cUrAKOzRPPawjNzoqEaRYo2V5M1wZg6t3xbcbhfVlSwKpNxWuSbvHW+1pmKDEww4OEbWdNR",
+	"hegmu06pWXMqbbRCjT7ZGdhfsgOxrLSZgZxbUUIdLzEsvZztCTC73Qd573AhxTh74hd8HpRSHYRYWKfK",
+	"qmFyurPv3r5SbJ9A9hUPzs9uCzDv59N8gxQdewo8RMXdOCU9/ApwWtV4EVf+C2Zt4ce/e3/6TdnG3sS8",
+	"xwHoAx9cqBtArPOB4hZv4wKU3nRaMzTJYlfXQCMFFuQpS67VgU3gG6AxNPu2V0oavYLmtq6oK4MEh+Ul",
+	"kJic8aUU/upJT/vMSaEMlxikVRxVZGoIw9JLIyNrQcfKUNu3rD+1praiNhOywlB3AI21YRBkPxiMo83e",
+	"kZcGGw5Uk4t79wnHIZc7UfneY2tx76k1R7+r66GRX43LsBgHj3u+r7xuznctFqweiO/aqgXQOTZjzdZ0",
+	"rcW0Eu62A1c7PYBlxuTFli+PIKSw03W+VFIqG8jYNgbma+KSelba3jhErs15tSvgcIfTqKQJqHQZzZKt",
+	"8NPM05go1SLgqRPIvsWyA70bfMjPJTB6hSXM7ryLGK1+H6y3e26fVMSOb98PNcimhrtHNHi4Y5Okanvj",
+	"JrmHPYrcYiijYDnzwiM39FuHZ8MxAkPmJovIduo28f3yBZZMvaAyVTV6QzGub+YU1OHevDYTzk1bkzwi",
+	"ajeRUS/5Uqee8IvewZvAoSuNkUyx+LwhS35V6/ZJWXf1dAyuK6B2pkP9pXw2c9an5So8CNv6H5OL9bXe",
+	"oEPdxBa4trOWqxpTiUo6/tmNTD5jKsmKLbaP5s2dBbJgSvKgAw5/wa9/ie2AR09cYYzohNDvLSiDBami",
+	"OEnOrM+FdopKynDBePu2WWm9K3ub0lJ2TJtdYA0f/HpZIYnCb/MKEHtFwuWyapVVe7hkNJHME2Z4MmP9",
+	"sgIdU/0SwyR+nuKi8ctQQyiT/fNHlaCRFI3Sw/U7rkITmfPOfPRXjPzRDNc/KvbWNFDCk4BvTjk3zN6I",
+	"3Ge9HJzQy7vmdLdd19lS9X7HBERsLppS/ZqUFIb+oK3KCkIsG7bdX9GA2zRqM+8/qW84TVT/iFs7pU8S",
+	"ygumBv6kE7jjXcHQGAZSUsnpJR/Ag02jiksp1EiiL1p9FSeGyd4weEeVPHbJk648GorRy46MO1XiDCH0",
+	"7nZzK0NQmGL35Xb+6vR3HGcwHOOVuqwuSEHtTOmQPylZujFYtlioLaVQb+D9HADhQnHdEGMc1pDflJyQ",
+	"zKZ3MwBhBx39Z0h9sj2U/w82Ts7S3x6kX/x8kmL75M8/ffvvgVYFEe2gAyShoqaFLf8H1gnm9f+ZOgsc",
+	"2N4vRvC3bstFN45Grmyd0RM4LblHKjJQY9rDmrs8FQc1gpQrYPYOIopU1QzolCLJWyeqlENRc6z3WTem",
+	"xaq1mvXUiNDF6S/Fuod1ep1ycBoNNpJkTxvEe6tMm16Dg3fZ3pVxOnk7eq5+Bt3TJHrvdK2zNFqkMAiR",
+	"J9dRJZOTkNx/pmfFukoBnykLkHOVSQuG4bblQiwCpp57ioJyhvyHFnoJsu7x+d1V6C8otx3pepjcHmvH",
+	"WhtWFxXKKZ3s3DmcX0/nGF4UebZSKoXh8k3WqsgrJFhjY9taMANPT39vrz3vmf+SYCP8tMk3u2L88SlI",
+	"q2YAB733Tn+Xf7l+PTyJZAzcVbXY1Xl7Q+dJts1/vlD4759QmjeqvtRHza4uYGPO23b76PSUiuefw8l7",
+	"eg9NYvZZ03n4k6GH343NTTDx9qe3/z9rAG8UXFcCAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -27,9 +27,24 @@ type ServerInterface interface {
 	// Get account information about a given app.
 	// (GET /v2/accounts/{address}/applications/{application-id})
 	AccountApplicationInformation(ctx echo.Context, address string, applicationId uint64, params AccountApplicationInformationParams) error
+	// Get a page of the assets held or created by an account.
+	// (GET /v2/accounts/{address}/assets)
+	AccountAssetsInformation(ctx echo.Context, address string, params AccountAssetsInformationParams) error
 	// Get account information about a given asset.
 	// (GET /v2/accounts/{address}/assets/{asset-id})
 	AccountAssetInformation(ctx echo.Context, address string, assetId uint64, params AccountAssetInformationParams) error
+	// Get a page of the applications created by an account.
+	// (GET /v2/accounts/{address}/created-applications)
+	AccountCreatedApplications(ctx echo.Context, address string, params AccountCreatedApplicationsParams) error
+	// Get a page of the assets created by an account.
+	// (GET /v2/accounts/{address}/created-assets)
+	AccountCreatedAssets(ctx echo.Context, address string, params AccountCreatedAssetsParams) error
+	// Get the projected state of an account after its pending transactions.
+	// (GET /v2/accounts/{address}/pending)
+	AccountPendingInformation(ctx echo.Context, address string) error
+	// Get the authorizing address of an account and its rekey history.
+	// (GET /v2/accounts/{address}/rekey-history)
+	AccountRekeyHistory(ctx echo.Context, address string) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
 	GetApplicationByID(ctx echo.Context, applicationId uint64) error
@@ -39,18 +54,33 @@ type ServerInterface interface {
 	// Get all box names for a given application.
 	// (GET /v2/applications/{application-id}/boxes)
 	GetApplicationBoxes(ctx echo.Context, applicationId uint64, params GetApplicationBoxesParams) error
+	// Get a page of the accounts opted in an application.
+	// (GET /v2/applications/{application-id}/opted-in-accounts)
+	GetApplicationOptIns(ctx echo.Context, applicationId uint64, params GetApplicationOptInsParams) error
 	// Get asset information.
 	// (GET /v2/assets/{asset-id})
 	GetAssetByID(ctx echo.Context, assetId uint64) error
+	// Get a page of the accounts holding an asset.
+	// (GET /v2/assets/{asset-id}/holders)
+	GetAssetHolders(ctx echo.Context, assetId uint64, params GetAssetHoldersParams) error
 	// Get the block for the given round.
 	// (GET /v2/blocks/{round})
 	GetBlock(ctx echo.Context, round uint64, params GetBlockParams) error
+	// Get the agreement certificate of the block for the given round.
+	// (GET /v2/blocks/{round}/certificate)
+	GetBlockCertificate(ctx echo.Context, round uint64, params GetBlockCertificateParams) error
 	// Get the block hash for the block on the given round.
 	// (GET /v2/blocks/{round}/hash)
 	GetBlockHash(ctx echo.Context, round uint64) error
+	// Get the block header for the given round.
+	// (GET /v2/blocks/{round}/header)
+	GetBlockHeader(ctx echo.Context, round uint64, params GetBlockHeaderParams) error
 	// Gets a proof for a given light block header inside a state proof commitment
 	// (GET /v2/blocks/{round}/lightheader/proof)
 	GetLightBlockHeaderProof(ctx echo.Context, round uint64) error
+	// Get the seed and sortition details of the block for the given round.
+	// (GET /v2/blocks/{round}/sortition)
+	GetBlockSortition(ctx echo.Context, round uint64) error
 	// Get a proof for a transaction in a block.
 	// (GET /v2/blocks/{round}/transactions/{txid}/proof)
 	GetTransactionProof(ctx echo.Context, round uint64, txid string, params GetTransactionProofParams) error
@@ -60,6 +90,9 @@ type ServerInterface interface {
 	// Get a LedgerStateDelta object for a given round
 	// (GET /v2/deltas/{round})
 	GetLedgerStateDelta(ctx echo.Context, round uint64, params GetLedgerStateDeltaParams) error
+	// Get the state changes of the watched applications in a round.
+	// (GET /v2/deltas/{round}/apps)
+	GetApplicationStateDeltas(ctx echo.Context, round uint64) error
 	// Get LedgerStateDelta objects for all transaction groups in a given round
 	// (GET /v2/deltas/{round}/txn/group)
 	GetTransactionGroupLedgerStateDeltasForRound(ctx echo.Context, round uint64, params GetTransactionGroupLedgerStateDeltasForRoundParams) error
@@ -72,12 +105,18 @@ type ServerInterface interface {
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
+	// Get the totals of the ledger at past rounds.
+	// (GET /v2/ledger/totals)
+	GetLedgerTotals(ctx echo.Context, params GetLedgerTotalsParams) error
 	// Get a state proof that covers a given round
 	// (GET /v2/stateproofs/{round})
 	GetStateProof(ctx echo.Context, round uint64) error
 	// Gets the current node status.
 	// (GET /v2/status)
 	GetStatus(ctx echo.Context) error
+	// Get the upcoming consensus protocol upgrade.
+	// (GET /v2/status/upgrade)
+	GetProtocolUpgrade(ctx echo.Context) error
 	// Gets the node status after waiting for a round after the given round.
 	// (GET /v2/status/wait-for-block-after/{round})
 	WaitForBlock(ctx echo.Context, round uint64) error
@@ -96,6 +135,18 @@ type ServerInterface interface {
 	// Simulates a raw transaction or transaction group as it would be evaluated on the network. The simulation will use blockchain state from the latest committed round.
 	// (POST /v2/transactions/simulate)
 	SimulateTransaction(ctx echo.Context, params SimulateTransactionParams) error
+	// Creates a new simulation session.
+	// (POST /v2/transactions/simulate/sessions)
+	CreateSimulationSession(ctx echo.Context) error
+	// Deletes a simulation session.
+	// (DELETE /v2/transactions/simulate/sessions/{session-id})
+	DeleteSimulationSession(ctx echo.Context, sessionId string) error
+	// Get the state delta of a simulation session.
+	// (GET /v2/transactions/simulate/sessions/{session-id})
+	GetSimulationSessionDelta(ctx echo.Context, sessionId string, params GetSimulationSessionDeltaParams) error
+	// Simulates a transaction group within a simulation session.
+	// (POST /v2/transactions/simulate/sessions/{session-id})
+	SimulateTransactionInSession(ctx echo.Context, sessionId string, params SimulateTransactionInSessionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -125,6 +176,13 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// ------------- Optional query parameter "exclude" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "exclude", ctx.QueryParams(), &params.Exclude)
@@ -172,6 +230,40 @@ func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context)
 	return err
 }
 
+// AccountAssetsInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountAssetsInformation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountAssetsInformationParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountAssetsInformation(ctx, address, params)
+	return err
+}
+
 // AccountAssetInformation converts echo context to params.
 func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error {
 	var err error
@@ -207,6 +299,110 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 	return err
 }
 
+// AccountCreatedApplications converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountCreatedApplications(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountCreatedApplicationsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountCreatedApplications(ctx, address, params)
+	return err
+}
+
+// AccountCreatedAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountCreatedAssets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountCreatedAssetsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountCreatedAssets(ctx, address, params)
+	return err
+}
+
+// AccountPendingInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountPendingInformation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountPendingInformation(ctx, address)
+	return err
+}
+
+// AccountRekeyHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountRekeyHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountRekeyHistory(ctx, address)
+	return err
+}
+
 // GetApplicationByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 	var err error
@@ -279,6 +475,40 @@ func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
 	return err
 }
 
+// GetApplicationOptIns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationOptIns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationOptInsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationOptIns(ctx, applicationId, params)
+	return err
+}
+
 // GetAssetByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 	var err error
@@ -297,6 +527,40 @@ func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 	return err
 }
 
+// GetAssetHolders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetHolders(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "asset-id", runtime.ParamLocationPath, ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetHoldersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetHolders(ctx, assetId, params)
+	return err
+}
+
 // GetBlock converts echo context to params.
 func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 	var err error
@@ -319,11 +583,45 @@ func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetBlock(ctx, round, params)
 	return err
 }
 
+// GetBlockCertificate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockCertificate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBlockCertificateParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockCertificate(ctx, round, params)
+	return err
+}
+
 // GetBlockHash converts echo context to params.
 func (w *ServerInterfaceWrapper) GetBlockHash(ctx echo.Context) error {
 	var err error
@@ -342,6 +640,33 @@ func (w *ServerInterfaceWrapper) GetBlockHash(ctx echo.Context) error {
 	return err
 }
 
+// GetBlockHeader converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockHeader(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBlockHeaderParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockHeader(ctx, round, params)
+	return err
+}
+
 // GetLightBlockHeaderProof converts echo context to params.
 func (w *ServerInterfaceWrapper) GetLightBlockHeaderProof(ctx echo.Context) error {
 	var err error
@@ -360,6 +685,24 @@ func (w *ServerInterfaceWrapper) GetLightBlockHeaderProof(ctx echo.Context) erro
 	return err
 }
 
+// GetBlockSortition converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockSortition(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockSortition(ctx, round)
+	return err
+}
+
 // GetTransactionProof converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTransactionProof(ctx echo.Context) error {
 	var err error
@@ -456,6 +799,24 @@ func (w *ServerInterfaceWrapper) GetLedgerStateDelta(ctx echo.Context) error {
 	return err
 }
 
+// GetApplicationStateDeltas converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationStateDeltas(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationStateDeltas(ctx, round)
+	return err
+}
+
 // GetTransactionGroupLedgerStateDeltasForRound converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTransactionGroupLedgerStateDeltasForRound(ctx echo.Context) error {
 	var err error
@@ -523,6 +884,40 @@ func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 	return err
 }
 
+// GetLedgerTotals converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLedgerTotals(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLedgerTotalsParams
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLedgerTotals(ctx, params)
+	return err
+}
+
 // GetStateProof converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStateProof(ctx echo.Context) error {
 	var err error
@@ -552,6 +947,17 @@ func (w *ServerInterfaceWrapper) GetStatus(ctx echo.Context) error {
 	return err
 }
 
+// GetProtocolUpgrade converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProtocolUpgrade(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProtocolUpgrade(ctx)
+	return err
+}
+
 // WaitForBlock converts echo context to params.
 func (w *ServerInterfaceWrapper) WaitForBlock(ctx echo.Context) error {
 	var err error
@@ -638,11 +1044,101 @@ func (w *ServerInterfaceWrapper) SimulateTransaction(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "decode-events" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "decode-events", ctx.QueryParams(), &params.DecodeEvents)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter decode-events: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SimulateTransaction(ctx, params)
 	return err
 }
 
+// CreateSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSimulationSession(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateSimulationSession(ctx)
+	return err
+}
+
+// DeleteSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSimulationSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "session-id", runtime.ParamLocationPath, ctx.Param("session-id"), &sessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteSimulationSession(ctx, sessionId)
+	return err
+}
+
+// GetSimulationSessionDelta converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSimulationSessionDelta(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "session-id", runtime.ParamLocationPath, ctx.Param("session-id"), &sessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSimulationSessionDeltaParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSimulationSessionDelta(ctx, sessionId, params)
+	return err
+}
+
+// SimulateTransactionInSession converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateTransactionInSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "session-id", runtime.ParamLocationPath, ctx.Param("session-id"), &sessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateTransactionInSessionParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SimulateTransactionInSession(ctx, sessionId, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -673,288 +1169,562 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/v2/accounts/:address", wrapper.AccountInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/applications/:application-id", wrapper.AccountApplicationInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/assets", wrapper.AccountAssetsInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/assets/:asset-id", wrapper.AccountAssetInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/created-applications", wrapper.AccountCreatedApplications, m...)
+	router.GET(baseURL+"/v2/accounts/:address/created-assets", wrapper.AccountCreatedAssets, m...)
+	router.GET(baseURL+"/v2/accounts/:address/pending", wrapper.AccountPendingInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/rekey-history", wrapper.AccountRekeyHistory, m...)
 	router.GET(baseURL+"/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/box", wrapper.GetApplicationBoxByName, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/boxes", wrapper.GetApplicationBoxes, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/opted-in-accounts", wrapper.GetApplicationOptIns, m...)
 	router.GET(baseURL+"/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
+	router.GET(baseURL+"/v2/assets/:asset-id/holders", wrapper.GetAssetHolders, m...)
 	router.GET(baseURL+"/v2/blocks/:round", wrapper.GetBlock, m...)
+	router.GET(baseURL+"/v2/blocks/:round/certificate", wrapper.GetBlockCertificate, m...)
 	router.GET(baseURL+"/v2/blocks/:round/hash", wrapper.GetBlockHash, m...)
+	router.GET(baseURL+"/v2/blocks/:round/header", wrapper.GetBlockHeader, m...)
 	router.GET(baseURL+"/v2/blocks/:round/lightheader/proof", wrapper.GetLightBlockHeaderProof, m...)
+	router.GET(baseURL+"/v2/blocks/:round/sortition", wrapper.GetBlockSortition, m...)
 	router.GET(baseURL+"/v2/blocks/:round/transactions/:txid/proof", wrapper.GetTransactionProof, m...)
 	router.GET(baseURL+"/v2/deltas/txn/group/:id", wrapper.GetLedgerStateDeltaForTransactionGroup, m...)
 	router.GET(baseURL+"/v2/deltas/:round", wrapper.GetLedgerStateDelta, m...)
+	router.GET(baseURL+"/v2/deltas/:round/apps", wrapper.GetApplicationStateDeltas, m...)
 	router.GET(baseURL+"/v2/deltas/:round/txn/group", wrapper.GetTransactionGroupLedgerStateDeltasForRound, m...)
 	router.GET(baseURL+"/v2/devmode/blocks/offset", wrapper.GetBlockTimeStampOffset, m...)
 	router.POST(baseURL+"/v2/devmode/blocks/offset/:offset", wrapper.SetBlockTimeStampOffset, m...)
 	router.GET(baseURL+"/v2/ledger/supply", wrapper.GetSupply, m...)
+	router.GET(baseURL+"/v2/ledger/totals", wrapper.GetLedgerTotals, m...)
 	router.GET(baseURL+"/v2/stateproofs/:round", wrapper.GetStateProof, m...)
 	router.GET(baseURL+"/v2/status", wrapper.GetStatus, m...)
+	router.GET(baseURL+"/v2/status/upgrade", wrapper.GetProtocolUpgrade, m...)
 	router.GET(baseURL+"/v2/status/wait-for-block-after/:round", wrapper.WaitForBlock, m...)
 	router.POST(baseURL+"/v2/teal/compile", wrapper.TealCompile, m...)
 	router.POST(baseURL+"/v2/teal/disassemble", wrapper.TealDisassemble, m...)
 	router.POST(baseURL+"/v2/teal/dryrun", wrapper.TealDryrun, m...)
 	router.GET(baseURL+"/v2/transactions/params", wrapper.TransactionParams, m...)
 	router.POST(baseURL+"/v2/transactions/simulate", wrapper.SimulateTransaction, m...)
+	router.POST(baseURL+"/v2/transactions/simulate/sessions", wrapper.CreateSimulationSession, m...)
+	router.DELETE(baseURL+"/v2/transactions/simulate/sessions/:session-id", wrapper.DeleteSimulationSession, m...)
+	router.GET(baseURL+"/v2/transactions/simulate/sessions/:session-id", wrapper.GetSimulationSessionDelta, m...)
+	router.POST(baseURL+"/v2/transactions/simulate/sessions/:session-id", wrapper.SimulateTransactionInSession, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9fXPbtrI4/FUw+v1m8nJFOa+9p57p3MdN0h7fpmkmcXvuuU2eFiJXEo4pgAcAZal5",
-	"8t2fwQIgQRKUKFt2ktZ/JRZJYLFYLPZ9P4xSsSwEB67V6PjDqKCSLkGDxL9omoqS64Rl5q8MVCpZoZng",
-	"o2P/jCgtGZ+PxiNmfi2oXozGI06XUL9jvh+PJPy7ZBKy0bGWJYxHKl3AkpqB9aYwb1cjrZO5SNwQJ3aI",
-	"0+ejj1se0CyToFQXyp94viGMp3mZAdGSckVT80iRC6YXRC+YIu5jwjgRHIiYEb1ovExmDPJMTfwi/12C",
-	"3ASrdJP3L+ljDWIiRQ5dOJ+J5ZRx8FBBBVS1IUQLksEMX1pQTcwMBlb/ohZEAZXpgsyE3AGqBSKEF3i5",
-	"HB3/OlLAM5C4WymwFf53JgH+gERTOQc9ej+OLW6mQSaaLSNLO3XYl6DKXCuC7+Ia52wFnJivJuTHUmky",
-	"BUI5efPdM/L48eOvzUKWVGvIHJH1rqqePVyT/Xx0PMqoBv+4S2s0nwtJeZZU77/57hnO/9YtcOhbVCmI",
-	"H5YT84ScPu9bgP8wQkKMa5jjPjSo33wRORT1z1OYCQkD98S+fNBNCef/pLuSUp0uCsG4juwLwafEPo7y",
-	"sODzbTysAqDxfmEwJc2gvz5Ivn7/4eH44YOP/+fXk+R/3Z9PH38cuPxn1bg7MBB9MS2lBJ5ukrkEiqdl",
-	"QXkXH28cPaiFKPOMLOgKN58ukdW7b4n51rLOFc1LQycsleIknwtFqCOjDGa0zDXxE5OS54ZNmdEctROm",
-	"SCHFimWQjQ33vViwdEFSquwQ+B65YHluaLBUkPXRWnx1Ww7TxxAlBq5L4QMX9Pkio17XDkzAGrlBkuZC",
-	"QaLFjuvJ3ziUZyS8UOq7Su13WZGzBRCc3Dywly3ijhuazvMN0bivGaGKUOKvpjFhM7IRJbnAzcnZOX7v",
-	"VmOwtiQGabg5jXvUHN4+9HWQEUHeVIgcKEfk+XPXRRmfsXkpQZGLBeiFu/MkqEJwBURM/wWpNtv+329/",
-	"ekWEJD+CUnQOr2l6ToCnIoNsQk5nhAsdkIajJcSh+bJvHQ6u2CX/LyUMTSzVvKDpefxGz9mSRVb1I12z",
-	"ZbkkvFxOQZot9VeIFkSCLiXvA8iOuIMUl3TdnfRMljzF/a+nbchyhtqYKnK6QYQt6fqbB2MHjiI0z0kB",
-	"PGN8TvSa98pxZu7d4CVSlDwbIOZos6fBxaoKSNmMQUaqUbZA4qbZBQ/j+8FTC18BOH6QXnCqWXaAw2Ed",
-	"oRlzus0TUtA5BCQzIT875oZPtTgHXhE6mW7wUSFhxUSpqo96YMSpt0vgXGhICgkzFqGxtw4dhsHYdxwH",
-	"XjoZKBVcU8YhM8wZgRYaLLPqhSmYcLu+073Fp1TBV0/67vj66cDdn4n2rm/d8UG7jS8l9khGrk7z1B3Y",
-	"uGTV+H6AfhjOrdg8sT93NpLNz8xtM2M53kT/Mvvn0VAqZAINRPi7SbE5p7qUcPyO3zd/kYS81ZRnVGbm",
-	"l6X96ccy1+wtm5ufcvvTSzFn6Vs270FmBWtU4cLPlvYfM16cHet1VK94KcR5WYQLShuK63RDTp/3bbId",
-	"c1/CPKm03VDxOFt7ZWTfL/S62sgeIHtxV1Dz4jlsJBhoaTrDf9YzpCc6k3+Yf4oiN1/rYhZDraFjdyWj",
-	"+cCZFU6KImcpNUh84x6bp4YJgFUkaP3GEV6oxx8CEAspCpCa2UFpUSS5SGmeKE01jvR/JcxGx6P/c1Tb",
-	"X47s5+oomPyl+eotfmREVisGJbQo9hjjtRF91BZmYRg0PkI2YdkeCk2M2000pMQMC85hRbme1CpLgx9U",
-	"B/hXN1ONbyvtWHy3VLBehBP74hSUlYDti3cUCVBPEK0E0YoC6TwX0+qHuydFUWMQn58UhcUHSo/AUDCD",
-	"NVNa3cPl0/okhfOcPp+Q78OxURQXPN+Yy8GKGuZumLlby91ilW3JraEe8Y4iuJ1CTszWeDQYMf8QFIdq",
-	"xULkRurZSSvm5b+7d0MyM78P+vjLILEQt/3EhYqWw5zVcfCXQLm526KcLuE4c8+EnLS/vRzZmFHiBHMp",
-	"Wtm6n3bcLXisUHghaWEBdE/sXco4Kmn2JQvrFbnpQEYXhTk4wwGtIVSXPms7z0MUEiSFFgzf5iI9/ztV",
-	"iwOc+akfq3v8cBqyAJqBJAuqFpNRTMoIj1c92pAjZl5EBZ9Mg6km1RIPtbwdS8uopsHSHLxxscSiHr9D",
-	"pgcyorv8hP+hOTGPzdk2rN8OOyFnyMCUPc7OyZAZbd8qCHYm8wJaIQRZWgWfGK17Lyif1ZPH92nQHr2w",
-	"NgW3Q24RuENiffBj8K1Yx2D4Vqw7R0CsQR2CPsw4KEZqWKoB8D13kAncf4c+KiXddJGMYw9BslmgEV0V",
-	"ngYe3vhmlto4ezIV8nLcp8VWOKlNzoSaUQPmO24hCV8ti8SRYsRsZV9oDVR7+bYzjfbwMYw1sPBW02vA",
-	"gjKjHgILzYEOjQWxLFgOByD9RZTpT6mCx4/I27+fPH346LdHT78yJFlIMZd0SaYbDYrcdboZUXqTw73u",
-	"ylA7KnMdH/2rJ95Q2Rw3No4SpUxhSYvuUNYAakUg+xox73Wx1kQzrroCcMjhPAPDyS3aibXtG9CeM2Uk",
-	"rOX0IJvRh7CsniUjDpIMdhLTvsurp9mES5QbWR5ClQUphYzY1/CIaZGKPFmBVExEvCmv3RvEveHF26L9",
-	"u4WWXFBFzNxo+i05ChQRytJrPpzv26HP1rzGzVbOb9cbWZ2bd8i+NJHvLYmKFCATveYkg2k5b2hCMymW",
-	"hJIMP8Q7+nvQKAqcsSW81XRZ/DSbHUZVFDhQRGVjS1BmJmLfMHK9glRwGwmxQztzow5BTxsx3kSn+wFw",
-	"GHm74SnaGQ9xbPsV1yXj6PRQG54GWqyBMYds3iDLq2urfeiwU91REXAMOl7iYzR0PIdc0++EPKstgd9L",
-	"URYHF/Lacw5dDnWLcaaUzHzrdWjG53kz+mZuYJ/E1vhJFvTMH1+3BoQeKfIlmy90oFa8lkLMDg9jbJYY",
-	"oPjAKmW5+aarmr0SmWEmulQHEMHqwWoOZ+g25Gt0KkpNKOEiA9z8UsWFs554DXQUo39bh/KeXlg9awqG",
-	"ulJamtWWBUHvbee+qD9MaGpPaIKoUT2+q8rpaN+y09lYgFwCzTZkCsCJmDoHkXNd4SIpup61F2+caBjh",
-	"Fw24CilSUAqyxBmmdoLm37NXh96CJwQcAa5mIUqQGZVXBvZ8tRPOc9gkGCihyN0fflH3PgG8Wmia70As",
-	"vhNDb6XmOy9gF+ph028juPbkIdlRCcTfK0QLlGZz0NCHwr1w0rt/bYg6u3h1tKxAoj/uWineT3I1AqpA",
-	"vWZ6vyq0ZdET/ufUWyPhmQ3jlAsvWMUGy6nSyS62bF5q6OBmBQEnjHFiHLhH8HpJlbY+ZMYzNH3Z6wTn",
-	"sUKYmaIf4F41xIz8i9dAumOn5h7kqlSVOqLKohBSQxZbA4f1lrlewbqaS8yCsSudRwtSKtg1ch+WgvEd",
-	"suxKLIKorlwtLsiiuzh0SJh7fhNFZQOIGhHbAHnr3wqwG4ZA9QDCVI1oSzhMtSinirsaj5QWRWG4hU5K",
-	"Xn3Xh6a39u0T/XP9bpe4qK7v7UyAwsgr976D/MJi1ga/LagiDg6ypOdG9kAziHV2d2E2hzFRjKeQbKN8",
-	"VPHMW+ER2HlIy2IuaQZJBjnddAf92T4m9vG2AXDHa3VXaEhsFFN802tK9kEjW4YWOJ6KCY8En5DUHEGj",
-	"CtQE4r7eMXIGOHaMOTk6ulMNhXNFt8iPh8u2Wx0ZEW/DldBmxx09IMiOow8BuAcP1dCXRwV+nNS6Z3uK",
-	"f4JyE1RyxP6TbED1LaEef68F9NhQXYB4cF5a7L3FgaNss5eN7eAjfUe2x6D7mkrNUlagrvMDbA6u+rUn",
-	"iLoZSQaashwyEjywamARfk9s/E17zMupgoNsb13wO8a3yHJyplDkaQJ/DhvUuV/bwM7A1HEIXTYyqrmf",
-	"KCcIqA8XMyJ4+AqsaarzjRHU9AI25AIkEFVOl0xrG7DdVHW1KJJwgKhfY8uMzolngyL9DgzxKr7FoYLl",
-	"dbdiPLI6wXb4zlqKQQMdThcohMgHWMg6yIhCMCjegxTC7DpzseM+ethTUgNIx7TRg1td/3dUA824AvJP",
-	"UZKUclS5Sg2VTCMkCgooQJoZjAhWzekiO2oMQQ5LsJokPrl/v73w+/fdnjNFZnDhEy7Mi2103L+PdpzX",
-	"QunG4TqAPdQct9PI9YEOH3PxOS2kzVN2Rxa4kYfs5OvW4JWXyJwppRzhmuVfmQG0TuZ6yNpDGhkWVYHj",
-	"DvLlBEPH1o37/pYty5zqQ3itYEXzRKxASpbBTk7uJmaCv1jR/KfqM0wmgdTQaApJiikQA8eCM/ONzZrY",
-	"pRvW0WRsuYSMUQ35hhQSUrBR/kbkUxWME2Lj/9IF5XOU9KUo5y4AzY6DnLpU1qYiS94ZIioN6TVP0Dod",
-	"49wu6Ngnehg5CKjRxdqmbat5XNBqPpfbM+RKDZDXNvVHvVvjUa+qapC6qlVVi5xmtsoALt4Q1AL81BMP",
-	"9IEg6ozQ0sVXuC3mFJjNvR5bez10DMruxEFIXP2wLyrO6Mn55gDSih2ISCgkKLxbQvuSsk/FLMxMc5eP",
-	"2igNy64J3n76W8/xe9Or6AmeMw7JUnDYRJOxGYcf8WH0OOH91vMxShp937aVhwb8LbCa8wyhxqviF3e7",
-	"fULbrib1nZCH8mXaAQfL5QNchzv95G7Kyzo4aZ5HfIIub6XNANS4ypNnklClRMpQ2DrN1NgeNOdGdEku",
-	"TfS/rqJxD3D22uO2nF9hSiQadyEvCCVpztD0K7jSskz1O07RuBQsNRK15LXofnPjM/9K3L4ZMT+6od5x",
-	"ihFrlckpGmkxg4h95TsAb3VU5XwOSreUlBnAO+7eYpyUnGmca2mOS2LPSwESQ4cm9s0l3ZCZoQktyB8g",
-	"BZmWuim2Y1qW0izPnSfOTEPE7B2nmuRAlSY/Mn62xuG8t94fWQ76QsjzCgvx230OHBRTSTy66nv7FANf",
-	"3fIXLggW0+jtY+u7MePXuVsbtD3VqeH/793/Ov71JPlfmvzxIPn6P47ef3jy8d79zo+PPn7zzf/X/Onx",
-	"x2/u/df/je2Uhz2WNOQgP33uVNrT56i31M6bDuw3ZrhfMp5EiSwMw2jRFrmLCbKOgO41rVp6Ae+4XnND",
-	"SCuas8zwlsuQQ/uG6ZxFezpaVNPYiJYVy691T23gClyGRJhMizVeWorqBiTG0/PQm+gy7vC8zEput9JL",
-	"3zb7xAeGidm4SsG01VmOCebnLaiPanR/Pnr61Whc59VVz0fjkXv6PkLJLFvHsiczWMeUPHdA8GDcUaSg",
-	"GwU6zj0Q9mgMnA3KCIddwnIKUi1YcfOcQmk2jXM4H9PvjEVrfsptsL05P+ib3DiXh5jdPNxaAmRQ6EWs",
-	"akNDUMO36t0EaMWLFFKsgI8Jm8CkbazJjL7oovFyoDOsHoDapxiiDVXnwBKap4oA6+FCBllEYvSDIo/j",
-	"1h/HI3f5q4OrQ27gGFztOStHpP9bC3Ln+xdn5MgxTHXHJvLaoYPUy4gq7bKLGpFEhpvZWjVWyHvH3/Hn",
-	"MGOcmefH73hGNT2aUsVSdVQqkN/SnPIUJnNBjn3C0nOq6TvekbR6y0kFqWKkKKc5S8l5qJDU5GlLhHRH",
-	"ePfuV5rPxbt37ztBFV31wU0V5S92gsQIwqLUiStwkEi4oDLmtFJVgjuObCuYbJvVCtmitJZNX0DBjR/n",
-	"ebQoVDvRtbv8osjN8gMyVC6N02wZUVpIL4sYAcVCg/v7SriLQdILb1cpFSjy+5IWvzKu35PkXfngwWMg",
-	"jczP392Vb2hyU8Bg60pvIm7bqIILt2olrLWkSUHnMd/Yu3e/aqAF7j7Ky0u0ceQ5wc8aGac+oh6Hqhfg",
-	"8dG/ARaOvbPncHFv7Ve+mFV8CfgItxDfMeJG7bG/7H4FOaiX3q5WHmtnl0q9SMzZjq5KGRL3O1PVuJkb",
-	"IcuHUSg2R23VlQOaAkkXkJ67Oi2wLPRm3PjcR+o4QdOzDqZsBR+bQYY1JNCzMAVSFhl1ojjlm3YyvwKt",
-	"fTzwGziHzZmoS1Dsk73fTCZXfQcVKTWQLg2xhsfWjdHefBcOhop9UficbEzO82RxXNGF/6b/IFuR9wCH",
-	"OEYUjWTnPkRQGUGEJf4eFFxioWa8K5F+bHlGy5jamy9SzcfzfuJeqZUnF7kVrgat7vb5ErAcmLhQZEqN",
-	"3C5cJSubMB1wsVLROfRIyKFzZ2BacsMhhIPsuveiN52YtS+0zn0TBdm+nJg1RykFzBNDKqjMtOL1/EzW",
-	"f+g8E1ig0iFsmqOYVAU2WqZDZcPJZivu9YEWJ2CQvBY4PBhNjISSzYIqX2QLa5H5szxIBrjGAgDbyr6c",
-	"BqFmQcGxqqiL57ntc9rRLl3xF1/xxZd5CVXLASVbjISP0e2x7RAcBaAMcpjbhduXPaHUxQjqDTJw/DSb",
-	"5YwDSWJRa4EZNLhm3Bxg5OP7hFgLPBk8QoyMA7DRL44Dk1ciPJt8vg+Q3BVToH5s9KgHf0M878vGcRuR",
-	"RxSGhbMer1bqOQB1oY7V/dUKuMVhCONjYtjciuaGzTmNrx6kU30ExdZWrREXmXGvT5zd4gCxF8tea7JX",
-	"0WVWE8pMHui4QLcF4qlYJzbxMyrxTtdTQ+/R0HZMQ40dTFvn5Y4iU7HGaB+8Wmwo9Q5Y+uHwYAQa/pop",
-	"pFf8ru82t8Bsm3a7NBWjQoUk48x5Fbn0iRNDpu6RYPrI5W5QuuVSALSMHXUdZKf87lRSm+JJ9zKvb7Vx",
-	"XZLMZw3Fjn/fEYruUg/+ulaYqtjK67bEErVTNINWmnVmAhEyRvSGTXSdNF1XkIIcUClIGkJUch7znBrd",
-	"BvDGees/C4wXWM2G8s29IBJKwpwpDbUR3cdJfArzJMUiekLM+lenCzkz63sjRHVNWTcifthY5o2vAEOJ",
-	"Z0wqnaAHIroE89J3CpXq78yrcVmpGWtlS86yLM4bcNpz2CQZy8s4vbp5f3hupn1VsURVTpHfMm4DVqZY",
-	"Ijkagbllahuku3XBL+2CX9KDrXfYaTCvmomlIZfmHF/IuWhx3m3sIEKAMeLo7lovSrcwyCBztssdA7kp",
-	"8PFPtllfO4cp82PvjNrx+bt9d5QdKbqWwGCwdRUM3URGLGE6qDDcTWntOQO0KFi2btlC7ai9GjPdy+Dh",
-	"67K1sIC76wbbgYHA7hnLqpGgmiX4agHf1opuVMCZDMLMWbNQXsgQwqmY8p0Ouoiqsu524eoMaP4DbH4x",
-	"7+JyRh/Ho6uZTmO4diPuwPXranujeEbXvDWlNTwhe6KcFoUUK5onzsDcR5pSrBxp4uveHn3DrC5uxjx7",
-	"cfLytQP/43iU5kBlUokKvavC94ovZlW22l/PAfGV1I3O52V2K0oGm1+VKAuN0hcLcCWpA2m0UzuzdjgE",
-	"R9EZqWfxCKGdJmfnG7FL3OIjgaJykdTmO+shaXpF6Iqy3NvNPLQ90Ty4uGEFWKNcIRzgyt6VwEmWHJTd",
-	"dE53/HTU1LWDJ4VzbSmavbR14RURvO1Cx5jnTeG87kuKlS+tVaTLnHi5REtConKWxm2sfKoMcXDrOzMv",
-	"E3y5Rxg1I5asxxXLSxaMZV4bUtumBWQwRxSZKlpep8bdVLiePyVn/y6BsAy4No8knsrWQcUyKc7a3r1O",
-	"jezQncsNbC309fBXkTHCqq/tGw+B2C5ghJ66DrjPK5XZL7SySJkfApfEHg7/cMbOlbjFWe/ow1GzDV5c",
-	"ND1uYYueLv8zhGFrte/uD+SVV1d+tmeOaL8fppKZFH9AXM9D9TiSsOTr3DKMcvkDwkSHsMtFg8VU1p26",
-	"bVE9e+9290k3oRWqGaTQQ/W484FbDgtuegs15XarbSJJI9YtTjBhVOmRHb8mGAdzJxI3pxdTGqtGaoQM",
-	"A9NJ7QBu2NK1IP5jj3tVZVvY2UngS67eZTYZvQBZ5xJ2C9tcUmCw0w4WFWrJAKk2lAnG1v+XKxEZpuQX",
-	"lNsuLuY7e5Tc1wqs8ct8dSEklpJQcbN/Bilb0jwuOWRp18SbsTmzDUpKBUEHDDeQbf5kqch1EalyiBxq",
-	"TmfkwThow+N2I2Mrptg0B3zjoX1jShVy8soQVX1ilgdcLxS+/mjA64uSZxIyvVAWsUqQSqhD9aZyXk1B",
-	"XwBw8gDfe/g1uYtuO8VWcM9g0d3Po+OHX6PR1f7xIHYBuAYz27hJhuzkH46dxOkY/ZZ2DMO43aiTaNa9",
-	"7TDXz7i2nCb76ZCzhG86Xrf7LC0pp3OIR4osd8Bkv8XdRENaCy88s+2RlJZiQ5iOzw+aGv7UE31u2J8F",
-	"g6RiuWR66Zw7SiwNPdXtLeykfjjba8lVJvZw+YfoIy28i6ilRN6s0dTeb7FVoyf7FV1CE61jQm39kJzV",
-	"0Qu+Xjo59eWJsFRzVaHZ4sbMZZaOYg4GM8xIIRnXqFiUepb8jaQLKmlq2N+kD9xk+tWTSHnqZplUvh/g",
-	"N453CQrkKo562UP2XoZw35K7XPBkaThKdq/O9ghOZa8zN+626/Mdbh96qFBmRkl6ya1skBsNOPWVCI9v",
-	"GfCKpFitZy963HtlN06ZpYyTBy3NDv385qWTMpZCxmoO1sfdSRwStGSwwti9+CaZMa+4FzIftAtXgf7T",
-	"eh68yBmIZf4sxxSBb0VEO/Ul0ytLuotVj1gH+o6peWDIYOqGGpNmeeqb56OHiYKKe7q8Ybvr2DJPPB7w",
-	"jzYiPjG54AbWvny7kh5CCcrzR0kmq54HPnZKvhXroYTTOoWeeD4DFEVRUrI8+6XO/Gx1P5CUp4uoz2xq",
-	"Pvyt7tNWLc7egdHygQvKOeTR4ay8+ZuXSyOS87/E0HmWjA98t92QwS63tbga8CaYHig/oUEv07mZIMRq",
-	"M6muCtrO5yIjOE9dq64+rt1GHkG59X+XoHQsQQkf2MAxtI0admCrfRPgGWqkE/K9bcW8ANIoRISaoK8U",
-	"0cyaLotc0GyMFSzOXpy8JHZW+43tNmSrjc9REWquomUTC8pwDgtB9o2D4ukRw8fZHq9tVq10UhUHjyWg",
-	"mjfq8uWs5SdAFSnEzoQ8D5qq2lxVMwTBAiZyabS6ajQrHyFNmP9oTdMFqn0N1tpP8sPL5HuqVEFryqrF",
-	"VFWbEs+dgdtVyreF8sdEGN38ginbgRdW0Mx5rRLAndnB58A2lydLzi2lTPa45apKlPui3QNnr0jvSohC",
-	"1kL8nkK/7TKxb9eAt/hVtFRWuwVBpyelzaCsWgf5zuop5YKzFAtVxa5o16p3iJ9tQE2vtiHXH3F3QiOH",
-	"K9r4oArFc1jsbYXgGaFDXNfQHzw1m2qpw/6psSfsgmoyB60cZ4Ns7Pt3OFsj4wpcrVFs7BzwSSEbvkvk",
-	"kFF3eFK5TfYkI0y96VEevzPPXjnTAsaknzOOSoRDmxP8rDUQO4lqo3kwTeYClFtPM/9Y/Wq+mWAqbgbr",
-	"9xPfeRTHsK4/s2zr5+4OdeK93s7LbN59Zt51BZKqnxtRznbSk6Jwk/Z3d4nKA3rNexEc8V4m3n0UILca",
-	"PxxtC7ltDVfB+9QQGqzQ2Q0F3sMdwqg6nbS6aBmh1VIUvkFsmFi0SgLjETBeMg51X9zIBZFGrwTcGDyv",
-	"Pd+pVFJtRcBBPO0MaI4e7hhDU9q5N646VLs8lEEJrtHP0b+NdZOWHsZRvVALbpRvqna8hroDYeIZ9gF3",
-	"iOy2XEGpyglRGWYttJqwxBiHYdy+zVPzAugeg65MZD/HWmn73kR9iajTMpuDTmiWxUq/fotPCT4lWYmS",
-	"A6whLasSoUVBUqy70ixE06U2N1EquCqXW+byL1xxuqCrUYQaws5Kfocx0WW6wX9j9TH7d8YFeuwdauij",
-	"OrL9qi91QydjUq+h6USxeTIcE3inXB0d9dSXI/T6+4NSei7mTUBuuPzENi4X7lGMv70wF0dYnaFT9NVe",
-	"LVXxBAzsE74XJaqNVdpvkyvhVdapAosOparX3XYDRH/XujFefj3hvUHRDWrvV+uh7AvyTXtj0ql22XGa",
-	"kq0sqDfjyEYI2dwihCJune2LCrJBQeZx5+thkmFHztbxwocBQn24WRegH3wsKykoc+73mll0Meui3rt5",
-	"CEPiYesNbi/CxZL3Wux+WPXFfftibPi83dXqHFzKfCFhxUTpHds+8smrhPbXRo+oKvI+uv6u4RWn+rTm",
-	"0F7j7ZnrLmCX6XTyH36xcXIEuJabz8CU29n0Tr+srrRrzVP1K6QqTD2oUHXjVhxSqDBWE8/Jho2OXTv6",
-	"jXXI6vkQcaDbP2w8Os32ujBjdRVHdpTYsYt3A+svO1WXmsIjVgjF6vrwsTZhA0MMz7DTV1A2qzuWj+9Z",
-	"QaqxKUAdtyAB9imiZSYLGo/elp/qUaerSExXdWpbqaluJ4Add3wnGyzIaLRV1CfDCyudVNFpyKexGvIc",
-	"uOv92czzGBxtPptBqtlqR/bdPxbAg8yusbfL2B7eQTIeq6KXsXjL/lbHGqBtyXFb4QmKKF4ZnL7cm3PY",
-	"3FGkQQ3Rsu5jf9Vepm4HYgC5Q2JIRKhY9Ic1JDuHPFMVZSAWfLSV/RzqCmi9HaGCXNJLzuVJ0lwcdX7p",
-	"linjLWkGzWU+3SvrGgNx+xL0uh0t+vWP59hARFXdGn3dj1BLJ6fd6ogXrm4I5kpWvhNfQQSU/80nRttZ",
-	"cnYOYc8q9FRdUJn5N6KmF2/VSbbcR52sOt+NoQ30rJqZ1bGx3TyqSL0tjIBOc2HEiKQvjLwZjlrFctxR",
-	"NujGln/HQFsD1wyk6+2H8m8uFCRa+FjabXBsQ4WNLLoUElRvjUsLXG/lmTd1aR2s9Uux0gx1AUXhAomE",
-	"JTXQyaAATv+c25D9zD73iUO+1utOC1NFr7ubDvioaKY6SAypfkbcbbk7IekyxibGue0frWLVcDjIpjek",
-	"kCIrU3tBhwejMsgNrjW1hZVE7TRpd5UtHSHI6jyHzZFVgny3Br+DIdBWcrKgB1UUWpt8UPObisE9Pwh4",
-	"n9JyNR4VQuRJj7PjtFvCp03x5yw9h4yYm8JHD/Z00CF30cZeebMvFhtfsqYogEN2b0LICbfx2t6x3awh",
-	"3Zqc39Hb5l/jrFlpq2o5o9rkHY8HvmK9K3lFbuaH2c7DFBhWd8Wp7CA7CsSse8oHSXoR6Sc1GaqVd13N",
-	"7R4/NVFZKGIySd2+ZkecTBUiU3f+qMNkutJBnouLBKkoqep/xXQO816TSfqKp/VnBttTCOJtqHIX6IYs",
-	"aEZSISWk4RfxFAcL1FJISHKB4Tcxz+BMG3loiXHNnORiTkRh1FxbRs/7UKJtaYK5DtWCx6brWggS6/Dp",
-	"KYgAyqXnOnDty114t3TB2b/DztkiYrfBDfO7tXcbHUdwe3e/CMAcQOi7bVYnsS5BzXW1+1X1dY/TYsnS",
-	"OLq/rGiV3hiTGPXGUOEK0NoEOHwND3jIUyrnJJ6eLpqB02ke89gQd/yckwbp3PwXb7D2uGQGjrn08LNI",
-	"Aua2Vcc6P0V2tZrKNabyOZU9FBJ1eG/3L9tugNOhXuaq4vRAZhAA0O93bsAwyPu8Lxgz7K6Z0AiSTyuZ",
-	"f9xofsxaHM9XA7QnO6VW518AMWOXElyOn20D2Oo7VFC98DKAeb2rmRstDxQm4NnmKVRZO5K3Z7kehG3h",
-	"ShRJDitouONd4mGZpqAUW0HYv9B+TDKAAq27bZ0j5mcOeXtLEHVrTwJP5RDsRiVTi1i7U2SH2BkVktc8",
-	"scdEDT1KBqIVy0rawJ+6Qie3viZukcvHw/p+GKfYm0nEF7eNReyMDEGaj55LHg8MCfNeK5MSzpZVpmdL",
-	"hPXJVgW94P0qWJcoa9lpeA/EALEv1pDiPdSMfLg6TggORlQrp71XaJLVDl9Wle+lsm1E1ukIGZXaFPiO",
-	"vmH5GS/4um8j0q41OjIVGYCpmjdgHCXUcXrBa0u6IRmbzUBat4rSlGdUZuHrjJMUpKbM6JgbdXkFw0Ar",
-	"Sxjv1DEMp8ZBPbOKaRtoIbSA5BunvPXJ/wPkdvShRWR2e21r0dessrMr8cQOujZ6Dka49RCBS0lHLcce",
-	"VsFRxCRLeg57zqPYH7B9GiwU46ywWuCsQ6b4uJXWf0LU4YH/mTO9ldqt6NcOObQ+IUuMngb5vHZM283p",
-	"0mAsSvTMtkwKI0XbHQj8XlsDlZ0PeioqOt6ZIE9VW1y+oIJeSakz2XXFgQ4ztsCMXQTtXtJC29yQ7mBK",
-	"URbdcyaasrqYIXXiptiLCeMGKnY8bke0NK+gatux+2daShSiLuhmd2G2+hqKBwPbkb0642McKqjdVlsC",
-	"U7ahRLTu2T7iSYTmYz0VuhWnDr8YG+Ve++GubznO0h5fQNihfTu91YK8J5UIrVG+iR0db0u+xAL7pJMB",
-	"cZoH26rqtFzHBkVZ9OUKkQ4CrRuzF8Fm0Dl4exhFWKe4ToCWNvQT3a5eH2rzix9rPWlYD2P/wQ7wwuia",
-	"oIuxd3Q4cD5xJvGPFVKCpbzvo4TG8ncF7LgF1oplsEVOVtMabNV4m33W3JcgGks9q4Kc+hput2OhsCix",
-	"EQ7yPBJDZcVH2+I2IBxzT8oVzW8+DgqrVZ8gPiB70+85DQNpQiRbVKrLpfG9pIPmDoJmDjc1f41xW/8A",
-	"s0fRa8EN5TTWDvNH4Z/m1so/8/0uV8DJBY5pg74ffkWmrsxJISFlqq0JX/hWVFXcCHZmdKmTa70jUGXX",
-	"On8R+gpkPPOGJfKqbmuDhuw5ryGsj+gnZio9JzdK5THq65BFBH8xHhXWG91xXZw3osFrqS640YSEA0eF",
-	"B/lde0aFdyupDl2ejXw2l06poLvOwbd1A7eRi7pe29CUhi5yt/U+GZKJEG9pZD7HVAiLEOwHRhBU8vvD",
-	"34mEGTb8FeT+fZzg/v2xe/X3R83H5jjfvx9V8m4sCcLiyI3h5o1RzC99afE29bunAkNrP0qWZ7sIo1FP",
-	"o26ZjRUjfnNVez5J0+7fbGBm96i6xqlXiCa3iImstTF5MFVQKWNAkQz3WaQkBgY9pKVkeoPFhL3Gy36L",
-	"pmt8X4X+utDxyoTn7j4tzqEqR10HCpfK367fC5rjfWQti9zcQiKfkBdruixycAflmzvT/4THf3uSPXj8",
-	"8D+nf3vw9EEKT55+/eAB/foJffj144fw6G9PnzyAh7Ovvp4+yh49eTR98ujJV0+/Th8/eTh98tXX/3nH",
-	"8CEDsgV05EvXjf4HO9snJ69PkzMDbI0TWrAfYGOb6Boy9u15aYonEZaU5aNj/9P/40/YJBXLenj/68hV",
-	"xhottC7U8dHRxcXFJPzkaI6RgYkWZbo48vN0+veevD6tXJDW6I87aotKeGeOJ4UTfPbmxdszcvL6dFIT",
-	"zOh49GDyYPLQjC8K4LRgo+PRY/wJT88C9/3IEdvo+MPH8ehoATTHQHrzxxK0ZKl/JIFmG/d/dUHnc5AT",
-	"17PY/LR6dOTFiqMPLkLyo5khavK09VSCIhrdVr4u2hotN7ZeSqM1nnKd2sZVw0TnW+IZlrmwQYeGzVWI",
-	"O83qzkCnNdPy9ZFtw4jjXyNZK95B7cv2NtopO2c2U+S/3/70ighJnHrzmqbnlXOenM5srUspVgyrJ2RB",
-	"yQ3z5cTT779LkJuavhznC5sh+P53zsu/VPOimcBdS1UxI0msbTLObMgiIOwqnrlmXGhFD5vNV2zYsNYH",
-	"ydfvPzz928fRAEAwuF4Blsr8neb57+SCYfdddCf5YtOumOg40usNpelxHR+LH9Q7OUYDTvU0bOdbvdOs",
-	"e/I7Fxx+79sGB1h0H2iemxcFh9gevMdijkgseOYePXhwsD7gVakf65SvRvEkcYmBugzJPqr6iV9IWtiz",
-	"6NuBY1SYM6zal7D7+ZMDLrSZqHvl5baH6yz6W5phi1VQ2i7l4Re7lFOO+S3mgiD2Avw4Hj39gvfmlBue",
-	"Q3OCbwaVkrsXzc/8nIsL7t80wk+5XFK5QdEm6APdKiNG5wq9Gcgi7dludH4dvf/Ye+sdhY0tjz40UiSy",
-	"K92JnZ6+p893XJN3VB/n7PYZafXNNM+rtojoGnLNQbFRo7o3Id+HXyP3xrKdtihmKTlkPsPB33pVHXJf",
-	"3byG7Y4KK5pGL+3AXHx7f3/q+/ukaexo9LKIAdM4BVth6jh+r3qBdiNjglSIPYrgBR24ml3bL9EH7Frb",
-	"M7d0TTvT+5gquJNR3+KuB3d9YlIAbyUxNTuPXj9r9hn11U3SuDKukXF/4ULfjzQ3dBIst1W5zjZ4uRUG",
-	"/zLCYJV5O7fSmetpdjXxELsbH33wTXsOIBK6pkUDhMFQrQ6+DQLz7rbYyb2J7cATvnM5nuFSbXeKedhK",
-	"6VbA+wwEvG6bshgYdfOpTyfUIQyLuo/ZzpZpvgNZKI34/nCD+619oVLcXxhZvWKbgXS3wHYJ9tkRxhyz",
-	"vja2+qcUwhzSbsWvv7T4VRXAuJIA1mg06EqqBG6sK1nv2tY5pitJrFkEJeBsmEFjGIo7wuM6ONiwGBtd",
-	"6+Jq1dhrhuhOtUqj3axxR2/siljfQ6igfrs5fb5LuvqC7DyDexlEboH43lw3L426Hd7cjNthGG968uDJ",
-	"zUEQ7sIrocl3eItfM4e8VpYWJ6t9Wdg2jnQ0tZ2ctnEl3mJLyCjqDk0Bj6qqP42D5+ZtG6VxF1ORmtUv",
-	"702I7xulqi6YLo93Lgyj8gkYVM7tR4bXGWSQO/7PYxz/zoR8hwkrWo0x2Ey7FonkDuP6+OGjx0/cK5Je",
-	"2Fiu9nvTr54cn3zzjXut7hJm9ZzO60rL4wXkuXAfuDuiO655cPw///zfyWRyZydbFetvN69sufzPhbeO",
-	"Y4nyFQH07dYXvkkxbd03vtqFuhtx338r1tFbQKxvb6FPdgsZ7P8pbp9pk4ycIlpZMhsF9Q54G9ljss99",
-	"NPYdsQzfqS6TCXklXG3TMqeSCJmBdG2D5yWVlGuAbOIpFWsMKFvLMc0Z5lhKgo1QZaJYBnWpkSrDuZCw",
-	"whh5nB51+gYEuxk9RtJ+tkz+R7oO6h1Oq2taC7dkNHsu6dq3YsZmo0LiT998Qx6Ma+0lz80ASYWYGHNd",
-	"0vXoBq1+FbENij9vdjLcGaCLYw+xINXST1UyIWyb9tfm3F+s5G7J3W3sgTjn3o6f2rET2hFcBdGtFgQr",
-	"2NlGzdg5eFMXXzFSnheh4izOzDDUOPAZ+wh2mqajSmgbvbeH+NYIcCVW0iaoPdkGZp2qow+ol4c8o3Nu",
-	"MWvur+UuDXxHUiy980iQGeh04RJ2W6iPsCffR7GfNy0ZZ0sD5YPxtUs1uIvdwkRhA4eM2jT5ITVCg1xK",
-	"dOCBjBDxT76lkXnMZraemK86eObq3qNrivke7VV7djsTcdWAtKjyes0u7gXls3ryrkCGaDmE//MWwfsh",
-	"uMMcX/g+3Ygxt4g/Q8S/VyUT8krUaeOuReSf0fV4nTf7dS/oleBgfexG8rW0eOtOrcQOwzgsUny9EKu/",
-	"VM26Li2CHPmO81vlkL/bfu9bZZEht7eZ7Iu8wv/usLTlljFrm+wshlCPNoQ5mxdtocJm+6hPqMV8En76",
-	"Gao2n4Jj3QyLwUPq+YwTC/hhmQ6W4LHEfFR1DurjQPFmbIO5kRZVGFq0f9oUcsHn6vNkRVvb4kXxEqGS",
-	"qk1dvBfdX+/sPsPqPkbltRGQrt6TYjwFosQSbDNYpsiSKeWCJZ88+NvNQajZ0rff4GHu6ifmLk8fPL65",
-	"6d+CXLEUyBksCyGpZPmG/MzpirIc68Vfgdthp72q/pq3BkebK6K3qVkXLA2LGF2eCTZC1z7oNcs+7maG",
-	"Qd3BPfkg4wEfDCvQ0qIAKi/PAHe7rto9JU6fh9HBjQZwVUWtCCgGRXsGyP/HaKDdCdPexcxdfiW3gPrq",
-	"X45NuNBdMRtXwTFGChCzY/KO3ydqQZ8+fPTbo6df+T8fPf2qx3Jm5nFFe7q2s3og89gOM8SA9kWbAw8r",
-	"tVf4Pb7p3d5vE8cjlq2jLaLqpq+dHgdOLLujSEE3vX3kih1Na8Nh6wa2N1/sUGk2XUT1K6/+VL1TTvm3",
-	"lRZsK/K5Xq+3zWp7kicCPmMIre5aW2F9ewPbLdJkiyyrTqE3rZzWSQb2ovPIk60755MKuvpTKakJ6qjA",
-	"vWDTRMunkymxjdk4cHcXUmiRitzGrpRFIaSuTreaDBL3oM9t15D2+gh3L2EupTpdlMXRB/wPVvj6WCce",
-	"YO1jdaTX/AgL/B992BoigCBG+qhbuTTaQaerJg9o474rBKB1YsbtQ2SbFWAsQUQ+ux7p7C8t1OzXFv+q",
-	"Ju3IiJ0DXOXVBQX6K9oNCn/7VDnbcCFCwrcumM9rQbVRZMZ4RmiwjS3dTciaEVyzYeS6F/0p7Cw373d6",
-	"+gWfs1dCk9NlYfujQXa16B3S5nD+9th63e4nGLirvxvi073zwxvfByZW1vWdF/weDrkgFRv8dFRibrS5",
-	"q6/H9n17k3/eN/kzX3K4QYa39/KXcy9LH055ewV//lfw4y92NdfoiBl4Jfub6NLXcK2J73khR1pho8mg",
-	"5Qrf5qdB1bu9SvWdkL69xe0t/oU6GexODk5aGmKh2ZXK5KY8ROjsZwX9MDtDnkcsDX0HdWx7/egFMCw6",
-	"I1KG9cNPMzW2h9gZJ9wpvhV8PmvBJ9jrW7nn1vTwhZkeeqQcp/U3e3L3CRr7CkCrpcjAR52I2cwVeeuT",
-	"fpq9Zwx5Kk2XBbFfRqUc9MaesSW8NW/+ZKc46BVbg90Si1rgGWQpSAXP1ACvqBv1svcQunH7AbhxD2i1",
-	"Ax4Wl/49uTTJvglqyHQogbSRr7BnkC9255CRwYosXZflq5Lt0Qf7L5rTCqFiTfY9AXc25q7bFlu9z47b",
-	"AJC8RiHUNSN2X4kZeWCL+JUcM3Xq5oCUZ0TLjRFUfc0SCTQnaSNCv4Kje3Le9p6cnapAZ3U9a4rrAqI+",
-	"oYcMZ21lR/1w4wfgGeWO5LsI0oJQwmFONVuBj1uf3GbUX/o2c/nsWxjgmNAss6ex3gRYgdwQVU6VkXV4",
-	"M9Dyjmqelz0YBqwLkMxc0TSvHfBWTTiy6fLbAirf2jeueGm1eJFN0pfNKCB/s7oUfjEjP7JUipN8LpSP",
-	"61IbpWHZab3nPv2tp+iqNyR0Y8AEzxmHZCl4rCHcT/j0R3wY+xpLDvR9fGYe9n3bum+b8LfAas4z5E6+",
-	"Kn4/k9N/pVyN1molFEIa7XZqm9Ra+t/zKPlDs+Fp9yRteBo4tdzDYKCwfVzj56MPjT9dsQz3plqUOhMX",
-	"wbeo2dugnyF58kGj6ktY0loNn9X12tKu04cU4CF2YqqnkdZfQTvy3u5ff9H8EOdyCYnEtehfgVQt9ew2",
-	"SeRPlSQyeN/34rG21eUujlaqw0okr0QGdtxmp9lYfWYuMnAdObuCSBXsGA+s97dS/V4r1Dml5XyhSVkQ",
-	"LWJB1fWHCU0tk02sehOfMKiIZpUgnG5BV0Bojn1OyRSAEzE1i67vR1wkVViTzkdmu5DOqCgUwFVIkYJS",
-	"kCW+HvUu0Ko+pxjHrbfgCQFHgKtZiBJkRuWVgT1f7YSz6hOuyN0ffjEK843Da0XB7Yi1lbAi6K2qbThp",
-	"rwv1sOm3EVx78pDsqATiRQNMJBHLIgeXShJB4V446d2/NkSdXbw6WjDXgl0zxftJrkZAFajXTO9XhbYs",
-	"EnN/d0F8Zp+esSVKYpxy4e2KscFyqnSyiy2bl8K1KLOCgBPGODEO3KNwvqRKv3FZhRlWoLHXCc5jZWwz",
-	"RT/Aq75+9GbkX6pu9J2xU3MfclWqqmW9yxSALLYGDustc72CdTUXpnX6satUBGvh2zVyH5aC8R2ygqLc",
-	"hOrAm2+GiywO7Y/UGSi6qGwAUSNiGyBv/VsBdkM3fg8gTNWItoSDRUZDypkKkQPlNqNLFIXhFjopefVd",
-	"H5re2rdP9M/1u13iorq+tzMBKkwTcZBfWMwqNNAuqCIODrKk5y6TZO6aLHVhNocxwQzwZBvlo8nWvBUe",
-	"gZ2HtCzmkmaQZJDTiCnlZ/uY2MfbBsAd9+SZrISGZAozISG+6TUly14TUTW0wPFUTHgk+ISk5gga5bkm",
-	"EPf1jpEzwLFjzMnR0Z1qKJwrukV+PFy23eoes5QZw+y4owcE2XH0IQD34KEa+vKowI+T2nzQnuKfoNwE",
-	"lRyx/yQbUH1LqMffawFtc154gTVuihZ7b3HgKNvsZWM7+EjfkY0ZEL9IY387dukaq780DaiBAji5jHJ7",
-	"dEGZTmZCWkE6oTMNcmdA/D8o8+5w5xrQwtUmIDiCuzfdOMjkw1YXjotYEIi7LgyJdP1vZqrvhBxUYrNZ",
-	"SIYyTUquWR6UGa9U5c/PYHhrBLg1AtwaAW6NALdGgFsjwK0R4NYIcGsEuDUC3BoBbo0Af10jwKcqmpt4",
-	"icOXEuOCJ+2oRHIblfinKjJZ3VXeKIFmjAvKtOua6fP93ZOr1djVQHPEAcuhP07ahm+evTh5SZQoZQok",
-	"NRAyToqcGt0A1rrq4dbsDur7FttGkLbxKFXw+BF5+/cTXwtv4Wq2Nd+9e+L6fyu9yeGe65IAPLOiqG+X",
-	"ANwg3XVLoP5O8L3eXOc7lmOMuSIv8O3nsIJcFCBtmS2iZRkx+ZwBzZ853Oyw+PzDTO6CVn83o/0+bhia",
-	"HNqWtPByvl8rVYTa3EXyPMhm/H1GcwW/9yU02vGWtIi1W6tuPmsLQm7yrcg2rRNidu0IN7B5NuqKeIxT",
-	"uYnUW+omE7RJQwvDrxxhdY1ZHw9et7FLtF0y20VhMXFdgoqe421UHi1YWG1YZyib8jpr0ckolq3ZrtI3",
-	"qgAcEgJ7hgkHdk/IG/vdp60KjxC5I1Yz888mcrD5ZsU08F2jRTjW86VG5XvER08vnv2xIeysTIEwrYgv",
-	"/bj7ehmP1okZaQ48cQwomYpskzTY16hxC2VMUaVgOd19E4X80zUYdpePebL9nvo018jzYHHbeHJINOvE",
-	"MeAe7rzRMJg3V9jCER17DjB+3Sy6j42GIBDHn2JWpRbv25fp1dNsbhnfLeMLTmNLImDclcptM5HJNTI+",
-	"uZEl7+d5L9aQlga48CTfRfM8+uRgrRuOzQym5XyOjZI7TjqzNMDxmOCfiBXa5Q7lgvtRkB28ap551XTv",
-	"9nBd7hJkYN/1NQ7v4XZQvkFvxrKgfON9vpAotixzi0PbY+6wjNZWs+1GAqA/1hn/+szar73NLzDeuqu2",
-	"+btFC7mgitj9hYyUPHO5Q52a12s+vGKIHfpszWs2vbU6iF1vZHVu3iFXhN/lZtK2IgXIRK+5PVDNTuq2",
-	"trY9uZPbBrF/jWvDpnxDD4Pt1omuGcKBbg8Z8DW8PoJuIHUyXKNHCFot+lNHwtYg9s2DRo90hm8GkdQm",
-	"Feckhbwg1HfvTwVXWpapfscpOmmChU26ASbeGt3P3575V+J+wogbzw31jlNs7l65bqJ8bgYRP8V3AJ6N",
-	"qnI+B2V4ZUgkM4B33L3FOCm50bTEjCxZKkViE1HNGTLyycS+uaQbMsP6H4L8AVKQqbnZg123BmOlWZ67",
-	"iBYzDRGzd5xqkgNVmvzIDJc1w/niA1UoF+gLIc8rLMQ7RcyBg2IqiRtfvrdPsRmDW7438qHB0j6ui6jf",
-	"bBcGDzvLeiE/fW7gpli7OGdK10EQHdhvzAG+ZDyJEtnZAoiLCWvTFrmLFdMcAd1reof0At5xc8NpQZCr",
-	"U305cmi7eTpn0Z6OFtU0NqLlDfJrHaTiHYTLkAiTuXWt/IlSMwM68O5L3Hhbjb6193u6URpXLvDMPO25",
-	"kO1T17yr5yWnJDQMYa1yMO6NswbIf97G7++vR1/0aDyYxtgdsMuumu2ZEG9+w8eE5oLPbRVCo0EK3CfG",
-	"i1JjYPV1GulgRfNErEBKloEauFIm+IsVzX+qPvs4HsEa0kRLmkJirQZDsXZmvrF0uusiDZrULZeQMaoh",
-	"35BCQgqZrbfFFKmV7YmtWEDSBeVzvHOlKOcL+5od5wIkVP28jH7bHiJe72TNE1t7rQvjCbGGyrA8LdB0",
-	"EemPgjeTUag9JdhyEkNU5ggrwMqafRr0eNQrIRukrurANoucJn8YcP03LvIAP/XEhyhFekutt9T6yag1",
-	"VvIPUTdr2QAsvsJtuWZj0XUXuLxB29MnqX57W0L+z15C3nMgRSiRtCH1x3uXUUWYJhdY4GcKxFw8Jdq8",
-	"XYtzpyFPiGFIgX3fVoJUrvNmuqCMu+owVboAwqFdd2Dt2xFei7nQMjO0Exp0QFpKpjeoJ9CC/XYO5v/v",
-	"jaCtQK68ClHKfHQ8WmhdHB8d5SKl+UIofTT6OA6fqdbD9xX8H7z0X0i2MhrNx/cf//8AAAD//ycflPTr",
-	"fAEA",
+	"H4sIAAAAAAACA+29+ZPbRpIo/K8g9DZCtpbolq/ZGUU49mtLtkdvfCgk2bP7xn42SBbZsECAC4B92M//",
+	"+5dXXUAVALKplmT3L7aaAKqysrKy8s7f7i2qzbYqVdk29x79dm+b1dlGtaqmv7LFotqVbZov8a+lahZ1",
+	"vm3zqrz3SD9LmrbOy/W92b0cf91m7Tn8u4RB7Dv4/exerf5nl9cKhmrrnZrdaxbnapPhwO31Ft82I12l",
+	"6yqVIc54iKdP7v0+8CBbLmvVNH0ovy2L6yQvF8VuqZK2zsomW+CjJrnM2/OkPc+bRD6G1xJARFKt4Gfv",
+	"5WSVq2LZnOhF/s9O1dfOKmXy+JJ+tyCmdVWoPpyPq808h8kFKmWAMhuStFWyVCt66TxrE5wBYdUvwuNG",
+	"ZfXiPFlV9QioDIQLryp3m3uP/nWvUeVS1bRbC5Vf0D9XtVK/qrTN6rVq7/04Cy1uBRCmbb4JLO2pYB8m",
+	"3hUtoHtFq4E1rmGCMsGvTpKvd02bzGHdZfL8i8fJRx999DdcyCZrW7UUIouuys7urok/h+fLrFX6cZ/W",
+	"smJdwV4vU/M+AEDzv5AFTn0raxoVPixn+CQBWo0sQH8YIKG8bNWa9sGjfvwicCjsz3MFkKqJe8IvH3VT",
+	"3Pnf6K4ssnZxvq0Aj4F9Sehpwo+DPMz5fIiHGQC897eIqRoH/dfD9G8//vbB7IOHv/+vf52l/0f+/OSj",
+	"3ycu/7EZdwQDwRcXu7pW5eI6Xdcqo9NynpV9fDwXemjOq12xTM6zC9r8bEOsXr5N8FtmnRdZsUM6yRd1",
+	"dQaQwOkWMgJWlcFQiZ442ZUFsikcTag9gQG2dXWRL9Vyhtz38jyHvVhkDQ9B7wFHLAqkwV2jljFaC69u",
+	"4DD97qIE4ToIH7SgtxcZdl0jmFiqRbVUqbrQUoCPhH+eA0OA2WcESFGtG31HLrKioJsn226LHCjf3qwZ",
+	"8JZ13sBmAKdYVCVcp4s2cQYm5GRFg7caTg8YKGEkHPbs+eP0w78mDI+ZS8aILdtfRGDF8wouPUAGrlhd",
+	"Ef9LF0XVABOqRi5kfcfCOUvcK9Tezs1+13PyEleEk+MDFi8IISWe4gJklpYoGaZrCJV8GQNhrJLrapdc",
+	"EjkW+Sv6XlaDaNrgRjE5epIDsqsY5nrIGEEegxtE2SaD+XFiBL2A7de7BzgAKROWK2sFkGrV7upyllTw",
+	"vNa/zxUwrKTa5HjDnCTfqAZHchDUqEIt8DehsmXVOlMi654lzQ7QDIj7eV5Ui1cndbn8+SQhSbDZbbdV",
+	"bT5HyP73i2+/kUsthiBZ8LB8p/lvHyvlKl/vAAFAGIrW6iGkmv8CC8LjT5BUdfI10Eu2Vs+yxasEDjKe",
+	"jZPk6Qpoo3VYhPAUQiV+GQWe4QoJe780FfKGTbPewlxhya7IYS/6q/o6u8o3u00CI81hRbDLWpQwOxsD",
+	"iEccYUmb7Ko/6ct6Vy5on+20nkyPZzBvtkV2TQiDQT59OBNwgHyAd25BvkUKa6/KqDyPc4+DBwxgVy4n",
+	"iLst7qkjYDVbtciBpJaJGWUAEplmDJ683A8eK4Q74OhBouCYWUbAKdVVgGaQ5+ETOKVr5ZDMSfKdXHL0",
+	"tK1ewX2jCT2ZX9Ojba0u8mrXmI8iMNLUwycVzpFKYbxVHqCxF4IOZLv8jtzEG5GF8R7KgM3jfcVAw3DM",
+	"oaIwORMO6719aW4OAsBfPo7JevbpxN2HLzu7Prjjk3abXkr5SAZEKHwqBzYsYXvfT7ATuHM3wCthnrDS",
+	"lTTAowqSShJ5EXSwkzAUzkjTbRUEQr5O+dceLeXrlygGrPKCRIRfkIT0Tuwa4kPeXmihAYYsM2Ba6tEP",
+	"5QP8K0lBloedz+ol/rLhn76GgXKYBH8q+KevqnW+gJ8i+2lgDer+9NmG/4fjhW+E9iqI7a+q6tVu6y5o",
+	"4dlQ4Bw7uO/AxWPuezbOjOHF1YFfXmm9eN8vAAq9kREgo7jbZvjiK3UNUi/8I1us6H9XKyLpbFX/iv8D",
+	"KRm/brerEGrxKIlUQNLV2bOnL5EXPpcf8TfkPoo1WUfmPqWbHH6zgAH/3Kq6zXkoXkGQIcMTY/LC2U56",
+	"+ijS+AJGo5HyVm2awEEwH2V1DbjAv3G08KTM4uG2Fi6vWel/pag3pbDwlFaenKtsqeoASL+7Z/RfvD4D",
+	"pp7b4piFLMZxl0mU6hIkw4XI2zjSMgEINDbgC70RzRF2gkb1MflvcDMAJP/r1JpiT/nz5lRP3UdwBwMy",
+	"7pQl6213lmm0rBKkTV7zvAHZUz2rq2p1hGUX1SWo5VEzLalDoD6Q2G9tn6j3LDWblF9BkpfXLlEv9Wyl",
+	"9L5iSa8NDakaUWJVthJdg3VagC6Z001l1E38dANLPZlooNG8BYVmWm1eLtVVeK30qD8VwxVetoXC3H8z",
+	"RHK1Ck8hUj1rEKRU8WwZb2xCn85EuDmvSCnDz1T9qpCnhi9cVgjZhSIorPBx3aoQs4BPI9IfPgmsWtR+",
+	"s0cGVDbxm79JUJih+Np9AeE3CCNLCb60ba2U5hCrXjSLlbhONN6omu/gbA3SXdOKim6BhHfmO7jItUCK",
+	"JwX0cXpAmllWO2uD01I2IK02LXCViVgLCnAv9bq9zRNjEdB/1oYpAxTd/Q/cqiqAdKcfOH5///NGwB3x",
+	"vPFiDz1vwVXP4B+/ZAuHPJlJAH8OIbzDjV0GoE+pHIyZEapdqKfwbeLFaG9syXAo/rfzDOkPTURVzYeD",
+	"DzP8Zk6CvFNUi6xgkiTTVOmfChxXqwN4A/AEZ/aVI9wD8G5KYKQExuj1Z4f+Cr96QR+h8Zav6xTG22OM",
+	"Z2gSayadNlSUNJvI0TfIdIeSbI5KaKEusrKdQAo80yRRJIpwsZvOVeOyvfuNu3/e7qKhcl1Uc/PDezCq",
+	"xSA9h18YH2RVVDmZptRV3rTN+7T8zAry7jwgxSdfumOTUbpC89pcibEF+e5KM1jW442XtenyY1gHbSe6",
+	"LR26Q+I9BsWRgV0uiFFawZf/Lu+6ZIa/T/r43SAxF7dx4iL2oa9Wsn3TL47R+70O5fQJRxyfJ8lZ99vD",
+	"yAZHGSCY5qnF4rGIZw9p3UdvtasXKqQaoZEqjehH3zWKSWObrfPSCCxw826yV7wRbDFHClCNMQkzEbFm",
+	"ZYzbYm4TnJ8cJHccjUwFmbMD6TW0tdoaR9Y6sSoaMgGxUBVLvP+0cgeim705Xdp5zC84rPcYup5zSe1B",
+	"Q3aCO9LRpONh8gACGtjfKAm5Ls3JBER0d0zS2ZMB0T11RzZdsjmY8wT3dYTrjBLLM3ZCHeN+GtLv9NXp",
+	"K8vBLeGAhvAwMOMv7HIFkS9D7dMfEDXsDcY8oIqGwiS5HJBuckCGdrjV6jKrlxEzhqXyoek9eaS7LNR8",
+	"lhRsgGjXUgeamyefG0fu6x4f9HzJ6seghFfJMzoFWWFsjJ4PGZK82ywtaa+m6wwNxDFuq6rguAukMXQ4",
+	"VLOO+lsA6aKXwp2oUMu1d5ajN7wxrwtBzeyl76Jwn4PoY9dTXgWl7Mt0ic3Fg3/wamIlAMMxDp9YnyI2",
+	"7jpbvEKqlbd8ArVGvX0ESwf+UYOwgW6iaWFbNaDRoZR/gQ7NrZ2qZ23TS3MsYS6Wn6tX6vrvoA1U9fXb",
+	"xON27TkZxIZH4vCpFtQS/KCq81/10QidLj1hwEamD3zDoUtwyhhlSKjVZZkAkg4wdZ0zXtF8UrfpAKtY",
+	"5bU5x4vqQlMegiZjPJJAFADErEdlNTCGmr/TIUlt8qpEiMUQxvsd5Fw0VsTaeJ6Va8e26yA3vIlwYIol",
+	"8iJayb5HhYgwxMpvUd8x3DC0aQZbB0gloRNmhRKycTIVx9Dsck8xLQuM+BQpVLbLP9cHnOUJWzW4UFnc",
+	"ZZ1teWXyhH3lcJtmJpTKhbV5krUZRkd8CyNu8l+Pwe8xDj5FeS9C4TYoaVdigCbJhn1nxlIgY1ZRZEDh",
+	"G5U1u5pDOvunCgSqWqG5OiuCwZkmpqw/BdEvPXMGQaKofrrIFjsQUzbkxmB6x333QF9kpWOhKQBKcqg4",
+	"YJq4wNk9XEmqYmcLA71FwMCoQN4VPlWYcsAxr42CrQG20+R47RA/2laLc5e75oVCp0u9KyPSE4NR11WE",
+	"z9OjCCSrLC8kNpCMoll5HbxKaA46zHsvlr4aWW5wXaTBwbIjqyK+DZPR1NqZi7oYUvNyh8ty4ZiG7hyD",
+	"DksiIzNMxBE55Vgc6TyIlBc/C0i1Ls4vs0brriiQAcO7zHInGIrlW/ELURQh0Hu+LFSY0PVJOIAXmEMk",
+	"N3GPPmZJUwEZ1lMoHZ6Ue+GBuQEwtXXHo+wubleOLskdFKWbzbZQJJwbOhKMIuKLKluGd7JzXTrs1ed5",
+	"mrrszvf2wCJDVjBVs0B9Ymdocg5y7VpUH2+NMZrlK8feIXDbPD2K6dDVLfY1GxIQd0agniK0l6AV29Oo",
+	"7cfEKuioA9+92qWU4wtTrt14cEEuLjtQkXfwiSrarDmOs9eYUMNbzep8RzW4xAQmZNReUgmnw9CbJqjD",
+	"BIRYxdp3VE5WGkIoOEh7kDV4C5tCpC6q9jWPTMciy8vWxa/NXqo+xn6f80j7Wat5+jt2pSlBI3EvbhXY",
+	"x1FGZR27bC+1FHF83sRuiQjgXX70Gdo1HyNZrXC6Y6huCxUS1Z05DHZANCK5A/Qea2Q9SZ6SIUdttu21",
+	"ERvXqlQNhoXRK/e6+xQ25XQX1/dZIKiTNt2AuvDXkWmINC7/njXnR0DiXI/VxyRNI9G6GG10Ph6ya0eb",
+	"slh80VmbCQw2S6S/j7ZIGm1kmSgD7rXrMmoYEfxsCipcIGbExKpdGzVQdkjhWBh6XbiZRY7qt/SPrPBp",
+	"nYbFSM2cAkQqp9iDY4nlmUiJVRTNt+EEuwTjY492bhktUzbwc87pE0qWRZgdelHBHEcKXxF/S8xMzKk5",
+	"l+eYhgq4w9xV+QLkLZCoKM3YpgxpwMLaKA+QboD1XwcuygoNTzIJyCuvYoPPjIfsLO4hgyXmVSjjx7BE",
+	"fsPmUVt/GeqkQkRRA4N4Q9LYPM8GR6/qHGUSzOLlkYbnCTGas6BFnGM/9Zju8d7bjTBIET7rCEHeKBX4",
+	"+oVS/sezyCbjSwWVEmA/QyhA2pZO+L/v/ecjLJmQpb8+TP/276c//vbx7+8/6P344e+ffvr//J8++v3T",
+	"9//z34IJLQDqlGOB79Gm7nMUOEcZk8kG8BTHDNnvHTano6rVG0BTq7ZDxwyfh0BGF2Lk7NKjYVmMXulR",
+	"4SSVwnDP76s2GHp3Ua9S4f+BrGa5GHDe759/wdkBBhIGC11iCks9UIwHOtZue1u6F4/H5DuM2PDKPldz",
+	"+I+NSSeC9Y5Hj5yFKvRO+iidcv+ZPUqWCjSUoglRUFeOra6OrpXAmEH5qrrqaSTVlTqGgjzHcSarxzDr",
+	"E4Gsqkf9/Tz2JAESFoj5X00/CQBnsfVjzuawUwctu8MvysRWxUkyHNVx2s26uhq+utvGT+ljfqEzkC1E",
+	"NnxcusOHMOZh4QV6bI6OBfIDHQML/kDHxgJQZV4cQwM/D+qNaEP/6MPkxd/PPvngw58+/OQv5CZCB0W2",
+	"SZCVNsl7WhZq2utCvR80zlBKdXj0v3ysa2j44wZvOwrY3mSBK49rc4htj15L8L0+1jrGHFy1AXCSPU+h",
+	"ksNoT7j8EIL2RF18DYs4W14cKXhpqiGTPFQs28IAy91iki9nP/vl+ISIgbxBM9VmfhRyjJHM0s6yTGQv",
+	"lmr0OO27wXaaa3eT6+t6dwy9z7i/eyQO77XVoipSkFuavApYVp/JG4m8oTMvtt3fGVrWeGBuEod25TJi",
+	"QMWCK5NvPh765VVpcTN49/F6A6uTeafsi498az3FHD0YBGSV+W7t2XVXdbXBCkT0IdHoF0p93rT55jhG",
+	"SyVDRXwnKwWCqH6F1GZymmZUV4JcInSkqGqjo2dN2gBnISEhmuIfJiWsGgDZoIBT7cgJH0lbxShRWFgk",
+	"pVlCa73SnICF9yj/FjNKgbO/n2jK0NrVDyXuX1thgbYcqw4atUtHSZWqvazqV4bGJ3A4uzkeOuwKptDc",
+	"F+4WSurUSl2yDYsOGW8fh2F9qVqyEL3MNwqEks3229XqODlyFQ0UQDrM1OBMCb/hBI1MQJGMOgUR3WNn",
+	"Qi7jAAhGXlyXC1LYX++dqEmvgekcj89e0dHTL8UYOniq+00AHETHV/TYOjC/qOqX9qh8Ce9tj65Edeec",
+	"upxMx4iw83KJ3+rkQXhe+IHrGJSxPQmt8Y0s6LG+HGQNBH1jwSNr51HSgJhBDfHa/QL2Dwwg5uowuCiy",
+	"f7xS2xY365H7YEIEcRgi/j5WKQafeS5+W7Bqyk3mbsioHOGjOxa3K/BOlW4teijnw98jTLrfZo29oJGG",
+	"8vV563hFDq/BMoiY0CzxsgNkjynwm74H7qtqvT5aPlPOjp602rUgKqSrvFAx+iyUiUfnsqRwx2OEgwxC",
+	"f7YYgbaml4dDOtWFKiInDB+5BXJwRNi7R8lD2LsyX8ySD5JVBrs8Sz7k+NJZ8hEIxjVyulnyMUmNFHf4",
+	"CYuREbPxbt5cN5qoA2Ee5rkYpwvGO4XpzhUpFai3eKcFDT3TDwsP+EJPNHpgGGse6JOVvl1JsapmEVIn",
+	"M3PN4Ca15GsFO7U4BkvFmopFNldFGs852/SqWzaqxpqAKsNKgAQLiJlYShR400O6t7C+Bla2jMi1DH9E",
+	"XLaVcuW9w7eQEfXEmWNsDzsIsbBO3Ul5311GNzPoG/jHCwq1PIIhzQ7m5zy4ulk2R6d4xseVgzzDJrZI",
+	"YfCXjnbgWO3IAZfrMrWLbIf8EKveVSGeYj9MswUjPCXmORphy2/xdFx0ugDNbomB+AoOx1wqUDpoxnq3",
+	"XgkiNvAFidGBCzCywDo9y3Q4m82CZuKHSP1tB/BEgBPAZhYd3XxTYF9djML5Sl2nVJG7Sd77x/dY0uTW",
+	"4aWbfwSx9E4IvSaOQWId+1BPm36I4LqTu2SHgpvRpOEm1WHeMRTuhZPo/nUh6u3izdGiK329Voo35cRu",
+	"REAG1NdM7zeFdreN9JkQJwXaEXDDyqystPoezd0ZY8tkIHY9KbgChxNGE3Yius5X8Ixd/nm5JOdjYw3R",
+	"rP7gFHGAo6ZUHPl7bUXtj22rxGmTqilPHloDhcNG5/oGnuq5YNvs2MZuC2d416ixkWNYcsYXZDU2BBu1",
+	"GBsJQ+G0/cVRvR+856/j+U0aCIuIIUBemGruFrtujfUIIBgIZr4kwqESfi7lOAkxoAFut8gt2nRXmu9i",
+	"aHrBb5+139l3+8SVtfbeXlaKCwjK+wL5pVGgyyXVbxM4dHyzzuIJwoyHMaVsnHTQVIuGRHzLPQKjh3S3",
+	"Xdeg+qVLVWSBSK/v+HHCj4cGoB23Jnssks1l0sObbinZKOLxoas0EmfyTSVxHAs8gii4WwKRr0dGhv/g",
+	"CCHmJHR03wxFcwW3SI9Hy+atjkXNwStUQYPpgUAWjj4F4AgezNCHo4I+Tq0q0Z3iv2FonsCzyO83yTVM",
+	"EVmCHX+vBUQ84dKJyLPle+y9w4GDbDPKxkb4SOzIRtzyz+Byzhf5lnSdf6jrz6+20yI1dKuHAf14644d",
+	"KXbivoKCB6dSoK0FGEet2mZqVK23kBf8bW+HfIgmlbMYBXCmszxLUA6pMgMqjZIzYtTWLp6PboTrThCu",
+	"UM1hUgCj2z6HDHL+TnAV8+6YoJYfo271VYQYgJjzNSqjXPzcNdtPpYIXNIDjquhXt76atvHfxYEx5gn2",
+	"PvRoOLjhh5krJhlq+lvfs9MESEH31OmB3/TAf7HbbLKjVGDRRT0OWpeAEXIjS6QihYNPCRmfjVZPCtPX",
+	"Dh4PdsnwfdYNQ8yR4oMO67HpLkHoqy4DQojtmsOXOttznfhH8Zc3i6wsgxE3w1N3jo/UGfHwbWM+Bcr9",
+	"GatGlKiJlpf2qZNPlzpOniHcktUmmPlOt5OidlwoZC/zrJBAeV20ZhoNwxCPK8D8IlaGsdq162oUBC3i",
+	"ExhHm72zuQYbDlSTC2v5gHKtopJLbbWVbBrl3Dvc+Rg23MCoODtGo+IidRMUBMN9RV3Bv7A0EwF9LYdk",
+	"N5d+YT0TL8hcqTtAMCpzYEbJzvEDZw680kIdMtAWNgzfy45BzEOH2MCwrNuE+IMeMoIQTGuasa1w13Np",
+	"VafbcpmOby6QtqCWaZhEKlK3MN1J8t/VjnxZwnSNLk/eFVaMaQY0PZg5pWCwxZAqKDfBYOfBg+7CHzyQ",
+	"PYeBVupSd7TEF7voePCAD0HVtB7vOwIXQyb5NHAZdRs2dGW88dRKGXl/hv70iYlxxTNFDZHM8uv8AhB+",
+	"Yz7QjcMssuvxwjE8d8Jvi6kpWywU1VroVjmkYBHa/XwTKVTWXk3BvDvotKRWWY5MMIkLO3NY9OsF222Y",
+	"mUJdU9GSt4Z0j7xprwt7N0SaRRYvvK7Q6/8422InLawHNmHpFVx9WNqpVtnGR4HN8MnLrL6+F+zfFHAi",
+	"8vQUfc5BCOjIQ7PjuoIrtiqSLT6hxg36F6yvaIMU1ZVa7DieAX9vAos7vl7qDR+5AvgdvcIAWEeJgJKh",
+	"Ii5aeXpgrcDuMsfCgzQse9QtdVHUcOBU1zmui2+eSUT4Me4WGTJWNZNnym+COA/kUdRZgKbizsKoz/em",
+	"ovZ51JPFeCpFcrWJtoxQMhSLKfqIcXjaBB0tAswGardf0W2XEzGm0wl76GIpGgxn1rxPRZLIFnR6TQS8",
+	"VNpwzhEWaN4l0xygw1Sgs3FcMO1zseo8lvbQxy1blObL2BHyixNJOBbHO+qG1qbAzEAn645S4cooHW0h",
+	"1p7bF5XiU02vQESrNhNOPrAuRvxVZ7YDOGlsTqTQSpp4PFfYtve5+kUdUbbUg8XCrvTzA7lgB+ZRJugA",
+	"NDm2yuF5nlbFY9kYoJpgoXOkFqbg2AtugHoUFoipztlapRz3FvHNw1sSGGdJkL+zoi1l4TVOErUswXZr",
+	"pRNuKjYGOSh+neLQdb5U42nhZujP4btvzWfUCl4tUpKsUg5BnDgWakILxd29p+fE5JuNWubwNRWHsH3+",
+	"0CdtYDxJXngVvdpz+HgtVwGPY8tzYL/tXdkbIhxhisOmy3y1mo4wjoTHTziLKyX1KmRJkda2uqM5XmEU",
+	"L9nXzcg4h+ZVAXgP45iD/W5iQzBTbHYvGjKBu3JhQyYYu35b9imt3lyHoYMfO/HEjA9CHfLKPr7cfbWn",
+	"mlxmpO8c69JTy+ju+rdMD0QMqVhgzNxqhyYh21+AT7YSteygdgfOCNT4MEeTOBrkx49J5pwSTWthe4G5",
+	"MgdhHWo3jQCDMmoOpVqhYanqsLbA+BFtuLMjbo0GA8SkxEYjcmVBOJCgymzbnFftbeYVc/qD2OgaAeA1",
+	"pxZH5kQMICW9nkQLO3SwGEZvYqfWuX0YK3du37hBeLW/g8t52uS/BtuZ/0ogcF6+V+iS26jaqrh7e8o4",
+	"i+loeU5j01GUBt+GBPpQ1JvDhXSeqLraoldAYgiw1EujG/64CDkAMOrqWjehwDIzbNdhU1B5FhAjTNt4",
+	"ihKaUVWhQ3KmLFE9I3CYtEbFW004nd2MYtuudqJGuXZrYtH624q6ClGJN8KAgyU+HzsMODyCw4oHQtkW",
+	"gCC9xg2tbfgpgPa1KV1m+Ayn0szCJoWfhopQHVBo7Vt6+rUU/4kk2w1WaYt9GzYP/BQpO+TOM6ko0A3x",
+	"S7vtCIWfYVjX0QpFTA9/CIAwpYKBnmaS920pZm4RuEXFnuN0YeFspnFlygJ0bOVdabqbBNt8UdXHyrLm",
+	"AScjdEJS8yh2ZcpDU6+xvXU/W1n6BwSQre0+OUbSN9UiJy336ZL3wSQ42zrMzoKemQapR2Ba3XE7CVNO",
+	"M1ZOCFDFFm01IHaWHDjd1rtF+0OZUUByJ7Krax4QU148RP2xfiUcEx8wBspQAADRuAlTDloEgmUjsMKC",
+	"RKo3u/Wa7+lO/YgfSnkLNmdX5nyeKMwoZUajS0uc8Jub7DpZUcvzKvlV1SAD7DrGmc2uwQpPGPDO2VtU",
+	"pqJawUKo5zg8/TrH+iY43CHFKGb3pPhwGq6r9CU/paq5svxzqaAbrFx8u1UFNewhLUogB0WKw0HgH+jz",
+	"twk/sarLrz/Z452pTdI/i3w6OlTjbcQNqpgch8skASbTYY0Hq2f9UmS8qGAGGipacE7ovKx2JW+l1uq5",
+	"t6c2ZKLfls76HAvo4mePkh/KB0lznul6ZvIn/BMt6uVugxtkn6M6z09/DFByvrzqA/m0XKqrkJM9dyqW",
+	"38cMrmuq6h6pPFutgrWfONXfHXaj0OrTnOfbN1F/NJ+HOZwuCC6BVlfl05IrUOP5oXy2a0mTYT3sduFu",
+	"a6WWatsGAH/uS7j0lt1NpTo5xqQioUH8RJ10A52WaPWSKlRwq6y0tQnWPKnTgD4HTGiaKhysuwuZqKP1",
+	"6YdEHlvJUy7/5uh2Fhk4BFd3TpO8pv8GxN3/8vOXyakwzOY+gvpPbqFx5K7g411RQq07ggW+9vIgDnUc",
+	"OcjHJxWSPRtsRkW+yCLRKwOA55n3CsE9e/b0Zbi3xxlVPFgm8AZ36pglzaLaMgO2xlpVLikBtOkLo/h9",
+	"xISd2fZoNHZQgqDpIpujp9WQmJHghwxPdUZ2cPjtEYX6zCQ+ddYJ5MNSR6DIlaE9dBjJ0BbSMg20/T2c",
+	"GSQ/Z19aiBtxexS69bTo0UE/X3rSqY/W3sf4O4KxIVRJM8o+OZrOzU55CRRX1jlwZtHifgAl5YlagRCI",
+	"zx/9UKIt9HQOZ3XRnILwUH/GVZpP1lXySPewxJi0H8oeLqMNb91S8NvdHE4ixtbv08/7hx/+hdbHH374",
+	"sZdp3zesyFThjt00QSrdJ1JpzJZKl+/+xM1WLTDFnHefvx6c1e9sMa2L+HbbpHDPZAUbG8PLBw6Gy/c4",
+	"GX1E5mtMs621spE3pr8v7u83lUh+dXapnZywtU3y8ybb/gsA+TFJf9g9fPiRSuDK+ArHJLPFz3Kw8NIB",
+	"oA9pSmUHC3k4aeFscFNXcPXGGhPC8luVbWn3Oc+Fq1IVCX3mNc/SxXK5b6FZQL/BcncDGI69e5bR4l7w",
+	"VwMd4XEH8RFtIb2D+oRN4z50v5z+7wdv10gP+YH+07CqBklc74zu1JutUYvSufVo3icjNxwLXDLeugob",
+	"cZ8kT1fcm2jmfa4jMHQvYNuRGtUZ6ZsChxIGkxJSu+0yM+3rrj0xDjAM62t1KUJqQfyy4s8PaEDBitQy",
+	"RZqJHVSiVEd9RGJ1j62M0d18qRFClrvtNlkX1VxOtyGLR4Yu9Dfxg8w67REOcYgoDBoG6B0wEEAEE38E",
+	"BQcsFMe7EemHlodmBOlPEOi2oHm/7jpjrSMiOLqr4Ygtek6tJ0CYuAQlibqpVtLCjGLkXS62w+LmsY61",
+	"nTznCZ2qvWwJpxVt/N4L3nQYuu9faL37JtJeHV9Occ1BSlH4BEmFrBWdIi56Jk6ukTijb8vC9EycF6QH",
+	"mWo3kk5Qexko5XoItDABg5ptBQ4Nho8RV7I5p269CwXSFbVJ1md5kgzwWgNrgQGnYcPRU6f+SNYaG5Jx",
+	"yGqe2z2nPfMRmYvyNf5vI/8v4P+u7Yj+2vD/6NmPQcMJuWxD21GVJAAtYalr05PaaYIroN1vnA1COL5d",
+	"rSgVNw2VMnH8HM41I3MolI8fJAn7JpPJI4TI2AGbksZo4ARY3TOXSPcBslQ5d0vWY1O6mfO3Chc05+Je",
+	"KPJQy9c0j4SYLTQHyKT+jRMk61Vh0p1jZwmyuYusoKDPSpwjehCHuzli63uexKnTFt+PibMDrmG+WPZa",
+	"E19Fh6zGlZk00GGBbgDieXWVck+HoMQ7v5ojvQfrnVEkS+hgAvUDpuG/MDglMNPVwvW1RmCJw6HBcEx4",
+	"V3lD9ErfxW5zBmZo2mFpKkSFDZGM2OsNucTEiSlTN/F6miFyeY/2/gYAdO1ZIlsa5XdUSfXFk/5lbm81",
+	"J/ZOl5IMHf/YEQruUgR/A6YJ3ZuV2o9E7RTeW06+BaZWW3Iiacl2gF3KL46M+V4O5Mmckaj0fV2dmzX5",
+	"hXzB+agdAwY+SWX0fdUm/jjkGjyTCfVVoMHHuj9CNtLINhh2ta5StgvyQFwsxcP+JFCZYvvkp8Ee2MBn",
+	"w6V1Qm91Emac/QlxLeTzfTd6wFpnOnp5UnD6KhQUhMqpIpHhhf7MsT4RnYCu+L6T5+2ndzjxuG/CgWSj",
+	"zqKra7f1Ctf3vKraUFyju8xbXwEVCKO8lJR8xMEl4EtfNGQV+cIp294Rdn1zKvxAA8bb9CHG0mVe7ML0",
+	"KvP+4wlOa0uaNLs5XZhAixT+b+KSQlVBolNz6a3BBX/FC/4qO9p6p50GfBUnRjdbZ4535Fx0reID7CBA",
+	"gCHi6O9aFKVDDFLVhIaguUDnnLIkhra9rX1dt7LTjVLrDA1jrgNqRr0abV6mE0VLAmegJFS3/SpuGpVo",
+	"S3Jp4BaqORI1379kfz0B1pdrphrOxqJb3KSJbldRvRBMvbHpV3l5WKAyd5PEMMK0DprbX5yj9cBNhm16",
+	"YDDtsW9Pkni5ry7+g7qx60bJOZfJNGVtNQrhWsIMs8JaLnDWyLj4qoRDY3y0lDqGgcuUQrlwIdTpnSK8",
+	"lX8ylxUcb6ekIMvxLjaadCOZt7GCT+6SGtvLt2PwOnw/mlSvfELZqSmboTuuH0QlnLkYiZhyUhvH4MnL",
+	"cf/3YKYmju9yl4CtMlaEM4i113m0iG8e8VhRdcys15/XHjP3iSlHi9I1fEVv+muceCa4PCiu48a0ePQV",
+	"JGd+s1k0g+LrjdPot8TIF0nlyqS0cXsObBhftMyjsB2LGfgM2zM1zQFV2zTOjnSC41i7aT05q2sHroEe",
+	"NwwyJ8MbzMHrEX6HhHrYGRAkyDsViizhOBVDDtrPtmvPqzr/1bQdc4N5PckicN3H3Xov95jCkzP4ijNZ",
+	"jGSp5xJnWBOrtKn9ttaE/rZW7a4umQAolPmSRBnXkG6GcAlnUXT61N+wk7yfvilob6vqVaJWK/TEBqlw",
+	"arKf2WinT1d/tx1TqRO3Pyix9dSvpR57NGFKdwuLHRUeKbgWx0c4uIqcQj9R/sWttcaA3ooiWlO23ebL",
+	"q074A48adZJle/k4I9YR0gdksBEMUNfr8H6SMbbXs3qWZKuW7PeSYt8Kuenrt5PeqLCLRQA//3Qqx+NE",
+	"eCrk5ZNgCe9pUWYw1O2bPchQHcl4x0cOcLNkVxbIofK2u+Q3qJISbkco5fOLoGx5ViZnzx+nH/6VC5Ak",
+	"SjgnZmd6hAMXZFHMTLUWHUhbrRP4rL625VtM8RK3/nJPmfeiI/t0R4ESsVau9Mx00EOwdcpWXkvSls52",
+	"0y7FQyIQCGNf4GTBrq7VOiVeEAYyd+POLZYMxEI9FplhljLt1NCI4dhMjYBIkzTjcvWxyXIiBfVvAVn5",
+	"lU780AsZT8iXHXQRNTMxmAaqKUTLWxBgcByMwyzOJeJDQ1xpvJmukRmkY3y9SfIwsuNpEmefPTVubjPT",
+	"yZ68SFOLx5M0zEzv1HgdGRE+xltPT/xIi1YSdWyOCwVFYWwbfjUjHx+X++T3/KbwXFZRP0ZpZbctZET5",
+	"lcGCgXVh0ZPkKZOzrjJcsWEiJ/s7jD3PW1MIKt9kRcLIaE76FQ85/J5RNEI5TgxgqO0IZn2zQ5hlHcfZ",
+	"TYzM530nk0SGTsi3a1t1p8obLuMUOu6mLdFo4qzKin+o6+/xXVrOPRMvfmgYYUgIkREn4zoii1BFAgcF",
+	"vvmRwuduIKMMmhCNjbMDQo6kGjyBk0QePSwrAglG3mrVwiebviB0gz2eDePVCCdhCE+i4vbI/n67bZ+W",
+	"4WLQMokOXxg9N8N75UdiRq2+HDzbDV4+IDg47hTvDD+CoGdG8A8yGkrE5LhKLyx+T56TYe1TLOQl0cYx",
+	"pQVeEqWFXtfBybcso4ZV4Zefn331TMBHhzIcitoWu4iuit7bvjOrQjd5NWLioMtQe+LZLe1sPkcbS+KV",
+	"/uTyHOuYdTzbeA0LcTFns9HnnvBOEcurcD74qNlCAuV5iQMB82pr4uVtLCeHy/sh8tlFlhc6iFJDG8nd",
+	"psVNO+fBa9Ed4Mah9g5XSI963/ZOd/h0WOoa4Ulj97GfiUY1MQKZdFJESZPNUOnPcYGoPQ8lwAUr/8Ba",
+	"IsFhL1kR15GKIhgc3gI4ZEwJqHvTpAJX+hsziNyMrkOigMcG+uIIaTa+KUyj76RD2s2oDDeAfmFXN8vc",
+	"iWzEYBnWSUfiWyDgmPEQ4zDlqckm8W/l+41g+ZRwcYqmL8JH4HTEUuS+AAbtiqJyGILZKFrA6soKHXH2",
+	"AJaOIg6zlojaKupq1rULnyREhsnP65/xgnrwwCW7Bw9myc+FPHAApN/n8jsdX+wVERDsgp4IpD2KEsGT",
+	"/b6pyxHdiNs1H5bqcrpAT7ijwlRxOjQkynklGt+Xgr7LOheELuUX5jNBjPYPjLvrjG8XmClH6EWs6pdJ",
+	"W5SW8E0iXN+J4SXnINIW8Q+sDjNXEngdsNvsNhSsnDYAQDiNo5w3KHKUnJ5H9gt6ORIuhSPu8ki2Z7nL",
+	"nbF2Ol16xO3SAdKZI4hMHTsZw928kvO9K/P/gX3Pl9g7Bh7VpqmFw8ApzFQSevpWirB9UgbmkFQ7/E18",
+	"GgOxntr2N+TQ0FGtqh5UM20Iro1dfU36ZSQl2A9IN/WPJF9CtU44/k3iU/ImXdXVr6F8f1fe0PjIqbDF",
+	"rypocRiP/LazDW5OsIHXEy9k2kOB4wfeM+HbnbG3wwPJ2nJ4vd059y2tU3dg78DqfeKoB7YXlkHRtYFu",
+	"Tgfttonu1+uZtN0xg4abheAnqUdYEu28k5ZJ3nSdoYRme3yJy4J7xYzCBOOGAZzy+JZgBOZeqbUiu5xL",
+	"F9e+XQFhOrNswculwqAQ+VjjvjGlr3n2xMklNu9K6B3AYBut9RjMoTYCnnaydcAaA4hqXTOAGPOLpgoM",
+	"sysvs9LkCMhRkq+xHI92OFxWNfWXb1TElkom/bCxYLnop/gs83XOpWJ3GE1HZmBOZGPfAJfGICpa5g0W",
+	"8TcV4QU1sCEPZw5Dlt1Y5hd5k88LRW98wG+gd4PW5vNwLm6H1WrOG3r9wwmvnwNK4dDBJ4xYQKux47DH",
+	"RicvzlV7iTlfD+m9D/6WvEfRJk1+od5HLIrwdO/RB3+jpBv+42Hodl6qVbYr2iFusiR2om+NMB2THs5j",
+	"IOOWUcNq66pW6lcVZ1wDp4k/nXKW6E3hdeNnaZOV2VqFKwVsRmDib2k3KQ6/g5eSXsJuF3V1HfP7wVnL",
+	"kD9Fygsi+2MwMJ0Y1rGR5L6m2lBHYGGk+rDp4U7obPDdZODSDylHdqtTBDt241vWf4LOVVw1ZTJ/Yzys",
+	"Gq0zjPqjIrW5DeYVhojuQgy9ojz14toJvyLckLs257xs9mtQY7eyJVvirl2lf0V9Gv22wP5OYuCmc7jl",
+	"eyB/5jk7HdfwJMBvHe9YGK2+CKO+jpC9liHkWyy4WKYb5CjL911x1pzKaDJvOG0zljs6PPRUoQxHSaPk",
+	"tvPILXM49Y0IrxwY8IakaNazFz3uvbJbp8xdHSaPbIc79N3zr0TK2FQUiuC4xOa6hpEnr9QKhlYXVLsl",
+	"vEk45g33oi4m7cJNoH+zUWJa5HTEMn2WQ4rAZ1XAdAA/Mh3qsEop6zc15AaNLPAAyWAuQ806QSa3z0eP",
+	"UwUjnCgXDufBvDh8ovFAf3QR8TYEFdpc7njUDblNeHUhhQZJZmmeuznWyWcc7TmFcDqnUBPPWxp3+dku",
+	"L5bf29Le/grncL8tzoMB1HP88CdJC3H7qPIdGCIxdB+UqggOx/LmT1ouDUjOv1RT5wEpYeK7HSzJcjuL",
+	"s4D7YGqg9ISI3rwtcAIXq37VZFO0C4QHIA58zzSrco6rw/jtXj3W5sy/q6wI1aBFRnBOz3R3PvnAba4R",
+	"Cp0G+EKqr5nQVgfYqKzZcammBgs6YikhKf2Yb5SkUXYqb+vyk7bBsfLvNrvEePijWcsjqdnfKSM50xW1",
+	"Z3CbtYtz1r/xGOdNuJ44ppxFa7VussU5FrXBwpV0Ncvbtl4N9rPM3FQxA6HFC0GCtSd22xk6fQpqc7lS",
+	"lymhICH/2mWKIKbNNluofWpgxqsB+XTgwXbilByqXtENS405kXHuSv7oOlB6KFKilAH4MUisUq/AdD54",
+	"TN7DYPaOrW2gX5bAXrZHaCc8Bx/1moqexJqwTIiUtxOKnUgfUFtlYXJwsxmLZNy87LY/8Aq9rSujQFh6",
+	"sd0DgDK+zq6wlAuHUzyumrCKg014DlknfucuMtwGxkZC0zyhjX5SX9e78Uq0ZAow5WiX9JEtPJt8SUVX",
+	"ETC3qTnbp3QzQb9Zx25bVFhUFsfBsKaEZ+VvOF0KTul8t16TecbnrUEH+PTmJbqobKRo5/RxhqsISr8l",
+	"5Kyw5s02lBmOb7zUL1BzBTdgiQw3LnZOkidsM2u0RUYacFGTzBoLBJvpRGujmwr/0bYZRd20lSfwxS9i",
+	"TV7x3iG6n7C+K62p3qlIo+9HJnqEm73AaJJa4mmr0GJ4mWOPtXP4WdcD6B5lcwtK6wV/eUBHJVPKPq2d",
+	"penE/mjXwOlUwAHIOojf0xTBFYOm0ySf5xdcjShAlO1V6Q/W7SYnhft1q83ka7Emg5JZlTkm51wHFQcq",
+	"sD4tOkQmsZxiPP5GH3E5oYHDFaBXp0CUYFHWH2eELyJlnNynuKlMHfxni7yYXChrLKHFnA0lBdyevNDp",
+	"DCBDqprDl5GIvEzTOhD+EgqDs5lAe5IRpW9ETFqU9/KNGDypUuKrnLv5CdpEHWUfBRY3RGovMVx+jVnd",
+	"vJ5O9uy/8JsT6gABEP948lW1zhew8TQGxyBSiSgKuO0PdabDb+UCxXcf47vSXdT87AUO8aTwrUwazFQy",
+	"O9wX0K7KKIJDAS464sBBrhnfHW2A3AYTR+g+RULD0gVAFWpL93CPMFRdhxTiz7ngAZWIxzekQXuwOQ8I",
+	"y4HrCUVoo0YFLohF8EqgjaHzGvkO3kfJenr3NjeeKSBFs9P1pkN1OwgjSmiNeo74NgKZS0e5COMwL1h1",
+	"Eis560OB1O0IE4+xIJ+OYyYhyDf/oVQlQtSSQiQlSZLFsjDjQMadAq9sdEz1dD3FfE79uPe9iWLl0ec7",
+	"kAZbzNEPRbt+Rk8TeposdyQ5YE/wnVbZsIb0gtp9+f3PAvG9PBFK8rvNwFz6hRtOB9ogWmU38yIQYPjE",
+	"PIR59A5T+dX5Nf1/Pw1SQnv3zobXMbjL/Zr+9bP7w/mq+SLForzTMUF3ys3RYac+jNDt90eldBjWB+SW",
+	"ux4Ndod19ijE3z7Hi8Ntr9OLmeOrxfTsISW/oue6Cq5JQu3YrTIm2t6csnmBLesAr18MAg6XXyQrwen1",
+	"lPH9yrp2rA7FIlpoL2ulZjOscpAFRevgclApV7wlKMI+o1ggKceR4uPe14eVkFlEg3MNQnV+QB+gf+iM",
+	"w2Sb5RIUZJlFH7MSgx3PTR46dHaDu4uQAnlRP8IXSn3egOIQFL1eej0psVegbhMolVbd7gvcaTrXrkKk",
+	"fcpjKTsFgQJ1B5RK4U+K590HiFmsHeZA4fVmLLxUqhYJ+NoD1WljZ6uvdZY9IXLZW62BKrQ3bBuHE1rV",
+	"7RTLKNcQZJAlTo1Dxexr7D3U9BPqkaqfTWb4XVP+jYy72qp/BLuus5RB6+4/LqLFXKQLLj13u+1K4NJM",
+	"miyqi7za6YAzHS6ujSL8qxQm9rrqRjhAMAvjTbspB2skYFNMz0L7j+85uYDLVrwFLtbepndbNgf0PTbQ",
+	"2lfECNRz9UTMOp5cOKVDdKgZsWhH2lrMl6tHS73mzj2yejJFIO7hA4B+utxLZAw1tL7Ho/wY3QFq5x4r",
+	"RErPuCa79CQhIy/jgopiRk7SIq8XaGdHd1WsjzyM5Hf2Mh9V5SNpAIfhVPT9LDEp/lKM3YCDFxLsxSs6",
+	"+J1GJtT+IXgX4SWAX0VSLaXZTHcKgNLCHEm1qdrUa4YyEQGBFAqJAnUH892k3tKnrbsPnnSMoWs8Eg9C",
+	"b3TyPqIghuetuDPIAciQL82Mw+MftpqJc5SHLqH0Ru+ICPIUbshXanDiA9dWTliaEE5aqAtVjK5Nt94h",
+	"QdCZU1z20jJ6eCYk0UmHz6XpKQdQT1BHJWpnJaBxAjed71pd3Cs0I2Xv1Z0iAvs0FDLNRJGfDjXK2I+0",
+	"yAJ0Pry1XZmsx5gdVhhnXqN8o3vAIweyc4bClN2lxg7NdHbYulVcFAYvvHx93lIDaBCUl6p+NtLg2ja1",
+	"Jv66rRpTzRcEAhxMyh6f03AnUxMR+4XSemNpx/oFgI5+CSeAvlZqn3bd3FyVw8fuGl3HD4bJ15T+1kNN",
+	"rYGUKooEeLGbN9egFW+Cuot+KOEnBX+j42PR1kXmRlhgDac33wScbKqkd4bzIVHGz608ZOedq6K65FfI",
+	"LEbHibJeEJablaw0szzicuUbilWiGCWMT9LOZwzXuiqb63IxnqWtFzuLRxh+jUHFiycuZH3Eb+glt/ii",
+	"12O6H7E2MBpXoLOV8GT1PEXQOjZpywREiqNF4wJ6ZTWp6xxIvMLtXpqELqEaWBIjo3kiPxlibG6yrwwZ",
+	"VrYgAOgRjLktMmtzytbaoRb2aao6V6NmHn7LxQajorGYoMw47H21aBPqBwU/FBlQdeQObeLH8aV3MLy1",
+	"PtJ2Ggx5LuDEaAMDxUz8JKUgte1GV1nZowyiWwJRTyn4myXrbLdWs+Qc1kkOhxmiV57iJjisYfj0uPPO",
+	"umfJbIqLJBkxdM68/kL/CIkkntm63xVj14wdu2jO+plJA+XyF1hSBiXKmsK2/CKCkys5UT1pEFmHu+T8",
+	"EwMJLN+Y6VADqTlpm+bkpobD7rAi7hagoSY2g/A4QbE3BidWxwjwf79JPGrgZl6xCiaHNEglDJD0k+oi",
+	"8LHYKMl8AQxoyiAs6LTGTj+KMJeg6ZyeTwfOpUkSLUG2D9TAlFiZ/sC58NOYslOCMFyMob57np/LZ/Ey",
+	"CZQzH2vFExsuwCXogdM9NMQsOmWSQT7yddaZuSXhAXXWIfN/XptsFHEWsPLGU8K1t4w6PGIxKiQmaamc",
+	"zpeMhgLyZtvuH803bbDJ8XexCB2n9pXMQm1iCUvc61SzU4wB5Iytih8rx3rE8OWNkTOodypcGVIfO5X3",
+	"cL9qL2C92S0WQDmAPk3B5isag8Y2I/hvr6vWoQF6fZVhqJq8HUKevOG6KvRi+ZLjue/JEeEEK/ok3AtX",
+	"AxQsftFhgME1h4WCqyBndUzGdjQYA5Dg2ZM1UrREMjZht2dXtIpn9wC/2G02WX09snKYn16LnWOsHEZp",
+	"CSYE9W26+cm+Fjw7r7q9X8ivSe5MMnzPbMalnCCHWg+4+8VzaW67wYZC0gVKAAThdCk6nddNyHGG6ktw",
+	"TVHwpEW4zYC61sYJXXpuLh3QBRi/3J2eZLrCBq+TNWe2jOA6j9cLa+BKHoHG7ZUjXucm3gTp0BZde5PE",
+	"8VBjiXtYiZWzwESl3FscE9XOs5zKapAl2rvOw+opX9UpqjtFSqz8ekJvI3rdXhIkR/tGV/LdPtSCg1x5",
+	"B/ddGgLJIYzu5tjGOschlKjY1mV3QW4j0p37g7Pn4a3Q6w/eJkrVj6uyVIuYSWZhniKHyihpbziPcLCk",
+	"2dNn3apmtdogWpXddztluBJRts3meZG3UVOFCRtbKeragN2+QFLplKOklUiMJpWrgv0CfvtKUd5WVpaA",
+	"y4UynOS/KD6GNhWxln6hxxYbciLJKxRoI4+oTA1RAJcmIhvEp5JWsF9MokVKCjBnAzavXd3JR9Qf0t3Y",
+	"KPhhGTHDLHccO4zKdgE0lS6mVJWj3AmLUiq3lCUSVMdNObCYDo0oFiJsJY+1nDCv8pq+CAOkM8MiS80z",
+	"MsMyQc2MCrJr1xXZa4cJiYQe2OI0bl9D4IkE+E0xqumVWhoJkVGk9A5hBSNms4i7KMN+l2s8GOjtuVAG",
+	"k/QNbWKZlZVs5MRV++4Gbuo2aXP12yA0XmM+iobGcSUa7xsi5eTAFDCqB1w1Tb61iVo64yt2esOCOzXy",
+	"aOvrdL2LiT/mneTL70CMv8mGOkL/xNPiaAkH4ZL66E2aiu6rA+aI3lEhJhS+Vkq8Mh1tKR76+4TTzkW1",
+	"xQuGvvUC5DHXpxtIiEIlNxksrm3a4swKdvKbbrTOsxT5K2U7a0qSKHnB5Y2RquFxv2Cv55ruy9MFemVm",
+	"zm2xvH4vhf7Gc0lE7I+BTtxpFT9NcZf7Dfv5if9eUuU9hGul6pqVD7orsPdGive8LYkdg2MIFVxq6CAk",
+	"RCotUe8FBE48yCGDFbv+Pf2PkdpZIIocGUJXk0gTFeRkziFkP+bnunmAbhU2mtxh6DUdjUDQZRJRXu8g",
+	"0aV65NRq4CK90IGpgWZPTisqv8eX7qGl9eias7hZCD+oPdlA9gkbdg/IQcmBKdWpTkj11/cUn/lJknC6",
+	"l7uFlH53Dq3J05m8uAE2F0zfWPRX2dFfnSrroP2c+nUPAo3oxLvOoGsN0qeU6Vs1MSunCcG9Pgp4bzKh",
+	"BWYDlTaN2Jefwk4vbBuBEEt7lVPvd9Naify+S3XfP7cUmfQepd6ZJPfL82se9hxwp0CDeP8kSTAlBotL",
+	"6nz33IGgN3l5vx2a/4pmXe4oKz2TXJuTH8oQViwWUmQEsTIsS8dqjSVRbogS0LmKCrvXonxnQXiUVCgE",
+	"okwwA7ZephJhNuOLQuqIzhLOuML01hlGLcD5wpoQFNSLDYY3GNww0/oGXF2pLh5TAPAKf9g1Ckuy4O1X",
+	"pUV1OWMoVjtsIokhVJeqKEidZyGD7BWp04+w5voDkRozJHrVN7y99DDDdxZcAssbT8WDDE8EiI5cXNkl",
+	"dWrE4YI34XD4dz+rvyOQOgeVoQjKoHUFKqd6nG3D7STP8CLAN1xrRrLg18kQtgS2GAqRXlahJGfXviaj",
+	"JFgPGhVITtzX4UPVZcmZ/WFz2p7qvUxlVXu0mWle3JTZtjmvYrGjEWb30sQdeYthxw6e0JmkgIfV6og4",
+	"4/QF8GGPtBbMY3KRrirAGSS0h4+SxXY3SyjdcmYqTZn6kxKKcYrFAFb6GxuQfa6yLXIHuKEBe0CPwLDy",
+	"EuvbkfkahtvA9XUVaVj6a7RX6a+qs9KloTmysLaKNuvynH6hYLJYfEybxfKYqOCWu0/0LjMpTRJOQS61",
+	"rRbnE5Q+InKHGLVfOec6GLhqDVbk9JHZ4CyauKsVBXzKu8TYNkdRm8UDGpl8RlU7BrCCO9q2lqmZ6UAO",
+	"6QKIxXO6tpWBJMy0yDd5GysaRvWwTfBmocq1GxzZ6T4/FPGAueT5MmzQj5sV6N4zpafo7vfKEhMOhExi",
+	"hsAhHmRIzgyGrd+nYU9dcbbf0HoIL3bpobUVatW6XSwJh9gOVsSR6TK80MHnV1TSPFyBCeSCWG9neIJZ",
+	"qsZ5091eBzjvwj0k1EcSJdNIGrAbMDxMcwao8B6ZiRjZB5DeHnOE9bboDFPGnhrVHxtiQizHKDe2nk+j",
+	"NHcPyBSujJNV25SJOiR0XHdPdQUqBNnEO0Y0U3KKIHvE/xP5FqGGwyQyr9S0r2qxLctajJsSJQBdODi0",
+	"MyzPy5h6NBp5rs5zrgSJonpaBXtKd62PHrP32a/HIL3LyrAZOby909On8i5NOlWfaL/9rRi69Cwv2f/k",
+	"uIwtc1zDtAW8HZbDdROQ9zQ/85Sx8LQwxb00Gt8jSW6g4C7Y0ayGTbI/oYQo/h25iLTOJY5cUb5Yr4NN",
+	"MloVforCJpkObO0hU96NBDzEU4rKIb6N8dXyJuJKraq6ewqR0LVgaE5LzX4T7cQaJ8aFdKcZJgLyXnzH",
+	"VSlHCl12algCcnLSIthwggZ8UkW4sBfmULDRFG+v5a5Qy3jX3VGmKrEInNBmRqdGXgwMtgRZZREvhJkm",
+	"tfgZns984c1RKrVspBKABmJoxrHQBj0u7rn+QuqmofHh0qLOKknA0BbnKUZtdTLF3Bh8dUVxMLRd4aWa",
+	"zXRr+Ak8jV+1zy/cacZNsSI+hUsOLVKsKIm83AQKeSZn/Apv7hJLouEZkS9Q10BehjUFp2PA1A5NxSAZ",
+	"Mw0FKp06ZVwxQnG1IhLntjJePWSJZ+ys5lvhKbbHgftcZ1gY5Bk7ow5QmFiMIVKqNlSVQSNsSMroA4qB",
+	"HU3CkR7A7WbaO6KJNm9GzgD5/pi/jc3MwSqlN75CqSNrB8beq9CH3PfxGOdI4M0AHzIcbxofivQklvqO",
+	"XQbl8JDugY4fRHez/Q3wUTYUZvNcYeen5+qXeJyNHzz6C8cEik5R0+dERjqSAysLw8l/+oQZnVy03oUs",
+	"jlSTEES3Lb1uG95VzFJ8I11XqphmBJa1PepbWQMFb5gfTIXeNdJGB5u8vEDR+XJV5It23BcnNbQFShcG",
+	"3aeG5RwNiSMNlTNz3/BzPavUsw4O28S9hwRwJEj5XDnByVWdr3MMhnLGFXfeK7gPpWCDfd8Qn1e1p21U",
+	"sTIrMHFC0jvMHyHgwQ/gR4+l3+5gJRyMiu9EWj3T5319N4r2UDZteEIOpIv2mLYxp3aWXKiQAvEGQQjE",
+	"yjBChsWc2K7KsdKHRhRQZB8oBlEgruDbtQ6B2sQDZ6UlxLAUwI6BiMWTnQYDhHRAGPiIjS8cFsIzH6Zw",
+	"TzlU09bW1x6W5NWUDTbIlEXK1MELBOnrmaoJQeUiZnFWhYRqkvKI1nB0v+lwcyopVksrRekWfqm9mawp",
+	"uRHSeqlE2jVgsOYrPw80z9F66jDRijndHXdaaLgrhfLLdcqLHe1w74Ta6y+G8826gc0D98GBocgjIEf3",
+	"AHnoeLy6Az++eFNELZC9ZR5QHXkv1srV0ERo05w+PRX/3QE2dApecL3feAcJ6jSemObgXAY6S6ROcNIU",
+	"Vajx00Ht0HGsyCl0ZiOIWlVO6cptwJDBgxiQJgijfRZMiwVpm0ABLrrNQt9mgMnTbKmzLu1Qmk9BZQlc",
+	"m582BtrPRJm3/RpQViVqvaaoX2AkNeo/9osw9TJQ2LQslQIMocrSK5Rs0RhIvdvhRdh+Ekx3HDPLcZwW",
+	"C+G5FhUH3YYqiRVIkSL6SWiudT6uqbWwY3tiFmbnm7mNKEDvptDypIChJDXyRNcgN41NpBz8JtuSC4/+",
+	"SuEvjic3CZWahcN8ea1LrIeXhwE4XIs2pZix9WiAlWzeS/yG2x3zODBIyghOuR5ygEgokgAzm+BlvRv8",
+	"cn87iEapSk/PMBmNJEYHaojyCcVZ163vA8CboevR61x3AkCXZp45OR9IVnkvhCwiGdmNCuSqmT1tJKqa",
+	"t1znI5rD4s1DrSlt/WjaQf1N49BfRqmgoO7YGGkhIgw6MzBPMoPorWeIv862saqUKkVDTnQjaFkUyYav",
+	"BSRz3mVWP3SIm3bMSKE4sg2TL9X+Ij3bpSfvrN/wgFu/zKsr7AOA6fWv4Fgk33gGIjxXlgYbEDUH9pXW",
+	"ike/zpdqKv5gPIqk+9Z8J40BhnxqAX8AthGRHdl7C+Uy6JVLDGzoriQmqpZjp5s5bv9gaXlEDhF2BatM",
+	"rFyOhZAa02BSuoehRHNJbVrO8/U57hW2hvgW+2SCNKK2nsVviRVw8M5Kzp49pSqyXCdjgiDiYH3CnTpe",
+	"XPKsv0/dbfKv17CL8wyYVVtt8kWY9b1bjVWi7VD67CRUcMneeNrtxRVVdBkFzdz6zDDK7HoKCo4Y6Xit",
+	"U306FzvzMAubaQVJ9je+053utdylxROzIl1D8JKICI8eJhxYZlphl/7abhTzyOx6M/ryuUWJB9nQProS",
+	"QYik+Qs++SxrkEzoiqH+FsZKZ4XoRE64JPiR7ID/ZGtGZ1ybhRcRgQOcniV38W9MAIAg5fbUSAsk2rjS",
+	"v77W2mrNmhZRaxfQiTIqNQ+5GWw4wtGBwmykGwDVu78NgO9xYsCMznUx09e6fv6+TQY7CPjfh6ncuwRi",
+	"XVnsZY9SJfZlkWy8GGcP9lQZbmFCdX6N/DrayMTEfU0UqB0A4q1NPBgmNTjZFwyOLk2zAJKfmtyWmRMF",
+	"L+XlXSO1FOLhG3mR8eVxzpGrGGcK4jR62PACQzXKrV+4zdpzHSCjYw/8DDTMZpKU3l9VXVFh7uXMqS9F",
+	"OYVkZvMC9astF8P0LbXkxOeaIJjiKd825mO4a9SWqkmGlI9u2rBrveyGrvHaU6cZxhTsBrMcGLESBzyS",
+	"whCuqVKmfEyaqUcJIbrIlzvf0t3sKwn7KTp4lCcINAbWH6dxir2ZRHhxQyxitPkQ0XzwXJbh3kN8JjjR",
+	"yKRO0mxLox4zEdqT3WyzyzKezhMK+tT2h+nak4PYz+Fzkjv85jo3xwnnjSTUiW5sDY7hYe8FSC6CfwZu",
+	"kl0WJdYhWoURJMfrSVBxf2kU8Y4eTk5YXQbRV8hnmrbEfLxBfz36XsMmDXsD7FEzolMwom/ynupKEpjT",
+	"bNXGXFidCtZ6WfRFmB+Ox/U6Mw+FbkSmdqLZDpvbSZsaWnlnY4+NAReKISyMgHFDbEQz230E9TYshMXg",
+	"miYdP20TGqUD52CJNHV+vcWghja3iaLEyHxR9zUcLpQUBzLOw9t1kx2R2UYQut0OI9OiqOxk6E9FJ9lg",
+	"uSLXK3XNAiBaIEVY5Ccc2cbSIAed0LuvQIgT+xe/EWnGupxQUiASEeYmT09xYOmuW9QatJOWHItwsFnJ",
+	"nLGiG0hU29bWx+mUL9hHPINPKPPZM5iO9/JlzI0SCCc0T7jxMqqLJRTMeI3Rj3sjOujpn9nuZ4fej4tI",
+	"cPcXGOnhACC6MVNi+GyjBb1adMqpOGMccLGaa2Wy+/SmZI/fs+0hUr1ZKjf32yEnBEPy8/pntCI/eOBu",
+	"9IMHs+TnQh44KHnwIMgQ7T02ed1DjaqMM0jvwkh4Uvg89HATLwbrHBN0qvydfSpThUMd0iU0T24Zirp7",
+	"N4RBvl+GJCIp6uFWUeGorgFZ6ICQEAFkSCiKQjIkDh0CCld2WQ6WdYmcSLoM0rycFmairw4nMzNilOVx",
+	"q127z8BOZmB06BHJw0h5/v44SBo+Up9VV9MuHTRpHnpSJnNRVudgJuwsUa476Qhul6loLTT8Gp9O6UkX",
+	"TJMeOmtusjRONKhuVN10APwCA5eWqlDRnFYCYeiUdWEY1jWiQEg1o2nh6syxo10zPHIalnSxxyKZEA1R",
+	"7Snj0icGfAzZyVubOqKu8qZ9hyjwjXV0RCDp8VvQuzFObEN9bS3RYW/cb93Ah1DMKXFaJ9cHDcM6Iku+",
+	"DThkuKRb3gQGyBtrkW7xdDlJgM5r2DdBUomoVhWWaFxiJTfndexNCMSKBW0vs+vm8Mg3hLbGPR0LfqNQ",
+	"HBxUm8hDYXBUf40BwZxd8rbHIrcmRFxxlFA/2oqdRVgtPhhg1d+VsOUmu8IAvJRsv8MVDzD8jk3EWFYY",
+	"oz0w+mbPeeIVNfQ01EVZEohgdTjrtCkmR7nY7R4NdOGeD/mqtUbmg/2OYUV4TCnvaJ1GK+/qnm+BCemV",
+	"7ux9gKkiKq/ZQYe52be0j49hwSABXz+umjZWPtjdb+Pt1B0h6alcswsZLJA2JU8Gp9AvUTwJnUlrT5FX",
+	"ltVih67BbCB96cYL4fIxdiljrQj12mTyKWgn/813ZaxmitwC7L7PS74nkVMhD5WESeLymrlTQW5p5Msr",
+	"CQT9LKKJuhTeY5o1rbwkVI025SSgR4VKL2QkckAoLL1hbceND9kjKNOLfA8FZHL0jo6A2iNIkT78Sn+H",
+	"Q7F3LyWvXzPQBNHSjsRiscO2V3Cx6y5k/Lo1pPbwZ3MUDJa3YEdwEDxJNOb7z5/WFDjDcSbj37PhhEHa",
+	"VttpVXlFSZFoHAHVBzJaBMrE2kQrn0vqQ+OGh9v0ansd3G/EontIKU++n/Rco5ZaOIfDLKJDhIE4Ik3Y",
+	"mjtKnJxOQXVCBjG6u/DKHDsxdZj9my+d3H/5LJT2Wuw2kfRujP9IKf4j4dc6UGHLhEiUSzB03Qn848Jk",
+	"JFE0jnHdLiGcF5mXahRUauTgj4ezTeH3jAsBX6Yb2dEpRvduX3IWRibZQmYU8LjzFHAWaimqRi2DpTHE",
+	"cRERY7RbQ1qXaI9W3fNJMvTLA9wcrv89wEmM5baJGokbRwDtxr1zSBQpF5J3hNA7RpF9oXWdJyFwyRKm",
+	"Q/GHjIb6HQFREOhmWrBEgmWlWYPEf4Nq1RwCdteYHQCd0gOiCryPS7GIEGsB3QTzaI+BXW0gHPVzaar1",
+	"6KOHfb2mCedyQKU/89UD72C24QwFcohaLZevHdI2tkVmXcKN2+kM39Vp0weF/O9zsHXuiOu7PPzsxv2T",
+	"tD8RGMTLRLaRrjvVHuTmsEM6BNIAobsWQ3rtMCIemp33mUp3wYI323giNz3ukIetm7dXAvekMg6jhJyX",
+	"J8kTXefAF2hpFzG9nr8pKdJbUa41ES9lsiSRQvbD6n8w6i1i8PFjnrFkYlZQ4KrE+lGhDBPhNqP9hsOp",
+	"ua0f1WdULyowBgIjxaVeZtfxClOpziBow1A+01kUNLLOCEAQGslOJ6hFLmclr7GGC5rDFdD2JM2u3hlq",
+	"qgO7WNuOoq9nMQnNYluNvr7lSCH88AIw3YginwHKYXqzsdGaVAK0BppbSNfTpd4PWGAs4DNQgl4CQI+/",
+	"Vea0vI4Nmnzyn8VyXF9OzGd1gn27MlZgz4hdNTtdJ41shCpb1hXoT1SO0FUltWWfe2oP+CUlOpd7bQST",
+	"t/urodlEQe+bq4M+wUi8ZXWFhRoHS6U6FajQK4UvYmXDZXxEsuPsO+RABQCndcU000Fo8/TOtXQklyBD",
+	"nY/M1YxtyuCE4b1BZ0zT5kXBAM2MqwMvSKwxQ3VI6kp6hA4kgqALYBqKCb1YGsDXJPoB6yMTTUcHT+lm",
+	"CAbSmmEni6Ug4zy7UAJiLGqC/BBoRm32NeLi+nQKOJU+7liVD+Zhnol8Shhe76z3TmD/APWJ39378PZ0",
+	"8BVUdSrgQriU74MdQs96lUw6eXBsHZExTNuokiwKyJpqlWqTcLASzmiLXKdo3iFdcUEn3oZiYb5//kXC",
+	"z5zE0Wo1c2pTULVUmrte6Xyg23edRzq3I/z0yEUQqt7UNSArbh9QU00pDQbtEcC7OegHbuwebasuq6nt",
+	"RaZY0C0vINw2+VunjfA42DaE+ywe3H+p8vV5O9hhVaqKoacnk5w7ntQrOOR3ih4PEpfToKmqu2kaBwbC",
+	"IMewDY/CtUy85FTq3DSZs2IABQ2tfZkhgZYAeBY+Fs9tmmJmVQiSv5ckgVE1HUwLortPZ/l1udLXNvtv",
+	"tK8WQaI/GAHPLTlv3zPW9TfEZDrk8rVBirOUKCV4yx/aD2eBNl3S2SKJBWnRlEHSd9W/LT5DC8ffqYFs",
+	"85iOCPp6I04tgLKq5SRRpinM0mLVI1TfC6RvqbDGHWk5PIXUGpdw8FDVF2+CoX6BabJnhA+1fB430nA6",
+	"rTXVaCQzKiOBY2OhrdgafMLcnWqOx5kaFboLVf4zwiXPqFAZDiV5mD39m4KL0BxbrZ3bHRNPmK+x6PLB",
+	"X5K56Gbw/SJvuvmdlySZzpUpqwECQb66toWKHTo5YJ0ocR1OxiudLp18Y10xVFZl7dR8tkf0DTOVyMkN",
+	"UnmI+npkEcDfCI8CTQsge5YBN1nk2yyE8DPv6MuFS+4t27nsPOMIzzkW415wuTMgj2v1BqTbmCAhMotQ",
+	"uzvJTduqREWLMYmB9oB2sMa/d5FaPRIWaPEq0TdUnp1rGXdOXc+1ASo9+j3SGHK4Wo9GTucWkgL7OTqr",
+	"pOPpXFlni1xbnj9m/5O/YVJMt5YWA+iA03KR4zS0cY2uF3DBFnRpGAdkN8MLjN+kq2C6Hjt0NkINHwfB",
+	"/cbro1w3viPrUCbJfDu6l/90NtFSzwZLPKkr9GvbrgXOHvOmxgPPx4CKeEy+spdhJszLFDi8ERJ4r6NI",
+	"4Goj/mFvQmcpWhh+EgD1lE0PcUufUx4wfYsLTCczO3LPaIZ3FDrsu5g7TCZypjtnpkvOTsscb4ctwjtr",
+	"D3FXN/RzRCEykZ/Ygqvux7xil4s6EBgoCn08rH9iLFgHjaxyxkNR+0GtU5dH66CjjyXwe+vcK45tSBW1",
+	"axuG7OXnZ1+xUNlHbsR4+8MP/2rnP/zwoxhRzceRtryhz1v8nBGCL5lUzg9+BpF5RVdKlTx4QBNgKie/",
+	"+vOH/mM8Aw8ehOPD85AQBVPvcpwaH/cAP+jAaSOnJGjSvEGKsXblzzAAdL+KhHMQipZYwPmuJOEQUqcX",
+	"U2brWNtJpmh2c2KWgyWWx0p/YkQtFoZHhShcBtTbzWmnPUg9UyofDZTL7GMvXPWIMwM0YqTwkSQaUNR2",
+	"n2Q5cw0HjcnBY1XM+2NSgxEt7Zp802776VhV6Bv0v7bTO+0IKKcOfvuD9LWOFhV7eSBewhfC1dPAthM/",
+	"kfzztlO/ky1I7qy6qkPH7xjLldPEFjoA38PGBaOUkWkt0abScKCySWII3JG7vFiOHd/P8CU9G5b6UKVq",
+	"8uYnXOlPc7hV4PvbteBpCDjjsy8+May0wm5If/d+jzAfRkxgrd7kzlS4Q3mLwRJ6Y2ywhxf67m5OuDhq",
+	"g2FPeXv9AvGvAxnyn4Leny8BmJq8amxstYmJYnFrq1eoJHDB47V5e9dom96XFeg4aAXjfMkSbV/Y6+zz",
+	"q2yzLSSHIfn0/vw/1Ed//Xj58KMP/mP+14efPFyojz/528OH2d8+zj7420cfqA//+snHD9UHq7/8bf7h",
+	"8sOPP5x//OHHf/nkb4uPPv5g/vFf/vYf98nTCiAzoDoB9NG9/0rRo5OePXuavkRgLU5g1SAkAk4oamBV",
+	"cTw8IHVBfB7dsQW8Jj/9f/qyPoHV2OH1ryje1Pj6edtum0enp5eXlyfuJ6foOgZO1Va7xfmpngebEPuy",
+	"ybOn5nplwwntqM14oE0VUjijZ88/f/ESY0dPLMHAs4cnD08+YN+7KmGp8NNH9BOdnnPa91MhNvg3vHgK",
+	"qCvac/kDdrnOF/oRcV75d3OZrYH7nvzCnTPxp4sPT7Ux8/Q3MS79jjME0w6/pGJCma6ruDA5fdbROOM8",
+	"EQrZ4yKqjZt1x1kdO4wHEC+e1Gksl6jeSSUJcp1pxCFzvSfBrU8t07rndPyDxf6r3xjBFPe9dG7gWmrU",
+	"6ULAAOv/fvHtN3iPiFPlGaa9aGkQ04DR+qMTM2bJUq0yrLqIIg9+eaLpF2QxSjkT+hLOhylYyC65cPBu",
+	"g0xExMpNs97CXA4Tt+y+vxo4iEmjcMktJUs0rb3gZUmrXBXcrJIxP5NaT/I7J4xJXYCT5Bs2mMhDLhsl",
+	"dgtp9LBEs5eZEonP9qT/mfvS1OXyZwmQttXrTaACYZYREUUTTe+haRQZZ2WA8PT4CKZzyo291HJxypx1",
+	"5rN3Et4zcMn8+Nsnf/393oRd+SdG00qxrZ+B4n+GpQPdS3PfwVYATpT1zNQ04A8sWc8oZso8dT6375wk",
+	"TxyK/BkUGvVzDNkCWJAoAXx8sfJSZ8zSf0QEMpkRA/rw4UPNdcWT4kB3KgzGmWUwcUuKR/3OEepmFH0+",
+	"Dhioz535ka5QmVzW2OkCaVSesBIl4cX80gky4Y+PuNDP67qqvSKZN1pud7jeoj/Llrq7Ny/lg3d2KU+l",
+	"9TLesiwNwCufvMN78xTd3Nggj95kcYKOcf/W/a6kRoj6TZQEd3AbYO42yHmtU87Nk+jbDHOe/nWPWSSf",
+	"bWMdRdftvR9/j4oAp9m8UVg3UodDDbzp5IfAz/avNF/eSJQg0cBlek+fjEgX95sYj6WxvJp5751tt7aY",
+	"AT2HX6i3bkMan3S6psIyzfsnyZfu18TnK7z25sp2Wsit75uyOHXlgbzppsTdl0I2HE8UlHWc2J4/rdjz",
+	"1tz0Z53ynBT1B7JLHQHGOwWDMPViyW561fZD3Z0SmnskUdvDQbYmFkJSGG+PMfg4TUp6QpVJaubjEXdr",
+	"LORoiynURVa2E6LveKYfQxr0KEu/w10EdzGByoHXyFamDc0tsWYdpmBuEu/KeI2M+x0XD7/OCrar2go5",
+	"dQd5d2Ljn0psTLI5lgvLpGo0LOUIgiTqtE1UDvyqql5h7lTpMgo/Jx/Pv6chNzMOFpI0E3obDzq7etiW",
+	"sc2w/zR88ohzXrFPp3h5uDQbRhldZHmBZqeZLyM52XgY1JeyrVRKA7DFdIuzUs00E/pnxDIUkVaKvHDU",
+	"DwurraHlZYuhxlTKkAfMG1uYFkXbpkIvA2eTkAM6NzlJxJebuKhIWNlDSvxaKpXZ1CGNGmM8igl4lGB3",
+	"b1CCmQWzlBhHXFVUZjtJvmuUxSCjxTBhCeXZ1uoir3aN+SgCGA7xbtiRjizhmQM2rUyOQzLPJTMzFGph",
+	"KT/AWhoJ9JJTJs3dFdXT4wxvtjyK9UHvqbRj4eOkjYT+4Ql62G5PABJkzg6QhLpn8HmAnWyd7rw8FddJ",
+	"xoBj4XBeierXLmNMEwrs6XzdAsGbv8Ff65V7AAEc5/6FH6Qs9BFMMubGGjXGuBe5861TJfW9jjj//kly",
+	"1n3nMJkdR5liZsH37gwsb4OBReq3j5hWdHnzN2lUcUsbjV64TrknzxqAv0/6+B23ovyJkTUoLIwbTA5g",
+	"nz1jiNGOXhNb/UMaQQRpd+aPP7X5w3TmuJEA5lh/jZ9szBzSMTo2YeHQN4N0rJ7vpjGk4+0bN4kkZyxT",
+	"4yqQU8JklySSwWhkqnDNBkEB8DGj9szdnjvzyZ/GfOIczz2MKHaCO9uJKcPrYvIAC0rgII7aUEZ55J0F",
+	"5Y9sQZmw/Ue7vif5MRy7zoQr+8/juTjeNa0NtHcX9J/tgt7Tz0EWg7vLuXs5H+zg8A7gROfG3ZX8J3Vq",
+	"vKbLGPNExIoYvIWf1dUvVC237fbWHmoT3yQyrm4/0U2/1IvDoiiPqKyZyYfs9l6wVjob999UUoDLnXLO",
+	"RZywQkRewMW8yDB1ngpgpSuulkVpMbaGN+Xxcu6GdAn6RemSnhdcsKSzrEp62CHc95vAssriOnrhPmOc",
+	"7OEa+YP402+hHb3sHR6QYGN6v2iQWz0WiU9Ta60us3oZqXlor8uh6TuhNj4UYWF1cj+ejim/ew87acVj",
+	"UHYP2RCyDqy5r/uqUoE6EssEyf0DFeAPVEkPaYzqls0c0blbvchvKbNXMUkhqJkNUnBRuM+N7mM3zidd",
+	"YnPx8Bbc4NThvq0qEMzKa9eVfneZ0/Qf3d70L0MnIueibUZRvZGI0d6YXm8qeNQKi93AXdpKz8Sg+PHc",
+	"jYKQm0ISvLGDzK49r+r8V80WupzFtRKYQvc8o65nr/tSGR2D1WsuZQ6n4ZXuRZiXjtghvVRq2BansZWD",
+	"PTENZHDF6PxNahsLv5t2HMQZGxY9NFTYEA79h6/UtqU7ol6c59gVAwUOOKKmxZmpWIlV2DNKEn2l1JYv",
+	"tITzlYJCyHPE+98F7Xfixz7iB1Ab9iGsh0eaTp7uhMm33e7i5i5uNK0VLDdQxU8427AXBxRcFEpLiWrS",
+	"elpRVhKHrYFFxngkpjK3SZnKariza32MtKTN3EhqsZTRhoc81qQOci5yw5sIF1qx3L+moHtOQlLWLYZO",
+	"mjMV2jSDrQMsDy4T6BsevCoaYTS7F8UgY30LBJu3Q375+OHHtwcB7a/ZETqAcqEY7fVdNpAMnP8OYSIl",
+	"1i4ybi64uFzcMZ/oD26Uu9v11pMVnyUgL1nSZSlGkZUAopltpUV5HtSLynYN6fel4w2c9bLG+iIE4N7x",
+	"JX52/fTJFAniHcnynOydDnDV8N7cOuvD8gTPb6c8wVvI9Nxd+AZY3hd0K77LduAwWe3LwoY40um8uhrj",
+	"St0gImIU2O4KD63Ho4xiMnOe49tc2uo9lYFQhL2J//Kxjtt+/yT5TF6Vqsdz07N4jQWzTLvCrF4npgQc",
+	"IiO5r/98ROPfP4Etx5qwmM62Ezchvwi/Pfrgw48+llfq7JKLknbfm//l40dnn34qr21BoqYauQkL173X",
+	"4edH56ooKvlA7oj+uPjg0X/99/85OTm5P8pWq6vPrr9Bfvj28Na+I9QlgNhuveObFHQZ876Mou5WyvwA",
+	"pQRvAdiZu1voTd1CiP0/xO0z98lIwuBNHrNbifqYt5Fq9r2PZnL/UNV0c5mcwC4kDMSuAAmYHC9kHmuS",
+	"9Q74KmAKHX+65O+K2r6R9WVR5Kj6wnobVV+oOm0wIGGh05SWuiUmxplQOxOanhQRD4JxRq+at5nJf51d",
+	"dRpc8jVtI34w6WoDb4ltulHk4aKfPv00eTiz2gsgBvsBGsSEmCt8du8Wc44MsU0yC2HzdsEOH6zhqqaR",
+	"zuxhfsFoFe3VD4T9c3Pud1ZyZ3KXjb0Nzllh9WIsqqotFpPiKnUJ0UqKZXcI0HhN8tovr+h6sVmcelcj",
+	"LrXvc0LAJdXkNFBTGzbPO8M+pM9LfEqhXfgPdNer+mm5VFe6P/zQnfDttn1avu2Xwp8vEPTIDinniO6b",
+	"HEEEcheE6dRt1+WN93CCdM/baOzlCJ98k06Oty3o8laVLeau1ErjiiRQRdx3+QeK/hwlvf2EiL1rV9ic",
+	"BNcZwYUFht0QbB2i7k9Uyrq41qXJFzmairQdJnwn4gxTPQxvcZmDCQH1AQbVRe+dJnDnSbgRT+kS1E3Z",
+	"xuk5cd79tAxbd4EButMjjqxH6Phg7rv3JtnmndpwfLXBOXL7hYur+k5h0ArDuTkee+gLzrmarCp0md2d",
+	"jnCnI7xuHaFPc3vd8hyQfPobnQxXM+jdNNQL/a5f0J+2X5DDmDEgXTizFpg45acjbQZECxvHGpMrJE3p",
+	"3qOHs9fuDSKS7p1EonTd8m0Jsli/k1w45Jo+/Dt3YMOyazBToPEz/QPbwiEgK4RVmZ6q1FVOJwSwfu3G",
+	"h/NM+IL0S5LejAmS9F5QPraT9x1ZhJZjVK27Q/B+CO7dH58zR5TjJYv4I3RU0i74FJRttCTRARdN4Q9Z",
+	"MO51yj+ve0GwRYorI+JdxrR4VwTPC01npOj20ez3pbvuRvLYqcPCRmUzl+P8qcS0YcnE7o6DzHdBOgnf",
+	"bo/dS010gXWt1IbCl0q7XNge2kG12bbXljK55am8cqwLjUA9hsDwB1hz35RgQF3468g0RHf3+d19fnef",
+	"v12pZrFD+xove2yDPnrL/x1fGrnep6jqONk7qa//XbA0oFLi2k5C9fL7WhiNNoWL44sO05apTt6kl/aN",
+	"MNu30Nr7JtjZ7egTdEg1nxEbQHlkpsPWlFG2w6/d6RUBvUKzgneFgZodfx1WuSCT5WdHsyi+TSvoM2gH",
+	"iJmpAhOrBXKnAdxpAHcawFt8AzMzObqsT7UpefBT3R0+egN/hS87POoZfTBZCYArS8dRqISLYnqLm6ui",
+	"KtfN23mBDZFEGC8B0qAHLL3313/yJxSZH1e7YklCFteYTYgGkybHwpBNtVF0faKgtsmbRiJ2Pn7419uD",
+	"sM0xigWvTrhNahuW+meqCfhC1Rc5bMhLBd/WWZ0X18l35VGqAVIME+25m6kWYA55SZmwmRQM5G9gMZu8",
+	"RfPIjZhgU8FbDO+EWoCNUn6F0RmXKgT5sGoAUl2U6vvnXyQKCw9wrBh8oS5AS/AMORcVprS5tf0MLCbA",
+	"g6ugeUX9OHU4r6XJmFQNbbxPZsJsAQtpJ8gTo1fLKkFuS1aKi3C9HeJoLwxubmTs8Ze2VC2mPL8Tqgrj",
+	"Nlat7jmt+PK8Qq2yLPLS2Y1LVSus87A017bBQLgEHQ+QbuB8XgciEimbQSaBU/AqNvjM1NA9i9fQhSXm",
+	"VWBB1kvAb+BY9tbmI3mZNZqK1TIyPJ2GrEhj8zwbHL2qcwz+LIDRyLkanCekhJ0FC/PxQdNjuqrP3tUM",
+	"BynCV6tCkCMb6X/9os9cwpuML2Ep4tppJ26gxNoldF510cv/+95/PsLCl1n668P0b/9++uNvH//+/oPe",
+	"jx/+/umn/8//6aPfP33/P/8tFDiLC5hyLIhf4qbucxQ4Gg2zRgfwFMcMlRF0+CxfFK1SbwBNrdoOHTN8",
+	"HgIZr4bI2aVHw+5JeqVHhZNitw3H/75qg83EL+pVKvFIfSDEkGZuQLqoDSQMFoYbys2I1H7BRqvb3Jau",
+	"bcVj8h1GbHhln6s5/Gdmri0iWO949MhZqELvpI/SKcadF92rNERBt+javXMP/DndA8TbKbMyRpCvyUfp",
+	"VcL8rb3CvLBR+8VL+9GepgtPTHHLo8NWqaw+XJKdlk7kzvj0iZuRUlFUCcZoa0UqAgqiaM8K2f9+b6Jz",
+	"Al/C/WY30a5kQOl2BgBFs5ey79VqZmrt4YGoVo+SH8oHSXOeffLBhz99+Mlf9J/wz4h7BechwEIOFjsQ",
+	"PuZhpgXZv8M+oyNne2n8Prrt3d5vEwGRy6s+kJypGOjukTshWvdBY8+udaJKSK9gXhIx4LnDbhTeaM15",
+	"vn0T0mU+Pw9GIuhAgRf5ulTLl1fl0/Izw4tB5MpX15RTqnnG7cLdgvi7VNv2PGRr2QIt47VGu0Vv2d1U",
+	"ilW4XKe04ElTqPOeqBPO+TNpntiVpWFzRJYUKlvpKvCw5kkJe5bPIKFpqnCw7i5kitwWpB8srPCm5DSb",
+	"qMcXnUZe3blz3qi81r4peS0lcQ21NAl68NDy5oQ3hW+6jaSAMNtqURXSWoxysczp5kL4o3Keiif8OQba",
+	"GOHuJcwBehbnu+3pb/SPbQUH8Hdbx5xaTsAUjf1pqea7NQp4q7xQ0d9xvG0LV7hUF/FeqtW2yK7TWkmf",
+	"NW+UogX8S9Po/o+hYur+W+1VebqGEwRLGixsQujkblEJfeqZvV3c0mhBs+xX9PkLNIM/wSG+qGpHsP0S",
+	"vxvNwO+c7ln3wNPsCVVACciSr0eS/FMLYIPuxc6G39yHHRgx0MfBzzan5F5Nu+yEcSkYnXOFCpHwXczF",
+	"27Ug63Nd5djf0tnGjp4JvxhG8Jr9rq970W/CjXv7gSafvMPnDIsdPd1sC7KKc4mEG9Qo6HI4fXsMXrf7",
+	"CTFy9fdLFvTvfPfG1zVZjRFs9ILfw/PqlCpRejq0tsMHeFe/Hofr3U3+dt/kj3W5GI8M7+7ld+dernUR",
+	"uLsr+O2/gj96Z1fzGuO8Jl7J+iY6+BrWSvx4GBe3G5tJcXHdV4gVm06/x0u0UqCjyymDzsYPibTSAVzc",
+	"vaFxSvZ5nyyr8n5L8VZ4gy3z1SpZ7YoioFRhLULuYqkjFwA8tcIShLqLpe69Srq7jeaq0f1W4s+6/6XZ",
+	"v7EC4HZrmkNFDh99r13yOHJtbGevwq6P6dQhtR7pzaV2PAi1uJUnvW4i0/uEhjbtoIahsgZvYVNqX7uo",
+	"2rdZ+SFn7OSuDO2tGr+j+8Rk0+Mt73wgw0E0eZOLypiM99Qce1qrgNQJrRgKfiAbcfc6br4AhAs7vlM3",
+	"31HPvdzeU8u0TnEljLUbkimPkVT5VkE/zSAelt4iB3VmiiPmNVbJrBY5RUA+XTYzPsRiRXevvDsN/W3V",
+	"0J29vlPQ72zk75iNPKKOi3ka+NoEQWNfAehiA1ftaba8wGhr14nODyTGs1qtGpaHYmIRa/CLXV1jbAbS",
+	"LXDfDYbRr2IF6in26SW8+QLf/JanOOrda8HuyEsd8BCLjYJJllM0LRn10AuKgqbiANy6VmV2QMMivRtP",
+	"DqZl16bTo4Ski/yGMumo6upcJYIMoL8ECfDkMHr2yPb0N/4/OYS2VdOGclfaMLjJe7It79Mh5HE9AJNn",
+	"JJ1KoW35CrSVhyBZwJHdlVQuFmvLcttrykWsr1GC1Q2Ha4Ulab2obANH/+S8iJ6cUR2ht7rImsJKQmVP",
+	"6DFNQ50Svf+49QPwOCuF5PsIgl3ChiFrmPhC6YSJk7t2mAdfc9JHaoABzrB9Cp9Guwmcp9vs5g0KQaWf",
+	"iXy/8c/LHgxDXWHeEN7dWWFvP9YfsItmvsl/Vb0H3L9qKK/hBb9xw9usw6S4a1btB+PqK1d6agHn+drk",
+	"mWorZ3PdgPYmuVFOSU3+9KehjMEDsmK/padfS6ZW/2vqATaYUhv7tltn04O/lyPmzjMpg+uG+H1L2MKN",
+	"jG6d1QIuuIWA9MJh+t/zjOlDc10u+icJfnTiNeIPT1ls91+gHZ7mW+JXKQGPdk7siWIpwATkrGklcfcR",
+	"Mx+iphkyIcrvxoubrFHuKjnrexbM+V8phdaDV/Sl6Q1xmdXA3bZVVZh+uskir7EtOQ9ImD9JzpIaLZ/U",
+	"rYj9Tjm6qy5BAUB1Az0YdDkh58PO5HCmaZe4Iw9mdrBFkDknHqEayw3wWDN2VeGbZP+AezsVVwjCBOjY",
+	"gJyUfPDw4UM9e0a9thChil7gmXXvK0IuvkJy3Cu1bcmX4hU56E5qbddsfsG6IvOsUZSZDDPo0vsoFy5A",
+	"BDHDkX/NPl1kO6xNsdvqvAIbwjwQzPOSaWeC1LTKa00aZhtxa2bY2ZzRhouVcxdtZ65RfO9wsypCU2QR",
+	"YIicQXU0jyfApOG/IUxOGy8mlrlqLxXuz2VliUaT3gcTINP0Ggbsg9dfYZv5YDrgrfNw7bGTcP4SqABt",
+	"VV+ndAiGBnbprfVPFx0sENofdXga/qWyushN8QHjDyKefxK/iCN+VX7meUaNI2MPU7CcsjGbr4/uMK4M",
+	"vFOdmvuw/Dtn5i07M3lviEDlsiAdGS8KCZ9oDbXLzUm4MnzkXXdv+oc3QpT7yVnVeo0/WCmJfwBp6UIV",
+	"p7/R/37vP6527XbX9n9HbYsE2+b0N/Nv53u4NOt84eTnOHAR5kM/n+YYyNTGngILJt+dZ4zsvCIVH2IT",
+	"nxpchx//5v3p5w6NvYn64gD0gQ9eqetaOXuyVdy0Uf5sznctCnXOL2W2bc4rZw5yY3HaGP1714SfTejU",
+	"RrblPRPtrUvZr/SF4ujbHEk0WFDF4iGkCJqnxn57WcOu0Em1DzntmoMP5L0/d8FACZJ1iYRj8LCeTNPx",
+	"U9xVDfxDVQ2cvO97XWmG3Q1ytF1zXEPbNyCE8Li2rysefbfPbDZHUspM3CcD0VEijB4alrG1scW+10mk",
+	"t5ptW4VS9u2HabZgJpuyOT88odXTxOhP01H0a1bUKluiqg07Vc05YlWbfWiRWUOmD1PWiBOGg4qFAxdg",
+	"ZKGwr3aq+5KOgWb6l5I23w7giQAngM0sGPe7yuobA/vqYhROuNlTcuk0yXv/+B4dRLcOL1s4hxFL74TQ",
+	"a1ocihGzD/W06YcIrju5S3ZsUGKqpTIlFdq1pFBJAIV74SS6f12Iert4c7RQJY/8NVO8nuRmBGRAfc30",
+	"flNod9sU7+9AgT1+ir5Q3LAyKyvtRw8NhsazdIwtk4XNWUuDK3A4YYgT08ARm85X8Oy51KxaUh24Rox1",
+	"xsyDU8QBxltUSuH2R/6eH4bGXuB9WDZwjckItidwaA3UAj461zfwVM9FdX712KbQBXu0x0aOYckZX5DV",
+	"uNHNrRPWSg3M+4sjf3smfrc+Kj0gLCKGAHlhWihb7LrxrBFAsEes+dK1bFi45lVVqKzkekHVdovcok13",
+	"pfkuhqYX/PZZ+519t09cUrmY7u1lpRq3CIlAfmkMhfDtORxIgSPZsAcBtnVNdVVDMONhTKkkeDpE+RSi",
+	"gG+5R2D0kO626zpbqnSpiizgIfyOHyf8eGgA2nFNnikWdkw5Yye86ZaS66jn0wxdpZGSn99UUlJzgUcQ",
+	"lWdLIPL1yMjwHxwhxJyEju6boWiu4Bbp8WjZYjKLFDCGV3DHhR4IZOHoUwCO4MEMfTgq6OPUmg+6U/w3",
+	"DM0TGDli/0muYYrIEuz4ey2ga9t2LzDvpuiw9w4HDrLNKBsb4SOxIxuypb+TwS3dIP7XaL714wIcBfDk",
+	"EOX2VBPSFC924NbVjIA1RiTXJVo4gFYROcsduooza2d2nFYS+46V8vGzBM5rkaOb1l67eYsOZTgAarWi",
+	"hI3ScVpbWIwhET+QjJ0Z3DdoM2TAxdJHPnEx6FdbzPJI5rslLLvpZ2xiVOC2VlvUE/Do5S07m/WCsVW7",
+	"5GGSL7hEn3dOMRn67gq6f58J5r4zB/g1+A319oQlTLN5bgbXSUhyGs0WdBN+aPHWRJEjXyzURVZGKio6",
+	"lDd0gLsIiznvzJr3yT7sDG4MrvzWXN2E7mdJDspVeX0Xr3djb9UODuuGTFLRnTiM+11meYu1/NmMkGYr",
+	"AHu0gMs/s1xHxUsgIIbCUN3fhEaQwyHjEMuqHTYqMhSDkIiwTOEsPYaBU31R1RRxu19fJfgwAa0+L2Rq",
+	"iRZiQ+Hb5y65M4HemUDvTKB3JtA7E+idCfTOBHpnAr0zgd6ZQO9MoHcm0D+vCfRN9WZKtcSh23Rgv8hu",
+	"DmJyZ9P4Q/VcdWvIkVGCzBhoREC+5NSnlSc3a93UqqwgHOSFimdFc7Lmy8/PvgKhdVcv0A63JCFzW2So",
+	"G8A5nIl1I8HMnb98rGv38N2ZbRJsXcIXLL7w0YfJi7+f6T4z59IPxX/3Pd0msmmvC/U+Gn2B56tyyaIo",
+	"mn0xx0iViHSx/Wb6TlhI4SG2UGALgoRSzD+nt59gBDSaJ7iFRYIGlr7J5yUg57HgZsTi80+cXFJUf8bR",
+	"fp55hiZB2yYz/QT1WjHFiUsYJU+cokY/r7KiUT/H0mJ4PBjuXqBjlbn52BZE3OSzanndOSG4a6e0gf7Z",
+	"sN1m8jKrrwP9AfpR/F3SgBXMqbUjIq9vzPr96D2R+kTbJ7MxCgva3VUTPMdDVB5sBmQ2rDcUV75adejk",
+	"XqhoU7cDzj0D4KRcGCovwHsCtwx992abDxJEcsQsM39r4qb9Nw3ToHdRixDW867a9DXig6eXzv4MCXu5",
+	"g9/Rzq7bKo1fL9igF0daqzIVBpTOgQOlHvu6591Cy7zJmkZt5uM3kcs/6cSZywefDN9Tb+YaeeIsbogn",
+	"u0RzlQoDjnDn61ZN5s0GWzSisGcH46+bRcfYqAtCIvwpZFXq8L59mZ6d5vqO8d0xPuc0diQCyqUPMZGT",
+	"18j46ut6V8Z53udXarFD4NyT/B6Z58knh+Ya17FJPbUwcS/gpKP8YBoP23u/GVbIy53KBfejIB7cpNPe",
+	"tOpbd7g+d3EKsb2ne/K8z7UTymvyZmy28C/t80Wzw4YqPGA73qzNTjzGS0L/cTkvt47rB0aRg1asgTE7",
+	"t47JcK25rRu2on9nPFG9Bt5w7ABfLr0MeKfB5FU5vZIoD/3yqrR8ezCDnNcbWJ3MO+XO0Nvu12xrElha",
+	"CoPwCfNOlxSc4KN8l0P+J7lHuOKbinDcflNGyyGOdJ3UDqOj+wTx72QF89+nv+HrTnaz26E7/OvpHC21",
+	"kWcrpVKYNYd1qsgrZCyJ5+u53b75zaMGrfSG92NXnDBF9s2qYovVYijekMzacHst2h/KjHxDzsJO+nEt",
+	"2gge56KP9Sth92TAeyhDAQAUr2k8RkFuCrvRn/MLpTSzboA0uV6CS4rw1Q+lvAVyxK5EBQ/m2mBNq5SL",
+	"WuFJRbHohN/cZNfJiqqPVsmvqgYVAgUKZ9fZTg2EAe9wIA2Hea5gIVguHR0HX+fIy3E4Xc/BxM+q9rKq",
+	"XxkshEMVsaV6kzdp2ObzJT+l/sqyfG1bJDspP7Z9UW+3sbKGPV9GIX/6BOHOqMVfkTetjb3owX5rfncs",
+	"WBQkspdOPG+HtpL3qEKWEND7vlMKJv6hxHsUCInuDkwXPoQcut6l3lnk09GhGm8jOk4ovdZJmuVRuEwS",
+	"YDJ3Hp0/UD68Qwfaa0obz01bO3u/p/fGu3JBj/OqvoSenv7WXnmFTvyX6vwifqeL5uJZ5zoVaeWNl96C",
+	"Bh0n73SDiFnMDSRF2Kp1Y8O6iqKR6GSnFRHmXWDVkDXyeuSPusF24gxM4YGgGVbwI7sbjJni7Pnj9MO/",
+	"Ym2/srVzyRixNfMoKX90AwfSDcquCKEcTVHvDxiuBOZKK4BCTdIzQG+lyxGi4l4RJebldtc2J6/ZNkrV",
+	"MYCiUy6yGQnEg7ekCqfdZv6u41FsgBqyYpc51TqtzYEyIUwFraAYgV+nOHQNJ6mZiHgY+nP47lvzGQ50",
+	"pRYpEqJK2XY0dRNf4jfMGMbkGhtpn282aok9O4B1b2u1UEuusY4BaAbGE67aY5oJtefw8VpSZnicSziG",
+	"GLtHcbho1OgOEZSR2LaDbeOmI4zr++MnbBNJuZJ/f5FnCdu73a58KsMAwF5beJI00AyjKXt6nb4A86Y+",
+	"LTG7y+xeVOPBXbmw8ZGMXZ+jTxDnPMHMwY+d+BiNbe5O393puzt9Rzp9ob4XhLpVxxLG+HL39TWbTF93",
+	"+5dbtMC+kd5Qd52A/+idgDUHwni0OvO00iTUMg0j13Jgd1T1b66ce68qJa6eLDicrO24vbgdCvAiMmXB",
+	"ZYAtH+hiMFk0kp1uG+zuE9u4t9E8pOSegmjf6G6zYb/s41oJupzFyWfJNi+lPvrAepKX/T5O5uogZUT3",
+	"FZBRURH08Vxtg31iudhxwZZDHhiu5Yu82jWFtLblOxaT6c+kyvtf9TwN2XrnmJqvSlujvcHa6+zfBa7Q",
+	"9+4yQpwrlke7d/xOwGoZva79RK1Ao6xmt8B8Q+zu7GHCwXNYyhgVgtydgjvXkMAEuSdzxB5NAZyM3F+A",
+	"3u8hWOVh0NSMAD99YqUstUKjS9WRVQPjR4JxOjsyMwnMDhCTvK4m0z8LwvGnd6reWWRvfstZpo2W1wi5",
+	"H2iB7d0dp7/ZI/A7w4lZvQHPeN4sqB1I8C5xbVMF1QpBZ122WOz0VUG8v8+Qn9B0IYY8aJM9CwEhLdD7",
+	"5QqcUz5Us2Bic90OFfThQPWK0bj8E9ZYDiCECi7rSsrvZDQe7WaM6087jrPxkkleL2B9FbuHCPvUFKYU",
+	"T+DidYSFGKz9ssTdw0cNPd+KE3jXrHvqSej2Y725lhcYMVBPXbzJXqP5O0PJXRPt12ZaeIO3y+0bgW5U",
+	"B8tpKc/WzZvcXZFetI5Bpm99CSn/3dssBBSyZ2MVQF5u7QmeZowiJhf7czpOdc0Rorle5E2OsfSiRPYs",
+	"GZypquv2yWOuYbEwTSM4X1DsI1wtEBvHqRl1faMWua73lgLMStSW12UGt7wpJyjrQfiKfJO3umNdkV3h",
+	"ZcR/rFD7DbTU7Vvhn75VUvMf8M6+iy64iy6482/e+TfvogvuTt/d6bs7fXfRBXdK853SfBc58W5GTvTZ",
+	"kLjxb2Ah2C+ggfkn5fWRI3ixq/P2mhTWbJv/9Erhv39E3asBfGhddlcXMNJ5224fnZ4W1SIrzqumPb2H",
+	"Gqd91nQe/mjg/00rhDqH4Pcff///AbcnJMJ2BgMA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
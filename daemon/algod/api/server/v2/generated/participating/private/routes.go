@@ -21,12 +21,27 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Return the recent transactions rejected by the replay protection.
+	// (GET /v2/debug/replay-rejections)
+	GetReplayRejections(ctx echo.Context, params GetReplayRejectionsParams) error
 	// Return a list of participation keys
 	// (GET /v2/participation)
 	GetParticipationKeys(ctx echo.Context) error
 	// Add a participation key to the node
 	// (POST /v2/participation)
 	AddParticipationKey(ctx echo.Context) error
+	// Install a participation key exported by another node.
+	// (POST /v2/participation/import)
+	ImportParticipationKey(ctx echo.Context) error
+	// Return the proposal and vote performance of the tracked accounts.
+	// (GET /v2/participation/performance)
+	GetAccountPerformance(ctx echo.Context) error
+	// Return the assemblies of the blocks the node proposed.
+	// (GET /v2/participation/proposals)
+	GetProposalAssemblies(ctx echo.Context) error
+	// Return the participation summary of the installed participation keys.
+	// (GET /v2/participation/summary)
+	GetParticipationSummary(ctx echo.Context, params GetParticipationSummaryParams) error
 	// Delete a given participation key by ID
 	// (DELETE /v2/participation/{participation-id})
 	DeleteParticipationKeyByID(ctx echo.Context, participationId string) error
@@ -36,6 +51,15 @@ type ServerInterface interface {
 	// Append state proof keys to a participation key
 	// (POST /v2/participation/{participation-id})
 	AppendKeys(ctx echo.Context, participationId string) error
+	// Export a participation key with its secrets.
+	// (GET /v2/participation/{participation-id}/export)
+	ExportParticipationKey(ctx echo.Context, participationId string) error
+	// Get the keyreg transaction registering a participation key.
+	// (GET /v2/participation/{participation-id}/keyreg)
+	GetParticipationKeyreg(ctx echo.Context, participationId string, params GetParticipationKeyregParams) error
+	// Return the progress of the node towards forming state proofs.
+	// (GET /v2/stateproofs/status)
+	GetStateProofStatus(ctx echo.Context, params GetStateProofStatusParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -43,6 +67,26 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetReplayRejections converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReplayRejections(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReplayRejectionsParams
+	// ------------- Optional query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetReplayRejections(ctx, params)
+	return err
+}
+
 // GetParticipationKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) GetParticipationKeys(ctx echo.Context) error {
 	var err error
@@ -65,6 +109,59 @@ func (w *ServerInterfaceWrapper) AddParticipationKey(ctx echo.Context) error {
 	return err
 }
 
+// ImportParticipationKey converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportParticipationKey(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportParticipationKey(ctx)
+	return err
+}
+
+// GetAccountPerformance converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountPerformance(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountPerformance(ctx)
+	return err
+}
+
+// GetProposalAssemblies converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProposalAssemblies(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProposalAssemblies(ctx)
+	return err
+}
+
+// GetParticipationSummary converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationSummary(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipationSummaryParams
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", ctx.QueryParams(), &params.Window)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter window: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationSummary(ctx, params)
+	return err
+}
+
 // DeleteParticipationKeyByID converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteParticipationKeyByID(ctx echo.Context) error {
 	var err error
@@ -119,6 +216,92 @@ func (w *ServerInterfaceWrapper) AppendKeys(ctx echo.Context) error {
 	return err
 }
 
+// ExportParticipationKey converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportParticipationKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "participation-id" -------------
+	var participationId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "participation-id", runtime.ParamLocationPath, ctx.Param("participation-id"), &participationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participation-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ExportParticipationKey(ctx, participationId)
+	return err
+}
+
+// GetParticipationKeyreg converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationKeyreg(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "participation-id" -------------
+	var participationId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "participation-id", runtime.ParamLocationPath, ctx.Param("participation-id"), &participationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participation-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipationKeyregParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "fee" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fee", ctx.QueryParams(), &params.Fee)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fee: %s", err))
+	}
+
+	// ------------- Optional query parameter "first-valid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "first-valid", ctx.QueryParams(), &params.FirstValid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter first-valid: %s", err))
+	}
+
+	// ------------- Optional query parameter "last-valid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last-valid", ctx.QueryParams(), &params.LastValid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter last-valid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationKeyreg(ctx, participationId, params)
+	return err
+}
+
+// GetStateProofStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStateProofStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStateProofStatusParams
+	// ------------- Optional query parameter "max-missing" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-missing", ctx.QueryParams(), &params.MaxMissing)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-missing: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetStateProofStatus(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -147,199 +330,433 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v2/debug/replay-rejections", wrapper.GetReplayRejections, m...)
 	router.GET(baseURL+"/v2/participation", wrapper.GetParticipationKeys, m...)
 	router.POST(baseURL+"/v2/participation", wrapper.AddParticipationKey, m...)
+	router.POST(baseURL+"/v2/participation/import", wrapper.ImportParticipationKey, m...)
+	router.GET(baseURL+"/v2/participation/performance", wrapper.GetAccountPerformance, m...)
+	router.GET(baseURL+"/v2/participation/proposals", wrapper.GetProposalAssemblies, m...)
+	router.GET(baseURL+"/v2/participation/summary", wrapper.GetParticipationSummary, m...)
 	router.DELETE(baseURL+"/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
 	router.GET(baseURL+"/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST(baseURL+"/v2/participation/:participation-id", wrapper.AppendKeys, m...)
+	router.GET(baseURL+"/v2/participation/:participation-id/export", wrapper.ExportParticipationKey, m...)
+	router.GET(baseURL+"/v2/participation/:participation-id/keyreg", wrapper.GetParticipationKeyreg, m...)
+	router.GET(baseURL+"/v2/stateproofs/status", wrapper.GetStateProofStatus, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PbNrIo/lVQOqfKiX/ijF/Jbly1dX4TO8nOjZO47En2nmP7ZiGyJWGHArgAOCPF",
-	"19/9FroBEiRBiZqZ2LtV+cseEY9Go9HoF7rfz3K1qZQEac3s6ftZxTXfgAWNf/E8V7W0mSjcXwWYXIvK",
-	"CiVnT8M3ZqwWcjWbz4T7teJ2PZvPJN9A28b1n880/LMWGorZU6trmM9MvoYNdwPbXeVaNyNts5XK/BBn",
-	"NMT589mHPR94UWgwZgjlT7LcMSHzsi6AWc2l4bn7ZNi1sGtm18Iw35kJyZQEppbMrjuN2VJAWZiTsMh/",
-	"1qB30Sr95ONL+tCCmGlVwhDOZ2qzEBICVNAA1WwIs4oVsMRGa26Zm8HBGhpaxQxwna/ZUukDoBIQMbwg",
-	"683s6ZuZAVmAxt3KQVzhf5ca4DfILNcrsLN389TilhZ0ZsUmsbRzj30Npi6tYdgW17gSVyCZ63XCfqiN",
-	"ZQtgXLJX3z5jjx8//sotZMOthcIT2eiq2tnjNVH32dNZwS2Ez0Na4+VKaS6LrGn/6ttnOP9rv8Cprbgx",
-	"kD4sZ+4LO38+toDQMUFCQlpY4T50qN/1SByK9ucFLJWGiXtCje90U+L5P+mu5Nzm60oJaRP7wvAro89J",
-	"HhZ138fDGgA67SuHKe0GffMg++rd+4fzhw8+/Mebs+x//J9fPP4wcfnPmnEPYCDZMK+1BpnvspUGjqdl",
-	"zeUQH688PZi1qsuCrfkVbj7fIKv3fZnrS6zzipe1oxORa3VWrpRh3JNRAUtel5aFiVktS8em3Gie2pkw",
-	"rNLqShRQzB33vV6LfM1ybmgIbMeuRVk6GqwNFGO0ll7dnsP0IUaJg+tG+MAF/esio13XAUzAFrlBlpfK",
-	"QGbVgesp3DhcFiy+UNq7yhx3WbGLNTCc3H2gyxZxJx1Nl+WOWdzXgnHDOAtX05yJJdupml3j5pTiEvv7",
-	"1TisbZhDGm5O5x51h3cMfQNkJJC3UKoELhF54dwNUSaXYlVrMOx6DXbt7zwNplLSAFOLf0Bu3bb/r9c/",
-	"/ciUZj+AMXwFL3l+yUDmqoDihJ0vmVQ2Ig1PS4hD13NsHR6u1CX/D6McTWzMquL5ZfpGL8VGJFb1A9+K",
-	"Tb1hst4sQLstDVeIVUyDrbUcA4hGPECKG74dTnqha5nj/rfTdmQ5R23CVCXfIcI2fPuXB3MPjmG8LFkF",
-	"shByxexWjspxbu7D4GVa1bKYIOZYt6fRxWoqyMVSQMGaUfZA4qc5BI+Qx8HTCl8ROGGQUXCaWQ6AI2Gb",
-	"oBl3ut0XVvEVRCRzwn72zA2/WnUJsiF0ttjhp0rDlVC1aTqNwIhT75fApbKQVRqWIkFjrz06HIOhNp4D",
-	"b7wMlCtpuZBQOOaMQCsLxKxGYYom3K/vDG/xBTfw5ZOxO779OnH3l6q/63t3fNJuY6OMjmTi6nRf/YFN",
-	"S1ad/hP0w3huI1YZ/TzYSLG6cLfNUpR4E/3D7V9AQ22QCXQQEe4mI1aS21rD07fyvvuLZey15bLgunC/",
-	"bOinH+rSitdi5X4q6acXaiXy12I1gswG1qTChd029I8bL82O7TapV7xQ6rKu4gXlHcV1sWPnz8c2mcY8",
-	"ljDPGm03VjwutkEZObaH3TYbOQLkKO4q7hpewk6Dg5bnS/xnu0R64kv9m/unqkrX21bLFGodHfsrGc0H",
-	"3qxwVlWlyLlD4iv/2X11TABIkeBti1O8UJ++j0CstKpAW0GD8qrKSpXzMjOWWxzpPzUsZ09n/3Ha2l9O",
-	"qbs5jSZ/4Xq9xk5OZCUxKONVdcQYL53oY/YwC8eg8ROyCWJ7KDQJSZvoSEk4FlzCFZf2pFVZOvygOcBv",
-	"/EwtvknaIXz3VLBRhDNquABDEjA1vGdYhHqGaGWIVhRIV6VaND98dlZVLQbx+1lVET5QegSBghlshbHm",
-	"c1w+b09SPM/58xP2XTw2iuJKljt3OZCo4e6Gpb+1/C3W2Jb8GtoR7xmG26n0iduagAYn5t8FxaFasVal",
-	"k3oO0opr/FffNiYz9/ukzv8eJBbjdpy4UNHymCMdB3+JlJvPepQzJBxv7jlhZ/2+NyMbN0qaYG5EK3v3",
-	"k8bdg8cGhdeaVwSg/0J3qZCopFEjgvWW3HQio0vCHJ3hiNYQqhuftYPnIQkJkkIPhq9LlV/+lZv1HZz5",
-	"RRhrePxwGrYGXoBma27WJ7OUlBEfr3a0KUfMNUQFny2iqU6aJd7V8g4sreCWR0vz8KbFEkI99kOmBzqh",
-	"u/yE/+Elc5/d2Xasn4Y9YRfIwAwdZ+9kKJy2TwoCzeQaoBVCsQ0p+Mxp3UdB+aydPL1Pk/boG7Ip+B3y",
-	"i8AdUts7PwZfq20Khq/VdnAE1BbMXdCHGwfFSAsbMwG+5x4yhfvv0ce15rshknHsKUh2C3Siq8HTIOMb",
-	"383SGmfPFkrfjPv02IpkrcmZcTdqxHznPSRh07rKPCkmzFbUoDdQ6+XbzzT6w6cw1sHCa8t/BywYN+pd",
-	"YKE70F1jQW0qUcIdkP46yfQX3MDjR+z1X8++ePjo10dffOlIstJqpfmGLXYWDPvM62bM2F0Jnw9XhtpR",
-	"Xdr06F8+CYbK7ripcYyqdQ4bXg2HIgMoiUDUjLl2Q6x10YyrbgCccjgvwHFyQjsj274D7bkwTsLaLO5k",
-	"M8YQVrSzFMxDUsBBYjp2ee00u3iJeqfru1BlQWulE/Y1PGJW5arMrkAboRLelJe+BfMtgnhb9X8naNk1",
-	"N8zNjabfWqJAkaAsu5XT+T4NfbGVLW72cn5ab2J1ft4p+9JFfrAkGlaBzuxWsgIW9aqjCS212jDOCuyI",
-	"d/R3YFEUuBAbeG35pvppubwbVVHhQAmVTWzAuJkYtXByvYFcSYqEOKCd+VGnoKePmGCis+MAeIy83skc",
-	"7Yx3cWzHFdeNkOj0MDuZR1qsg7GEYtUhy9trq2PooKnumQQ4Dh0v8DMaOp5Dafm3Sl+0lsDvtKqrOxfy",
-	"+nNOXQ73i/GmlML1DTq0kKuyG32zcrCfpNb4SRb0LBxfvwaEHinyhVitbaRWvNRKLe8extQsKUDxAyll",
-	"peszVM1+VIVjJrY2dyCCtYO1HM7RbczX+ELVlnEmVQG4+bVJC2cj8RroKEb/to3lPbsmPWsBjrpyXrvV",
-	"1hVD7+3gvmg7ZjynE5ohasyI76pxOlIrmo5iAUoNvNixBYBkauEdRN51hYvk6Hq2QbzxomGCX3TgqrTK",
-	"wRgoMm+YOghaaEdXh92DJwQcAW5mYUaxJde3Bvby6iCcl7DLMFDCsM++/8V8/gngtcry8gBisU0KvY2a",
-	"772AQ6inTb+P4PqTx2THNbBwrzCrUJotwcIYCo/Cyej+9SEa7OLt0XIFGv1xvyvFh0luR0ANqL8zvd8W",
-	"2roaCf/z6q2T8NyGSS5VEKxSg5Xc2OwQW3aNOjq4W0HECVOcGAceEbxecGPJhyxkgaYvuk5wHhLC3BTj",
-	"AI+qIW7kX4IGMhw7d/egNLVp1BFTV5XSForUGiRs98z1I2ybudQyGrvReaxitYFDI49hKRrfI4tWQgji",
-	"tnG1+CCL4eLQIeHu+V0SlR0gWkTsA+R1aBVhNw6BGgFEmBbRRDjC9Cinibuaz4xVVeW4hc1q2fQbQ9Nr",
-	"an1mf27bDomL2/beLhQYjLzy7T3k14RZCn5bc8M8HGzDL53sgWYQcnYPYXaHMTNC5pDto3xU8Vyr+Agc",
-	"PKR1tdK8gKyAku+Gg/5Mnxl93jcA7nir7ioLGUUxpTe9peQQNLJnaIXjmZTwyPALy90RdKpASyC+94GR",
-	"C8CxU8zJ09G9ZiicK7lFYTxcNm11YkS8Da+UdTvu6QFB9hx9CsAjeGiGvjkqsHPW6p79Kf4bjJ+gkSOO",
-	"n2QHZmwJ7fhHLWDEhuoDxKPz0mPvPQ6cZJujbOwAHxk7siMG3ZdcW5GLCnWd72F356pff4Kkm5EVYLko",
-	"oWDRB1IDq7g/o/ib/pg3UwUn2d6G4A+Mb4nllMKgyNMF/hJ2qHO/pMDOyNRxF7psYlR3P3HJENAQLuZE",
-	"8LgJbHluy50T1OwaduwaNDBTLzbCWgrY7qq6VlVZPEDSr7FnRu/Eo6DIsANTvIqvcahoecOtmM9IJ9gP",
-	"30VPMeigw+sClVLlBAvZABlJCCbFe7BKuV0XPnY8RA8HSuoA6Zk2enCb6/+e6aAZV8D+W9Us5xJVrtpC",
-	"I9MojYICCpBuBieCNXP6yI4WQ1DCBkiTxC/37/cXfv++33Nh2BKuw4ML17CPjvv30Y7zUhnbOVx3YA91",
-	"x+08cX2gw8ddfF4L6fOUw5EFfuQpO/myN3jjJXJnyhhPuG75t2YAvZO5nbL2mEamRVXguJN8OdHQqXXj",
-	"vr8Wm7rk9i68VnDFy0xdgdaigIOc3E8slPzmipc/Nd3wMQnkjkZzyHJ8AjFxLLhwfejVxCHdsI0mE5sN",
-	"FIJbKHes0pADRfk7kc80MJ4wiv/L11yuUNLXql75ADQaBzl1bcimoms5GCIpDdmtzNA6neLcPug4PPRw",
-	"chBwp4v1TdukeVzzZj7/tmfKlRohr2/qT3q35rNRVdUh9apVVQk53dcqE7h4R1CL8NNOPNEHgqhzQssQ",
-	"X/G2uFPgNvf3sbW3Q6egHE4chcS1H8ei4pyeXO7uQFqhgZiGSoPBuyW2Lxn6qpbxyzR/+ZidsbAZmuCp",
-	"668jx+/VqKKnZCkkZBslYZd8jC0k/IAfk8cJ77eRzihpjPXtKw8d+HtgdeeZQo23xS/udv+E9l1N5lul",
-	"78qXSQNOlssnuA4P+sn9lDd1cPKyTPgE/buVPgMw8+advNCMG6NygcLWeWHmdNC8G9E/cumi/2UTjXsH",
-	"Z68/bs/5FT+JROMulBXjLC8Fmn6VNFbXuX0rORqXoqUmopaCFj1ubnwWmqTtmwnzox/qreQYsdaYnJKR",
-	"FktI2Fe+BQhWR1OvVmBsT0lZAryVvpWQrJbC4lwbd1wyOi8VaAwdOqGWG75jS0cTVrHfQCu2qG1XbMdn",
-	"WcaKsvSeODcNU8u3kltWAjeW/SDkxRaHC976cGQl2GulLxsspG/3FUgwwmTp6Krv6CsGvvrlr30QLD6j",
-	"p8/ku3Hjt2+3dmh7ap+G/5/P/uvpm7Psf3j224Psq//v9N37Jx8+vz/48dGHv/zl/3Z/evzhL5//13+m",
-	"dirAnno05CE/f+5V2vPnqLe0zpsB7B/NcL8RMksSWRyG0aMt9hk+kPUE9HnXqmXX8FbarXSEdMVLUTje",
-	"chNy6N8wg7NIp6NHNZ2N6FmxwlqP1AZuwWVYgsn0WOONpahhQGL6eR56E/2LOzwvy1rSVgbpm16fhMAw",
-	"tZw3TzApO8tThu/z1jxENfo/H33x5Wzevqtrvs/mM//1XYKSRbFNvZ4sYJtS8vwBwYNxz7CK7wzYNPdA",
-	"2JMxcBSUEQ+7gc0CtFmL6uNzCmPFIs3hQky/NxZt5bmkYHt3ftA3ufMuD7X8+HBbDVBAZdeprA0dQQ1b",
-	"tbsJ0IsXqbS6Ajln4gRO+saawumLPhqvBL7E7AGofaop2lBzDojQAlVEWI8XMskikqIfFHk8t/4wn/nL",
-	"39y5OuQHTsHVn7NxRIa/rWL3vvvmgp16hmnu0UNeGjp6eplQpf3rok4kkeNmlKuGhLy38q18Dkshhfv+",
-	"9K0suOWnC25Ebk5rA/prXnKZw8lKsafhwdJzbvlbOZC0RtNJRU/FWFUvSpGzy1ghacmTUoQMR3j79g0v",
-	"V+rt23eDoIqh+uCnSvIXmiBzgrCqbeYTHGQarrlOOa1M88AdR6YMJvtmJSFb1WTZDAkU/PhpnseryvQf",
-	"ug6XX1WlW35EhsY/43RbxoxVOsgiTkAhaHB/f1T+YtD8OthVagOG/X3DqzdC2ncse1s/ePAYWOfl59/9",
-	"le9oclfBZOvK6EPcvlEFF05qJWyt5lnFVynf2Nu3byzwCncf5eUN2jjKkmG3zovTEFGPQ7ULCPgY3wCC",
-	"4+jXc7i419QrJLNKLwE/4RZiGydutB77m+5X9Ab1xtvVe8c62KXarjN3tpOrMo7Ew840OW5WTsgKYRRG",
-	"rFBb9emAFsDyNeSXPk8LbCq7m3e6h0gdL2gG1iEMZfChF2SYQwI9CwtgdVVwL4pzues/5jdgbYgHfgWX",
-	"sLtQbQqKY17vdx+Tm7GDipQaSZeOWONj68fob74PB0PFvqrCm2x8nBfI4mlDF6HP+EEmkfcODnGKKDqP",
-	"nccQwXUCEUT8Iyi4wULdeLci/dTynJaxoJsvkc0n8H7mm7TKk4/cileDVnf6vgFMB6auDVtwJ7crn8mK",
-	"HkxHXKw2fAUjEnLs3Jn4LLnjEMJBDt17yZtOLfsX2uC+SYJMjTO35iSlgPviSAWVmV68XpiJ/IfeM4EJ",
-	"Kj3CFiWKSU1gIzEdrjtONsq4NwZamoBBy1bgCGB0MRJLNmtuQpItzEUWzvIkGeB3TACwL+3LeRRqFiUc",
-	"a5K6BJ7bP6cD7dInfwkZX0Kal1i1nJCyxUn4GN2e2g4lUQAqoIQVLZwaB0JpkxG0G+Tg+Gm5LIUElqWi",
-	"1iIzaHTN+DnAycf3GSMLPJs8QoqMI7DRL44Dsx9VfDbl6hggpU+mwMPY6FGP/ob0uy+K43Yij6ocCxcj",
-	"Xq08cADuQx2b+6sXcIvDMCHnzLG5K146Nuc1vnaQQfYRFFt7uUZ8ZMbnY+LsHgcIXSxHrYmuopusJpaZ",
-	"AtBpgW4PxAu1zejhZ1LiXWwXjt6Toe34DDV1MCnPyz3DFmqL0T54tVAo9QFYxuEIYEQa/lYYpFfsN3ab",
-	"EzD7pt0vTaWo0CDJeHNeQy5j4sSUqUckmDFy+SxK3XIjAHrGjjYPsld+DyqpXfFkeJm3t9q8TUkWXg2l",
-	"jv/YEUru0gj+hlaYJtnKy77EkrRTdINWunlmIhEyRfSOTQydNENXkIESUCnIOkJUdpnynDrdBvDGeR26",
-	"RcYLzGbD5e7zKBJKw0oYC60RPcRJfArzJMckekotx1dnK71063ulVHNNkRsRO3aW+dFXgKHES6GNzdAD",
-	"kVyCa/StQaX6W9c0LSt1Y60o5awo0rwBp72EXVaIsk7Tq5/3++du2h8blmjqBfJbISlgZYEpkpMRmHum",
-	"piDdvQt+QQt+we9svdNOg2vqJtaOXLpz/Jucix7n3ccOEgSYIo7hro2idA+DjF7ODrljJDdFPv6TfdbX",
-	"wWEqwtgHo3bC+92xO4pGSq4lMhjsXYVAN5ETS4SNMgwPn7SOnAFeVaLY9myhNOqoxsyPMniEvGw9LODu",
-	"+sEOYCCye6Ze1Wgw3RR8rYBPuaI7GXBOJmHmopsoL2YI8VTChEoHQ0Q1r+4O4eoCePk97H5xbXE5sw/z",
-	"2e1Mpylc+xEP4Ppls71JPKNrnkxpHU/IkSjnVaXVFS8zb2AeI02trjxpYvNgj/7IrC5txrz45uzFSw/+",
-	"h/ksL4HrrBEVRleF7ap/m1VRtr+RAxIyqTudL8jsJEpGm9+kKIuN0tdr8CmpI2l0kDuzdThER9EbqZfp",
-	"CKGDJmfvG6El7vGRQNW4SFrzHXlIul4RfsVFGexmAdqRaB5c3LQErEmuEA9wa+9K5CTL7pTdDE53+nS0",
-	"1HWAJ8Vz7UmavaG88IYp2XehY8zzrvJe9w3HzJdkFRkyJ1lv0JKQmVLkaRurXBhHHJJ8Z64xw8Yjwqgb",
-	"sRYjrlhZi2gs12xKbpsekNEcSWSaZHqdFncL5Wv+1FL8swYmCpDWfdJ4KnsHFdOkeGv78Dp1ssNwLj8w",
-	"Wejb4W8jY8RZX/s3HgKxX8CIPXUDcJ83KnNYaGORcj9ELokjHP7xjIMrcY+z3tOHp2YKXlx3PW5xiZ4h",
-	"/3OEQbnaD9cHCsqrTz87Mkey3o8w2VKr3yCt56F6nHiwFPLcCoxy+Q3ihw5xlYsOi2msO23Zonb20e0e",
-	"k25iK1Q3SGGE6nHnI7ccJtwMFmouaavpIUkn1i1NMHFU6SmN3xKMh3kQiVvy6wVPZSN1QoaD6ax1AHds",
-	"6Vax0Dng3jSvLWh2FvmSm7aCHqNXoNu3hMPENjcUGGjayaJCKxkg1cYywZz8f6VRiWFqec0lVXFx/ego",
-	"+d4GyPjlel0rjakkTNrsX0AuNrxMSw5FPjTxFmIlqEBJbSCqgOEHouJPREW+ikjzhsij5nzJHsyjMjx+",
-	"NwpxJYxYlIAtHlKLBTfIyRtDVNPFLQ+kXRts/mhC83UtCw2FXRtCrFGsEepQvWmcVwuw1wCSPcB2D79i",
-	"n6Hbzogr+Nxh0d/Ps6cPv0KjK/3xIHUB+AIz+7hJgezkb56dpOkY/ZY0hmPcftST5Kt7qjA3zrj2nCbq",
-	"OuUsYUvP6w6fpQ2XfAXpSJHNAZioL+4mGtJ6eJEFlUcyVqsdEzY9P1ju+NNI9LljfwQGy9VmI+zGO3eM",
-	"2jh6astb0KRhOKq15DMTB7jCR/SRVsFF1FMiP67RlO631KrRk/0j30AXrXPGKX9IKdrohZAvnZ2H9ESY",
-	"qrnJ0Ey4cXO5paOYg8EMS1ZpIS0qFrVdZn9m+Zprnjv2dzIGbrb48kkiPXU3Tao8DvCPjncNBvRVGvV6",
-	"hOyDDOH7ss+kktnGcZTi8/a1R3QqR525abfdmO9w/9BThTI3SjZKbnWH3HjEqW9FeHLPgLckxWY9R9Hj",
-	"0Sv76JRZ6zR58Nrt0M+vXngpY6N0Kudge9y9xKHBagFXGLuX3iQ35i33QpeTduE20H9az0MQOSOxLJzl",
-	"lCLwtUpopyFlemNJ97HqCevA2DF1HxwZLPxQc9ZNT/3x+ejdREGlPV3BsD10bLkvAQ/4Rx8Rn5hccANb",
-	"Xz6tZIRQovT8SZIpmu+Rj52zr9V2KuH0TmEgnn8BFCVRUouy+KV9+dmrfqC5zNdJn9nCdfy1rdPWLI7u",
-	"wGT6wDWXEsrkcCRv/hrk0oTk/A81dZ6NkBPb9gsy0HJ7i2sB74IZgAoTOvQKW7oJYqx2H9U1QdvlShUM",
-	"52lz1bXHdVjII0q3/s8ajE09UMIPFDiGtlHHDijbNwNZoEZ6wr6jUsxrYJ1ERKgJhkwR3VfTdVUqXswx",
-	"g8XFN2cvGM1KfajaEGUbX6Ei1F1FzyYWpeGcFoIcCgeln0dMH2d/vLZbtbFZkxw89QDVtWjTl4uenwBV",
-	"pBg7J+x5VFSV3qq6IRgmMNEbp9U1o5F8hDTh/mMtz9eo9nVY6zjJT0+TH6jSRKUpmxJTTW5KPHcObp8p",
-	"nxLlz5lyuvm1MFSBF66g++a1eQDuzQ7hDWx3ebqWkijl5IhbrslEeSzaA3B0RQZXQhKyHuKPFPqpysSx",
-	"VQNeY69kqqx+CYJBTUp6QdmUDgqV1XMulRQ5JqpKXdG+VO8UP9uEnF59Q2444v6EJg5XsvBBE4rnsTha",
-	"CiEwQo+4oaE/+uo2laiD/rRYE3bNLVuBNZ6zQTEP9Tu8rVFIAz7XKBZ2jvik0h3fJXLIpDs8a9wmR5IR",
-	"Pr0ZUR6/dd9+9KYFjEm/FBKVCI82L/iRNRAriVqneQjLVgqMX0/3/bF54/qc4FPcArbvTkLlURyDXH9u",
-	"2eTnHg51Frze3svs2j5zbX2CpObnTpQzTXpWVX7S8eouSXnAbuUoghPeyyy4jyLkNuPHo+0ht73hKnif",
-	"OkKDK3R2Q4X38IAwmkonvSpaTmglisIWjMLEklkShEyA8UJIaOviJi6IPHkl4MbgeR3pZ3LNLYmAk3ja",
-	"BfASPdwphmasd2/cdqh+eiiHElxjmGN8G9siLSOMo2nQCm5c7ppyvI66I2HiGdYB94gcllxBqcoLUQW+",
-	"WugVYUkxDse4Q5mn7gUwPAZDmYi6Y660Y2+isYeoi7pYgc14UaRSv36NXxl+ZUWNkgNsIa+bFKFVxXLM",
-	"u9JNRDOkNj9RrqSpN3vmCg1uOV1U1ShBDXFlpbDD+NBlscN/U/kxx3fGB3ocHWoYojqK47IvDUMnU1Kv",
-	"o+nMiFU2HRN4p9weHe3UNyP0tv+dUnqpVl1APnL6iX1cLt6jFH/7xl0ccXaGQdJXulqa5AkY2KdCLUpU",
-	"G5tnv12uhFfZIAssOpSaWnf7DRDjVevmePmNhPdGSTc43a/koRwL8s1HY9K59a/jLGd7WdDoiyOKEKK3",
-	"RQhF2jo7FhVEQUHu86D3NMlwIGfbdOLDCKEh3GwI0PchlpVVXHj3e8sshpj1Ue/DdwhT4mHbDe4vwseS",
-	"j1rsvr8ai/sOydjwe7+q1SX4J/OVhiuh6uDYDpFPQSWkXzs1oprI++T6h4ZXnOrTmkNHjbcXvroALdPr",
-	"5N//QnFyDKTVu38BU+5g0wf1sobSLpmn2iasSUw9KVF151ackqgwlRPPy4adil0H6o0NyOr5FHFgWD9s",
-	"PjsvjrowU3kVZzRK6tilq4GNp51qU03hEauUEW1++FSZsIkhhhdY6StKmzUcK8T3XEFusShAG7egAY5J",
-	"ouUmiwqP/pF+akSdbiIxfdapfammhpUADtzxg9dg0YtGyqJ+Mj2x0lkTnYZ8GrMhr0D62p/ddx6To82X",
-	"S8ituDrw+u5va5DRy655sMtQDe/oMZ5oopcxecvxVscWoH2P4/bCEyVRvDU4Y29vLmF3z7AONSTTus/D",
-	"VXuTvB2IAeQOmSMRZVLRH2RI9g55YRrKQCyEaCvqDm0GtNGKUNFb0hvOFUjSXRzt+9I9U6ZL0kyay3U9",
-	"6tU1BuKOPdAbVrQY1z+eYwER01RrDHk/Yi2dnQ+zI177vCH4VrLxnYQMImDCb+FhNM1SikuIa1ahp+qa",
-	"6yK0SJpeglUn23MfDV7VhWoMfaCXzcyijY0dvqNK5NvCCOi8VE6MyMbCyLvhqE0sxz1DQTeU/h0DbR1c",
-	"S9C+th/Kv6UykFkVYmn3wbEPFRRZdCMkmNEclwTcaOaZV21qHcz1yzHTDPcBRfECmYYNd9DpKAHO+Jz7",
-	"kP2MvoeHQyHX60ELU0Ovh4sOhKhoYQZIjKl+yfxtefhB0k2MTUJKqh9tUtlwJOiuN6TSqqhzuqDjg9EY",
-	"5CbnmtrDSpJ2mny4yp6OEL3qvITdKSlBoVpD2MEYaJKcCPQoi0Jvk+/U/GZScK/uBLxPabmazyqlymzE",
-	"2XE+TOHTp/hLkV9CwdxNEaIHRyrosM/Qxt54s6/Xu5CypqpAQvH5CWNnkuK1g2O7m0O6N7m8Z/fNv8VZ",
-	"i5qyanmj2slbmQ58xXxX+pbcLAyzn4cZcKzullPRIAcSxGxH0gdpfp2oJ3UyVSsfupr7NX5aoiIoUjJJ",
-	"W77mQJxMEyLTVv5ow2SG0kFZqusMqShr8n+ldA7XrsskQ8bTtpvD9gKieBtu/AW6Y2tesFxpDXncI/3E",
-	"gYDaKA1ZqTD8JuUZXFonD20wrlmyUq2YqpyaS2n0gg8lWZYmmuuuSvDQc12CICOHz0hCBDD+ea4HlxoP",
-	"4d1TBef4CjsX64TdBjcs7NbRZXQ8wR1d/SICcwKhH7ZZnaWqBHXX1a9XNVY9zqqNyNPo/veKVhmNMUlR",
-	"bwoVPgEtPYDDZnjAY57SOCfx9AzRDJIvypTHhvnj5500SOfuv3iD9cdlS/DMZYSfJR5g7lt1qvJTYleb",
-	"qXxhqvCmcoRCkg7v/f5lqga4mOplbjJOT2QGEQDjfucODJO8z8eCscTqmhlPIPm8kfnnneLHosfxQjZA",
-	"Otk5J51/DcyNXWvwb/yoDGCv7lDF7TrIAK75UDN3Wh4YfIBHxVO4ITtSsGf5GoR94UpVWQlX0HHH+4eH",
-	"dZ6DMeIK4vqF1JkVABVad/s6R8rPHPP2niDq155Fnsop2E1KpoRY2il2QOxMCslbmdExMVOPkoPoShQ1",
-	"7+DP3KKS21gRt8TlE2B9N41THM0k0ovbxyIORoYgzSfPpUwHhsTvXhuTEs5WNKZnIsL2ZJuKX8txFWxI",
-	"lK3sNL0GYoTYb7aQ4z3UjXy4PU4YDsZM7037qNCkmx2+qSo/SmX7iGxQETIptRkIFX3j9DNB8PV9E9Iu",
-	"GR2FSQwgTMsbMI4S2ji9qNmG71ghlkvQ5FYxlsuC6yJuLiTLQVsunI65MzdXMBy0uob5QR3DcWocNDCr",
-	"lLaBFkICpNx55W1M/p8gt6MPLSGz07Vt1VixysGupB928K3TczDCbYQI/JN01HLosCqJIibb8Es4ch4j",
-	"foP902CiGG+FtQpnnTLFh720/hOiDg/8z1LYvdROol8/5JB8QkSMgQblqnVM0+YMaTAVJXpBJZPiSNF+",
-	"BYKw12SgovlgJKOi550Z8lSzx+ULJqqVlHuT3VAcGDBjAmbuI2iPkhb65ob8AFNKsuiRM9GV1dUSqRM3",
-	"hS4mjBto2PG8H9HSvYKabcfqn3mtUYi65rvDidnaaygdDEwjB3UmxDg0UPutJgIzVFAimffsGPEkQfOp",
-	"mgrDjFN3vxiKcm/9cL/fcrylPb2AuEL7fnprBflAKgla43KXOjrBlnyDBY5JJxPiNO9sq5rT8ntsUJJF",
-	"3ywR6STQhjF7CWxGlYP3h1HEeYrbB9CaQj/R7Rr0oT6/+KHVk6bVMA4dDoAXR9dEVYyDo8OD84lfEv/Q",
-	"ICVayrsxSugs/1DAjl9gq1hGW+RlNWuBssbT67PuvkTRWOZZE+Q0VnC7HwuFSYmdcFCWiRgqEh+pxG1E",
-	"OO6e1Fe8/PhxUJit+gzxAcWrcc9pHEgTI5lQaW72jO8FnzR3FDRzd1PLlxi39Tdwe5S8FvxQXmMdMH8U",
-	"/nlJVv5lqHd5BZJd45gU9P3wS7bwaU4qDbkwfU34OpSiauJGsDKjfzq5tQcCVQ6t8xdlb0HGy2BYYj+2",
-	"ZW3QkL2SLYTtEf3ETGXk5CapPEV9A7JI4C/Fo+J8oweui8tONHgr1UU3mtJwx1Hh0fuuI6PCh5lUpy6P",
-	"Ip/dpVMbGK5z8m3dwW3iom7XNvVJwxC5+2qfTHmJkC5p5LrjUwhCCNYDYwgq+/vDvzMNSyz4q9j9+zjB",
-	"/ftz3/Tvj7qf3XG+fz+p5H20RxCEIz+GnzdFMb+MPYunp98jGRh6+1GLsjhEGJ18Gm3JbMwY8avP2vNJ",
-	"inb/SoGZw6PqC6feIpqcEJNYa2fyaKooU8aEJBm+WyIlBgY95LUWdofJhIPGK35NPtf4rgn99aHjjQnP",
-	"331WXUKTjroNFK5NuF2/U7zE+4gsi9LdQqo8Yd9s+aYqwR+Uv9xb/Ake//lJ8eDxwz8t/vzgiwc5PPni",
-	"qwcP+FdP+MOvHj+ER3/+4skDeLj88qvFo+LRk0eLJ4+efPnFV/njJw8XT7786k/3HB9yIBOgs5C6bva/",
-	"sbJ9dvbyPLtwwLY44ZX4HnZURNeRcSjPy3M8ibDhopw9DT/9/+GEneRq0w4ffp35zFiztbWVeXp6en19",
-	"fRJ3OV1hZGBmVZ2vT8M8g/q9Zy/PGxckGf1xRympRHDmBFI4w2+vvnl9wc5enp+0BDN7Ontw8uDkoRtf",
-	"VSB5JWZPZ4/xJzw9a9z3U09ss6fvP8xnp2vgJQbSuz82YLXIwycNvNj5/5trvlqBPvE1i91PV49Og1hx",
-	"+t5HSH7Y9+00Lv91+r4TSFoc6InlgU7fh6y3+1t30sr6ANqow0Qo9jU7XWAyralNwUSNx5eCyoY5fY/i",
-	"8ujvpz77T/ojqi10Hk5DtHW6ZQdL7+3WwdrrkXObr+vq9D3+B+kzAove2p7arTxF8/Tp+85q/OfBarq/",
-	"t93jFlcbVUAAWC2XlMV73+fT9/RvNBFsK9DCCX4Y3+5/pXdIp5hbbzf8eSfz5I/DdQxKaCZN/a8o8Q9n",
-	"pTA2XchnhueVjvp5gRzY9t+DUD0ucg/hMX704MFRpcWnRZf2X6EM77Qh89q3sg/z2ZMjAd1r/em83U0A",
-	"8zUvWIhow7kffry5zyU+KnFcmdGtgxA8+XgQdIuffQ879qOy7FtUjz7MZ198zJ04l05Y4yXDllFu4+ER",
-	"+VleSnUtQ0snrtSbDde7ycfH8pVBV4QWV9wLi1E9zNk7DLWlKMfuUTsrigHRk9gGxn6t8P4bw9jGrCqf",
-	"qaNFWiu1CumWMFR7B6i6WEPiQRc9OwjBJlIVMIvlSatr+HBLntDzanFtzxNWHDRHYoXKZchGHoGafJ3U",
-	"9xDRyEON4xAJt0n5Tb3YCBPUhT94yh88RdP0jz/e9K9BX4kc2AVsKqW5FuWO/SybPGs35nFnRZF80tk9",
-	"+gd53Hy2zXJVwApk5hlYtlDFLtSr6ExwCaSgDgSZ0/fdonMk0s0KKMEmn6u53xlnK8yXOFzEYsfOnw8k",
-	"HOrW57xf77BpVMzt6Zv3pOE59aVVwPogDjhjXEesz5vepbnmPrJ3C1kpywgLhV/UH4zoD0Z0K+Fm8uGZ",
-	"It8ktQ/KYsoHd/Y8JCRNpbvmdgjKFB3lkx7fO9n4of6T0nfoaSwULPpAcYJ9NP/BIv5gEbdjEd9B4jDi",
-	"qfVMI0F0x+lDUxkGBmwX/dLO6OQIzeuS6yg89JCZ4wxH9MaNj8E1PrZSl8QV6XRcttXvExt4t3reHyzv",
-	"D5b378Pyzg4zmq5gcmvN6BJ2G141+pBZ17ZQ15GfA2GhGKShHdh9rE3/79NrLmy2VNonWsHSZ8POFnh5",
-	"6rMq935tExkOvmB2xujH+MlL8tfTprJk8mPfRZL66l0EI41C1Hz43LpLY/cjsvbG8fjmnWPLWLfIc/3W",
-	"m/b09BSTF6yVsaezD/P3PU9b/PFdQwLvm7vCk8KHdx/+XwAAAP//YEWO0mbdAAA=",
+	"H4sIAAAAAAACA+29e7PbRrIn+FUQmomwrSHOkV9924romD2WbLem/VBIsvvOtr1tkCiSsECAFwDPw15/",
+	"981XvYAqAOShZXtW/9g6BFCVlZWVlZWV+ctfHqzq3b6uVNW1Dx7/8mCfNdlOdaqhv7LVqj5UXVrk+Feu",
+	"2lVT7Luirh481s+StmuKavNg8aDAX/dZt4V/V9CIfQe/Xzxo1H8dikZBU11zUIsH7Wqrdhk23N3t8W3T",
+	"0m26qVNp4oqbePb0wa8jD7I8b1TbDqn8pirvkqJalYdcJV2TVW22wkdtclN026TbFm0iH8NrCTAiqdfw",
+	"s/dysi5UmbcXepD/dVDNnTNK6Tw+pF8tiWlTl2pI55N6tyygc6FKGaLMhCRdneRqTS9tsy7BHpBW/SI8",
+	"blXWrLbJum4mSGUiXHpVddg9ePyvB62qctXQbK1UcU3/XDdK/azSLms2qnvwwyI0uDVQmHbFLjC0Z8J9",
+	"6PhQdsDuNY0GxriBDqoEv7pIvjq0XbKEcVfJi8+fJB9++OEnOJBd1nUqFyGLjsr27o6JP4fnedYp/Xgo",
+	"a1m5qWGu89S8DwRQ/y9lgHPfytpWhRfLFT5JQFYjA9AfBkSoqDq1oXnwpB+/CCwK+/NSAaVq5pzwy2ed",
+	"FLf/33VWVlm32u5r4GNgXhJ6mvDjoA5zPh/TYYYA7/09cqrBRv/1KP3kh1/eX7z/6Nf/9q+r9P+WPz/+",
+	"8NeZw39i2p3gQPDF1aFpVLW6SzeNymi1bLNqyI8XIg/ttj6UebLNrmnysx2pevk2wW9ZdV5n5QHlpFg1",
+	"9RVQAqtbxAhUVQZNJbrj5FCVqKawNZH2BBrYN/V1kat8gdr3ZlvAXKyylpug90AjliXK4KFVeUzWwqMb",
+	"WUy/uixBuk7iBw3oj8sMO64JTuRqVecqVdfaCvCZ8M8tKATofUGElPWm1XvkKitL2nmy/b4sQPLtzpqB",
+	"btkULUwGaIpVXcF2uuoSp2FiTla2uKth98CBClrCZq9ePEk/+GvC9Ji+pI3YsP1BBEa8rGHTA2bgiNUt",
+	"6b90VdYtKKF6YkPWeyyss8TdQu3u3B63PSevcETYOT5g84IYUuEqLsFm6UiSobuWWMmbMQjGOrmrD8kN",
+	"iWNZvKbvZTTIph1OFIujZzmguopxbsCMCeYxuUGW7TLoHztG0kuYfj17wAOwMmG4MlYgqVHdoakWSQ3P",
+	"G/37UoHCSupdgTvMRfK1arElh0GtKtUKfxMpy+vO6RJV9yJpD8BmYNyPy7Jevb5oqvzHi4Qswfaw39eN",
+	"+Rwp+18vv/laNrUYg2TA4/ad1r9DrlTrYnMABoBgKBqrx5B6+RMMCJc/UVI3yVcgL9lGPc9WrxNYyLg2",
+	"LpJna5CNzlERolOIlfhllHimK2Ts/dTWqBt27WYPfYUtu7KAuRiO6qvsttgddgm0tIQRwSxrU8LMbIwg",
+	"bnFCJe2y22Gnr5pDtaJ5tt16Nj2uwaLdl9kdMQwa+dujhZAD4gO6cw/2LUpYd1tF7Xnse5o8UACHKp9h",
+	"7nY4p46B1e7VqgCRyhPTyggl0s0UPUV1HD3WCHfI0Y1EyTG9TJBTqduAzKDOwyewSjfKEZmL5FvZ5Ohp",
+	"V7+G/UYLerK8o0f7Rl0X9aE1H0VopK7HVyqsI5VCe+siIGMvhR2odvkd2Yl3YgvjPpSBmsf9iomG5lhD",
+	"RWlyOhw/9w6tuSUYAH/5KGbr2aczZx++7M366IzPmm16KeUlGTCh8Kks2LCF7X0/w0/g9t2CroR+woeu",
+	"pAUdVZJVksiLcAa7CFPhtDTfV0EkFJuUfx3IUrF5hWbAuijJRPgJRUjPxKElPeTNhTYaoMkqA6WlHn9f",
+	"PcS/khRseZj5rMnxlx3/9BU0VEAn+FPJP31Zb4oV/BSZT0Nr8OxPn+34f9heeEfoboPc/rKuXx/27oBW",
+	"ng8F1rHD+x5d3Oaxa+PKOF7cM/CrW30uPvYLoEJPZITIKO/2Gb74Wt2B1Qv/yFZr+t/tmkQ6Wzc/4//A",
+	"Ssavu/06xFpcSmIVkHV19fzZK9SFL+RH/A21j+KTrGNzX9JODr9ZwkB/7lXTFdwUjyCokOGJcXlhbxeD",
+	"8yjK+Apao5aKTu3awEIwH2VNA7zAv7G1cKes4mG3Fi2vVel/pnhuSmHgKY082aosV02ApF/dNfovHp8h",
+	"U/dtecxGFvO4ryQqdQOW4UrsbWwpT4ACzQ34Qk9Ee4aZoFZ9Tv532BmAkv92aV2xl/x5e6m7HjK4xwFp",
+	"d86Q9bQ7wzSnrAqsTR7zsgXbUz1v6np9hmGX9Q0cy6NuWjoOwfGBzH7r+8RzT67VpPwKlry8doPnUs9X",
+	"Su8rtvS6UJOqlUOsytZy1uAzLVCXLGmnMsdN/HQHQ72Y6aDRugWNZhptUeXqNjxWejTsiukKD9tSYfa/",
+	"BTK5Xoe7EKueTxB0qOLeMp7YhD5diHGzrelQhp+p5nUpT41euKmRsmtFVFjj465TIWUBn0asP3wSGLUc",
+	"+80cGVLZxW/+JkNhgeZr/wWk3zCMPCX40r6zVpojrHrQbFbiONF5oxreg7MNWHdtJ0d0SyS8szzARq4N",
+	"UlwpcB6nB3QyyxpnbLBaqhas1bYDrTKTa0ED7pUetzd54iwC+c+6sGTAQff4BbeuSxDd+QuO3z9+vRFx",
+	"Z1xvPNhT11tw1Av4x0/ZyhFPVhKgn0MM72ljVwHoVSoLY2GMapfqOXqbdDH6GztyHMr92zZD+UMXUd3w",
+	"4uDFDL+ZlSDvlPUqK1kkyTVV+asC29XHAdwBuIMr+8oZ9gF4NyUyUiJjcvuzTX+JX72kj9B5y9t1Cu0d",
+	"0cZzdIm1s1YbHpS0mijwbpDlDi3ZAg+hpbrOqm6GKHBPs0yRKMPFb7pUrav23mnd+fNmFx2Vm7Jemh/e",
+	"hVYtB+k5/ML8IK+iKsg1pW6Ltmvfo+Fn1pB3+wErPvnCbZuc0jW615ZKnC2od9dawfI53tyytn19DOOg",
+	"6cRrS0fuUHjPIXHkYJcNYlJW8OW/y7uumOHvsz7+c4iYy9u4cJH60Fsr+b7pF8fp/W5PcoaCIxefF8lV",
+	"/9vTxAZbGRGY9pnl4rmE5whr3WdvfWhWKnQ0QidVGjkffdsqFo19tikqY7DAzrvLXvNEsMccJUC1xiXM",
+	"QsQnK+PcFneb8PziJLvjbGIqzFycKK+hqdXeOPLWiVfRiAmYharMcf/Thzsw3ezO6crOE37BUb3nOOs5",
+	"m9QRMmQ7eCs6WnQ8Tp4gQCPzGxUh90pztgCR3J1TdI5UQLRPvRWbvticrHmC8zqhdSaF5TlfQp1jfxo7",
+	"3+mt0z8sB6eEAxrCzUCPP/GVK5h8GZ4+/QbxhL3DmAc8oqExSVcOKDcFMENfuDXqJmvyiBvDSvlY9549",
+	"0h8WnnxyCjZAtmurA93Ns9eNY/f1lw/efMnop6iEV+lmdA6zwtyYXB/SJN1us7WkbzXdy9BAHOO+rkuO",
+	"u0AZwwuHetE7/pYgunhL4XZUqnzjreXoDm/c6yJQC7vpuyw8ZiH63PUOr8JSvst0hc3lg7/wGlIlQMM5",
+	"Fp94nyI+7iZbvUaplbd8AbVOvWMMS4f+SYewoW6ma2Fft3CiQyv/Gi8097argbdND83xhLlcfqFeq7u/",
+	"w2mgbu7+SDru0G3JITbeEodPdXAswQ/qpvhZL43Q6tIdBnxkesG3HLoEq4xZhoJa31QJMOkEV9eW+Yru",
+	"k6ZLR1TFumjMOl7V11rykDRp47EEogAhZjwqa0AxNPydDknqktcVUiyOMJ7voOaitiLexm1WbRzfrsPc",
+	"8CTCgilz1EU0kmOXCglhSJW/wfOO0YahSTPcOsEqCa0wa5SQj5OlOMZmV3uKa1loxKcooTJd/ro+YS3P",
+	"mKrRgcrgbppszyOTJ3xXDrtpZkKpXFrbp1mXYXTEN9Dirvj5HPoe4+BTtPciEm6Dkg4VBmiSbTi8zMiF",
+	"MlYVZQYSvlNZe2g4pHO4qsCgahS6q7MyGJxpYsqGXZD80jOnERSK+t/X2eoAZsqOrjFY3nHePdJXWeV4",
+	"aEqgki5UHDJNXODiAY4kVbG1hYHeYmBgVCDPCq8qTDngmNdWwdSA2mkL3HZIH+3r1dbVrkWp8NKlOVQR",
+	"64nJaJo6oufpUYSSdVaUEhtITtGsugtuJdQHLeajB0tfTQw3OC46wcGwI6MivQ2dUdf6MhfPYijN+QGH",
+	"5dIxj90FBh1WJEammchF5Jxlcab1IFZefC2g1Lo8v8lafXZFgwwU3k1WOMFQbN/KvRBFEYK8F3mpwoKu",
+	"V8IJusAsItmJB/KxSNoaxLCZI+nwpDqKD6wNQKltejfK7uAO1eSQ3EbRutntS0XGuZEj4SgyvqyzPDyT",
+	"ve3SUa++ztPSZWd+MAeWGTKCuScLPE8cjEwuwa7dyNHHG2NMZnnLsXsI7DbPzuI6dM8Wx7oNiYi3TqDB",
+	"QegoQys2p1Hfj4lV0FEH/vVqX1LOb0y5fuPRAbm87FFFt4NPVdll7Xkue40LNTzVfJzvHQ1uMIEJFbWX",
+	"VMLpMPSmCeowASH2YO1fVM4+NIRYcNLpQcbgDWyOkLqsOtY9Mp+LbC/bK37t9lLNOeZ7yy0d563m7t+q",
+	"Ky0JmolHaavAPE4qKnuxy/5SKxHn1018LREhvK+PPkW/5hMUqzV2d46j20qFTHWnD8MdMI3I7oBzj3Wy",
+	"XiTPyJGjdvvuzpiNG1WpFsPC6JUH/XkKu3L6gxveWSCpsybdkLryx5FpijQv/5612zMwcanbGnKSupFo",
+	"XYw22k6H7NrW5gwWX3TGZgKDzRDp77MNklqbGCbagEfNurQaZgQ/m8MKl4gFKbH60EUdlD1ROBeHfive",
+	"LCJL9Rv6R1b6sk7NYqRmQQEitQP24HhiuSc6xCqK5ttxgl2C8bFnW7fMljkT+Bnn9IkkyyDMDL2soY8z",
+	"ha/IfUvMTcypOTdbTEMF3mHuqnwB9hZYVJRmbFOGNGHh0yg3kO5A9d8FNsoaHU/SCdgrr2ONL8wN2VX8",
+	"hgyGWNShjB+jEvkNm0dt78vwTCpCFHUwyG1IGuvn+WjrdVOgTYJZvNzSeD8hRXMV9Ihz7Kdu013eR18j",
+	"jEqErzpClLdKBb5+qZT/8SIyyfhSSVACfM8QCpC20An/z7v/8zFCJmTpz4/ST/7H5Q+/fPTrew8HP37w",
+	"69/+9v/6P33469/e+5//PZjQAqTOWRb4Hk3qMUuBc5QxmWyET3HOkP/eUXM6qlr9Dmzq1H5smeHzEMl4",
+	"hRhZu/Ro3BajVwZSOOtIYbTnd3UXDL27btap6P9AVrNsDNjvdy8+5+wAQwmThVdiCqEeKMYDL9be9LT0",
+	"Nx5PyfcUsdGVQ63m6B8bk04C6y2PgTiLVOiZ9Fk6Z/8zc5TkCk4oZRuSoL4dW9+e/VQCbQbtq/p2cCKp",
+	"b9U5DshLbGf28Rh6fSqU1c3kfT+3PcuAhAFi/lc7TALAXix+zNUSZuqkYff0RZVYVJwkw1adS7tF/6yG",
+	"rx728VX6hF/oNWSByMaXS7/5EMc8LrzEG5uzc4Hugc7BBb+hc3MBpLIoz3EC3wbPjehD//CD5OXfrz5+",
+	"/4N/f/DxX+iaCC8osl2CqrRN3tW2UNvdleq9oHOGUqrDrf/lI42h4bcb3O0oYHuXBbY8xuYQ3x69luB7",
+	"Q671nDk4akPgLH+ewkMOsz1h+CEk7am6/goGcZVfnyl4aa4jk26o2LaFBvLDatZdznH+y+kOkQNFi26q",
+	"3fIs4hgTmdz2kicyF7maXE7HTrDt5s6d5OauOZzj3GeuvwciDu919aouU7Bb2qIOeFafyxuJvKEzL/b9",
+	"35laPvFA32QOHao84kBFwJXZOx83/eq2srwZ3ft4vIHRSb9z5sVnvvWeYo4eNAK2yvKw8fy666beIQIR",
+	"fUgy+rlSn7VdsTuP01JJU5G7k7UCQ1S/QsdmujTNCFeCrkRoSRFqo3POmjUBzkBCRjTFP8xKWDUEskMB",
+	"uzrQJXwkbRWjRGFgkZRmCa31oDmBC+9S/i1mlIJmfy/RkqFPV99XOH9djQBtBaIOmmOXjpKqVHdTN6+N",
+	"jM/QcHZyPHbYEcyRuc/dKZTUqbW6YR8WLTKePg7D+kJ15CF6VewUGCW7/Tfr9Xly5GpqKMB06KnFnhJ+",
+	"wwkamcEiaXUOI/rLzoRcxgkQjry8q1Z0YP9t90Qtei1059z4HBUdPX9TjLGDu3qnDZCD7PiSHtsLzM/r",
+	"5pVdKl/Ae/uzH6L6fc4dTqZjRPjyMsdvdfIgPC/9wHUMythfhMb4uwzoid4cZAxEfWvJI2/nWdKAWEGN",
+	"6drjAvZPDCBmdBgcFPk/Xqt9h5P12H0wI4I4TBF/H0OKwWfeFb8FrJqzk7kTMmlH+OyOxe0KvXOtW8se",
+	"yvnw5wiT7vdZazdolKFis+2cW5HTMVhGGRPqJQ47QP6YEr8Z3sB9WW82Z8tnKviiJ60PHZgK6booVUw+",
+	"S2Xi0RmWFPZ4jHCQRujPDiPQNvTyeEinulZlZIXhIxcgB1uEuXucPIK5q4rVInk/WWcwy4vkA44vXSQf",
+	"gmHcoKZbJB+R1Uhxhx+zGRlxGx+W7V2rhToQ5mGei3O6ZL5TmO5S0aECzy3eakFHz/zFwg2+1B1NLhjm",
+	"mkf67EPfoaJYVTMIwcnMXDe4SS35SsFMrc6hUhFTscyWqkzjOWe7AbplqxrEBFQZIgESLWBmIpQo6KZH",
+	"tG8hvgYiW0bsWqY/Yi5bpFx57/QpZEY9dfqYmsMeQyytc2dS3neH0c8M+hr+8ZJCLc/gSLON+TkP7tks",
+	"W+KleMbLlYM8wy62CDD4K+d04Hjt6AKu0DC1q+yA+hBR7+qQTrEfptmKGZ6S8pyMsOW3uDsGnS7hZJdj",
+	"IL6CxbEUBEqHzYh360EQsYMvKIwOXcCRFeL05Ol4NpslzcQP0fG3G+ETEU4Em150dPN9iX19PUnna3WX",
+	"EiJ3m7z7j+8Q0uSN00s7/wRj6Z0Qe00cg8Q6Dqme1/2YwPU7d8UODTdzkoadVId5x1h4FE+i89enaDCL",
+	"92eLRvr6TSXewIndS4AMqb+xvN+X2sM+UmdCLinQj4ATVmVVrY/v0dydKbVMDmL3JgVH4GjCaMJO5Kzz",
+	"JTzjK/+iyunysbWOaD7+YBdxgqOuVGz5O+1FHbZtUeK0S9XAk4fGQOGw0b6+hqe6L5g227bx28IaPrRq",
+	"quUYl5z2hVmtDcHGU4yNhKFw2uHgCO8H9/m7eH6TJsIyYoyQlwbN3XLXxViPEIKBYOZLEhyC8HMlx0mI",
+	"gRPgfo/aoksPlfkuxqaX/PZV9619dyhcWWf37bxWDCAo7wvlN+YAXeWE3yZ06PhmncUTpBkXY0rZOOmo",
+	"qxYdifiWuwQmF+lhv2ng6JfmqswCkV7f8uOEH481QDNuXfYIks0w6eFJt5JsDuLxpus0EmfydS1xHCtc",
+	"gmi4WwGRrydahv9gCyHlJHL0jmmK+gpOkW6Phs1THYuag1cIQYPlgUgWjT6H4AgfTNOns4I+Tu1Rot/F",
+	"/4amuQPPI39cJ3fQRWQItv2jBhC5CZdKRJ4v31PvPQ0cVJtRNTahR2JLNnIt/xw252JV7Oms8w9199nt",
+	"fl6khi71MHI+3rttR8BO3FfQ8OBUCvS1gOJoVNfOjar1BvKSvx3MkE/RLDiLSQIXOsuzgsMhITPgoVFy",
+	"Rsyxtc/nszvh+h2EEao5TApodMvnkEPOnwlGMe+3Ccfyc+BW30aEAYS52OBhlMHPXbf9XCl4SQ04VxVD",
+	"dOvbeRP/bZwY457g24eBDAcn/DR3xSxHzXDqB36agCjomjoD8tsB+S8Pu112FgQWDepx0riEjNA1skQq",
+	"Ujj4nJDxxSR6Uli+DvB4tEqGf2fdMsUcKT56YT3V3Q0YffVNwAixVXN4U2d/rhP/KPfl7SqrqmDEzXjX",
+	"veUjOCMev23Mp1B5vGLVjJJjotWlQ+nk1aXOk2cIu2S9C2a+0+6kqBwXGtl5kZUSKK9Ba+bJMDTxpAbO",
+	"r2IwjPWh29STJGgTn8g4W++9yTXccKiaDazlE8pYRRVDbXW1TBrl3Dva+Rw+3ECr2DtGo+IgdREUJMN9",
+	"Rd3CvxCaiYi+k0VyWEq9sIGLF2yu1G0gGJU50qNk5/iBMyduaaEKGegLG6fvVc8h5rFDfGAI6zYj/mDA",
+	"jCAF84pm7Guc9UJK1emyXKbim0ukBdQyBZPoiNQHprtI/nd9oLssUbrmLE+3K3wwph7Q9WD6FMBgyyFV",
+	"Um6C4c7Dh/2BP3wocw4NrdWNrmiJL/bZ8fAhL4K67TzddwYthkryWWAz6hds6Nt406mV0vLxCv3ZUxPj",
+	"imuKCiKZ4TfFNTD83nqgH4dZZnfTwDHcd8Jvi6spW60UYS30UQ4pWIRmv9hFgMq62zmcdxudl9Qqw5EO",
+	"Zmlhpw/Lfj1gOw0LA9Q1ly1FZ0T3zJP2W3HvnkyzzOKBNzXe+j/J9lhJC/HAZgy9hq0PoZ0ale18FtgM",
+	"n6LKmrsHwfpNgUtE7p6izzkIAS/y0O24qWGLrctkj0+ocIP+BfEVbZCiulWrA8cz4O9tYHDnP5d6zUe2",
+	"AH5HjzBA1lkioKSpyBWtPD0RK7A/zKnwIE3LEbilLotaDpzqX45r8M0riQg/x94iTcZQM7mn4j6M80ie",
+	"ZJ0laC7vLI16fe9qKp9HNVnMTaVYrjbRlhlKjmJxRZ8xDk+7oKMgwOygdusVvWk4EeM6nTGHLpeiwXBm",
+	"zMcgkkSmoFdrInBLpR3nHGGB7l1yzQE7DAKdjeOCbl+IV+eJlIc+L2xRWuSxJeSDE0k4Fsc76oLWBmBm",
+	"pJJ171Dh2ii900KsPLdvKsW7mo9ARKM2Hc5esC5H/FFntgI4ndicSKG1FPF4obBs7wv1kzqjbakbi4Vd",
+	"6ecnasEezZNK0CFodmyVo/O8UxW3ZWOAGqKF1pFaGcCxl1wA9SwqEFOds41KOe4tcjcPb0lgnBVB/s6a",
+	"tpSF1zpJ1DIEW62VVrhBbAxqUPw6xaabIlfTaeGm6c/gu2/MZ1QKXq1SsqxSDkGc2RaehFaKq3vPz4kp",
+	"djuVF/A1gUPYOn94J21ovEheeohe3RY+3shWwO1YeA6st32oBk2EI0yx2TQv1uv5DONIePyEs7hSOl6F",
+	"PClS2lZXNMctjOIlh2czcs6he1UIPsI55nC/n9gQzBRbPIiGTOCsXNuQCeauX5Z9Tqk398LQ4Y/teGbG",
+	"B7EOdeWQX+682lVNV2Z03jnXpqfy6Oz6u8yARAypWGHM3PqALiFbX4BXtpJj2UnlDpwWqPBhgS5xdMhP",
+	"L5PMWSVa1sL+ArNljtI6Vm4aCYbDqFmUao2Opbqn2gLtR07DvRlxMRoMEbMSG43JlQXpQIGqsn27rbs3",
+	"mVfM6Q/io2uFgN84tTjSJ3IAJem3SbSwTQfBMAYdO1jn9mEM7ty+cY/wan8G82XaFj8Hy5n/TCRwXr4H",
+	"dMllVC0q7tE3ZZzFdLY8p6nuKEqDd0MifSzqzdFCOk9U3e7xVkBiCBDqpdUFf1yGnEAYVXVt2lBgmWm2",
+	"f2FTEjwLmBGmbDxFCS0IVeiUnCkrVM+JHBatSfNWC05vNqPctqOdeaLcuJhYNP6upqpCBPFGHHC4xOvj",
+	"gAGHZ7iw4obQtgUi6Fzjhta2/BRI+8pAlxk9w6k0i7BL4d9jIFQnAK19Q0+/EvCfSLLdKEpb7Nuwe+Df",
+	"Edght59ZoED35C/NtmMUfophXWcDipgf/hAgYQ6Cge5m1u1bLm5uMbjliL3E7sLG2ULzysAC9HzlfWu6",
+	"nwTbfl4358qy5gZnM3RGUvMkd6XLU1Ovsbz1MFtZ6gcEmK39PgVG0rf1qqBT7rOc58EkOFscZmdAz02B",
+	"1DMorX67vYQppxgrJwSoco++GjA7Kw6c7prDqvu+yigguRfZ1XcPiCsvHqL+RL8SjokPOAOlKSCAZNyE",
+	"KQc9AkHYCERYkEj19rDZ8D7dw4/4vpK3YHIOVcHricKMUlY0Glrigt/cZXfJmkqe18nPqgEb4NBzzuwO",
+	"LSI8YcA7Z28RTEW9hoFQzXF4+lWB+CbY3ClgFIsHAj6chnGVvuCnhJorw98Kgm4QufjNogpq2kOnKKEc",
+	"DlIcDgL/wDt/m/ATQ13+7ZM9/jTYJMO1yKujJzXeRNwDxeQ8WiYJKJmeajz5eDaEIuNBBTPQ8KAF64TW",
+	"y/pQ8VTqUz3X9tSOTLy3pbW+RABd/Oxx8n31MGm3mcYzkz/hn+hRrw47nCD7HI/z/PSHgCQX+e2QyGdV",
+	"rm5Dl+yFg1j+DmZw3RGqewR5tl4HsZ841d9tdqfQ69Nui/3vgT9aLMMaTgOCS6DVbfWsYgRqXD+Uz3Yn",
+	"aTJ8DnuzdHeNUrnadwHCX/gWLr1lZ1OpXo4xHZHQIX6hLvqBTjl6vQSFCnaVtfY2wZhnVRrQ64AFTUuF",
+	"w3V3IDPPaEP5IZPHInnK5t+e3c8iDYfo6vdpktf038C4d7747FVyKQqzfQdJ/SeX0DhzVfDpqiih0h1B",
+	"gK+jbhDHKo6cdMcnCMmeDzYjkC/ySAxgAHA981whuVfPn70K1/a4IsSDPIE3uFLHImlX9Z4VsHXWqiqn",
+	"BNB2aIzi9xEXdmbLo1HbQQuCuotMju5WU2Jagh8yXNUZ+cHht8cU6rOQ+NRFL5APoY7gIFeF5tBRJGNT",
+	"SMM01A7ncGGY/ILv0kLaiMuj0K6nTY8e+3nTk0p9NPYhx/8kHBtjlRSjHIqjqdzswEugubIpQDPLKe57",
+	"OKQ8VWswAvH54+8r9IVeLmGtrtpLMB6aTxml+WJTJ491DUuMSfu+GvAyWvDWhYLfH5awEjG2/ph63t9/",
+	"/y/0Pn7//Q+DTPuhY0W6Clfspg5SqT6RSmG2VKp8Dztu92qFKeY8+/z1aK9+ZYt5VcT3+zaFfSYr2dkY",
+	"Hj5oMBy+p8noI3JfY5ptow8bRWvq++L8fl2L5ddkN/qSE6a2TX7cZft/ASE/JOn3h0ePPlQJbBlfYpvk",
+	"tvhRFhZuOkD0KUWpbGOhG04aODvc1C1svbHChDD8TmV7mn3Oc2FUqjKhz7ziWRosl+sWmgEMCyz3J4Dp",
+	"OLpmGQ3uJX81UhEeZxAf0RTSO3iesGncp86XU//95OmaqCE/Un8aRtWiiOuZ0ZV6sw2eonRuPbr3yckN",
+	"ywKHjLuuwkLcF8mzNdcmWnif6wgMXQvYVqTG44zUTYFFCY0JhNRhn2emfN2dZ8YBh2F8nYYipBLEr2r+",
+	"/IQCFHyQylOUmdhCJUl1jo8orO6ylTb6ky8YIeS52++TTVkvZXUbsXhs5EJ/E1/IfKY9wyIOCYVhw4i8",
+	"AwcCjGDhj7DghIFie/cS/dDw0I0g9QkC1Ra07tdVZ6x3RAxHdzQcsUXPqfQEGBM3cEiiaqq1lDCjGHlX",
+	"ix0Q3DxWsbaX5zyjUrWXLeGUoo3ve8GdDkP3/Q1tsN9EyqvjyymOOSgpCp+gqJC3ogfionvi5BqJM/qm",
+	"Kk3NxGVJ5yCDdiPpBI2XgVJtxkgLCzAcs63BocnwOeJaNluq1rtSYF1RmWS9lmfZAL9pYC0o4DTsOHrm",
+	"4I9knfEhmQtZrXP763TgPiJ3UbHB/+3k/yX83/Ud0V87/h89+yHoOKEr29B01BUZQDkMdWNqUjtFcIW0",
+	"d1pngpCOb9ZrSsVNQ1Amzj2Hs81IHwrt44dJwneTyewWQmLskE1JY9RwAqruuSukxxBZqYKrJeu2Kd3M",
+	"+VuFAc0Z3AtNHir5mhaRELOV1gCZ4N84QbIeCpOuHLtIUM1dZyUFfdZyOaIbcbSbY7a+61mcOm3xvZg5",
+	"O3I1zBvLUWPireiU0bg2kyY6bNCNULysb1Ou6RC0eJe3S5T3IN4ZRbKEFiZIP3Aa/guNUwIzbS2MrzVB",
+	"S5wOTYbjwrstWpJX+i62mzMxY92OW1MhKWxJZMRfb8QlZk7M6bqN42mGxOVdmvt7END3Z4ltaQ6/k4dU",
+	"3zwZbuZ2V3Ni7zSUZGj5x5ZQcJYi/BtxTejarFR+JOqn8N5y8i0wtdqKE1lLtgJsLr84Nua7BYgna0aS",
+	"0vc0Ojef5FfyBeej9hwY+CSV1o89NvHHoavBK+lQbwWafMT9EbGRQrbBsKtNnbJfkBtisBSP+7NIZYkd",
+	"ip8me2QCn49D64Te6iXMOPMT0lqo54fX6AFvnano5VnB6etQUBAeThWZDC/1Z473ieQEzorvOXnefnqH",
+	"E4/7e1wg2aiz6Oi6fbPG8b2o6y4U1+gO842PgADCKC8lpTvi4BDwpc9b8op87sC294xd350KP1CD8TJ9",
+	"yLE0L8pDWF6l3388xW4tpEl7WNKGCbJI4f8mLimEChLtmqG3Rgf8JQ/4y+xs4523GvBV7Biv2Xp9/EnW",
+	"Rd8rPqIOAgIYEo7hrEVZOqYgVUNsCLoLdM4pW2Lo29vb13UpO10otcnQMeZeQC2oVqPNy3SiaMngDEBC",
+	"9cuv4qQRRFtSSAG3EOZI1H3/iu/ribChXTPXcTYV3eImTfSriuqBYOqNTb8qqtMClbmaJIYRpk3Q3f5y",
+	"i94DNxm2HZDBssd3e5LEy3V18R9UjV0XSi4YJtPA2moWwraEGWal9Vxgr5F28VUJh8b4aIE6hoarlEK5",
+	"cCBU6Z0ivJW/MvMalrcDKch2vMuNNt1J5m0M8MkdUmtr+fYcXqfPR5vqkc+AnZozGbri+klSwpmLkYgp",
+	"J7Vxip6imr7/Hs3UxPZd7RLwVcZAOINc+y2XFunNMy4rQsfMBvV57TJznxg4WrSu4St60x/jzDXB8KA4",
+	"jnvL4tlHkFz5xWbRDYqvt06h3wojXySVKxNo424LahhftMqjtBWLmfgMyzO17QmobZpnZ1rBca7dF0/O",
+	"nrUD28BAGwaVk9ENZuENBL8nQgPujBgSdDsViizhOBUjDvqe7dBt66b42ZQdc4N5PcsisN3Hr/VeHdGF",
+	"Z2fwFmeyGMlTzxBniIlV2dR+izWhv21Ud2gqFgAKZb4hU8Z1pJsmXMFZlb069fesJO+nbwrbu7p+naj1",
+	"Gm9ig1I4N9nPTLRTp2s4246r1InbH7XYBsevXLc9mTClq4XFlgq3FByLc0c4OoqCQj/R/sWptc6AwYgi",
+	"p6Zsvy/y2174A7cavSTLjrrjjHhH6DwgjU1wgKpeh+eTnLGDmtWLJFt35L+XFPtOxE1vv730RoVVLAL8",
+	"+aeDHI8d4aqQly+CEN7zosygqTfv9iBHdSTjHR85xC2SQ1Wihiq6/pB/xyMp8XZCUj67DtqWV1Vy9eJJ",
+	"+sFfGYAkUaI5MTvTExzYIMtyYdBadCBtvUngs+bOwrcY8BIXf3lwmPeiI4dyR4ESsVKu9MxU0EOydcpW",
+	"0UjSls5201eKp0QgEMc+x86CVV3rTUq6IExk4cadWy4ZikV6LDPDKmXeqqEWw7GZmgGRImnmytXnJtuJ",
+	"FNS/B2YVtzrxQw9kOiFfZtBl1MLEYBqq5ggtT0FAwXEwDqs4V4hPDXGl9hYaIzMox/h6mxRhZsfTJK4+",
+	"fWauuU1PF0fqIi0tnk7SNLO8U+F1VET4GHc93fFjbVpJ1LFZLhQUhbFt+NWC7vgY7pPf84vCM6yifozW",
+	"ymFfSovyK5MFDWtg0YvkGYuzRhmu2TFRkP8d2l4WnQGCKnZZmTAz2osh4iGH3zOLJiTHiQEMlR3BrG++",
+	"EGZbx7nsJkXm676LWSZDL+Tb9a26XRUtwziFlrspSzSZOKuy8h/q7jt8l4bzwMSLnxpGGDJCpMXZvI7Y",
+	"IoRI4LDAdz9S+Nw9bJRRF6LxcfZIKFBUgytwlsmjm+WDQIKRt/po4YvN0BC6xxwvxvlqjJMwhRdRc3ti",
+	"fr/Zd8+qMBi0dKLDFybXzfhc+ZGYUa8vB8/2g5dPCA6OX4r3mp9g0HNj+AcVDSViclylFxZ/pM7JEPsU",
+	"gbwk2jh2aIGX5NBCr+vg5Ddso4aPwq8+u/ryuZCPF8qwKBoLdhEdFb23/9OMCq/J6wkXB22G+iaer6Wd",
+	"yedoY0m80p/cbBHHrHezjduwCBdrNht97hnvFLG8DueDT7otJFCehzgSMK/2Jl7exnJyuLwfIp9dZ0Wp",
+	"gyg1tZHcbRrcvHUe3BbdBu4dau9ohfSs++1gdYdXh5WuCZ00tR/7mWiEiRHIpBMQJS02Y9Cf0wZRtw0l",
+	"wAWRf2AskeCwV3wQ15GKYhicXgI45EwJHPfmWQWu9TflELmfXIdMAU8NDM0ROtn4rjDNvoueaLeTNtwI",
+	"+0Vd3S9zJzIRozCss5bENyDAMechxmHKU5NN4u/K77TC5UvixSW6vogfgdURS5H7HBS0a4rKYghmo2gD",
+	"q28r9MzZE1Q6mjisWiLHVjmuZn2/8EVCYpj8uPkRN6iHD12xe/hwkfxYygOHQPp9Kb/T8sVaEQHDLngT",
+	"gbJHUSK4st8zuBzRiXiz7sNK3cw36Il3BEwVl0MjopxXovl9I+y7aQphaC6/sJ4JcnS4YNxZZ367xMxZ",
+	"Qi9jqF8mbVFKwreJaH0nhpcuB1G2SH8gOsxSSeB1wG9z2FGwctoCAeE0jmrZoslRcXoe+S/o5Ui4FLZ4",
+	"KCLZntWhcNo66HTpiWuXHpFOH0Fm6tjJGO+WtazvQ1X8F8x7kWPtGHjUmKIWjgKnMFNJ6Bl6KcL+SWmY",
+	"Q1Jt8/e50xiJ9dS+v7ELDR3VqprRY6YNwbWxq7/R+TKSEuwHpBv8I8mXUJ0Tjn+f+JSiTddN/XMo39+1",
+	"NzQ/CgK2+FkFPQ7Tkd+2t9HJCRbweuqFTHsscO6Bj0z4dnsczPBIsrYsXm92tr6nde4MHB1YfUwc9cj0",
+	"wjAoujZQzemk2TbR/Xo8s6Y75tBwsxD8JPWISqKZd9Iy6TZdZyih2x5fYlhwD8woLDBuGMAlt28FRmge",
+	"QK2V2c1SqrgO/QpI05VVC14uFQaFyMea962BvubeEyeX2LwroXdAgy20NlAwp/oIuNvZ3gHrDCCpdd0A",
+	"4swv2zrQzKG6ySqTIyBLSb5GOB594XBTN1RfvlURXyq59MPOgnw1TPHJi03BULEHjKYjNzAnsvHdAENj",
+	"kBTlRYsg/gYRXlgDE/Jo4ShkmY28uC7aYlkqeuN9fgNvN2hsvg5ncDtEq9m29PoHM17fAkth0cEnzFhg",
+	"q/Hj8I2NTl5cqu4Gc74e0Xvvf5K8S9EmbXGt3kMuivH04PH7n1DSDf/xKLQ752qdHcpuTJvkpE70rhGW",
+	"YzqHcxuouKXV8LF13Sj1s4orrpHVxJ/OWUv0pui66bW0y6pso8JIAbsJmvhbmk2Kw+/xpaKXsNpFU9/F",
+	"7v1grWWonyLwgqj+mAxMJ4Zx7CS5r613VBFYFKlebLq5C1obvDcZuvRDypHd6xTBnt/4DZ9/gperOGrK",
+	"ZP7a3LBqti4w6o9AagsbzCsKEa8LMfSK8tTLOyf8inhD17UF52XzvQYVdqs68iUeunX6VzxP470tqL+L",
+	"GLnpEnb5AcmfepedztXwLMLfON8RGK25DrO+iYi9tiHkWwRcrNIdapT8PdecNasymswbTtuM5Y6ONz3X",
+	"KMNW0qi4HTxxyxxNfS/Bq0YavKcomvEcJY9Hj+yNS+ahCYtHdsAZ+vbFl2Jl7GoKRXCuxJYaw8izVxoF",
+	"Tatrwm4JTxK2ec+5aMpZs3Af6n/fKDFtcjpmmV7LoYPAp3XAdQA/shzqsEqB9ZsbcoNOFniAYrCUpha9",
+	"IJM3r0fPg4IRTpQLh/NgXhw+0XygP/qM+CMEFdpc7njUDV2b8OhCBxoUmdw8d3Osk0852nOO4PRWoRae",
+	"P2jc5aeHosy/s9De/giXsL+ttsEA6iV++G9JC3HrqPIeGBIxvD6oVBlsju3Nf2u7NGA5/1TP7QeshJnv",
+	"9rgkw+0NzhLuk6mJ0h0ie4uuxA5crvqoyQa0C4wHEA58zxSrcparo/jtXD3R7sy/q6wMYdCiItjSM12d",
+	"Tz5wi2uEQqeBvtDR13Ro0QF2KmsPDNXUIqAjQgkJ9GOxU5JG2UPe1vCTtsCx8vc2O8R4+KMZy2PB7O/B",
+	"SC40ovYCdrNuteXzNy7jog3jiWPKWRSrdZettghqg8CVtDXL2xavButZZm6qmKHQ8oUoQeyJw36Blz4l",
+	"lblcq5uUWJDQ/dpNiiSm7T5bqWMwMONoQL4ceLRdOJBD9WvaYakwJyrOQ8Uf3QWghyIQpUzAD0FhFbwC",
+	"U/ngCd0eBrN3LLaBflkCe9kfoS/hOfhoUFT0IlaEZUakvO1Q/ER6gVqUhdnBzaYtsnGLql/+wAN629Tm",
+	"AGHlxVYPAMn4KrtFKBcOp3hSt+EjDhbhOWWc+J07yHAZGBsJTf2EJvppc9ccppFoyRVg4Ghz+sgCzyZf",
+	"EOgqEuYWNWf/lC4m6BfrOOzLGkFlsR0Ma0q4V/6G06VglS4Pmw25Z3zdGrwAn1+8RIPKRkA757czjiIo",
+	"9ZZQs8KYd/tQZji+8Uq/QMUV3IAlcty43LlInrLPrNUeGSnARUUyGwQINt3JqY12KvxH12UUddPVnsEX",
+	"34i1eMVrh+h6wnqvtK56B5FG748s9Eg33wKjSyrH1Vajx/CmwBprW/hZ4wH0l7LZBaX0gj88kKOKJeWY",
+	"0s5SdOJ4tmvidCrgCGU9xh/pimDEoPkyyev5JaMRBYSyu638xvrV5AS4X5faTL4SbzIcMuuqwOScu+DB",
+	"gQDW50WHSCdWU0zH3+glLis0sLgC8uoARAkXZfxxRfgyAuPkPsVJZengPzvUxXSFskEILdZsaCng9BSl",
+	"TmcAG1I1HL6MQuRlmjaB8JdQGJzNBDpSjCh9I+LSoryXr8XhSUiJrwuu5idsk+Mo31EguCFKe4Xh8hvM",
+	"6ubx9LJn/4XfXFAFCKD4h4sv602xgomnNjgGkSCiKOB22NSVDr+VDRTffYLvSnVR87MXOMSdwrfSaTBT",
+	"yczw0EC7raIMDgW46IgDh7mmfbe1EXEbTRyh/RQFDaELQCrUnvbhgWCopgkdiD9jwAOCiMc3pEB7sDgP",
+	"GMuB7QlNaHOMCmwQq+CWQBND6zXyHbyPlvX86m1uPFPAiuZL1/s21a8gjCyhMeo+4tMIYi4V5SKKw7xg",
+	"j5OI5KwXBUq3Y0w8QUA+HcdMRpDv/kOrSoyonEIkJUmSzbKw4kDFnYKubHVM9fxzivmc6nEfuxPF4NGX",
+	"B7AGO8zRD0W7fkpPE3qa5AeyHLAm+EEf2RBDekXlvvz6Z4H4Xu4ILfnDbqQv/cI9u4PTIHpld8syEGD4",
+	"1DyEfvQME/zq8o7+f9wJUkJ7j86G1zG4+XFF/4bZ/eF81WKVIijvfE7QnnJ/dtiuTxN0+/1ZJR2a9Ql5",
+	"w1WPRqvDOnMU0m+f4cbhltcZxMzx1mJq9tAhv6bnGgXXJKH2/FYZC+2gT5m8wJT1iNcvBgmHzS+SleDU",
+	"esp4f+WzdgyHYhUF2ss6wWyGUY6qoCgOLgeVMuItURG+M4oFknIcKT4efH0ahMwqGpxrGKrzA4YE/UNn",
+	"HCb7rJCgIKsshpyVGOx4bvLYorMT3B+EAORF7xE+V+qzFg4OQdPrlVeTEmsF6jKBgrTqVl/gStOFvipE",
+	"2ac8lqoHCBTAHVAqhT8pnvcYIhaxcpgjwOvtVHipoBYJ+foGqlfGzqKv9YY9I3LZG62hKjQ37BuHFVo3",
+	"3RzPKGMIMskSp8ahYvY1vj3U8hOqkaqfzVb4fVf+vZy72qt/Br+uM5RR7+4/rqNgLlIFl5671XYlcGkh",
+	"RRbVdVEfdMCZDhfXThH+VYCJvaq6EQ0QzML4va8pRzESsCim56H9x3ecXMCwFX+AK9bBpPdLNgfOe+yg",
+	"ta+IE2hw1RNx63h24ZwK0aFixHI60t5i3lw9WRoUdx6I1dM5BvGAH0D0s/wokzFU0PoBt/JDdAaonHsM",
+	"iJSeMSa71CQhJy/zgkAxIytpVTQr9LPjdVWsjjy05Ff2Mh/V1WMpAIfhVPT9IjEp/gLGbsjBDQnm4jUt",
+	"/F4hEyr/ENyLcBPAryKpllJspt8FUGlpjqTa1F3qFUOZyYBACoVEgbqN+dek3tDnjXtInlSMoW08Eg9C",
+	"b/TyPqIkhvutuTLICcyQL02P4+2fNpqZfVSnDqHyWu+ZCPIUdsjXarTjE8dWzRiaCE5aqmtVTo5Nl94h",
+	"Q9DpU67spWT0eE8oorMWnyvTcxag7qCJWtTOSODECdp0eeg0uFeoR8rea3ogAscUFDLFRFGfjhXKOE60",
+	"yAO0HZ/avk02UMyOKowrr0m90V/gkQXZW0Nhye5LY09mejNsr1VcFgY3vGKz7agANBjKuWqeTxS4tkWt",
+	"Sb/u69ag+YJBgI0J7PGWmruYm4g4BEobtKUv1q+BdLyXcALoG6WOKdfNxVU5fOxtoev4wjD5mlLfeqyo",
+	"NYhSTZEALw/L9g5Oxbvg2UU/lPCTkr/R8bHo6yJ3IwywgdVb7AKXbKqid8bzIdHGL6w9ZPtdqrK+4VfI",
+	"LUbLibJekJb7QVaaXh4zXPmOYpUoRgnjk/TlM4Zr3VbtXbWaztLWg13EIwy/wqDi1VOXsiHjd/SSC77o",
+	"1ZgeRqyNtMYIdBYJT0bPXQS9Y7OmTEikOFp0LuCtrBZ1nQOJW7idS5PQJVIDQ2JmtE/lJyOM7X3mlSlD",
+	"ZAsigB5Bm/sysz6nbKMv1MJ3mqop1KSbh99yucGsaC0nKDMOa1+tuoTqQcEPZQZSHdlD2/hyfOUtDG+s",
+	"j7WfBkOeS1gx2sFAMRP/FihI7bvRKCtHwCC6EIi6S+HfItlkh41aJFsYJ104LJC98hQnwVEN46vH7XfR",
+	"X0tmUlwmSYuhdebVF/pHyCTx3NbDqhiHdmrZRXPWr0waKMNfIKQMWpQNhW35IIKzkZwITxpM1vEqOf/E",
+	"QAKrNxY61EAwJ23RnMJgOBxOA3G3BI0VsRmlxwmKvTc5MRwj4P87beJJAxfziiGYnFIglThA1k+qQeBj",
+	"sVGS+QIc0JJBXNBpjb16FGEtQd05NZ9O7EuLJHqCbB2okS4Rmf7EvvDT2GGnAmO4nGJ9fz2/kM/iMAmU",
+	"Mx8rxRNrLqAl6IFTPTSkLHowyWAf+WfWhdkl4QFV1iH3f9GYbBS5LODDG3cJ214evfCIxaiQmaStclpf",
+	"0hoayLt9d3w037zGZsffxSJ0HOwr6YXKxBKXuNapVqcYA8gZWzU/Vo73iOkrWmNnUO1U2DIEHzuV93C+",
+	"Gi9gvT2sViA5wD4tweYraoPaNi34b2/qzpEBen2dYaiavB1inrzhXlXowfImx30/kCXCCVb0SbgWriYo",
+	"CH7RU4DBMYeNgtugZnVcxrY1aAOY4PmTNVO0RTLVYb9mVxTFs7+AXx52u6y5mxg59E+vxdYxIodRWoIJ",
+	"Qf0j7fzkXwuundf92i90r0nXmeT4XtiMS1lBjrSesPfLzaXZ7UYLCkkVKCEQjNNcznReNSHnMlRvghuK",
+	"gqdThFsMqO9tnFGl5/7WAW2A8c3dqUmmETZ4nHxyZs8IjvN8tbBGtuQJatxaOXLr3MaLIJ1aoutokTgf",
+	"a6xwjx9iZS2wUCl3F8dEtW1WEKwGeaK97Tx8POWtOsXjTpmSKr+bUduIXrebBNnRvtOV7m4facNBtryT",
+	"6y6NkeQIRn9ybGGd8whK1Gzrq7ugthHrzv3BmfPwVOjxB3cTpZondVWpVcwlszJPUUNllLQ3nkc4Cmn2",
+	"7Hkf1axRO2SrsvNuuwwjEWX7bFmURRd1VZiwsbWiqg1Y7QsslR4cJY1EYjQJrgrmC/Tta0V5W1lVAS9X",
+	"ymiS/6T4GJpU5Fr6uW5bfMiJJK9QoI08IpgakgCGJiIfxN8kreC4mETLlBRozkZ8Xoeml4+oP6S9sVXw",
+	"Qx5xw+QHjh3Gw3YJMpWu5qDKUe6EZSnBLWWJBNVxUQ4E06EWxUOEpeQRywnzKu/oizBBOjMsMtQiIzcs",
+	"C9TCHEEO3aYmf+24IJHRA1Ocxv1rSDyJAL8pTjU9UisjITGKQO8QVzBiNotcF2VY73KDCwNve66V4SR9",
+	"Q5NYZVUtEzlz1P51Axd1mzW5+m0wGu8wH0VT41wlmts3ZMrFiSlghAdct22xt4laOuMrtnrDhjsV8uia",
+	"u3RziJk/5p3ki2/BjL/PhDpG/8zV4pwSTuIl1dGb1RXtVyf0Ed2jQkoovK1UuGU6p6V46O9TTjuXoy1u",
+	"MPStFyCPuT79QEI0KrnIYHln0xYX1rCT33Shde6lLF4rW1lTkkTpFlzemEANj98LDmqu6bo8faLXpufC",
+	"guUNaykMJ54hEbE+Bl7izkP8NOAu77R8z0/694aQ95CutWoaPnzQXoG1N1Lc5y0kdoyOMVYw1NBJTIgg",
+	"LVHtBSRObpBDDiu++vfOf8zU3gDR5MiQuoZMmqghJ32OMfsJP9fFA3SpsMnkDiOv6WQEgoZJRHu9x0RX",
+	"6lFTq5GN9FoHpgaKPTmlqPwaX7qGlj5HN5zFzUb4SeXJRrJP2LF7Qg5KAUqpSXVCqj++Z/jMT5KE1Z0f",
+	"VgL97ixak6cze3Ajai6YvrEajrJ3fnVQ1uH0c+njHgQK0cntOpOuT5C+pMyfqplZOW2I7s1ZyPs9E1qg",
+	"NzjSphH/8jOY6ZUtIxBSaa8Lqv1uSivRvW+u3vHXLUUmvUupdybJ/WZ7x81ugXcKThDvXSQJpsQguKTO",
+	"dy8cCgadV+90Y/3fUq/5gbLSM8m1ufi+CnHFciFFRRCDYckdrzVCotyTJXDmKmusXov2nSXhcVKjEYg2",
+	"wQLUepVKhNmCNwrBEV0knHGF6a0LjFqA9YWYEBTUiwWGdxjcsNDnDdi6Ug0eUwLxCn84tAohWXD3q9Oy",
+	"vlkwFesDFpHEEKobVZZ0nGcjg/wVqVOPsGH8gQjGDJlezT13L93M+J4Fm0B+7664kfGOgNGRjSu7oUqN",
+	"2FxwJxwP/x5m9fcMUmehMhVBG7Sp4cipnmT7cDnJK9wI8A3Xm5Gs+HVyhOWgFkMh0nkdSnJ2/WvSSoJ4",
+	"0HiA5MR9HT5U31Sc2R92px15vJeu7NEefWZaF7dVtm+3dSx2NKLsXpm4I28wfLGDK3QhKeDhY3XEnHHq",
+	"Avi0R0oLFjG7SKMKcAYJzeHjZLU/LBJKt1wYpCmDPymhGJcIBrDW39iA7K3K9qgdYIcG7oE8gsIqKsS3",
+	"I/c1NLeD7es2UrD052it0p9Vb6S5kTnysHaKJutmS79QMFksPqbLYnlMBLjlzhO9y0pKi4QDyKX29Wo7",
+	"49BHQu4Io75XLhgHA0etyYqsPnIbXEUTd/VBAZ/yLDG3zVLUbvHAiUw+I9SOEa7gjHadVWqmO7BD+gQi",
+	"eE7ftzKShJmWxa7oYqBhhIdtgjdLVW3c4Mhe9fmxiAfMJS/ysEM/7lagfc9AT9He78ESEw9ETGKOwDEd",
+	"ZETONIal3+dxT91ytt/YeIgvduihsZVq3blVLImHWA5WzJH5NrzIwWe3BGkeRmACuyBW2xmeYJaqubzp",
+	"T69DnLfhnhLqI4mSaSQN2A0YHpc5Q1R4jkxHzOwTRO+IPsLntmgPc9qeG9Ufa2JGLMekNrY3n+bQ3F8g",
+	"c7QydlbvUxbqkNFx11/VNRwhyCfec6IZyCmi7DH/T+xbpBoWk9i8gmlfN+JblrGYa0q0ADRwcGhm2J6X",
+	"NnVr1PJSbQtGgkRTPa2DNaX73kdP2fvq11OQ3mZl1Iws3sHqGUp5XyYd1Ceab38qxjY9q0uOXzmuYsuc",
+	"q2GaAp4Oq+H6CchHup+5y1h4WljiXpkT32NJbqDgLpjRrIFJsj+hhSj3O7IR6TOXXOTK4YvPdTBJ5lSF",
+	"n6KxSa4Diz1k4N3IwEM+pXg4xLcxvlreRF6pdd30VyEKujYMzWpp+N5EX2JNC+NKqtOMCwHdXnzLqJQT",
+	"QJc9DEtgTkGnCHacoAOfjiIM7IU5FOw0xd0rP5Qqj1fdnVSqEovACW2mdSrkxcRgSZB1FrmFMN2klj/j",
+	"/ZkvvD4qpfJWkAA0EWM9ToU26HZxzvUXgpuGzocbyzp7SAKFttqmGLXVyxRzY/DVLcXB0HSFh2om08Xw",
+	"E3paH7XPB+407aaIiE/hkmODFC9KIi+3ASDP5Ipf4cnNERIN14h8gWcN1GWIKTifAwY7NBWHZMw1FEA6",
+	"dWBcMUJxvSYR57IyHh6yxDP2RvON6BRb48B9rjMsDPOMn1EHKMwEY4hA1YZQGTTDxqyMIaEY2NEmHOkB",
+	"2m6hb0e00BbtxBqguz/Wb1M9c7BK5bWv0OrIupG2jwL6kP0+HuMcCbwZ0UNG483TQ5GaxILv2FdQjg7p",
+	"L+j4QnQn258An2VjYTYvFFZ+eqF+isfZ+MGjP3FMoJwpGvqcxEhHciCyMKz8Z09Z0clG623IcpFqEoJo",
+	"t6XXbcG7mlWK76TrWxXznMAytsdDL2sA8Ib1wVzqXSdttLHZwwuAzlfrslh103dxgqEtVLo06Do1bOdo",
+	"ShxrqFqY/Yaf614FzzrYbBu/PSSCI0HKW+UEJ9dNsSkwGMppV67zXsN+KIAN9n0jfB5qT9eqcm1GYOKE",
+	"pHaY30LgBj/AH92WfrvHlXAwKr4TKfVMnw/Pu1G2h7Jpwx1yIF20xrSNObW9FCKFFIg3SkIgVoYZMm7m",
+	"xGZVlpVeNHIARfWBZhAF4gq/Xe8QHJu44ayyghi2AvhiIOLx5EuDEUE6IQx8wscXDgvhnk87cM9ZVPPG",
+	"Njw95HSrKRNsmCmDlK6DGwjK13PVEIOqVczjrEoJ1aTDI3rD8fpNh5sTpFgjpRSlWviNvs3kk5IbIa2H",
+	"SqLdAAcb3vKLQPEcfU4dF1pxp7vtzgsNd61QfrlJebCTFe6dUHv9xXi+WT+weWQ/ODEUeYLk6BygDp2O",
+	"V3foxxfvy6gVqrfMI6pn78VKuRqZCE2aU6en5r97xIZWwUvG+41XkKBK44kpDs4w0FkiOMFJW9ahwk8n",
+	"lUPHtiKr0OmNKOpUNacqtyFDGg9yQIogTNZZMCUWpGwCBbjoMgtDnwEmT7Onzl5ph9J8SoIlcH1+2hlo",
+	"P5PDvK3XgLYqSesdRf2CImnw/GO/CEsvE4VFy1IBYAghS6/RskVnINVuhxdh+skwPXDMLMdxWi6E+1rV",
+	"HHQbQhIrUSLF9JPQXHv5uKHSwo7viVWY7W/hFqKAczeFliclNCWpkRcag9wUNhE4+F22pys8+iuFvzie",
+	"3CRUahUO/RWNhlgPDw8DcBiLNqWYsc1kgJVM3iv8hssdczvQSMoMThkPOSAkFEmAmU3wsp4Nfnk4HSSj",
+	"hNIzcExGI4nxAjUk+cTirH+t7xPAk6Hx6HWuOxGgoZkXTs4HilUxCCGLWEZ2ogK5amZOW4mq5inX+Yhm",
+	"sXj9UGlKix9NM6i/aR35yygVFI47NkZahAiDzgzNs9wgeuqZ4q+yfQyVUqXoyIlOBA2LItnwtYBlzrPM",
+	"xw8d4qYvZgQojnzDdJdqf5Ga7VKTdzEseMClX5b1LdYBwPT617Askq89BxGuKyuDLZiaI/NKY8Wl3xS5",
+	"mss/aI8i6b4x30lhgLE7tcB9AJYRkRk5egplMxjAJQYm9FCRElX51OpmjTtcWNoekUWEVcFqEytXIBBS",
+	"awpMSvUwtGhuqEzLtthsca6wNMQ3WCcTrBG19zx+OSLg4J6VXD1/RiiyjJMxwxBxuD5jT50Gl7wazlN/",
+	"mvztNXzFeQXKqqt3xSqs+v5chVWi5VCG6iQEuGR3PH3txYgqGkZBK7ehMowqu8EBBVuMVLzWqT69jZ11",
+	"mKXNlIIk/xvv6U71Wq7S4plZkaohuElEjEePEw4tC31gl/rabhTzRO96Mob2uWWJR9nYPLoWQUik+Qte",
+	"+WxrkE3omqH+FMags0JyIitcEvzIdsB/sjej167NwouYwAFNz5a73G/MIIAo5fLUKAtk2rjWv97WunrD",
+	"Jy2S1j6hM21UKh5yP9qwhbMThdlI9yBqsH8bAt/lxIAFretyobd1/fw9mwx2EvG/jku5twnEqrLYzR6t",
+	"SqzLItl4Mc0erKkyXsKEcH6N/TpZyMTEfc00qB0C4qVNPBpmFTg5lgyOLk2zAJOfmdyWhRMFL/DyrpNa",
+	"gHh4R15lvHlsOXIV40zBnMYbNtzA8Bjl4hfus26rA2R07IGfgYbZTJLS+7NqagLmzhcOvhTlFJKbzQvU",
+	"r/cMhul7aukSnzFBMMVTvm3Nx7DXqD2hSYYOH/20Ydd72Q9d47GnTjGMOdwNZjkwYyUOeCKFIYypUqW8",
+	"TNq5Swkpui7yg+/pbo+1hP0UHVzKMwwaQ+sP8zTF0UoiPLgxFTFZfIhkPrguq3DtIV4TnGhkUiept9wc",
+	"j1kI7cpu99lNFU/nCQV9av/D/NOTw9jP4HOyO/ziOvfnCeeNJFSJbmoMjuPh6AFILoK/Bu6TXRYV1jFZ",
+	"hRYkx+tp8OD+yhzEe+dwuoTVMIj+gXyhZUvcxzu8r8e717BLw+4AR2BG9AAjhi7vuVdJQnOarbvYFVYP",
+	"wVoPi74I68PpuF6n57HQjUjXTjTbaX07aVNjI+9N7Lk54FIxxoUJMu7JjWhmu8+gwYSFuBgc06zlp31C",
+	"k3LgLCyxprZ3ewxq6AqbKEqKzDd1f4PFhZbiSMZ5eLruMyPS2wRD9/txZloWVb0M/bnsJB8sI3K9Vnds",
+	"AKIHUoxFfsKRbWwNctAJvfsajDjxf/EbkWKs+QxIgUhEmJs8PecCS1fdotKgvbTkWISDzUrmjBVdQKLe",
+	"dxYfpwdfcIx5Bp9Q5rPnMJ2u5cucmxQQTmieseNlhIslEsx8jcmPuyM67Bmu2f5np+6Pq0hw9+cY6eEQ",
+	"IGdjlsTw2kYPer3qwak4bZywsZptZfb16X3FHr9n30MEvVmQm4flkBOiIflx8yN6kR8+dCf64cNF8mMp",
+	"DxyWPHwYVIh2H5s97rFCVeYySM/CRHhSeD0MeBMHg3WWCV6q/J3vVOYahzqkS2SermUo6u7PYQzy/jJm",
+	"EQmoh4uiwlFdI7bQCSEhQsiYURSlZMwcOoUURnbJR2FdIiuSNoO0qOaFmeitw8nMjDhlud360B3TsJMZ",
+	"GG16wvIwVp4/Pw6TxpfUp/XtvE0HXZqnrpTZWpSPc9ATVpaoNr10BLfKVBQLDb/Gp3Nq0gXTpMfWmpss",
+	"jR2NHjfqfjoAfoGBS7kqVTSnlUgYW2V9GsbPGlEiBM1oXrg6a+xo1QxPnMYtXayxSC5EI1RH2rj0iSEf",
+	"Q3aKzqaOqNui7f5EEvi7VXREIunxH6B2Y1zYxuraWqHD2rjfuIEPoZhT0rROrg86hnVElnwbuJBhSLei",
+	"DTRQtNYj3eHqcpIAndewboKkEhFWFUI05ojk5ryOtQlBWBHQ9ia7a0+PfENqG5zTqeA3CsXBRrWLPBQG",
+	"R/hrTAjm7NJteyxya0bEFUcJDaOt+LII0eKDAVbDWQl7brJbDMBLyfc7jniA4XfsIkZYYYz2wOibI/uJ",
+	"I2robqiKsiQQweiw13ldzI5ysdM9GejCNR+KdWedzCffO4YPwlOH8t6p05zK+2fPP4AL6bWu7H2CqyJq",
+	"r9lGx7XZNzSPT2DAYAHfPanbLgYf7M63ue3UFSHpqWyzK2kskDYlT0a70C9RPAmtSetPkVfyenXAq8Fs",
+	"JH3p3gNh+Bg7lKlShHps0vkcttP9zbdVDDNFdgG+vi8q3idRU6EOlYRJ0vJauRMgtxTy5ZEEgn5W0URd",
+	"Cu8xxZrWXhKqZptyEtCjRqUXMhJZIBSW3vJpx40POSIo04t8DwVkcvSOjoA6IkiRPvxSf4dN8e1eSrd+",
+	"7UgRRCs7EovFF7YDwMX+dSHz18WQOuI+m6NgEN6CL4KD5EmiMe9/frcG4Azbmc1/z4cTJmlf7+eh8soh",
+	"RaJxhFSfyCgIlIm1iSKfS+pD64aH2/Rqux2804pH9xQoT96fdF+TnlpYh+MqoieEgTgiLdhaO0qcnE5B",
+	"dUIGMbq79GCOnZg6zP4tcif3Xz4Lpb2Wh10kvRvjP1KK/0j4tR5VWDIhEuUSDF13Av8YmIwsitZxrtsh",
+	"hPMii0pNkkqFHPz2sLc5+p55IeRLdxMzOsfp3q9LzsbILF/IggIeD94BnI1aiqpReRAaQy4uImaMvtaQ",
+	"0iX6RqsZ3Eky9fkJ1xzu/XtAkxjPbRt1EreOAdqPe+eQKDpcSN4RUu84RY6l1r08CZFLnjAdij/mNNTv",
+	"CInCQDfTgi0ShJXmEyT+G45W7Slk953ZAdIpPSB6gPd5KR4RUi1wNsE82nNwVzsIJ++5tNR68jHgvh7T",
+	"jHU5cqS/8o8H3sLswhkKdCFqT7m87dBpY19m9kq4dSud4bs6bfqkkP9jFrbOHXHvLk9fu/H7SZqfCA1y",
+	"y0S+kf51ql3I7WmLdIykEUF3PYb02mlCPNY7zzNBd8GAd/t4Ijc97omHxc07KoF7FozDpCAX1UXyVOMc",
+	"+AYtzSKm1/M3FUV6K8q1JuGlTJYkAmQ/fvwPRr1FHD5+zDNCJmYlBa5KrB8BZZgItwXNNyxOrW39qD5z",
+	"9CKAMTAYKS71JruLI0ylOoOgC1P5XGdRUMs6IwBJaCU7nagWu5wPea11XFAfroF2pGj2z52hojowi42t",
+	"KPrbDCahXmyp0d9uOAKEHx4AphtR5DNQOS5vNjZai0pA1uDkFjrraaj3EwYYC/gMQNBLAOj5p8qslt9i",
+	"gmav/OexHNdXM/NZnWDfvo0VmDNSV+1B46SRj1BleVPD+YngCN2jpPbsc03tkXtJic7lWhvB5O3haKg3",
+	"OaAP3dXBO8FIvGV9i0CNo1CpDgIV3krhi4hsmMdbJD/OsU2OIAA4pSvmuQ5Ck6dnrqMlmYMNtZ3oq52a",
+	"lNEOw3ODlzFtV5QlE7QwVx24QSLGDOGQNLXUCB1JBMErgHksJvYiNIB/khgGrE90NJ8d3KWbIRhIa4aZ",
+	"LHNhxja7VkJiLGqC7iHQjdoe68TF8ekUcII+7nmVT9Zhnot8ThjeYK0PVuBwAQ2F35378PT0+BU86tSg",
+	"hXAo3wUrhF4NkEx6eXDsHZE2TNmoijwKqJoalWqXcBAJZ7JErgOad0pVXDgT70OxMN+9+DzhZ07iaL1e",
+	"ONgUhJZKfTdrnQ/05q/OI5XbkX565DIIj95UNSAr3zyhBk0pDQbtEcGHJZwP3Ng9mlYNq6n9RQYs6A0P",
+	"IFw2+RunjPA02TaE+yoe3H+jis22G62wKqhieNOTSc4dd+oBDvmVoqeDxGU1aKnqT5rmgaEwqDFswaMw",
+	"lomXnEqVm2ZrVgygoKb1XWbIoCUCnoeXxQubppjZIwTZ3zlZYISmg2lBtPfpLL++VvrKZv9N1tUiSvQH",
+	"E+S5kPP2PeNd/52UTE9cvjJMcYYSlQRv+GPz4QzQpks6UySxIB26Msj6roe7xafo4fg7FZBtn9ASwbve",
+	"yKUWUFk3spIo0xR66RD1CI/vJcq3IKxxRVoOT6FjjSs4uKia699DoX6OabJXxA+Vv4g7aTid1rpqNJOZ",
+	"lZHAsanQViwNPqPvHprjebrGA921qv4Z0ZJXBFSGTUke5uD8TcFF6I6tN87ujoknrNfYdHn/L8lSzmbw",
+	"/apo+/mdN2SZLpWB1QCDoFjfWaBiR05OGCdaXKeL8VqnSydf26sYglXZOJjPdon+zkolsnKDUh6SvoFY",
+	"BPg3oaPgpAWUPc9Am6yKfRZi+JW39GXDpestW7lsm3GE5xLBuFcMdwbicad+B+s2ZkiIzSLS7nZy37Iq",
+	"UdNiymKgOaAZbPDvQwSrR8ICLV8l+obg2RnLuLfqBlcbcKTHe480xhxG69HM6e1CArBf4GWVVDxdKnvZ",
+	"ItuWdx9z/MrfsSimeyuLAXbAarkusBuauFbjBVyzB10KxoHYLXAD4zdpK5h/jh1bG6GCj6Pkfu3VUW5a",
+	"/yLrVCXJejs6l/90JtFKzw4hntQt3mvbqgXOHPOkxgPPp4iK3Jh8aTfDTJSXATi8FxN4rqNMYLQRf7G3",
+	"obUUBYafRUAzZ9JD2tLXlCd03+EA09nKjq5ntMI7ixwOr5h7Siaypntrpi/OTskcb4Ytw3tjD2lXN/Rz",
+	"4kBkIj+xBFczjHnFKhdNIDBQDvTxsP6ZsWA9NvKRMx6KOgxqnTs8GgctfYTAH4zzqDi2saOoHds4Za8+",
+	"u/qSjcohcyPO2++//1e3/P77H8SJaj6OlOUNfd7h58wQfMmkcr7/I5jMa9pS6uThQ+oAUzn51R8/8B/j",
+	"Gnj4MBwfXoSMKOj6UGDX+HhA+EkLTjs5JUGT+g1KjPUrf4oBoMchEi7BKMoRwPktJOEYU+eDKbN3rOsl",
+	"U7SHJSnLUYjlKehPjKhFYHg8EIVhQL3ZnLfag9IzB/loBC5zyL0w6hFnBmjGCPCRJBpQ1PZQZDlzDRuN",
+	"2cFTKObDNqnAiLZ2Tb5pv/x0DBX6HvWvbfdOOQLKqYPf/g+pax0FFXt1Il/CG8Lts8C0kz6R/POuh9/J",
+	"HiS3V43q0Lt3jOXKaWELLYDvYOKCUcqotHL0qbQcqGySGAJ75KEo86nl+ym+pHtDqA9VqbZo/40j/fcS",
+	"dhX4/s168DQFnPE5NJ+YVhphP6S/v79HlA8zJjBWr3OnK5yhosNgCT0xNtjDC313JycMjtpi2FPR3b1E",
+	"/utAhuLfwdufL4CYhm7V2NlqExPF49bVr/GQwIDHG/P2odU+vS9qOOOgF4zzJSv0fWGts89us92+lByG",
+	"5G/vLP9DffjXj/JHH77/H8u/Pvr40Up99PEnjx5ln3yUvf/Jh++rD/768UeP1Pvrv3yy/CD/4KMPlh99",
+	"8NFfPv5k9eFH7y8/+ssn//EO3bQCyUyoTgB9/OA/U7zRSa+eP0tfIbGWJzBqMBKBJxQ1sK45Hh6YuiI9",
+	"j9exJbwmP/1ferO+gNHY5vWvaN40+Pq26/bt48vLm5ubC/eTS7w6Bk3V1YfV9lL3g0WIfdvk+TOzvbLj",
+	"hGbUZjzQpIooXNGzF5+9fIWxoxdWYODZo4tHF+/z3buqYKjw04f0E62eLc37pQgb/BtevATWld1W/oBZ",
+	"boqVfkSaV/7d3mQb0L4XP3HlTPzp+oNL7cy8/EWcS7+OPbvMlmBkrygejW5cRt50AkfhZ/tXWuQTfeAm",
+	"0854BX4QtIPxBsX1kLokzftgmhJbDyn+TqPwrATrruOUu/ibbixLoOmZLB177XJZ3x7xqmrnvqwRNlIn",
+	"3Fl/ODJb/UeXGDLOzg95hSvwXf5Ch/dfY79fOpET0XcEXzvykDVQ7DFdYfE7lz3p771pAjSib3jT/AvW",
+	"Vfq13+YKjafD/vIX+gfpFWfsqIcaaMBhU66Wh82l4EBGf8f29mg6+dPAL3EprtRUyyNlE8xA5ioKcsuI",
+	"2PmNWvXOBm3QlLW1qUzpLzA3/bT1udX33CJhEhw4bGdW4T00Umto0MTYhAqY6fq87HJki7BXQ88pKmBr",
+	"DrYa7NfUSwQNzcH1q7Igl4VsxHmRbbAcGB1DzdaB5iXs6F2vTKO4uwRvAKyTgKPuToOmDqtho6/E5PU5",
+	"SdG0GcPBkvJn9WZprh3Y+KPsVWPKoXkGttkPv3z8118DtusPaEIxMijJ0wePHukdW27hHE1yKZuT25Fn",
+	"mvqyGTLr9fNFgorkaC99vxjmlEnoEDQ0x38d2AmvxhbMZGXNCyTnoyMZOBoB0jR14wC3Duj9NANDRTwQ",
+	"1Pf7b67vZ1JwG20rtgHhlY/f5OifYTQCqgF6k62+dRZ0KHxbUb1K/SYa7AewnnG/d2vOnDjtIFkZ5rBh",
+	"XYTiOpPDlPi68SL+wQ+/GlVedjA2SfYZ/jhiOMhbcJS/pKPo5S+hx4ON2P893LPZ+XTb7hvXuzpXl1l+",
+	"LcUDew9k86zX65b2orHHl7/w/x3y1C3oj4JQCkr7K7si0Gopdgzp4T/Aajzl3fDnu2oV/HHIlsBDMPle",
+	"D16gKweHY7acmP/DJQG1X/5C//t1+NjEXvZ+bw/L9q5F3Xf5i/m3871/YoAfHMHi1TRiAmBWAti1gpFj",
+	"P6Nk0NAu9tx96x/80r32h1lavd9rQK0Pj3RjI/v/vR7+6NFHb44Cb/oSmD8qmPU53eb9ufeEieUzrfXh",
+	"F0FY8ZfaVZ4PhJ4tFxChT2vyCsQ4tms3e9ZUDtOsLw8MY7IO+5Ze0NgZDIsKJuW5TqSpuOqsNakQ2ejX",
+	"s9qMSMIcH61P6rOn055YaXmO4fe817i5JzfXM291yludYnTKx48+fHPdv1TNdbFSySsF3zZZU8DB8dsq",
+	"u86KkmpdnarjQAch9klIAzhLf1LH4ZUrXmltFNaRIHlNl6DBUjmeeh28VnxGGxgylwUOjkawD2JSPava",
+	"jiDTQiSDEcm1GR0HAgXJkcvgi89eJcMOf/H+JOcWN2MdBX5HBElXXyuuKdfrBJH8VvX+Thf+RKrIpcP4",
+	"mEOSW7VqVMdx3rWHYUCpNq++fEk44QaIhjP/tzUflv3d5Bnx7o+5oRAgTWx+3m4tb7cWpuDDN0kBXdap",
+	"5EldVdaP9Gc0U0UpHqUTL96gUoe1SHqEHQfhk+rnuoizxqA0lR8Jweq5bQLT41+rXLBU2oWUs26t37eN",
+	"l6MHLtjUTNDgUlaeHdPRUvXkN7ZZkLSdoHofHgeSgucCFXhlNtCFyX+/0Z5nTrNxOkIX06pucs6LX1g3",
+	"N8e9UGoWKIqk0Yhrg1O7cMRh1YOz6tFxiJyOp8UkXiw4Wlwkj53T84HihkOZjXE0SxvTtAuaDs2wI6M9",
+	"FNLWDM2Ju79462j9P8UAdhy/+zli0Rf0oxy/PcUo/Y3d4bFu29Y3NucD72F3CGM1uNpjNyvee4liyx/H",
+	"oIoKRgIoaI1q8ANBnCYQDbRlHSWEkVithfdAwCVTHAGIwQND/droOb3AruTpqwIx17VFTSm1DV+p2QSy",
+	"QgxpCuBpE4wvkwEF1V2vi0K151V33uSEUem42xMvsfosmsbANATNvcSyNJrae0NhMWKlReatbjuHLhny",
+	"Ps7vkxWI6XnKqhoahhFDRRtTNxISy0BkjdogmGjDb6+2GRpEBOtE0EeI82XAANAwMsXbHJsM/i5Kego2",
+	"adFktoqtTkxhWDQj5r3GknrZwhZgEU0948lE0q44HxS7dIwoC8Bim8c7J4YMwNM19kRjuJi8EXkpXJ+4",
+	"27eJQB5VEvPgUU+4caANK8nOCnBjkbz/6NEjtEFEMukxKFNa0vjsUSw04Kao8vrGiwwYwCOcNwKAPOOn",
+	"3vto9gaSS2rKa0ojsBSc9FU74BT3Te2ciQQoy1AHmzCobNad0J1M1UhSWVyICGlUpOi+2SX6ZtDlt03N",
+	"EiqP93toRslqtTpoeJJ6G0bx1ro/s3V/uiievEEP3ctMPiL+DgfylH5PMikJOty0Qfs/ezrYn/izvhr9",
+	"9I5e7W1RtD9gkLLdHvokDtyxgY1jKnJsTAngQDZ1Z1DseVBvvZ9vL9buZXvPXjxz7uuD1vQX1HA2uChA",
+	"q5m0jFd1ZIkH6MAFEqf4Tsbc/K7L9ywTP4znCcXvMD4eVj6yD6QeQ4/Nb1XEWxVxPxUByyx4El7XojQC",
+	"QndcfM9chQG8UlXuIXDQ9QFeKsvrhxIhn9XcsL0ralGC9d6E1njTd8pBXnGMUlZxcUm8JAlM4Hkvl9+q",
+	"vLcq78+j8q6mFY1vmNz7Uhia2WX7B3PPQxJuE3VhThldbeBsh1qdXH94Ky5eRIm0eay9jVRlUaJvEDzq",
+	"TtkTiXZKGtjgAf8ukmfkGN2prOoEPMnzqA7jj55/8zIUgMQRTwuBJueaMZ1Uc98JCIamS8KAipbvIOfG",
+	"FDGj+jFFEuA1FlH02W0kougPZZUGN5lhCJAXLT8nBtZc8wtDhynfYZea7yvmb4OhQ5ai4z1pIQIXU4I4",
+	"w6cWZOab21LO3/0JkUa/BRHBYKPxze38ZNxrf/stuHKWLY61VDD6KbiEz+XJu4QeGrW55851qAS2kFvz",
+	"rur1tZsGfAiEVZobLt7SFomBr+ba0b19a2H2lrwoD+a1jiteFnnRwXaBldWGflH4iHccl8KiFZ0jg5AQ",
+	"GXP/h+U8cSs6dNu6wcpn/GJzMccFgsyduGN7oqPE7I2lxEyR1AmcFJL5v15+8zWSI7jMz7FypgaUwkri",
+	"aAPo2o4LfcdGo8MvY1drovTdzUxVhx3KlyBT6WXzQyCYdHBdW2YdIu0EakL0b7KSpw6FfAe12TC+IjQQ",
+	"JVep8WvAIUmEg8MqlC+8hrQNiRHER1NtpQ8OGqQNe0qppyNpJJDJY0mkSuCge4zYYwJi7dT8XPdHHiMc",
+	"AZ9n0v1Hc98NAMbCttGIjpprFAXA14agYSE7aHGsrfeHHMVg7/t2ptoP7Gq/+c3o4o9kEf6xh/LGfRaL",
+	"P7KJ+Eefq7M5kcWIOnrhHmN9Kg9gp90euhxIcn6psn27rZ1kcjL2uHAE/fswHVdqUPFtMEulupu6eS3h",
+	"Uf2iIQSbhZCD+A91DRuhDyOtg9sKA7JM7hMLvQzfLQwcs8UcdwvEyU+2YhLqXx2iTwkzHYXID8subWfi",
+	"g18kTxjNRZcxyBXCOPBYydp1B0XxbkGL1cKFC4D7hLn6FRodh50TjlbBpo90IZeEaA7Bk0iwAYsxDmws",
+	"CixmpYC9kwog85uMBMuXacvQDQPI158JhpixfIeA1EmedRmWHT8hikpqxjZjRTqsQeqXED+hO1xAtgRo",
+	"vY51/PX4QhNcfQcmPTsHUnwIo/ylW2Nm5pptTR6RtYaPg9T3Sh1MRTtrwenNZpTbdrQzE0A2brUJGn9X",
+	"32RNTsPd9Zy+b8PR/vQB2fvjp/yI7drdewcoM2Yz9v6+POw3TZarwe83WdHhck4pYjylY/Sw0U5lJbGW",
+	"67y6v+ZFq3NE+k+au+bgGBGEMdr2/778BTcNty8HCin86yWBLkeeIXgwlrDaebh/Po4ibpuxtgcgi6Gn",
+	"gtEXe0kmMPxU1+yeeHwJW2I7woPBe5e/yL9cDCcLcetCxpK1YMBi//UD7sMU7y6GhEVAfXx5SZc2eGdz",
+	"+QDdCj46qvvwByPCvxiHg3Di1x9+/f8AWmwDU90PAgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
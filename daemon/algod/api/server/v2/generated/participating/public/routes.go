@@ -27,12 +27,21 @@ type ServerInterface interface {
 	// Broadcasts a raw transaction or transaction group to the network.
 	// (POST /v2/transactions)
 	RawTransaction(ctx echo.Context) error
+	// Broadcasts a batch of transactions or transaction groups to the network.
+	// (POST /v2/transactions/batch)
+	RawTransactionBatch(ctx echo.Context) error
+	// Get fee estimates based on the recent network congestion.
+	// (GET /v2/transactions/fee-estimate)
+	GetFeeEstimate(ctx echo.Context) error
 	// Get a list of unconfirmed transactions currently in the transaction pool.
 	// (GET /v2/transactions/pending)
 	GetPendingTransactions(ctx echo.Context, params GetPendingTransactionsParams) error
 	// Get a specific pending transaction.
 	// (GET /v2/transactions/pending/{txid})
 	PendingTransactionInformation(ctx echo.Context, txid string, params PendingTransactionInformationParams) error
+	// Submits a raw transaction or transaction group privately to the configured relays.
+	// (POST /v2/transactions/private)
+	RawPrivateTransaction(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -69,6 +78,13 @@ func (w *ServerInterfaceWrapper) GetPendingTransactionsByAddress(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetPendingTransactionsByAddress(ctx, address, params)
 	return err
@@ -85,6 +101,28 @@ func (w *ServerInterfaceWrapper) RawTransaction(ctx echo.Context) error {
 	return err
 }
 
+// RawTransactionBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) RawTransactionBatch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RawTransactionBatch(ctx)
+	return err
+}
+
+// GetFeeEstimate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFeeEstimate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFeeEstimate(ctx)
+	return err
+}
+
 // GetPendingTransactions converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPendingTransactions(ctx echo.Context) error {
 	var err error
@@ -107,6 +145,13 @@ func (w *ServerInterfaceWrapper) GetPendingTransactions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetPendingTransactions(ctx, params)
 	return err
@@ -134,11 +179,29 @@ func (w *ServerInterfaceWrapper) PendingTransactionInformation(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "decode-events" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "decode-events", ctx.QueryParams(), &params.DecodeEvents)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter decode-events: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.PendingTransactionInformation(ctx, txid, params)
 	return err
 }
 
+// RawPrivateTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) RawPrivateTransaction(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RawPrivateTransaction(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -169,205 +232,420 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/v2/accounts/:address/transactions/pending", wrapper.GetPendingTransactionsByAddress, m...)
 	router.POST(baseURL+"/v2/transactions", wrapper.RawTransaction, m...)
+	router.POST(baseURL+"/v2/transactions/batch", wrapper.RawTransactionBatch, m...)
+	router.GET(baseURL+"/v2/transactions/fee-estimate", wrapper.GetFeeEstimate, m...)
 	router.GET(baseURL+"/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
 	router.GET(baseURL+"/v2/transactions/pending/:txid", wrapper.PendingTransactionInformation, m...)
+	router.POST(baseURL+"/v2/transactions/private", wrapper.RawPrivateTransaction, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9fXPcNpIw/lXwm7sq27qhJL8ku1ZV6n6ynWR1sR2XpWR3z/KTxZA9M1iRAAOAo5n4",
-	"8Xd/Cg2ABElwhiMp9ubOf9ka4qXRaDT6Dd0fJqkoSsGBazU5+TApqaQFaJD4F01TUXGdsMz8lYFKJSs1",
-	"E3xy4r8RpSXji8l0wsyvJdXLyXTCaQFNG9N/OpHwa8UkZJMTLSuYTlS6hIKagfWmNK3rkdbJQiRuiFM7",
-	"xNmLycctH2iWSVCqD+WPPN8QxtO8yoBoSbmiqfmkyDXTS6KXTBHXmTBOBAci5kQvW43JnEGeqUO/yF8r",
-	"kJtglW7y4SV9bEBMpMihD+dzUcwYBw8V1EDVG0K0IBnMsdGSamJmMLD6hloQBVSmSzIXcgeoFogQXuBV",
-	"MTl5N1HAM5C4WymwFf53LgF+g0RTuQA9eT+NLW6uQSaaFZGlnTnsS1BVrhXBtrjGBVsBJ6bXIXlVKU1m",
-	"QCgnb797Th4/fvzULKSgWkPmiGxwVc3s4Zps98nJJKMa/Oc+rdF8ISTlWVK3f/vdc5z/3C1wbCuqFMQP",
-	"y6n5Qs5eDC3Ad4yQEOMaFrgPLeo3PSKHovl5BnMhYeSe2MZ3uinh/J91V1Kq02UpGNeRfSH4ldjPUR4W",
-	"dN/Gw2oAWu1LgylpBn13nDx9/+Hh9OHxx397d5r8t/vzq8cfRy7/eT3uDgxEG6aVlMDTTbKQQPG0LCnv",
-	"4+Otowe1FFWekSVd4ebTAlm960tMX8s6VzSvDJ2wVIrTfCEUoY6MMpjTKtfET0wqnhs2ZUZz1E6YIqUU",
-	"K5ZBNjXc93rJ0iVJqbJDYDtyzfLc0GClIBuitfjqthymjyFKDFw3wgcu6F8XGc26dmAC1sgNkjQXChIt",
-	"dlxP/sahPCPhhdLcVWq/y4pcLIHg5OaDvWwRd9zQdJ5viMZ9zQhVhBJ/NU0Jm5ONqMg1bk7OrrC/W43B",
-	"WkEM0nBzWveoObxD6OshI4K8mRA5UI7I8+eujzI+Z4tKgiLXS9BLd+dJUKXgCoiY/RNSbbb9v85/fE2E",
-	"JK9AKbqANzS9IsBTkUF2SM7mhAsdkIajJcSh6Tm0DgdX7JL/pxKGJgq1KGl6Fb/Rc1awyKpe0TUrqoLw",
-	"qpiBNFvqrxAtiARdST4EkB1xBykWdN2f9EJWPMX9b6ZtyXKG2pgqc7pBhBV0/c3x1IGjCM1zUgLPGF8Q",
-	"veaDcpyZezd4iRQVz0aIOdrsaXCxqhJSNmeQkXqULZC4aXbBw/h+8DTCVwCOH2QQnHqWHeBwWEdoxpxu",
-	"84WUdAEByRySnxxzw69aXAGvCZ3MNviplLBiolJ1pwEYcertEjgXGpJSwpxFaOzcocMwGNvGceDCyUCp",
-	"4JoyDplhzgi00GCZ1SBMwYTb9Z3+LT6jCr5+MnTHN19H7v5cdHd9646P2m1slNgjGbk6zVd3YOOSVav/",
-	"CP0wnFuxRWJ/7m0kW1yY22bOcryJ/mn2z6OhUsgEWojwd5NiC051JeHkkh+Yv0hCzjXlGZWZ+aWwP72q",
-	"cs3O2cL8lNufXooFS8/ZYgCZNaxRhQu7FfYfM16cHet1VK94KcRVVYYLSluK62xDzl4MbbIdc1/CPK21",
-	"3VDxuFh7ZWTfHnpdb+QAkIO4K6lpeAUbCQZams7xn/Uc6YnO5W/mn7LMTW9dzmOoNXTsrmQ0HzizwmlZ",
-	"5iylBolv3Wfz1TABsIoEbVoc4YV68iEAsZSiBKmZHZSWZZKLlOaJ0lTjSP8uYT45mfzbUWN/ObLd1VEw",
-	"+UvT6xw7GZHVikEJLcs9xnhjRB+1hVkYBo2fkE1YtodCE+N2Ew0pMcOCc1hRrg8blaXFD+oD/M7N1ODb",
-	"SjsW3x0VbBDhxDacgbISsG14T5EA9QTRShCtKJAucjGrf7h/WpYNBvH7aVlafKD0CAwFM1gzpdUDXD5t",
-	"TlI4z9mLQ/J9ODaK4oLnG3M5WFHD3A1zd2u5W6y2Lbk1NCPeUwS3U8hDszUeDUbMvwuKQ7ViKXIj9eyk",
-	"FdP4L65tSGbm91Gd/xgkFuJ2mLhQ0XKYszoO/hIoN/c7lNMnHGfuOSSn3b43IxszSpxgbkQrW/fTjrsF",
-	"jzUKryUtLYDui71LGUclzTaysN6Sm45kdFGYgzMc0BpCdeOztvM8RCFBUujA8CwX6dVfqFrewZmf+bH6",
-	"xw+nIUugGUiypGp5OIlJGeHxakYbc8RMQ1TwySyY6rBe4l0tb8fSMqppsDQHb1wssajHfsj0QEZ0lx/x",
-	"PzQn5rM524b122EPyQUyMGWPs3MyZEbbtwqCnck0QCuEIIVV8InRuveC8nkzeXyfRu3Rt9am4HbILQJ3",
-	"SKzv/Bg8E+sYDM/EuncExBrUXdCHGQfFSA2FGgHfCweZwP136KNS0k0fyTj2GCSbBRrRVeFp4OGNb2Zp",
-	"jLOnMyFvxn06bIWTxuRMqBk1YL7TDpKwaVUmjhQjZivboDNQ4+XbzjS6w8cw1sLCuaa/AxaUGfUusNAe",
-	"6K6xIIqS5XAHpL+MMv0ZVfD4ETn/y+lXDx/98uirrw1JllIsJC3IbKNBkftONyNKb3J40F8ZakdVruOj",
-	"f/3EGyrb48bGUaKSKRS07A9lDaBWBLLNiGnXx1obzbjqGsAxh/MCDCe3aCfWtm9Ae8GUkbCK2Z1sxhDC",
-	"smaWjDhIMthJTPsur5lmEy5RbmR1F6osSClkxL6GR0yLVOTJCqRiIuJNeeNaENfCi7dl93cLLbmmipi5",
-	"0fRbcRQoIpSl13w837dDX6x5g5utnN+uN7I6N++YfWkj31sSFSlBJnrNSQazatHShOZSFISSDDviHf09",
-	"aBQFLlgB55oW5Y/z+d2oigIHiqhsrABlZiK2hZHrFaSC20iIHdqZG3UMerqI8SY6PQyAw8j5hqdoZ7yL",
-	"YzusuBaMo9NDbXgaaLEGxhyyRYssb6+tDqHDTnVPRcAx6HiJn9HQ8QJyTb8T8qKxBH4vRVXeuZDXnXPs",
-	"cqhbjDOlZKav16EZX+Tt6JuFgf0wtsbPsqDn/vi6NSD0SJEv2WKpA7XijRRifvcwxmaJAYofrFKWmz59",
-	"1ey1yAwz0ZW6AxGsGazhcIZuQ75GZ6LShBIuMsDNr1RcOBuI10BHMfq3dSjv6aXVs2ZgqCullVltVRL0",
-	"3vbui6ZjQlN7QhNEjRrwXdVOR9vKTmdjAXIJNNuQGQAnYuYcRM51hYuk6HrWXrxxomGEX7TgKqVIQSnI",
-	"EmeY2gmab2evDr0FTwg4AlzPQpQgcypvDezVaiecV7BJMFBCkfs//KwefAZ4tdA034FYbBNDb63mOy9g",
-	"H+px028juO7kIdlRCcTfK0QLlGZz0DCEwr1wMrh/XYh6u3h7tKxAoj/ud6V4P8ntCKgG9Xem99tCW5UD",
-	"4X9OvTUSntkwTrnwglVssJwqnexiy6ZRSwc3Kwg4YYwT48ADgtdLqrT1ITOeoenLXic4jxXCzBTDAA+q",
-	"IWbkn70G0h87NfcgV5Wq1RFVlaWQGrLYGjist8z1Gtb1XGIejF3rPFqQSsGukYewFIzvkGVXYhFEde1q",
-	"cUEW/cWhQ8Lc85soKltANIjYBsi5bxVgNwyBGgCEqQbRlnCY6lBOHXc1nSgtytJwC51UvO43hKZz2/pU",
-	"/9S07RMX1c29nQlQGHnl2jvIry1mbfDbkiri4CAFvTKyB5pBrLO7D7M5jIliPIVkG+WjimdahUdg5yGt",
-	"yoWkGSQZ5HTTH/Qn+5nYz9sGwB1v1F2hIbFRTPFNbyjZB41sGVrgeComPBL8QlJzBI0q0BCI671j5Axw",
-	"7BhzcnR0rx4K54pukR8Pl223OjIi3oYroc2OO3pAkB1HHwPwAB7qoW+OCuycNLpnd4q/g3IT1HLE/pNs",
-	"QA0toRl/rwUM2FBdgHhwXjrsvcOBo2xzkI3t4CNDR3bAoPuGSs1SVqKu8wNs7lz1604QdTOSDDRlOWQk",
-	"+GDVwDLsT2z8TXfMm6mCo2xvffB7xrfIcnKmUORpA38FG9S539jAzsDUcRe6bGRUcz9RThBQHy5mRPCw",
-	"CaxpqvONEdT0EjbkGiQQVc0KprUN2G6rulqUSThA1K+xZUbnxLNBkX4HxngVz3GoYHn9rZhOrE6wHb6L",
-	"jmLQQofTBUoh8hEWsh4yohCMivcgpTC7zlzsuI8e9pTUAtIxbfTg1tf/PdVCM66A/F1UJKUcVa5KQy3T",
-	"CImCAgqQZgYjgtVzusiOBkOQQwFWk8QvBwfdhR8cuD1niszh2j+4MA276Dg4QDvOG6F063DdgT3UHLez",
-	"yPWBDh9z8TktpMtTdkcWuJHH7OSbzuC1l8icKaUc4Zrl35oBdE7meszaQxoZF1WB447y5QRDx9aN+37O",
-	"iiqn+i68VrCieSJWICXLYCcndxMzwb9d0fzHuhs+JoHU0GgKSYpPIEaOBRemj301sUs3bKLJWFFAxqiG",
-	"fENKCSnYKH8j8qkaxkNi4//SJeULlPSlqBYuAM2Og5y6UtamIiveGyIqDek1T9A6HePcLujYP/QwchBQ",
-	"o4t1TdtW87im9Xzubc+YKzVAXtfUH/VuTSeDqqpB6qpRVS1y2q9VRnDxlqAW4KeZeKQPBFFnhJY+vsJt",
-	"MafAbO7vY2tvho5B2Z84CIlrPg5FxRk9Od/cgbRiByISSgkK75bQvqTsVzEPX6a5y0dtlIaib4K3XX8Z",
-	"OH5vBxU9wXPGISkEh030MTbj8Ao/Ro8T3m8DnVHSGOrbVR5a8HfAas8zhhpvi1/c7e4J7bqa1HdC3pUv",
-	"0w44Wi4f4Trc6Sd3U97UwUnzPOITdO9WugxATet38kwSqpRIGQpbZ5ma2oPm3IjukUsb/W/qaNw7OHvd",
-	"cTvOr/BJJBp3IS8JJWnO0PQruNKySvUlp2hcCpYaiVryWvSwufG5bxK3b0bMj26oS04xYq02OUUjLeYQ",
-	"sa98B+CtjqpaLEDpjpIyB7jkrhXjpOJM41yFOS6JPS8lSAwdOrQtC7ohc0MTWpDfQAoyq3RbbMdnWUqz",
-	"PHeeODMNEfNLTjXJgSpNXjF+scbhvLfeH1kO+lrIqxoL8dt9ARwUU0k8uup7+xUDX93yly4IFp/R28/W",
-	"d2PGb95ubdD21DwN/z/3//Pk3Wny3zT57Th5+h9H7z88+fjgoPfjo4/ffPN/2z89/vjNg//899hOedhj",
-	"j4Yc5GcvnEp79gL1lsZ504P9kxnuC8aTKJGFYRgd2iL38YGsI6AHbauWXsIl12tuCGlFc5YZ3nITcuje",
-	"ML2zaE9Hh2paG9GxYvm17qkN3ILLkAiT6bDGG0tR/YDE+PM89Ca6F3d4XuYVt1vppW/7+sQHhon5tH6C",
-	"abOznBB8n7ekPqrR/fnoq68n0+ZdXf19Mp24r+8jlMyydez1ZAbrmJLnDggejHuKlHSjQMe5B8IejYGz",
-	"QRnhsAUUM5BqycpPzymUZrM4h/Mx/c5YtOZn3Abbm/ODvsmNc3mI+aeHW0uADEq9jGVtaAlq2KrZTYBO",
-	"vEgpxQr4lLBDOOwaazKjL7povBzoHLMHoPYpxmhD9TmwhOapIsB6uJBRFpEY/aDI47j1x+nEXf7qztUh",
-	"N3AMru6ctSPS/60Fuff9txfkyDFMdc8+5LVDB08vI6q0e13UiiQy3MzmqrFC3iW/5C9gzjgz308ueUY1",
-	"PZpRxVJ1VCmQz2hOeQqHC0FO/IOlF1TTS96TtAbTSQVPxUhZzXKWkqtQIWnI06YI6Y9wefmO5gtxefm+",
-	"F1TRVx/cVFH+YidIjCAsKp24BAeJhGsqY04rVT9wx5FtBpNts1ohW1TWsukTKLjx4zyPlqXqPnTtL78s",
-	"c7P8gAyVe8ZptowoLaSXRYyAYqHB/X0t3MUg6bW3q1QKFPlHQct3jOv3JLmsjo8fA2m9/PyHu/INTW5K",
-	"GG1dGXyI2zWq4MKtWglrLWlS0kXMN3Z5+U4DLXH3UV4u0MaR5wS7tV6c+oh6HKpZgMfH8AZYOPZ+PYeL",
-	"O7e9fDKr+BLwE24htjHiRuOxv+l+BW9Qb7xdnXesvV2q9DIxZzu6KmVI3O9MneNmYYQsH0ah2AK1VZcO",
-	"aAYkXUJ65fK0QFHqzbTV3UfqOEHTsw6mbAYf+4IMc0igZ2EGpCoz6kRxyjfdx/wKtPbxwG/hCjYXoklB",
-	"sc/r/fZjcjV0UJFSA+nSEGt4bN0Y3c134WCo2Jelf5ONj/M8WZzUdOH7DB9kK/LewSGOEUXrsfMQIqiM",
-	"IMIS/wAKbrBQM96tSD+2PKNlzOzNF8nm43k/cU0a5clFboWrQau7/V4ApgMT14rMqJHbhctkZR9MB1ys",
-	"UnQBAxJy6NwZ+Sy55RDCQXbde9GbTsy7F1rvvomCbBsnZs1RSgHzxZAKKjOdeD0/k/UfOs8EJqh0CJvl",
-	"KCbVgY2W6VDZcrLZjHtDoMUJGCRvBA4PRhsjoWSzpMon2cJcZP4sj5IBfscEANvSvpwFoWZBwrE6qYvn",
-	"ud1z2tMuXfIXn/HFp3kJVcsRKVuMhI/R7bHtEBwFoAxyWNiF28aeUJpkBM0GGTh+nM9zxoEksai1wAwa",
-	"XDNuDjDy8QEh1gJPRo8QI+MAbPSL48DktQjPJl/sAyR3yRSoHxs96sHfEH/3ZeO4jcgjSsPC2YBXK/Uc",
-	"gLpQx/r+6gTc4jCE8SkxbG5Fc8PmnMbXDNLLPoJiayfXiIvMeDAkzm5xgNiLZa812avoJqsJZSYPdFyg",
-	"2wLxTKwT+/AzKvHO1jND79HQdnyGGjuYNs/LPUVmYo3RPni12FDqHbAMw+HBCDT8NVNIr9hv6Da3wGyb",
-	"drs0FaNChSTjzHk1uQyJE2OmHpBghsjlfpC65UYAdIwdTR5kp/zuVFLb4kn/Mm9utWmTksy/Good/6Ej",
-	"FN2lAfz1rTB1spU3XYklaqdoB62088wEImSM6A2b6Dtp+q4gBTmgUpC0hKjkKuY5NboN4I1z7rsFxgvM",
-	"ZkP55kEQCSVhwZSGxoju4yQ+h3mSYhI9IebDq9OlnJv1vRWivqasGxE7tpb5yVeAocRzJpVO0AMRXYJp",
-	"9J1Cpfo70zQuK7VjrWzKWZbFeQNOewWbJGN5FadXN+8PL8y0r2uWqKoZ8lvGbcDKDFMkRyMwt0xtg3S3",
-	"LvilXfBLemfrHXcaTFMzsTTk0p7jD3IuOpx3GzuIEGCMOPq7NojSLQwyeDnb546B3BT4+A+3WV97hynz",
-	"Y++M2vHvd4fuKDtSdC2BwWDrKhi6iYxYwnSQYbj/pHXgDNCyZNm6Ywu1ow5qzHQvg4fPy9bBAu6uG2wH",
-	"BgK7Z+xVjQTVTsHXCPg2V3QrA87hKMxctBPlhQwhnIopX+mgj6j61d0uXF0AzX+Azc+mLS5n8nE6uZ3p",
-	"NIZrN+IOXL+ptzeKZ3TNW1NayxOyJ8ppWUqxonniDMxDpCnFypEmNvf26E/M6uJmzItvT1++ceB/nE7S",
-	"HKhMalFhcFXYrvzDrMpm+xs4ID6TutH5vMxuRclg8+sUZaFR+noJLiV1II32cmc2DofgKDoj9TweIbTT",
-	"5Ox8I3aJW3wkUNYuksZ8Zz0kba8IXVGWe7uZh3YgmgcXNy4Ba5QrhAPc2rsSOMmSO2U3vdMdPx0Nde3g",
-	"SeFcW5JmFzYvvCKCd13oGPO8KZ3XvaCY+dJaRfrMiVcFWhISlbM0bmPlM2WIg1vfmWlMsPGAMGpGrNiA",
-	"K5ZXLBjLNBuT26YDZDBHFJkqml6nwd1MuJo/FWe/VkBYBlybTxJPZeegYpoUZ23vX6dGdujP5Qa2Fvpm",
-	"+NvIGGHW1+6Nh0BsFzBCT10P3Be1yuwXWlukzA+BS2IPh384Y+9K3OKsd/ThqNkGLy7bHrewRE+f/xnC",
-	"sLnad9cH8sqrSz87MEe03g9TyVyK3yCu56F6HHmw5PPcMoxy+Q3Chw5hlYsWi6mtO03Zomb2we0ekm5C",
-	"K1Q7SGGA6nHnA7ccJtz0FmrK7VbbhyStWLc4wYRRpUd2/IZgHMy9SNycXs9oLBupETIMTKeNA7hlS9eC",
-	"+M4e96p+bWFnJ4EvuW7L7GP0EmTzlrCf2OaGAoOddrSo0EgGSLWhTDC1/r9cicgwFb+m3FZxMf3sUXK9",
-	"FVjjl+l1LSSmklBxs38GKStoHpccsrRv4s3YgtkCJZWCoAKGG8gWf7JU5KqI1G+IHGrO5uR4GpThcbuR",
-	"sRVTbJYDtnhoW8yoQk5eG6LqLmZ5wPVSYfNHI5ovK55JyPRSWcQqQWqhDtWb2nk1A30NwMkxtnv4lNxH",
-	"t51iK3hgsOju58nJw6dodLV/HMcuAFdgZhs3yZCd/NWxkzgdo9/SjmEYtxv1MPrq3laYG2ZcW06T7Trm",
-	"LGFLx+t2n6WCcrqAeKRIsQMm2xd3Ew1pHbzwzJZHUlqKDWE6Pj9oavjTQPS5YX8WDJKKomC6cM4dJQpD",
-	"T015CzupH87WWnKZiT1c/iP6SEvvIuookZ/WaGrvt9iq0ZP9mhbQRuuUUJs/JGdN9ILPl07OfHoiTNVc",
-	"Z2i2uDFzmaWjmIPBDHNSSsY1KhaVnid/JumSSpoa9nc4BG4y+/pJJD11O00q3w/wT453CQrkKo56OUD2",
-	"XoZwfcl9LnhSGI6SPWheewSnctCZG3fbDfkOtw89VigzoySD5Fa1yI0GnPpWhMe3DHhLUqzXsxc97r2y",
-	"T06ZlYyTB63MDv309qWTMgohYzkHm+PuJA4JWjJYYexefJPMmLfcC5mP2oXbQP95PQ9e5AzEMn+WY4rA",
-	"MxHRTn3K9NqS7mLVI9aBoWNqPhgymLmhpqSdnvrT89G7iYKKe7q8Ybvv2DJfPB7wjy4iPjO54AY2vny7",
-	"kgFCCdLzR0kmq78HPnZKnon1WMLpnEJPPP8CKIqipGJ59nPz8rNT/UBSni6jPrOZ6fhLU6etXpy9A6Pp",
-	"A5eUc8ijw1l58xcvl0Yk53+KsfMUjI9s2y3IYJfbWVwDeBtMD5Sf0KCX6dxMEGK1/aiuDtrOFyIjOE+T",
-	"q645rv1CHkG69V8rUDr2QAk/2MAxtI0admCzfRPgGWqkh+R7W4p5CaSViAg1QZ8pov1quipzQbMpZrC4",
-	"+Pb0JbGz2j622pDNNr5ARai9io5NLEjDOS4E2RcOij+PGD/O9nhts2qlkzo5eOwBqmnRpC9nHT8Bqkgh",
-	"dg7Ji6Coqn2raoYgmMBEFkarq0ez8hHShPmP1jRdotrXYq3DJD8+Tb6nShWUpqxLTNW5KfHcGbhdpnyb",
-	"KH9KhNHNr5myFXhhBe03r/UDcGd28G9g28uTFeeWUg73uOXqTJT7ot0DZ69I70qIQtZB/J5Cv60ysW/V",
-	"gHPsFU2V1S1B0KtJaV9Q1qWDfGX1lHLBWYqJqmJXtCvVO8bPNiKnV9eQ64+4O6GRwxUtfFCH4jksDpZC",
-	"8IzQIa5v6A++mk211GH/1FgTdkk1WYBWjrNBNvX1O5ytkXEFLtcoFnYO+KSQLd8lcsioOzyp3SZ7khE+",
-	"vRlQHr8z31470wLGpF8xjkqEQ5sT/Kw1ECuJaqN5ME0WApRbT/v9sXpn+hziU9wM1u8PfeVRHMO6/syy",
-	"rZ+7P9Sp93o7L7Np+9y0dQmS6p9bUc520tOydJMOV3eJygN6zQcRHPFeJt59FCC3Hj8cbQu5bQ1XwfvU",
-	"EBqs0NkNJd7DPcKoK510qmgZodVSFLYgNkwsmiWB8QgYLxmHpi5u5IJIo1cCbgye14F+KpVUWxFwFE+7",
-	"AJqjhzvG0JR27o3bDtVND2VQgmv0cwxvY1OkZYBx1A0awY3yTV2O11B3IEw8xzrgDpH9kisoVTkhKsNX",
-	"C50iLDHGYRi3L/PUvgD6x6AvE9numCtt35to6CHqrMoWoBOaZbHUr8/wK8GvJKtQcoA1pFWdIrQsSYp5",
-	"V9qJaPrU5iZKBVdVsWUu3+CW0wVVjSLUEFZW8juMD11mG/w3lh9zeGdcoMfeoYY+qiPbL/tSP3QyJvUa",
-	"mk4UWyTjMYF3yu3R0Ux9M0Jv+t8ppedi0QbkE6ef2Mblwj2K8bdvzcURZmfoJX21V0udPAED+4SvRYlq",
-	"Y/3st82V8CrrZYFFh1Jd6267AWK4at0UL7+B8N4g6Qa196v1UA4F+aaDMelUu9dxmpKtLGjwxZGNELJv",
-	"ixCKuHV2KCrIBgWZz73e4yTDnpyt44kPA4T6cLM+QD/4WFZSUubc7w2z6GPWRb333yGMiYdtNri7CBdL",
-	"Pmix+2E1FPftk7Hh925VqytwT+ZLCSsmKu/Y9pFPXiW0v7ZqRNWR99H19w2vONXnNYcOGm8vXHUBu0yn",
-	"k//ws42TI8C13PwLmHJ7m96rl9WXdq15qmlC6sTUoxJVt27FMYkKYznxnGzYqti1o95Yj6xejBEH+vXD",
-	"ppOzbK8LM5ZXcWJHiR27eDWw4bRTTaopPGKlUKzJDx8rEzYyxPACK30FabP6Y/n4nhWkGosCNHELEmCf",
-	"JFpmsqDw6Jf0UwPqdB2J6bJObUs11a8EsOOO770GC1402izqh+MTK53W0WnIpzEb8gK4q/3ZfucxOtp8",
-	"PodUs9WO13d/XQIPXnZNvV3G1vAOHuOxOnoZk7fsb3VsANr2OG4rPEESxVuDM/T25go29xRpUUM0rfvU",
-	"X7U3yduBGEDukBgSESoW/WENyc4hz1RNGYgFH21lu0OTAW2wIlTwlvSGc3mSNBdH8750y5TxkjSj5jJd",
-	"93p1jYG4Qw/0+hUthvWPF1hARNXVGn3ej1BLJ2f97IjXLm8IvpWsfSc+gwgo/5t/GG1nydkVhDWr0FN1",
-	"TWXmW0RNL96qk2y5j3qv6nw1hi7Q83pm1sTG9t9RRfJtYQR0mgsjRiRDYeTtcNQ6luOeskE3Nv07Btoa",
-	"uOYgXW0/lH9zoSDRwsfSboNjGypsZNGNkKAGc1xa4AYzz7xtUutgrl+KmWaoCygKF0gkFNRAJ4MEOMNz",
-	"bkP2c/vdPxzyuV53Wphqet1ddMBHRTPVQ2JI9XPibsvdD5JuYmxinNv60SqWDYeDbHtDSimyKrUXdHgw",
-	"aoPc6FxTW1hJ1E6T9lfZ0RGCV51XsDmySpCv1uB3MATaSk4W9CCLQmeT79T8pmJwL+4EvM9puZpOSiHy",
-	"ZMDZcdZP4dOl+CuWXkFGzE3howcHKuiQ+2hjr73Z18uNT1lTlsAhe3BIyCm38dresd3OId2ZnN/T2+Zf",
-	"46xZZbNqOaPa4SWPB75ivit5S27mh9nOwxQYVnfLqewgOxLErAfSB0l6HakndThWK++7mrs1fhqislDE",
-	"ZJKmfM2OOJk6RKap/NGEyfSlgzwX1wlSUVLn/4rpHKZdm0n6jKdNN4PtGQTxNlS5C3RDljQjqZAS0rBH",
-	"/ImDBaoQEpJcYPhNzDM410YeKjCumZNcLIgojZpr0+h5H0q0LE0w112V4LHPdS0EiXX4DCREAOWe5zpw",
-	"beM+vFuq4OxfYediGbHb4Ib53dq7jI4juL2rXwRgjiD03Tar01iVoPa6uvWqhqrHaVGwNI7uP1a0ymCM",
-	"SYx6Y6hwCWjtAzhshgc85Cm1cxJPTx/NwOksj3lsiDt+zkmDdG7+izdYd1wyB8dcBvhZ5AHmtlXHKj9F",
-	"drWeyhWm8m8qBygk6vDe7l+21QBnY73MdcbpkcwgAGDY79yCYZT3eV8w5lhdM6ERJJ/VMv+0VfyYdTie",
-	"zwZoT3ZKrc6/BGLGriS4N362DGCn7lBJ9dLLAKZ5XzM3Wh4ofIBni6dQZe1I3p7lahB2hStRJjmsoOWO",
-	"dw8PqzQFpdgKwvqFtjPJAEq07nZ1jpifOeTtHUHUrT0JPJVjsBuVTC1i7U6RHWJnVEhe88QeEzX2KBmI",
-	"ViyraAt/6haV3IaKuEUuHw/r+3GcYm8mEV/cNhaxMzIEaT56Lnk8MCR891qblHC2rDY9WyJsTrYq6TUf",
-	"VsH6RNnITuNrIAaI/XYNKd5D7ciH2+OE4GBEdd60DwpNst7hm6ryg1S2jch6FSGjUpsCX9E3TD/jBV/X",
-	"NyLtWqMjU5EBmGp4A8ZRQhOnFzQr6IZkbD4Had0qSlOeUZmFzRknKUhNmdExN+rmCoaBVlYw3aljGE6N",
-	"g3pmFdM20EJoAck3Tnkbkv9HyO3oQ4vI7Pba1mKoWGVvV+IPO+ja6DkY4TZABO5JOmo59rAKjiImKegV",
-	"7DmPYr/B9mkwUYyzwmqBs46Z4uNWWv8RUYcH/ifO9FZqt6JfN+TQ+oQsMXoa5IvGMW03p0+DsSjRC1sy",
-	"KYwU7VYg8HttDVR2PhjIqOh4Z4I8VW1x+YIKaiWlzmTXFwd6zNgCM3URtHtJC11zQ7qDKUVZ9MCZaMvq",
-	"Yo7UiZtiLyaMG6jZ8bQb0dK+guptx+qfaSVRiLqmm92J2ZprKB4MbEf26oyPcaihdlttCUzZghLRvGf7",
-	"iCcRmo/VVOhnnLr7xdgo98YP9/stx1na4wsIK7Rvp7dGkPekEqE1yjexo+NtyTdY4JB0MiJO8862qj4t",
-	"v8cGRVn0zRKRjgKtH7MXwWZQOXh7GEWYp7h5AC1t6Ce6Xb0+1OUXrxo9aVwNY99hB3hhdE1Qxdg7Ohw4",
-	"n/kl8asaKcFS3g9RQmv5uwJ23AIbxTLYIieraQ02a7x9fdbelyAaSz2vg5yGCm53Y6EwKbERDvI8EkNl",
-	"xUdb4jYgHHNPyhXNP30cFGarPkV8QPZ22HMaBtKESLaoVDd7xveSjpo7CJq5u6n5G4zb+iuYPYpeC24o",
-	"p7H2mD8K/zS3Vv65r3e5Ak6ucUwb9P3wazJzaU5KCSlTXU342peiquNGsDKjezq51jsCVXat82ehb0HG",
-	"c29YIq+bsjZoyF7wBsLmiH5mpjJwcqNUHqO+HllE8BfjUWG+0R3XxVUrGryR6oIbTUi446jw4H3XnlHh",
-	"/UyqY5dnI5/NpVMp6K9z9G3dwm3kom7WNvZJQx+522qfjHmJEC9pZLrjUwiLEKwHRhBU8o+H/yAS5ljw",
-	"V5CDA5zg4GDqmv7jUfuzOc4HB1El75M9grA4cmO4eWMU8/PQs3j79HsgA0NnPyqWZ7sIo5VPoymZjRkj",
-	"fnFZez5L0e5fbGBm/6i6wqm3iCa3iImstTV5MFWQKWNEkgzXLZISA4Me0koyvcFkwl7jZb9En2t8X4f+",
-	"utDx2oTn7j4trqBOR90EClfK367fC5rjfWQti9zcQiI/JN+uaVHm4A7KN/dmf4LHf36SHT9++KfZn4+/",
-	"Ok7hyVdPj4/p0yf04dPHD+HRn796cgwP518/nT3KHj15NHvy6MnXXz1NHz95OHvy9dM/3TN8yIBsAZ34",
-	"1HWTv2Fl++T0zVlyYYBtcEJL9gNsbBFdQ8a+PC9N8SRCQVk+OfE//f/+hB2momiG979OXGasyVLrUp0c",
-	"HV1fXx+GXY4WGBmYaFGlyyM/T69+7+mbs9oFaY3+uKM2qYR35nhSOMVvb789vyCnb84OG4KZnEyOD48P",
-	"H5rxRQmclmxyMnmMP+HpWeK+Hzlim5x8+DidHC2B5hhIb/4oQEuW+k8SaLZx/1fXdLEAeehqFpufVo+O",
-	"vFhx9MFFSH7c9u0oLP919KEVSJrt6InlgY4++Ky321u30sq6AFqz9Kgt9nvQ7s2E0mFtwpYJYLbxMaBT",
-	"ooR0gWWlZMKcqqm5IjNIJVA8A0JiFhAtK55aS7KdAjj+99Xp39Ca/ur0b+Qbcjx1yWEUqh2x6W3YVE0O",
-	"Z5kFu+9AUM82p3VIclAT4+RdzHISq6WMx8nQSkDt9YgNN0PTeliBvubNht8eJ0/ff/jqzx9jMl9Pgq2R",
-	"FETphqjXwmeGRaQVdP3NEMrWzu1pxv21ArlpFlHQ9SQEuG9qjjxd8lEKPndzq6a2i2hgivzX+Y+viZDE",
-	"6bhvaHpVR2gYkDHhqRQrhik0siDviuk5BLG7/kKgfRFEF+pRqEXZfsVfo/k9ZpNEQPHQPzo+3qsQecf4",
-	"1Cc0TAMTWN/68WyKwJqmOt8QqgIniqpmTebXThyNKJOW93arva8/o6+jFjNm7xtSF0kzg/XKtsN30cmS",
-	"2UKHC3TAuo27nxb0kBGF4H3ssg+31tPIl939n7G7fdmBlMKcaYaBYc2V46+zFpBNNS0H7kC08CH5u6hQ",
-	"wrP1ciGWvh5nQLe+n9M9bgje0DXxKvjl4KC78IMDt+dMkTlcI5OlHBt20XFwcGh26smerGyrNbmVC2DU",
-	"2dlnuN5mvaLrOms4JVzwhGM51xWQQC18cvzwD7vCM44v64xoSqzo/XE6+eoPvGVn3Ag2NCfY0q7m8R92",
-	"NecgVywFcgFFKSSVLN+Qn3idKi5IQd9nfz/xKy6uuUeE0SqroqBy44RoWvOcigfJ+7byn94zhUbQRi5K",
-	"FwpdzCiiTlply/li8v6j1wFGKhbbmh3NMD/u2KaggsbD2gn6D9TRB7SAD/5+5BJ6xj+iJ8KquEf+AWW8",
-	"ZUvx+aDXBtZOj5TqdFmVRx/wP6hyBmDZ9DlHes2PMOLk6ENrNe5zbzXt35vuYYtVITLwAIv53Bbm2fb5",
-	"6IP9N5gI1iVIZu4UfLLqfrWpBY4wXfam//OGp9Ef++voVsWP/Xz0oV2VsYUgtax0Jq6DvugBsO6r/nx1",
-	"nfLW30fXlGkjv7g3ulg1o99ZA82PXEK+zq9NDpzeF0zsE/zYkXhKYd+CtJXNt/T6ohV5KG0w/TOBBoIh",
-	"XrhOZowjgwgZWGPXsx/72kuPbV0swcYQeddoRDzUgsykoFlKFRZjcKkre2rrx1uqRt3Y/7OI4wvBREtA",
-	"/7mnOeqHO70hOO4Y+S/Yl6CGEcrhytoDf2eZqQfRM5oR/3goIa9objYcMnLqJPMWNn5veefzCyifWaL4",
-	"ZCLAM3/4FKH4xK2lu8n4o5ogx+yY+94oeIYBLIAnjgUlM5FtfEkuSa/12r7Z6DK3o7q2WvTjHRgJ/7Ut",
-	"g7sMgl/scF/scF8sNV/scF9294sdbqQd7ouV6ouV6n+llWof01RMzHSmmWFpE2uP0Na8VrejTY6nmsW3",
-	"X4wyXctk/VJWTB8ScoEZdKi5JWAFkuZY7lMFKbEKDIHEd6eQnVzypAWJDTQ0E99v/msjPC+r4+PHQI4f",
-	"dPsozfI85M39vijv4iebf/cbcjm5nPRGklCIFWT20U6YY8T22jns/1eP+2MvORG+UFvSFdTPU4mq5nOW",
-	"MovyXPAFoQvRRCcbvk24wC8gDXA2xSNheurSqDJFrs3iXQWYdiqUtuTelwDOmi3c6dHvkEvcmW8Ib09P",
-	"/n+MceP/r5bSb/HK81aMdOvYPa76hat8Cq7y2fnKH91HGpgP/0eKmU+On/xhFxQam18LTb7DyPvbiWN1",
-	"Va1YpsubClr+Ebc39zXRu2E0LN6idRzsu/fmIsAyuu6CbYI7T46OMJfeUih9NDHXXzvwM/z4vobZ1z6c",
-	"lJKtsJTC+4//LwAA//9Tf3Gr9esAAA==",
+	"H4sIAAAAAAACA+19a5fbRrLYX0GUnGNbIWbk1961ztmTjCXbq6xt6Uiy995Yig2STRIWCPAC4Dzs6L+n",
+	"Xv0CugGQQ0n2Rl9sDQF0V1dXV9e7fr+zqLa7qlRl29y5//udXVZnW9Wqmv7KFotqX7ZpvsS/lqpZ1Pmu",
+	"zavyzn39LGnaOi/Xd2Z3cvx1l7Ub+HcJg9h38PvZnVr95z6vFQzV1ns1u9MsNmqb4cDtzQ7fNiNdp+sq",
+	"lSEueIhHD++8HniQLZe1apo+lI/L4ibJy0WxX6qkrbOyyRb4qEmu8naTtJu8SeRjeC0BRCTVCn72Xk5W",
+	"uSqWzZle5H/uVX3jrFImjy/ptQUxratC9eF8UG3nOUwuUCkDlNmQpK2SpVrRS5usTXAGhFW/CI8bldWL",
+	"TbKq6hFQGQgXXlXut3fu/3SnUeVS1bRbC5Vf0j9XtVK/qbTN6rVq77ychRa3AgjTNt8GlvZIsA8T74sW",
+	"0L2i1cAa1zBBmeBXZ8l3+6ZN5rDuMnn69YPk008//QIXss3aVi2FyKKrsrO7a+LP4fkya5V+3Ke1rFhX",
+	"sNfL1LwPAND8z2SBU9/KmkaFD8sFPkmAViML0B8GSCgvW7WmffCoH78IHAr781wBpGrinvDLJ90Ud/53",
+	"uiuLrF1sdhXgMbAvCT1N+HGQhzmfD/EwA4D3/g4xVeOgP91Lv3j5+8ezj++9/q8/XaT/W/78/NPXE5f/",
+	"wIw7goHgi4t9XatycZOua5XRadlkZR8fT4Uemk21L5bJJrukzc+2xOrl2wS/ZdZ5mRV7pJN8UVcXAAmc",
+	"biEjYFUZDJXoiZN9WSCbwtGE2hMYYFdXl/lSLWfIfa82OezFImt4CHoPOGJRIA3uG7WM0Vp4dQOH6bWL",
+	"EoTrKHzQgv64yLDrGsHEUi2qpUrVpZYCfCT8cwMMAWafESBFtW70HbnIioJunmy3K3KgfHuzZsBb1nkD",
+	"mwGcYlGVcJ0u2sQZmJCTFQ3eajg9YKCEkXDYi6cP0k/+mjA8Zi4ZI7ZsfxGBFc8ruPQAGbhidU38L10U",
+	"VQNMqBq5kPUdC+csca9Qezs3h13PyXNcEU6OD1i8IISUeIoLkFlaomSYriFU8mUMhLFKbqp9ckXkWOSv",
+	"6HtZDaJpixvF5OhJDsiuYpjrIWMEeQxuEGXbDObHiRH0ArZf7x7gAKRMWK6sFUCqVbuvy1lSwfNa/z5X",
+	"wLCSapvjDXOWfK8aHMlBUKMKtcDfhMqWVetMiax7ljR7QDMg7pd5US1endXl8pezhCTBZr/bVbX5HCH7",
+	"X88efy+XWgxBsuBh+U7z3z5WylW+3gMCgDAUrdVDSDX/FRaEx58gqerkO6CXbK2eZItXCRxkPBtnyaMV",
+	"0EbrsAjhKYRK/DIKPMMVEvZ+bSrkDdtmvYO5wpJdkcNe9Ff1XXadb/fbBEaaw4pgl7UoYXY2BhCPOMKS",
+	"ttl1f9Ln9b5c0D7baT2ZHs9g3uyK7IYQBoP87d5MwAHyAd65A/kWKay9LqPyPM49Dh4wgH25nCDutrin",
+	"joDV7NQiB5JaJmaUAUhkmjF48vIweKwQ7oCjB4mCY2YZAadU1wGaQZ6HT+CUrpVDMmfJD3LJ0dO2egX3",
+	"jSb0ZH5Dj3a1usyrfWM+isBIUw+fVDhHKoXxVnmAxp4JOpDt8jtyE29FFsZ7KAM2j/cVAw3DMYeKwuRM",
+	"OKz39qW5OQgAf/ksJuvZpxN3H77s7Prgjk/abXop5SMZEKHwqRzYsITtfT/BTuDO3QCvhHnCSlfSAI8q",
+	"SCpJ5EXQwc7CUDgjTbdVEAj5OuVfe7SUr5+jGLDKCxIRfkUS0juxb4gPeXuhhQYYssyAaan7L8q7+FeS",
+	"giwPO5/VS/xlyz99BwPlMAn+VPBP31brfAE/RfbTwBrU/emzLf8PxwvfCO11ENvfVtWr/c5d0MKzocA5",
+	"dnDfgYvHPPRsXBjDi6sDP7/WevGhXwAUeiMjQEZxt8vwxVfqBqRe+Ee2WNH/rldE0tmq/g3/B1Iyft3u",
+	"ViHU4lESqYCkq4snj54jL3wqP+JvyH0Ua7KOzH1ONzn8ZgED/rlTdZvzULyCIEOGJ8bkhbOd9fRRpPEF",
+	"jEYj5a3aNoGDYD7K6hpwgX/jaOFJmcXDbS1cXrPSf09Rb0ph4SmtPNmobKnqAEiv3TP6E6/PgKnntjhm",
+	"IYtx3GUSpboCyXAh8jaOtEwAAo0N+EJvRHOCnaBRfUz+N7gZAJL/em5Nsef8eXOup+4juIMBGXfKkvW2",
+	"O8s0WlYJ0iaved6A7Kme1FW1OsGyi+oK1PKomZbUIVAfSOy3tk/Ue5aaTcqvIMnLa1eol3q2UnpfsaTX",
+	"hoZUjSixKluJrsE6LUCXzOmmMuomfrqFpZ5NNNBo3oJCM602L5fqOrxWetSfiuEKL9tCYe6/GSK5WoWn",
+	"EKmeNQhSqni2jDc2oU9nItxsKlLK8DNVvyrkqeELVxVCdqkICit83LQqxCzg04j0h08Cqxa13+yRAZVN",
+	"/OZvEhRmKL52X0D4DcLIUoIv7VorpTnEqhfNYiWuE403quY7OFuDdNe0oqJbIOGd+R4uci2Q4kkBfZwe",
+	"kGaW1c7a4LSUDUirTQtcZSLWggLcc71ub/PEWAT0n7VhygBF9/ADt6oKIN3pB47fP/y8EXAnPG+82GPP",
+	"W3DVM/jHr9nCIU9mEsCfQwjvcGOXAehTKgdjZoRqF+opfJt4MdobWzIciv9tkyH9oYmoqvlw8GGG38xJ",
+	"kHeKapEVTJJkmir9U4HjanUAbwCe4MK+coJ7AN5NCYyUwBi9/uzQ3+JXz+gjNN7ydZ3CeAeM8QRNYs2k",
+	"04aKkmYTOfoGme5Qks1RCS3UZVa2E0iBZ5okikQRLnbTuWpctvdB4+6ft7toqFwX1dz88CGMajFIz+EX",
+	"xgdZFVVOpil1nTdt8xEtP7OCvDsPSPHJN+7YZJSu0Lw2V2JsQb670gyW9XjjZW26/BjWQduJbkuH7pB4",
+	"T0FxZGCXC2KUVvDlv8u7Lpnh75M+/nOQmIvbOHER+9BXK9m+6RfH6P1hh3L6hCOOz7PkovvtcWSDowwQ",
+	"TPPIYvFUxHOAtO6jt9rXCxVSjdBIlUb0ox8axaSxy9Z5aQQWuHm32SveCLaYIwWoxpiEmYhYszLGbTG3",
+	"Cc7PjpI7TkamgszZkfQa2lptjSNrnVgVDZmAWKiKJd5/WrkD0c3enC7tPOAXHNZ7Cl3PuaQOoCE7wXvS",
+	"0aTjYfIIAhrY3ygJuS7NyQREdHdK0jmQAdE99Z5sumRzNOcJ7usI1xkllifshDrF/TSk3+mr01eWg1vC",
+	"AQ3hYWDGX9nlCiJfhtqnPyBq2FuMeUAVDYVJcjkg3eSADO1wq9VVVi8jZgxL5UPTe/JId1mo+Swp2ADR",
+	"rqUONDdPPjeO3Nc9Puj5ktWPQQmvkmd0CrLC2Bg9HzIkebdZWtJeTdcZGohj3FVVwXEXSGPocKhmHfW3",
+	"ANJFL4U7UaGWa+8sR294Y14XgprZS99F4SEH0ceup7wKStmX6RKbiwf/4NXESgCGUxw+sT5FbNx1tniF",
+	"VCtv+QRqjXqHCJYO/KMGYQPdRNPCrmpAo0Mp/xIdmjs7Vc/appfmWMJcLD9Vr9TN30EbqOqbPxKP27cb",
+	"MogNj8ThUy2oJfhBVee/6aMROl16woCNTB/4hkOX4JQxypBQq6syASQdYeraMF7RfFK36QCrWOW1OceL",
+	"6lJTHoImY9yXQBQAxKxHZTUwhpq/0yFJbfKqRIjFEMb7HeRcNFbE2rjJyrVj23WQG95EODDFEnkRreTQ",
+	"o0JEGGLlb1HfMdwwtGkGW0dIJaETZoUSsnEyFcfQ7HJPMS0LjPgUKVS2yz/XR5zlCVs1uFBZ3FWd7Xhl",
+	"8oR95XCbZiaUyoW1eZi1GUZHPIYRt/lvp+D3GAeforwXoXAblLQvMUCTZMO+M2MpkDGrKDKg8K3Kmn3N",
+	"IZ39UwUCVa3QXJ0VweBME1PWn4Lol545gyBRVD9fZos9iClbcmMwveO+e6AvstKx0BQAJTlUHDBNXODs",
+	"Dq4kVbGzhYHeImBgVCDvCp8qTDngmNdGwdYA22lyvHaIH+2qxcblrnmh0OlS78uI9MRg1HUV4fP0KALJ",
+	"KssLiQ0ko2hW3gSvEpqDDvPBi6WvRpYbXBdpcLDsyKqIb8NkNLV25qIuhtS83OOyXDimoTvHoMOSyMgM",
+	"E3FETjkWJzoPIuXFzwJSrYvzq6zRuisKZMDwrrLcCYZi+Vb8QhRFCPSeLwsVJnR9Eo7gBeYQyU3co49Z",
+	"0lRAhvUUSocn5UF4YG4ATG3d8Si7i9uXo0tyB0XpZrsrFAnnho4Eo4j4osqW4Z3sXJcOe/V5nqYuu/O9",
+	"PbDIkBVM1SxQn9gbmpyDXLsW1cdbY4xm+cqxdwjcNo9OYjp0dYtDzYYExHsjUE8ROkjQiu1p1PZjYhV0",
+	"1IHvXu1SyumFKdduPLggF5cdqMg7+FAVbdacxtlrTKjhrWZ1vqMaXGECEzJqL6mE02HoTRPUYQJCrGLt",
+	"OyonKw0hFBylPcgavIVNIVIXVYeaR6ZjkeVl6+LXZi9Vn2K/NzzSYdZqnv49u9KUoJF4ELcK7OMoo7KO",
+	"XbaXWoo4PW9it0QE8C4/+hLtmg+QrFY43SlUt4UKierOHAY7IBqR3AF6jzWyniWPyJCjtrv2xoiNa1Wq",
+	"BsPC6JU73X0Km3K6i+v7LBDUSZtuQF3468g0RBqXf8+azQmQONdj9TFJ00i0LkYbbcZDdu1oUxaLLzpr",
+	"M4HBZon098kWSaONLBNlwIN2XUYNI4KfTUGFC8SMmFi1b6MGyg4pnApDbwo3s8hRfUz/yAqf1mlYjNTM",
+	"KUCkcoo9OJZYnomUWEXRfFtOsEswPvZk55bRMmUDv+KcPqFkWYTZoWcVzHGi8BXxt8TMxJyac7XBNFTA",
+	"Heauyhcgb4FERWnGNmVIAxbWRnmAdAus/yZwUVZoeJJJQF55FRt8ZjxkF3EPGSwxr0IZP4Yl8hs2j9r6",
+	"y1AnFSKKGhjEG5LG5nkyOHpV5yiTYBYvjzQ8T4jRXAQt4hz7qcd0j/fBboRBivBZRwjyRqnA18+U8j+e",
+	"RTYZXyqolAD7GUIB0rZ0wv/58H/cx5IJWfrbvfSL/37+8vfPXn90t/fjJ6//9rf/6//06eu/ffQ//lsw",
+	"oQVAnXIs8D3a1EOOAucoYzLZAJ7imCH7vcPmdFS1egdoatVu6Jjh8xDI6EKMnF16NCyL0Ss9KpykUhju",
+	"+WPVBkPvLutVKvw/kNUsFwPO++PTrzk7wEDCYKFLTGGpB4rxQMfa296W7sXjMfkOIza8ss/VHP5jY9KJ",
+	"YL3j0SNnoQq9kz5Kp9x/Zo+SpQINpWhCFNSVY6vrk2slMGZQvqquexpJda1OoSDPcZzJ6jHM+lAgq+pR",
+	"fz+PPUmAhAVi/lfTTwLAWWz9mIs57NRRy+7wizKxVXGSDEd1nHazrq6Gr+538VP6gF/oDGQLkQ0fl+7w",
+	"IYx5WHiGHpuTY4H8QKfAgj/QqbEAVJkXp9DAN0G9EW3on36SPPv7xecff/LzJ5//hdxE6KDItgmy0ib5",
+	"UMtCTXtTqI+CxhlKqQ6P/pfPdA0Nf9zgbUcB29sscOVxbQ6x7dFrCb7Xx1rHmIOrNgBOsucpVHIY7QmX",
+	"H0LQHqrL72ARF8vLEwUvTTVkkoeKZVsYYLlfTPLlHGa/HJ8QMZA3aKbazk9CjjGSWdpZlonsxVKNHqdD",
+	"N9hOc+Nucn1T70+h9xn3d4/E4b22WlRFCnJLk1cBy+oTeSORN3Tmxa77O0PLGg/MTeLQvlxGDKhYcGXy",
+	"zcdDP78uLW4G7z5eb2B1Mu+UffGRb62nmKMHg4CsMt+vPbvuqq62WIGIPiQa/Vqpr5o2357GaKlkqIjv",
+	"ZKVAENWvkNpMTtOM6kqQS4SOFFVtdPSsSRvgLCQkRFP8w6SEVQMgGxRwqj054SNpqxglCguLpDRLaK1X",
+	"mhOw8CHl32JGKXD2jxJNGVq7elHi/rUVFmjLseqgUbt0lFSp2quqfmVofAKHs5vjocOuYArNfe1uoaRO",
+	"rdQV27DokPH2cRjWN6olC9HzfKtAKNnuHq9Wp8mRq2igANJhpgZnSvgNJ2hkAopk1CmI6B47E3IZB0Aw",
+	"8uymXJDC/mbvRE16DUzneHwOio6efinG0MFTfdAEwEF0fEuPrQPz66p+bo/KN/De7uRKVHfOqcvJdIwI",
+	"Oy+X+K1OHoTnhR+4jkEZu7PQGt/Jgh7oy0HWQNA3Fjyydp4kDYgZ1BCvPSxg/8gAYq4Og4si+8crtWtx",
+	"s+67DyZEEIch4u9jlWLwmefitwWrptxk7oaMyhE+umNxuwLvVOnWoodyPvw9wqT7XdbYCxppKF9vWscr",
+	"cnwNlkHEhGaJlx0ge0yB3/Q9cN9W6/XJ8plydvSk1b4FUSFd5YWK0WehTDw6lyWFOx4jHGQQ+rPFCLQ1",
+	"vTwc0qkuVRE5YfjILZCDI8Le3U/uwd6V+WKWfJysMtjlWfIJx5fOkk9BMK6R082Sz0hqpLjDz1mMjJiN",
+	"9/PmptFEHQjzMM/FOF0w3ilMd65IqUC9xTstaOiZflh4wGd6otEDw1jzQJ+s9O1LilU1i5A6mZlrBjep",
+	"Jd8p2KnFKVgq1lQssrkq0njO2bZX3bJRNdYEVBlWAiRYQMzEUqLAm+7RvYX1NbCyZUSuZfgj4rKtlCvv",
+	"Hb+FjKiHzhxje9hBiIV16k7K++4yuplB38M/nlGo5QkMaXYwP+fB1c2yOTrFMz6uHOQZNrFFCoM/d7QD",
+	"x2pHDrhcl6ldZHvkh1j1rgrxFPthmi0Y4Skxz9EIW36Lp+Oi0wVodksMxFdwOOZSgdJBM9a79UoQsYEv",
+	"SIwOXICRBdbpWabD2WwWNBM/ROpvO4AnApwANrPo6ObbAvvqchTOV+ompYrcTfLhP37EkiZvHV66+UcQ",
+	"S++E0GviGCTWsQ/1tOmHCK47uUt2KLgZTRpuUh3mHUPhQTiJ7l8Xot4u3h4tutLXG6V4U07sVgRkQH3D",
+	"9H5baPe7SJ8JcVKgHQE3rMzKSqvv0dydMbZMBmLXk4IrcDhhNGEnout8C8/Y5Z+XS3I+NtYQzeoPThEH",
+	"OGpKxZF/1FbU/ti2Spw2qZry5KE1UDhsdK7v4ameC7bNjm3stnCG940aGzmGJWd8QVZjQ7BRi7GRMBRO",
+	"218c1fvBe/4mnt+kgbCIGALkmanmbrHr1liPAIKBYOZLIhwq4edSjpMQAxrgbofcok33pfkuhqZn/PZF",
+	"+4N9t09cWWvv7WWluICgvC+QXxkFulxS/TaBQ8c36yyeIMx4GFPKxkkHTbVoSMS33CMwekj3u3UNql+6",
+	"VEUWiPT6gR8n/HhoANpxa7LHItlcJj286ZaSjSIeH7pKI3Em31cSx7HAI4iCuyUQ+XpkZPgPjhBiTkJH",
+	"H5ihaK7gFunxaNm81bGoOXiFKmgwPRDIwtGnABzBgxn6eFTQx6lVJbpT/AcMzRN4FvnDJrmBKSJLsOMf",
+	"tICIJ1w6EXm2fI+9dzhwkG1G2dgIH4kd2Yhb/glczvki35Gu8w9189X1blqkhm71MKAf79yxI8VO3FdQ",
+	"8OBUCrS1AOOoVdtMjar1FvKMv+3tkA/RpHIWowDOdJZnCcohVWZApVFyRoza2sXzyY1w3QnCFao5TApg",
+	"dNvnkEHO3wmuYt4dE9TyU9Stvo4QAxBzvkZllIufu2b7qVTwjAZwXBX96tbX0zb+hzgwxjzB3oceDQc3",
+	"/DhzxSRDTX/re3aaACnonjo98Jse+M/22212kgosuqjHUesSMEJuZIlUpHDwKSHjs9HqSWH62sPjwS4Z",
+	"vs+6YYg5UnzQYT023RUIfdVVQAixXXP4Umd7rhP/KP7yZpGVZTDiZnjqzvGROiMevm3Mp0B5OGPViBI1",
+	"0fLSPnXy6VKnyTOEW7LaBjPf6XZS1I4LhexlnhUSKK+L1kyjYRjiQQWYX8TKMFb7dl2NgqBFfALjZLN3",
+	"Ntdgw4FqcmEtH1CuVVRyqa22kk2jnHuHO5/ChhsYFWfHaFRcpG6CgmC4r6hr+BeWZiKgb+SQ7OfSL6xn",
+	"4gWZK3UHCEZlDswo2Tl+4MyRV1qoQwbawobhe94xiHnoEBsYlnWbEH/QQ0YQgmlNM3YV7nourep0Wy7T",
+	"8c0F0hbUMg2TSEXqFqY7S/6j2pMvS5iu0eXJu8KKMc2ApgczpxQMthhSBeUmGOzcvdtd+N27sucw0Epd",
+	"6Y6W+GIXHXfv8iGomtbjfSfgYsgkHwUuo27Dhq6MN55aKSMfztAfPTQxrnimqCGSWX6dXwLCb80HunGY",
+	"RXYzXjiG5074bTE1ZYuFoloL3SqHFCxCu59vI4XK2uspmHcHnZbUKsuRCSZxYWcOi369YLsNM1Ooaypa",
+	"8taQ7ok37U1h75ZIs8jihdcVev0fZDvspIX1wCYsvYKrD0s71Srb+iiwGT55mdU3d4L9mwJORJ6eos85",
+	"CAEdeWh2XFdwxVZFssMn1LhB/4L1FW2QorpWiz3HM+DvTWBxp9dLveEjVwC/o1cYAOskEVAyVMRFK0+P",
+	"rBXYXeZYeJCG5YC6pS6KGg6c6jrHdfHNC4kIP8XdIkPGqmbyTPltEOeBPIo6C9BU3FkY9fneVtQ+j3qy",
+	"GE+lSK420ZYRSoZiMUWfMA5Pm6CjRYDZQO32K3rb5USM6XTCHrpYigbDmTUfUpEksgWdXhMBL5U2nHOE",
+	"BZp3yTQH6DAV6GwcF0z7VKw6D6Q99GnLFqX5MnaE/OJEEo7F8Y66obUpMDPQybqjVLgySkdbiLXn9kWl",
+	"+FTTKxDRqs2Ekw+sixF/1ZntAE4amxMptJImHk8Vtu19qn5VJ5Qt9WCxsCv9/Egu2IF5lAk6AE2OrXJ4",
+	"nqdV8Vg2BqgmWOgcqYUpOPaMG6CehAViqnO2VinHvUV88/CWBMZZEuTvrGhLWXiNk0QtS7DdWumEm4qN",
+	"QQ6KX6c4dJ0v1XhauBn6K/jusfmMWsGrRUqSVcohiBPHQk1oobi79/ScmHy7VcscvqbiELbPH/qkDYxn",
+	"yTOvole7gY/XchXwOLY8B/bb3pe9IcIRpjhsusxXq+kI40h4/ISzuFJSr0KWFGltqzua4xVG8ZJ93YyM",
+	"c2heFYAPMI452O8mNgQzxWZ3oiETuCuXNmSCseu3ZZ/S6s11GDr4sRNPzPgg1CGv7OPL3Vd7qsllRvrO",
+	"qS49tYzurn/L9EDEkIoFxsyt9mgSsv0F+GQrUcuOanfgjECND3M0iaNBfvyYZM4p0bQWtheYK3MQ1qF2",
+	"0wgwKKPmUKoVGpaqDmsLjB/Rhjs74tZoMEBMSmw0IlcWhAMJqsx2zaZq32ZeMac/iI2uEQDecGpxZE7E",
+	"AFLSm0m0sEMHi2H0JnZqnduHsXLn9o1bhFf7O7icp03+W7Cd+W8EAufle4UuuY2qrYp7sKeMs5hOluc0",
+	"Nh1FafBtSKAPRb05XEjniarrHXoFJIYAS700uuGPi5AjAKOurnUTCiwzw3YdNgWVZwExwrSNpyihGVUV",
+	"OiZnyhLVEwKHSWtUvNWE09nNKLbtaidqlGu3Jhatv62oqxCVeCMMOFji87HHgMMTOKx4IJRtAQjSa9zQ",
+	"2oafAmjfmdJlhs9wKs0sbFL4eagI1RGF1h7T0++k+E8k2W6wSlvs27B54OdI2SF3nklFgW6JX9ptRyj8",
+	"EsO6TlYoYnr4QwCEKRUM9DSTvG9LMXOLwC0q9hynCwtnM40rUxagYyvvStPdJNjm66o+VZY1DzgZoROS",
+	"mkexK1Mem3qN7a372crSPyCAbG33yTGSvqkWOWm5j5a8DybB2dZhdhb0xDRIPQHT6o7bSZhymrFyQoAq",
+	"dmirAbGz5MDptt4v2hdlRgHJnciurnlATHnxEPUH+pVwTHzAGChDAQBE4yZMOWgRCJaNwAoLEqne7Ndr",
+	"vqc79SNelPIWbM6+zPk8UZhRyoxGl5Y44ze32U2yopbnVfKbqkEG2HeMM9t9gxWeMOCds7eoTEW1goVQ",
+	"z3F4+l2O9U1wuGOKUczuSPHhNFxX6Rt+SlVzZfkbqaAbrFz8dqsKathDWpRADooUh4PAP9DnbxN+YlWX",
+	"33yyx5+mNkn/LPLp6FCNtxG3qGJyGi6TBJhMhzUerZ71S5HxooIZaKhowTmh87Lal7yVWqvn3p7akIl+",
+	"Wzrrcyygi5/dT16Ud5Nmk+l6ZvIn/BMt6uV+ixtkn6M6z09fBig5X173gXxULtV1yMmeOxXLP8AMrhuq",
+	"6h6pPFutgrWfONXfHXar0OrTbPLdu6g/ms/DHE4XBJdAq+vyUckVqPH8UD7bjaTJsB72duFua6WWatcG",
+	"AH/qS7j0lt1NpTo5xqQioUH8TJ11A52WaPWSKlRwq6y0tQnWPKnTgD4HTGiaKhysuwuZqKP16YdEHlvJ",
+	"Uy7/5uR2Fhk4BFd3TpO8pv8GxH3wzVfPk3NhmM0HCOo/uYXGibuCj3dFCbXuCBb4OsiDONRx5Cgfn1RI",
+	"9mywGRX5IotErwwAnmfeKwT34smj5+HeHhdU8WCZwBvcqWOWNItqxwzYGmtVuaQE0KYvjOL3ERN2Ztuj",
+	"0dhBCYKmi2yOnlZDYkaCHzI81RnZweG3+xTqM5P41FknkA9LHYEiV4b20GEkQ1tIyzTQ9vdwZpD8lH1p",
+	"IW7E7VHo1tOiRwf9fOlJpz5aex/jfxKMDaFKmlH2ydF0bnbKS6C4ss6BM4sW9wKUlIdqBUIgPr//okRb",
+	"6PkczuqiOQfhof6SqzSfravkvu5hiTFpL8oeLqMNb91S8Lv9HE4ixtYf0s/7xYuf0Pr44sXLXqZ937Ai",
+	"U4U7dtMEqXSfSKUxWypdvvsTNzu1wBRz3n3+enBWv7PFtC7iu12Twj2TFWxsDC8fOBgu3+Nk9BGZrzHN",
+	"ttbKRt6Y/r64v99XIvnV2ZV2csLWNskv22z3EwDyMklf7O/d+1QlcGV8i2OS2eIXOVh46QDQxzSlsoOF",
+	"PJy0cDa4qWu4emONCWH5rcp2tPuc58JVqYqEPvOaZ+liudy30Cyg32C5uwEMx8E9y2hxz/irgY7wuIP4",
+	"iLaQ3kF9wqZxH7tfTv/3o7drpIf8QP9pWFWDJK53RnfqzdaoRencejTvk5EbjgUuGW9dhY24z5JHK+5N",
+	"NPM+1xEYuhew7UiN6oz0TYFDCYNJCan9bpmZ9nU3nhgHGIb1tboUIbUgfl7x50c0oGBFapkizcQOKlGq",
+	"oz4isbrHVsbobr7UCCHL3W6XrItqLqfbkMV9Qxf6m/hBZp32BIc4RBQGDQP0DhgIIIKJP4KCIxaK492K",
+	"9EPLQzOC9CcIdFvQvF93nbHWEREc3dVwxBY9p9YTIExcgZJE3VQraWFGMfIuF9tjcfNYx9pOnvOETtVe",
+	"toTTijZ+7wVvOgzd9y+03n0Taa+OL6e45iClKHyCpELWik4RFz0TJ9dInNHjsjA9E+cF6UGm2o2kE9Re",
+	"Bkq5HgItTMCgZluBQ4PhY8SVbDbUrXehQLqiNsn6LE+SAd5oYC0w4DRsOHrk1B/JWmNDMg5ZzXO757Rn",
+	"PiJzUb7G/23l/wX837Ud0V9b/h89exk0nJDLNrQdVUkC0BKWujY9qZ0muALaB42zQQjH49WKUnHTUCkT",
+	"x8/hXDMyh0L5+G6SsG8ymTxCiIwdsClpjAZOgNU9cYn0ECBLlXO3ZD02pZs5f6twQXMu7oUiD7V8TfNI",
+	"iNlCc4BM6t84QbJeFSbdOXaWIJu7zAoK+qzEOaIHcbibI7Z+6EmcOm3xo5g4O+Aa5ovloDXxVXTMalyZ",
+	"SQMdFugGIJ5X1yn3dAhKvPPrOdJ7sN4ZRbKEDiZQP2Aa/guDUwIzXS1cX2sEljgcGgzHhHedN0Sv9F3s",
+	"NmdghqYdlqZCVNgQyYi93pBLTJyYMnUTr6cZIpcPae9vAUDXniWypVF+R5VUXzzpX+b2VnNi73QpydDx",
+	"jx2h4C5F8DdgmtC9Wan9SNRO4b3l5FtgarUlJ5KWbAfYpfziyJgf5kCezBmJSj/S1blZk1/IF5yP2jFg",
+	"4JNURj9UbeKPQ67BC5lQXwUafKz7I2QjjWyDYVfrKmW7IA/ExVI87E8ClSm2T34a7IENfDJcWif0Vidh",
+	"xtmfENdCPt93owesdaajlycFp69CQUGonCoSGZ7pzxzrE9EJ6IofOXnefnqHE4/7LhxINuosurp2V69w",
+	"fU+rqg3FNbrLfOsroAJhlJeSko84uAR86euGrCJfO2XbO8Kub06FH2jAeJs+xFi6zIt9mF5l3n88xGlt",
+	"SZNmP6cLE2iRwv9NXFKoKkh0ai69Nbjgb3nB32YnW++004Cv4sToZuvM8Sc5F12r+AA7CBBgiDj6uxZF",
+	"6RCDVDWhIWgu0DmnLImhbW9nX9et7HSj1DpDw5jrgJpRr0abl+lE0ZLAGSgJ1W2/iptGJdqSXBq4hWqO",
+	"RM33z9lfT4D15ZqphrOx6BY3aaLbVVQvBFNvbPpVXh4XqMzdJDGMMK2D5vZnG7QeuMmwTQ8Mpj327UkS",
+	"L/fVxX9QN3bdKDnnMpmmrK1GIVxLmGFWWMsFzhoZF1+VcGiMj5ZSxzBwmVIoFy6EOr1ThLfyT+ayguPt",
+	"lBRkOd7FRpNuJfM2VvDJXVJje/l2DF7H70eT6pVPKDs1ZTN0x/WjqIQzFyMRU05q4xg8eTnu/x7M1MTx",
+	"Xe4SsFXGinAGsfYmjxbxzRMeK6qOmfX689pj5j4x5WhRuoav6E1/jRPPBJcHxXXcmhZPvoLkwm82i2ZQ",
+	"fL1xGv2WGPkiqVyZlDZuN8CG8UXLPArbsZiBz7A9U9McUbVN4+xEJziOtdvWk7O6duAa6HHDIHMyvMEc",
+	"vB7hd0ioh50BQYK8U6HIEo5TMeSg/Wz7dlPV+W+m7ZgbzOtJFoHrPu7We37AFJ6cwVecyWIkSz2XOMOa",
+	"WKVN7be1JvS3tWr3dckEQKHMVyTKuIZ0M4RLOIui06f+lp3k/fRNQXtbVa8StVqhJzZIhVOT/cxGO326",
+	"+rvtmEqduP1Bia2nfi312KMJU7pbWOyo8EjBtTg+wsFV5BT6ifIvbq01BvRWFNGast0uX153wh941KiT",
+	"LDvIxxmxjpA+IIONYIC6Xof3k4yxvZ7VsyRbtWS/lxT7VshNX7+d9EaFXSwC+PmnUzkeJ8JTIS+fBUt4",
+	"T4syg6HevtmDDNWRjHd85AA3S/ZlgRwqb7tLfocqKeF2hFK+ugzKlhdlcvH0QfrJX7kASaKEc2J2pkc4",
+	"cEEWxcxUa9GBtNU6gc/qG1u+xRQvcesv95R5LzqyT3cUKBFr5UrPTAc9BFunbOW1JG3pbDftUjwmAoEw",
+	"9jVOFuzqWq1T4gVhIHM37txiyUAs1GORGWYp004NjRiOzdQIiDRJMy5XH5ssJ1JQ/w6QlV/rxA+9kPGE",
+	"fNlBF1EzE4NpoJpCtLwFAQbHwTjM4lwiPjbElcab6RqZQTrG15skDyM7niZx8eUj4+Y2M50dyIs0tXg8",
+	"ScPM9E6N15ER4WO89fTE97VoJVHH5rhQUBTGtuFXM/LxcblPfs9vCs9lFfVjlFb2u0JGlF8ZLBhYFxY9",
+	"Sx4xOesqwxUbJnKyv8PY87w1haDybVYkjIzmrF/xkMPvGUUjlOPEAIbajmDWNzuEWdZxnN3EyHzedzZJ",
+	"ZOiEfLu2VXeqvOEyTqHjbtoSjSbOqqz4h7r5Ed+l5dwx8eLHhhGGhBAZcTKuI7IIVSRwUOCbHyl87hYy",
+	"yqAJ0dg4OyDkSKrBEzhJ5NHDsiKQYOStVi18sukLQrfY49kwXo1wEobwLCpuj+zv4137qAwXg5ZJdPjC",
+	"6LkZ3is/EjNq9eXg2W7w8hHBwXGneGf4EQQ9MYJ/kNFQIibHVXph8QfynAxrn2IhL4k2jikt8JIoLfS6",
+	"Dk5+yzJqWBV+/tXFt08EfHQow6GobbGL6Krovd2fZlXoJq9GTBx0GWpPPLulnc3naGNJvNKfXG2wjlnH",
+	"s43XsBAXczYbfe4J7xSxvArng4+aLSRQnpc4EDCvdiZe3sZycri8HyKfXWZ5oYMoNbSR3G1a3LRzHrwW",
+	"3QFuHWrvcIX0pPdt73SHT4elrhGeNHYf+5loVBMjkEknRZQ02QyV/hwXiNpNKAEuWPkH1hIJDnvOiriO",
+	"VBTB4PgWwCFjSkDdmyYVuNLfmEHkdnQdEgU8NtAXR0iz8U1hGn1nHdJuRmW4AfQLu7pd5k5kIwbLsE46",
+	"Eo+BgGPGQ4zDlKcmm8S/lT9oBMvnhItzNH0RPgKnI5Yi9zUwaFcUlcMQzEbRAlZXVuiIs0ewdBRxmLVE",
+	"1FZRV7OuXfgsITJMfln/ghfU3bsu2d29O0t+KeSBAyD9Ppff6fhir4iAYBf0RCDtUZQInuyPTF2O6Ea8",
+	"XfNhqa6mC/SEOypMFadDQ6KcV6LxfSXou6pzQehSfmE+E8Ro/8C4u874doGZcoSexap+mbRFaQnfJML1",
+	"nRhecg4ibRH/wOowcyWB1wG7zX5LwcppAwCE0zjKeYMiR8npeWS/oJcj4VI44j6PZHuW+9wZa6/TpUfc",
+	"Lh0gnTmCyNSxkzHczSs53/sy/0/Y93yJvWPgUW2aWjgMnMJMJaGnb6UI2ydlYA5JtcPfxqcxEOupbX9D",
+	"Dg0d1arqQTXThuDa2NU3pF9GUoL9gHRT/0jyJVTrhOPfJj4lb9JVXf0Wyvd35Q2Nj5wKW/ymghaH8chv",
+	"O9vg5gQbeD30QqY9FDh+4AMTvt0Zezs8kKwth9fbnY1vaZ26AwcHVh8SRz2wvbAMiq4NdHM6ardNdL9e",
+	"z6Ttjhk03CwEP0k9wpJo5520TPKm6wwlNNvjS1wW3CtmFCYYNwzgnMe3BCMw90qtFdnVXLq49u0KCNOF",
+	"ZQteLhUGhcjHGveNKX3NsydOLrF5V0LvAAbbaK3HYI61EfC0k60D1hhAVOuaAcSYXzRVYJh9eZWVJkdA",
+	"jpJ8jeV4tMPhqqqpv3yjIrZUMumHjQXLRT/FZ5mvcy4Vu8doOjIDcyIb+wa4NAZR0TJvsIi/qQgvqIEN",
+	"uTdzGLLsxjK/zJt8Xih642N+A70btDafh3NxO6xWs2no9U8mvL4BlMKhg08YsYBWY8dhj41OXpyr9gpz",
+	"vu7Rex9/kXxI0SZNfqk+QiyK8HTn/sdfUNIN/3EvdDsv1SrbF+0QN1kSO9G3RpiOSQ/nMZBxy6hhtXVV",
+	"K/WbijOugdPEn045S/Sm8Lrxs7TNymytwpUCtiMw8be0mxSH38FLSS9ht4u6uon5/eCsZcifIuUFkf0x",
+	"GJhODOvYSnJfU22pI7AwUn3Y9HBndDb4bjJw6YeUI7vTKYIdu/Fb1n+CzlVcNWUyf288rBqtM4z6oyK1",
+	"uQ3mFYaI7kIMvaI89eLGCb8i3JC7Nue8bPZrUGO3siVb4r5dpX9FfRr9tsD+zmLgpnO45Xsgf+k5Ox3X",
+	"8CTA3zresTBafRlGfR0hey1DyLdYcLFMt8hRlh+54qw5ldFk3nDaZix3dHjoqUIZjpJGyW3vkVvmcOpb",
+	"EV45MOAtSdGs5yB6PHhlb50y93WYPLI97tAPT78VKWNbUSiC4xKb6xpGnrxSKxhaXVLtlvAm4Zi33Iu6",
+	"mLQLt4H+3UaJaZHTEcv0WQ4pAl9WAdMB/Mh0qMMqpazf1JAbNLLAAySDuQw16wSZvH0+epoqGOFEuXA4",
+	"D+bF4RONB/qji4g/QlChzeWOR92Q24RXF1JokGSW5rmbY518ydGeUwincwo18fxB4y6/3OfF8kdb2ttf",
+	"4Rzut8UmGEA9xw9/lrQQt48q34EhEkP3QamK4HAsb/6s5dKA5PxrNXUekBImvtvBkiy3szgLuA+mBkpP",
+	"iOjN2wIncLHqV002RbtAeADiwPdMsyrnuDqM3+7VA23O/LvKilANWmQEG3qmu/PJB25zjVDoNMAXUn3N",
+	"hLY6wFZlzZ5LNTVY0BFLCUnpx3yrJI2yU3lbl5+0DY6Vf7fZJcbDH81a7kvN/k4ZyZmuqD2D26xdbFj/",
+	"xmOcN+F64phyFq3Vus0WGyxqg4Ur6WqWt229GuxnmbmpYgZCixeCBGtP7HczdPoU1OZypa5SQkFC/rWr",
+	"FEFMm122UIfUwIxXA/LpwIPtzCk5VL2iG5YacyLj3Jf80U2g9FCkRCkD8DJIrFKvwHQ+eEDew2D2jq1t",
+	"oF+WwF62R2gnPAcf9ZqKnsWasEyIlLcTip1IH1BbZWFycLMZi2TcvOy2P/AKva0ro0BYerHdA4Ayvsuu",
+	"sZQLh1M8qJqwioNNeI5ZJ37nLjLcBsZGQtM8oY1+WN/U+/FKtGQKMOVol/SRLTybfENFVxEwt6k526d0",
+	"M0G/Wcd+V1RYVBbHwbCmhGflbzhdCk7pfL9ek3nG561BB/j05iW6qGykaOf0cYarCEq/JeSssObtLpQZ",
+	"jm881y9QcwU3YIkMNy52zpKHbDNrtEVGGnBRk8waCwSb6URro5sK/9G2GUXdtJUn8MUvYk1e8d4hup+w",
+	"viutqd6pSKPvRyZ6hJu9wGiSWuJpq9BieJVjj7UN/KzrAXSPsrkFpfWCvzygo5Ip5ZDWztJ04nC0a+B0",
+	"KuAAZB3EH2iK4IpB02mSz/MzrkYUIMr2uvQH63aTk8L9utVm8p1Yk0HJrMock3NugooDFVifFh0ik1hO",
+	"MR5/o4+4nNDA4QrQq1MgSrAo648zwmeRMk7uU9xUpg7+s0VeTC6UNZbQYs6GkgJuT17odAaQIVXN4ctI",
+	"RF6maR0IfwmFwdlMoAPJiNI3IiYtynv5XgyeVCnxVc7d/ARtoo6yjwKLGyK1lxguv8asbl5PJ3v2J/zm",
+	"jDpAAMQvz76t1vkCNp7G4BhEKhFFAbf9oS50+K1coPjuA3xXuouan73AIZ4UvpVJg5lKZof7Atp1GUVw",
+	"KMBFRxw4yDXju6MNkNtg4gjdp0hoWLoAqELt6B7uEYaq65BC/BUXPKAS8fiGNGgPNucBYTlwPaEIbdSo",
+	"wAWxCF4JtDF0XiPfwfsoWU/v3ubGMwWkaHa63naobgdhRAmtUc8R30Ygc+koF2Ec5gWrTmIlZ30okLod",
+	"YeIBFuTTccwkBPnmP5SqRIhaUoikJEmyWBZmHMi4U+CVjY6pnq6nmM+pH/ehN1GsPPp8D9Jgizn6oWjX",
+	"L+lpQk+T5Z4kB+wJvtcqG9aQXlC7L7//WSC+lydCSX6/HZhLv3DL6UAbRKvsdl4EAgwfmocwj95hKr86",
+	"v6H/H6ZBSmjvwdnwOgZ3eVjTv352fzhfNV+kWJR3OiboTrk9OuzUxxG6/f6klA7D+oC85a5Hg91hnT0K",
+	"8bev8OJw2+v0Yub4ajE9e0jJr+i5roJrklA7dquMibY3p2xeYMs6wOsXg4DD5RfJSnB6PWV8v7KuHatD",
+	"sYgW2staqdkMqxxkQdE6uBxUyhVvCYqwzygWSMpxpPi49/VxJWQW0eBcg1CdH9AH6B864zDZZbkEBVlm",
+	"0cesxGDHc5OHDp3d4O4ipEBe1I/wtVJfNaA4BEWv515PSuwVqNsESqVVt/sCd5rOtasQaZ/yWMpOQaBA",
+	"3QGlUviT4nkPAWIWa4c5UHi9GQsvlapFAr72QHXa2Nnqa51lT4hc9lZroArtDdvG4YRWdTvFMso1BBlk",
+	"iVPjUDH7GnsPNf2EeqTqZ5MZfteUfyvjrrbqn8Cu6yxl0Lr7j8toMRfpgkvP3W67Erg0kyaL6jKv9jrg",
+	"TIeLa6MI/yqFib2uuhEOEMzCeNduysEaCdgU07PQ/uNHTi7gshV/ABdrb9O7LZsD+h4baO0rYgTquXoi",
+	"Zh1PLpzSITrUjFi0I20t5svVo6Vec+ceWT2cIhD38AFAP1oeJDKGGlrf4VFeRneA2rnHCpHSM67JLj1J",
+	"yMjLuKCimJGTtMjrBdrZ0V0V6yMPI/mdvcxHVXlfGsBhOBV9P0tMir8UYzfg4IUEe/GKDn6nkQm1fwje",
+	"RXgJ4FeRVEtpNtOdAqC0MEdSbao29ZqhTERAIIVCokDdwXw3qbf0aevugycdY+gaj8SD0BudvI8oiOF5",
+	"K+4McgQy5Esz4/D4x61m4hzlsUsovdE7IoI8hRvylRqc+Mi1lROWJoSTFupSFaNr0613SBB05hSXvbSM",
+	"Hp4JSXTS4XNpesoB1BPUUYnaWQlonMBN5/tWF/cKzUjZe3WniMAhDYVMM1Hkp0ONMg4jLbIAbYa3tiuT",
+	"9RizwwrjzGuUb3QPeORAds5QmLK71Nihmc4OW7eKi8LghZevNy01gAZBeanqJyMNrm1Ta+Kvu6ox1XxB",
+	"IMDBpOzxhoY7m5qI2C+U1htLO9YvAXT0SzgB9LVSh7Tr5uaqHD72vtF1/GCYfE3pbz3U1BpIqaJIgGf7",
+	"eXMDWvE2qLvohxJ+UvA3Oj4WbV1kboQF1nB6823AyaZKemc4HxJl/NzKQ3beuSqqK36FzGJ0nCjrBWG5",
+	"XclKM8t9Lle+pVglilHC+CTtfMZwreuyuSkX41naerGzeIThdxhUvHjoQtZH/JZecosvej2m+xFrA6Nx",
+	"BTpbCU9Wz1MErWOTtkxApDhaNC6gV1aTus6BxCvc7qVJ6BKqgSUxMpqH8pMhxuY2+8qQYWULAoAewZi7",
+	"IrM2p2ytHWphn6aqczVq5uG3XGwwKhqLCcqMw95XizahflDwQ5EBVUfu0CZ+HJ97B8Nb631tp8GQ5wJO",
+	"jDYwUMzEz1IKUttudJWVA8oguiUQ9ZSCv1myzvZrNUs2sE5yOMwQvfIUN8FhDcOnx5131j1LZlNcJMmI",
+	"oXPm9Rf6R0gk8czW/a4Y+2bs2EVz1i9MGiiXv8CSMihR1hS25RcRnFzJiepJg8g63CXnnxhIYPnGTIca",
+	"SM1J2zQnNzUc9scVcbcADTWxGYTHCYq9NTixOkaA/w+axKMGbuYVq2ByTINUwgBJP6kuAh+LjZLMF8CA",
+	"pgzCgk5r7PSjCHMJms7p+XTkXJok0RJk+0ANTImV6Y+cCz+NKTslCMPFGOq75/mpfBYvk0A587FWPLHh",
+	"AlyCHjjdQ0PMolMmGeQjX2edmVsSHlBnHTL/57XJRhFnAStvPCVce8uowyMWo0JikpbK6XzJaCggb3ft",
+	"4dF80wabHH8Xi9Bxal/JLNQmlrDEvU41O8UYQM7YqvixcqxHDF/eGDmDeqfClSH1sVN5D/er9gLWm/1i",
+	"AZQD6NMUbL6iMWhsM4L/9rpqHRqg11cZhqrJ2yHkyRuuq0Ivli85nvuOHBFOsKJPwr1wNUDB4hcdBhhc",
+	"c1gouA5yVsdkbEeDMQAJnj1ZI0VLJGMTdnt2Rat4dg/ws/12m9U3IyuH+em12DnGymGUlmBCUP9INz/Z",
+	"14Jn51W39wv5NcmdSYbvmc24lBPkUOsRd794Ls1tN9hQSLpACYAgnC5Fp/O6CTnOUH0JrikKnrQItxlQ",
+	"19o4oUvP7aUDugDjl7vTk0xX2OB1subMlhFc5+l6YQ1cySPQuL1yxOvcxJsgHdui62CSOB1qLHEPK7Fy",
+	"FpiolHuLY6LaJsuprAZZor3rPKye8lWdorpTpMTKbyb0NqLX7SVBcrRvdCXf7T0tOMiVd3TfpSGQHMLo",
+	"bo5trHMaQomKbV12F+Q2It25Pzh7Ht4Kvf7gbaJU/aAqS7WImWQW5ilyqIyS9obzCAdLmj160q1qVqst",
+	"olXZfbdThisRZbtsnhd5GzVVmLCxlaKuDdjtCySVTjlKWonEaFK5Ktgv4LevFOVtZWUJuFwow0n+neJj",
+	"aFMRa+nXemyxISeSvEKBNvKIytQQBXBpIrJB/E3SCg6LSbRISQHmbMDmta87+Yj6Q7obGwU/LCNmmOWe",
+	"Y4dR2S6AptLFlKpylDthUUrllrJEguq4KQcW06ERxUKEreSxlhPmVd7QF2GAdGZYZKl5RmZYJqiZUUH2",
+	"7boie+0wIZHQA1ucxu1rCDyRAL8pRjW9UksjITKKlN4hrGDEbBZxF2XY73KNBwO9PZfKYJK+oU0ss7KS",
+	"jZy4at/dwE3dJm2ufhuExhvMR9HQOK5E431DpJwdmQJG9YCrpsl3NlFLZ3zFTm9YcKdGHm19k673MfHH",
+	"vJN88wOI8bfZUEfon3haHC3hKFxSH71JU9F9dcQc0TsqxITC10qJV6ajLcVDfx9y2rmotnjB0LdegDzm",
+	"+nQDCVGo5CaDxY1NW5xZwU5+043WeZYif6VsZ01JEiUvuLwxUjU87hfs9VzTfXm6QK/MzLktltfvpdDf",
+	"eC6JiP0x0Ik7reKnKe7yQcN+fuK/V1R5D+Faqbpm5YPuCuy9keI9b0tix+AYQgWXGjoKCZFKS9R7AYET",
+	"D3LIYMWuf0//Y6R2FogiR4bQ1STSRAU5mXMI2Q/4uW4eoFuFjSZ3GHpNRyMQdJlElNc7SHSpHjm1GrhI",
+	"L3VgaqDZk9OKyu/xpXtoaT265ixuFsKPak82kH3Cht0jclByYEp1qhNS/fU9wmd+kiSc7uV+IaXfnUNr",
+	"8nQmL26AzQXTNxb9VXb0V6fKOmg/537dg0AjOvGuM+hag/QpZfpWTczKaUJwr08C3rtMaIHZQKVNI/bl",
+	"R7DTC9tGIMTSXuXU+920ViK/71J94J9bikz6kFLvTJL71eaGh90A7hRoEB+dJQmmxGBxSZ3vnjsQ9CYv",
+	"P2iH5r+mWZd7ykrPJNfm7EUZworFQoqMIFaGZelYrbEkyi1RAjpXUWH3WpTvLAj3kwqFQJQJZsDWy1Qi",
+	"zGZ8UUgd0VnCGVeY3jrDqAU4X1gTgoJ6scHwFoMbZlrfgKsr1cVjCgBe4Q/7RmFJFrz9qrSormYMxWqP",
+	"TSQxhOpKFQWp8yxkkL0idfoR1lx/IFJjhkSv+pa3lx5m+M6CS2B566l4kOGJANGRiyu7ok6NOFzwJhwO",
+	"/+5n9XcEUuegMhRBGbSuQOVUD7JduJ3kBV4E+IZrzUgW/DoZwpbAFkMh0ssqlOTs2tdklATrQaMCyYn7",
+	"Onyouio5sz9sTjtQvZeprGqPNjPNi5sy2zWbKhY7GmF2z03ckbcYduzgCZ1JCnhYrY6IM05fAB/2SGvB",
+	"PCYX6aoCnEFCe3g/Wez2s4TSLWem0pSpPymhGOdYDGClv7EB2RuV7ZA7wA0N2AN6BIaVl1jfjszXMNwW",
+	"rq/rSMPS36K9Sn9TnZUuDc2RhbVVtFlXG/qFgsli8TFtFstjooJb7j7Ru8ykNEk4BbnUrlpsJih9ROQO",
+	"MWq/cs51MHDVGqzI6SOzwUU0cVcrCviUd4mxbY6iNosHNDL5jKp2DGAFd7RtLVMz04Ec0gUQi+d0bSsD",
+	"SZhpkW/zNlY0jOphm+DNQpVrNziy031+KOIBc8nzZdigHzcr0L1nSk/R3e+VJSYcCJnEDIFDPMiQnBkM",
+	"W79Pw5665my/ofUQXuzSQ2sr1Kp1u1gSDrEdrIgj02V4oYOvrqmkebgCE8gFsd7O8ASzVI3zpru9DnDe",
+	"hXtMqI8kSqaRNGA3YHiY5gxQ4T0yEzGyjyC9A+YI623RGaaMPTWqPzbEhFiOUW5sPZ9Gae4ekClcGSer",
+	"dikTdUjouOme6gpUCLKJd4xopuQUQXaf/yfyLUINh0lkXqlpX9ViW5a1GDclSgC6cHBoZ1ielzH1aDTy",
+	"XG1yrgSJonpaBXtKd62PHrP32a/HIL3LyrAZOby909On8i5NOlWfaL/9rRi69CwvOfzkuIwtc1zDtAW8",
+	"HZbDdROQDzQ/85Sx8LQwxT03Gt99SW6g4C7Y0ayGTbI/oYQo/h25iLTOJY5cUb5Yr4NNMloVforCJpkO",
+	"bO0hU96NBDzEU4rKIb6N8dXyJuJKraq6ewqR0LVgaE5LzX4T7cQaJ8aFdKcZJgLyXvzAVSlHCl12algC",
+	"cnLSIthwggZ8UkW4sBfmULDRFG+v5b5Qy3jX3VGmKrEInNBmRqdGXgwMtgRZZREvhJkmtfgZns984c1R",
+	"KrVspBKABmJoxrHQBj0u7rn+QuqmofHhyqLOKknA0BabFKO2Oplibgy+uqY4GNqu8FLNZro1/ASexq/a",
+	"5xfuNOOmWBGfwiWHFilWlERebgKFPJMLfoU3d4kl0fCMyBeoayAvw5qC0zFgaoemYpCMmYYClU6dMq4Y",
+	"obhaEYlzWxmvHrLEM3ZW81h4iu1x4D7XGRYGecbOqAMUJhZjiJSqDVVl0AgbkjL6gGJgR5NwpAdwu5n2",
+	"jmiizZuRM0C+P+ZvYzNzsErpja9Q6sjagbEPKvQh9308xjkSeDPAhwzHm8aHIj2Jpb5jl0E5PKR7oOMH",
+	"0d1sfwN8lA2F2TxV2Pnpqfo1HmfjB4/+yjGBolPU9DmRkY7kwMrCcPIfPWRGJxetdyGLI9UkBNFtS6/b",
+	"hncVsxTfSNeVKqYZgWVt9/tW1kDBG+YHU6F3jbTRwSYvL1B0vlwV+aId98VJDW2B0oVB96lhOUdD4khD",
+	"5czcN/xczyr1rIPDNnHvIQEcCVLeKCc4uarzdY7BUM644s57BfehFGyw7xvi86r2tI0qVmYFJk5Ieof5",
+	"IwQ8+AH86LH02x2shINR8Z1Iq2f6vK/vRtEeyqYNT8iBdNEe0zbm1M6SCxVSIN4gCIFYGUbIsJgT21U5",
+	"VvrQiAKK7APFIArEFXy71iFQm3jgrLSEGJYC2DEQsXiy02CAkI4IAx+x8YXDQnjm4xTuKYdq2tr62sOS",
+	"vJqywQaZskiZOniBIH09UTUhqFzELM6qkFBNUh7RGo7uNx1uTiXFammlKN3Cr7Q3kzUlN0JaL5VIuwYM",
+	"1nzl54HmOVpPHSZaMae7404LDXelUH65Tnmxox3unVB7/cVwvlk3sHngPjgyFHkE5OgeIA8dj1d34McX",
+	"b4uoBbK3zAOqI+/FWrkamghtmtOnp+K/O8CGTsEzrvcb7yBBncYT0xycy0BnidQJTpqiCjV+OqodOo4V",
+	"OYXObARRq8opXbkNGDJ4EAPSBGG0z4JpsSBtEyjARbdZ6NsMMHmaLXXWpR1K8ymoLIFr89PGQPuZKPO2",
+	"XwPKqkStNxT1C4ykRv3HfhGmXgYKm5alUoAhVFl6hZItGgOpdzu8CNtPgumeY2Y5jtNiITzXouKg21Al",
+	"sQIpUkQ/Cc21zsc1tRZ2bE/Mwux8M7cRBejdFFqeFDCUpEae6RrkprGJlIPfZjty4dFfKfzF8eQmoVKz",
+	"cJgvr3WJ9fDyMACHa9GmFDO2Hg2wks17jt9wu2MeBwZJGcEp10MOEAlFEmBmE7ysd4Nf7m8H0ShV6ekZ",
+	"JqORxOhADVE+oTjruvV9AHgzdD16netOAOjSzDMn5wPJKu+FkEUkI7tRgVw1s6eNRFXzlut8RHNYvHmo",
+	"NaWtH007qL9pHPrLKBUU1B0bIy1EhEFnBuZJZhC99Qzxd9kuVpVSpWjIiW4ELYsi2fC1gGTOu8zqhw5x",
+	"044ZKRRHtmHypdpfpGe79OSd9RsecOuXeXWNfQAwvf4VHIvke89AhOfK0mADoubAvtJa8ejX+VJNxR+M",
+	"R5F0j8130hhgyKcW8AdgGxHZkYO3UC6DXrnEwIbuS2Kiajl2upnj9g+WlkfkEGFXsMrEyuVYCKkxDSal",
+	"exhKNFfUpmWTrze4V9ga4jH2yQRpRO08i98SK+DgnZVcPHlEVWS5TsYEQcTB+oQ7dby45EV/n7rb5F+v",
+	"YRfnBTCrttrmizDr+3M1Vom2Q+mzk1DBJXvjabcXV1TRZRQ0c+szwyiz6ykoOGKk47VO9elc7MzDLGym",
+	"FSTZ3/hOd7rXcpcWT8yKdA3BSyIiPHqYcGCZaYVd+mu7Ucwjs+vN6MvnFiUeZEP76EoEIZLmL/jks6xB",
+	"MqErhvpbGCudFaITOeGS4EeyA/6TrRmdcW0WXkQEDnB6ltzFvzEBAIKU21MjLZBo40r/+lprqzVrWkSt",
+	"XUAnyqjUPOR2sOEIJwcKs5FuAVTv/jYAfsiJATM618VMX+v6+Uc2Gewo4F8PU7l3CcS6stjLHqVK7Msi",
+	"2Xgxzh7sqTLcwoTq/Br5dbSRiYn7mihQOwDEW5t4MExqcHIoGBxdmmYBJD8yuS0zJwpeysu7RmopxMM3",
+	"8iLjy2PDkasYZwriNHrY8AJDNcqtX7jL2o0OkNGxB34GGmYzSUrvb6quqDD3cubUl6KcQjKzeYH61Y6L",
+	"YfqWWnLic00QTPGUbxvzMdw1akfVJEPKRzdt2LVedkPXeO2p0wxjCnaDWQ6MWIkDHklhCNdUKVM+Js3U",
+	"o4QQXebLvW/pbg6VhP0UHTzKEwQaA+vLaZziYCYRXtwQixhtPkQ0HzyXZbj3EJ8JTjQyqZM029Kox0yE",
+	"9mQ3u+yqjKfzhII+tf1huvbkIPYr+JzkDr+5zu1xwnkjCXWiG1uDY3g4eAGSi+Cfgdtkl0WJdYhWYQTJ",
+	"8XoYVNyfG0W8o4eTE1aXQfQV8pmmLTEfb9Ffj77XsEnD3gAH1IzoFIzom7ynupIE5jRbtTEXVqeCtV4W",
+	"fRHmh+Nxvc7MQ6EbkamdaLbj5nbSpoZW3tnYU2PAhWIICyNg3BIb0cx2H0G9DQthMbimScdP24RG6cA5",
+	"WCJNbW52GNTQ5jZRlBiZL+q+gcOFkuJAxnl4u26zIzLbCEJ3u2FkWhSVnQz9qegkGyxX5HqlblgARAuk",
+	"CIv8hCPbWBrkoBN69xUIcWL/4jcizViXE0oKRCLC3OTpKQ4s3XWLWoN20pJjEQ42K5kzVnQDiWrX2vo4",
+	"nfIFh4hn8AllPnsG0/Fevoy5UQLhhOYJN15GdbGEghmvMfpxb0QHPf0z2/3s2PtxEQnu/hojPRwARDdm",
+	"SgyfbbSgV4tOORVnjCMuVnOtTHaf3pbs8Xu2PUSqN0vl5n475IRgSH5Z/4JW5Lt33Y2+e3eW/FLIAwcl",
+	"d+8GGaK9xyave6hRlXEG6V0YCU8Kn4cebuLFYJ1jgk6Vv7NPZapwqEO6hObJLUNRd38OYZDvlyGJSIp6",
+	"uFVUOKprQBY6IiREABkSiqKQDIlDx4DClV2Wg2VdIieSLoM0L6eFmeirw8nMjBhledxq3x4ysJMZGB16",
+	"RPIwUp6/Pw6Sho/Ul9X1tEsHTZrHnpTJXJTVOZgJO0uU6046gttlKloLDb/Gp1N60gXTpIfOmpssjRMN",
+	"qhtVNx0Av8DApaUqVDSnlUAYOmVdGIZ1jSgQUs1oWrg6c+xo1wyPnIYlXeyxSCZEQ1QHyrj0iQEfQ3by",
+	"1qaOqOu8af9EFPjOOjoikPT4D9C7MU5sQ31tLdFhb9zHbuBDKOaUOK2T64OGYR2RJd8GHDJc0i1vAgPk",
+	"jbVIt3i6nCRA5zXsmyCpRFSrCks0LrGSm/M69iYEYsWCtlfZTXN85BtCW+OejgW/USgODqpN5KEwOKq/",
+	"xoBgzi5522ORWxMirjhKqB9txc4irBYfDLDq70rYcpNdYwBeSrbf4YoHGH7HJmIsK4zRHhh9c+A88Yoa",
+	"ehrqoiwJRLA6nHXaFJOjXOx2jwa6cM+HfNVaI/PRfsewIjymlHe0TqOVd3XPP4AJ6ZXu7H2EqSIqr9lB",
+	"h7nZY9rHB7BgkIBvHlRNGysf7O638XbqjpD0VK7ZhQwWSJuSJ4NT6JconoTOpLWnyCvLarFH12A2kL50",
+	"64Vw+Ri7lLFWhHptMvkUtJP/5ocyVjNFbgF23+cl35PIqZCHSsIkcXnN3KkgtzTy5ZUEgn4W0URdCu8x",
+	"zZpWXhKqRptyEtCjQqUXMhI5IBSW3rC248aHHBCU6UW+hwIyOXpHR0AdEKRIH36rv8Oh2LuXktevGWiC",
+	"aGlHYrHYYdsruNh1FzJ+3RpSB/izOQoGy1uwIzgIniQa8/3nT2sKnOE4k/Hv2XDCIO2q3bSqvKKkSDSO",
+	"gOoDGS0CZWJtopXPJfWhccPDbXq1vQ4+aMSie0wpT76f9Fyjllo4h8MsokOEgTgiTdiaO0qcnE5BdUIG",
+	"Mbq78MocOzF1mP2bL53cf/kslPZa7LeR9G6M/0gp/iPh1zpQYcuESJRLMHTdCfzjwmQkUTSOcd0uIZwX",
+	"mZdqFFRq5OCPh7NN4feMCwFfphvZ0SlG925fchZGJtlCZhTwuPcUcBZqKapGLYOlMcRxERFjtFtDWpdo",
+	"j1bd80ky9Msj3Byu/z3ASYzltokaiRtHAO3GvXNIFCkXkneE0DtGkUOhdZ0nIXDJEqZD8YeMhvodAVEQ",
+	"6GZasESCZaVZg8R/g2rVHAN215gdAJ3SA6IKvI9LsYgQawHdBPNoT4FdbSAc9XNpqvXoo4d9vaYJ53JA",
+	"pb/w1QPvYLbhDAVyiFotl68d0jZ2RWZdwo3b6Qzf1WnTR4X8H3Kwde6I67s8/uzG/ZO0PxEYxMtEtpGu",
+	"O9Ue5Oa4QzoE0gChuxZDeu04Ih6anfeZSnfBgre7eCI3Pe6Qh62bd1AC96QyDqOEnJdnyUNd58AXaGkX",
+	"Mb2evykp0ltRrjURL2WyJJFC9sPqfzDqLWLw8WOesWRiVlDgqsT6UaEME+E2o/2Gw6m5rR/VZ1QvKjAG",
+	"AiPFpV5lN/EKU6nOIGjDUD7RWRQ0ss4IQBAayU4nqEUuZyWvsYYLmsMV0A4kza7eGWqqA7tY246ib2Yx",
+	"Cc1iW42+ueVIIfzwAjDdiCKfAcpherOx0ZpUArQGmltI19Ol3o9YYCzgM1CCXgJAT79V5rS8iQ2afPKf",
+	"xHJcn0/MZ3WCfbsyVmDPiF01e10njWyEKlvWFehPVI7QVSW1ZZ97ag/4JSU6l3ttBJO3+6uh2URB75ur",
+	"gz7BSLxldY2FGgdLpToVqNArhS9iZcNlfESy4xw65EAFAKd1xTTTQWjz9M61dCSXIENtRuZqxjZlcMLw",
+	"3qAzpmnzomCAZsbVgRck1pihOiR1JT1CBxJB0AUwDcWEXiwN4GsS/YD1kYmmo4OndDMEA2nNsJPFUpCx",
+	"yS6VgBiLmiA/BJpRm0ONuLg+nQJOpY87VuWjeZhnIp8Shtc7670T2D9AfeJ39z68PR18BVWdCrgQLuXH",
+	"YIfQi14lk04eHFtHZAzTNqokiwKyplql2iQcrIQz2iLXKZp3TFdc0Il3oViYH59+nfAzJ3G0Ws2c2hRU",
+	"LZXmrlc6H+jtu84jndsRfnrkIghVb+oakBVvH1BTTSkNBu0RwPs56Adu7B5tqy6rqe1FpljQW15AuG3y",
+	"Y6eN8DjYNoT7Ih7cf6Xy9aYd7LAqVcXQ05NJzh1P6hUc8jtFjweJy2nQVNXdNI0DA2GQY9iGR+FaJl5y",
+	"KnVumsxZMYCChta+zJBASwA8CR+LpzZNMbMqBMnfS5LAqJoOpgXR3aez/Lpc6Tub/TfaV4sg0R+MgOeW",
+	"nLfvGev6O2IyHXL5ziDFWUqUErzlD+2Hs0CbLulskcSCtGjKIOm76t8WX6KF4+/UQLZ5QEcEfb0RpxZA",
+	"WdVykijTFGZpseoRqu8F0rdUWOOOtByeQmqNSzh4qOrLd8FQv8Y02QvCh1o+jRtpOJ3Wmmo0khmVkcCx",
+	"sdBWbA0+Ye5ONcfTTI0K3aUq/xnhkhdUqAyHkjzMnv5NwUVojq3Wzu2OiSfM11h0+fgvyVx0M/h+kTfd",
+	"/M4rkkznypTVAIEgX93YQsUOnRyxTpS4jifjlU6XTr63rhgqq7J2aj7bI/qOmUrk5AapPER9PbII4G+E",
+	"R4GmBZA9yYCbLPJdFkL4hXf05cIl95btXLbJOMJzjsW4F1zuDMjjRr0D6TYmSIjMItTuTnLbtipR0WJM",
+	"YqA9oB2s8e99pFaPhAVavEr0DZVn51rGnVPXc22ASo9+jzSGHK7Wo5HTuYWkwH6OzirpeDpX1tki15bn",
+	"jzn85G+ZFNOdpcUAOuC0XOY4DW1co+sFXLIFXRrGAdnN8ALjN+kqmK7HDp2NUMPHQXC/9/oo143vyDqW",
+	"STLfju7lP51NtNSzxRJP6hr92rZrgbPHvKnxwPMxoCIek2/tZZgJ8zIFDm+FBN7rKBK42oh/2JvQWYoW",
+	"hp8EQD1l00Pc0ueUR0zf4gLTycyO3DOa4Z2EDvsu5g6TiZzpzpnpkrPTMsfbYYvwztpD3NUN/RxRiEzk",
+	"J7bgqvsxr9jlog4EBopCHw/rnxgL1kEjq5zxUNR+UOvU5dE66OhjCfzeOg+KYxtSRe3ahiF7/tXFtyxU",
+	"9pEbMd6+ePFTO3/x4qUYUc3Hkba8oc9b/JwRgi+ZVM6PfwGReUVXSpXcvUsTYConv/rLJ/5jPAN374bj",
+	"w/OQEAVT73OcGh/3AD/qwGkjpyRo0rxBirF25S8xAPSwioRzEIqWWMD5fUnCIaROL6bM1rG2k0zR7OfE",
+	"LAdLLI+V/sSIWiwMjwpRuAyot5vTTnuQeqZUPhool9nHXrjqEWcGaMRI4SNJNKCo7T7JcuYaDhqTg8eq",
+	"mPfHpAYjWto1+abd9tOxqtC36H9tp3faEVBOHfz2L9LXOlpU7PmReAlfCNePAttO/ETyz9tO/U62ILmz",
+	"6qoOHb9jLFdOE1voAPwIGxeMUkamtUSbSsOByiaJIXBH7vNiOXZ8v8SX9GxY6kOVqsmbn3GlP8/hVoHv",
+	"364FT0PAGZ998YlhpRV2Q/q793uE+TBiAmv1Jnemwh3KWwyW0Btjgz280Hd3c8LFURsMe8rbm2eIfx3I",
+	"kP8c9P58A8DU5FVjY6tNTBSLW1u9QiWBCx6vzdv7Rtv0vqlAx0ErGOdLlmj7wl5nX11n210hOQzJ3z6Y",
+	"/5v69K+fLe99+vG/zf967/N7C/XZ51/cu5d98Vn28Reffqw++evnn91TH6/+8sX8k+Unn30y/+yTz/7y",
+	"+ReLTz/7eP7ZX774tw/I0wogM6A6AfT+nX9P0aOTXjx5lD5HYC1OYNUgJAJOKGpgVXE8PCB1QXwe3bEF",
+	"vCY//U99WZ/Bauzw+lcUb2p8fdO2u+b++fnV1dWZ+8k5uo6BU7XVfrE51/NgE2JfNnnyyFyvbDihHbUZ",
+	"D7SpQgoX9OzpV8+eY+zomSUYeHbv7N7Zx+x7VyUsFX76lH6i07OhfT8XYoN/w4vngLqi3cgfsMt1vtCP",
+	"iPPKv5urbA3c9+xX7pyJP11+cq6Nmee/i3Hp9dCz82wOQvaC4tHI4zLwphM4Cj/bv9J8OTIHXjLNhFfg",
+	"B6l2MDygmB5SF6RpH4xDYvshxd+pFepKcO5aTrmLv+nGsjhD3wmmgX6jdNBTw0nK/c5WlLojo88ocoCN",
+	"JLs6r5CZkH1yqXC9dPTJCodpxPtykTkWOEVGluS7i3+n1Gj4f/K35N5MCuU2ZAMITX/2oqSwCDkFeFMi",
+	"2P3igc2XNxfGuGnTqIHp9i5vA1ukAzMIUcu8waZSBOs2u/5bDNJriWnGcUGYppxBYRDwGRoG6LIL5X69",
+	"nvV7cZh60leO0FdLWURdexq47/969vh7FF3Ej/cEM620AoIgo8FR5wLNYHtWGRb6xIXhlzGI5bJ1gVaA",
+	"Iry3RJPZNusdzOXIDVbC6K8GeH/SKNyLlvJzmtbKlLKkVa4K7o/K6J1JeTH5nXMUpRTFWfI92+jkIVcq",
+	"E1OZ9BZZoqXVTIn8boYZMxtMOfqFWyHV5fIXicm3DRNMbAxhlhERRRNNH9rbODIubP6yDarQ4yOYzsVi",
+	"qNgKDpSs7cxnxSAUbUCuefn75399HZD7XuIojGti/J/cu6dvO/FgOSztXBi7M1En8rR/6qinpxN6K2bu",
+	"TtAs/AtTIBqnPABplboPW0eXrHapVw13MNi3P6MclGApYZGHjlbCtYFvGD628EbYi9OZbkIwSA8ZQQhe",
+	"hgQ+d2v1yX2/u/8au9uXH2HKlmPJYDPs/WvYrgukaA2F6V2O7roPmr7dIPmPak9SvqQjmosJLh+8ZIz0",
+	"QGWS9ZzS9tdiyKn/TU/u3u0u/O5d2XMYaKWudLdGfLGLDqxWB8v/7EBWNhjHVNdV7ZUfHj07hwzX26zv",
+	"smt2eqGvEhBZpqVaw2SXKnHMtZ/d+/hPu8JH0use1RpWv+CVz//EW/YI44qwIym9yav59E+7mmeqvswX",
+	"Knmu4Ns6q3NgBT+U2SVovtSchD4gqbHP/n4oqbGuRgRaFvYg6qFiQhpFZngO8AMUadH2NsJ/uozH0TqI",
+	"i2aYf/vTHZadXDcdxhDdeflaK0QTVcah187n1fUBr6pm6su6gmDqpHPqDwe00e6jc0yJZeeuvMIdxs9/",
+	"J+fk69jv505kePQd6R8UecgWlthjCtHjd8472n3nTROAHn3DU2N/x76xr7tjLtA4vN+d/07/ILuJs3Y8",
+	"lTUM4KBpqeb79bnUuY/+juPt0DTsbwO/xK2GU9MN3BulaOHkSYps/8cBcpS3QJk8JwPu+e+hx73t9X8P",
+	"z2zwqcd237jcwq1/ni0vpeVu54FsSbVaNWQ8GHp8/jv/3wFPXYPQl1Ntn8L+ygZ8PAv5lgth+Q9QJStu",
+	"+j/flIvgj320BB6eM/P0XyDBwsGYbcLp/3BO7U3Of6f/ve4/NhkLnd9BBG5uGhRQz383/3a+9+1s8IPD",
+	"09yT4f18niO/bmNPd14L5fAr1KvWW7n/gmHm4ce/e3/6hDz2JtLEAPSBD0BLrpWzJzvlcb5ms2+XcBc5",
+	"v5TZrtlUzhwUR8IRy/TvfRN+1iOk0Mv75ny/W9fZUvV+v8ryFpWNlA4GFwPtD9qqrDiXZmadX5d5g0x+",
+	"O+8/qW9AtnZ+RIGq6f59/jtaENy5OlrMTipp+da0p9nVc687S81u4S8rMvzG5JvrdJ6XTCeOUGL9Nfyw",
+	"b5HoiSJUwgAr3ulA+4DK57qH8Y9StVdV/apnI3l9S3NH16c9xTHnwonX57j7jcadotM5++I0Z7f++Deu",
+	"B/Ug+jJbJjpsIE2+ywr2rCYXom172HjTOsy7VzresZbw1sT6L/Xhw+CoOrvy7TF1ODyHg9rpoE6R4dFo",
+	"gwxgrbDZD5FYOgcelIpdFKYFKebO6wBzO6egCpfFdajWgr/lxP5gMEoTXIqkzktUh0B2lnyFNUd5rWgk",
+	"W0q55EgABqaxUO7CVel1jk2MKEkp+6Wu8mTqEu9qEDvK1nbeaLjwF1vGtSOfKxwbqzpqCFyjVOJSsMiM",
+	"Mck53WRoVIqbRlObw1dmg5cEBcZMvikOo/FY9NKtdeL4wMEraUKQ0pu8e5w+ckfFQk1sAxdvARewMHai",
+	"nRonoKljYRSMDdPYH+Xu4gXUmhze31n/gndWiEhj7P4NXF0OD+vdXRi8himUW+lwFPTUPxXnM8t/67U4",
+	"QpUi1k7iMyfbdKVm0/VwqdMlP54ln+PKP7nHuQ8NXx4aBParcklFCW/TMUYFIDQk9dIFR0XtpIXzvihK",
+	"J1tqi73Ra7XAMdhqwFMi+MRCvGjTjc7i44A2jKPodefDL3UtFw8JWyCTHEjVZnJoraJQ5brdBMA/C8UX",
+	"fK3UV3pPTsrXDZojuaHK2Qlin7B9XmwFrSCrsViGbN/UcFl3TcFaZkC1k2qLWVLh+oG6UmXWxnvjwcKG",
+	"G+K5NIBY+JCkH9nXjxJ9aek45RclCIOU44ncM2ttALOmVjm/GAfRVotJ3ji7OR467AqmXJNfu1sop3Kl",
+	"rvgc0vXJ29ecvb9r/lXuGnR7eEfXZ6DC+zRFwlqRgetAzYm+DT+yCwOcmrCp5yRhX3/sWK/3IV7vQ7ze",
+	"cYjX+8iq95FV7yOr3kdW/UtGVr2PO3ofd/T/ZdzR8QI5s3iJVYmL3tRO27d4sGcvEy2BZBZtqXdem2EK",
+	"oRZQvQ5Cc2zRlrdnCRpVsCQXCU2XCisqLaiKt5ScBwawpZoL0kPi/osy7ZT23MrEH9p/st7/Yn/v3qcq",
+	"ufdR9xupimp5c/9bEv7pkcKtg79f3HlxpzdSrbaVaWxCry/31M+Gvxod9r+YcR/3s2WxgCvVYtkAgSu8",
+	"+Jr9agW7yijHlo1JtnYcM1TApqzoCfBMAE4hz8VywrqOcI4lyGDxvCt4nRMgITWmLwE8sls4ps1cdMgl",
+	"nDeAhHdg0sB/vzNJyP9XUln+iUehUdx6EfOPnQZh2O0OgHGj+LTSUas1NqypqSUOR5YlzsDSFrGpUD0m",
+	"c6NJxb54+iD95K8JefHsXDJGbM08SsofhdQQ223vtnrIEDvvU+3prorBsXv3xnu++Tb45jvnnH/2uH7H",
+	"xfgvKUh/du+zP+2C3GCq7+Em+pps+7cTOJudWmBIdcicebwoWeeX4gsMh7NcLJeHxuH03HW6ta8JaEDo",
+	"87bj/OTzCjzzKquXjfNcYIQDXGQ3WLJMZASyFz/hh8+vn9LTmTSbWkpnQrji2P/nN52psf/XrnV72wfz",
+	"kRtQIWygDQWEeiE1N0mFhr85XKFbpbMtKyz4nDFrkpZS+O424XBYrnq/5UgfsiHeRws2VhlpKY7GVCV1",
+	"lz1LxOVEw+Zto4rVzHFgylB6jlq7qLYdz6zuEKW1IL53vqJuUAaXz2wVHtt8pxeko1//EwR08m2tUVrc",
+	"vPGAGtyysQ4IHbpmwjX1dcK1XvAOz7eRjohvKoZUljO7bTCpXrANzLFdUqaiBfsEvA9EfaeXZw8CYQSx",
+	"Ql6oikm7ufc+4NOYoIhBTw+QNXxP32TOHcrH7E0HzepecBEvsn58Duy38XOdht87/13+5SaH2NJJbiki",
+	"MnaYIkQ/vURtlu53sYPYyjr3z8+pseAGJKLzO6jX+1V33IcvDd5+1wq1lqlev3z9/wCuN7WFNQICAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
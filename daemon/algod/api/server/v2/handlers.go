@@ -24,7 +24,10 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,21 +41,30 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	specv2 "github.com/algorand/go-algorand/daemon/algod/api/spec/v2"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/appwatch"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/stateproof"
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tokens"
+	"golang.org/x/exp/slices"
 )
 
 // MaxTealSourceBytes sets a size limit for TEAL source programs for requests
@@ -66,11 +78,31 @@ const MaxTealSourceBytes = 200_000
 // become quite large, so we allow up to 1MB
 const MaxTealDryrunBytes = 1_000_000
 
+// feeEstimateTargets are the numbers of rounds for which fee estimates are provided
+var feeEstimateTargets = []uint64{1, 5, 20}
+
+// MaxTransactionBatchGroups sets a limit on the number of transaction groups
+// which can be submitted in a single batch request
+const MaxTransactionBatchGroups = 1000
+
+// defaultAccountResourcesLimit is the number of resources returned by a single page
+// of the account resources listings, when no limit is provided by the caller
+const defaultAccountResourcesLimit = 1000
+
+// maxDevModeAdvanceRounds is the number of blocks that a single dev mode advance request can produce
+const maxDevModeAdvanceRounds = 1000
+
+// maxLedgerTotalsRounds is the number of rounds whose totals a single request can return
+const maxLedgerTotalsRounds = 1000
+
 // Handlers is an implementation to the V2 route handler interface defined by the generated code.
 type Handlers struct {
-	Node     NodeInterface
-	Log      logging.Logger
-	Shutdown <-chan struct{}
+	Node       NodeInterface
+	Log        logging.Logger
+	Shutdown   <-chan struct{}
+	TokenStore *tokens.Store
+	Contracts  *ContractEvents
+	Profiles   *ProfileCaptures
 }
 
 // LedgerForAPI describes the Ledger methods used by the v2 API.
@@ -80,9 +112,19 @@ type LedgerForAPI interface {
 	LookupKv(round basics.Round, key string) ([]byte, error)
 	LookupKeysByPrefix(round basics.Round, keyPrefix string, maxKeyNum uint64) ([]string, error)
 	ConsensusParams(r basics.Round) (config.ConsensusParams, error)
+	Seed(rnd basics.Round) (committee.Seed, error)
+	LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error)
+	Circulation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error)
 	Latest() basics.Round
 	LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error)
 	LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error)
+	LookupAssets(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64, createdOnly bool) ([]ledgercore.AssetResourceWithID, basics.Round, error)
+	LookupApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64, createdOnly bool) ([]ledgercore.AppResourceWithID, basics.Round, error)
+	LookupAssetHolders(aidx basics.AssetIndex, after *basics.Address, limit uint64) ([]ledgercore.AssetHoldingWithAddress, basics.Round, error)
+	LookupAppOptIns(appIdx basics.AppIndex, after *basics.Address, limit uint64) ([]ledgercore.AppLocalStateWithAddress, basics.Round, error)
+	LookupRekeyHistory(addr basics.Address) ([]ledgercore.RekeyEvent, basics.Round, error)
+	LookupTotalsHistory(minRound, maxRound basics.Round, interval uint64, limit uint64) ([]ledgercore.RoundTotals, basics.Round, error)
+	AbsenceProof(rnd basics.Round, scope ledgercore.AbsenceProofScope, addr basics.Address) (ledgercore.AbsenceProof, error)
 	BlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error)
 	LatestTotals() (basics.Round, ledgercore.AccountTotals, error)
 	BlockHdr(rnd basics.Round) (blk bookkeeping.BlockHeader, err error)
@@ -102,10 +144,16 @@ type NodeInterface interface {
 	GenesisID() string
 	GenesisHash() crypto.Digest
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
+	SubmitPrivateTxGroup(txgroup []transactions.SignedTxn) (relays int, err error)
 	Simulate(request simulation.Request) (result simulation.Result, err error)
+	CreateSimulationSession(owner string) (simulation.SessionInfo, error)
+	SimulateInSession(sessionID string, request simulation.Request) (simulation.Result, error)
+	SimulationSessionDelta(sessionID string) (ledgercore.StateDelta, error)
+	DeleteSimulationSession(sessionID string) error
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
+	EstimateFees(targets []uint64) ([]pools.FeeEstimate, error)
 	StartCatchup(catchpoint string) error
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
@@ -114,11 +162,30 @@ type NodeInterface interface {
 	GetParticipationKey(account.ParticipationID) (account.ParticipationRecord, error)
 	RemoveParticipationKey(account.ParticipationID) error
 	AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error
+	ExportParticipationKey(id account.ParticipationID) (account.ParticipationSecrets, error)
+	ImportParticipationKey(secrets account.ParticipationSecrets) (account.ParticipationID, error)
+	ParticipationKeyRenewalStatus(id account.ParticipationID) (node.ParticipationKeyRenewalStatus, bool)
 	SetSyncRound(rnd uint64) error
 	GetSyncRound() uint64
 	UnsetSyncRound()
+	AcknowledgeRound(rnd uint64) error
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	AdvanceDevModeRounds(rounds uint64, timestampOffset *int64) (basics.Round, error)
+	PeerConnections() (telemetryspec.PeersConnectionDetails, error)
+	StateProofStatus(maxMissing int) (stateproof.WorkerStatus, error)
+	WatchApplication(appID basics.AppIndex)
+	UnwatchApplication(appID basics.AppIndex) error
+	WatchedApplications() []basics.AppIndex
+	ApplicationStateChanges(rnd basics.Round) (appwatch.RoundChanges, error)
+	AccountPerformance() ([]node.AccountPerformance, error)
+	ProposalAssemblies() ([]pools.AssemblyReport, error)
+	ReplayRejections() ([]pools.ReplayRejection, error)
+	RegisterAgreementObserver(o agreement.Observer) (unregister func(), err error)
+	OptimizeAccountsDatabase() error
+	AccountsDatabaseOptimizeStatus() ledger.AccountsDatabaseOptimizeStatus
+	Snapshot(ctx context.Context, dir string, withPartKeys bool) (basics.Round, error)
+	PendingAccount(addr basics.Address) (node.PendingAccountState, error)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -170,6 +237,32 @@ func convertParticipationRecord(record account.ParticipationRecord) model.Partic
 	return participationKey
 }
 
+func convertParticipationKeyRenewalStatus(status node.ParticipationKeyRenewalStatus) *model.ParticipationKeyRenewal {
+	renewal := model.ParticipationKeyRenewal{
+		State: model.ParticipationKeyRenewalState(status.State),
+		Round: roundToPtrOrNil(status.Round),
+		Error: omitEmpty(status.Error),
+	}
+	if !status.Successor.IsZero() {
+		successor := status.Successor.String()
+		renewal.SuccessorId = &successor
+	}
+	if status.TxID != (transactions.Txid{}) {
+		txid := status.TxID.String()
+		renewal.Txid = &txid
+	}
+	return &renewal
+}
+
+// participationKeyResponse converts the participation record, adding the status of its renewal.
+func (v2 *Handlers) participationKeyResponse(record account.ParticipationRecord) model.ParticipationKey {
+	participationKey := convertParticipationRecord(record)
+	if status, ok := v2.Node.ParticipationKeyRenewalStatus(record.ParticipationID); ok {
+		participationKey.Renewal = convertParticipationKeyRenewalStatus(status)
+	}
+	return participationKey
+}
+
 // ErrNoStateProofForRound returned when a state proof transaction could not be found
 var ErrNoStateProofForRound = errors.New("no state proof can be found for that round")
 
@@ -230,12 +323,268 @@ func (v2 *Handlers) GetParticipationKeys(ctx echo.Context) error {
 	var response []model.ParticipationKey
 
 	for _, participationRecord := range partKeys {
-		response = append(response, convertParticipationRecord(participationRecord))
+		response = append(response, v2.participationKeyResponse(participationRecord))
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+const (
+	defaultParticipationSummaryWindow = 1000
+	maxParticipationSummaryWindow     = 10000
+)
+
+// participationActivity is the activity of an account observed in the certificates of a window of rounds.
+type participationActivity struct {
+	votes        uint64
+	proposals    uint64
+	lastVote     basics.Round
+	lastProposal basics.Round
+}
+
+// tallyCertificate adds the proposal and the votes of the certificate of round rnd to the activity of the accounts.
+func tallyCertificate(activity map[basics.Address]*participationActivity, rnd basics.Round, cert agreement.Certificate) {
+	if a, ok := activity[cert.Proposal.OriginalProposer]; ok {
+		a.proposals++
+		if rnd > a.lastProposal {
+			a.lastProposal = rnd
+		}
+	}
+	for _, vote := range cert.Votes {
+		if a, ok := activity[vote.Sender]; ok {
+			a.votes++
+			if rnd > a.lastVote {
+				a.lastVote = rnd
+			}
+		}
+	}
+}
+
+// GetParticipationSummary returns the participation summary of the installed participation keys.
+// (GET /v2/participation/summary)
+func (v2 *Handlers) GetParticipationSummary(ctx echo.Context, params model.GetParticipationSummaryParams) error {
+	window := uint64(defaultParticipationSummaryWindow)
+	if params.Window != nil {
+		window = *params.Window
+	}
+	if window == 0 || window > maxParticipationSummaryWindow {
+		err := fmt.Errorf(errInvalidParticipationSummaryWindow, maxParticipationSummaryWindow)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		return serviceUnavailable(ctx, fmt.Errorf("GetParticipationSummary failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	partKeys, err := v2.Node.ListParticipationKeys()
+	if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	latest, totals, err := ledger.LatestTotals()
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+
+	activity := make(map[basics.Address]*participationActivity, len(partKeys))
+	for _, record := range partKeys {
+		activity[record.Account] = &participationActivity{}
+	}
+
+	// Scan the certificates from the latest round back, stopping at the first block the ledger no longer has.
+	scanned := uint64(0)
+	for rnd := latest; len(activity) > 0 && rnd > 0 && scanned < window; rnd-- {
+		_, cert, err := ledger.BlockCert(rnd)
+		if err != nil {
+			if _, ok := err.(ledgercore.ErrNoEntry); ok {
+				break
+			}
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		tallyCertificate(activity, rnd, cert)
+		scanned++
+	}
+
+	response := model.ParticipationSummaryResponse{
+		Round:       uint64(latest),
+		Window:      scanned,
+		OnlineStake: totals.Online.Money.Raw,
+		Keys:        make([]model.ParticipationKeySummary, 0, len(partKeys)),
+	}
+
+	accounts := make(map[basics.Address]basics.AccountData)
+	for _, record := range partKeys {
+		acct, ok := accounts[record.Account]
+		if !ok {
+			acct, _, _, err = ledger.LookupLatest(record.Account)
+			if err != nil {
+				return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+			}
+			accounts[record.Account] = acct
+		}
+
+		registered := acct.Status == basics.Online &&
+			record.Voting != nil && acct.VoteID == record.Voting.OneTimeSignatureVerifier &&
+			record.VRF != nil && acct.SelectionID == record.VRF.PK
+
+		a := activity[record.Account]
+		summary := model.ParticipationKeySummary{
+			Id:           record.ParticipationID.String(),
+			Address:      record.Account.String(),
+			Registered:   registered,
+			LastVote:     roundToPtrOrNil(a.lastVote),
+			LastProposal: roundToPtrOrNil(a.lastProposal),
+			Votes:        a.votes,
+			Proposals:    a.proposals,
+		}
+		if registered {
+			summary.EffectiveStake = acct.MicroAlgos.Raw
+			if response.OnlineStake != 0 {
+				summary.ExpectedProposals = float64(scanned) * float64(summary.EffectiveStake) / float64(response.OnlineStake)
+			}
+		}
+		if record.LastValid > latest {
+			summary.RoundsUntilExpiry = uint64(record.LastValid - latest)
+		}
+		response.Keys = append(response.Keys, summary)
 	}
 
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// missRate returns the share of selected rounds which were missed, 0 when the account was never selected.
+func missRate(missed, selected uint64) float64 {
+	if selected == 0 {
+		return 0
+	}
+	return float64(missed) / float64(selected)
+}
+
+// GetAccountPerformance returns the proposal and vote performance of the accounts tracked by the node.
+// (GET /v2/participation/performance)
+func (v2 *Handlers) GetAccountPerformance(ctx echo.Context) error {
+	performance, err := v2.Node.AccountPerformance()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingAccountPerformance, v2.Log)
+	}
+
+	response := model.AccountPerformanceResponse{
+		Accounts: make([]model.AccountPerformance, len(performance)),
+	}
+	for i, p := range performance {
+		account := model.AccountPerformance{
+			Address:           p.Address.String(),
+			Rounds:            p.Rounds,
+			LastRound:         roundToPtrOrNil(p.LastRound),
+			ProposalsSelected: p.ProposalsSelected,
+			ProposalsMade:     p.ProposalsMade,
+			VotesSelected:     p.VotesSelected,
+			VotesMissed:       p.VotesMissed,
+			VoteMissRate:      missRate(p.VotesMissed, p.VotesSelected),
+			Recent:            make([]model.RoundPerformance, len(p.Recent)),
+		}
+		if p.ProposalsMade < p.ProposalsSelected {
+			account.ProposalMissRate = missRate(p.ProposalsSelected-p.ProposalsMade, p.ProposalsSelected)
+		}
+		for j, r := range p.Recent {
+			account.Recent[j] = model.RoundPerformance{
+				Round:            uint64(r.Round),
+				ProposerSelected: r.ProposerSelected,
+				Proposed:         r.Proposed,
+				VoterSelected:    r.VoterSelected,
+				Voted:            r.Voted,
+			}
+		}
+		response.Accounts[i] = account
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetProposalAssemblies returns how the node assembled the most recent blocks it proposed.
+// (GET /v2/participation/proposals)
+func (v2 *Handlers) GetProposalAssemblies(ctx echo.Context) error {
+	reports, err := v2.Node.ProposalAssemblies()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingProposalAssemblies, v2.Log)
+	}
+
+	response := model.ProposalAssemblyResponse{
+		Proposals: make([]model.ProposalAssembly, len(reports)),
+	}
+	for i, r := range reports {
+		proposal := model.ProposalAssembly{
+			Round:          uint64(r.Round),
+			Start:          uint64(r.Start.UnixNano()),
+			AssemblyTime:   uint64(r.AssemblyTime),
+			Duration:       uint64(r.Duration),
+			StopReason:     r.StopReason,
+			Considered:     uint64(r.Considered),
+			IncludedGroups: uint64(r.IncludedGroups),
+			IncludedTxns:   uint64(r.IncludedTxns),
+			IncludedBytes:  r.IncludedBytes,
+			BytesLimit:     r.BytesLimit,
+			Fees:           r.Fees,
+			Excluded:       make([]model.ProposalExclusion, len(r.Excluded)),
+		}
+		for j, e := range r.Excluded {
+			proposal.Excluded[j] = model.ProposalExclusion{Reason: e.Reason, Count: uint64(e.Count)}
+		}
+		response.Proposals[i] = proposal
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetReplayRejections returns the recent transactions the transaction pool rejected as replays of a transaction ID or
+// a lease.
+// (GET /v2/debug/replay-rejections)
+func (v2 *Handlers) GetReplayRejections(ctx echo.Context, params model.GetReplayRejectionsParams) error {
+	var sender basics.Address
+	if params.Address != nil {
+		var err error
+		sender, err = basics.UnmarshalChecksumAddress(*params.Address)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+		}
+	}
+
+	rejections, err := v2.Node.ReplayRejections()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingReplayRejections, v2.Log)
+	}
+
+	response := model.ReplayRejectionsResponse{
+		Rejections: make([]model.ReplayRejection, 0, len(rejections)),
+	}
+	for _, r := range rejections {
+		if params.Address != nil && r.Sender != sender {
+			continue
+		}
+		rejection := model.ReplayRejection{
+			Time:          uint64(r.Time.UnixNano()),
+			Txid:          r.Txid.String(),
+			Sender:        r.Sender.String(),
+			Code:          string(r.Code),
+			Pending:       r.Pending,
+			ConflictRound: omitEmpty(uint64(r.ConflictRound)),
+			LeaseExpires:  omitEmpty(uint64(r.LeaseExpires)),
+		}
+		if r.ConflictTxid != (transactions.Txid{}) {
+			conflictTxid := r.ConflictTxid.String()
+			rejection.ConflictTxid = &conflictTxid
+		}
+		if r.Lease != ([32]byte{}) {
+			rejection.Lease = byteOrNil(r.Lease[:])
+		}
+		response.Rejections = append(response.Rejections, rejection)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // AddParticipationKey Add a participation key to the node
 // (POST /v2/participation)
 func (v2 *Handlers) AddParticipationKey(ctx echo.Context) error {
@@ -262,6 +611,172 @@ func (v2 *Handlers) AddParticipationKey(ctx echo.Context) error {
 
 }
 
+// GetParticipationKeyreg returns the unsigned keyreg transaction registering a participation key.
+// (GET /v2/participation/{participation-id}/keyreg)
+func (v2 *Handlers) GetParticipationKeyreg(ctx echo.Context, participationID string, params model.GetParticipationKeyregParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	decodedParticipationID, err := account.ParseParticipationID(participationID)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	participationRecord, err := v2.Node.GetParticipationKey(decodedParticipationID)
+	if err != nil {
+		if errors.Is(err, account.ErrParticipationIDNotFound) {
+			return notFound(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	latest := ledger.Latest()
+	proto, err := ledger.ConsensusParams(latest)
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+
+	firstValid := latest + 1
+	if params.FirstValid != nil {
+		firstValid = basics.Round(*params.FirstValid)
+	}
+	lastValid := firstValid + basics.Round(proto.MaxTxnLife)
+	if params.LastValid != nil {
+		lastValid = basics.Round(*params.LastValid)
+	}
+	if lastValid < firstValid || uint64(lastValid-firstValid) > proto.MaxTxnLife {
+		err = fmt.Errorf(errInvalidKeyregValidity, proto.MaxTxnLife)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	tx := transactions.Transaction{
+		Type: protocol.KeyRegistrationTx,
+		Header: transactions.Header{
+			Sender:     participationRecord.Account,
+			FirstValid: firstValid,
+			LastValid:  lastValid,
+			GenesisID:  v2.Node.GenesisID(),
+		},
+		KeyregTxnFields: participationRecord.KeyregTxnFields(proto.EnableStateProofKeyregCheck),
+	}
+	if proto.SupportGenesisHash {
+		tx.GenesisHash = v2.Node.GenesisHash()
+	}
+	if params.Fee != nil {
+		tx.Fee = basics.MicroAlgos{Raw: *params.Fee}
+	} else {
+		tx.Fee = basics.MulAIntSaturate(v2.Node.SuggestedFee(), tx.EstimateEncodedSize())
+		if tx.Fee.Raw < proto.MinTxnFee {
+			tx.Fee.Raw = proto.MinTxnFee
+		}
+	}
+
+	response := struct {
+		Txn transactions.SignedTxn `codec:"txn"`
+	}{
+		Txn: transactions.SignedTxn{Txn: tx},
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// participationKeyTransfer is the msgpack encoded body of the participation key exports and imports.
+type participationKeyTransfer struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Participation account.ParticipationSecrets `codec:"participation"`
+}
+
+// participationKeyTransferAllowed reports whether the secrets of participation keys may be transferred
+// over the connection of the request: it must be encrypted, or local to the host when allowLocalPlaintext is set.
+// The remote address of a plaintext request being no proof of its origin behind a local proxy, the local
+// plaintext transfers are only allowed when the operator opted in.
+func participationKeyTransferAllowed(req *http.Request, allowLocalPlaintext bool) bool {
+	if req.TLS != nil {
+		return true
+	}
+	if !allowLocalPlaintext {
+		return false
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// ExportParticipationKey returns a participation key with all of its secrets, to be installed on another node.
+// (GET /v2/participation/{participation-id}/export)
+func (v2 *Handlers) ExportParticipationKey(ctx echo.Context, participationID string) error {
+	if !participationKeyTransferAllowed(ctx.Request(), v2.Node.Config().AdminAllowPlaintextParticipationKeyTransfer) {
+		err := errors.New(errParticipationKeyTransferInsecure)
+		return forbidden(ctx, err, err.Error(), v2.Log)
+	}
+
+	decodedParticipationID, err := account.ParseParticipationID(participationID)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	secrets, err := v2.Node.ExportParticipationKey(decodedParticipationID)
+	if err != nil {
+		if errors.Is(err, account.ErrParticipationIDNotFound) {
+			return notFound(ctx, err, err.Error(), v2.Log)
+		}
+		if errors.Is(err, account.ErrParticipationKeyHeldBySigner) {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	data, err := encode(protocol.CodecHandle, participationKeyTransfer{Participation: secrets})
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, "application/msgpack", data)
+}
+
+// ImportParticipationKey installs a participation key exported by another node.
+// (POST /v2/participation/import)
+func (v2 *Handlers) ImportParticipationKey(ctx echo.Context) error {
+	if !participationKeyTransferAllowed(ctx.Request(), v2.Node.Config().AdminAllowPlaintextParticipationKeyTransfer) {
+		err := errors.New(errParticipationKeyTransferInsecure)
+		return forbidden(ctx, err, err.Error(), v2.Log)
+	}
+
+	buf := new(bytes.Buffer)
+	_, err := buf.ReadFrom(ctx.Request().Body)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if buf.Len() == 0 {
+		lenErr := fmt.Errorf(errRESTPayloadZeroLength)
+		return badRequest(ctx, lenErr, lenErr.Error(), v2.Log)
+	}
+
+	var transfer participationKeyTransfer
+	err = decode(protocol.CodecHandle, buf.Bytes(), &transfer)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	partID, err := v2.Node.ImportParticipationKey(transfer.Participation)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.PostParticipationResponse{PartId: partID.String()}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // DeleteParticipationKeyByID Delete a given participation key by id
 // (DELETE /v2/participation/{participation-id})
 func (v2 *Handlers) DeleteParticipationKeyByID(ctx echo.Context, participationID string) error {
@@ -305,7 +820,7 @@ func (v2 *Handlers) GetParticipationKeyByID(ctx echo.Context, participationID st
 		return notFound(ctx, account.ErrParticipationIDNotFound, account.ErrParticipationIDNotFound.Error(), v2.Log)
 	}
 
-	response := convertParticipationRecord(participationRecord)
+	response := v2.participationKeyResponse(participationRecord)
 
 	return ctx.JSON(http.StatusOK, response)
 }
@@ -344,6 +859,272 @@ func (v2 *Handlers) ShutdownNode(ctx echo.Context, params model.ShutdownNodePara
 	return ctx.String(http.StatusNotImplemented, "Endpoint not implemented.")
 }
 
+// CreateSnapshot writes a consistent copy of the data directory of the node into a new directory.
+// (POST /v2/snapshot)
+func (v2 *Handlers) CreateSnapshot(ctx echo.Context, params model.CreateSnapshotParams) error {
+	if !filepath.IsAbs(params.Path) {
+		return badRequest(ctx, errors.New(errSnapshotPathNotAbsolute), errSnapshotPathNotAbsolute, v2.Log)
+	}
+	withPartKeys := params.WithPartkeys != nil && *params.WithPartkeys
+	round, err := v2.Node.Snapshot(ctx.Request().Context(), params.Path, withPartKeys)
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedCreatingSnapshot, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.SnapshotResponse{Round: uint64(round)})
+}
+
+// loggingResponse returns the runtime logging configuration of the node logger.
+func (v2 *Handlers) loggingResponse(ctx echo.Context) error {
+	disabled := make(map[string]bool)
+	for _, name := range v2.Log.DisabledSubsystems() {
+		disabled[name] = true
+	}
+	response := model.LoggingResponse{
+		Level:      uint64(v2.Log.GetLevel()),
+		Subsystems: make([]model.LoggingSubsystem, len(logging.Subsystems)),
+	}
+	for i, name := range logging.Subsystems {
+		response.Subsystems[i] = model.LoggingSubsystem{Name: name, Enabled: !disabled[name]}
+	}
+	if file := v2.Log.GetAdditionalOutputFile(); file != "" {
+		response.AdditionalOutputFile = &file
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetLoggingConfig returns the runtime logging configuration of the node.
+// (GET /v2/logging)
+func (v2 *Handlers) GetLoggingConfig(ctx echo.Context) error {
+	return v2.loggingResponse(ctx)
+}
+
+// SetLogLevel sets the level of the node logger.
+// (POST /v2/logging/level/{level})
+func (v2 *Handlers) SetLogLevel(ctx echo.Context, level uint64) error {
+	if level > uint64(logging.Debug) {
+		err := fmt.Errorf(errInvalidLogLevel, logging.Debug)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	v2.Log.SetLevel(logging.Level(level))
+	return v2.loggingResponse(ctx)
+}
+
+// SetLogOutputFile makes the node log to a file in addition to its log file.
+// (POST /v2/logging/output)
+func (v2 *Handlers) SetLogOutputFile(ctx echo.Context, params model.SetLogOutputFileParams) error {
+	if !filepath.IsAbs(params.Path) {
+		return badRequest(ctx, errors.New(errLogOutputFileNotAbsolute), errLogOutputFileNotAbsolute, v2.Log)
+	}
+	err := v2.Log.SetAdditionalOutputFile(params.Path)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return v2.loggingResponse(ctx)
+}
+
+// RemoveLogOutputFile stops logging to the additional file.
+// (DELETE /v2/logging/output)
+func (v2 *Handlers) RemoveLogOutputFile(ctx echo.Context) error {
+	err := v2.Log.SetAdditionalOutputFile("")
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+	return v2.loggingResponse(ctx)
+}
+
+// EnableSubsystemLogging enables the logging of a subsystem.
+// (POST /v2/logging/subsystems/{subsystem})
+func (v2 *Handlers) EnableSubsystemLogging(ctx echo.Context, subsystem string) error {
+	return v2.setSubsystemLogging(ctx, subsystem, true)
+}
+
+// DisableSubsystemLogging disables the logging of a subsystem below the error level.
+// (DELETE /v2/logging/subsystems/{subsystem})
+func (v2 *Handlers) DisableSubsystemLogging(ctx echo.Context, subsystem string) error {
+	return v2.setSubsystemLogging(ctx, subsystem, false)
+}
+
+func (v2 *Handlers) setSubsystemLogging(ctx echo.Context, subsystem string, enabled bool) error {
+	if !logging.IsSubsystem(subsystem) {
+		err := fmt.Errorf(errUnknownLogSubsystem, subsystem, strings.Join(logging.Subsystems, ", "))
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	v2.Log.SetSubsystemEnabled(subsystem, enabled)
+	return v2.loggingResponse(ctx)
+}
+
+// ListAPITokens lists the named API tokens.
+// (GET /v2/tokens)
+func (v2 *Handlers) ListAPITokens(ctx echo.Context) error {
+	if v2.TokenStore == nil {
+		return notFound(ctx, errors.New(errTokenStoreNotAvailable), errTokenStoreNotAvailable, v2.Log)
+	}
+	response := model.APITokensResponse{Tokens: []model.APIToken{}}
+	for _, t := range v2.TokenStore.List() {
+		response.Tokens = append(response.Tokens, model.APIToken{Name: t.Name, Scopes: scopeStrings(t.Scopes)})
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// CreateAPIToken creates a named API token.
+// (POST /v2/tokens/{name})
+func (v2 *Handlers) CreateAPIToken(ctx echo.Context, name string) error {
+	if v2.TokenStore == nil {
+		return notFound(ctx, errors.New(errTokenStoreNotAvailable), errTokenStoreNotAvailable, v2.Log)
+	}
+
+	buf := new(bytes.Buffer)
+	_, err := buf.ReadFrom(ctx.Request().Body)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	var request model.APITokenRequest
+	err = decode(protocol.JSONStrictHandle, buf.Bytes(), &request)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	scopes := make([]tokens.Scope, len(request.Scopes))
+	for i, s := range request.Scopes {
+		scopes[i], err = tokens.ParseScope(s)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
+	token, err := v2.TokenStore.Create(name, scopes)
+	if errors.Is(err, tokens.ErrTokenExists) {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errFailedToCreateToken, v2.Log)
+	}
+	response := model.APITokenResponse{
+		Name:   token.Name,
+		Token:  token.Token,
+		Scopes: scopeStrings(token.Scopes),
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// RevokeAPIToken revokes a named API token.
+// (DELETE /v2/tokens/{name})
+func (v2 *Handlers) RevokeAPIToken(ctx echo.Context, name string) error {
+	if v2.TokenStore == nil {
+		return notFound(ctx, errors.New(errTokenStoreNotAvailable), errTokenStoreNotAvailable, v2.Log)
+	}
+	err := v2.TokenStore.Revoke(name)
+	if errors.Is(err, tokens.ErrTokenNotFound) {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errFailedToRevokeToken, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetMetrics returns the metrics registered by the node.
+// (GET /v2/metrics)
+func (v2 *Handlers) GetMetrics(ctx echo.Context) error {
+	descriptions := metrics.DefaultRegistry().Describe()
+	response := model.MetricsResponse{
+		MaxLabelSets: metrics.MaxLabelSets(),
+		Metrics:      make([]model.MetricDescription, len(descriptions)),
+	}
+	for i, d := range descriptions {
+		response.Metrics[i] = model.MetricDescription{
+			Name:        d.Name,
+			Description: d.Description,
+			Type:        d.Type,
+			Subsystem:   d.Subsystem,
+			Series:      uint64(d.Series),
+			Enabled:     d.Enabled,
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetAccountsDatabaseOptimizeStatus returns the status of the background optimizations of the accounts database.
+// (GET /v2/ledger/optimize)
+func (v2 *Handlers) GetAccountsDatabaseOptimizeStatus(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, convertAccountsDatabaseOptimizeStatus(v2.Node.AccountsDatabaseOptimizeStatus()))
+}
+
+// OptimizeAccountsDatabase requests an optimization of the accounts database, which runs in the background.
+// (POST /v2/ledger/optimize)
+func (v2 *Handlers) OptimizeAccountsDatabase(ctx echo.Context) error {
+	err := v2.Node.OptimizeAccountsDatabase()
+	if err != nil {
+		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, convertAccountsDatabaseOptimizeStatus(v2.Node.AccountsDatabaseOptimizeStatus()))
+}
+
+func convertAccountsDatabaseOptimizeStatus(status ledger.AccountsDatabaseOptimizeStatus) model.AccountsDatabaseOptimizeResponse {
+	return model.AccountsDatabaseOptimizeResponse{
+		Running:       status.Running,
+		Pending:       status.Pending,
+		Runs:          status.Runs,
+		LastStart:     unixTimeOrNil(status.LastStart),
+		LastEnd:       unixTimeOrNil(status.LastEnd),
+		LastError:     omitEmpty(status.LastError),
+		NextRun:       unixTimeOrNil(status.NextRun),
+		Incremental:   status.Incremental,
+		Pages:         status.Pages,
+		FreePages:     status.FreePages,
+		ReleasedPages: status.ReleasedPages,
+	}
+}
+
+// unixTimeOrNil returns the time in seconds since the epoch, or nil for the zero time.
+func unixTimeOrNil(t time.Time) *uint64 {
+	if t.IsZero() {
+		return nil
+	}
+	seconds := uint64(t.Unix())
+	return &seconds
+}
+
+// GetPeers returns the peers the node is connected to.
+// (GET /v2/peers)
+func (v2 *Handlers) GetPeers(ctx echo.Context) error {
+	details, err := v2.Node.PeerConnections()
+	if err != nil {
+		return serviceUnavailable(ctx, err, errPeersNotAvailable, v2.Log)
+	}
+	response := model.PeersResponse{
+		Outgoing: convertPeerConnections(details.OutgoingPeers),
+		Incoming: convertPeerConnections(details.IncomingPeers),
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func convertPeerConnections(peers []telemetryspec.PeerConnectionDetails) []model.PeerConnection {
+	res := make([]model.PeerConnection, 0, len(peers))
+	for _, peer := range peers {
+		conn := model.PeerConnection{
+			Address:              peer.Address,
+			ConnectionDuration:   uint64(peer.ConnectionDuration),
+			Endpoint:             omitEmpty(peer.Endpoint),
+			InstanceName:         omitEmpty(peer.InstanceName),
+			TelemetryGuid:        omitEmpty(peer.TelemetryGUID),
+			DuplicateFilterCount: omitEmpty(peer.DuplicateFilterCount),
+			TransactionCount:     omitEmpty(peer.TXCount),
+			VoteCount:            omitEmpty(peer.AVCount),
+			ProposalCount:        omitEmpty(peer.PPCount),
+			ProtocolVersion:      omitEmpty(peer.ProtocolVersion),
+		}
+		if peer.MessageDelay > 0 {
+			conn.MessageDelay = omitEmpty(uint64(peer.MessageDelay))
+		}
+		if len(peer.Capabilities) > 0 {
+			capabilities := peer.Capabilities
+			conn.Capabilities = &capabilities
+		}
+		res = append(res, conn)
+	}
+	return res
+}
+
 // AccountInformation gets account information for a given account.
 // (GET /v2/accounts/{address})
 func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params model.AccountInformationParams) error {
@@ -357,16 +1138,24 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
+	fs, err := fieldSelectionForFormat(params.Fields, handle)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseFields, v2.Log)
+	}
+
 	// should we skip fetching apps and assets?
 	if params.Exclude != nil {
 		switch *params.Exclude {
 		case "all":
-			return v2.basicAccountInformation(ctx, addr, handle, contentType)
+			return v2.basicAccountInformation(ctx, addr, handle, contentType, fs)
 		case "none", "":
 		default:
 			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
 		}
 	}
+	if !fs.selects("assets") && !fs.selects("created-assets") && !fs.selects("apps-local-state") && !fs.selects("created-apps") {
+		return v2.basicAccountInformation(ctx, addr, handle, contentType, fs)
+	}
 
 	myLedger := v2.Node.LedgerForAPI()
 
@@ -418,11 +1207,11 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 	}
 
 	response := model.AccountResponse(account)
-	return ctx.JSON(http.StatusOK, response)
+	return v2.respondWithFields(ctx, response, fs)
 }
 
 // basicAccountInformation handles the case when no resources (assets or apps) are requested.
-func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string) error {
+func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string, fs fieldSelection) error {
 	myLedger := v2.Node.LedgerForAPI()
 	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
 	if err != nil {
@@ -462,84 +1251,392 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 		return internalError(ctx, errors.New("overflow on pending reward calculation"), errInternalFailure, v2.Log)
 	}
 
-	account := model.Account{
-		SigType:                     nil,
-		Round:                       uint64(lastRound),
-		Address:                     addr.String(),
-		Amount:                      record.MicroAlgos.Raw,
-		PendingRewards:              pendingRewards.Raw,
-		AmountWithoutPendingRewards: amountWithoutPendingRewards.Raw,
-		Rewards:                     record.RewardedMicroAlgos.Raw,
-		Status:                      record.Status.String(),
-		RewardBase:                  &record.RewardsBase,
-		Participation:               apiParticipation,
-		TotalCreatedAssets:          record.TotalAssetParams,
-		TotalCreatedApps:            record.TotalAppParams,
-		TotalAssetsOptedIn:          record.TotalAssets,
-		AuthAddr:                    addrOrNil(record.AuthAddr),
-		TotalAppsOptedIn:            record.TotalAppLocalStates,
-		AppsTotalSchema: &model.ApplicationStateSchema{
-			NumByteSlice: record.TotalAppSchema.NumByteSlice,
-			NumUint:      record.TotalAppSchema.NumUint,
-		},
-		AppsTotalExtraPages: omitEmpty(uint64(record.TotalExtraAppPages)),
-		TotalBoxes:          omitEmpty(record.TotalBoxes),
-		TotalBoxBytes:       omitEmpty(record.TotalBoxBytes),
-		MinBalance:          record.MinBalance(&consensus).Raw,
+	account := model.Account{
+		SigType:                     nil,
+		Round:                       uint64(lastRound),
+		Address:                     addr.String(),
+		Amount:                      record.MicroAlgos.Raw,
+		PendingRewards:              pendingRewards.Raw,
+		AmountWithoutPendingRewards: amountWithoutPendingRewards.Raw,
+		Rewards:                     record.RewardedMicroAlgos.Raw,
+		Status:                      record.Status.String(),
+		RewardBase:                  &record.RewardsBase,
+		Participation:               apiParticipation,
+		TotalCreatedAssets:          record.TotalAssetParams,
+		TotalCreatedApps:            record.TotalAppParams,
+		TotalAssetsOptedIn:          record.TotalAssets,
+		AuthAddr:                    addrOrNil(record.AuthAddr),
+		TotalAppsOptedIn:            record.TotalAppLocalStates,
+		AppsTotalSchema: &model.ApplicationStateSchema{
+			NumByteSlice: record.TotalAppSchema.NumByteSlice,
+			NumUint:      record.TotalAppSchema.NumUint,
+		},
+		AppsTotalExtraPages: omitEmpty(uint64(record.TotalExtraAppPages)),
+		TotalBoxes:          omitEmpty(record.TotalBoxes),
+		TotalBoxBytes:       omitEmpty(record.TotalBoxBytes),
+		MinBalance:          record.MinBalance(&consensus).Raw,
+	}
+	response := model.AccountResponse(account)
+	return v2.respondWithFields(ctx, response, fs)
+}
+
+// AccountAssetInformation gets account information about a given asset.
+// (GET /v2/accounts/{address}/assets/{asset-id})
+func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, assetID uint64, params model.AccountAssetInformationParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+
+	lastRound := ledger.Latest()
+	record, err := ledger.LookupAsset(lastRound, addr, basics.AssetIndex(assetID))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	if record.AssetParams == nil && record.AssetHolding == nil {
+		return notFound(ctx, errors.New(errAccountAssetDoesNotExist), errAccountAssetDoesNotExist, v2.Log)
+	}
+
+	// return msgpack response
+	if handle == protocol.CodecHandle {
+		data, err := encode(handle, specv2.AssetResourceToAccountAssetModel(record))
+		if err != nil {
+			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+		}
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
+
+	// prepare JSON response
+	response := model.AccountAssetResponse{Round: uint64(lastRound)}
+
+	if record.AssetParams != nil {
+		asset := AssetParamsToAsset(addr.String(), basics.AssetIndex(assetID), record.AssetParams)
+		response.CreatedAsset = &asset.Params
+	}
+
+	if record.AssetHolding != nil {
+		response.AssetHolding = &model.AssetHolding{
+			Amount:   record.AssetHolding.Amount,
+			AssetID:  uint64(assetID),
+			IsFrozen: record.AssetHolding.Frozen,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// resourcesPageLimit returns the number of results of a page of the resources listings, given the requested limit.
+func (v2 *Handlers) resourcesPageLimit(limit *uint64) uint64 {
+	pageLimit := uint64(defaultAccountResourcesLimit)
+	if limit != nil && *limit != 0 {
+		pageLimit = *limit
+	}
+	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 && pageLimit > maxResults {
+		pageLimit = maxResults
+	}
+	return pageLimit
+}
+
+// accountResourcesPage parses the pagination parameters of the account resources listings, returning
+// the creatable index to resume from and the maximum number of resources to return.
+func (v2 *Handlers) accountResourcesPage(limit *uint64, next *string) (basics.CreatableIndex, uint64, error) {
+	pageLimit := v2.resourcesPageLimit(limit)
+
+	var minIdx uint64
+	if next != nil && *next != "" {
+		var err error
+		minIdx, err = strconv.ParseUint(*next, 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return basics.CreatableIndex(minIdx), pageLimit, nil
+}
+
+// AccountAssetsInformation gets a page of the assets held or created by an account.
+// (GET /v2/accounts/{address}/assets)
+func (v2 *Handlers) AccountAssetsInformation(ctx echo.Context, address string, params model.AccountAssetsInformationParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	minIdx, limit, err := v2.accountResourcesPage(params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseNextToken, v2.Log)
+	}
+
+	records, lastRound, err := v2.Node.LedgerForAPI().LookupAssets(addr, basics.AssetIndex(minIdx), limit, false)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.AccountAssetsInformationResponse{
+		Round:  uint64(lastRound),
+		Assets: make([]model.AccountAssetResource, 0, len(records)),
+	}
+	for _, record := range records {
+		resource := model.AccountAssetResource{AssetID: uint64(record.AssetID)}
+		if record.AssetParams != nil {
+			asset := AssetParamsToAsset(addr.String(), record.AssetID, record.AssetParams)
+			resource.CreatedAsset = &asset.Params
+		}
+		if record.AssetHolding != nil {
+			resource.AssetHolding = &model.AssetHolding{
+				Amount:   record.AssetHolding.Amount,
+				AssetID:  uint64(record.AssetID),
+				IsFrozen: record.AssetHolding.Frozen,
+			}
+		}
+		response.Assets = append(response.Assets, resource)
+	}
+	if uint64(len(records)) == limit {
+		nextToken := strconv.FormatUint(uint64(records[len(records)-1].AssetID), 10)
+		response.NextToken = &nextToken
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountCreatedAssets gets a page of the assets created by an account.
+// (GET /v2/accounts/{address}/created-assets)
+func (v2 *Handlers) AccountCreatedAssets(ctx echo.Context, address string, params model.AccountCreatedAssetsParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	minIdx, limit, err := v2.accountResourcesPage(params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseNextToken, v2.Log)
+	}
+
+	records, lastRound, err := v2.Node.LedgerForAPI().LookupAssets(addr, basics.AssetIndex(minIdx), limit, true)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.AccountCreatedAssetsResponse{
+		Round:         uint64(lastRound),
+		CreatedAssets: make([]model.Asset, 0, len(records)),
+	}
+	for _, record := range records {
+		response.CreatedAssets = append(response.CreatedAssets, AssetParamsToAsset(addr.String(), record.AssetID, record.AssetParams))
+	}
+	if uint64(len(records)) == limit {
+		nextToken := strconv.FormatUint(uint64(records[len(records)-1].AssetID), 10)
+		response.NextToken = &nextToken
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountCreatedApplications gets a page of the applications created by an account.
+// (GET /v2/accounts/{address}/created-applications)
+func (v2 *Handlers) AccountCreatedApplications(ctx echo.Context, address string, params model.AccountCreatedApplicationsParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	minIdx, limit, err := v2.accountResourcesPage(params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseNextToken, v2.Log)
+	}
+
+	records, lastRound, err := v2.Node.LedgerForAPI().LookupApplications(addr, basics.AppIndex(minIdx), limit, true)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.AccountCreatedApplicationsResponse{
+		Round:       uint64(lastRound),
+		CreatedApps: make([]model.Application, 0, len(records)),
 	}
-	response := model.AccountResponse(account)
+	for _, record := range records {
+		response.CreatedApps = append(response.CreatedApps, AppParamsToApplication(addr.String(), record.AppID, record.AppParams))
+	}
+	if uint64(len(records)) == limit {
+		nextToken := strconv.FormatUint(uint64(records[len(records)-1].AppID), 10)
+		response.NextToken = &nextToken
+	}
+
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// AccountAssetInformation gets account information about a given asset.
-// (GET /v2/accounts/{address}/assets/{asset-id})
-func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, assetID uint64, params model.AccountAssetInformationParams) error {
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
+// AccountRekeyHistory returns the address authorizing the transactions of an account, and the history of its changes.
+// (GET /v2/accounts/{address}/rekey-history)
+func (v2 *Handlers) AccountRekeyHistory(ctx echo.Context, address string) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
-		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	myLedger := v2.Node.LedgerForAPI()
+	rekeys, baseRound, err := myLedger.LookupRekeyHistory(addr)
+	if err != nil {
+		if errors.Is(err, ledger.ErrNoRekeyHistory) {
+			return notFound(ctx, err, errRekeyHistoryNotKept, v2.Log)
+		}
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	lastRound := myLedger.Latest()
+	record, _, _, err := myLedger.LookupAccount(lastRound, addr)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.AccountRekeyHistoryResponse{
+		Round:             uint64(lastRound),
+		Address:           addr.String(),
+		AuthAddr:          addrOrNil(record.AuthAddr),
+		HistoryStartRound: uint64(baseRound + 1),
+		Rekeys:            make([]model.AccountRekey, 0, len(rekeys)),
 	}
+	// the history may hold changes leaving the authorizing address as it was, such as the closing of an account
+	// which was not rekeyed by an inner transaction: they are skipped once the previous address is known.
+	var prevAuthAddr basics.Address
+	prevKnown := baseRound == 0
+	for _, rekey := range rekeys {
+		if prevKnown && rekey.AuthAddr == prevAuthAddr {
+			continue
+		}
+		response.Rekeys = append(response.Rekeys, model.AccountRekey{
+			Round:    uint64(rekey.Round),
+			AuthAddr: addrOrNil(rekey.AuthAddr),
+		})
+		prevAuthAddr, prevKnown = rekey.AuthAddr, true
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
 
+// AccountAbsenceProof proves that an account has no record, or holds no asset or has no local state of an
+// application, at a round.
+// (GET /v2/accounts/{address}/absence-proof)
+func (v2 *Handlers) AccountAbsenceProof(ctx echo.Context, address string, params model.AccountAbsenceProofParams) error {
 	addr, err := basics.UnmarshalChecksumAddress(address)
 	if err != nil {
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
-	ledger := v2.Node.LedgerForAPI()
+	var scope ledgercore.AbsenceProofScope
+	switch {
+	case params.AssetId != nil && params.ApplicationId != nil:
+		return badRequest(ctx, nil, errAbsenceProofScope, v2.Log)
+	case params.AssetId != nil:
+		scope = ledgercore.AbsenceProofScope{Creatable: basics.CreatableIndex(*params.AssetId), CreatableType: basics.AssetCreatable}
+	case params.ApplicationId != nil:
+		scope = ledgercore.AbsenceProofScope{Creatable: basics.CreatableIndex(*params.ApplicationId), CreatableType: basics.AppCreatable}
+	}
+	if (params.AssetId != nil || params.ApplicationId != nil) && scope.Creatable == 0 {
+		return badRequest(ctx, nil, errAbsenceProofScope, v2.Log)
+	}
 
-	lastRound := ledger.Latest()
-	record, err := ledger.LookupAsset(lastRound, addr, basics.AssetIndex(assetID))
+	// the round 0 lets the ledger pick the default round of the scope
+	myLedger := v2.Node.LedgerForAPI()
+	var rnd basics.Round
+	if params.Round != nil {
+		if basics.Round(*params.Round) > myLedger.Latest() {
+			return notFound(ctx, nil, errRoundGreaterThanTheLatest, v2.Log)
+		}
+		rnd = basics.Round(*params.Round)
+	}
+
+	proof, err := myLedger.AbsenceProof(rnd, scope, addr)
 	if err != nil {
+		var roundOffsetErr *ledger.RoundOffsetError
+		switch {
+		case errors.Is(err, ledgercore.ErrAddressCommitted):
+			return badRequest(ctx, err, errAccountExists, v2.Log)
+		case errors.Is(err, ledger.ErrNoAbsenceProofs):
+			return notFound(ctx, err, errAbsenceProofsNotBuilt, v2.Log)
+		case errors.Is(err, ledger.ErrAbsenceProofRound):
+			return notFound(ctx, err, errAbsenceProofRoundNotAvailable, v2.Log)
+		case errors.Is(err, ledger.ErrAbsenceProofRateLimited):
+			return serviceUnavailable(ctx, err, errAbsenceProofRateLimited, v2.Log)
+		case errors.Is(err, ledger.ErrNoCreatableHolderIndex):
+			return notFound(ctx, err, errCreatableHolderIndexDisabled, v2.Log)
+		case errors.As(err, &roundOffsetErr):
+			return notFound(ctx, err, errAbsenceProofRoundNotAvailable, v2.Log)
+		}
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
 
-	if record.AssetParams == nil && record.AssetHolding == nil {
-		return notFound(ctx, errors.New(errAccountAssetDoesNotExist), errAccountAssetDoesNotExist, v2.Log)
+	response := model.AbsenceProofResponse{
+		Round:      uint64(proof.Round),
+		Root:       proof.Root,
+		LowerIndex: proof.Lower.Index,
+		UpperIndex: proof.Upper.Index,
+		Proof:      protocol.EncodeReflect(&proof),
+	}
+	if proof.Lower.Address != nil {
+		lower := proof.Lower.Address.String()
+		response.LowerAddress = &lower
 	}
+	if proof.Upper.Address != nil {
+		upper := proof.Upper.Address.String()
+		response.UpperAddress = &upper
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	// return msgpack response
-	if handle == protocol.CodecHandle {
-		data, err := encode(handle, specv2.AssetResourceToAccountAssetModel(record))
-		if err != nil {
-			return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
-		}
-		return ctx.Blob(http.StatusOK, contentType, data)
+// AccountPendingInformation projects the balance, minimum balance and asset holdings of an account after the
+// transactions pending in the transaction pool.
+// (GET /v2/accounts/{address}/pending)
+func (v2 *Handlers) AccountPendingInformation(ctx echo.Context, address string) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
-	// prepare JSON response
-	response := model.AccountAssetResponse{Round: uint64(lastRound)}
+	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 {
+		myLedger := v2.Node.LedgerForAPI()
+		record, _, _, lookupErr := myLedger.LookupAccount(myLedger.Latest(), addr)
+		if lookupErr != nil {
+			return internalError(ctx, lookupErr, errFailedLookingUpLedger, v2.Log)
+		}
+		if record.TotalAssets > maxResults {
+			v2.Log.Infof("MaxAccountAPIResults limit %d exceeded, total assets %d", maxResults, record.TotalAssets)
+			extraData := map[string]interface{}{
+				"max-results":           maxResults,
+				"total-assets-opted-in": record.TotalAssets,
+			}
+			return ctx.JSON(http.StatusBadRequest, model.ErrorResponse{
+				Message: "Result limit exceeded",
+				Data:    &extraData,
+			})
+		}
+	}
 
-	if record.AssetParams != nil {
-		asset := AssetParamsToAsset(addr.String(), basics.AssetIndex(assetID), record.AssetParams)
-		response.CreatedAsset = &asset.Params
+	state, err := v2.Node.PendingAccount(addr)
+	if err != nil {
+		return serviceUnavailable(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
 	}
 
-	if record.AssetHolding != nil {
-		response.AssetHolding = &model.AssetHolding{
-			Amount:   record.AssetHolding.Amount,
-			AssetID:  uint64(assetID),
-			IsFrozen: record.AssetHolding.Frozen,
-		}
+	response := model.AccountPendingResponse{
+		Address:    addr.String(),
+		Round:      uint64(state.Round),
+		Amount:     state.Amount.Raw,
+		MinBalance: state.MinBalance.Raw,
+		Assets:     make([]model.AssetHolding, 0, len(state.Assets)),
 	}
+	for aidx, holding := range state.Assets {
+		response.Assets = append(response.Assets, model.AssetHolding{
+			AssetID:  uint64(aidx),
+			Amount:   holding.Amount,
+			IsFrozen: holding.Frozen,
+		})
+	}
+	slices.SortFunc(response.Assets, func(a, b model.AssetHolding) bool { return a.AssetID < b.AssetID })
 
 	return ctx.JSON(http.StatusOK, response)
 }
@@ -609,6 +1706,11 @@ func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params model.GetBlo
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
 
+	fs, err := fieldSelectionForFormat(params.Fields, handle)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseFields, v2.Log)
+	}
+
 	// msgpack format uses 'RawBlockBytes' and attaches a custom header.
 	if handle == protocol.CodecHandle {
 		blockbytes, blockErr := rpcs.RawBlockBytes(v2.Node.LedgerForAPI(), basics.Round(round))
@@ -643,7 +1745,7 @@ func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params model.GetBlo
 		Block: block,
 	}
 
-	data, err := encode(handle, response)
+	data, err := encode(handle, fs.project(response, codecTags))
 	if err != nil {
 		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
 	}
@@ -670,6 +1772,123 @@ func (v2 *Handlers) GetBlockHash(ctx echo.Context, round uint64) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetBlockHeader gets the block header for the given round, without the transactions of the block.
+// (GET /v2/blocks/{round}/header)
+func (v2 *Handlers) GetBlockHeader(ctx echo.Context, round uint64, params model.GetBlockHeaderParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	hdr, err := ledger.BlockHdr(basics.Round(round))
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+	}
+
+	response := struct {
+		BlockHeader bookkeeping.BlockHeader `codec:"blockHeader"`
+	}{
+		BlockHeader: hdr,
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// GetBlockCertificate gets the agreement certificate of the block for the given round.
+// (GET /v2/blocks/{round}/certificate)
+func (v2 *Handlers) GetBlockCertificate(ctx echo.Context, round uint64, params model.GetBlockCertificateParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	_, cert, err := ledger.BlockCert(basics.Round(round))
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+	}
+
+	response := struct {
+		Cert agreement.Certificate `codec:"cert"`
+	}{
+		Cert: cert,
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// GetBlockSortition gets the seed of the block for the given round and the
+// VRF evaluation of the votes of its certificate.
+// (GET /v2/blocks/{round}/sortition)
+func (v2 *Handlers) GetBlockSortition(ctx echo.Context, round uint64) error {
+	ledger := v2.Node.LedgerForAPI()
+	block, cert, err := ledger.BlockCert(basics.Round(round))
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+	}
+
+	sortition, err := cert.Sortition(ledger)
+	if err != nil {
+		var noEntry ledgercore.ErrNoEntry
+		if errors.As(err, &noEntry) {
+			return notFound(ctx, err, errFailedEvaluatingSortition, v2.Log)
+		}
+		return internalError(ctx, err, errFailedEvaluatingSortition, v2.Log)
+	}
+
+	seed := block.Seed()
+	response := model.BlockSortitionResponse{
+		Round:          round,
+		Period:         uint64(cert.Period),
+		Step:           uint64(cert.Step),
+		Seed:           seed[:],
+		Proposer:       cert.Proposal.OriginalProposer.String(),
+		ProposalPeriod: uint64(cert.Proposal.OriginalPeriod),
+		SelectionSeed:  sortition.Seed[:],
+		SeedRound:      uint64(sortition.SeedRound),
+		BalanceRound:   uint64(sortition.BalanceRound),
+		OnlineMoney:    sortition.TotalMoney.Raw,
+		VrfMessage:     sortition.Message,
+		Votes:          make([]model.SortitionVote, len(sortition.Votes)),
+	}
+	for i, vote := range sortition.Votes {
+		response.Votes[i] = model.SortitionVote{
+			Address:      vote.Sender.String(),
+			SelectionKey: vote.SelectionID[:],
+			Proof:        vote.Proof[:],
+			Output:       vote.Output[:],
+			Stake:        vote.Stake.Raw,
+			Weight:       vote.Weight,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetTransactionProof generates a Merkle proof for a transaction in a block.
 // (GET /v2/blocks/{round}/transactions/{txid}/proof)
 func (v2 *Handlers) GetTransactionProof(ctx echo.Context, round uint64, txid string, params model.GetTransactionProofParams) error {
@@ -770,6 +1989,72 @@ func (v2 *Handlers) GetSupply(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, supply)
 }
 
+// GetLedgerTotals returns the totals of the ledger at past rounds, one every interval rounds of the range.
+// (GET /v2/ledger/totals)
+func (v2 *Handlers) GetLedgerTotals(ctx echo.Context, params model.GetLedgerTotalsParams) error {
+	myLedger := v2.Node.LedgerForAPI()
+	latest := myLedger.Latest()
+	maxRound := latest
+	if params.MaxRound != nil {
+		maxRound = basics.Round(*params.MaxRound)
+		if maxRound > latest {
+			return badRequest(ctx, errors.New(errRoundGreaterThanTheLatest), errRoundGreaterThanTheLatest, v2.Log)
+		}
+	}
+	minRound := maxRound
+	if params.MinRound != nil {
+		minRound = basics.Round(*params.MinRound)
+		if minRound > maxRound {
+			return badRequest(ctx, errors.New(errInvalidTotalsRange), errInvalidTotalsRange, v2.Log)
+		}
+	}
+	interval := uint64(1)
+	if params.Interval != nil {
+		interval = *params.Interval
+	}
+	if interval == 0 || uint64(maxRound-minRound)/interval >= maxLedgerTotalsRounds {
+		err := fmt.Errorf(errTooManyTotalsRounds, maxLedgerTotalsRounds)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	totals, baseRound, err := myLedger.LookupTotalsHistory(minRound, maxRound, interval, maxLedgerTotalsRounds)
+	if err != nil {
+		if errors.Is(err, ledger.ErrNoTotalsHistory) {
+			return notFound(ctx, err, errTotalsHistoryNotKept, v2.Log)
+		}
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if len(totals) == 0 {
+		err = fmt.Errorf(errTotalsRoundsNotAvailable, baseRound+1)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.LedgerTotalsResponse{
+		CurrentRound:      uint64(latest),
+		HistoryStartRound: uint64(baseRound + 1),
+		Totals:            make([]model.LedgerTotals, len(totals)),
+	}
+	for i, rt := range totals {
+		response.Totals[i] = model.LedgerTotals{
+			Round:                       uint64(rt.Round),
+			OnlineMoney:                 rt.Totals.Online.Money.Raw,
+			OnlineRewardUnits:           rt.Totals.Online.RewardUnits,
+			OfflineMoney:                rt.Totals.Offline.Money.Raw,
+			OfflineRewardUnits:          rt.Totals.Offline.RewardUnits,
+			NotParticipatingMoney:       rt.Totals.NotParticipating.Money.Raw,
+			NotParticipatingRewardUnits: rt.Totals.NotParticipating.RewardUnits,
+			TotalMoney:                  rt.Totals.All().Raw,
+			CirculatingMoney:            rt.Circulating().Raw,
+			RewardsLevel:                rt.Totals.RewardsLevel,
+			RewardsRate:                 rt.RewardsRate,
+			FeeSink:                     rt.FeeSink.Raw,
+			RewardsPool:                 rt.RewardsPool.Raw,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetStatus gets the current node status.
 // (GET /v2/status)
 func (v2 *Handlers) GetStatus(ctx echo.Context) error {
@@ -908,23 +2193,139 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 	}
 	if stat.Catchpoint != "" {
 		// node is currently catching up to the requested catchpoint.
-		return serviceUnavailable(ctx, fmt.Errorf("RawTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+		return serviceUnavailable(ctx, fmt.Errorf("RawTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	txgroup, err := decodeTxGroup(ctx.Request().Body, proto.MaxTxGroupSize)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	err = v2.Node.BroadcastSignedTxGroup(txgroup)
+	if errors.Is(err, node.ErrShuttingDown) {
+		return serviceUnavailable(ctx, err, errServiceShuttingDown, v2.Log)
+	}
+	if err != nil {
+		return rejectTxGroup(ctx, err, v2.Log)
+	}
+
+	// For backwards compatibility, return txid of first tx in group
+	txid := txgroup[0].ID()
+	return ctx.JSON(http.StatusOK, model.PostTransactionsResponse{TxId: txid.String()})
+}
+
+// RawPrivateTransaction adds a transaction group to the transaction pool without broadcasting it, and forwards it
+// to the private relays of the node.
+// (POST /v2/transactions/private)
+func (v2 *Handlers) RawPrivateTransaction(ctx echo.Context) error {
+	if !v2.Node.Config().EnablePrivateTxSubmission {
+		return notFound(ctx, node.ErrPrivateTxSubmissionDisabled, errPrivateTxSubmissionDisabled, v2.Log)
+	}
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("RawPrivateTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	txgroup, err := decodeTxGroup(ctx.Request().Body, proto.MaxTxGroupSize)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	relays, err := v2.Node.SubmitPrivateTxGroup(txgroup)
+	if errors.Is(err, node.ErrShuttingDown) {
+		return serviceUnavailable(ctx, err, errServiceShuttingDown, v2.Log)
+	}
+	if err != nil {
+		return rejectTxGroup(ctx, err, v2.Log)
+	}
+
+	txid := txgroup[0].ID()
+	return ctx.JSON(http.StatusOK, model.PostPrivateTransactionsResponse{TxId: txid.String(), Relays: uint64(relays)})
+}
+
+// PreEncodedTransactionBatchGroup mirrors model.TransactionBatchGroup
+type PreEncodedTransactionBatchGroup struct {
+	Txns []transactions.SignedTxn `codec:"txns"`
+}
+
+// PreEncodedTransactionBatchRequest mirrors model.TransactionBatchRequest
+type PreEncodedTransactionBatchRequest struct {
+	TxnGroups []PreEncodedTransactionBatchGroup `codec:"txn-groups"`
+}
+
+// RawTransactionBatch broadcasts a batch of independent transaction groups to the network,
+// reporting whether each of them was accepted.
+// (POST /v2/transactions/batch)
+func (v2 *Handlers) RawTransactionBatch(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("RawTransactionBatch failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
 	}
 	proto := config.Consensus[stat.LastVersion]
 
-	txgroup, err := decodeTxGroup(ctx.Request().Body, proto.MaxTxGroupSize)
+	requestBuffer := new(bytes.Buffer)
+	_, err = requestBuffer.ReadFrom(ctx.Request().Body)
 	if err != nil {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
+	requestData := requestBuffer.Bytes()
 
-	err = v2.Node.BroadcastSignedTxGroup(txgroup)
+	var batchRequest PreEncodedTransactionBatchRequest
+	err = decode(protocol.CodecHandle, requestData, &batchRequest)
 	if err != nil {
+		err = decode(protocol.JSONStrictHandle, requestData, &batchRequest)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+	if len(batchRequest.TxnGroups) == 0 {
+		err = errors.New("empty batch")
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if len(batchRequest.TxnGroups) > MaxTransactionBatchGroups {
+		err = fmt.Errorf("batch size %d exceeds max %d", len(batchRequest.TxnGroups), MaxTransactionBatchGroups)
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
-	// For backwards compatibility, return txid of first tx in group
-	txid := txgroup[0].ID()
-	return ctx.JSON(http.StatusOK, model.PostTransactionsResponse{TxId: txid.String()})
+	response := model.TransactionBatchResponse{
+		Results: make([]model.TransactionBatchResult, len(batchRequest.TxnGroups)),
+	}
+	for i, txgroup := range batchRequest.TxnGroups {
+		result := &response.Results[i]
+		if len(txgroup.Txns) == 0 {
+			message := "empty txgroup"
+			result.Message = &message
+			continue
+		}
+		txid := txgroup.Txns[0].ID().String()
+		result.TxId = &txid
+		if len(txgroup.Txns) > proto.MaxTxGroupSize {
+			message := fmt.Sprintf("max group size is %d", proto.MaxTxGroupSize)
+			result.Message = &message
+			continue
+		}
+		err = v2.Node.BroadcastSignedTxGroup(txgroup.Txns)
+		if err != nil {
+			message := err.Error()
+			code := string(pools.RejectionCodeOf(err))
+			result.Message = &message
+			result.Code = &code
+			continue
+		}
+		result.Accepted = true
+	}
+
+	return ctx.JSON(http.StatusOK, response)
 }
 
 // PreEncodedSimulateTxnResult mirrors model.SimulateTransactionResult
@@ -933,6 +2334,7 @@ type PreEncodedSimulateTxnResult struct {
 	AppBudgetConsumed      *uint64                               `codec:"app-budget-consumed,omitempty"`
 	LogicSigBudgetConsumed *uint64                               `codec:"logic-sig-budget-consumed,omitempty"`
 	TransactionTrace       *model.SimulationTransactionExecTrace `codec:"exec-trace,omitempty"`
+	Profile                *model.SimulationTransactionProfile   `codec:"profile,omitempty"`
 }
 
 // PreEncodedSimulateTxnGroupResult mirrors model.SimulateTransactionGroupResult
@@ -951,6 +2353,8 @@ type PreEncodedSimulateResponse struct {
 	TxnGroups       []PreEncodedSimulateTxnGroupResult `codec:"txn-groups"`
 	EvalOverrides   *model.SimulationEvalOverrides     `codec:"eval-overrides,omitempty"`
 	ExecTraceConfig simulation.ExecTraceConfig         `codec:"exec-trace-config,omitempty"`
+	CoverageReport  string                             `codec:"coverage-report,omitempty"`
+	StateDiff       *model.SimulationStateDiff         `codec:"state-diff,omitempty"`
 }
 
 // PreEncodedSimulateRequestTransactionGroup mirrors model.SimulateRequestTransactionGroup
@@ -958,25 +2362,115 @@ type PreEncodedSimulateRequestTransactionGroup struct {
 	Txns []transactions.SignedTxn `codec:"txns"`
 }
 
+// PreEncodedSimulateSourceMap mirrors model.SimulateSourceMap
+type PreEncodedSimulateSourceMap struct {
+	ProgramHash string          `codec:"program-hash"`
+	SourceMap   logic.SourceMap `codec:"source-map"`
+}
+
 // PreEncodedSimulateRequest mirrors model.SimulateRequest
 type PreEncodedSimulateRequest struct {
-	TxnGroups            []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
-	AllowEmptySignatures bool                                        `codec:"allow-empty-signatures,omitempty"`
-	AllowMoreLogging     bool                                        `codec:"allow-more-logging,omitempty"`
-	ExtraOpcodeBudget    uint64                                      `codec:"extra-opcode-budget,omitempty"`
-	ExecTraceConfig      simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
+	TxnGroups             []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
+	AllowEmptySignatures  bool                                        `codec:"allow-empty-signatures,omitempty"`
+	AllowMoreLogging      bool                                        `codec:"allow-more-logging,omitempty"`
+	ExtraOpcodeBudget     uint64                                      `codec:"extra-opcode-budget,omitempty"`
+	ExecTraceConfig       simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
+	SourceMaps            []PreEncodedSimulateSourceMap               `codec:"source-maps,omitempty"`
+	Coverage              bool                                        `codec:"coverage,omitempty"`
+	Profile               bool                                        `codec:"profile,omitempty"`
+	UnlimitedOpcodeBudget bool                                        `codec:"unlimited-opcode-budget,omitempty"`
+	StateOverrides        *model.SimulationStateOverrides             `codec:"state-overrides,omitempty"`
+	StateDiff             bool                                        `codec:"state-diff,omitempty"`
 }
 
 // SimulateTransaction simulates broadcasting a raw transaction to the network, returning relevant simulation results.
 // (POST /v2/transactions/simulate)
 func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateTransactionParams) error {
+	decodeEvents := params.DecodeEvents != nil && *params.DecodeEvents
+	return v2.simulate(ctx, "SimulateTransaction", (*string)(params.Format), decodeEvents, v2.Node.Simulate)
+}
+
+// CreateSimulationSession opens a new simulation session, pinned at the latest round.
+// (POST /v2/transactions/simulate/sessions)
+func (v2 *Handlers) CreateSimulationSession(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("CreateSimulationSession failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	// the sessions are bounded per API token
+	info, err := v2.Node.CreateSimulationSession(middlewares.AuthenticatedToken(ctx))
+	if err != nil {
+		var invalidTxErr simulation.InvalidRequestError
+		if errors.As(err, &invalidTxErr) {
+			return badRequest(ctx, invalidTxErr, invalidTxErr.Error(), v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	return ctx.JSON(http.StatusOK, convertSimulationSessionInfo(info))
+}
+
+// SimulateTransactionInSession simulates a transaction group on top of the state accumulated by a simulation session.
+// (POST /v2/transactions/simulate/sessions/{session-id})
+func (v2 *Handlers) SimulateTransactionInSession(ctx echo.Context, sessionID string, params model.SimulateTransactionInSessionParams) error {
+	return v2.simulate(ctx, "SimulateTransactionInSession", (*string)(params.Format), false, func(request simulation.Request) (simulation.Result, error) {
+		return v2.Node.SimulateInSession(sessionID, request)
+	})
+}
+
+// GetSimulationSessionDelta returns the state changes accumulated by a simulation session.
+// (GET /v2/transactions/simulate/sessions/{session-id})
+func (v2 *Handlers) GetSimulationSessionDelta(ctx echo.Context, sessionID string, params model.GetSimulationSessionDeltaParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	sDelta, err := v2.Node.SimulationSessionDelta(sessionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, simulation.ErrSessionNotFound):
+			return notFound(ctx, err, errSimulationSessionNotFound, v2.Log)
+		case errors.Is(err, simulation.ErrSessionExpired):
+			return notFound(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+	data, err := encode(handle, sDelta)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// DeleteSimulationSession discards a simulation session.
+// (DELETE /v2/transactions/simulate/sessions/{session-id})
+func (v2 *Handlers) DeleteSimulationSession(ctx echo.Context, sessionID string) error {
+	err := v2.Node.DeleteSimulationSession(sessionID)
+	if err != nil {
+		if errors.Is(err, simulation.ErrSessionNotFound) {
+			return notFound(ctx, err, errSimulationSessionNotFound, v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// simulate decodes and validates a simulation request from the request body, runs it through simulateFn
+// and writes the encoded simulation result. opName is used when reporting that the node is catching up, and
+// decodeEvents sets whether the ARC-28 events of the registered contracts are decoded from the logs.
+func (v2 *Handlers) simulate(ctx echo.Context, opName string, format *string, decodeEvents bool, simulateFn func(simulation.Request) (simulation.Result, error)) error {
 	stat, err := v2.Node.Status()
 	if err != nil {
 		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
 	}
 	if stat.Catchpoint != "" {
 		// node is currently catching up to the requested catchpoint.
-		return serviceUnavailable(ctx, fmt.Errorf("SimulateTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+		return serviceUnavailable(ctx, fmt.Errorf("%s failed as the node was catchpoint catchuping", opName), errOperationNotAvailableDuringCatchup, v2.Log)
 	}
 	proto := config.Consensus[stat.LastVersion]
 
@@ -1008,11 +2502,20 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 		}
 	}
 
+	request, err := convertSimulationRequest(simulateRequest)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
 	// Simulate transaction
-	simulationResult, err := v2.Node.Simulate(convertSimulationRequest(simulateRequest))
+	simulationResult, err := simulateFn(request)
 	if err != nil {
 		var invalidTxErr simulation.InvalidRequestError
 		switch {
+		case errors.Is(err, simulation.ErrSessionNotFound):
+			return notFound(ctx, err, errSimulationSessionNotFound, v2.Log)
+		case errors.Is(err, simulation.ErrSessionExpired):
+			return notFound(ctx, err, err.Error(), v2.Log)
 		case errors.As(err, &invalidTxErr):
 			return badRequest(ctx, invalidTxErr, invalidTxErr.Error(), v2.Log)
 		default:
@@ -1021,8 +2524,15 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 	}
 
 	response := convertSimulationResult(simulationResult)
+	if decodeEvents {
+		for i := range response.TxnGroups {
+			for j := range response.TxnGroups[i].Txns {
+				v2.Contracts.decodeTxnEvents(&response.TxnGroups[i].Txns[j].Txn)
+			}
+		}
+	}
 
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	handle, contentType, err := getCodecHandle(format)
 	if err != nil {
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
@@ -1124,6 +2634,21 @@ func (v2 *Handlers) SetSyncRound(ctx echo.Context, round uint64) error {
 	return ctx.NoContent(http.StatusOK)
 }
 
+// AcknowledgeSyncRound records that the consumer of the ledger processed a round, advancing the sync round past it.
+// (POST /v2/ledger/sync/{round}/ack)
+func (v2 *Handlers) AcknowledgeSyncRound(ctx echo.Context, round uint64) error {
+	err := v2.Node.AcknowledgeRound(round)
+	if err != nil {
+		switch err {
+		case node.ErrRoundNotReached, catchup.ErrSyncRoundInvalid:
+			return badRequest(ctx, err, errFailedSettingSyncRound, v2.Log)
+		default:
+			return internalError(ctx, err, errFailedSettingSyncRound, v2.Log)
+		}
+	}
+	return ctx.JSON(http.StatusOK, model.GetSyncRoundResponse{Round: v2.Node.GetSyncRound()})
+}
+
 // GetSyncRound gets the sync round from the ledger.
 // (GET /v2/ledger/sync)
 func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
@@ -1153,6 +2678,180 @@ func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64, params m
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
+// GetWatchedApplications returns the applications whose state changes are collected by the node.
+// (GET /v2/deltas/apps)
+func (v2 *Handlers) GetWatchedApplications(ctx echo.Context) error {
+	watched := v2.Node.WatchedApplications()
+	response := model.WatchedApplicationsResponse{Applications: make([]uint64, len(watched))}
+	for i, appID := range watched {
+		response.Applications[i] = uint64(appID)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// RegisterContract registers the ARC-28 events of a contract description for the applications implementing it.
+// (POST /v2/contracts)
+func (v2 *Handlers) RegisterContract(ctx echo.Context, params model.RegisterContractParams) error {
+	if v2.Contracts == nil {
+		return notFound(ctx, errors.New(errContractEventsNotAvailable), errContractEventsNotAvailable, v2.Log)
+	}
+
+	buf := new(bytes.Buffer)
+	_, err := buf.ReadFrom(http.MaxBytesReader(nil, ctx.Request().Body, MaxTealSourceBytes))
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	genesisHash := v2.Node.GenesisHash()
+	decoders, apps, err := parseContractEvents(buf.Bytes(), base64.StdEncoding.EncodeToString(genesisHash[:]))
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errInvalidContractDescription, err), v2.Log)
+	}
+	if params.ApplicationId != nil && *params.ApplicationId != 0 && !slices.Contains(apps, basics.AppIndex(*params.ApplicationId)) {
+		apps = append(apps, basics.AppIndex(*params.ApplicationId))
+	}
+	if len(apps) == 0 {
+		err = errors.New(errNoContractApplications)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	err = v2.Contracts.register(decoders, apps)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.RegisterContractResponse{
+		ApplicationIds: make([]uint64, len(apps)),
+		Events:         uint64(len(decoders)),
+	}
+	for i, app := range apps {
+		response.ApplicationIds[i] = uint64(app)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// WatchApplicationStateDeltas registers an application whose state changes are collected by the node.
+// (POST /v2/deltas/apps/{application-id})
+func (v2 *Handlers) WatchApplicationStateDeltas(ctx echo.Context, applicationID uint64) error {
+	if applicationID == 0 {
+		return badRequest(ctx, errors.New(errInvalidAppID), errInvalidAppID, v2.Log)
+	}
+	v2.Node.WatchApplication(basics.AppIndex(applicationID))
+	return ctx.NoContent(http.StatusOK)
+}
+
+// UnwatchApplicationStateDeltas stops collecting the state changes of an application.
+// (DELETE /v2/deltas/apps/{application-id})
+func (v2 *Handlers) UnwatchApplicationStateDeltas(ctx echo.Context, applicationID uint64) error {
+	err := v2.Node.UnwatchApplication(basics.AppIndex(applicationID))
+	if err != nil {
+		if errors.Is(err, appwatch.ErrNotWatched) {
+			return notFound(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetApplicationStateDeltas returns the state changes of the watched applications in a round.
+// (GET /v2/deltas/{round}/apps)
+func (v2 *Handlers) GetApplicationStateDeltas(ctx echo.Context, round uint64) error {
+	changes, err := v2.Node.ApplicationStateChanges(basics.Round(round))
+	if err != nil {
+		if errors.Is(err, appwatch.ErrRoundNotAvailable) {
+			return notFound(ctx, err, fmt.Sprintf(errFailedRetrievingAppStateChanges, err), v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedRetrievingAppStateChanges, err), v2.Log)
+	}
+
+	response := model.ApplicationStateDeltasResponse{
+		Round:        uint64(changes.Round),
+		Applications: make([]model.ApplicationStateDelta, len(changes.Apps)),
+	}
+	for i, app := range changes.Apps {
+		response.Applications[i] = convertAppStateChanges(app)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// convertAppStateChanges converts the state changes of an application, reporting the changed
+// global and local states, even empty ones
+func convertAppStateChanges(changes appwatch.AppStateChanges) model.ApplicationStateDelta {
+	tkvOrEmpty := func(tkv basics.TealKeyValue) *model.TealKeyValueStore {
+		if converted := convertTKVToGenerated(&tkv); converted != nil {
+			return converted
+		}
+		return &model.TealKeyValueStore{}
+	}
+
+	result := model.ApplicationStateDelta{
+		ApplicationId: uint64(changes.AppID),
+		Deleted:       omitEmpty(changes.Deleted),
+	}
+	if changes.GlobalChanged {
+		result.GlobalState = tkvOrEmpty(changes.Global)
+	}
+	if len(changes.Local) != 0 {
+		locals := make([]model.ApplicationLocalStateDelta, len(changes.Local))
+		for i, local := range changes.Local {
+			locals[i] = model.ApplicationLocalStateDelta{
+				Address: local.Address.String(),
+				Deleted: omitEmpty(local.Deleted),
+			}
+			if !local.Deleted {
+				locals[i].KeyValue = tkvOrEmpty(local.State)
+			}
+		}
+		result.LocalStates = &locals
+	}
+	if len(changes.Boxes) != 0 {
+		boxes := make([]model.ApplicationBoxDelta, len(changes.Boxes))
+		for i, box := range changes.Boxes {
+			boxes[i] = model.ApplicationBoxDelta{
+				Name:    box.Name,
+				Deleted: omitEmpty(box.Deleted),
+			}
+			if !box.Deleted {
+				value := box.Value
+				if value == nil {
+					value = []byte{}
+				}
+				boxes[i].Value = &value
+			}
+		}
+		result.Boxes = &boxes
+	}
+	return result
+}
+
+// GetFeeEstimate returns the suggested fee per byte for a transaction to be included within a few numbers of rounds.
+// (GET /v2/transactions/fee-estimate)
+func (v2 *Handlers) GetFeeEstimate(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("GetFeeEstimate failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	estimates, err := v2.Node.EstimateFees(feeEstimateTargets)
+	if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.FeeEstimateResponse{
+		LastRound: uint64(stat.LastRound),
+		MinFee:    config.Consensus[stat.LastVersion].MinTxnFee,
+		Estimates: make([]model.FeeEstimate, len(estimates)),
+	}
+	for i, estimate := range estimates {
+		response.Estimates[i] = model.FeeEstimate{Rounds: estimate.Rounds, FeePerByte: estimate.FeePerByte}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // TransactionParams returns the suggested parameters for constructing a new transaction.
 // (GET /v2/transactions/params)
 func (v2 *Handlers) TransactionParams(ctx echo.Context) error {
@@ -1192,9 +2891,11 @@ type PreEncodedTxInfo struct {
 	GlobalStateDelta   *model.StateDelta          `codec:"global-state-delta,omitempty"`
 	LocalStateDelta    *[]model.AccountStateDelta `codec:"local-state-delta,omitempty"`
 	PoolError          string                     `codec:"pool-error"`
+	PoolErrorCode      *string                    `codec:"pool-error-code,omitempty"`
 	ReceiverRewards    *uint64                    `codec:"receiver-rewards,omitempty"`
 	SenderRewards      *uint64                    `codec:"sender-rewards,omitempty"`
 	Txn                transactions.SignedTxn     `codec:"txn"`
+	Events             *[]model.ApplicationEvent  `codec:"events,omitempty"`
 	Logs               *[][]byte                  `codec:"logs,omitempty"`
 	Inners             *[]PreEncodedTxInfo        `codec:"inner-txns,omitempty"`
 }
@@ -1232,6 +2933,10 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 		Txn:       txn.Txn,
 		PoolError: txn.PoolError,
 	}
+	if txn.PoolErrorCode != "" {
+		code := string(txn.PoolErrorCode)
+		response.PoolErrorCode = &code
+	}
 
 	if txn.ConfirmedRound != 0 {
 		r := uint64(txn.ConfirmedRound)
@@ -1247,6 +2952,9 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 		response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(txn)
 		response.Logs = convertLogs(txn)
 		response.Inners = convertInners(&txn)
+		if params.DecodeEvents != nil && *params.DecodeEvents {
+			v2.Contracts.decodeTxnEvents(&response)
+		}
 	}
 
 	handle, contentType, err := getCodecHandle((*string)(params.Format))
@@ -1262,7 +2970,7 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 }
 
 // getPendingTransactions returns to the provided context a list of uncomfirmed transactions currently in the transaction pool with optional Max/Address filters.
-func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format *string, addrFilter *string) error {
+func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format *string, fields *string, addrFilter *string) error {
 
 	stat, err := v2.Node.Status()
 	if err != nil {
@@ -1288,6 +2996,11 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
 
+	fs, err := fieldSelectionForFormat(fields, handle)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseFields, v2.Log)
+	}
+
 	txnPool, err := v2.Node.GetPendingTxnsFromPool()
 	if err != nil {
 		return internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
@@ -1303,6 +3016,9 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 	if max != nil && *max != 0 {
 		txnLimit = *max
 	}
+	if !fs.selects("top-transactions") {
+		txnLimit = 0
+	}
 
 	// Convert transactions to msgp / json strings
 	topTxns := make([]transactions.SignedTxn, 0)
@@ -1329,7 +3045,7 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 		TotalTransactions: uint64(len(txnPool)),
 	}
 
-	data, err := encode(handle, response)
+	data, err := encode(handle, fs.project(response, codecTags))
 	if err != nil {
 		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
 	}
@@ -1385,7 +3101,7 @@ func (v2 *Handlers) abortCatchup(ctx echo.Context, catchpoint string) error {
 // GetPendingTransactions returns the list of unconfirmed transactions currently in the transaction pool.
 // (GET /v2/transactions/pending)
 func (v2 *Handlers) GetPendingTransactions(ctx echo.Context, params model.GetPendingTransactionsParams) error {
-	return v2.getPendingTransactions(ctx, params.Max, (*string)(params.Format), nil)
+	return v2.getPendingTransactions(ctx, params.Max, (*string)(params.Format), params.Fields, nil)
 }
 
 // GetApplicationByID returns application information by app idx.
@@ -1537,10 +3253,96 @@ func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// holdersPage parses the pagination parameters of the asset holders and application opt-ins listings, returning
+// the address to resume after, if any, and the maximum number of accounts to return.
+func (v2 *Handlers) holdersPage(limit *uint64, next *string) (*basics.Address, uint64, error) {
+	pageLimit := v2.resourcesPageLimit(limit)
+	if next == nil || *next == "" {
+		return nil, pageLimit, nil
+	}
+	after, err := basics.UnmarshalChecksumAddress(*next)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &after, pageLimit, nil
+}
+
+// holdersLookupError surfaces the errors of the asset holders and application opt-ins lookups.
+func (v2 *Handlers) holdersLookupError(ctx echo.Context, err error) error {
+	if errors.Is(err, ledger.ErrNoCreatableHolderIndex) {
+		return notFound(ctx, err, errCreatableHolderIndexDisabled, v2.Log)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
+// GetAssetHolders gets a page of the accounts holding an asset.
+// (GET /v2/assets/{asset-id}/holders)
+func (v2 *Handlers) GetAssetHolders(ctx echo.Context, assetID uint64, params model.GetAssetHoldersParams) error {
+	after, limit, err := v2.holdersPage(params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseNextToken, v2.Log)
+	}
+
+	records, lastRound, err := v2.Node.LedgerForAPI().LookupAssetHolders(basics.AssetIndex(assetID), after, limit)
+	if err != nil {
+		return v2.holdersLookupError(ctx, err)
+	}
+
+	response := model.AssetHoldersResponse{
+		Round:   uint64(lastRound),
+		Holders: make([]model.AssetHolder, 0, len(records)),
+	}
+	for _, record := range records {
+		response.Holders = append(response.Holders, model.AssetHolder{
+			Address:  record.Address.String(),
+			Amount:   record.Amount,
+			IsFrozen: record.Frozen,
+		})
+	}
+	if uint64(len(records)) == limit {
+		nextToken := records[len(records)-1].Address.String()
+		response.NextToken = &nextToken
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetApplicationOptIns gets a page of the accounts opted in an application.
+// (GET /v2/applications/{application-id}/opted-in-accounts)
+func (v2 *Handlers) GetApplicationOptIns(ctx echo.Context, applicationID uint64, params model.GetApplicationOptInsParams) error {
+	after, limit, err := v2.holdersPage(params.Limit, params.Next)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseNextToken, v2.Log)
+	}
+
+	appIdx := basics.AppIndex(applicationID)
+	records, lastRound, err := v2.Node.LedgerForAPI().LookupAppOptIns(appIdx, after, limit)
+	if err != nil {
+		return v2.holdersLookupError(ctx, err)
+	}
+
+	response := model.ApplicationOptInsResponse{
+		Round:    uint64(lastRound),
+		Accounts: make([]model.ApplicationOptIn, 0, len(records)),
+	}
+	for _, record := range records {
+		response.Accounts = append(response.Accounts, model.ApplicationOptIn{
+			Address:       record.Address.String(),
+			AppLocalState: AppLocalState(record.AppLocalState, appIdx),
+		})
+	}
+	if uint64(len(records)) == limit {
+		nextToken := records[len(records)-1].Address.String()
+		response.NextToken = &nextToken
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetPendingTransactionsByAddress takes an Algorand address and returns its associated list of unconfirmed transactions currently in the transaction pool.
 // (GET /v2/accounts/{address}/transactions/pending)
 func (v2 *Handlers) GetPendingTransactionsByAddress(ctx echo.Context, addr string, params model.GetPendingTransactionsByAddressParams) error {
-	return v2.getPendingTransactions(ctx, params.Max, (*string)(params.Format), &addr)
+	return v2.getPendingTransactions(ctx, params.Max, (*string)(params.Format), params.Fields, &addr)
 }
 
 // StartCatchup Given a catchpoint, it starts catching up to this catchpoint
@@ -1647,6 +3449,56 @@ func (v2 *Handlers) wrapStateproofError(ctx echo.Context, err error) error {
 	return internalError(ctx, err, err.Error(), v2.Log)
 }
 
+const (
+	defaultStateProofMaxMissing = 10
+	maxStateProofMaxMissing     = 1000
+)
+
+// GetStateProofStatus returns the progress of the node towards forming state proofs.
+// (GET /v2/stateproofs/status)
+func (v2 *Handlers) GetStateProofStatus(ctx echo.Context, params model.GetStateProofStatusParams) error {
+	maxMissing := uint64(defaultStateProofMaxMissing)
+	if params.MaxMissing != nil {
+		maxMissing = *params.MaxMissing
+	}
+	if maxMissing > maxStateProofMaxMissing {
+		err := fmt.Errorf(errInvalidStateProofMaxMissing, maxStateProofMaxMissing)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	status, err := v2.Node.StateProofStatus(int(maxMissing))
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingStateProofStatus, v2.Log)
+	}
+
+	response := model.StateProofStatusResponse{
+		LatestRound:         uint64(status.LatestRound),
+		NextStateProofRound: uint64(status.NextStateProofRound),
+		DbSize:              uint64(status.DBSize),
+		Provers:             make([]model.StateProofProverStatus, 0, len(status.Provers)),
+	}
+	for _, p := range status.Provers {
+		prover := model.StateProofProverStatus{
+			Round:               uint64(p.Round),
+			SignedWeight:        p.SignedWeight,
+			ProvenWeight:        p.ProvenWeight,
+			AcceptableWeight:    p.AcceptableWeight,
+			TotalWeight:         p.TotalWeight,
+			Participants:        p.Participants,
+			Signers:             p.Signers,
+			MissingParticipants: make([]model.StateProofMissingParticipant, 0, len(p.MissingParticipants)),
+		}
+		for _, m := range p.MissingParticipants {
+			prover.MissingParticipants = append(prover.MissingParticipants, model.StateProofMissingParticipant{
+				Address: m.Address.String(),
+				Weight:  m.Weight,
+			})
+		}
+		response.Provers = append(response.Provers, prover)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetLightBlockHeaderProof Gets a proof of a light block header for a given round
 // (GET /v2/blocks/{round}/lightheader/proof)
 func (v2 *Handlers) GetLightBlockHeaderProof(ctx echo.Context, round uint64) error {
@@ -1796,3 +3648,91 @@ func (v2 *Handlers) SetBlockTimeStampOffset(ctx echo.Context, offset uint64) err
 	}
 	return ctx.NoContent(http.StatusOK)
 }
+
+// AdvanceDevModeRounds produces blocks right away in dev mode.
+// (POST /v2/devmode/advance)
+func (v2 *Handlers) AdvanceDevModeRounds(ctx echo.Context, params model.AdvanceDevModeRoundsParams) error {
+	rounds := uint64(1)
+	if params.Rounds != nil {
+		rounds = *params.Rounds
+	}
+	if rounds == 0 || rounds > maxDevModeAdvanceRounds {
+		err := fmt.Errorf("rounds must be between 1 and %d", maxDevModeAdvanceRounds)
+		return badRequest(ctx, err, fmt.Sprintf(errFailedAdvancingDevModeRounds, err), v2.Log)
+	}
+	var offset *int64
+	if params.Offset != nil {
+		if *params.Offset > math.MaxInt64 {
+			err := fmt.Errorf("block timestamp offset cannot be larger than max int64 value")
+			return badRequest(ctx, err, fmt.Sprintf(errFailedAdvancingDevModeRounds, err), v2.Log)
+		}
+		o := int64(*params.Offset)
+		offset = &o
+	}
+
+	round, err := v2.Node.AdvanceDevModeRounds(rounds, offset)
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedAdvancingDevModeRounds, err), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.DevModeAdvanceResponse{Round: uint64(round)})
+}
+
+// StartProfileCapture captures a profile of the node, in the background for CPU profiles and execution traces.
+// (POST /v2/debug/profiles)
+func (v2 *Handlers) StartProfileCapture(ctx echo.Context, params model.StartProfileCaptureParams) error {
+	if v2.Profiles == nil {
+		return notFound(ctx, errors.New(errProfilerNotEnabled), errProfilerNotEnabled, v2.Log)
+	}
+	duration := defaultProfileDuration
+	if params.Seconds != nil {
+		if *params.Seconds == 0 || *params.Seconds > uint64(maxProfileDuration/time.Second) {
+			err := fmt.Errorf(errInvalidProfileDuration, uint64(maxProfileDuration/time.Second))
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		duration = time.Duration(*params.Seconds) * time.Second
+	}
+
+	capture, err := v2.Profiles.start(params.Kind, duration, v2.Shutdown)
+	if err != nil {
+		if errors.Is(err, errUnknownProfileKind) {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+	}
+	v2.profileAuditLog(ctx).Infof("Started capture %d of a %s profile for %v", capture.Id, capture.Kind, time.Duration(capture.Duration)*time.Second)
+	return ctx.JSON(http.StatusOK, capture)
+}
+
+// GetProfileCaptures lists the profile captures kept by the node.
+// (GET /v2/debug/profiles)
+func (v2 *Handlers) GetProfileCaptures(ctx echo.Context) error {
+	if v2.Profiles == nil {
+		return notFound(ctx, errors.New(errProfilerNotEnabled), errProfilerNotEnabled, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.ProfileCapturesResponse{Captures: v2.Profiles.list()})
+}
+
+// GetProfileCapture downloads a finished profile capture.
+// (GET /v2/debug/profiles/{capture-id})
+func (v2 *Handlers) GetProfileCapture(ctx echo.Context, captureID uint64) error {
+	if v2.Profiles == nil {
+		return notFound(ctx, errors.New(errProfilerNotEnabled), errProfilerNotEnabled, v2.Log)
+	}
+	kind, data, err := v2.Profiles.get(captureID)
+	switch {
+	case errors.Is(err, errProfileCaptureNotFound):
+		return notFound(ctx, err, err.Error(), v2.Log)
+	case errors.Is(err, errProfileCaptureRunning):
+		return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+	case err != nil:
+		return internalError(ctx, err, fmt.Sprintf(errFailedCapturingProfile, err), v2.Log)
+	}
+	v2.profileAuditLog(ctx).Infof("Downloaded capture %d of a %s profile", captureID, kind)
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", profileFilename(kind, captureID)))
+	return ctx.Blob(http.StatusOK, echo.MIMEOctetStream, data)
+}
+
+// profileAuditLog returns the logger recording who captured and downloaded the profiles of the node.
+func (v2 *Handlers) profileAuditLog(ctx echo.Context) logging.Logger {
+	return v2.Log.With("remote", ctx.RealIP()).With("requestID", middlewares.RequestID(ctx))
+}
@@ -23,7 +23,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -234,5 +240,237 @@ func TestSimulateRequestStruct(t *testing.T) {
 		preEncodedTxPath(generatedResponseGraph).children = preEncodedTxPath(customResponseGraph).children
 	}
 
+	expectedGeneratedSourceMapGraph := map[string]*tagNode{
+		"<map-key>": {children: make(map[string]*tagNode)},
+		"<value>":   {children: make(map[string]*tagNode)},
+	}
+	sourceMapPath := func(graph *tagNode) *tagNode {
+		// Resolve the field model.SimulateRequest{}.SourceMaps[0].SourceMap
+		return graph.children["source-maps"].children["<value>"].children["source-map"]
+	}
+	if assert.Equal(t, expectedGeneratedSourceMapGraph, sourceMapPath(generatedResponseGraph).children) {
+		// The generated request type uses map[string]interface{} to represent a source map, while
+		// the custom request type uses logic.SourceMap.
+		sourceMapPath(generatedResponseGraph).children = sourceMapPath(customResponseGraph).children
+	}
+
 	generatedResponseGraph.AssertEquals(t, customResponseGraph)
 }
+
+func TestTallyCertificate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// agreement.Certificate votes have an unexported type, so the certificates are decoded from their encoding.
+	type testVote struct {
+		Sender basics.Address `codec:"snd"`
+	}
+	type testProposal struct {
+		OriginalProposer basics.Address `codec:"oprop"`
+	}
+	type testCert struct {
+		Proposal testProposal `codec:"prop"`
+		Votes    []testVote   `codec:"vote"`
+	}
+	makeCert := func(proposer basics.Address, voters ...basics.Address) (cert agreement.Certificate) {
+		c := testCert{Proposal: testProposal{OriginalProposer: proposer}}
+		for _, voter := range voters {
+			c.Votes = append(c.Votes, testVote{Sender: voter})
+		}
+		require.NoError(t, protocol.Decode(protocol.EncodeReflect(&c), &cert))
+		return
+	}
+
+	a := basics.Address{1}
+	b := basics.Address{2}
+	other := basics.Address{3}
+	activity := map[basics.Address]*participationActivity{a: {}, b: {}}
+
+	tallyCertificate(activity, 10, makeCert(a, a, other))
+	tallyCertificate(activity, 9, makeCert(other, a, b))
+	tallyCertificate(activity, 8, makeCert(a, other))
+
+	require.Equal(t, participationActivity{votes: 2, proposals: 2, lastVote: 10, lastProposal: 10}, *activity[a])
+	require.Equal(t, participationActivity{votes: 1, lastVote: 9}, *activity[b])
+	require.NotContains(t, activity, other)
+}
+
+func TestConvertSimulationSourceMaps(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	program := []byte{0x08, 0x81, 0x01}
+	sourceMap := logic.GetSourceMap([]string{"approval.teal"}, map[int]int{1: 1})
+	request, err := convertSimulationRequest(PreEncodedSimulateRequest{
+		SourceMaps: []PreEncodedSimulateSourceMap{{
+			ProgramHash: basics.Address(logic.HashProgram(program)).String(),
+			SourceMap:   sourceMap,
+		}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[crypto.Digest]logic.SourceMap{logic.HashProgram(program): sourceMap}, request.SourceMaps)
+
+	_, err = convertSimulationRequest(PreEncodedSimulateRequest{
+		SourceMaps: []PreEncodedSimulateSourceMap{{ProgramHash: "not a hash"}},
+	})
+	require.ErrorContains(t, err, "invalid source map program hash")
+
+	trace := convertProgramTrace([]simulation.OpcodeTraceUnit{{
+		PC:                 1,
+		StackPopCount:      1,
+		StackAdded:         []basics.TealValue{{Type: basics.TealBytesType, Bytes: "k"}},
+		ScratchSlotChanges: []simulation.ScratchChange{{Slot: 2, NewValue: basics.TealValue{Type: basics.TealUintType, Uint: 3}}},
+		StateChanges: []simulation.StateOperation{{
+			Operation: simulation.AppStateDelete,
+			AppState:  simulation.LocalState,
+			Account:   basics.Address{1},
+			Key:       "k",
+		}},
+		Source: &simulation.SourceLocation{File: "approval.teal", Line: 1},
+	}})
+	account := basics.Address{1}.String()
+	popCount := uint64(1)
+	require.Equal(t, []model.SimulationOpcodeTraceUnit{{
+		Pc:             1,
+		StackPopCount:  &popCount,
+		StackAdditions: &[]model.TealValue{{Type: uint64(basics.TealBytesType), Bytes: "aw=="}},
+		ScratchChanges: &[]model.ScratchChange{{Slot: 2, NewValue: model.TealValue{Type: uint64(basics.TealUintType), Bytes: "", Uint: 3}}},
+		StateChanges: &[]model.ApplicationStateOperation{{
+			Operation:    "d",
+			AppStateType: "l",
+			Account:      &account,
+			Key:          []byte("k"),
+		}},
+		SourceLocation: &model.SimulationSourceLocation{File: "approval.teal", Line: 1},
+	}}, *trace)
+}
+
+func TestConvertSimulationStateOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1}
+	round := uint64(5000)
+	request, err := convertSimulationRequest(PreEncodedSimulateRequest{
+		StateOverrides: &model.SimulationStateOverrides{
+			Accounts: &[]model.SimulationAccountOverride{{Address: addr.String(), Amount: 7}},
+			Apps: &[]model.SimulationAppOverride{{
+				AppId:       9,
+				GlobalState: &model.TealKeyValueStore{{Key: "Zw==", Value: model.TealValue{Type: uint64(basics.TealUintType), Uint: 42}}},
+				LocalStates: &[]model.SimulationLocalStateOverride{{
+					Address:  addr.String(),
+					KeyValue: model.TealKeyValueStore{{Key: "bA==", Value: model.TealValue{Type: uint64(basics.TealBytesType), Bytes: "dg=="}}},
+				}},
+			}},
+			Boxes: &[]model.SimulationBoxOverride{{AppId: 9, Name: []byte("box"), Value: []byte("value")}},
+			Round: &round,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &simulation.StateOverrides{
+		Balances: map[basics.Address]basics.MicroAlgos{addr: {Raw: 7}},
+		GlobalStates: map[basics.AppIndex]basics.TealKeyValue{
+			9: {"g": {Type: basics.TealUintType, Uint: 42}},
+		},
+		LocalStates: map[basics.Address]map[basics.AppIndex]basics.TealKeyValue{
+			addr: {9: {"l": {Type: basics.TealBytesType, Bytes: "v"}}},
+		},
+		Boxes: map[basics.AppIndex]map[string][]byte{9: {"box": []byte("value")}},
+		Round: 5000,
+	}, request.StateOverrides)
+
+	_, err = convertSimulationRequest(PreEncodedSimulateRequest{
+		StateOverrides: &model.SimulationStateOverrides{
+			Accounts: &[]model.SimulationAccountOverride{{Address: "not an address"}},
+		},
+	})
+	require.ErrorContains(t, err, "invalid account override address")
+}
+
+func TestConvertSimulationStateDiff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1}
+	size := uint64(8)
+	result := convertSimulationResult(simulation.Result{
+		StateDiff: &simulation.StateDiff{
+			Accounts: []simulation.AccountDiff{{
+				Address:          addr,
+				BalanceBefore:    basics.MicroAlgos{Raw: 300_000},
+				BalanceAfter:     basics.MicroAlgos{Raw: 99_000},
+				MinBalanceBefore: basics.MicroAlgos{Raw: 100_000},
+				MinBalanceAfter:  basics.MicroAlgos{Raw: 200_000},
+			}},
+			AssetHoldings: []simulation.AssetHoldingDiff{{Address: addr, Asset: 5, AmountAfter: 10, OptedIn: true}},
+			AppStates: []simulation.AppStateDiff{{
+				App:      9,
+				AppState: simulation.LocalState,
+				Account:  addr,
+				Key:      "l",
+				Before:   &basics.TealValue{Type: basics.TealUintType, Uint: 1},
+			}},
+			Boxes: []simulation.BoxDiff{{App: 9, Name: "box", SizeAfter: &size}},
+		},
+	})
+	account := addr.String()
+	optedIn := true
+	require.Equal(t, &model.SimulationStateDiff{
+		Accounts: []model.SimulationAccountDiff{{
+			Address:          account,
+			BalanceBefore:    300_000,
+			BalanceAfter:     99_000,
+			MinBalanceBefore: 100_000,
+			MinBalanceAfter:  200_000,
+		}},
+		AssetHoldings: []model.SimulationAssetHoldingDiff{{Address: account, AssetId: 5, AmountAfter: 10, OptedIn: &optedIn}},
+		AppStates: []model.SimulationAppStateDiff{{
+			AppId:        9,
+			AppStateType: "l",
+			Account:      &account,
+			Key:          []byte("l"),
+			Before:       &model.TealValue{Type: uint64(basics.TealUintType), Uint: 1},
+		}},
+		Boxes: []model.SimulationBoxDiff{{AppId: 9, Name: []byte("box"), SizeAfter: &size}},
+	}, result.StateDiff)
+
+	// no state diff was requested, or the group failed
+	require.Nil(t, convertSimulationResult(simulation.Result{}).StateDiff)
+}
+
+func TestErrorData(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Nil(t, errorData(fmt.Errorf("not an eval error")))
+
+	outerHash := crypto.Hash([]byte("outer"))
+	innerHash := crypto.Hash([]byte("inner"))
+	inner := logic.EvalError{Err: fmt.Errorf("/ 0"), ProgramHash: innerHash, PC: 5, Opcode: "/", AppID: 56}
+	outer := logic.EvalError{Err: inner, ProgramHash: outerHash, PC: 12, Opcode: "itxn_submit", AppID: 888}
+
+	data := errorData(fmt.Errorf("transaction %s: %w", "TXID", outer))
+	require.NotNil(t, data)
+	expected := map[string]interface{}{
+		"eval-error": map[string]interface{}{
+			"message": "/ 0",
+			"call-path": []map[string]interface{}{
+				{
+					"group-index":  0,
+					"program-hash": basics.Address(outerHash).String(),
+					"pc":           12,
+					"opcode":       "itxn_submit",
+					"app-id":       uint64(888),
+				},
+				{
+					"group-index":  0,
+					"program-hash": basics.Address(innerHash).String(),
+					"pc":           5,
+					"opcode":       "/",
+					"app-id":       uint64(56),
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, *data)
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime/pprof"
+	"runtime/trace"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+)
+
+const (
+	// maxProfileCaptures bounds the number of captures kept for download, the oldest finished
+	// captures being dropped first.
+	maxProfileCaptures = 16
+
+	// defaultProfileDuration is the duration of the CPU profiles and execution traces when none is requested.
+	defaultProfileDuration = 30 * time.Second
+
+	// maxProfileDuration bounds the duration of the CPU profiles and execution traces.
+	maxProfileDuration = 10 * time.Minute
+)
+
+// The kinds of profiles captured over a duration, the other kinds being the snapshots of the
+// runtime/pprof profiles, such as heap or goroutine.
+const (
+	profileKindCPU   = "cpu"
+	profileKindTrace = "trace"
+)
+
+var (
+	errProfileCaptureNotFound = errors.New("profile capture not found")
+	errProfileCaptureRunning  = errors.New("profile capture is still running")
+	errUnknownProfileKind     = errors.New("unknown profile kind")
+)
+
+type profileCapture struct {
+	id       uint64
+	kind     string
+	start    time.Time
+	duration time.Duration
+	done     bool
+	err      error
+	data     bytes.Buffer
+}
+
+// ProfileCaptures keeps the profiles of the node captured on demand, until they are downloaded.
+type ProfileCaptures struct {
+	mu       deadlock.Mutex
+	lastID   uint64
+	captures []*profileCapture
+}
+
+// MakeProfileCaptures creates an empty set of profile captures.
+func MakeProfileCaptures() *ProfileCaptures {
+	return &ProfileCaptures{}
+}
+
+// start captures a profile of the given kind. CPU profiles and execution traces are captured in
+// the background for duration, or until stop is closed; the other kinds are snapshots taken at once.
+// Only one CPU profile and one execution trace can be captured at a time.
+func (p *ProfileCaptures) start(kind string, duration time.Duration, stop <-chan struct{}) (model.ProfileCapture, error) {
+	c := &profileCapture{kind: kind, start: time.Now()}
+	var stopProfile func()
+	switch kind {
+	case profileKindCPU:
+		if err := pprof.StartCPUProfile(&c.data); err != nil {
+			return model.ProfileCapture{}, err
+		}
+		c.duration = duration
+		stopProfile = pprof.StopCPUProfile
+	case profileKindTrace:
+		if err := trace.Start(&c.data); err != nil {
+			return model.ProfileCapture{}, err
+		}
+		c.duration = duration
+		stopProfile = trace.Stop
+	default:
+		profile := pprof.Lookup(kind)
+		if profile == nil {
+			return model.ProfileCapture{}, fmt.Errorf("%w %s", errUnknownProfileKind, kind)
+		}
+		c.err = profile.WriteTo(&c.data, 0)
+		c.done = true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastID++
+	c.id = p.lastID
+	p.captures = append(p.captures, c)
+	for i := 0; len(p.captures) > maxProfileCaptures && i < len(p.captures); {
+		if p.captures[i].done {
+			p.captures = append(p.captures[:i], p.captures[i+1:]...)
+		} else {
+			i++
+		}
+	}
+	if stopProfile != nil {
+		go p.finish(c, stopProfile, stop)
+	}
+	return c.model(), nil
+}
+
+// finish stops the profile of c once its duration elapses, or when stop is closed.
+func (p *ProfileCaptures) finish(c *profileCapture, stopProfile func(), stop <-chan struct{}) {
+	timer := time.NewTimer(c.duration)
+	select {
+	case <-timer.C:
+	case <-stop:
+		timer.Stop()
+	}
+	stopProfile()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	c.duration = time.Since(c.start)
+	c.done = true
+}
+
+// list returns the captures kept, the oldest first.
+func (p *ProfileCaptures) list() []model.ProfileCapture {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	captures := make([]model.ProfileCapture, len(p.captures))
+	for i, c := range p.captures {
+		captures[i] = c.model()
+	}
+	return captures
+}
+
+// get returns the kind and the data of a finished capture.
+func (p *ProfileCaptures) get(id uint64) (string, []byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, c := range p.captures {
+		if c.id != id {
+			continue
+		}
+		if !c.done {
+			return "", nil, errProfileCaptureRunning
+		}
+		if c.err != nil {
+			return "", nil, c.err
+		}
+		return c.kind, c.data.Bytes(), nil
+	}
+	return "", nil, errProfileCaptureNotFound
+}
+
+// model converts the capture, with the lock of its ProfileCaptures held.
+func (c *profileCapture) model() model.ProfileCapture {
+	capture := model.ProfileCapture{
+		Id:       c.id,
+		Kind:     c.kind,
+		Start:    uint64(c.start.Unix()),
+		Duration: uint64(c.duration.Seconds()),
+		Done:     c.done,
+	}
+	if c.done {
+		capture.Size = uint64(c.data.Len())
+	}
+	if c.err != nil {
+		errString := c.err.Error()
+		capture.Error = &errString
+	}
+	return capture
+}
+
+// profileFilename names the downloaded profiles after their kind and capture, execution traces
+// being read by go tool trace and the other profiles by go tool pprof.
+func profileFilename(kind string, id uint64) string {
+	if kind == profileKindTrace {
+		return fmt.Sprintf("trace-%d.out", id)
+	}
+	return fmt.Sprintf("%s-%d.pprof", kind, id)
+}
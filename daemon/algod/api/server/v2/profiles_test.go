@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProfileCapturesSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	p := MakeProfileCaptures()
+	capture, err := p.start("heap", time.Hour, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), capture.Id)
+	require.True(t, capture.Done)
+	require.Zero(t, capture.Duration)
+	require.NotZero(t, capture.Size)
+
+	kind, data, err := p.get(capture.Id)
+	require.NoError(t, err)
+	require.Equal(t, "heap", kind)
+	require.Len(t, data, int(capture.Size))
+
+	_, err = p.start("nosuchprofile", time.Hour, nil)
+	require.ErrorIs(t, err, errUnknownProfileKind)
+	_, _, err = p.get(capture.Id + 1)
+	require.ErrorIs(t, err, errProfileCaptureNotFound)
+	require.Len(t, p.list(), 1)
+}
+
+func TestProfileCapturesCPU(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	p := MakeProfileCaptures()
+	stop := make(chan struct{})
+	capture, err := p.start(profileKindCPU, time.Hour, stop)
+	require.NoError(t, err)
+	require.False(t, capture.Done)
+	require.Equal(t, uint64(time.Hour.Seconds()), capture.Duration)
+
+	_, _, err = p.get(capture.Id)
+	require.ErrorIs(t, err, errProfileCaptureRunning)
+
+	// a single CPU profile is captured at a time
+	_, err = p.start(profileKindCPU, time.Hour, stop)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, errUnknownProfileKind)
+
+	close(stop)
+	require.Eventually(t, func() bool {
+		_, _, err := p.get(capture.Id)
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	captures := p.list()
+	require.Len(t, captures, 1)
+	require.True(t, captures[0].Done)
+	require.NotZero(t, captures[0].Size)
+	require.Less(t, captures[0].Duration, uint64(time.Hour.Seconds()))
+}
+
+func TestProfileCapturesRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	p := MakeProfileCaptures()
+	for i := 0; i < maxProfileCaptures+3; i++ {
+		_, err := p.start("goroutine", 0, nil)
+		require.NoError(t, err)
+	}
+
+	captures := p.list()
+	require.Len(t, captures, maxProfileCaptures)
+	require.Equal(t, uint64(4), captures[0].Id)
+	require.Equal(t, uint64(maxProfileCaptures+3), captures[len(captures)-1].Id)
+	_, _, err := p.get(1)
+	require.ErrorIs(t, err, errProfileCaptureNotFound)
+}
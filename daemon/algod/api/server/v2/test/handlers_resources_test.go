@@ -17,11 +17,13 @@
 package test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"net/http"
 	"net/http/httptest"
+	"sort"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -35,7 +37,9 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
@@ -50,6 +54,22 @@ type mockLedger struct {
 	latest   basics.Round
 	blocks   []bookkeeping.Block
 	tracer   logic.EvalTracer
+
+	// noHolderIndex makes the asset holders and application opt-ins lookups fail as if the index was disabled
+	noHolderIndex bool
+
+	// rekeys is the rekey history kept past rekeyBase, the lookups failing as on a non-archival ledger when nil
+	rekeys    map[basics.Address][]ledgercore.RekeyEvent
+	rekeyBase basics.Round
+
+	// totals is the totals history kept past totalsBase, the lookups failing as on a non-archival ledger when nil
+	totals     []ledgercore.RoundTotals
+	totalsBase basics.Round
+
+	// absenceProofs makes the absence proofs available, as on an archival ledger, for the latest round only
+	absenceProofs bool
+	// absenceProofsLimited refuses to build the commitments of the holders, as a ledger which just built one
+	absenceProofsLimited bool
 }
 
 func (l *mockLedger) GetTracer() logic.EvalTracer {
@@ -92,6 +112,15 @@ func (l *mockLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, er
 }
 
 func (l *mockLedger) Latest() basics.Round { return l.latest }
+func (l *mockLedger) Seed(rnd basics.Round) (committee.Seed, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) Circulation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error) {
+	panic("not implemented")
+}
 
 func (l *mockLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ar ledgercore.AssetResource, err error) {
 	ad, ok := l.accounts[addr]
@@ -119,6 +148,147 @@ func (l *mockLedger) LookupApplication(rnd basics.Round, addr basics.Address, ai
 	}
 	return ar, nil
 }
+func (l *mockLedger) LookupAssets(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64, createdOnly bool) ([]ledgercore.AssetResourceWithID, basics.Round, error) {
+	ad := l.accounts[addr]
+	var ids []basics.AssetIndex
+	for aidx := range ad.Assets {
+		if _, ok := ad.AssetParams[aidx]; (ok || !createdOnly) && aidx > assetIDGT {
+			ids = append(ids, aidx)
+		}
+	}
+	for aidx := range ad.AssetParams {
+		if _, ok := ad.Assets[aidx]; !ok && aidx > assetIDGT {
+			ids = append(ids, aidx)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if uint64(len(ids)) > limit {
+		ids = ids[:limit]
+	}
+	res := make([]ledgercore.AssetResourceWithID, len(ids))
+	for i, aidx := range ids {
+		res[i].AssetID = aidx
+		res[i].AssetResource, _ = l.LookupAsset(l.latest, addr, aidx)
+	}
+	return res, l.latest, nil
+}
+func (l *mockLedger) LookupApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64, createdOnly bool) ([]ledgercore.AppResourceWithID, basics.Round, error) {
+	ad := l.accounts[addr]
+	var ids []basics.AppIndex
+	for aidx := range ad.AppLocalStates {
+		if _, ok := ad.AppParams[aidx]; (ok || !createdOnly) && aidx > appIDGT {
+			ids = append(ids, aidx)
+		}
+	}
+	for aidx := range ad.AppParams {
+		if _, ok := ad.AppLocalStates[aidx]; !ok && aidx > appIDGT {
+			ids = append(ids, aidx)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if uint64(len(ids)) > limit {
+		ids = ids[:limit]
+	}
+	res := make([]ledgercore.AppResourceWithID, len(ids))
+	for i, aidx := range ids {
+		res[i].AppID = aidx
+		res[i].AppResource, _ = l.LookupApplication(l.latest, addr, aidx)
+	}
+	return res, l.latest, nil
+}
+
+// sortedAddresses returns the addresses of the accounts selected by has, greater than after, ordered by address.
+func (l *mockLedger) sortedAddresses(after *basics.Address, limit uint64, has func(basics.AccountData) bool) []basics.Address {
+	var addrs []basics.Address
+	for addr, ad := range l.accounts {
+		if has(ad) && (after == nil || bytes.Compare(addr[:], after[:]) > 0) {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	if uint64(len(addrs)) > limit {
+		addrs = addrs[:limit]
+	}
+	return addrs
+}
+func (l *mockLedger) LookupAssetHolders(aidx basics.AssetIndex, after *basics.Address, limit uint64) ([]ledgercore.AssetHoldingWithAddress, basics.Round, error) {
+	if l.noHolderIndex {
+		return nil, 0, ledger.ErrNoCreatableHolderIndex
+	}
+	addrs := l.sortedAddresses(after, limit, func(ad basics.AccountData) bool {
+		_, ok := ad.Assets[aidx]
+		return ok
+	})
+	res := make([]ledgercore.AssetHoldingWithAddress, len(addrs))
+	for i, addr := range addrs {
+		res[i].Address = addr
+		res[i].AssetHolding = l.accounts[addr].Assets[aidx]
+	}
+	return res, l.latest, nil
+}
+func (l *mockLedger) LookupAppOptIns(appIdx basics.AppIndex, after *basics.Address, limit uint64) ([]ledgercore.AppLocalStateWithAddress, basics.Round, error) {
+	if l.noHolderIndex {
+		return nil, 0, ledger.ErrNoCreatableHolderIndex
+	}
+	addrs := l.sortedAddresses(after, limit, func(ad basics.AccountData) bool {
+		_, ok := ad.AppLocalStates[appIdx]
+		return ok
+	})
+	res := make([]ledgercore.AppLocalStateWithAddress, len(addrs))
+	for i, addr := range addrs {
+		res[i].Address = addr
+		res[i].AppLocalState = l.accounts[addr].AppLocalStates[appIdx]
+	}
+	return res, l.latest, nil
+}
+func (l *mockLedger) LookupRekeyHistory(addr basics.Address) ([]ledgercore.RekeyEvent, basics.Round, error) {
+	if l.rekeys == nil {
+		return nil, 0, ledger.ErrNoRekeyHistory
+	}
+	return l.rekeys[addr], l.rekeyBase, nil
+}
+func (l *mockLedger) LookupTotalsHistory(minRound, maxRound basics.Round, interval uint64, limit uint64) ([]ledgercore.RoundTotals, basics.Round, error) {
+	if l.totals == nil {
+		return nil, 0, ledger.ErrNoTotalsHistory
+	}
+	var res []ledgercore.RoundTotals
+	for _, rt := range l.totals {
+		if rt.Round >= minRound && rt.Round <= maxRound && uint64(rt.Round-minRound)%interval == 0 && uint64(len(res)) < limit {
+			res = append(res, rt)
+		}
+	}
+	return res, l.totalsBase, nil
+}
+func (l *mockLedger) AbsenceProof(rnd basics.Round, scope ledgercore.AbsenceProofScope, addr basics.Address) (ledgercore.AbsenceProof, error) {
+	if !l.absenceProofs {
+		return ledgercore.AbsenceProof{}, ledger.ErrNoAbsenceProofs
+	}
+	if rnd == 0 {
+		rnd = l.latest
+	}
+	if rnd != l.latest && scope.Creatable == 0 {
+		return ledgercore.AbsenceProof{}, ledger.ErrAbsenceProofRound
+	}
+	if rnd != l.latest {
+		return ledgercore.AbsenceProof{}, &ledger.RoundOffsetError{}
+	}
+	if l.absenceProofsLimited && scope.Creatable != 0 {
+		return ledgercore.AbsenceProof{}, ledger.ErrAbsenceProofRateLimited
+	}
+	var addrs []basics.Address
+	for a, ad := range l.accounts {
+		if scope.Creatable == 0 {
+			addrs = append(addrs, a)
+		} else if _, ok := ad.Assets[basics.AssetIndex(scope.Creatable)]; ok && scope.CreatableType == basics.AssetCreatable {
+			addrs = append(addrs, a)
+		}
+	}
+	commitment, err := ledgercore.MakeAbsenceCommitment(rnd, scope, addrs)
+	if err != nil {
+		return ledgercore.AbsenceProof{}, err
+	}
+	return commitment.Prove(addr)
+}
 func (l *mockLedger) BlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
 	panic("not implemented")
 }
@@ -398,3 +568,402 @@ func TestAccountInformationResourceLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountResourcesPagination(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// 1000 resources, above the configured MaxAPIResourcesPerAccount
+	handlers, addr, acctData := setupTestForLargeResources(t, 1000, 100, randomAccountWithResources)
+
+	// assets are held (i%4 == 0) or created (i%4 == 1)
+	var assetIDs []uint64
+	var next *string
+	for {
+		ctx, rec := newReq(t)
+		limit := uint64(200)
+		err := handlers.AccountAssetsInformation(ctx, addr.String(), model.AccountAssetsInformationParams{Limit: &limit, Next: next})
+		require.NoError(t, err)
+		require.Equal(t, 200, rec.Code)
+		var ret model.AccountAssetsInformationResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &ret)
+		require.NoError(t, err)
+		// the limit is capped by MaxAPIResourcesPerAccount
+		require.LessOrEqual(t, len(ret.Assets), 100)
+		for _, asset := range ret.Assets {
+			if asset.AssetHolding != nil {
+				require.Equal(t, acctData.Assets[basics.AssetIndex(asset.AssetID)].Amount, asset.AssetHolding.Amount)
+			} else {
+				require.NotNil(t, asset.CreatedAsset)
+			}
+			assetIDs = append(assetIDs, asset.AssetID)
+		}
+		if ret.NextToken == nil {
+			break
+		}
+		next = ret.NextToken
+	}
+	// the random account holds asset 0, which is not a valid asset ID and is never listed
+	require.Len(t, assetIDs, len(acctData.Assets)+len(acctData.AssetParams)-1)
+	require.True(t, sort.SliceIsSorted(assetIDs, func(i, j int) bool { return assetIDs[i] < assetIDs[j] }))
+
+	// only created applications are listed and count toward the limit, local states are skipped
+	var appIDs []uint64
+	next = nil
+	for {
+		ctx, rec := newReq(t)
+		limit := uint64(10)
+		err := handlers.AccountCreatedApplications(ctx, addr.String(), model.AccountCreatedApplicationsParams{Limit: &limit, Next: next})
+		require.NoError(t, err)
+		require.Equal(t, 200, rec.Code)
+		var ret model.AccountCreatedApplicationsResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &ret)
+		require.NoError(t, err)
+		if ret.NextToken != nil {
+			require.Len(t, ret.CreatedApps, int(limit))
+		}
+		for _, app := range ret.CreatedApps {
+			require.Contains(t, acctData.AppParams, basics.AppIndex(app.Id))
+			appIDs = append(appIDs, app.Id)
+		}
+		if ret.NextToken == nil {
+			break
+		}
+		next = ret.NextToken
+	}
+	require.Len(t, appIDs, len(acctData.AppParams))
+
+	// only created assets are listed, holdings are skipped
+	var createdAssetIDs []uint64
+	next = nil
+	for {
+		ctx, rec := newReq(t)
+		err := handlers.AccountCreatedAssets(ctx, addr.String(), model.AccountCreatedAssetsParams{Next: next})
+		require.NoError(t, err)
+		require.Equal(t, 200, rec.Code)
+		var ret model.AccountCreatedAssetsResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &ret)
+		require.NoError(t, err)
+		for _, asset := range ret.CreatedAssets {
+			require.Contains(t, acctData.AssetParams, basics.AssetIndex(asset.Index))
+			require.Equal(t, addr.String(), asset.Params.Creator)
+			createdAssetIDs = append(createdAssetIDs, asset.Index)
+		}
+		if ret.NextToken == nil {
+			break
+		}
+		next = ret.NextToken
+	}
+	require.Len(t, createdAssetIDs, len(acctData.AssetParams))
+
+	// malformed next token
+	ctx, rec := newReq(t)
+	badNext := "not-a-number"
+	err := handlers.AccountAssetsInformation(ctx, addr.String(), model.AccountAssetsInformationParams{Next: &badNext})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
+func TestCreatableHoldersPagination(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const assetID = basics.AssetIndex(7)
+	const appID = basics.AppIndex(9)
+	ml := mockLedger{
+		accounts: make(map[basics.Address]basics.AccountData),
+		latest:   basics.Round(10),
+	}
+	// every account holds the asset, every other account is opted in the application
+	for i := 0; i < 50; i++ {
+		ad := ledgertesting.RandomAccountData(0)
+		ad.Assets = map[basics.AssetIndex]basics.AssetHolding{assetID: ledgertesting.RandomAssetHolding(false)}
+		if i%2 == 0 {
+			ad.AppLocalStates = map[basics.AppIndex]basics.AppLocalState{appID: ledgertesting.RandomAppLocalState()}
+		}
+		ml.accounts[ledgertesting.RandomAddress()] = ad
+	}
+	mockNode := makeMockNode(&ml, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.config.MaxAPIResourcesPerAccount = 20
+	handlers := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	var holders []string
+	var next *string
+	for {
+		ctx, rec := newReq(t)
+		limit := uint64(30)
+		err := handlers.GetAssetHolders(ctx, uint64(assetID), model.GetAssetHoldersParams{Limit: &limit, Next: next})
+		require.NoError(t, err)
+		require.Equal(t, 200, rec.Code)
+		var ret model.AssetHoldersResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &ret)
+		require.NoError(t, err)
+		// the limit is capped by MaxAPIResourcesPerAccount
+		require.LessOrEqual(t, len(ret.Holders), 20)
+		for _, holder := range ret.Holders {
+			addr, err := basics.UnmarshalChecksumAddress(holder.Address)
+			require.NoError(t, err)
+			require.Equal(t, ml.accounts[addr].Assets[assetID].Amount, holder.Amount)
+			holders = append(holders, holder.Address)
+		}
+		if ret.NextToken == nil {
+			break
+		}
+		next = ret.NextToken
+	}
+	require.Len(t, holders, len(ml.accounts))
+
+	var optIns int
+	next = nil
+	for {
+		ctx, rec := newReq(t)
+		limit := uint64(10)
+		err := handlers.GetApplicationOptIns(ctx, uint64(appID), model.GetApplicationOptInsParams{Limit: &limit, Next: next})
+		require.NoError(t, err)
+		require.Equal(t, 200, rec.Code)
+		var ret model.ApplicationOptInsResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &ret)
+		require.NoError(t, err)
+		for _, account := range ret.Accounts {
+			addr, err := basics.UnmarshalChecksumAddress(account.Address)
+			require.NoError(t, err)
+			require.Contains(t, ml.accounts[addr].AppLocalStates, appID)
+			require.Equal(t, uint64(appID), account.AppLocalState.Id)
+			optIns++
+		}
+		if ret.NextToken == nil {
+			break
+		}
+		next = ret.NextToken
+	}
+	require.Equal(t, 25, optIns)
+
+	// malformed next token
+	ctx, rec := newReq(t)
+	badNext := "not-an-address"
+	err := handlers.GetAssetHolders(ctx, uint64(assetID), model.GetAssetHoldersParams{Next: &badNext})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	// index disabled
+	ml.noHolderIndex = true
+	ctx, rec = newReq(t)
+	err = handlers.GetApplicationOptIns(ctx, uint64(appID), model.GetApplicationOptInsParams{})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+}
+
+func TestAccountRekeyHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	addr, auth1, auth2 := ledgertesting.RandomAddress(), ledgertesting.RandomAddress(), ledgertesting.RandomAddress()
+	acct := ledgertesting.RandomAccountData(0)
+	acct.AuthAddr = auth2
+	ml := mockLedger{
+		accounts: map[basics.Address]basics.AccountData{addr: acct},
+		latest:   basics.Round(10),
+	}
+	mockNode := makeMockNode(&ml, t.Name(), nil, cannedStatusReportGolden, false)
+	handlers := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	lookup := func(address string, expectedCode int) model.AccountRekeyHistoryResponse {
+		ctx, rec := newReq(t)
+		err := handlers.AccountRekeyHistory(ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		var ret model.AccountRekeyHistoryResponse
+		if expectedCode == 200 {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ret))
+		}
+		return ret
+	}
+
+	// not kept by non-archival nodes
+	lookup(addr.String(), 404)
+	lookup("not-an-address", 400)
+
+	ml.rekeys = map[basics.Address][]ledgercore.RekeyEvent{addr: {
+		// the closing of the account, which was not rekeyed
+		{Address: addr, Round: 2},
+		{Address: addr, Round: 3, AuthAddr: auth1},
+		{Address: addr, Round: 5},
+		{Address: addr, Round: 7, AuthAddr: auth2},
+		{Address: addr, Round: 8, AuthAddr: auth2},
+	}}
+	ret := lookup(addr.String(), 200)
+	require.Equal(t, uint64(10), ret.Round)
+	require.Equal(t, auth2.String(), *ret.AuthAddr)
+	require.Equal(t, uint64(1), ret.HistoryStartRound)
+	require.Len(t, ret.Rekeys, 3)
+	require.Equal(t, uint64(3), ret.Rekeys[0].Round)
+	require.Equal(t, auth1.String(), *ret.Rekeys[0].AuthAddr)
+	require.Equal(t, uint64(5), ret.Rekeys[1].Round)
+	require.Nil(t, ret.Rekeys[1].AuthAddr)
+	require.Equal(t, uint64(7), ret.Rekeys[2].Round)
+	require.Equal(t, auth2.String(), *ret.Rekeys[2].AuthAddr)
+
+	// past the start of the history, the account may have been rekeyed before the first change
+	ml.rekeyBase = 1
+	ret = lookup(addr.String(), 200)
+	require.Equal(t, uint64(2), ret.HistoryStartRound)
+	require.Len(t, ret.Rekeys, 4)
+	require.Equal(t, uint64(2), ret.Rekeys[0].Round)
+	require.Nil(t, ret.Rekeys[0].AuthAddr)
+
+	// accounts never rekeyed have an empty history
+	ret = lookup(auth1.String(), 200)
+	require.Nil(t, ret.AuthAddr)
+	require.Empty(t, ret.Rekeys)
+}
+
+func TestGetLedgerTotals(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ml := mockLedger{latest: basics.Round(2000)}
+	mockNode := makeMockNode(&ml, t.Name(), nil, cannedStatusReportGolden, false)
+	handlers := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	lookup := func(minRound, maxRound, interval *uint64, expectedCode int) model.LedgerTotalsResponse {
+		ctx, rec := newReq(t)
+		err := handlers.GetLedgerTotals(ctx, model.GetLedgerTotalsParams{MinRound: minRound, MaxRound: maxRound, Interval: interval})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		var ret model.LedgerTotalsResponse
+		if expectedCode == 200 {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ret))
+		}
+		return ret
+	}
+	u := func(v uint64) *uint64 { return &v }
+
+	// not kept by non-archival nodes
+	lookup(nil, nil, nil, 404)
+
+	ml.totalsBase = 100
+	for rnd := basics.Round(101); rnd <= ml.latest; rnd++ {
+		rt := ledgercore.RoundTotals{Round: rnd, RewardsRate: 7}
+		rt.Totals.Online.Money.Raw = uint64(rnd) * 1000
+		rt.Totals.Offline.Money.Raw = 500
+		rt.Totals.NotParticipating.Money.Raw = 300
+		rt.FeeSink.Raw = 100
+		rt.RewardsPool.Raw = 200
+		ml.totals = append(ml.totals, rt)
+	}
+
+	// the latest round by default
+	ret := lookup(nil, nil, nil, 200)
+	require.Equal(t, uint64(2000), ret.CurrentRound)
+	require.Equal(t, uint64(101), ret.HistoryStartRound)
+	require.Len(t, ret.Totals, 1)
+	require.Equal(t, model.LedgerTotals{
+		Round:                 2000,
+		OnlineMoney:           2000000,
+		OfflineMoney:          500,
+		NotParticipatingMoney: 300,
+		TotalMoney:            2000800,
+		CirculatingMoney:      2000500,
+		RewardsRate:           7,
+		FeeSink:               100,
+		RewardsPool:           200,
+	}, ret.Totals[0])
+
+	// downsampled, the rounds before the history being skipped
+	ret = lookup(u(1), u(1000), u(100), 200)
+	require.Len(t, ret.Totals, 9)
+	for i, totals := range ret.Totals {
+		require.Equal(t, uint64(101+100*i), totals.Round)
+	}
+	lookup(u(1), u(100), nil, 404)
+
+	// at most maxLedgerTotalsRounds rounds
+	ret = lookup(u(1001), u(2000), nil, 200)
+	require.Len(t, ret.Totals, 1000)
+	lookup(u(1000), u(2000), nil, 400)
+	lookup(u(1000), u(2000), u(0), 400)
+	lookup(u(1000), u(2000), u(2), 200)
+
+	lookup(u(10), u(5), nil, 400)
+	lookup(nil, u(2001), nil, 400)
+}
+
+func TestAccountAbsenceProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const assetID = 1000
+	holder, other, absent := ledgertesting.RandomAddress(), ledgertesting.RandomAddress(), ledgertesting.RandomAddress()
+	holderData := ledgertesting.RandomAccountData(0)
+	holderData.Assets = map[basics.AssetIndex]basics.AssetHolding{assetID: {Amount: 10}}
+	ml := mockLedger{
+		accounts: map[basics.Address]basics.AccountData{
+			holder: holderData,
+			other:  ledgertesting.RandomAccountData(0),
+		},
+		latest: basics.Round(10),
+	}
+	mockNode := makeMockNode(&ml, t.Name(), nil, cannedStatusReportGolden, false)
+	handlers := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	prove := func(address string, params model.AccountAbsenceProofParams, expectedCode int) model.AbsenceProofResponse {
+		ctx, rec := newReq(t)
+		err := handlers.AccountAbsenceProof(ctx, address, params)
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		var ret model.AbsenceProofResponse
+		if expectedCode == 200 {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ret))
+		}
+		return ret
+	}
+	verify := func(ret model.AbsenceProofResponse, scope ledgercore.AbsenceProofScope, addr basics.Address) {
+		var proof ledgercore.AbsenceProof
+		require.NoError(t, protocol.DecodeReflect(ret.Proof, &proof))
+		require.NoError(t, proof.Verify(ret.Root, scope, addr))
+		require.Equal(t, ret.LowerIndex+1, ret.UpperIndex)
+	}
+	assetParam := uint64(assetID)
+	zero := uint64(0)
+
+	// not built by non-archival nodes
+	prove(absent.String(), model.AccountAbsenceProofParams{}, 404)
+	prove("not-an-address", model.AccountAbsenceProofParams{}, 400)
+
+	ml.absenceProofs = true
+	ret := prove(absent.String(), model.AccountAbsenceProofParams{}, 200)
+	require.Equal(t, uint64(10), ret.Round)
+	verify(ret, ledgercore.AbsenceProofScope{}, absent)
+	prove(other.String(), model.AccountAbsenceProofParams{}, 400)
+
+	// an existing account holding no such asset
+	assetScope := ledgercore.AbsenceProofScope{Creatable: assetID, CreatableType: basics.AssetCreatable}
+	ret = prove(other.String(), model.AccountAbsenceProofParams{AssetId: &assetParam}, 200)
+	verify(ret, assetScope, other)
+	prove(holder.String(), model.AccountAbsenceProofParams{AssetId: &assetParam}, 400)
+	prove(other.String(), model.AccountAbsenceProofParams{AssetId: &assetParam, ApplicationId: &assetParam}, 400)
+	prove(other.String(), model.AccountAbsenceProofParams{AssetId: &zero}, 400)
+
+	// the rounds past the latest one, or no longer kept in memory
+	round := uint64(11)
+	prove(absent.String(), model.AccountAbsenceProofParams{Round: &round}, 404)
+	round = 5
+	prove(absent.String(), model.AccountAbsenceProofParams{Round: &round}, 404)
+	prove(other.String(), model.AccountAbsenceProofParams{Round: &round, AssetId: &assetParam}, 404)
+
+	// the commitments of the holders are built at a limited rate
+	ml.absenceProofsLimited = true
+	prove(other.String(), model.AccountAbsenceProofParams{AssetId: &assetParam}, 503)
+	prove(absent.String(), model.AccountAbsenceProofParams{}, 200)
+}
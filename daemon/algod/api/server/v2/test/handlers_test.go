@@ -19,6 +19,7 @@ package test
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,15 +28,20 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"golang.org/x/exp/slices"
 
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/websocket"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -49,24 +55,30 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklearray"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/stateproofmsg"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/logic/mocktracer"
 	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 const stateProofInterval = uint64(256)
@@ -133,6 +145,33 @@ func TestAccountInformation(t *testing.T) {
 	accountInformationTest(t, "bad account", 400)
 }
 
+func TestAccountInformationFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	fields := "address,amount"
+	err := handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Fields: &fields})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, map[string]interface{}{
+		"address": poolAddrResponseGolden.Address,
+		"amount":  float64(poolAddrResponseGolden.Amount),
+	}, response)
+
+	// fields are not supported with msgpack
+	handler, c, rec, _, _, releasefunc = setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	format := "msgpack"
+	err = handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Fields: &fields, Format: (*model.AccountInformationParamsFormat)(&format)})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func getBlockTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
 	defer releasefunc()
@@ -152,6 +191,104 @@ func TestGetBlock(t *testing.T) {
 	getBlockTest(t, 0, "bad format", 400)
 }
 
+func TestGetBlockFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	fields := "block.rnd,block.gen"
+	err := handler.GetBlock(c, 0, model.GetBlockParams{Fields: &fields})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response, 1)
+	require.Contains(t, response, "block")
+	for field := range response["block"] {
+		require.Contains(t, []string{"rnd", "gen"}, field)
+	}
+}
+
+func getBlockHeaderTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	err := handler.GetBlockHeader(c, blockNum, model.GetBlockHeaderParams{Format: (*model.GetBlockHeaderParamsFormat)(&format)})
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestGetBlockHeader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getBlockHeaderTest(t, 0, "json", 200)
+	getBlockHeaderTest(t, 0, "msgpack", 200)
+	getBlockHeaderTest(t, 1, "json", 404)
+	getBlockHeaderTest(t, 1, "msgpack", 404)
+	getBlockHeaderTest(t, 0, "bad format", 400)
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	format := "msgpack"
+	err := handler.GetBlockHeader(c, 0, model.GetBlockHeaderParams{Format: (*model.GetBlockHeaderParamsFormat)(&format)})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response struct {
+		BlockHeader bookkeeping.BlockHeader `codec:"blockHeader"`
+	}
+	require.NoError(t, protocol.DecodeReflect(rec.Body.Bytes(), &response))
+	hdr, err := handler.Node.LedgerForAPI().BlockHdr(0)
+	require.NoError(t, err)
+	require.Equal(t, hdr, response.BlockHeader)
+}
+
+func getBlockCertificateTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	err := handler.GetBlockCertificate(c, blockNum, model.GetBlockCertificateParams{Format: (*model.GetBlockCertificateParamsFormat)(&format)})
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+}
+
+func TestGetBlockCertificate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	getBlockCertificateTest(t, 0, "json", 200)
+	getBlockCertificateTest(t, 0, "msgpack", 200)
+	getBlockCertificateTest(t, 1, "json", 404)
+	getBlockCertificateTest(t, 1, "msgpack", 404)
+	getBlockCertificateTest(t, 0, "bad format", 400)
+}
+
+func TestGetBlockSortition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	err := handler.GetBlockSortition(c, 0)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response model.BlockSortitionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	blk, err := handler.Node.LedgerForAPI().Block(0)
+	require.NoError(t, err)
+	seed := blk.Seed()
+	require.Equal(t, seed[:], response.Seed)
+	require.Empty(t, response.Votes)
+
+	handler, c, rec, _, _, releasefunc = setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	err = handler.GetBlockSortition(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+}
+
 func testGetLedgerStateDelta(t *testing.T, round uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
 	defer releasefunc()
@@ -245,6 +382,27 @@ func TestSyncRound(t *testing.T) {
 	err = handler.GetSyncRound(c)
 	require.NoError(t, err)
 	require.Equal(t, 404, rec.Code)
+	mockCall.Unset()
+	c, rec = newReq(t)
+
+	// TestAcknowledgeSyncRound 200
+	mockCall = mockNode.On("AcknowledgeRound", uint64(4)).Return(nil)
+	getCall := mockNode.On("GetSyncRound").Return(5)
+	err = handler.AcknowledgeSyncRound(c, 4)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var syncRoundResponse model.GetSyncRoundResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &syncRoundResponse))
+	require.Equal(t, uint64(5), syncRoundResponse.Round)
+	mockCall.Unset()
+	getCall.Unset()
+	c, rec = newReq(t)
+	// TestAcknowledgeSyncRound 400 RoundNotReached
+	mockCall = mockNode.On("AcknowledgeRound", mock.Anything).Return(node.ErrRoundNotReached)
+	err = handler.AcknowledgeSyncRound(c, 100)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
 	c, rec = newReq(t)
 
 	// TestUnsetSyncRound 200
@@ -601,6 +759,24 @@ func TestGetTransactionParams(t *testing.T) {
 	require.Equal(t, 200, rec.Code)
 }
 
+func TestGetFeeEstimate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	err := handler.GetFeeEstimate(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response model.FeeEstimateResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Estimates, 3)
+	require.Equal(t, uint64(1), response.Estimates[0].Rounds)
+	require.Equal(t, uint64(5), response.Estimates[1].Rounds)
+	require.Equal(t, uint64(20), response.Estimates[2].Rounds)
+}
+
 func pendingTransactionInformationTest(t *testing.T, txidToUse int, format string, expectedCode int) {
 	handler, c, rec, _, stxns, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
 	defer releasefunc()
@@ -771,6 +947,102 @@ func TestPostTransaction(t *testing.T) {
 	postTransactionTest(t, 0, 200)
 }
 
+func TestPostTransactionRejectionCode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txnPrep := func(stxn transactions.SignedTxn) []byte {
+		return protocol.Encode(&stxn)
+	}
+	handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep)
+	defer releasefunc()
+	deadErr := &transactions.TxnDeadError{Round: 20, FirstValid: 1, LastValid: 10}
+	handler.Node.(*mockNode).err = fmt.Errorf("TransactionPool.Remember: %w", deadErr)
+	err := handler.RawTransaction(c)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	var response model.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, "TransactionPool.Remember: "+deadErr.Error(), response.Message)
+	require.NotNil(t, response.Data)
+	require.Equal(t, "txn-dead", (*response.Data)["code"])
+}
+
+func TestPostPrivateTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txnPrep := func(stxn transactions.SignedTxn) []byte {
+		return protocol.Encode(&stxn)
+	}
+
+	// not enabled
+	handler, c, rec, releasefunc := prepareTransactionTest(t, 0, txnPrep)
+	defer releasefunc()
+	err := handler.RawPrivateTransaction(c)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	handler, c, rec, releasefunc = prepareTransactionTest(t, 0, txnPrep)
+	defer releasefunc()
+	handler.Node.(*mockNode).config.EnablePrivateTxSubmission = true
+	err = handler.RawPrivateTransaction(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response model.PostPrivateTransactionsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.NotEmpty(t, response.TxId)
+	require.Equal(t, uint64(1), response.Relays)
+
+	// no body
+	handler, c, rec, releasefunc = prepareTransactionTest(t, -1, txnPrep)
+	defer releasefunc()
+	handler.Node.(*mockNode).config.EnablePrivateTxSubmission = true
+	err = handler.RawPrivateTransaction(c)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
+func TestPostTransactionBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txnPrep := func(stxn transactions.SignedTxn) []byte {
+		request := v2.PreEncodedTransactionBatchRequest{
+			TxnGroups: []v2.PreEncodedTransactionBatchGroup{
+				{Txns: []transactions.SignedTxn{stxn}},
+				{},
+			},
+		}
+		return protocol.EncodeReflect(&request)
+	}
+
+	// no body
+	handler, c, rec, releasefunc := prepareTransactionTest(t, -1, txnPrep)
+	defer releasefunc()
+	err := handler.RawTransactionBatch(c)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	handler, c, rec, releasefunc = prepareTransactionTest(t, 0, txnPrep)
+	defer releasefunc()
+	err = handler.RawTransactionBatch(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response model.TransactionBatchResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Results, 2)
+	require.True(t, response.Results[0].Accepted)
+	require.NotNil(t, response.Results[0].TxId)
+	require.Nil(t, response.Results[0].Message)
+	require.False(t, response.Results[1].Accepted)
+	require.Nil(t, response.Results[1].TxId)
+	require.Equal(t, "empty txgroup", *response.Results[1].Message)
+}
+
 func simulateTransactionTest(t *testing.T, txnToUse int, format string, expectedCode int) {
 	txnPrep := func(stxn transactions.SignedTxn) []byte {
 		request := v2.PreEncodedSimulateRequest{
@@ -1192,6 +1464,112 @@ func TestSimulateTransactionMultipleGroups(t *testing.T) {
 	require.Contains(t, bodyString, "expected 1 transaction group, got 2")
 }
 
+func TestSimulationSessionHandlers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 5
+	offlineAccounts := true
+	mockLedger, roots, _, _, releasefunc := testingenv(t, numAccounts, 1, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	txnInfo := simulationtesting.TxnInfo{LatestHeader: hdr}
+	sender := roots[0]
+	receiver := roots[1]
+	pay := func(amount uint64) transactions.SignedTxn {
+		return txnInfo.NewTxn(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   sender.Address(),
+			Receiver: receiver.Address(),
+			Amount:   amount,
+		}).SignedTxn()
+	}
+
+	// the handlers are called through the auth middleware, which records the token of the request
+	auth := middlewares.MakeAuth("X-Algo-API-Token", []string{"token-a", "token-b"})
+	call := func(token string, method string, body []byte, h echo.HandlerFunc) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/", bytes.NewReader(body))
+		req.Header.Set("X-Algo-API-Token", token)
+		rec := httptest.NewRecorder()
+		require.NoError(t, auth(h)(echo.New().NewContext(req, rec)))
+		return rec
+	}
+	create := func(token string) *httptest.ResponseRecorder {
+		return call(token, http.MethodPost, nil, handler.CreateSimulationSession)
+	}
+	simulate := func(id string, request v2.PreEncodedSimulateRequest) *httptest.ResponseRecorder {
+		return call("token-a", http.MethodPost, protocol.EncodeReflect(&request), func(c echo.Context) error {
+			return handler.SimulateTransactionInSession(c, id, model.SimulateTransactionInSessionParams{})
+		})
+	}
+	delta := func(id string) *httptest.ResponseRecorder {
+		return call("token-a", http.MethodGet, nil, func(c echo.Context) error {
+			return handler.GetSimulationSessionDelta(c, id, model.GetSimulationSessionDeltaParams{})
+		})
+	}
+	remove := func(id string) *httptest.ResponseRecorder {
+		return call("token-a", http.MethodDelete, nil, func(c echo.Context) error {
+			return handler.DeleteSimulationSession(c, id)
+		})
+	}
+
+	rec := create("token-a")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var session model.SimulationSessionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &session))
+	require.NotEmpty(t, session.SessionId)
+	require.Zero(t, session.AppliedGroups)
+
+	signed := pay(1).Txn.Sign(sender.Secrets())
+	rec = simulate(session.SessionId, v2.PreEncodedSimulateRequest{
+		TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: []transactions.SignedTxn{signed}}},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var result v2.PreEncodedSimulateResponse
+	require.NoError(t, codec.NewDecoderBytes(rec.Body.Bytes(), protocol.JSONStrictHandle).Decode(&result))
+	require.Nil(t, result.TxnGroups[0].FailureMessage)
+
+	rec = delta(session.SessionId)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// a group missing its signatures could never be committed, so the session refuses it
+	rec = simulate(session.SessionId, v2.PreEncodedSimulateRequest{
+		TxnGroups:            []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: []transactions.SignedTxn{pay(2)}}},
+		AllowEmptySignatures: true,
+	})
+	require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	require.Contains(t, rec.Body.String(), "no signature")
+	info, err := mockNode.simulationSessionManager().Info(session.SessionId)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), info.AppliedGroups)
+
+	rec = remove(session.SessionId)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, http.StatusNotFound, remove(session.SessionId).Code)
+	require.Equal(t, http.StatusNotFound, delta(session.SessionId).Code)
+	rec = simulate(session.SessionId, v2.PreEncodedSimulateRequest{
+		TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: []transactions.SignedTxn{signed}}},
+	})
+	require.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	// the sessions are bounded per API token
+	for i := 0; i < simulation.MaxSessionsPerOwner; i++ {
+		require.Equal(t, http.StatusOK, create("token-a").Code)
+	}
+	rec = create("token-a")
+	require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	require.Contains(t, rec.Body.String(), "too many open simulation sessions")
+	require.Equal(t, http.StatusOK, create("token-b").Code)
+}
+
 func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
@@ -1657,6 +2035,120 @@ func TestAppendParticipationKeys(t *testing.T) {
 	})
 }
 
+func TestTransferParticipationKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	id := account.ParticipationID{}
+	id[0] = 10
+	mockNode.secrets = account.ParticipationSecrets{
+		Parent:      basics.Address{1},
+		FirstValid:  1,
+		LastValid:   100,
+		KeyDilution: 10,
+		VRF:         crypto.GenerateVRFSecrets(),
+		Voting:      crypto.GenerateOneTimeSignatureSecrets(0, 1),
+	}
+	expected := mockNode.secrets
+
+	var exported []byte
+	t.Run("Export", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.TLS = &tls.ConnectionState{}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.ExportParticipationKey(c, id.String())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, id, mockNode.id)
+		exported = rec.Body.Bytes()
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		mockNode.secrets = account.ParticipationSecrets{}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(exported))
+		req.TLS = &tls.ConnectionState{}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.ImportParticipationKey(c)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response model.PostParticipationResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		require.Equal(t, expected.Participation().ID().String(), response.PartId)
+		require.Equal(t, expected.Parent, mockNode.secrets.Parent)
+		require.Equal(t, *expected.VRF, *mockNode.secrets.VRF)
+		require.Equal(t, expected.Voting.OneTimeSignatureVerifier, mockNode.secrets.Voting.OneTimeSignatureVerifier)
+	})
+
+	t.Run("Insecure connection", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, handler.ExportParticipationKey(c, id.String()))
+		require.Equal(t, http.StatusForbidden, rec.Code)
+
+		req = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(exported))
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		require.NoError(t, handler.ImportParticipationKey(c))
+		require.Equal(t, http.StatusForbidden, rec.Code)
+
+		// a local plaintext request is refused unless the operator allowed it, since it may come from a local proxy
+		req = httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "127.0.0.1:4160"
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		require.NoError(t, handler.ExportParticipationKey(c, id.String()))
+		require.Equal(t, http.StatusForbidden, rec.Code)
+
+		mockNode.config.AdminAllowPlaintextParticipationKeyTransfer = true
+		defer func() { mockNode.config.AdminAllowPlaintextParticipationKeyTransfer = false }()
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		require.NoError(t, handler.ExportParticipationKey(c, id.String()))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "192.0.2.1:4160"
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		require.NoError(t, handler.ExportParticipationKey(c, id.String()))
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockNode := makeMockNode(mockLedger, t.Name(), account.ErrParticipationIDNotFound, cannedStatusReportGolden, false)
+		handler := v2.Handlers{
+			Node:     mockNode,
+			Log:      logging.Base(),
+			Shutdown: make(chan struct{}),
+		}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.TLS = &tls.ConnectionState{}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, handler.ExportParticipationKey(c, id.String()))
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
 // TxnMerkleElemRaw this struct helps creates a hashable struct from the bytes
 type TxnMerkleElemRaw struct {
 	Txn  crypto.Digest // txn id
@@ -2072,6 +2564,50 @@ func TestTimestampOffsetInDevMode(t *testing.T) {
 	require.Equal(t, "{\"message\":\"failed to set timestamp offset on the node: block timestamp offset cannot be larger than max int64 value\"}\n", rec.Body.String())
 }
 
+func TestAdvanceDevModeRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	err := handler.AdvanceDevModeRounds(c, model.AdvanceDevModeRoundsParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	require.Equal(t, "{\"message\":\"failed to advance rounds on the node: cannot advance rounds when not in dev mode\"}\n", rec.Body.String())
+
+	handler, c, rec, _, _, releasefunc = setupMockNodeForMethodGet(t, cannedStatusReportGolden, true)
+	defer releasefunc()
+
+	err = handler.AdvanceDevModeRounds(c, model.AdvanceDevModeRoundsParams{})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response model.DevModeAdvanceResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(1), response.Round)
+	c, rec = newReq(t)
+
+	rounds := uint64(5)
+	offset := uint64(60)
+	err = handler.AdvanceDevModeRounds(c, model.AdvanceDevModeRoundsParams{Rounds: &rounds, Offset: &offset})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(6), response.Round)
+	c, rec = newReq(t)
+
+	rounds = 0
+	err = handler.AdvanceDevModeRounds(c, model.AdvanceDevModeRoundsParams{Rounds: &rounds})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	c, rec = newReq(t)
+
+	offset = math.MaxUint64
+	err = handler.AdvanceDevModeRounds(c, model.AdvanceDevModeRoundsParams{Offset: &offset})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func TestDeltasForTxnGroup(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -2214,7 +2750,7 @@ func TestRouterRequestBody(t *testing.T) {
 	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
 	dummyShutdownChan := make(chan struct{})
 	l, err := net.Listen("tcp", ":0") // create listener so requests are buffered
-	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, "", "", l, 1000)
+	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, "", "", nil, l, 1000, server.DefaultListenerPolicy)
 	go e.Start(":0")
 	defer e.Close()
 
@@ -2236,3 +2772,747 @@ func TestRouterRequestBody(t *testing.T) {
 	e.ServeHTTP(rec, req)
 	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
 }
+
+func TestRouterPublicOnly(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, _ := testingenv(t, 1, 1, true)
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	dummyShutdownChan := make(chan struct{})
+	policy := server.ListenerPolicy{PublicOnly: true, AccessLogSampling: 1}
+	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, "", "", nil, nil, 1000, policy)
+
+	// public endpoints are served
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/status", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotEmpty(t, rec.Header().Get(echo.HeaderXRequestID))
+
+	// admin and participation endpoints are left to the admin listener
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/participation", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v2/shutdown", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAPITokens(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	store, err := tokens.LoadStore(t.TempDir(), tokens.AlgodTokenStoreFilename)
+	require.NoError(t, err)
+	handler := v2.Handlers{Log: logging.Base(), TokenStore: store}
+	e := echo.New()
+
+	create := func(name string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.CreateAPIToken(e.NewContext(req, rec), name))
+		return rec
+	}
+
+	rec := create("reader", `{"scopes":["read"]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var created model.APITokenResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Equal(t, "reader", created.Name)
+	require.Equal(t, []string{"read"}, created.Scopes)
+	require.True(t, store.Authorize(created.Token, tokens.ScopeRead))
+
+	require.Equal(t, http.StatusBadRequest, create("reader", `{"scopes":["read"]}`).Code)
+	require.Equal(t, http.StatusBadRequest, create("writer", `{"scopes":["write"]}`).Code)
+	require.Equal(t, http.StatusBadRequest, create("writer", `not json`).Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.ListAPITokens(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list model.APITokensResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Equal(t, []model.APIToken{{Name: "reader", Scopes: []string{"read"}}}, list.Tokens)
+
+	req = httptest.NewRequest(http.MethodDelete, "/", nil)
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.RevokeAPIToken(e.NewContext(req, rec), "reader"))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, store.Authorize(created.Token, tokens.ScopeRead))
+
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.RevokeAPIToken(e.NewContext(req, rec), "reader"))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	mockNode.peers = telemetryspec.PeersConnectionDetails{
+		OutgoingPeers: []telemetryspec.PeerConnectionDetails{
+			{Address: "10.0.0.1", Endpoint: "relay.example.com:4160", ConnectionDuration: 30, MessageDelay: 1500, TXCount: 7,
+				ProtocolVersion: "2.2", Capabilities: []string{"ppzstd", "txsync=1"}},
+		},
+		IncomingPeers: []telemetryspec.PeerConnectionDetails{
+			{Address: "10.0.0.2", ConnectionDuration: 12, InstanceName: "instance"},
+		},
+	}
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base()}
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.GetPeers(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.PeersResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Outgoing, 1)
+	require.Equal(t, "10.0.0.1", response.Outgoing[0].Address)
+	require.Equal(t, "relay.example.com:4160", *response.Outgoing[0].Endpoint)
+	require.Equal(t, uint64(1500), *response.Outgoing[0].MessageDelay)
+	require.Equal(t, uint64(7), *response.Outgoing[0].TransactionCount)
+	require.Nil(t, response.Outgoing[0].VoteCount)
+	require.Equal(t, "2.2", *response.Outgoing[0].ProtocolVersion)
+	require.Equal(t, []string{"ppzstd", "txsync=1"}, *response.Outgoing[0].Capabilities)
+	require.Len(t, response.Incoming, 1)
+	require.Equal(t, uint64(12), response.Incoming[0].ConnectionDuration)
+	require.Equal(t, "instance", *response.Incoming[0].InstanceName)
+	require.Nil(t, response.Incoming[0].Endpoint)
+	require.Nil(t, response.Incoming[0].Capabilities)
+
+	mockNode.err = errors.New("no peers")
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetPeers(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestAccountsDatabaseOptimize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	mockNode.optimizeStatus = ledger.AccountsDatabaseOptimizeStatus{
+		Runs:          2,
+		LastStart:     time.Unix(1700000000, 0),
+		LastEnd:       time.Unix(1700000060, 0),
+		Incremental:   true,
+		Pages:         5000,
+		FreePages:     10,
+		ReleasedPages: 990,
+	}
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base()}
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.GetAccountsDatabaseOptimizeStatus(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.AccountsDatabaseOptimizeResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.False(t, response.Pending)
+	require.Equal(t, uint64(2), response.Runs)
+	require.Equal(t, uint64(1700000000), *response.LastStart)
+	require.Equal(t, uint64(1700000060), *response.LastEnd)
+	require.Nil(t, response.LastError)
+	require.Nil(t, response.NextRun)
+	require.True(t, response.Incremental)
+	require.Equal(t, uint64(5000), response.Pages)
+	require.Equal(t, uint64(10), response.FreePages)
+	require.Equal(t, uint64(990), response.ReleasedPages)
+
+	req = httptest.NewRequest(http.MethodPost, "/", nil)
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.OptimizeAccountsDatabase(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.True(t, response.Pending)
+
+	mockNode.err = errors.New("an accounts database optimization is already in progress")
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.OptimizeAccountsDatabase(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestGetStateProofStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	heavy := basics.Address{0x1}
+	light := basics.Address{0x2}
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	mockNode.stateProofs = stateproof.WorkerStatus{
+		LatestRound:         1100,
+		NextStateProofRound: 1024,
+		DBSize:              4096,
+		Provers: []stateproof.ProverStatus{{
+			Round:            1024,
+			SignedWeight:     300,
+			ProvenWeight:     700,
+			AcceptableWeight: 900,
+			TotalWeight:      1000,
+			Participants:     3,
+			Signers:          1,
+			MissingParticipants: []stateproof.MissingParticipant{
+				{Address: heavy, Weight: 500},
+				{Address: light, Weight: 200},
+			},
+		}},
+	}
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base()}
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.GetStateProofStatus(e.NewContext(req, rec), model.GetStateProofStatusParams{}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.StateProofStatusResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(1100), response.LatestRound)
+	require.Equal(t, uint64(1024), response.NextStateProofRound)
+	require.Equal(t, uint64(4096), response.DbSize)
+	require.Len(t, response.Provers, 1)
+	prover := response.Provers[0]
+	require.Equal(t, uint64(1024), prover.Round)
+	require.Equal(t, uint64(300), prover.SignedWeight)
+	require.Equal(t, uint64(700), prover.ProvenWeight)
+	require.Equal(t, uint64(900), prover.AcceptableWeight)
+	require.Equal(t, uint64(1000), prover.TotalWeight)
+	require.Equal(t, uint64(3), prover.Participants)
+	require.Equal(t, uint64(1), prover.Signers)
+	require.Equal(t, []model.StateProofMissingParticipant{
+		{Address: heavy.String(), Weight: 500},
+		{Address: light.String(), Weight: 200},
+	}, prover.MissingParticipants)
+
+	maxMissing := uint64(1)
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetStateProofStatus(e.NewContext(req, rec), model.GetStateProofStatusParams{MaxMissing: &maxMissing}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Provers[0].MissingParticipants, 1)
+	require.Equal(t, heavy.String(), response.Provers[0].MissingParticipants[0].Address)
+
+	maxMissing = 1001
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetStateProofStatus(e.NewContext(req, rec), model.GetStateProofStatusParams{MaxMissing: &maxMissing}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mockNode.err = errors.New("worker not running")
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetStateProofStatus(e.NewContext(req, rec), model.GetStateProofStatusParams{}))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestGetAccountPerformance(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{0x1}
+	idle := basics.Address{0x2}
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	mockNode.performance = []node.AccountPerformance{
+		{
+			Address:           addr,
+			Rounds:            10,
+			LastRound:         110,
+			ProposalsSelected: 4,
+			ProposalsMade:     1,
+			VotesSelected:     5,
+			VotesMissed:       1,
+			Recent: []node.RoundPerformance{
+				{Round: 105, ProposerSelected: true, VoterSelected: true, Voted: true},
+				{Round: 110, ProposerSelected: true, Proposed: true, VoterSelected: true},
+			},
+		},
+		{Address: idle},
+	}
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base()}
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.GetAccountPerformance(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.AccountPerformanceResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Accounts, 2)
+
+	p := response.Accounts[0]
+	require.Equal(t, addr.String(), p.Address)
+	require.Equal(t, uint64(10), p.Rounds)
+	require.Equal(t, uint64(110), *p.LastRound)
+	require.Equal(t, uint64(4), p.ProposalsSelected)
+	require.Equal(t, uint64(1), p.ProposalsMade)
+	require.Equal(t, 0.75, p.ProposalMissRate)
+	require.Equal(t, uint64(5), p.VotesSelected)
+	require.Equal(t, uint64(1), p.VotesMissed)
+	require.Equal(t, 0.2, p.VoteMissRate)
+	require.Equal(t, []model.RoundPerformance{
+		{Round: 105, ProposerSelected: true, VoterSelected: true, Voted: true},
+		{Round: 110, ProposerSelected: true, Proposed: true, VoterSelected: true},
+	}, p.Recent)
+
+	p = response.Accounts[1]
+	require.Equal(t, idle.String(), p.Address)
+	require.Nil(t, p.LastRound)
+	require.Zero(t, p.ProposalMissRate)
+	require.Zero(t, p.VoteMissRate)
+	require.Empty(t, p.Recent)
+
+	mockNode.err = errors.New("not tracked in follower mode")
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetAccountPerformance(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestGetProposalAssemblies(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	start := time.Unix(1700000000, 0)
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	mockNode.assemblies = []pools.AssemblyReport{
+		{
+			Round:          12,
+			Start:          start,
+			AssemblyTime:   500 * time.Millisecond,
+			Duration:       480 * time.Millisecond,
+			StopReason:     "block-full",
+			Considered:     10,
+			IncludedGroups: 6,
+			IncludedTxns:   8,
+			IncludedBytes:  900,
+			BytesLimit:     1000,
+			Fees:           8000,
+			Excluded: []pools.AssemblyExclusion{
+				{Reason: pools.ExclusionExpired, Count: 1},
+				{Reason: pools.ExclusionLeftOver, Count: 3},
+			},
+		},
+	}
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base()}
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.GetProposalAssemblies(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ProposalAssemblyResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, []model.ProposalAssembly{
+		{
+			Round:          12,
+			Start:          uint64(start.UnixNano()),
+			AssemblyTime:   uint64(500 * time.Millisecond),
+			Duration:       uint64(480 * time.Millisecond),
+			StopReason:     "block-full",
+			Considered:     10,
+			IncludedGroups: 6,
+			IncludedTxns:   8,
+			IncludedBytes:  900,
+			BytesLimit:     1000,
+			Fees:           8000,
+			Excluded: []model.ProposalExclusion{
+				{Reason: "expired", Count: 1},
+				{Reason: "left-over", Count: 3},
+			},
+		},
+	}, response.Proposals)
+
+	mockNode.err = errors.New("blocks are not proposed in follower mode")
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetProposalAssemblies(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestGetReplayRejections(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	at := time.Unix(1700000000, 0)
+	sender := basics.Address{1}
+	other := basics.Address{2}
+	lease := [32]byte{3}
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	mockNode.replayRejections = []pools.ReplayRejection{
+		{
+			Time:         at,
+			Txid:         transactions.Txid{4},
+			Sender:       sender,
+			Code:         pools.RejectionAlreadyInLedger,
+			ConflictTxid: transactions.Txid{4},
+		},
+		{
+			Time:          at,
+			Txid:          transactions.Txid{5},
+			Sender:        other,
+			Code:          pools.RejectionLeaseInUse,
+			Lease:         lease,
+			ConflictRound: 7,
+			LeaseExpires:  17,
+		},
+	}
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base()}
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.GetReplayRejections(e.NewContext(req, rec), model.GetReplayRejectionsParams{}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ReplayRejectionsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	conflictTxid := transactions.Txid{4}.String()
+	conflictRound, leaseExpires := uint64(7), uint64(17)
+	leaseBytes := lease[:]
+	require.Equal(t, []model.ReplayRejection{
+		{
+			Time:         uint64(at.UnixNano()),
+			Txid:         transactions.Txid{4}.String(),
+			Sender:       sender.String(),
+			Code:         "already-in-ledger",
+			ConflictTxid: &conflictTxid,
+		},
+		{
+			Time:          uint64(at.UnixNano()),
+			Txid:          transactions.Txid{5}.String(),
+			Sender:        other.String(),
+			Code:          "lease-in-use",
+			Lease:         &leaseBytes,
+			ConflictRound: &conflictRound,
+			LeaseExpires:  &leaseExpires,
+		},
+	}, response.Rejections)
+
+	// filtered by sender
+	address := other.String()
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetReplayRejections(e.NewContext(req, rec), model.GetReplayRejectionsParams{Address: &address}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Rejections, 1)
+	require.Equal(t, other.String(), response.Rejections[0].Sender)
+
+	badAddress := "not-an-address"
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetReplayRejections(e.NewContext(req, rec), model.GetReplayRejectionsParams{Address: &badAddress}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	mockNode.err = errors.New("transactions are not accepted in follower mode")
+	rec = httptest.NewRecorder()
+	require.NoError(t, handler.GetReplayRejections(e.NewContext(req, rec), model.GetReplayRejectionsParams{}))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestAgreementEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	mockNode.agreementObservers = make(chan agreement.Observer, 1)
+	shutdown := make(chan struct{})
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: shutdown}
+	e := echo.New()
+	e.GET(v2.AgreementEventsPath, handler.AgreementEvents)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + v2.AgreementEventsPath
+
+	readEvent := func(conn *websocket.Conn) v2.AgreementEvent {
+		conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+		mtype, data, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, websocket.TextMessage, mtype)
+		var event v2.AgreementEvent
+		require.NoError(t, json.Unmarshal(data, &event))
+		return event
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	observer := <-mockNode.agreementObservers
+
+	sender := basics.Address{1}
+	proposer := basics.Address{2}
+	digest := crypto.Digest{3}
+	observer.OnRoundStart(agreement.RoundStartEvent{Round: 10})
+	observer.OnProposalObserved(agreement.ProposalObservedEvent{Round: 10, Period: 1, Proposer: proposer, Sender: sender, BlockDigest: digest})
+	// the votes are only streamed when requested
+	observer.OnVoteObserved(agreement.VoteObservedEvent{Round: 10, Step: 1, Sender: sender, BlockDigest: digest, Weight: 2, ProposalWeight: 5})
+	observer.OnCertified(agreement.CertifiedEvent{Round: 10, Period: 1, Proposer: proposer, BlockDigest: digest})
+
+	require.Equal(t, v2.AgreementEvent{Type: "round-start", Round: 10}, readEvent(conn))
+	require.Equal(t, v2.AgreementEvent{Type: "proposal", Round: 10, Period: 1, Sender: sender.String(), Proposer: proposer.String(), BlockHash: digest.String()}, readEvent(conn))
+	require.Equal(t, v2.AgreementEvent{Type: "certified", Round: 10, Period: 1, Proposer: proposer.String(), BlockHash: digest.String()}, readEvent(conn))
+
+	votesConn, _, err := websocket.DefaultDialer.Dial(url+"?votes=true", nil)
+	require.NoError(t, err)
+	defer votesConn.Close()
+	observer = <-mockNode.agreementObservers
+	observer.OnVoteObserved(agreement.VoteObservedEvent{Round: 10, Step: 1, Sender: sender, Weight: 2, ProposalWeight: 5})
+	require.Equal(t, v2.AgreementEvent{Type: "vote", Round: 10, Step: 1, Sender: sender.String(), Weight: 2, ProposalWeight: 5}, readEvent(votesConn))
+
+	// the server closes the streams when shutting down
+	close(shutdown)
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway))
+
+	// the follower nodes run no agreement
+	mockNode.err = errors.New("agreement does not run in follower mode")
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, v2.AgreementEventsPath, nil)
+	require.NoError(t, handler.AgreementEvents(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, v2.AgreementEventsPath+"?votes=maybe", nil)
+	require.NoError(t, handler.AgreementEvents(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestApplicationStateDeltas(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	newContext := func() {
+		rec = httptest.NewRecorder()
+		c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	}
+
+	require.NoError(t, handler.WatchApplicationStateDeltas(c, 0))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	newContext()
+	require.NoError(t, handler.WatchApplicationStateDeltas(c, 10))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	newContext()
+	require.NoError(t, handler.GetWatchedApplications(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var watched model.WatchedApplicationsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &watched))
+	require.Equal(t, []uint64{10}, watched.Applications)
+
+	hdr := bookkeeping.BlockHeader{Round: 1}
+	delta := ledgercore.MakeStateDelta(&hdr, 0, 0, 0)
+	delta.Accts.UpsertAppResource(basics.Address{1}, 10, ledgercore.AppParamsDelta{Params: &basics.AppParams{}}, ledgercore.AppLocalStateDelta{})
+	delta.Accts.UpsertAppResource(basics.Address{2}, 10, ledgercore.AppParamsDelta{}, ledgercore.AppLocalStateDelta{Deleted: true})
+	delta.AddKvMod(apps.MakeBoxKey(10, "box"), ledgercore.KvValueDelta{Data: []byte("value")})
+	handler.Node.(*mockNode).appWatcher.OnNewBlock(bookkeeping.Block{BlockHeader: hdr}, delta)
+
+	newContext()
+	require.NoError(t, handler.GetApplicationStateDeltas(c, 1))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.ApplicationStateDeltasResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	deleted := true
+	value := []byte("value")
+	require.Equal(t, model.ApplicationStateDeltasResponse{
+		Round: 1,
+		Applications: []model.ApplicationStateDelta{{
+			ApplicationId: 10,
+			GlobalState:   &model.TealKeyValueStore{},
+			LocalStates:   &[]model.ApplicationLocalStateDelta{{Address: basics.Address{2}.String(), Deleted: &deleted}},
+			Boxes:         &[]model.ApplicationBoxDelta{{Name: []byte("box"), Value: &value}},
+		}},
+	}, response)
+
+	newContext()
+	require.NoError(t, handler.GetApplicationStateDeltas(c, 2))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	newContext()
+	require.NoError(t, handler.UnwatchApplicationStateDeltas(c, 10))
+	require.Equal(t, http.StatusOK, rec.Code)
+	newContext()
+	require.NoError(t, handler.UnwatchApplicationStateDeltas(c, 10))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestLoggingConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	log := logging.NewLogger()
+	log.SetLevel(logging.Info)
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{Node: mockNode, Log: log}
+	e := echo.New()
+
+	call := func(f func(echo.Context) error, expectedCode int) model.LoggingResponse {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, f(e.NewContext(req, rec)))
+		require.Equal(t, expectedCode, rec.Code)
+		var response model.LoggingResponse
+		if expectedCode == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		}
+		return response
+	}
+
+	response := call(handler.GetLoggingConfig, http.StatusOK)
+	require.Equal(t, uint64(logging.Info), response.Level)
+	require.Len(t, response.Subsystems, len(logging.Subsystems))
+	for _, s := range response.Subsystems {
+		require.True(t, s.Enabled)
+	}
+	require.Nil(t, response.AdditionalOutputFile)
+
+	response = call(func(ctx echo.Context) error { return handler.SetLogLevel(ctx, uint64(logging.Debug)) }, http.StatusOK)
+	require.Equal(t, uint64(logging.Debug), response.Level)
+	require.Equal(t, logging.Debug, log.GetLevel())
+	call(func(ctx echo.Context) error { return handler.SetLogLevel(ctx, 6) }, http.StatusBadRequest)
+
+	response = call(func(ctx echo.Context) error {
+		return handler.DisableSubsystemLogging(ctx, logging.AgreementSubsystem)
+	}, http.StatusOK)
+	for _, s := range response.Subsystems {
+		require.Equal(t, s.Name != logging.AgreementSubsystem, s.Enabled)
+	}
+	require.Equal(t, []string{logging.AgreementSubsystem}, log.DisabledSubsystems())
+	call(func(ctx echo.Context) error { return handler.EnableSubsystemLogging(ctx, logging.AgreementSubsystem) }, http.StatusOK)
+	require.Empty(t, log.DisabledSubsystems())
+	call(func(ctx echo.Context) error { return handler.DisableSubsystemLogging(ctx, "ledger") }, http.StatusBadRequest)
+
+	dataDir := t.TempDir()
+	log.SetAdditionalOutputDirs(dataDir)
+	call(func(ctx echo.Context) error {
+		return handler.SetLogOutputFile(ctx, model.SetLogOutputFileParams{Path: filepath.Join(t.TempDir(), "incident.log")})
+	}, http.StatusBadRequest)
+
+	path := filepath.Join(dataDir, "incident.log")
+	response = call(func(ctx echo.Context) error {
+		return handler.SetLogOutputFile(ctx, model.SetLogOutputFileParams{Path: path})
+	}, http.StatusOK)
+	require.Equal(t, path, *response.AdditionalOutputFile)
+	log.Info("logged to the additional file")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "logged to the additional file")
+	call(func(ctx echo.Context) error {
+		return handler.SetLogOutputFile(ctx, model.SetLogOutputFileParams{Path: "incident.log"})
+	}, http.StatusBadRequest)
+
+	response = call(handler.RemoveLogOutputFile, http.StatusOK)
+	require.Nil(t, response.AdditionalOutputFile)
+}
+
+func TestCreateSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base()}
+	e := echo.New()
+	callWithPartKeys := func(path string, withPartKeys *bool, expectedCode int) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.CreateSnapshot(e.NewContext(req, rec), model.CreateSnapshotParams{Path: path, WithPartkeys: withPartKeys}))
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+	call := func(path string, expectedCode int) *httptest.ResponseRecorder {
+		return callWithPartKeys(path, nil, expectedCode)
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	rec := call(path, http.StatusOK)
+	var response model.SnapshotResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(cannedStatusReportGolden.LastRound), response.Round)
+	require.DirExists(t, path)
+	require.False(t, mockNode.snapshotPartKeys)
+
+	// the participation keys are only copied on request
+	withPartKeys := true
+	callWithPartKeys(filepath.Join(t.TempDir(), "snapshot"), &withPartKeys, http.StatusOK)
+	require.True(t, mockNode.snapshotPartKeys)
+
+	rec = call(path, http.StatusBadRequest)
+	require.Contains(t, rec.Body.String(), "already exists")
+	rec = call("snapshot", http.StatusBadRequest)
+	require.Contains(t, rec.Body.String(), "must be absolute")
+}
+
+func TestAccountPendingInformation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := poolAddr
+	mockNode := makeMockNode(nil, "", nil, cannedStatusReportGolden, false)
+	mockNode.config.MaxAPIResourcesPerAccount = 0
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base()}
+	e := echo.New()
+	call := func(address string, expectedCode int) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.AccountPendingInformation(e.NewContext(req, rec), address))
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+
+	// no pending block evaluator yet
+	call(addr.String(), http.StatusServiceUnavailable)
+	call("not-an-address", http.StatusBadRequest)
+
+	mockNode.pendingAccounts = map[basics.Address]node.PendingAccountState{
+		addr: {
+			Round:      11,
+			Amount:     basics.MicroAlgos{Raw: 2_000_000},
+			MinBalance: basics.MicroAlgos{Raw: 300_000},
+			Assets: map[basics.AssetIndex]basics.AssetHolding{
+				20: {Amount: 5, Frozen: true},
+				10: {Amount: 7},
+			},
+		},
+	}
+	rec := call(addr.String(), http.StatusOK)
+	var response model.AccountPendingResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.AccountPendingResponse{
+		Address:    addr.String(),
+		Round:      11,
+		Amount:     2_000_000,
+		MinBalance: 300_000,
+		Assets: []model.AssetHolding{
+			{AssetID: 10, Amount: 7},
+			{AssetID: 20, Amount: 5, IsFrozen: true},
+		},
+	}, response)
+}
+
+func TestGetMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	counter := metrics.MakeCounter(metrics.MetricName{Name: "algod_handlerstest_requests_total", Description: "requests"})
+	defer counter.Deregister(nil)
+	counter.Inc(map[string]string{"code": "200"})
+	counter.Inc(map[string]string{"code": "404"})
+
+	handler := v2.Handlers{Node: makeMockNode(nil, "", nil, cannedStatusReportGolden, false), Log: logging.Base()}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.GetMetrics(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.MetricsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, metrics.MaxLabelSets(), response.MaxLabelSets)
+	require.Contains(t, response.Metrics, model.MetricDescription{
+		Name:        "algod_handlerstest_requests_total",
+		Description: "requests",
+		Type:        "counter",
+		Subsystem:   "handlerstest",
+		Series:      2,
+		Enabled:     true,
+	})
+	for i := 1; i < len(response.Metrics); i++ {
+		require.LessOrEqual(t, response.Metrics[i-1].Name, response.Metrics[i].Name)
+	}
+}
@@ -17,8 +17,10 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
+	"os"
 	"strconv"
 	"testing"
 
@@ -34,13 +36,18 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	basics_testing "github.com/algorand/go-algorand/data/basics/testing"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/appwatch"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/util/db"
 )
 
@@ -89,16 +96,32 @@ var txnPoolGolden = make([]transactions.SignedTxn, 2)
 // package `data` and package `node`, which themselves import `mocks`
 type mockNode struct {
 	mock.Mock
-	ledger          v2.LedgerForAPI
-	genesisID       string
-	config          config.Local
-	err             error
-	id              account.ParticipationID
-	keys            account.StateProofKeys
-	usertxns        map[basics.Address][]node.TxnWithStatus
-	status          node.StatusReport
-	devmode         bool
-	timestampOffset *int64
+	ledger           v2.LedgerForAPI
+	genesisID        string
+	config           config.Local
+	err              error
+	id               account.ParticipationID
+	keys             account.StateProofKeys
+	secrets          account.ParticipationSecrets
+	usertxns         map[basics.Address][]node.TxnWithStatus
+	status           node.StatusReport
+	devmode          bool
+	devmodeRound     basics.Round
+	timestampOffset  *int64
+	peers            telemetryspec.PeersConnectionDetails
+	stateProofs      stateproof.WorkerStatus
+	performance      []node.AccountPerformance
+	assemblies       []pools.AssemblyReport
+	replayRejections []pools.ReplayRejection
+	optimizeStatus   ledger.AccountsDatabaseOptimizeStatus
+	pendingAccounts  map[basics.Address]node.PendingAccountState
+
+	// snapshotPartKeys is whether the latest snapshot was asked for the participation keys
+	snapshotPartKeys bool
+
+	simulationSessions *simulation.SessionManager
+	appWatcher         *appwatch.Watcher
+	agreementObservers chan agreement.Observer
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -125,6 +148,11 @@ func (m *mockNode) SetSyncRound(rnd uint64) error {
 func (m *mockNode) UnsetSyncRound() {
 }
 
+func (m *mockNode) AcknowledgeRound(rnd uint64) error {
+	args := m.Called(rnd)
+	return args.Error(0)
+}
+
 func (m *mockNode) GetSyncRound() uint64 {
 	args := m.Called()
 	return uint64(args.Int(0))
@@ -136,15 +164,30 @@ func (m *mockNode) AppendParticipationKeys(id account.ParticipationID, keys acco
 	return m.err
 }
 
+func (m *mockNode) ExportParticipationKey(id account.ParticipationID) (account.ParticipationSecrets, error) {
+	m.id = id
+	return m.secrets, m.err
+}
+
+func (m *mockNode) ImportParticipationKey(secrets account.ParticipationSecrets) (account.ParticipationID, error) {
+	m.secrets = secrets
+	return secrets.Participation().ID(), m.err
+}
+
+func (m *mockNode) ParticipationKeyRenewalStatus(id account.ParticipationID) (node.ParticipationKeyRenewalStatus, bool) {
+	return node.ParticipationKeyRenewalStatus{}, false
+}
+
 func makeMockNode(ledger v2.LedgerForAPI, genesisID string, nodeError error, status node.StatusReport, devMode bool) *mockNode {
 	return &mockNode{
-		ledger:    ledger,
-		genesisID: genesisID,
-		config:    config.GetDefaultLocal(),
-		err:       nodeError,
-		usertxns:  map[basics.Address][]node.TxnWithStatus{},
-		status:    status,
-		devmode:   devMode,
+		ledger:     ledger,
+		genesisID:  genesisID,
+		config:     config.GetDefaultLocal(),
+		err:        nodeError,
+		usertxns:   map[basics.Address][]node.TxnWithStatus{},
+		status:     status,
+		devmode:    devMode,
+		appWatcher: appwatch.MakeWatcher(10),
 	}
 }
 
@@ -154,6 +197,10 @@ func (m *mockNode) LedgerForAPI() v2.LedgerForAPI {
 func (m *mockNode) Status() (node.StatusReport, error) {
 	return m.status, nil
 }
+func (m *mockNode) Health() node.HealthReport {
+	return node.HealthReport{Status: node.HealthOK}
+}
+
 func (m *mockNode) GenesisID() string {
 	return m.genesisID
 }
@@ -166,11 +213,42 @@ func (m *mockNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) erro
 	return m.err
 }
 
+func (m *mockNode) SubmitPrivateTxGroup(txgroup []transactions.SignedTxn) (int, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	// the group is accepted by a single relay
+	return 1, nil
+}
+
 func (m *mockNode) Simulate(request simulation.Request) (simulation.Result, error) {
 	simulator := simulation.MakeSimulator(m.ledger.(*data.Ledger), m.config.EnableDeveloperAPI)
 	return simulator.Simulate(request)
 }
 
+func (m *mockNode) simulationSessionManager() *simulation.SessionManager {
+	if m.simulationSessions == nil {
+		m.simulationSessions = simulation.MakeSessionManager(m.ledger.(*data.Ledger), m.config.EnableDeveloperAPI)
+	}
+	return m.simulationSessions
+}
+
+func (m *mockNode) CreateSimulationSession(owner string) (simulation.SessionInfo, error) {
+	return m.simulationSessionManager().Create(owner)
+}
+
+func (m *mockNode) SimulateInSession(sessionID string, request simulation.Request) (simulation.Result, error) {
+	return m.simulationSessionManager().Simulate(sessionID, request)
+}
+
+func (m *mockNode) SimulationSessionDelta(sessionID string) (ledgercore.StateDelta, error) {
+	return m.simulationSessionManager().Delta(sessionID)
+}
+
+func (m *mockNode) DeleteSimulationSession(sessionID string) error {
+	return m.simulationSessionManager().Delete(sessionID)
+}
+
 func (m *mockNode) GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
 	res = node.TxnWithStatus{}
 	found = true
@@ -185,6 +263,14 @@ func (m *mockNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{Raw: 1}
 }
 
+func (m *mockNode) EstimateFees(targets []uint64) ([]pools.FeeEstimate, error) {
+	estimates := make([]pools.FeeEstimate, len(targets))
+	for i, rounds := range targets {
+		estimates[i] = pools.FeeEstimate{Rounds: rounds, FeePerByte: 1}
+	}
+	return estimates, m.err
+}
+
 // unused by handlers:
 func (m *mockNode) Config() config.Local {
 	return m.config
@@ -244,6 +330,14 @@ func (m *mockNode) SetBlockTimeStampOffset(offset int64) error {
 	return nil
 }
 
+func (m *mockNode) AdvanceDevModeRounds(rounds uint64, timestampOffset *int64) (basics.Round, error) {
+	if !m.devmode {
+		return 0, fmt.Errorf("cannot advance rounds when not in dev mode")
+	}
+	m.devmodeRound += basics.Round(rounds)
+	return m.devmodeRound, nil
+}
+
 func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	if !m.devmode {
 		return nil, fmt.Errorf("cannot get block timestamp when not in dev mode")
@@ -253,6 +347,90 @@ func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	return m.timestampOffset, nil
 }
 
+func (m *mockNode) PeerConnections() (telemetryspec.PeersConnectionDetails, error) {
+	return m.peers, m.err
+}
+
+func (m *mockNode) WatchApplication(appID basics.AppIndex) {
+	m.appWatcher.Watch(appID)
+}
+
+func (m *mockNode) UnwatchApplication(appID basics.AppIndex) error {
+	return m.appWatcher.Unwatch(appID)
+}
+
+func (m *mockNode) WatchedApplications() []basics.AppIndex {
+	return m.appWatcher.Watched()
+}
+
+func (m *mockNode) ApplicationStateChanges(rnd basics.Round) (appwatch.RoundChanges, error) {
+	return m.appWatcher.Changes(rnd)
+}
+
+func (m *mockNode) AccountPerformance() ([]node.AccountPerformance, error) {
+	return m.performance, m.err
+}
+
+func (m *mockNode) ProposalAssemblies() ([]pools.AssemblyReport, error) {
+	return m.assemblies, m.err
+}
+
+func (m *mockNode) ReplayRejections() ([]pools.ReplayRejection, error) {
+	return m.replayRejections, m.err
+}
+
+func (m *mockNode) RegisterAgreementObserver(o agreement.Observer) (func(), error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.agreementObservers != nil {
+		m.agreementObservers <- o
+	}
+	return func() {}, nil
+}
+
+func (m *mockNode) OptimizeAccountsDatabase() error {
+	if m.err != nil {
+		return m.err
+	}
+	m.optimizeStatus.Pending = true
+	return nil
+}
+
+func (m *mockNode) AccountsDatabaseOptimizeStatus() ledger.AccountsDatabaseOptimizeStatus {
+	return m.optimizeStatus
+}
+
+func (m *mockNode) Snapshot(ctx context.Context, dir string, withPartKeys bool) (basics.Round, error) {
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		return 0, fmt.Errorf("snapshot directory %s already exists", dir)
+	}
+	err := os.Mkdir(dir, 0700)
+	if err != nil {
+		return 0, err
+	}
+	m.snapshotPartKeys = withPartKeys
+	return m.status.LastRound, nil
+}
+
+func (m *mockNode) PendingAccount(addr basics.Address) (node.PendingAccountState, error) {
+	if m.pendingAccounts == nil {
+		return node.PendingAccountState{}, pools.ErrNoPendingBlockEvaluator
+	}
+	return m.pendingAccounts[addr], nil
+}
+
+func (m *mockNode) StateProofStatus(maxMissing int) (stateproof.WorkerStatus, error) {
+	status := m.stateProofs
+	status.Provers = append([]stateproof.ProverStatus(nil), status.Provers...)
+	for i := range status.Provers {
+		if len(status.Provers[i].MissingParticipants) > maxMissing {
+			status.Provers[i].MissingParticipants = status.Provers[i].MissingParticipants[:maxMissing]
+		}
+	}
+	return status, m.err
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+)
+
+// GetProtocolUpgrade returns the consensus protocol upgrade being voted on or scheduled as of the latest round.
+// (GET /v2/status/upgrade)
+func (v2 *Handlers) GetProtocolUpgrade(ctx echo.Context) error {
+	ledger := v2.Node.LedgerForAPI()
+	hdr, err := ledger.BlockHdr(ledger.Latest())
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingLatestBlockHeaderStatus, v2.Log)
+	}
+
+	response := model.ProtocolUpgradeResponse{
+		CurrentProtocol: string(hdr.CurrentProtocol),
+		Round:           uint64(hdr.Round),
+		Upgrade:         protocolUpgrade(hdr),
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// protocolUpgrade describes the upgrade in progress as of the block header hdr, or returns nil if there is none.
+func protocolUpgrade(hdr bookkeeping.BlockHeader) *model.ProtocolUpgrade {
+	if hdr.NextProtocol == "" {
+		return nil
+	}
+
+	// the vote runs in the rounds before NextProtocolVoteBefore, and a rejected proposal is cleared when it ends,
+	// so that a proposal remaining past it was approved.
+	current := config.Consensus[hdr.CurrentProtocol]
+	votesToGo := uint64(0)
+	if hdr.NextProtocolVoteBefore > hdr.Round {
+		votesToGo = uint64(hdr.NextProtocolVoteBefore - hdr.Round - 1)
+	}
+	votes := uint64(0)
+	if current.UpgradeVoteRounds > votesToGo {
+		votes = current.UpgradeVoteRounds - votesToGo
+	}
+
+	next, supported := config.Consensus[hdr.NextProtocol]
+	upgrade := model.ProtocolUpgrade{
+		NextProtocol:          string(hdr.NextProtocol),
+		NextProtocolSupported: supported,
+		Approved:              hdr.Round >= hdr.NextProtocolVoteBefore,
+		VoteBefore:            uint64(hdr.NextProtocolVoteBefore),
+		SwitchOn:              uint64(hdr.NextProtocolSwitchOn),
+		Votes:                 votes,
+		Approvals:             hdr.NextProtocolApprovals,
+		ApprovalsRequired:     current.UpgradeThreshold,
+		VoteRounds:            current.UpgradeVoteRounds,
+	}
+	if supported {
+		changes := consensusParameterChanges(current, next)
+		upgrade.ParameterChanges = &changes
+	}
+	return &upgrade
+}
+
+// consensusParameterChanges lists the consensus parameters whose values differ between the current and the next
+// protocol, in the order of their declaration.
+func consensusParameterChanges(current, next config.ConsensusParams) []model.ConsensusParameterChange {
+	changes := make([]model.ConsensusParameterChange, 0)
+	currentValue := reflect.ValueOf(current)
+	nextValue := reflect.ValueOf(next)
+	for i := 0; i < currentValue.NumField(); i++ {
+		field := currentValue.Type().Field(i)
+		// the upgrades approved by a protocol describe the protocols after it, rather than the protocol itself.
+		if !field.IsExported() || field.Name == "ApprovedUpgrades" {
+			continue
+		}
+		c := currentValue.Field(i).Interface()
+		n := nextValue.Field(i).Interface()
+		if !reflect.DeepEqual(c, n) {
+			changes = append(changes, model.ConsensusParameterChange{Name: field.Name, Current: c, Next: n})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestConsensusParameterChanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	current := config.Consensus[protocol.ConsensusCurrentVersion]
+	require.Empty(t, consensusParameterChanges(current, current))
+
+	next := current
+	next.MinTxnFee = current.MinTxnFee * 2
+	next.MaxAppProgramCost = current.MaxAppProgramCost + 100
+	next.ApprovedUpgrades = map[protocol.ConsensusVersion]uint64{"next": 0}
+	require.Equal(t, []model.ConsensusParameterChange{
+		{Name: "MinTxnFee", Current: current.MinTxnFee, Next: next.MinTxnFee},
+		{Name: "MaxAppProgramCost", Current: current.MaxAppProgramCost, Next: next.MaxAppProgramCost},
+	}, consensusParameterChanges(current, next))
+}
+
+func TestProtocolUpgrade(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	params := config.Consensus[protocol.ConsensusCurrentVersion]
+	hdr := bookkeeping.BlockHeader{Round: 1000}
+	hdr.CurrentProtocol = protocol.ConsensusCurrentVersion
+	require.Nil(t, protocolUpgrade(hdr))
+
+	// a vote in progress, 10 rounds after the proposal
+	hdr.NextProtocol = protocol.ConsensusFuture
+	hdr.NextProtocolVoteBefore = hdr.Round - 10 + basics.Round(params.UpgradeVoteRounds)
+	hdr.NextProtocolSwitchOn = hdr.NextProtocolVoteBefore + 1000
+	hdr.NextProtocolApprovals = 8
+	upgrade := protocolUpgrade(hdr)
+	require.NotNil(t, upgrade)
+	require.Equal(t, string(protocol.ConsensusFuture), upgrade.NextProtocol)
+	require.True(t, upgrade.NextProtocolSupported)
+	require.False(t, upgrade.Approved)
+	require.Equal(t, uint64(hdr.NextProtocolVoteBefore), upgrade.VoteBefore)
+	require.Equal(t, uint64(hdr.NextProtocolSwitchOn), upgrade.SwitchOn)
+	require.Equal(t, uint64(11), upgrade.Votes)
+	require.Equal(t, uint64(8), upgrade.Approvals)
+	require.Equal(t, params.UpgradeThreshold, upgrade.ApprovalsRequired)
+	require.Equal(t, params.UpgradeVoteRounds, upgrade.VoteRounds)
+	require.NotNil(t, upgrade.ParameterChanges)
+	require.Equal(t, consensusParameterChanges(params, config.Consensus[protocol.ConsensusFuture]), *upgrade.ParameterChanges)
+
+	// the vote ended, and the upgrade is scheduled
+	hdr.Round = hdr.NextProtocolVoteBefore + 1
+	upgrade = protocolUpgrade(hdr)
+	require.True(t, upgrade.Approved)
+	require.Equal(t, params.UpgradeVoteRounds, upgrade.Votes)
+
+	// an upgrade to a protocol this node does not support
+	hdr.NextProtocol = "https://example.com/unsupported"
+	upgrade = protocolUpgrade(hdr)
+	require.False(t, upgrade.NextProtocolSupported)
+	require.Nil(t, upgrade.ParameterChanges)
+}
@@ -18,6 +18,7 @@ package v2
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -28,25 +29,80 @@ import (
 	"github.com/labstack/echo/v4"
 	"golang.org/x/exp/slices"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 // returnError logs an internal message while returning the encoded response.
 func returnError(ctx echo.Context, code int, internal error, external string, logger logging.Logger) error {
 	logger.Info(internal)
-	return ctx.JSON(code, model.ErrorResponse{Message: external})
+	return ctx.JSON(code, model.ErrorResponse{Message: external, Data: errorData(internal)})
+}
+
+// errorData returns the structured details of an error, to be returned in the
+// data field of the error response. A logic evaluation failure is detailed by
+// its call path, from the top-level program to the program that failed, so that
+// clients can locate the failure without parsing the error message.
+func errorData(internal error) *map[string]interface{} {
+	var evalErr logic.EvalError
+	if !errors.As(internal, &evalErr) {
+		return nil
+	}
+	path := evalErr.CallPath()
+	callPath := make([]map[string]interface{}, len(path))
+	for i, failure := range path {
+		frame := map[string]interface{}{
+			"group-index":  failure.GroupIndex(),
+			"program-hash": basics.Address(failure.ProgramHash).String(),
+			"pc":           failure.PC,
+		}
+		if failure.Opcode != "" {
+			frame["opcode"] = failure.Opcode
+		}
+		if failure.LogicSig() {
+			frame["logicsig"] = true
+		} else {
+			frame["app-id"] = uint64(failure.AppID)
+		}
+		callPath[i] = frame
+	}
+	return &map[string]interface{}{
+		"eval-error": map[string]interface{}{
+			"message":   path[len(path)-1].Err.Error(),
+			"call-path": callPath,
+		},
+	}
+}
+
+// rejectTxGroup returns the error response of a transaction group rejected by the node, whose data holds the
+// code of the reason of the rejection, so that clients can present it without parsing the error message.
+func rejectTxGroup(ctx echo.Context, internal error, logger logging.Logger) error {
+	logger.Info(internal)
+	data := errorData(internal)
+	if data == nil {
+		data = &map[string]interface{}{}
+	}
+	(*data)["code"] = string(pools.RejectionCodeOf(internal))
+	return ctx.JSON(http.StatusBadRequest, model.ErrorResponse{Message: internal.Error(), Data: data})
 }
 
 func badRequest(ctx echo.Context, internal error, external string, log logging.Logger) error {
 	return returnError(ctx, http.StatusBadRequest, internal, external, log)
 }
 
+func forbidden(ctx echo.Context, internal error, external string, log logging.Logger) error {
+	return returnError(ctx, http.StatusForbidden, internal, external, log)
+}
+
 func serviceUnavailable(ctx echo.Context, internal error, external string, log logging.Logger) error {
 	return returnError(ctx, http.StatusServiceUnavailable, internal, external, log)
 }
@@ -362,14 +418,88 @@ func convertProgramTrace(programTrace []simulation.OpcodeTraceUnit) *[]model.Sim
 			spawnedInnersPtr = &spawnedInners
 		}
 		modelProgramTrace[i] = model.SimulationOpcodeTraceUnit{
-			Pc:            programTrace[i].PC,
-			SpawnedInners: spawnedInnersPtr,
+			Pc:             programTrace[i].PC,
+			SpawnedInners:  spawnedInnersPtr,
+			StackAdditions: convertTealValueSlice(programTrace[i].StackAdded),
+			ScratchChanges: convertScratchChanges(programTrace[i].ScratchSlotChanges),
+			StateChanges:   convertStateChanges(programTrace[i].StateChanges),
+			SourceLocation: convertSourceLocation(programTrace[i].Source),
+		}
+		if programTrace[i].StackPopCount > 0 {
+			modelProgramTrace[i].StackPopCount = &programTrace[i].StackPopCount
 		}
 	}
 
 	return &modelProgramTrace
 }
 
+func convertTealValue(tv basics.TealValue) model.TealValue {
+	return model.TealValue{
+		Type:  uint64(tv.Type),
+		Bytes: base64.StdEncoding.EncodeToString([]byte(tv.Bytes)),
+		Uint:  tv.Uint,
+	}
+}
+
+func convertTealValueSlice(values []basics.TealValue) *[]model.TealValue {
+	if len(values) == 0 {
+		return nil
+	}
+	converted := make([]model.TealValue, len(values))
+	for i, tv := range values {
+		converted[i] = convertTealValue(tv)
+	}
+	return &converted
+}
+
+func convertScratchChanges(changes []simulation.ScratchChange) *[]model.ScratchChange {
+	if len(changes) == 0 {
+		return nil
+	}
+	converted := make([]model.ScratchChange, len(changes))
+	for i, change := range changes {
+		converted[i] = model.ScratchChange{
+			Slot:     change.Slot,
+			NewValue: convertTealValue(change.NewValue),
+		}
+	}
+	return &converted
+}
+
+func convertStateChanges(changes []simulation.StateOperation) *[]model.ApplicationStateOperation {
+	if len(changes) == 0 {
+		return nil
+	}
+	converted := make([]model.ApplicationStateOperation, len(changes))
+	for i, change := range changes {
+		converted[i] = model.ApplicationStateOperation{
+			Operation:    change.Operation.String(),
+			AppStateType: change.AppState.String(),
+			Key:          []byte(change.Key),
+		}
+		if change.Operation == simulation.AppStateWrite {
+			newValue := convertTealValue(change.NewValue)
+			converted[i].NewValue = &newValue
+		}
+		if change.AppState == simulation.LocalState {
+			account := change.Account.String()
+			converted[i].Account = &account
+		}
+	}
+	return &converted
+}
+
+func convertSourceLocation(location *simulation.SourceLocation) *model.SimulationSourceLocation {
+	if location == nil {
+		return nil
+	}
+	return &model.SimulationSourceLocation{
+		File:   location.File,
+		Line:   location.Line,
+		Column: location.Column,
+	}
+}
+
 func convertTxnTrace(txnTrace *simulation.TransactionTrace) *model.SimulationTransactionExecTrace {
 	if txnTrace == nil {
 		return nil
@@ -400,6 +530,30 @@ func convertTxnResult(txnResult simulation.TxnResult) PreEncodedSimulateTxnResul
 		AppBudgetConsumed:      omitEmpty(txnResult.AppBudgetConsumed),
 		LogicSigBudgetConsumed: omitEmpty(txnResult.LogicSigBudgetConsumed),
 		TransactionTrace:       convertTxnTrace(txnResult.Trace),
+		Profile:                convertTxnProfile(txnResult.Profile),
+	}
+}
+
+func convertTxnProfile(profile *simulation.TxnProfile) *model.SimulationTransactionProfile {
+	if profile == nil {
+		return nil
+	}
+	opcodeCosts := make([]model.SimulationOpcodeCategoryCost, len(profile.OpcodeCosts))
+	for i, categoryCost := range profile.OpcodeCosts {
+		opcodeCosts[i] = model.SimulationOpcodeCategoryCost{
+			Category: categoryCost.Category,
+			Cost:     categoryCost.Cost,
+		}
+	}
+	return &model.SimulationTransactionProfile{
+		OpcodeCosts:        opcodeCosts,
+		BoxReadBytes:       profile.BoxReadBytes,
+		BoxWriteBytes:      profile.BoxWriteBytes,
+		InnerTxnCount:      profile.InnerTxnCount,
+		LogBytes:           profile.LogBytes,
+		AppBudgetRemaining: profile.AppBudgetRemaining,
+		LogBytesRemaining:  profile.LogBytesRemaining,
+		InnerTxnsRemaining: profile.InnerTxnsRemaining,
 	}
 }
 
@@ -424,14 +578,23 @@ func convertTxnGroupResult(txnGroupResult simulation.TxnGroupResult) PreEncodedS
 	return encoded
 }
 
+func convertSimulationSessionInfo(info simulation.SessionInfo) model.SimulationSessionResponse {
+	return model.SimulationSessionResponse{
+		SessionId:     info.ID,
+		Round:         uint64(info.Round),
+		AppliedGroups: info.AppliedGroups,
+	}
+}
+
 func convertSimulationResult(result simulation.Result) PreEncodedSimulateResponse {
 	var evalOverrides *model.SimulationEvalOverrides
 	if result.EvalOverrides != (simulation.ResultEvalOverrides{}) {
 		evalOverrides = &model.SimulationEvalOverrides{
-			AllowEmptySignatures: omitEmpty(result.EvalOverrides.AllowEmptySignatures),
-			MaxLogSize:           result.EvalOverrides.MaxLogSize,
-			MaxLogCalls:          result.EvalOverrides.MaxLogCalls,
-			ExtraOpcodeBudget:    omitEmpty(result.EvalOverrides.ExtraOpcodeBudget),
+			AllowEmptySignatures:  omitEmpty(result.EvalOverrides.AllowEmptySignatures),
+			MaxLogSize:            result.EvalOverrides.MaxLogSize,
+			MaxLogCalls:           result.EvalOverrides.MaxLogCalls,
+			ExtraOpcodeBudget:     omitEmpty(result.EvalOverrides.ExtraOpcodeBudget),
+			UnlimitedOpcodeBudget: omitEmpty(result.EvalOverrides.UnlimitedOpcodeBudget),
 		}
 	}
 
@@ -441,27 +604,170 @@ func convertSimulationResult(result simulation.Result) PreEncodedSimulateRespons
 		TxnGroups:       make([]PreEncodedSimulateTxnGroupResult, len(result.TxnGroups)),
 		EvalOverrides:   evalOverrides,
 		ExecTraceConfig: result.TraceConfig,
+		CoverageReport:  result.CoverageReport,
 	}
 
 	for i, txnGroup := range result.TxnGroups {
 		encodedSimulationResult.TxnGroups[i] = convertTxnGroupResult(txnGroup)
 	}
 
+	if result.StateDiff != nil {
+		stateDiff := convertSimulationStateDiff(*result.StateDiff)
+		encodedSimulationResult.StateDiff = &stateDiff
+	}
+
 	return encodedSimulationResult
 }
 
-func convertSimulationRequest(request PreEncodedSimulateRequest) simulation.Request {
+func convertSimulationStateDiff(diff simulation.StateDiff) model.SimulationStateDiff {
+	converted := model.SimulationStateDiff{
+		Accounts:      make([]model.SimulationAccountDiff, len(diff.Accounts)),
+		AssetHoldings: make([]model.SimulationAssetHoldingDiff, len(diff.AssetHoldings)),
+		AppStates:     make([]model.SimulationAppStateDiff, len(diff.AppStates)),
+		Boxes:         make([]model.SimulationBoxDiff, len(diff.Boxes)),
+	}
+	for i, account := range diff.Accounts {
+		converted.Accounts[i] = model.SimulationAccountDiff{
+			Address:          account.Address.String(),
+			BalanceBefore:    account.BalanceBefore.Raw,
+			BalanceAfter:     account.BalanceAfter.Raw,
+			MinBalanceBefore: account.MinBalanceBefore.Raw,
+			MinBalanceAfter:  account.MinBalanceAfter.Raw,
+		}
+	}
+	for i, holding := range diff.AssetHoldings {
+		converted.AssetHoldings[i] = model.SimulationAssetHoldingDiff{
+			Address:      holding.Address.String(),
+			AssetId:      uint64(holding.Asset),
+			AmountBefore: holding.AmountBefore,
+			AmountAfter:  holding.AmountAfter,
+			OptedIn:      omitEmpty(holding.OptedIn),
+			OptedOut:     omitEmpty(holding.OptedOut),
+		}
+	}
+	for i, state := range diff.AppStates {
+		converted.AppStates[i] = model.SimulationAppStateDiff{
+			AppId:        uint64(state.App),
+			AppStateType: state.AppState.String(),
+			Account:      addrOrNil(state.Account),
+			Key:          []byte(state.Key),
+		}
+		if state.Before != nil {
+			before := convertTealValue(*state.Before)
+			converted.AppStates[i].Before = &before
+		}
+		if state.After != nil {
+			after := convertTealValue(*state.After)
+			converted.AppStates[i].After = &after
+		}
+	}
+	for i, box := range diff.Boxes {
+		converted.Boxes[i] = model.SimulationBoxDiff{
+			AppId:      uint64(box.App),
+			Name:       []byte(box.Name),
+			SizeBefore: box.SizeBefore,
+			SizeAfter:  box.SizeAfter,
+		}
+	}
+	return converted
+}
+
+func convertSimulationRequest(request PreEncodedSimulateRequest) (simulation.Request, error) {
 	txnGroups := make([][]transactions.SignedTxn, len(request.TxnGroups))
 	for i, txnGroup := range request.TxnGroups {
 		txnGroups[i] = txnGroup.Txns
 	}
+	var sourceMaps map[crypto.Digest]logic.SourceMap
+	if len(request.SourceMaps) > 0 {
+		sourceMaps = make(map[crypto.Digest]logic.SourceMap, len(request.SourceMaps))
+		for _, sourceMap := range request.SourceMaps {
+			hash, err := basics.UnmarshalChecksumAddress(sourceMap.ProgramHash)
+			if err != nil {
+				return simulation.Request{}, fmt.Errorf("invalid source map program hash %s: %w", sourceMap.ProgramHash, err)
+			}
+			sourceMaps[crypto.Digest(hash)] = sourceMap.SourceMap
+		}
+	}
+	var stateOverrides *simulation.StateOverrides
+	if request.StateOverrides != nil {
+		overrides, err := convertSimulationStateOverrides(*request.StateOverrides)
+		if err != nil {
+			return simulation.Request{}, err
+		}
+		stateOverrides = &overrides
+	}
 	return simulation.Request{
-		TxnGroups:            txnGroups,
-		AllowEmptySignatures: request.AllowEmptySignatures,
-		AllowMoreLogging:     request.AllowMoreLogging,
-		ExtraOpcodeBudget:    request.ExtraOpcodeBudget,
-		TraceConfig:          request.ExecTraceConfig,
+		TxnGroups:             txnGroups,
+		AllowEmptySignatures:  request.AllowEmptySignatures,
+		AllowMoreLogging:      request.AllowMoreLogging,
+		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
+		TraceConfig:           request.ExecTraceConfig,
+		SourceMaps:            sourceMaps,
+		Coverage:              request.Coverage,
+		Profile:               request.Profile,
+		UnlimitedOpcodeBudget: request.UnlimitedOpcodeBudget,
+		StateOverrides:        stateOverrides,
+		StateDiff:             request.StateDiff,
+	}, nil
+}
+
+func convertSimulationStateOverrides(overrides model.SimulationStateOverrides) (simulation.StateOverrides, error) {
+	converted := simulation.StateOverrides{
+		LatestTimestamp: int64(nilToZero(overrides.LatestTimestamp)),
+		Round:           basics.Round(nilToZero(overrides.Round)),
+	}
+	if overrides.Accounts != nil {
+		converted.Balances = make(map[basics.Address]basics.MicroAlgos, len(*overrides.Accounts))
+		for _, account := range *overrides.Accounts {
+			addr, err := basics.UnmarshalChecksumAddress(account.Address)
+			if err != nil {
+				return simulation.StateOverrides{}, fmt.Errorf("invalid account override address %s: %w", account.Address, err)
+			}
+			converted.Balances[addr] = basics.MicroAlgos{Raw: account.Amount}
+		}
 	}
+	if overrides.Apps != nil {
+		converted.GlobalStates = make(map[basics.AppIndex]basics.TealKeyValue)
+		converted.LocalStates = make(map[basics.Address]map[basics.AppIndex]basics.TealKeyValue)
+		for _, app := range *overrides.Apps {
+			appIdx := basics.AppIndex(app.AppId)
+			if app.GlobalState != nil {
+				tkv, err := convertGeneratedTKV(app.GlobalState)
+				if err != nil {
+					return simulation.StateOverrides{}, fmt.Errorf("invalid global state override of application %d: %w", app.AppId, err)
+				}
+				converted.GlobalStates[appIdx] = tkv
+			}
+			if app.LocalStates == nil {
+				continue
+			}
+			for _, local := range *app.LocalStates {
+				addr, err := basics.UnmarshalChecksumAddress(local.Address)
+				if err != nil {
+					return simulation.StateOverrides{}, fmt.Errorf("invalid local state override address %s: %w", local.Address, err)
+				}
+				tkv, err := convertGeneratedTKV(&local.KeyValue)
+				if err != nil {
+					return simulation.StateOverrides{}, fmt.Errorf("invalid local state override of account %s in application %d: %w", local.Address, app.AppId, err)
+				}
+				if converted.LocalStates[addr] == nil {
+					converted.LocalStates[addr] = make(map[basics.AppIndex]basics.TealKeyValue)
+				}
+				converted.LocalStates[addr][appIdx] = tkv
+			}
+		}
+	}
+	if overrides.Boxes != nil {
+		converted.Boxes = make(map[basics.AppIndex]map[string][]byte)
+		for _, box := range *overrides.Boxes {
+			appIdx := basics.AppIndex(box.AppId)
+			if converted.Boxes[appIdx] == nil {
+				converted.Boxes[appIdx] = make(map[string][]byte)
+			}
+			converted.Boxes[appIdx][string(box.Name)] = box.Value
+		}
+	}
+	return converted, nil
 }
 
 // printableUTF8OrEmpty checks to see if the entire string is a UTF8 printable string.
@@ -477,3 +783,12 @@ func printableUTF8OrEmpty(in string) string {
 	}
 	return in
 }
+
+// scopeStrings converts token scopes to their API representation.
+func scopeStrings(scopes []tokens.Scope) []string {
+	res := make([]string, len(scopes))
+	for i, scope := range scopes {
+		res[i] = string(scope)
+	}
+	return res
+}
@@ -18,16 +18,64 @@ package algod
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
 	"runtime"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/algorand/go-deadlock"
 
+	apiServer "github.com/algorand/go-algorand/daemon/algod/api/server"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 )
 
+// deadlockReportsFilename is the file of the data directory keeping the reports of the most recent
+// potential deadlocks. As the node aborts on a potential deadlock, the reports are kept on disk so
+// that they can be retrieved once the node restarts.
+const deadlockReportsFilename = "deadlocks.json"
+
+// maxDeadlockReports is the number of the most recent potential deadlock reports kept.
+const maxDeadlockReports = 10
+
+// The kinds of potential deadlocks reported by the deadlock detector.
+const (
+	deadlockKindTimeout   = "timeout"
+	deadlockKindRecursive = "recursive"
+	deadlockKindLockOrder = "lock-order"
+)
+
+// deadlockLockLine matches the lines of the deadlock detector reports naming a goroutine and a lock.
+var deadlockLockLine = regexp.MustCompile(`^(current )?goroutine (\d+) lock (\S+)$`)
+
+// deadlockReport is a potential deadlock reported by the deadlock detector, with the stacks of all
+// the goroutines when it was detected.
+type deadlockReport struct {
+	Time time.Time
+	telemetryspec.DeadlockDetectedEventDetails
+	Stacks string
+}
+
+// deadlockReportStore keeps the most recent potential deadlock reports in a file.
+type deadlockReportStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+var deadlockReports deadlockReportStore
+
+func init() {
+	// the endpoint is routed by the REST API to the default mux, like the pprof endpoints
+	http.HandleFunc(apiServer.DeadlockReportsPath, deadlockReports.serveHTTP)
+}
+
 type deadlockLogger struct {
 	logging.Logger
 	*bytes.Buffer
@@ -79,16 +127,30 @@ func (logger *deadlockLogger) onPotentialDeadlock() {
 
 		fmt.Fprintln(os.Stderr, string(buf))
 
+		report := deadlockReport{
+			Time:                         time.Now(),
+			DeadlockDetectedEventDetails: parseDeadlockReport(loggedString),
+			Stacks:                       string(buf),
+		}
+
 		// logging the logged string to the logger has to happen in a separate go-routine, since the
 		// logger itself ( for instance, the CyclicLogWriter ) is using a mutex of it's own.
 		go func() {
 			logger.Error(loggedString)
+			if err := deadlockReports.add(report); err != nil {
+				logger.Warnf("Cannot save the potential deadlock report: %v", err)
+			}
+			logger.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.DeadlockDetectedEvent, report.DeadlockDetectedEventDetails)
 			logger.panic()
 		}()
 	})
 }
 
-func setupDeadlockLogger() *deadlockLogger {
+// setupDeadlockLogger directs the reports of the deadlock detector to the base logger, and keeps
+// the most recent ones in the data directory rootPath.
+func setupDeadlockLogger(rootPath string) *deadlockLogger {
+	deadlockReports.setPath(filepath.Join(rootPath, deadlockReportsFilename))
+
 	logger := &deadlockLogger{
 		Logger:     logging.Base(),
 		Buffer:     bytes.NewBuffer(make([]byte, 0)),
@@ -100,3 +162,107 @@ func setupDeadlockLogger() *deadlockLogger {
 	deadlock.Opts.OnPotentialDeadlock = logger.onPotentialDeadlock
 	return logger
 }
+
+// parseDeadlockReport extracts the kind of a potential deadlock and the acquisition graph of its
+// locks from the report of the deadlock detector: the goroutines holding the locks, and the
+// goroutine waiting for one of them.
+func parseDeadlockReport(report string) telemetryspec.DeadlockDetectedEventDetails {
+	details := telemetryspec.DeadlockDetectedEventDetails{
+		Kind:   deadlockKindTimeout,
+		Report: report,
+	}
+	waiting := false
+	for _, line := range strings.Split(report, "\n") {
+		switch {
+		case strings.Contains(line, "Recursive locking"):
+			details.Kind = deadlockKindRecursive
+		case strings.Contains(line, "Inconsistent locking"):
+			details.Kind = deadlockKindLockOrder
+		case strings.HasPrefix(line, "Have been trying to lock it again"):
+			waiting = true
+		case strings.HasPrefix(line, "Other goroutines holding locks"):
+			waiting = false
+		}
+		m := deadlockLockLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		goroutine, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		details.Locks = append(details.Locks, telemetryspec.DeadlockLock{
+			Goroutine: goroutine,
+			Lock:      m[3],
+			Waiting:   waiting || m[1] != "",
+		})
+		waiting = false
+	}
+	return details
+}
+
+func (s *deadlockReportStore) setPath(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.path = path
+}
+
+// load returns the reports kept, the oldest first.
+func (s *deadlockReportStore) load() ([]deadlockReport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.loadLocked()
+}
+
+func (s *deadlockReportStore) loadLocked() ([]deadlockReport, error) {
+	if s.path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var reports []deadlockReport
+	err = json.Unmarshal(data, &reports)
+	return reports, err
+}
+
+// add keeps a report, dropping the oldest ones past maxDeadlockReports.
+func (s *deadlockReportStore) add(report deadlockReport) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.path == "" {
+		return nil
+	}
+	reports, err := s.loadLocked()
+	if err != nil {
+		// start over rather than losing the new report
+		reports = nil
+	}
+	reports = append(reports, report)
+	if len(reports) > maxDeadlockReports {
+		reports = reports[len(reports)-maxDeadlockReports:]
+	}
+	data, err := json.Marshal(reports)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0600)
+}
+
+// serveHTTP returns the reports kept, the oldest first.
+func (s *deadlockReportStore) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	reports, err := s.load()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if reports == nil {
+		reports = []deadlockReport{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reports)
+}
@@ -17,14 +17,20 @@
 package algod
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/algorand/go-deadlock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -38,7 +44,7 @@ func TestDeadlockLogging(t *testing.T) {
 	logWriter := logging.MakeCyclicFileWriter(logFn, archiveFn, 65536, time.Hour)
 	l.SetOutput(logWriter)
 
-	logger := setupDeadlockLogger()
+	logger := setupDeadlockLogger(t.TempDir())
 
 	deadlockCh := make(chan struct{})
 	logger.panic = func() {
@@ -69,7 +75,7 @@ func TestDeadlockOnPotentialDeadlock(t *testing.T) {
 	logWriter := logging.MakeCyclicFileWriter(logFn, archiveFn, 65536, time.Hour)
 	l.SetOutput(logWriter)
 
-	logger := setupDeadlockLogger()
+	logger := setupDeadlockLogger(t.TempDir())
 
 	deadlockCh := make(chan struct{})
 	logger.panic = func() {
@@ -92,4 +98,75 @@ func TestDeadlockOnPotentialDeadlock(t *testing.T) {
 	}
 
 	_ = <-deadlockCh
+
+	// the report is kept before aborting
+	reports, err := deadlockReports.load()
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, deadlockKindTimeout, reports[0].Kind)
+	require.Contains(t, reports[0].Report, "line 9")
+	require.NotEmpty(t, reports[0].Stacks)
+}
+
+const testTimeoutReport = `POTENTIAL DEADLOCK:
+Previous place where the lock was grabbed
+goroutine 10 lock 0xc000010000
+deadlock_test.go:50 algod.holder { mu.Lock() } <<<<<
+Have been trying to lock it again for more than 30s
+goroutine 20 lock 0xc000010000
+deadlock_test.go:60 algod.waiter { mu.Lock() } <<<<<
+Other goroutines holding locks:
+goroutine 30 lock 4
+deadlock_test.go:70 algod.other { other.Lock() } <<<<<
+`
+
+func TestParseDeadlockReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	details := parseDeadlockReport(testTimeoutReport)
+	require.Equal(t, deadlockKindTimeout, details.Kind)
+	require.Equal(t, testTimeoutReport, details.Report)
+	require.Equal(t, []telemetryspec.DeadlockLock{
+		{Goroutine: 10, Lock: "0xc000010000"},
+		{Goroutine: 20, Lock: "0xc000010000", Waiting: true},
+		{Goroutine: 30, Lock: "4"},
+	}, details.Locks)
+
+	details = parseDeadlockReport("POTENTIAL DEADLOCK: Recursive locking:\ncurrent goroutine 5 lock 3\n")
+	require.Equal(t, deadlockKindRecursive, details.Kind)
+	require.Equal(t, []telemetryspec.DeadlockLock{{Goroutine: 5, Lock: "3", Waiting: true}}, details.Locks)
+
+	details = parseDeadlockReport("POTENTIAL DEADLOCK: Inconsistent locking. saw this ordering in one goroutine:\nhappened before\n")
+	require.Equal(t, deadlockKindLockOrder, details.Kind)
+	require.Empty(t, details.Locks)
+}
+
+func TestDeadlockReportStore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	store := deadlockReportStore{path: filepath.Join(t.TempDir(), deadlockReportsFilename)}
+	reports, err := store.load()
+	require.NoError(t, err)
+	require.Empty(t, reports)
+
+	for i := 0; i < maxDeadlockReports+2; i++ {
+		report := deadlockReport{Time: time.Unix(int64(i), 0)}
+		report.Kind = deadlockKindTimeout
+		require.NoError(t, store.add(report))
+	}
+	reports, err = store.load()
+	require.NoError(t, err)
+	require.Len(t, reports, maxDeadlockReports)
+	require.Equal(t, int64(2), reports[0].Time.Unix())
+	require.Equal(t, int64(maxDeadlockReports+1), reports[len(reports)-1].Time.Unix())
+
+	rec := httptest.NewRecorder()
+	store.serveHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/deadlocks", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var served []deadlockReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+	require.Equal(t, len(reports), len(served))
+	require.Equal(t, reports[0].Time.Unix(), served[0].Time.Unix())
 }
@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package algod
+
+import (
+	"github.com/algorand/go-algorand/logging"
+)
+
+// MultiServer hosts the nodes of several networks in a single process. Each network is served by
+// its own Server, with its own data directory, ledger, listeners and logger, while the servers share the
+// process log output, telemetry and metrics.
+type MultiServer struct {
+	Servers []*Server
+	Log     logging.Logger
+}
+
+// Start starts the nodes of all the servers and serves them until one of their REST listeners
+// stops or the process is interrupted.
+func (m *MultiServer) Start() {
+	errChan := make(chan error, len(m.Servers))
+	for _, s := range m.Servers {
+		go func(serverErr <-chan error) {
+			errChan <- <-serverErr
+		}(s.Serve())
+	}
+	serveUntilExit(m.Log, errChan, m.Stop)
+}
+
+// Stop stops all the servers, then closes the shared telemetry.
+func (m *MultiServer) Stop() {
+	for _, s := range m.Servers {
+		s.Stop()
+	}
+	m.Log.CloseTelemetry()
+}
@@ -18,6 +18,7 @@ package algod
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -34,9 +35,10 @@ import (
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/grpc"
 	apiServer "github.com/algorand/go-algorand/daemon/algod/api/server"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
-	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
@@ -44,67 +46,75 @@ import (
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/resources"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
-var server http.Server
-
 // maxHeaderBytes must have enough room to hold an api token
 const maxHeaderBytes = 4096
 
+// sqliteFDs are the file descriptors held by the databases of the node, along with their journals.
+const sqliteFDs = 64
+
+// minRestConnections and minIncomingConnections are the least connections the REST API and the gossip network are
+// left with when the file descriptors fall short.
+const minRestConnections = 10
+const minIncomingConnections = 1
+
+// resourcesCheckInterval is how often the usage of the resources of the process is checked.
+const resourcesCheckInterval = 10 * time.Second
+
 // ServerNode is the required methods for any node the server fronts
 type ServerNode interface {
 	apiServer.APINodeInterface
 	ListeningAddress() (string, bool)
+	Progressing() bool
 	Start()
 	Stop()
 }
 
 // Server represents an instance of the REST API HTTP server
 type Server struct {
-	RootPath             string
-	Genesis              bookkeeping.Genesis
+	RootPath string
+	Genesis  bookkeeping.Genesis
+	// Log is the logger of the server. When set, the caller owns its output and telemetry, as when
+	// several servers share the process, otherwise the server sets up the base logger.
+	Log logging.Logger
+	// NoMetrics keeps the server from serving and reporting the metrics, which are process-wide, when another
+	// server of the process serves them.
+	NoMetrics bool
+
 	pidFile              string
 	netFile              string
+	restServer           *http.Server
 	netListenFile        string
+	adminNetFile         string
 	log                  logging.Logger
 	node                 ServerNode
 	metricCollector      *metrics.MetricService
 	metricServiceStarted bool
+	metricsServer        *http.Server
+	adminServer          *http.Server
+	unixSocketServer     *http.Server
+	grpcServer           *grpc.Server
+	systemd              *systemdNotifier
+	resources            *resources.Manager
 	stopping             chan struct{}
 }
 
 // Initialize creates a Node instance with applicable network services
 func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genesisText string) error {
 	// set up node
-	s.log = logging.Base()
+	if s.Log != nil {
+		s.log = s.Log
+	} else {
+		s.log = logging.Base()
+		SetupLogOutput(s.log, s.RootPath, cfg)
+	}
 
-	lib.GenesisJSONText = genesisText
+	lib.SetGenesisJSONText(s.Genesis.ID(), genesisText)
 
-	liveLog := filepath.Join(s.RootPath, "node.log")
-	archive := filepath.Join(s.RootPath, cfg.LogArchiveName)
-	var maxLogAge time.Duration
 	var err error
-	if cfg.LogArchiveMaxAge != "" {
-		maxLogAge, err = time.ParseDuration(cfg.LogArchiveMaxAge)
-		if err != nil {
-			s.log.Fatalf("invalid config LogArchiveMaxAge: %s", err)
-			maxLogAge = 0
-		}
-	}
-
-	var logWriter io.Writer
-	if cfg.LogSizeLimit > 0 {
-		fmt.Println("Logging to: ", liveLog)
-		logWriter = logging.MakeCyclicFileWriter(liveLog, archive, cfg.LogSizeLimit, maxLogAge)
-	} else {
-		fmt.Println("Logging to: stdout")
-		logWriter = os.Stdout
-	}
-	s.log.SetOutput(logWriter)
-	s.log.SetJSONFormatter()
-	s.log.SetLevel(logging.Level(cfg.BaseLoggerDebugLevel))
-	setupDeadlockLogger()
 
 	// Check some config parameters.
 	if cfg.RestConnectionsSoftLimit > cfg.RestConnectionsHardLimit {
@@ -119,50 +129,38 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 			cfg.IncomingConnectionsLimit)
 	}
 
-	// Set large enough soft file descriptors limit.
-	var ot basics.OverflowTracker
-	fdRequired := ot.Add(cfg.ReservedFDs, cfg.RestConnectionsHardLimit)
-	if ot.Overflowed {
-		return errors.New(
-			"Initialize() overflowed when adding up ReservedFDs and RestConnectionsHardLimit; decrease them")
-	}
-	err = util.SetFdSoftLimit(fdRequired)
+	// Apportion the file descriptors among the subsystems, raising their soft limit as far as permitted, and shrink
+	// the connection limits to their budgets when the descriptors fall short.
+	s.resources, err = resources.MakeManager(s.log, cfg.ResourceUsageWarningPercent)
 	if err != nil {
 		return fmt.Errorf("Initialize() err: %w", err)
 	}
+	demands := []resources.Demand{
+		{Subsystem: resources.Reserved, FDs: cfg.ReservedFDs, Min: cfg.ReservedFDs},
+		{Subsystem: resources.SQLite, FDs: sqliteFDs, Min: sqliteFDs},
+		{Subsystem: resources.REST, FDs: cfg.RestConnectionsHardLimit, Min: minRestConnections},
+	}
 	if cfg.IsGossipServer() {
-		var ot basics.OverflowTracker
-		fdRequired = ot.Add(fdRequired, uint64(cfg.IncomingConnectionsLimit))
-		if ot.Overflowed {
-			return errors.New("Initialize() overflowed when adding up IncomingConnectionsLimit to the existing RLIMIT_NOFILE value; decrease RestConnectionsHardLimit or IncomingConnectionsLimit")
+		demands = append(demands, resources.Demand{Subsystem: resources.Network, FDs: uint64(cfg.IncomingConnectionsLimit), Min: minIncomingConnections})
+	}
+	budgets, err := s.resources.Apportion(demands)
+	if err != nil {
+		return fmt.Errorf("Initialize() err: %w", err)
+	}
+	if budgets[resources.REST] < cfg.RestConnectionsHardLimit || (cfg.IsGossipServer() && budgets[resources.Network] < uint64(cfg.IncomingConnectionsLimit)) {
+		cfg.RestConnectionsHardLimit = budgets[resources.REST]
+		if cfg.RestConnectionsSoftLimit > cfg.RestConnectionsHardLimit {
+			cfg.RestConnectionsSoftLimit = cfg.RestConnectionsHardLimit
 		}
-		_, hard, fdErr := util.GetFdLimits()
-		if fdErr != nil {
-			s.log.Errorf("Failed to get RLIMIT_NOFILE values: %s", fdErr.Error())
-		} else {
-			maxFDs := fdRequired
-			if fdRequired > hard {
-				// claim as many descriptors are possible
-				maxFDs = hard
-				// but try to keep cfg.ReservedFDs untouched by decreasing other limits
-				if cfg.AdjustConnectionLimits(fdRequired, hard) {
-					s.log.Warnf(
-						"Updated connection limits: RestConnectionsSoftLimit=%d, RestConnectionsHardLimit=%d, IncomingConnectionsLimit=%d",
-						cfg.RestConnectionsSoftLimit,
-						cfg.RestConnectionsHardLimit,
-						cfg.IncomingConnectionsLimit,
-					)
-					if cfg.IncomingConnectionsLimit == 0 {
-						return errors.New("Initialize() failed to adjust connection limits")
-					}
-				}
-			}
-			fdErr = util.SetFdSoftLimit(maxFDs)
-			if fdErr != nil {
-				// do not fail but log the error
-				s.log.Errorf("Failed to set a new RLIMIT_NOFILE value to %d (max %d): %s", fdRequired, hard, fdErr.Error())
-			}
+		if cfg.IsGossipServer() {
+			cfg.IncomingConnectionsLimit = int(budgets[resources.Network])
 		}
+		s.log.Warnf(
+			"Updated connection limits: RestConnectionsSoftLimit=%d, RestConnectionsHardLimit=%d, IncomingConnectionsLimit=%d",
+			cfg.RestConnectionsSoftLimit,
+			cfg.RestConnectionsHardLimit,
+			cfg.IncomingConnectionsLimit,
+		)
 	}
 
 	// configure the deadlock detector library
@@ -197,22 +195,30 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 	}
 	s.log.Infoln("++++++++++++++++++++++++++++++++++++++++")
 
-	metricLabels := map[string]string{}
-	if s.log.GetTelemetryEnabled() {
-		metricLabels["telemetry_session"] = s.log.GetTelemetrySession()
-		if h := s.log.GetTelemetryGUID(); h != "" {
-			metricLabels["telemetry_host"] = h
+	if !s.NoMetrics {
+		metricLabels := map[string]string{}
+		if s.log.GetTelemetryEnabled() {
+			metricLabels["telemetry_session"] = s.log.GetTelemetrySession()
+			if h := s.log.GetTelemetryGUID(); h != "" {
+				metricLabels["telemetry_host"] = h
+			}
+			if i := s.log.GetInstanceName(); i != "" {
+				metricLabels["telemetry_instance"] = i
+			}
 		}
-		if i := s.log.GetInstanceName(); i != "" {
-			metricLabels["telemetry_instance"] = i
+		metrics.SetMaxLabelSets(cfg.MetricsMaxLabelSets)
+		for _, subsystem := range strings.Split(cfg.MetricsDisabledSubsystems, ",") {
+			if subsystem = strings.TrimSpace(subsystem); subsystem != "" {
+				metrics.DefaultRegistry().SetSubsystemEnabled(subsystem, false)
+			}
 		}
+		s.metricCollector = metrics.MakeMetricService(
+			&metrics.ServiceConfig{
+				NodeExporterListenAddress: cfg.NodeExporterListenAddress,
+				Labels:                    metricLabels,
+				NodeExporterPath:          cfg.NodeExporterPath,
+			})
 	}
-	s.metricCollector = metrics.MakeMetricService(
-		&metrics.ServiceConfig{
-			NodeExporterListenAddress: cfg.NodeExporterListenAddress,
-			Labels:                    metricLabels,
-			NodeExporterPath:          cfg.NodeExporterPath,
-		})
 
 	var serverNode ServerNode
 	if cfg.EnableFollowMode {
@@ -234,6 +240,41 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 	return nil
 }
 
+// SetupLogOutput directs log to the node.log file of rootPath, or to stdout when the config disables
+// the log file, and sets up the deadlock detector to log to it and keep its reports in rootPath.
+// The additional log files set at runtime are restricted to rootPath and to the log archive directory.
+func SetupLogOutput(log logging.Logger, rootPath string, cfg config.Local) {
+	liveLog := filepath.Join(rootPath, "node.log")
+	archive := filepath.Join(rootPath, cfg.LogArchiveName)
+	var maxLogAge time.Duration
+	var err error
+	if cfg.LogArchiveMaxAge != "" {
+		maxLogAge, err = time.ParseDuration(cfg.LogArchiveMaxAge)
+		if err != nil {
+			log.Fatalf("invalid config LogArchiveMaxAge: %s", err)
+			maxLogAge = 0
+		}
+	}
+
+	var logWriter io.Writer
+	if cfg.LogSizeLimit > 0 {
+		fmt.Println("Logging to: ", liveLog)
+		logWriter = logging.MakeCyclicFileWriter(liveLog, archive, cfg.LogSizeLimit, maxLogAge)
+	} else {
+		fmt.Println("Logging to: stdout")
+		logWriter = os.Stdout
+	}
+	log.SetOutput(logWriter)
+	if cfg.StructuredLogging {
+		log.SetStructuredFormatter()
+	} else {
+		log.SetJSONFormatter()
+	}
+	log.SetLevel(logging.Level(cfg.BaseLoggerDebugLevel))
+	log.SetAdditionalOutputDirs(rootPath, filepath.Dir(archive))
+	setupDeadlockLogger(rootPath)
+}
+
 // helper handles startup of tcp listener
 func makeListener(addr string) (net.Listener, error) {
 	var listener net.Listener
@@ -250,8 +291,16 @@ func makeListener(addr string) (net.Listener, error) {
 	return net.Listen("tcp", addr)
 }
 
-// Start starts a Node instance and its network services
+// Start starts a Node instance and its network services, and serves them until the REST listener
+// stops or the process is interrupted.
 func (s *Server) Start() {
+	errChan := s.Serve()
+	serveUntilExit(s.log, errChan, s.Stop)
+}
+
+// Serve starts a Node instance and its network services, and returns a channel receiving the
+// result of the REST listener once it stops.
+func (s *Server) Serve() <-chan error {
 	s.log.Info("Trying to start an Algorand node")
 	fmt.Print("Initializing the Algorand node... ")
 	s.node.Start()
@@ -260,11 +309,11 @@ func (s *Server) Start() {
 
 	cfg := s.node.Config()
 
-	if cfg.EnableRuntimeMetrics {
+	if cfg.EnableRuntimeMetrics && !s.NoMetrics {
 		metrics.DefaultRegistry().Register(metrics.NewRuntimeMetrics())
 	}
 
-	if cfg.EnableMetricReporting {
+	if cfg.EnableMetricReporting && !s.NoMetrics {
 		if err := s.metricCollector.Start(context.Background()); err != nil {
 			// log this error
 			s.log.Infof("Unable to start metric collection service : %v", err)
@@ -284,7 +333,14 @@ func (s *Server) Start() {
 		os.Exit(1)
 	}
 
+	tokenStore, err := tokens.LoadStore(s.RootPath, tokens.AlgodTokenStoreFilename)
+	if err != nil {
+		fmt.Printf("APIToken error: %v\n", err)
+		os.Exit(1)
+	}
+
 	s.stopping = make(chan struct{})
+	go s.resources.Run(s.stopping, resourcesCheckInterval)
 
 	addr := cfg.EndpointAddress
 	if addr == "" {
@@ -300,16 +356,27 @@ func (s *Server) Start() {
 		listener, cfg.RestConnectionsHardLimit, s.log)
 
 	addr = listener.Addr().String()
-	server = http.Server{
+	s.restServer = &http.Server{
 		Addr:           addr,
 		ReadTimeout:    time.Duration(cfg.RestReadTimeoutSeconds) * time.Second,
 		WriteTimeout:   time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
 		MaxHeaderBytes: maxHeaderBytes,
 	}
 
+	policy := apiServer.ListenerPolicy{
+		PublicOnly:         cfg.AdminEndpointAddress != "",
+		CORSAllowedOrigins: splitList(cfg.RestCORSAllowedOrigins),
+		AccessLogSampling:  cfg.RestAccessLogSampling,
+		NoMetrics:          s.NoMetrics,
+	}
+	policy.Quotas, err = makeQuotaLimiter(cfg, adminAPIToken)
+	if err != nil {
+		fmt.Printf("Could not start node: %v\n", err)
+		os.Exit(1)
+	}
 	e := apiServer.NewRouter(
-		s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
-		cfg.RestConnectionsSoftLimit)
+		s.log, s.node, s.stopping, apiToken, adminAPIToken, tokenStore, listener,
+		cfg.RestConnectionsSoftLimit, policy)
 
 	// Set up files for our PID and our listening address
 	// before beginning to listen to prevent 'goal node start'
@@ -337,35 +404,312 @@ func (s *Server) Start() {
 		}
 	}
 
+	if cfg.EndpointUnixSocket != "" {
+		err = s.startUnixSocketServer(cfg, apiToken, adminAPIToken, tokenStore, policy)
+		if err != nil {
+			fmt.Printf("Could not start unix socket listener: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if cfg.AdminEndpointAddress != "" {
+		err = s.startAdminServer(cfg, apiToken, adminAPIToken, tokenStore)
+		if err != nil {
+			fmt.Printf("Could not start admin listener: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if cfg.MetricsListenAddress != "" && !s.NoMetrics {
+		err = s.startMetricsServer(cfg, apiToken, adminAPIToken, tokenStore)
+		if err != nil {
+			fmt.Printf("Could not start metrics listener: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if cfg.GRPCListenAddress != "" {
+		err = s.startGRPCServer(cfg, apiToken, adminAPIToken, tokenStore)
+		if err != nil {
+			fmt.Printf("Could not start gRPC listener: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	errChan := make(chan error, 1)
-	go func() {
-		err := e.StartServer(&server)
+	go func(restServer *http.Server) {
+		err := e.StartServer(restServer)
 		errChan <- err
-	}()
+	}(s.restServer)
+
+	fmt.Printf("Node running and accepting RPC requests over HTTP on port %v. Press Ctrl-C to exit\n", addr)
+
+	s.systemd = makeSystemdNotifier()
+	if s.systemd != nil {
+		go s.systemdNotifyThread(cfg.SystemdReadyMaxLag)
+	}
+	return errChan
+}
 
+// serveUntilExit waits for a REST listener to stop or for the process to be interrupted, and calls stop.
+// stopRequests receives the requests to stop the server which don't come as process signals, such
+// as the stop requests of the Windows service control manager.
+var stopRequests = make(chan string, 1)
+
+// RequestStop asks the server to stop as it would on an interrupt, letting serving return instead of
+// exiting the process. reason is printed as the cause of exiting.
+func RequestStop(reason string) {
+	select {
+	case stopRequests <- reason:
+	default:
+	}
+}
+
+func serveUntilExit(log logging.Logger, errChan <-chan error, stop func()) {
 	// Handle signals cleanly
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
 	signal.Ignore(syscall.SIGHUP)
 
-	fmt.Printf("Node running and accepting RPC requests over HTTP on port %v. Press Ctrl-C to exit\n", addr)
 	select {
 	case err := <-errChan:
 		if err != nil {
-			s.log.Warn(err)
+			log.Warn(err)
 		} else {
-			s.log.Info("Node exited successfully")
+			log.Info("Node exited successfully")
 		}
-		s.Stop()
+		stop()
 	case sig := <-c:
 		fmt.Printf("Exiting on %v\n", sig)
-		s.Stop()
+		stop()
 		os.Exit(0)
+	case reason := <-stopRequests:
+		fmt.Printf("Exiting on %s\n", reason)
+		stop()
 	}
 }
 
+// startAdminServer serves every endpoint of the REST API, including the admin and participation ones,
+// on the configured admin listener, over TLS when a certificate is configured, and writes its address
+// to the admin net file.
+func (s *Server) startAdminServer(cfg config.Local, apiToken string, adminAPIToken string, tokenStore *tokens.Store) error {
+	useTLS := cfg.AdminTLSCertFile != "" || cfg.AdminTLSKeyFile != ""
+	if useTLS && (cfg.AdminTLSCertFile == "" || cfg.AdminTLSKeyFile == "") {
+		return fmt.Errorf("both AdminTLSCertFile and AdminTLSKeyFile must be set to serve the admin API over TLS")
+	}
+	var tlsConfig *tls.Config
+	if useTLS {
+		cert, err := tls.LoadX509KeyPair(cfg.AdminTLSCertFile, cfg.AdminTLSKeyFile)
+		if err != nil {
+			return err
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	listener, err := net.Listen("tcp", cfg.AdminEndpointAddress)
+	if err != nil {
+		return err
+	}
+	listener = limitlistener.RejectingLimitListener(
+		listener, cfg.RestConnectionsHardLimit, s.log)
+	if useTLS {
+		// the router serves the listener as is, so the TLS handshake is done by the listener itself.
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	policy := apiServer.ListenerPolicy{
+		CORSAllowedOrigins: splitList(cfg.AdminCORSAllowedOrigins),
+		AccessLogSampling:  cfg.AdminAccessLogSampling,
+		NoMetrics:          s.NoMetrics,
+	}
+	e := apiServer.NewRouter(
+		s.log, s.node, s.stopping, apiToken, adminAPIToken, tokenStore, listener,
+		cfg.RestConnectionsSoftLimit, policy)
+
+	addr := listener.Addr().String()
+	netAddr := addr
+	if useTLS {
+		netAddr = "https://" + addr
+	}
+	s.adminNetFile = filepath.Join(s.RootPath, "algod-admin.net")
+	err = os.WriteFile(s.adminNetFile, []byte(fmt.Sprintf("%s\n", netAddr)), 0644)
+	if err != nil {
+		listener.Close()
+		return err
+	}
+
+	s.adminServer = &http.Server{
+		Addr:           addr,
+		ReadTimeout:    time.Duration(cfg.RestReadTimeoutSeconds) * time.Second,
+		WriteTimeout:   time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
+		MaxHeaderBytes: maxHeaderBytes,
+	}
+	go func(adminServer *http.Server) {
+		err := e.StartServer(adminServer)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Warnf("admin listener stopped: %v", err)
+		}
+	}(s.adminServer)
+
+	if useTLS {
+		s.log.Infof("Serving the admin API on %s over TLS", addr)
+		return nil
+	}
+	s.log.Infof("Serving the admin API on %s", addr)
+	return nil
+}
+
+// startUnixSocketServer serves the REST API on the configured unix domain socket, with the policy of the
+// REST listener on EndpointAddress.
+func (s *Server) startUnixSocketServer(cfg config.Local, apiToken string, adminAPIToken string, tokenStore *tokens.Store, policy apiServer.ListenerPolicy) error {
+	mode, err := util.ParseFileMode(cfg.EndpointUnixSocketMode)
+	if err != nil {
+		return err
+	}
+	path := cfg.EndpointUnixSocket
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(s.RootPath, path)
+	}
+
+	listener, err := util.ListenUnixSocket(path, mode)
+	if err != nil {
+		return err
+	}
+	listener = limitlistener.RejectingLimitListener(
+		listener, cfg.RestConnectionsHardLimit, s.log)
+
+	e := apiServer.NewRouter(
+		s.log, s.node, s.stopping, apiToken, adminAPIToken, tokenStore, listener,
+		cfg.RestConnectionsSoftLimit, policy)
+
+	s.unixSocketServer = &http.Server{
+		Addr:           path,
+		ReadTimeout:    time.Duration(cfg.RestReadTimeoutSeconds) * time.Second,
+		WriteTimeout:   time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
+		MaxHeaderBytes: maxHeaderBytes,
+	}
+	go func(unixSocketServer *http.Server) {
+		err := e.StartServer(unixSocketServer)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Warnf("unix socket listener stopped: %v", err)
+		}
+	}(s.unixSocketServer)
+
+	s.log.Infof("Serving the REST API on the unix domain socket %s", path)
+	return nil
+}
+
+// makeQuotaLimiter makes the limiter of the requests of the API tokens, shared by the REST listeners, or returns
+// nil when the tokens are not limited. The admin API token is never limited.
+func makeQuotaLimiter(cfg config.Local, adminAPIToken string) (*middlewares.QuotaLimiter, error) {
+	costs, err := middlewares.ParseEndpointCosts(cfg.RestEndpointCosts)
+	if err != nil {
+		return nil, err
+	}
+	quotaCfg := middlewares.QuotaConfig{
+		RequestsPerSecond:  cfg.RestTokenRequestsPerSecond,
+		Burst:              cfg.RestTokenRequestsBurst,
+		ConcurrentRequests: cfg.RestTokenConcurrentRequests,
+		EndpointCosts:      costs,
+	}
+	if !quotaCfg.Enabled() {
+		return nil, nil
+	}
+	return middlewares.MakeQuotaLimiter(quotaCfg, adminAPIToken), nil
+}
+
+// splitList splits a comma delimited config value, ignoring the empty entries.
+func splitList(list string) []string {
+	var res []string
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
+// startMetricsServer serves the metrics in the Prometheus exposition format on the configured metrics listener.
+func (s *Server) startMetricsServer(cfg config.Local, apiToken string, adminAPIToken string, tokenStore *tokens.Store) error {
+	useTLS := cfg.MetricsTLSCertFile != "" || cfg.MetricsTLSKeyFile != ""
+	if useTLS && (cfg.MetricsTLSCertFile == "" || cfg.MetricsTLSKeyFile == "") {
+		return fmt.Errorf("both MetricsTLSCertFile and MetricsTLSKeyFile must be set to serve metrics over TLS")
+	}
+
+	listener, err := net.Listen("tcp", cfg.MetricsListenAddress)
+	if err != nil {
+		return err
+	}
+
+	s.metricsServer = &http.Server{
+		Addr:           listener.Addr().String(),
+		Handler:        apiServer.NewMetricsRouter(s.log, apiToken, adminAPIToken, tokenStore, cfg.MetricsRequireAuth),
+		ReadTimeout:    time.Duration(cfg.RestReadTimeoutSeconds) * time.Second,
+		WriteTimeout:   time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
+		MaxHeaderBytes: maxHeaderBytes,
+	}
+	go func(metricsServer *http.Server) {
+		var err error
+		if useTLS {
+			err = metricsServer.ServeTLS(listener, cfg.MetricsTLSCertFile, cfg.MetricsTLSKeyFile)
+		} else {
+			err = metricsServer.Serve(listener)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Warnf("metrics listener stopped: %v", err)
+		}
+	}(s.metricsServer)
+
+	s.log.Infof("Serving metrics on %s", listener.Addr().String())
+	return nil
+}
+
+// startGRPCServer serves the gRPC interface of the node on the configured gRPC listener, over TLS when
+// a certificate is configured.
+func (s *Server) startGRPCServer(cfg config.Local, apiToken string, adminAPIToken string, tokenStore *tokens.Store) error {
+	useTLS := cfg.GRPCTLSCertFile != "" || cfg.GRPCTLSKeyFile != ""
+	if useTLS && (cfg.GRPCTLSCertFile == "" || cfg.GRPCTLSKeyFile == "") {
+		return fmt.Errorf("both GRPCTLSCertFile and GRPCTLSKeyFile must be set to serve gRPC over TLS")
+	}
+	var tlsConfig *tls.Config
+	if useTLS {
+		cert, err := tls.LoadX509KeyPair(cfg.GRPCTLSCertFile, cfg.GRPCTLSKeyFile)
+		if err != nil {
+			return err
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+
+	listener, err := net.Listen("tcp", cfg.GRPCListenAddress)
+	if err != nil {
+		return err
+	}
+
+	s.grpcServer = grpc.MakeServer(s.log, s.node, s.stopping, apiToken, adminAPIToken, tokenStore, tlsConfig)
+	go func(grpcServer *grpc.Server) {
+		err := grpcServer.Serve(listener)
+		if err != nil {
+			s.log.Warnf("gRPC listener stopped: %v", err)
+		}
+	}(s.grpcServer)
+
+	if useTLS {
+		s.log.Infof("Serving gRPC over TLS on %s", listener.Addr().String())
+	} else {
+		s.log.Infof("Serving gRPC on %s", listener.Addr().String())
+	}
+	return nil
+}
+
 // Stop initiates a graceful shutdown of the node by shutting down the network server.
 func (s *Server) Stop() {
+	if s.systemd != nil {
+		if err := s.systemd.notify(systemdStopping); err != nil {
+			s.log.Warnf("systemd: could not notify stopping: %v", err)
+		}
+	}
+
 	// close the s.stopping, which would signal the rest api router that any pending commands
 	// should be aborted.
 	close(s.stopping)
@@ -373,13 +717,51 @@ func (s *Server) Stop() {
 	// Attempt to log a shutdown event before we exit...
 	s.log.Event(telemetryspec.ApplicationState, telemetryspec.ShutdownEvent)
 
-	s.node.Stop()
+	// Stop serving requests before stopping the node, letting the requests in flight complete
+	// within the shutdown deadline, so that no request reaches the node while it drains.
+	shutdownCtx := context.Background()
+	if deadline := s.node.Config().ShutdownDeadline; deadline > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, deadline)
+		defer cancel()
+	}
 
-	err := server.Shutdown(context.Background())
+	err := s.restServer.Shutdown(shutdownCtx)
 	if err != nil {
 		s.log.Error(err)
 	}
 
+	if s.adminServer != nil {
+		err = s.adminServer.Shutdown(shutdownCtx)
+		if err != nil {
+			s.log.Error(err)
+		}
+		s.adminServer = nil
+	}
+
+	if s.unixSocketServer != nil {
+		err = s.unixSocketServer.Shutdown(shutdownCtx)
+		if err != nil {
+			s.log.Error(err)
+		}
+		s.unixSocketServer = nil
+	}
+
+	if s.metricsServer != nil {
+		err = s.metricsServer.Shutdown(context.Background())
+		if err != nil {
+			s.log.Error(err)
+		}
+		s.metricsServer = nil
+	}
+
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+		s.grpcServer = nil
+	}
+
+	s.node.Stop()
+
 	if s.metricServiceStarted {
 		if err := s.metricCollector.Shutdown(); err != nil {
 			// log this error
@@ -388,9 +770,12 @@ func (s *Server) Stop() {
 		s.metricServiceStarted = false
 	}
 
-	s.log.CloseTelemetry()
+	if s.Log == nil {
+		s.log.CloseTelemetry()
+	}
 
 	os.Remove(s.pidFile)
 	os.Remove(s.netFile)
 	os.Remove(s.netListenFile)
+	os.Remove(s.adminNetFile)
 }
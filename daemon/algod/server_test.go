@@ -23,6 +23,7 @@ import (
 	"net"
 	"testing"
 
+	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -74,3 +75,20 @@ func TestFirstListenerSetupGetsPassedPortWhenPassedPortNonZero(t *testing.T) {
 	actualAddr := listener.Addr().String()
 	require.Equal(t, expectedAddr, actualAddr)
 }
+
+func TestServeUntilExitOnStopRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	stopped := false
+	RequestStop("test request")
+	// a second request doesn't block while the first is pending
+	RequestStop("another test request")
+	serveUntilExit(logging.TestingLog(t), make(chan error), func() { stopped = true })
+	require.True(t, stopped)
+
+	select {
+	case reason := <-stopRequests:
+		require.Fail(t, "unexpected pending stop request", reason)
+	default:
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package algod
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/node"
+)
+
+// The environment variables systemd passes the notification socket and the watchdog of a service in.
+const (
+	systemdNotifySocketEnv = "NOTIFY_SOCKET"
+	systemdWatchdogUsecEnv = "WATCHDOG_USEC"
+	systemdWatchdogPIDEnv  = "WATCHDOG_PID"
+)
+
+// The states sent to systemd, as described in sd_notify(3).
+const (
+	systemdReady    = "READY=1"
+	systemdStopping = "STOPPING=1"
+	systemdWatchdog = "WATCHDOG=1"
+)
+
+// systemdPollInterval is how often the node is checked for having caught up, until it is ready.
+const systemdPollInterval = time.Second
+
+// systemdNotifier sends the state of the node to systemd over the notification socket of the service algod runs
+// as, the way sd_notify(3) does.
+type systemdNotifier struct {
+	addr *net.UnixAddr
+	// watchdog is the WatchdogSec of the service, the time within which systemd expects a keepalive, or 0 if the
+	// service has no watchdog.
+	watchdog time.Duration
+}
+
+// makeSystemdNotifier returns a notifier for the service algod runs as, or nil if it does not run as a systemd
+// service expecting notifications.
+func makeSystemdNotifier() *systemdNotifier {
+	socket := os.Getenv(systemdNotifySocketEnv)
+	if socket == "" {
+		return nil
+	}
+	// a socket name starting with @ is in the abstract namespace, which the net package supports as is.
+	return &systemdNotifier{
+		addr:     &net.UnixAddr{Name: socket, Net: "unixgram"},
+		watchdog: systemdWatchdogInterval(),
+	}
+}
+
+// systemdWatchdogInterval returns the WatchdogSec of the service, if it applies to this process.
+func systemdWatchdogInterval() time.Duration {
+	usec, err := strconv.ParseInt(os.Getenv(systemdWatchdogUsecEnv), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0
+	}
+	if pid := os.Getenv(systemdWatchdogPIDEnv); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0
+	}
+	return time.Duration(usec) * time.Microsecond
+}
+
+// notify sends newline separated state assignments to systemd.
+func (n *systemdNotifier) notify(state string) error {
+	conn, err := net.DialUnix(n.addr.Net, nil, n.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte(state))
+	return err
+}
+
+// caughtUp returns whether the node caught up with the network: it is not catching up from a catchpoint, and its
+// latest block is at most maxLag old.
+func caughtUp(status node.StatusReport, latest bookkeeping.BlockHeader, now time.Time, maxLag time.Duration) bool {
+	return status.Catchpoint == "" && now.Sub(time.Unix(latest.TimeStamp, 0)) <= maxLag
+}
+
+// systemdNotifyThread notifies systemd that the node is ready once it caught up with the network, and sends the
+// watchdog keepalives while the node progresses, until the server stops.
+func (s *Server) systemdNotifyThread(maxLag time.Duration) {
+	n := s.systemd
+	interval := systemdPollInterval
+	if n.watchdog > 0 && n.watchdog/2 < interval {
+		interval = n.watchdog / 2
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	ready := false
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.stopping:
+			return
+		}
+
+		if n.watchdog > 0 && s.node.Progressing() {
+			if err := n.notify(systemdWatchdog); err != nil {
+				s.log.Warnf("systemd: could not send the watchdog keepalive: %v", err)
+			}
+		}
+		if ready {
+			continue
+		}
+
+		status, err := s.node.Status()
+		if err != nil {
+			continue
+		}
+		latest, err := s.node.LedgerForAPI().BlockHdr(status.LastRound)
+		if err != nil {
+			continue
+		}
+		if !caughtUp(status, latest, time.Now(), maxLag) {
+			if err := n.notify(fmt.Sprintf("STATUS=Catching up at round %d", status.LastRound)); err != nil {
+				s.log.Warnf("systemd: could not send the status: %v", err)
+			}
+			continue
+		}
+
+		if err := n.notify(fmt.Sprintf("%s\nSTATUS=Caught up at round %d", systemdReady, status.LastRound)); err != nil {
+			s.log.Warnf("systemd: could not notify readiness: %v", err)
+			continue
+		}
+		s.log.Infof("systemd: notified readiness at round %d", status.LastRound)
+		ready = true
+		if n.watchdog == 0 {
+			return
+		}
+		// keepalives are expected within the watchdog interval, sending them twice as often tolerates a late one
+		ticker.Reset(n.watchdog / 2)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package algod
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSystemdNotify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	t.Setenv(systemdNotifySocketEnv, "")
+	require.Nil(t, makeSystemdNotifier())
+
+	socket := filepath.Join(t.TempDir(), "notify")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	t.Setenv(systemdNotifySocketEnv, socket)
+	t.Setenv(systemdWatchdogUsecEnv, "")
+	n := makeSystemdNotifier()
+	require.NotNil(t, n)
+	require.Zero(t, n.watchdog)
+
+	require.NoError(t, n.notify(systemdReady+"\nSTATUS=Caught up at round 5"))
+	buf := make([]byte, 128)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+	read, err := conn.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "READY=1\nSTATUS=Caught up at round 5", string(buf[:read]))
+}
+
+func TestSystemdWatchdogInterval(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	t.Setenv(systemdWatchdogUsecEnv, "")
+	require.Zero(t, systemdWatchdogInterval())
+
+	t.Setenv(systemdWatchdogUsecEnv, "30000000")
+	t.Setenv(systemdWatchdogPIDEnv, "")
+	require.Equal(t, 30*time.Second, systemdWatchdogInterval())
+
+	t.Setenv(systemdWatchdogPIDEnv, strconv.Itoa(os.Getpid()))
+	require.Equal(t, 30*time.Second, systemdWatchdogInterval())
+
+	// the watchdog of another process of the service
+	t.Setenv(systemdWatchdogPIDEnv, strconv.Itoa(os.Getpid()+1))
+	require.Zero(t, systemdWatchdogInterval())
+
+	t.Setenv(systemdWatchdogPIDEnv, "")
+	t.Setenv(systemdWatchdogUsecEnv, "not a number")
+	require.Zero(t, systemdWatchdogInterval())
+}
+
+func TestCaughtUp(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	var latest bookkeeping.BlockHeader
+	latest.TimeStamp = now.Add(-30 * time.Second).Unix()
+
+	require.True(t, caughtUp(node.StatusReport{}, latest, now, time.Minute))
+	require.False(t, caughtUp(node.StatusReport{}, latest, now, 10*time.Second))
+	require.False(t, caughtUp(node.StatusReport{Catchpoint: "1000#ABCD"}, latest, now, time.Minute))
+}
@@ -71,6 +71,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/daemon/kmd/api/v1"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
@@ -137,7 +138,7 @@ func SwaggerHandler(w http.ResponseWriter, r *http.Request) {
 
 // Handler returns the root mux router for the kmd API. It sets up handlers on
 // subrouters specific to each API version.
-func Handler(sm *session.Manager, log logging.Logger, allowedOrigins []string, apiToken string, reqCB func()) *mux.Router {
+func Handler(sm *session.Manager, auditLog *audit.Log, log logging.Logger, allowedOrigins []string, apiToken string, reqCB func()) *mux.Router {
 	rootRouter := mux.NewRouter()
 
 	// Send the appropriate CORS headers
@@ -152,9 +153,15 @@ func Handler(sm *session.Manager, log logging.Logger, allowedOrigins []string, a
 	rootRouter.HandleFunc("/versions", versionsHandler)
 	rootRouter.HandleFunc("/swagger.json", SwaggerHandler)
 
+	// The approving clients of the signing relay don't hold the API token,
+	// since they authenticate with their session keys, so their routes are
+	// registered before the /v1 subrouter requiring it.
+	relayClientRouter := rootRouter.PathPrefix(fmt.Sprintf("/%s/relay/client", apiV1Tag)).Subrouter()
+	v1.RegisterRelayClientHandlers(relayClientRouter, sm, auditLog, log)
+
 	// Handle API V1 routes at /v1/<...>
 	v1Router := rootRouter.PathPrefix(fmt.Sprintf("/%s", apiV1Tag)).Subrouter()
-	v1.RegisterHandlers(v1Router, sm, log, apiToken, reqCB)
+	v1.RegisterHandlers(v1Router, sm, auditLog, log, apiToken, reqCB)
 
 	return rootRouter
 }
@@ -26,3 +26,9 @@ var errCouldNotDecode = fmt.Errorf("could not decode request body")
 var errCouldNotDecodeAddress = fmt.Errorf("could not decode address")
 var errCouldNotDecodeTx = fmt.Errorf("could not decode transaction")
 var errInvalidAPIToken = fmt.Errorf("invalid API token")
+var errAuditLogDisabled = fmt.Errorf("audit log is not enabled")
+var errAuditLogFailed = fmt.Errorf("could not record the operation in the audit log")
+var errMnemonicAndMDK = fmt.Errorf("a wallet can be recovered from either a master derivation key or a BIP-39 mnemonic, not both")
+var errNoHDSupport = fmt.Errorf("wallet driver cannot recover wallets from BIP-39 mnemonics")
+var errNoFrostSupport = fmt.Errorf("wallet driver does not support FROST key shares")
+var errCouldNotDecodeFrost = fmt.Errorf("could not decode FROST commitments or signature shares")
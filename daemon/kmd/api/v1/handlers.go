@@ -17,11 +17,14 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
@@ -35,7 +38,70 @@ import (
 // reqContext is passed to each of the handlers below via wrapCtx, allowing
 // handlers to interact with kmd's session store
 type reqContext struct {
-	sm *session.Manager
+	sm    *session.Manager
+	audit *audit.Log
+	log   logging.Logger
+}
+
+// recordAudit appends an entry for a key or signing operation on wlt to the
+// audit log, if it is enabled, along with the error the operation failed
+// with. The operations releasing a secret key or a signature, or deleting a
+// key, are recorded before they run and must not run when recordAudit fails,
+// so that none of them goes unrecorded: they are recorded again with their
+// error if they fail. Failing to write the entry is logged as well.
+func (ctx reqContext) recordAudit(r *http.Request, wlt wallet.Wallet, entry audit.Entry, opErr error) error {
+	if ctx.audit == nil {
+		return nil
+	}
+
+	entry.Requester = r.RemoteAddr
+	metadata, err := wlt.Metadata()
+	if err == nil {
+		entry.WalletID = string(metadata.ID)
+	}
+	if opErr != nil {
+		entry.Error = opErr.Error()
+	}
+
+	err = ctx.audit.Record(entry)
+	if err != nil {
+		ctx.log.Errorf("failed to record %s in the audit log: %v", entry.Event, err)
+	}
+	return err
+}
+
+// displayHints describes tx the way wlt asks the user to confirm it, if it
+// does, so that clients can show the same description
+func displayHints(wlt wallet.Wallet, tx transactions.Transaction) []string {
+	hinter, ok := wlt.(wallet.DisplayHinter)
+	if !ok {
+		return nil
+	}
+	return hinter.DisplayHints(tx)
+}
+
+// frostSigner returns wlt as a wallet.FrostSigner, if its driver holds FROST
+// key shares
+func frostSigner(wlt wallet.Wallet) (wallet.FrostSigner, error) {
+	signer, ok := wlt.(wallet.FrostSigner)
+	if !ok {
+		return nil, errNoFrostSupport
+	}
+	return signer, nil
+}
+
+// frostCommitmentsFromAPI decodes the FROST commitments of a request
+func frostCommitmentsFromAPI(apiCommitments []kmdapi.APIV1FrostCommitment) ([]crypto.FrostCommitment, error) {
+	commitments := make([]crypto.FrostCommitment, len(apiCommitments))
+	for i, c := range apiCommitments {
+		if len(c.Hiding) != len(commitments[i].Hiding) || len(c.Binding) != len(commitments[i].Binding) {
+			return nil, errCouldNotDecodeFrost
+		}
+		commitments[i].Identifier = c.Identifier
+		copy(commitments[i].Hiding[:], c.Hiding)
+		copy(commitments[i].Binding[:], c.Binding)
+	}
+	return commitments, nil
 }
 
 // errorResponse sets the specified status code (should != 200), and fills in the
@@ -171,8 +237,13 @@ func postWalletHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 		walletName = walletID
 	}
 
-	// Create the wallet via its driver
-	err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	// Create the wallet via its driver, recovering it from a BIP-39
+	// mnemonic if one was passed
+	if req.Mnemonic != "" {
+		err = createHDWallet(walletDriver, walletName, walletID, req)
+	} else {
+		err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -201,6 +272,26 @@ func postWalletHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	successResponse(w, resp)
 }
 
+// createHDWallet creates a wallet deriving its keys from the BIP-39 mnemonic
+// of req, if the wallet driver supports it
+func createHDWallet(walletDriver driver.Driver, walletName, walletID []byte, req kmdapi.APIV1POSTWalletRequest) error {
+	if req.MasterDerivationKey != (kmdapi.APIV1MasterDerivationKey{}) {
+		return errMnemonicAndMDK
+	}
+
+	hdDriver, ok := walletDriver.(driver.HDDriver)
+	if !ok {
+		return errNoHDSupport
+	}
+
+	seed, err := passphrase.BIP39MnemonicToSeed(req.Mnemonic, req.MnemonicPassphrase)
+	if err != nil {
+		return err
+	}
+
+	return hdDriver.CreateHDWallet(walletName, walletID, []byte(req.WalletPassword), seed, req.HDAccount)
+}
+
 // postWalletInitHandler handles `POST /v1/wallet/init`
 func postWalletInitHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/wallet/init InitWalletHandleToken
@@ -240,7 +331,7 @@ func postWalletInitHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	}
 
 	// Attempt to auth
-	handleToken, err := ctx.sm.InitWalletHandle(wallet, []byte(req.WalletPassword))
+	handleToken, err := ctx.sm.InitWalletHandle(wallet, []byte(req.WalletPassword), req.Policy)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -348,9 +439,23 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// Export the master derivation key
+	// Check that the wallet handle may export keys
+	entry := audit.Entry{Event: audit.ExportMasterKey}
+	err = ctx.sm.AuthorizeExport([]byte(req.WalletHandleToken), req.Confirmation)
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
+	// Export the master derivation key, once the export is recorded
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 	mdk, err := wallet.ExportMasterDerivationKey([]byte(req.WalletPassword))
 	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
@@ -513,6 +618,10 @@ func postWalletRenameHandler(ctx reqContext, w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// Revoke the relay pairings, approved by their clients under the former
+	// name of the wallet
+	ctx.sm.RevokeRelayPairings(metadata.ID)
+
 	// Fetch the renamed wallet metadata
 	metadata, err = wallet.Metadata()
 	if err != nil {
@@ -569,9 +678,15 @@ func postKeyImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 	// Import the key
 	addr, err := wallet.ImportKey(req.PrivateKey)
 	if err != nil {
+		ctx.recordAudit(r, wallet, audit.Entry{Event: audit.ImportKey}, err)
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
+	err = ctx.recordAudit(r, wallet, audit.Entry{Event: audit.ImportKey, Address: encodeAddress(addr)}, nil)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 
 	// Build the response
 	resp := kmdapi.APIV1POSTKeyImportResponse{
@@ -623,9 +738,23 @@ func postKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// Export the key
+	// Check that the wallet handle may export keys
+	entry := audit.Entry{Event: audit.ExportKey, Address: req.Address}
+	err = ctx.sm.AuthorizeExport([]byte(req.WalletHandleToken), req.Confirmation)
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
+	// Export the key, once the export is recorded
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 	secretKey, err := wallet.ExportKey(crypto.Digest(reqAddr), []byte(req.WalletPassword))
 	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
@@ -678,9 +807,15 @@ func postKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// Generate the key
 	addr, err := wallet.GenerateKey(req.DisplayMnemonic)
 	if err != nil {
+		ctx.recordAudit(r, wallet, audit.Entry{Event: audit.GenerateKey}, err)
 		errorResponse(w, http.StatusInternalServerError, err)
 		return
 	}
+	err = ctx.recordAudit(r, wallet, audit.Entry{Event: audit.GenerateKey, Address: encodeAddress(addr)}, nil)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 
 	// Build the response
 	resp := kmdapi.APIV1POSTKeyResponse{
@@ -732,9 +867,15 @@ func deleteKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Delete the key
+	// Delete the key, once the deletion is recorded
+	entry := audit.Entry{Event: audit.DeleteKey, Address: req.Address}
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 	err = wallet.DeleteKey(crypto.Digest(reqAddr), []byte(req.WalletPassword))
 	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
@@ -842,9 +983,23 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// Sign the transaction
+	// Check the transaction against the policies of the wallet handle
+	entry := audit.Entry{Event: audit.SignTransaction, Address: tx.Sender.String(), Digest: tx.ID().String()}
+	err = ctx.sm.AuthorizeTransaction([]byte(req.WalletHandleToken), tx, req.Confirmation)
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
+	// Sign the transaction, once it is recorded
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 	stx, err := wallet.SignTransaction(tx, req.PublicKey, []byte(req.WalletPassword))
 	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
@@ -852,6 +1007,7 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	// Build the response
 	resp := kmdapi.APIV1POSTTransactionSignResponse{
 		SignedTransaction: stx,
+		DisplayHints:      displayHints(wallet, tx),
 	}
 
 	// Return and encode the response
@@ -901,8 +1057,23 @@ func postProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// Check that the policies of the wallet handle allow signing programs
+	entry := audit.Entry{Event: audit.SignProgram, Address: req.Address, Digest: crypto.Hash(req.Program).String()}
+	err = ctx.sm.AuthorizeProgram([]byte(req.WalletHandleToken))
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
+	// Sign the program, once it is recorded
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 	stx, err := wallet.SignProgram(req.Program, crypto.Digest(reqAddr), []byte(req.WalletPassword))
 	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
@@ -1126,16 +1297,31 @@ func postMultisigTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r
 		return
 	}
 
-	// Sign the transaction
+	// Check the transaction against the policies of the wallet handle
+	entry := audit.Entry{Event: audit.SignMultisigTransaction, Address: tx.Sender.String(), Digest: tx.ID().String()}
+	err = ctx.sm.AuthorizeTransaction([]byte(req.WalletHandleToken), tx, req.Confirmation)
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
+	// Sign the transaction, once it is recorded
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 	msig, err := wallet.MultisigSignTransaction(tx, req.PublicKey, req.PartialMsig, []byte(req.WalletPassword), req.AuthAddr)
 	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
 
 	// Build the response
 	resp := kmdapi.APIV1POSTMultisigTransactionSignResponse{
-		Multisig: protocol.Encode(&msig),
+		Multisig:     protocol.Encode(&msig),
+		DisplayHints: displayHints(wallet, tx),
 	}
 
 	// Return and encode the response
@@ -1185,9 +1371,23 @@ func postMultisigProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *ht
 		return
 	}
 
-	// Sign the transaction
+	// Check that the policies of the wallet handle allow signing programs
+	entry := audit.Entry{Event: audit.SignMultisigProgram, Address: req.Address, Digest: crypto.Hash(req.Program).String()}
+	err = ctx.sm.AuthorizeProgram([]byte(req.WalletHandleToken))
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
+	// Sign the transaction, once it is recorded
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
 	msig, err := wallet.MultisigSignProgram(req.Program, crypto.Digest(reqAddr), req.PublicKey, req.PartialMsig, []byte(req.WalletPassword))
 	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
 		errorResponse(w, http.StatusBadRequest, err)
 		return
 	}
@@ -1257,63 +1457,1337 @@ func deleteMultisigHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	successResponse(w, resp)
 }
 
-// wrapCtx is used to pass common context to each request without using any
-// global variables.
-func wrapCtx(ctx reqContext, handler func(reqContext, http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
-	return func(w http.ResponseWriter, r *http.Request) {
-		handler(ctx, w, r)
+// postMultisigSessionHandler handles `POST /v1/multisig/session`
+func postMultisigSessionHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/multisig/session CreateMultisigSession
+	//---
+	//    Summary: Start a multisig signing session
+	//    Description: >
+	//      Start collecting the signatures of a transaction by a multisig account.
+	//      The signatures can then be added over time from the wallets holding the
+	//      keys of the account, with any wallet handle token.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Create Multisig Session Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/CreateMultisigSessionRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/CreateMultisigSessionResponse"
+	var req kmdapi.APIV1POSTMultisigSessionRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Decode the transaction
+	var tx transactions.Transaction
+	err = protocol.Decode(req.Transaction, &tx)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+		return
 	}
+
+	// Look up the preimage of the sender in the wallet if none was passed
+	version, threshold, pks := req.Version, req.Threshold, req.PKs
+	if len(pks) == 0 {
+		version, threshold, pks, err = wallet.LookupMultisigPreimage(crypto.Digest(tx.Sender))
+		if err != nil {
+			errorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	session, err := ctx.sm.CreateMultisigSession(tx, version, threshold, pks)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTMultisigSessionResponse{
+		Session: session,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
 }
 
-// reqCallbackMiddlware calls the reqCB function once per request that passes
-// through. We use this in server.go to kick a watchdog timer, so that we can
-// kill kmd if we haven't received a request in a while.
-func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Call the callback
-			reqCB()
-			// Serve the request
-			next.ServeHTTP(w, r)
-		})
+// postMultisigSessionSignHandler handles `POST /v1/multisig/session/sign`
+func postMultisigSessionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/multisig/session/sign SignMultisigSession
+	//---
+	//    Summary: Sign the transaction of a multisig session
+	//    Description: >
+	//      Add the signature of a key of the wallet to a multisig signing session.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Sign Multisig Session Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SignMultisigSessionRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/SignMultisigSessionResponse"
+	var req kmdapi.APIV1POSTMultisigSessionSignRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
 	}
+
+	tx, partial, addr, err := ctx.sm.MultisigSessionPartial(req.SessionID)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Check the transaction against the policies of the wallet handle
+	entry := audit.Entry{Event: audit.SignMultisigSession, Address: encodeAddress(addr), Digest: tx.ID().String()}
+	err = ctx.sm.AuthorizeTransaction([]byte(req.WalletHandleToken), tx, req.Confirmation)
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
+	// Sign the transaction, once it is recorded
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
+	msig, err := wallet.MultisigSignTransaction(tx, req.PublicKey, partial, []byte(req.WalletPassword), addr)
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	session, err := ctx.sm.MergeMultisigSession(req.SessionID, msig)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTMultisigSessionSignResponse{
+		Session: session,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
 }
 
-// RegisterHandlers sets up the API handlers on the passed router
-func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, reqCB func()) {
-	// All /v1 requests require a valid auth token
-	router.Use(authMiddleware(log, apiToken))
+// postMultisigSessionInfoHandler handles `POST /v1/multisig/session/info`
+func postMultisigSessionInfoHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/multisig/session/info GetMultisigSession
+	//---
+	//    Summary: Get a multisig session
+	//    Description: >
+	//      Returns the keys which signed the transaction of a multisig session, the
+	//      ones which are still missing, and the transaction with the signatures
+	//      collected so far.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Multisig Session Info Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/MultisigSessionInfoRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/MultisigSessionInfoResponse"
+	var req kmdapi.APIV1POSTMultisigSessionInfoRequest
 
-	// reqCB gets called each time a request matches a route
-	router.Use(reqCallbackMiddleware(reqCB))
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
 
-	// ctx holds the global context passed to each of the handlers
-	ctx := reqContext{
-		sm: sm,
+	// Check the WalletHandleToken
+	_, _, err = ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
 	}
 
-	router.HandleFunc("/wallets", wrapCtx(ctx, getWalletsHandler)).Methods("GET")
-	router.HandleFunc("/wallet", wrapCtx(ctx, postWalletHandler)).Methods("POST")
-	router.HandleFunc("/wallet/init", wrapCtx(ctx, postWalletInitHandler)).Methods("POST")
-	router.HandleFunc("/wallet/release", wrapCtx(ctx, postWalletReleaseHandler)).Methods("POST")
-	router.HandleFunc("/wallet/renew", wrapCtx(ctx, postWalletRenewHandler)).Methods("POST")
-	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
-	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
-	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
+	session, err := ctx.sm.MultisigSession(req.SessionID)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
 
-	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
-	router.HandleFunc("/key/import", wrapCtx(ctx, postKeyImportHandler)).Methods("POST")
-	router.HandleFunc("/key/export", wrapCtx(ctx, postKeyExportHandler)).Methods("POST")
-	router.HandleFunc("/key", wrapCtx(ctx, postKeyHandler)).Methods("POST")
-	router.HandleFunc("/key", wrapCtx(ctx, deleteKeyHandler)).Methods("DELETE")
+	// Build the response
+	resp := kmdapi.APIV1POSTMultisigSessionInfoResponse{
+		Session: session,
+	}
 
-	router.HandleFunc("/multisig/list", wrapCtx(ctx, postMultisigListHandler)).Methods("POST")
-	router.HandleFunc("/multisig/sign", wrapCtx(ctx, postMultisigTransactionSignHandler)).Methods("POST")
-	router.HandleFunc("/multisig/signprogram", wrapCtx(ctx, postMultisigProgramSignHandler)).Methods("POST")
-	router.HandleFunc("/multisig/import", wrapCtx(ctx, postMultisigImportHandler)).Methods("POST")
-	router.HandleFunc("/multisig/export", wrapCtx(ctx, postMultisigExportHandler)).Methods("POST")
-	router.HandleFunc("/multisig", wrapCtx(ctx, deleteMultisigHandler)).Methods("DELETE")
+	// Return and encode the response
+	successResponse(w, resp)
+}
 
-	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
-	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
+// postMultisigSessionImportHandler handles `POST /v1/multisig/session/import`
+func postMultisigSessionImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/multisig/session/import ImportMultisigSession
+	//---
+	//    Summary: Import a partially signed multisig transaction
+	//    Description: >
+	//      Add the signatures of a partially signed multisig transaction, as
+	//      exported by a multisig session, to a session. A new session is
+	//      started when no session ID is passed.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Import Multisig Session Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ImportMultisigSessionRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ImportMultisigSessionResponse"
+	var req kmdapi.APIV1POSTMultisigSessionImportRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Check the WalletHandleToken
+	_, _, err = ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Decode the signed transaction
+	var stx transactions.SignedTxn
+	err = protocol.Decode(req.SignedTransaction, &stx)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+		return
+	}
+
+	session, err := ctx.sm.ImportMultisigSession(req.SessionID, stx)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTMultisigSessionImportResponse{
+		Session: session,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// deleteMultisigSessionHandler handles `DELETE /v1/multisig/session`
+func deleteMultisigSessionHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /v1/multisig/session DeleteMultisigSession
+	//---
+	//    Summary: Delete a multisig session
+	//    Description: Stop collecting the signatures of a multisig session.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Delete Multisig Session Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/DeleteMultisigSessionRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/DeleteMultisigSessionResponse"
+	var req kmdapi.APIV1DELETEMultisigSessionRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Check the WalletHandleToken
+	_, _, err = ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	err = ctx.sm.DeleteMultisigSession(req.SessionID)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1DELETEMultisigSessionResponse{}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postFrostImportHandler handles `POST /v1/frost/import`
+func postFrostImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/frost/import ImportFrostShare
+	//---
+	//    Summary: Import a FROST key share
+	//    Description: >
+	//      Import a FROST key share into the wallet: the share of an ed25519 key
+	//      split between several signers, a threshold of which sign for the
+	//      address of the key together. The share is checked against its
+	//      verifying share before it is stored.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Import FROST Share Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ImportFrostShareRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ImportFrostShareResponse"
+	var req kmdapi.APIV1POSTFrostImportRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	var share crypto.FrostKeyShare
+	if len(req.SecretShare) != len(share.SecretShare) || len(req.VerifyingShare) != len(share.VerifyingShare) || len(req.GroupKey) != len(share.GroupKey) {
+		errorResponse(w, http.StatusBadRequest, crypto.ErrFrostKeyShare)
+		return
+	}
+	share.Identifier = req.Identifier
+	share.Threshold = req.Threshold
+	copy(share.SecretShare[:], req.SecretShare)
+	copy(share.VerifyingShare[:], req.VerifyingShare)
+	copy(share.GroupKey[:], req.GroupKey)
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	signer, err := frostSigner(wallet)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Import the share
+	addr, err := signer.ImportFrostShare(share)
+	if err != nil {
+		ctx.recordAudit(r, wallet, audit.Entry{Event: audit.ImportFrostShare}, err)
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	err = ctx.recordAudit(r, wallet, audit.Entry{Event: audit.ImportFrostShare, Address: encodeAddress(addr)}, nil)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTFrostImportResponse{
+		Address: encodeAddress(addr),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postFrostListHandler handles `POST /v1/frost/list`
+func postFrostListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/frost/list ListFrostShares
+	//---
+	//    Summary: List FROST key shares
+	//    Description: Lists the addresses of the FROST key shares in the wallet.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: List FROST Shares Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ListFrostSharesRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ListFrostSharesResponse"
+	var req kmdapi.APIV1POSTFrostListRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	signer, err := frostSigner(wallet)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	addrs, err := signer.ListFrostShares()
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTFrostListResponse{
+		Addresses: encodeAddresses(addrs),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postFrostCommitHandler handles `POST /v1/frost/commit`
+func postFrostCommitHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/frost/commit CommitFrost
+	//---
+	//    Summary: Start a FROST signature
+	//    Description: >
+	//      Generates the nonces of a new signature by a FROST key share of the
+	//      wallet, and returns the commitment to them, to share with the other
+	//      signers. The nonces are kept by kmd until they sign a signature share
+	//      or expire, and are referred to by the returned ID.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Commit FROST Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/CommitFrostRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/CommitFrostResponse"
+	var req kmdapi.APIV1POSTFrostCommitRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Decode the address
+	reqAddr, err := basics.UnmarshalChecksumAddress(req.Address)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeAddress)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	signer, err := frostSigner(wallet)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	nonces, commitment, err := signer.FrostCommit(crypto.Digest(reqAddr))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := ctx.sm.AddFrostNonces(metadata.ID, crypto.Digest(reqAddr), nonces)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTFrostCommitResponse{
+		NoncesID: id,
+		Commitment: kmdapi.APIV1FrostCommitment{
+			Identifier: commitment.Identifier,
+			Hiding:     commitment.Hiding[:],
+			Binding:    commitment.Binding[:],
+		},
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postFrostSignHandler handles `POST /v1/frost/sign`
+func postFrostSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/frost/sign SignFrost
+	//---
+	//    Summary: Sign a FROST signature share
+	//    Description: >
+	//      Signs the share of a transaction signature by a FROST key share of the
+	//      wallet, with the nonces committed to by `POST /v1/frost/commit` and the
+	//      commitments of all the signers. The nonces are used once only.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Sign FROST Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SignFrostRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/SignFrostResponse"
+	var req kmdapi.APIV1POSTFrostSignRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Decode the address
+	reqAddr, err := basics.UnmarshalChecksumAddress(req.Address)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeAddress)
+		return
+	}
+
+	commitments, err := frostCommitmentsFromAPI(req.Commitments)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	signer, err := frostSigner(wallet)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Decode the transaction
+	var tx transactions.Transaction
+	err = protocol.Decode(req.Transaction, &tx)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+		return
+	}
+
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	nonces, err := ctx.sm.TakeFrostNonces(req.NoncesID, metadata.ID, crypto.Digest(reqAddr))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Check the transaction against the policies of the wallet handle
+	entry := audit.Entry{Event: audit.SignFrostShare, Address: req.Address, Digest: tx.ID().String()}
+	err = ctx.sm.AuthorizeTransaction([]byte(req.WalletHandleToken), tx, req.Confirmation)
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
+	// Sign the share, once it is recorded
+	if ctx.recordAudit(r, wallet, entry, nil) != nil {
+		errorResponse(w, http.StatusInternalServerError, errAuditLogFailed)
+		return
+	}
+	share, err := signer.FrostSign(crypto.Digest(reqAddr), nonces, commitments, crypto.HashRep(tx), []byte(req.WalletPassword))
+	if err != nil {
+		ctx.recordAudit(r, wallet, entry, err)
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTFrostSignResponse{
+		SignatureShare: kmdapi.APIV1FrostSignatureShare{
+			Identifier: share.Identifier,
+			Share:      share.Share[:],
+		},
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postFrostAggregateHandler handles `POST /v1/frost/aggregate`
+func postFrostAggregateHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/frost/aggregate AggregateFrost
+	//---
+	//    Summary: Aggregate FROST signature shares
+	//    Description: >
+	//      Aggregates the signature shares of a transaction by the signers of a
+	//      FROST group key into an ed25519 signature, and returns the signed
+	//      transaction. The signature is checked before it is returned. The
+	//      address of the group key is set as the AuthAddr of the transaction
+	//      if it is not its sender.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Aggregate FROST Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/AggregateFrostRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/AggregateFrostResponse"
+	var req kmdapi.APIV1POSTFrostAggregateRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Decode the address
+	reqAddr, err := basics.UnmarshalChecksumAddress(req.Address)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeAddress)
+		return
+	}
+
+	commitments, err := frostCommitmentsFromAPI(req.Commitments)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	shares := make([]crypto.FrostSignatureShare, len(req.SignatureShares))
+	for i, s := range req.SignatureShares {
+		if len(s.Share) != len(shares[i].Share) {
+			errorResponse(w, http.StatusBadRequest, errCouldNotDecodeFrost)
+			return
+		}
+		shares[i].Identifier = s.Identifier
+		copy(shares[i].Share[:], s.Share)
+	}
+
+	// Check the WalletHandleToken
+	_, _, err = ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Decode the transaction
+	var tx transactions.Transaction
+	err = protocol.Decode(req.Transaction, &tx)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+		return
+	}
+
+	sig, err := crypto.FrostAggregate(crypto.PublicKey(reqAddr), commitments, shares, crypto.HashRep(tx))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	stx := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+	// Set the AuthAddr if the group key doesn't match the txn sender
+	if tx.Sender != reqAddr {
+		stx.AuthAddr = reqAddr
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTFrostAggregateResponse{
+		SignedTransaction: protocol.Encode(&stx),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postAuditVerifyHandler handles `POST /v1/audit/verify`
+func postAuditVerifyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/audit/verify VerifyAuditLog
+	//---
+	//    Summary: Verify the audit log
+	//    Description: >
+	//      Checks that the chain of hashes of the audit log is intact, and
+	//      returns the first entry breaking it if it is not.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Verify Audit Log Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/VerifyAuditLogRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/VerifyAuditLogResponse"
+	var req kmdapi.APIV1POSTAuditVerifyRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	if ctx.audit == nil {
+		errorResponse(w, http.StatusBadRequest, errAuditLogDisabled)
+		return
+	}
+
+	res, err := ctx.audit.Verify()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTAuditVerifyResponse{
+		Valid:        res.Valid,
+		Entries:      res.Entries,
+		FirstInvalid: res.FirstInvalid,
+		HeadSeq:      res.HeadSeq,
+		LastHash:     res.LastHash,
+		Detail:       res.Message,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postAuditExportHandler handles `POST /v1/audit/export`
+func postAuditExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/audit/export ExportAuditLog
+	//---
+	//    Summary: Export audit log entries
+	//    Description: >
+	//      Returns the entries of the audit log in a range of sequence numbers,
+	//      so that they can be archived and verified elsewhere.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Export Audit Log Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ExportAuditLogRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ExportAuditLogResponse"
+	var req kmdapi.APIV1POSTAuditExportRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	if ctx.audit == nil {
+		errorResponse(w, http.StatusBadRequest, errAuditLogDisabled)
+		return
+	}
+
+	entries, err := ctx.audit.Export(req.From, req.To)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTAuditExportResponse{
+		Entries: make([]kmdapi.APIV1AuditEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, kmdapi.APIV1AuditEntry{
+			Seq:       e.Seq,
+			Time:      e.Time,
+			Event:     string(e.Event),
+			WalletID:  e.WalletID,
+			Requester: e.Requester,
+			Address:   e.Address,
+			Digest:    e.Digest,
+			Error:     e.Error,
+			PrevHash:  e.PrevHash,
+			Hash:      e.Hash,
+		})
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postRelayPairingHandler handles `POST /v1/relay/pairing`
+func postRelayPairingHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/relay/pairing CreateRelayPairing
+	//---
+	//    Summary: Create a signing relay pairing
+	//    Description: >
+	//      Create a pairing of the wallet with an approving client, such as a
+	//      mobile wallet, to which the requests to sign transactions with the
+	//      wallet are pushed for approval. The client pairs by scanning the
+	//      returned pairing URI, shown as a QR code. kmd keeps the wallet
+	//      password in memory to sign the approved transactions, and the
+	//      policies of the wallet handle restrict them, until the pairing
+	//      expires or is deleted. Only available when the signing relay is
+	//      enabled in the kmd configuration.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Create Relay Pairing Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/CreateRelayPairingRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/CreateRelayPairingResponse"
+	var req kmdapi.APIV1POSTRelayPairingRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	pairing, uri, err := ctx.sm.CreateRelayPairing([]byte(req.WalletHandleToken), []byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTRelayPairingResponse{
+		Pairing:    pairing,
+		PairingURI: uri,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postRelayPairingInfoHandler handles `POST /v1/relay/pairing/info`
+func postRelayPairingInfoHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/relay/pairing/info GetRelayPairing
+	//---
+	//    Summary: Get a signing relay pairing
+	//    Description: >
+	//      Returns whether the approving client completed the pairing, and how
+	//      many requests wait for its approval.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Relay Pairing Info Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RelayPairingInfoRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RelayPairingInfoResponse"
+	var req kmdapi.APIV1POSTRelayPairingInfoRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	pairing, err := ctx.sm.RelayPairing([]byte(req.WalletHandleToken), req.PairingID)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTRelayPairingInfoResponse{
+		Pairing: pairing,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// deleteRelayPairingHandler handles `DELETE /v1/relay/pairing`
+func deleteRelayPairingHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /v1/relay/pairing DeleteRelayPairing
+	//---
+	//    Summary: Delete a signing relay pairing
+	//    Description: >
+	//      Unpair the approving client, and forget the wallet password kept by
+	//      the pairing. The requests waiting for the approval of the client
+	//      fail.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Delete Relay Pairing Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/DeleteRelayPairingRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/DeleteRelayPairingResponse"
+	var req kmdapi.APIV1DELETERelayPairingRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	err = ctx.sm.DeleteRelayPairing([]byte(req.WalletHandleToken), req.PairingID)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1DELETERelayPairingResponse{}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postRelaySignHandler handles `POST /v1/relay/sign`
+func postRelaySignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/relay/sign SubmitRelaySignRequest
+	//---
+	//    Summary: Request the signature of a transaction through the signing relay
+	//    Description: >
+	//      Push a request to sign the transaction with the wallet of a pairing
+	//      to its approving client. The pairing must belong to the wallet of
+	//      the wallet handle token. The outcome is returned by
+	//      `POST /v1/relay/sign/info` once the client answers.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Relay Sign Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RelaySignRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RelaySignResponse"
+	var req kmdapi.APIV1POSTRelaySignRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Decode the transaction
+	var tx transactions.Transaction
+	err = protocol.Decode(req.Transaction, &tx)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+		return
+	}
+
+	request, err := ctx.sm.SubmitRelayRequest([]byte(req.WalletHandleToken), req.PairingID, tx, req.Message)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTRelaySignResponse{
+		Request: request,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postRelaySignInfoHandler handles `POST /v1/relay/sign/info`
+func postRelaySignInfoHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/relay/sign/info GetRelaySignRequest
+	//---
+	//    Summary: Get a signing request of the signing relay
+	//    Description: >
+	//      Returns a signing request of the wallet of the wallet handle token,
+	//      with the signed transaction once the client approved it. The response waits up to `wait_seconds` for
+	//      the client to answer a pending request.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Relay Sign Info Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RelaySignInfoRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RelaySignInfoResponse"
+	var req kmdapi.APIV1POSTRelaySignInfoRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	request, err := ctx.sm.RelayRequest([]byte(req.WalletHandleToken), req.RequestID, req.WaitSeconds)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTRelaySignInfoResponse{
+		Request: request,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postRelayClientPairHandler handles `POST /v1/relay/client/pair`
+func postRelayClientPairHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/relay/client/pair PairRelayClient
+	//---
+	//    Summary: Pair an approving client with a signing relay pairing
+	//    Description: >
+	//      Complete a pairing with the session key of the approving client,
+	//      proving that the client scanned the pairing URI. A pairing is only
+	//      paired once. The approving client endpoints are not authenticated
+	//      with the kmd API token, but with the session key.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Relay Client Pair Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RelayClientPairRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RelayClientPairResponse"
+	var req kmdapi.APIV1POSTRelayClientPairRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	pairing, err := ctx.sm.PairRelayClient(req.PairingID, req.PublicKey, req.Proof)
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTRelayClientPairResponse{
+		Pairing: pairing,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postRelayClientPollHandler handles `POST /v1/relay/client/poll`
+func postRelayClientPollHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/relay/client/poll PollRelayClient
+	//---
+	//    Summary: Poll the signing requests of a signing relay pairing
+	//    Description: >
+	//      Returns the requests waiting for the approval of the client, oldest
+	//      first. The response waits up to `wait_seconds` for a request when
+	//      there are none, so that the requests are pushed to the client as
+	//      soon as they are submitted.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Relay Client Poll Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RelayClientPollRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RelayClientPollResponse"
+	var req kmdapi.APIV1POSTRelayClientPollRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	requests, err := ctx.sm.PollRelayRequests(req.PairingID, req.Timestamp, req.Signature, req.WaitSeconds)
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTRelayClientPollResponse{
+		Requests: requests,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postRelayClientRespondHandler handles `POST /v1/relay/client/respond`
+func postRelayClientRespondHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/relay/client/respond AnswerRelayClient
+	//---
+	//    Summary: Answer a signing request of a signing relay pairing
+	//    Description: >
+	//      Approve or reject a signing request. An approved transaction is
+	//      signed with the wallet of the pairing, unless the policies of the
+	//      wallet handle the pairing was created with refuse it.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Relay Client Respond Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RelayClientRespondRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RelayClientRespondResponse"
+	var req kmdapi.APIV1POSTRelayClientRespondRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// The approved transaction is recorded before it is signed, and not signed
+	// when it cannot be recorded
+	record := func(wlt wallet.Wallet, tx transactions.Transaction) error {
+		if ctx.recordAudit(r, wlt, audit.Entry{Event: audit.SignRelayRequest, Address: tx.Sender.String(), Digest: tx.ID().String()}, nil) != nil {
+			return errAuditLogFailed
+		}
+		return nil
+	}
+	request, wlt, err := ctx.sm.AnswerRelayRequest(req.PairingID, req.RequestID, req.Approve, req.Signature, req.Confirmation, record)
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Record the failure of the approved transaction, refused by the policies
+	// of the pairing or by the wallet
+	if wlt != nil && request.Error != "" {
+		var tx transactions.Transaction
+		err = protocol.Decode(request.Transaction, &tx)
+		if err == nil {
+			entry := audit.Entry{Event: audit.SignRelayRequest, Address: tx.Sender.String(), Digest: request.TxID}
+			ctx.recordAudit(r, wlt, entry, errors.New(request.Error))
+		}
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTRelayClientRespondResponse{
+		Request: request,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// wrapCtx is used to pass common context to each request without using any
+// global variables.
+func wrapCtx(ctx reqContext, handler func(reqContext, http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handler(ctx, w, r)
+	}
+}
+
+// reqCallbackMiddlware calls the reqCB function once per request that passes
+// through. We use this in server.go to kick a watchdog timer, so that we can
+// kill kmd if we haven't received a request in a while.
+func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Call the callback
+			reqCB()
+			// Serve the request
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RegisterHandlers sets up the API handlers on the passed router
+func RegisterHandlers(router *mux.Router, sm *session.Manager, auditLog *audit.Log, log logging.Logger, apiToken string, reqCB func()) {
+	// All /v1 requests require a valid auth token
+	router.Use(authMiddleware(log, apiToken))
+
+	// reqCB gets called each time a request matches a route
+	router.Use(reqCallbackMiddleware(reqCB))
+
+	// ctx holds the global context passed to each of the handlers
+	ctx := reqContext{
+		sm:    sm,
+		audit: auditLog,
+		log:   log,
+	}
+
+	router.HandleFunc("/wallets", wrapCtx(ctx, getWalletsHandler)).Methods("GET")
+	router.HandleFunc("/wallet", wrapCtx(ctx, postWalletHandler)).Methods("POST")
+	router.HandleFunc("/wallet/init", wrapCtx(ctx, postWalletInitHandler)).Methods("POST")
+	router.HandleFunc("/wallet/release", wrapCtx(ctx, postWalletReleaseHandler)).Methods("POST")
+	router.HandleFunc("/wallet/renew", wrapCtx(ctx, postWalletRenewHandler)).Methods("POST")
+	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
+	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
+	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
+
+	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
+	router.HandleFunc("/key/import", wrapCtx(ctx, postKeyImportHandler)).Methods("POST")
+	router.HandleFunc("/key/export", wrapCtx(ctx, postKeyExportHandler)).Methods("POST")
+	router.HandleFunc("/key", wrapCtx(ctx, postKeyHandler)).Methods("POST")
+	router.HandleFunc("/key", wrapCtx(ctx, deleteKeyHandler)).Methods("DELETE")
+
+	router.HandleFunc("/multisig/list", wrapCtx(ctx, postMultisigListHandler)).Methods("POST")
+	router.HandleFunc("/multisig/sign", wrapCtx(ctx, postMultisigTransactionSignHandler)).Methods("POST")
+	router.HandleFunc("/multisig/signprogram", wrapCtx(ctx, postMultisigProgramSignHandler)).Methods("POST")
+	router.HandleFunc("/multisig/import", wrapCtx(ctx, postMultisigImportHandler)).Methods("POST")
+	router.HandleFunc("/multisig/export", wrapCtx(ctx, postMultisigExportHandler)).Methods("POST")
+	router.HandleFunc("/multisig", wrapCtx(ctx, deleteMultisigHandler)).Methods("DELETE")
+	router.HandleFunc("/multisig/session", wrapCtx(ctx, postMultisigSessionHandler)).Methods("POST")
+	router.HandleFunc("/multisig/session", wrapCtx(ctx, deleteMultisigSessionHandler)).Methods("DELETE")
+	router.HandleFunc("/multisig/session/sign", wrapCtx(ctx, postMultisigSessionSignHandler)).Methods("POST")
+	router.HandleFunc("/multisig/session/info", wrapCtx(ctx, postMultisigSessionInfoHandler)).Methods("POST")
+	router.HandleFunc("/multisig/session/import", wrapCtx(ctx, postMultisigSessionImportHandler)).Methods("POST")
+
+	router.HandleFunc("/frost/import", wrapCtx(ctx, postFrostImportHandler)).Methods("POST")
+	router.HandleFunc("/frost/list", wrapCtx(ctx, postFrostListHandler)).Methods("POST")
+	router.HandleFunc("/frost/commit", wrapCtx(ctx, postFrostCommitHandler)).Methods("POST")
+	router.HandleFunc("/frost/sign", wrapCtx(ctx, postFrostSignHandler)).Methods("POST")
+	router.HandleFunc("/frost/aggregate", wrapCtx(ctx, postFrostAggregateHandler)).Methods("POST")
+
+	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
+	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
+
+	router.HandleFunc("/audit/verify", wrapCtx(ctx, postAuditVerifyHandler)).Methods("POST")
+	router.HandleFunc("/audit/export", wrapCtx(ctx, postAuditExportHandler)).Methods("POST")
+
+	router.HandleFunc("/relay/pairing", wrapCtx(ctx, postRelayPairingHandler)).Methods("POST")
+	router.HandleFunc("/relay/pairing", wrapCtx(ctx, deleteRelayPairingHandler)).Methods("DELETE")
+	router.HandleFunc("/relay/pairing/info", wrapCtx(ctx, postRelayPairingInfoHandler)).Methods("POST")
+	router.HandleFunc("/relay/sign", wrapCtx(ctx, postRelaySignHandler)).Methods("POST")
+	router.HandleFunc("/relay/sign/info", wrapCtx(ctx, postRelaySignInfoHandler)).Methods("POST")
+}
+
+// RegisterRelayClientHandlers sets up the handlers of the approving clients
+// of the signing relay on the passed router. The clients do not hold the API
+// token: they authenticate their requests with their session keys instead.
+// Their requests do not count as activity keeping kmd alive, since anyone
+// reaching kmd can make them.
+func RegisterRelayClientHandlers(router *mux.Router, sm *session.Manager, auditLog *audit.Log, log logging.Logger) {
+	// ctx holds the global context passed to each of the handlers
+	ctx := reqContext{
+		sm:    sm,
+		audit: auditLog,
+		log:   log,
+	}
+
+	router.HandleFunc("/pair", wrapCtx(ctx, postRelayClientPairHandler)).Methods("POST")
+	router.HandleFunc("/poll", wrapCtx(ctx, postRelayClientPollHandler)).Methods("POST")
+	router.HandleFunc("/respond", wrapCtx(ctx, postRelayClientRespondHandler)).Methods("POST")
 }
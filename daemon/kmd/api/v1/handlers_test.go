@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v1
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/session"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testWallet is a wallet counting the keys it exports and the transactions
+// it signs, whose password is always right
+type testWallet struct {
+	wallet.Wallet
+	signed   int
+	exported int
+}
+
+func (w *testWallet) Init(pw []byte) error {
+	return nil
+}
+
+func (w *testWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{ID: []byte("wallet-id")}, nil
+}
+
+func (w *testWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	w.exported++
+	return crypto.MasterDerivationKey{}, nil
+}
+
+func (w *testWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	w.exported++
+	return crypto.PrivateKey{}, nil
+}
+
+func (w *testWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	w.signed++
+	return []byte(tx.ID().String()), nil
+}
+
+// makeTestContext returns the context of the handlers, with a handle of the
+// wallet restricted by policy
+func makeTestContext(t *testing.T, cfg config.KMDConfig, w *testWallet, policy kmdapi.APIV1WalletPolicy) (reqContext, string) {
+	cfg.SessionLifetimeSecs = 60
+	sm, err := session.MakeManager(cfg)
+	require.NoError(t, err)
+	t.Cleanup(sm.Kill)
+	handle, err := sm.InitWalletHandle(w, nil, policy)
+	require.NoError(t, err)
+	return reqContext{sm: sm, log: logging.TestingLog(t)}, string(handle)
+}
+
+// callHandler calls the handler with the JSON encoding of req, and returns
+// the status code of the response
+func callHandler(ctx reqContext, handler func(reqContext, http.ResponseWriter, *http.Request), req interface{}) int {
+	rec := httptest.NewRecorder()
+	handler(ctx, rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(protocol.EncodeJSON(req))))
+	return rec.Code
+}
+
+func TestAuditLogFailureRefusesOperations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := &testWallet{}
+	ctx, handle := makeTestContext(t, config.KMDConfig{}, w, kmdapi.APIV1WalletPolicy{})
+	auditLog, err := audit.Open(t.TempDir(), "")
+	require.NoError(t, err)
+	ctx.audit = auditLog
+
+	tx := transactions.Transaction{Type: protocol.PaymentTx}
+	sign := kmdapi.APIV1POSTTransactionSignRequest{WalletHandleToken: handle, Transaction: protocol.Encode(&tx)}
+	exportKey := kmdapi.APIV1POSTKeyExportRequest{WalletHandleToken: handle, Address: basics.Address{1}.String()}
+	exportMasterKey := kmdapi.APIV1POSTMasterKeyExportRequest{WalletHandleToken: handle}
+
+	require.Equal(t, http.StatusOK, callHandler(ctx, postTransactionSignHandler, sign))
+	require.Equal(t, http.StatusOK, callHandler(ctx, postKeyExportHandler, exportKey))
+	require.Equal(t, 1, w.signed)
+	require.Equal(t, 1, w.exported)
+	seq, _ := auditLog.Head()
+	require.Equal(t, uint64(2), seq)
+
+	// nothing is signed or exported once the audit log cannot be written
+	require.NoError(t, auditLog.Close())
+	require.Equal(t, http.StatusInternalServerError, callHandler(ctx, postTransactionSignHandler, sign))
+	require.Equal(t, http.StatusInternalServerError, callHandler(ctx, postKeyExportHandler, exportKey))
+	require.Equal(t, http.StatusInternalServerError, callHandler(ctx, postMasterKeyExportHandler, exportMasterKey))
+	require.Equal(t, 1, w.signed)
+	require.Equal(t, 1, w.exported)
+}
+
+func TestExportRequiresAuthorization(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// nothing is exported with a handle restricted by a policy
+	w := &testWallet{}
+	restrictive := kmdapi.APIV1WalletPolicy{AllowedTransactions: []protocol.TxType{protocol.PaymentTx}}
+	ctx, handle := makeTestContext(t, config.KMDConfig{}, w, restrictive)
+	exportKey := kmdapi.APIV1POSTKeyExportRequest{WalletHandleToken: handle, Address: basics.Address{1}.String()}
+	exportMasterKey := kmdapi.APIV1POSTMasterKeyExportRequest{WalletHandleToken: handle}
+	require.Equal(t, http.StatusForbidden, callHandler(ctx, postKeyExportHandler, exportKey))
+	require.Equal(t, http.StatusForbidden, callHandler(ctx, postMasterKeyExportHandler, exportMasterKey))
+	require.Equal(t, 0, w.exported)
+
+	// nor without a valid confirmation code once kmd has a second factor
+	cfg := config.KMDConfig{ConfirmationTOTPSecret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"}
+	ctx, handle = makeTestContext(t, cfg, w, kmdapi.APIV1WalletPolicy{})
+	exportKey = kmdapi.APIV1POSTKeyExportRequest{WalletHandleToken: handle, Address: basics.Address{1}.String()}
+	exportMasterKey = kmdapi.APIV1POSTMasterKeyExportRequest{WalletHandleToken: handle, Confirmation: "123456x"}
+	require.Equal(t, http.StatusForbidden, callHandler(ctx, postKeyExportHandler, exportKey))
+	require.Equal(t, http.StatusForbidden, callHandler(ctx, postMasterKeyExportHandler, exportMasterKey))
+	require.Equal(t, 0, w.exported)
+
+	// while signing with an unrestricted handle needs no code
+	tx := transactions.Transaction{Type: protocol.PaymentTx}
+	sign := kmdapi.APIV1POSTTransactionSignRequest{WalletHandleToken: handle, Transaction: protocol.Encode(&tx)}
+	require.Equal(t, http.StatusOK, callHandler(ctx, postTransactionSignHandler, sign))
+}
@@ -0,0 +1,500 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package audit keeps a tamper-evident record of the key and signing
+// operations served by kmd. Every entry is a line of JSON carrying the hash
+// of the entry before it, so that editing, removing or reordering entries
+// breaks the chain. The hashes are HMACs under a key kept outside of the log,
+// so that the chain cannot be rewritten without the key, and the head of the
+// chain is checkpointed in a file of its own, so that removing entries from
+// the end of the log is detected too.
+package audit
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// LogFilename is the name of the audit log in the kmd data directory
+const LogFilename = "kmd-audit.log"
+
+// HeadFilename is the name of the checkpoint of the head of the audit log in
+// the kmd data directory
+const HeadFilename = "kmd-audit.head"
+
+// KeyFilename is the default name of the file holding the HMAC key of the
+// audit log, in the kmd data directory
+const KeyFilename = "kmd-audit.key"
+
+// keyLen is the length of the HMAC key
+const keyLen = 32
+
+// MaxExportEntries bounds the number of entries returned by a single Export
+const MaxExportEntries = 10000
+
+// maxEntrySize bounds the length of a line of the log
+const maxEntrySize = 64 * 1024
+
+// Event names the operation an entry records
+type Event string
+
+const (
+	// GenerateKey is the generation of a key in a wallet
+	GenerateKey Event = "generate_key"
+	// ImportKey is the import of a key into a wallet
+	ImportKey Event = "import_key"
+	// ExportKey is the export of the secret key of an account
+	ExportKey Event = "export_key"
+	// DeleteKey is the removal of a key from a wallet
+	DeleteKey Event = "delete_key"
+	// ExportMasterKey is the export of the master derivation key of a wallet
+	ExportMasterKey Event = "export_master_key"
+	// SignTransaction is the signing of a transaction
+	SignTransaction Event = "sign_transaction"
+	// SignProgram is the signing of a program for delegation
+	SignProgram Event = "sign_program"
+	// SignMultisigTransaction is the signing of a multisig transaction
+	SignMultisigTransaction Event = "sign_multisig_transaction"
+	// SignMultisigProgram is the signing of a program by a multisig account
+	SignMultisigProgram Event = "sign_multisig_program"
+	// SignMultisigSession is the signing of a multisig session transaction
+	SignMultisigSession Event = "sign_multisig_session"
+	// ImportFrostShare is the import of a FROST key share into a wallet
+	ImportFrostShare Event = "import_frost_share"
+	// SignFrostShare is the signing of a FROST signature share
+	SignFrostShare Event = "sign_frost_share"
+	// SignRelayRequest is the signing of a transaction approved by the
+	// client of a signing relay pairing
+	SignRelayRequest Event = "sign_relay_request"
+)
+
+// Entry is a line of the audit log. Digest is the transaction ID of signed
+// transactions or the hash of signed programs, and Error is set when the
+// operation failed.
+type Entry struct {
+	Seq       uint64 `json:"seq"`
+	Time      string `json:"time"`
+	Event     Event  `json:"event"`
+	WalletID  string `json:"wallet_id,omitempty"`
+	Requester string `json:"requester,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Digest    string `json:"digest,omitempty"`
+	Error     string `json:"error,omitempty"`
+	PrevHash  string `json:"prev_hash"`
+	Hash      string `json:"hash"`
+}
+
+// ErrTruncated is returned by Open when the log ends before the entry of its
+// checkpointed head, i.e. entries were removed from its end
+var ErrTruncated = errors.New("the audit log ends before its checkpointed head")
+
+// errKeyMissing is returned by Open when the log has entries but its key is gone
+var errKeyMissing = errors.New("the audit log has entries, but its HMAC key is missing")
+
+// computeHash computes the HMAC of the entry with its Hash field cleared
+func (e Entry) computeHash(key []byte) (crypto.Digest, error) {
+	e.Hash = ""
+	data, err := json.Marshal(e)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	return macDigest(key, data), nil
+}
+
+// head is the checkpoint of the last entry of the log. MAC authenticates it,
+// so that it cannot be moved back to an earlier entry without the key.
+type head struct {
+	Seq  uint64 `json:"seq"`
+	Hash string `json:"hash"`
+	MAC  string `json:"mac"`
+}
+
+func (h head) computeMAC(key []byte) string {
+	return macDigest(key, []byte(fmt.Sprintf("head:%d:%s", h.Seq, h.Hash))).String()
+}
+
+func macDigest(key []byte, data []byte) (d crypto.Digest) {
+	mac := hmac.New(sha512.New512_256, key)
+	mac.Write(data)
+	copy(d[:], mac.Sum(nil))
+	return
+}
+
+// VerifyResult is the outcome of checking the chain of the log
+type VerifyResult struct {
+	Valid bool
+	// Entries is the number of entries read
+	Entries uint64
+	// FirstInvalid is the sequence number of the first entry failing the
+	// check, when Valid is false
+	FirstInvalid uint64
+	// LastHash is the hash of the last valid entry
+	LastHash string
+	// HeadSeq is the sequence number of the checkpointed head of the log
+	HeadSeq uint64
+	Message string
+}
+
+// Log is an append-only, hash-chained log. A nil *Log records nothing.
+type Log struct {
+	mu       deadlock.Mutex
+	path     string
+	headPath string
+	key      []byte
+	file     *os.File
+	seq      uint64
+	lastHash crypto.Digest
+}
+
+// Open opens the audit log in dataDir, creating it if needed, and resumes the
+// chain from its last entry. keyFile holds the HMAC key of the log, and is
+// KeyFilename in dataDir if empty; it is generated with the log. Open fails
+// if the log was truncated before its checkpointed head.
+func Open(dataDir string, keyFile string) (*Log, error) {
+	path := filepath.Join(dataDir, LogFilename)
+	l := &Log{
+		path:     path,
+		headPath: filepath.Join(dataDir, HeadFilename),
+	}
+
+	err := l.scan(func(e Entry) error {
+		hash, err := crypto.DigestFromString(e.Hash)
+		if err != nil {
+			return fmt.Errorf("audit log entry %d: %w", e.Seq, err)
+		}
+		l.seq = e.Seq
+		l.lastHash = hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if keyFile == "" {
+		keyFile = filepath.Join(dataDir, KeyFilename)
+	}
+	l.key, err = loadOrGenerateKey(keyFile, l.seq == 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refuse to extend a log which lost its last entries, since the next
+	// checkpoint would hide it
+	h, found, err := l.readHead()
+	if err != nil {
+		return nil, err
+	}
+	if found && (h.Seq > l.seq || (h.Seq == l.seq && h.Hash != l.lastHash.String())) {
+		return nil, fmt.Errorf("%w: entry %d is checkpointed, the log ends at entry %d", ErrTruncated, h.Seq, l.seq)
+	}
+
+	l.file, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// loadOrGenerateKey reads the hex encoded HMAC key in path, or generates it if
+// the file does not exist and generate is set
+func loadOrGenerateKey(path string, generate bool) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil || len(key) != keyLen {
+			return nil, fmt.Errorf("audit log key %s must hold %d hex encoded bytes", path, keyLen)
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	if !generate {
+		return nil, fmt.Errorf("%w: %s", errKeyMissing, path)
+	}
+
+	key := make([]byte, keyLen)
+	_, err = rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+	err = os.WriteFile(path, []byte(hex.EncodeToString(key)), 0400)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Head returns the sequence number and the hash of the last entry of the log.
+// Since the hash depends on every entry before it, publishing it somewhere
+// else anchors the whole log.
+func (l *Log) Head() (seq uint64, hash string) {
+	if l == nil {
+		return 0, ""
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.seq, l.lastHash.String()
+}
+
+// readHead reads the checkpointed head of the log, if there is one, and
+// checks its MAC
+func (l *Log) readHead() (h head, found bool, err error) {
+	data, err := os.ReadFile(l.headPath)
+	if os.IsNotExist(err) {
+		return head{}, false, nil
+	}
+	if err != nil {
+		return head{}, false, err
+	}
+	err = json.Unmarshal(data, &h)
+	if err != nil {
+		return head{}, false, fmt.Errorf("audit log head: %w", err)
+	}
+	if !hmac.Equal([]byte(h.MAC), []byte(h.computeMAC(l.key))) {
+		return head{}, false, fmt.Errorf("audit log head: MAC does not match entry %d", h.Seq)
+	}
+	return h, true, nil
+}
+
+// writeHead checkpoints the last entry of the log. The file is replaced
+// atomically, so that a crash leaves either checkpoint.
+func (l *Log) writeHead() error {
+	h := head{Seq: l.seq, Hash: l.lastHash.String()}
+	h.MAC = h.computeMAC(l.key)
+	data, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+
+	tmp := l.headPath + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, l.headPath)
+}
+
+// Close closes the file of the log
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// Record appends e to the log, filling in its sequence number, time and
+// hashes. The entry and the new head are synced to disk before Record returns.
+func (l *Log) Record(e Entry) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e.Seq = l.seq + 1
+	e.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	e.PrevHash = l.lastHash.String()
+	hash, err := e.computeHash(l.key)
+	if err != nil {
+		return err
+	}
+	e.Hash = hash.String()
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = l.file.Write(append(data, '\n'))
+	if err != nil {
+		return err
+	}
+	err = l.file.Sync()
+	if err != nil {
+		return err
+	}
+
+	l.seq = e.Seq
+	l.lastHash = hash
+	return l.writeHead()
+}
+
+// Verify reads the whole log and checks that every entry follows the one
+// before it and hashes to its recorded hash, and that the log reaches its
+// checkpointed head
+func (l *Log) Verify() (res VerifyResult, err error) {
+	if l == nil {
+		return VerifyResult{Valid: true}, nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	h, found, err := l.readHead()
+	if err != nil {
+		return VerifyResult{Valid: false, Message: err.Error()}, nil
+	}
+	if found {
+		res.HeadSeq = h.Seq
+	}
+
+	var prev crypto.Digest
+	res.Valid = true
+	res.LastHash = prev.String()
+	err = l.scan(func(e Entry) error {
+		if !res.Valid {
+			return nil
+		}
+		res.Entries++
+		fail := func(format string, args ...interface{}) {
+			res.Valid = false
+			res.FirstInvalid = res.Entries
+			res.Message = fmt.Sprintf("entry %d: ", res.Entries) + fmt.Sprintf(format, args...)
+		}
+		if e.Seq != res.Entries {
+			fail("unexpected sequence number %d", e.Seq)
+			return nil
+		}
+		if e.PrevHash != prev.String() {
+			fail("previous hash %s does not match %s", e.PrevHash, prev.String())
+			return nil
+		}
+		hash, err := e.computeHash(l.key)
+		if err != nil {
+			return err
+		}
+		if e.Hash != hash.String() {
+			fail("hash %s does not match the contents of the entry", e.Hash)
+			return nil
+		}
+		prev = hash
+		res.LastHash = hash.String()
+		if h.Seq == e.Seq && h.Hash != e.Hash {
+			fail("hash %s does not match the checkpointed head %s", e.Hash, h.Hash)
+		}
+		return nil
+	})
+	var malformed malformedError
+	if errors.As(err, &malformed) {
+		if res.Valid {
+			res.Valid = false
+			res.FirstInvalid = uint64(malformed.line)
+			res.Message = malformed.Error()
+		}
+		err = nil
+	}
+	if err != nil {
+		return
+	}
+
+	if res.Valid && res.Entries < h.Seq {
+		res.Valid = false
+		res.FirstInvalid = res.Entries + 1
+		res.Message = fmt.Sprintf("the log ends at entry %d, before the checkpointed head at entry %d", res.Entries, h.Seq)
+	}
+	return
+}
+
+// Export returns the entries with sequence numbers from from to to, both
+// included. A zero to reads up to the end of the log.
+func (l *Log) Export(from, to uint64) (entries []Entry, err error) {
+	if l == nil {
+		return nil, nil
+	}
+	if to != 0 && to < from {
+		return nil, fmt.Errorf("invalid audit log range %d-%d", from, to)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err = l.scan(func(e Entry) error {
+		if e.Seq < from || (to != 0 && e.Seq > to) {
+			return nil
+		}
+		if len(entries) >= MaxExportEntries {
+			return fmt.Errorf("audit log range exceeds %d entries", MaxExportEntries)
+		}
+		entries = append(entries, e)
+		return nil
+	})
+	return
+}
+
+// malformedError is returned by scan for lines that are not entries
+type malformedError struct {
+	line int
+	err  error
+}
+
+func (e malformedError) Error() string {
+	return fmt.Sprintf("audit log line %d: %v", e.line, e.err)
+}
+
+// scan calls fn with each entry of the log file, if there is one
+func (l *Log) scan(fn func(Entry) error) error {
+	f, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 4096), maxEntrySize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		var e Entry
+		err = json.Unmarshal(scanner.Bytes(), &e)
+		if err != nil {
+			return malformedError{line: line, err: err}
+		}
+		err = fn(e)
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
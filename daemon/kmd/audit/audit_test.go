@@ -0,0 +1,206 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// recordEntries opens the log in dir, and records n signing entries
+func recordEntries(t *testing.T, dir string, keyFile string, n int) *Log {
+	l, err := Open(dir, keyFile)
+	require.NoError(t, err)
+	for i := 0; i < n; i++ {
+		require.NoError(t, l.Record(Entry{Event: SignTransaction, WalletID: "wallet", Address: "addr"}))
+	}
+	return l
+}
+
+// readLines returns the lines of the log in dir
+func readLines(t *testing.T, dir string) []string {
+	f, err := os.Open(filepath.Join(dir, LogFilename))
+	require.NoError(t, err)
+	defer f.Close()
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+// writeLines replaces the log in dir with lines
+func writeLines(t *testing.T, dir string, lines []string) {
+	data := strings.Join(lines, "\n")
+	if len(lines) > 0 {
+		data += "\n"
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, LogFilename), []byte(data), 0600))
+}
+
+func TestRecordVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	l := recordEntries(t, dir, "", 3)
+
+	res, err := l.Verify()
+	require.NoError(t, err)
+	require.True(t, res.Valid, res.Message)
+	require.Equal(t, uint64(3), res.Entries)
+	require.Equal(t, uint64(3), res.HeadSeq)
+	seq, hash := l.Head()
+	require.Equal(t, uint64(3), seq)
+	require.Equal(t, hash, res.LastHash)
+	require.NoError(t, l.Close())
+
+	// The key is generated in the data directory by default
+	_, err = os.Stat(filepath.Join(dir, KeyFilename))
+	require.NoError(t, err)
+
+	// Reopening resumes the chain
+	l = recordEntries(t, dir, "", 1)
+	defer l.Close()
+	res, err = l.Verify()
+	require.NoError(t, err)
+	require.True(t, res.Valid, res.Message)
+	require.Equal(t, uint64(4), res.Entries)
+
+	entries, err := l.Export(2, 3)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(2), entries[0].Seq)
+	require.Equal(t, entries[0].Hash, entries[1].PrevHash)
+}
+
+func TestVerifyEditedEntry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	l := recordEntries(t, dir, "", 3)
+	defer l.Close()
+
+	lines := readLines(t, dir)
+	lines[1] = strings.Replace(lines[1], `"address":"addr"`, `"address":"other"`, 1)
+	writeLines(t, dir, lines)
+
+	res, err := l.Verify()
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+	require.Equal(t, uint64(2), res.FirstInvalid)
+}
+
+func TestVerifyRehashedLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	l := recordEntries(t, dir, "", 3)
+	defer l.Close()
+
+	// Rewriting and rehashing every entry, without the key of the log, does
+	// not produce a valid chain
+	var prevHash string
+	var lines []string
+	for i, line := range readLines(t, dir) {
+		var e Entry
+		require.NoError(t, json.Unmarshal([]byte(line), &e))
+		if i == 1 {
+			e.Address = "other"
+		}
+		if i > 0 {
+			e.PrevHash = prevHash
+		}
+		hash, err := e.computeHash([]byte("not the key of the log"))
+		require.NoError(t, err)
+		e.Hash = hash.String()
+		prevHash = e.Hash
+		data, err := json.Marshal(e)
+		require.NoError(t, err)
+		lines = append(lines, string(data))
+	}
+	writeLines(t, dir, lines)
+
+	res, err := l.Verify()
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+	require.Equal(t, uint64(1), res.FirstInvalid)
+}
+
+func TestVerifyTruncatedLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	l := recordEntries(t, dir, "", 3)
+	require.NoError(t, l.Close())
+
+	lines := readLines(t, dir)
+	writeLines(t, dir, lines[:2])
+
+	// The log no longer reaches its checkpointed head
+	res, err := l.Verify()
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+	require.Equal(t, uint64(3), res.FirstInvalid)
+	require.Equal(t, uint64(3), res.HeadSeq)
+
+	// and it cannot be extended, which would move the checkpoint
+	_, err = Open(dir, "")
+	require.ErrorIs(t, err, ErrTruncated)
+
+	// Removing every entry is detected as well
+	writeLines(t, dir, nil)
+	_, err = Open(dir, "")
+	require.ErrorIs(t, err, ErrTruncated)
+}
+
+func TestKeyFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(t.TempDir(), "audit.key")
+	l := recordEntries(t, dir, keyFile, 2)
+	require.NoError(t, l.Close())
+	_, err := os.Stat(filepath.Join(dir, KeyFilename))
+	require.True(t, os.IsNotExist(err))
+
+	// The log cannot be verified nor extended with another key
+	otherDir := t.TempDir()
+	other := recordEntries(t, otherDir, "", 0)
+	require.NoError(t, other.Close())
+	_, err = Open(dir, filepath.Join(otherDir, KeyFilename))
+	require.Error(t, err)
+
+	// nor without its key
+	require.NoError(t, os.Remove(keyFile))
+	_, err = Open(dir, keyFile)
+	require.ErrorIs(t, err, errKeyMissing)
+}
@@ -141,6 +141,66 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1DELETEMultisigRequest:
 		reqPath = "v1/multisig"
 		reqMethod = "DELETE"
+	case kmdapi.APIV1POSTMultisigSessionRequest:
+		reqPath = "v1/multisig/session"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTMultisigSessionSignRequest:
+		reqPath = "v1/multisig/session/sign"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTMultisigSessionInfoRequest:
+		reqPath = "v1/multisig/session/info"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTMultisigSessionImportRequest:
+		reqPath = "v1/multisig/session/import"
+		reqMethod = "POST"
+	case kmdapi.APIV1DELETEMultisigSessionRequest:
+		reqPath = "v1/multisig/session"
+		reqMethod = "DELETE"
+	case kmdapi.APIV1POSTFrostImportRequest:
+		reqPath = "v1/frost/import"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTFrostListRequest:
+		reqPath = "v1/frost/list"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTFrostCommitRequest:
+		reqPath = "v1/frost/commit"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTFrostSignRequest:
+		reqPath = "v1/frost/sign"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTFrostAggregateRequest:
+		reqPath = "v1/frost/aggregate"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTAuditVerifyRequest:
+		reqPath = "v1/audit/verify"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTAuditExportRequest:
+		reqPath = "v1/audit/export"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTRelayPairingRequest:
+		reqPath = "v1/relay/pairing"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTRelayPairingInfoRequest:
+		reqPath = "v1/relay/pairing/info"
+		reqMethod = "POST"
+	case kmdapi.APIV1DELETERelayPairingRequest:
+		reqPath = "v1/relay/pairing"
+		reqMethod = "DELETE"
+	case kmdapi.APIV1POSTRelaySignRequest:
+		reqPath = "v1/relay/sign"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTRelaySignInfoRequest:
+		reqPath = "v1/relay/sign/info"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTRelayClientPairRequest:
+		reqPath = "v1/relay/client/pair"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTRelayClientPollRequest:
+		reqPath = "v1/relay/client/poll"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTRelayClientRespondRequest:
+		reqPath = "v1/relay/client/respond"
+		reqMethod = "POST"
 	}
 	return
 }
@@ -59,6 +59,21 @@ func (kcl KMDClient) CreateWallet(walletName []byte, walletDriverName string, wa
 	return
 }
 
+// CreateWalletFromMnemonic wraps kmdapi.APIV1POSTWalletRequest, recovering
+// the wallet from a BIP-39 mnemonic
+func (kcl KMDClient) CreateWalletFromMnemonic(walletName []byte, walletDriverName string, walletPassword []byte, mnemonic, mnemonicPassphrase string, account uint32) (resp kmdapi.APIV1POSTWalletResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRequest{
+		WalletName:         string(walletName),
+		WalletDriverName:   walletDriverName,
+		WalletPassword:     string(walletPassword),
+		Mnemonic:           mnemonic,
+		MnemonicPassphrase: mnemonicPassphrase,
+		HDAccount:          account,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // InitWallet wraps kmdapi.APIV1POSTWalletInitRequest
 func (kcl KMDClient) InitWallet(walletID []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletInitResponse, err error) {
 	req := kmdapi.APIV1POSTWalletInitRequest{
@@ -69,6 +84,18 @@ func (kcl KMDClient) InitWallet(walletID []byte, walletPassword []byte) (resp km
 	return
 }
 
+// InitWalletWithPolicy wraps kmdapi.APIV1POSTWalletInitRequest, restricting
+// the transactions signed with the wallet handle by the policy
+func (kcl KMDClient) InitWalletWithPolicy(walletID []byte, walletPassword []byte, policy kmdapi.APIV1WalletPolicy) (resp kmdapi.APIV1POSTWalletInitResponse, err error) {
+	req := kmdapi.APIV1POSTWalletInitRequest{
+		WalletID:       string(walletID),
+		WalletPassword: string(walletPassword),
+		Policy:         policy,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // ReleaseWalletHandle wraps kmdapi.APIV1POSTWalletReleaseRequest
 func (kcl KMDClient) ReleaseWalletHandle(walletHandle []byte) (resp kmdapi.APIV1POSTWalletReleaseResponse, err error) {
 	req := kmdapi.APIV1POSTWalletReleaseRequest{
@@ -154,6 +181,229 @@ func (kcl KMDClient) MultisigSignTransaction(walletHandle, pw []byte, tx []byte,
 	return
 }
 
+// CreateMultisigSession wraps kmdapi.APIV1POSTMultisigSessionRequest. When pks
+// is empty, the preimage of the sender is looked up in the wallet.
+func (kcl KMDClient) CreateMultisigSession(walletHandle []byte, tx transactions.Transaction, version, threshold uint8, pks []crypto.PublicKey) (resp kmdapi.APIV1POSTMultisigSessionResponse, err error) {
+	req := kmdapi.APIV1POSTMultisigSessionRequest{
+		WalletHandleToken: string(walletHandle),
+		Transaction:       protocol.Encode(&tx),
+		Version:           version,
+		Threshold:         threshold,
+		PKs:               pks,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// SignMultisigSession wraps kmdapi.APIV1POSTMultisigSessionSignRequest
+func (kcl KMDClient) SignMultisigSession(walletHandle, pw []byte, sessionID string, pk crypto.PublicKey) (resp kmdapi.APIV1POSTMultisigSessionSignResponse, err error) {
+	req := kmdapi.APIV1POSTMultisigSessionSignRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		SessionID:         sessionID,
+		PublicKey:         pk,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// MultisigSessionInfo wraps kmdapi.APIV1POSTMultisigSessionInfoRequest
+func (kcl KMDClient) MultisigSessionInfo(walletHandle []byte, sessionID string) (resp kmdapi.APIV1POSTMultisigSessionInfoResponse, err error) {
+	req := kmdapi.APIV1POSTMultisigSessionInfoRequest{
+		WalletHandleToken: string(walletHandle),
+		SessionID:         sessionID,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ImportMultisigSession wraps kmdapi.APIV1POSTMultisigSessionImportRequest
+func (kcl KMDClient) ImportMultisigSession(walletHandle []byte, sessionID string, stx []byte) (resp kmdapi.APIV1POSTMultisigSessionImportResponse, err error) {
+	req := kmdapi.APIV1POSTMultisigSessionImportRequest{
+		WalletHandleToken: string(walletHandle),
+		SessionID:         sessionID,
+		SignedTransaction: stx,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// DeleteMultisigSession wraps kmdapi.APIV1DELETEMultisigSessionRequest
+func (kcl KMDClient) DeleteMultisigSession(walletHandle []byte, sessionID string) (resp kmdapi.APIV1DELETEMultisigSessionResponse, err error) {
+	req := kmdapi.APIV1DELETEMultisigSessionRequest{
+		WalletHandleToken: string(walletHandle),
+		SessionID:         sessionID,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ImportFrostShare wraps kmdapi.APIV1POSTFrostImportRequest
+func (kcl KMDClient) ImportFrostShare(walletHandle []byte, share crypto.FrostKeyShare) (resp kmdapi.APIV1POSTFrostImportResponse, err error) {
+	req := kmdapi.APIV1POSTFrostImportRequest{
+		WalletHandleToken: string(walletHandle),
+		Identifier:        share.Identifier,
+		Threshold:         share.Threshold,
+		SecretShare:       share.SecretShare[:],
+		VerifyingShare:    share.VerifyingShare[:],
+		GroupKey:          share.GroupKey[:],
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ListFrostShares wraps kmdapi.APIV1POSTFrostListRequest
+func (kcl KMDClient) ListFrostShares(walletHandle []byte) (resp kmdapi.APIV1POSTFrostListResponse, err error) {
+	req := kmdapi.APIV1POSTFrostListRequest{
+		WalletHandleToken: string(walletHandle),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// CommitFrost wraps kmdapi.APIV1POSTFrostCommitRequest
+func (kcl KMDClient) CommitFrost(walletHandle []byte, addr string) (resp kmdapi.APIV1POSTFrostCommitResponse, err error) {
+	req := kmdapi.APIV1POSTFrostCommitRequest{
+		WalletHandleToken: string(walletHandle),
+		Address:           addr,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// SignFrost wraps kmdapi.APIV1POSTFrostSignRequest
+func (kcl KMDClient) SignFrost(walletHandle, pw []byte, addr, noncesID string, tx transactions.Transaction, commitments []kmdapi.APIV1FrostCommitment) (resp kmdapi.APIV1POSTFrostSignResponse, err error) {
+	req := kmdapi.APIV1POSTFrostSignRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		Address:           addr,
+		NoncesID:          noncesID,
+		Transaction:       protocol.Encode(&tx),
+		Commitments:       commitments,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// AggregateFrost wraps kmdapi.APIV1POSTFrostAggregateRequest
+func (kcl KMDClient) AggregateFrost(walletHandle []byte, addr string, tx transactions.Transaction, commitments []kmdapi.APIV1FrostCommitment, shares []kmdapi.APIV1FrostSignatureShare) (resp kmdapi.APIV1POSTFrostAggregateResponse, err error) {
+	req := kmdapi.APIV1POSTFrostAggregateRequest{
+		WalletHandleToken: string(walletHandle),
+		Address:           addr,
+		Transaction:       protocol.Encode(&tx),
+		Commitments:       commitments,
+		SignatureShares:   shares,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// VerifyAuditLog wraps kmdapi.APIV1POSTAuditVerifyRequest
+func (kcl KMDClient) VerifyAuditLog() (resp kmdapi.APIV1POSTAuditVerifyResponse, err error) {
+	req := kmdapi.APIV1POSTAuditVerifyRequest{}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ExportAuditLog wraps kmdapi.APIV1POSTAuditExportRequest
+func (kcl KMDClient) ExportAuditLog(from, to uint64) (resp kmdapi.APIV1POSTAuditExportResponse, err error) {
+	req := kmdapi.APIV1POSTAuditExportRequest{
+		From: from,
+		To:   to,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// CreateRelayPairing wraps kmdapi.APIV1POSTRelayPairingRequest
+func (kcl KMDClient) CreateRelayPairing(walletHandle, pw []byte) (resp kmdapi.APIV1POSTRelayPairingResponse, err error) {
+	req := kmdapi.APIV1POSTRelayPairingRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RelayPairingInfo wraps kmdapi.APIV1POSTRelayPairingInfoRequest
+func (kcl KMDClient) RelayPairingInfo(walletHandle []byte, pairingID string) (resp kmdapi.APIV1POSTRelayPairingInfoResponse, err error) {
+	req := kmdapi.APIV1POSTRelayPairingInfoRequest{
+		WalletHandleToken: string(walletHandle),
+		PairingID:         pairingID,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// DeleteRelayPairing wraps kmdapi.APIV1DELETERelayPairingRequest
+func (kcl KMDClient) DeleteRelayPairing(walletHandle []byte, pairingID string) (resp kmdapi.APIV1DELETERelayPairingResponse, err error) {
+	req := kmdapi.APIV1DELETERelayPairingRequest{
+		WalletHandleToken: string(walletHandle),
+		PairingID:         pairingID,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RelaySign wraps kmdapi.APIV1POSTRelaySignRequest
+func (kcl KMDClient) RelaySign(walletHandle []byte, pairingID string, tx transactions.Transaction, message string) (resp kmdapi.APIV1POSTRelaySignResponse, err error) {
+	req := kmdapi.APIV1POSTRelaySignRequest{
+		WalletHandleToken: string(walletHandle),
+		PairingID:         pairingID,
+		Transaction:       protocol.Encode(&tx),
+		Message:           message,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RelaySignInfo wraps kmdapi.APIV1POSTRelaySignInfoRequest
+func (kcl KMDClient) RelaySignInfo(walletHandle []byte, requestID string, waitSeconds uint64) (resp kmdapi.APIV1POSTRelaySignInfoResponse, err error) {
+	req := kmdapi.APIV1POSTRelaySignInfoRequest{
+		WalletHandleToken: string(walletHandle),
+		RequestID:         requestID,
+		WaitSeconds:       waitSeconds,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RelayClientPair wraps kmdapi.APIV1POSTRelayClientPairRequest
+func (kcl KMDClient) RelayClientPair(pairingID string, pk crypto.PublicKey, proof []byte) (resp kmdapi.APIV1POSTRelayClientPairResponse, err error) {
+	req := kmdapi.APIV1POSTRelayClientPairRequest{
+		PairingID: pairingID,
+		PublicKey: pk,
+		Proof:     proof,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RelayClientPoll wraps kmdapi.APIV1POSTRelayClientPollRequest
+func (kcl KMDClient) RelayClientPoll(pairingID string, timestamp int64, sig crypto.Signature, waitSeconds uint64) (resp kmdapi.APIV1POSTRelayClientPollResponse, err error) {
+	req := kmdapi.APIV1POSTRelayClientPollRequest{
+		PairingID:   pairingID,
+		Timestamp:   timestamp,
+		Signature:   sig,
+		WaitSeconds: waitSeconds,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RelayClientRespond wraps kmdapi.APIV1POSTRelayClientRespondRequest
+func (kcl KMDClient) RelayClientRespond(pairingID, requestID string, approve bool, sig crypto.Signature, confirmation string) (resp kmdapi.APIV1POSTRelayClientRespondResponse, err error) {
+	req := kmdapi.APIV1POSTRelayClientRespondRequest{
+		PairingID:    pairingID,
+		RequestID:    requestID,
+		Approve:      approve,
+		Signature:    sig,
+		Confirmation: confirmation,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // MultisigSignProgram wraps kmdapi.APIV1POSTMultisigProgramSignRequest
 func (kcl KMDClient) MultisigSignProgram(walletHandle, pw []byte, addr string, data []byte, pk crypto.PublicKey, partial crypto.MultisigSig) (resp kmdapi.APIV1POSTMultisigProgramSignResponse, err error) {
 	req := kmdapi.APIV1POSTMultisigProgramSignRequest{
@@ -197,6 +447,19 @@ func (kcl KMDClient) ExportMasterDerivationKey(walletHandle []byte, walletPasswo
 	return
 }
 
+// ExportMasterDerivationKeyWithConfirmation wraps
+// kmdapi.APIV1POSTMasterKeyExportRequest, passing the second factor code
+// required when kmd is configured with one
+func (kcl KMDClient) ExportMasterDerivationKeyWithConfirmation(walletHandle []byte, walletPassword []byte, confirmation string) (resp kmdapi.APIV1POSTMasterKeyExportResponse, err error) {
+	req := kmdapi.APIV1POSTMasterKeyExportRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		Confirmation:      confirmation,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignTransaction wraps kmdapi.APIV1POSTTransactionSignRequest
 func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
 	txBytes := protocol.Encode(&tx)
@@ -210,6 +473,21 @@ func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKe
 	return
 }
 
+// SignTransactionWithConfirmation wraps kmdapi.APIV1POSTTransactionSignRequest,
+// passing the second factor code required by the policies of the wallet handle
+func (kcl KMDClient) SignTransactionWithConfirmation(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction, confirmation string) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
+	txBytes := protocol.Encode(&tx)
+	req := kmdapi.APIV1POSTTransactionSignRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+		PublicKey:         pk,
+		Transaction:       txBytes,
+		Confirmation:      confirmation,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignProgram wraps kmdapi.APIV1POSTProgramSignRequest
 func (kcl KMDClient) SignProgram(walletHandle, pw []byte, addr string, data []byte) (resp kmdapi.APIV1POSTProgramSignResponse, err error) {
 	req := kmdapi.APIV1POSTProgramSignRequest{
@@ -18,9 +18,13 @@ package config
 
 import (
 	"encoding/json"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/codecs"
 )
 
@@ -28,9 +32,14 @@ const (
 	kmdConfigFilename          = "kmd_config.json"
 	kmdConfigExampleFilename   = kmdConfigFilename + ".example"
 	defaultSessionLifetimeSecs = 60
+	defaultMultisigSessionSecs = 24 * 60 * 60
+	defaultRelayPairingSecs    = 24 * 60 * 60
+	defaultRelayRequestSecs    = 5 * 60
 	defaultScryptN             = 65536
 	defaultScryptR             = 1
 	defaultScryptP             = 32
+	defaultLedgerAccounts      = 1
+	defaultUnixSocketMode      = "0600"
 )
 
 // KMDConfig contains global configuration information for kmd
@@ -40,12 +49,59 @@ type KMDConfig struct {
 	SessionLifetimeSecs uint64       `json:"session_lifetime_secs"`
 	Address             string       `json:"address"`
 	AllowedOrigins      []string     `json:"allowed_origins"`
+	// MultisigSessionLifetimeSecs is how long the multisig signing sessions
+	// are kept, waiting for the signatures to be collected
+	MultisigSessionLifetimeSecs uint64 `json:"multisig_session_lifetime_secs"`
+	// Policies restrict the transactions signed with every wallet handle
+	// token of a wallet, keyed by wallet ID
+	Policies map[string]kmdapi.APIV1WalletPolicy `json:"policies"`
+	// ConfirmationTOTPSecret is the base32 encoded secret of the time-based
+	// one-time passwords confirming the key registrations of the wallets
+	// whose policy requires it. Once it is set, every key export requires a
+	// confirmation code as well.
+	ConfirmationTOTPSecret string `json:"confirmation_totp_secret"`
+	// EnableAuditLog records the key generation, export and signing
+	// requests in a hash-chained, append-only log in the kmd data directory.
+	// Exports and signatures are recorded before they happen, and refused
+	// when the log cannot be written.
+	EnableAuditLog bool `json:"enable_audit_log"`
+	// AuditLogKeyFile holds the HMAC key of the audit log entries, and
+	// defaults to kmd-audit.key in the kmd data directory. Keeping it out of
+	// reach of whoever can write the log, e.g. on another volume, keeps them
+	// from rewriting the log undetected.
+	AuditLogKeyFile string `json:"audit_log_key_file"`
+	// UnixSocket, when set, is the path of a unix domain socket on which
+	// kmd serves its API as well, relative to the kmd data directory unless
+	// absolute
+	UnixSocket string `json:"unix_socket"`
+	// UnixSocketMode is the octal permissions of the UnixSocket file, which
+	// restrict the users allowed to connect to it
+	UnixSocketMode string `json:"unix_socket_mode"`
+	// SigningRelay configures the signing relay, which pushes the signing
+	// requests of a wallet to a paired approving client, such as a mobile
+	// wallet
+	SigningRelay SigningRelayConfig `json:"signing_relay"`
+}
+
+// SigningRelayConfig is configuration specific to the signing relay
+type SigningRelayConfig struct {
+	Enable bool `json:"enable"`
+	// PublicURL is the URL the approving clients reach kmd at, passed to
+	// them in the pairing URIs
+	PublicURL string `json:"public_url"`
+	// PairingLifetimeSecs is how long a pairing lasts from its creation, at
+	// most: it ends with the wallet handle it was created with
+	PairingLifetimeSecs uint64 `json:"pairing_lifetime_secs"`
+	// RequestLifetimeSecs is how long a signing request waits for the
+	// approving client, and its outcome is kept
+	RequestLifetimeSecs uint64 `json:"request_lifetime_secs"`
 }
 
 // DriverConfig contains config info specific to each wallet driver
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	RemoteWalletDriverConfig RemoteWalletDriverConfig `json:"remote"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -58,6 +114,27 @@ type SQLiteWalletDriverConfig struct {
 // LedgerWalletDriverConfig is configuration specific to the LedgerWalletDriver
 type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
+	// Accounts is the number of accounts derived from the device master
+	// secret, at the BIP-44 account indices 0 to Accounts-1
+	Accounts uint32 `json:"accounts"`
+}
+
+// RemoteWalletDriverConfig is configuration specific to the RemoteWalletDriver
+type RemoteWalletDriverConfig struct {
+	Disable bool                 `json:"disable"`
+	Signers []RemoteSignerConfig `json:"signers"`
+}
+
+// RemoteSignerConfig describes a remote signing service, which is exposed
+// as a wallet. The service is reached over https, and both sides are
+// authenticated with certificates.
+type RemoteSignerConfig struct {
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	CAFile      string `json:"ca_file"`
+	CertFile    string `json:"cert_file"`
+	KeyFile     string `json:"key_file"`
+	TimeoutSecs uint64 `json:"timeout_secs"`
 }
 
 // ScryptParams stores the parameters used for key derivation. This allows
@@ -71,8 +148,14 @@ type ScryptParams struct {
 // defaultConfig returns the default KMDConfig
 func defaultConfig(dataDir string) KMDConfig {
 	return KMDConfig{
-		DataDir:             dataDir,
-		SessionLifetimeSecs: defaultSessionLifetimeSecs,
+		DataDir:                     dataDir,
+		SessionLifetimeSecs:         defaultSessionLifetimeSecs,
+		MultisigSessionLifetimeSecs: defaultMultisigSessionSecs,
+		UnixSocketMode:              defaultUnixSocketMode,
+		SigningRelay: SigningRelayConfig{
+			PairingLifetimeSecs: defaultRelayPairingSecs,
+			RequestLifetimeSecs: defaultRelayRequestSecs,
+		},
 		DriverConfig: DriverConfig{
 			SQLiteWalletDriverConfig: SQLiteWalletDriverConfig{
 				ScryptParams: ScryptParams{
@@ -81,6 +164,9 @@ func defaultConfig(dataDir string) KMDConfig {
 					ScryptP: defaultScryptP,
 				},
 			},
+			LedgerWalletDriverConfig: LedgerWalletDriverConfig{
+				Accounts: defaultLedgerAccounts,
+			},
 		},
 	}
 }
@@ -95,6 +181,34 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+
+	if k.UnixSocket != "" {
+		_, err := util.ParseFileMode(k.UnixSocketMode)
+		if err != nil {
+			return ErrUnixSocketMode
+		}
+	}
+
+	if k.SigningRelay.PublicURL != "" {
+		u, err := url.Parse(k.SigningRelay.PublicURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrSigningRelayURL
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, signer := range k.DriverConfig.RemoteWalletDriverConfig.Signers {
+		if signer.Name == "" || names[signer.Name] {
+			return ErrRemoteSignerName
+		}
+		names[signer.Name] = true
+		if !strings.HasPrefix(signer.URL, "https://") {
+			return ErrRemoteSignerURL
+		}
+		if signer.CertFile == "" || signer.KeyFile == "" || signer.CAFile == "" {
+			return ErrRemoteSignerCertificates
+		}
+	}
 	return nil
 }
 
@@ -22,3 +22,18 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrRemoteSignerName is returned when a remote signer has no name, or the name of another remote signer
+var ErrRemoteSignerName = fmt.Errorf("remote signers must have unique non-empty names")
+
+// ErrRemoteSignerURL is returned when the url of a remote signer is not an https url
+var ErrRemoteSignerURL = fmt.Errorf("remote signer url must be an https url")
+
+// ErrRemoteSignerCertificates is returned when the certificates of a remote signer are missing
+var ErrRemoteSignerCertificates = fmt.Errorf("remote signer requires a ca file, a client certificate file and a client key file")
+
+// ErrSigningRelayURL is returned when the public url of the signing relay is not an http or https url
+var ErrSigningRelayURL = fmt.Errorf("signing relay public url must be an http or https url")
+
+// ErrUnixSocketMode is returned when the permissions of the unix socket are not octal file permissions
+var ErrUnixSocketMode = fmt.Errorf("unix socket mode must be octal file permissions, such as 0600")
@@ -18,13 +18,16 @@ package kmd
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/config"
 	"github.com/algorand/go-algorand/daemon/kmd/server"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
@@ -63,15 +66,48 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		return
 	}
 
+	// Set up the wallet sessions, with the configured policies
+	sessionManager, err := session.MakeManager(kmdCfg)
+	if err != nil {
+		return
+	}
+
+	// Open the audit log of key and signing operations, if enabled
+	var auditLog *audit.Log
+	if kmdCfg.EnableAuditLog {
+		auditLog, err = audit.Open(startConfig.DataDir, kmdCfg.AuditLogKeyFile)
+		if err != nil {
+			return
+		}
+		seq, hash := auditLog.Head()
+		startConfig.Log.Infof("audit log head is entry %d with hash %s", seq, hash)
+	}
+
+	// Resolve the unix socket the API is served on as well, if any
+	unixSocket := kmdCfg.UnixSocket
+	var unixSocketMode os.FileMode
+	if unixSocket != "" {
+		if !filepath.IsAbs(unixSocket) {
+			unixSocket = filepath.Join(startConfig.DataDir, unixSocket)
+		}
+		unixSocketMode, err = util.ParseFileMode(kmdCfg.UnixSocketMode)
+		if err != nil {
+			return
+		}
+	}
+
 	// Configure the wallet API server
 	serverCfg := server.WalletServerConfig{
 		APIToken:       apiToken,
 		DataDir:        startConfig.DataDir,
 		Address:        kmdCfg.Address,
 		AllowedOrigins: kmdCfg.AllowedOrigins,
-		SessionManager: session.MakeManager(kmdCfg),
+		SessionManager: sessionManager,
+		AuditLog:       auditLog,
 		Log:            startConfig.Log,
 		Timeout:        startConfig.Timeout,
+		UnixSocket:     unixSocket,
+		UnixSocketMode: unixSocketMode,
 	}
 
 	// Instantiate the wallet API server
@@ -47,6 +47,123 @@ type APIV1Wallet struct {
 	SupportedTransactions []protocol.TxType `json:"supported_txs"`
 }
 
+// APIV1WalletPolicy restricts the transactions that can be signed with a
+// wallet handle token. The zero value places no restriction.
+//
+// swagger:model WalletPolicy
+type APIV1WalletPolicy struct {
+	// Maximum amount of microalgos that the signed transactions may spend,
+	// in fees and payment amounts, within any window of PeriodSecs seconds,
+	// or 0 for no limit. Asset amounts are not counted: restrict the assets
+	// sent with AllowedReceivers and AllowedTransactions.
+	MaxAmount  uint64 `json:"max_amount"`
+	PeriodSecs uint64 `json:"period_secs"`
+	// Addresses that the signed transactions may send algos or assets to,
+	// close to, or rekey to, or any address when empty
+	AllowedReceivers []string `json:"allowed_receivers"`
+	// Types of the transactions that may be signed, or any type when empty
+	AllowedTransactions []protocol.TxType `json:"allowed_txs"`
+	// Require a confirmation code from the second factor configured in kmd
+	// to sign key registrations
+	RequireKeyregConfirmation bool `json:"require_keyreg_confirmation"`
+}
+
+// APIV1MultisigSession is the state of a multisig signing session, which
+// collects the signatures of a transaction by a multisig account
+type APIV1MultisigSession struct {
+	ID        string `json:"id"`
+	TxID      string `json:"txid"`
+	Address   string `json:"address"`
+	Version   uint8  `json:"multisig_version"`
+	Threshold uint8  `json:"threshold"`
+	// The keys which signed the transaction, and the ones which did not yet
+	Signed  []APIV1PublicKey `json:"signed"`
+	Missing []APIV1PublicKey `json:"missing"`
+	// Complete is true once the threshold of signatures is reached
+	Complete bool `json:"complete"`
+	// Base64 encoding of msgpack encoding of the `SignedTxn` holding the
+	// signatures collected so far. It can be imported by another kmd, and
+	// sent to the network once complete.
+	//
+	// swagger:strfmt byte
+	SignedTransaction []byte `json:"signed_transaction"`
+	ExpiresSeconds    int64  `json:"expires_seconds"`
+}
+
+// APIV1RelayPairing is the state of a pairing of the signing relay, which
+// pushes the signing requests of a wallet to an approving client
+type APIV1RelayPairing struct {
+	ID       string `json:"id"`
+	WalletID string `json:"wallet_id"`
+	// Paired is true once the approving client completed the pairing with
+	// its session key
+	Paired          bool           `json:"paired"`
+	ClientPublicKey APIV1PublicKey `json:"client_public_key"`
+	PendingRequests int            `json:"pending_requests"`
+	ExpiresSeconds  int64          `json:"expires_seconds"`
+}
+
+// APIV1RelayRequest is a signing request of the signing relay, waiting for
+// the approving client or answered by it
+type APIV1RelayRequest struct {
+	ID        string `json:"id"`
+	PairingID string `json:"pairing_id"`
+	TxID      string `json:"txid"`
+	// Base64 encoding of msgpack encoding of the `Transaction` to sign
+	//
+	// swagger:strfmt byte
+	Transaction []byte `json:"transaction"`
+	// Message is shown to the user of the approving client along with the
+	// transaction
+	Message string `json:"message"`
+	// Status is pending until the client answers, signing while the approved
+	// transaction is signed, then signed, rejected, or failed when the
+	// approved transaction could not be signed
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	// Base64 encoding of msgpack encoding of the `SignedTxn`, once approved
+	//
+	// swagger:strfmt byte
+	SignedTransaction []byte `json:"signed_transaction,omitempty"`
+	ExpiresSeconds    int64  `json:"expires_seconds"`
+}
+
+// APIV1FrostCommitment is the commitment of a FROST signer to the nonces it
+// will use for one signature. The commitments of the signers taking part in a
+// signature are shared with all of them before they sign.
+type APIV1FrostCommitment struct {
+	Identifier uint16 `json:"identifier"`
+	// swagger:strfmt byte
+	Hiding []byte `json:"hiding"`
+	// swagger:strfmt byte
+	Binding []byte `json:"binding"`
+}
+
+// APIV1FrostSignatureShare is the share of a FROST signature produced by one
+// signer, which is aggregated with the others into an ed25519 signature
+type APIV1FrostSignatureShare struct {
+	Identifier uint16 `json:"identifier"`
+	// swagger:strfmt byte
+	Share []byte `json:"share"`
+}
+
+// APIV1AuditEntry is an entry of the kmd audit log. Each entry carries the
+// hash of the entry before it, chaining the whole log together.
+type APIV1AuditEntry struct {
+	Seq       uint64 `json:"seq"`
+	Time      string `json:"time"`
+	Event     string `json:"event"`
+	WalletID  string `json:"wallet_id,omitempty"`
+	Requester string `json:"requester,omitempty"`
+	Address   string `json:"address,omitempty"`
+	// Digest is the transaction ID of a signed transaction, or the hash of
+	// a signed program
+	Digest   string `json:"digest,omitempty"`
+	Error    string `json:"error,omitempty"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
 // APIV1WalletHandle includes the wallet the handle corresponds to
 // and the number of number of seconds to expiration
 type APIV1WalletHandle struct {
@@ -49,6 +49,12 @@ type APIV1POSTWalletRequest struct {
 	WalletDriverName    string                   `json:"wallet_driver_name"`
 	WalletPassword      string                   `json:"wallet_password"`
 	MasterDerivationKey APIV1MasterDerivationKey `json:"master_derivation_key"`
+	// A BIP-39 mnemonic to recover the wallet from, instead of a master
+	// derivation key, with its optional passphrase. The keys are derived
+	// at the paths m/44'/283'/HDAccount'/0'/index'.
+	Mnemonic           string `json:"mnemonic"`
+	MnemonicPassphrase string `json:"mnemonic_passphrase"`
+	HDAccount          uint32 `json:"hd_account"`
 }
 
 // APIV1POSTWalletInitRequest is the request for `POST /v1/wallet/init`
@@ -59,6 +65,9 @@ type APIV1POSTWalletInitRequest struct {
 
 	WalletID       string `json:"wallet_id"`
 	WalletPassword string `json:"wallet_password"`
+	// Restricts the transactions signed with the wallet handle token, in
+	// addition to the policy configured in kmd for the wallet
+	Policy APIV1WalletPolicy `json:"policy"`
 }
 
 // APIV1POSTWalletReleaseRequest is the request for `POST /v1/wallet/release`
@@ -107,6 +116,8 @@ type APIV1POSTMasterKeyExportRequest struct {
 
 	WalletHandleToken string `json:"wallet_handle_token"`
 	WalletPassword    string `json:"wallet_password"`
+	// Second factor code, required when kmd is configured with a confirmation totp secret
+	Confirmation string `json:"confirmation"`
 }
 
 // APIV1POSTKeyImportRequest is the request for `POST /v1/key/import`
@@ -128,6 +139,8 @@ type APIV1POSTKeyExportRequest struct {
 	WalletHandleToken string `json:"wallet_handle_token"`
 	Address           string `json:"address"`
 	WalletPassword    string `json:"wallet_password"`
+	// Second factor code, required when kmd is configured with a confirmation totp secret
+	Confirmation string `json:"confirmation"`
 }
 
 // APIV1POSTKeyRequest is the request for `POST /v1/key`
@@ -176,6 +189,8 @@ type APIV1POSTTransactionSignRequest struct {
 	Transaction    []byte           `json:"transaction"`
 	PublicKey      crypto.PublicKey `json:"public_key"`
 	WalletPassword string           `json:"wallet_password"`
+	// Second factor code, required by the policies that confirm key registrations
+	Confirmation string `json:"confirmation"`
 }
 
 // APIV1POSTProgramSignRequest is the request for `POST /v1/program/sign`
@@ -246,6 +261,8 @@ type APIV1POSTMultisigTransactionSignRequest struct {
 	PartialMsig    crypto.MultisigSig `json:"partial_multisig"`
 	WalletPassword string             `json:"wallet_password"`
 	AuthAddr       crypto.Digest      `json:"signer"`
+	// Second factor code, required by the policies that confirm key registrations
+	Confirmation string `json:"confirmation"`
 }
 
 // APIV1POSTMultisigProgramSignRequest is the request for `POST /v1/multisig/signprogram`
@@ -262,3 +279,280 @@ type APIV1POSTMultisigProgramSignRequest struct {
 	PartialMsig    crypto.MultisigSig `json:"partial_multisig"`
 	WalletPassword string             `json:"wallet_password"`
 }
+
+// APIV1POSTMultisigSessionRequest is the request for `POST /v1/multisig/session`
+//
+// swagger:model CreateMultisigSessionRequest
+type APIV1POSTMultisigSessionRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	// Base64 encoding of msgpack encoding of the `Transaction` to sign
+	//
+	// swagger:strfmt byte
+	Transaction []byte `json:"transaction"`
+	// The preimage of the multisig account signing the transaction. When
+	// PKs is empty, the preimage of the sender of the transaction is looked
+	// up in the wallet.
+	Version   uint8            `json:"multisig_version"`
+	Threshold uint8            `json:"threshold"`
+	PKs       []APIV1PublicKey `json:"pks"`
+}
+
+// APIV1POSTMultisigSessionSignRequest is the request for `POST /v1/multisig/session/sign`
+//
+// swagger:model SignMultisigSessionRequest
+type APIV1POSTMultisigSessionSignRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string           `json:"wallet_handle_token"`
+	SessionID         string           `json:"session_id"`
+	PublicKey         crypto.PublicKey `json:"public_key"`
+	WalletPassword    string           `json:"wallet_password"`
+	// Second factor code, required by the policies that confirm key registrations
+	Confirmation string `json:"confirmation"`
+}
+
+// APIV1POSTMultisigSessionInfoRequest is the request for `POST /v1/multisig/session/info`
+//
+// swagger:model MultisigSessionInfoRequest
+type APIV1POSTMultisigSessionInfoRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	SessionID         string `json:"session_id"`
+}
+
+// APIV1POSTMultisigSessionImportRequest is the request for `POST /v1/multisig/session/import`
+//
+// swagger:model ImportMultisigSessionRequest
+type APIV1POSTMultisigSessionImportRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	// The session to merge the signatures into, or empty to create a new session
+	SessionID string `json:"session_id"`
+	// Base64 encoding of msgpack encoding of a partially signed `SignedTxn`,
+	// as exported by a multisig session
+	//
+	// swagger:strfmt byte
+	SignedTransaction []byte `json:"signed_transaction"`
+}
+
+// APIV1DELETEMultisigSessionRequest is the request for `DELETE /v1/multisig/session`
+//
+// swagger:model DeleteMultisigSessionRequest
+type APIV1DELETEMultisigSessionRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	SessionID         string `json:"session_id"`
+}
+
+// APIV1POSTFrostImportRequest is the request for `POST /v1/frost/import`
+//
+// swagger:model ImportFrostShareRequest
+type APIV1POSTFrostImportRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	// The identifier of the share among the signers, and the number of
+	// signers needed to sign
+	Identifier uint16 `json:"identifier"`
+	Threshold  uint16 `json:"threshold"`
+	// swagger:strfmt byte
+	SecretShare []byte `json:"secret_share"`
+	// swagger:strfmt byte
+	VerifyingShare []byte `json:"verifying_share"`
+	// The public key of the group, which is the address signed for
+	//
+	// swagger:strfmt byte
+	GroupKey []byte `json:"group_key"`
+}
+
+// APIV1POSTFrostListRequest is the request for `POST /v1/frost/list`
+//
+// swagger:model ListFrostSharesRequest
+type APIV1POSTFrostListRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+}
+
+// APIV1POSTFrostCommitRequest is the request for `POST /v1/frost/commit`
+//
+// swagger:model CommitFrostRequest
+type APIV1POSTFrostCommitRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	Address           string `json:"address"`
+}
+
+// APIV1POSTFrostSignRequest is the request for `POST /v1/frost/sign`
+//
+// swagger:model SignFrostRequest
+type APIV1POSTFrostSignRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	Address           string `json:"address"`
+	// The nonces committed to by `POST /v1/frost/commit`. They are consumed
+	// by the request, whether it succeeds or not.
+	NoncesID string `json:"nonces_id"`
+	// Base64 encoding of msgpack encoding of the `Transaction` to sign
+	//
+	// swagger:strfmt byte
+	Transaction []byte `json:"transaction"`
+	// The commitments of all the signers taking part in the signature
+	Commitments []APIV1FrostCommitment `json:"commitments"`
+	// Second factor code, required by the policies that confirm key registrations
+	Confirmation string `json:"confirmation"`
+}
+
+// APIV1POSTFrostAggregateRequest is the request for `POST /v1/frost/aggregate`
+//
+// swagger:model AggregateFrostRequest
+type APIV1POSTFrostAggregateRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	Address           string `json:"address"`
+	// Base64 encoding of msgpack encoding of the `Transaction` signed
+	//
+	// swagger:strfmt byte
+	Transaction     []byte                     `json:"transaction"`
+	Commitments     []APIV1FrostCommitment     `json:"commitments"`
+	SignatureShares []APIV1FrostSignatureShare `json:"signature_shares"`
+}
+
+// APIV1POSTAuditVerifyRequest is the request for `POST /v1/audit/verify`
+//
+// swagger:model VerifyAuditLogRequest
+type APIV1POSTAuditVerifyRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+}
+
+// APIV1POSTAuditExportRequest is the request for `POST /v1/audit/export`
+//
+// swagger:model ExportAuditLogRequest
+type APIV1POSTAuditExportRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// The sequence numbers of the first and last entries to export. A zero
+	// To exports up to the end of the log.
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// APIV1POSTRelayPairingRequest is the request for `POST /v1/relay/pairing`
+//
+// swagger:model CreateRelayPairingRequest
+type APIV1POSTRelayPairingRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	// The password of the wallet, kept by kmd to sign the requests approved
+	// by the client for as long as the pairing lasts
+	WalletPassword string `json:"wallet_password"`
+}
+
+// APIV1POSTRelayPairingInfoRequest is the request for `POST /v1/relay/pairing/info`
+//
+// swagger:model RelayPairingInfoRequest
+type APIV1POSTRelayPairingInfoRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	PairingID         string `json:"pairing_id"`
+}
+
+// APIV1DELETERelayPairingRequest is the request for `DELETE /v1/relay/pairing`
+//
+// swagger:model DeleteRelayPairingRequest
+type APIV1DELETERelayPairingRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	PairingID         string `json:"pairing_id"`
+}
+
+// APIV1POSTRelaySignRequest is the request for `POST /v1/relay/sign`
+//
+// swagger:model RelaySignRequest
+type APIV1POSTRelaySignRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	PairingID         string `json:"pairing_id"`
+	// Base64 encoding of msgpack encoding of the `Transaction` to sign
+	//
+	// swagger:strfmt byte
+	Transaction []byte `json:"transaction"`
+	// A message shown to the user of the approving client along with the
+	// transaction
+	Message string `json:"message"`
+}
+
+// APIV1POSTRelaySignInfoRequest is the request for `POST /v1/relay/sign/info`
+//
+// swagger:model RelaySignInfoRequest
+type APIV1POSTRelaySignInfoRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	RequestID         string `json:"request_id"`
+	// How long to wait for the client to answer a pending request, at most
+	// 60 seconds
+	WaitSeconds uint64 `json:"wait_seconds"`
+}
+
+// APIV1POSTRelayClientPairRequest is the request for `POST /v1/relay/client/pair`
+//
+// swagger:model RelayClientPairRequest
+type APIV1POSTRelayClientPairRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	PairingID string `json:"pairing_id"`
+	// The session key of the client, which signs its polls and answers
+	PublicKey crypto.PublicKey `json:"public_key"`
+	// HMAC-SHA256 of the session key under the pairing secret of the
+	// pairing URI
+	//
+	// swagger:strfmt byte
+	Proof []byte `json:"proof"`
+}
+
+// APIV1POSTRelayClientPollRequest is the request for `POST /v1/relay/client/poll`
+//
+// swagger:model RelayClientPollRequest
+type APIV1POSTRelayClientPollRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	PairingID string `json:"pairing_id"`
+	// The current unix time, in seconds
+	Timestamp int64 `json:"timestamp"`
+	// Signature of "kmd-relay/poll/<pairing_id>/<timestamp>" by the session
+	// key, as signed by the `SignBytes` of the Algorand SDKs
+	Signature crypto.Signature `json:"signature"`
+	// How long to wait for a pending request, at most 60 seconds
+	WaitSeconds uint64 `json:"wait_seconds"`
+}
+
+// APIV1POSTRelayClientRespondRequest is the request for `POST /v1/relay/client/respond`
+//
+// swagger:model RelayClientRespondRequest
+type APIV1POSTRelayClientRespondRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	PairingID string `json:"pairing_id"`
+	RequestID string `json:"request_id"`
+	Approve   bool   `json:"approve"`
+	// Signature of "kmd-relay/approve/<pairing_id>/<request_id>/<txid>",
+	// or of "kmd-relay/reject/..." to reject the request, by the session
+	// key, as signed by the `SignBytes` of the Algorand SDKs
+	Signature crypto.Signature `json:"signature"`
+	// Second factor code, required by the policies that confirm key registrations
+	Confirmation string `json:"confirmation"`
+}
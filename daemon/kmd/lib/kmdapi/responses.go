@@ -249,6 +249,10 @@ type APIV1POSTTransactionSignResponse struct {
 
 	// swagger:strfmt byte
 	SignedTransaction []byte `json:"signed_transaction"`
+
+	// DisplayHints describe the transaction the way the wallet asks the
+	// user to confirm it, e.g. on a hardware device, if it does
+	DisplayHints []string `json:"display_hints,omitempty"`
 }
 
 // Response to `POST /v1/transaction/sign`
@@ -338,6 +342,10 @@ type APIV1POSTMultisigTransactionSignResponse struct {
 
 	// swagger:strfmt byte
 	Multisig []byte `json:"multisig"`
+
+	// DisplayHints describe the transaction the way the wallet asks the
+	// user to confirm it, e.g. on a hardware device, if it does
+	DisplayHints []string `json:"display_hints,omitempty"`
 }
 
 // Response to `POST /v1/multisig/sign`
@@ -362,3 +370,296 @@ type signProgramMultisigResponse struct {
 	//	in:body
 	Body *APIV1POSTMultisigProgramSignResponse
 }
+
+// APIV1POSTMultisigSessionResponse is the response to `POST /v1/multisig/session`
+// friendly:CreateMultisigSessionResponse
+type APIV1POSTMultisigSessionResponse struct {
+	APIV1ResponseEnvelope
+	Session APIV1MultisigSession `json:"session"`
+}
+
+// Response to `POST /v1/multisig/session`
+// swagger:response CreateMultisigSessionResponse
+type createMultisigSessionResponse struct {
+	//	in:body
+	Body *APIV1POSTMultisigSessionResponse
+}
+
+// APIV1POSTMultisigSessionSignResponse is the response to `POST /v1/multisig/session/sign`
+// friendly:SignMultisigSessionResponse
+type APIV1POSTMultisigSessionSignResponse struct {
+	APIV1ResponseEnvelope
+	Session APIV1MultisigSession `json:"session"`
+}
+
+// Response to `POST /v1/multisig/session/sign`
+// swagger:response SignMultisigSessionResponse
+type signMultisigSessionResponse struct {
+	//	in:body
+	Body *APIV1POSTMultisigSessionSignResponse
+}
+
+// APIV1POSTMultisigSessionInfoResponse is the response to `POST /v1/multisig/session/info`
+// friendly:MultisigSessionInfoResponse
+type APIV1POSTMultisigSessionInfoResponse struct {
+	APIV1ResponseEnvelope
+	Session APIV1MultisigSession `json:"session"`
+}
+
+// Response to `POST /v1/multisig/session/info`
+// swagger:response MultisigSessionInfoResponse
+type multisigSessionInfoResponse struct {
+	//	in:body
+	Body *APIV1POSTMultisigSessionInfoResponse
+}
+
+// APIV1POSTMultisigSessionImportResponse is the response to `POST /v1/multisig/session/import`
+// friendly:ImportMultisigSessionResponse
+type APIV1POSTMultisigSessionImportResponse struct {
+	APIV1ResponseEnvelope
+	Session APIV1MultisigSession `json:"session"`
+}
+
+// Response to `POST /v1/multisig/session/import`
+// swagger:response ImportMultisigSessionResponse
+type importMultisigSessionResponse struct {
+	//	in:body
+	Body *APIV1POSTMultisigSessionImportResponse
+}
+
+// APIV1DELETEMultisigSessionResponse is the response to `DELETE /v1/multisig/session`
+// friendly:DeleteMultisigSessionResponse
+type APIV1DELETEMultisigSessionResponse struct {
+	APIV1ResponseEnvelope
+}
+
+// Response to `DELETE /v1/multisig/session`
+// swagger:response DeleteMultisigSessionResponse
+type deleteMultisigSessionResponse struct {
+	//	in:body
+	Body *APIV1DELETEMultisigSessionResponse
+}
+
+// APIV1POSTFrostImportResponse is the response to `POST /v1/frost/import`
+// friendly:ImportFrostShareResponse
+type APIV1POSTFrostImportResponse struct {
+	APIV1ResponseEnvelope
+	Address string `json:"address"`
+}
+
+// Response to `POST /v1/frost/import`
+// swagger:response ImportFrostShareResponse
+type importFrostShareResponse struct {
+	//	in:body
+	Body *APIV1POSTFrostImportResponse
+}
+
+// APIV1POSTFrostListResponse is the response to `POST /v1/frost/list`
+// friendly:ListFrostSharesResponse
+type APIV1POSTFrostListResponse struct {
+	APIV1ResponseEnvelope
+	Addresses []string `json:"addresses"`
+}
+
+// Response to `POST /v1/frost/list`
+// swagger:response ListFrostSharesResponse
+type listFrostSharesResponse struct {
+	//	in:body
+	Body *APIV1POSTFrostListResponse
+}
+
+// APIV1POSTFrostCommitResponse is the response to `POST /v1/frost/commit`
+// friendly:CommitFrostResponse
+type APIV1POSTFrostCommitResponse struct {
+	APIV1ResponseEnvelope
+	NoncesID   string               `json:"nonces_id"`
+	Commitment APIV1FrostCommitment `json:"commitment"`
+}
+
+// Response to `POST /v1/frost/commit`
+// swagger:response CommitFrostResponse
+type commitFrostResponse struct {
+	//	in:body
+	Body *APIV1POSTFrostCommitResponse
+}
+
+// APIV1POSTFrostSignResponse is the response to `POST /v1/frost/sign`
+// friendly:SignFrostResponse
+type APIV1POSTFrostSignResponse struct {
+	APIV1ResponseEnvelope
+	SignatureShare APIV1FrostSignatureShare `json:"signature_share"`
+}
+
+// Response to `POST /v1/frost/sign`
+// swagger:response SignFrostResponse
+type signFrostResponse struct {
+	//	in:body
+	Body *APIV1POSTFrostSignResponse
+}
+
+// APIV1POSTFrostAggregateResponse is the response to `POST /v1/frost/aggregate`
+// friendly:AggregateFrostResponse
+type APIV1POSTFrostAggregateResponse struct {
+	APIV1ResponseEnvelope
+	// swagger:strfmt byte
+	SignedTransaction []byte `json:"signed_transaction"`
+}
+
+// Response to `POST /v1/frost/aggregate`
+// swagger:response AggregateFrostResponse
+type aggregateFrostResponse struct {
+	//	in:body
+	Body *APIV1POSTFrostAggregateResponse
+}
+
+// APIV1POSTAuditVerifyResponse is the response to `POST /v1/audit/verify`
+// friendly:VerifyAuditLogResponse
+type APIV1POSTAuditVerifyResponse struct {
+	APIV1ResponseEnvelope
+	Valid   bool   `json:"valid"`
+	Entries uint64 `json:"entries"`
+	// FirstInvalid is the sequence number of the first entry breaking the
+	// chain, if any
+	FirstInvalid uint64 `json:"first_invalid"`
+	// HeadSeq is the sequence number of the checkpointed head of the log,
+	// which the log must reach
+	HeadSeq  uint64 `json:"head_seq"`
+	LastHash string `json:"last_hash"`
+	Detail   string `json:"detail"`
+}
+
+// Response to `POST /v1/audit/verify`
+// swagger:response VerifyAuditLogResponse
+type verifyAuditLogResponse struct {
+	//	in:body
+	Body *APIV1POSTAuditVerifyResponse
+}
+
+// APIV1POSTAuditExportResponse is the response to `POST /v1/audit/export`
+// friendly:ExportAuditLogResponse
+type APIV1POSTAuditExportResponse struct {
+	APIV1ResponseEnvelope
+	Entries []APIV1AuditEntry `json:"entries"`
+}
+
+// Response to `POST /v1/audit/export`
+// swagger:response ExportAuditLogResponse
+type exportAuditLogResponse struct {
+	//	in:body
+	Body *APIV1POSTAuditExportResponse
+}
+
+// APIV1POSTRelayPairingResponse is the response to `POST /v1/relay/pairing`
+// friendly:CreateRelayPairingResponse
+type APIV1POSTRelayPairingResponse struct {
+	APIV1ResponseEnvelope
+	Pairing APIV1RelayPairing `json:"pairing"`
+	// PairingURI is shown as a QR code, to be scanned by the approving
+	// client. It holds the pairing secret, which is only returned once.
+	PairingURI string `json:"pairing_uri"`
+}
+
+// Response to `POST /v1/relay/pairing`
+// swagger:response CreateRelayPairingResponse
+type createRelayPairingResponse struct {
+	//	in:body
+	Body *APIV1POSTRelayPairingResponse
+}
+
+// APIV1POSTRelayPairingInfoResponse is the response to `POST /v1/relay/pairing/info`
+// friendly:RelayPairingInfoResponse
+type APIV1POSTRelayPairingInfoResponse struct {
+	APIV1ResponseEnvelope
+	Pairing APIV1RelayPairing `json:"pairing"`
+}
+
+// Response to `POST /v1/relay/pairing/info`
+// swagger:response RelayPairingInfoResponse
+type relayPairingInfoResponse struct {
+	//	in:body
+	Body *APIV1POSTRelayPairingInfoResponse
+}
+
+// APIV1DELETERelayPairingResponse is the response to `DELETE /v1/relay/pairing`
+// friendly:DeleteRelayPairingResponse
+type APIV1DELETERelayPairingResponse struct {
+	APIV1ResponseEnvelope
+}
+
+// Response to `DELETE /v1/relay/pairing`
+// swagger:response DeleteRelayPairingResponse
+type deleteRelayPairingResponse struct {
+	//	in:body
+	Body *APIV1DELETERelayPairingResponse
+}
+
+// APIV1POSTRelaySignResponse is the response to `POST /v1/relay/sign`
+// friendly:RelaySignResponse
+type APIV1POSTRelaySignResponse struct {
+	APIV1ResponseEnvelope
+	Request APIV1RelayRequest `json:"request"`
+}
+
+// Response to `POST /v1/relay/sign`
+// swagger:response RelaySignResponse
+type relaySignResponse struct {
+	//	in:body
+	Body *APIV1POSTRelaySignResponse
+}
+
+// APIV1POSTRelaySignInfoResponse is the response to `POST /v1/relay/sign/info`
+// friendly:RelaySignInfoResponse
+type APIV1POSTRelaySignInfoResponse struct {
+	APIV1ResponseEnvelope
+	Request APIV1RelayRequest `json:"request"`
+}
+
+// Response to `POST /v1/relay/sign/info`
+// swagger:response RelaySignInfoResponse
+type relaySignInfoResponse struct {
+	//	in:body
+	Body *APIV1POSTRelaySignInfoResponse
+}
+
+// APIV1POSTRelayClientPairResponse is the response to `POST /v1/relay/client/pair`
+// friendly:RelayClientPairResponse
+type APIV1POSTRelayClientPairResponse struct {
+	APIV1ResponseEnvelope
+	Pairing APIV1RelayPairing `json:"pairing"`
+}
+
+// Response to `POST /v1/relay/client/pair`
+// swagger:response RelayClientPairResponse
+type relayClientPairResponse struct {
+	//	in:body
+	Body *APIV1POSTRelayClientPairResponse
+}
+
+// APIV1POSTRelayClientPollResponse is the response to `POST /v1/relay/client/poll`
+// friendly:RelayClientPollResponse
+type APIV1POSTRelayClientPollResponse struct {
+	APIV1ResponseEnvelope
+	// The requests waiting for the approval of the client
+	Requests []APIV1RelayRequest `json:"requests"`
+}
+
+// Response to `POST /v1/relay/client/poll`
+// swagger:response RelayClientPollResponse
+type relayClientPollResponse struct {
+	//	in:body
+	Body *APIV1POSTRelayClientPollResponse
+}
+
+// APIV1POSTRelayClientRespondResponse is the response to `POST /v1/relay/client/respond`
+// friendly:RelayClientRespondResponse
+type APIV1POSTRelayClientRespondResponse struct {
+	APIV1ResponseEnvelope
+	Request APIV1RelayRequest `json:"request"`
+}
+
+// Response to `POST /v1/relay/client/respond`
+// swagger:response RelayClientRespondResponse
+type relayClientRespondResponse struct {
+	//	in:body
+	Body *APIV1POSTRelayClientRespondResponse
+}
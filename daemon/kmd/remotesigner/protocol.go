@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package remotesigner defines the protocol between the remote wallet driver
+// of kmd and a remote signing service, which holds the private keys in an
+// isolated environment.
+//
+// The service is reached over https, and must require a client certificate
+// signed by a CA it trusts, so that only the configured kmd instances can
+// request signatures. Bodies are json encoded, with byte fields in base64.
+//
+//	GET  /v1/keys  returns a KeysResponse
+//	POST /v1/sign  takes a SignRequest and returns a SignResponse
+//
+// Failures are reported with a non-200 status and an ErrorResponse body.
+//
+// The service is expected to apply its own policy before signing, such as
+// limits on the amounts sent or approval by an operator, which is why the
+// transaction is sent rather than only the bytes to sign. kmd verifies every
+// returned signature against the requested public key.
+package remotesigner
+
+// The paths of the protocol endpoints
+const (
+	KeysPath = "/v1/keys"
+	SignPath = "/v1/sign"
+)
+
+// Kind is the kind of data of a SignRequest
+type Kind string
+
+const (
+	// KindTransaction is a msgpack encoded transactions.Transaction. The
+	// signed message is the "TX" domain separation prefix followed by the
+	// encoded transaction.
+	KindTransaction Kind = "transaction"
+
+	// KindProgram is a TEAL program, for delegated logic signatures. The
+	// signed message is the "Program" domain separation prefix followed by
+	// the program.
+	KindProgram Kind = "program"
+)
+
+// KeysResponse lists the ed25519 public keys the service can sign with
+type KeysResponse struct {
+	Keys [][]byte `json:"keys"`
+}
+
+// SignRequest requests the signature of data with the private key of
+// PublicKey
+type SignRequest struct {
+	PublicKey []byte `json:"public_key"`
+	Kind      Kind   `json:"kind"`
+	Data      []byte `json:"data"`
+}
+
+// SignResponse holds the ed25519 signature of a SignRequest
+type SignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// ErrorResponse describes why a request failed
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
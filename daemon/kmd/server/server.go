@@ -30,8 +30,10 @@ import (
 	"github.com/gofrs/flock"
 
 	"github.com/algorand/go-algorand/daemon/kmd/api"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
@@ -55,8 +57,13 @@ type WalletServerConfig struct {
 	Address        string
 	AllowedOrigins []string
 	SessionManager *session.Manager
+	AuditLog       *audit.Log
 	Log            logging.Logger
 	Timeout        *time.Duration
+	// UnixSocket, when set, is the path of a unix domain socket the API is
+	// served on as well, with the permissions UnixSocketMode
+	UnixSocket     string
+	UnixSocketMode os.FileMode
 }
 
 // WalletServer deals with serving API requests
@@ -211,7 +218,7 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 	// Initialize HTTP server
 	watchdogCB := ws.makeWatchdogCallback(kill)
 	srv := http.Server{
-		Handler: api.Handler(ws.SessionManager, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
+		Handler: api.Handler(ws.SessionManager, ws.AuditLog, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
 	}
 
 	// Read the kill channel and shut down the server gracefully
@@ -254,13 +261,38 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 		}
 	}
 
+	// Listen on the unix socket as well, if configured
+	var unixListener net.Listener
+	if ws.UnixSocket != "" {
+		unixListener, err = util.ListenUnixSocket(ws.UnixSocket, ws.UnixSocketMode)
+		if err != nil {
+			listener.Close()
+			return
+		}
+	}
+
 	// Write out our net file
 	addr := listener.Addr().String()
 	err = ws.writeStateFiles(addr)
 	if err != nil {
+		listener.Close()
+		if unixListener != nil {
+			unixListener.Close()
+		}
 		return
 	}
 
+	// Serve the unix socket until the server shuts down, which closes its
+	// listener as well
+	if unixListener != nil {
+		go func() {
+			err := srv.Serve(unixListener)
+			if err != http.ErrServerClosed {
+				ws.Log.Warnf("kmd wallet HTTP server stopped serving %s: %s", ws.UnixSocket, err)
+			}
+		}()
+	}
+
 	// We'll send something on this channel when we die
 	died = make(chan error)
 
@@ -24,7 +24,9 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/transactions"
 )
 
 const (
@@ -112,7 +114,8 @@ func (sm *Manager) cleanUpExpiredHandles() {
 }
 
 // deleteExpiredHandles is a helper for cleanUpExpiredHandles. It periodically
-// iterates over the walletHandles map and deletes ones that have expired
+// iterates over the walletHandles, multisigSessions and frostNonces maps, and
+// the relay pairings and requests, and deletes ones that have expired
 func (sm *Manager) deleteExpiredHandles() {
 	sm.mux.Lock()
 	defer sm.mux.Unlock()
@@ -121,11 +124,24 @@ func (sm *Manager) deleteExpiredHandles() {
 			delete(sm.walletHandles, handleID)
 		}
 	}
+	for id, ms := range sm.multisigSessions {
+		if checkHandleNotExpired(ms.expires) != nil {
+			delete(sm.multisigSessions, id)
+		}
+	}
+	for id, fn := range sm.frostNonces {
+		if checkHandleNotExpired(fn.expires) != nil {
+			delete(sm.frostNonces, id)
+		}
+	}
+	sm.deleteExpiredRelayLocked()
 }
 
 // InitWalletHandle attempts to init the wallet using the passed password,
-// generates a wallet handle token, and adds the session to the memory store
-func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte) ([]byte, error) {
+// generates a wallet handle token, and adds the session to the memory store.
+// The transactions signed with the token are restricted by the requested
+// policy, in addition to the policy configured for the wallet.
+func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte, requested kmdapi.APIV1WalletPolicy) ([]byte, error) {
 	// Attempt to initialize the wallet with the password
 	err := w.Init(pw)
 	if err != nil {
@@ -138,22 +154,68 @@ func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte) ([]byte, error)
 		return nil, err
 	}
 
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	// Set up the policies of the handle
+	policies, err := sm.handlePoliciesLocked(w, requested)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build the walletHandle
 	handle := walletHandle{
-		secret:  handleSecret,
-		expires: time.Now().Add(sm.sessionLifetime),
-		wallet:  w,
+		secret:   handleSecret,
+		expires:  time.Now().Add(sm.sessionLifetime),
+		wallet:   w,
+		policies: policies,
 	}
 
 	// Insert the handle into the walletHandles map
-	sm.mux.Lock()
-	defer sm.mux.Unlock()
 	sm.walletHandles[string(handleID)] = handle
 	handleToken := []byte(fmt.Sprintf("%s%s%s", handleID, wHandleTokenSplitChar, handleSecret))
 
 	return handleToken, nil
 }
 
+// handlePoliciesLocked returns the policies of a new handle of the wallet
+func (sm *Manager) handlePoliciesLocked(w wallet.Wallet, requested kmdapi.APIV1WalletPolicy) ([]*policy, error) {
+	metadata, err := w.Metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	walletID := string(metadata.ID)
+	var policies []*policy
+	for i, p := range []kmdapi.APIV1WalletPolicy{sm.walletPolicies[walletID], requested} {
+		pol, err := makeEnforceablePolicy(p, sm.totpSecret)
+		if err != nil {
+			return nil, err
+		}
+		if !pol.restrictive() {
+			continue
+		}
+		if i == 0 {
+			// The amounts limited by the wallet policy are counted across the
+			// handles of the wallet, so that a new handle does not reset them
+			pol.spends = sm.walletSpendsLocked(walletID)
+		}
+		policies = append(policies, pol)
+	}
+	return policies, nil
+}
+
+// walletSpendsLocked returns the window of the amounts limited by the policy
+// configured for the wallet
+func (sm *Manager) walletSpendsLocked(walletID string) *spendWindow {
+	spends, ok := sm.walletSpends[walletID]
+	if !ok {
+		spends = &spendWindow{}
+		sm.walletSpends[walletID] = spends
+	}
+	return spends
+}
+
 // getHandleFromTokenLocked is a helper that looks up the wallet handle from
 // the token and checks that the token secret is correct. If it is, it returns
 // the handle ID and the handle itself
@@ -191,8 +253,9 @@ func (sm *Manager) ReleaseWalletHandle(walletHandleToken []byte) error {
 		return err
 	}
 
-	// Delete the handle
+	// Delete the handle, and the relay pairings created with it
 	delete(sm.walletHandles, string(handleID))
+	sm.deleteHandleRelayPairingsLocked(string(handleID))
 	return nil
 }
 
@@ -242,3 +305,105 @@ func (sm *Manager) RenewWalletHandleToken(walletHandleToken []byte) (wallet.Wall
 func (sm *Manager) AuthWithWalletHandleToken(walletHandleToken []byte) (wallet.Wallet, int64, error) {
 	return sm.authMaybeRenewWalletHandleToken(walletHandleToken, false)
 }
+
+// authValidHandleLocked looks up the handle of the token, and checks that it
+// has not expired
+func (sm *Manager) authValidHandleLocked(walletHandleToken []byte) (walletHandle, error) {
+	handleID, handle, err := sm.getHandleFromTokenLocked(walletHandleToken)
+	if err != nil {
+		return walletHandle{}, err
+	}
+
+	err = checkHandleNotExpired(handle.expires)
+	if err != nil {
+		delete(sm.walletHandles, string(handleID))
+		return walletHandle{}, err
+	}
+	return handle, nil
+}
+
+// AuthorizeTransaction checks that the policies of the wallet handle allow
+// signing the transaction. The confirmation code of the second factor is only
+// checked when a policy requires it, and cannot be used twice.
+func (sm *Manager) AuthorizeTransaction(walletHandleToken []byte, tx transactions.Transaction, confirmation string) error {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	handle, err := sm.authValidHandleLocked(walletHandleToken)
+	if err != nil {
+		return err
+	}
+	return sm.authorizeLocked(handle.policies, tx, confirmation)
+}
+
+// authorizeLocked checks that the policies allow signing the transaction,
+// and counts it against them
+func (sm *Manager) authorizeLocked(policies []*policy, tx transactions.Transaction, confirmation string) error {
+	now := time.Now()
+	step, confirmed := verifyTOTP(sm.totpSecret, confirmation, now)
+	confirmed = confirmed && step > sm.lastConfirmationStep
+
+	// Nothing is counted unless all the policies allow the transaction
+	amounts := make([]uint64, len(policies))
+	for i, p := range policies {
+		var err error
+		amounts[i], err = p.check(tx, confirmed, now)
+		if err != nil {
+			return err
+		}
+	}
+	for i, p := range policies {
+		p.record(amounts[i], now)
+	}
+
+	if confirmed {
+		sm.lastConfirmationStep = step
+	}
+	return nil
+}
+
+// AuthorizeProgram checks that the policies of the wallet handle allow signing
+// programs. A delegated logic signature can approve any transaction, so it
+// cannot be signed with a handle restricted by a policy.
+func (sm *Manager) AuthorizeProgram(walletHandleToken []byte) error {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	handle, err := sm.authValidHandleLocked(walletHandleToken)
+	if err != nil {
+		return err
+	}
+
+	if len(handle.policies) > 0 {
+		return errPolicyProgram
+	}
+	return nil
+}
+
+// AuthorizeExport checks that the wallet handle may export keys. An exported
+// key can sign anything, so it cannot be exported with a handle restricted by
+// a policy. When kmd has a second factor configured, every export requires one
+// of its confirmation codes, which cannot be used twice.
+func (sm *Manager) AuthorizeExport(walletHandleToken []byte, confirmation string) error {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	handle, err := sm.authValidHandleLocked(walletHandleToken)
+	if err != nil {
+		return err
+	}
+
+	if len(handle.policies) > 0 {
+		return errPolicyExport
+	}
+	if len(sm.totpSecret) == 0 {
+		return nil
+	}
+
+	step, confirmed := verifyTOTP(sm.totpSecret, confirmation, time.Now())
+	if !confirmed || step <= sm.lastConfirmationStep {
+		return errExportConfirmation
+	}
+	sm.lastConfirmationStep = step
+	return nil
+}
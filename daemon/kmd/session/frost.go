@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+var errFrostNoncesNotFound = fmt.Errorf("FROST nonces do not exist, or were already used")
+
+// frostNonces are the nonces of a FROST signature, kept in memory between the
+// commitment and the signature share of a wallet. They expire after the
+// multisig session lifetime, since both wait on the other signers.
+type frostNonces struct {
+	walletID []byte
+	addr     crypto.Digest
+	nonces   crypto.FrostNonces
+	expires  time.Time
+}
+
+// AddFrostNonces keeps the nonces committed to by the FROST key share of the
+// given wallet and group address, and returns the ID to sign with them
+func (sm *Manager) AddFrostNonces(walletID []byte, addr crypto.Digest, nonces crypto.FrostNonces) (string, error) {
+	_, secret, err := generateHandleIDAndSecret()
+	if err != nil {
+		return "", err
+	}
+	id := string(secret)
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+	sm.frostNonces[id] = &frostNonces{
+		walletID: walletID,
+		addr:     addr,
+		nonces:   nonces,
+		expires:  time.Now().Add(sm.multisigSessionLifetime),
+	}
+	return id, nil
+}
+
+// TakeFrostNonces returns the nonces with the given ID and forgets them, so
+// that they sign at most one signature share
+func (sm *Manager) TakeFrostNonces(id string, walletID []byte, addr crypto.Digest) (crypto.FrostNonces, error) {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	fn, ok := sm.frostNonces[id]
+	if !ok || !bytes.Equal(fn.walletID, walletID) || fn.addr != addr {
+		return crypto.FrostNonces{}, errFrostNoncesNotFound
+	}
+	delete(sm.frostNonces, id)
+	if checkHandleNotExpired(fn.expires) != nil {
+		return crypto.FrostNonces{}, errFrostNoncesNotFound
+	}
+	return fn.nonces, nil
+}
@@ -0,0 +1,255 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var errMultisigSessionNotFound = fmt.Errorf("multisig session does not exist")
+var errMultisigSessionTxn = fmt.Errorf("transaction does not match the multisig session")
+var errMultisigSessionNoMsig = fmt.Errorf("signed transaction has no multisig signature")
+var errMultisigSessionAddr = fmt.Errorf("multisig preimage hashes to neither the sender nor the auth address of the transaction")
+var errMultisigSessionSig = fmt.Errorf("invalid multisig subsignature")
+
+// multisigSession collects the signatures of a transaction by a multisig
+// account. The sessions are kept in memory, and expire after the multisig
+// session lifetime.
+type multisigSession struct {
+	txn     transactions.Transaction
+	addr    crypto.Digest
+	msig    crypto.MultisigSig
+	expires time.Time
+}
+
+// signedTxn returns the transaction with the signatures collected so far
+func (ms *multisigSession) signedTxn() transactions.SignedTxn {
+	stxn := transactions.SignedTxn{
+		Txn:  ms.txn,
+		Msig: ms.msig,
+	}
+	if basics.Address(ms.addr) != ms.txn.Sender {
+		stxn.AuthAddr = basics.Address(ms.addr)
+	}
+	return stxn
+}
+
+func (ms *multisigSession) info(id string) kmdapi.APIV1MultisigSession {
+	info := kmdapi.APIV1MultisigSession{
+		ID:                id,
+		TxID:              ms.txn.ID().String(),
+		Address:           basics.Address(ms.addr).String(),
+		Version:           ms.msig.Version,
+		Threshold:         ms.msig.Threshold,
+		SignedTransaction: protocol.Encode(ms.signedTxn()),
+		ExpiresSeconds:    int64(time.Until(ms.expires).Seconds()),
+	}
+	for _, subsig := range ms.msig.Subsigs {
+		if (subsig.Sig == crypto.Signature{}) {
+			info.Missing = append(info.Missing, subsig.Key)
+		} else {
+			info.Signed = append(info.Signed, subsig.Key)
+		}
+	}
+	info.Complete = len(info.Signed) >= int(ms.msig.Threshold)
+	return info
+}
+
+// merge adds the signatures of partial to the session, after checking them
+func (ms *multisigSession) merge(partial crypto.MultisigSig) error {
+	for _, subsig := range partial.Subsigs {
+		if (subsig.Sig != crypto.Signature{}) && !crypto.SignatureVerifier(subsig.Key).Verify(ms.txn, subsig.Sig) {
+			return errMultisigSessionSig
+		}
+	}
+
+	merged, err := crypto.MultisigMerge(ms.msig, partial)
+	if err != nil {
+		return err
+	}
+	ms.msig = merged
+	return nil
+}
+
+// generateMultisigSessionID returns a random session ID, as long as a wallet
+// handle secret since it is enough to access the session with any wallet handle
+func generateMultisigSessionID() (string, error) {
+	_, secret, err := generateHandleIDAndSecret()
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// CreateMultisigSession starts collecting the signatures of the transaction
+// by the multisig account with the given preimage. When the multisig account
+// is not the sender, the sender is expected to be rekeyed to it.
+func (sm *Manager) CreateMultisigSession(txn transactions.Transaction, version, threshold uint8, pks []crypto.PublicKey) (kmdapi.APIV1MultisigSession, error) {
+	addr, err := crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return kmdapi.APIV1MultisigSession{}, err
+	}
+
+	msig := crypto.MultisigSig{
+		Version:   version,
+		Threshold: threshold,
+		Subsigs:   make([]crypto.MultisigSubsig, len(pks)),
+	}
+	for i, pk := range pks {
+		msig.Subsigs[i].Key = pk
+	}
+
+	return sm.addMultisigSession(&multisigSession{txn: txn, addr: addr, msig: msig})
+}
+
+func (sm *Manager) addMultisigSession(ms *multisigSession) (kmdapi.APIV1MultisigSession, error) {
+	id, err := generateMultisigSessionID()
+	if err != nil {
+		return kmdapi.APIV1MultisigSession{}, err
+	}
+	ms.expires = time.Now().Add(sm.multisigSessionLifetime)
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+	sm.multisigSessions[id] = ms
+	return ms.info(id), nil
+}
+
+// getMultisigSessionLocked looks up a multisig session which has not expired
+func (sm *Manager) getMultisigSessionLocked(id string) (*multisigSession, error) {
+	ms, ok := sm.multisigSessions[id]
+	if !ok {
+		return nil, errMultisigSessionNotFound
+	}
+	if checkHandleNotExpired(ms.expires) != nil {
+		delete(sm.multisigSessions, id)
+		return nil, errMultisigSessionNotFound
+	}
+	return ms, nil
+}
+
+// MultisigSession returns the state of a multisig session
+func (sm *Manager) MultisigSession(id string) (kmdapi.APIV1MultisigSession, error) {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	ms, err := sm.getMultisigSessionLocked(id)
+	if err != nil {
+		return kmdapi.APIV1MultisigSession{}, err
+	}
+	return ms.info(id), nil
+}
+
+// MultisigSessionPartial returns the transaction of a multisig session, the
+// signatures collected so far, and the multisig address, to be passed to
+// wallet.MultisigSignTransaction
+func (sm *Manager) MultisigSessionPartial(id string) (transactions.Transaction, crypto.MultisigSig, crypto.Digest, error) {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	ms, err := sm.getMultisigSessionLocked(id)
+	if err != nil {
+		return transactions.Transaction{}, crypto.MultisigSig{}, crypto.Digest{}, err
+	}
+
+	// copy the subsignatures, so that the signer cannot modify the session
+	partial := ms.msig
+	partial.Subsigs = append([]crypto.MultisigSubsig(nil), ms.msig.Subsigs...)
+	return ms.txn, partial, ms.addr, nil
+}
+
+// MergeMultisigSession adds the signatures of partial to a multisig session
+func (sm *Manager) MergeMultisigSession(id string, partial crypto.MultisigSig) (kmdapi.APIV1MultisigSession, error) {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	ms, err := sm.getMultisigSessionLocked(id)
+	if err != nil {
+		return kmdapi.APIV1MultisigSession{}, err
+	}
+	err = ms.merge(partial)
+	if err != nil {
+		return kmdapi.APIV1MultisigSession{}, err
+	}
+	return ms.info(id), nil
+}
+
+// ImportMultisigSession adds the signatures of a partially signed transaction
+// to the multisig session with the given ID, or to a new session if id is empty
+func (sm *Manager) ImportMultisigSession(id string, stxn transactions.SignedTxn) (kmdapi.APIV1MultisigSession, error) {
+	if stxn.Msig.Blank() {
+		return kmdapi.APIV1MultisigSession{}, errMultisigSessionNoMsig
+	}
+
+	if id != "" {
+		sm.mux.Lock()
+		defer sm.mux.Unlock()
+
+		ms, err := sm.getMultisigSessionLocked(id)
+		if err != nil {
+			return kmdapi.APIV1MultisigSession{}, err
+		}
+		if ms.txn.ID() != stxn.Txn.ID() {
+			return kmdapi.APIV1MultisigSession{}, errMultisigSessionTxn
+		}
+		err = ms.merge(stxn.Msig)
+		if err != nil {
+			return kmdapi.APIV1MultisigSession{}, err
+		}
+		return ms.info(id), nil
+	}
+
+	addr, err := crypto.MultisigAddrGenWithSubsigs(stxn.Msig.Version, stxn.Msig.Threshold, stxn.Msig.Subsigs)
+	if err != nil {
+		return kmdapi.APIV1MultisigSession{}, err
+	}
+	if addr != crypto.Digest(stxn.Txn.Sender) && addr != crypto.Digest(stxn.AuthAddr) {
+		return kmdapi.APIV1MultisigSession{}, errMultisigSessionAddr
+	}
+
+	version, threshold, pks := stxn.Msig.Preimage()
+	ms := &multisigSession{txn: stxn.Txn, addr: addr}
+	ms.msig = crypto.MultisigSig{Version: version, Threshold: threshold, Subsigs: make([]crypto.MultisigSubsig, len(pks))}
+	for i, pk := range pks {
+		ms.msig.Subsigs[i].Key = pk
+	}
+	err = ms.merge(stxn.Msig)
+	if err != nil {
+		return kmdapi.APIV1MultisigSession{}, err
+	}
+	return sm.addMultisigSession(ms)
+}
+
+// DeleteMultisigSession deletes a multisig session
+func (sm *Manager) DeleteMultisigSession(id string) error {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	_, err := sm.getMultisigSessionLocked(id)
+	if err != nil {
+		return err
+	}
+	delete(sm.multisigSessions, id)
+	return nil
+}
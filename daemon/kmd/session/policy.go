@@ -0,0 +1,193 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var errPolicyPeriod = fmt.Errorf("policy with a maximum amount requires a period")
+var errPolicyTxType = fmt.Errorf("transaction type not allowed by the wallet handle policy")
+var errPolicyReceiver = fmt.Errorf("receiver not allowed by the wallet handle policy")
+var errPolicyAmount = fmt.Errorf("amount exceeds the limit of the wallet handle policy")
+var errPolicyCloseTo = fmt.Errorf("closing an account is not allowed by a wallet handle policy with a maximum amount")
+var errPolicyConfirmation = fmt.Errorf("key registration requires a valid confirmation code")
+var errPolicyProgram = fmt.Errorf("signing programs is not allowed by a restrictive wallet handle policy")
+var errPolicyExport = fmt.Errorf("exporting keys is not allowed by a restrictive wallet handle policy")
+var errExportConfirmation = fmt.Errorf("exporting keys requires a valid confirmation code")
+
+// spend is an amount authorized by a policy at some time
+type spend struct {
+	at     time.Time
+	amount uint64
+}
+
+// spendWindow holds the amounts authorized within the period of a policy
+type spendWindow struct {
+	spends []spend
+}
+
+// total forgets the spends which are out of the period, and returns the sum of the others
+func (w *spendWindow) total(period time.Duration, now time.Time) uint64 {
+	kept := w.spends[:0]
+	total := uint64(0)
+	for _, s := range w.spends {
+		if now.Sub(s.at) < period {
+			kept = append(kept, s)
+			total += s.amount
+		}
+	}
+	w.spends = kept
+	return total
+}
+
+// policy enforces a kmdapi.APIV1WalletPolicy in the signing path. Amounts
+// are counted when a transaction is authorized, whether or not it is then
+// signed and sent. The amounts limited by the policy configured for a wallet
+// are counted in a window shared by all the handles of the wallet, held by
+// the Manager, while the ones limited by the policy requested for a handle
+// are counted per handle.
+//
+// The limited amount is in microalgos: the fees of all the transactions and
+// the amounts of the payments count toward it. The amounts of asset transfers
+// are in units of their asset and are not counted; the assets a handle may
+// send are restricted with the allowed receivers and transaction types instead.
+type policy struct {
+	maxAmount          uint64
+	period             time.Duration
+	receivers          map[basics.Address]bool
+	txTypes            map[protocol.TxType]bool
+	keyregConfirmation bool
+
+	spends *spendWindow
+}
+
+func makePolicy(p kmdapi.APIV1WalletPolicy) (*policy, error) {
+	if p.MaxAmount != 0 && p.PeriodSecs == 0 {
+		return nil, errPolicyPeriod
+	}
+
+	pol := &policy{
+		maxAmount:          p.MaxAmount,
+		period:             time.Duration(p.PeriodSecs) * time.Second,
+		keyregConfirmation: p.RequireKeyregConfirmation,
+		spends:             &spendWindow{},
+	}
+	if len(p.AllowedReceivers) > 0 {
+		pol.receivers = make(map[basics.Address]bool, len(p.AllowedReceivers))
+		for _, receiver := range p.AllowedReceivers {
+			addr, err := basics.UnmarshalChecksumAddress(receiver)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed receiver %s: %w", receiver, err)
+			}
+			pol.receivers[addr] = true
+		}
+	}
+	if len(p.AllowedTransactions) > 0 {
+		pol.txTypes = make(map[protocol.TxType]bool, len(p.AllowedTransactions))
+		for _, txType := range p.AllowedTransactions {
+			pol.txTypes[txType] = true
+		}
+	}
+	return pol, nil
+}
+
+// restrictive returns true if the policy restricts what can be signed
+func (p *policy) restrictive() bool {
+	return p.maxAmount != 0 || p.receivers != nil || p.txTypes != nil || p.keyregConfirmation
+}
+
+// receivers returns the addresses a transaction moves funds or control to
+func receivers(tx transactions.Transaction) []basics.Address {
+	var addrs []basics.Address
+	switch tx.Type {
+	case protocol.PaymentTx:
+		addrs = append(addrs, tx.Receiver, tx.CloseRemainderTo)
+	case protocol.AssetTransferTx:
+		addrs = append(addrs, tx.AssetReceiver, tx.AssetCloseTo)
+	}
+	addrs = append(addrs, tx.RekeyTo)
+
+	nonZero := addrs[:0]
+	for _, addr := range addrs {
+		if !addr.IsZero() {
+			nonZero = append(nonZero, addr)
+		}
+	}
+	return nonZero
+}
+
+// spentAmount returns the microalgos the transaction spends toward the limit of a policy
+func spentAmount(tx transactions.Transaction) (uint64, error) {
+	amount := tx.Fee.Raw
+	if tx.Type == protocol.PaymentTx {
+		if tx.Amount.Raw > math.MaxUint64-amount {
+			return 0, errPolicyAmount
+		}
+		amount += tx.Amount.Raw
+	}
+	return amount, nil
+}
+
+// check checks the transaction against the policy, and returns the amount it
+// counts toward the limit. confirmed is true if a valid second factor code was given.
+func (p *policy) check(tx transactions.Transaction, confirmed bool, now time.Time) (uint64, error) {
+	if p.txTypes != nil && !p.txTypes[tx.Type] {
+		return 0, errPolicyTxType
+	}
+	if p.receivers != nil {
+		for _, addr := range receivers(tx) {
+			if !p.receivers[addr] {
+				return 0, errPolicyReceiver
+			}
+		}
+	}
+	if p.keyregConfirmation && tx.Type == protocol.KeyRegistrationTx && !confirmed {
+		return 0, errPolicyConfirmation
+	}
+
+	if p.maxAmount == 0 {
+		return 0, nil
+	}
+	if !tx.CloseRemainderTo.IsZero() {
+		return 0, errPolicyCloseTo
+	}
+
+	amount, err := spentAmount(tx)
+	if err != nil {
+		return 0, err
+	}
+	total := p.spends.total(p.period, now)
+	if amount > p.maxAmount || total > p.maxAmount-amount {
+		return 0, errPolicyAmount
+	}
+	return amount, nil
+}
+
+// record counts an amount returned by check toward the limit of the policy
+func (p *policy) record(amount uint64, now time.Time) {
+	if amount != 0 {
+		p.spends.spends = append(p.spends.spends, spend{at: now, amount: amount})
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func payment(receiver basics.Address, amount, fee uint64) transactions.Transaction {
+	return transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Fee: basics.MicroAlgos{Raw: fee}},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+// authorize checks the transaction against the policy and counts it, as the Manager does
+func (p *policy) authorize(tx transactions.Transaction, confirmed bool, now time.Time) error {
+	amount, err := p.check(tx, confirmed, now)
+	if err != nil {
+		return err
+	}
+	p.record(amount, now)
+	return nil
+}
+
+func TestPolicyMaxAmount(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, err := makePolicy(kmdapi.APIV1WalletPolicy{MaxAmount: 1000})
+	require.ErrorIs(t, err, errPolicyPeriod)
+
+	p, err := makePolicy(kmdapi.APIV1WalletPolicy{MaxAmount: 1000, PeriodSecs: 3600})
+	require.NoError(t, err)
+	require.True(t, p.restrictive())
+
+	now := time.Now()
+	receiver := basics.Address{1}
+	require.NoError(t, p.authorize(payment(receiver, 500, 100), false, now))
+	require.NoError(t, p.authorize(payment(receiver, 300, 100), false, now.Add(time.Minute)))
+
+	// the fees count toward the limit, whatever the transaction type
+	keyreg := transactions.Transaction{Type: protocol.KeyRegistrationTx, Header: transactions.Header{Fee: basics.MicroAlgos{Raw: 1}}}
+	require.ErrorIs(t, p.authorize(keyreg, false, now.Add(time.Minute)), errPolicyAmount)
+	require.ErrorIs(t, p.authorize(payment(receiver, 1001, 0), false, now.Add(2*time.Hour)), errPolicyAmount)
+
+	// the asset amounts do not count, their fees do
+	axfer := transactions.Transaction{
+		Type:                   protocol.AssetTransferTx,
+		Header:                 transactions.Header{Fee: basics.MicroAlgos{Raw: 1000}},
+		AssetTransferTxnFields: transactions.AssetTransferTxnFields{AssetAmount: 1 << 40, AssetReceiver: receiver},
+	}
+	require.NoError(t, p.authorize(axfer, false, now.Add(time.Hour)))
+	require.ErrorIs(t, p.authorize(axfer, false, now.Add(time.Hour+time.Second)), errPolicyAmount)
+
+	closing := payment(receiver, 0, 0)
+	closing.CloseRemainderTo = receiver
+	require.ErrorIs(t, p.authorize(closing, false, now.Add(3*time.Hour)), errPolicyCloseTo)
+
+	// overflowing amounts are refused
+	require.ErrorIs(t, p.authorize(payment(receiver, ^uint64(0), 1), false, now.Add(3*time.Hour)), errPolicyAmount)
+}
+
+func TestPolicyRestrictions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	allowed := basics.Address{1}
+	p, err := makePolicy(kmdapi.APIV1WalletPolicy{
+		AllowedReceivers:          []string{allowed.String()},
+		AllowedTransactions:       []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx},
+		RequireKeyregConfirmation: true,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	require.NoError(t, p.authorize(payment(allowed, 1, 1), false, now))
+	require.ErrorIs(t, p.authorize(payment(basics.Address{2}, 1, 1), false, now), errPolicyReceiver)
+
+	rekey := payment(allowed, 1, 1)
+	rekey.RekeyTo = basics.Address{2}
+	require.ErrorIs(t, p.authorize(rekey, false, now), errPolicyReceiver)
+
+	require.ErrorIs(t, p.authorize(transactions.Transaction{Type: protocol.ApplicationCallTx}, false, now), errPolicyTxType)
+
+	keyreg := transactions.Transaction{Type: protocol.KeyRegistrationTx}
+	require.ErrorIs(t, p.authorize(keyreg, false, now), errPolicyConfirmation)
+	require.NoError(t, p.authorize(keyreg, true, now))
+
+	_, err = makePolicy(kmdapi.APIV1WalletPolicy{AllowedReceivers: []string{"not an address"}})
+	require.Error(t, err)
+	p, err = makePolicy(kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	require.False(t, p.restrictive())
+}
+
+// testWallet is a wallet with an ID, whose password is always right
+type testWallet struct {
+	wallet.Wallet
+	id []byte
+}
+
+func (w testWallet) Init(pw []byte) error {
+	return nil
+}
+
+func (w testWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{ID: w.id}, nil
+}
+
+func TestManagerWalletPolicySharedByHandles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := testWallet{id: []byte("wallet-id")}
+	other := testWallet{id: []byte("other-wallet-id")}
+	sm, err := MakeManager(config.KMDConfig{
+		SessionLifetimeSecs: 60,
+		Policies: map[string]kmdapi.APIV1WalletPolicy{
+			string(w.id):     {MaxAmount: 1000, PeriodSecs: 3600},
+			string(other.id): {MaxAmount: 1000, PeriodSecs: 3600},
+		},
+	})
+	require.NoError(t, err)
+	defer sm.Kill()
+
+	receiver := basics.Address{1}
+	handle1, err := sm.InitWalletHandle(w, nil, kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	require.NoError(t, sm.AuthorizeTransaction(handle1, payment(receiver, 600, 0), ""))
+
+	// a new handle of the wallet does not reset the amounts spent
+	handle2, err := sm.InitWalletHandle(w, nil, kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	require.ErrorIs(t, sm.AuthorizeTransaction(handle2, payment(receiver, 600, 0), ""), errPolicyAmount)
+	require.NoError(t, sm.AuthorizeTransaction(handle2, payment(receiver, 400, 0), ""))
+	require.ErrorIs(t, sm.AuthorizeTransaction(handle1, payment(receiver, 1, 0), ""), errPolicyAmount)
+
+	// the amounts are counted per wallet
+	handle3, err := sm.InitWalletHandle(other, nil, kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	require.NoError(t, sm.AuthorizeTransaction(handle3, payment(receiver, 1000, 0), ""))
+
+	// a transaction refused by the policy requested for the handle is not counted by the wallet policy
+	handle4, err := sm.InitWalletHandle(other, nil, kmdapi.APIV1WalletPolicy{AllowedTransactions: []protocol.TxType{protocol.KeyRegistrationTx}})
+	require.NoError(t, err)
+	require.ErrorIs(t, sm.AuthorizeTransaction(handle4, payment(receiver, 0, 0), ""), errPolicyTxType)
+
+	// programs cannot be signed with a restricted handle
+	require.ErrorIs(t, sm.AuthorizeProgram(handle1), errPolicyProgram)
+}
+
+func TestManagerPoliciesCountTogether(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := testWallet{id: []byte("wallet-id")}
+	sm, err := MakeManager(config.KMDConfig{
+		SessionLifetimeSecs: 60,
+		Policies: map[string]kmdapi.APIV1WalletPolicy{
+			string(w.id): {MaxAmount: 1000, PeriodSecs: 3600},
+		},
+	})
+	require.NoError(t, err)
+	defer sm.Kill()
+
+	receiver := basics.Address{1}
+	handle, err := sm.InitWalletHandle(w, nil, kmdapi.APIV1WalletPolicy{MaxAmount: 500, PeriodSecs: 3600})
+	require.NoError(t, err)
+
+	// refused by the handle policy: not counted by the wallet policy either
+	require.ErrorIs(t, sm.AuthorizeTransaction(handle, payment(receiver, 800, 0), ""), errPolicyAmount)
+	other, err := sm.InitWalletHandle(w, nil, kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	require.NoError(t, sm.AuthorizeTransaction(other, payment(receiver, 1000, 0), ""))
+}
+
+func TestManagerAuthorizeExport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := testWallet{id: []byte("wallet-id")}
+	sm, err := MakeManager(config.KMDConfig{
+		SessionLifetimeSecs:    60,
+		ConfirmationTOTPSecret: base32.StdEncoding.EncodeToString(rfc6238Secret),
+	})
+	require.NoError(t, err)
+	defer sm.Kill()
+
+	handle, err := sm.InitWalletHandle(w, nil, kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	restricted, err := sm.InitWalletHandle(w, nil, kmdapi.APIV1WalletPolicy{AllowedTransactions: []protocol.TxType{protocol.PaymentTx}})
+	require.NoError(t, err)
+
+	// every export needs a confirmation code, which cannot be replayed
+	step := uint64(time.Now().Unix()) / uint64(totpStep/time.Second)
+	code := totpCode(rfc6238Secret, step)
+	require.ErrorIs(t, sm.AuthorizeExport(handle, ""), errExportConfirmation)
+	require.ErrorIs(t, sm.AuthorizeExport(handle, totpCode(rfc6238Secret, step+10)), errExportConfirmation)
+	require.NoError(t, sm.AuthorizeExport(handle, code))
+	require.ErrorIs(t, sm.AuthorizeExport(handle, code), errExportConfirmation)
+
+	// nothing can be exported with a restricted handle, even with a code
+	require.ErrorIs(t, sm.AuthorizeExport(restricted, code), errPolicyExport)
+
+	// without a second factor configured, unrestricted handles need no code
+	other, err := MakeManager(config.KMDConfig{SessionLifetimeSecs: 60})
+	require.NoError(t, err)
+	defer other.Kill()
+	handle, err = other.InitWalletHandle(w, nil, kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	require.NoError(t, other.AuthorizeExport(handle, ""))
+}
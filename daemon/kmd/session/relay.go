@@ -0,0 +1,639 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	// RelayURIScheme is the scheme of the pairing URIs of the signing relay
+	RelayURIScheme = "algorand-kmd-relay"
+	// relayMaxWait bounds the long polls of the signing relay
+	relayMaxWait = 60 * time.Second
+	// relayClockSkew is how far the timestamps of the polls of the clients
+	// may be from the kmd clock
+	relayClockSkew = 5 * time.Minute
+
+	relayStatusPending  = "pending"
+	relayStatusSigning  = "signing"
+	relayStatusSigned   = "signed"
+	relayStatusRejected = "rejected"
+	relayStatusFailed   = "failed"
+)
+
+var errRelayDisabled = fmt.Errorf("signing relay is not enabled")
+var errRelayPairingNotFound = fmt.Errorf("relay pairing does not exist")
+var errRelayPairingWallet = fmt.Errorf("relay pairing belongs to another wallet")
+var errRelayPaired = fmt.Errorf("relay pairing is already paired with a client")
+var errRelayNotPaired = fmt.Errorf("relay pairing is not paired with a client yet")
+var errRelayProof = fmt.Errorf("invalid relay pairing proof")
+var errRelaySignature = fmt.Errorf("invalid relay client signature")
+var errRelayTimestamp = fmt.Errorf("relay poll timestamp is too far from the kmd clock")
+var errRelayRequestNotFound = fmt.Errorf("relay signing request does not exist")
+var errRelayRequestAnswered = fmt.Errorf("relay signing request was already answered")
+
+// relayPairing pairs a wallet with an approving client, which approves the
+// signing requests pushed to it. The pairing keeps the wallet and its
+// password in memory, to sign the approved requests, until it expires or the
+// wallet handle it was created with is released or expires.
+type relayPairing struct {
+	walletID string
+	handleID string
+	wallet   wallet.Wallet
+	pw       []byte
+	// policies are the policies of the wallet handle the pairing was
+	// created with, which restrict the approved transactions as well
+	policies []*policy
+	// secret is the single-use pairing secret of the pairing URI
+	secret    []byte
+	clientKey crypto.PublicKey
+	paired    bool
+	expires   time.Time
+}
+
+// relayRequest is a signing request pushed to the client of a pairing
+type relayRequest struct {
+	pairingID string
+	walletID  string
+	txn       transactions.Transaction
+	message   string
+	status    string
+	err       string
+	signed    []byte
+	created   time.Time
+	expires   time.Time
+}
+
+func (rr *relayRequest) info(id string) kmdapi.APIV1RelayRequest {
+	return kmdapi.APIV1RelayRequest{
+		ID:                id,
+		PairingID:         rr.pairingID,
+		TxID:              rr.txn.ID().String(),
+		Transaction:       protocol.Encode(&rr.txn),
+		Message:           rr.message,
+		Status:            rr.status,
+		Error:             rr.err,
+		SignedTransaction: rr.signed,
+		ExpiresSeconds:    int64(time.Until(rr.expires).Seconds()),
+	}
+}
+
+// relayPollMessage is the message signed by the client to poll the requests
+// of a pairing
+func relayPollMessage(pairingID string, timestamp int64) []byte {
+	return []byte(fmt.Sprintf("kmd-relay/poll/%s/%d", pairingID, timestamp))
+}
+
+// relayAnswerMessage is the message signed by the client to approve or
+// reject a request
+func relayAnswerMessage(pairingID, requestID string, txid transactions.Txid, approve bool) []byte {
+	answer := "reject"
+	if approve {
+		answer = "approve"
+	}
+	return []byte(fmt.Sprintf("kmd-relay/%s/%s/%s/%s", answer, pairingID, requestID, txid.String()))
+}
+
+// relayPairingProof is the proof of the knowledge of the pairing secret
+// sent by the client along with its session key
+func relayPairingProof(secret []byte, clientKey crypto.PublicKey) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(clientKey[:])
+	return mac.Sum(nil)
+}
+
+// notifyRelayLocked wakes up the long polls of the relay
+func (sm *Manager) notifyRelayLocked() {
+	close(sm.relayChanged)
+	sm.relayChanged = make(chan struct{})
+}
+
+// waitRelay calls ready with the lock held until it returns true or an
+// error, or the wait of waitSeconds is over, ready being called once more
+// after the wait. The wait is bounded by relayMaxWait.
+func (sm *Manager) waitRelay(waitSeconds uint64, ready func() (bool, error)) error {
+	wait := relayMaxWait
+	if waitSeconds < uint64(relayMaxWait/time.Second) {
+		wait = time.Duration(waitSeconds) * time.Second
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	over := false
+	for {
+		sm.mux.Lock()
+		done, err := ready()
+		changed := sm.relayChanged
+		sm.mux.Unlock()
+		if done || err != nil || over {
+			return err
+		}
+
+		select {
+		case <-changed:
+		case <-timer.C:
+			over = true
+		case <-sm.ctx.Done():
+			over = true
+		}
+	}
+}
+
+// relayPairingExpiredLocked returns whether the pairing expired, or the wallet
+// handle it was created with was released or expired
+func (sm *Manager) relayPairingExpiredLocked(rp *relayPairing) bool {
+	if checkHandleNotExpired(rp.expires) != nil {
+		return true
+	}
+	handle, ok := sm.walletHandles[rp.handleID]
+	return !ok || checkHandleNotExpired(handle.expires) != nil
+}
+
+func (sm *Manager) getRelayPairingLocked(id string) (*relayPairing, error) {
+	rp, ok := sm.relayPairings[id]
+	if !ok {
+		return nil, errRelayPairingNotFound
+	}
+	if sm.relayPairingExpiredLocked(rp) {
+		sm.deleteRelayPairingLocked(id)
+		return nil, errRelayPairingNotFound
+	}
+	return rp, nil
+}
+
+// walletIDLocked returns the ID of the wallet of the wallet handle token
+func (sm *Manager) walletIDLocked(walletHandleToken []byte) (string, error) {
+	handle, err := sm.authValidHandleLocked(walletHandleToken)
+	if err != nil {
+		return "", err
+	}
+	metadata, err := handle.wallet.Metadata()
+	if err != nil {
+		return "", err
+	}
+	return string(metadata.ID), nil
+}
+
+// getWalletRelayPairingLocked returns the pairing if it belongs to the
+// wallet of the wallet handle token
+func (sm *Manager) getWalletRelayPairingLocked(walletHandleToken []byte, id string) (*relayPairing, error) {
+	walletID, err := sm.walletIDLocked(walletHandleToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rp, err := sm.getRelayPairingLocked(id)
+	if err != nil {
+		return nil, err
+	}
+	if rp.walletID != walletID {
+		return nil, errRelayPairingWallet
+	}
+	return rp, nil
+}
+
+// deleteRelayPairingLocked deletes the pairing, failing its pending requests
+func (sm *Manager) deleteRelayPairingLocked(id string) {
+	delete(sm.relayPairings, id)
+	for _, rr := range sm.relayRequests {
+		if rr.pairingID == id && rr.status == relayStatusPending {
+			rr.status = relayStatusFailed
+			rr.err = errRelayPairingNotFound.Error()
+		}
+	}
+	sm.notifyRelayLocked()
+}
+
+// deleteHandleRelayPairingsLocked deletes the pairings created with the
+// wallet handle
+func (sm *Manager) deleteHandleRelayPairingsLocked(handleID string) {
+	for id, rp := range sm.relayPairings {
+		if rp.handleID == handleID {
+			sm.deleteRelayPairingLocked(id)
+		}
+	}
+}
+
+// RevokeRelayPairings deletes the pairings of the wallet, failing their
+// pending requests. It is called when the wallet is renamed or its password
+// changes, since the pairings keep the wallet and its password.
+func (sm *Manager) RevokeRelayPairings(walletID []byte) {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	for id, rp := range sm.relayPairings {
+		if rp.walletID == string(walletID) {
+			sm.deleteRelayPairingLocked(id)
+		}
+	}
+}
+
+func (sm *Manager) relayPairingInfoLocked(id string, rp *relayPairing) kmdapi.APIV1RelayPairing {
+	info := kmdapi.APIV1RelayPairing{
+		ID:              id,
+		WalletID:        rp.walletID,
+		Paired:          rp.paired,
+		ClientPublicKey: rp.clientKey,
+		ExpiresSeconds:  int64(time.Until(rp.expires).Seconds()),
+	}
+	for _, rr := range sm.relayRequests {
+		if rr.pairingID == id && rr.status == relayStatusPending {
+			info.PendingRequests++
+		}
+	}
+	return info
+}
+
+// CreateRelayPairing creates a pairing of the wallet of the wallet handle
+// token, and returns it with its pairing URI. The URI holds the pairing
+// secret, proving to kmd that the client pairing with it was given the URI.
+// The pairing lasts no longer than the wallet handle: it is deleted when the
+// handle is released or expires.
+func (sm *Manager) CreateRelayPairing(walletHandleToken, pw []byte) (kmdapi.APIV1RelayPairing, string, error) {
+	if !sm.relayEnabled {
+		return kmdapi.APIV1RelayPairing{}, "", errRelayDisabled
+	}
+
+	sm.mux.Lock()
+	handleID, handle, err := sm.getHandleFromTokenLocked(walletHandleToken)
+	if err == nil {
+		err = checkHandleNotExpired(handle.expires)
+	}
+	sm.mux.Unlock()
+	if err != nil {
+		return kmdapi.APIV1RelayPairing{}, "", err
+	}
+
+	// The password is kept to sign the approved requests, check it now
+	err = handle.wallet.CheckPassword(pw)
+	if err != nil {
+		return kmdapi.APIV1RelayPairing{}, "", err
+	}
+	metadata, err := handle.wallet.Metadata()
+	if err != nil {
+		return kmdapi.APIV1RelayPairing{}, "", err
+	}
+
+	id, _, err := generateHandleIDAndSecret()
+	if err != nil {
+		return kmdapi.APIV1RelayPairing{}, "", err
+	}
+	secret := make([]byte, wHandleSecretBytes)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return kmdapi.APIV1RelayPairing{}, "", err
+	}
+
+	rp := &relayPairing{
+		walletID: string(metadata.ID),
+		handleID: string(handleID),
+		wallet:   handle.wallet,
+		pw:       pw,
+		policies: handle.policies,
+		secret:   secret,
+		expires:  time.Now().Add(sm.relayPairingLifetime),
+	}
+
+	query := url.Values{}
+	query.Set("secret", hex.EncodeToString(secret))
+	if sm.relayPublicURL != "" {
+		query.Set("relay", sm.relayPublicURL)
+	}
+	uri := fmt.Sprintf("%s:%s?%s", RelayURIScheme, id, query.Encode())
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+	// The handle may have been released while the password was checked
+	if sm.relayPairingExpiredLocked(rp) {
+		return kmdapi.APIV1RelayPairing{}, "", errRelayPairingNotFound
+	}
+	sm.relayPairings[string(id)] = rp
+	return sm.relayPairingInfoLocked(string(id), rp), uri, nil
+}
+
+// RelayPairing returns the pairing, if it belongs to the wallet of the wallet
+// handle token
+func (sm *Manager) RelayPairing(walletHandleToken []byte, id string) (kmdapi.APIV1RelayPairing, error) {
+	if !sm.relayEnabled {
+		return kmdapi.APIV1RelayPairing{}, errRelayDisabled
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	rp, err := sm.getWalletRelayPairingLocked(walletHandleToken, id)
+	if err != nil {
+		return kmdapi.APIV1RelayPairing{}, err
+	}
+	return sm.relayPairingInfoLocked(id, rp), nil
+}
+
+// DeleteRelayPairing deletes the pairing, if it belongs to the wallet of the
+// wallet handle token. Its pending requests fail.
+func (sm *Manager) DeleteRelayPairing(walletHandleToken []byte, id string) error {
+	if !sm.relayEnabled {
+		return errRelayDisabled
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	_, err := sm.getWalletRelayPairingLocked(walletHandleToken, id)
+	if err != nil {
+		return err
+	}
+	sm.deleteRelayPairingLocked(id)
+	return nil
+}
+
+// PairRelayClient completes the pairing with the session key of the client,
+// given the proof that the client knows the pairing secret. A pairing is
+// only paired once.
+func (sm *Manager) PairRelayClient(id string, clientKey crypto.PublicKey, proof []byte) (kmdapi.APIV1RelayPairing, error) {
+	if !sm.relayEnabled {
+		return kmdapi.APIV1RelayPairing{}, errRelayDisabled
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	rp, err := sm.getRelayPairingLocked(id)
+	if err != nil {
+		return kmdapi.APIV1RelayPairing{}, err
+	}
+	if rp.paired {
+		return kmdapi.APIV1RelayPairing{}, errRelayPaired
+	}
+	if !hmac.Equal(proof, relayPairingProof(rp.secret, clientKey)) {
+		return kmdapi.APIV1RelayPairing{}, errRelayProof
+	}
+
+	rp.clientKey = clientKey
+	rp.paired = true
+	rp.secret = nil
+	return sm.relayPairingInfoLocked(id, rp), nil
+}
+
+// SubmitRelayRequest pushes a request to sign the transaction to the client
+// of the pairing, if it belongs to the wallet of the wallet handle token
+func (sm *Manager) SubmitRelayRequest(walletHandleToken []byte, pairingID string, txn transactions.Transaction, message string) (kmdapi.APIV1RelayRequest, error) {
+	if !sm.relayEnabled {
+		return kmdapi.APIV1RelayRequest{}, errRelayDisabled
+	}
+
+	id, _, err := generateHandleIDAndSecret()
+	if err != nil {
+		return kmdapi.APIV1RelayRequest{}, err
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	rp, err := sm.getWalletRelayPairingLocked(walletHandleToken, pairingID)
+	if err != nil {
+		return kmdapi.APIV1RelayRequest{}, err
+	}
+	if !rp.paired {
+		return kmdapi.APIV1RelayRequest{}, errRelayNotPaired
+	}
+
+	now := time.Now()
+	rr := &relayRequest{
+		pairingID: pairingID,
+		walletID:  rp.walletID,
+		txn:       txn,
+		message:   message,
+		status:    relayStatusPending,
+		created:   now,
+		expires:   now.Add(sm.relayRequestLifetime),
+	}
+	sm.relayRequests[string(id)] = rr
+	sm.notifyRelayLocked()
+	return rr.info(string(id)), nil
+}
+
+func (sm *Manager) getRelayRequestLocked(id string) (*relayRequest, error) {
+	rr, ok := sm.relayRequests[id]
+	if !ok {
+		return nil, errRelayRequestNotFound
+	}
+	if checkHandleNotExpired(rr.expires) != nil {
+		delete(sm.relayRequests, id)
+		return nil, errRelayRequestNotFound
+	}
+	return rr, nil
+}
+
+// RelayRequest returns the request, if it was pushed for the wallet of the
+// wallet handle token, waiting up to waitSeconds for the client to answer it
+// while it is pending
+func (sm *Manager) RelayRequest(walletHandleToken []byte, id string, waitSeconds uint64) (info kmdapi.APIV1RelayRequest, err error) {
+	if !sm.relayEnabled {
+		return kmdapi.APIV1RelayRequest{}, errRelayDisabled
+	}
+
+	sm.mux.Lock()
+	walletID, err := sm.walletIDLocked(walletHandleToken)
+	sm.mux.Unlock()
+	if err != nil {
+		return kmdapi.APIV1RelayRequest{}, err
+	}
+
+	err = sm.waitRelay(waitSeconds, func() (bool, error) {
+		rr, err := sm.getRelayRequestLocked(id)
+		if err != nil {
+			return false, err
+		}
+		if rr.walletID != walletID {
+			return false, errRelayRequestNotFound
+		}
+		info = rr.info(id)
+		return rr.status != relayStatusPending && rr.status != relayStatusSigning, nil
+	})
+	return
+}
+
+// PollRelayRequests returns the pending requests of the pairing, oldest
+// first, waiting up to waitSeconds for one when there are none. The poll is
+// signed by the session key of the client, at a time close to the kmd clock.
+func (sm *Manager) PollRelayRequests(pairingID string, timestamp int64, sig crypto.Signature, waitSeconds uint64) ([]kmdapi.APIV1RelayRequest, error) {
+	if !sm.relayEnabled {
+		return nil, errRelayDisabled
+	}
+
+	skew := time.Since(time.Unix(timestamp, 0))
+	if skew > relayClockSkew || skew < -relayClockSkew {
+		return nil, errRelayTimestamp
+	}
+
+	sm.mux.Lock()
+	rp, err := sm.getRelayPairingLocked(pairingID)
+	if err == nil && !rp.paired {
+		err = errRelayNotPaired
+	}
+	if err == nil && !crypto.SignatureVerifier(rp.clientKey).VerifyBytes(relayPollMessage(pairingID, timestamp), sig) {
+		err = errRelaySignature
+	}
+	sm.mux.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []kmdapi.APIV1RelayRequest
+	err = sm.waitRelay(waitSeconds, func() (bool, error) {
+		_, err := sm.getRelayPairingLocked(pairingID)
+		if err != nil {
+			return false, err
+		}
+
+		var requests []*relayRequest
+		ids := make(map[*relayRequest]string)
+		for id, rr := range sm.relayRequests {
+			if rr.pairingID == pairingID && rr.status == relayStatusPending && checkHandleNotExpired(rr.expires) == nil {
+				requests = append(requests, rr)
+				ids[rr] = id
+			}
+		}
+		sort.Slice(requests, func(i, j int) bool {
+			return requests[i].created.Before(requests[j].created)
+		})
+		pending = make([]kmdapi.APIV1RelayRequest, len(requests))
+		for i, rr := range requests {
+			pending[i] = rr.info(ids[rr])
+		}
+		return len(pending) > 0, nil
+	})
+	return pending, err
+}
+
+// AnswerRelayRequest approves or rejects a pending request of the pairing,
+// with the answer signed by the session key of the client. An approved
+// transaction is signed with the wallet of the pairing, if the policies of
+// the pairing allow it: the request fails otherwise. record is called with
+// the wallet and the transaction before it is signed, and the request fails
+// without the transaction being signed if it returns an error. The wallet is
+// returned when the transaction was signed, or failed to be.
+func (sm *Manager) AnswerRelayRequest(pairingID, requestID string, approve bool, sig crypto.Signature, confirmation string, record func(wallet.Wallet, transactions.Transaction) error) (kmdapi.APIV1RelayRequest, wallet.Wallet, error) {
+	if !sm.relayEnabled {
+		return kmdapi.APIV1RelayRequest{}, nil, errRelayDisabled
+	}
+
+	sm.mux.Lock()
+	rp, rr, err := sm.answerRelayRequestLocked(pairingID, requestID, approve, sig, confirmation)
+	if err != nil {
+		sm.mux.Unlock()
+		return kmdapi.APIV1RelayRequest{}, nil, err
+	}
+	info := rr.info(requestID)
+	txn := rr.txn
+	sm.mux.Unlock()
+	switch info.Status {
+	case relayStatusRejected:
+		return info, nil, nil
+	case relayStatusFailed:
+		// Refused by the policies of the pairing
+		return info, rp.wallet, nil
+	}
+
+	// The wallet may take a while to sign, e.g. on a hardware device
+	// The password kept by the pairing is checked again, in case it changed
+	var stx []byte
+	signErr := rp.wallet.CheckPassword(rp.pw)
+	if signErr == nil {
+		signErr = record(rp.wallet, txn)
+	}
+	if signErr == nil {
+		stx, signErr = rp.wallet.SignTransaction(txn, crypto.PublicKey{}, rp.pw)
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+	if signErr != nil {
+		rr.status = relayStatusFailed
+		rr.err = signErr.Error()
+	} else {
+		rr.status = relayStatusSigned
+		rr.signed = stx
+	}
+	sm.notifyRelayLocked()
+	return rr.info(requestID), rp.wallet, nil
+}
+
+// answerRelayRequestLocked checks the answer of the client, and records it.
+// The request is left signing when the approved transaction is to be signed.
+func (sm *Manager) answerRelayRequestLocked(pairingID, requestID string, approve bool, sig crypto.Signature, confirmation string) (*relayPairing, *relayRequest, error) {
+	rp, err := sm.getRelayPairingLocked(pairingID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rp.paired {
+		return nil, nil, errRelayNotPaired
+	}
+	rr, err := sm.getRelayRequestLocked(requestID)
+	if err != nil || rr.pairingID != pairingID {
+		return nil, nil, errRelayRequestNotFound
+	}
+	if !crypto.SignatureVerifier(rp.clientKey).VerifyBytes(relayAnswerMessage(pairingID, requestID, rr.txn.ID(), approve), sig) {
+		return nil, nil, errRelaySignature
+	}
+	if rr.status != relayStatusPending {
+		return nil, nil, errRelayRequestAnswered
+	}
+
+	defer sm.notifyRelayLocked()
+	if !approve {
+		rr.status = relayStatusRejected
+		return rp, rr, nil
+	}
+	err = sm.authorizeLocked(rp.policies, rr.txn, confirmation)
+	if err != nil {
+		rr.status = relayStatusFailed
+		rr.err = err.Error()
+		return rp, rr, nil
+	}
+	rr.status = relayStatusSigning
+	return rp, rr, nil
+}
+
+// deleteExpiredRelayLocked deletes the expired pairings, along with the ones
+// whose wallet handle expired, and the expired requests
+func (sm *Manager) deleteExpiredRelayLocked() {
+	for id, rp := range sm.relayPairings {
+		if sm.relayPairingExpiredLocked(rp) {
+			sm.deleteRelayPairingLocked(id)
+		}
+	}
+	for id, rr := range sm.relayRequests {
+		if checkHandleNotExpired(rr.expires) != nil {
+			delete(sm.relayRequests, id)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+var errTestWrongPassword = errors.New("wrong password")
+
+// relayTestWallet is a test wallet with a password, signing transactions
+// with their encoding
+type relayTestWallet struct {
+	testWallet
+	pw string
+}
+
+func (w relayTestWallet) CheckPassword(pw []byte) error {
+	if string(pw) != w.pw {
+		return errTestWrongPassword
+	}
+	return nil
+}
+
+func (w relayTestWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	err := w.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(tx.ID().String()), nil
+}
+
+func makeRelayTestManager(t *testing.T, policies map[string]kmdapi.APIV1WalletPolicy) *Manager {
+	cfg := config.KMDConfig{
+		SessionLifetimeSecs: 60,
+		Policies:            policies,
+	}
+	cfg.SigningRelay.Enable = true
+	cfg.SigningRelay.PublicURL = "https://kmd.example.com"
+	cfg.SigningRelay.PairingLifetimeSecs = 3600
+	cfg.SigningRelay.RequestLifetimeSecs = 60
+	sm, err := MakeManager(cfg)
+	require.NoError(t, err)
+	return sm
+}
+
+// pairRelayTestClient pairs a client with a new pairing of the wallet, as
+// the client does when it scans the pairing URI
+func pairRelayTestClient(t *testing.T, sm *Manager, handle []byte, pw string) (string, *crypto.SignatureSecrets) {
+	pairing, uri, err := sm.CreateRelayPairing(handle, []byte(pw))
+	require.NoError(t, err)
+	require.False(t, pairing.Paired)
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	require.Equal(t, RelayURIScheme, parsed.Scheme)
+	require.Equal(t, pairing.ID, parsed.Opaque)
+	require.Equal(t, "https://kmd.example.com", parsed.Query().Get("relay"))
+	secret, err := hex.DecodeString(parsed.Query().Get("secret"))
+	require.NoError(t, err)
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	client := crypto.GenerateSignatureSecrets(seed)
+
+	paired, err := sm.PairRelayClient(pairing.ID, client.SignatureVerifier, relayPairingProof(secret, client.SignatureVerifier))
+	require.NoError(t, err)
+	require.True(t, paired.Paired)
+	require.Equal(t, client.SignatureVerifier, paired.ClientPublicKey)
+	return pairing.ID, client
+}
+
+func pollRelayTestClient(t *testing.T, sm *Manager, pairingID string, client *crypto.SignatureSecrets) []kmdapi.APIV1RelayRequest {
+	now := time.Now().Unix()
+	requests, err := sm.PollRelayRequests(pairingID, now, client.SignBytes(relayPollMessage(pairingID, now)), 0)
+	require.NoError(t, err)
+	return requests
+}
+
+// recordRelayTestAnswer records nothing of the transactions to be signed
+func recordRelayTestAnswer(wallet.Wallet, transactions.Transaction) error {
+	return nil
+}
+
+// answerRelayTestClient answers the request, as the client does once its
+// user approved or rejected the transaction
+func answerRelayTestClient(sm *Manager, pairingID string, client *crypto.SignatureSecrets, request kmdapi.APIV1RelayRequest, approve bool) (kmdapi.APIV1RelayRequest, error) {
+	return answerRelayTestClientRecorded(sm, pairingID, client, request, approve, recordRelayTestAnswer)
+}
+
+func answerRelayTestClientRecorded(sm *Manager, pairingID string, client *crypto.SignatureSecrets, request kmdapi.APIV1RelayRequest, approve bool, record func(wallet.Wallet, transactions.Transaction) error) (kmdapi.APIV1RelayRequest, error) {
+	var tx transactions.Transaction
+	err := protocol.Decode(request.Transaction, &tx)
+	if err != nil {
+		return kmdapi.APIV1RelayRequest{}, err
+	}
+	info, _, err := sm.AnswerRelayRequest(pairingID, request.ID, approve, client.SignBytes(relayAnswerMessage(pairingID, request.ID, tx.ID(), approve)), "", record)
+	return info, err
+}
+
+func TestRelayPairing(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := relayTestWallet{testWallet: testWallet{id: []byte("wallet-id")}, pw: "pw"}
+	sm := makeRelayTestManager(t, nil)
+	defer sm.Kill()
+	handle, err := sm.InitWalletHandle(w, []byte("pw"), kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+
+	// the password kept by the pairing must be right
+	_, _, err = sm.CreateRelayPairing(handle, []byte("wrong"))
+	require.ErrorIs(t, err, errTestWrongPassword)
+
+	pairing, _, err := sm.CreateRelayPairing(handle, []byte("pw"))
+	require.NoError(t, err)
+
+	// the client must prove it knows the secret of the pairing URI
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	client := crypto.GenerateSignatureSecrets(seed)
+	_, err = sm.PairRelayClient(pairing.ID, client.SignatureVerifier, relayPairingProof([]byte("guess"), client.SignatureVerifier))
+	require.ErrorIs(t, err, errRelayProof)
+
+	// a pairing is only paired once
+	pairingID, _ := pairRelayTestClient(t, sm, handle, "pw")
+	_, err = sm.PairRelayClient(pairingID, client.SignatureVerifier, nil)
+	require.ErrorIs(t, err, errRelayPaired)
+
+	// the pairings are only seen through the handles of their wallet
+	other := relayTestWallet{testWallet: testWallet{id: []byte("other-wallet-id")}, pw: "pw"}
+	otherHandle, err := sm.InitWalletHandle(other, []byte("pw"), kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	_, err = sm.RelayPairing(otherHandle, pairingID)
+	require.ErrorIs(t, err, errRelayPairingWallet)
+	require.ErrorIs(t, sm.DeleteRelayPairing(otherHandle, pairingID), errRelayPairingWallet)
+
+	require.NoError(t, sm.DeleteRelayPairing(handle, pairingID))
+	_, err = sm.RelayPairing(handle, pairingID)
+	require.ErrorIs(t, err, errRelayPairingNotFound)
+}
+
+func TestRelaySignRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := relayTestWallet{testWallet: testWallet{id: []byte("wallet-id")}, pw: "pw"}
+	sm := makeRelayTestManager(t, map[string]kmdapi.APIV1WalletPolicy{
+		string(w.id): {MaxAmount: 1000, PeriodSecs: 3600},
+	})
+	defer sm.Kill()
+	handle, err := sm.InitWalletHandle(w, []byte("pw"), kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+
+	// requests are only pushed to paired clients
+	pairing, _, err := sm.CreateRelayPairing(handle, []byte("pw"))
+	require.NoError(t, err)
+	_, err = sm.SubmitRelayRequest(handle, pairing.ID, payment(basics.Address{1}, 100, 0), "")
+	require.ErrorIs(t, err, errRelayNotPaired)
+
+	pairingID, client := pairRelayTestClient(t, sm, handle, "pw")
+	require.Empty(t, pollRelayTestClient(t, sm, pairingID, client))
+
+	tx := payment(basics.Address{1}, 100, 0)
+	submitted, err := sm.SubmitRelayRequest(handle, pairingID, tx, "pay the rent")
+	require.NoError(t, err)
+	require.Equal(t, relayStatusPending, submitted.Status)
+	refused, err := sm.SubmitRelayRequest(handle, pairingID, payment(basics.Address{1}, 5000, 0), "")
+	require.NoError(t, err)
+	rejected, err := sm.SubmitRelayRequest(handle, pairingID, payment(basics.Address{2}, 100, 0), "")
+	require.NoError(t, err)
+
+	requests := pollRelayTestClient(t, sm, pairingID, client)
+	require.Len(t, requests, 3)
+	require.Equal(t, submitted.ID, requests[0].ID)
+	require.Equal(t, "pay the rent", requests[0].Message)
+
+	// the polls and answers must be signed by the session key of the client
+	now := time.Now().Unix()
+	_, err = sm.PollRelayRequests(pairingID, now, client.SignBytes(relayPollMessage(pairingID, now+1)), 0)
+	require.ErrorIs(t, err, errRelaySignature)
+	_, err = sm.PollRelayRequests(pairingID, now-3600, client.SignBytes(relayPollMessage(pairingID, now-3600)), 0)
+	require.ErrorIs(t, err, errRelayTimestamp)
+	_, _, err = sm.AnswerRelayRequest(pairingID, submitted.ID, true, client.SignBytes([]byte("approve")), "", recordRelayTestAnswer)
+	require.ErrorIs(t, err, errRelaySignature)
+
+	// an approved transaction is signed with the wallet of the pairing
+	signed, err := answerRelayTestClient(sm, pairingID, client, requests[0], true)
+	require.NoError(t, err)
+	require.Equal(t, relayStatusSigned, signed.Status)
+	require.Equal(t, []byte(tx.ID().String()), signed.SignedTransaction)
+	info, err := sm.RelayRequest(handle, submitted.ID, 0)
+	require.NoError(t, err)
+	require.Equal(t, relayStatusSigned, info.Status)
+	require.Equal(t, signed.SignedTransaction, info.SignedTransaction)
+	_, err = answerRelayTestClient(sm, pairingID, client, requests[0], true)
+	require.ErrorIs(t, err, errRelayRequestAnswered)
+
+	// the policies of the wallet still apply to the approved transactions
+	failed, err := answerRelayTestClient(sm, pairingID, client, requests[1], true)
+	require.NoError(t, err)
+	require.Equal(t, refused.ID, failed.ID)
+	require.Equal(t, relayStatusFailed, failed.Status)
+	require.Empty(t, failed.SignedTransaction)
+
+	answer, err := answerRelayTestClient(sm, pairingID, client, requests[2], false)
+	require.NoError(t, err)
+	require.Equal(t, rejected.ID, answer.ID)
+	require.Equal(t, relayStatusRejected, answer.Status)
+	require.Empty(t, pollRelayTestClient(t, sm, pairingID, client))
+}
+
+func TestRelayWaitsForAnswer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := relayTestWallet{testWallet: testWallet{id: []byte("wallet-id")}, pw: "pw"}
+	sm := makeRelayTestManager(t, nil)
+	defer sm.Kill()
+	handle, err := sm.InitWalletHandle(w, []byte("pw"), kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	pairingID, client := pairRelayTestClient(t, sm, handle, "pw")
+
+	submitted, err := sm.SubmitRelayRequest(handle, pairingID, payment(basics.Address{1}, 100, 0), "")
+	require.NoError(t, err)
+	go func() {
+		now := time.Now().Unix()
+		requests, err := sm.PollRelayRequests(pairingID, now, client.SignBytes(relayPollMessage(pairingID, now)), 10)
+		if err == nil && len(requests) == 1 {
+			answerRelayTestClient(sm, pairingID, client, requests[0], true)
+		}
+	}()
+
+	info, err := sm.RelayRequest(handle, submitted.ID, 10)
+	require.NoError(t, err)
+	require.Equal(t, relayStatusSigned, info.Status)
+
+	// the transaction is not signed when it cannot be recorded
+	unrecorded, err := sm.SubmitRelayRequest(handle, pairingID, payment(basics.Address{1}, 100, 0), "")
+	require.NoError(t, err)
+	requests := pollRelayTestClient(t, sm, pairingID, client)
+	require.Len(t, requests, 1)
+	errRecord := errors.New("audit log unavailable")
+	info, err = answerRelayTestClientRecorded(sm, pairingID, client, requests[0], true, func(wallet.Wallet, transactions.Transaction) error {
+		return errRecord
+	})
+	require.NoError(t, err)
+	require.Equal(t, unrecorded.ID, info.ID)
+	require.Equal(t, relayStatusFailed, info.Status)
+	require.Equal(t, errRecord.Error(), info.Error)
+	require.Empty(t, info.SignedTransaction)
+
+	// deleting the pairing fails its pending requests
+	pending, err := sm.SubmitRelayRequest(handle, pairingID, payment(basics.Address{1}, 100, 0), "")
+	require.NoError(t, err)
+	require.NoError(t, sm.DeleteRelayPairing(handle, pairingID))
+	info, err = sm.RelayRequest(handle, pending.ID, 0)
+	require.NoError(t, err)
+	require.Equal(t, relayStatusFailed, info.Status)
+}
+
+func TestRelayDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sm, err := MakeManager(config.KMDConfig{SessionLifetimeSecs: 60})
+	require.NoError(t, err)
+	defer sm.Kill()
+
+	w := relayTestWallet{testWallet: testWallet{id: []byte("wallet-id")}, pw: "pw"}
+	handle, err := sm.InitWalletHandle(w, []byte("pw"), kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	_, _, err = sm.CreateRelayPairing(handle, []byte("pw"))
+	require.ErrorIs(t, err, errRelayDisabled)
+	_, err = sm.PairRelayClient("pairing", crypto.PublicKey{}, nil)
+	require.ErrorIs(t, err, errRelayDisabled)
+	_, err = sm.SubmitRelayRequest(handle, "pairing", transactions.Transaction{}, "")
+	require.ErrorIs(t, err, errRelayDisabled)
+}
+
+func TestRelayPairingRevoked(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := &relayTestWallet{testWallet: testWallet{id: []byte("wallet-id")}, pw: "pw"}
+	sm := makeRelayTestManager(t, nil)
+	defer sm.Kill()
+	handle, err := sm.InitWalletHandle(w, []byte("pw"), kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	pairingID, client := pairRelayTestClient(t, sm, handle, "pw")
+
+	// the requests are only pushed and seen through the handles of the wallet
+	other := relayTestWallet{testWallet: testWallet{id: []byte("other-wallet-id")}, pw: "pw"}
+	otherHandle, err := sm.InitWalletHandle(other, []byte("pw"), kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	_, err = sm.SubmitRelayRequest(otherHandle, pairingID, payment(basics.Address{1}, 100, 0), "")
+	require.ErrorIs(t, err, errRelayPairingWallet)
+	_, err = sm.SubmitRelayRequest([]byte("bogus"), pairingID, payment(basics.Address{1}, 100, 0), "")
+	require.Error(t, err)
+	submitted, err := sm.SubmitRelayRequest(handle, pairingID, payment(basics.Address{1}, 100, 0), "")
+	require.NoError(t, err)
+	_, err = sm.RelayRequest(otherHandle, submitted.ID, 0)
+	require.ErrorIs(t, err, errRelayRequestNotFound)
+
+	// the password is checked again when the approved transaction is signed
+	w.pw = "changed"
+	requests := pollRelayTestClient(t, sm, pairingID, client)
+	require.Len(t, requests, 1)
+	info, err := answerRelayTestClient(sm, pairingID, client, requests[0], true)
+	require.NoError(t, err)
+	require.Equal(t, relayStatusFailed, info.Status)
+	require.Equal(t, errTestWrongPassword.Error(), info.Error)
+	w.pw = "pw"
+
+	// revoking the pairings of the wallet fails their pending requests
+	pending, err := sm.SubmitRelayRequest(handle, pairingID, payment(basics.Address{1}, 100, 0), "")
+	require.NoError(t, err)
+	sm.RevokeRelayPairings(w.id)
+	info, err = sm.RelayRequest(handle, pending.ID, 0)
+	require.NoError(t, err)
+	require.Equal(t, relayStatusFailed, info.Status)
+	_, err = sm.RelayPairing(handle, pairingID)
+	require.ErrorIs(t, err, errRelayPairingNotFound)
+
+	// the pairings end with the wallet handle they were created with
+	pairingID, _ = pairRelayTestClient(t, sm, handle, "pw")
+	pending, err = sm.SubmitRelayRequest(handle, pairingID, payment(basics.Address{1}, 100, 0), "")
+	require.NoError(t, err)
+	secondHandle, err := sm.InitWalletHandle(w, []byte("pw"), kmdapi.APIV1WalletPolicy{})
+	require.NoError(t, err)
+	require.NoError(t, sm.ReleaseWalletHandle(handle))
+	_, err = sm.RelayPairing(secondHandle, pairingID)
+	require.ErrorIs(t, err, errRelayPairingNotFound)
+	info, err = sm.RelayRequest(secondHandle, pending.ID, 0)
+	require.NoError(t, err)
+	require.Equal(t, relayStatusFailed, info.Status)
+
+	// and with its expiration
+	pairingID, _ = pairRelayTestClient(t, sm, secondHandle, "pw")
+	sm.mux.Lock()
+	for id, wh := range sm.walletHandles {
+		wh.expires = time.Now().Add(-time.Second)
+		sm.walletHandles[id] = wh
+	}
+	sm.mux.Unlock()
+	sm.deleteExpiredHandles()
+	sm.mux.Lock()
+	_, ok := sm.relayPairings[pairingID]
+	sm.mux.Unlock()
+	require.False(t, ok)
+}
@@ -18,17 +18,20 @@ package session
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-deadlock"
 )
 
 type walletHandle struct {
-	secret  []byte
-	expires time.Time
-	wallet  wallet.Wallet
+	secret   []byte
+	expires  time.Time
+	wallet   wallet.Wallet
+	policies []*policy
 }
 
 // Manager allows users to initialize wallets by knowing their passwords, and
@@ -41,11 +44,53 @@ type Manager struct {
 	Kill            context.CancelFunc
 	ctx             context.Context
 	mux             deadlock.Mutex
+
+	// walletPolicies are the policies configured for each wallet ID
+	walletPolicies map[string]kmdapi.APIV1WalletPolicy
+	// walletSpends are the amounts limited by the policy of each wallet ID,
+	// counted across all the handles of the wallet
+	walletSpends map[string]*spendWindow
+	// totpSecret is the secret of the second factor confirming key registrations
+	totpSecret []byte
+	// lastConfirmationStep is the time step of the last confirmation code used,
+	// so that a code cannot be replayed
+	lastConfirmationStep uint64
+
+	multisigSessions        map[string]*multisigSession
+	multisigSessionLifetime time.Duration
+
+	frostNonces map[string]*frostNonces
+
+	relayEnabled         bool
+	relayPublicURL       string
+	relayPairingLifetime time.Duration
+	relayRequestLifetime time.Duration
+	relayPairings        map[string]*relayPairing
+	relayRequests        map[string]*relayRequest
+	// relayChanged is closed and replaced whenever a relay request is
+	// submitted or answered, or a pairing deleted, to wake up the long polls
+	relayChanged chan struct{}
 }
 
 // MakeManager initializes and returns a *Manager using the kmd global
 // configuration
-func MakeManager(cfg config.KMDConfig) *Manager {
+func MakeManager(cfg config.KMDConfig) (*Manager, error) {
+	var totpSecret []byte
+	if cfg.ConfirmationTOTPSecret != "" {
+		var err error
+		totpSecret, err = decodeTOTPSecret(cfg.ConfirmationTOTPSecret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid confirmation totp secret: %w", err)
+		}
+	}
+
+	for walletID, p := range cfg.Policies {
+		_, err := makeEnforceablePolicy(p, totpSecret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy of wallet %s: %w", walletID, err)
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	sm := &Manager{
 		Initialized:     true,
@@ -53,7 +98,31 @@ func MakeManager(cfg config.KMDConfig) *Manager {
 		sessionLifetime: time.Duration(cfg.SessionLifetimeSecs * uint64(time.Second)),
 		Kill:            cancel,
 		ctx:             ctx,
+		walletPolicies:  cfg.Policies,
+		walletSpends:    make(map[string]*spendWindow),
+		totpSecret:      totpSecret,
+
+		multisigSessions:        make(map[string]*multisigSession),
+		multisigSessionLifetime: time.Duration(cfg.MultisigSessionLifetimeSecs * uint64(time.Second)),
+
+		frostNonces: make(map[string]*frostNonces),
+
+		relayEnabled:         cfg.SigningRelay.Enable,
+		relayPublicURL:       cfg.SigningRelay.PublicURL,
+		relayPairingLifetime: time.Duration(cfg.SigningRelay.PairingLifetimeSecs * uint64(time.Second)),
+		relayRequestLifetime: time.Duration(cfg.SigningRelay.RequestLifetimeSecs * uint64(time.Second)),
+		relayPairings:        make(map[string]*relayPairing),
+		relayRequests:        make(map[string]*relayRequest),
+		relayChanged:         make(chan struct{}),
 	}
 	go sm.cleanUpExpiredHandles()
-	return sm
+	return sm, nil
+}
+
+// makeEnforceablePolicy makes a policy, checking that it can be enforced
+func makeEnforceablePolicy(p kmdapi.APIV1WalletPolicy, totpSecret []byte) (*policy, error) {
+	if p.RequireKeyregConfirmation && len(totpSecret) == 0 {
+		return nil, fmt.Errorf("key registration confirmation requires a confirmation totp secret in the kmd configuration")
+	}
+	return makePolicy(p)
 }
@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// rfc6238Secret is the HMAC-SHA1 secret of the RFC 6238 test vectors
+var rfc6238Secret = []byte("12345678901234567890")
+
+func TestTOTPCodeRFC6238(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// The RFC gives 8 digit codes, of which the 6 digit codes are the last digits
+	vectors := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, v := range vectors {
+		require.Equal(t, v.code, totpCode(rfc6238Secret, uint64(v.unix)/30), "time %d", v.unix)
+
+		step, ok := verifyTOTP(rfc6238Secret, v.code, time.Unix(v.unix, 0))
+		require.True(t, ok)
+		require.Equal(t, uint64(v.unix)/30, step)
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Unix(1234567890, 0)
+	code := "005924"
+
+	// one step of clock drift is allowed either way
+	_, ok := verifyTOTP(rfc6238Secret, code, now.Add(totpStep))
+	require.True(t, ok)
+	_, ok = verifyTOTP(rfc6238Secret, code, now.Add(-totpStep))
+	require.True(t, ok)
+	_, ok = verifyTOTP(rfc6238Secret, code, now.Add(3*totpStep))
+	require.False(t, ok)
+
+	_, ok = verifyTOTP(rfc6238Secret, "005925", now)
+	require.False(t, ok)
+	_, ok = verifyTOTP(rfc6238Secret, "05924", now)
+	require.False(t, ok)
+	_, ok = verifyTOTP(nil, code, now)
+	require.False(t, ok)
+}
+
+func TestDecodeTOTPSecret(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// the base32 encoding of the RFC 6238 secret, as an authenticator app may display it
+	secret, err := decodeTOTPSecret("gezd gnbv gy3t qojq gezd gnbv gy3t qojq")
+	require.NoError(t, err)
+	require.Equal(t, rfc6238Secret, secret)
+
+	secret, err = decodeTOTPSecret("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ====")
+	require.NoError(t, err)
+	require.Equal(t, rfc6238Secret, secret)
+
+	_, err = decodeTOTPSecret("not base32!")
+	require.Error(t, err)
+}
@@ -28,6 +28,7 @@ import (
 var walletDrivers = map[string]Driver{
 	sqliteWalletDriverName: &SQLiteWalletDriver{},
 	ledgerWalletDriverName: &LedgerWalletDriver{},
+	remoteWalletDriverName: &RemoteWalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
@@ -42,6 +43,12 @@ type Driver interface {
 	FetchWallet(id []byte) (wallet.Wallet, error)
 }
 
+// HDDriver is implemented by the wallet drivers able to create wallets
+// deriving their keys hierarchically from a BIP-39 seed
+type HDDriver interface {
+	CreateHDWallet(name []byte, id []byte, pw []byte, seed []byte, account uint32) error
+}
+
 // InitWalletDrivers accepts a KMDConfig and uses it to initialize each driver
 func InitWalletDrivers(cfg config.KMDConfig, log logging.Logger) error {
 	for _, driver := range walletDrivers {
@@ -48,12 +48,20 @@ const (
 	ledgerInsSignKeyregV2  = uint8(0x05)
 	ledgerInsSignMsgpack   = uint8(0x08)
 	ledgerP1first          = uint8(0x00)
+	ledgerP1withAccountID  = uint8(0x01)
 	ledgerP1more           = uint8(0x80)
 	ledgerP2last           = uint8(0x00)
 	ledgerP2more           = uint8(0x80)
 )
 
-var ledgerWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+var ledgerWalletSupportedTxs = []protocol.TxType{
+	protocol.PaymentTx,
+	protocol.KeyRegistrationTx,
+	protocol.AssetConfigTx,
+	protocol.AssetTransferTx,
+	protocol.AssetFreezeTx,
+	protocol.ApplicationCallTx,
+}
 
 // LedgerWalletDriver provides access to a hardware wallet on the
 // Ledger Nano S device.  The device must run the Algorand wallet
@@ -67,10 +75,14 @@ type LedgerWalletDriver struct {
 
 // LedgerWallet represents a particular wallet under the
 // LedgerWalletDriver.  The lock prevents concurrent access
-// to the USB device.
+// to the USB device.  The keys of the wallet are derived
+// from the device master secret at the account indices 0
+// to accounts-1.
 type LedgerWallet struct {
-	mu  deadlock.Mutex
-	dev LedgerUSB
+	mu       deadlock.Mutex
+	dev      LedgerUSB
+	accounts uint32
+	log      logging.Logger
 }
 
 // CreateWallet implements the Driver interface.  There is
@@ -158,14 +170,21 @@ func (lwd *LedgerWalletDriver) scanWalletsLocked() error {
 		delete(lwd.wallets, deadPath)
 	}
 
+	accounts := lwd.cfg.Accounts
+	if accounts == 0 {
+		accounts = 1
+	}
+
 	// Add in new ledger wallets if they appear valid
 	for _, dev := range newDevs {
 		newWallet := &LedgerWallet{
-			dev: dev,
+			dev:      dev,
+			accounts: accounts,
+			log:      lwd.log,
 		}
 
 		// Check that device responds to Algorand app requests
-		_, err := newWallet.ListKeys()
+		_, err := newWallet.publicKey(0)
 		if err != nil {
 			continue
 		}
@@ -177,9 +196,8 @@ func (lwd *LedgerWalletDriver) scanWalletsLocked() error {
 	return nil
 }
 
-// InitWithConfig accepts a driver configuration.  Currently, the Ledger
-// driver does not have any configuration parameters.  However, we use
-// this to enumerate the USB devices.
+// InitWithConfig accepts a driver configuration, which sets the number
+// of accounts derived from each device, and enumerates the USB devices.
 func (lwd *LedgerWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
 	lwd.mu.Lock()
 	defer lwd.mu.Unlock()
@@ -271,14 +289,79 @@ func (lw *LedgerWallet) ListKeys() ([]crypto.Digest, error) {
 	lw.mu.Lock()
 	defer lw.mu.Unlock()
 
-	reply, err := lw.dev.Exchange([]byte{ledgerClass, ledgerInsGetPublicKey, 0x00, 0x00, 0x00})
+	return lw.listKeysLocked()
+}
+
+// listKeysLocked derives the keys of all the accounts of the wallet.
+// lw.mu must be held
+func (lw *LedgerWallet) listKeysLocked() ([]crypto.Digest, error) {
+	keys := make([]crypto.Digest, 0, lw.accounts)
+	for account := uint32(0); account < lw.accounts; account++ {
+		addr, err := lw.publicKeyLocked(account)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, addr)
+	}
+	return keys, nil
+}
+
+func (lw *LedgerWallet) publicKey(account uint32) (crypto.Digest, error) {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	return lw.publicKeyLocked(account)
+}
+
+// publicKeyLocked asks the device for the public key of an account index,
+// without confirmation on the device.
+// lw.mu must be held
+func (lw *LedgerWallet) publicKeyLocked(account uint32) (addr crypto.Digest, err error) {
+	msg := []byte{ledgerClass, ledgerInsGetPublicKey, 0x00, 0x00, 0x04}
+	msg = binary.BigEndian.AppendUint32(msg, account)
+
+	reply, err := lw.dev.Exchange(msg)
 	if err != nil {
-		return nil, err
+		return
+	}
+	if len(reply) < len(addr) {
+		err = fmt.Errorf("unexpected public key length %d", len(reply))
+		return
 	}
 
-	var addr crypto.Digest
 	copy(addr[:], reply)
-	return []crypto.Digest{addr}, nil
+	return
+}
+
+// signingAccount returns the account index and the key to sign a transaction
+// with.  When no key is requested, the key of the sender is used if it is one
+// of the wallet keys, and the key of the first account otherwise.
+func (lw *LedgerWallet) signingAccount(pk crypto.PublicKey, sender basics.Address) (uint32, crypto.Digest, error) {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	keys, err := lw.listKeysLocked()
+	if err != nil {
+		return 0, crypto.Digest{}, err
+	}
+	if len(keys) < 1 {
+		return 0, crypto.Digest{}, errKeyNotFound
+	}
+
+	want := crypto.Digest(pk)
+	if (pk == crypto.PublicKey{}) {
+		want = crypto.Digest(sender)
+	}
+	for account, key := range keys {
+		if key == want {
+			return uint32(account), key, nil
+		}
+	}
+	if (pk == crypto.PublicKey{}) {
+		return 0, keys[0], nil
+	}
+	// A specific key was requested; return an error if it's not on the device.
+	return 0, crypto.Digest{}, errKeyNotFound
 }
 
 // ImportKey implements the Wallet interface.
@@ -291,9 +374,21 @@ func (lw *LedgerWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKe
 	return crypto.PrivateKey{}, errNotSupported
 }
 
-// GenerateKey implements the Wallet interface.
+// GenerateKey implements the Wallet interface.  It derives the key of the
+// next account index; the key never leaves the device, so there is no
+// mnemonic to display.  The number of derived accounts is not saved, so the
+// accounts option of the driver should be raised to keep the key listed
+// after kmd restarts.
 func (lw *LedgerWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
-	return crypto.Digest{}, errNotSupported
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	addr, err := lw.publicKeyLocked(lw.accounts)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	lw.accounts++
+	return addr, nil
 }
 
 // DeleteKey implements the Wallet interface.
@@ -323,24 +418,12 @@ func (lw *LedgerWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error
 
 // SignTransaction implements the Wallet interface.
 func (lw *LedgerWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
-	pks, err := lw.ListKeys()
+	account, signer, err := lw.signingAccount(pk, tx.Sender)
 	if err != nil {
 		return nil, err
 	}
-	// Right now the device only supports one key
-	if len(pks) > 1 {
-		return nil, errors.New("LedgerWallet device only supports one key but ListKeys returned more than one")
-	}
-	if len(pks) < 1 {
-		return nil, errKeyNotFound
-	}
-	if (pk != crypto.PublicKey{}) && pk != crypto.PublicKey(pks[0]) {
-		// A specific key was requested; return an error if it's not the one on the device.
-		return nil, errKeyNotFound
-	}
-	pk = crypto.PublicKey(pks[0])
 
-	sig, err := lw.signTransactionHelper(tx)
+	sig, err := lw.signTransactionHelper(tx, account)
 	if err != nil {
 		return nil, err
 	}
@@ -351,8 +434,8 @@ func (lw *LedgerWallet) SignTransaction(tx transactions.Transaction, pk crypto.P
 	}
 
 	// Set the AuthAddr if the key we signed with doesn't match the txn sender
-	if basics.Address(pk) != tx.Sender {
-		stxn.AuthAddr = basics.Address(pk)
+	if basics.Address(signer) != tx.Sender {
+		stxn.AuthAddr = basics.Address(signer)
 	}
 
 	return protocol.Encode(&stxn), nil
@@ -383,7 +466,12 @@ func (lw *LedgerWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 		return partial, errMsigWrongKey
 	}
 
-	sig, err := lw.signTransactionHelper(tx)
+	account, _, err := lw.signingAccount(pk, tx.Sender)
+	if err != nil {
+		return partial, err
+	}
+
+	sig, err := lw.signTransactionHelper(tx, account)
 	if err != nil {
 		return partial, err
 	}
@@ -434,17 +522,22 @@ func uint64le(i uint64) []byte {
 	return buf[:]
 }
 
-func (lw *LedgerWallet) signTransactionHelper(tx transactions.Transaction) (sig crypto.Signature, err error) {
+func (lw *LedgerWallet) signTransactionHelper(tx transactions.Transaction, account uint32) (sig crypto.Signature, err error) {
 	lw.mu.Lock()
 	defer lw.mu.Unlock()
 
-	sig, err = lw.sendTransactionMsgpack(tx)
+	hints := ledgerDisplayHints(tx)
+	if len(hints) > 0 && lw.log != nil {
+		lw.log.Infof("confirm the transaction %s on the ledger device: %s", tx.ID(), strings.Join(hints, ", "))
+	}
+
+	sig, err = lw.sendTransactionMsgpack(tx, account)
 	if err == nil {
 		return
 	}
 
 	ledgerErr, ok := err.(LedgerUSBError)
-	if ok && ledgerErr == 0x6d00 {
+	if ok && ledgerErr == 0x6d00 && account == 0 {
 		// We tried to send a msgpack-encoded transaction to the device,
 		// but it doesn't support the new-style opcode, so fall back
 		// to old-style encoding.
@@ -454,13 +547,21 @@ func (lw *LedgerWallet) signTransactionHelper(tx transactions.Transaction) (sig
 	return
 }
 
-func (lw *LedgerWallet) sendTransactionMsgpack(tx transactions.Transaction) (sig crypto.Signature, err error) {
+func (lw *LedgerWallet) sendTransactionMsgpack(tx transactions.Transaction, account uint32) (sig crypto.Signature, err error) {
 	var reply []byte
 
 	tosend := protocol.Encode(&tx)
 	p1 := ledgerP1first
 	p2 := ledgerP2more
 
+	// The account index is sent ahead of the transaction in the first
+	// chunk.  Account 0 is sent without it, as older versions of the
+	// application only sign with the first account.
+	if account != 0 {
+		tosend = append(binary.BigEndian.AppendUint32(nil, account), tosend...)
+		p1 = ledgerP1withAccountID
+	}
+
 	// As a precaution, make sure that chunk + 5-byte APDU header
 	// fits in 8-bit length fields.
 	const chunkSize = 250
@@ -556,3 +657,59 @@ func (lw *LedgerWallet) signProgramHelper(data []byte) (sig crypto.Signature, er
 	err = errors.New("signing programs not yet implemented for ledger wallet")
 	return
 }
+
+// DisplayHints implements the wallet.DisplayHinter interface. They are
+// returned to kmd clients, so that the user can check them against the fields
+// shown on the device.
+func (lw *LedgerWallet) DisplayHints(tx transactions.Transaction) []string {
+	return ledgerDisplayHints(tx)
+}
+
+// ledgerDisplayHints describes an application call the way an ARC-1 wallet
+// displays it before signing, so that the user can check the fields shown on
+// the device, which cannot decode ABI arguments, against the intended call.
+func ledgerDisplayHints(tx transactions.Transaction) []string {
+	if tx.Type != protocol.ApplicationCallTx {
+		return nil
+	}
+
+	var hints []string
+	if tx.ApplicationID == 0 {
+		hints = append(hints, "create application")
+	} else {
+		hints = append(hints, fmt.Sprintf("application %d", tx.ApplicationID))
+	}
+	hints = append(hints, fmt.Sprintf("on completion %s", tx.OnCompletion))
+
+	// ARC-4 method calls pass the method selector as the first argument
+	if len(tx.ApplicationArgs) > 0 && len(tx.ApplicationArgs[0]) == 4 {
+		hints = append(hints, fmt.Sprintf("method selector 0x%x", tx.ApplicationArgs[0]))
+	}
+
+	counts := []struct {
+		name  string
+		count int
+	}{
+		{"arguments", len(tx.ApplicationArgs)},
+		{"accounts", len(tx.Accounts)},
+		{"foreign applications", len(tx.ForeignApps)},
+		{"foreign assets", len(tx.ForeignAssets)},
+		{"boxes", len(tx.Boxes)},
+	}
+	for _, c := range counts {
+		if c.count > 0 {
+			hints = append(hints, fmt.Sprintf("%d %s", c.count, c.name))
+		}
+	}
+
+	if len(tx.ApprovalProgram) > 0 {
+		hints = append(hints, fmt.Sprintf("approval program of %d bytes", len(tx.ApprovalProgram)))
+	}
+	if len(tx.ClearStateProgram) > 0 {
+		hints = append(hints, fmt.Sprintf("clear state program of %d bytes", len(tx.ClearStateProgram)))
+	}
+	if !tx.RekeyTo.IsZero() {
+		hints = append(hints, fmt.Sprintf("rekey to %s", tx.RekeyTo))
+	}
+	return hints
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLedgerDisplayHints(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var lw wallet.Wallet = &LedgerWallet{}
+	hinter, ok := lw.(wallet.DisplayHinter)
+	require.True(t, ok)
+
+	// Only application calls get hints
+	pay := transactions.Transaction{Type: protocol.PaymentTx}
+	require.Empty(t, hinter.DisplayHints(pay))
+
+	// An ARC-4 method call on an existing application
+	var rekeyTo basics.Address
+	rekeyTo[0] = 1
+	call := transactions.Transaction{
+		Type: protocol.ApplicationCallTx,
+		Header: transactions.Header{
+			RekeyTo: rekeyTo,
+		},
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID:   123,
+			OnCompletion:    transactions.OptInOC,
+			ApplicationArgs: [][]byte{{0xde, 0xad, 0xbe, 0xef}, []byte("arg")},
+			Accounts:        []basics.Address{{}},
+			ForeignAssets:   []basics.AssetIndex{7, 8},
+		},
+	}
+	require.Equal(t, []string{
+		"application 123",
+		"on completion OptInOC",
+		"method selector 0xdeadbeef",
+		"2 arguments",
+		"1 accounts",
+		"2 foreign assets",
+		"rekey to " + rekeyTo.String(),
+	}, hinter.DisplayHints(call))
+
+	// An application creation
+	create := transactions.Transaction{
+		Type: protocol.ApplicationCallTx,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApprovalProgram:   []byte{0x06, 0x81, 0x01},
+			ClearStateProgram: []byte{0x06, 0x81, 0x01},
+			ApplicationArgs:   [][]byte{[]byte("not a selector")},
+		},
+	}
+	require.Equal(t, []string{
+		"create application",
+		"on completion NoOpOC",
+		"1 arguments",
+		"approval program of 3 bytes",
+		"clear state program of 3 bytes",
+	}, hinter.DisplayHints(create))
+}
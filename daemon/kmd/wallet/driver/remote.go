@@ -0,0 +1,457 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/remotesigner"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	remoteWalletDriverName    = "remote"
+	remoteWalletDriverVersion = 1
+	remoteIDLen               = 16
+
+	defaultRemoteSignerTimeout = 30 * time.Second
+	maxRemoteResponseSize      = 1 << 20
+)
+
+var remoteWalletSupportedTxs = []protocol.TxType{
+	protocol.PaymentTx,
+	protocol.KeyRegistrationTx,
+	protocol.AssetConfigTx,
+	protocol.AssetTransferTx,
+	protocol.AssetFreezeTx,
+	protocol.ApplicationCallTx,
+}
+
+// RemoteWalletDriver provides access to remote signing services, which keep
+// the private keys away from the host running kmd. Each configured service is
+// a wallet, and kmd authenticates to it with a client certificate. The
+// protocol is defined by the remotesigner package.
+type RemoteWalletDriver struct {
+	mu      deadlock.Mutex
+	wallets map[string]*RemoteWallet
+	log     logging.Logger
+}
+
+// RemoteWallet represents a remote signing service. The wallet password is
+// not used: the service authenticates kmd by its certificate, and applies
+// its own policy to the signing requests.
+type RemoteWallet struct {
+	id     string
+	name   string
+	url    string
+	client *http.Client
+}
+
+// InitWithConfig accepts a driver configuration, and sets up a wallet for each
+// configured remote signer
+func (rwd *RemoteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rwd.log = log
+	rwd.wallets = make(map[string]*RemoteWallet)
+
+	driverCfg := cfg.DriverConfig.RemoteWalletDriverConfig
+	if driverCfg.Disable {
+		return nil
+	}
+
+	for _, signer := range driverCfg.Signers {
+		w, err := makeRemoteWallet(signer)
+		if err != nil {
+			return fmt.Errorf("remote signer %s: %w", signer.Name, err)
+		}
+		rwd.wallets[w.id] = w
+	}
+	return nil
+}
+
+func makeRemoteWallet(signer config.RemoteSignerConfig) (*RemoteWallet, error) {
+	caPEM, err := os.ReadFile(signer.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPEM) {
+		return nil, errRemoteCA
+	}
+	cert, err := tls.LoadX509KeyPair(signer.CertFile, signer.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultRemoteSignerTimeout
+	if signer.TimeoutSecs != 0 {
+		timeout = time.Duration(signer.TimeoutSecs) * time.Second
+	}
+
+	idHash := sha512.Sum512_256([]byte(signer.Name))
+	return &RemoteWallet{
+		id:   fmt.Sprintf("%x", idHash[:remoteIDLen]),
+		name: signer.Name,
+		url:  strings.TrimSuffix(signer.URL, "/"),
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs:      roots,
+					Certificates: []tls.Certificate{cert},
+					MinVersion:   tls.VersionTLS12,
+				},
+			},
+		},
+	}, nil
+}
+
+// ListWalletMetadatas returns all wallets supported by this driver.
+func (rwd *RemoteWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	for _, w := range rwd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+	return metadatas, nil
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (rwd *RemoteWalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	w, ok := rwd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+	return w, nil
+}
+
+// CreateWallet implements the Driver interface. The remote wallets are set
+// up from the kmd configuration instead.
+func (rwd *RemoteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return errNotSupported
+}
+
+// RenameWallet implements the Driver interface.
+func (rwd *RemoteWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// do sends a request to the remote signer and decodes the response into resp
+func (rw *RemoteWallet) do(method string, path string, req interface{}, resp interface{}) error {
+	var body io.Reader
+	if req != nil {
+		enc, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(enc)
+	}
+
+	httpReq, err := http.NewRequest(method, rw.url+path, body)
+	if err != nil {
+		return err
+	}
+	if req != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := rw.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(httpResp.Body, maxRemoteResponseSize))
+	if err != nil {
+		return err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp remotesigner.ErrorResponse
+		// the body is only informative, so a malformed one is not an error of its own
+		_ = json.Unmarshal(data, &errResp)
+		return fmt.Errorf("remote signer %s: %s: %s", rw.name, httpResp.Status, errResp.Message)
+	}
+	return json.Unmarshal(data, resp)
+}
+
+// sign requests the signature of data with the private key of pk
+func (rw *RemoteWallet) sign(pk crypto.PublicKey, kind remotesigner.Kind, data []byte) (sig crypto.Signature, err error) {
+	req := remotesigner.SignRequest{
+		PublicKey: pk[:],
+		Kind:      kind,
+		Data:      data,
+	}
+	var resp remotesigner.SignResponse
+	err = rw.do(http.MethodPost, remotesigner.SignPath, &req, &resp)
+	if err != nil {
+		return
+	}
+	if len(resp.Signature) != len(sig) {
+		err = errRemoteBadSignature
+		return
+	}
+	copy(sig[:], resp.Signature)
+	return
+}
+
+func (rw *RemoteWallet) signTransactionHelper(tx transactions.Transaction, pk crypto.PublicKey) (crypto.Signature, error) {
+	sig, err := rw.sign(pk, remotesigner.KindTransaction, protocol.Encode(&tx))
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	if !crypto.SignatureVerifier(pk).Verify(tx, sig) {
+		return crypto.Signature{}, errRemoteBadSignature
+	}
+	return sig, nil
+}
+
+func (rw *RemoteWallet) signProgramHelper(data []byte, pk crypto.PublicKey) (crypto.Signature, error) {
+	sig, err := rw.sign(pk, remotesigner.KindProgram, data)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	if !crypto.SignatureVerifier(pk).Verify(logic.Program(data), sig) {
+		return crypto.Signature{}, errRemoteBadSignature
+	}
+	return sig, nil
+}
+
+// Metadata implements the Wallet interface.
+func (rw *RemoteWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(rw.id),
+		Name:                  []byte(rw.name),
+		DriverName:            remoteWalletDriverName,
+		DriverVersion:         remoteWalletDriverVersion,
+		SupportedTransactions: remoteWalletSupportedTxs,
+	}, nil
+}
+
+// Init implements the Wallet interface.
+func (rw *RemoteWallet) Init(pw []byte) error {
+	return nil
+}
+
+// CheckPassword implements the Wallet interface.
+func (rw *RemoteWallet) CheckPassword(pw []byte) error {
+	return nil
+}
+
+// ListKeys implements the Wallet interface.
+func (rw *RemoteWallet) ListKeys() ([]crypto.Digest, error) {
+	var resp remotesigner.KeysResponse
+	err := rw.do(http.MethodGet, remotesigner.KeysPath, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]crypto.Digest, 0, len(resp.Keys))
+	for _, key := range resp.Keys {
+		var addr crypto.Digest
+		if len(key) != len(addr) {
+			return nil, fmt.Errorf("remote signer %s: invalid public key length %d", rw.name, len(key))
+		}
+		copy(addr[:], key)
+		keys = append(keys, addr)
+	}
+	return keys, nil
+}
+
+// ImportKey implements the Wallet interface.
+func (rw *RemoteWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// GenerateKey implements the Wallet interface.
+func (rw *RemoteWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// DeleteKey implements the Wallet interface.
+func (rw *RemoteWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// ImportMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// LookupMultisigPreimage implements the Wallet interface.
+func (rw *RemoteWallet) LookupMultisigPreimage(crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	return 0, 0, nil, errNotSupported
+}
+
+// ListMultisigAddrs implements the Wallet interface.
+func (rw *RemoteWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	return nil, nil
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// SignTransaction implements the Wallet interface. When no key is passed,
+// the transaction is signed with the key of its sender.
+func (rw *RemoteWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Sender)
+	}
+
+	sig, err := rw.signTransactionHelper(tx, pk)
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram implements the Wallet interface.
+func (rw *RemoteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	sig, err := rw.signProgramHelper(data, crypto.PublicKey(src))
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:], nil
+}
+
+// checkMultisigPartial checks that pk can add its signature to the partial
+// multisig of one of the addrs. The wallet does not store multisig preimages,
+// so the partial multisig must list the keys of the multisig account.
+func checkMultisigPartial(partial crypto.MultisigSig, pk crypto.PublicKey, addrs ...crypto.Digest) error {
+	if len(partial.Subsigs) == 0 {
+		return errMsigDataNotFound
+	}
+
+	addr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, a := range addrs {
+		if a == addr {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errMsigWrongAddr
+	}
+
+	for _, subsig := range partial.Subsigs {
+		if subsig.Key == pk {
+			return nil
+		}
+	}
+	return errMsigWrongKey
+}
+
+func addMultisigSubsig(partial crypto.MultisigSig, pk crypto.PublicKey, sig crypto.Signature) crypto.MultisigSig {
+	for i := range partial.Subsigs {
+		if partial.Subsigs[i].Key == pk {
+			partial.Subsigs[i].Sig = sig
+		}
+	}
+	return partial
+}
+
+// MultisigSignTransaction implements the Wallet interface.
+func (rw *RemoteWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	err := checkMultisigPartial(partial, pk, crypto.Digest(tx.Src()), signer)
+	if err != nil {
+		return partial, err
+	}
+
+	sig, err := rw.signTransactionHelper(tx, pk)
+	if err != nil {
+		return partial, err
+	}
+
+	return addMultisigSubsig(partial, pk, sig), nil
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (rw *RemoteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	err := checkMultisigPartial(partial, pk, src)
+	if err != nil {
+		return partial, err
+	}
+
+	sig, err := rw.signProgramHelper(data, pk)
+	if err != nil {
+		return partial, err
+	}
+
+	return addMultisigSubsig(partial, pk, sig), nil
+}
@@ -0,0 +1,302 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/remotesigner"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testCA issues the certificates of the remote signer and of kmd
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func makeTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns a certificate and its key, in PEM, for a server on
+// 127.0.0.1 or for a client
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (certPEM []byte, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// testRemoteSigner is a remote signing service holding one key. When
+// badSignature is set, it signs something else than what is requested.
+type testRemoteSigner struct {
+	secrets      *crypto.SignatureSecrets
+	badSignature bool
+}
+
+func (s *testRemoteSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	writeJSON := func(status int, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(v)
+	}
+
+	switch r.URL.Path {
+	case remotesigner.KeysPath:
+		writeJSON(http.StatusOK, remotesigner.KeysResponse{Keys: [][]byte{s.secrets.SignatureVerifier[:]}})
+	case remotesigner.SignPath:
+		var req remotesigner.SignRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			writeJSON(http.StatusBadRequest, remotesigner.ErrorResponse{Message: err.Error()})
+			return
+		}
+		if string(req.PublicKey) != string(s.secrets.SignatureVerifier[:]) {
+			writeJSON(http.StatusForbidden, remotesigner.ErrorResponse{Message: "unknown key"})
+			return
+		}
+
+		var msg []byte
+		switch req.Kind {
+		case remotesigner.KindTransaction:
+			var tx transactions.Transaction
+			err = protocol.Decode(req.Data, &tx)
+			if err != nil {
+				writeJSON(http.StatusBadRequest, remotesigner.ErrorResponse{Message: err.Error()})
+				return
+			}
+			msg = crypto.HashRep(tx)
+		case remotesigner.KindProgram:
+			msg = crypto.HashRep(logic.Program(req.Data))
+		default:
+			writeJSON(http.StatusBadRequest, remotesigner.ErrorResponse{Message: "unknown kind"})
+			return
+		}
+		if s.badSignature {
+			msg = append(msg, 0)
+		}
+		sig := s.secrets.SignBytes(msg)
+		writeJSON(http.StatusOK, remotesigner.SignResponse{Signature: sig[:]})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// startRemoteSigner starts a remote signer with a certificate issued by ca,
+// which requires client certificates issued by ca
+func startRemoteSigner(t *testing.T, ca *testCA, signer *testRemoteSigner) *httptest.Server {
+	certPEM, keyPEM := ca.issue(t, "signer", x509.ExtKeyUsageServerAuth)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	srv := httptest.NewUnstartedServer(signer)
+	// The handshakes failing on purpose are not worth logging
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// makeTestRemoteWallet configures a remote wallet for url, trusting serverCA
+// and authenticating with a client certificate issued by clientCA
+func makeTestRemoteWallet(t *testing.T, url string, serverCA *testCA, clientCA *testCA) *RemoteWallet {
+	dir := t.TempDir()
+	certPEM, keyPEM := clientCA.issue(t, "kmd", x509.ExtKeyUsageClientAuth)
+	cfg := config.RemoteSignerConfig{
+		Name:        "test",
+		URL:         url,
+		CAFile:      filepath.Join(dir, "ca.pem"),
+		CertFile:    filepath.Join(dir, "cert.pem"),
+		KeyFile:     filepath.Join(dir, "key.pem"),
+		TimeoutSecs: 10,
+	}
+	require.NoError(t, os.WriteFile(cfg.CAFile, serverCA.pem, 0600))
+	require.NoError(t, os.WriteFile(cfg.CertFile, certPEM, 0600))
+	require.NoError(t, os.WriteFile(cfg.KeyFile, keyPEM, 0600))
+
+	w, err := makeRemoteWallet(cfg)
+	require.NoError(t, err)
+	return w
+}
+
+func makeTestRemoteSigner() *testRemoteSigner {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	return &testRemoteSigner{secrets: crypto.GenerateSignatureSecrets(seed)}
+}
+
+func TestRemoteWalletSign(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ca := makeTestCA(t)
+	signer := makeTestRemoteSigner()
+	srv := startRemoteSigner(t, ca, signer)
+	w := makeTestRemoteWallet(t, srv.URL, ca, ca)
+	pk := crypto.PublicKey(signer.secrets.SignatureVerifier)
+
+	keys, err := w.ListKeys()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{crypto.Digest(pk)}, keys)
+
+	// Sign a transaction of the key
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     basics.Address(pk),
+			FirstValid: 1,
+			LastValid:  10,
+		},
+	}
+	encoded, err := w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	var stxn transactions.SignedTxn
+	require.NoError(t, protocol.Decode(encoded, &stxn))
+	require.Equal(t, tx.ID(), stxn.Txn.ID())
+	require.True(t, pk.Verify(tx, stxn.Sig))
+	require.True(t, stxn.AuthAddr.IsZero())
+
+	// Sign a transaction of a rekeyed account
+	tx.Sender = basics.Address{1}
+	encoded, err = w.SignTransaction(tx, pk, nil)
+	require.NoError(t, err)
+	require.NoError(t, protocol.Decode(encoded, &stxn))
+	require.True(t, pk.Verify(tx, stxn.Sig))
+	require.Equal(t, basics.Address(pk), stxn.AuthAddr)
+
+	// Sign a program
+	program := []byte{0x06, 0x81, 0x01}
+	sig, err := w.SignProgram(program, crypto.Digest(pk), nil)
+	require.NoError(t, err)
+	var progSig crypto.Signature
+	copy(progSig[:], sig)
+	require.True(t, pk.Verify(logic.Program(program), progSig))
+
+	// The signer refuses keys it does not hold
+	_, err = w.SignTransaction(tx, crypto.PublicKey{2}, nil)
+	require.ErrorContains(t, err, "unknown key")
+}
+
+func TestRemoteWalletBadSignature(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ca := makeTestCA(t)
+	signer := makeTestRemoteSigner()
+	signer.badSignature = true
+	srv := startRemoteSigner(t, ca, signer)
+	w := makeTestRemoteWallet(t, srv.URL, ca, ca)
+	pk := crypto.PublicKey(signer.secrets.SignatureVerifier)
+
+	tx := transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: basics.Address(pk)},
+	}
+	_, err := w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.ErrorIs(t, err, errRemoteBadSignature)
+
+	_, err = w.SignProgram([]byte{0x06, 0x81, 0x01}, crypto.Digest(pk), nil)
+	require.ErrorIs(t, err, errRemoteBadSignature)
+}
+
+func TestRemoteWalletUntrustedCertificate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ca := makeTestCA(t)
+	otherCA := makeTestCA(t)
+	signer := makeTestRemoteSigner()
+	srv := startRemoteSigner(t, ca, signer)
+
+	// kmd does not trust the certificate of the signer
+	w := makeTestRemoteWallet(t, srv.URL, otherCA, ca)
+	_, err := w.ListKeys()
+	var unknownAuthority x509.UnknownAuthorityError
+	require.ErrorAs(t, err, &unknownAuthority)
+
+	// the signer does not trust the certificate of kmd
+	w = makeTestRemoteWallet(t, srv.URL, ca, otherCA)
+	_, err = w.ListKeys()
+	require.Error(t, err)
+}
@@ -19,6 +19,7 @@ package driver
 import (
 	"bytes"
 	"crypto/subtle"
+	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -31,6 +32,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/kmd/config"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/logging"
@@ -78,7 +80,12 @@ CREATE TABLE IF NOT EXISTS msig_addrs (
 	threshold INT NOT NULL,
 	pks BLOB NOT NULL
 );
-`
+
+CREATE TABLE IF NOT EXISTS hd_seed (
+	seed_encrypted BLOB NOT NULL,
+	account INT NOT NULL
+);
+` + frostSharesSchema
 
 // SQLiteWalletDriver is the default wallet driver used by kmd. Keys are stored
 // as authenticated-encrypted blobs in a sqlite 3 database.
@@ -95,6 +102,8 @@ type SQLiteWalletDriver struct {
 type SQLiteWallet struct {
 	masterEncryptionKey  []byte
 	masterDerivationKey  []byte
+	hdSeed               []byte
+	hdAccount            uint32
 	walletPasswordSalt   [saltLen]byte
 	walletPasswordHash   crypto.Digest
 	walletPasswordHashed bool
@@ -197,6 +206,12 @@ func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
 		return
 	}
 
+	// Wallets recovered from a BIP-39 seed have no master derivation key
+	_, _, hd, err := hdSeedFromDB(db)
+	if err != nil {
+		return
+	}
+
 	// Build the Metadata
 	metadata = wallet.Metadata{
 		ID:                    walletID,
@@ -204,13 +219,40 @@ func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
 		DriverName:            driverName,
 		DriverVersion:         driverVersion,
 		SupportsMnemonicUX:    sqliteWalletHasMnemonicUX,
-		SupportsMasterKey:     sqliteWalletHasMasterKey,
+		SupportsMasterKey:     sqliteWalletHasMasterKey && !hd,
 		SupportedTransactions: sqliteWalletSupportedTxs,
 	}
 
 	return
 }
 
+// hdSeedFromDB fetches the encrypted BIP-39 seed and the account the keys of
+// the wallet are derived from, if the wallet was recovered from a BIP-39
+// mnemonic. Databases created before BIP-39 support have no hd_seed table.
+func hdSeedFromDB(db *sqlx.DB) (encryptedSeed []byte, account uint32, found bool, err error) {
+	var cnt int
+	err = db.Get(&cnt, "SELECT COUNT(1) FROM sqlite_master WHERE type='table' AND name='hd_seed'")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	if cnt == 0 {
+		return
+	}
+
+	err = db.QueryRow("SELECT seed_encrypted, account FROM hd_seed LIMIT 1").Scan(&encryptedSeed, &account)
+	if err == sql.ErrNoRows {
+		err = nil
+		return
+	}
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	found = true
+	return
+}
+
 // walletMetadataFromDBPath accepts path to a sqlite wallet database and
 // returns a Metadata struct with information about it
 func walletMetadataFromDBPath(dbPath string) (metadata wallet.Metadata, err error) {
@@ -412,6 +454,24 @@ func (swd *SQLiteWalletDriver) claimWalletNameID(name []byte, id []byte) (dbPath
 // CreateWallet ensures that a wallet of the given name/id combo doesn't exist,
 // and initializes a database with the appropriate name.
 func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return swd.createWallet(name, id, pw, mdk, nil, 0)
+}
+
+// CreateHDWallet creates a wallet whose keys are derived from a BIP-39 seed,
+// at the hierarchical derivation paths of account
+func (swd *SQLiteWalletDriver) CreateHDWallet(name []byte, id []byte, pw []byte, seed []byte, account uint32) error {
+	if len(seed) == 0 {
+		return errHDSeed
+	}
+	if account >= hdHardenedOffset {
+		return errHDAccount
+	}
+	return swd.createWallet(name, id, pw, crypto.MasterDerivationKey{}, seed, account)
+}
+
+// createWallet is the guts of CreateWallet and CreateHDWallet. hdSeed is nil
+// for wallets deriving their keys from the master derivation key.
+func (swd *SQLiteWalletDriver) createWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, hdSeed []byte, hdAccount uint32) error {
 	if len(name) > sqliteMaxWalletNameLen {
 		return errNameTooLong
 	}
@@ -485,6 +545,19 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 		return errDatabase
 	}
 
+	// Store the BIP-39 seed, encrypted like the master derivation key
+	if hdSeed != nil {
+		encryptedSeedBlob, err := encryptBlobWithKey(hdSeed, PTHDSeed, masterKey[:])
+		if err != nil {
+			return err
+		}
+
+		_, err = db.Exec("INSERT INTO hd_seed (seed_encrypted, account) VALUES(?, ?)", encryptedSeedBlob, hdAccount)
+		if err != nil {
+			return errDatabase
+		}
+	}
+
 	return nil
 }
 
@@ -647,6 +720,30 @@ func (sw *SQLiteWallet) decryptAndGetMasterDerivationKey(pw []byte) ([]byte, err
 	return mdk, nil
 }
 
+// decryptAndGetHDSeed fetches the BIP-39 seed of the wallet and attempts to
+// decrypt it with the master password. It returns a nil seed for wallets
+// which are not recovered from a BIP-39 mnemonic.
+func (sw *SQLiteWallet) decryptAndGetHDSeed(pw []byte) ([]byte, uint32, error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return nil, 0, errDatabaseConnect
+	}
+	defer db.Close()
+
+	encryptedSeedBlob, account, found, err := hdSeedFromDB(db)
+	if err != nil || !found {
+		return nil, 0, err
+	}
+
+	seed, err := decryptBlobWithPassword(encryptedSeedBlob, PTHDSeed, pw)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return seed, account, nil
+}
+
 // Init attempts to decrypt the master encrypt password and master derivation
 // key, and store them in memory for subsequent operations
 func (sw *SQLiteWallet) Init(pw []byte) error {
@@ -662,9 +759,17 @@ func (sw *SQLiteWallet) Init(pw []byte) error {
 		return err
 	}
 
+	// Decrypt the BIP-39 seed, if the wallet has one
+	hdSeed, hdAccount, err := sw.decryptAndGetHDSeed(masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+
 	// Initialize wallet
 	sw.masterEncryptionKey = masterEncryptionKey
 	sw.masterDerivationKey = masterDerivationKey
+	sw.hdSeed = hdSeed
+	sw.hdAccount = hdAccount
 	err = fillRandomBytes(sw.walletPasswordSalt[:])
 	if err != nil {
 		return err
@@ -726,6 +831,13 @@ func (sw *SQLiteWallet) ExportMasterDerivationKey(pw []byte) (mdk crypto.MasterD
 		return
 	}
 
+	// The keys of wallets recovered from a BIP-39 seed are not derived from
+	// the master derivation key, so it would not back them up
+	if sw.hdSeed != nil {
+		err = errHDNoMasterKey
+		return
+	}
+
 	// Copy master derivation key into the result
 	copy(mdk[:], sw.masterDerivationKey)
 	return
@@ -782,34 +894,28 @@ func (sw *SQLiteWallet) ExportKey(addr crypto.Digest, pw []byte) (sk crypto.Priv
 	return sw.fetchSecretKey(addr)
 }
 
-// fetchSecretKey retrieves the private key for a given public key
+// fetchSecretKey retrieves the private key for a given public key. Keys
+// derived from a BIP-39 seed are extended secret keys, which cannot be
+// returned as ed25519 private keys.
 func (sw *SQLiteWallet) fetchSecretKey(addr crypto.Digest) (sk crypto.PrivateKey, err error) {
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	typedSK, err := sw.fetchTypedSecretKey(addr)
 	if err != nil {
-		err = errDatabaseConnect
 		return
 	}
-	defer db.Close()
 
-	var skCandidate crypto.PrivateKey
-	var blob []byte
-
-	// Fetch the encrypted secret key from the database
-	err = db.Get(&blob, "SELECT secret_key_encrypted FROM keys WHERE address=?", addr[:])
-	if err != nil {
-		err = errKeyNotFound
+	switch typedSK.Type {
+	case PTSecretKey:
+	case PTHDSecretKey:
+		err = errHDKeyExport
 		return
-	}
-
-	// Decrypt the secret key
-	skEncoded, err := decryptBlobWithPassword(blob, PTSecretKey, sw.masterEncryptionKey)
-	if err != nil {
+	default:
+		err = errTypeMismatch
 		return
 	}
 
 	// Decode the secret key candidate
-	err = msgpackDecode(skEncoded, &skCandidate)
+	var skCandidate crypto.PrivateKey
+	err = msgpackDecode(typedSK.Plaintext, &skCandidate)
 	if err != nil {
 		return
 	}
@@ -835,6 +941,97 @@ func (sw *SQLiteWallet) fetchSecretKey(addr crypto.Digest) (sk crypto.PrivateKey
 	return
 }
 
+// walletSigner signs with a key of the wallet, which may be an ed25519
+// private key or a BIP32-Ed25519 extended secret key
+type walletSigner struct {
+	pk   crypto.PublicKey
+	sign func(crypto.Hashable) crypto.Signature
+}
+
+// signTransaction signs tx like transactions.Transaction.Sign, setting the
+// AuthAddr if the key does not match the transaction sender
+func (s walletSigner) signTransaction(tx transactions.Transaction) transactions.SignedTxn {
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: s.sign(tx),
+	}
+	if basics.Address(s.pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(s.pk)
+	}
+	return stxn
+}
+
+// fetchSigner retrieves the secret key for a given public key, of either
+// type, and returns a signer for it
+func (sw *SQLiteWallet) fetchSigner(addr crypto.Digest) (signer walletSigner, err error) {
+	typedSK, err := sw.fetchTypedSecretKey(addr)
+	if err != nil {
+		return
+	}
+
+	switch typedSK.Type {
+	case PTSecretKey:
+		var sk crypto.PrivateKey
+		err = msgpackDecode(typedSK.Plaintext, &sk)
+		if err != nil {
+			return
+		}
+		var secrets *crypto.SignatureSecrets
+		secrets, err = crypto.SecretKeyToSignatureSecrets(sk)
+		if err != nil {
+			err = errSKToPK
+			return
+		}
+		signer = walletSigner{pk: secrets.SignatureVerifier, sign: secrets.Sign}
+	case PTHDSecretKey:
+		var sk crypto.ExtendedSecretKey
+		err = msgpackDecode(typedSK.Plaintext, &sk)
+		if err != nil {
+			return
+		}
+		var secrets *crypto.ExtendedSignatureSecrets
+		secrets, err = sk.SignatureSecrets()
+		if err != nil {
+			err = errSKToPK
+			return
+		}
+		signer = walletSigner{pk: secrets.SignatureVerifier, sign: secrets.Sign}
+	default:
+		err = errTypeMismatch
+		return
+	}
+
+	// Ensure the derived address matches the one we used to look the key up
+	if addr != publicKeyToAddress(signer.pk) {
+		err = errTampering
+		return
+	}
+	return
+}
+
+// fetchTypedSecretKey retrieves and decrypts the secret key for a given
+// public key, along with its plaintext type
+func (sw *SQLiteWallet) fetchTypedSecretKey(addr crypto.Digest) (typedSK typedPlaintext, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	// Fetch the encrypted secret key from the database
+	var blob []byte
+	err = db.Get(&blob, "SELECT secret_key_encrypted FROM keys WHERE address=?", addr[:])
+	if err != nil {
+		err = errKeyNotFound
+		return
+	}
+
+	// Decrypt the secret key
+	return decryptTypedBlobWithPassword(blob, sw.masterEncryptionKey)
+}
+
 // GenerateKey generates a key from system entropy and imports it
 func (sw *SQLiteWallet) GenerateKey(displayMnemonic bool) (addr crypto.Digest, err error) {
 	// Connect to the database
@@ -907,7 +1104,8 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 	nextIndex := highestIndex + 1
 
 	var genPK crypto.PublicKey
-	var genSK crypto.PrivateKey
+	var genSKEncoded []byte
+	var genSKType plaintextType
 	var genAddr crypto.Digest
 
 	// We may have to bump nextIndex if the user has manually imported the next
@@ -920,8 +1118,17 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 			return
 		}
 
-		// Compute the secret key and public key for nextIndex
-		genPK, genSK, err = extractKeyWithIndex(sw.masterDerivationKey, nextIndex)
+		// Compute the secret key and public key for nextIndex. Indices start
+		// at one, while BIP-39 wallets number their keys from zero.
+		if sw.hdSeed != nil {
+			var genSK crypto.ExtendedSecretKey
+			genPK, genSK, err = extractHDKeyWithIndex(sw.hdSeed, sw.hdAccount, nextIndex-1)
+			genSKEncoded, genSKType = msgpackEncode(genSK), PTHDSecretKey
+		} else {
+			var genSK crypto.PrivateKey
+			genPK, genSK, err = extractKeyWithIndex(sw.masterDerivationKey, nextIndex)
+			genSKEncoded, genSKType = msgpackEncode(genSK), PTSecretKey
+		}
 		if err != nil {
 			return
 		}
@@ -947,7 +1154,7 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 	}
 
 	// Encrypt the encoded secret key
-	skEncrypted, err := encryptBlobWithKey(msgpackEncode(genSK), PTSecretKey, sw.masterEncryptionKey)
+	skEncrypted, err := encryptBlobWithKey(genSKEncoded, genSKType, sw.masterEncryptionKey)
 	if err != nil {
 		return
 	}
@@ -1118,25 +1325,18 @@ func (sw *SQLiteWallet) SignTransaction(tx transactions.Transaction, pk crypto.P
 	}
 
 	// Fetch the required key
-	var sk crypto.PrivateKey
+	var signer walletSigner
 	if (pk == crypto.PublicKey{}) {
-		sk, err = sw.fetchSecretKey(crypto.Digest(tx.Src()))
+		signer, err = sw.fetchSigner(crypto.Digest(tx.Src()))
 	} else {
-		sk, err = sw.fetchSecretKey(crypto.Digest(pk))
+		signer, err = sw.fetchSigner(crypto.Digest(pk))
 	}
 	if err != nil {
 		return
 	}
 
-	// Generate the signature secrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
-	if err != nil {
-		err = errSKToPK
-		return
-	}
-
 	// Sign the transaction
-	stxn := tx.Sign(secrets)
+	stxn := signer.signTransaction(tx)
 	stx = protocol.Encode(&stxn)
 	return
 }
@@ -1150,21 +1350,14 @@ func (sw *SQLiteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) (
 	}
 
 	// Fetch the required key
-	sk, err := sw.fetchSecretKey(crypto.Digest(src))
-	if err != nil {
-		return
-	}
-
-	// Generate the signature secrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	signer, err := sw.fetchSigner(crypto.Digest(src))
 	if err != nil {
-		err = errSKToPK
 		return
 	}
 
 	progb := logic.Program(data)
 	// Sign the transaction
-	sig := secrets.Sign(&progb)
+	sig := signer.sign(&progb)
 	stx = sig[:]
 	return
 }
@@ -1191,23 +1384,15 @@ func (sw *SQLiteWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 		}
 
 		// Fetch the required secret key
-		var sk crypto.PrivateKey
-		sk, err = sw.fetchSecretKey(publicKeyToAddress(pk))
-		if err != nil {
-			return
-		}
-
-		// Convert the secret key to crypto.SignatureSecrets
-		var secrets *crypto.SignatureSecrets
-		secrets, err = crypto.SecretKeyToSignatureSecrets(sk)
+		var signer walletSigner
+		signer, err = sw.fetchSigner(publicKeyToAddress(pk))
 		if err != nil {
-			err = errSKToPK
 			return
 		}
 
 		// Sign the transaction
 		from := crypto.Digest(tx.Src())
-		sig, err = crypto.MultisigSign(tx, from, version, threshold, pks, *secrets)
+		sig, err = crypto.MultisigSignWith(tx, from, version, threshold, pks, signer.pk, signer.sign)
 		return
 	}
 
@@ -1239,20 +1424,14 @@ func (sw *SQLiteWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 	}
 
 	// Fetch the required secret key
-	sk, err := sw.fetchSecretKey(publicKeyToAddress(pk))
-	if err != nil {
-		return
-	}
-
-	// Convert the secret key to crypto.SignatureSecrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	signer, err := sw.fetchSigner(publicKeyToAddress(pk))
 	if err != nil {
 		return
 	}
 
 	// Sign the transaction, and merge the multisig into the partial
 	version, threshold, pks := partial.Preimage()
-	msig2, err := crypto.MultisigSign(tx, addr, version, threshold, pks, *secrets)
+	msig2, err := crypto.MultisigSignWith(tx, addr, version, threshold, pks, signer.pk, signer.sign)
 	if err != nil {
 		return
 	}
@@ -1282,24 +1461,16 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 		}
 
 		// Fetch the required secret key
-		var sk crypto.PrivateKey
-		sk, err = sw.fetchSecretKey(publicKeyToAddress(pk))
-		if err != nil {
-			return
-		}
-
-		// Convert the secret key to crypto.SignatureSecrets
-		var secrets *crypto.SignatureSecrets
-		secrets, err = crypto.SecretKeyToSignatureSecrets(sk)
+		var signer walletSigner
+		signer, err = sw.fetchSigner(publicKeyToAddress(pk))
 		if err != nil {
-			err = errSKToPK
 			return
 		}
 
 		// Sign the transaction
 		from := src
 		progb := logic.Program(data)
-		sig, err = crypto.MultisigSign(&progb, from, version, threshold, pks, *secrets)
+		sig, err = crypto.MultisigSignWith(&progb, from, version, threshold, pks, signer.pk, signer.sign)
 		return
 	}
 
@@ -1329,13 +1500,7 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 	}
 
 	// Fetch the required secret key
-	sk, err := sw.fetchSecretKey(publicKeyToAddress(pk))
-	if err != nil {
-		return
-	}
-
-	// Convert the secret key to crypto.SignatureSecrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	signer, err := sw.fetchSigner(publicKeyToAddress(pk))
 	if err != nil {
 		return
 	}
@@ -1343,7 +1508,7 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 	// Sign the transaction, and merge the multisig into the partial
 	version, threshold, pks := partial.Preimage()
 	progb := logic.Program(data)
-	msig2, err := crypto.MultisigSign(&progb, addr, version, threshold, pks, *secrets)
+	msig2, err := crypto.MultisigSignWith(&progb, addr, version, threshold, pks, signer.pk, signer.sign)
 	if err != nil {
 		return
 	}
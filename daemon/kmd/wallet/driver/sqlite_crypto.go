@@ -51,6 +51,14 @@ var (
 	PTMasterDerivationKey plaintextType = "master_derivation_key"
 	// PTMaxKeyIdx is the plaintext type for the maximum key index
 	PTMaxKeyIdx plaintextType = "max_key_idx"
+	// PTHDSeed is the plaintext type for the BIP-39 seed of hierarchical
+	// deterministic wallets
+	PTHDSeed plaintextType = "hd_seed"
+	// PTHDSecretKey is the plaintext type for a BIP32-Ed25519 extended secret
+	// key, derived from the BIP-39 seed
+	PTHDSecretKey plaintextType = "hd_secret_key"
+	// PTFrostShare is the plaintext type for a FROST key share
+	PTFrostShare plaintextType = "frost_share"
 )
 
 // typedPlaintext prevents us from confusing differently typed data encrypted
@@ -184,6 +192,23 @@ func encryptBlobWithPasswordBlankOK(plaintext []byte, ptType plaintextType, pass
 }
 
 func decryptBlobWithPassword(blob []byte, ptType plaintextType, password []byte) (plaintext []byte, err error) {
+	typedPT, err := decryptTypedBlobWithPassword(blob, password)
+	if err != nil {
+		return
+	}
+
+	// Make sure the type is what we expected
+	if typedPT.Type != ptType {
+		err = errTypeMismatch
+		return
+	}
+
+	return typedPT.Plaintext, nil
+}
+
+// decryptTypedBlobWithPassword decrypts a blob holding any type of plaintext,
+// for the callers that accept several types
+func decryptTypedBlobWithPassword(blob []byte, password []byte) (typedPT typedPlaintext, err error) {
 	// Decode blob from msgpack
 	var dbblob encryptedDBBlob
 	err = msgpackDecode(blob, &dbblob)
@@ -210,23 +235,13 @@ func decryptBlobWithPassword(blob []byte, ptType plaintextType, password []byte)
 	// Decrypt the ciphertext
 	encodedPT, ok := secretbox.Open(nil, dbblob.Ciphertext, &dbblob.Nonce, key)
 	if !ok {
-		return nil, errDecrypt
+		err = errDecrypt
+		return
 	}
 
 	// Decode the typedPlaintext
-	var typedPT typedPlaintext
 	err = msgpackDecode(encodedPT, &typedPT)
-	if err != nil {
-		return
-	}
-
-	// Make sure the type is what we expected
-	if typedPT.Type != ptType {
-		err = errTypeMismatch
-		return
-	}
-
-	return typedPT.Plaintext, nil
+	return
 }
 
 // extractKeyWithIndex accepts the master derivation key and an index which
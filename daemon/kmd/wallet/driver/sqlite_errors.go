@@ -45,3 +45,8 @@ var errNameTooLong = fmt.Errorf("wallet name too long, must be <= %d bytes", sql
 var errIDTooLong = fmt.Errorf("wallet id too long, must be <= %d bytes", sqliteMaxWalletIDLen)
 var errMsigWrongAddr = fmt.Errorf("given multisig preimage hashes to neither Sender nor AuthAddr")
 var errMsigWrongKey = fmt.Errorf("given key is not a possible signer for this multisig")
+var errHDSeed = fmt.Errorf("BIP-39 seed must not be empty")
+var errHDAccount = fmt.Errorf("BIP-39 account index must be less than %d", hdHardenedOffset)
+var errHDNoMasterKey = fmt.Errorf("wallet keys are derived from a BIP-39 mnemonic, which backs up the wallet instead of a master derivation key")
+var errHDKeyExport = fmt.Errorf("key is derived from the wallet's BIP-39 mnemonic as a BIP32-Ed25519 extended key, which cannot be exported as an ed25519 secret key")
+var errFrostShareNotFound = fmt.Errorf("FROST key share does not exist in this wallet")
@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// frostSharesSchema holds the FROST key shares of the wallet, keyed by the
+// address of their group key. Wallets created before FROST support lack the
+// table, which is created along with the first share imported.
+const frostSharesSchema = `
+CREATE TABLE IF NOT EXISTS frost_shares (
+	address BLOB PRIMARY KEY,
+	share_encrypted BLOB NOT NULL
+);
+`
+
+// ImportFrostShare imports a FROST key share into the wallet, after checking
+// that its verifying share matches its secret share
+func (sw *SQLiteWallet) ImportFrostShare(share crypto.FrostKeyShare) (addr crypto.Digest, err error) {
+	err = share.Validate()
+	if err != nil {
+		return
+	}
+
+	// Encrypt the encoded share
+	shareEncrypted, err := encryptBlobWithKey(msgpackEncode(share), PTFrostShare, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	_, err = db.Exec(frostSharesSchema)
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	addr = publicKeyToAddress(share.GroupKey)
+	_, err = db.Exec("INSERT INTO frost_shares (address, share_encrypted) VALUES(?, ?)", addr[:], shareEncrypted)
+	err = checkDBError(err)
+	return
+}
+
+// ListFrostShares lists the addresses of the group keys of the FROST key
+// shares in the wallet
+func (sw *SQLiteWallet) ListFrostShares() (addrs []crypto.Digest, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	found, err := frostSharesTableExists(db)
+	if err != nil || !found {
+		return
+	}
+
+	var addrByteSlices [][]byte
+	var tmp crypto.Digest
+	err = db.Select(&addrByteSlices, "SELECT address FROM frost_shares")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	for _, addr := range addrByteSlices {
+		copy(tmp[:], addr)
+		addrs = append(addrs, tmp)
+	}
+	return
+}
+
+// FrostCommit generates the nonces of a new signature by the FROST key share
+// of the group key with the given address, and the commitment to them
+func (sw *SQLiteWallet) FrostCommit(addr crypto.Digest) (nonces crypto.FrostNonces, commitment crypto.FrostCommitment, err error) {
+	share, err := sw.fetchFrostShare(addr)
+	if err != nil {
+		return
+	}
+	return share.Commit()
+}
+
+// FrostSign computes the signature share of msg by the FROST key share of the
+// group key with the given address
+func (sw *SQLiteWallet) FrostSign(addr crypto.Digest, nonces crypto.FrostNonces, commitments []crypto.FrostCommitment, msg []byte, pw []byte) (sigShare crypto.FrostSignatureShare, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	share, err := sw.fetchFrostShare(addr)
+	if err != nil {
+		return
+	}
+	return share.Sign(nonces, commitments, msg)
+}
+
+// fetchFrostShare retrieves and decrypts the FROST key share of the group key
+// with the given address
+func (sw *SQLiteWallet) fetchFrostShare(addr crypto.Digest) (share crypto.FrostKeyShare, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	found, err := frostSharesTableExists(db)
+	if err != nil {
+		return
+	}
+	if !found {
+		err = errFrostShareNotFound
+		return
+	}
+
+	var blob []byte
+	err = db.Get(&blob, "SELECT share_encrypted FROM frost_shares WHERE address=?", addr[:])
+	if err != nil {
+		err = errFrostShareNotFound
+		return
+	}
+
+	plaintext, err := decryptBlobWithPassword(blob, PTFrostShare, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(plaintext, &share)
+	if err != nil {
+		return
+	}
+
+	// Ensure the share matches the address we used to look it up
+	if addr != publicKeyToAddress(share.GroupKey) {
+		err = errTampering
+		return
+	}
+	return
+}
+
+func frostSharesTableExists(db *sqlx.DB) (bool, error) {
+	var cnt int
+	err := db.Get(&cnt, "SELECT COUNT(1) FROM sqlite_master WHERE type='table' AND name='frost_shares'")
+	if err != nil {
+		return false, errDatabase
+	}
+	return cnt > 0, nil
+}
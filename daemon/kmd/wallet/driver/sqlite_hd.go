@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// Wallets recovered from a BIP-39 seed derive their keys with BIP32-Ed25519,
+// as specified by ARC-52, at the path m/44'/283'/account'/0/index. 283 is the
+// coin type registered for Algorand in SLIP-0044. These are the keys of the
+// same mnemonic in the Ledger and Pera wallets. Derived keys are extended
+// secret keys rather than ed25519 seeds, so they are stored with their own
+// plaintext type and cannot be exported as ed25519 secret keys.
+const (
+	hdHardenedOffset = crypto.BIP32Hardened
+	hdPurpose        = 44
+	hdCoinType       = 283
+	hdChange         = 0
+)
+
+// hdPath returns the derivation path of the key at index of account
+func hdPath(account uint32, index uint32) []uint32 {
+	return []uint32{hdPurpose | hdHardenedOffset, hdCoinType | hdHardenedOffset, account | hdHardenedOffset, hdChange, index}
+}
+
+// extractHDKeyWithIndex derives the key at index of account from a BIP-39
+// seed. Keys are numbered from zero, so the first key generated by a wallet
+// is the first key of the account in other BIP-39 wallets.
+func extractHDKeyWithIndex(seed []byte, account uint32, index uint64) (pk crypto.PublicKey, sk crypto.ExtendedSecretKey, err error) {
+	if index >= hdHardenedOffset {
+		err = errTooManyKeys
+		return
+	}
+
+	key, err := crypto.BIP32Ed25519MasterKey(seed).DerivePath(hdPath(account, uint32(index)))
+	if err != nil {
+		return
+	}
+	pk, err = key.SK.PublicKey()
+	if err != nil {
+		err = errSKToPK
+		return
+	}
+	return pk, key.SK, nil
+}
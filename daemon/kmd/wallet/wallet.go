@@ -59,6 +59,27 @@ type Wallet interface {
 	MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error)
 }
 
+// DisplayHinter is implemented by the wallets which ask the user to confirm
+// the transactions they sign, like hardware wallets. DisplayHints describes a
+// transaction the way the user should check it before confirming it, so that
+// clients can show it alongside the signing request.
+type DisplayHinter interface {
+	DisplayHints(tx transactions.Transaction) []string
+}
+
+// FrostSigner is implemented by the wallets which hold FROST key shares: the
+// shares of ed25519 keys split between several participants, a threshold of
+// which sign together. Signing takes two rounds. FrostCommit returns the
+// nonces of a new signature along with the commitment to publish to the other
+// signers; the caller must keep the nonces secret and pass them to FrostSign
+// once only, with the commitments of all the signers.
+type FrostSigner interface {
+	ImportFrostShare(share crypto.FrostKeyShare) (crypto.Digest, error)
+	ListFrostShares() ([]crypto.Digest, error)
+	FrostCommit(addr crypto.Digest) (crypto.FrostNonces, crypto.FrostCommitment, error)
+	FrostSign(addr crypto.Digest, nonces crypto.FrostNonces, commitments []crypto.FrostCommitment, msg []byte, pw []byte) (crypto.FrostSignatureShare, error)
+}
+
 // Metadata represents high-level information about a wallet, like its name, id
 // and what operations it supports
 type Metadata struct {
@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/pkcs11"
+)
+
+// KeySignerProviderPKCS11 is the ParticipationKeySignerProvider holding the participation keys in a PKCS#11 token.
+const KeySignerProviderPKCS11 = "pkcs11"
+
+// OpenKeySigner opens the key signer selected by the ParticipationKeySignerProvider of cfg, which the participation
+// keys are generated in. It returns nil when no provider is configured. The node, goal and algokey all open it, so
+// that the keys they generate are held by the same token.
+//
+// The signer must hold every key type of the participation keys: it is refused when it does not support the ed25519
+// voting keys, or the falcon state proof keys unless PKCS11StateProofKeysInDatabase is set.
+func OpenKeySigner(cfg config.Local) (*pkcs11.Signer, error) {
+	switch cfg.ParticipationKeySignerProvider {
+	case "":
+		return nil, nil
+	case KeySignerProviderPKCS11:
+	default:
+		return nil, fmt.Errorf("unknown ParticipationKeySignerProvider %q", cfg.ParticipationKeySignerProvider)
+	}
+
+	pin, err := os.ReadFile(cfg.PKCS11PinFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read PKCS11PinFile: %w", err)
+	}
+	signer, err := pkcs11.Open(cfg.PKCS11ModulePath, cfg.PKCS11TokenLabel, strings.TrimSpace(string(pin)))
+	if err != nil {
+		return nil, err
+	}
+
+	if !signer.Supports(crypto.Ed25519Algorithm) {
+		signer.Close()
+		return nil, fmt.Errorf("PKCS#11 token %s does not support ed25519 keys, which the voting keys need", cfg.PKCS11TokenLabel)
+	}
+	if !signer.Supports(crypto.FalconAlgorithm) && !cfg.PKCS11StateProofKeysInDatabase {
+		signer.Close()
+		return nil, fmt.Errorf("PKCS#11 token %s does not support falcon keys, which the state proof keys need: set PKCS11StateProofKeysInDatabase to keep them in the participation database", cfg.PKCS11TokenLabel)
+	}
+	return signer, nil
+}
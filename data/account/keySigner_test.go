@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestOpenKeySigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	signer, err := OpenKeySigner(cfg)
+	require.NoError(t, err)
+	require.Nil(t, signer)
+
+	cfg.ParticipationKeySignerProvider = "hsm"
+	_, err = OpenKeySigner(cfg)
+	require.ErrorContains(t, err, "unknown ParticipationKeySignerProvider")
+
+	cfg.ParticipationKeySignerProvider = KeySignerProviderPKCS11
+	cfg.PKCS11PinFile = filepath.Join(t.TempDir(), "missing")
+	_, err = OpenKeySigner(cfg)
+	require.ErrorContains(t, err, "cannot read PKCS11PinFile")
+
+	module := os.Getenv("ALGORAND_TEST_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("ALGORAND_TEST_PKCS11_MODULE is not set")
+	}
+	cfg.PKCS11ModulePath = module
+	cfg.PKCS11TokenLabel = os.Getenv("ALGORAND_TEST_PKCS11_TOKEN")
+	cfg.PKCS11PinFile = filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, os.WriteFile(cfg.PKCS11PinFile, []byte(os.Getenv("ALGORAND_TEST_PKCS11_PIN")+"\n"), 0600))
+
+	cfg.PKCS11StateProofKeysInDatabase = true
+	signer, err = OpenKeySigner(cfg)
+	if err != nil {
+		require.ErrorContains(t, err, "does not support ed25519 keys")
+		t.Skip("the token does not support EdDSA")
+	}
+	require.True(t, signer.Supports(crypto.Ed25519Algorithm))
+	signer.Close()
+
+	// the token cannot hold the falcon state proof keys
+	cfg.PKCS11StateProofKeysInDatabase = false
+	_, err = OpenKeySigner(cfg)
+	require.ErrorContains(t, err, "does not support falcon keys")
+}
@@ -217,6 +217,12 @@ func (part PersistedParticipation) PersistNewParent() error {
 
 // FillDBWithParticipationKeys initializes the passed database with participation keys
 func FillDBWithParticipationKeys(store db.Accessor, address basics.Address, firstValid, lastValid basics.Round, keyDilution uint64) (part PersistedParticipation, err error) {
+	return FillDBWithParticipationKeysWithSigner(store, address, firstValid, lastValid, keyDilution, nil)
+}
+
+// FillDBWithParticipationKeysWithSigner is a version of FillDBWithParticipationKeys generating the voting
+// keys in signer, when it is not nil. The database then only holds their public keys.
+func FillDBWithParticipationKeysWithSigner(store db.Accessor, address basics.Address, firstValid, lastValid basics.Round, keyDilution uint64, signer crypto.KeySigner) (part PersistedParticipation, err error) {
 	if lastValid < firstValid {
 		err = fmt.Errorf("FillDBWithParticipationKeys: firstValid %d is after lastValid %d", firstValid, lastValid)
 		return
@@ -233,7 +239,20 @@ func FillDBWithParticipationKeys(store db.Accessor, address basics.Address, firs
 	numBatches := lastID.Batch - firstID.Batch + 1
 
 	// Generate them
-	v := crypto.GenerateOneTimeSignatureSecrets(firstID.Batch, numBatches)
+	var v *crypto.OneTimeSignatureSecrets
+	if signer != nil {
+		v, err = crypto.GenerateOneTimeSignatureSecretsWithSigner(firstID.Batch, numBatches, signer)
+		if err != nil {
+			return PersistedParticipation{}, err
+		}
+		defer func() {
+			if err != nil {
+				v.DestroySignerSubkeys()
+			}
+		}()
+	} else {
+		v = crypto.GenerateOneTimeSignatureSecrets(firstID.Batch, numBatches)
+	}
 
 	// Generate a new VRF key, which lives in the participation keys db
 	vrf := crypto.GenerateVRFSecrets()
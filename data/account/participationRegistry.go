@@ -28,6 +28,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
@@ -104,6 +105,23 @@ type (
 		StateProofSecrets *merklesignature.Signer
 	}
 
+	// ParticipationSecrets contains all the secrets of an installed participation key, as needed to install it
+	// on another node: the voting secrets not yet deleted, and the remaining state proof keys.
+	//msgp:ignore ParticipationSecrets
+	ParticipationSecrets struct {
+		_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+		Parent      basics.Address `codec:"addr"`
+		FirstValid  basics.Round   `codec:"fv"`
+		LastValid   basics.Round   `codec:"lv"`
+		KeyDilution uint64         `codec:"kd"`
+
+		VRF                   *crypto.VRFSecrets              `codec:"vrf"`
+		Voting                *crypto.OneTimeSignatureSecrets `codec:"vote"`
+		StateProofContext     *merklesignature.SignerContext  `codec:"spctx"`
+		StateProofSigningKeys StateProofKeys                  `codec:"spkeys"`
+	}
+
 	// SortUint64 implements sorting by uint64 keys for
 	// canonical encoding of maps in msgpack format.
 	SortUint64 = basics.SortUint64
@@ -180,6 +198,41 @@ func (r ParticipationRecord) OverlapsInterval(first, last basics.Round) bool {
 	return true
 }
 
+// KeyregTxnFields returns the fields of the keyreg transaction registering the participation key.
+func (r ParticipationRecord) KeyregTxnFields(includeStateProofKeys bool) transactions.KeyregTxnFields {
+	fields := transactions.KeyregTxnFields{
+		VoteFirst:       r.FirstValid,
+		VoteLast:        r.LastValid,
+		VoteKeyDilution: r.KeyDilution,
+	}
+	if r.Voting != nil {
+		fields.VotePK = r.Voting.OneTimeSignatureVerifier
+	}
+	if r.VRF != nil {
+		fields.SelectionPK = r.VRF.PK
+	}
+	if includeStateProofKeys && r.StateProof != nil {
+		fields.StateProofPK = r.StateProof.Commitment
+	}
+	return fields
+}
+
+// Participation returns the participation key, without its state proof keys which are appended separately.
+func (s ParticipationSecrets) Participation() Participation {
+	part := Participation{
+		Parent:      s.Parent,
+		VRF:         s.VRF,
+		Voting:      s.Voting,
+		FirstValid:  s.FirstValid,
+		LastValid:   s.LastValid,
+		KeyDilution: s.KeyDilution,
+	}
+	if s.StateProofContext != nil {
+		part.StateProofSecrets = &merklesignature.Secrets{SignerContext: *s.StateProofContext}
+	}
+	return part
+}
+
 // ParticipationAction is used when recording participation actions.
 //
 //msgp:ignore ParticipationAction
@@ -222,6 +275,13 @@ var ErrNoKeyForID = errors.New("no valid key found for the participationID")
 // ErrSecretNotFound is used when attempting to lookup secrets for a particular round.
 var ErrSecretNotFound = errors.New("the participation ID did not have secrets for the requested round")
 
+// ErrParticipationKeyHeldBySigner is used when exporting a participation key whose voting keys are held by a key signer.
+var ErrParticipationKeyHeldBySigner = errors.New("the voting keys of the participation key are held by the key signer and cannot be exported")
+
+// ErrParticipationKeyNotHeldBySigner is used when installing a participation key whose voting keys are in memory on a
+// node holding its voting keys in a key signer.
+var ErrParticipationKeyNotHeldBySigner = errors.New("the node holds the voting keys in its key signer, and does not install participation keys whose voting keys are not: generate them with the key signer")
+
 // ErrStateProofVerifierNotFound states that no state proof field was found.
 var ErrStateProofVerifierNotFound = errors.New("record contains no StateProofVerifier")
 
@@ -255,6 +315,9 @@ type ParticipationRegistry interface {
 	// GetStateProofSecretsForRound fetches a record with stateproof secrets for a particular round.
 	GetStateProofSecretsForRound(id ParticipationID, round basics.Round) (StateProofSecretsForRound, error)
 
+	// Export fetches a record with all of its secrets, so that it can be installed on another node.
+	Export(id ParticipationID) (ParticipationSecrets, error)
+
 	// HasLiveKeys quickly tests to see if there is a valid participation key over some range of rounds
 	HasLiveKeys(from, to basics.Round) bool
 
@@ -367,6 +430,10 @@ const (
 		FROM StateProofKeys s
 		WHERE round=?
 		   AND pk IN (SELECT pk FROM Keysets WHERE participationID=?)`
+	selectStateProofKeys = `SELECT s.round, s.key
+		FROM StateProofKeys s
+		WHERE pk IN (SELECT pk FROM Keysets WHERE participationID=?)
+		ORDER BY s.round`
 	deleteKeysets          = `DELETE FROM Keysets WHERE pk=?`
 	deleteRolling          = `DELETE FROM Rolling WHERE pk=?`
 	deleteStateProofByPK   = `DELETE FROM StateProofKeys WHERE pk=?`
@@ -575,12 +642,18 @@ func (db *participationDB) Delete(id ParticipationID) error {
 	defer db.mutex.Unlock()
 
 	// NoOp if key does not exist.
-	if _, ok := db.cache[id]; !ok {
+	record, ok := db.cache[id]
+	if !ok {
 		return nil
 	}
 	delete(db.dirty, id)
 	delete(db.cache, id)
 
+	// the voting keys held by the key signer are not in the database, destroy them there
+	if record.Voting != nil {
+		record.Voting.DestroySignerSubkeys()
+	}
+
 	// do the db part async
 	db.writeQueue <- makeOpRequest(&deleteOp{id})
 
@@ -832,6 +905,70 @@ func (db *participationDB) GetStateProofSecretsForRound(id ParticipationID, roun
 	return result, nil
 }
 
+// Export fetches a record with all of its secrets, reading the state proof secrets from the database.
+// Pending writes are not flushed: Flush should be called first to export the latest state proof keys.
+func (db *participationDB) Export(id ParticipationID) (ParticipationSecrets, error) {
+	record := db.Get(id)
+	if record.IsZero() {
+		return ParticipationSecrets{}, ErrParticipationIDNotFound
+	}
+	if record.Voting != nil && record.Voting.HeldBySigner() {
+		return ParticipationSecrets{}, ErrParticipationKeyHeldBySigner
+	}
+
+	result := ParticipationSecrets{
+		Parent:      record.Account,
+		FirstValid:  record.FirstValid,
+		LastValid:   record.LastValid,
+		KeyDilution: record.KeyDilution,
+		VRF:         record.VRF,
+		Voting:      record.Voting,
+	}
+	if record.StateProof == nil {
+		return result, nil
+	}
+
+	var rawSignerContext []byte
+	err := db.store.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRow(selectStateProofData, id[:])
+		err2 := row.Scan(&rawSignerContext)
+		if err2 != nil {
+			return fmt.Errorf("error while querying stateproof data: %w", err2)
+		}
+
+		rows, err2 := tx.Query(selectStateProofKeys, id[:])
+		if err2 != nil {
+			return fmt.Errorf("error while querying secrets: %w", err2)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var round uint64
+			var rawKey []byte
+			err2 = rows.Scan(&round, &rawKey)
+			if err2 != nil {
+				return fmt.Errorf("error while scanning secrets: %w", err2)
+			}
+			key := &crypto.FalconSigner{}
+			err2 = protocol.Decode(rawKey, key)
+			if err2 != nil {
+				return fmt.Errorf("unable to decode stateproof key for round %d: %w", round, err2)
+			}
+			result.StateProofSigningKeys = append(result.StateProofSigningKeys, merklesignature.KeyRoundPair{Round: round, Key: key})
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return ParticipationSecrets{}, fmt.Errorf("failed to export participation key %s: %w", id, err)
+	}
+
+	result.StateProofContext = &merklesignature.SignerContext{}
+	err = protocol.Decode(rawSignerContext, result.StateProofContext)
+	if err != nil {
+		return ParticipationSecrets{}, err
+	}
+	return result, nil
+}
+
 // GetForRound fetches a record with all secrets for a particular round.
 func (db *participationDB) GetForRound(id ParticipationID, round basics.Round) (ParticipationRecordForRound, error) {
 	var result ParticipationRecordForRound
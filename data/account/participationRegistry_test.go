@@ -195,6 +195,26 @@ func TestParticipation_InsertGet(t *testing.T) {
 	}
 }
 
+// The keyreg fields of a record match the keyreg transaction generated from its participation keys.
+func TestParticipation_KeyregTxnFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+	registry, dbfile := getRegistry(t)
+	defer registryCloseTest(t, registry, dbfile)
+
+	p := makeTestParticipation(a, 1, 1, 2, 3)
+	id, err := registry.Insert(p)
+	a.NoError(err)
+	record := registry.Get(id)
+
+	for _, includeStateProofKeys := range []bool{false, true} {
+		expected := p.GenerateRegistrationTransaction(basics.MicroAlgos{}, 1, 2, [32]byte{}, includeStateProofKeys).KeyregTxnFields
+		a.Equal(expected, record.KeyregTxnFields(includeStateProofKeys))
+	}
+	fields := record.KeyregTxnFields(true)
+	a.False(fields.StateProofPK.IsEmpty())
+}
+
 // Insert participation records and make sure they can be fetched.
 func TestParticipation_InsertGetWithoutEmptyStateproof(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -957,6 +977,55 @@ func TestAddStateProofKeys(t *testing.T) {
 	}
 }
 
+// An exported key, installed in another registry, has the same ID and remaining secrets.
+func TestParticipation_Export(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+	registry, dbfile := getRegistry(t)
+	defer registryCloseTest(t, registry, dbfile)
+
+	_, err := registry.Export(ParticipationID{})
+	a.ErrorIs(err, ErrParticipationIDNotFound)
+
+	max := uint64(20)
+	p := makeTestParticipationWithLifetime(a, 1, 0, basics.Round(max), 3, 3)
+	id, err := registry.Insert(p)
+	a.NoError(err)
+	a.NoError(registry.AppendKeys(id, p.StateProofSecrets.GetAllKeys()))
+	a.NoError(registry.DeleteStateProofKeys(id, 9))
+	a.NoError(registry.Flush(10 * time.Second))
+
+	secrets, err := registry.Export(id)
+	a.NoError(err)
+	a.Equal(p.ID(), secrets.Participation().ID())
+	a.Equal(*p.VRF, *secrets.VRF)
+	a.Equal(p.Voting.OneTimeSignatureVerifier, secrets.Voting.OneTimeSignatureVerifier)
+	a.Equal(p.StateProofSecrets.GetVerifier(), secrets.StateProofContext.GetVerifier())
+	a.Len(secrets.StateProofSigningKeys, 4)
+	for _, key := range secrets.StateProofSigningKeys {
+		a.GreaterOrEqual(key.Round, uint64(9))
+		a.Equal(*p.StateProofSecrets.GetKey(key.Round), *key.Key)
+	}
+
+	// The export survives the encoding, and installs in another registry.
+	var decoded ParticipationSecrets
+	a.NoError(protocol.DecodeReflect(protocol.EncodeReflect(&secrets), &decoded))
+	other, otherfile := getRegistry(t)
+	defer registryCloseTest(t, other, otherfile)
+	otherID, err := other.Insert(decoded.Participation())
+	a.NoError(err)
+	a.Equal(id, otherID)
+	a.NoError(other.AppendKeys(otherID, decoded.StateProofSigningKeys))
+	a.NoError(other.Flush(10 * time.Second))
+
+	assertParticipation(t, p, other.Get(otherID))
+	_, err = other.GetStateProofSecretsForRound(otherID, 6)
+	a.ErrorIs(err, ErrSecretNotFound)
+	r, err := other.GetStateProofSecretsForRound(otherID, 12)
+	a.NoError(err)
+	a.Equal(*p.StateProofSecrets.GetKey(12), *r.StateProofSecrets.SigningKey)
+}
+
 func TestGetRoundSecretsWithNilStateProofVerifier(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := assert.New(t)
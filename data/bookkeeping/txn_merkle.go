@@ -43,6 +43,46 @@ func (block Block) TxnMerkleTreeSHA256() (*merklearray.Tree, error) {
 	return merklearray.BuildVectorCommitmentTree(&txnMerkleArray{block: block, hashType: crypto.Sha256}, crypto.HashFactory{HashType: crypto.Sha256})
 }
 
+// VerifyTxnMerkleProof checks a proof that the transaction with ID txid,
+// whose SignedTxnInBlock hashes to stibHash, is at position idx of the payset
+// committed to by commitment. The proof is one of TxnMerkleTree or
+// TxnMerkleTreeSHA256, as returned by the transaction proof REST API, and
+// txid and stibHash are computed with the hash function of the proof: for
+// SHA256 proofs, they are Transaction.IDSha256 and SignedTxnInBlock.HashSHA256.
+func VerifyTxnMerkleProof(commitment crypto.GenericDigest, txid crypto.Digest, stibHash crypto.Digest, idx uint64, proof *merklearray.SingleLeafProof) error {
+	if proof == nil {
+		return merklearray.ErrProofIsNil
+	}
+
+	elems := map[uint64]crypto.Hashable{idx: &txnMerkleRawElem{txid: txid, stibHash: stibHash}}
+	switch proof.HashFactory.HashType {
+	case crypto.Sha512_256:
+		return merklearray.Verify(commitment, elems, proof.ToProof())
+	case crypto.Sha256:
+		return merklearray.VerifyVectorCommitment(commitment, elems, proof.ToProof())
+	default:
+		return fmt.Errorf("unsupported hash type %v for transaction proofs", proof.HashFactory.HashType)
+	}
+}
+
+// VerifyTxnProof checks a proof of a transaction of the block against the
+// commitment of the block header matching the hash function of the proof. See
+// VerifyTxnMerkleProof.
+func (bh BlockHeader) VerifyTxnProof(txid crypto.Digest, stibHash crypto.Digest, idx uint64, proof *merklearray.SingleLeafProof) error {
+	if proof == nil {
+		return merklearray.ErrProofIsNil
+	}
+
+	var commitment crypto.Digest
+	switch proof.HashFactory.HashType {
+	case crypto.Sha512_256:
+		commitment = bh.TxnCommitments.NativeSha512_256Commitment
+	case crypto.Sha256:
+		commitment = bh.TxnCommitments.Sha256Commitment
+	}
+	return VerifyTxnMerkleProof(commitment.ToSlice(), txid, stibHash, idx, proof)
+}
+
 // txnMerkleArray is a representation of the transactions in this block,
 // along with their ApplyData, as an array for the merklearray package.
 type txnMerkleArray struct {
@@ -116,3 +156,16 @@ func (tme *txnMerkleElem) HashRepresentation() []byte {
 	s = append(s, tme.RawLeaf()...)
 	return s
 }
+
+// txnMerkleRawElem is a leaf of the Merkle tree of the transactions of a
+// block, made of the hashes of the transaction and of its SignedTxnInBlock,
+// as known to a verifier without the block.
+type txnMerkleRawElem struct {
+	txid     crypto.Digest
+	stibHash crypto.Digest
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (tme *txnMerkleRawElem) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.TxnMerkleLeaf, txnMerkleToRaw(tme.txid, tme.stibHash)
+}
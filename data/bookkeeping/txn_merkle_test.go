@@ -140,6 +140,74 @@ func TestBlock_TxnMerkleTreeSHA256(t *testing.T) {
 	}
 }
 
+func TestVerifyTxnProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var b Block
+	b.CurrentProtocol = protocol.ConsensusCurrentVersion
+	crypto.RandBytes(b.BlockHeader.GenesisHash[:])
+
+	var txns []transactions.Transaction
+	for i := uint64(0); i < 13; i++ {
+		txn := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				GenesisHash: b.BlockHeader.GenesisHash,
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Amount: basics.MicroAlgos{Raw: i},
+			},
+		}
+		stib, err := b.BlockHeader.EncodeSignedTxn(transactions.SignedTxn{Txn: txn}, transactions.ApplyData{})
+		require.NoError(t, err)
+		b.Payset = append(b.Payset, stib)
+		txns = append(txns, txn)
+	}
+
+	var err error
+	b.TxnCommitments.NativeSha512_256Commitment, err = b.paysetCommit(config.PaysetCommitMerkle)
+	require.NoError(t, err)
+	b.TxnCommitments.Sha256Commitment, err = b.paysetCommitSHA256()
+	require.NoError(t, err)
+
+	tree, err := b.TxnMerkleTree()
+	require.NoError(t, err)
+	treeSHA256, err := b.TxnMerkleTreeSHA256()
+	require.NoError(t, err)
+
+	// proveAndVerify proves the transaction at idx and verifies the proof as
+	// a light client would, from the serialized proof
+	proveAndVerify := func(tree *merklearray.Tree, idx uint64, txid crypto.Digest, stibHash crypto.Digest) error {
+		proof, err := tree.ProveSingleLeaf(idx)
+		require.NoError(t, err)
+		decoded, err := merklearray.ProofDataToSingleLeafProof(proof.HashFactory.HashType.String(), uint64(proof.TreeDepth), proof.GetConcatenatedProof())
+		require.NoError(t, err)
+		return b.BlockHeader.VerifyTxnProof(txid, stibHash, idx, &decoded)
+	}
+
+	for i := range txns {
+		idx := uint64(i)
+		txid := crypto.Digest(txns[i].ID())
+		txidSHA256 := crypto.Digest(txns[i].IDSha256())
+		stibHash := b.Payset[i].Hash()
+		stibHashSHA256 := b.Payset[i].HashSHA256()
+
+		require.NoError(t, proveAndVerify(tree, idx, txid, stibHash))
+		require.NoError(t, proveAndVerify(treeSHA256, idx, txidSHA256, stibHashSHA256))
+
+		// The leaf must be hashed with the hash function of the proof
+		require.Error(t, proveAndVerify(treeSHA256, idx, txid, stibHash))
+
+		// The proof does not hold for another position or transaction
+		other := (idx + 1) % uint64(len(txns))
+		require.Error(t, proveAndVerify(tree, other, txid, stibHash))
+		require.Error(t, proveAndVerify(treeSHA256, other, txidSHA256, stibHashSHA256))
+		require.Error(t, proveAndVerify(tree, idx, txid, b.Payset[other].Hash()))
+	}
+
+	require.ErrorIs(t, b.BlockHeader.VerifyTxnProof(crypto.Digest{}, crypto.Digest{}, 0, nil), merklearray.ErrProofIsNil)
+}
+
 func BenchmarkTxnRoots(b *testing.B) {
 	var blk Block
 	blk.CurrentProtocol = protocol.ConsensusCurrentVersion
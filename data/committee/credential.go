@@ -73,9 +73,28 @@ type (
 // If it is, the returned Credential constitutes a proof of this fact.
 // Otherwise, an error is returned.
 func (cred UnauthenticatedCredential) Verify(proto config.ConsensusParams, m Membership) (res Credential, err error) {
-	selectionKey := m.Record.SelectionID
-	ok, vrfOut := selectionKey.Verify(cred.Proof, m.Selector)
+	ok, vrfOut := m.Record.SelectionID.Verify(cred.Proof, m.Selector)
+	if !ok {
+		return Credential{}, cred.ProofError(m)
+	}
+	return cred.VerifyOutput(proto, m, vrfOut)
+}
+
+// BatchPrep enqueues the VRF proof of the credential into a batch, and returns the index of its output.
+// The caller must verify the batch, then finish the verification of the credential with VerifyOutput,
+// or report ProofError if its item failed.
+func (cred UnauthenticatedCredential) BatchPrep(m Membership, bv *crypto.BatchVerification) int {
+	return bv.EnqueueVrfProof(m.Record.SelectionID, cred.Proof, m.Selector)
+}
 
+// ProofError returns the error reported when the VRF proof of the credential is invalid.
+func (cred UnauthenticatedCredential) ProofError(m Membership) error {
+	return fmt.Errorf("UnauthenticatedCredential.Verify: could not verify VRF Proof with %v (parameters = %+v, proof = %#v)", m.Record.SelectionID, m, cred.Proof)
+}
+
+// VerifyOutput finishes the verification of a credential whose VRF proof was verified, given the output of the proof.
+// It computes the weight of the credential, and returns an error if it is 0.
+func (cred UnauthenticatedCredential) VerifyOutput(proto config.ConsensusParams, m Membership, vrfOut crypto.VrfOutput) (res Credential, err error) {
 	hashable := hashableCredential{
 		RawOut: vrfOut,
 		Member: m.Record.Addr,
@@ -89,11 +108,6 @@ func (cred UnauthenticatedCredential) Verify(proto config.ConsensusParams, m Mem
 		h = crypto.Hash(append(vrfOut[:], m.Record.Addr[:]...))
 	}
 
-	if !ok {
-		err = fmt.Errorf("UnauthenticatedCredential.Verify: could not verify VRF Proof with %v (parameters = %+v, proof = %#v)", selectionKey, m, cred.Proof)
-		return
-	}
-
 	var weight uint64
 	userMoney := m.Record.VotingStake()
 	expectedSelection := float64(m.Selector.CommitteeSize(proto))
@@ -33,6 +33,10 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
+// blockIngestionHaltedRetryInterval is the delay between attempts to write a block while the ledger
+// stopped adding blocks, as the disk is running out of space.
+const blockIngestionHaltedRetryInterval = 5 * time.Second
+
 // The Ledger object in this (data) package provides a wrapper around the
 // Ledger from the ledger package.  The reason for this is compatibility
 // with the existing callers of the previous ledger API, without increasing
@@ -320,6 +324,7 @@ func (l *Ledger) ConsensusVersion(r basics.Round) (protocol.ConsensusVersion, er
 // written to the ledger.
 func (l *Ledger) EnsureValidatedBlock(vb *ledgercore.ValidatedBlock, c agreement.Certificate) {
 	round := vb.Block().Round()
+	haltedLogged := false
 
 	for l.LastRound() < round {
 		err := l.AddValidatedBlock(*vb, c)
@@ -336,6 +341,15 @@ func (l *Ledger) EnsureValidatedBlock(vb *ledgercore.ValidatedBlock, c agreement
 			logfn = l.log.Debugf
 			// Otherwise, the error is because the block is in the future. Error is logged.
 		}
+		if err == ledgercore.ErrBlockIngestionHalted {
+			// Wait for disk space to be freed, logging only once.
+			if !haltedLogged {
+				l.log.Warnf("data.EnsureValidatedBlock: waiting to write block %d to the ledger: %v", round, err)
+				haltedLogged = true
+			}
+			time.Sleep(blockIngestionHaltedRetryInterval)
+			continue
+		}
 		logfn("data.EnsureValidatedBlock: could not write block %d to the ledger: %v", round, err)
 	}
 }
@@ -347,6 +361,7 @@ func (l *Ledger) EnsureValidatedBlock(vb *ledgercore.ValidatedBlock, c agreement
 func (l *Ledger) EnsureBlock(block *bookkeeping.Block, c agreement.Certificate) {
 	round := block.Round()
 	protocolErrorLogged := false
+	haltedLogged := false
 
 	for l.LastRound() < round {
 		err := l.AddBlock(*block, c)
@@ -366,6 +381,15 @@ func (l *Ledger) EnsureBlock(block *bookkeeping.Block, c agreement.Certificate)
 			l.log.Debugf("data.EnsureBlock: could not write block %d to the ledger: %v", round, err)
 			return
 		default:
+			if err == ledgercore.ErrBlockIngestionHalted {
+				// Wait for disk space to be freed, logging only once.
+				if !haltedLogged {
+					l.log.Warnf("data.EnsureBlock: waiting to write block %d to the ledger: %v", round, err)
+					haltedLogged = true
+				}
+				time.Sleep(blockIngestionHaltedRetryInterval)
+				continue
+			}
 			l.log.Errorf("data.EnsureBlock: could not write block %d to the ledger: %v", round, err)
 		}
 
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+)
+
+// assemblyReportsRetained is the number of the most recent block assemblies the pool reports on.
+const assemblyReportsRetained = 100
+
+// The reasons transaction groups of the pool were left out of an assembled block.
+const (
+	// ExclusionCommitted groups were already committed to the ledger.
+	ExclusionCommitted = "committed"
+	// ExclusionEarlyCommitted groups were committed by the block the assembly started after.
+	ExclusionEarlyCommitted = "early-committed"
+	// ExclusionExpired groups were past their last valid round.
+	ExclusionExpired = "expired"
+	// ExclusionLease groups held a lease already in use.
+	ExclusionLease = "lease"
+	// ExclusionMinFee groups did not pay the minimum fee.
+	ExclusionMinFee = "min-fee"
+	// ExclusionInvalid groups failed evaluation for any other reason.
+	ExclusionInvalid = "invalid"
+	// ExclusionLeftOver groups were not evaluated before the assembly stopped, as the block was
+	// full or the assembly ran out of time.
+	ExclusionLeftOver = "left-over"
+)
+
+// AssemblyExclusion is the number of transaction groups left out of an assembled block for a reason.
+type AssemblyExclusion struct {
+	Reason string
+	Count  int
+}
+
+// AssemblyReport describes the assembly of a block for proposal.
+type AssemblyReport struct {
+	Round basics.Round
+
+	// Start is the time the block was requested, AssemblyTime the time the assembly was allotted
+	// (ProposalAssemblyTime) and Duration the time it took.
+	Start        time.Time
+	AssemblyTime time.Duration
+	Duration     time.Duration
+
+	// StopReason is why the assembly stopped adding transactions to the block, one of the
+	// telemetryspec.AssembleBlock* reasons.
+	StopReason string
+
+	// Considered is the number of transaction groups in the pool when the assembly started.
+	Considered int
+
+	// IncludedGroups and IncludedTxns are the number of transaction groups and transactions of the
+	// block, IncludedBytes their encoded length, of at most BytesLimit, and Fees the fees they pay.
+	IncludedGroups int
+	IncludedTxns   int
+	IncludedBytes  uint64
+	BytesLimit     uint64
+	Fees           uint64
+
+	// Excluded are the numbers of considered transaction groups left out of the block, by reason.
+	Excluded []AssemblyExclusion
+}
+
+// makeAssemblyReport reports on the assembly of a block from the stats collected while assembling it.
+func makeAssemblyReport(round basics.Round, start time.Time, duration time.Duration, assemblyTime time.Duration, stats *telemetryspec.AssembleBlockMetrics, blk *ledgercore.ValidatedBlock) AssemblyReport {
+	report := AssemblyReport{
+		Round:        round,
+		Start:        start,
+		AssemblyTime: assemblyTime,
+		Duration:     duration,
+		StopReason:   stats.StopReason,
+		Considered:   stats.StartCount,
+	}
+
+	block := blk.Block()
+	if maxBytes := config.Consensus[block.CurrentProtocol].MaxTxnBytesPerBlock; maxBytes > 0 {
+		report.BytesLimit = uint64(maxBytes)
+	}
+	var lastGroup crypto.Digest
+	for i, txib := range block.Payset {
+		report.IncludedTxns++
+		report.IncludedBytes += uint64(txib.GetEncodedLength())
+		report.Fees += txib.Txn.Fee.Raw
+		if group := txib.Txn.Group; group.IsZero() || i == 0 || group != lastGroup {
+			report.IncludedGroups++
+			lastGroup = group
+		}
+	}
+
+	excluded := 0
+	for _, e := range []AssemblyExclusion{
+		{ExclusionCommitted, stats.CommittedCount},
+		{ExclusionEarlyCommitted, int(stats.EarlyCommittedCount)},
+		{ExclusionExpired, stats.ExpiredCount},
+		{ExclusionLease, stats.LeaseErrorCount},
+		{ExclusionMinFee, stats.MinFeeErrorCount},
+		{ExclusionInvalid, stats.InvalidCount},
+	} {
+		if e.Count > 0 {
+			report.Excluded = append(report.Excluded, e)
+			excluded += e.Count
+		}
+	}
+	// the counts are taken when the block is generated, so that the groups evaluated after it are
+	// left over as well.
+	if leftOver := report.Considered - excluded - report.IncludedGroups; leftOver > 0 {
+		report.Excluded = append(report.Excluded, AssemblyExclusion{ExclusionLeftOver, leftOver})
+	}
+	return report
+}
+
+// recordAssemblyReport appends the report on a block assembly to the retained reports, discarding the oldest.
+func (pool *TransactionPool) recordAssemblyReport(report AssemblyReport) {
+	pool.assemblyReportsMu.Lock()
+	defer pool.assemblyReportsMu.Unlock()
+	pool.assemblyReports = append(pool.assemblyReports, report)
+	if len(pool.assemblyReports) > assemblyReportsRetained {
+		pool.assemblyReports = pool.assemblyReports[len(pool.assemblyReports)-assemblyReportsRetained:]
+	}
+}
+
+// AssemblyReports returns the reports on the most recent blocks the pool assembled for proposal, oldest first.
+func (pool *TransactionPool) AssemblyReports() []AssemblyReport {
+	pool.assemblyReportsMu.Lock()
+	defer pool.assemblyReportsMu.Unlock()
+	reports := make([]AssemblyReport, len(pool.assemblyReports))
+	copy(reports, pool.assemblyReports)
+	return reports
+}
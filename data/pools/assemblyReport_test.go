@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAssemblyReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	var fees uint64
+	for i := range addresses {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[i],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee + uint64(i)},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[(i+1)%numOfAccounts],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		require.NoError(t, transactionPool.RememberOne(tx.Sign(secrets[i])))
+		fees += tx.Fee.Raw
+	}
+	require.Empty(t, transactionPool.AssemblyReports())
+
+	transactionPool.recomputeBlockEvaluator(nil, 0)
+	blk, err := transactionPool.AssembleBlock(1, time.Time{})
+	require.NoError(t, err)
+
+	reports := transactionPool.AssemblyReports()
+	require.Len(t, reports, 1)
+	report := reports[0]
+	require.Equal(t, basics.Round(1), report.Round)
+	require.Equal(t, cfg.ProposalAssemblyTime, report.AssemblyTime)
+	require.Equal(t, telemetryspec.AssembleBlockEmpty, report.StopReason)
+	require.Equal(t, numOfAccounts, report.Considered)
+	require.Equal(t, numOfAccounts, report.IncludedGroups)
+	require.Equal(t, numOfAccounts, report.IncludedTxns)
+	require.Equal(t, fees, report.Fees)
+	require.Equal(t, uint64(config.Consensus[blk.Block().CurrentProtocol].MaxTxnBytesPerBlock), report.BytesLimit)
+	require.NotZero(t, report.IncludedBytes)
+	require.Empty(t, report.Excluded)
+}
+
+func TestMakeAssemblyReportExclusions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger := makeMockLedger(t, initAccFixed([]basics.Address{{0x1}}, 1<<32))
+	eval := newBlockEvaluator(t, mockLedger)
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+
+	var stats telemetryspec.AssembleBlockMetrics
+	stats.StartCount = 10
+	stats.StopReason = telemetryspec.AssembleBlockFull
+	stats.ExpiredCount = 2
+	stats.MinFeeErrorCount = 1
+	stats.EarlyCommittedCount = 3
+	report := makeAssemblyReport(1, time.Now(), time.Millisecond, 500*time.Millisecond, &stats, blk)
+	require.Equal(t, telemetryspec.AssembleBlockFull, report.StopReason)
+	require.Zero(t, report.IncludedGroups)
+	require.Equal(t, []AssemblyExclusion{
+		{ExclusionEarlyCommitted, 3},
+		{ExclusionExpired, 2},
+		{ExclusionMinFee, 1},
+		{ExclusionLeftOver, 4},
+	}, report.Excluded)
+}
+
+func TestAssemblyReportsRetained(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var pool TransactionPool
+	for i := 1; i <= assemblyReportsRetained+5; i++ {
+		pool.recordAssemblyReport(AssemblyReport{Round: basics.Round(i)})
+	}
+	reports := pool.AssemblyReports()
+	require.Len(t, reports, assemblyReportsRetained)
+	require.Equal(t, basics.Round(6), reports[0].Round)
+	require.Equal(t, basics.Round(assemblyReportsRetained+5), reports[len(reports)-1].Round)
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// congestedBlockFullness is the fraction of a block's transaction bytes capacity
+// above which the block is considered to have been congested.
+const congestedBlockFullness = 0.9
+
+// maxFeeEstimateGrowthRounds bounds the number of rounds over which the pool's fee
+// threshold is projected to keep growing, so that estimates remain reasonable under
+// very large backlogs.
+const maxFeeEstimateGrowthRounds = 10
+
+// FeeEstimate is the suggested fee per byte a transaction should pay in order
+// to be included in a block within a number of rounds.
+type FeeEstimate struct {
+	Rounds     uint64
+	FeePerByte uint64
+}
+
+// blockFeeStats summarizes the load observed by the pool when a block was committed.
+type blockFeeStats struct {
+	// fullness is the fraction of the block's transaction bytes capacity that was used.
+	fullness float64
+	// feePerByte is the pool's fee threshold right after the block was committed.
+	feePerByte uint64
+}
+
+// blockFullness returns the fraction of the block's transaction bytes capacity used by its payset,
+// from the encoded length of the transactions counted by the evaluator. Blocks which were added
+// without validation, such as during catchup, count as empty.
+func blockFullness(block bookkeeping.Block, delta ledgercore.StateDelta) float64 {
+	proto := config.Consensus[block.CurrentProtocol]
+	if proto.MaxTxnBytesPerBlock <= 0 {
+		return 0
+	}
+	return float64(delta.TxnBytes) / float64(proto.MaxTxnBytesPerBlock)
+}
+
+// recordBlockFeeStats appends the stats of a newly committed block to the fee history,
+// discarding the oldest entries beyond the configured history size.
+// The caller must hold pool.mu.
+func (pool *TransactionPool) recordBlockFeeStats(stats blockFeeStats) {
+	if pool.feeHistorySize <= 0 {
+		return
+	}
+	pool.feeHistory = append(pool.feeHistory, stats)
+	if len(pool.feeHistory) > pool.feeHistorySize {
+		pool.feeHistory = pool.feeHistory[len(pool.feeHistory)-pool.feeHistorySize:]
+	}
+}
+
+// EstimateFees returns the suggested fee per byte for a transaction to be included
+// within each of the given numbers of rounds. The estimates start from the pool's
+// current fee threshold, and account for:
+//   - the recent blocks: if the last SuggestedFeeBlockHistory blocks were congested,
+//     targets within that horizon are expected to need the highest threshold seen
+//     over those blocks.
+//   - the pool occupancy: if the whole blocks pending in the pool would not drain
+//     within the target, the threshold is projected to grow by the exponential
+//     increase factor for every round it takes them to drain past the target.
+func (pool *TransactionPool) EstimateFees(targets []uint64) []FeeEstimate {
+	pool.mu.Lock()
+	base := pool.computeFeePerByte()
+	pendingBlocks := uint64(pool.numPendingWholeBlocks)
+	history := make([]blockFeeStats, len(pool.feeHistory))
+	copy(history, pool.feeHistory)
+	pool.mu.Unlock()
+
+	congested := len(history) > 0
+	var recentFeePerByte uint64
+	for _, stats := range history {
+		if stats.fullness < congestedBlockFullness {
+			congested = false
+		}
+		if stats.feePerByte > recentFeePerByte {
+			recentFeePerByte = stats.feePerByte
+		}
+	}
+
+	estimates := make([]FeeEstimate, len(targets))
+	for i, rounds := range targets {
+		feePerByte := base
+		if congested && rounds <= uint64(len(history)) && recentFeePerByte > feePerByte {
+			feePerByte = recentFeePerByte
+		}
+		if pendingBlocks >= rounds && pendingBlocks > 1 {
+			growthRounds := pendingBlocks - rounds + 1
+			if growthRounds > maxFeeEstimateGrowthRounds {
+				growthRounds = maxFeeEstimateGrowthRounds
+			}
+			if feePerByte == 0 {
+				feePerByte = 1
+			}
+			for j := uint64(0); j < growthRounds; j++ {
+				feePerByte *= pool.expFeeFactor
+			}
+		}
+		estimates[i] = FeeEstimate{Rounds: rounds, FeePerByte: feePerByte}
+	}
+	return estimates
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"container/heap"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// footprintKind tells which kind of state a footprintKey refers to
+type footprintKind byte
+
+const (
+	footprintAccount footprintKind = iota
+	footprintApp
+	footprintAsset
+)
+
+// footprintKey identifies an account, an application or an asset a transaction group may read or write
+type footprintKey struct {
+	kind footprintKind
+	addr basics.Address
+	id   uint64
+}
+
+// groupFootprint returns the accounts, applications and assets the transaction group references. The
+// applications called by the group may only access the state the group references, so that two groups
+// without a common key do not depend on each other, whichever order they are evaluated in. The fee sink,
+// credited by every transaction, is left out since its credits commute.
+func groupFootprint(txgroup []transactions.SignedTxn) []footprintKey {
+	keys := make(map[footprintKey]struct{}, 4*len(txgroup))
+	addAccount := func(addr basics.Address) {
+		if !addr.IsZero() {
+			keys[footprintKey{kind: footprintAccount, addr: addr}] = struct{}{}
+		}
+	}
+	addApp := func(app basics.AppIndex) {
+		if app != 0 {
+			keys[footprintKey{kind: footprintApp, id: uint64(app)}] = struct{}{}
+			addAccount(app.Address())
+		}
+	}
+	addAsset := func(asset basics.AssetIndex) {
+		if asset != 0 {
+			keys[footprintKey{kind: footprintAsset, id: uint64(asset)}] = struct{}{}
+		}
+	}
+
+	for i := range txgroup {
+		txn := &txgroup[i].Txn
+		addAccount(txn.Sender)
+		addAccount(txn.Receiver)
+		addAccount(txn.CloseRemainderTo)
+		addAccount(txn.AssetSender)
+		addAccount(txn.AssetReceiver)
+		addAccount(txn.AssetCloseTo)
+		addAccount(txn.FreezeAccount)
+		addAsset(txn.XferAsset)
+		addAsset(txn.ConfigAsset)
+		addAsset(txn.FreezeAsset)
+		addApp(txn.ApplicationID)
+		for _, addr := range txn.Accounts {
+			addAccount(addr)
+		}
+		for _, app := range txn.ForeignApps {
+			addApp(app)
+		}
+		for _, asset := range txn.ForeignAssets {
+			addAsset(asset)
+		}
+	}
+
+	footprint := make([]footprintKey, 0, len(keys))
+	for key := range keys {
+		footprint = append(footprint, key)
+	}
+	return footprint
+}
+
+// packingCandidate is a pending transaction group waiting for the groups it depends on to be ordered
+type packingCandidate struct {
+	index      int
+	feePerByte float64
+}
+
+// packingQueue orders the candidates by decreasing fee per byte, then by arrival
+type packingQueue []packingCandidate
+
+func (q packingQueue) Len() int { return len(q) }
+func (q packingQueue) Less(i, j int) bool {
+	if q[i].feePerByte != q[j].feePerByte {
+		return q[i].feePerByte > q[j].feePerByte
+	}
+	return q[i].index < q[j].index
+}
+func (q packingQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *packingQueue) Push(x interface{}) {
+	*q = append(*q, x.(packingCandidate))
+}
+func (q *packingQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
+
+// orderByValue returns the transaction groups in the order packing the most fees per byte first, while
+// each group still follows the earlier groups sharing an account, application or asset with it. The state
+// a group sees when evaluated is hence the same as in the arrival order.
+func orderByValue(txgroups [][]transactions.SignedTxn) [][]transactions.SignedTxn {
+	// each group depends on the previous group referencing each of its keys
+	successors := make([][]int, len(txgroups))
+	dependencies := make([]int, len(txgroups))
+	lastGroup := make(map[footprintKey]int)
+	queue := make(packingQueue, 0, len(txgroups))
+	for i, txgroup := range txgroups {
+		predecessors := make(map[int]struct{})
+		for _, key := range groupFootprint(txgroup) {
+			if prev, has := lastGroup[key]; has {
+				predecessors[prev] = struct{}{}
+			}
+			lastGroup[key] = i
+		}
+		for prev := range predecessors {
+			successors[prev] = append(successors[prev], i)
+		}
+		dependencies[i] = len(predecessors)
+	}
+
+	feePerByte := func(i int) float64 {
+		var fee uint64
+		var size int
+		for _, stxn := range txgroups[i] {
+			fee += stxn.Txn.Fee.Raw
+			size += stxn.GetEncodedLength()
+		}
+		if size == 0 {
+			return 0
+		}
+		return float64(fee) / float64(size)
+	}
+	for i := range txgroups {
+		if dependencies[i] == 0 {
+			queue = append(queue, packingCandidate{index: i, feePerByte: feePerByte(i)})
+		}
+	}
+	heap.Init(&queue)
+
+	ordered := make([][]transactions.SignedTxn, 0, len(txgroups))
+	for queue.Len() > 0 {
+		next := heap.Pop(&queue).(packingCandidate)
+		ordered = append(ordered, txgroups[next.index])
+		for _, succ := range successors[next.index] {
+			dependencies[succ]--
+			if dependencies[succ] == 0 {
+				heap.Push(&queue, packingCandidate{index: succ, feePerByte: feePerByte(succ)})
+			}
+		}
+	}
+	return ordered
+}
+
+// congested returns true if the transaction groups would not fit in a block of maxTxnBytes
+func congested(txgroups [][]transactions.SignedTxn, maxTxnBytes int) bool {
+	if maxTxnBytes <= 0 {
+		return false
+	}
+	size := 0
+	for _, txgroup := range txgroups {
+		for _, stxn := range txgroup {
+			size += stxn.GetEncodedLength()
+			if size > maxTxnBytes {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func packingPayment(sender, receiver byte, fee uint64) []transactions.SignedTxn {
+	return []transactions.SignedTxn{{
+		Txn: transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender: basics.Address{sender},
+				Fee:    basics.MicroAlgos{Raw: fee},
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: basics.Address{receiver},
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		},
+	}}
+}
+
+func packingAppCall(sender byte, app basics.AppIndex, fee uint64) []transactions.SignedTxn {
+	return []transactions.SignedTxn{{
+		Txn: transactions.Transaction{
+			Type: protocol.ApplicationCallTx,
+			Header: transactions.Header{
+				Sender: basics.Address{sender},
+				Fee:    basics.MicroAlgos{Raw: fee},
+			},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: app,
+			},
+		},
+	}}
+}
+
+func TestGroupFootprint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	footprint := groupFootprint(packingAppCall(1, 7, 1000))
+	require.ElementsMatch(t, []footprintKey{
+		{kind: footprintAccount, addr: basics.Address{1}},
+		{kind: footprintApp, id: 7},
+		{kind: footprintAccount, addr: basics.AppIndex(7).Address()},
+	}, footprint)
+
+	footprint = groupFootprint(append(packingPayment(1, 2, 1000), packingPayment(2, 3, 1000)...))
+	require.ElementsMatch(t, []footprintKey{
+		{kind: footprintAccount, addr: basics.Address{1}},
+		{kind: footprintAccount, addr: basics.Address{2}},
+		{kind: footprintAccount, addr: basics.Address{3}},
+	}, footprint)
+}
+
+func TestOrderByValue(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txgroups := [][]transactions.SignedTxn{
+		packingPayment(1, 2, 2000),
+		packingAppCall(3, 7, 1000),
+		packingPayment(2, 4, 5000), // depends on the first group through account 2
+		packingAppCall(5, 7, 9000), // depends on the second group through app 7
+		packingPayment(6, 8, 3000), // independent
+	}
+	ordered := orderByValue(txgroups)
+	require.Len(t, ordered, len(txgroups))
+	require.Equal(t, []([]transactions.SignedTxn){
+		txgroups[4], txgroups[0], txgroups[2], txgroups[1], txgroups[3],
+	}, ordered)
+
+	// equal fees keep the arrival order
+	txgroups = [][]transactions.SignedTxn{
+		packingPayment(1, 2, 1000),
+		packingPayment(3, 4, 1000),
+		packingPayment(5, 6, 1000),
+	}
+	require.Equal(t, txgroups, orderByValue(txgroups))
+}
+
+func TestCongested(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txgroups := [][]transactions.SignedTxn{packingPayment(1, 2, 1000), packingPayment(3, 4, 1000)}
+	size := txgroups[0][0].GetEncodedLength() + txgroups[1][0].GetEncodedLength()
+	require.False(t, congested(txgroups, size))
+	require.True(t, congested(txgroups, size-1))
+	require.False(t, congested(txgroups, 0))
+	require.False(t, congested(nil, 1))
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"errors"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// RejectionCode is a stable, machine-readable code of the reason a transaction group was rejected by the node,
+// or evicted from the transaction pool. It is returned alongside the error message, which may change between
+// releases, so that clients can present the errors without parsing their messages.
+type RejectionCode string
+
+const (
+	// RejectionOther is the code of the rejections which have no specific code.
+	RejectionOther RejectionCode = "other"
+	// RejectionOverspend is the code of a transaction spending more algos than its sender holds.
+	RejectionOverspend RejectionCode = "overspend"
+	// RejectionMinBalance is the code of a transaction leaving an account below its minimum balance.
+	RejectionMinBalance RejectionCode = "min-balance"
+	// RejectionAssetFrozen is the code of a transaction moving an asset out of, or into, a frozen holding.
+	RejectionAssetFrozen RejectionCode = "asset-frozen"
+	// RejectionLogicEval is the code of a program, of an application or a logic signature, failing.
+	RejectionLogicEval RejectionCode = "logic-eval"
+	// RejectionTxnDead is the code of a transaction outside of its validity window.
+	RejectionTxnDead RejectionCode = "txn-dead"
+	// RejectionGroupMismatch is the code of a transaction group whose group IDs do not match its transactions.
+	RejectionGroupMismatch RejectionCode = "group-mismatch"
+	// RejectionAlreadyInLedger is the code of a transaction already committed.
+	RejectionAlreadyInLedger RejectionCode = "already-in-ledger"
+	// RejectionLeaseInUse is the code of a transaction whose lease is used by another transaction.
+	RejectionLeaseInUse RejectionCode = "lease-in-use"
+	// RejectionFeeTooLow is the code of a transaction group whose fees are below the minimum fee, or below the
+	// fee threshold of a congested transaction pool.
+	RejectionFeeTooLow RejectionCode = "fee-too-low"
+	// RejectionPoolFull is the code of a transaction group rejected as the transaction pool is full.
+	RejectionPoolFull RejectionCode = "pool-full"
+	// RejectionNotWellFormed is the code of a malformed transaction.
+	RejectionNotWellFormed RejectionCode = "not-well-formed"
+	// RejectionInvalidSignature is the code of a transaction which is not signed, or whose signature is invalid.
+	RejectionInvalidSignature RejectionCode = "invalid-signature"
+)
+
+// RejectionCodeOf returns the code of the reason of the error a transaction group was rejected with, or evicted
+// from the transaction pool with. It returns RejectionOther for the errors which have no specific code, and an
+// empty code for a nil error.
+func RejectionCodeOf(err error) RejectionCode {
+	if err == nil {
+		return ""
+	}
+
+	// the logic evaluation failures are wrapped by the verification errors of the logic signatures
+	var evalErr logic.EvalError
+	if errors.As(err, &evalErr) {
+		return RejectionLogicEval
+	}
+	var groupErr *verify.TxGroupError
+	if errors.As(err, &groupErr) {
+		switch groupErr.Reason {
+		case verify.TxGroupErrorReasonInvalidFee:
+			return RejectionFeeTooLow
+		case verify.TxGroupErrorReasonHasNoSig, verify.TxGroupErrorReasonSigNotWellFormed, verify.TxGroupErrorReasonMsigNotWellFormed:
+			return RejectionInvalidSignature
+		case verify.TxGroupErrorReasonLogicSigFailed:
+			return RejectionLogicEval
+		}
+	}
+
+	var overspendErr *ledgercore.OverspendError
+	var minBalanceErr *ledgercore.MinBalanceError
+	var frozenErr *ledgercore.AssetFrozenError
+	var deadErr *transactions.TxnDeadError
+	var malformedGroupErr *ledgercore.TxGroupMalformedError
+	var inLedgerErr *ledgercore.TransactionInLedgerError
+	var leaseErr *ledgercore.LeaseInLedgerError
+	var minFeeErr *transactions.MinFeeError
+	var poolFeeErr *ErrTxPoolFeeError
+	var notWellFormedErr *ledgercore.TxnNotWellFormedError
+	switch {
+	case errors.As(err, &overspendErr):
+		return RejectionOverspend
+	case errors.As(err, &minBalanceErr):
+		return RejectionMinBalance
+	case errors.As(err, &frozenErr):
+		return RejectionAssetFrozen
+	case errors.As(err, &deadErr):
+		return RejectionTxnDead
+	case errors.As(err, &malformedGroupErr):
+		return RejectionGroupMismatch
+	case errors.As(err, &inLedgerErr):
+		return RejectionAlreadyInLedger
+	case errors.As(err, &leaseErr):
+		return RejectionLeaseInUse
+	case errors.As(err, &minFeeErr), errors.As(err, &poolFeeErr):
+		return RejectionFeeTooLow
+	case errors.Is(err, ErrPendingQueueReachedMaxCap):
+		return RejectionPoolFull
+	case errors.As(err, &notWellFormedErr), groupErr != nil && groupErr.Reason == verify.TxGroupErrorReasonNotWellFormed:
+		return RejectionNotWellFormed
+	}
+	return RejectionOther
+}
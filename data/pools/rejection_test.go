@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRejectionCodeOf(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	minFeeErr := transactions.MinFeeError("transaction had fee 1, which is less than the minimum 1000")
+	notWellFormedErr := ledgercore.TxnNotWellFormedError("transaction malformed")
+	tests := []struct {
+		err  error
+		code RejectionCode
+	}{
+		{nil, ""},
+		{errors.New("unexpected"), RejectionOther},
+		{&ledgercore.OverspendError{Amount: basics.MicroAlgos{Raw: 10}}, RejectionOverspend},
+		{&ledgercore.MinBalanceError{Balance: 1, MinBalance: 100000}, RejectionMinBalance},
+		{&ledgercore.AssetFrozenError{Asset: 1, Recipient: true}, RejectionAssetFrozen},
+		{logic.EvalError{Err: errors.New("assert failed")}, RejectionLogicEval},
+		{&transactions.TxnDeadError{Round: 20, FirstValid: 1, LastValid: 10}, RejectionTxnDead},
+		{&ledgercore.TxGroupMalformedError{Msg: "incomplete group"}, RejectionGroupMismatch},
+		{&ledgercore.TransactionInLedgerError{}, RejectionAlreadyInLedger},
+		{ledgercore.MakeLeaseInLedgerError(transactions.Txid{}, ledgercore.Txlease{}, false), RejectionLeaseInUse},
+		{&minFeeErr, RejectionFeeTooLow},
+		{&ErrTxPoolFeeError{}, RejectionFeeTooLow},
+		{ErrPendingQueueReachedMaxCap, RejectionPoolFull},
+		{&notWellFormedErr, RejectionNotWellFormed},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.code, RejectionCodeOf(test.err), "%v", test.err)
+		if test.err != nil {
+			// the errors are wrapped on their way to the pool and the REST API
+			wrapped := fmt.Errorf("TransactionPool.Remember: transaction %v: %w", transactions.Txid{}, test.err)
+			require.Equal(t, test.code, RejectionCodeOf(wrapped), "%v", wrapped)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// replayRejectionsRetained is the number of the most recent replay rejections the pool keeps.
+const replayRejectionsRetained = 1000
+
+// ReplayRejection describes a transaction rejected by the replay protection: either its ID is already committed, or
+// pending in the pool, or its lease is held by another transaction. These are typically caused by the retries of a
+// client, resubmitting a transaction which went through, or a new transaction with the lease of a previous one.
+type ReplayRejection struct {
+	// Time is when the transaction was rejected.
+	Time time.Time
+
+	// Txid and Sender identify the rejected transaction, and Code is RejectionAlreadyInLedger or
+	// RejectionLeaseInUse.
+	Txid   transactions.Txid
+	Sender basics.Address
+	Code   RejectionCode
+
+	// Lease is the lease of the transaction, for the lease conflicts.
+	Lease [32]byte
+
+	// Pending is set when the original transaction, whose ID or lease was replayed, is pending in the pool
+	// rather than committed.
+	Pending bool
+
+	// ConflictTxid is the ID of the original transaction, when known: the ID of the rejected transaction itself
+	// for the duplicates, and the ID of the pending transaction holding the lease for the pending lease conflicts.
+	ConflictTxid transactions.Txid
+
+	// ConflictRound is the round the committed transaction holding the lease was committed in, and LeaseExpires the
+	// last round the lease is held until, for the lease conflicts.
+	ConflictRound basics.Round
+	LeaseExpires  basics.Round
+}
+
+// makeReplayRejection describes the rejection of a transaction group with err, returning false when err is not
+// raised by the replay protection.
+//
+// makeReplayRejection assumes that pool.mu is locked.
+func (pool *TransactionPool) makeReplayRejection(txgroup []transactions.SignedTxn, err error) (ReplayRejection, bool) {
+	var inLedgerErr *ledgercore.TransactionInLedgerError
+	var leaseErr *ledgercore.LeaseInLedgerError
+	switch {
+	case errors.As(err, &inLedgerErr):
+		rejection := ReplayRejection{
+			Time:         time.Now(),
+			Txid:         inLedgerErr.Txid,
+			Code:         RejectionAlreadyInLedger,
+			Pending:      inLedgerErr.InBlockEvaluator,
+			ConflictTxid: inLedgerErr.Txid,
+		}
+		if txn, ok := findTxn(txgroup, inLedgerErr.Txid); ok {
+			rejection.Sender = txn.Txn.Sender
+		}
+		return rejection, true
+	case errors.As(err, &leaseErr):
+		lease := leaseErr.Lease()
+		rejection := ReplayRejection{
+			Time:          time.Now(),
+			Txid:          leaseErr.Txid(),
+			Sender:        lease.Sender,
+			Code:          RejectionLeaseInUse,
+			Lease:         lease.Lease,
+			Pending:       leaseErr.InBlockEvaluator,
+			ConflictRound: leaseErr.Round,
+			LeaseExpires:  leaseErr.Expires,
+		}
+		if rejection.Pending {
+			// the lease is held by a transaction of the pending block, which is not committed in any round yet.
+			rejection.ConflictRound = 0
+			rejection.ConflictTxid, _ = pool.pendingLeaseHolder(lease)
+		}
+		return rejection, true
+	}
+	return ReplayRejection{}, false
+}
+
+// findTxn returns the transaction of a group with the given ID.
+func findTxn(txgroup []transactions.SignedTxn, txid transactions.Txid) (transactions.SignedTxn, bool) {
+	for _, txn := range txgroup {
+		if txn.ID() == txid {
+			return txn, true
+		}
+	}
+	return transactions.SignedTxn{}, false
+}
+
+// pendingLeaseHolder returns the ID of the transaction of the pool holding a lease.
+//
+// pendingLeaseHolder assumes that pool.mu is locked.
+func (pool *TransactionPool) pendingLeaseHolder(lease ledgercore.Txlease) (transactions.Txid, bool) {
+	holds := func(txn transactions.SignedTxn) bool {
+		return txn.Txn.Sender == lease.Sender && txn.Txn.Lease == lease.Lease
+	}
+	for txid, txn := range pool.rememberedTxids {
+		if holds(txn) {
+			return txid, true
+		}
+	}
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+	for txid, txn := range pool.pendingTxids {
+		if holds(txn) {
+			return txid, true
+		}
+	}
+	return transactions.Txid{}, false
+}
+
+// recordReplayRejection records the rejection of a transaction group with err, if raised by the replay protection,
+// discarding the oldest rejections recorded.
+//
+// recordReplayRejection assumes that pool.mu is locked.
+func (pool *TransactionPool) recordReplayRejection(txgroup []transactions.SignedTxn, err error) {
+	rejection, ok := pool.makeReplayRejection(txgroup, err)
+	if !ok {
+		return
+	}
+	pool.replayRejectionsMu.Lock()
+	defer pool.replayRejectionsMu.Unlock()
+	pool.replayRejections = append(pool.replayRejections, rejection)
+	if len(pool.replayRejections) > replayRejectionsRetained {
+		pool.replayRejections = pool.replayRejections[len(pool.replayRejections)-replayRejectionsRetained:]
+	}
+}
+
+// ReplayRejections returns the most recent transactions rejected by the replay protection, oldest first.
+func (pool *TransactionPool) ReplayRejections() []ReplayRejection {
+	pool.replayRejectionsMu.Lock()
+	defer pool.replayRejectionsMu.Unlock()
+	rejections := make([]ReplayRejection, len(pool.replayRejections))
+	copy(rejections, pool.replayRejections)
+	return rejections
+}
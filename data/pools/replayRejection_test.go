@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestReplayRejections(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	secret := keypair()
+	sender := basics.Address(secret.SignatureVerifier)
+	receiver := basics.Address(keypair().SignatureVerifier)
+
+	ledger := makeMockLedger(t, initAccFixed([]basics.Address{sender, receiver}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	payment := func(note byte, lease [32]byte) transactions.SignedTxn {
+		return transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee + 1},
+				FirstValid:  0,
+				LastValid:   10,
+				Note:        []byte{note},
+				Lease:       lease,
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: receiver,
+				Amount:   basics.MicroAlgos{Raw: proto.MinBalance},
+			},
+		}.Sign(secret)
+	}
+	var lease [32]byte
+	crypto.RandBytes(lease[:])
+
+	original := payment(0, lease)
+	require.NoError(t, transactionPool.RememberOne(original))
+	require.Empty(t, transactionPool.ReplayRejections())
+
+	// resubmitting the pending transaction is rejected as a duplicate of itself
+	require.Error(t, transactionPool.RememberOne(original))
+	// a new transaction reusing the lease conflicts with the pending one
+	retry := payment(1, lease)
+	require.Error(t, transactionPool.RememberOne(retry))
+	// other rejections are not recorded
+	overspend := payment(2, [32]byte{})
+	overspend.Txn.Amount = basics.MicroAlgos{Raw: 1 << 33}
+	overspend = overspend.Txn.Sign(secret)
+	require.Error(t, transactionPool.RememberOne(overspend))
+
+	rejections := transactionPool.ReplayRejections()
+	require.Len(t, rejections, 2)
+	require.False(t, rejections[0].Time.IsZero())
+	require.Equal(t, ReplayRejection{
+		Time:         rejections[0].Time,
+		Txid:         original.ID(),
+		Sender:       sender,
+		Code:         RejectionAlreadyInLedger,
+		Pending:      true,
+		ConflictTxid: original.ID(),
+	}, rejections[0])
+	require.Equal(t, ReplayRejection{
+		Time:         rejections[1].Time,
+		Txid:         retry.ID(),
+		Sender:       sender,
+		Code:         RejectionLeaseInUse,
+		Lease:        lease,
+		Pending:      true,
+		ConflictTxid: original.ID(),
+		LeaseExpires: original.Txn.LastValid,
+	}, rejections[1])
+}
+
+func TestReplayRejectionsRetained(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger := makeMockLedger(t, initAccFixed([]basics.Address{{1}}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	for i := 0; i < replayRejectionsRetained+10; i++ {
+		err := ledgercore.MakeLeaseInLedgerError(transactions.Txid{byte(i), byte(i >> 8)}, ledgercore.Txlease{Sender: basics.Address{1}}, false)
+		err.Round, err.Expires = basics.Round(i), basics.Round(i+10)
+		transactionPool.recordReplayRejection(nil, err)
+	}
+	rejections := transactionPool.ReplayRejections()
+	require.Len(t, rejections, replayRejectionsRetained)
+	require.Equal(t, basics.Round(10), rejections[0].ConflictRound)
+	require.Equal(t, basics.Round(20), rejections[0].LeaseExpires)
+	require.False(t, rejections[0].Pending)
+	require.Equal(t, RejectionLeaseInUse, rejections[0].Code)
+}
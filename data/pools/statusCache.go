@@ -21,8 +21,9 @@ import (
 )
 
 type statusCacheEntry struct {
-	tx    transactions.SignedTxn
-	txErr string
+	tx        transactions.SignedTxn
+	txErr     string
+	txErrCode RejectionCode
 }
 
 type statusCache struct {
@@ -39,25 +40,27 @@ func makeStatusCache(sz int) *statusCache {
 	return sc
 }
 
-func (sc *statusCache) check(txid transactions.Txid) (tx transactions.SignedTxn, txErr string, found bool) {
+func (sc *statusCache) check(txid transactions.Txid) (tx transactions.SignedTxn, txErr string, txErrCode RejectionCode, found bool) {
 	ent, found := sc.cur[txid]
 	if !found {
 		ent, found = sc.prev[txid]
 	}
 	tx = ent.tx
 	txErr = ent.txErr
+	txErrCode = ent.txErrCode
 	return
 }
 
-func (sc *statusCache) put(tx transactions.SignedTxn, txErr string) {
+func (sc *statusCache) put(tx transactions.SignedTxn, txErr error) {
 	if len(sc.cur) >= sc.sz {
 		sc.prev = sc.cur
 		sc.cur = map[transactions.Txid]statusCacheEntry{}
 	}
 
 	sc.cur[tx.ID()] = statusCacheEntry{
-		tx:    tx,
-		txErr: txErr,
+		tx:        tx,
+		txErr:     txErr.Error(),
+		txErrCode: RejectionCodeOf(txErr),
 	}
 }
 
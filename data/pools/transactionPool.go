@@ -68,6 +68,10 @@ type TransactionPool struct {
 	feeThresholdMultiplier uint64
 	statusCache            *statusCache
 
+	// feeHistory holds the load observed over the last feeHistorySize blocks, for fee estimation
+	feeHistory     []blockFeeStats
+	feeHistorySize int
+
 	assemblyMu       deadlock.Mutex
 	assemblyCond     sync.Cond
 	assemblyDeadline time.Time
@@ -75,6 +79,14 @@ type TransactionPool struct {
 	assemblyRound   basics.Round
 	assemblyResults poolAsmResults
 
+	// assemblyReports holds the reports on the most recent blocks assembled for proposal.
+	assemblyReportsMu deadlock.Mutex
+	assemblyReports   []AssemblyReport
+
+	// replayRejections holds the most recent transactions rejected by the replay protection.
+	replayRejectionsMu deadlock.Mutex
+	replayRejections   []ReplayRejection
+
 	// pendingMu protects pendingTxGroups and pendingTxids
 	pendingMu       deadlock.RWMutex
 	pendingTxGroups [][]transactions.SignedTxn
@@ -93,6 +105,10 @@ type TransactionPool struct {
 	// proposalAssemblyTime is the ProposalAssemblyTime configured for this node.
 	proposalAssemblyTime time.Duration
 
+	// packByValue reorders the pending transaction groups by fee per byte, when they do not fit in a block,
+	// keeping the groups which reference a common account, application or asset in arrival order.
+	packByValue bool
+
 	// stateproofOverflowed indicates that a stateproof transaction was allowed to
 	// exceed the txPoolMaxSize. This flag is reset to false OnNewBlock
 	stateproofOverflowed bool
@@ -107,6 +123,7 @@ type BlockEvaluator interface {
 	Transaction(txn transactions.SignedTxn, ad transactions.ApplyData) error
 	GenerateBlock() (*ledgercore.ValidatedBlock, error)
 	ResetTxnBytes()
+	PendingAccount(addr basics.Address, assets []basics.AssetIndex) (ledgercore.AccountData, map[basics.AssetIndex]basics.AssetHolding, error)
 }
 
 // MakeTransactionPool makes a transaction pool.
@@ -124,7 +141,9 @@ func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Lo
 		logAssembleStats:     cfg.EnableAssembleStats,
 		expFeeFactor:         cfg.TxPoolExponentialIncreaseFactor,
 		txPoolMaxSize:        cfg.TxPoolSize,
+		feeHistorySize:       cfg.SuggestedFeeBlockHistory,
 		proposalAssemblyTime: cfg.ProposalAssemblyTime,
+		packByValue:          cfg.TxPoolPackByValue,
 		log:                  log,
 	}
 	pool.cond.L = &pool.mu
@@ -385,6 +404,20 @@ func (pool *TransactionPool) Test(txgroup []transactions.SignedTxn) error {
 	return pool.pendingBlockEvaluator.TestTransactionGroup(txgroup)
 }
 
+// PendingAccount returns the state of an account after the transactions pending in the pool, with its rewards
+// updated to the round of the block they are evaluated in, which it returns, along with its holdings of the given
+// assets, and of the assets the pending transactions opted it into.
+func (pool *TransactionPool) PendingAccount(addr basics.Address, assets []basics.AssetIndex) (basics.Round, ledgercore.AccountData, map[basics.AssetIndex]basics.AssetHolding, error) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if pool.pendingBlockEvaluator == nil {
+		return 0, ledgercore.AccountData{}, nil, ErrNoPendingBlockEvaluator
+	}
+	data, holdings, err := pool.pendingBlockEvaluator.PendingAccount(addr, assets)
+	return pool.pendingBlockEvaluator.Round(), data, holdings, err
+}
+
 type poolIngestParams struct {
 	recomputing bool // if unset, perform fee checks and wait until ledger is caught up
 	stats       *telemetryspec.AssembleBlockMetrics
@@ -467,6 +500,7 @@ func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 
 	err := pool.remember(txgroup)
 	if err != nil {
+		pool.recordReplayRejection(txgroup, err)
 		return fmt.Errorf("TransactionPool.Remember: %w", err)
 	}
 
@@ -475,12 +509,13 @@ func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 }
 
 // Lookup returns the error associated with a transaction that used
-// to be in the pool.  If no status information is available (e.g., because
-// it was too long ago, or the transaction committed successfully), then
-// found is false.  If the transaction is still in the pool, txErr is empty.
-func (pool *TransactionPool) Lookup(txid transactions.Txid) (tx transactions.SignedTxn, txErr string, found bool) {
+// to be in the pool, along with the code of its reason.  If no status
+// information is available (e.g., because it was too long ago, or the
+// transaction committed successfully), then found is false.  If the
+// transaction is still in the pool, txErr and txErrCode are empty.
+func (pool *TransactionPool) Lookup(txid transactions.Txid) (tx transactions.SignedTxn, txErr string, txErrCode RejectionCode, found bool) {
 	if pool == nil {
-		return transactions.SignedTxn{}, "", false
+		return transactions.SignedTxn{}, "", "", false
 	}
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
@@ -490,7 +525,7 @@ func (pool *TransactionPool) Lookup(txid transactions.Txid) (tx transactions.Sig
 
 	tx, inPool := pool.pendingTxids[txid]
 	if inPool {
-		return tx, "", true
+		return tx, "", "", true
 	}
 
 	return pool.statusCache.check(txid)
@@ -546,6 +581,7 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 		// This has the side-effect of discarding transactions that
 		// have been committed (or that are otherwise no longer valid).
 		stats = pool.recomputeBlockEvaluator(committedTxids, knownCommitted)
+		pool.recordBlockFeeStats(blockFeeStats{fullness: blockFullness(block, delta), feePerByte: pool.computeFeePerByte()})
 	}
 
 	stats.KnownCommittedCount = knownCommitted
@@ -676,7 +712,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 
 	// Ensure we know about the next protocol version (MakeBlock will panic
 	// if we don't, and we would rather stall locally than panic)
-	_, ok := config.Consensus[upgradeState.CurrentProtocol]
+	proto, ok := config.Consensus[upgradeState.CurrentProtocol]
 	if !ok {
 		pool.log.Warnf("TransactionPool.recomputeBlockEvaluator: next protocol version %v is not supported", upgradeState.CurrentProtocol)
 		return
@@ -688,6 +724,12 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	pendingCount := pool.pendingCountNoLock()
 	pool.pendingMu.RUnlock()
 
+	// once the pending groups no longer fit in a block, the ones evaluated first before the assembly deadline
+	// are the ones proposed, so these had better be the most valuable.
+	if pool.packByValue && congested(txgroups, proto.MaxTxnBytesPerBlock) {
+		txgroups = orderByValue(txgroups)
+	}
+
 	pool.assemblyMu.Lock()
 	pool.assemblyResults = poolAsmResults{
 		roundStartedEvaluating: prev.Round + basics.Round(1),
@@ -736,7 +778,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 		err := pool.add(txgroup, &asmStats)
 		if err != nil {
 			for _, tx := range txgroup {
-				pool.statusCache.put(tx, err.Error())
+				pool.statusCache.put(tx, err)
 			}
 			// metrics here are duplicated for historic reasons. stats is hardly used and should be removed in favor of asmstats
 			switch terr := err.(type) {
@@ -820,8 +862,14 @@ func (pool *TransactionPool) getStateProofStats(txib *transactions.SignedTxnInBl
 func (pool *TransactionPool) AssembleBlock(round basics.Round, deadline time.Time) (assembled *ledgercore.ValidatedBlock, err error) {
 	var stats telemetryspec.AssembleBlockMetrics
 
+	start := time.Now()
+	defer func() {
+		if err == nil && assembled != nil {
+			pool.recordAssemblyReport(makeAssemblyReport(round, start, time.Since(start), pool.proposalAssemblyTime, &stats, assembled))
+		}
+	}()
+
 	if pool.logAssembleStats {
-		start := time.Now()
 		defer func() {
 			if err != nil {
 				return
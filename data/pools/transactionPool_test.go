@@ -980,6 +980,55 @@ func TestTransactionPool_CurrentFeePerByte(t *testing.T) {
 
 }
 
+func TestTransactionPool_EstimateFees(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l := makeMockLedger(t, initAccFixed([]basics.Address{basics.Address(keypair().SignatureVerifier)}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.SuggestedFeeBlockHistory = 3
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base())
+	targets := []uint64{1, 5, 20}
+
+	// an idle pool does not require any fee beyond the minimum fee
+	for _, estimate := range transactionPool.EstimateFees(targets) {
+		require.Zero(t, estimate.FeePerByte)
+	}
+
+	// a backlog of 6 whole blocks grows the threshold for the targets it would not drain within
+	transactionPool.mu.Lock()
+	transactionPool.numPendingWholeBlocks = 6
+	transactionPool.feeThresholdMultiplier = 1
+	transactionPool.mu.Unlock()
+	estimates := transactionPool.EstimateFees(targets)
+	base := uint64(1 << 5) // 2^(pending whole blocks - 1)
+	require.Equal(t, FeeEstimate{Rounds: 1, FeePerByte: base << 6}, estimates[0])
+	require.Equal(t, FeeEstimate{Rounds: 5, FeePerByte: base << 2}, estimates[1])
+	require.Equal(t, FeeEstimate{Rounds: 20, FeePerByte: base}, estimates[2])
+
+	// congested recent blocks raise the short term targets to the highest recent threshold
+	transactionPool.mu.Lock()
+	transactionPool.numPendingWholeBlocks = 0
+	transactionPool.feeThresholdMultiplier = 0
+	for i := 0; i < 5; i++ {
+		transactionPool.recordBlockFeeStats(blockFeeStats{fullness: 1, feePerByte: uint64(10 * (i + 1))})
+	}
+	require.Len(t, transactionPool.feeHistory, 3)
+	transactionPool.mu.Unlock()
+	estimates = transactionPool.EstimateFees(targets)
+	require.Equal(t, FeeEstimate{Rounds: 1, FeePerByte: 50}, estimates[0])
+	require.Equal(t, FeeEstimate{Rounds: 5, FeePerByte: 0}, estimates[1])
+	require.Equal(t, FeeEstimate{Rounds: 20, FeePerByte: 0}, estimates[2])
+
+	// a single block with spare capacity means the network is not congested
+	transactionPool.mu.Lock()
+	transactionPool.recordBlockFeeStats(blockFeeStats{fullness: 0.5, feePerByte: 0})
+	transactionPool.mu.Unlock()
+	for _, estimate := range transactionPool.EstimateFees(targets) {
+		require.Zero(t, estimate.FeePerByte)
+	}
+}
+
 func BenchmarkTransactionPoolRememberOne(b *testing.B) {
 	numOfAccounts := 5
 	// Generate accounts
@@ -0,0 +1,309 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// Checks performed by Analyze
+const (
+	// CheckUnreachable reports instructions that no evaluation can reach.
+	CheckUnreachable = "unreachable-code"
+	// CheckStackDepth reports instructions that may not find the stack values they need, stack
+	// heights that depend on the path taken, and programs that may not end with a single value on
+	// the stack.
+	CheckStackDepth = "stack-depth"
+	// CheckRekeyTo reports logicsigs that never read the RekeyTo field of a transaction.
+	CheckRekeyTo = "missing-rekey-to-check"
+	// CheckCloseRemainderTo reports logicsigs that never read the CloseRemainderTo field of a transaction.
+	CheckCloseRemainderTo = "missing-close-remainder-to-check"
+	// CheckAssetCloseTo reports logicsigs that never read the AssetCloseTo field of a transaction.
+	CheckAssetCloseTo = "missing-asset-close-to-check"
+	// CheckDeprecated reports opcodes superseded by another opcode in the version of the program.
+	CheckDeprecated = "deprecated-opcode"
+)
+
+// Finding is a potential problem in a program, reported by Analyze.
+type Finding struct {
+	// Check is the check that reported the finding.
+	Check string `json:"check"`
+	// PC is the program counter of the instruction the finding is about, zero for findings about
+	// the whole program.
+	PC int `json:"pc"`
+	// Line is the one-based source line of the instruction, zero if unknown.
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// deprecatedOpcode is an opcode superseded by another one
+type deprecatedOpcode struct {
+	replacement string
+	version     uint64
+}
+
+// deprecatedOpcodes maps opcodes to the opcode superseding them, and the version it was
+// introduced in.
+var deprecatedOpcodes = map[string]deprecatedOpcode{
+	"substring":  {"extract", 5},
+	"substring3": {"extract3", 5},
+	"gaid":       {"gtxn CreatedAssetID or CreatedApplicationID", 5},
+	"gaids":      {"gtxns CreatedAssetID or CreatedApplicationID", 5},
+}
+
+// unknownHeight is the stack height of instructions the analysis can't determine it for, such
+// as subroutines, whose stack height depends on their callers.
+const unknownHeight = -1
+
+type analysis struct {
+	program []byte
+	version uint64
+	// pcs are the PCs of the instructions of the program, in order
+	pcs []int
+	// next maps the PC of each instruction to the PC of the following one
+	next map[int]int
+	// heights maps the PC of the instructions reachable from the start of the program to the
+	// stack height before their evaluation
+	heights  map[int]int
+	findings []Finding
+}
+
+// Analyze runs a static analysis over an assembled program, evaluated in the given mode, and
+// returns the potential problems it found, ordered by PC. The analysis is conservative: a finding
+// is not necessarily an error, but deserves a second look.
+func Analyze(ops *OpStream, mode RunMode) ([]Finding, error) {
+	version, vlen := binary.Uvarint(ops.Program)
+	if vlen <= 0 {
+		return nil, fmt.Errorf("invalid version")
+	}
+	if version > LogicVersion {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+	_, ds, err := disassembleInstrumented(ops.Program, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	a := analysis{
+		program: ops.Program,
+		version: version,
+		pcs:     make([]int, len(ds.pcOffset)),
+		next:    make(map[int]int, len(ds.pcOffset)),
+		heights: make(map[int]int, len(ds.pcOffset)),
+	}
+	for i, pcOffset := range ds.pcOffset {
+		a.pcs[i] = pcOffset.PC
+		if i+1 < len(ds.pcOffset) {
+			a.next[pcOffset.PC] = ds.pcOffset[i+1].PC
+		} else {
+			a.next[pcOffset.PC] = len(ops.Program)
+		}
+	}
+
+	a.checkStack(vlen)
+	a.checkUnreachable()
+	a.checkDeprecated()
+	if mode == ModeSig {
+		a.checkTxnFieldRead(RekeyTo, CheckRekeyTo, "the sender may be rekeyed")
+		a.checkTxnFieldRead(CloseRemainderTo, CheckCloseRemainderTo, "the sender's Algos may be closed out")
+		a.checkTxnFieldRead(AssetCloseTo, CheckAssetCloseTo, "the sender's assets may be closed out")
+	}
+
+	for i := range a.findings {
+		if line, ok := ops.OffsetToLine[a.findings[i].PC]; ok {
+			a.findings[i].Line = line + 1
+		}
+	}
+	sort.SliceStable(a.findings, func(i, j int) bool {
+		return a.findings[i].PC < a.findings[j].PC
+	})
+	return a.findings, nil
+}
+
+func (a *analysis) report(pc int, check string, format string, args ...interface{}) {
+	a.findings = append(a.findings, Finding{Check: check, PC: pc, Message: fmt.Sprintf(format, args...)})
+}
+
+func (a *analysis) spec(pc int) *OpSpec {
+	return &opsByOpcode[a.version][a.program[pc]]
+}
+
+func (a *analysis) branchTarget(pc int) int {
+	return pc + 3 + decodeBranchOffset(a.program, pc+1)
+}
+
+// successors returns the PCs evaluation may continue at after the instruction at pc, where the
+// length of the program stands for the end of the program.
+func (a *analysis) successors(pc int) []int {
+	spec := a.spec(pc)
+	switch spec.Name {
+	case "b":
+		return []int{a.branchTarget(pc)}
+	case "bz", "bnz", "switch", "match":
+		return branchTargets(a.program, a.version, pc)
+	case "callsub":
+		return []int{a.branchTarget(pc), a.next[pc]}
+	case "retsub":
+		return nil
+	}
+	if spec.AlwaysExits() {
+		return nil
+	}
+	return []int{a.next[pc]}
+}
+
+// stackEffect returns the number of values the instruction at pc needs on the stack, and the
+// stack height change its evaluation makes.
+func (a *analysis) stackEffect(pc int) (needs int, change int) {
+	spec := a.spec(pc)
+	immediate := func() int {
+		return int(a.program[pc+1])
+	}
+	switch spec.Name {
+	case "popn":
+		return immediate(), -immediate()
+	case "dupn":
+		return 1, immediate()
+	case "pushints", "pushbytess":
+		count, _ := binary.Uvarint(a.program[pc+1:])
+		return 0, int(count)
+	case "match":
+		return immediate() + 1, -(immediate() + 1)
+	case "dig":
+		return immediate() + 1, 1
+	case "bury":
+		return immediate() + 1, -1
+	case "cover", "uncover":
+		return immediate() + 1, 0
+	}
+	if spec.AlwaysExits() {
+		return len(spec.Arg.Types), -len(spec.Arg.Types)
+	}
+	return len(spec.Arg.Types), len(spec.Return.Types) - len(spec.Arg.Types)
+}
+
+// checkStack computes the stack height before each instruction reachable from the start of the
+// program, and reports the instructions it may be wrong for.
+func (a *analysis) checkStack(start int) {
+	reported := make(map[int]bool)
+	reportOnce := func(pc int, format string, args ...interface{}) {
+		if !reported[pc] {
+			reported[pc] = true
+			a.report(pc, CheckStackDepth, format, args...)
+		}
+	}
+
+	a.heights[start] = 0
+	worklist := []int{start}
+	for len(worklist) > 0 {
+		pc := worklist[len(worklist)-1]
+		worklist = worklist[:len(worklist)-1]
+		if pc >= len(a.program) {
+			continue
+		}
+
+		spec := a.spec(pc)
+		after := a.heights[pc]
+		if after != unknownHeight {
+			needs, change := a.stackEffect(pc)
+			if after < needs {
+				reportOnce(pc, "%s needs %d stack values, but the stack may only hold %d", spec.Name, needs, after)
+				after = unknownHeight
+			} else if after+change > maxStackDepth {
+				reportOnce(pc, "%s exceeds the maximum stack depth of %d", spec.Name, maxStackDepth)
+				after = unknownHeight
+			} else {
+				after += change
+			}
+		}
+		if spec.Name == "callsub" {
+			// the stack height of subroutines depends on their callers
+			after = unknownHeight
+		}
+
+		for _, succ := range a.successors(pc) {
+			if succ >= len(a.program) && after != unknownHeight && after != 1 {
+				reportOnce(pc, "the program ends with %d values on the stack instead of 1", after)
+			}
+			previous, visited := a.heights[succ]
+			switch {
+			case !visited:
+				a.heights[succ] = after
+				worklist = append(worklist, succ)
+			case previous != after && previous != unknownHeight:
+				if after != unknownHeight && succ < len(a.program) {
+					reportOnce(succ, "the stack holds either %d or %d values here, depending on the path taken", previous, after)
+				}
+				a.heights[succ] = unknownHeight
+				worklist = append(worklist, succ)
+			}
+		}
+	}
+}
+
+// checkUnreachable reports the runs of instructions no evaluation can reach.
+func (a *analysis) checkUnreachable() {
+	for i := 0; i < len(a.pcs); i++ {
+		if _, reachable := a.heights[a.pcs[i]]; reachable {
+			continue
+		}
+		first := i
+		for i+1 < len(a.pcs) {
+			if _, reachable := a.heights[a.pcs[i+1]]; reachable {
+				break
+			}
+			i++
+		}
+		count := i - first + 1
+		plural := "s"
+		if count == 1 {
+			plural = ""
+		}
+		a.report(a.pcs[first], CheckUnreachable, "%d unreachable instruction%s", count, plural)
+	}
+}
+
+// checkDeprecated reports the opcodes superseded by another opcode in the version of the program.
+func (a *analysis) checkDeprecated() {
+	for _, pc := range a.pcs {
+		name := a.spec(pc).Name
+		if deprecated, ok := deprecatedOpcodes[name]; ok && a.version >= deprecated.version {
+			a.report(pc, CheckDeprecated, "%s is superseded by %s since version %d", name, deprecated.replacement, deprecated.version)
+		}
+	}
+}
+
+// checkTxnFieldRead reports a logicsig that never reads the given field of a transaction, so the
+// transactions it approves may set it to anything.
+func (a *analysis) checkTxnFieldRead(field TxnField, check string, consequence string) {
+	for _, pc := range a.pcs {
+		fieldPos := pc + 1
+		switch a.spec(pc).Name {
+		case "txn", "gtxns":
+		case "gtxn":
+			fieldPos = pc + 2
+		default:
+			continue
+		}
+		if fieldPos < len(a.program) && TxnField(a.program[fieldPos]) == field {
+			return
+		}
+	}
+	a.report(0, check, "the logicsig never checks %s, so %s", field, consequence)
+}
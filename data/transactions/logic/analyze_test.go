@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic_test
+
+import (
+	"testing"
+
+	. "github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func analyze(t *testing.T, source string, version uint64, mode RunMode) []Finding {
+	t.Helper()
+	findings, err := Analyze(TestProg(t, source, version), mode)
+	require.NoError(t, err)
+	return findings
+}
+
+func TestAnalyzeClean(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `txn RekeyTo
+global ZeroAddress
+==
+txn CloseRemainderTo
+global ZeroAddress
+==
+&&
+txn AssetCloseTo
+global ZeroAddress
+==
+&&
+bz fail
+callsub check
+return
+check:
+pushint 1
+retsub
+fail:
+err`
+	require.Empty(t, analyze(t, source, 8, ModeSig))
+}
+
+func TestAnalyzeUnreachable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `pushint 1
+return
+pushint 2
+pop
+pushint 1`
+	findings := analyze(t, source, 8, ModeApp)
+	require.Equal(t, []Finding{{
+		Check:   CheckUnreachable,
+		PC:      4,
+		Line:    3,
+		Message: "3 unreachable instructions",
+	}}, findings)
+}
+
+func TestAnalyzeStackDepth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	findings := analyze(t, "pushint 1\npushint 2", 8, ModeApp)
+	require.Len(t, findings, 1)
+	require.Equal(t, CheckStackDepth, findings[0].Check)
+	require.Equal(t, 3, findings[0].PC)
+	require.Contains(t, findings[0].Message, "ends with 2 values")
+
+	source := `pushint 1
+bnz next
+next:
++`
+	findings = analyze(t, source, 8, ModeApp)
+	require.Len(t, findings, 1)
+	require.Equal(t, CheckStackDepth, findings[0].Check)
+	require.Equal(t, 6, findings[0].PC)
+	require.Equal(t, 4, findings[0].Line)
+	require.Contains(t, findings[0].Message, "+ needs 2 stack values")
+
+	source = `pushint 1
+pushint 1
+bnz skip
+pushint 2
+skip:
+return`
+	findings = analyze(t, source, 8, ModeApp)
+	require.Len(t, findings, 1)
+	require.Equal(t, CheckStackDepth, findings[0].Check)
+	require.Equal(t, 10, findings[0].PC)
+	require.Contains(t, findings[0].Message, "either 1 or 2 values")
+
+	// the stack height of subroutines depends on their callers
+	source = `pushint 1
+callsub double
+return
+double:
+dup
++
+retsub`
+	require.Empty(t, analyze(t, source, 8, ModeApp))
+}
+
+func TestAnalyzeLogicSigChecks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	findings := analyze(t, "pushint 1", 8, ModeSig)
+	var checks []string
+	for _, finding := range findings {
+		require.Zero(t, finding.PC)
+		require.Zero(t, finding.Line)
+		checks = append(checks, finding.Check)
+	}
+	require.ElementsMatch(t, []string{CheckRekeyTo, CheckCloseRemainderTo, CheckAssetCloseTo}, checks)
+
+	source := `gtxn 0 RekeyTo
+txn GroupIndex
+gtxns CloseRemainderTo
+==
+txn AssetCloseTo
+pop`
+	require.Empty(t, analyze(t, source, 8, ModeSig))
+
+	require.Empty(t, analyze(t, "pushint 1", 8, ModeApp))
+}
+
+func TestAnalyzeDeprecated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `pushbytes 0x0102
+substring 0 1
+len`
+	require.Empty(t, analyze(t, source, 4, ModeApp))
+
+	findings := analyze(t, source, 5, ModeApp)
+	require.Equal(t, []Finding{{
+		Check:   CheckDeprecated,
+		PC:      5,
+		Line:    2,
+		Message: "substring is superseded by extract since version 5",
+	}}, findings)
+}
@@ -0,0 +1,299 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// ProgramCoverage is the coverage of a program, accumulated over all its evaluations.
+type ProgramCoverage struct {
+	Program []byte
+	// Hits counts the evaluations of each instruction, by PC.
+	Hits map[int]uint64
+	// Branches counts, for each conditional branching instruction, by PC, the evaluations that
+	// continued at each PC.
+	Branches map[int]map[int]uint64
+}
+
+// programEvaluation is a program being evaluated by a CoverageTracer.
+type programEvaluation struct {
+	coverage *ProgramCoverage
+	// branchPC is the PC of the conditional branching instruction being evaluated, or -1.
+	branchPC int
+}
+
+// CoverageTracer is an EvalTracer recording which instructions and branches of the programs it
+// traces are evaluated. Coverage accumulates over all the evaluations it traces, so that a
+// single CoverageTracer may measure the coverage of a whole test suite.
+//
+// A CoverageTracer is not safe for concurrent evaluations.
+type CoverageTracer struct {
+	NullEvalTracer
+
+	programs map[crypto.Digest]*ProgramCoverage
+
+	// evaluating holds the programs being evaluated, innermost last.
+	evaluating []programEvaluation
+}
+
+// MakeCoverageTracer creates a CoverageTracer with no coverage.
+func MakeCoverageTracer() *CoverageTracer {
+	return &CoverageTracer{programs: make(map[crypto.Digest]*ProgramCoverage)}
+}
+
+// Programs returns the coverage of the programs evaluated so far, keyed by program hash.
+func (t *CoverageTracer) Programs() map[crypto.Digest]*ProgramCoverage {
+	return t.programs
+}
+
+// BeforeProgram starts recording the coverage of the program.
+func (t *CoverageTracer) BeforeProgram(cx *EvalContext) {
+	hash := HashProgram(cx.program)
+	coverage, ok := t.programs[hash]
+	if !ok {
+		coverage = &ProgramCoverage{
+			Program:  append([]byte(nil), cx.program...),
+			Hits:     make(map[int]uint64),
+			Branches: make(map[int]map[int]uint64),
+		}
+		t.programs[hash] = coverage
+	}
+	t.evaluating = append(t.evaluating, programEvaluation{coverage: coverage, branchPC: -1})
+}
+
+// AfterProgram stops recording the coverage of the program.
+func (t *CoverageTracer) AfterProgram(cx *EvalContext, evalError error) {
+	t.evaluating = t.evaluating[:len(t.evaluating)-1]
+}
+
+// BeforeOpcode records the evaluation of the instruction.
+func (t *CoverageTracer) BeforeOpcode(cx *EvalContext) {
+	eval := &t.evaluating[len(t.evaluating)-1]
+	eval.coverage.Hits[cx.pc]++
+	if isConditionalBranch(opsByOpcode[cx.version][cx.program[cx.pc]].Name) {
+		eval.branchPC = cx.pc
+	}
+}
+
+// AfterOpcode records the branch taken by a conditional branching instruction.
+func (t *CoverageTracer) AfterOpcode(cx *EvalContext, evalError error) {
+	eval := &t.evaluating[len(t.evaluating)-1]
+	if eval.branchPC < 0 {
+		return
+	}
+	if evalError == nil {
+		taken := eval.coverage.Branches[eval.branchPC]
+		if taken == nil {
+			taken = make(map[int]uint64)
+			eval.coverage.Branches[eval.branchPC] = taken
+		}
+		taken[cx.pc]++
+	}
+	eval.branchPC = -1
+}
+
+func isConditionalBranch(name string) bool {
+	switch name {
+	case "bz", "bnz", "switch", "match":
+		return true
+	}
+	return false
+}
+
+// branchTargets returns the PCs evaluation may continue at after the conditional branching
+// instruction at pc, starting with the following instruction. It returns nil for other
+// instructions, or if the instruction is malformed.
+func branchTargets(program []byte, version uint64, pc int) []int {
+	var targets []int
+	switch opsByOpcode[version][program[pc]].Name {
+	case "bz", "bnz":
+		if pc+3 > len(program) {
+			return nil
+		}
+		targets = []int{pc + 3, pc + 3 + decodeBranchOffset(program, pc+1)}
+	case "switch", "match":
+		if pc+2 > len(program) {
+			return nil
+		}
+		numOffsets := int(program[pc+1])
+		eoi := pc + 2 + 2*numOffsets
+		if eoi > len(program) {
+			return nil
+		}
+		targets = []int{eoi}
+		for i := 0; i < numOffsets; i++ {
+			targets = append(targets, eoi+decodeBranchOffset(program, pc+2+2*i))
+		}
+	default:
+		return nil
+	}
+
+	// a target equal to another one is a single branch
+	unique := targets[:0]
+	for _, target := range targets {
+		dup := false
+		for _, u := range unique {
+			dup = dup || u == target
+		}
+		if !dup {
+			unique = append(unique, target)
+		}
+	}
+	return unique
+}
+
+// lcovFile is the coverage of a source file, as reported in an lcov tracefile.
+type lcovFile struct {
+	lines    map[int]uint64
+	branches []lcovBranch
+}
+
+// lcovBranch is a conditional branching instruction, as reported in an lcov tracefile.
+type lcovBranch struct {
+	line     int
+	executed bool
+	taken    []uint64
+}
+
+// instructionLines maps the instructions of a program to their file and one-based line, using
+// the source map if there is one, and the disassembly of the program otherwise.
+func instructionLines(hash crypto.Digest, program []byte, sourceMap *SourceMap) (map[int]string, map[int]int, error) {
+	text, ds, err := disassembleInstrumented(program, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not disassemble program %s: %w", basics.Address(hash), err)
+	}
+	files := make(map[int]string, len(ds.pcOffset))
+	lines := make(map[int]int, len(ds.pcOffset))
+
+	if sourceMap == nil {
+		name := basics.Address(hash).String() + ".teal"
+		for _, pcOffset := range ds.pcOffset {
+			files[pcOffset.PC] = name
+			lines[pcOffset.PC] = strings.Count(text[:pcOffset.Offset], "\n") + 1
+		}
+		return files, lines, nil
+	}
+
+	locations, err := sourceMap.PCLocations()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid source map for program %s: %w", basics.Address(hash), err)
+	}
+	for _, pcOffset := range ds.pcOffset {
+		if location, ok := locations[pcOffset.PC]; ok {
+			files[pcOffset.PC] = sourceMap.Sources[location.Source]
+			lines[pcOffset.PC] = location.Line + 1
+		}
+	}
+	return files, lines, nil
+}
+
+// WriteLcov writes the coverage recorded so far as an lcov tracefile. The lines of a program with
+// a source map in sourceMaps, keyed by program hash, are the lines of its sources. The lines of
+// other programs are the lines of their disassembly, in a file named after the program hash.
+func (t *CoverageTracer) WriteLcov(w io.Writer, sourceMaps map[crypto.Digest]SourceMap) error {
+	files := make(map[string]*lcovFile)
+	for hash, coverage := range t.programs {
+		var sourceMap *SourceMap
+		if sm, ok := sourceMaps[hash]; ok {
+			sourceMap = &sm
+		}
+		pcFiles, pcLines, err := instructionLines(hash, coverage.Program, sourceMap)
+		if err != nil {
+			return err
+		}
+		version, _ := binary.Uvarint(coverage.Program)
+
+		pcs := make([]int, 0, len(pcFiles))
+		for pc := range pcFiles {
+			pcs = append(pcs, pc)
+		}
+		sort.Ints(pcs)
+		for _, pc := range pcs {
+			name := pcFiles[pc]
+			file, ok := files[name]
+			if !ok {
+				file = &lcovFile{lines: make(map[int]uint64)}
+				files[name] = file
+			}
+			line := pcLines[pc]
+			hits := coverage.Hits[pc]
+			file.lines[line] += hits
+
+			targets := branchTargets(coverage.Program, version, pc)
+			if targets == nil {
+				continue
+			}
+			branch := lcovBranch{line: line, executed: hits > 0, taken: make([]uint64, len(targets))}
+			for i, target := range targets {
+				branch.taken[i] = coverage.Branches[pc][target]
+			}
+			file.branches = append(file.branches, branch)
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := bufio.NewWriter(w)
+	for _, name := range names {
+		file := files[name]
+		fmt.Fprintf(out, "TN:\nSF:%s\n", name)
+
+		branchesFound, branchesHit := 0, 0
+		for block, branch := range file.branches {
+			for i, taken := range branch.taken {
+				branchesFound++
+				if !branch.executed {
+					fmt.Fprintf(out, "BRDA:%d,%d,%d,-\n", branch.line, block, i)
+					continue
+				}
+				if taken > 0 {
+					branchesHit++
+				}
+				fmt.Fprintf(out, "BRDA:%d,%d,%d,%d\n", branch.line, block, i, taken)
+			}
+		}
+		fmt.Fprintf(out, "BRF:%d\nBRH:%d\n", branchesFound, branchesHit)
+
+		lines := make([]int, 0, len(file.lines))
+		for line := range file.lines {
+			lines = append(lines, line)
+		}
+		sort.Ints(lines)
+		linesHit := 0
+		for _, line := range lines {
+			if file.lines[line] > 0 {
+				linesHit++
+			}
+			fmt.Fprintf(out, "DA:%d,%d\n", line, file.lines[line])
+		}
+		fmt.Fprintf(out, "LF:%d\nLH:%d\nend_of_record\n", len(lines), linesHit)
+	}
+	return out.Flush()
+}
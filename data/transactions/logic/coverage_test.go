@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	. "github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoverageTracer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `pushint 1
+bnz yes
+err
+yes:
+pushint 1`
+	ops := TestProg(t, source, 8)
+	hash := HashProgram(ops.Program)
+
+	coverage := MakeCoverageTracer()
+	for i := 0; i < 2; i++ {
+		ep := DefaultEvalParams()
+		ep.Tracer = coverage
+		TestLogic(t, source, 8, ep)
+	}
+
+	require.Len(t, coverage.Programs(), 1)
+	programCoverage := coverage.Programs()[hash]
+	require.Equal(t, ops.Program, programCoverage.Program)
+	require.Equal(t, map[int]uint64{1: 2, 3: 2, 7: 2}, programCoverage.Hits)
+	require.Equal(t, map[int]map[int]uint64{3: {7: 2}}, programCoverage.Branches)
+
+	// without a source map, lines are those of the disassembly
+	var report strings.Builder
+	require.NoError(t, coverage.WriteLcov(&report, nil))
+	require.Equal(t, `TN:
+SF:`+basics.Address(hash).String()+`.teal
+BRDA:3,0,0,0
+BRDA:3,0,1,2
+BRF:2
+BRH:1
+DA:2,2
+DA:3,2
+DA:4,0
+DA:6,2
+LF:4
+LH:3
+end_of_record
+`, report.String())
+
+	// with a source map, lines are those of the source
+	sourceMap := GetSourceMap([]string{"test.teal"}, ops.OffsetToLine)
+	report.Reset()
+	require.NoError(t, coverage.WriteLcov(&report, map[crypto.Digest]SourceMap{hash: sourceMap}))
+	require.Equal(t, `TN:
+SF:test.teal
+BRDA:2,0,0,0
+BRDA:2,0,1,2
+BRF:2
+BRH:1
+DA:1,2
+DA:2,2
+DA:3,0
+DA:5,2
+LF:4
+LH:3
+end_of_record
+`, report.String())
+}
@@ -345,6 +345,15 @@ func (ep *EvalParams) GetCaller() *EvalContext {
 	return ep.caller
 }
 
+// PooledAllowedInners returns the number of inner transactions the group may still issue, and
+// false if inner transactions are not pooled across the group.
+func (ep *EvalParams) PooledAllowedInners() (int, bool) {
+	if !ep.Proto.EnableInnerTransactionPooling || ep.pooledAllowedInners == nil {
+		return 0, false
+	}
+	return *ep.pooledAllowedInners, true
+}
+
 func copyWithClearAD(txgroup []transactions.SignedTxnWithAD) []transactions.SignedTxnWithAD {
 	copy := make([]transactions.SignedTxnWithAD, len(txgroup))
 	for i := range txgroup {
@@ -630,6 +639,31 @@ func (cx *EvalContext) RunMode() RunMode {
 // PC returns the program counter of the current application being evaluated
 func (cx *EvalContext) PC() int { return cx.pc }
 
+// GetOpSpec returns the OpSpec of the opcode at the program counter
+func (cx *EvalContext) GetOpSpec() OpSpec { return opsByOpcode[cx.version][cx.program[cx.pc]] }
+
+// GetProgram returns the program being evaluated
+func (cx *EvalContext) GetProgram() []byte { return cx.program }
+
+// StackHeight returns the number of values on the operand stack
+func (cx *EvalContext) StackHeight() int { return len(cx.stack) }
+
+// StackValue returns the value at index i of the operand stack, 0 being the bottom of the stack
+func (cx *EvalContext) StackValue(i int) basics.TealValue { return cx.stack[i].toTealValue() }
+
+// StackValueEquals reports whether the value at index i of the operand stack
+// equals tv, without copying the value out of the stack
+func (cx *EvalContext) StackValueEquals(i int, tv basics.TealValue) bool {
+	sv := cx.stack[i]
+	if sv.avmType() == avmBytes {
+		return tv.Type == basics.TealBytesType && string(sv.Bytes) == tv.Bytes
+	}
+	return tv.Type == basics.TealUintType && sv.Uint == tv.Uint
+}
+
+// ScratchValue returns the value of scratch slot i
+func (cx *EvalContext) ScratchValue(i int) basics.TealValue { return cx.scratch[i].toTealValue() }
+
 // avmType describes the type of a value on the operand stack
 // avmTypes are a subset of StackTypes
 type avmType byte
@@ -879,6 +913,40 @@ type EvalError struct {
 	details    string
 	groupIndex int
 	logicsig   bool
+
+	// ProgramHash is the hash of the program that failed.
+	ProgramHash crypto.Digest
+	// PC is the program counter of the failing instruction.
+	PC int
+	// Opcode is the name of the instruction at PC, empty if PC is past the
+	// end of the program.
+	Opcode string
+	// AppID is the application whose program failed, zero for a logicsig.
+	AppID basics.AppIndex
+}
+
+// GroupIndex returns the index, in its group, of the transaction whose program failed.
+func (err EvalError) GroupIndex() int {
+	return err.groupIndex
+}
+
+// LogicSig reports whether the failed program was a logicsig.
+func (err EvalError) LogicSig() bool {
+	return err.logicsig
+}
+
+// CallPath returns the chain of failures leading to err, following inner
+// transactions: err itself first, then the failure of the inner app call that
+// made it fail, and so on, ending with the program that actually failed.
+func (err EvalError) CallPath() []EvalError {
+	path := []EvalError{err}
+	for {
+		var inner EvalError
+		if !errors.As(path[len(path)-1].Err, &inner) {
+			return path
+		}
+		path = append(path, inner)
+	}
 }
 
 // Error satisfies builtin interface `error`
@@ -970,7 +1038,7 @@ func EvalContract(program []byte, gi int, aid basics.AppIndex, params *EvalParam
 			if used > cx.ioBudget {
 				err = fmt.Errorf("box read budget (%d) exceeded", cx.ioBudget)
 				if !cx.Proto.EnableBareBudgetError {
-					err = EvalError{err, "", gi, false}
+					err = EvalError{Err: err, groupIndex: gi, ProgramHash: HashProgram(program), AppID: aid}
 				}
 				return false, nil, err
 			}
@@ -983,9 +1051,7 @@ func EvalContract(program []byte, gi int, aid basics.AppIndex, params *EvalParam
 	}
 	pass, err := eval(program, &cx)
 	if err != nil {
-		pc, det := cx.pcDetails()
-		details := fmt.Sprintf("pc=%d, opcodes=%s", pc, det)
-		err = EvalError{err, details, gi, false}
+		err = cx.evalError(err, false)
 	}
 
 	if cx.Trace != nil && cx.caller != nil {
@@ -1022,9 +1088,7 @@ func EvalSignatureFull(gi int, params *EvalParams) (bool, *EvalContext, error) {
 	pass, err := eval(cx.txn.Lsig.Logic, &cx)
 
 	if err != nil {
-		pc, det := cx.pcDetails()
-		details := fmt.Sprintf("pc=%d, opcodes=%s", pc, det)
-		err = EvalError{err, details, gi, true}
+		err = cx.evalError(err, true)
 	}
 
 	return pass, &cx, err
@@ -5799,6 +5863,25 @@ func opBlock(cx *EvalContext) error {
 }
 
 // pcDetails return PC and disassembled instructions at PC up to 2 opcodes back
+// evalError wraps err, which failed the evaluation of cx's program, in an
+// EvalError locating the failure.
+func (cx *EvalContext) evalError(err error, logicsig bool) EvalError {
+	pc, det := cx.pcDetails()
+	evalErr := EvalError{
+		Err:         err,
+		details:     fmt.Sprintf("pc=%d, opcodes=%s", pc, det),
+		groupIndex:  cx.groupIndex,
+		logicsig:    logicsig,
+		ProgramHash: HashProgram(cx.program),
+		PC:          pc,
+		AppID:       cx.appID,
+	}
+	if pc < len(cx.program) && cx.version <= LogicVersion {
+		evalErr.Opcode = opsByOpcode[cx.version][cx.program[pc]].Name
+	}
+	return evalErr
+}
+
 func (cx *EvalContext) pcDetails() (pc int, dis string) {
 	const maxNumAdditionalOpcodes = 2
 	text, ds, err := disassembleInstrumented(cx.program, nil)
@@ -501,6 +501,47 @@ func TestInnerAppl(t *testing.T) {
 	})
 }
 
+// TestInnerEvalErrorCallPath ensures that the failure of an inner app call is
+// located in both the inner and the outer program.
+func TestInnerEvalErrorCallPath(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := MakeSampleEnv()
+	ledger.NewApp(tx.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(appAddr(888), 200000)
+
+	inner := TestProg(t, "pushint 1; pushint 0; /", AssemblerMaxVersion)
+	ledger.NewApp(basics.Address{0x01}, 56, basics.AppParams{ApprovalProgram: inner.Program})
+
+	outer := TestProg(t, `
+  itxn_begin
+   int appl;   itxn_field TypeEnum
+   int 56;     itxn_field ApplicationID
+  itxn_submit
+  int 1
+`, AssemblerMaxVersion)
+	_, err := EvalApp(outer.Program, 0, 888, ep)
+	var evalErr EvalError
+	require.ErrorAs(t, err, &evalErr)
+
+	path := evalErr.CallPath()
+	require.Len(t, path, 2)
+
+	require.Equal(t, basics.AppIndex(888), path[0].AppID)
+	require.Equal(t, HashProgram(outer.Program), path[0].ProgramHash)
+	require.Equal(t, "itxn_submit", path[0].Opcode)
+	require.Equal(t, 0, path[0].GroupIndex())
+	require.False(t, path[0].LogicSig())
+
+	require.Equal(t, basics.AppIndex(56), path[1].AppID)
+	require.Equal(t, HashProgram(inner.Program), path[1].ProgramHash)
+	require.Equal(t, 5, path[1].PC)
+	require.Equal(t, "/", path[1].Opcode)
+	require.Equal(t, 0, path[1].GroupIndex())
+	require.Equal(t, "/ 0", path[1].Err.Error())
+}
+
 // TestExtraFields tests that the inner txn fields are not allowed to be set for
 // different transaction type than the one submitted.
 func TestExtraFields(t *testing.T) {
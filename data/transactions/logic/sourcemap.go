@@ -18,6 +18,7 @@ package logic
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 )
 
@@ -92,3 +93,83 @@ func MakeSourceMapLine(tcol, sindex, sline, scol int) string {
 	intToVLQ(scol, buf)
 	return buf.String()
 }
+
+// SourceLocation is a position in the sources of a source map.
+type SourceLocation struct {
+	// Source is the index of the source file in the Sources of the source map.
+	Source int
+	// Line and Column are zero based.
+	Line   int
+	Column int
+}
+
+// PCLocations decodes the mappings of the source map, and returns the source
+// location of every program counter that has one. As in the source maps
+// generated by the assembler, every line of the mappings is a program counter,
+// and its first segment locates it in the sources.
+func (s SourceMap) PCLocations() (map[int]SourceLocation, error) {
+	if s.Version != sourceMapVersion {
+		return nil, fmt.Errorf("unsupported source map version %d", s.Version)
+	}
+
+	locations := make(map[int]SourceLocation)
+	var loc SourceLocation
+	for pc, line := range strings.Split(s.Mappings, ";") {
+		for _, segment := range strings.Split(line, ",") {
+			if segment == "" {
+				continue
+			}
+			fields, err := vlqToInts(segment)
+			if err != nil {
+				return nil, fmt.Errorf("pc %d: %w", pc, err)
+			}
+			if len(fields) == 1 {
+				// only a generated column, with no source
+				continue
+			}
+			if len(fields) < 4 {
+				return nil, fmt.Errorf("pc %d: segment %q has %d fields", pc, segment, len(fields))
+			}
+			loc.Source += fields[1]
+			loc.Line += fields[2]
+			loc.Column += fields[3]
+			if loc.Source < 0 || loc.Source >= len(s.Sources) || loc.Line < 0 || loc.Column < 0 {
+				return nil, fmt.Errorf("pc %d: segment %q is out of the sources", pc, segment)
+			}
+			if _, ok := locations[pc]; !ok {
+				locations[pc] = loc
+			}
+		}
+	}
+	return locations, nil
+}
+
+// vlqToInts decodes the base64 VLQ values of a source map segment.
+func vlqToInts(segment string) ([]int, error) {
+	var values []int
+	v, shift := 0, 0
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(b64table, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid character %q in segment %q", segment[i], segment)
+		}
+		if shift > 30 {
+			return nil, fmt.Errorf("value overflow in segment %q", segment)
+		}
+		v |= (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+		if v&1 != 0 {
+			values = append(values, -(v >> 1))
+		} else {
+			values = append(values, v>>1)
+		}
+		v, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated segment %q", segment)
+	}
+	return values, nil
+}
@@ -66,3 +66,51 @@ func TestVLQ(t *testing.T) {
 	a.Equal("AAggBA", MakeSourceMapLine(0, 0, 512, 0))
 	a.Equal("ADggBD", MakeSourceMapLine(0, -1, 512, -1))
 }
+
+func TestSourceMapPCLocations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	offsetToLine := map[int]int{
+		1: 1,
+		2: 2,
+		5: 3,
+		6: 1,
+	}
+	locations, err := GetSourceMap([]string{"test.teal"}, offsetToLine).PCLocations()
+	a.NoError(err)
+	a.Len(locations, len(offsetToLine))
+	for pc, line := range offsetToLine {
+		a.Equal(SourceLocation{Source: 0, Line: line}, locations[pc])
+	}
+
+	// a second source, and several segments for the same pc
+	sm := SourceMap{
+		Version:  sourceMapVersion,
+		Sources:  []string{"a.py", "b.py"},
+		Mappings: MakeSourceMapLine(0, 1, 4, 2) + "," + MakeSourceMapLine(3, 0, 1, 0) + ";;" + MakeSourceMapLine(0, -1, 7, -2),
+	}
+	locations, err = sm.PCLocations()
+	a.NoError(err)
+	a.Equal(map[int]SourceLocation{
+		0: {Source: 1, Line: 4, Column: 2},
+		2: {Source: 0, Line: 12, Column: 0},
+	}, locations)
+
+	sm.Mappings = MakeSourceMapLine(0, 2, 0, 0)
+	_, err = sm.PCLocations()
+	a.ErrorContains(err, "out of the sources")
+
+	sm.Mappings = "A!AA"
+	_, err = sm.PCLocations()
+	a.ErrorContains(err, "invalid character")
+
+	sm.Mappings = "AAg"
+	_, err = sm.PCLocations()
+	a.ErrorContains(err, "truncated")
+
+	sm.Version = 2
+	_, err = sm.PCLocations()
+	a.ErrorContains(err, "version")
+}
@@ -78,7 +78,8 @@ type batchLoad struct {
 	txnGroups      [][]transactions.SignedTxn
 	groupCtxs      []*GroupContext
 	backlogMessage []interface{}
-	messagesForTxn []int
+	// items holds the batch verification item of each txn group
+	items []int
 }
 
 func makeBatchLoad(l int) (bl *batchLoad) {
@@ -86,15 +87,15 @@ func makeBatchLoad(l int) (bl *batchLoad) {
 		txnGroups:      make([][]transactions.SignedTxn, 0, l),
 		groupCtxs:      make([]*GroupContext, 0, l),
 		backlogMessage: make([]interface{}, 0, l),
-		messagesForTxn: make([]int, 0, l),
+		items:          make([]int, 0, l),
 	}
 }
 
-func (bl *batchLoad) addLoad(txngrp []transactions.SignedTxn, gctx *GroupContext, backlogMsg interface{}, numBatchableSigs int) {
+func (bl *batchLoad) addLoad(txngrp []transactions.SignedTxn, gctx *GroupContext, backlogMsg interface{}, item int) {
 	bl.txnGroups = append(bl.txnGroups, txngrp)
 	bl.groupCtxs = append(bl.groupCtxs, gctx)
 	bl.backlogMessage = append(bl.backlogMessage, backlogMsg)
-	bl.messagesForTxn = append(bl.messagesForTxn, numBatchableSigs)
+	bl.items = append(bl.items, item)
 
 }
 
@@ -165,27 +166,28 @@ func MakeSigVerifyJobProcessor(ledger LedgerForStreamVerifier, cache VerifiedTra
 
 func (tbp *txnSigBatchProcessor) ProcessBatch(txns []execpool.InputJob) {
 	batchVerifier, ctx := tbp.preProcessUnverifiedTxns(txns)
-	failed, err := batchVerifier.VerifyWithFeedback()
+	failed, err := batchVerifier.Verify()
 	// this error can only be crypto.ErrBatchHasFailedSigs
 	tbp.postProcessVerifiedJobs(ctx, failed, err)
 }
 
-func (tbp *txnSigBatchProcessor) preProcessUnverifiedTxns(uTxns []execpool.InputJob) (batchVerifier *crypto.BatchVerifier, ctx interface{}) {
-	batchVerifier = crypto.MakeBatchVerifier()
+func (tbp *txnSigBatchProcessor) preProcessUnverifiedTxns(uTxns []execpool.InputJob) (batchVerifier *crypto.BatchVerification, ctx interface{}) {
+	batchVerifier = crypto.MakeBatchVerification(len(uTxns))
 	bl := makeBatchLoad(len(uTxns))
 	// TODO: separate operations here, and get the sig verification inside the LogicSig to the batch here
 	blockHeader := tbp.nbw.getBlockHeader()
 
 	for i := range uTxns {
 		ut := uTxns[i].(*UnverifiedTxnSigJob)
+		// each txn group is a batch item, failing on its own
+		item := batchVerifier.StartItem()
 		groupCtx, err := txnGroupBatchPrep(ut.TxnGroup, blockHeader, tbp.ledger, batchVerifier, nil)
 		if err != nil {
 			// verification failed, no need to add the sig to the batch, report the error
 			tbp.sendResult(ut.TxnGroup, ut.BacklogMessage, err)
 			continue
 		}
-		totalBatchCount := batchVerifier.GetNumberOfEnqueuedSignatures()
-		bl.addLoad(ut.TxnGroup, groupCtx, ut.BacklogMessage, totalBatchCount)
+		bl.addLoad(ut.TxnGroup, groupCtx, ut.BacklogMessage, item)
 	}
 	return batchVerifier, bl
 }
@@ -243,20 +245,8 @@ func (tbp *txnSigBatchProcessor) postProcessVerifiedJobs(ctx interface{}, failed
 
 	verifiedTxnGroups := make([][]transactions.SignedTxn, 0, len(bl.txnGroups))
 	verifiedGroupCtxs := make([]*GroupContext, 0, len(bl.groupCtxs))
-	failedSigIdx := 0
 	for txgIdx := range bl.txnGroups {
-		txGroupSigFailed := false
-		for failedSigIdx < bl.messagesForTxn[txgIdx] {
-			if failed[failedSigIdx] {
-				// if there is a failed sig check, then no need to check the rest of the
-				// sigs for this txnGroup
-				failedSigIdx = bl.messagesForTxn[txgIdx]
-				txGroupSigFailed = true
-			} else {
-				// proceed to check the next sig belonging to this txnGroup
-				failedSigIdx++
-			}
-		}
+		txGroupSigFailed := failed[bl.items[txgIdx]]
 		var result error
 		if !txGroupSigFailed {
 			verifiedTxnGroups = append(verifiedTxnGroups, bl.txnGroups[txgIdx])
@@ -21,13 +21,28 @@ import (
 
 	"github.com/algorand/go-deadlock"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 const maxPinnedEntries = 500000
 
+// signature kinds used to tag the verified transactions cache metrics
+const (
+	cacheSigTag        = "sig"
+	cacheMsigTag       = "msig"
+	cacheLsigTag       = "lsig"
+	cacheStateProofTag = "stateproof"
+)
+
+var verifyCacheSkipped = metrics.NewTagCounter("algod_verify_cache_skipped_{TAG}", "Number of {TAG} transactions whose signature re-verification was avoided because they were in the verified transactions cache",
+	cacheSigTag, cacheMsigTag, cacheLsigTag, cacheStateProofTag)
+var verifyCacheUnverified = metrics.NewTagCounter("algod_verify_cache_unverified_{TAG}", "Number of {TAG} transactions looked up in the verified transactions cache which had to be verified",
+	cacheSigTag, cacheMsigTag, cacheLsigTag, cacheStateProofTag)
+
 // VerifiedTxnCacheError helps to identify the errors of a cache error and diffrenciate these from a general verification errors.
 type VerifiedTxnCacheError struct {
 	inner error
@@ -164,11 +179,35 @@ func (v *verifiedTransactionCache) GetUnverifiedTransactionGroups(txnGroups [][]
 		}
 		if verifiedTxn != len(signedTxnGroup) || verifiedTxn == 0 {
 			unverifiedGroups = append(unverifiedGroups, signedTxnGroup)
+			countCacheLookup(verifyCacheUnverified, signedTxnGroup)
+		} else {
+			countCacheLookup(verifyCacheSkipped, signedTxnGroup)
 		}
 	}
 	return
 }
 
+// countCacheLookup adds the transactions of a group to counter, tagged by the
+// kind of signature they carry.
+func countCacheLookup(counter *metrics.TagCounter, txgroup []transactions.SignedTxn) {
+	for i := range txgroup {
+		counter.Add(cacheSignatureTag(&txgroup[i]), 1)
+	}
+}
+
+func cacheSignatureTag(stx *transactions.SignedTxn) string {
+	switch {
+	case !stx.Lsig.Blank():
+		return cacheLsigTag
+	case !stx.Msig.Blank():
+		return cacheMsigTag
+	case stx.Sig != (crypto.Signature{}):
+		return cacheSigTag
+	default:
+		return cacheStateProofTag
+	}
+}
+
 // UpdatePinned replaces the pinned entries with the one provided in the pinnedTxns map. This is typically expected to be a subset of the
 // already-pinned transactions. If a transaction is not currently pinned, and it's can't be found in the cache, a errMissingPinnedEntry error would be generated.
 func (v *verifiedTransactionCache) UpdatePinned(pinnedTxns map[transactions.Txid]transactions.SignedTxn) (err error) {
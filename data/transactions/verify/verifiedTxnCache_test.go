@@ -22,9 +22,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 func TestAddingToCache(t *testing.T) {
@@ -101,6 +104,55 @@ func TestGetUnverifiedTransactionGroups50(t *testing.T) {
 	require.Equal(t, len(expectedUnverifiedGroups), len(unverifiedGroups))
 }
 
+func TestGetUnverifiedTransactionGroupsMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, sigTxns, _, _ := generateTestObjects(2, 2, 0, 50)
+	_, msigTxns, _, _ := generateMultiSigTxn(2, 3, 50, t)
+	op, err := logic.AssembleString("int 1")
+	require.NoError(t, err)
+	lsigTxn := sigTxns[1]
+	lsigTxn.Lsig.Logic = op.Program
+	lsigTxn.Sig = crypto.Signature{}
+
+	cached := [][]transactions.SignedTxn{{sigTxns[0]}, {msigTxns[0]}, {lsigTxn}}
+	uncached := [][]transactions.SignedTxn{{msigTxns[1]}}
+
+	cache := MakeVerifiedTransactionCache(100)
+	for _, txgroup := range cached {
+		groupCtx, err := PrepareGroupContext(txgroup, blockHeader, nil)
+		require.NoError(t, err)
+		cache.Add(txgroup, groupCtx)
+	}
+
+	values := func(counter *metrics.TagCounter) map[string]float64 {
+		v := make(map[string]float64)
+		counter.AddMetric(v)
+		return v
+	}
+	skippedBefore := values(verifyCacheSkipped)
+	unverifiedBefore := values(verifyCacheUnverified)
+
+	unverified := cache.GetUnverifiedTransactionGroups(append(cached, uncached...), spec, protocol.ConsensusCurrentVersion)
+	require.Equal(t, uncached, unverified)
+
+	skipped := values(verifyCacheSkipped)
+	for _, tag := range []string{cacheSigTag, cacheMsigTag, cacheLsigTag} {
+		name := "algod_verify_cache_skipped_" + tag
+		require.Equal(t, skippedBefore[name]+1, skipped[name], tag)
+	}
+	unverifiedAfter := values(verifyCacheUnverified)
+	require.Equal(t, unverifiedBefore["algod_verify_cache_unverified_msig"]+1, unverifiedAfter["algod_verify_cache_unverified_msig"])
+	require.Equal(t, unverifiedBefore["algod_verify_cache_unverified_sig"], unverifiedAfter["algod_verify_cache_unverified_sig"])
+
+	// a cached transaction signed differently is verified again
+	resigned := lsigTxn
+	resigned.Lsig.Args = [][]byte{{1}}
+	unverified = cache.GetUnverifiedTransactionGroups([][]transactions.SignedTxn{{resigned}}, spec, protocol.ConsensusCurrentVersion)
+	require.Len(t, unverified, 1)
+	require.Equal(t, unverifiedBefore["algod_verify_cache_unverified_lsig"]+1, values(verifyCacheUnverified)["algod_verify_cache_unverified_lsig"])
+}
+
 func BenchmarkGetUnverifiedTransactionGroups50(b *testing.B) {
 	if b.N < 20000 {
 		b.N = 20000
@@ -708,7 +708,13 @@ func (handler *TxHandler) processDecoded(unverifiedTxGroup []transactions.Signed
 		return network.OutgoingMessage{}, true
 	}
 
+	// groups the pool already verified, e.g. received earlier over gossip, need no re-verification
 	unverifiedTxnGroups := bookkeeping.SignedTxnsToGroups(unverifiedTxGroup)
+	specialAddresses := transactions.SpecialAddresses{
+		FeeSink:     latestHdr.FeeSink,
+		RewardsPool: latestHdr.RewardsPool,
+	}
+	unverifiedTxnGroups = handler.ledger.VerifiedTransactionCache().GetUnverifiedTransactionGroups(unverifiedTxnGroups, specialAddresses, latestHdr.CurrentProtocol)
 	err = verify.PaysetGroups(context.Background(), unverifiedTxnGroups, latestHdr, handler.txVerificationPool, handler.ledger.VerifiedTransactionCache(), handler.ledger)
 	if err != nil {
 		// transaction is invalid
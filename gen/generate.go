@@ -31,6 +31,7 @@ import (
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto/passphrase"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -52,9 +53,10 @@ var defaultIncentivePoolBalanceAtInception uint64 = 125e6 * 1e6
 const TotalMoney uint64 = 10 * 1e9 * 1e6
 
 type genesisAllocation struct {
-	Name   string
-	Stake  uint64
-	Online basics.Status
+	Name     string
+	Stake    uint64
+	Online   basics.Status
+	Mnemonic string
 }
 
 func u64absDiff(a, b uint64) uint64 {
@@ -98,9 +100,10 @@ func setupGenerateGenesisFiles(genesisData *GenesisData, consensus config.Consen
 
 	for i, wallet := range genesisData.Wallets {
 		acct := genesisAllocation{
-			Name:   wallet.Name,
-			Stake:  uint64(float64(TotalMoney/100)*wallet.Stake + .5),
-			Online: basics.Online,
+			Name:     wallet.Name,
+			Stake:    uint64(float64(TotalMoney/100)*wallet.Stake + .5),
+			Online:   basics.Online,
+			Mnemonic: wallet.Mnemonic,
 		}
 		if !wallet.Online {
 			acct.Online = basics.Offline
@@ -233,6 +236,8 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 					rootDB, err = db.MakeErasableAccessor(wfilename)
 					if err != nil {
 						err = fmt.Errorf("couldn't open root DB accessor %s: %v", wfilename, err)
+					} else if wallet.Mnemonic != "" {
+						root, err = importRootFromMnemonic(rootDB, wallet.Mnemonic)
 					} else {
 						root, err = account.GenerateRoot(rootDB)
 					}
@@ -401,6 +406,17 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 }
 
 // If err != nil, rootDB needs to be closed.
+// importRootFromMnemonic stores the root key derived from the given mnemonic in rootDB
+func importRootFromMnemonic(rootDB db.Accessor, mnemonic string) (root account.Root, err error) {
+	key, err := passphrase.MnemonicToKey(mnemonic)
+	if err != nil {
+		return root, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+	var seed [32]byte
+	copy(seed[:], key)
+	return account.ImportRoot(rootDB, seed)
+}
+
 func loadRootKey(filename string) (root account.Root, rootDB db.Accessor, err error) {
 	if !util.FileExists(filename) {
 		err = os.ErrNotExist
@@ -32,6 +32,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/protocol"
@@ -122,6 +123,28 @@ func TestGenesisRoundoff(t *testing.T) {
 	require.True(t, strings.Contains(verbosity.String(), "roundoff"))
 }
 
+func TestImportRootFromMnemonic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	mnemonic, err := passphrase.KeyToMnemonic(seed[:])
+	require.NoError(t, err)
+	expected := basics.Address(crypto.GenerateSignatureSecrets(seed).SignatureVerifier)
+
+	rootDB, err := db.MakeErasableAccessor(filepath.Join(t.TempDir(), "wallet"))
+	require.NoError(t, err)
+	defer rootDB.Close()
+
+	root, err := importRootFromMnemonic(rootDB, mnemonic)
+	require.NoError(t, err)
+	require.Equal(t, expected, root.Address())
+
+	_, err = importRootFromMnemonic(rootDB, "not a mnemonic")
+	require.ErrorContains(t, err, "invalid mnemonic")
+}
+
 // `TestGenesisJsonCreation` defends against regressions to `genesis.json` generation by comparing a known, valid `genesis.json` against a version generated during test invocation.
 //
 // * For each `testCase`, there is a corresponding `genesis.json` in `gen/resources` representing the known, valid output.
@@ -37,6 +37,9 @@ type WalletData struct {
 	Name   string
 	Stake  float64
 	Online bool
+	// Mnemonic optionally fixes the wallet's root key to the account derived from the given
+	// 25-word mnemonic, so that private networks can be recreated with the same addresses.
+	Mnemonic string `json:",omitempty"`
 }
 
 // GenesisData represents the genesis data for creating a genesis.json and wallets
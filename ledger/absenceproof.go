@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// ErrNoAbsenceProofs is returned when the absence proofs are requested from a ledger which isn't archival, or which
+// doesn't track catchpoints.
+var ErrNoAbsenceProofs = errors.New("the absence proofs are only built by archival ledgers tracking catchpoints")
+
+// ErrAbsenceProofRound is returned when the absence of an account is to be proven at another round than the
+// accounts round of the latest catchpoint, or before the commitment of that round is built.
+var ErrAbsenceProofRound = errors.New("the absence of accounts is only proven at the accounts round of the latest catchpoint")
+
+// ErrAbsenceProofRateLimited is returned when the commitment of the holders of an asset or an application is to be
+// built too soon after the previous one.
+var ErrAbsenceProofRateLimited = errors.New("too many absence commitments of assets and applications built")
+
+// absenceCommitmentBuildInterval is the minimal time between two builds of the absence commitments of the holders of
+// an asset or an application, which read all the holders.
+const absenceCommitmentBuildInterval = 10 * time.Second
+
+// absenceCommitmentCache keeps the latest absence commitment built for the holders of an asset or an application: the
+// proofs of the same round and scope share it. Building a commitment holds the lock, so that the commitments are
+// built one at a time, and at most once every absenceCommitmentBuildInterval.
+type absenceCommitmentCache struct {
+	mu         deadlock.Mutex
+	commitment *ledgercore.AbsenceCommitment
+	built      time.Time
+}
+
+// AbsenceProof proves that an account has no record at the given round, or holds no asset or has no local state of
+// an application when the scope names one, along with the root of the commitment the proof is verified against.
+// The absence of an account is proven at the accounts round of the latest catchpoint, from the commitment built
+// along the catchpoint, and the round 0 stands for that round. The absence of a holder is proven at one of the rounds
+// past the accounts database round, kept in memory, and the round 0 stands for the latest round.
+// It returns ledgercore.ErrAddressCommitted if the account exists, ErrNoAbsenceProofs unless the ledger is archival
+// and tracks catchpoints, ErrAbsenceProofRound for the other rounds of the accounts, ErrAbsenceProofRateLimited
+// when the commitment of the holders would be built too soon after the previous one, and ErrNoCreatableHolderIndex
+// for the assets and the applications unless EnableCreatableHolderIndex is set.
+func (l *Ledger) AbsenceProof(rnd basics.Round, scope ledgercore.AbsenceProofScope, addr basics.Address) (ledgercore.AbsenceProof, error) {
+	if !l.archival || !l.catchpoint.buildAbsenceCommitments {
+		return ledgercore.AbsenceProof{}, ErrNoAbsenceProofs
+	}
+	if scope.Creatable == 0 {
+		commitment := l.catchpoint.absenceCommitment.Load()
+		if commitment == nil || (rnd != 0 && rnd != commitment.Round) {
+			return ledgercore.AbsenceProof{}, ErrAbsenceProofRound
+		}
+		return commitment.Prove(addr)
+	}
+	if !l.cfg.EnableCreatableHolderIndex {
+		return ledgercore.AbsenceProof{}, ErrNoCreatableHolderIndex
+	}
+	if rnd == 0 {
+		rnd = l.Latest()
+	}
+
+	l.absenceCommitments.mu.Lock()
+	defer l.absenceCommitments.mu.Unlock()
+	commitment := l.absenceCommitments.commitment
+	if commitment == nil || commitment.Round != rnd || commitment.Scope != scope {
+		if time.Since(l.absenceCommitments.built) < absenceCommitmentBuildInterval {
+			return ledgercore.AbsenceProof{}, ErrAbsenceProofRateLimited
+		}
+		l.trackerMu.RLock()
+		addrs, err := l.accts.lookupScopeAddresses(rnd, scope)
+		l.trackerMu.RUnlock()
+		if err != nil {
+			return ledgercore.AbsenceProof{}, err
+		}
+		commitment, err = ledgercore.MakeAbsenceCommitment(rnd, scope, addrs)
+		if err != nil {
+			return ledgercore.AbsenceProof{}, err
+		}
+		l.absenceCommitments.commitment = commitment
+		l.absenceCommitments.built = time.Now()
+	}
+	return commitment.Prove(addr)
+}
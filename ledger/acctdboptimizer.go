@@ -0,0 +1,329 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+const (
+	// accountsDBOptimizerPollInterval is how often the optimizer checks whether an optimization is due and the
+	// commits of the ledger are idle.
+	accountsDBOptimizerPollInterval = 5 * time.Second
+
+	// accountsDBOptimizerVacuumBatch is the number of unused pages released at a time, so that the commits
+	// of the ledger are not held back by the write lock of the incremental vacuum for long.
+	accountsDBOptimizerVacuumBatch = 1024
+
+	// accountsDBOptimizerIdleWait is how long the optimizer waits between two batches for the commits
+	// of the ledger to complete.
+	accountsDBOptimizerIdleWait = 100 * time.Millisecond
+)
+
+// errAccountsDBOptimizerRunning is returned when an optimization is requested while one is in progress.
+var errAccountsDBOptimizerRunning = errors.New("an accounts database optimization is already in progress")
+
+// errAccountsDBOptimizerStopped is returned when an optimization is requested while the ledger is not loaded.
+var errAccountsDBOptimizerStopped = errors.New("the accounts database optimizer is not running")
+
+var ledgerOptimizeCount = metrics.NewCounter("ledger_optimize_count", "calls")
+var ledgerOptimizeMicros = metrics.NewCounter("ledger_optimize_micros", "µs spent")
+var ledgerOptimizeErrors = metrics.NewCounter("ledger_optimize_errors", "failed accounts database optimizations")
+var ledgerOptimizeReleasedPages = metrics.NewCounter("ledger_optimize_released_pages", "accounts database pages released by incremental vacuum")
+
+// AccountsDatabaseOptimizeStatus reports the background optimizations of the accounts database.
+type AccountsDatabaseOptimizeStatus struct {
+	// Running is true while an optimization is in progress.
+	Running bool
+	// Pending is true if an optimization was requested and waits for the commits of the ledger to be idle.
+	Pending bool
+	// Runs is the number of optimizations completed since the ledger was loaded.
+	Runs uint64
+	// LastStart is the time the last optimization started.
+	LastStart time.Time
+	// LastEnd is the time the last optimization ended, zero while it runs.
+	LastEnd time.Time
+	// LastError is the error the last optimization failed with, if any.
+	LastError string
+	// NextRun is the earliest time of the next scheduled optimization, zero if none is scheduled.
+	NextRun time.Time
+	// Incremental is true if the database is in the incremental auto_vacuum mode, which its unused pages
+	// can only be released in.
+	Incremental bool
+	// Pages is the number of pages of the database when last measured.
+	Pages uint64
+	// FreePages is the number of unused pages of the database when last measured.
+	FreePages uint64
+	// ReleasedPages is the number of unused pages released by the last optimization, so far while it runs.
+	ReleasedPages uint64
+}
+
+// optimizeWindow is a daily time window, in minutes since midnight.
+type optimizeWindow struct {
+	enabled    bool
+	start, end int
+}
+
+// parseOptimizeWindow parses a "HH:MM-HH:MM" time window. An empty string is a window that is always open.
+func parseOptimizeWindow(s string) (w optimizeWindow, err error) {
+	if s == "" {
+		return w, nil
+	}
+	var startHour, startMinute, endHour, endMinute int
+	var rest string
+	n, _ := fmt.Sscanf(s, "%d:%d-%d:%d%s", &startHour, &startMinute, &endHour, &endMinute, &rest)
+	if n != 4 || startHour > 23 || endHour > 23 || startMinute > 59 || endMinute > 59 ||
+		startHour < 0 || endHour < 0 || startMinute < 0 || endMinute < 0 {
+		return w, fmt.Errorf("invalid time window %q, expected HH:MM-HH:MM", s)
+	}
+	w.enabled = true
+	w.start = startHour*60 + startMinute
+	w.end = endHour*60 + endMinute
+	return w, nil
+}
+
+// contains returns true if t is within the window. A window ending before it starts spans midnight.
+func (w optimizeWindow) contains(t time.Time) bool {
+	if !w.enabled {
+		return true
+	}
+	minute := t.Hour()*60 + t.Minute()
+	if w.start <= w.end {
+		return minute >= w.start && minute < w.end
+	}
+	return minute >= w.start || minute < w.end
+}
+
+// accountsDBOptimizer runs the optimizations of the accounts database in the background: a scheduled one every
+// interval within the configured time window, and the ones requested explicitly. An optimization refreshes the
+// statistics of the query planner and releases the unused pages of the database in batches, starting only
+// when the commits of the ledger are idle and giving way to them between the batches. Unlike a full vacuum,
+// it does not rewrite the database and does not require the ledger to be stopped.
+type accountsDBOptimizer struct {
+	dbs  trackerdb.Store
+	log  logging.Logger
+	idle func() bool
+
+	interval time.Duration
+	window   optimizeWindow
+	maxPages uint64
+
+	requests chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	mu     deadlock.Mutex
+	status AccountsDatabaseOptimizeStatus
+}
+
+func makeAccountsDBOptimizer(dbs trackerdb.Store, log logging.Logger, idle func() bool, interval time.Duration, window string, maxPages uint64) *accountsDBOptimizer {
+	o := &accountsDBOptimizer{
+		dbs:      dbs,
+		log:      log,
+		idle:     idle,
+		interval: interval,
+		maxPages: maxPages,
+		requests: make(chan struct{}, 1),
+	}
+	var err error
+	o.window, err = parseOptimizeWindow(window)
+	if err != nil {
+		// rather than optimizing at times the operator did not intend to, only run the requested optimizations.
+		log.Warnf("accountsDBOptimizer: scheduled optimizations disabled: %v", err)
+		o.interval = 0
+	}
+	return o
+}
+
+// start starts the optimizer goroutine. If optimizeNow is set, an optimization runs as soon as the commits
+// of the ledger are idle, regardless of the schedule.
+func (o *accountsDBOptimizer) start(optimizeNow bool) {
+	o.ctx, o.cancel = context.WithCancel(context.Background())
+	o.done = make(chan struct{})
+	o.mu.Lock()
+	o.status.Pending = optimizeNow
+	if o.interval > 0 {
+		o.status.NextRun = time.Now().Add(o.interval)
+	}
+	o.mu.Unlock()
+	go o.loop()
+}
+
+// stop aborts the optimization in progress, if any, and waits for the optimizer goroutine to exit.
+func (o *accountsDBOptimizer) stop() {
+	if o == nil || o.cancel == nil {
+		return
+	}
+	o.cancel()
+	<-o.done
+}
+
+// request schedules an optimization to run as soon as the commits of the ledger are idle.
+func (o *accountsDBOptimizer) request() error {
+	if o == nil || o.ctx == nil || o.ctx.Err() != nil {
+		return errAccountsDBOptimizerStopped
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.status.Running {
+		return errAccountsDBOptimizerRunning
+	}
+	o.status.Pending = true
+	select {
+	case o.requests <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// getStatus returns the status of the optimizations.
+func (o *accountsDBOptimizer) getStatus() AccountsDatabaseOptimizeStatus {
+	if o == nil {
+		return AccountsDatabaseOptimizeStatus{}
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.status
+}
+
+func (o *accountsDBOptimizer) loop() {
+	defer close(o.done)
+	ticker := time.NewTicker(accountsDBOptimizerPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-o.ctx.Done():
+			return
+		case <-o.requests:
+		case <-ticker.C:
+		}
+		if o.due(time.Now()) && o.idle() {
+			o.optimize()
+		}
+	}
+}
+
+// due returns true if an optimization was requested, or if the scheduled one is due and within the window.
+func (o *accountsDBOptimizer) due(now time.Time) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.status.Pending {
+		return true
+	}
+	return o.interval > 0 && !now.Before(o.status.NextRun) && o.window.contains(now)
+}
+
+func (o *accountsDBOptimizer) optimize() {
+	startTime := time.Now()
+	o.mu.Lock()
+	o.status.Running = true
+	o.status.Pending = false
+	o.status.LastStart = startTime
+	o.status.LastEnd = time.Time{}
+	o.status.LastError = ""
+	o.status.ReleasedPages = 0
+	o.mu.Unlock()
+
+	o.log.Infof("Optimizing accounts database started")
+	ledgerOptimizeCount.Inc(nil)
+	err := o.run()
+	elapsedTime := time.Since(startTime)
+	ledgerOptimizeMicros.AddUint64(uint64(elapsedTime.Microseconds()), nil)
+
+	o.mu.Lock()
+	o.status.Running = false
+	o.status.Runs++
+	o.status.LastEnd = time.Now()
+	if o.interval > 0 {
+		o.status.NextRun = startTime.Add(o.interval)
+	}
+	if err != nil {
+		o.status.LastError = err.Error()
+	}
+	status := o.status
+	o.mu.Unlock()
+
+	if err != nil {
+		ledgerOptimizeErrors.Inc(nil)
+		o.log.Warnf("Optimizing accounts database failed after releasing %d pages : %v", status.ReleasedPages, err)
+		return
+	}
+	if !status.Incremental {
+		o.log.Infof("Optimizing accounts database completed within %v; the database is not in the incremental auto_vacuum mode, so its unused pages were not released", elapsedTime)
+		return
+	}
+	o.log.Infof("Optimizing accounts database completed within %v, releasing %d pages, leaving %d pages of which %d are unused", elapsedTime, status.ReleasedPages, status.Pages, status.FreePages)
+}
+
+// run performs an optimization, releasing the unused pages a batch at a time.
+func (o *accountsDBOptimizer) run() error {
+	err := o.dbs.Optimize(o.ctx)
+	if err != nil {
+		return err
+	}
+	var released uint64
+	for {
+		batch := uint64(accountsDBOptimizerVacuumBatch)
+		if o.maxPages > 0 {
+			if released >= o.maxPages {
+				return nil
+			}
+			if o.maxPages-released < batch {
+				batch = o.maxPages - released
+			}
+		}
+		stats, err := o.dbs.IncrementalVacuum(o.ctx, batch)
+		if err != nil {
+			return err
+		}
+		var freed uint64
+		if stats.FreePagesBefore > stats.FreePagesAfter {
+			freed = stats.FreePagesBefore - stats.FreePagesAfter
+		}
+		released += freed
+		ledgerOptimizeReleasedPages.AddUint64(freed, nil)
+
+		o.mu.Lock()
+		o.status.Incremental = stats.Incremental
+		o.status.Pages = stats.PagesAfter
+		o.status.FreePages = stats.FreePagesAfter
+		o.status.ReleasedPages = released
+		o.mu.Unlock()
+
+		if !stats.Incremental || stats.FreePagesAfter == 0 || freed == 0 {
+			return nil
+		}
+		// let the pending commits of the ledger go first.
+		for !o.idle() {
+			select {
+			case <-o.ctx.Done():
+				return o.ctx.Err()
+			case <-time.After(accountsDBOptimizerIdleWait):
+			}
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-deadlock"
+)
+
+// optimizerTestStore is a trackerdb.Store recording the optimizations and the incremental vacuum batches.
+type optimizerTestStore struct {
+	trackerdb.Store
+
+	mu          deadlock.Mutex
+	incremental bool
+	pages       uint64
+	freePages   uint64
+	optimized   int
+	batches     []uint64
+}
+
+func (s *optimizerTestStore) Optimize(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.optimized++
+	return nil
+}
+
+func (s *optimizerTestStore) IncrementalVacuum(ctx context.Context, maxPages uint64) (stats db.IncrementalVacuumStats, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, maxPages)
+	if !s.incremental {
+		return stats, nil
+	}
+	stats = db.IncrementalVacuumStats{Incremental: true, PagesBefore: s.pages, FreePagesBefore: s.freePages}
+	released := s.freePages
+	if maxPages > 0 && maxPages < released {
+		released = maxPages
+	}
+	s.pages -= released
+	s.freePages -= released
+	stats.PagesAfter, stats.FreePagesAfter = s.pages, s.freePages
+	return stats, nil
+}
+
+func TestParseOptimizeWindow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2023, 5, 1, hour, minute, 0, 0, time.Local)
+	}
+
+	w, err := parseOptimizeWindow("")
+	require.NoError(t, err)
+	require.True(t, w.contains(at(12, 0)))
+
+	w, err = parseOptimizeWindow("02:00-05:30")
+	require.NoError(t, err)
+	require.False(t, w.contains(at(1, 59)))
+	require.True(t, w.contains(at(2, 0)))
+	require.True(t, w.contains(at(5, 29)))
+	require.False(t, w.contains(at(5, 30)))
+
+	// a window spanning midnight
+	w, err = parseOptimizeWindow("23:00-01:00")
+	require.NoError(t, err)
+	require.True(t, w.contains(at(23, 30)))
+	require.True(t, w.contains(at(0, 30)))
+	require.False(t, w.contains(at(1, 0)))
+	require.False(t, w.contains(at(12, 0)))
+
+	for _, invalid := range []string{"02:00", "2-5", "24:00-01:00", "02:60-03:00", "02:00-03:00x", "-1:00-02:00", "02:00 - 03:00"} {
+		_, err = parseOptimizeWindow(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestAccountsDBOptimizerRun(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	store := &optimizerTestStore{incremental: true, pages: 10000, freePages: 5000}
+	o := makeAccountsDBOptimizer(store, logging.TestingLog(t), func() bool { return true }, 0, "", 3000)
+	o.ctx = context.Background()
+	o.optimize()
+
+	status := o.getStatus()
+	require.Equal(t, 1, store.optimized)
+	require.Equal(t, []uint64{accountsDBOptimizerVacuumBatch, accountsDBOptimizerVacuumBatch, 3000 - 2*accountsDBOptimizerVacuumBatch}, store.batches)
+	require.False(t, status.Running)
+	require.True(t, status.Incremental)
+	require.Equal(t, uint64(1), status.Runs)
+	require.Equal(t, uint64(3000), status.ReleasedPages)
+	require.Equal(t, uint64(7000), status.Pages)
+	require.Equal(t, uint64(2000), status.FreePages)
+	require.Empty(t, status.LastError)
+	require.True(t, status.NextRun.IsZero())
+
+	// without a limit, all the unused pages are released.
+	o.maxPages = 0
+	o.optimize()
+	status = o.getStatus()
+	require.Equal(t, uint64(2000), status.ReleasedPages)
+	require.Zero(t, status.FreePages)
+
+	// a database which is not in the incremental auto_vacuum mode is only analyzed.
+	store = &optimizerTestStore{pages: 10000, freePages: 5000}
+	o = makeAccountsDBOptimizer(store, logging.TestingLog(t), func() bool { return true }, time.Hour, "", 0)
+	o.ctx = context.Background()
+	o.optimize()
+	status = o.getStatus()
+	require.Equal(t, 1, store.optimized)
+	require.Len(t, store.batches, 1)
+	require.False(t, status.Incremental)
+	require.Zero(t, status.ReleasedPages)
+	require.False(t, status.NextRun.IsZero())
+}
+
+func TestAccountsDBOptimizerRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	store := &optimizerTestStore{incremental: true, pages: 10000, freePages: 100}
+	var idle atomic.Bool
+	o := makeAccountsDBOptimizer(store, logging.TestingLog(t), idle.Load, time.Hour, "", 0)
+	require.ErrorIs(t, o.request(), errAccountsDBOptimizerStopped)
+
+	o.start(false)
+	require.False(t, o.getStatus().Pending)
+	require.False(t, o.due(time.Now()))
+	require.True(t, o.due(time.Now().Add(time.Hour)))
+
+	// the requested optimization waits for the commits to be idle.
+	require.NoError(t, o.request())
+	require.True(t, o.getStatus().Pending)
+	time.Sleep(10 * time.Millisecond)
+	require.Zero(t, o.getStatus().Runs)
+
+	idle.Store(true)
+	require.NoError(t, o.request())
+	require.Eventually(t, func() bool { return o.getStatus().Runs == 1 }, 2*accountsDBOptimizerPollInterval, 10*time.Millisecond)
+	status := o.getStatus()
+	require.False(t, status.Pending)
+	require.Equal(t, uint64(100), status.ReleasedPages)
+
+	o.stop()
+	require.ErrorIs(t, o.request(), errAccountsDBOptimizerStopped)
+
+	// an invalid window disables the scheduled optimizations.
+	o = makeAccountsDBOptimizer(store, logging.TestingLog(t), idle.Load, time.Hour, "late", 0)
+	require.Zero(t, o.interval)
+}
@@ -17,10 +17,12 @@
 package ledger
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"sort"
 	"strings"
 	"sync"
@@ -265,6 +267,10 @@ func (e *MismatchingDatabaseRoundError) Error() string {
 // ErrLookupLatestResources is returned if there is an error retrieving an account along with its resources.
 var ErrLookupLatestResources = errors.New("couldn't find latest resources")
 
+// ErrNoCreatableHolderIndex is returned by the lookups of the asset holders and the application opt-ins when the
+// ledger does not index them, as of EnableCreatableHolderIndex.
+var ErrNoCreatableHolderIndex = errors.New("the asset holders and application opt-ins are not indexed")
+
 //msgp:ignore resourcesUpdates
 type resourcesUpdates map[accountCreatable]modifiedResource
 
@@ -1184,6 +1190,282 @@ func (au *accountUpdates) lookupLatest(addr basics.Address) (data basics.Account
 	}
 }
 
+// indexedResource is an account resource along with the index of the creatable it belongs to.
+type indexedResource struct {
+	cidx     basics.CreatableIndex
+	resource ledgercore.AccountResource
+}
+
+// lookupLimitedResources returns up to limit resources of the given type which are held or created by the
+// given address, or only created by it when createdOnly is set, for the latest round. Only resources with a
+// creatable index greater than minIdx are returned, ordered by their creatable index, so that callers can
+// page through all of the account's resources.
+func (au *accountUpdates) lookupLimitedResources(addr basics.Address, minIdx basics.CreatableIndex, limit uint64, ctype basics.CreatableType, createdOnly bool) (data []indexedResource, rnd basics.Round, err error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	matchesType := func(res ledgercore.AccountResource) bool {
+		if ctype == basics.AssetCreatable {
+			return res.AssetParams != nil || (!createdOnly && res.AssetHolding != nil)
+		}
+		return res.AppParams != nil || (!createdOnly && res.AppLocalState != nil)
+	}
+
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		rnd = au.latest()
+
+		// resources modified in the past rounds take precedence over the ones on disk. Deleted
+		// resources are kept as well, so that they hide their stale on-disk counterparts.
+		modified := make(map[basics.CreatableIndex]ledgercore.AccountResource)
+		for cidx, mr := range au.resources.getForAddress(addr) {
+			if cidx > minIdx {
+				modified[cidx] = mr.resource
+			}
+		}
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		// every modified resource may hide one of the resources on disk, so fetch enough of them
+		// to still be able to fill up the requested number of resources.
+		var persistedResources []trackerdb.PersistedResourcesData
+		var resourceDbRound basics.Round
+		persistedResources, resourceDbRound, err = au.accountsq.LookupLimitedResources(addr, minIdx, limit+uint64(len(modified)), ctype, createdOnly)
+		if err != nil {
+			return nil, basics.Round(0), err
+		}
+		if resourceDbRound == currentDbRound {
+			data = make([]indexedResource, 0, len(persistedResources)+len(modified))
+			for _, pd := range persistedResources {
+				if _, has := modified[pd.Aidx]; !has {
+					data = append(data, indexedResource{cidx: pd.Aidx, resource: pd.AccountResource()})
+				}
+			}
+			for cidx, res := range modified {
+				if matchesType(res) {
+					data = append(data, indexedResource{cidx: cidx, resource: res})
+				}
+			}
+			sort.Slice(data, func(i, j int) bool { return data[i].cidx < data[j].cidx })
+			if uint64(len(data)) > limit {
+				data = data[:limit]
+			}
+			return data, rnd, nil
+		}
+
+		if resourceDbRound < currentDbRound {
+			au.log.Errorf("accountUpdates.lookupLimitedResources: resource database round %d is behind in-memory round %d", resourceDbRound, currentDbRound)
+			return nil, basics.Round(0), &StaleDatabaseRoundError{databaseRound: resourceDbRound, memoryRound: currentDbRound}
+		}
+
+		// the database was updated while we were reading the in-memory deltas; wait for the
+		// in-memory state to catch up and try again.
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
+// addressedResource is an account resource along with the address of the account holding it.
+type addressedResource struct {
+	addr     basics.Address
+	resource ledgercore.AccountResource
+}
+
+// lookupCreatableHolders returns up to limit resources of the given creatable held by accounts, for the latest
+// round: the holdings of an asset, or the local states of an application. Only accounts with an address greater
+// than after are returned, ordered by address, so that callers can page through all of the holders. It requires
+// the index of the creatable holders in the database.
+func (au *accountUpdates) lookupCreatableHolders(cidx basics.CreatableIndex, ctype basics.CreatableType, after *basics.Address, limit uint64) (data []addressedResource, rnd basics.Round, err error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	holds := func(res ledgercore.AccountResource) bool {
+		if ctype == basics.AssetCreatable {
+			return res.AssetHolding != nil
+		}
+		return res.AppLocalState != nil
+	}
+
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		rnd = au.latest()
+
+		// resources modified in the past rounds take precedence over the ones on disk. Deleted
+		// resources are kept as well, so that they hide their stale on-disk counterparts.
+		modified := make(map[basics.Address]ledgercore.AccountResource)
+		for ac, mr := range au.resources {
+			if ac.index == cidx && (after == nil || bytes.Compare(ac.address[:], after[:]) > 0) {
+				modified[ac.address] = mr.resource
+			}
+		}
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		// every modified resource may hide one of the resources on disk, so fetch enough of them
+		// to still be able to fill up the requested number of holders.
+		var persistedHolders []trackerdb.PersistedCreatableHolder
+		var holdersDbRound basics.Round
+		err = au.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+			ar, err := tx.MakeAccountsReader()
+			if err != nil {
+				return err
+			}
+			persistedHolders, holdersDbRound, err = ar.LookupCreatableHolders(ctx, cidx, ctype, after, limit+uint64(len(modified)))
+			return err
+		})
+		if err != nil {
+			return nil, basics.Round(0), err
+		}
+		if holdersDbRound == currentDbRound {
+			data = make([]addressedResource, 0, len(persistedHolders)+len(modified))
+			for _, ph := range persistedHolders {
+				if _, has := modified[ph.Addr]; !has {
+					data = append(data, addressedResource{addr: ph.Addr, resource: ph.AccountResource()})
+				}
+			}
+			for addr, res := range modified {
+				if holds(res) {
+					data = append(data, addressedResource{addr: addr, resource: res})
+				}
+			}
+			sort.Slice(data, func(i, j int) bool { return bytes.Compare(data[i].addr[:], data[j].addr[:]) < 0 })
+			if uint64(len(data)) > limit {
+				data = data[:limit]
+			}
+			return data, rnd, nil
+		}
+
+		if holdersDbRound < currentDbRound {
+			au.log.Errorf("accountUpdates.lookupCreatableHolders: holders database round %d is behind in-memory round %d", holdersDbRound, currentDbRound)
+			return nil, basics.Round(0), &StaleDatabaseRoundError{databaseRound: holdersDbRound, memoryRound: currentDbRound}
+		}
+
+		// the database was updated while we were reading the in-memory deltas; wait for the
+		// in-memory state to catch up and try again.
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
+// lookupScopeAddresses returns the addresses of the accounts of the given round, or of the accounts holding the
+// creatable of the scope, in no particular order. The holders of a creatable are only known when they are indexed.
+func (au *accountUpdates) lookupScopeAddresses(rnd basics.Round, scope ledgercore.AbsenceProofScope) (addrs []basics.Address, err error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		offset, err := au.roundOffset(rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		// the accounts created or deleted in the rounds past the database round, up to rnd; later rounds
+		// take precedence over the earlier ones.
+		present := make(map[basics.Address]bool)
+		for _, delta := range au.deltas[:offset] {
+			switch {
+			case scope.Creatable == 0:
+				for i := 0; i < delta.Accts.Len(); i++ {
+					addr, data := delta.Accts.GetByIdx(i)
+					present[addr] = !data.IsZero()
+				}
+			case scope.CreatableType == basics.AssetCreatable:
+				for _, rec := range delta.Accts.GetAllAssetResources() {
+					if rec.Aidx == basics.AssetIndex(scope.Creatable) && (rec.Holding.Deleted || rec.Holding.Holding != nil) {
+						present[rec.Addr] = rec.Holding.Holding != nil
+					}
+				}
+			case scope.CreatableType == basics.AppCreatable:
+				for _, rec := range delta.Accts.GetAllAppResources() {
+					if rec.Aidx == basics.AppIndex(scope.Creatable) && (rec.State.Deleted || rec.State.LocalState != nil) {
+						present[rec.Addr] = rec.State.LocalState != nil
+					}
+				}
+			}
+		}
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		var dbRound basics.Round
+		err = au.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+			ar, err := tx.MakeAccountsReader()
+			if err != nil {
+				return err
+			}
+			if scope.Creatable == 0 {
+				addrs, dbRound, err = ar.LookupAccountAddresses(ctx)
+				return err
+			}
+			var holders []trackerdb.PersistedCreatableHolder
+			holders, dbRound, err = ar.LookupCreatableHolders(ctx, scope.Creatable, scope.CreatableType, nil, math.MaxUint64)
+			addrs = make([]basics.Address, len(holders))
+			for i := range holders {
+				addrs[i] = holders[i].Addr
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if dbRound == currentDbRound {
+			kept := addrs[:0]
+			for _, addr := range addrs {
+				if has, modified := present[addr]; modified {
+					delete(present, addr)
+					if !has {
+						continue
+					}
+				}
+				kept = append(kept, addr)
+			}
+			for addr, has := range present {
+				if has {
+					kept = append(kept, addr)
+				}
+			}
+			return kept, nil
+		}
+
+		if dbRound < currentDbRound {
+			au.log.Errorf("accountUpdates.lookupScopeAddresses: database round %d is behind in-memory round %d", dbRound, currentDbRound)
+			return nil, &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+		}
+
+		// the database was updated while we were reading the in-memory deltas; wait for the
+		// in-memory state to catch up and try again.
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
 func (au *accountUpdates) lookupResource(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType, synchronized bool) (data ledgercore.AccountResource, validThrough basics.Round, err error) {
 	needUnlock := false
 	if synchronized {
@@ -2102,6 +2102,252 @@ func TestAcctUpdatesLookupLatest(t *testing.T) {
 	}
 }
 
+func TestAcctUpdatesLookupLimitedResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := ledgertesting.RandomAccounts(10, false)
+	ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	au, _ := newAcctUpdates(t, ml, conf)
+	defer au.close()
+
+	const pageSize = 2
+	for addr, acct := range accts {
+		expectedAssets := make(map[basics.CreatableIndex]ledgercore.AccountResource)
+		for aidx, holding := range acct.Assets {
+			holding := holding
+			res := expectedAssets[basics.CreatableIndex(aidx)]
+			res.AssetHolding = &holding
+			expectedAssets[basics.CreatableIndex(aidx)] = res
+		}
+		for aidx, params := range acct.AssetParams {
+			params := params
+			res := expectedAssets[basics.CreatableIndex(aidx)]
+			res.AssetParams = &params
+			expectedAssets[basics.CreatableIndex(aidx)] = res
+		}
+		expectedApps := make(map[basics.CreatableIndex]ledgercore.AccountResource)
+		for aidx, localState := range acct.AppLocalStates {
+			localState := localState
+			res := expectedApps[basics.CreatableIndex(aidx)]
+			res.AppLocalState = &localState
+			expectedApps[basics.CreatableIndex(aidx)] = res
+		}
+		for aidx, params := range acct.AppParams {
+			params := params
+			res := expectedApps[basics.CreatableIndex(aidx)]
+			res.AppParams = &params
+			expectedApps[basics.CreatableIndex(aidx)] = res
+		}
+
+		// the created resources also hold the local state or holding of the same creatable
+		expectedCreatedAssets := make(map[basics.CreatableIndex]ledgercore.AccountResource)
+		for aidx := range acct.AssetParams {
+			expectedCreatedAssets[basics.CreatableIndex(aidx)] = expectedAssets[basics.CreatableIndex(aidx)]
+		}
+		expectedCreatedApps := make(map[basics.CreatableIndex]ledgercore.AccountResource)
+		for aidx := range acct.AppParams {
+			expectedCreatedApps[basics.CreatableIndex(aidx)] = expectedApps[basics.CreatableIndex(aidx)]
+		}
+
+		for _, tc := range []struct {
+			ctype       basics.CreatableType
+			createdOnly bool
+			expected    map[basics.CreatableIndex]ledgercore.AccountResource
+		}{
+			{basics.AssetCreatable, false, expectedAssets},
+			{basics.AppCreatable, false, expectedApps},
+			{basics.AssetCreatable, true, expectedCreatedAssets},
+			{basics.AppCreatable, true, expectedCreatedApps},
+		} {
+			found := make(map[basics.CreatableIndex]ledgercore.AccountResource)
+			minIdx := basics.CreatableIndex(0)
+			for {
+				page, _, err := au.lookupLimitedResources(addr, minIdx, pageSize, tc.ctype, tc.createdOnly)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(page), pageSize)
+				for _, r := range page {
+					// resources are returned in order, past the requested index
+					require.Greater(t, r.cidx, minIdx)
+					minIdx = r.cidx
+					found[r.cidx] = r.resource
+				}
+				if len(page) < pageSize {
+					break
+				}
+			}
+			require.Equal(t, tc.expected, found)
+		}
+	}
+}
+
+func TestAcctUpdatesLookupCreatableHolders(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const assetID = basics.AssetIndex(1000)
+	const appID = basics.AppIndex(2000)
+	accts := ledgertesting.RandomAccounts(20, false)
+	expectedHolders := make(map[basics.Address]basics.AssetHolding)
+	expectedOptIns := make(map[basics.Address]basics.AppLocalState)
+	i := 0
+	for addr, acct := range accts {
+		if acct.Assets == nil {
+			acct.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+		}
+		acct.Assets[assetID] = ledgertesting.RandomAssetHolding(false)
+		expectedHolders[addr] = acct.Assets[assetID]
+		if i%2 == 0 {
+			if acct.AppLocalStates == nil {
+				acct.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState)
+			}
+			acct.AppLocalStates[appID] = ledgertesting.RandomAppLocalState()
+			expectedOptIns[addr] = acct.AppLocalStates[appID]
+		}
+		accts[addr] = acct
+		i++
+	}
+	ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	au, _ := newAcctUpdates(t, ml, conf)
+	defer au.close()
+
+	// the index is not there until it is enabled
+	_, _, err := au.lookupCreatableHolders(basics.CreatableIndex(assetID), basics.AssetCreatable, nil, 1)
+	require.Error(t, err)
+	built, err := ml.trackerDB().SetCreatableHolderIndex(context.Background(), true)
+	require.NoError(t, err)
+	require.True(t, built)
+	built, err = ml.trackerDB().SetCreatableHolderIndex(context.Background(), true)
+	require.NoError(t, err)
+	require.False(t, built)
+
+	const pageSize = 3
+	lookupAll := func(cidx basics.CreatableIndex, ctype basics.CreatableType) map[basics.Address]ledgercore.AccountResource {
+		found := make(map[basics.Address]ledgercore.AccountResource)
+		var after *basics.Address
+		for {
+			page, _, err := au.lookupCreatableHolders(cidx, ctype, after, pageSize)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page), pageSize)
+			for _, h := range page {
+				// holders are returned in order, past the requested address
+				if after != nil {
+					require.Greater(t, bytes.Compare(h.addr[:], after[:]), 0)
+				}
+				addr := h.addr
+				after = &addr
+				found[h.addr] = h.resource
+			}
+			if len(page) < pageSize {
+				return found
+			}
+		}
+	}
+
+	holders := lookupAll(basics.CreatableIndex(assetID), basics.AssetCreatable)
+	require.Len(t, holders, len(expectedHolders))
+	for addr, holding := range expectedHolders {
+		require.Contains(t, holders, addr)
+		require.Equal(t, holding, *holders[addr].AssetHolding)
+	}
+
+	optIns := lookupAll(basics.CreatableIndex(appID), basics.AppCreatable)
+	require.Len(t, optIns, len(expectedOptIns))
+	for addr, localState := range expectedOptIns {
+		require.Contains(t, optIns, addr)
+		require.Equal(t, localState, *optIns[addr].AppLocalState)
+	}
+
+	// disabling the index drops it
+	built, err = ml.trackerDB().SetCreatableHolderIndex(context.Background(), false)
+	require.NoError(t, err)
+	require.False(t, built)
+	_, _, err = au.lookupCreatableHolders(basics.CreatableIndex(assetID), basics.AssetCreatable, nil, 1)
+	require.Error(t, err)
+}
+
+func TestAcctUpdatesLookupScopeAddresses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := setupAccts(10)
+	testProtocolVersion := protocol.ConsensusCurrentVersion
+	protoParams := config.Consensus[testProtocolVersion]
+
+	ml := makeMockLedgerForTracker(t, true, 1, testProtocolVersion, accts)
+	defer ml.Close()
+	_, err := ml.trackerDB().SetCreatableHolderIndex(context.Background(), true)
+	require.NoError(t, err)
+
+	conf := config.GetDefaultLocal()
+	au, _ := newAcctUpdates(t, ml, conf)
+	defer au.close()
+
+	var closed basics.Address
+	for addr := range accts[0] {
+		if addr != testSinkAddr && addr != testPoolAddr {
+			closed = addr
+			break
+		}
+	}
+	created, holder := ledgertesting.RandomAddress(), ledgertesting.RandomAddress()
+	const assetID = basics.AssetIndex(1000)
+	assetScope := ledgercore.AbsenceProofScope{Creatable: basics.CreatableIndex(assetID), CreatableType: basics.AssetCreatable}
+
+	// an account is created in the first round, committed to the database, while the last round closes an
+	// account and creates an account holding an asset, kept in memory.
+	lastRound := basics.Round(conf.MaxAcctLookback + 2)
+	for i := basics.Round(1); i <= lastRound; i++ {
+		var updates ledgercore.AccountDeltas
+		switch i {
+		case 1:
+			updates.Upsert(created, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 1000000}}})
+		case lastRound:
+			updates.Upsert(closed, ledgercore.AccountData{})
+			updates.Upsert(holder, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 1000000}, TotalAssets: 1}})
+			updates.UpsertAssetResource(holder, assetID, ledgercore.AssetParamsDelta{}, ledgercore.AssetHoldingDelta{Holding: &basics.AssetHolding{Amount: 100}})
+		}
+
+		base := accts[i-1]
+		accts = append(accts, applyPartialDeltas(base, updates))
+		opts := auNewBlockOpts{updates, testProtocolVersion, protoParams, map[basics.CreatableIndex]bool{}}
+		auNewBlock(t, i, au, base, opts, nil)
+		auCommitSync(t, i, au, ml)
+	}
+	require.Less(t, au.cachedDBRound, lastRound-1)
+
+	expectedAddrs := func(rnd basics.Round) []basics.Address {
+		var addrs []basics.Address
+		for addr, data := range accts[rnd] {
+			if !data.IsZero() {
+				addrs = append(addrs, addr)
+			}
+		}
+		return addrs
+	}
+	for _, rnd := range []basics.Round{lastRound - 1, lastRound} {
+		addrs, err := au.lookupScopeAddresses(rnd, ledgercore.AbsenceProofScope{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, expectedAddrs(rnd), addrs)
+	}
+	require.Contains(t, expectedAddrs(lastRound-1), closed)
+	require.NotContains(t, expectedAddrs(lastRound), closed)
+
+	holders, err := au.lookupScopeAddresses(lastRound-1, assetScope)
+	require.NoError(t, err)
+	require.Empty(t, holders)
+	holders, err = au.lookupScopeAddresses(lastRound, assetScope)
+	require.NoError(t, err)
+	require.Equal(t, []basics.Address{holder}, holders)
+
+	// the rounds committed to the database are gone
+	_, err = au.lookupScopeAddresses(au.cachedDBRound-1, ledgercore.AbsenceProofScope{})
+	require.Error(t, err)
+}
+
 // This test helper attempts to cover the case when an accountUpdates.lookupX method:
 // - can't find the requested address,
 // - falls through looking at deltas and the LRU accounts cache,
@@ -21,6 +21,7 @@ import (
 
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 )
 
 func getParams(balances Balances, aidx basics.AssetIndex) (params basics.AssetParams, creator basics.Address, err error) {
@@ -209,7 +210,7 @@ func takeOut(balances Balances, addr basics.Address, asset basics.AssetIndex, am
 	}
 
 	if sndHolding.Frozen && !bypassFreeze {
-		return fmt.Errorf("asset %v frozen in %v", asset, addr)
+		return &ledgercore.AssetFrozenError{Asset: asset, Holder: addr}
 	}
 
 	newAmount, overflowed := basics.OSub(sndHolding.Amount, amount)
@@ -235,7 +236,7 @@ func putIn(balances Balances, addr basics.Address, asset basics.AssetIndex, amou
 	}
 
 	if rcvHolding.Frozen && !bypassFreeze {
-		return fmt.Errorf("asset frozen in recipient")
+		return &ledgercore.AssetFrozenError{Asset: asset, Holder: addr, Recipient: true}
 	}
 
 	var overflowed bool
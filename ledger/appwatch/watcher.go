@@ -0,0 +1,239 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package appwatch collects the state changes of watched applications as blocks are committed,
+// so that API clients following a set of applications don't have to diff full state deltas.
+package appwatch
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// ErrRoundNotAvailable is returned for the rounds the watcher has no changes for, either because
+// they are not committed yet, or because they were committed before the watcher started or are
+// no longer retained.
+var ErrRoundNotAvailable = errors.New("round is not available")
+
+// ErrNotWatched is returned when unwatching an application that is not watched.
+var ErrNotWatched = errors.New("application is not watched")
+
+// LocalStateChange is the local state of an account in an application, after a round it changed in.
+type LocalStateChange struct {
+	Address basics.Address
+	// Deleted is set when the account closed out of the application.
+	Deleted bool
+	State   basics.TealKeyValue
+}
+
+// BoxChange is the value of a box of an application, after a round it changed in.
+type BoxChange struct {
+	Name []byte
+	// Deleted is set when the box was deleted.
+	Deleted bool
+	Value   []byte
+}
+
+// AppStateChanges are the state changes of an application in a round.
+type AppStateChanges struct {
+	AppID basics.AppIndex
+	// Deleted is set when the application was deleted.
+	Deleted bool
+	// Global is the global state of the application after the round, if its parameters changed.
+	Global basics.TealKeyValue
+	// GlobalChanged is set when the parameters of the application changed, including its global state.
+	GlobalChanged bool
+	// Local are the local states that changed, ordered by address.
+	Local []LocalStateChange
+	// Boxes are the boxes that changed, ordered by name.
+	Boxes []BoxChange
+}
+
+// RoundChanges are the state changes of the watched applications in a round, ordered by
+// application ID.
+type RoundChanges struct {
+	Round basics.Round
+	Apps  []AppStateChanges
+}
+
+// Watcher is a ledgercore.BlockListener collecting the state changes of the watched applications
+// in the blocks committed while they are watched. It retains the changes of a bounded number of
+// recent rounds.
+type Watcher struct {
+	mu deadlock.Mutex
+
+	watched map[basics.AppIndex]struct{}
+	retain  uint64
+
+	// first and latest are the bounds of the rounds changes are available for, first being zero
+	// before any block is committed.
+	first   basics.Round
+	latest  basics.Round
+	changes map[basics.Round][]AppStateChanges
+}
+
+// MakeWatcher creates a watcher retaining the changes of the given number of recent rounds.
+func MakeWatcher(retain uint64) *Watcher {
+	if retain == 0 {
+		retain = 1
+	}
+	return &Watcher{
+		watched: make(map[basics.AppIndex]struct{}),
+		retain:  retain,
+		changes: make(map[basics.Round][]AppStateChanges),
+	}
+}
+
+// Watch adds an application to the watched ones. Its changes are collected from the next
+// committed round on.
+func (w *Watcher) Watch(appID basics.AppIndex) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.watched[appID] = struct{}{}
+}
+
+// Unwatch removes an application from the watched ones. The changes already collected for it
+// are retained.
+func (w *Watcher) Unwatch(appID basics.AppIndex) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.watched[appID]; !ok {
+		return ErrNotWatched
+	}
+	delete(w.watched, appID)
+	return nil
+}
+
+// Watched returns the watched applications, in increasing order.
+func (w *Watcher) Watched() []basics.AppIndex {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	watched := make([]basics.AppIndex, 0, len(w.watched))
+	for appID := range w.watched {
+		watched = append(watched, appID)
+	}
+	sort.Slice(watched, func(i, j int) bool { return watched[i] < watched[j] })
+	return watched
+}
+
+// Changes returns the state changes of the watched applications in the given round.
+func (w *Watcher) Changes(rnd basics.Round) (RoundChanges, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.first == 0 || rnd < w.first || rnd > w.latest {
+		return RoundChanges{}, ErrRoundNotAvailable
+	}
+	return RoundChanges{Round: rnd, Apps: w.changes[rnd]}, nil
+}
+
+// OnNewBlock implements ledgercore.BlockListener
+func (w *Watcher) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	rnd := block.Round()
+	if w.first != 0 && rnd <= w.latest {
+		return
+	}
+	if w.first == 0 || rnd != w.latest+1 {
+		// rounds were skipped, such as during a catchpoint catchup
+		w.first = rnd
+		w.changes = make(map[basics.Round][]AppStateChanges)
+	}
+	w.latest = rnd
+	for w.latest-w.first >= basics.Round(w.retain) {
+		delete(w.changes, w.first)
+		w.first++
+	}
+
+	if len(w.watched) == 0 {
+		return
+	}
+	changes := w.collect(delta)
+	if len(changes) != 0 {
+		w.changes[rnd] = changes
+	}
+}
+
+// collect returns the changes of the watched applications in delta
+func (w *Watcher) collect(delta ledgercore.StateDelta) []AppStateChanges {
+	byApp := make(map[basics.AppIndex]*AppStateChanges)
+	app := func(appID basics.AppIndex) *AppStateChanges {
+		changes, ok := byApp[appID]
+		if !ok {
+			changes = &AppStateChanges{AppID: appID}
+			byApp[appID] = changes
+		}
+		return changes
+	}
+
+	for _, rec := range delta.Accts.AppResources {
+		if _, ok := w.watched[rec.Aidx]; !ok {
+			continue
+		}
+		if rec.Params.Deleted {
+			app(rec.Aidx).Deleted = true
+		} else if rec.Params.Params != nil {
+			changes := app(rec.Aidx)
+			changes.GlobalChanged = true
+			changes.Global = rec.Params.Params.GlobalState.Clone()
+		}
+		if rec.State.Deleted {
+			changes := app(rec.Aidx)
+			changes.Local = append(changes.Local, LocalStateChange{Address: rec.Addr, Deleted: true})
+		} else if rec.State.LocalState != nil {
+			changes := app(rec.Aidx)
+			changes.Local = append(changes.Local, LocalStateChange{Address: rec.Addr, State: rec.State.LocalState.KeyValue.Clone()})
+		}
+	}
+
+	for key, kv := range delta.KvMods {
+		appID, name, err := apps.SplitBoxKey(key)
+		if err != nil {
+			continue
+		}
+		if _, ok := w.watched[basics.AppIndex(appID)]; !ok {
+			continue
+		}
+		changes := app(basics.AppIndex(appID))
+		changes.Boxes = append(changes.Boxes, BoxChange{
+			Name:    []byte(name),
+			Deleted: kv.Data == nil,
+			Value:   append([]byte(nil), kv.Data...),
+		})
+	}
+
+	result := make([]AppStateChanges, 0, len(byApp))
+	for _, changes := range byApp {
+		sort.Slice(changes.Local, func(i, j int) bool {
+			return bytes.Compare(changes.Local[i].Address[:], changes.Local[j].Address[:]) < 0
+		})
+		sort.Slice(changes.Boxes, func(i, j int) bool {
+			return bytes.Compare(changes.Boxes[i].Name, changes.Boxes[j].Name) < 0
+		})
+		result = append(result, *changes)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].AppID < result[j].AppID })
+	return result
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package appwatch
+
+import (
+	"testing"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeBlock(rnd basics.Round) bookkeeping.Block {
+	return bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+}
+
+func TestWatcherChanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	creator := basics.Address{1}
+	user := basics.Address{2}
+	global := basics.TealKeyValue{"counter": {Type: basics.TealUintType, Uint: 7}}
+	local := basics.TealKeyValue{"seen": {Type: basics.TealUintType, Uint: 1}}
+
+	hdr := bookkeeping.BlockHeader{Round: 1}
+	delta := ledgercore.MakeStateDelta(&hdr, 0, 0, 0)
+	delta.Accts.UpsertAppResource(creator, 10, ledgercore.AppParamsDelta{Params: &basics.AppParams{GlobalState: global}}, ledgercore.AppLocalStateDelta{})
+	delta.Accts.UpsertAppResource(user, 10, ledgercore.AppParamsDelta{}, ledgercore.AppLocalStateDelta{LocalState: &basics.AppLocalState{KeyValue: local}})
+	delta.Accts.UpsertAppResource(user, 20, ledgercore.AppParamsDelta{}, ledgercore.AppLocalStateDelta{Deleted: true})
+	delta.Accts.UpsertAppResource(creator, 30, ledgercore.AppParamsDelta{Params: &basics.AppParams{GlobalState: global}}, ledgercore.AppLocalStateDelta{})
+	delta.AddKvMod(apps.MakeBoxKey(10, "b"), ledgercore.KvValueDelta{Data: []byte("value")})
+	delta.AddKvMod(apps.MakeBoxKey(10, "a"), ledgercore.KvValueDelta{OldData: []byte("old")})
+	delta.AddKvMod(apps.MakeBoxKey(30, "c"), ledgercore.KvValueDelta{Data: []byte("ignored")})
+
+	w := MakeWatcher(10)
+	w.Watch(10)
+	w.Watch(20)
+	require.Equal(t, []basics.AppIndex{10, 20}, w.Watched())
+
+	_, err := w.Changes(1)
+	require.ErrorIs(t, err, ErrRoundNotAvailable)
+
+	w.OnNewBlock(makeBlock(1), delta)
+	changes, err := w.Changes(1)
+	require.NoError(t, err)
+	require.Equal(t, RoundChanges{
+		Round: 1,
+		Apps: []AppStateChanges{{
+			AppID:         10,
+			Global:        global,
+			GlobalChanged: true,
+			Local:         []LocalStateChange{{Address: user, State: local}},
+			Boxes: []BoxChange{
+				{Name: []byte("a"), Deleted: true},
+				{Name: []byte("b"), Value: []byte("value")},
+			},
+		}, {
+			AppID: 20,
+			Local: []LocalStateChange{{Address: user, Deleted: true}},
+		}},
+	}, changes)
+
+	// a round without changes of the watched applications is still available
+	w.OnNewBlock(makeBlock(2), ledgercore.StateDelta{})
+	changes, err = w.Changes(2)
+	require.NoError(t, err)
+	require.Equal(t, RoundChanges{Round: 2}, changes)
+
+	_, err = w.Changes(3)
+	require.ErrorIs(t, err, ErrRoundNotAvailable)
+
+	require.NoError(t, w.Unwatch(20))
+	require.ErrorIs(t, w.Unwatch(20), ErrNotWatched)
+	require.Equal(t, []basics.AppIndex{10}, w.Watched())
+}
+
+func TestWatcherRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w := MakeWatcher(3)
+	w.Watch(10)
+	for rnd := basics.Round(1); rnd <= 5; rnd++ {
+		hdr := bookkeeping.BlockHeader{Round: rnd}
+		delta := ledgercore.MakeStateDelta(&hdr, 0, 0, 0)
+		delta.AddKvMod(apps.MakeBoxKey(10, "box"), ledgercore.KvValueDelta{Data: []byte{byte(rnd)}})
+		w.OnNewBlock(makeBlock(rnd), delta)
+	}
+
+	for rnd := basics.Round(1); rnd <= 2; rnd++ {
+		_, err := w.Changes(rnd)
+		require.ErrorIs(t, err, ErrRoundNotAvailable)
+	}
+	for rnd := basics.Round(3); rnd <= 5; rnd++ {
+		changes, err := w.Changes(rnd)
+		require.NoError(t, err)
+		require.Len(t, changes.Apps, 1)
+		require.Equal(t, []byte{byte(rnd)}, changes.Apps[0].Boxes[0].Value)
+	}
+	require.Len(t, w.changes, 3)
+
+	// replayed rounds are ignored, skipped rounds reset the retained changes
+	w.OnNewBlock(makeBlock(4), ledgercore.StateDelta{})
+	changes, err := w.Changes(4)
+	require.NoError(t, err)
+	require.Len(t, changes.Apps, 1)
+
+	w.OnNewBlock(makeBlock(100), ledgercore.StateDelta{})
+	_, err = w.Changes(5)
+	require.ErrorIs(t, err, ErrRoundNotAvailable)
+	_, err = w.Changes(100)
+	require.NoError(t, err)
+}
@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -173,6 +174,9 @@ func (bq *blockQueue) syncer() {
 			if err != nil {
 				bq.l.log.Warnf("blockQueue.syncer: blockForgetBefore(%d): %v", minToSave, err)
 			}
+			if bq.l.vault != nil {
+				bq.l.vault.notifyCommit(committed)
+			}
 
 			bq.mu.Lock()
 		}
@@ -286,6 +290,9 @@ func (bq *blockQueue) getBlock(r basics.Round) (blk bookkeeping.Block, err error
 		return err0
 	})
 	ledgerGetblockMicros.AddMicrosecondsSince(start, nil)
+	if errors.Is(err, blockdb.ErrBlockVaulted) && bq.l.vault != nil {
+		blk, _, err = bq.l.vault.getBlockCert(r)
+	}
 	err = updateErrNoEntry(err, lastCommitted, latest)
 	return
 }
@@ -334,6 +341,9 @@ func (bq *blockQueue) getEncodedBlockCert(r basics.Round) (blk []byte, cert []by
 		return err0
 	})
 	ledgerGeteblockcertMicros.AddMicrosecondsSince(start, nil)
+	if errors.Is(err, blockdb.ErrBlockVaulted) && bq.l.vault != nil {
+		blk, cert, err = bq.l.vault.get(r)
+	}
 	err = updateErrNoEntry(err, lastCommitted, latest)
 	return
 }
@@ -356,6 +366,9 @@ func (bq *blockQueue) getBlockCert(r basics.Round) (blk bookkeeping.Block, cert
 		return err0
 	})
 	ledgerGetblockcertMicros.AddMicrosecondsSince(start, nil)
+	if errors.Is(err, blockdb.ErrBlockVaulted) && bq.l.vault != nil {
+		blk, cert, err = bq.l.vault.getBlockCert(r)
+	}
 	err = updateErrNoEntry(err, lastCommitted, latest)
 	return
 }
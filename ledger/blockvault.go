@@ -0,0 +1,303 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/store/blockdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/s3"
+)
+
+// blockVaultBatchSize is the number of blocks moved to the block vault per blocks database transaction.
+const blockVaultBatchSize = 64
+
+// blockVaultRequestTimeout bounds each request to the object storage of the block vault, so that an unresponsive
+// storage does not hold the block queue readers or the offloader forever.
+const blockVaultRequestTimeout = 30 * time.Second
+
+// errBlockVaultMismatch is returned when a block or certificate fetched from the block vault does not match the
+// header of its round kept in the blocks database.
+var errBlockVaultMismatch = errors.New("does not match the local block header")
+
+// blockVaultStore is the object storage holding the blocks of the block vault.
+type blockVaultStore interface {
+	PutObject(ctx context.Context, key string, data []byte) error
+	GetObject(ctx context.Context, key string) ([]byte, error)
+}
+
+// vaultedBlock is the object stored in the block vault for each round.
+//
+//msgp:ignore vaultedBlock
+type vaultedBlock struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Block []byte `codec:"blk"`
+	Cert  []byte `codec:"cert"`
+}
+
+// blockVault offloads the blocks of an archival ledger older than afterRounds rounds to an object storage, keeping
+// their headers in the blocks database as the index of the vault, and fetches them back for the block queue.
+type blockVault struct {
+	l           *Ledger
+	store       blockVaultStore
+	prefix      string
+	afterRounds basics.Round
+
+	mu        deadlock.Mutex
+	committed basics.Round
+	wake      chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// makeBlockVault returns the block vault of the BlockVaultBucket of cfg, or nil when there is none. Blocks are
+// only offloaded to the vault by archival ledgers with a non-zero BlockVaultAfterRounds.
+func makeBlockVault(l *Ledger, cfg config.Local) (*blockVault, error) {
+	if cfg.BlockVaultBucket == "" {
+		return nil, nil
+	}
+	helper, err := s3.MakeS3SessionForEndpoint(cfg.BlockVaultBucket, cfg.BlockVaultEndpoint, cfg.BlockVaultRegion, cfg.BlockVaultCredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to the block vault %s: %w", cfg.BlockVaultBucket, err)
+	}
+	var afterRounds basics.Round
+	if cfg.Archival {
+		afterRounds = basics.Round(cfg.BlockVaultAfterRounds)
+	}
+	return newBlockVault(l, &helper, path.Join(cfg.BlockVaultPrefix, l.genesisHash.String()), afterRounds), nil
+}
+
+func newBlockVault(l *Ledger, store blockVaultStore, prefix string, afterRounds basics.Round) *blockVault {
+	return &blockVault{
+		l:           l,
+		store:       store,
+		prefix:      prefix,
+		afterRounds: afterRounds,
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+func (bv *blockVault) start() {
+	if bv.afterRounds == 0 {
+		return
+	}
+	bv.ctx, bv.cancel = context.WithCancel(context.Background())
+	bv.wg.Add(1)
+	go bv.offloader()
+}
+
+func (bv *blockVault) stop() {
+	if bv.cancel != nil {
+		bv.cancel()
+	}
+	bv.wg.Wait()
+}
+
+// notifyCommit lets the offloader move the blocks older than afterRounds rounds before committed.
+func (bv *blockVault) notifyCommit(committed basics.Round) {
+	if bv.afterRounds == 0 {
+		return
+	}
+	bv.mu.Lock()
+	bv.committed = committed
+	bv.mu.Unlock()
+
+	select {
+	case bv.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (bv *blockVault) offloader() {
+	defer bv.wg.Done()
+	for {
+		select {
+		case <-bv.ctx.Done():
+			return
+		case <-bv.wake:
+		}
+
+		bv.mu.Lock()
+		committed := bv.committed
+		bv.mu.Unlock()
+		if committed <= bv.afterRounds {
+			continue
+		}
+
+		err := bv.offload(committed - bv.afterRounds)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			bv.l.log.Warnf("blockVault.offloader: could not move the blocks before round %d to the vault: %v", committed-bv.afterRounds, err)
+		}
+	}
+}
+
+// offload moves the blocks of the rounds before rnd to the vault, batch by batch.
+func (bv *blockVault) offload(rnd basics.Round) error {
+	for {
+		var rounds []basics.Round
+		err := bv.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+			rounds, err0 = blockdb.BlockVaultCandidates(tx, rnd, blockVaultBatchSize)
+			return err0
+		})
+		if err != nil || len(rounds) == 0 {
+			return err
+		}
+
+		start := time.Now()
+		for _, r := range rounds {
+			if bv.ctx.Err() != nil {
+				return bv.ctx.Err()
+			}
+			var entry vaultedBlock
+			err = bv.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+				entry.Block, entry.Cert, err0 = blockdb.BlockGetEncodedCert(tx, r)
+				return err0
+			})
+			if err != nil {
+				return err
+			}
+			putCtx, cancel := context.WithTimeout(bv.ctx, blockVaultRequestTimeout)
+			err = bv.store.PutObject(putCtx, bv.key(r), protocol.EncodeReflect(&entry))
+			cancel()
+			if err != nil {
+				return err
+			}
+		}
+
+		// only drop the blocks from the database once all of them are in the vault
+		err = bv.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			for _, r := range rounds {
+				err0 := blockdb.BlockSetVaulted(tx, r)
+				if err0 != nil {
+					return err0
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		ledgerVaultPutCount.AddUint64(uint64(len(rounds)), nil)
+		ledgerVaultPutMicros.AddMicrosecondsSince(start, nil)
+	}
+}
+
+// fetch returns the entry of round rnd of the vault, along with its decoded block and certificate, once checked
+// against the header of the round kept in the blocks database: the object storage is not trusted with the blocks.
+func (bv *blockVault) fetch(rnd basics.Round) (entry vaultedBlock, blk bookkeeping.Block, cert agreement.Certificate, err error) {
+	start := time.Now()
+	ledgerVaultGetCount.Inc(nil)
+	defer ledgerVaultGetMicros.AddMicrosecondsSince(start, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), blockVaultRequestTimeout)
+	defer cancel()
+	data, err := bv.store.GetObject(ctx, bv.key(rnd))
+	if err != nil {
+		err = fmt.Errorf("cannot fetch block %d from the block vault: %w", rnd, err)
+		return
+	}
+	err = protocol.DecodeReflect(data, &entry)
+	if err != nil {
+		err = fmt.Errorf("cannot decode block %d of the block vault: %w", rnd, err)
+		return
+	}
+	err = protocol.Decode(entry.Block, &blk)
+	if err != nil {
+		err = fmt.Errorf("cannot decode block %d of the block vault: %w", rnd, err)
+		return
+	}
+	if len(entry.Cert) > 0 {
+		err = protocol.Decode(entry.Cert, &cert)
+		if err != nil {
+			err = fmt.Errorf("cannot decode the certificate of block %d of the block vault: %w", rnd, err)
+			return
+		}
+	}
+
+	var hdr bookkeeping.BlockHeader
+	err = bv.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		hdr, err0 = blockdb.BlockGetHdr(tx, rnd)
+		return err0
+	})
+	if err != nil {
+		err = fmt.Errorf("cannot read the header of block %d of the block vault: %w", rnd, err)
+		return
+	}
+	err = verifyVaultedBlock(rnd, hdr, blk, cert)
+	return
+}
+
+// verifyVaultedBlock checks the block and certificate of round rnd fetched from the vault against the local header
+// hdr of the round. The block must have the same header, and contents matching it. The certificate must certify
+// the block, unless it is empty, as is the one of the genesis block. The genesis block has no transactions, and
+// its commitments are computed differently.
+func verifyVaultedBlock(rnd basics.Round, hdr bookkeeping.BlockHeader, blk bookkeeping.Block, cert agreement.Certificate) error {
+	if blk.Round() != rnd || blk.Hash() != hdr.Hash() {
+		return fmt.Errorf("block %d of the block vault %w", rnd, errBlockVaultMismatch)
+	}
+	if (rnd == 0 && len(blk.Payset) != 0) || (rnd != 0 && !blk.ContentsMatchHeader()) {
+		return fmt.Errorf("contents of block %d of the block vault %w", rnd, errBlockVaultMismatch)
+	}
+	if cert.Round != 0 || cert.Proposal.BlockDigest != (crypto.Digest{}) {
+		if cert.Round != rnd || cert.Proposal.BlockDigest != blk.Digest() {
+			return fmt.Errorf("certificate of block %d of the block vault %w", rnd, errBlockVaultMismatch)
+		}
+	}
+	return nil
+}
+
+// get returns the encoded block and certificate of round rnd from the vault.
+func (bv *blockVault) get(rnd basics.Round) (blk []byte, cert []byte, err error) {
+	entry, _, _, err := bv.fetch(rnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	return entry.Block, entry.Cert, nil
+}
+
+// getBlockCert returns the block and certificate of round rnd from the vault.
+func (bv *blockVault) getBlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
+	_, blk, cert, err = bv.fetch(rnd)
+	return
+}
+
+func (bv *blockVault) key(rnd basics.Round) string {
+	return path.Join(bv.prefix, fmt.Sprintf("%d.block", rnd))
+}
+
+var ledgerVaultPutCount = metrics.NewCounter("ledger_blockvault_put_count", "blocks moved to the block vault")
+var ledgerVaultPutMicros = metrics.NewCounter("ledger_blockvault_put_micros", "µs spent moving blocks to the block vault")
+var ledgerVaultGetCount = metrics.NewCounter("ledger_blockvault_get_count", "blocks fetched from the block vault")
+var ledgerVaultGetMicros = metrics.NewCounter("ledger_blockvault_get_micros", "µs spent fetching blocks from the block vault")
@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-deadlock"
+)
+
+type memBlockVaultStore struct {
+	mu      deadlock.Mutex
+	objects map[string][]byte
+}
+
+func (s *memBlockVaultStore) PutObject(ctx context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memBlockVaultStore) GetObject(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", key, os.ErrNotExist)
+	}
+	return data, nil
+}
+
+func TestBlockVault(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	genesisInitState := getInitState()
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	log := logging.TestingLog(t)
+	l, err := OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	store := &memBlockVaultStore{objects: make(map[string][]byte)}
+	l.vault = newBlockVault(l, store, "vault", 10)
+	l.vault.start()
+
+	blocks := []bookkeeping.Block{genesisInitState.Block}
+	blk := genesisInitState.Block
+	for i := 0; i < 100; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += int64(crypto.RandUint64() % 100 * 1000)
+		blk.TxnCommitments, err = blk.PaysetCommit()
+		require.NoError(t, err)
+		cert := agreement.Certificate{Round: blk.Round()}
+		cert.Proposal.BlockDigest = blk.Digest()
+		require.NoError(t, l.AddBlock(blk, cert))
+		blocks = append(blocks, blk)
+	}
+	l.WaitForCommit(blk.Round())
+
+	require.Eventually(t, func() bool {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		return len(store.objects) == 90
+	}, 10*time.Second, 10*time.Millisecond)
+
+	for rnd := basics.Round(0); rnd <= blk.Round(); rnd++ {
+		b, err := l.Block(rnd)
+		require.NoError(t, err)
+		require.Equal(t, blocks[rnd], b)
+
+		hdr, err := l.BlockHdr(rnd)
+		require.NoError(t, err)
+		require.Equal(t, blocks[rnd].BlockHeader, hdr)
+
+		b, c, err := l.BlockCert(rnd)
+		require.NoError(t, err)
+		require.Equal(t, blocks[rnd], b)
+		if rnd > 0 {
+			require.Equal(t, rnd, c.Round)
+		}
+
+		_, _, err = l.EncodedBlockCert(rnd)
+		require.NoError(t, err)
+	}
+
+	// the objects of the vault are checked against the local headers
+	store.mu.Lock()
+	store.objects[l.vault.key(5)] = store.objects[l.vault.key(6)]
+	store.mu.Unlock()
+	_, err = l.Block(5)
+	require.ErrorIs(t, err, errBlockVaultMismatch)
+	_, _, err = l.EncodedBlockCert(5)
+	require.ErrorIs(t, err, errBlockVaultMismatch)
+
+	// the blocks moved to the vault cannot be read back without it
+	l.vault.stop()
+	l.vault = nil
+	_, err = l.Block(5)
+	require.Error(t, err)
+	_, err = l.BlockHdr(5)
+	require.NoError(t, err)
+}
+
+func TestVerifyVaultedBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	blk := getInitState().Block
+	blk.BlockHeader.Round = 7
+	var err error
+	blk.TxnCommitments, err = blk.PaysetCommit()
+	require.NoError(t, err)
+	cert := agreement.Certificate{Round: blk.Round()}
+	cert.Proposal.BlockDigest = blk.Digest()
+	require.NoError(t, verifyVaultedBlock(7, blk.BlockHeader, blk, cert))
+	// the certificates are optional, as the genesis block has none
+	require.NoError(t, verifyVaultedBlock(7, blk.BlockHeader, blk, agreement.Certificate{}))
+
+	other := blk
+	other.BlockHeader.TimeStamp++
+	require.ErrorIs(t, verifyVaultedBlock(7, blk.BlockHeader, other, cert), errBlockVaultMismatch)
+	require.ErrorIs(t, verifyVaultedBlock(8, blk.BlockHeader, blk, cert), errBlockVaultMismatch)
+
+	// the payset must match the commitment of the header
+	other = blk
+	other.Payset = append(other.Payset, transactions.SignedTxnInBlock{})
+	require.ErrorIs(t, verifyVaultedBlock(7, blk.BlockHeader, other, cert), errBlockVaultMismatch)
+
+	badCert := cert
+	badCert.Proposal.BlockDigest = crypto.Hash([]byte("another block"))
+	require.ErrorIs(t, verifyVaultedBlock(7, blk.BlockHeader, blk, badCert), errBlockVaultMismatch)
+	badCert = cert
+	badCert.Round = 8
+	require.ErrorIs(t, verifyVaultedBlock(7, blk.BlockHeader, blk, badCert), errBlockVaultMismatch)
+}
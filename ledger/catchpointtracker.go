@@ -30,6 +30,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -103,6 +104,18 @@ type catchpointTracker struct {
 	// enableGeneratingCatchpointFiles determines whether catchpoints files should be generated by the trackers.
 	enableGeneratingCatchpointFiles bool
 
+	// catchpointFilesPaused is non-zero while writing catchpoint files is paused, see pauseFiles.
+	// It's an atomic variable.
+	catchpointFilesPaused int32
+
+	// buildAbsenceCommitments determines whether the absence commitment of all the accounts is built along the first
+	// stage of each catchpoint, as archival ledgers do.
+	buildAbsenceCommitments bool
+
+	// absenceCommitment is the commitment to the addresses of all the accounts at the accounts round of the latest
+	// catchpoint, which the absence proofs of the accounts are built from.
+	absenceCommitment atomic.Pointer[ledgercore.AbsenceCommitment]
+
 	// Prepared SQL statements for fast accounts DB lookups.
 	accountsq trackerdb.AccountsReader
 
@@ -130,9 +143,29 @@ type catchpointTracker struct {
 	catchpointDataWriting int32
 
 	// The Trie tracking the current account balances. Always matches the balances that were
-	// written to the database.
+	// written to the database, once the deferred trie updates, if any, are written.
 	balancesTrie *merkletrie.Trie
 
+	// trieMu protects `balancesTrie`, which the trieCommitter updates concurrently with the commits.
+	trieMu deadlock.Mutex
+
+	// trieCommitWindow is the number of commits whose merkle trie update may be deferred past the
+	// transaction writing their accounts. 0 updates the trie in that transaction.
+	trieCommitWindow uint64
+
+	// trieCommits is the queue of the deferred merkle trie updates, written in order by trieCommitter.
+	// It is nil unless catchpoints are enabled and trieCommitWindow is non-zero.
+	trieCommits chan *deferredCommitContext
+
+	// trieCommitterDone is closed once the trieCommitter goroutine exits.
+	trieCommitterDone chan struct{}
+
+	// triePending tracks the deferred merkle trie updates that are queued or being written.
+	triePending sync.WaitGroup
+
+	// trieOutOfSync is set when a deferred merkle trie update failed, until the trie is rebuilt.
+	trieOutOfSync atomic.Bool
+
 	// roundDigest stores the digest of the block for every round starting with dbRound+1 and every round after it.
 	roundDigest []crypto.Digest
 
@@ -192,6 +225,9 @@ func (ct *catchpointTracker) initialize(cfg config.Local, dbPathPrefix string) {
 	if cfg.CatchpointFileHistoryLength < -1 {
 		ct.catchpointFileHistoryLength = -1
 	}
+
+	ct.trieCommitWindow = cfg.LedgerCommitPipelineWindow
+	ct.buildAbsenceCommitments = cfg.Archival && ct.catchpointInterval > 0
 }
 
 // GetLastCatchpointLabel retrieves the last catchpoint label that was stored to the database.
@@ -201,6 +237,20 @@ func (ct *catchpointTracker) GetLastCatchpointLabel() string {
 	return ct.lastCatchpointLabel
 }
 
+// pauseFiles pauses, or resumes, writing catchpoint data files and catchpoint files, for instance when
+// the disk is running out of space.
+func (ct *catchpointTracker) pauseFiles(paused bool) {
+	var v int32
+	if paused {
+		v = 1
+	}
+	atomic.StoreInt32(&ct.catchpointFilesPaused, v)
+}
+
+func (ct *catchpointTracker) filesPaused() bool {
+	return atomic.LoadInt32(&ct.catchpointFilesPaused) != 0
+}
+
 func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basics.Round, updatingBalancesDuration time.Duration) error {
 	ct.log.Infof("finishing catchpoint's first stage dbRound: %d", dbRound)
 
@@ -211,7 +261,11 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 	var spVerificationHash crypto.Digest
 	var catchpointGenerationStats telemetryspec.CatchpointGenerationEventDetails
 
-	if ct.enableGeneratingCatchpointFiles {
+	if ct.enableGeneratingCatchpointFiles && ct.filesPaused() {
+		// No data file is written, so that the catchpoint file of the round is skipped.
+		ct.log.Warnf("skipping the catchpoint data file of round %d, as writing catchpoint files is paused", dbRound)
+		atomic.StoreInt32(&ct.catchpointDataWriting, 0)
+	} else if ct.enableGeneratingCatchpointFiles {
 		// Generate the catchpoint file. This is done inline so that it will
 		// block any new accounts from being written. generateCatchpointData()
 		// expects that the accounts data would not be modified in the
@@ -227,6 +281,14 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 		}
 	}
 
+	if ct.buildAbsenceCommitments {
+		// Like the data file, the commitment is built inline, while the accounts are those of the round.
+		err := ct.buildAbsenceCommitment(ctx, dbRound)
+		if err != nil {
+			ct.log.Warnf("catchpointTracker: could not build the absence commitment of round %d: %v", dbRound, err)
+		}
+	}
+
 	return ct.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
 		cw, err := tx.MakeCatchpointWriter()
 		if err != nil {
@@ -243,6 +305,35 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 	})
 }
 
+// buildAbsenceCommitment commits to the addresses of all the accounts of the accounts round of a catchpoint, the
+// same accounts the balances trie of the catchpoint label commits to. The absence proofs of the accounts are built
+// from it until the next catchpoint, so that reading all the accounts happens once per catchpoint.
+func (ct *catchpointTracker) buildAbsenceCommitment(ctx context.Context, dbRound basics.Round) error {
+	var addrs []basics.Address
+	var rnd basics.Round
+	err := ct.dbs.SnapshotContext(ctx, func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err := tx.MakeAccountsReader()
+		if err != nil {
+			return err
+		}
+		addrs, rnd, err = ar.LookupAccountAddresses(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if rnd != dbRound {
+		return fmt.Errorf("the accounts are those of round %d", rnd)
+	}
+
+	commitment, err := ledgercore.MakeAbsenceCommitment(rnd, ledgercore.AbsenceProofScope{}, addrs)
+	if err != nil {
+		return err
+	}
+	ct.absenceCommitment.Store(commitment)
+	return nil
+}
+
 // Possibly finish generating first stage catchpoint db record and data file after
 // a crash.
 func (ct *catchpointTracker) finishFirstStageAfterCrash(dbRound basics.Round) error {
@@ -344,9 +435,11 @@ func (ct *catchpointTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Rou
 	ct.catchpointDataSlowWriting = make(chan struct{}, 1)
 	close(ct.catchpointDataSlowWriting)
 
+	ct.trieMu.Lock()
 	err = ct.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
 		return ct.initializeHashes(ctx, tx, dbRound)
 	})
+	ct.trieMu.Unlock()
 	if err != nil {
 		return err
 	}
@@ -362,7 +455,20 @@ func (ct *catchpointTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Rou
 		return
 	}
 
-	return ct.recoverFromCrash(dbRound)
+	err = ct.recoverFromCrash(dbRound)
+	if err != nil {
+		return
+	}
+
+	ct.trieOutOfSync.Store(false)
+	if ct.catchpointEnabled() && ct.trieCommitWindow > 0 {
+		// the commit handing over its trie update waits while the queue is full, so that the
+		// queued updates and the one being written never exceed the window.
+		ct.trieCommits = make(chan *deferredCommitContext, ct.trieCommitWindow-1)
+		ct.trieCommitterDone = make(chan struct{})
+		go ct.trieCommitter(ct.trieCommits, ct.trieCommitterDone)
+	}
+	return nil
 }
 
 // newBlock informs the tracker of a new block from round
@@ -487,6 +593,21 @@ func (ct *catchpointTracker) produceCommittingTask(committedRound basics.Round,
 // prepareCommit, commitRound and postCommit are called when it is time to commit tracker's data.
 // If an error returned the process is aborted.
 func (ct *catchpointTracker) prepareCommit(dcc *deferredCommitContext) error {
+	if ct.trieCommits != nil {
+		// the trie update of the commits reaching a catchpoint round is written along their accounts,
+		// and the catchpoint data generated afterward expects the trie to be up to date.
+		dcc.deferredTrieUpdate = !dcc.catchpointFirstStage && len(ct.calculateCatchpointRounds(&dcc.deferredCommitRange)) == 0
+		if !dcc.deferredTrieUpdate || ct.trieOutOfSync.Load() {
+			ct.triePending.Wait()
+		}
+		if ct.trieOutOfSync.Load() {
+			err := ct.rebuildTrie(dcc.oldBase)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	ct.catchpointsMu.RLock()
 	defer ct.catchpointsMu.RUnlock()
 
@@ -521,6 +642,16 @@ func (ct *catchpointTracker) commitRound(ctx context.Context, tx trackerdb.Trans
 		return err
 	}
 
+	if dcc.deferredTrieUpdate {
+		// the trie and its round are updated by the trieCommitter once this transaction is committed.
+		return ct.commitCatchpointState(ctx, cw, dcc)
+	}
+
+	// prepareCommit waited for the deferred trie updates to be written, so that the trieCommitter, which takes
+	// the lock before its own transaction, is idle while this transaction holds it.
+	ct.trieMu.Lock()
+	defer ct.trieMu.Unlock()
+
 	if ct.catchpointEnabled() {
 		var mc trackerdb.MerkleCommitter
 		mc, err = tx.MakeMerkleCommitter(false)
@@ -561,6 +692,11 @@ func (ct *catchpointTracker) commitRound(ctx context.Context, tx trackerdb.Trans
 		return err
 	}
 
+	return ct.commitCatchpointState(ctx, cw, dcc)
+}
+
+// commitCatchpointState writes the catchpoint state of the committed rounds range.
+func (ct *catchpointTracker) commitCatchpointState(ctx context.Context, cw trackerdb.CatchpointWriter, dcc *deferredCommitContext) (err error) {
 	if dcc.catchpointFirstStage {
 		err = cw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateWritingFirstStageInfo, 1)
 		if err != nil {
@@ -584,11 +720,17 @@ func (ct *catchpointTracker) commitRound(ctx context.Context, tx trackerdb.Trans
 }
 
 func (ct *catchpointTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
-	if ct.balancesTrie != nil {
-		_, err := ct.balancesTrie.Evict(false)
-		if err != nil {
-			ct.log.Warnf("merkle trie failed to evict: %v", err)
+	// a deferred trie update is evicted by the trieCommitter once written, and the trie is only read
+	// under its lock, which the trieCommitter holds while it updates it.
+	if !dcc.deferredTrieUpdate {
+		ct.trieMu.Lock()
+		if ct.balancesTrie != nil {
+			_, err := ct.balancesTrie.Evict(false)
+			if err != nil {
+				ct.log.Warnf("merkle trie failed to evict: %v", err)
+			}
 		}
+		ct.trieMu.Unlock()
 	}
 
 	ct.catchpointsMu.Lock()
@@ -747,7 +889,7 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 	ct.lastCatchpointLabel = label
 	ct.catchpointsMu.Unlock()
 
-	if !ct.enableGeneratingCatchpointFiles {
+	if !ct.enableGeneratingCatchpointFiles || ct.filesPaused() {
 		return nil
 	}
 
@@ -898,6 +1040,11 @@ func (ct *catchpointTracker) pruneFirstStageRecordsData(ctx context.Context, max
 }
 
 func (ct *catchpointTracker) postCommitUnlocked(ctx context.Context, dcc *deferredCommitContext) {
+	if dcc.deferredTrieUpdate {
+		ct.triePending.Add(1)
+		ct.trieCommits <- dcc
+	}
+
 	if dcc.catchpointFirstStage {
 		err := ct.finishFirstStage(ctx, dcc.newBase(), dcc.updatingBalancesDuration)
 		if err != nil {
@@ -947,6 +1094,12 @@ func (ct *catchpointTracker) handleUnorderedCommitOrError(dcc *deferredCommitCon
 // be called even if loadFromDisk() is not called or does
 // not succeed.
 func (ct *catchpointTracker) close() {
+	if ct.trieCommits != nil {
+		// the queued trie updates are written before returning, sparing a rebuild of the trie on the next startup.
+		close(ct.trieCommits)
+		<-ct.trieCommitterDone
+		ct.trieCommits = nil
+	}
 }
 
 // accountsUpdateBalances applies the given compactAccountDeltas to the merkle trie
@@ -1214,17 +1367,19 @@ func (ct *catchpointTracker) recordFirstStageInfo(ctx context.Context, tx tracke
 	if err != nil {
 		return err
 	}
+	ct.trieMu.Lock()
 	if ct.balancesTrie == nil {
 		trie, trieErr := merkletrie.MakeTrie(mc, trackerdb.TrieMemoryConfig)
 		if trieErr != nil {
+			ct.trieMu.Unlock()
 			return trieErr
 		}
 		ct.balancesTrie = trie
 	} else {
 		ct.balancesTrie.SetCommitter(mc)
 	}
-
 	trieBalancesHash, err := ct.balancesTrie.RootHash()
+	ct.trieMu.Unlock()
 	if err != nil {
 		return err
 	}
@@ -1224,6 +1224,67 @@ func TestCatchpointFirstStagePersistence(t *testing.T) {
 	require.Zero(t, v)
 }
 
+// Test that archival ledgers build the absence commitment of all the accounts along the first stage of the
+// catchpoints.
+func TestCatchpointAbsenceCommitment(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testProtocolVersion :=
+		protocol.ConsensusVersion("test-protocol-TestCatchpointAbsenceCommitment")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.CatchpointLookback = 32
+	protoParams.EnableCatchpointsWithSPContexts = true
+	config.Consensus[testProtocolVersion] = protoParams
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, true)}
+
+	ml := makeMockLedgerForTracker(t, false, 1, testProtocolVersion, accts)
+	defer ml.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.CatchpointInterval = 4
+	cfg.CatchpointTracking = 2
+	cfg.MaxAcctLookback = 0
+	ct := newCatchpointTracker(
+		t, ml, cfg, filepath.Join(t.TempDir(), config.LedgerFilenamePrefix))
+	defer ct.close()
+	require.True(t, ct.buildAbsenceCommitments)
+	require.Nil(t, ct.absenceCommitment.Load())
+
+	firstStageRound := basics.Round(4)
+	for i := basics.Round(1); i <= firstStageRound; i++ {
+		blk := bookkeeping.Block{
+			BlockHeader: bookkeeping.BlockHeader{
+				Round: i,
+				UpgradeState: bookkeeping.UpgradeState{
+					CurrentProtocol: testProtocolVersion,
+				},
+			},
+		}
+		delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, 0, 0)
+
+		ml.addBlock(blockEntry{block: blk}, delta)
+	}
+	ml.trackers.committedUpTo(firstStageRound)
+	ml.trackers.waitAccountsWriting()
+
+	commitment := ct.absenceCommitment.Load()
+	require.NotNil(t, commitment)
+	require.Equal(t, firstStageRound, commitment.Round)
+	for addr := range accts[0] {
+		_, err := commitment.Prove(addr)
+		require.ErrorIs(t, err, ledgercore.ErrAddressCommitted)
+	}
+	absent := ledgertesting.RandomAddress()
+	proof, err := commitment.Prove(absent)
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(commitment.Root(), ledgercore.AbsenceProofScope{}, absent))
+}
+
 // Test that on startup the catchpoint tracker restarts catchpoint's second stage if
 // there is an unfinished catchpoint record in the database.
 func TestCatchpointSecondStagePersistence(t *testing.T) {
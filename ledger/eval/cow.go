@@ -224,7 +224,9 @@ func (cb *roundCowState) checkDup(firstValid, lastValid basics.Round, txid trans
 	if cb.proto.SupportTransactionLeases && (txl.Lease != [32]byte{}) {
 		expires, ok := cb.mods.Txleases[txl]
 		if ok && cb.mods.Hdr.Round <= expires {
-			return ledgercore.MakeLeaseInLedgerError(txid, txl, true)
+			err := ledgercore.MakeLeaseInLedgerError(txid, txl, true)
+			err.Round, err.Expires = cb.mods.Hdr.Round, expires
+			return err
 		}
 	}
 
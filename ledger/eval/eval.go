@@ -536,7 +536,7 @@ func (cs *roundCowState) Move(from basics.Address, to basics.Address, amt basics
 		var overflowed bool
 		fromBalNew.MicroAlgos, overflowed = basics.OSubA(fromBalNew.MicroAlgos, amt)
 		if overflowed {
-			return fmt.Errorf("overspend (account %v, data %+v, tried to spend %v)", from, fromBal, amt)
+			return &ledgercore.OverspendError{Account: from, Data: fromBal, Amount: amt}
 		}
 		err = cs.putAccount(from, fromBalNew)
 		if err != nil {
@@ -592,7 +592,9 @@ type BlockEvaluator struct {
 
 	block        bookkeeping.Block
 	blockTxBytes int
-	specials     transactions.SpecialAddresses
+	// blockTxnsByType counts the transactions of the types capped by MaxTxnsPerBlockByType
+	blockTxnsByType map[protocol.TxType]int
+	specials        transactions.SpecialAddresses
 
 	blockGenerated bool // prevent repeated GenerateBlock calls
 
@@ -836,6 +838,36 @@ func (eval *BlockEvaluator) Round() basics.Round {
 // simulate the effect of putting pending transactions in multiple blocks.
 func (eval *BlockEvaluator) ResetTxnBytes() {
 	eval.blockTxBytes = 0
+	eval.blockTxnsByType = nil
+}
+
+// PendingAccount returns the state of an account after the transactions added to the block so far, with its
+// rewards updated to the round of the block, along with its holdings of the given assets, and of the assets the
+// transactions added opted it into. The transaction pool uses it to project the state of the accounts after
+// their pending transactions.
+func (eval *BlockEvaluator) PendingAccount(addr basics.Address, assets []basics.AssetIndex) (ledgercore.AccountData, map[basics.AssetIndex]basics.AssetHolding, error) {
+	data, err := eval.state.lookup(addr)
+	if err != nil {
+		return ledgercore.AccountData{}, nil, err
+	}
+	data = data.WithUpdatedRewards(eval.proto, eval.state.rewardsLevel())
+
+	for _, record := range eval.state.mods.Accts.GetAllAssetResources() {
+		if record.Addr == addr && record.Holding.Holding != nil {
+			assets = append(assets, record.Aidx)
+		}
+	}
+	holdings := make(map[basics.AssetIndex]basics.AssetHolding, len(assets))
+	for _, aidx := range assets {
+		holding, ok, err := eval.state.lookupAssetHolding(addr, aidx, false)
+		if err != nil {
+			return ledgercore.AccountData{}, nil, err
+		}
+		if ok && !holding.Deleted && holding.Holding != nil {
+			holdings[aidx] = *holding.Holding
+		}
+	}
+	return data, holdings, nil
 }
 
 // TestTransactionGroup performs basic duplicate detection and well-formedness checks
@@ -955,6 +987,14 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 	var group transactions.TxGroup
 	var groupTxBytes int
 
+	var groupTxnsByType map[protocol.TxType]int
+	if eval.validate && len(eval.proto.MaxTxnsPerBlockByType) > 0 {
+		groupTxnsByType, err = eval.checkTxnTypeCaps(txgroup)
+		if err != nil {
+			return err
+		}
+	}
+
 	cow := eval.state.child(len(txgroup))
 	defer cow.recycle()
 
@@ -1033,11 +1073,36 @@ func (eval *BlockEvaluator) TransactionGroup(txgroup []transactions.SignedTxnWit
 
 	eval.block.Payset = append(eval.block.Payset, txibs...)
 	eval.blockTxBytes += groupTxBytes
+	for txType, count := range groupTxnsByType {
+		if eval.blockTxnsByType == nil {
+			eval.blockTxnsByType = make(map[protocol.TxType]int)
+		}
+		eval.blockTxnsByType[txType] += count
+	}
+	eval.state.mods.TxnBytes += groupTxBytes
 	cow.commitToParent()
 
 	return nil
 }
 
+// checkTxnTypeCaps counts the transactions of the group of each type capped by MaxTxnsPerBlockByType, returning
+// ErrNoSpace if the block has no room left for them.
+func (eval *BlockEvaluator) checkTxnTypeCaps(txgroup []transactions.SignedTxnWithAD) (map[protocol.TxType]int, error) {
+	groupTxnsByType := make(map[protocol.TxType]int)
+	for _, txad := range txgroup {
+		txType := txad.SignedTxn.Txn.Type
+		if _, capped := eval.proto.MaxTxnsPerBlockByType[txType]; capped {
+			groupTxnsByType[txType]++
+		}
+	}
+	for txType, count := range groupTxnsByType {
+		if eval.blockTxnsByType[txType]+count > eval.proto.MaxTxnsPerBlockByType[txType] {
+			return nil, ledgercore.ErrNoSpace
+		}
+	}
+	return groupTxnsByType, nil
+}
+
 // Check the minimum balance requirement for the modified accounts in `cow`.
 func (eval *BlockEvaluator) checkMinBalance(cow *roundCowState) error {
 	rewardlvl := cow.rewardsLevel()
@@ -1066,8 +1131,12 @@ func (eval *BlockEvaluator) checkMinBalance(cow *roundCowState) error {
 		dataNew := data.WithUpdatedRewards(eval.proto, rewardlvl)
 		effectiveMinBalance := dataNew.MinBalance(&eval.proto)
 		if dataNew.MicroAlgos.Raw < effectiveMinBalance.Raw {
-			return fmt.Errorf("account %v balance %d below min %d (%d assets)",
-				addr, dataNew.MicroAlgos.Raw, effectiveMinBalance.Raw, dataNew.TotalAssets)
+			return &ledgercore.MinBalanceError{
+				Account:     addr,
+				Balance:     dataNew.MicroAlgos.Raw,
+				MinBalance:  effectiveMinBalance.Raw,
+				TotalAssets: dataNew.TotalAssets,
+			}
 		}
 
 		// Check if we have exceeded the maximum minimum balance
@@ -198,6 +198,7 @@ func TestEvalAppAllocStateWithTxnGroup(t *testing.T) {
 	state := ad.AppParams[1001].GlobalState
 	require.Equal(t, basics.TealValue{Type: basics.TealBytesType, Bytes: string(addr[:])}, state["caller"])
 	require.Equal(t, basics.TealValue{Type: basics.TealBytesType, Bytes: string(addr[:])}, state["creator"])
+	require.Equal(t, eval.block.Payset[0].GetEncodedLength()+eval.block.Payset[1].GetEncodedLength(), deltas.TxnBytes)
 }
 
 // a couple trivial tests that don't need setup
@@ -232,6 +233,31 @@ func TestPrivateTransactionGroup(t *testing.T) {
 	require.Error(t, err) // too many
 }
 
+func TestCheckTxnTypeCaps(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	eval := BlockEvaluator{}
+	eval.proto = config.Consensus[protocol.ConsensusCurrentVersion]
+	eval.proto.MaxTxnsPerBlockByType = map[protocol.TxType]int{protocol.ApplicationCallTx: 2}
+	txn := func(txType protocol.TxType) transactions.SignedTxnWithAD {
+		return transactions.SignedTxnWithAD{SignedTxn: transactions.SignedTxn{Txn: transactions.Transaction{Type: txType}}}
+	}
+
+	counts, err := eval.checkTxnTypeCaps([]transactions.SignedTxnWithAD{txn(protocol.PaymentTx), txn(protocol.ApplicationCallTx)})
+	require.NoError(t, err)
+	require.Equal(t, map[protocol.TxType]int{protocol.ApplicationCallTx: 1}, counts)
+
+	eval.blockTxnsByType = map[protocol.TxType]int{protocol.ApplicationCallTx: 1}
+	_, err = eval.checkTxnTypeCaps([]transactions.SignedTxnWithAD{txn(protocol.ApplicationCallTx), txn(protocol.ApplicationCallTx)})
+	require.ErrorIs(t, err, ledgercore.ErrNoSpace)
+
+	// the next simulated block of the transaction pool starts with no transactions
+	eval.ResetTxnBytes()
+	_, err = eval.checkTxnTypeCaps([]transactions.SignedTxnWithAD{txn(protocol.ApplicationCallTx), txn(protocol.ApplicationCallTx)})
+	require.NoError(t, err)
+}
+
 func TestTransactionGroupWithTracer(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -21,6 +21,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -58,6 +59,9 @@ type Ledger struct {
 	// persistent storage
 	blockQ *blockQueue
 
+	// vault is the block vault that old blocks are moved to, if configured.
+	vault *blockVault
+
 	log logging.Logger
 
 	// archival determines whether the ledger keeps all blocks forever
@@ -87,12 +91,20 @@ type Ledger struct {
 	notifier       blockNotifier
 	metrics        metricsTracker
 	spVerification spVerificationTracker
+	rekeys         rekeyTracker
+	totals         totalsTracker
 
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
 
+	// optimizer runs the background optimizations of the accounts database.
+	optimizer *accountsDBOptimizer
+
 	headerCache blockHeaderCache
 
+	// absenceCommitments keeps the latest commitment the absence proofs of the holders of a creatable were built from.
+	absenceCommitments absenceCommitmentCache
+
 	// verifiedTxnCache holds all the verified transactions state
 	verifiedTxnCache verify.VerifiedTransactionCache
 
@@ -101,6 +113,9 @@ type Ledger struct {
 	dbPathPrefix string
 
 	tracer logic.EvalTracer
+
+	// blockIngestionHalted is set while new blocks are rejected, see HaltBlockIngestion.
+	blockIngestionHalted atomic.Bool
 }
 
 // OpenLedger creates a Ledger object, using SQLite database filenames
@@ -172,11 +187,19 @@ func OpenLedger(
 		return nil, err
 	}
 
+	l.vault, err = makeBlockVault(l, cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	err = l.reloadLedger()
 	if err != nil {
 		return nil, err
 	}
 
+	if l.vault != nil {
+		l.vault.start()
+	}
 	return l, nil
 }
 
@@ -192,7 +215,8 @@ func (l *Ledger) reloadLedger() error {
 	l.trackerMu.Lock()
 	defer l.trackerMu.Unlock()
 
-	// close the trackers.
+	// close the trackers, and the optimizer which writes to their database.
+	l.optimizer.stop()
 	l.trackers.close()
 
 	// init block queue
@@ -209,6 +233,17 @@ func (l *Ledger) reloadLedger() error {
 		return err
 	}
 
+	// index the asset holders and application opt-ins, rebuilding the index after a catchpoint was applied, or
+	// drop the index so that it is not maintained
+	built, err := l.trackerDBs.SetCreatableHolderIndex(context.Background(), l.cfg.EnableCreatableHolderIndex)
+	if err != nil {
+		err = fmt.Errorf("reloadLedger.SetCreatableHolderIndex %w", err)
+		return err
+	}
+	if built {
+		l.log.Infof("reloadLedger: indexed the asset holders and application opt-ins")
+	}
+
 	// set account updates tracker as a driver to calculate tracker db round and committing offsets
 	trackers := []ledgerTracker{
 		&l.accts,          // update the balances
@@ -219,11 +254,15 @@ func (l *Ledger) reloadLedger() error {
 		&l.notifier,       // send OnNewBlocks to subscribers
 		&l.metrics,        // provides metrics reporting support
 		&l.spVerification, // provides state proof verification support
+		&l.rekeys,         // keeps the history of the rekeys on archival ledgers
+		&l.totals,         // keeps the history of the totals on archival ledgers
 	}
 
 	l.accts.initialize(l.cfg)
 	l.acctsOnline.initialize(l.cfg)
 	l.catchpoint.initialize(l.cfg, l.dbPathPrefix)
+	l.rekeys.initialize(l.archival)
+	l.totals.initialize(l.archival)
 
 	err = l.trackers.initialize(l, trackers, l.cfg)
 	if err != nil {
@@ -237,7 +276,17 @@ func (l *Ledger) reloadLedger() error {
 	}
 
 	// post-init actions
-	if trackerDBInitParams.VacuumOnStartup || l.cfg.OptimizeAccountsDatabaseOnStartup {
+	vacuumOnStartup := trackerDBInitParams.VacuumOnStartup
+	if l.cfg.OptimizeAccountsDatabaseOnStartup && !vacuumOnStartup {
+		// the unused pages of a database created by an older version can only be released in the background
+		// once a full vacuum switched it to the incremental auto_vacuum mode.
+		incremental, err := l.trackerDBs.IncrementalVacuumEnabled(context.Background())
+		if err != nil {
+			return err
+		}
+		vacuumOnStartup = !incremental
+	}
+	if vacuumOnStartup {
 		err = l.accts.vacuumDatabase(context.Background())
 		if err != nil {
 			return err
@@ -249,6 +298,9 @@ func (l *Ledger) reloadLedger() error {
 	if err != nil {
 		return err
 	}
+
+	l.optimizer = makeAccountsDBOptimizer(l.trackerDBs, l.log, l.trackers.commitsIdle, l.cfg.AccountsDatabaseOptimizeInterval, l.cfg.AccountsDatabaseOptimizeWindow, l.cfg.AccountsDatabaseIncrementalVacuumPages)
+	l.optimizer.start(l.cfg.OptimizeAccountsDatabaseOnStartup)
 	return nil
 }
 
@@ -393,6 +445,11 @@ func initBlocksDB(tx *sql.Tx, l *Ledger, initBlocks []bookkeeping.Block, isArchi
 // Close reclaims resources used by the ledger (namely, the database connection
 // and goroutines used by trackers).
 func (l *Ledger) Close() {
+	// the block vault moves blocks out of the blocks database in the background.
+	if l.vault != nil {
+		l.vault.stop()
+	}
+
 	// we shut the blockqueue first, since it's sync goroutine dispatches calls
 	// back to the trackers.
 	if l.blockQ != nil {
@@ -403,7 +460,8 @@ func (l *Ledger) Close() {
 	l.trackerMu.Lock()
 	defer l.trackerMu.Unlock()
 
-	// then, we shut down the trackers and their corresponding goroutines.
+	// then, we shut down the optimizer, the trackers and their corresponding goroutines.
+	l.optimizer.stop()
 	l.trackers.close()
 
 	// last, we close the underlying database connections.
@@ -411,6 +469,18 @@ func (l *Ledger) Close() {
 	l.trackerDBs.Close()
 }
 
+// CloseGracefully closes the ledger like Close, but first lets the account updates being written to the
+// database, or scheduled to be, commit instead of aborting them, so that no write-ahead log is left to
+// recover when the ledger is reopened.
+func (l *Ledger) CloseGracefully() {
+	// stop adding blocks, which schedules new account updates.
+	if l.blockQ != nil {
+		l.blockQ.stop()
+	}
+	l.trackers.waitAccountsWriting()
+	l.Close()
+}
+
 // RegisterBlockListeners registers listeners that will be called when a
 // new block is added to the ledger.
 func (l *Ledger) RegisterBlockListeners(listeners []ledgercore.BlockListener) {
@@ -550,6 +620,107 @@ func (l *Ledger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.
 	return ledgercore.AssetResource{AssetParams: r.AssetParams, AssetHolding: r.AssetHolding}, err
 }
 
+// LookupAssets loads up to limit asset resources of an account, or only the assets it created when createdOnly is set,
+// for the latest round. Only assets with an ID greater than assetIDGT are returned, ordered by their ID.
+func (l *Ledger) LookupAssets(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64, createdOnly bool) ([]ledgercore.AssetResourceWithID, basics.Round, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	resources, rnd, err := l.accts.lookupLimitedResources(addr, basics.CreatableIndex(assetIDGT), limit, basics.AssetCreatable, createdOnly)
+	if err != nil {
+		return nil, basics.Round(0), err
+	}
+	assets := make([]ledgercore.AssetResourceWithID, len(resources))
+	for i, r := range resources {
+		assets[i] = ledgercore.AssetResourceWithID{
+			AssetResource: ledgercore.AssetResource{AssetParams: r.resource.AssetParams, AssetHolding: r.resource.AssetHolding},
+			AssetID:       basics.AssetIndex(r.cidx),
+		}
+	}
+	return assets, rnd, nil
+}
+
+// LookupApplications loads up to limit application resources of an account, or only the applications it created when
+// createdOnly is set, for the latest round. Only applications with an ID greater than appIDGT are returned, ordered by
+// their ID.
+func (l *Ledger) LookupApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64, createdOnly bool) ([]ledgercore.AppResourceWithID, basics.Round, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	resources, rnd, err := l.accts.lookupLimitedResources(addr, basics.CreatableIndex(appIDGT), limit, basics.AppCreatable, createdOnly)
+	if err != nil {
+		return nil, basics.Round(0), err
+	}
+	apps := make([]ledgercore.AppResourceWithID, len(resources))
+	for i, r := range resources {
+		apps[i] = ledgercore.AppResourceWithID{
+			AppResource: ledgercore.AppResource{AppParams: r.resource.AppParams, AppLocalState: r.resource.AppLocalState},
+			AppID:       basics.AppIndex(r.cidx),
+		}
+	}
+	return apps, rnd, nil
+}
+
+// LookupAssetHolders loads up to limit holdings of an asset, for the latest round. Only the holdings of accounts with an
+// address greater than after are returned, ordered by address. It returns ErrNoCreatableHolderIndex unless
+// EnableCreatableHolderIndex is set.
+func (l *Ledger) LookupAssetHolders(aidx basics.AssetIndex, after *basics.Address, limit uint64) ([]ledgercore.AssetHoldingWithAddress, basics.Round, error) {
+	if !l.cfg.EnableCreatableHolderIndex {
+		return nil, basics.Round(0), ErrNoCreatableHolderIndex
+	}
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	resources, rnd, err := l.accts.lookupCreatableHolders(basics.CreatableIndex(aidx), basics.AssetCreatable, after, limit)
+	if err != nil {
+		return nil, basics.Round(0), err
+	}
+	holdings := make([]ledgercore.AssetHoldingWithAddress, len(resources))
+	for i, r := range resources {
+		holdings[i] = ledgercore.AssetHoldingWithAddress{AssetHolding: *r.resource.AssetHolding, Address: r.addr}
+	}
+	return holdings, rnd, nil
+}
+
+// LookupAppOptIns loads up to limit local states of the accounts opted in an application, for the latest round. Only
+// the local states of accounts with an address greater than after are returned, ordered by address. It returns
+// ErrNoCreatableHolderIndex unless EnableCreatableHolderIndex is set.
+func (l *Ledger) LookupAppOptIns(appIdx basics.AppIndex, after *basics.Address, limit uint64) ([]ledgercore.AppLocalStateWithAddress, basics.Round, error) {
+	if !l.cfg.EnableCreatableHolderIndex {
+		return nil, basics.Round(0), ErrNoCreatableHolderIndex
+	}
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	resources, rnd, err := l.accts.lookupCreatableHolders(basics.CreatableIndex(appIdx), basics.AppCreatable, after, limit)
+	if err != nil {
+		return nil, basics.Round(0), err
+	}
+	localStates := make([]ledgercore.AppLocalStateWithAddress, len(resources))
+	for i, r := range resources {
+		localStates[i] = ledgercore.AppLocalStateWithAddress{AppLocalState: *r.resource.AppLocalState, Address: r.addr}
+	}
+	return localStates, rnd, nil
+}
+
+// LookupRekeyHistory returns the changes of the authorizing address of an account, ordered by round, along with the
+// round past which the history is kept: the rekeys of the earlier rounds are unknown. It returns ErrNoRekeyHistory
+// unless the ledger is archival.
+func (l *Ledger) LookupRekeyHistory(addr basics.Address) ([]ledgercore.RekeyEvent, basics.Round, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.rekeys.lookupRekeys(addr)
+}
+
+// LookupTotalsHistory returns the totals of the rounds from minRound to maxRound, one every interval rounds starting
+// with minRound, ordered by round and up to limit of them, along with the round past which the history is kept: the
+// totals of the earlier rounds are unknown. It returns ErrNoTotalsHistory unless the ledger is archival.
+func (l *Ledger) LookupTotalsHistory(minRound, maxRound basics.Round, interval uint64, limit uint64) ([]ledgercore.RoundTotals, basics.Round, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.totals.lookupTotals(minRound, maxRound, interval, limit)
+}
+
 // lookupResource loads a resource that matches the request parameters from the accounts update
 func (l *Ledger) lookupResource(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.AccountResource, error) {
 	l.trackerMu.RLock()
@@ -703,6 +874,10 @@ func (l *Ledger) AddBlock(blk bookkeeping.Block, cert agreement.Certificate) err
 // the block has previously been validated.  Otherwise, AddValidatedBlock
 // behaves like AddBlock.
 func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) error {
+	if l.blockIngestionHalted.Load() {
+		return ledgercore.ErrBlockIngestionHalted
+	}
+
 	// Grab the tracker lock first, to ensure newBlock() is notified before committedUpTo().
 	l.trackerMu.Lock()
 	defer l.trackerMu.Unlock()
@@ -718,6 +893,49 @@ func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.
 	return nil
 }
 
+// HaltBlockIngestion stops, or resumes, adding new blocks to the ledger. While halted, adding a block
+// returns ErrBlockIngestionHalted, and the blocks already added are still committed.
+func (l *Ledger) HaltBlockIngestion(halted bool) {
+	l.blockIngestionHalted.Store(halted)
+}
+
+// Snapshot writes a consistent copy of the block and tracker databases of the ledger to the database files of
+// dbPathPrefix, which a ledger opened with that prefix would use. Only the commits of the trackers are paused while
+// the copy is taken: blocks are still added and the ledger read. It returns the latest round of the blocks database
+// when the copy of the blocks started, which the copied ledger holds at least.
+func (l *Ledger) Snapshot(ctx context.Context, dbPathPrefix string) (basics.Round, error) {
+	l.blockQ.waitCommit(l.blockQ.latest())
+
+	// the tracker database stays at its round while the commits are paused, and the blocks following it, which
+	// the trackers need, are kept in the blocks database: copying the trackers first leaves them behind the
+	// blocks, which opening the copied ledger replays.
+	l.trackers.pauseCommits()
+	defer l.trackers.resumeCommits()
+
+	trackerDB, err := db.MakeAccessor(l.dbPathPrefix+".tracker.sqlite", true, false)
+	if err != nil {
+		return 0, fmt.Errorf("cannot open the tracker database: %w", err)
+	}
+	defer trackerDB.Close()
+	err = trackerDB.Backup(ctx, dbPathPrefix+".tracker.sqlite")
+	if err != nil {
+		return 0, fmt.Errorf("cannot copy the tracker database: %w", err)
+	}
+
+	latest, _ := l.blockQ.latestCommitted()
+	err = l.blockDBs.Rdb.Backup(ctx, dbPathPrefix+".block.sqlite")
+	if err != nil {
+		return 0, fmt.Errorf("cannot copy the blocks database: %w", err)
+	}
+	return latest, nil
+}
+
+// PauseCatchpointFiles pauses, or resumes, writing catchpoint files. The catchpoint labels are still
+// computed, while the catchpoint files of the rounds reached while paused are skipped.
+func (l *Ledger) PauseCatchpointFiles(paused bool) {
+	l.catchpoint.pauseFiles(paused)
+}
+
 // WaitForCommit waits until block r (and block before r) are durably
 // written to disk.
 func (l *Ledger) WaitForCommit(r basics.Round) {
@@ -823,6 +1041,21 @@ func (l *Ledger) IsWritingCatchpointDataFile() bool {
 	return l.catchpoint.isWritingCatchpointDataFile()
 }
 
+// OptimizeAccountsDatabase requests a background optimization of the accounts database, which starts as soon as
+// the ledger commits are idle. It fails if an optimization is already in progress.
+func (l *Ledger) OptimizeAccountsDatabase() error {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.optimizer.request()
+}
+
+// AccountsDatabaseOptimizeStatus returns the status of the background optimizations of the accounts database.
+func (l *Ledger) AccountsDatabaseOptimizeStatus() AccountsDatabaseOptimizeStatus {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.optimizer.getStatus()
+}
+
 // VerifiedTransactionCache returns the verify.VerifiedTransactionCache
 func (l *Ledger) VerifiedTransactionCache() verify.VerifiedTransactionCache {
 	return l.verifiedTxnCache
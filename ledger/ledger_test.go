@@ -1548,6 +1548,155 @@ func TestLedgerReload(t *testing.T) {
 	ledgertesting.WithAndWithoutLRUCache(t, cfg, testLedgerReload)
 }
 
+// TestLedgerCloseGracefully checks that the blocks added before closing the ledger gracefully are
+// found once it is reopened.
+func TestLedgerCloseGracefully(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dbName := filepath.Join(t.TempDir(), "ledger")
+	genesisInitState := getInitState()
+	const inMem = false
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+
+	blk := genesisInitState.Block
+	for i := 0; i < 32; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += int64(crypto.RandUint64() % 100 * 1000)
+		err = l.AddBlock(blk, agreement.Certificate{})
+		require.NoError(t, err)
+	}
+	l.CloseGracefully()
+
+	l, err = OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+	require.Equal(t, blk.Round(), l.Latest())
+	latestCommitted, _ := l.LatestCommitted()
+	require.Equal(t, blk.Round(), latestCommitted)
+}
+
+// TestLedgerSnapshot checks that a ledger opened from a snapshot holds the blocks and the accounts of the
+// ledger it was taken from.
+func TestLedgerSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dbName := filepath.Join(t.TempDir(), "ledger")
+	genesisInitState := getInitState()
+	const inMem = false
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	blk := genesisInitState.Block
+	for i := 0; i < 32; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += int64(crypto.RandUint64() % 100 * 1000)
+		err = l.AddBlock(blk, agreement.Certificate{})
+		require.NoError(t, err)
+	}
+
+	snapshotName := filepath.Join(t.TempDir(), "ledger")
+	round, err := l.Snapshot(context.Background(), snapshotName)
+	require.NoError(t, err)
+	require.Equal(t, blk.Round(), round)
+
+	_, err = l.Snapshot(context.Background(), snapshotName)
+	require.Error(t, err)
+
+	snapshot, err := OpenLedger(log, snapshotName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer snapshot.Close()
+	require.Equal(t, blk.Round(), snapshot.Latest())
+	hdr, err := snapshot.BlockHdr(blk.Round())
+	require.NoError(t, err)
+	require.Equal(t, blk.BlockHeader, hdr)
+	for addr := range genesisInitState.Accounts {
+		expected, _, _, err := l.LookupLatest(addr)
+		require.NoError(t, err)
+		data, _, _, err := snapshot.LookupLatest(addr)
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+	}
+}
+
+// TestLedgerPauseCommits checks that the tracker database stays at its round while the commits of the trackers
+// are paused, while blocks are still added, and that the commits resume.
+func TestLedgerPauseCommits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dbName := filepath.Join(t.TempDir(), "ledger")
+	genesisInitState := getInitState()
+	const inMem = false
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	addBlock := func(blk *bookkeeping.Block) {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += int64(crypto.RandUint64() % 100 * 1000)
+		l.trackers.mu.Lock()
+		l.trackers.lastFlushTime = time.Time{}
+		l.trackers.mu.Unlock()
+		err := l.AddBlock(*blk, agreement.Certificate{})
+		require.NoError(t, err)
+		l.WaitForCommit(blk.Round())
+	}
+
+	l.trackers.pauseCommits()
+	dbRound := l.trackers.getDbRound()
+	blk := genesisInitState.Block
+	for i := 0; i < 32; i++ {
+		addBlock(&blk)
+	}
+	require.Equal(t, blk.Round(), l.Latest())
+	l.trackers.waitAccountsWriting()
+	require.Equal(t, dbRound, l.trackers.getDbRound())
+
+	l.trackers.resumeCommits()
+	started := time.Now()
+	for l.trackers.getDbRound() == dbRound {
+		require.Less(t, time.Since(started), 5*time.Second)
+		time.Sleep(50 * time.Millisecond)
+		addBlock(&blk)
+	}
+}
+
+// TestLedgerHaltBlockIngestion checks that no block is added while block ingestion is halted.
+func TestLedgerHaltBlockIngestion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState := getInitState()
+	const inMem = true
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(log, t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	blk := genesisInitState.Block
+	blk.BlockHeader.Round++
+	l.HaltBlockIngestion(true)
+	err = l.AddBlock(blk, agreement.Certificate{})
+	require.ErrorIs(t, err, ledgercore.ErrBlockIngestionHalted)
+	require.Equal(t, basics.Round(0), l.Latest())
+
+	l.HaltBlockIngestion(false)
+	err = l.AddBlock(blk, agreement.Certificate{})
+	require.NoError(t, err)
+	require.Equal(t, blk.Round(), l.Latest())
+}
+
 // TestGetLastCatchpointLabel tests ledger.GetLastCatchpointLabel is returning the correct value.
 func TestGetLastCatchpointLabel(t *testing.T) {
 	partitiontest.PartitionTest(t)
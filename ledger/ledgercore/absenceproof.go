@@ -0,0 +1,207 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrAddressCommitted is returned when the absence of an address is to be proven, but the address is part of the
+// committed accounts.
+var ErrAddressCommitted = errors.New("the address is part of the committed accounts")
+
+// AbsenceProofScope selects the accounts an absence commitment is made of: all the accounts of the ledger, or the
+// accounts holding an asset or opted in an application.
+//
+//msgp:ignore AbsenceProofScope
+type AbsenceProofScope struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Creatable is the asset or the application the accounts hold, zero for all the accounts.
+	Creatable     basics.CreatableIndex `codec:"cidx"`
+	CreatableType basics.CreatableType  `codec:"ctype"`
+}
+
+// AbsenceProofLeaf is a leaf of an absence commitment, enclosing the address whose absence is proven.
+//
+//msgp:ignore AbsenceProofLeaf
+type AbsenceProofLeaf struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Index uint64 `codec:"idx"`
+
+	// Address is the committed address, unset for the bounds enclosing all the addresses of the commitment.
+	Address *basics.Address `codec:"addr"`
+}
+
+// AbsenceProof proves that an address is not part of the accounts of an absence commitment, by revealing the two
+// adjacent leaves of the commitment enclosing the address.
+//
+//msgp:ignore AbsenceProof
+type AbsenceProof struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Round basics.Round         `codec:"rnd"`
+	Scope AbsenceProofScope    `codec:"scope"`
+	Root  crypto.GenericDigest `codec:"root"`
+	Lower AbsenceProofLeaf     `codec:"lower"`
+	Upper AbsenceProofLeaf     `codec:"upper"`
+	Proof merklearray.Proof    `codec:"proof"`
+}
+
+// The leaves of an absence commitment are the addresses of the accounts in increasing order, enclosed by a lower
+// and an upper bound, so that the absence of any other address is proven by the two adjacent leaves around it.
+const (
+	absenceLowerBound byte = iota
+	absenceAddress
+	absenceUpperBound
+)
+
+type absenceLeaf struct {
+	scope AbsenceProofScope
+	kind  byte
+	addr  basics.Address
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (l absenceLeaf) ToBeHashed() (protocol.HashID, []byte) {
+	buf := make([]byte, 0, 1+8+8+len(l.addr))
+	buf = append(buf, l.kind)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(l.scope.CreatableType))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(l.scope.Creatable))
+	buf = append(buf, l.addr[:]...)
+	return protocol.AbsenceProofLeaf, buf
+}
+
+// absenceLeafArray is the merklearray.Array of the leaves of an absence commitment.
+type absenceLeafArray struct {
+	scope AbsenceProofScope
+	addrs []basics.Address
+}
+
+func (a absenceLeafArray) Length() uint64 {
+	return uint64(len(a.addrs)) + 2
+}
+
+func (a absenceLeafArray) Marshal(pos uint64) (crypto.Hashable, error) {
+	switch {
+	case pos == 0:
+		return absenceLeaf{scope: a.scope, kind: absenceLowerBound}, nil
+	case pos <= uint64(len(a.addrs)):
+		return absenceLeaf{scope: a.scope, kind: absenceAddress, addr: a.addrs[pos-1]}, nil
+	case pos == uint64(len(a.addrs))+1:
+		return absenceLeaf{scope: a.scope, kind: absenceUpperBound}, nil
+	}
+	return nil, fmt.Errorf("absence commitment leaf %d out of bounds", pos)
+}
+
+// AbsenceCommitment is a merkle commitment to the addresses of the accounts of a round, ordered by address, which
+// proves the absence of the other addresses.
+//
+// The commitment root is not part of the consensus state: the verifiers of the proofs trust the node reporting it.
+type AbsenceCommitment struct {
+	Round basics.Round
+	Scope AbsenceProofScope
+
+	leaves absenceLeafArray
+	tree   *merklearray.Tree
+}
+
+// MakeAbsenceCommitment builds the absence commitment to the given addresses, which are sorted in place.
+func MakeAbsenceCommitment(rnd basics.Round, scope AbsenceProofScope, addrs []basics.Address) (*AbsenceCommitment, error) {
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	leaves := absenceLeafArray{scope: scope, addrs: addrs}
+	tree, err := merklearray.Build(leaves, crypto.HashFactory{HashType: crypto.Sha512_256})
+	if err != nil {
+		return nil, err
+	}
+	return &AbsenceCommitment{Round: rnd, Scope: scope, leaves: leaves, tree: tree}, nil
+}
+
+// Root returns the root of the commitment, which the absence proofs are verified against.
+func (c *AbsenceCommitment) Root() crypto.GenericDigest {
+	return c.tree.Root()
+}
+
+// Prove builds the proof of the absence of addr from the committed accounts. It returns ErrAddressCommitted if
+// addr is one of them.
+func (c *AbsenceCommitment) Prove(addr basics.Address) (AbsenceProof, error) {
+	addrs := c.leaves.addrs
+	i := sort.Search(len(addrs), func(i int) bool { return bytes.Compare(addrs[i][:], addr[:]) >= 0 })
+	if i < len(addrs) && addrs[i] == addr {
+		return AbsenceProof{}, ErrAddressCommitted
+	}
+
+	// addr sits between the leaves i and i+1, the leaf 0 being the lower bound
+	lower := AbsenceProofLeaf{Index: uint64(i)}
+	if i > 0 {
+		lower.Address = &addrs[i-1]
+	}
+	upper := AbsenceProofLeaf{Index: uint64(i) + 1}
+	if i < len(addrs) {
+		upper.Address = &addrs[i]
+	}
+	proof, err := c.tree.Prove([]uint64{lower.Index, upper.Index})
+	if err != nil {
+		return AbsenceProof{}, err
+	}
+	return AbsenceProof{
+		Round: c.Round,
+		Scope: c.Scope,
+		Root:  c.Root(),
+		Lower: lower,
+		Upper: upper,
+		Proof: *proof,
+	}, nil
+}
+
+// Verify checks that the proof shows the absence of addr from the accounts of the given scope committed to by root.
+func (p *AbsenceProof) Verify(root crypto.GenericDigest, scope AbsenceProofScope, addr basics.Address) error {
+	if !bytes.Equal(p.Root, root) || p.Scope != scope {
+		return errors.New("absence proof of another commitment")
+	}
+	if p.Upper.Index != p.Lower.Index+1 {
+		return fmt.Errorf("absence proof leaves %d and %d are not adjacent", p.Lower.Index, p.Upper.Index)
+	}
+
+	lower := absenceLeaf{scope: scope, kind: absenceLowerBound}
+	if p.Lower.Address != nil {
+		if bytes.Compare(p.Lower.Address[:], addr[:]) >= 0 {
+			return fmt.Errorf("absence proof lower leaf %v does not precede %v", *p.Lower.Address, addr)
+		}
+		lower.kind, lower.addr = absenceAddress, *p.Lower.Address
+	}
+	upper := absenceLeaf{scope: scope, kind: absenceUpperBound}
+	if p.Upper.Address != nil {
+		if bytes.Compare(p.Upper.Address[:], addr[:]) <= 0 {
+			return fmt.Errorf("absence proof upper leaf %v does not follow %v", *p.Upper.Address, addr)
+		}
+		upper.kind, upper.addr = absenceAddress, *p.Upper.Address
+	}
+
+	elems := map[uint64]crypto.Hashable{p.Lower.Index: lower, p.Upper.Index: upper}
+	return merklearray.Verify(root, elems, &p.Proof)
+}
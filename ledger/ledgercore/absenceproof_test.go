@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func randomAddress() (addr basics.Address) {
+	crypto.RandBytes(addr[:])
+	return
+}
+
+func TestAbsenceProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 2, 7, 64} {
+		addrs := make([]basics.Address, n)
+		committed := make(map[basics.Address]bool)
+		for i := range addrs {
+			addrs[i] = randomAddress()
+			committed[addrs[i]] = true
+		}
+		scope := AbsenceProofScope{Creatable: 1000, CreatableType: basics.AssetCreatable}
+		commitment, err := MakeAbsenceCommitment(10, scope, append([]basics.Address(nil), addrs...))
+		require.NoError(t, err)
+		root := commitment.Root()
+
+		// the committed addresses can't be proven absent
+		for _, addr := range addrs {
+			_, err := commitment.Prove(addr)
+			require.ErrorIs(t, err, ErrAddressCommitted)
+		}
+
+		// the addresses before, between and after the committed ones, including the bounds of the address space
+		sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+		absent := []basics.Address{{}, randomAddress()}
+		for _, addr := range addrs {
+			next := addr
+			next[len(next)-1]++
+			absent = append(absent, next)
+		}
+		var last basics.Address
+		for i := range last {
+			last[i] = 0xff
+		}
+		absent = append(absent, last)
+
+		for _, addr := range absent {
+			if committed[addr] {
+				continue
+			}
+			proof, err := commitment.Prove(addr)
+			require.NoError(t, err)
+			require.Equal(t, basics.Round(10), proof.Round)
+			require.NoError(t, proof.Verify(root, scope, addr))
+
+			// the proof survives its encoding
+			var decoded AbsenceProof
+			require.NoError(t, protocol.DecodeReflect(protocol.EncodeReflect(&proof), &decoded))
+			require.NoError(t, decoded.Verify(root, scope, addr))
+
+			// the proof does not hold for another scope, nor for the addresses outside of the leaves
+			otherScope := AbsenceProofScope{Creatable: 1000, CreatableType: basics.AppCreatable}
+			require.Error(t, proof.Verify(root, otherScope, addr))
+			if proof.Lower.Address != nil {
+				require.Error(t, proof.Verify(root, scope, *proof.Lower.Address))
+			}
+			if proof.Upper.Address != nil {
+				require.Error(t, proof.Verify(root, scope, *proof.Upper.Address))
+			}
+		}
+	}
+}
+
+func TestAbsenceProofForgery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addrs := make([]basics.Address, 16)
+	for i := range addrs {
+		addrs[i] = randomAddress()
+	}
+	commitment, err := MakeAbsenceCommitment(10, AbsenceProofScope{}, append([]basics.Address(nil), addrs...))
+	require.NoError(t, err)
+	root := commitment.Root()
+
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	between := addrs[3]
+	between[len(between)-1]++
+	proof, err := commitment.Prove(between)
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(root, AbsenceProofScope{}, between))
+
+	// dropping a leaf to hide the committed address in the gap fails
+	forged := proof
+	forged.Upper = AbsenceProofLeaf{Index: proof.Upper.Index + 1, Address: &addrs[4]}
+	require.Error(t, forged.Verify(root, AbsenceProofScope{}, addrs[3]))
+
+	// replacing a leaf by a bound fails
+	forged = proof
+	forged.Upper.Address = nil
+	require.Error(t, forged.Verify(root, AbsenceProofScope{}, addrs[len(addrs)-1]))
+
+	// the proof is bound to its root
+	other, err := MakeAbsenceCommitment(10, AbsenceProofScope{}, []basics.Address{randomAddress()})
+	require.NoError(t, err)
+	require.Error(t, proof.Verify(other.Root(), AbsenceProofScope{}, between))
+}
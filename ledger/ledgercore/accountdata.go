@@ -186,3 +186,11 @@ func (u AccountData) OnlineAccountData(proto config.ConsensusParams, rewardsLeve
 func (u *AccountData) NormalizedOnlineBalance(genesisProto config.ConsensusParams) uint64 {
 	return basics.NormalizedOnlineAccountBalance(u.Status, u.RewardsBase, u.MicroAlgos, genesisProto)
 }
+
+// RekeyEvent is a change of the address authorizing the transactions of an account, in the round it took effect
+// in. AuthAddr is zero when the control of the account returned to its own key, or when the account was closed.
+type RekeyEvent struct {
+	Address  basics.Address
+	Round    basics.Round
+	AuthAddr basics.Address
+}
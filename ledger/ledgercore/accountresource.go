@@ -40,6 +40,30 @@ type AppResource struct {
 	AppParams     *basics.AppParams
 }
 
+// AssetResourceWithID is an AssetResource along with the ID of the asset it describes
+type AssetResourceWithID struct {
+	AssetResource
+	AssetID basics.AssetIndex
+}
+
+// AppResourceWithID is an AppResource along with the ID of the application it describes
+type AppResourceWithID struct {
+	AppResource
+	AppID basics.AppIndex
+}
+
+// AssetHoldingWithAddress is an AssetHolding along with the address of the account holding it
+type AssetHoldingWithAddress struct {
+	basics.AssetHolding
+	Address basics.Address
+}
+
+// AppLocalStateWithAddress is an AppLocalState along with the address of the account opted in the application
+type AppLocalStateWithAddress struct {
+	basics.AppLocalState
+	Address basics.Address
+}
+
 // AssignAccountResourceToAccountData assigns the Asset/App params/holdings contained
 // in the AccountResource to the given basics.AccountData, creating maps if necessary.
 // Returns true if the AccountResource contained a new or updated resource,
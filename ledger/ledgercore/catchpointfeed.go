@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrCatchpointFeedNotTrusted is returned when none of the signatures of a catchpoint feed is
+// a valid signature of a trusted publisher.
+var ErrCatchpointFeedNotTrusted = errors.New("catchpoint feed is not signed by a trusted publisher")
+
+// ErrCatchpointFeedStale is returned when a catchpoint feed was issued more than CatchpointFeedMaxAge ago, or
+// claims to be issued in the future.
+var ErrCatchpointFeedStale = errors.New("catchpoint feed is stale")
+
+// CatchpointFeedMaxAge is the age past which a catchpoint feed is refused: a replayed old feed would otherwise
+// keep the nodes catching up to old catchpoints. The publishers sign a new feed more often than that.
+const CatchpointFeedMaxAge = 48 * time.Hour
+
+// CatchpointFeedMaxClockSkew is how far in the future the issue time of a catchpoint feed may be, for the clocks
+// of the publishers and of the nodes to differ.
+const CatchpointFeedMaxClockSkew = 5 * time.Minute
+
+// CatchpointFeed lists the recent catchpoint labels of a network, as published by an archival node,
+// so that the nodes catching up can verify the provenance of the labels they catch up to.
+//
+//msgp:ignore CatchpointFeed
+type CatchpointFeed struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	GenesisID   string        `codec:"genesis-id"`
+	GenesisHash crypto.Digest `codec:"genesis-hash"`
+
+	// Issued is the time the feed was published, in seconds since the epoch.
+	Issued int64 `codec:"issued"`
+
+	// Labels are the catchpoint labels, the latest first.
+	Labels []string `codec:"labels"`
+}
+
+// CatchpointFeedSignature is the signature of a catchpoint feed by one of its publishers.
+//
+//msgp:ignore CatchpointFeedSignature
+type CatchpointFeedSignature struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Signer    basics.Address   `codec:"signer"`
+	Signature crypto.Signature `codec:"sig"`
+}
+
+// SignedCatchpointFeed is a catchpoint feed with the signatures of its publishers, as served to the
+// nodes catching up, in JSON.
+//
+//msgp:ignore SignedCatchpointFeed
+type SignedCatchpointFeed struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Feed       CatchpointFeed            `codec:"feed"`
+	Signatures []CatchpointFeedSignature `codec:"signatures"`
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (f CatchpointFeed) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.CatchpointFeed, protocol.EncodeReflect(&f)
+}
+
+// Sign adds the signature of the publisher holding secrets to the feed.
+func (s *SignedCatchpointFeed) Sign(secrets *crypto.SignatureSecrets) {
+	s.Signatures = append(s.Signatures, CatchpointFeedSignature{
+		Signer:    basics.Address(secrets.SignatureVerifier),
+		Signature: secrets.Sign(s.Feed),
+	})
+}
+
+// Verify checks that the feed lists valid catchpoint labels of the given network, was issued less than
+// CatchpointFeedMaxAge before now and not after it, and is signed by at least one of the trusted publishers.
+func (s *SignedCatchpointFeed) Verify(genesisID string, genesisHash crypto.Digest, trusted []basics.Address, now time.Time) error {
+	if s.Feed.GenesisID != genesisID || s.Feed.GenesisHash != genesisHash {
+		return fmt.Errorf("catchpoint feed of network %s, expected %s", s.Feed.GenesisID, genesisID)
+	}
+	issued := time.Unix(s.Feed.Issued, 0)
+	if issued.After(now.Add(CatchpointFeedMaxClockSkew)) {
+		return fmt.Errorf("%w: issued at %s, in the future", ErrCatchpointFeedStale, issued.UTC().Format(time.RFC3339))
+	}
+	if now.Sub(issued) > CatchpointFeedMaxAge {
+		return fmt.Errorf("%w: issued at %s, more than %s ago", ErrCatchpointFeedStale, issued.UTC().Format(time.RFC3339), CatchpointFeedMaxAge)
+	}
+	for _, label := range s.Feed.Labels {
+		if _, _, err := ParseCatchpointLabel(label); err != nil {
+			return fmt.Errorf("catchpoint feed lists an invalid label %s: %w", label, err)
+		}
+	}
+	for _, sig := range s.Signatures {
+		for _, addr := range trusted {
+			if sig.Signer == addr && crypto.SignatureVerifier(addr).Verify(s.Feed, sig.Signature) {
+				return nil
+			}
+		}
+	}
+	return ErrCatchpointFeedNotTrusted
+}
+
+// Latest returns the label of the latest catchpoint of the feed.
+func (f *CatchpointFeed) Latest() (string, error) {
+	var latest string
+	var latestRound basics.Round
+	for _, label := range f.Labels {
+		round, _, err := ParseCatchpointLabel(label)
+		if err != nil {
+			return "", err
+		}
+		if latest == "" || round > latestRound {
+			latest, latestRound = label, round
+		}
+	}
+	if latest == "" {
+		return "", errors.New("catchpoint feed lists no label")
+	}
+	return latest, nil
+}
+
+// ParseCatchpointFeedPublishers parses a comma separated list of the addresses of trusted catchpoint
+// feed publishers, as configured by CatchpointFeedPublishers.
+func ParseCatchpointFeedPublishers(list string) ([]basics.Address, error) {
+	var publishers []basics.Address
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		addr, err := basics.UnmarshalChecksumAddress(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid catchpoint feed publisher %s: %w", s, err)
+		}
+		publishers = append(publishers, addr)
+	}
+	return publishers, nil
+}
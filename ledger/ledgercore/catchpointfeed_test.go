@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestCatchpointLabel(round basics.Round) string {
+	blockHash := crypto.Hash([]byte{byte(round)})
+	balancesRoot := crypto.Hash([]byte{byte(round), 1})
+	spHash := crypto.Hash([]byte{byte(round), 2})
+	return MakeLabel(MakeCatchpointLabelMakerCurrent(round, &blockHash, &balancesRoot, AccountTotals{}, &spHash))
+}
+
+func makeTestCatchpointFeed() SignedCatchpointFeed {
+	return SignedCatchpointFeed{
+		Feed: CatchpointFeed{
+			GenesisID:   "testnet-v1.0",
+			GenesisHash: crypto.Hash([]byte("testnet")),
+			Issued:      1700000000,
+			Labels:      []string{makeTestCatchpointLabel(20000), makeTestCatchpointLabel(30000), makeTestCatchpointLabel(10000)},
+		},
+	}
+}
+
+func TestCatchpointFeedVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	publisher := crypto.GenerateSignatureSecrets(seed)
+	crypto.RandBytes(seed[:])
+	other := crypto.GenerateSignatureSecrets(seed)
+	trusted := []basics.Address{basics.Address(publisher.SignatureVerifier)}
+	now := time.Unix(1700000000, 0).Add(time.Hour)
+
+	feed := makeTestCatchpointFeed()
+	require.ErrorIs(t, feed.Verify(feed.Feed.GenesisID, feed.Feed.GenesisHash, trusted, now), ErrCatchpointFeedNotTrusted)
+
+	feed.Sign(other)
+	require.ErrorIs(t, feed.Verify(feed.Feed.GenesisID, feed.Feed.GenesisHash, trusted, now), ErrCatchpointFeedNotTrusted)
+
+	feed.Sign(publisher)
+	require.NoError(t, feed.Verify(feed.Feed.GenesisID, feed.Feed.GenesisHash, trusted, now))
+	require.Error(t, feed.Verify("mainnet-v1.0", feed.Feed.GenesisHash, trusted, now))
+	require.Error(t, feed.Verify(feed.Feed.GenesisID, crypto.Digest{}, trusted, now))
+
+	// a signer claiming a trusted address without its key is not trusted
+	forged := makeTestCatchpointFeed()
+	forged.Sign(other)
+	forged.Signatures[0].Signer = trusted[0]
+	require.ErrorIs(t, forged.Verify(forged.Feed.GenesisID, forged.Feed.GenesisHash, trusted, now), ErrCatchpointFeedNotTrusted)
+
+	// altering the feed invalidates the signatures
+	feed.Feed.Labels = append(feed.Feed.Labels, makeTestCatchpointLabel(40000))
+	require.ErrorIs(t, feed.Verify(feed.Feed.GenesisID, feed.Feed.GenesisHash, trusted, now), ErrCatchpointFeedNotTrusted)
+
+	invalid := makeTestCatchpointFeed()
+	invalid.Feed.Labels = append(invalid.Feed.Labels, "not a label")
+	invalid.Sign(publisher)
+	require.ErrorIs(t, invalid.Verify(invalid.Feed.GenesisID, invalid.Feed.GenesisHash, trusted, now), ErrCatchpointParsingFailed)
+}
+
+func TestCatchpointFeedVerifyIssued(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	publisher := crypto.GenerateSignatureSecrets(seed)
+	trusted := []basics.Address{basics.Address(publisher.SignatureVerifier)}
+
+	feed := makeTestCatchpointFeed()
+	feed.Sign(publisher)
+	issued := time.Unix(feed.Feed.Issued, 0)
+	verify := func(now time.Time) error {
+		return feed.Verify(feed.Feed.GenesisID, feed.Feed.GenesisHash, trusted, now)
+	}
+
+	require.NoError(t, verify(issued))
+	require.NoError(t, verify(issued.Add(CatchpointFeedMaxAge)))
+	require.NoError(t, verify(issued.Add(-CatchpointFeedMaxClockSkew)))
+
+	// a replayed old feed is refused
+	require.ErrorIs(t, verify(issued.Add(CatchpointFeedMaxAge+time.Second)), ErrCatchpointFeedStale)
+
+	// and so is a feed issued in the future
+	require.ErrorIs(t, verify(issued.Add(-CatchpointFeedMaxClockSkew-time.Second)), ErrCatchpointFeedStale)
+}
+
+func TestCatchpointFeedLatest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	feed := makeTestCatchpointFeed()
+	latest, err := feed.Feed.Latest()
+	require.NoError(t, err)
+	require.Equal(t, makeTestCatchpointLabel(30000), latest)
+
+	_, err = (&CatchpointFeed{}).Latest()
+	require.Error(t, err)
+}
+
+func TestParseCatchpointFeedPublishers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := basics.Address(crypto.Hash([]byte("a")))
+	b := basics.Address(crypto.Hash([]byte("b")))
+
+	publishers, err := ParseCatchpointFeedPublishers(a.String() + ", " + b.String() + ",")
+	require.NoError(t, err)
+	require.Equal(t, []basics.Address{a, b}, publishers)
+
+	publishers, err = ParseCatchpointFeedPublishers("")
+	require.NoError(t, err)
+	require.Empty(t, publishers)
+
+	_, err = ParseCatchpointFeedPublishers(strings.ToLower(a.String()))
+	require.Error(t, err)
+}
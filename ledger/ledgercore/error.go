@@ -27,6 +27,10 @@ import (
 // ErrNoSpace indicates insufficient space for transaction in block
 var ErrNoSpace = errors.New("block does not have space for transaction")
 
+// ErrBlockIngestionHalted is returned when adding a block to a ledger which stopped adding blocks, as the disk it is
+// stored on is running out of space.
+var ErrBlockIngestionHalted = errors.New("ledger stopped adding blocks as the disk is running out of space")
+
 // TxnNotWellFormedError indicates a transaction was not well-formed when evaluated by the BlockEvaluator
 //
 //msgp:ignore TxnNotWellFormedError
@@ -53,6 +57,10 @@ type LeaseInLedgerError struct {
 	txid             transactions.Txid
 	lease            Txlease
 	InBlockEvaluator bool
+
+	// Round is the round the lease was taken in, and Expires the last round it is held until, when they are known.
+	Round   basics.Round
+	Expires basics.Round
 }
 
 // MakeLeaseInLedgerError builds a LeaseInLedgerError object
@@ -64,6 +72,16 @@ func MakeLeaseInLedgerError(txid transactions.Txid, lease Txlease, inBlockEvalua
 	}
 }
 
+// Txid returns the ID of the transaction which could not be added.
+func (lile *LeaseInLedgerError) Txid() transactions.Txid {
+	return lile.txid
+}
+
+// Lease returns the lease which is already being used.
+func (lile *LeaseInLedgerError) Lease() Txlease {
+	return lile.lease
+}
+
 // Error implements the error interface for the LeaseInLedgerError stuct
 func (lile *LeaseInLedgerError) Error() string {
 	// format the lease as address.
@@ -71,6 +89,47 @@ func (lile *LeaseInLedgerError) Error() string {
 	return fmt.Sprintf("transaction %v using an overlapping lease (sender, lease):(%s, %s)", lile.txid, lile.lease.Sender.String(), leaseValue.String())
 }
 
+// OverspendError is returned when a transaction spends more algos than its sender holds.
+type OverspendError struct {
+	Account basics.Address
+	Data    AccountData
+	Amount  basics.MicroAlgos
+}
+
+// Error satisfies builtin interface `error`
+func (e *OverspendError) Error() string {
+	return fmt.Sprintf("overspend (account %v, data %+v, tried to spend %v)", e.Account, e.Data, e.Amount)
+}
+
+// MinBalanceError is returned when a transaction leaves an account below its minimum balance.
+type MinBalanceError struct {
+	Account     basics.Address
+	Balance     uint64
+	MinBalance  uint64
+	TotalAssets uint64
+}
+
+// Error satisfies builtin interface `error`
+func (e *MinBalanceError) Error() string {
+	return fmt.Sprintf("account %v balance %d below min %d (%d assets)", e.Account, e.Balance, e.MinBalance, e.TotalAssets)
+}
+
+// AssetFrozenError is returned when a transaction moves an asset out of, or into, a holding which is frozen.
+type AssetFrozenError struct {
+	Asset  basics.AssetIndex
+	Holder basics.Address
+	// Recipient is set when the holding of the recipient of the asset is frozen.
+	Recipient bool
+}
+
+// Error satisfies builtin interface `error`
+func (e *AssetFrozenError) Error() string {
+	if e.Recipient {
+		return "asset frozen in recipient"
+	}
+	return fmt.Sprintf("asset %v frozen in %v", e.Asset, e.Holder)
+}
+
 // BlockInLedgerError is returned when a block cannot be added because it has already been done
 type BlockInLedgerError struct {
 	LastRound basics.Round
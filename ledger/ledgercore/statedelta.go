@@ -119,6 +119,10 @@ type StateDelta struct {
 	// previous block timestamp
 	PrevTimestamp int64
 
+	// encoded length of the block's transactions, only counted when the block is validated;
+	// not part of the encoded delta
+	TxnBytes int `codec:"-"`
+
 	// initial hint for allocating data structures for StateDelta
 	initialHint int
 
@@ -319,6 +323,7 @@ func (sd *StateDelta) Reset() {
 	sd.Hdr = nil
 	sd.StateProofNext = basics.Round(0)
 	sd.PrevTimestamp = 0
+	sd.TxnBytes = 0
 }
 
 // reset clears out allocated slices from AccountDeltas struct for reuse with sync.Pool
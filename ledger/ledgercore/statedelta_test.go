@@ -486,6 +486,7 @@ func TestStateDeltaReset(t *testing.T) {
 	require.Zero(t, sd.Hdr)
 	require.Zero(t, sd.StateProofNext)
 	require.Zero(t, sd.PrevTimestamp)
+	require.Zero(t, sd.TxnBytes)
 	require.Zero(t, sd.Totals)
 
 	// required allocated maps
@@ -526,6 +527,7 @@ func TestStateDeltaReflect(t *testing.T) {
 		"Hdr":            {},
 		"StateProofNext": {},
 		"PrevTimestamp":  {},
+		"TxnBytes":       {},
 		"initialHint":    {},
 		"Totals":         {},
 	}
@@ -133,3 +133,27 @@ func (at *AccountTotals) RewardUnits() uint64 {
 	}
 	return res
 }
+
+// RoundTotals are the totals of algos at the end of a round, along with the rewards rate of the round and the
+// balances of the fee sink and of the rewards pool.
+//
+//msgp:ignore RoundTotals
+type RoundTotals struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Round       basics.Round      `codec:"rnd"`
+	Totals      AccountTotals     `codec:"totals"`
+	RewardsRate uint64            `codec:"rwdrate"`
+	FeeSink     basics.MicroAlgos `codec:"feesink"`
+	RewardsPool basics.MicroAlgos `codec:"rwdpool"`
+}
+
+// Circulating returns the algos held outside of the fee sink and of the rewards pool.
+func (rt *RoundTotals) Circulating() basics.MicroAlgos {
+	var ot basics.OverflowTracker
+	res := ot.SubA(ot.SubA(rt.Totals.All(), rt.FeeSink), rt.RewardsPool)
+	if ot.Overflowed {
+		return basics.MicroAlgos{}
+	}
+	return res
+}
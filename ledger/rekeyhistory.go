@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrNoRekeyHistory is returned by the lookups of the rekey history on ledgers which don't keep it.
+var ErrNoRekeyHistory = errors.New("the rekey history is only kept by archival ledgers")
+
+// rekeyTracker keeps the history of the changes of the authorizing address of the accounts on archival ledgers, so
+// that the control changes of an account can be audited without scanning the blocks. The history starts with the
+// round the database was at when it was enabled, or when a catchpoint was applied.
+type rekeyTracker struct {
+	// enabled is set on archival ledgers
+	enabled bool
+
+	// baseRound is the round past which the history is kept
+	baseRound basics.Round
+
+	// latest is the latest round added to the tracker
+	latest basics.Round
+
+	// pending are the rekeys of the rounds not committed to the database yet, ordered by round
+	pending []ledgercore.RekeyEvent
+
+	// mu protects baseRound, latest and pending
+	mu deadlock.RWMutex
+
+	log logging.Logger
+
+	l ledgerForTracker
+}
+
+func (rt *rekeyTracker) initialize(enabled bool) {
+	rt.enabled = enabled
+}
+
+func (rt *rekeyTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	rt.log = l.trackerLog()
+	rt.l = l
+
+	baseRound, err := l.trackerDB().SetRekeyHistory(context.Background(), rt.enabled)
+	if err != nil {
+		return err
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.baseRound = baseRound
+	rt.latest = dbRound
+	rt.pending = nil
+	return nil
+}
+
+func (rt *rekeyTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !rt.enabled {
+		return
+	}
+
+	rekeys := blockRekeys(blk)
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if blk.Round() <= rt.latest {
+		// repeat, ignore
+		return
+	}
+	rt.latest = blk.Round()
+	rt.pending = append(rt.pending, rekeys...)
+}
+
+// blockRekeys returns the changes of the authorizing address of the accounts in a block, ordered by address. Only
+// the last change of an account in the block is returned.
+func blockRekeys(blk bookkeeping.Block) []ledgercore.RekeyEvent {
+	authAddrs := make(map[basics.Address]basics.Address)
+	var visit func(stxn *transactions.SignedTxnWithAD, inner bool)
+	visit = func(stxn *transactions.SignedTxnWithAD, inner bool) {
+		txn := &stxn.Txn
+		// the transactions signed by the key of their sender, rather than by an authorizing address, can't give the
+		// control of the account back to its own key. Inner transactions carry no authorizing address, so their
+		// changes are kept regardless.
+		rekeyed := inner || !stxn.AuthAddr.IsZero()
+		if !txn.RekeyTo.IsZero() {
+			switch {
+			case txn.RekeyTo != txn.Sender:
+				authAddrs[txn.Sender] = txn.RekeyTo
+			case rekeyed:
+				authAddrs[txn.Sender] = basics.Address{}
+			}
+		}
+		for i := range stxn.ApplyData.EvalDelta.InnerTxns {
+			visit(&stxn.ApplyData.EvalDelta.InnerTxns[i], true)
+		}
+		if txn.Type == protocol.PaymentTx && !txn.CloseRemainderTo.IsZero() {
+			// closing an account clears its authorizing address
+			if _, has := authAddrs[txn.Sender]; has || rekeyed {
+				authAddrs[txn.Sender] = basics.Address{}
+			}
+		}
+	}
+	for i := range blk.Payset {
+		visit(&blk.Payset[i].SignedTxnWithAD, false)
+	}
+
+	rekeys := make([]ledgercore.RekeyEvent, 0, len(authAddrs))
+	for addr, authAddr := range authAddrs {
+		rekeys = append(rekeys, ledgercore.RekeyEvent{Address: addr, Round: blk.Round(), AuthAddr: authAddr})
+	}
+	sort.Slice(rekeys, func(i, j int) bool { return bytes.Compare(rekeys[i].Address[:], rekeys[j].Address[:]) < 0 })
+	return rekeys
+}
+
+func (rt *rekeyTracker) committedUpTo(round basics.Round) (minRound, lookback basics.Round) {
+	return round, 0
+}
+
+func (rt *rekeyTracker) produceCommittingTask(_ basics.Round, _ basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (rt *rekeyTracker) prepareCommit(dcc *deferredCommitContext) error {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	newBase := dcc.newBase()
+	count := sort.Search(len(rt.pending), func(i int) bool { return rt.pending[i].Round > newBase })
+	dcc.rekeys = make([]ledgercore.RekeyEvent, count)
+	copy(dcc.rekeys, rt.pending[:count])
+	return nil
+}
+
+func (rt *rekeyTracker) commitRound(ctx context.Context, tx trackerdb.TransactionScope, dcc *deferredCommitContext) error {
+	if len(dcc.rekeys) == 0 {
+		return nil
+	}
+	return tx.MakeRekeyHistoryWriter().StoreRekeys(ctx, dcc.rekeys)
+}
+
+func (rt *rekeyTracker) postCommit(_ context.Context, dcc *deferredCommitContext) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.pending = rt.pending[len(dcc.rekeys):]
+}
+
+func (rt *rekeyTracker) postCommitUnlocked(context.Context, *deferredCommitContext) {
+}
+
+func (rt *rekeyTracker) handleUnorderedCommitOrError(*deferredCommitContext) {
+}
+
+func (rt *rekeyTracker) close() {
+}
+
+// lookupRekeys returns the changes of the authorizing address of an account, ordered by round, along with the round
+// past which the history is kept.
+func (rt *rekeyTracker) lookupRekeys(addr basics.Address) ([]ledgercore.RekeyEvent, basics.Round, error) {
+	if !rt.enabled {
+		return nil, basics.Round(0), ErrNoRekeyHistory
+	}
+
+	// the pending rekeys are collected before reading the database: the ones committed in between are found in
+	// both, and are deduplicated by round.
+	rt.mu.RLock()
+	baseRound := rt.baseRound
+	var pending []ledgercore.RekeyEvent
+	for _, rekey := range rt.pending {
+		if rekey.Address == addr {
+			pending = append(pending, rekey)
+		}
+	}
+	rt.mu.RUnlock()
+
+	var persisted []ledgercore.RekeyEvent
+	err := rt.l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) (err error) {
+		persisted, _, err = tx.MakeRekeyHistoryReader().LookupRekeys(ctx, addr)
+		return err
+	})
+	if err != nil {
+		return nil, basics.Round(0), err
+	}
+
+	rekeys := persisted
+	for _, rekey := range pending {
+		if len(persisted) == 0 || rekey.Round > persisted[len(persisted)-1].Round {
+			rekeys = append(rekeys, rekey)
+		}
+	}
+	return rekeys, baseRound, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func rekeyTxn(sender, authAddr, rekeyTo, closeTo basics.Address) transactions.SignedTxnWithAD {
+	return transactions.SignedTxnWithAD{
+		SignedTxn: transactions.SignedTxn{
+			Txn: transactions.Transaction{
+				Type:             protocol.PaymentTx,
+				Header:           transactions.Header{Sender: sender, RekeyTo: rekeyTo},
+				PaymentTxnFields: transactions.PaymentTxnFields{CloseRemainderTo: closeTo},
+			},
+			AuthAddr: authAddr,
+		},
+	}
+}
+
+func rekeyBlock(rnd basics.Round, txns ...transactions.SignedTxnWithAD) bookkeeping.Block {
+	blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+	for _, txn := range txns {
+		blk.Payset = append(blk.Payset, transactions.SignedTxnInBlock{SignedTxnWithAD: txn})
+	}
+	return blk
+}
+
+func TestBlockRekeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var addrs [8]basics.Address
+	for i := range addrs {
+		addrs[i] = ledgertesting.RandomAddress()
+	}
+	var zero basics.Address
+
+	appCall := transactions.SignedTxnWithAD{
+		SignedTxn: transactions.SignedTxn{Txn: transactions.Transaction{Type: protocol.ApplicationCallTx, Header: transactions.Header{Sender: addrs[5]}}},
+	}
+	appCall.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{
+		rekeyTxn(addrs[6], zero, addrs[1], zero),
+		rekeyTxn(addrs[7], zero, addrs[7], zero),
+	}
+
+	blk := rekeyBlock(10,
+		// rekeyed, then rekeyed again: only the last change is kept
+		rekeyTxn(addrs[0], zero, addrs[1], zero),
+		rekeyTxn(addrs[0], addrs[1], addrs[2], zero),
+		// rekeyed back to its own key
+		rekeyTxn(addrs[1], addrs[2], addrs[1], zero),
+		// signed by its own key, the account was not rekeyed
+		rekeyTxn(addrs[2], zero, addrs[2], zero),
+		rekeyTxn(addrs[3], zero, zero, addrs[0]),
+		// closed while rekeyed
+		rekeyTxn(addrs[4], addrs[0], zero, addrs[0]),
+		appCall,
+	)
+	rekeys := blockRekeys(blk)
+
+	expected := map[basics.Address]basics.Address{
+		addrs[0]: addrs[2],
+		addrs[1]: zero,
+		addrs[4]: zero,
+		addrs[6]: addrs[1],
+		// inner transactions carry no authorizing address
+		addrs[7]: zero,
+	}
+	found := make(map[basics.Address]basics.Address)
+	for i, rekey := range rekeys {
+		require.Equal(t, basics.Round(10), rekey.Round)
+		if i > 0 {
+			require.Negative(t, bytes.Compare(rekeys[i-1].Address[:], rekey.Address[:]))
+		}
+		found[rekey.Address] = rekey.AuthAddr
+	}
+	require.Equal(t, expected, found)
+}
+
+func commitRekeys(t *testing.T, rt *rekeyTracker, ml *mockLedgerForTracker, dbRound basics.Round, newBase basics.Round) {
+	dcc := deferredCommitContext{deferredCommitRange: deferredCommitRange{oldBase: dbRound, offset: uint64(newBase - dbRound)}}
+	require.NoError(t, rt.prepareCommit(&dcc))
+	err := ml.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		return rt.commitRound(ctx, tx, &dcc)
+	})
+	require.NoError(t, err)
+	rt.postCommit(context.Background(), &dcc)
+}
+
+func TestRekeyTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(5, true)}
+	ml := makeMockLedgerForTracker(t, true, 1, protocol.ConsensusCurrentVersion, accts)
+	defer ml.Close()
+	_, err := trackerDBInitialize(ml, false, ".")
+	require.NoError(t, err)
+
+	rt := rekeyTracker{}
+	rt.initialize(true)
+	require.NoError(t, ml.trackers.initialize(ml, []ledgerTracker{&rt}, config.GetDefaultLocal()))
+	require.NoError(t, rt.loadFromDisk(ml, 0))
+
+	addr, auth1, auth2 := ledgertesting.RandomAddress(), ledgertesting.RandomAddress(), ledgertesting.RandomAddress()
+	var zero basics.Address
+	rt.newBlock(rekeyBlock(1, rekeyTxn(addr, zero, auth1, zero)), ledgercore.StateDelta{})
+	rt.newBlock(rekeyBlock(2), ledgercore.StateDelta{})
+	rt.newBlock(rekeyBlock(3, rekeyTxn(addr, auth1, auth2, zero)), ledgercore.StateDelta{})
+	// repeated rounds are ignored
+	rt.newBlock(rekeyBlock(3, rekeyTxn(addr, auth1, auth1, zero)), ledgercore.StateDelta{})
+	rt.newBlock(rekeyBlock(4, rekeyTxn(addr, auth2, zero, auth1)), ledgercore.StateDelta{})
+
+	expected := []ledgercore.RekeyEvent{
+		{Address: addr, Round: 1, AuthAddr: auth1},
+		{Address: addr, Round: 3, AuthAddr: auth2},
+		{Address: addr, Round: 4, AuthAddr: zero},
+	}
+	checkRekeys := func() {
+		rekeys, baseRound, err := rt.lookupRekeys(addr)
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(0), baseRound)
+		require.Equal(t, expected, rekeys)
+	}
+
+	// all pending, then partly and fully committed
+	checkRekeys()
+	commitRekeys(t, &rt, ml, 0, 2)
+	require.Len(t, rt.pending, 2)
+	checkRekeys()
+	commitRekeys(t, &rt, ml, 2, 4)
+	require.Empty(t, rt.pending)
+	checkRekeys()
+
+	// the history is kept across reloads, and dropped once disabled
+	require.NoError(t, rt.loadFromDisk(ml, 4))
+	checkRekeys()
+	rt.initialize(false)
+	require.NoError(t, rt.loadFromDisk(ml, 4))
+	_, _, err = rt.lookupRekeys(addr)
+	require.ErrorIs(t, err, ErrNoRekeyHistory)
+}
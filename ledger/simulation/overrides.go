@@ -0,0 +1,274 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// StateOverrides describe a hypothetical ledger state which a transaction group is simulated
+// against, in place of the state of the latest round. They let contract authors set up the edge
+// cases they want to test without the transactions which would lead to them.
+type StateOverrides struct {
+	// Balances replace the balances of the accounts.
+	Balances map[basics.Address]basics.MicroAlgos
+	// GlobalStates set the values of keys of the global states of applications.
+	GlobalStates map[basics.AppIndex]basics.TealKeyValue
+	// LocalStates set the values of keys of the local states of accounts opted in applications.
+	LocalStates map[basics.Address]map[basics.AppIndex]basics.TealKeyValue
+	// Boxes replace the contents of the boxes of applications, keyed by their names. The boxes
+	// which do not exist are created.
+	Boxes map[basics.AppIndex]map[string][]byte
+	// LatestTimestamp, unless zero, replaces the timestamp of the latest block.
+	LatestTimestamp int64
+	// Round, unless zero, replaces the round the transaction group is simulated in.
+	Round basics.Round
+}
+
+// overrideLedger applies the state overrides of a simulation request on top of the state of the
+// round the simulator is pinned at. As the evaluator looks up the state as of the round preceding
+// the simulated one, that round is mapped back to the pinned round.
+type overrideLedger struct {
+	simulatorLedger
+	overrides StateOverrides
+
+	// prevHeader is the header of the pinned round, with the overridden round and timestamp.
+	prevHeader bookkeeping.BlockHeader
+	// boxes holds the overridden box contents, keyed by their ledger keys.
+	boxes map[string][]byte
+	// boxTotals adjusts the box counters of the application accounts to the overridden boxes.
+	boxTotals map[basics.Address]boxTotals
+}
+
+type boxTotals struct {
+	boxes int64
+	bytes int64
+}
+
+func invalidOverrideError(format string, args ...interface{}) error {
+	return InvalidRequestError{SimulatorError{fmt.Errorf("invalid state override: "+format, args...)}}
+}
+
+func validateTealKeyValue(tkv basics.TealKeyValue, proto config.ConsensusParams) error {
+	for key, value := range tkv {
+		if len(key) > proto.MaxAppKeyLen {
+			return fmt.Errorf("key %#x is longer than %d bytes", key, proto.MaxAppKeyLen)
+		}
+		switch value.Type {
+		case basics.TealBytesType:
+			if len(value.Bytes) > proto.MaxAppBytesValueLen {
+				return fmt.Errorf("value of key %#x is longer than %d bytes", key, proto.MaxAppBytesValueLen)
+			}
+		case basics.TealUintType:
+		default:
+			return fmt.Errorf("value of key %#x has unknown type %d", key, value.Type)
+		}
+	}
+	return nil
+}
+
+func makeOverrideLedger(l simulatorLedger, overrides StateOverrides) (*overrideLedger, error) {
+	prevHeader, err := l.BlockHdr(l.start)
+	if err != nil {
+		return nil, err
+	}
+	if overrides.Round != 0 {
+		prevHeader.Round = overrides.Round - 1
+	}
+	if overrides.LatestTimestamp != 0 {
+		prevHeader.TimeStamp = overrides.LatestTimestamp
+	}
+	proto := config.Consensus[prevHeader.CurrentProtocol]
+
+	appExists := func(app basics.AppIndex) error {
+		_, ok, err := l.GetCreatorForRound(l.start, basics.CreatableIndex(app), basics.AppCreatable)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return invalidOverrideError("application %d does not exist", app)
+		}
+		return nil
+	}
+
+	for app, tkv := range overrides.GlobalStates {
+		if err := appExists(app); err != nil {
+			return nil, err
+		}
+		if err := validateTealKeyValue(tkv, proto); err != nil {
+			return nil, invalidOverrideError("global state of application %d: %w", app, err)
+		}
+	}
+	for addr, states := range overrides.LocalStates {
+		for app, tkv := range states {
+			res, err := l.LookupApplication(l.start, addr, app)
+			if err != nil {
+				return nil, err
+			}
+			if res.AppLocalState == nil {
+				return nil, invalidOverrideError("account %s is not opted in application %d", addr, app)
+			}
+			if err := validateTealKeyValue(tkv, proto); err != nil {
+				return nil, invalidOverrideError("local state of account %s in application %d: %w", addr, app, err)
+			}
+		}
+	}
+
+	ol := &overrideLedger{
+		simulatorLedger: l,
+		overrides:       overrides,
+		prevHeader:      prevHeader,
+		boxes:           make(map[string][]byte),
+		boxTotals:       make(map[basics.Address]boxTotals),
+	}
+	for app, boxes := range overrides.Boxes {
+		if err := appExists(app); err != nil {
+			return nil, err
+		}
+		for name, value := range boxes {
+			if len(name) == 0 || len(name) > proto.MaxAppKeyLen {
+				return nil, invalidOverrideError("box name %#x of application %d must be 1 to %d bytes long", name, app, proto.MaxAppKeyLen)
+			}
+			if uint64(len(value)) > proto.MaxBoxSize {
+				return nil, invalidOverrideError("box %#x of application %d is larger than %d bytes", name, app, proto.MaxBoxSize)
+			}
+			key := apps.MakeBoxKey(uint64(app), name)
+			old, err := l.LookupKv(l.start, key)
+			if err != nil {
+				return nil, err
+			}
+			totals := ol.boxTotals[app.Address()]
+			if old == nil {
+				totals.boxes++
+				totals.bytes += int64(len(name))
+			}
+			totals.bytes += int64(len(value)) - int64(len(old))
+			ol.boxTotals[app.Address()] = totals
+			ol.boxes[key] = value
+		}
+	}
+	return ol, nil
+}
+
+// baseRound maps the round the evaluator looks up the state of to the pinned round.
+func (l *overrideLedger) baseRound(rnd basics.Round) basics.Round {
+	if rnd == l.prevHeader.Round {
+		return l.start
+	}
+	return rnd
+}
+
+// nextBlockHeader returns the header of the block in which the transaction group is simulated.
+func (l *overrideLedger) nextBlockHeader() (bookkeeping.BlockHeader, error) {
+	return bookkeeping.MakeBlock(l.prevHeader).BlockHeader, nil
+}
+
+func (l *overrideLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if rnd == l.prevHeader.Round {
+		return l.prevHeader, nil
+	}
+	return l.simulatorLedger.BlockHdr(rnd)
+}
+
+func (l *overrideLedger) BlockHdrCached(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if rnd == l.prevHeader.Round {
+		return l.prevHeader, nil
+	}
+	return l.simulatorLedger.BlockHdrCached(rnd)
+}
+
+// LatestTotals reports the totals of the ledger as of the overridden round, which the evaluator
+// checks it evaluates the block following.
+func (l *overrideLedger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
+	_, totals, err := l.simulatorLedger.LatestTotals()
+	return l.prevHeader.Round, totals, err
+}
+
+// CheckDup looks for duplicate transactions and leases, unless the round is overridden: the
+// transactions of the ledger then do not relate to the simulated round.
+func (l *overrideLedger) CheckDup(proto config.ConsensusParams, current basics.Round, firstValid basics.Round, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	if l.overrides.Round != 0 {
+		return nil
+	}
+	return l.simulatorLedger.CheckDup(proto, current, firstValid, lastValid, txid, txl)
+}
+
+func (l *overrideLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
+	ad, _, err := l.simulatorLedger.LookupWithoutRewards(l.baseRound(rnd), addr)
+	if err != nil {
+		return ledgercore.AccountData{}, 0, err
+	}
+	if balance, ok := l.overrides.Balances[addr]; ok {
+		ad.MicroAlgos = balance
+	}
+	if totals, ok := l.boxTotals[addr]; ok {
+		ad.TotalBoxes = uint64(int64(ad.TotalBoxes) + totals.boxes)
+		ad.TotalBoxBytes = uint64(int64(ad.TotalBoxBytes) + totals.bytes)
+	}
+	return ad, rnd, nil
+}
+
+func (l *overrideLedger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	res, err := l.simulatorLedger.LookupApplication(l.baseRound(rnd), addr, aidx)
+	if err != nil {
+		return ledgercore.AppResource{}, err
+	}
+	if tkv, ok := l.overrides.GlobalStates[aidx]; ok && res.AppParams != nil {
+		params := res.AppParams.Clone()
+		if params.GlobalState == nil {
+			params.GlobalState = make(basics.TealKeyValue, len(tkv))
+		}
+		for key, value := range tkv {
+			params.GlobalState[key] = value
+		}
+		res.AppParams = &params
+	}
+	if tkv, ok := l.overrides.LocalStates[addr][aidx]; ok && res.AppLocalState != nil {
+		state := res.AppLocalState.Clone()
+		if state.KeyValue == nil {
+			state.KeyValue = make(basics.TealKeyValue, len(tkv))
+		}
+		for key, value := range tkv {
+			state.KeyValue[key] = value
+		}
+		res.AppLocalState = &state
+	}
+	return res, nil
+}
+
+func (l *overrideLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	return l.simulatorLedger.LookupAsset(l.baseRound(rnd), addr, aidx)
+}
+
+func (l *overrideLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	if value, ok := l.boxes[key]; ok {
+		return value, nil
+	}
+	return l.simulatorLedger.LookupKv(l.baseRound(rnd), key)
+}
+
+func (l *overrideLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	return l.simulatorLedger.GetCreatorForRound(l.baseRound(rnd), cidx, ctype)
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSimulateStateOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	other := env.Accounts[1]
+
+	approval := `#pragma version 8
+txn ApplicationID
+bz done
+global LatestTimestamp
+int 1700000000
+==
+assert
+global Round
+int 5000
+==
+assert
+byte "g"
+app_global_get
+int 42
+==
+assert
+txn Sender
+byte "l"
+app_local_get
+byte "local"
+==
+assert
+byte "box"
+box_get
+assert
+byte "boxvalue"
+==
+assert
+txna Accounts 1
+balance
+int 123456789
+==
+assert
+done:
+int 1`
+
+	// create the app, opting the creator in, and commit it to the ledger
+	create := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		OnCompletion:      transactions.OptInOC,
+		ApprovalProgram:   approval,
+		ClearStateProgram: "#pragma version 8\nint 1",
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+		LocalStateSchema:  basics.StateSchema{NumByteSlice: 1},
+	}).Txn().Sign(sender.Sk)
+	result, err := simulation.MakeSimulator(env.Ledger, false).Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{{create}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.NoError(t, env.Ledger.AddValidatedBlock(*result.Block, agreement.Certificate{}))
+	appID := result.TxnGroups[0].Txns[0].Txn.ApplyData.ApplicationID
+
+	call := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+		Accounts:      []basics.Address{other.Addr},
+		Boxes:         []transactions.BoxRef{{Name: []byte("box")}},
+	})
+	call.FirstValid = 4999
+	call.LastValid = 5001
+	request := simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{{call.Txn().Sign(sender.Sk)}},
+		StateOverrides: &simulation.StateOverrides{
+			Balances: map[basics.Address]basics.MicroAlgos{
+				other.Addr: {Raw: 123456789},
+			},
+			GlobalStates: map[basics.AppIndex]basics.TealKeyValue{
+				appID: {"g": {Type: basics.TealUintType, Uint: 42}},
+			},
+			LocalStates: map[basics.Address]map[basics.AppIndex]basics.TealKeyValue{
+				sender.Addr: {appID: {"l": {Type: basics.TealBytesType, Bytes: "local"}}},
+			},
+			Boxes: map[basics.AppIndex]map[string][]byte{
+				appID: {"box": []byte("boxvalue")},
+			},
+			LatestTimestamp: 1700000000,
+			Round:           5000,
+		},
+	}
+
+	_, err = simulation.MakeSimulator(env.Ledger, false).Simulate(request)
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "while requesting for state overrides")
+
+	result, err = simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, basics.Round(4999), result.LastRound)
+
+	// the call fails against the actual balance of the account
+	overrides := *request.StateOverrides
+	overrides.Balances = nil
+	request.StateOverrides = &overrides
+	result, err = simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "assert failed")
+
+	// local states can only be overridden for accounts opted in the app
+	overrides.LocalStates = map[basics.Address]map[basics.AppIndex]basics.TealKeyValue{
+		other.Addr: {appID: {"l": {Type: basics.TealBytesType, Bytes: "local"}}},
+	}
+	_, err = simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "is not opted in")
+}
+
+func TestSimulateUnlimitedOpcodeBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	create := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:   protocol.ApplicationCallTx,
+		Sender: sender.Addr,
+		ApprovalProgram: `#pragma version 8
+int 0
+loop:
+int 1
++
+dup
+int 100000
+<
+bnz loop
+pop
+int 1`,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	}).Txn().Sign(sender.Sk)
+
+	request := simulation.Request{
+		TxnGroups:         [][]transactions.SignedTxn{{create}},
+		ExtraOpcodeBudget: simulation.MaxExtraOpcodeBudget,
+	}
+	result, err := simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "dynamic cost budget exceeded")
+
+	request.ExtraOpcodeBudget = 0
+	request.UnlimitedOpcodeBudget = true
+	_, err = simulation.MakeSimulator(env.Ledger, false).Simulate(request)
+	require.ErrorContains(t, err, "while requesting for an unlimited opcode budget")
+
+	result, err = simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.True(t, result.EvalOverrides.UnlimitedOpcodeBudget)
+	require.Greater(t, result.TxnGroups[0].AppBudgetConsumed, simulation.MaxExtraOpcodeBudget)
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// otherOpcodeCategory is the category of opcodes that belong to no documentation group
+const otherOpcodeCategory = "Other"
+
+// opcodeCategories maps opcode names to the documentation group they belong to, which is their
+// category in profiles.
+var opcodeCategories = func() map[string]string {
+	groups := make([]string, 0, len(logic.OpGroups))
+	for group := range logic.OpGroups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	categories := make(map[string]string)
+	for _, group := range groups {
+		for _, name := range logic.OpGroups[group] {
+			if _, ok := categories[name]; !ok {
+				categories[name] = group
+			}
+		}
+	}
+	return categories
+}()
+
+func opcodeCategory(name string) string {
+	if category, ok := opcodeCategories[name]; ok {
+		return category
+	}
+	return otherOpcodeCategory
+}
+
+// opcodeProfile is the profiling state of the opcode being evaluated by a program
+type opcodeProfile struct {
+	category string
+	// cost is the cost of the program before the opcode
+	cost          int
+	boxReadBytes  uint64
+	boxWriteBytes uint64
+	// conditionalWrite is set for box_create, which only writes the box if it creates it
+	conditionalWrite bool
+}
+
+// profileOpcode returns the profiling state of the opcode about to be evaluated.
+func profileOpcode(cx *logic.EvalContext) opcodeProfile {
+	name := cx.GetOpSpec().Name
+	op := opcodeProfile{category: opcodeCategory(name), cost: cx.Cost()}
+
+	height := cx.StackHeight()
+	if height == 0 {
+		return op
+	}
+	top := cx.StackValue(height - 1)
+	switch name {
+	case "box_put", "box_replace":
+		op.boxWriteBytes = uint64(len(top.Bytes))
+	case "box_create":
+		op.boxWriteBytes = top.Uint
+		op.conditionalWrite = true
+	case "box_extract":
+		op.boxReadBytes = top.Uint
+	case "box_get":
+		box, _, err := cx.Ledger.GetBox(cx.AppID(), top.Bytes)
+		if err == nil {
+			op.boxReadBytes = uint64(len(box))
+		}
+	}
+	return op
+}
+
+// complete adds the cost of the opcode, once evaluated, to costs, and the box bytes it read and
+// wrote to profile.
+func (op opcodeProfile) complete(cx *logic.EvalContext, evalError error, costs map[string]uint64, profile *TxnProfile) {
+	costs[op.category] += uint64(cx.Cost() - op.cost)
+	if evalError != nil {
+		return
+	}
+	if op.conditionalWrite {
+		height := cx.StackHeight()
+		if height == 0 || !cx.StackValueEquals(height-1, basics.TealValue{Type: basics.TealUintType, Uint: 1}) {
+			op.boxWriteBytes = 0
+		}
+	}
+	profile.BoxReadBytes += op.boxReadBytes
+	profile.BoxWriteBytes += op.boxWriteBytes
+}
+
+func logBytes(logs []string) uint64 {
+	size := uint64(0)
+	for _, log := range logs {
+		size += uint64(len(log))
+	}
+	return size
+}
+
+// innerTxnUsage returns the number of inner transactions, at any depth, and the number of bytes
+// they logged.
+func innerTxnUsage(evalDelta transactions.EvalDelta) (count uint64, logSize uint64) {
+	for _, inner := range evalDelta.InnerTxns {
+		innerCount, innerLogSize := innerTxnUsage(inner.EvalDelta)
+		count += 1 + innerCount
+		logSize += logBytes(inner.EvalDelta.Logs) + innerLogSize
+	}
+	return count, logSize
+}
+
+// finishProfile completes the profile of a top level app call once evaluated, from the opcode
+// costs accumulated during its evaluation, its apply data, and the limits left to its group.
+func finishProfile(profile *TxnProfile, costs map[string]uint64, ad *transactions.ApplyData, ep *logic.EvalParams) {
+	categories := make([]string, 0, len(costs))
+	for category := range costs {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		profile.OpcodeCosts = append(profile.OpcodeCosts, OpcodeCategoryCost{Category: category, Cost: costs[category]})
+	}
+
+	ownLogBytes := logBytes(ad.EvalDelta.Logs)
+	innerCount, innerLogBytes := innerTxnUsage(ad.EvalDelta)
+	profile.InnerTxnCount = innerCount
+	profile.LogBytes = ownLogBytes + innerLogBytes
+
+	if ep.PooledApplicationBudget != nil && *ep.PooledApplicationBudget > 0 {
+		profile.AppBudgetRemaining = uint64(*ep.PooledApplicationBudget)
+	}
+	if ownLogBytes < ep.MaxLogSize {
+		profile.LogBytesRemaining = ep.MaxLogSize - ownLogBytes
+	}
+	if remaining, ok := ep.PooledAllowedInners(); ok {
+		innerTxnsRemaining := uint64(remaining)
+		profile.InnerTxnsRemaining = &innerTxnsRemaining
+	}
+}
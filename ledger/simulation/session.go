@@ -0,0 +1,339 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/eval"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// MaxSessions is the maximum number of simulation sessions that may be open at the same time.
+const MaxSessions = 64
+
+// MaxSessionsPerOwner is the maximum number of simulation sessions that may be open at the same time with the
+// same API token, so that a single client cannot take all of them.
+const MaxSessionsPerOwner = 8
+
+// MaxSessionGroups is the maximum number of transaction groups that may be applied to a single session.
+const MaxSessionGroups = 256
+
+// SessionIdleTimeout is the duration after which an unused simulation session is discarded.
+const SessionIdleTimeout = 10 * time.Minute
+
+// ErrSessionNotFound is returned when the requested simulation session does not exist or has expired.
+var ErrSessionNotFound = errors.New("simulation session not found")
+
+// ErrSessionExpired is returned when the ledger no longer holds the state of the round a simulation
+// session is pinned at, so that no more groups can be simulated in it.
+var ErrSessionExpired = errors.New("simulation session expired")
+
+// SessionInfo describes an open simulation session.
+type SessionInfo struct {
+	ID string
+	// Round is the round the session is pinned at; all groups are evaluated on top of the state as of this round.
+	Round basics.Round
+	// AppliedGroups is the number of transaction groups that have been successfully applied to the session.
+	AppliedGroups uint64
+}
+
+// sessionEvaluator is the block evaluator of a simulation session. It holds the state created by
+// the groups applied to the session, so that each group is only evaluated once, and never generates
+// its block, so that the following groups can still be evaluated on top of it.
+type sessionEvaluator struct {
+	eval   *eval.BlockEvaluator
+	tracer *switchEvalTracer
+}
+
+func makeSessionEvaluator(ledger simulatorLedger, paysetHint int) (*sessionEvaluator, error) {
+	hdr, err := ledger.nextBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+	tracer := &switchEvalTracer{EvalTracer: logic.NullEvalTracer{}}
+	evaluator, err := ledger.StartEvaluator(hdr, paysetHint, 0, tracer)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionEvaluator{eval: evaluator, tracer: tracer}, nil
+}
+
+// transactionGroup evaluates a group on top of the state of the session, forwarding the evaluation
+// hooks to the given tracer. The effects of the group are kept only if it is evaluated successfully.
+func (e *sessionEvaluator) transactionGroup(stxns []transactions.SignedTxn, tracer logic.EvalTracer) error {
+	e.tracer.EvalTracer = tracer
+	defer func() { e.tracer.EvalTracer = logic.NullEvalTracer{} }()
+	return e.eval.TransactionGroup(transactions.WrapSignedTxnsWithAD(stxns))
+}
+
+// session accumulates the transaction groups that were successfully simulated, so that each new
+// group is evaluated against the hypothetical state created by all of the groups before it.
+type session struct {
+	id    string
+	owner string
+	round basics.Round
+
+	// mu protects the evaluation state of the session. It is never acquired while holding the
+	// mutex of the SessionManager, so that a long simulation only holds up its own session.
+	mu      deadlock.Mutex
+	eval    *sessionEvaluator
+	applied []Request
+	// delta caches the state changes of the applied groups, until another group is applied.
+	delta *ledgercore.StateDelta
+
+	// expired is set once the ledger no longer holds the state of the session's round, and
+	// lastUsed is the last time the session was looked up. They are protected by the mutex
+	// of the SessionManager.
+	expired  bool
+	lastUsed time.Time
+}
+
+func (s *session) info() SessionInfo {
+	return SessionInfo{ID: s.id, Round: s.round, AppliedGroups: uint64(len(s.applied))}
+}
+
+// SessionManager keeps track of the open simulation sessions of a node.
+type SessionManager struct {
+	mu deadlock.Mutex
+
+	ledger       *data.Ledger
+	developerAPI bool
+	sessions     map[string]*session
+}
+
+// MakeSessionManager creates a new SessionManager, simulating against the given ledger.
+func MakeSessionManager(ledger *data.Ledger, developerAPI bool) *SessionManager {
+	return &SessionManager{
+		ledger:       ledger,
+		developerAPI: developerAPI,
+		sessions:     make(map[string]*session),
+	}
+}
+
+// expire drops all the sessions that were not used for longer than SessionIdleTimeout, and marks
+// the sessions pinned at a round older than the ledger's lookback window as expired. The expired
+// sessions are kept until they become idle, so that their users learn why they can no longer be
+// used. It returns the number of sessions which can still be used, in total and by owner.
+// The caller must hold m.mu.
+func (m *SessionManager) expire(now time.Time) (live int, liveByOwner map[string]int) {
+	oldest := m.ledger.LatestTrackerCommitted()
+	liveByOwner = make(map[string]int)
+	for id, s := range m.sessions {
+		if s.round < oldest {
+			s.expired = true
+		}
+		if now.Sub(s.lastUsed) > SessionIdleTimeout {
+			delete(m.sessions, id)
+		} else if !s.expired {
+			live++
+			liveByOwner[s.owner]++
+		}
+	}
+	return live, liveByOwner
+}
+
+// get returns the session with the given id, with its mutex held. The mutex of the manager is
+// released before the one of the session is acquired.
+func (m *SessionManager) get(id string) (*session, error) {
+	m.mu.Lock()
+	m.expire(time.Now())
+	s, ok := m.sessions[id]
+	var expired bool
+	if ok {
+		s.lastUsed = time.Now()
+		expired = s.expired
+	}
+	m.mu.Unlock()
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+
+	s.mu.Lock()
+	if expired {
+		// the state of the session is of no use anymore, and is released until the session is dropped
+		s.eval = nil
+		s.applied = nil
+		s.delta = nil
+		s.mu.Unlock()
+		return nil, fmt.Errorf("%w: the ledger no longer holds the state of round %d", ErrSessionExpired, s.round)
+	}
+	return s, nil
+}
+
+// Create opens a new simulation session pinned at the latest round of the ledger, on behalf of
+// owner, the API token of the request.
+func (m *SessionManager) Create(owner string) (SessionInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	live, liveByOwner := m.expire(now)
+	if live >= MaxSessions {
+		return SessionInfo{}, InvalidRequestError{
+			SimulatorError{fmt.Errorf("too many open simulation sessions, limit is %d", MaxSessions)},
+		}
+	}
+	if liveByOwner[owner] >= MaxSessionsPerOwner {
+		return SessionInfo{}, InvalidRequestError{
+			SimulatorError{fmt.Errorf("too many open simulation sessions for this API token, limit is %d", MaxSessionsPerOwner)},
+		}
+	}
+
+	var id string
+	for {
+		id = crypto.Hash(crypto.RandBytes(32)).String()
+		if _, exists := m.sessions[id]; !exists {
+			break
+		}
+	}
+	round := m.ledger.Latest()
+	evaluator, err := makeSessionEvaluator(simulatorLedger{m.ledger, round}, 0)
+	if err != nil {
+		return SessionInfo{}, err
+	}
+	s := &session{
+		id:       id,
+		owner:    owner,
+		round:    round,
+		eval:     evaluator,
+		lastUsed: now,
+	}
+	m.sessions[id] = s
+	return s.info(), nil
+}
+
+// Info returns the description of an open simulation session.
+func (m *SessionManager) Info(id string) (SessionInfo, error) {
+	s, err := m.get(id)
+	if err != nil {
+		return SessionInfo{}, err
+	}
+	defer s.mu.Unlock()
+	return s.info(), nil
+}
+
+// Delta returns the state changes accumulated by all of the transaction groups applied to a session.
+// As the session never completes its block, they are obtained by evaluating the applied groups once
+// more in a block of their own, and cached until another group is applied.
+func (m *SessionManager) Delta(id string) (ledgercore.StateDelta, error) {
+	s, err := m.get(id)
+	if err != nil {
+		return ledgercore.StateDelta{}, err
+	}
+	defer s.mu.Unlock()
+
+	if s.delta == nil {
+		delta, err := m.generateDelta(s)
+		if err != nil {
+			return ledgercore.StateDelta{}, err
+		}
+		s.delta = &delta
+	}
+	return *s.delta, nil
+}
+
+// generateDelta evaluates the groups applied to a session in a complete block, and returns its state
+// changes. The caller must hold s.mu.
+func (m *SessionManager) generateDelta(s *session) (ledgercore.StateDelta, error) {
+	if len(s.applied) == 0 {
+		return ledgercore.StateDelta{}, nil
+	}
+
+	paysetHint := 0
+	for _, request := range s.applied {
+		paysetHint += len(request.TxnGroups[0])
+	}
+	evaluator, err := makeSessionEvaluator(simulatorLedger{m.ledger, s.round}, paysetHint)
+	if err != nil {
+		return ledgercore.StateDelta{}, err
+	}
+	// Each group is evaluated with the overrides it was originally simulated with.
+	for i, request := range s.applied {
+		tracer, err := makeEvalTracer(s.round, request, m.developerAPI)
+		if err != nil {
+			return ledgercore.StateDelta{}, err
+		}
+		err = evaluator.transactionGroup(request.TxnGroups[0], tracer)
+		if err != nil {
+			return ledgercore.StateDelta{}, fmt.Errorf("failed to evaluate simulation session group %d: %w", i, err)
+		}
+	}
+	vb, err := evaluator.eval.GenerateBlock()
+	if err != nil {
+		return ledgercore.StateDelta{}, err
+	}
+	return vb.Delta(), nil
+}
+
+// Delete discards a simulation session along with its accumulated state.
+func (m *SessionManager) Delete(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.sessions[id]; !ok {
+		return ErrSessionNotFound
+	}
+	delete(m.sessions, id)
+	return nil
+}
+
+// Simulate simulates a transaction group on top of the state accumulated by the session. If the
+// group is evaluated successfully, its effects are kept and become visible to the following groups.
+func (m *SessionManager) Simulate(id string, request Request) (Result, error) {
+	s, err := m.get(id)
+	if err != nil {
+		return Result{}, err
+	}
+	defer s.mu.Unlock()
+
+	if len(s.applied) >= MaxSessionGroups {
+		return Result{}, InvalidRequestError{
+			SimulatorError{fmt.Errorf("simulation session has reached the limit of %d transaction groups", MaxSessionGroups)},
+		}
+	}
+
+	simulator := Simulator{
+		ledger:       simulatorLedger{m.ledger, s.round},
+		developerAPI: m.developerAPI,
+		session:      s.eval,
+	}
+	result, err := simulator.Simulate(request)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if result.TxnGroups[0].FailureMessage == "" {
+		s.applied = append(s.applied, request)
+		s.delta = nil
+	}
+	return result, nil
+}
+
+// switchEvalTracer forwards all the hooks to the currently selected tracer. It lets a single block
+// evaluator evaluate the groups of a session, each with its own evaluation overrides.
+type switchEvalTracer struct {
+	logic.EvalTracer
+}
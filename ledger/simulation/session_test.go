@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulationSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	receiver := env.Accounts[1]
+	manager := MakeSessionManager(env.Ledger, false)
+
+	info, err := manager.Create("token")
+	require.NoError(t, err)
+	require.Equal(t, env.TxnInfo.LatestRound(), info.Round)
+	require.Zero(t, info.AppliedGroups)
+
+	// spend half of the sender's balance, twice: the second payment only fails if the
+	// session kept the effects of the first one.
+	amount := sender.AcctData.MicroAlgos.Raw / 2
+	pay := func(note byte) transactions.SignedTxn {
+		return env.TxnInfo.NewTxn(txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   sender.Addr,
+			Receiver: receiver.Addr,
+			Amount:   amount,
+			Note:     []byte{note},
+		}).Txn().Sign(sender.Sk)
+	}
+
+	result, err := manager.Simulate(info.ID, Request{TxnGroups: [][]transactions.SignedTxn{{pay(1)}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	info, err = manager.Info(info.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), info.AppliedGroups)
+
+	delta, err := manager.Delta(info.ID)
+	require.NoError(t, err)
+	receiverData, ok := delta.Accts.GetData(receiver.Addr)
+	require.True(t, ok)
+	require.Equal(t, receiver.AcctData.MicroAlgos.Raw+amount, receiverData.MicroAlgos.Raw)
+
+	result, err = manager.Simulate(info.ID, Request{TxnGroups: [][]transactions.SignedTxn{{pay(2)}}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+	// the failed group must not be kept by the session
+	info, err = manager.Info(info.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), info.AppliedGroups)
+
+	// without a session, the same payment succeeds
+	simulator := MakeSimulator(env.Ledger, false)
+	result, err = simulator.Simulate(Request{TxnGroups: [][]transactions.SignedTxn{{pay(2)}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	require.NoError(t, manager.Delete(info.ID))
+	_, err = manager.Simulate(info.ID, Request{TxnGroups: [][]transactions.SignedTxn{{pay(3)}}})
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	require.ErrorIs(t, manager.Delete(info.ID), ErrSessionNotFound)
+}
+
+func TestSimulationSessionExpires(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	manager := MakeSessionManager(env.Ledger, false)
+	info, err := manager.Create("token")
+	require.NoError(t, err)
+
+	// move the ledger past the lookback window of the session's round
+	hdr := env.TxnInfo.LatestHeader
+	for i := uint64(0); i <= config.GetDefaultLocal().MaxAcctLookback+1; i++ {
+		blk := bookkeeping.MakeBlock(hdr)
+		blk.TxnCounter = hdr.TxnCounter
+		require.NoError(t, env.Ledger.AddBlock(blk, agreement.Certificate{}))
+		hdr = blk.BlockHeader
+	}
+	env.Ledger.WaitForCommit(hdr.Round)
+	require.Eventually(t, func() bool {
+		return env.Ledger.LatestTrackerCommitted() > info.Round
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err = manager.Simulate(info.ID, Request{TxnGroups: [][]transactions.SignedTxn{{}}})
+	require.ErrorIs(t, err, ErrSessionExpired)
+	_, err = manager.Delta(info.ID)
+	require.ErrorIs(t, err, ErrSessionExpired)
+
+	// the expired session no longer counts toward the limit, and can still be deleted
+	_, err = manager.Create("token")
+	require.NoError(t, err)
+	require.NoError(t, manager.Delete(info.ID))
+}
+
+func TestSimulationSessionLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	manager := MakeSessionManager(env.Ledger, false)
+	for i := 0; i < MaxSessionsPerOwner; i++ {
+		_, err := manager.Create("a")
+		require.NoError(t, err)
+	}
+	_, err := manager.Create("a")
+	require.ErrorContains(t, err, "too many open simulation sessions for this API token")
+
+	// the other tokens are not affected
+	info, err := manager.Create("b")
+	require.NoError(t, err)
+
+	// a session busy simulating does not hold up the others
+	busy, err := manager.get(info.ID)
+	require.NoError(t, err)
+	defer busy.mu.Unlock()
+	done := make(chan error)
+	go func() {
+		other, err := manager.Create("b")
+		if err == nil {
+			_, err = manager.Info(other.ID)
+		}
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "a busy session held up the session manager")
+	}
+}
+
+func TestSimulationSessionRefusesUncommittableGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	manager := MakeSessionManager(env.Ledger, false)
+	info, err := manager.Create("token")
+	require.NoError(t, err)
+
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: env.Accounts[1].Addr,
+		Amount:   1,
+	})
+	signed := pay.Txn().Sign(sender.Sk)
+
+	requests := []Request{
+		{TxnGroups: [][]transactions.SignedTxn{{pay.SignedTxn()}}, AllowEmptySignatures: true},
+		{TxnGroups: [][]transactions.SignedTxn{{signed}}, ExtraOpcodeBudget: 100},
+		{TxnGroups: [][]transactions.SignedTxn{{signed}}, UnlimitedOpcodeBudget: true},
+		{TxnGroups: [][]transactions.SignedTxn{{signed}}, AllowMoreLogging: true},
+	}
+	for _, request := range requests {
+		_, err = manager.Simulate(info.ID, request)
+		require.ErrorAs(t, err, &InvalidRequestError{})
+	}
+
+	// without the signature or the relaxed limits, the group is applied
+	result, err := manager.Simulate(info.ID, Request{TxnGroups: [][]transactions.SignedTxn{{signed}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	info, err = manager.Info(info.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), info.AppliedGroups)
+}
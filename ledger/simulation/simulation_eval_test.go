@@ -3012,3 +3012,369 @@ func TestMockTracerScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestAppCallStackScratchStateChanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	op, err := logic.AssembleString(`#pragma version 8
+pushbytes "k"     // [k]
+pushint 3         // [k, 3]
+app_global_put    // []
+pushint 7         // [7]
+store 1           // []
+load 1            // [7]
+pushint 1         // [7, 1]
++                 // [8]`)
+	require.NoError(t, err)
+
+	simulationTest(t, func(env simulationtesting.Environment) simulationTestCase {
+		sender := env.Accounts[0]
+
+		futureAppID := basics.AppIndex(1001)
+		createTxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:              protocol.ApplicationCallTx,
+			Sender:            sender.Addr,
+			ApprovalProgram:   op.Program,
+			ClearStateProgram: "#pragma version 8\nint 1",
+			GlobalStateSchema: basics.StateSchema{NumUint: 1},
+		})
+		signedCreateTxn := createTxn.Txn().Sign(sender.Sk)
+
+		uintValue := func(v uint64) basics.TealValue {
+			return basics.TealValue{Type: basics.TealUintType, Uint: v}
+		}
+		traceConfig := simulation.ExecTraceConfig{
+			Enable:  true,
+			Stack:   true,
+			Scratch: true,
+			State:   true,
+		}
+
+		return simulationTestCase{
+			input: simulation.Request{
+				TxnGroups:   [][]transactions.SignedTxn{{signedCreateTxn}},
+				TraceConfig: traceConfig,
+			},
+			developerAPI: true,
+			expected: simulation.Result{
+				Version:   simulation.ResultLatestVersion,
+				LastRound: env.TxnInfo.LatestRound(),
+				TxnGroups: []simulation.TxnGroupResult{
+					{
+						Txns: []simulation.TxnResult{
+							{
+								Txn: transactions.SignedTxnWithAD{
+									ApplyData: transactions.ApplyData{
+										ApplicationID: futureAppID,
+										EvalDelta: transactions.EvalDelta{
+											GlobalDelta: basics.StateDelta{
+												"k": {Action: basics.SetUintAction, Uint: 3},
+											},
+										},
+									},
+								},
+								AppBudgetConsumed: 8,
+								Trace: &simulation.TransactionTrace{
+									ApprovalProgramTrace: []simulation.OpcodeTraceUnit{
+										{
+											PC:         1,
+											StackAdded: []basics.TealValue{{Type: basics.TealBytesType, Bytes: "k"}},
+										},
+										{
+											PC:         4,
+											StackAdded: []basics.TealValue{uintValue(3)},
+										},
+										{
+											PC:            6,
+											StackPopCount: 2,
+											StateChanges: []simulation.StateOperation{{
+												Operation: simulation.AppStateWrite,
+												AppState:  simulation.GlobalState,
+												Key:       "k",
+												NewValue:  uintValue(3),
+											}},
+										},
+										{
+											PC:         7,
+											StackAdded: []basics.TealValue{uintValue(7)},
+										},
+										{
+											PC:                 9,
+											StackPopCount:      1,
+											ScratchSlotChanges: []simulation.ScratchChange{{Slot: 1, NewValue: uintValue(7)}},
+										},
+										{
+											PC:         11,
+											StackAdded: []basics.TealValue{uintValue(7)},
+										},
+										{
+											PC:         13,
+											StackAdded: []basics.TealValue{uintValue(1)},
+										},
+										{
+											PC:            15,
+											StackPopCount: 2,
+											StackAdded:    []basics.TealValue{uintValue(8)},
+										},
+									},
+								},
+							},
+						},
+						AppBudgetAdded:    700,
+						AppBudgetConsumed: 8,
+					},
+				},
+				TraceConfig: traceConfig,
+			},
+		}
+	})
+}
+
+func TestAppCallSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	op, err := logic.AssembleString(`#pragma version 8
+pushint 1
+pushint 2
+pop`)
+	require.NoError(t, err)
+	sourceMap := logic.GetSourceMap([]string{"approval.teal"}, op.OffsetToLine)
+
+	simulationTest(t, func(env simulationtesting.Environment) simulationTestCase {
+		sender := env.Accounts[0]
+
+		createTxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:              protocol.ApplicationCallTx,
+			Sender:            sender.Addr,
+			ApprovalProgram:   op.Program,
+			ClearStateProgram: "#pragma version 8\nint 1",
+		})
+		signedCreateTxn := createTxn.Txn().Sign(sender.Sk)
+
+		traceConfig := simulation.ExecTraceConfig{Enable: true}
+		location := func(pc int) *simulation.SourceLocation {
+			return &simulation.SourceLocation{File: "approval.teal", Line: uint64(op.OffsetToLine[pc])}
+		}
+
+		return simulationTestCase{
+			input: simulation.Request{
+				TxnGroups:   [][]transactions.SignedTxn{{signedCreateTxn}},
+				TraceConfig: traceConfig,
+				SourceMaps: map[crypto.Digest]logic.SourceMap{
+					logic.HashProgram(op.Program): sourceMap,
+				},
+			},
+			developerAPI: true,
+			expected: simulation.Result{
+				Version:   simulation.ResultLatestVersion,
+				LastRound: env.TxnInfo.LatestRound(),
+				TxnGroups: []simulation.TxnGroupResult{
+					{
+						Txns: []simulation.TxnResult{
+							{
+								Txn: transactions.SignedTxnWithAD{
+									ApplyData: transactions.ApplyData{
+										ApplicationID: 1001,
+									},
+								},
+								AppBudgetConsumed: 3,
+								Trace: &simulation.TransactionTrace{
+									ApprovalProgramTrace: []simulation.OpcodeTraceUnit{
+										{PC: 1, Source: location(1)},
+										{PC: 3, Source: location(3)},
+										{PC: 5, Source: location(5)},
+									},
+								},
+							},
+						},
+						AppBudgetAdded:    700,
+						AppBudgetConsumed: 3,
+					},
+				},
+				TraceConfig: traceConfig,
+			},
+		}
+	})
+}
+
+func TestTraceOptionsRequireTrace(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	txn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: sender.Addr,
+	}).Txn().Sign(sender.Sk)
+
+	requests := map[string]simulation.Request{
+		"stack": {
+			TxnGroups:   [][]transactions.SignedTxn{{txn}},
+			TraceConfig: simulation.ExecTraceConfig{Stack: true},
+		},
+		"source map": {
+			TxnGroups:  [][]transactions.SignedTxn{{txn}},
+			SourceMaps: map[crypto.Digest]logic.SourceMap{{}: {}},
+		},
+	}
+	for name, request := range requests {
+		_, err := simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+		require.ErrorAs(t, err, &simulation.InvalidRequestError{}, name)
+		require.ErrorContains(t, err, "must be enabled", name)
+	}
+
+	// an undecodable source map is rejected as well
+	request := simulation.Request{
+		TxnGroups:   [][]transactions.SignedTxn{{txn}},
+		TraceConfig: simulation.ExecTraceConfig{Enable: true},
+		SourceMaps:  map[crypto.Digest]logic.SourceMap{{}: {Version: 2}},
+	}
+	_, err := simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "invalid source map")
+}
+
+func TestAppCallCoverage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	op, err := logic.AssembleString(`#pragma version 8
+pushint 1
+bnz yes
+err
+yes:
+pushint 1`)
+	require.NoError(t, err)
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	createTxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		ApprovalProgram:   op.Program,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	}).Txn().Sign(sender.Sk)
+
+	request := simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{{createTxn}},
+		Coverage:  true,
+	}
+	_, err = simulation.MakeSimulator(env.Ledger, false).Simulate(request)
+	require.ErrorContains(t, err, "while requesting for coverage")
+
+	result, err := simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	// the clear state program is not evaluated, so only the approval program is covered
+	programFile := basics.Address(logic.HashProgram(op.Program)).String() + ".teal"
+	require.Equal(t, `TN:
+SF:`+programFile+`
+BRDA:3,0,0,0
+BRDA:3,0,1,1
+BRF:2
+BRH:1
+DA:2,1
+DA:3,1
+DA:4,0
+DA:6,1
+LF:4
+LH:3
+end_of_record
+`, result.CoverageReport)
+}
+
+func TestAppCallProfile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	op, err := logic.AssembleString(`#pragma version 8
+pushbytes "box"
+pushint 8
+box_create
+pop
+pushbytes "box"
+pushint 0
+pushbytes "abcd"
+box_replace
+pushbytes "box"
+pushint 0
+pushint 8
+box_extract
+pop
+pushbytes "hello"
+log
+itxn_begin
+pushint 1 // pay
+itxn_field TypeEnum
+pushint 0
+itxn_field Amount
+txn Sender
+itxn_field Receiver
+itxn_submit
+pushint 1`)
+	require.NoError(t, err)
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	futureAppID := basics.AppIndex(1002)
+
+	fund := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: futureAppID.Address(),
+		Amount:   200_000,
+	})
+	create := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		ApprovalProgram:   op.Program,
+		ClearStateProgram: "#pragma version 8\nint 1",
+		Boxes:             []transactions.BoxRef{{Name: []byte("box")}},
+	})
+	txgroup := txntest.Group(&fund, &create)
+	for i := range txgroup {
+		txgroup[i] = txgroup[i].Txn.Sign(sender.Sk)
+	}
+
+	result, err := simulation.MakeSimulator(env.Ledger, false).Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{txgroup},
+		Profile:   true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, futureAppID, result.TxnGroups[0].Txns[1].Txn.ApplyData.ApplicationID)
+
+	// only app calls are profiled
+	require.Nil(t, result.TxnGroups[0].Txns[0].Profile)
+
+	proto := env.TxnInfo.CurrentProtocolParams()
+	innerTxnsRemaining := uint64(proto.MaxTxGroupSize*proto.MaxInnerTransactions - 1)
+	require.Equal(t, &simulation.TxnProfile{
+		OpcodeCosts: []simulation.OpcodeCategoryCost{
+			{Category: "Box Access", Cost: 3},
+			{Category: "Flow Control", Cost: 2},
+			{Category: "Inner Transactions", Cost: 5},
+			{Category: "Loading Values", Cost: 13},
+			{Category: "State Access", Cost: 1},
+		},
+		BoxReadBytes:       8,
+		BoxWriteBytes:      12,
+		InnerTxnCount:      1,
+		LogBytes:           5,
+		AppBudgetRemaining: 700 - 24,
+		LogBytesRemaining:  logic.RuntimeEvalConstants().MaxLogSize - 5,
+		InnerTxnsRemaining: &innerTxnsRemaining,
+	}, result.TxnGroups[0].Txns[1].Profile)
+	require.Equal(t, uint64(24), result.TxnGroups[0].Txns[1].AppBudgetConsumed)
+}
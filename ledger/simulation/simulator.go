@@ -19,6 +19,7 @@ package simulation
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data"
@@ -27,6 +28,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/protocol"
 )
@@ -44,6 +46,22 @@ type Request struct {
 	AllowMoreLogging     bool
 	ExtraOpcodeBudget    uint64
 	TraceConfig          ExecTraceConfig
+	// SourceMaps holds source maps of programs, keyed by the hash of the program they describe.
+	// Traces of programs with a source map are annotated with source locations, and the coverage
+	// report uses the lines of their sources.
+	SourceMaps map[crypto.Digest]logic.SourceMap
+	// Coverage requests an lcov report of the coverage of the programs evaluated by the simulation.
+	Coverage bool
+	// Profile requests a profile of the opcode budget and resources used by each top level app call.
+	Profile bool
+	// UnlimitedOpcodeBudget lifts the opcode budget of the app calls, so that their cost can be measured
+	// however high it is.
+	UnlimitedOpcodeBudget bool
+	// StateOverrides, when set, is the hypothetical state the transaction group is simulated against.
+	StateOverrides *StateOverrides
+	// StateDiff requests the state diff of the transaction group: the changes of the balances, asset holdings,
+	// app states and boxes it makes.
+	StateDiff bool
 }
 
 // Latest is part of the LedgerForSimulator interface.
@@ -52,6 +70,16 @@ func (l simulatorLedger) Latest() basics.Round {
 	return l.start
 }
 
+// nextBlockHeader returns the header of the block following the start round, in which
+// transaction groups are simulated.
+func (l simulatorLedger) nextBlockHeader() (bookkeeping.BlockHeader, error) {
+	prevBlockHdr, err := l.BlockHdr(l.start)
+	if err != nil {
+		return bookkeeping.BlockHeader{}, err
+	}
+	return bookkeeping.MakeBlock(prevBlockHdr).BlockHeader, nil
+}
+
 // LookupLatest would implicitly use the latest round in the _underlying_
 // Ledger, it would give wrong results if that ledger has moved forward. But it
 // should never be called, as the REST API is the only code using this function,
@@ -88,6 +116,12 @@ type EvalFailureError struct {
 type Simulator struct {
 	ledger       simulatorLedger
 	developerAPI bool
+	// session, when set, is the evaluator of a simulation session, on top of which the group
+	// is evaluated instead of in a block of its own.
+	session *sessionEvaluator
+	// overrides, when set, applies the state overrides of the request to the ledger the group is
+	// evaluated against.
+	overrides *overrideLedger
 }
 
 // MakeSimulator creates a new simulator from a ledger.
@@ -156,22 +190,42 @@ func (s Simulator) check(hdr bookkeeping.BlockHeader, txgroup []transactions.Sig
 }
 
 func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.SignedTxn, tracer logic.EvalTracer) (*ledgercore.ValidatedBlock, error) {
-	// s.ledger has 'StartEvaluator' because *data.Ledger is embedded in the simulatorLedger
-	// and data.Ledger embeds *ledger.Ledger
-	eval, err := s.ledger.StartEvaluator(hdr, len(stxns), 0, tracer)
+	if s.session != nil {
+		// The session keeps evaluating its block, so there is no block to return.
+		err := s.session.transactionGroup(stxns, tracer)
+		if err != nil {
+			return nil, EvalFailureError{SimulatorError{err}}
+		}
+		return nil, nil
+	}
+
+	var evaluator *eval.BlockEvaluator
+	var err error
+	if s.overrides != nil {
+		evaluator, err = eval.StartEvaluator(s.overrides, hdr, eval.EvaluatorOptions{
+			PaysetHint: len(stxns),
+			Generate:   true,
+			Validate:   true,
+			Tracer:     tracer,
+		})
+	} else {
+		// s.ledger has 'StartEvaluator' because *data.Ledger is embedded in the simulatorLedger
+		// and data.Ledger embeds *ledger.Ledger
+		evaluator, err = s.ledger.StartEvaluator(hdr, len(stxns), 0, tracer)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	group := transactions.WrapSignedTxnsWithAD(stxns)
 
-	err = eval.TransactionGroup(group)
+	err = evaluator.TransactionGroup(group)
 	if err != nil {
 		return nil, EvalFailureError{SimulatorError{err}}
 	}
 
 	// Finally, process any pending end-of-block state changes.
-	vb, err := eval.GenerateBlock()
+	vb, err := evaluator.GenerateBlock()
 	if err != nil {
 		return nil, err
 	}
@@ -179,13 +233,52 @@ func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.Si
 	return vb, nil
 }
 
+// checkSessionRequest refuses the groups a simulation session could only apply by relaxing the rules
+// of the ledger: the session would keep their effects for the following groups, although they could
+// never be committed.
+func checkSessionRequest(request Request) error {
+	var relaxed string
+	switch {
+	case request.ExtraOpcodeBudget != 0:
+		relaxed = "an extra opcode budget"
+	case request.UnlimitedOpcodeBudget:
+		relaxed = "an unlimited opcode budget"
+	case request.AllowMoreLogging:
+		relaxed = "more logging"
+	}
+	if relaxed != "" {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("simulation sessions only apply groups evaluated within the limits of the ledger, not with %s", relaxed),
+			},
+		}
+	}
+
+	if request.AllowEmptySignatures {
+		for i, stxn := range request.TxnGroups[0] {
+			if txnHasNoSignature(stxn) {
+				return InvalidRequestError{
+					SimulatorError{
+						err: fmt.Errorf("transaction %d of the group has no signature: simulation sessions only apply signed groups", i),
+					},
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (s Simulator) simulateWithTracer(txgroup []transactions.SignedTxn, tracer logic.EvalTracer, overrides ResultEvalOverrides) (*ledgercore.ValidatedBlock, error) {
-	prevBlockHdr, err := s.ledger.BlockHdr(s.ledger.start)
+	var hdr bookkeeping.BlockHeader
+	var err error
+	if s.overrides != nil {
+		hdr, err = s.overrides.nextBlockHeader()
+	} else {
+		hdr, err = s.ledger.nextBlockHeader()
+	}
 	if err != nil {
 		return nil, err
 	}
-	nextBlock := bookkeeping.MakeBlock(prevBlockHdr)
-	hdr := nextBlock.BlockHeader
 
 	// check that the transaction is well-formed and mark whether signatures are missing
 	err = s.check(hdr, txgroup, tracer, overrides)
@@ -223,6 +316,36 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 		}
 	}
 
+	if simulateRequest.StateOverrides != nil {
+		if s.session != nil {
+			return Result{}, InvalidRequestError{
+				SimulatorError{
+					err: errors.New("state overrides are not supported in simulation sessions"),
+				},
+			}
+		}
+		s.overrides, err = makeOverrideLedger(s.ledger, *simulateRequest.StateOverrides)
+		if err != nil {
+			return Result{}, err
+		}
+		simulatorTracer.result.LastRound = s.overrides.prevHeader.Round
+	}
+
+	if simulateRequest.StateDiff && s.session != nil {
+		return Result{}, InvalidRequestError{
+			SimulatorError{
+				err: errors.New("state diffs are not supported in simulation sessions"),
+			},
+		}
+	}
+
+	if s.session != nil {
+		err = checkSessionRequest(simulateRequest)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
 	block, err := s.simulateWithTracer(simulateRequest.TxnGroups[0], simulatorTracer, simulatorTracer.result.EvalOverrides)
 	if err != nil {
 		var verifyError *verify.TxGroupError
@@ -245,6 +368,27 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 
 	simulatorTracer.result.Block = block
 
+	// A group which failed has no effect, and so no state diff.
+	if simulateRequest.StateDiff && block != nil {
+		var l stateDiffLedger = s.ledger
+		if s.overrides != nil {
+			l = s.overrides
+		}
+		simulatorTracer.result.StateDiff, err = makeStateDiff(l, block)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
+	if simulatorTracer.coverage != nil {
+		var report strings.Builder
+		err = simulatorTracer.coverage.WriteLcov(&report, simulateRequest.SourceMaps)
+		if err != nil {
+			return Result{}, err
+		}
+		simulatorTracer.result.CoverageReport = report.String()
+	}
+
 	// Update total cost by aggregating individual txn costs
 	totalCost := uint64(0)
 	for _, txn := range simulatorTracer.result.TxnGroups[0].Txns {
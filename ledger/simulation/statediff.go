@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"sort"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// StateDiff is the effect of a simulated transaction group on the ledger state, so that wallets can preview what a
+// group does without interpreting its execution trace.
+type StateDiff struct {
+	Accounts      []AccountDiff
+	AssetHoldings []AssetHoldingDiff
+	AppStates     []AppStateDiff
+	Boxes         []BoxDiff
+}
+
+// AccountDiff is the change of the balance, or of the minimum balance, of an account.
+type AccountDiff struct {
+	Address          basics.Address
+	BalanceBefore    basics.MicroAlgos
+	BalanceAfter     basics.MicroAlgos
+	MinBalanceBefore basics.MicroAlgos
+	MinBalanceAfter  basics.MicroAlgos
+}
+
+// AssetHoldingDiff is the change of the holding of an asset by an account. OptedIn is set when the account opted
+// into the asset, and OptedOut when it opted out of it.
+type AssetHoldingDiff struct {
+	Address      basics.Address
+	Asset        basics.AssetIndex
+	AmountBefore uint64
+	AmountAfter  uint64
+	OptedIn      bool
+	OptedOut     bool
+}
+
+// AppStateDiff is the change of a key of the global state of an app, or of the local state of an account in an
+// app. Before is nil when the key was not set, and After when it was deleted.
+type AppStateDiff struct {
+	App      basics.AppIndex
+	AppState AppStateKind
+	// Account is the account whose local state changed, for LocalState changes.
+	Account basics.Address
+	Key     string
+	Before  *basics.TealValue
+	After   *basics.TealValue
+}
+
+// BoxDiff is the change of a box. SizeBefore is nil when the box was created, and SizeAfter when it was deleted.
+type BoxDiff struct {
+	App        basics.AppIndex
+	Name       string
+	SizeBefore *uint64
+	SizeAfter  *uint64
+}
+
+// stateDiffLedger is the ledger state the transaction groups are simulated against.
+type stateDiffLedger interface {
+	BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error)
+	LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error)
+	LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error)
+	LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error)
+}
+
+// makeStateDiff computes the state diff of the block a transaction group was simulated in, against the state of
+// the round before it.
+func makeStateDiff(l stateDiffLedger, block *ledgercore.ValidatedBlock) (*StateDiff, error) {
+	hdr := block.Block().BlockHeader
+	delta := block.Delta()
+	prev := hdr.Round - 1
+	prevHdr, err := l.BlockHdr(prev)
+	if err != nil {
+		return nil, err
+	}
+	prevProto := config.Consensus[prevHdr.CurrentProtocol]
+	proto := config.Consensus[hdr.CurrentProtocol]
+
+	diff := StateDiff{}
+	for _, record := range delta.Accts.Accts {
+		before, _, err := l.LookupWithoutRewards(prev, record.Addr)
+		if err != nil {
+			return nil, err
+		}
+		before = before.WithUpdatedRewards(prevProto, prevHdr.RewardsLevel)
+		account := AccountDiff{
+			Address:          record.Addr,
+			BalanceBefore:    before.MicroAlgos,
+			BalanceAfter:     record.MicroAlgos,
+			MinBalanceBefore: minBalance(before, &prevProto),
+			MinBalanceAfter:  minBalance(record.AccountData, &proto),
+		}
+		if account.BalanceBefore == account.BalanceAfter && account.MinBalanceBefore == account.MinBalanceAfter {
+			continue
+		}
+		diff.Accounts = append(diff.Accounts, account)
+	}
+
+	for _, record := range delta.Accts.AssetResources {
+		if record.Holding.Holding == nil && !record.Holding.Deleted {
+			continue
+		}
+		before, err := l.LookupAsset(prev, record.Addr, record.Aidx)
+		if err != nil {
+			return nil, err
+		}
+		holding := AssetHoldingDiff{Address: record.Addr, Asset: record.Aidx}
+		if before.AssetHolding != nil {
+			holding.AmountBefore = before.AssetHolding.Amount
+		}
+		if record.Holding.Holding != nil {
+			holding.AmountAfter = record.Holding.Holding.Amount
+		}
+		holding.OptedIn = before.AssetHolding == nil && !record.Holding.Deleted
+		holding.OptedOut = before.AssetHolding != nil && record.Holding.Deleted
+		if holding.AmountBefore == holding.AmountAfter && !holding.OptedIn && !holding.OptedOut {
+			continue
+		}
+		diff.AssetHoldings = append(diff.AssetHoldings, holding)
+	}
+
+	for _, record := range delta.Accts.AppResources {
+		globalChanged := record.Params.Params != nil || record.Params.Deleted
+		localChanged := record.State.LocalState != nil || record.State.Deleted
+		if !globalChanged && !localChanged {
+			continue
+		}
+		before, err := l.LookupApplication(prev, record.Addr, record.Aidx)
+		if err != nil {
+			return nil, err
+		}
+		if globalChanged {
+			var beforeState, afterState basics.TealKeyValue
+			if before.AppParams != nil {
+				beforeState = before.AppParams.GlobalState
+			}
+			if record.Params.Params != nil {
+				afterState = record.Params.Params.GlobalState
+			}
+			diff.AppStates = appendTealKeyValueDiffs(diff.AppStates, AppStateDiff{App: record.Aidx, AppState: GlobalState}, beforeState, afterState)
+		}
+		if localChanged {
+			var beforeState, afterState basics.TealKeyValue
+			if before.AppLocalState != nil {
+				beforeState = before.AppLocalState.KeyValue
+			}
+			if record.State.LocalState != nil {
+				afterState = record.State.LocalState.KeyValue
+			}
+			diff.AppStates = appendTealKeyValueDiffs(diff.AppStates, AppStateDiff{App: record.Aidx, AppState: LocalState, Account: record.Addr}, beforeState, afterState)
+		}
+	}
+
+	keys := make([]string, 0, len(delta.KvMods))
+	for key := range delta.KvMods {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		appID, name, err := apps.SplitBoxKey(key)
+		if err != nil {
+			continue
+		}
+		mod := delta.KvMods[key]
+		box := BoxDiff{App: basics.AppIndex(appID), Name: name}
+		if mod.OldData != nil {
+			size := uint64(len(mod.OldData))
+			box.SizeBefore = &size
+		}
+		if mod.Data != nil {
+			size := uint64(len(mod.Data))
+			box.SizeAfter = &size
+		}
+		if box.SizeBefore == nil && box.SizeAfter == nil {
+			// created and deleted by the group
+			continue
+		}
+		diff.Boxes = append(diff.Boxes, box)
+	}
+	return &diff, nil
+}
+
+// minBalance returns the minimum balance of an account, which is 0 for the accounts which do not exist.
+func minBalance(data ledgercore.AccountData, proto *config.ConsensusParams) basics.MicroAlgos {
+	if data.IsZero() {
+		return basics.MicroAlgos{}
+	}
+	return data.MinBalance(proto)
+}
+
+// appendTealKeyValueDiffs appends the changes from before to after of an app state to diffs, by key.
+func appendTealKeyValueDiffs(diffs []AppStateDiff, template AppStateDiff, before, after basics.TealKeyValue) []AppStateDiff {
+	keys := make([]string, 0, len(before)+len(after))
+	for key := range before {
+		keys = append(keys, key)
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		beforeValue, hadBefore := before[key]
+		afterValue, hasAfter := after[key]
+		if hadBefore && hasAfter && beforeValue == afterValue {
+			continue
+		}
+		change := template
+		change.Key = key
+		if hadBefore {
+			change.Before = &beforeValue
+		}
+		if hasAfter {
+			change.After = &afterValue
+		}
+		diffs = append(diffs, change)
+	}
+	return diffs
+}
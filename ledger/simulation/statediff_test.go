@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSimulateStateDiff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	futureAppID := basics.AppIndex(1002)
+
+	approval := `#pragma version 8
+byte "box"
+int 8
+box_create
+pop
+byte "counter"
+int 7
+app_global_put
+int 1`
+
+	fund := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: futureAppID.Address(),
+		Amount:   200_000,
+	})
+	create := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		ApprovalProgram:   approval,
+		ClearStateProgram: "#pragma version 8\nint 1",
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+		Boxes:             []transactions.BoxRef{{Name: []byte("box")}},
+	})
+	txgroup := txntest.Group(&fund, &create)
+	for i := range txgroup {
+		txgroup[i] = txgroup[i].Txn.Sign(sender.Sk)
+	}
+
+	simulator := simulation.MakeSimulator(env.Ledger, false)
+
+	// the state diff is only computed on request
+	result, err := simulator.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{txgroup}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Nil(t, result.StateDiff)
+
+	result, err = simulator.Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{txgroup},
+		StateDiff: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.NotNil(t, result.StateDiff)
+	diff := result.StateDiff
+
+	proto := env.TxnInfo.CurrentProtocolParams()
+	accounts := make(map[basics.Address]simulation.AccountDiff)
+	for _, account := range diff.Accounts {
+		accounts[account.Address] = account
+	}
+
+	app, ok := accounts[futureAppID.Address()]
+	require.True(t, ok)
+	boxMinBalance := proto.BoxFlatMinBalance + proto.BoxByteMinBalance*uint64(len("box")+8)
+	require.Equal(t, simulation.AccountDiff{
+		Address:          futureAppID.Address(),
+		BalanceAfter:     basics.MicroAlgos{Raw: 200_000},
+		MinBalanceAfter:  basics.MicroAlgos{Raw: proto.MinBalance + boxMinBalance},
+		BalanceBefore:    basics.MicroAlgos{},
+		MinBalanceBefore: basics.MicroAlgos{},
+	}, app)
+
+	creator, ok := accounts[sender.Addr]
+	require.True(t, ok)
+	require.Less(t, creator.BalanceAfter.Raw, creator.BalanceBefore.Raw)
+	appMinBalance := proto.AppFlatParamsMinBalance + proto.SchemaMinBalancePerEntry + proto.SchemaUintMinBalance
+	require.Equal(t, creator.MinBalanceBefore.Raw+appMinBalance, creator.MinBalanceAfter.Raw)
+
+	require.Empty(t, diff.AssetHoldings)
+	require.Equal(t, []simulation.AppStateDiff{{
+		App:      futureAppID,
+		AppState: simulation.GlobalState,
+		Key:      "counter",
+		After:    &basics.TealValue{Type: basics.TealUintType, Uint: 7},
+	}}, diff.AppStates)
+
+	boxSize := uint64(8)
+	require.Equal(t, []simulation.BoxDiff{{
+		App:       futureAppID,
+		Name:      "box",
+		SizeAfter: &boxSize,
+	}}, diff.Boxes)
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -36,6 +37,37 @@ type TxnResult struct {
 	AppBudgetConsumed      uint64
 	LogicSigBudgetConsumed uint64
 	Trace                  *TransactionTrace
+	// Profile is the profile of the transaction, if requested and the transaction is an app call.
+	Profile *TxnProfile
+}
+
+// OpcodeCategoryCost is the opcode budget consumed by the opcodes of a category
+type OpcodeCategoryCost struct {
+	Category string
+	Cost     uint64
+}
+
+// TxnProfile summarizes the opcode budget and resources used by a top level app call, including
+// the inner transactions it issued, and the headroom left against the limits of its group.
+type TxnProfile struct {
+	// OpcodeCosts is the opcode budget consumed by app programs, by opcode category, sorted by category
+	OpcodeCosts []OpcodeCategoryCost
+	// BoxReadBytes is the number of box bytes read
+	BoxReadBytes uint64
+	// BoxWriteBytes is the number of box bytes written
+	BoxWriteBytes uint64
+	// InnerTxnCount is the number of inner transactions issued, at any depth
+	InnerTxnCount uint64
+	// LogBytes is the number of bytes logged, including by inner app calls
+	LogBytes uint64
+
+	// AppBudgetRemaining is the opcode budget left to the group after the transaction
+	AppBudgetRemaining uint64
+	// LogBytesRemaining is the number of bytes the program of the transaction could still have logged
+	LogBytesRemaining uint64
+	// InnerTxnsRemaining is the number of inner transactions the group may still issue, if they are
+	// pooled across the group
+	InnerTxnsRemaining *uint64
 }
 
 // TxnGroupResult contains the simulation result for a single transaction group
@@ -71,6 +103,8 @@ type ResultEvalOverrides struct {
 	MaxLogCalls          *uint64
 	MaxLogSize           *uint64
 	ExtraOpcodeBudget    uint64
+	// UnlimitedOpcodeBudget adds UnlimitedExtraOpcodeBudget to the budget of each transaction group.
+	UnlimitedOpcodeBudget bool
 }
 
 // LogBytesLimit hardcode limit of how much bytes one can log per transaction during simulation (with AllowMoreLogging)
@@ -79,6 +113,11 @@ const LogBytesLimit = uint64(65536)
 // MaxExtraOpcodeBudget hardcode limit of how much extra budget one can add to one transaction group (which is group-size * logic-sig-budget)
 const MaxExtraOpcodeBudget = uint64(20000 * 16)
 
+// UnlimitedExtraOpcodeBudget is the extra budget added to a transaction group when the opcode budget is
+// lifted. It is not exactly unlimited, so that the pooled budget never overflows, but no app call can be
+// evaluated within the time of a request with more.
+const UnlimitedExtraOpcodeBudget = uint64(1 << 30)
+
 // AllowMoreLogging method modify the log limits from lift option:
 // - if lift log limits, then overload result from local Config
 // - otherwise, set `LogLimits` field to be nil
@@ -106,7 +145,10 @@ func (eo ResultEvalOverrides) LogicEvalConstants() logic.EvalConstants {
 
 // ExecTraceConfig gathers all execution trace related configs for simulation result
 type ExecTraceConfig struct {
-	Enable bool `codec:"enable,omitempty"`
+	Enable  bool `codec:"enable,omitempty"`
+	Stack   bool `codec:"stack-change,omitempty"`
+	Scratch bool `codec:"scratch-change,omitempty"`
+	State   bool `codec:"state-change,omitempty"`
 }
 
 // Result contains the result from a call to Simulator.Simulate
@@ -117,6 +159,10 @@ type Result struct {
 	EvalOverrides ResultEvalOverrides
 	Block         *ledgercore.ValidatedBlock
 	TraceConfig   ExecTraceConfig
+	// CoverageReport is the lcov report of the coverage of the programs evaluated, if requested.
+	CoverageReport string
+	// StateDiff is the state diff of the transaction group, if requested and the group succeeded.
+	StateDiff *StateDiff
 }
 
 // ReturnTrace reads from Result object and decides if simulation returns PC.
@@ -124,9 +170,22 @@ type Result struct {
 // The other invalid options would be eliminated in validateSimulateRequest early.
 func (r Result) ReturnTrace() bool { return r.TraceConfig.Enable }
 
+// ReturnStackChange reads from Result object and decides if simulation returns stack changes.
+func (r Result) ReturnStackChange() bool { return r.TraceConfig.Stack }
+
+// ReturnScratchChange reads from Result object and decides if simulation returns scratch slot changes.
+func (r Result) ReturnScratchChange() bool { return r.TraceConfig.Scratch }
+
+// ReturnStateChange reads from Result object and decides if simulation returns application state changes.
+func (r Result) ReturnStateChange() bool { return r.TraceConfig.State }
+
 // validateSimulateRequest first checks relation between request and config variables, including developerAPI:
 // if `developerAPI` provided is turned off, this method would:
 // - error on asking for exec trace
+// - error on asking for coverage
+// - error on asking for an unlimited opcode budget or state overrides
+// It also errors on asking for stack, scratch or state changes without exec trace, or providing
+// source maps without exec trace or coverage.
 func validateSimulateRequest(request Request, developerAPI bool) error {
 	if !developerAPI && request.TraceConfig.Enable {
 		return InvalidRequestError{
@@ -135,9 +194,75 @@ func validateSimulateRequest(request Request, developerAPI bool) error {
 			},
 		}
 	}
+	if !developerAPI && request.Coverage {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("the local configuration of the node has `EnableDeveloperAPI` turned off, while requesting for coverage"),
+			},
+		}
+	}
+	if !developerAPI && request.UnlimitedOpcodeBudget {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("the local configuration of the node has `EnableDeveloperAPI` turned off, while requesting for an unlimited opcode budget"),
+			},
+		}
+	}
+	if !developerAPI && request.StateOverrides != nil {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("the local configuration of the node has `EnableDeveloperAPI` turned off, while requesting for state overrides"),
+			},
+		}
+	}
+	if !request.TraceConfig.Enable {
+		if request.TraceConfig.Stack || request.TraceConfig.Scratch || request.TraceConfig.State {
+			return InvalidRequestError{
+				SimulatorError{
+					err: fmt.Errorf("basic trace must be enabled when requesting for stack, scratch or state changes"),
+				},
+			}
+		}
+		if len(request.SourceMaps) != 0 && !request.Coverage {
+			return InvalidRequestError{
+				SimulatorError{
+					err: fmt.Errorf("basic trace or coverage must be enabled when providing source maps"),
+				},
+			}
+		}
+	}
 	return nil
 }
 
+// decodeSourceMaps decodes the source maps of a request into the source locations of every
+// mapped PC, keyed by the hash of the program they describe.
+func decodeSourceMaps(sourceMaps map[crypto.Digest]logic.SourceMap) (map[crypto.Digest]map[int]SourceLocation, error) {
+	if len(sourceMaps) == 0 {
+		return nil, nil
+	}
+	decoded := make(map[crypto.Digest]map[int]SourceLocation, len(sourceMaps))
+	for hash, sourceMap := range sourceMaps {
+		pcLocations, err := sourceMap.PCLocations()
+		if err != nil {
+			return nil, InvalidRequestError{
+				SimulatorError{
+					err: fmt.Errorf("invalid source map for program %s: %w", basics.Address(hash), err),
+				},
+			}
+		}
+		locations := make(map[int]SourceLocation, len(pcLocations))
+		for pc, location := range pcLocations {
+			locations[pc] = SourceLocation{
+				File:   sourceMap.Sources[location.Source],
+				Line:   uint64(location.Line),
+				Column: uint64(location.Column),
+			}
+		}
+		decoded[hash] = locations
+	}
+	return decoded, nil
+}
+
 func makeSimulationResult(lastRound basics.Round, request Request, developerAPI bool) (Result, error) {
 	groups := make([]TxnGroupResult, len(request.TxnGroups))
 
@@ -146,8 +271,9 @@ func makeSimulationResult(lastRound basics.Round, request Request, developerAPI
 	}
 
 	resultEvalConstants := ResultEvalOverrides{
-		AllowEmptySignatures: request.AllowEmptySignatures,
-		ExtraOpcodeBudget:    request.ExtraOpcodeBudget,
+		AllowEmptySignatures:  request.AllowEmptySignatures,
+		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
+		UnlimitedOpcodeBudget: request.UnlimitedOpcodeBudget,
 	}.AllowMoreLogging(request.AllowMoreLogging)
 
 	if err := validateSimulateRequest(request, developerAPI); err != nil {
@@ -172,6 +298,95 @@ type OpcodeTraceUnit struct {
 	// if any. These indexes refer to the InnerTraces array of the TransactionTrace object containing
 	// this OpcodeTraceUnit.
 	SpawnedInners []int
+
+	// StackPopCount is the number of values removed from the top of the stack by this opcode.
+	StackPopCount uint64
+	// StackAdded contains the values pushed on top of the stack by this opcode, after StackPopCount
+	// values were removed, from the deepest to the topmost.
+	StackAdded []basics.TealValue
+
+	// ScratchSlotChanges contains the scratch slots written by this opcode, with their new values.
+	ScratchSlotChanges []ScratchChange
+
+	// StateChanges contains the application state operations performed by this opcode.
+	StateChanges []StateOperation
+
+	// Source is the location of this opcode in the source code of the program, if a source map
+	// was provided for the program.
+	Source *SourceLocation
+}
+
+// ScratchChange is a write to a scratch slot.
+type ScratchChange struct {
+	Slot     uint64
+	NewValue basics.TealValue
+}
+
+// AppStateOperation is the kind of operation performed on an application state.
+type AppStateOperation uint64
+
+const (
+	// AppStateWrite writes a value to an application state key.
+	AppStateWrite AppStateOperation = iota + 1
+	// AppStateDelete deletes an application state key.
+	AppStateDelete
+)
+
+// String returns the REST API representation of the operation.
+func (op AppStateOperation) String() string {
+	switch op {
+	case AppStateWrite:
+		return "w"
+	case AppStateDelete:
+		return "d"
+	default:
+		return ""
+	}
+}
+
+// AppStateKind is the kind of application state an operation applies to.
+type AppStateKind uint64
+
+const (
+	// GlobalState is the global state of an application.
+	GlobalState AppStateKind = iota + 1
+	// LocalState is the local state of an application for an account.
+	LocalState
+	// BoxState is the box storage of an application.
+	BoxState
+)
+
+// String returns the REST API representation of the application state kind.
+func (kind AppStateKind) String() string {
+	switch kind {
+	case GlobalState:
+		return "g"
+	case LocalState:
+		return "l"
+	case BoxState:
+		return "b"
+	default:
+		return ""
+	}
+}
+
+// StateOperation is an operation on the state of the application being evaluated.
+type StateOperation struct {
+	Operation AppStateOperation
+	AppState  AppStateKind
+	// Account is the account whose local state is modified, for LocalState operations.
+	Account basics.Address
+	// Key is the state key, or the box name for BoxState operations.
+	Key string
+	// NewValue is the value written by AppStateWrite operations.
+	NewValue basics.TealValue
+}
+
+// SourceLocation is a location in the source code of a program.
+type SourceLocation struct {
+	File   string
+	Line   uint64
+	Column uint64
 }
 
 // TransactionTrace contains the trace effects of a single transaction evaluation (including its inners)
@@ -188,4 +403,14 @@ type TransactionTrace struct {
 	// object only contains traces for inners that are immediate children of this transaction.
 	// Grandchild traces will be present inside the TransactionTrace of their parent.
 	InnerTraces []TransactionTrace
+
+	// stackSnapshot is the stack of the program being evaluated as of the latest opcode, from
+	// which the stack changes of the next opcode are computed.
+	stackSnapshot []basics.TealValue
+	// sourceLocations maps the PCs of the program being evaluated to their source locations.
+	sourceLocations map[int]SourceLocation
+	// pendingScratchSlot is the scratch slot written by the opcode being evaluated, if any.
+	pendingScratchSlot *int
+	// pendingStateChange is the state operation performed by the opcode being evaluated, if any.
+	pendingStateChange *pendingStateOperation
 }
@@ -19,6 +19,7 @@ package simulation
 import (
 	"fmt"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -88,6 +89,21 @@ type evalTracer struct {
 	// from top level transaction to the current inner txn that contains latest TransactionTrace.
 	// NOTE: execTraceStack is used only for PC/Stack/Storage exposure.
 	execTraceStack []*TransactionTrace
+
+	// sourceLocations maps the hashes of programs with a source map to the source locations of their PCs.
+	sourceLocations map[crypto.Digest]map[int]SourceLocation
+
+	// coverage records the coverage of the programs evaluated, if requested.
+	coverage *logic.CoverageTracer
+
+	// profile is set if profiles of the top level app calls are requested.
+	profile bool
+	// opcodeCosts accumulates the opcode budget consumed by category, for the profile of the
+	// top level app call being evaluated.
+	opcodeCosts map[string]uint64
+	// pendingOpcodes holds the profiling state of the opcode being evaluated by each app program
+	// under evaluation, the innermost last.
+	pendingOpcodes []opcodeProfile
 }
 
 func makeEvalTracer(lastRound basics.Round, request Request, developerAPI bool) (*evalTracer, error) {
@@ -95,7 +111,15 @@ func makeEvalTracer(lastRound basics.Round, request Request, developerAPI bool)
 	if err != nil {
 		return nil, err
 	}
-	return &evalTracer{result: &result}, nil
+	sourceLocations, err := decodeSourceMaps(request.SourceMaps)
+	if err != nil {
+		return nil, err
+	}
+	tracer := &evalTracer{result: &result, sourceLocations: sourceLocations, profile: request.Profile}
+	if request.Coverage {
+		tracer.coverage = logic.MakeCoverageTracer()
+	}
+	return tracer, nil
 }
 
 func (tracer *evalTracer) handleError(evalError error) {
@@ -153,6 +177,11 @@ func (tracer *evalTracer) BeforeTxnGroup(ep *logic.EvalParams) {
 	if ep.PooledApplicationBudget != nil {
 		tracer.result.TxnGroups[0].AppBudgetAdded += tracer.result.EvalOverrides.ExtraOpcodeBudget
 		*ep.PooledApplicationBudget += int(tracer.result.EvalOverrides.ExtraOpcodeBudget)
+		// the inner groups share the budget of the top level group
+		if tracer.result.EvalOverrides.UnlimitedOpcodeBudget && ep.GetCaller() == nil {
+			tracer.result.TxnGroups[0].AppBudgetAdded += UnlimitedExtraOpcodeBudget
+			*ep.PooledApplicationBudget += int(UnlimitedExtraOpcodeBudget)
+		}
 	}
 
 	// Override runtime related constraints against ep, before entering txn group
@@ -173,6 +202,11 @@ func (tracer *evalTracer) saveApplyData(applyData transactions.ApplyData) {
 }
 
 func (tracer *evalTracer) BeforeTxn(ep *logic.EvalParams, groupIndex int) {
+	if tracer.profile && ep.GetCaller() == nil && ep.TxnGroup[groupIndex].Txn.Type == protocol.ApplicationCallTx {
+		tracer.result.TxnGroups[0].Txns[groupIndex].Profile = &TxnProfile{}
+		tracer.opcodeCosts = make(map[string]uint64)
+	}
+
 	if tracer.result.ReturnTrace() {
 		var txnTraceStackElem *TransactionTrace
 
@@ -222,6 +256,12 @@ func (tracer *evalTracer) BeforeTxn(ep *logic.EvalParams, groupIndex int) {
 func (tracer *evalTracer) AfterTxn(ep *logic.EvalParams, groupIndex int, ad transactions.ApplyData, evalError error) {
 	tracer.handleError(evalError)
 	tracer.saveApplyData(ad)
+	if ep.GetCaller() == nil {
+		if profile := tracer.result.TxnGroups[0].Txns[groupIndex].Profile; profile != nil {
+			finishProfile(profile, tracer.opcodeCosts, tracer.mustGetApplyDataAtPath(tracer.absolutePath()), ep)
+			tracer.opcodeCosts = nil
+		}
+	}
 	// if the current transaction + simulation condition would lead to exec trace making
 	// we should clean them up from tracer.execTraceStack.
 	if tracer.result.ReturnTrace() {
@@ -241,8 +281,193 @@ func (tracer *evalTracer) saveEvalDelta(evalDelta transactions.EvalDelta, appIDT
 	applyDataOfCurrentTxn.EvalDelta.InnerTxns = inners
 }
 
-func (tracer *evalTracer) makeOpcodeTraceUnit(cx *logic.EvalContext) OpcodeTraceUnit {
-	return OpcodeTraceUnit{PC: uint64(cx.PC())}
+func (tracer *evalTracer) makeOpcodeTraceUnit(cx *logic.EvalContext, txnTrace *TransactionTrace) OpcodeTraceUnit {
+	unit := OpcodeTraceUnit{PC: uint64(cx.PC())}
+	if location, ok := txnTrace.sourceLocations[cx.PC()]; ok {
+		unit.Source = &location
+	}
+	return unit
+}
+
+// currentTrace returns the TransactionTrace of the program being evaluated.
+func (tracer *evalTracer) currentTrace(cx *logic.EvalContext) *TransactionTrace {
+	if cx.RunMode() == logic.ModeSig {
+		return tracer.result.TxnGroups[0].Txns[cx.GroupIndex()].Trace
+	}
+	return tracer.execTraceStack[len(tracer.execTraceStack)-1]
+}
+
+// scratchSlotWritten returns the scratch slot the opcode about to be evaluated writes, if any.
+func scratchSlotWritten(cx *logic.EvalContext) *int {
+	var slot int
+	switch cx.GetOpSpec().Name {
+	case "store":
+		program := cx.GetProgram()
+		if cx.PC()+1 >= len(program) {
+			return nil
+		}
+		slot = int(program[cx.PC()+1])
+	case "stores":
+		height := cx.StackHeight()
+		if height < 2 {
+			return nil
+		}
+		value := cx.StackValue(height - 2)
+		if value.Type != basics.TealUintType || value.Uint >= 256 {
+			return nil
+		}
+		slot = int(value.Uint)
+	default:
+		return nil
+	}
+	return &slot
+}
+
+// localStateAccount resolves the account argument of app_local_put and app_local_del, which is
+// either an address or an index in the accounts array of the transaction.
+func localStateAccount(cx *logic.EvalContext, account basics.TealValue) (basics.Address, bool) {
+	if account.Type == basics.TealUintType {
+		txn := cx.TxnGroup[cx.GroupIndex()].Txn
+		addr, err := txn.AddressByIndex(account.Uint, txn.Sender)
+		return addr, err == nil
+	}
+	var addr basics.Address
+	if len(account.Bytes) != len(addr) {
+		return basics.Address{}, false
+	}
+	copy(addr[:], account.Bytes)
+	return addr, true
+}
+
+// pendingStateOperation is the state operation of the opcode being evaluated, completed once the
+// opcode has been evaluated.
+type pendingStateOperation struct {
+	StateOperation
+	// conditional is set for opcodes that push whether they changed the state
+	conditional bool
+}
+
+// stateOperation returns the application state operation the opcode about to be evaluated
+// performs, if any. The new value of boxes is only known after the opcode is evaluated, and is
+// filled in by completeStateOperation.
+func stateOperation(cx *logic.EvalContext) *pendingStateOperation {
+	height := cx.StackHeight()
+	// the stack value at depth i below the top, for an opcode taking n arguments
+	arg := func(n, i int) (basics.TealValue, bool) {
+		if height < n {
+			return basics.TealValue{}, false
+		}
+		return cx.StackValue(height - 1 - i), true
+	}
+	switch cx.GetOpSpec().Name {
+	case "app_global_put":
+		key, ok := arg(2, 1)
+		if !ok {
+			return nil
+		}
+		value, _ := arg(2, 0)
+		return &pendingStateOperation{StateOperation: StateOperation{Operation: AppStateWrite, AppState: GlobalState, Key: key.Bytes, NewValue: value}}
+	case "app_global_del":
+		key, ok := arg(1, 0)
+		if !ok {
+			return nil
+		}
+		return &pendingStateOperation{StateOperation: StateOperation{Operation: AppStateDelete, AppState: GlobalState, Key: key.Bytes}}
+	case "app_local_put":
+		account, ok := arg(3, 2)
+		if !ok {
+			return nil
+		}
+		addr, ok := localStateAccount(cx, account)
+		if !ok {
+			return nil
+		}
+		key, _ := arg(3, 1)
+		value, _ := arg(3, 0)
+		return &pendingStateOperation{StateOperation: StateOperation{Operation: AppStateWrite, AppState: LocalState, Account: addr, Key: key.Bytes, NewValue: value}}
+	case "app_local_del":
+		account, ok := arg(2, 1)
+		if !ok {
+			return nil
+		}
+		addr, ok := localStateAccount(cx, account)
+		if !ok {
+			return nil
+		}
+		key, _ := arg(2, 0)
+		return &pendingStateOperation{StateOperation: StateOperation{Operation: AppStateDelete, AppState: LocalState, Account: addr, Key: key.Bytes}}
+	case "box_create":
+		name, ok := arg(2, 1)
+		if !ok {
+			return nil
+		}
+		return &pendingStateOperation{StateOperation: StateOperation{Operation: AppStateWrite, AppState: BoxState, Key: name.Bytes}, conditional: true}
+	case "box_put":
+		name, ok := arg(2, 1)
+		if !ok {
+			return nil
+		}
+		return &pendingStateOperation{StateOperation: StateOperation{Operation: AppStateWrite, AppState: BoxState, Key: name.Bytes}}
+	case "box_replace":
+		name, ok := arg(3, 2)
+		if !ok {
+			return nil
+		}
+		return &pendingStateOperation{StateOperation: StateOperation{Operation: AppStateWrite, AppState: BoxState, Key: name.Bytes}}
+	case "box_del":
+		name, ok := arg(1, 0)
+		if !ok {
+			return nil
+		}
+		return &pendingStateOperation{StateOperation: StateOperation{Operation: AppStateDelete, AppState: BoxState, Key: name.Bytes}, conditional: true}
+	}
+	return nil
+}
+
+// completeStateOperation completes a state operation once its opcode has been evaluated. It
+// returns false if the opcode turned out to leave the state unchanged.
+func completeStateOperation(cx *logic.EvalContext, op *pendingStateOperation) bool {
+	if op.conditional {
+		height := cx.StackHeight()
+		if height == 0 || !cx.StackValueEquals(height-1, basics.TealValue{Type: basics.TealUintType, Uint: 1}) {
+			return false
+		}
+	}
+	if op.AppState == BoxState && op.Operation == AppStateWrite {
+		box, _, err := cx.Ledger.GetBox(cx.AppID(), op.Key)
+		if err != nil {
+			return false
+		}
+		op.NewValue = basics.TealValue{Type: basics.TealBytesType, Bytes: string(box)}
+	}
+	return true
+}
+
+// recordStackChange records the difference between the stack before and after the opcode, as
+// the number of values removed from the stack and the values then pushed on top of it.
+func (trace *TransactionTrace) recordStackChange(cx *logic.EvalContext, unit *OpcodeTraceUnit) {
+	previous := trace.stackSnapshot
+	height := cx.StackHeight()
+
+	unchanged := 0
+	for unchanged < len(previous) && unchanged < height && cx.StackValueEquals(unchanged, previous[unchanged]) {
+		unchanged++
+	}
+
+	unit.StackPopCount = uint64(len(previous) - unchanged)
+	if height > unchanged {
+		unit.StackAdded = make([]basics.TealValue, height-unchanged)
+		for i := range unit.StackAdded {
+			unit.StackAdded[i] = cx.StackValue(unchanged + i)
+		}
+	}
+	trace.stackSnapshot = append(previous[:unchanged], unit.StackAdded...)
+}
+
+// profiling reports whether the program being evaluated is profiled, which is the case for the
+// app programs of top level app calls with a profile, and of their inner app calls.
+func (tracer *evalTracer) profiling(cx *logic.EvalContext) bool {
+	return tracer.opcodeCosts != nil && cx.RunMode() == logic.ModeApp
 }
 
 func (tracer *evalTracer) BeforeOpcode(cx *logic.EvalContext) {
@@ -259,18 +484,64 @@ func (tracer *evalTracer) BeforeOpcode(cx *logic.EvalContext) {
 		tracer.saveEvalDelta(cx.TxnGroup[groupIndex].EvalDelta, appIDToSave)
 	}
 
+	if tracer.coverage != nil {
+		tracer.coverage.BeforeOpcode(cx)
+	}
+
+	if tracer.profiling(cx) {
+		tracer.pendingOpcodes[len(tracer.pendingOpcodes)-1] = profileOpcode(cx)
+	}
+
 	if tracer.result.ReturnTrace() {
-		var txnTrace *TransactionTrace
-		if cx.RunMode() == logic.ModeSig {
-			txnTrace = tracer.result.TxnGroups[0].Txns[groupIndex].Trace
-		} else {
-			txnTrace = tracer.execTraceStack[len(tracer.execTraceStack)-1]
+		txnTrace := tracer.currentTrace(cx)
+		*txnTrace.programTraceRef = append(*txnTrace.programTraceRef, tracer.makeOpcodeTraceUnit(cx, txnTrace))
+
+		txnTrace.pendingScratchSlot = nil
+		if tracer.result.ReturnScratchChange() {
+			txnTrace.pendingScratchSlot = scratchSlotWritten(cx)
+		}
+		txnTrace.pendingStateChange = nil
+		if tracer.result.ReturnStateChange() && cx.RunMode() == logic.ModeApp {
+			txnTrace.pendingStateChange = stateOperation(cx)
+		}
+	}
+}
+
+// recordOpcodeEffects records the stack, scratch and state changes of the opcode that was just
+// evaluated in its OpcodeTraceUnit.
+func (tracer *evalTracer) recordOpcodeEffects(cx *logic.EvalContext, evalError error) {
+	txnTrace := tracer.currentTrace(cx)
+	unit := &(*txnTrace.programTraceRef)[len(*txnTrace.programTraceRef)-1]
+
+	if tracer.result.ReturnStackChange() {
+		txnTrace.recordStackChange(cx, unit)
+	}
+	if evalError == nil {
+		if slot := txnTrace.pendingScratchSlot; slot != nil {
+			unit.ScratchSlotChanges = append(unit.ScratchSlotChanges, ScratchChange{
+				Slot:     uint64(*slot),
+				NewValue: cx.ScratchValue(*slot),
+			})
+		}
+		if op := txnTrace.pendingStateChange; op != nil && completeStateOperation(cx, op) {
+			unit.StateChanges = append(unit.StateChanges, op.StateOperation)
 		}
-		*txnTrace.programTraceRef = append(*txnTrace.programTraceRef, tracer.makeOpcodeTraceUnit(cx))
 	}
+	txnTrace.pendingScratchSlot = nil
+	txnTrace.pendingStateChange = nil
 }
 
 func (tracer *evalTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
+	if tracer.coverage != nil {
+		tracer.coverage.AfterOpcode(cx, evalError)
+	}
+	if tracer.profiling(cx) {
+		op := tracer.pendingOpcodes[len(tracer.pendingOpcodes)-1]
+		op.complete(cx, evalError, tracer.opcodeCosts, tracer.result.TxnGroups[0].Txns[tracer.relativeCursor[0]].Profile)
+	}
+	if tracer.result.ReturnTrace() {
+		tracer.recordOpcodeEffects(cx, evalError)
+	}
 	if cx.RunMode() != logic.ModeApp {
 		// do nothing for LogicSig ops
 		return
@@ -290,11 +561,39 @@ func (tracer *evalTracer) BeforeProgram(cx *logic.EvalContext) {
 			traceRef.programTraceRef = &traceRef.LogicSigTrace
 		}
 	}
+
+	if tracer.result.ReturnTrace() && tracer.sourceLocations != nil {
+		txnTrace := tracer.currentTrace(cx)
+		txnTrace.sourceLocations = tracer.sourceLocations[logic.HashProgram(cx.GetProgram())]
+	}
+
+	if tracer.coverage != nil {
+		tracer.coverage.BeforeProgram(cx)
+	}
+
+	if tracer.profiling(cx) {
+		tracer.pendingOpcodes = append(tracer.pendingOpcodes, opcodeProfile{})
+	}
 }
 
 func (tracer *evalTracer) AfterProgram(cx *logic.EvalContext, evalError error) {
 	groupIndex := cx.GroupIndex()
 
+	if tracer.coverage != nil {
+		tracer.coverage.AfterProgram(cx, evalError)
+	}
+
+	if tracer.profiling(cx) {
+		tracer.pendingOpcodes = tracer.pendingOpcodes[:len(tracer.pendingOpcodes)-1]
+	}
+
+	if tracer.result.ReturnTrace() {
+		// The program state kept to compute the trace is not needed anymore
+		txnTrace := tracer.currentTrace(cx)
+		txnTrace.stackSnapshot = nil
+		txnTrace.sourceLocations = nil
+	}
+
 	if cx.RunMode() != logic.ModeApp {
 		// Report cost for LogicSig program and exit
 		tracer.result.TxnGroups[0].Txns[groupIndex].LogicSigBudgetConsumed = uint64(cx.Cost())
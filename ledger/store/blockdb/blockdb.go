@@ -18,6 +18,7 @@ package blockdb
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -40,6 +41,10 @@ var blockSchema = []string{
 		certdata blob)`,
 }
 
+// ErrBlockVaulted is returned when the block of a round was moved to the block vault, which only keeps its
+// header in the blocks table.
+var ErrBlockVaulted = errors.New("block was moved to the block vault")
+
 var blockResetExprs = []string{
 	`DROP TABLE IF EXISTS blocks`,
 }
@@ -97,6 +102,11 @@ func BlockGet(tx *sql.Tx, rnd basics.Round) (blk bookkeeping.Block, err error) {
 		return
 	}
 
+	if buf == nil {
+		err = ErrBlockVaulted
+		return
+	}
+
 	err = protocol.Decode(buf, &blk)
 	return
 }
@@ -127,6 +137,9 @@ func BlockGetEncodedCert(tx *sql.Tx, rnd basics.Round) (blk []byte, cert []byte,
 
 		return
 	}
+	if blk == nil {
+		err = ErrBlockVaulted
+	}
 	return
 }
 
@@ -241,6 +254,33 @@ func BlockForgetBefore(tx *sql.Tx, rnd basics.Round) error {
 	return err
 }
 
+// BlockVaultCandidates returns up to limit rounds below rnd whose blocks are not in the block vault yet, in
+// increasing order
+func BlockVaultCandidates(tx *sql.Tx, rnd basics.Round, limit int) ([]basics.Round, error) {
+	rows, err := tx.Query("SELECT rnd FROM blocks WHERE rnd<? AND blkdata IS NOT NULL ORDER BY rnd LIMIT ?", rnd, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rounds []basics.Round
+	for rows.Next() {
+		var r basics.Round
+		err = rows.Scan(&r)
+		if err != nil {
+			return nil, err
+		}
+		rounds = append(rounds, r)
+	}
+	return rounds, rows.Err()
+}
+
+// BlockSetVaulted drops the block and certificate of a round once stored in the block vault, keeping its header
+func BlockSetVaulted(tx *sql.Tx, rnd basics.Round) error {
+	_, err := tx.Exec("UPDATE blocks SET blkdata=NULL, certdata=NULL WHERE rnd=?", rnd)
+	return err
+}
+
 // BlockStartCatchupStaging initializes catchup for catchpoint
 func BlockStartCatchupStaging(tx *sql.Tx, blk bookkeeping.Block) error {
 	// delete the old catchpointblocks table, if there is such.
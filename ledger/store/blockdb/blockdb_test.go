@@ -174,3 +174,48 @@ func TestBlockDBAppend(t *testing.T) {
 		checkBlockDB(t, tx, blocks)
 	}
 }
+
+func TestBlockDBVault(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbs, _ := storetesting.DbOpenTest(t, true)
+	storetesting.SetDbLogging(t, dbs)
+	defer dbs.Close()
+
+	tx, err := dbs.Wdb.Handle.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	blocks := randomInitChain(protocol.ConsensusCurrentVersion, 10)
+	err = BlockInit(tx, blockChainBlocks(blocks))
+	require.NoError(t, err)
+
+	rounds, err := BlockVaultCandidates(tx, 5, 3)
+	require.NoError(t, err)
+	require.Equal(t, []basics.Round{0, 1, 2}, rounds)
+
+	for _, rnd := range rounds {
+		require.NoError(t, BlockSetVaulted(tx, rnd))
+	}
+
+	rounds, err = BlockVaultCandidates(tx, 5, 10)
+	require.NoError(t, err)
+	require.Equal(t, []basics.Round{3, 4}, rounds)
+
+	// vaulted blocks keep their headers, and still count as present
+	_, err = BlockGet(tx, 1)
+	require.ErrorIs(t, err, ErrBlockVaulted)
+	_, _, err = BlockGetCert(tx, 1)
+	require.ErrorIs(t, err, ErrBlockVaulted)
+	hdr, err := BlockGetHdr(tx, 1)
+	require.NoError(t, err)
+	require.Equal(t, blocks[1].block.BlockHeader, hdr)
+
+	earliest, err := BlockEarliest(tx)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(0), earliest)
+
+	blk, err := BlockGet(tx, 3)
+	require.NoError(t, err)
+	require.Equal(t, blocks[3].block, blk)
+}
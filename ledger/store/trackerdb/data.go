@@ -202,6 +202,15 @@ type PersistedResourcesData struct {
 	Round basics.Round
 }
 
+// PersistedCreatableHolder is a resource of an asset or an application, along with the address of the account
+// holding it.
+//
+//msgp:ignore PersistedCreatableHolder
+type PersistedCreatableHolder struct {
+	PersistedResourcesData
+	Addr basics.Address
+}
+
 // PersistedOnlineAccountData is exported view of persistedOnlineAccountData
 type PersistedOnlineAccountData struct {
 	Addr        basics.Address
@@ -90,6 +90,7 @@ type AccountsReader interface {
 
 	LookupResources(addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (data PersistedResourcesData, err error)
 	LookupAllResources(addr basics.Address) (data []PersistedResourcesData, rnd basics.Round, err error)
+	LookupLimitedResources(addr basics.Address, minIdx basics.CreatableIndex, maxCreatables uint64, ctype basics.CreatableType, createdOnly bool) (data []PersistedResourcesData, rnd basics.Round, err error)
 
 	LookupKeyValue(key string) (pv PersistedKVData, err error)
 	LookupKeysByPrefix(prefix string, maxKeyNum uint64, results map[string]bool, resultCount uint64) (round basics.Round, err error)
@@ -109,6 +110,7 @@ type AccountsReaderExt interface {
 	LookupResourceDataByAddrID(accountRef AccountRef, aidx basics.CreatableIndex) (data []byte, err error)
 	TotalResources(ctx context.Context) (total uint64, err error)
 	TotalAccounts(ctx context.Context) (total uint64, err error)
+	LookupAccountAddresses(ctx context.Context) (addrs []basics.Address, rnd basics.Round, err error)
 	TotalKVs(ctx context.Context) (total uint64, err error)
 	AccountsRound() (rnd basics.Round, err error)
 	LookupOnlineAccountDataByAddress(addr basics.Address) (ref OnlineAccountRef, data []byte, err error)
@@ -118,6 +120,7 @@ type AccountsReaderExt interface {
 	OnlineAccountsAll(maxAccounts uint64) ([]PersistedOnlineAccountData, error)
 	LoadTxTail(ctx context.Context, dbRound basics.Round) (roundData []*TxTailRound, roundHash []crypto.Digest, baseRound basics.Round, err error)
 	LoadAllFullAccounts(ctx context.Context, balancesTable string, resourcesTable string, acctCb func(basics.Address, basics.AccountData)) (count int, err error)
+	LookupCreatableHolders(ctx context.Context, cidx basics.CreatableIndex, ctype basics.CreatableType, after *basics.Address, maxHolders uint64) (data []PersistedCreatableHolder, rnd basics.Round, err error)
 	// testing
 	Testing() AccountsReaderTestExt
 }
@@ -231,6 +234,30 @@ type CatchpointPendingHashesIter interface {
 	Close()
 }
 
+// RekeyHistoryReader is a reader abstraction for the rekey history tracker
+// Use with SnapshotScope
+type RekeyHistoryReader interface {
+	LookupRekeys(ctx context.Context, addr basics.Address) (rekeys []ledgercore.RekeyEvent, baseRound basics.Round, err error)
+}
+
+// RekeyHistoryWriter is a writer abstraction for the rekey history tracker
+// Use with BatchScope
+type RekeyHistoryWriter interface {
+	StoreRekeys(ctx context.Context, rekeys []ledgercore.RekeyEvent) error
+}
+
+// TotalsHistoryReader is a reader abstraction for the totals history tracker
+// Use with SnapshotScope
+type TotalsHistoryReader interface {
+	LookupTotals(ctx context.Context, minRound, maxRound basics.Round, interval uint64, limit uint64) (totals []ledgercore.RoundTotals, err error)
+}
+
+// TotalsHistoryWriter is a writer abstraction for the totals history tracker
+// Use with BatchScope
+type TotalsHistoryWriter interface {
+	StoreTotals(ctx context.Context, totals []ledgercore.RoundTotals) error
+}
+
 // SpVerificationCtxReader is a reader abstraction for stateproof verification tracker
 // Use with SnapshotScope
 type SpVerificationCtxReader interface {
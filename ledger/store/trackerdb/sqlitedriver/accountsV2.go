@@ -368,6 +368,39 @@ func (r *accountsV2Reader) TotalAccounts(ctx context.Context) (total uint64, err
 	return
 }
 
+// LookupAccountAddresses returns the addresses of all the accounts, ordered by address, along with the database
+// round.
+func (r *accountsV2Reader) LookupAccountAddresses(ctx context.Context) (addrs []basics.Address, rnd basics.Round, err error) {
+	err = db.Retry(func() error {
+		err := r.q.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id='acctbase'").Scan(&rnd)
+		if err != nil {
+			return err
+		}
+		rows, err := r.q.QueryContext(ctx, "SELECT address FROM accountbase ORDER BY address")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var addrbuf []byte
+		addrs = nil
+		for rows.Next() {
+			err := rows.Scan(&addrbuf)
+			if err != nil {
+				return err
+			}
+			var addr basics.Address
+			if len(addrbuf) != len(addr) {
+				return fmt.Errorf("lookupAccountAddresses: account DB address length mismatch: %d != %d", len(addrbuf), len(addr))
+			}
+			copy(addr[:], addrbuf)
+			addrs = append(addrs, addr)
+		}
+		return rows.Err()
+	})
+	return
+}
+
 // TotalKVs returns the total number of kv items
 func (r *accountsV2Reader) TotalKVs(ctx context.Context) (total uint64, err error) {
 	err = r.q.QueryRowContext(ctx, "SELECT count(1) FROM kvstore").Scan(&total)
@@ -517,6 +517,14 @@ func (cw *catchpointWriter) ApplyCatchpointStagingBalances(ctx context.Context,
 		"DROP TABLE IF EXISTS resources",
 		"DROP TABLE IF EXISTS kvstore",
 		"DROP TABLE IF EXISTS stateproofverification",
+		// the index of the creatable holders is rebuilt from the new tables on the ledger reload
+		"DROP TABLE IF EXISTS creatableholders",
+		// the rekey history has a gap over the rounds skipped by the catchpoint, it starts over on the ledger reload
+		"DROP TABLE IF EXISTS rekeyhistory",
+		"DELETE FROM acctrounds WHERE id = 'rekeybase'",
+		// so does the totals history
+		"DROP TABLE IF EXISTS totalshistory",
+		"DELETE FROM acctrounds WHERE id = 'totalsbase'",
 
 		"ALTER TABLE catchpointbalances RENAME TO accountbase",
 		"ALTER TABLE catchpointassetcreators RENAME TO assetcreators",
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// The creatableholders table indexes the resources by creatable and address, so that the holders of an asset, or
+// the accounts opted in an application, can be paged through by address. It is kept up to date by triggers on the
+// resources and accountbase tables, which are dropped along with these tables when a catchpoint is applied.
+var creatableHoldersTriggers = []string{
+	"creatableholders_resources_insert",
+	"creatableholders_resources_delete",
+	"creatableholders_accountbase_delete",
+}
+
+var createCreatableHoldersExprs = []string{
+	`CREATE TABLE creatableholders (
+		aidx INTEGER NOT NULL,
+		address BLOB NOT NULL,
+		PRIMARY KEY (aidx, address)) WITHOUT ROWID`,
+	`CREATE INDEX creatableholders_address_idx ON creatableholders (address)`,
+	`INSERT OR IGNORE INTO creatableholders (aidx, address)
+		SELECT resources.aidx, accountbase.address FROM resources JOIN accountbase ON accountbase.rowid = resources.addrid`,
+	`CREATE TRIGGER creatableholders_resources_insert AFTER INSERT ON resources BEGIN
+		INSERT OR IGNORE INTO creatableholders (aidx, address) SELECT NEW.aidx, address FROM accountbase WHERE rowid = NEW.addrid;
+	END`,
+	`CREATE TRIGGER creatableholders_resources_delete AFTER DELETE ON resources BEGIN
+		DELETE FROM creatableholders WHERE aidx = OLD.aidx AND address = (SELECT address FROM accountbase WHERE rowid = OLD.addrid);
+	END`,
+	`CREATE TRIGGER creatableholders_accountbase_delete AFTER DELETE ON accountbase BEGIN
+		DELETE FROM creatableholders WHERE address = OLD.address;
+	END`,
+}
+
+var dropCreatableHoldersExprs = []string{
+	`DROP TRIGGER IF EXISTS creatableholders_resources_insert`,
+	`DROP TRIGGER IF EXISTS creatableholders_resources_delete`,
+	`DROP TRIGGER IF EXISTS creatableholders_accountbase_delete`,
+	`DROP TABLE IF EXISTS creatableholders`,
+}
+
+// SetCreatableHolderIndex builds the index of the creatable holders when enabled and missing, or drops it when
+// disabled. It reports whether the index was built.
+func (s *trackerSQLStore) SetCreatableHolderIndex(ctx context.Context, enabled bool) (built bool, err error) {
+	err = s.pair.Wdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if enabled {
+			var triggers int
+			err := tx.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master WHERE type = 'trigger' AND name IN (?, ?, ?)",
+				creatableHoldersTriggers[0], creatableHoldersTriggers[1], creatableHoldersTriggers[2]).Scan(&triggers)
+			if err != nil {
+				return err
+			}
+			if triggers == len(creatableHoldersTriggers) {
+				return nil
+			}
+			built = true
+		}
+
+		// a partial index, such as the one left behind by a catchpoint, is rebuilt from scratch
+		for _, stmt := range dropCreatableHoldersExprs {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		if !enabled {
+			return nil
+		}
+		for _, stmt := range createCreatableHoldersExprs {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return built, err
+}
+
+// LookupCreatableHolders returns up to maxHolders resources of the given creatable held by accounts, along with
+// the database round: the holdings of an asset, or the local states of an application. Only the accounts with an
+// address greater than after are returned, ordered by address. It requires the index of the creatable holders.
+func (r *accountsV2Reader) LookupCreatableHolders(ctx context.Context, cidx basics.CreatableIndex, ctype basics.CreatableType, after *basics.Address, maxHolders uint64) (data []trackerdb.PersistedCreatableHolder, rnd basics.Round, err error) {
+	afterAddr := []byte{}
+	if after != nil {
+		afterAddr = after[:]
+	}
+	err = db.Retry(func() error {
+		rows, err := r.q.QueryContext(ctx, `SELECT acctrounds.rnd, creatableholders.address, accountbase.rowid, resources.data FROM acctrounds
+			LEFT JOIN creatableholders ON creatableholders.aidx = ? AND creatableholders.address > ?
+			LEFT JOIN accountbase ON accountbase.address = creatableholders.address
+			LEFT JOIN resources ON resources.addrid = accountbase.rowid AND resources.aidx = creatableholders.aidx
+			WHERE acctrounds.id = 'acctbase' ORDER BY creatableholders.address`, cidx, afterAddr)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var addrbuf, buf []byte
+		var addrid sql.NullInt64
+		data = nil
+		for rows.Next() {
+			err := rows.Scan(&rnd, &addrbuf, &addrid, &buf)
+			if err != nil {
+				return err
+			}
+			if uint64(len(data)) >= maxHolders {
+				break
+			}
+			if len(addrbuf) == 0 {
+				// the creatable has no holders, past the given address
+				break
+			}
+			if !addrid.Valid || len(buf) == 0 {
+				continue
+			}
+			holder := trackerdb.PersistedCreatableHolder{
+				PersistedResourcesData: trackerdb.PersistedResourcesData{AcctRef: sqlRowRef{addrid.Int64}, Aidx: cidx, Round: rnd},
+			}
+			if len(addrbuf) != len(holder.Addr) {
+				return fmt.Errorf("lookupCreatableHolders: account DB address length mismatch: %d != %d", len(addrbuf), len(holder.Addr))
+			}
+			copy(holder.Addr[:], addrbuf)
+			err = protocol.Decode(buf, &holder.Data)
+			if err != nil {
+				return err
+			}
+			if (ctype == basics.AssetCreatable && !holder.Data.IsAsset()) || (ctype == basics.AppCreatable && !holder.Data.IsApp()) {
+				continue
+			}
+			if !holder.Data.IsHolding() {
+				continue
+			}
+			data = append(data, holder)
+		}
+		return rows.Err()
+	})
+	return
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// The rekeyhistory table keeps the changes of the authorizing address of the accounts, one per account and round.
+// The history covers the rounds past the 'rekeybase' round of the acctrounds table: the round the database was at
+// when the history was enabled, or when a catchpoint was applied.
+const createRekeyHistoryTable = `CREATE TABLE IF NOT EXISTS rekeyhistory (
+	address BLOB NOT NULL,
+	rnd INTEGER NOT NULL,
+	authaddr BLOB NOT NULL,
+	PRIMARY KEY (address, rnd)) WITHOUT ROWID`
+
+type rekeyHistoryReader struct {
+	q db.Queryable
+}
+
+type rekeyHistoryWriter struct {
+	e db.Executable
+}
+
+// SetRekeyHistory creates the rekey history table when enabled and missing, or drops it when disabled. It returns
+// the round past which the history is kept.
+func (s *trackerSQLStore) SetRekeyHistory(ctx context.Context, enabled bool) (baseRound basics.Round, err error) {
+	err = s.pair.Wdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if !enabled {
+			if _, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS rekeyhistory"); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, "DELETE FROM acctrounds WHERE id = 'rekeybase'")
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, createRekeyHistoryTable); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO acctrounds (id, rnd) SELECT 'rekeybase', rnd FROM acctrounds WHERE id = 'acctbase'")
+		if err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id = 'rekeybase'").Scan(&baseRound)
+	})
+	return baseRound, err
+}
+
+// LookupRekeys returns the changes of the authorizing address of an account, ordered by round, along with the
+// round past which the history is kept.
+func (r *rekeyHistoryReader) LookupRekeys(ctx context.Context, addr basics.Address) (rekeys []ledgercore.RekeyEvent, baseRound basics.Round, err error) {
+	err = db.Retry(func() error {
+		err := r.q.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id = 'rekeybase'").Scan(&baseRound)
+		if err != nil {
+			return err
+		}
+
+		rows, err := r.q.QueryContext(ctx, "SELECT rnd, authaddr FROM rekeyhistory WHERE address = ? ORDER BY rnd", addr[:])
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		rekeys = nil
+		for rows.Next() {
+			rekey := ledgercore.RekeyEvent{Address: addr}
+			var authbuf []byte
+			err := rows.Scan(&rekey.Round, &authbuf)
+			if err != nil {
+				return err
+			}
+			if len(authbuf) != len(rekey.AuthAddr) {
+				return fmt.Errorf("lookupRekeys: rekey history address length mismatch: %d != %d", len(authbuf), len(rekey.AuthAddr))
+			}
+			copy(rekey.AuthAddr[:], authbuf)
+			rekeys = append(rekeys, rekey)
+		}
+		return rows.Err()
+	})
+	return
+}
+
+// StoreRekeys stores changes of the authorizing address of accounts.
+func (w *rekeyHistoryWriter) StoreRekeys(ctx context.Context, rekeys []ledgercore.RekeyEvent) error {
+	stmt, err := w.e.PrepareContext(ctx, "INSERT OR REPLACE INTO rekeyhistory (address, rnd, authaddr) VALUES (?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, rekey := range rekeys {
+		_, err = stmt.ExecContext(ctx, rekey.Address[:], rekey.Round, rekey.AuthAddr[:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
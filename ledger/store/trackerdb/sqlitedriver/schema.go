@@ -174,6 +174,9 @@ var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS catchpointfirststageinfo`,
 	`DROP TABLE IF EXISTS unfinishedcatchpoints`,
 	`DROP TABLE IF EXISTS stateproofverification`,
+	`DROP TABLE IF EXISTS creatableholders`,
+	`DROP TABLE IF EXISTS rekeyhistory`,
+	`DROP TABLE IF EXISTS totalshistory`,
 }
 
 // accountsInit fills the database using tx with initAccounts if the
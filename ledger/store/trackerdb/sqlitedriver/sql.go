@@ -30,12 +30,13 @@ import (
 // accountsDbQueries is used to cache a prepared SQL statement to look up
 // the state of a single account.
 type accountsDbQueries struct {
-	lookupAccountStmt      *sql.Stmt
-	lookupResourcesStmt    *sql.Stmt
-	lookupAllResourcesStmt *sql.Stmt
-	lookupKvPairStmt       *sql.Stmt
-	lookupKeysByRangeStmt  *sql.Stmt
-	lookupCreatorStmt      *sql.Stmt
+	lookupAccountStmt          *sql.Stmt
+	lookupResourcesStmt        *sql.Stmt
+	lookupAllResourcesStmt     *sql.Stmt
+	lookupLimitedResourcesStmt *sql.Stmt
+	lookupKvPairStmt           *sql.Stmt
+	lookupKeysByRangeStmt      *sql.Stmt
+	lookupCreatorStmt          *sql.Stmt
 }
 
 type onlineAccountsDbQueries struct {
@@ -84,6 +85,11 @@ func AccountsInitDbQueries(q db.Queryable) (*accountsDbQueries, error) {
 		return nil, err
 	}
 
+	qs.lookupLimitedResourcesStmt, err = q.Prepare("SELECT accountbase.rowid, acctrounds.rnd, resources.aidx, resources.data FROM acctrounds LEFT JOIN accountbase ON accountbase.address = ? LEFT JOIN resources ON accountbase.rowid = resources.addrid AND resources.aidx > ? WHERE id='acctbase' ORDER BY resources.aidx")
+	if err != nil {
+		return nil, err
+	}
+
 	qs.lookupKvPairStmt, err = q.Prepare("SELECT acctrounds.rnd, kvstore.key, kvstore.value FROM acctrounds LEFT JOIN kvstore ON key = ? WHERE id='acctbase';")
 	if err != nil {
 		return nil, err
@@ -418,6 +424,60 @@ func (qs *accountsDbQueries) LookupAllResources(addr basics.Address) (data []tra
 	return
 }
 
+// LookupLimitedResources returns up to maxCreatables resources of the given type associated with the given address,
+// having a creatable index greater than minIdx. When createdOnly is set, only the resources created by the address
+// are returned and counted. The resources are ordered by their creatable index.
+func (qs *accountsDbQueries) LookupLimitedResources(addr basics.Address, minIdx basics.CreatableIndex, maxCreatables uint64, ctype basics.CreatableType, createdOnly bool) (data []trackerdb.PersistedResourcesData, rnd basics.Round, err error) {
+	err = db.Retry(func() error {
+		rows, err := qs.lookupLimitedResourcesStmt.Query(addr[:], minIdx)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var addrid, aidx sql.NullInt64
+		var dbRound basics.Round
+		data = nil
+		var buf []byte
+		for rows.Next() {
+			err := rows.Scan(&addrid, &dbRound, &aidx, &buf)
+			if err != nil {
+				return err
+			}
+			rnd = dbRound
+			if uint64(len(data)) >= maxCreatables {
+				break
+			}
+			if !addrid.Valid || !aidx.Valid {
+				// we received an entry without any index. This would happen only on the first entry when there are no resources for this address.
+				if len(data) != 0 {
+					return fmt.Errorf("lookupLimitedResources: unexpected invalid result on non-first resource record: (%v, %v)", addrid.Valid, aidx.Valid)
+				}
+				break
+			}
+			var resData trackerdb.ResourcesData
+			err = protocol.Decode(buf, &resData)
+			if err != nil {
+				return err
+			}
+			if (ctype == basics.AssetCreatable && !resData.IsAsset()) || (ctype == basics.AppCreatable && !resData.IsApp()) {
+				continue
+			}
+			if createdOnly && !resData.IsOwning() {
+				continue
+			}
+			data = append(data, trackerdb.PersistedResourcesData{
+				AcctRef: sqlRowRef{addrid.Int64},
+				Aidx:    basics.CreatableIndex(aidx.Int64),
+				Data:    resData,
+				Round:   dbRound,
+			})
+		}
+		return nil
+	})
+	return
+}
+
 // LookupAccount looks up for a the account data given it's address. It returns the persistedAccountData, which includes the current database round and the matching
 // account data, if such was found. If no matching account data could be found for the given address, an empty account data would
 // be retrieved.
@@ -538,6 +598,7 @@ func (qs *accountsDbQueries) Close() {
 		&qs.lookupAccountStmt,
 		&qs.lookupResourcesStmt,
 		&qs.lookupAllResourcesStmt,
+		&qs.lookupLimitedResourcesStmt,
 		&qs.lookupKvPairStmt,
 		&qs.lookupKeysByRangeStmt,
 		&qs.lookupCreatorStmt,
@@ -39,7 +39,7 @@ type trackerSQLStore struct {
 
 // Open opens the sqlite database store
 func Open(dbFilename string, dbMem bool, log logging.Logger) (store trackerdb.Store, err error) {
-	pair, err := db.OpenPair(dbFilename, dbMem)
+	pair, err := db.OpenIncrementalVacuumPair(dbFilename, dbMem)
 	if err != nil {
 		return
 	}
@@ -130,6 +130,18 @@ func (s *trackerSQLStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err
 	return
 }
 
+func (s *trackerSQLStore) IncrementalVacuum(ctx context.Context, maxPages uint64) (stats db.IncrementalVacuumStats, err error) {
+	return s.pair.Wdb.IncrementalVacuum(ctx, maxPages)
+}
+
+func (s *trackerSQLStore) IncrementalVacuumEnabled(ctx context.Context) (bool, error) {
+	return s.pair.Wdb.IncrementalVacuumEnabled(ctx)
+}
+
+func (s *trackerSQLStore) Optimize(ctx context.Context) (err error) {
+	return s.pair.Wdb.Optimize(ctx)
+}
+
 func (s *trackerSQLStore) ResetToV6Test(ctx context.Context) error {
 	var resetExprs = []string{
 		`DROP TABLE IF EXISTS onlineaccounts`,
@@ -178,6 +190,16 @@ func (r *sqlReader) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxRea
 	return makeStateProofVerificationReader(r.q)
 }
 
+// MakeRekeyHistoryReader implements trackerdb.Reader
+func (r *sqlReader) MakeRekeyHistoryReader() trackerdb.RekeyHistoryReader {
+	return &rekeyHistoryReader{q: r.q}
+}
+
+// MakeTotalsHistoryReader implements trackerdb.Reader
+func (r *sqlReader) MakeTotalsHistoryReader() trackerdb.TotalsHistoryReader {
+	return &totalsHistoryReader{q: r.q}
+}
+
 type sqlWriter struct {
 	e db.Executable
 }
@@ -202,6 +224,16 @@ func (w *sqlWriter) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWri
 	return makeStateProofVerificationWriter(w.e)
 }
 
+// MakeRekeyHistoryWriter implements trackerdb.Writer
+func (w *sqlWriter) MakeRekeyHistoryWriter() trackerdb.RekeyHistoryWriter {
+	return &rekeyHistoryWriter{e: w.e}
+}
+
+// MakeTotalsHistoryWriter implements trackerdb.Writer
+func (w *sqlWriter) MakeTotalsHistoryWriter() trackerdb.TotalsHistoryWriter {
+	return &totalsHistoryWriter{e: w.e}
+}
+
 // Testing implements trackerdb.Writer
 func (w *sqlWriter) Testing() trackerdb.WriterTestExt {
 	return w
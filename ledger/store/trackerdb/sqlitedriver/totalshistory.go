@@ -0,0 +1,178 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// The totalshistory table keeps the totals of algos at the end of each round, with the balances of the fee sink and
+// of the rewards pool. The history covers the rounds past the 'totalsbase' round of the acctrounds table: the round
+// the database was at when the history was enabled, or when a catchpoint was applied.
+const createTotalsHistoryTable = `CREATE TABLE IF NOT EXISTS totalshistory (
+	rnd INTEGER PRIMARY KEY NOT NULL,
+	data BLOB NOT NULL)`
+
+type totalsHistoryReader struct {
+	q db.Queryable
+}
+
+type totalsHistoryWriter struct {
+	e db.Executable
+}
+
+// SetTotalsHistory creates the totals history table when enabled and missing, or drops it when disabled. It returns
+// the round past which the history is kept.
+func (s *trackerSQLStore) SetTotalsHistory(ctx context.Context, enabled bool) (baseRound basics.Round, err error) {
+	err = s.pair.Wdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if !enabled {
+			if _, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS totalshistory"); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, "DELETE FROM acctrounds WHERE id = 'totalsbase'")
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, createTotalsHistoryTable); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO acctrounds (id, rnd) SELECT 'totalsbase', rnd FROM acctrounds WHERE id = 'acctbase'")
+		if err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id = 'totalsbase'").Scan(&baseRound)
+	})
+	return baseRound, err
+}
+
+// LookupTotals returns the totals of the rounds from minRound to maxRound, one every interval rounds starting with
+// minRound, ordered by round and up to limit of them.
+func (r *totalsHistoryReader) LookupTotals(ctx context.Context, minRound, maxRound basics.Round, interval uint64, limit uint64) (totals []ledgercore.RoundTotals, err error) {
+	if interval <= 1 {
+		err = db.Retry(func() error {
+			totals, err = r.lookupTotalsRange(ctx, minRound, maxRound, limit)
+			return err
+		})
+		return
+	}
+	err = db.Retry(func() error {
+		totals, err = r.lookupTotalsSampled(ctx, minRound, maxRound, interval, limit)
+		return err
+	})
+	return
+}
+
+// lookupTotalsRange reads the totals of the consecutive rounds from minRound to maxRound, up to limit of them.
+func (r *totalsHistoryReader) lookupTotalsRange(ctx context.Context, minRound, maxRound basics.Round, limit uint64) (totals []ledgercore.RoundTotals, err error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT data FROM totalshistory WHERE rnd >= ? AND rnd <= ? ORDER BY rnd LIMIT ?", minRound, maxRound, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var buf []byte
+		err = rows.Scan(&buf)
+		if err != nil {
+			return nil, err
+		}
+		var rt ledgercore.RoundTotals
+		err = protocol.DecodeReflect(buf, &rt)
+		if err != nil {
+			return nil, err
+		}
+		totals = append(totals, rt)
+	}
+	return totals, rows.Err()
+}
+
+// lookupTotalsSampled reads the totals of the sampled rounds one by one, rather than filtering the rounds of the
+// range, which would scan all of them. At most limit rounds are looked up, starting with the first sampled round
+// kept: the history holds every round past its base, so that each of them is found.
+func (r *totalsHistoryReader) lookupTotalsSampled(ctx context.Context, minRound, maxRound basics.Round, interval uint64, limit uint64) (totals []ledgercore.RoundTotals, err error) {
+	var first, last sql.NullInt64
+	err = r.q.QueryRowContext(ctx, "SELECT MIN(rnd) FROM totalshistory WHERE rnd >= ? AND rnd <= ?", minRound, maxRound).Scan(&first)
+	if err != nil {
+		return nil, err
+	}
+	if !first.Valid {
+		return nil, nil
+	}
+	err = r.q.QueryRowContext(ctx, "SELECT MAX(rnd) FROM totalshistory WHERE rnd >= ? AND rnd <= ?", minRound, maxRound).Scan(&last)
+	if err != nil {
+		return nil, err
+	}
+
+	rnd := minRound
+	if skipped := uint64(first.Int64) - uint64(minRound); skipped > 0 {
+		steps := (skipped + interval - 1) / interval
+		if steps > (uint64(last.Int64)-uint64(minRound))/interval {
+			return nil, nil
+		}
+		rnd += basics.Round(steps * interval)
+	}
+
+	stmt, err := r.q.PrepareContext(ctx, "SELECT data FROM totalshistory WHERE rnd = ?")
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	for lookups := uint64(0); lookups < limit; lookups++ {
+		var buf []byte
+		err = stmt.QueryRowContext(ctx, rnd).Scan(&buf)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		if err == nil {
+			var rt ledgercore.RoundTotals
+			err = protocol.DecodeReflect(buf, &rt)
+			if err != nil {
+				return nil, err
+			}
+			totals = append(totals, rt)
+		}
+		if uint64(last.Int64)-uint64(rnd) < interval {
+			break
+		}
+		rnd += basics.Round(interval)
+	}
+	return totals, nil
+}
+
+// StoreTotals stores the totals of rounds.
+func (w *totalsHistoryWriter) StoreTotals(ctx context.Context, totals []ledgercore.RoundTotals) error {
+	stmt, err := w.e.PrepareContext(ctx, "INSERT OR REPLACE INTO totalshistory (rnd, data) VALUES (?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for i := range totals {
+		_, err = stmt.ExecContext(ctx, totals[i].Round, protocol.EncodeReflect(&totals[i]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
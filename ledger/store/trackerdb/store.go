@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/util/db"
 )
@@ -44,6 +45,12 @@ type Store interface {
 	BeginTransaction(ctx context.Context) (Transaction, error)
 	// maintenance
 	Vacuum(ctx context.Context) (stats db.VacuumStats, err error)
+	IncrementalVacuum(ctx context.Context, maxPages uint64) (stats db.IncrementalVacuumStats, err error)
+	IncrementalVacuumEnabled(ctx context.Context) (bool, error)
+	Optimize(ctx context.Context) (err error)
+	SetCreatableHolderIndex(ctx context.Context, enabled bool) (built bool, err error)
+	SetRekeyHistory(ctx context.Context, enabled bool) (baseRound basics.Round, err error)
+	SetTotalsHistory(ctx context.Context, enabled bool) (baseRound basics.Round, err error)
 	// testing
 	ResetToV6Test(ctx context.Context) error
 	// cleanup
@@ -56,6 +63,8 @@ type Reader interface {
 	MakeAccountsOptimizedReader() (AccountsReader, error)
 	MakeOnlineAccountsOptimizedReader() (OnlineAccountsReader, error)
 	MakeSpVerificationCtxReader() SpVerificationCtxReader
+	MakeRekeyHistoryReader() RekeyHistoryReader
+	MakeTotalsHistoryReader() TotalsHistoryReader
 }
 
 // Writer is the interface for the trackerdb write operations.
@@ -65,6 +74,8 @@ type Writer interface {
 	MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (AccountsWriter, error)
 	MakeOnlineAccountsOptimizedWriter(hasAccounts bool) (OnlineAccountsWriter, error)
 	MakeSpVerificationCtxWriter() SpVerificationCtxWriter
+	MakeRekeyHistoryWriter() RekeyHistoryWriter
+	MakeTotalsHistoryWriter() TotalsHistoryWriter
 	// testing
 	Testing() WriterTestExt
 }
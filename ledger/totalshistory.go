@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// ErrNoTotalsHistory is returned by the lookups of the totals history on ledgers which don't keep it.
+var ErrNoTotalsHistory = errors.New("the totals history is only kept by archival ledgers")
+
+// totalsTracker keeps the history of the totals of algos of each round on archival ledgers, along with the balances
+// of the fee sink and of the rewards pool, so that the stake and the supply can be looked up at past rounds. The
+// history starts with the round the database was at when it was enabled, or when a catchpoint was applied.
+type totalsTracker struct {
+	// enabled is set on archival ledgers
+	enabled bool
+
+	// baseRound is the round past which the history is kept
+	baseRound basics.Round
+
+	// latest is the latest round added to the tracker
+	latest basics.Round
+
+	// feeSink and rewardsPool are the balances of the fee sink and of the rewards pool at the latest round, carried
+	// over the rounds which leave them unchanged
+	feeSink     basics.MicroAlgos
+	rewardsPool basics.MicroAlgos
+
+	// pending are the totals of the rounds not committed to the database yet, ordered by round
+	pending []ledgercore.RoundTotals
+
+	// mu protects baseRound, latest, feeSink, rewardsPool and pending
+	mu deadlock.RWMutex
+
+	log logging.Logger
+
+	l ledgerForTracker
+}
+
+func (tt *totalsTracker) initialize(enabled bool) {
+	tt.enabled = enabled
+}
+
+func (tt *totalsTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	tt.log = l.trackerLog()
+	tt.l = l
+
+	baseRound, err := l.trackerDB().SetTotalsHistory(context.Background(), tt.enabled)
+	if err != nil {
+		return err
+	}
+
+	var feeSink, rewardsPool basics.MicroAlgos
+	if tt.enabled {
+		hdr, err := l.BlockHdr(dbRound)
+		if err != nil {
+			return err
+		}
+		err = l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+			ar, err := tx.MakeAccountsOptimizedReader()
+			if err != nil {
+				return err
+			}
+			defer ar.Close()
+			feeSinkData, err := ar.LookupAccount(hdr.FeeSink)
+			if err != nil {
+				return err
+			}
+			rewardsPoolData, err := ar.LookupAccount(hdr.RewardsPool)
+			if err != nil {
+				return err
+			}
+			feeSink, rewardsPool = feeSinkData.AccountData.MicroAlgos, rewardsPoolData.AccountData.MicroAlgos
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	tt.baseRound = baseRound
+	tt.latest = dbRound
+	tt.feeSink = feeSink
+	tt.rewardsPool = rewardsPool
+	tt.pending = nil
+	return nil
+}
+
+func (tt *totalsTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	if !tt.enabled {
+		return
+	}
+
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	if blk.Round() <= tt.latest {
+		// repeat, ignore
+		return
+	}
+	tt.latest = blk.Round()
+	if ad, ok := delta.Accts.GetData(blk.FeeSink); ok {
+		tt.feeSink = ad.MicroAlgos
+	}
+	if ad, ok := delta.Accts.GetData(blk.RewardsPool); ok {
+		tt.rewardsPool = ad.MicroAlgos
+	}
+	tt.pending = append(tt.pending, ledgercore.RoundTotals{
+		Round:       blk.Round(),
+		Totals:      delta.Totals,
+		RewardsRate: blk.RewardsRate,
+		FeeSink:     tt.feeSink,
+		RewardsPool: tt.rewardsPool,
+	})
+}
+
+func (tt *totalsTracker) committedUpTo(round basics.Round) (minRound, lookback basics.Round) {
+	return round, 0
+}
+
+func (tt *totalsTracker) produceCommittingTask(_ basics.Round, _ basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (tt *totalsTracker) prepareCommit(dcc *deferredCommitContext) error {
+	tt.mu.RLock()
+	defer tt.mu.RUnlock()
+
+	newBase := dcc.newBase()
+	count := sort.Search(len(tt.pending), func(i int) bool { return tt.pending[i].Round > newBase })
+	dcc.roundTotals = make([]ledgercore.RoundTotals, count)
+	copy(dcc.roundTotals, tt.pending[:count])
+	return nil
+}
+
+func (tt *totalsTracker) commitRound(ctx context.Context, tx trackerdb.TransactionScope, dcc *deferredCommitContext) error {
+	if len(dcc.roundTotals) == 0 {
+		return nil
+	}
+	return tx.MakeTotalsHistoryWriter().StoreTotals(ctx, dcc.roundTotals)
+}
+
+func (tt *totalsTracker) postCommit(_ context.Context, dcc *deferredCommitContext) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	tt.pending = tt.pending[len(dcc.roundTotals):]
+}
+
+func (tt *totalsTracker) postCommitUnlocked(context.Context, *deferredCommitContext) {
+}
+
+func (tt *totalsTracker) handleUnorderedCommitOrError(*deferredCommitContext) {
+}
+
+func (tt *totalsTracker) close() {
+}
+
+// lookupTotals returns the totals of the rounds from minRound to maxRound, one every interval rounds starting with
+// minRound, ordered by round and up to limit of them, along with the round past which the history is kept.
+func (tt *totalsTracker) lookupTotals(minRound, maxRound basics.Round, interval uint64, limit uint64) ([]ledgercore.RoundTotals, basics.Round, error) {
+	if !tt.enabled {
+		return nil, basics.Round(0), ErrNoTotalsHistory
+	}
+	if interval == 0 {
+		interval = 1
+	}
+	sampled := func(rnd basics.Round) bool {
+		return rnd >= minRound && rnd <= maxRound && uint64(rnd-minRound)%interval == 0
+	}
+
+	// the pending totals are collected before reading the database: the ones committed in between are found in
+	// both, and are deduplicated by round.
+	tt.mu.RLock()
+	baseRound := tt.baseRound
+	var pending []ledgercore.RoundTotals
+	for _, rt := range tt.pending {
+		if sampled(rt.Round) {
+			pending = append(pending, rt)
+		}
+	}
+	tt.mu.RUnlock()
+
+	var persisted []ledgercore.RoundTotals
+	err := tt.l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) (err error) {
+		persisted, err = tx.MakeTotalsHistoryReader().LookupTotals(ctx, minRound, maxRound, interval, limit)
+		return err
+	})
+	if err != nil {
+		return nil, basics.Round(0), err
+	}
+
+	totals := persisted
+	for _, rt := range pending {
+		if uint64(len(totals)) >= limit {
+			break
+		}
+		if len(persisted) == 0 || rt.Round > persisted[len(persisted)-1].Round {
+			totals = append(totals, rt)
+		}
+	}
+	return totals, baseRound, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func commitTotals(t *testing.T, tt *totalsTracker, ml *mockLedgerForTracker, dbRound basics.Round, newBase basics.Round) {
+	dcc := deferredCommitContext{deferredCommitRange: deferredCommitRange{oldBase: dbRound, offset: uint64(newBase - dbRound)}}
+	require.NoError(t, tt.prepareCommit(&dcc))
+	err := ml.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		return tt.commitRound(ctx, tx, &dcc)
+	})
+	require.NoError(t, err)
+	tt.postCommit(context.Background(), &dcc)
+}
+
+func TestTotalsTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(5, true)}
+	var feeSink, rewardsPool basics.Address
+	for addr := range accts[0] {
+		if feeSink.IsZero() {
+			feeSink = addr
+		} else if rewardsPool.IsZero() {
+			rewardsPool = addr
+		}
+	}
+	ml := makeMockLedgerForTracker(t, true, 1, protocol.ConsensusCurrentVersion, accts)
+	defer ml.Close()
+	ml.blocks[0].block.FeeSink = feeSink
+	ml.blocks[0].block.RewardsPool = rewardsPool
+	_, err := trackerDBInitialize(ml, false, ".")
+	require.NoError(t, err)
+
+	tt := totalsTracker{}
+	tt.initialize(true)
+	require.NoError(t, ml.trackers.initialize(ml, []ledgerTracker{&tt}, config.GetDefaultLocal()))
+	require.NoError(t, tt.loadFromDisk(ml, 0))
+
+	var expected []ledgercore.RoundTotals
+	feeSinkBalance, rewardsPoolBalance := accts[0][feeSink].MicroAlgos, accts[0][rewardsPool].MicroAlgos
+	initialFeeSink, initialRewardsPool := feeSinkBalance.Raw, rewardsPoolBalance.Raw
+	for rnd := basics.Round(1); rnd <= 10; rnd++ {
+		blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+		blk.FeeSink = feeSink
+		blk.RewardsPool = rewardsPool
+		blk.RewardsRate = uint64(rnd)
+		delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, 0, 0)
+		delta.Totals.Online.Money.Raw = uint64(rnd) * 1000
+		delta.Totals.NotParticipating.Money.Raw = 1 << 40
+		// the fee sink changes every other round, and the rewards pool in round 5 only
+		if rnd%2 == 0 {
+			feeSinkBalance.Raw += 10
+			delta.Accts.Upsert(feeSink, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: feeSinkBalance}})
+		}
+		if rnd == 5 {
+			rewardsPoolBalance.Raw += 100
+			delta.Accts.Upsert(rewardsPool, ledgercore.AccountData{AccountBaseData: ledgercore.AccountBaseData{MicroAlgos: rewardsPoolBalance}})
+		}
+		tt.newBlock(blk, delta)
+		// repeated rounds are ignored
+		tt.newBlock(blk, ledgercore.StateDelta{})
+		expected = append(expected, ledgercore.RoundTotals{
+			Round:       rnd,
+			Totals:      delta.Totals,
+			RewardsRate: uint64(rnd),
+			FeeSink:     feeSinkBalance,
+			RewardsPool: rewardsPoolBalance,
+		})
+	}
+
+	checkTotals := func() {
+		totals, baseRound, err := tt.lookupTotals(1, 10, 1, 100)
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(0), baseRound)
+		require.Equal(t, expected, totals)
+
+		// downsampled
+		totals, _, err = tt.lookupTotals(2, 10, 3, 100)
+		require.NoError(t, err)
+		require.Equal(t, []ledgercore.RoundTotals{expected[1], expected[4], expected[7]}, totals)
+
+		// downsampled from before the first round kept
+		totals, _, err = tt.lookupTotals(0, 10, 3, 100)
+		require.NoError(t, err)
+		require.Equal(t, []ledgercore.RoundTotals{expected[2], expected[5], expected[8]}, totals)
+		totals, _, err = tt.lookupTotals(0, 10, 20, 100)
+		require.NoError(t, err)
+		require.Empty(t, totals)
+
+		// limited
+		totals, _, err = tt.lookupTotals(1, 10, 2, 3)
+		require.NoError(t, err)
+		require.Equal(t, []ledgercore.RoundTotals{expected[0], expected[2], expected[4]}, totals)
+
+		totals, _, err = tt.lookupTotals(7, 7, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, expected[6:7], totals)
+		require.Equal(t, basics.MicroAlgos{Raw: 7000 + 1<<40 - (initialFeeSink + 30) - (initialRewardsPool + 100)}, totals[0].Circulating())
+	}
+
+	// all pending, then partly and fully committed
+	checkTotals()
+	commitTotals(t, &tt, ml, 0, 4)
+	require.Len(t, tt.pending, 6)
+	checkTotals()
+	commitTotals(t, &tt, ml, 4, 10)
+	require.Empty(t, tt.pending)
+	checkTotals()
+
+	// the history is kept across reloads, and dropped once disabled
+	ml.blocks = append(ml.blocks, make([]blockEntry, 10)...)
+	ml.blocks[10].block.FeeSink = feeSink
+	ml.blocks[10].block.RewardsPool = rewardsPool
+	require.NoError(t, tt.loadFromDisk(ml, 10))
+	checkTotals()
+	tt.initialize(false)
+	require.NoError(t, tt.loadFromDisk(ml, 10))
+	_, _, err = tt.lookupTotals(1, 10, 1, 100)
+	require.ErrorIs(t, err, ErrNoTotalsHistory)
+}
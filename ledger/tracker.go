@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/algorand/go-algorand/config"
@@ -170,6 +171,10 @@ type trackerRegistry struct {
 	// accountsWriting provides synchronization around the background writing of account balances.
 	accountsWriting sync.WaitGroup
 
+	// pendingCommits is the number of deferred commits scheduled or being written, tracked along accountsWriting
+	// so that the database maintenance can tell when the commit pipeline is idle.
+	pendingCommits atomic.Int64
+
 	// dbRound is always exactly accountsRound(),
 	// cached to avoid SQL queries.
 	dbRound basics.Round
@@ -185,6 +190,10 @@ type trackerRegistry struct {
 
 	mu deadlock.RWMutex
 
+	// commitsPaused is the number of callers of pauseCommits which did not resume the commits yet. No deferred
+	// commit is scheduled while it is positive. It is protected by mu.
+	commitsPaused int
+
 	// lastFlushTime is the time we last flushed updates to
 	// the accounts DB (bumping dbRound).
 	lastFlushTime time.Time
@@ -252,6 +261,10 @@ type deferredCommitContext struct {
 	// Block hashes for the committed rounds range.
 	committedRoundDigests []crypto.Digest
 
+	// deferredTrieUpdate is set when the merkle trie update of the committed rounds is left to the
+	// catchpointTracker trie committer, rather than done in the transaction writing the accounts.
+	deferredTrieUpdate bool
+
 	// on catchpoint rounds, the transaction tail would fill up this field with the hash of the recent 1001 rounds
 	// of the txtail data. The catchpointTracker would be able to use that for calculating the catchpoint label.
 	txTailHash crypto.Digest
@@ -273,6 +286,12 @@ type deferredCommitContext struct {
 		// state proof verification commit information
 		commitContext []verificationCommitContext
 	}
+
+	// changes of the authorizing address of the accounts in the committed rounds
+	rekeys []ledgercore.RekeyEvent
+
+	// totals of algos of the committed rounds
+	roundTotals []ledgercore.RoundTotals
 }
 
 func (dcc deferredCommitContext) newBase() basics.Round {
@@ -420,12 +439,20 @@ func (tr *trackerRegistry) scheduleCommit(blockqRound, maxLookback basics.Round)
 			dcc = nil
 		}
 	}
-	tr.mu.RUnlock()
-
+	if tr.commitsPaused > 0 {
+		// the rounds are committed by the next attempt once the commits resume
+		dcc = nil
+	}
 	if dcc != nil {
 		// Increment the waitgroup first, otherwise this goroutine can be interrupted
-		// and commitSyncer attempts calling Done() on empty wait group.
+		// and commitSyncer attempts calling Done() on empty wait group. It is incremented
+		// while holding mu, so that pauseCommits waits for this commit as well.
 		tr.accountsWriting.Add(1)
+		tr.pendingCommits.Add(1)
+	}
+	tr.mu.RUnlock()
+
+	if dcc != nil {
 		select {
 		case tr.deferredCommits <- dcc:
 		default:
@@ -438,6 +465,7 @@ func (tr *trackerRegistry) scheduleCommit(blockqRound, maxLookback basics.Round)
 			// This means a blocking write to deferredCommits will block Ledger reads (TODO use more fine-grained locks).
 			// Dropping this dcc allows the blockqueue syncer to continue persisting other blocks
 			// and ledger reads to proceed without being blocked by trackerMu lock.
+			tr.pendingCommits.Add(-1)
 			tr.accountsWriting.Done()
 		}
 	}
@@ -448,6 +476,28 @@ func (tr *trackerRegistry) waitAccountsWriting() {
 	tr.accountsWriting.Wait()
 }
 
+// pauseCommits stops scheduling deferred commits, and waits for the scheduled ones to be written, so that the
+// tracker database stays at its round until resumeCommits is called. Blocks are still added and the trackers
+// read meanwhile, while the blocks following the round of the tracker database are kept.
+func (tr *trackerRegistry) pauseCommits() {
+	tr.mu.Lock()
+	tr.commitsPaused++
+	tr.mu.Unlock()
+	tr.waitAccountsWriting()
+}
+
+// resumeCommits resumes scheduling the deferred commits paused by pauseCommits.
+func (tr *trackerRegistry) resumeCommits() {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.commitsPaused--
+}
+
+// commitsIdle returns true if no deferred commit is scheduled or being written to the database.
+func (tr *trackerRegistry) commitsIdle() bool {
+	return tr.pendingCommits.Load() == 0
+}
+
 func (tr *trackerRegistry) close() {
 	if tr.ctxCancel != nil {
 		tr.ctxCancel()
@@ -487,6 +537,7 @@ func (tr *trackerRegistry) commitSyncer(deferredCommits chan *deferredCommitCont
 			for !drained {
 				select {
 				case <-deferredCommits:
+					tr.pendingCommits.Add(-1)
 					tr.accountsWriting.Done()
 				default:
 					drained = true
@@ -500,6 +551,7 @@ func (tr *trackerRegistry) commitSyncer(deferredCommits chan *deferredCommitCont
 // commitRound commits the given deferredCommitContext via the trackers.
 func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 	defer tr.accountsWriting.Done()
+	defer tr.pendingCommits.Add(-1)
 	tr.mu.RLock()
 
 	offset := dcc.offset
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var ledgerTrieCommitCount = metrics.NewCounter("ledger_triecommit_count", "calls")
+var ledgerTrieCommitMicros = metrics.NewCounter("ledger_triecommit_micros", "µs spent")
+
+// trieCommitter writes the deferred merkle trie updates, in the order of their commits, until the
+// queue is closed. Once an update fails, the following ones are skipped and the trie is rebuilt by
+// the next commit which could not be deferred.
+func (ct *catchpointTracker) trieCommitter(queue <-chan *deferredCommitContext, done chan<- struct{}) {
+	defer close(done)
+	for dcc := range queue {
+		if !ct.trieOutOfSync.Load() {
+			start := time.Now()
+			err := ct.commitTrie(dcc)
+			if err != nil {
+				ct.log.Warnf("trieCommitter: unable to update the merkle trie for rounds (%d-%d]: %v", dcc.oldBase, dcc.newBase(), err)
+				ct.trieOutOfSync.Store(true)
+			} else {
+				ledgerTrieCommitCount.Inc(nil)
+				ledgerTrieCommitMicros.AddUint64(uint64(time.Since(start).Microseconds()), nil)
+			}
+		}
+		ct.triePending.Done()
+	}
+}
+
+// commitTrie adds the account, resource and kv deltas of the commit to the merkle trie, and advances
+// the accounts hash round to the commit new base.
+func (ct *catchpointTracker) commitTrie(dcc *deferredCommitContext) error {
+	ct.trieMu.Lock()
+	defer ct.trieMu.Unlock()
+
+	err := ct.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		mc, err := tx.MakeMerkleCommitter(false)
+		if err != nil {
+			return err
+		}
+
+		if ct.balancesTrie == nil {
+			trie, trieErr := merkletrie.MakeTrie(mc, trackerdb.TrieMemoryConfig)
+			if trieErr != nil {
+				return trieErr
+			}
+			ct.balancesTrie = trie
+		} else {
+			ct.balancesTrie.SetCommitter(mc)
+		}
+
+		err = ct.accountsUpdateBalances(dcc.compactAccountDeltas, dcc.compactResourcesDeltas, dcc.compactKvDeltas, dcc.oldBase, dcc.newBase())
+		if err != nil {
+			return err
+		}
+
+		aw, err := tx.MakeAccountsWriter()
+		if err != nil {
+			return err
+		}
+		return aw.UpdateAccountsHashRound(ctx, dcc.newBase())
+	})
+	if err != nil {
+		// the in-memory trie may hold changes which were rolled back.
+		ct.balancesTrie = nil
+		return err
+	}
+
+	_, err = ct.balancesTrie.Evict(false)
+	if err != nil {
+		ct.log.Warnf("merkle trie failed to evict: %v", err)
+	}
+	return nil
+}
+
+// rebuildTrie brings the merkle trie back in sync with the accounts written up to rnd, after a
+// deferred trie update failed.
+func (ct *catchpointTracker) rebuildTrie(rnd basics.Round) error {
+	ct.log.Infof("rebuilding the merkle trie for round %d after a failed deferred update", rnd)
+	ct.trieMu.Lock()
+	ct.balancesTrie = nil
+	err := ct.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		return ct.initializeHashes(ctx, tx, rnd)
+	})
+	ct.trieMu.Unlock()
+	if err != nil {
+		return err
+	}
+	ct.trieOutOfSync.Store(false)
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// persistedTrieState returns the root of the merkle trie stored in the ledger database, and the round it was computed for.
+func persistedTrieState(t *testing.T, ml *mockLedgerForTracker) (root crypto.Digest, hashRound basics.Round) {
+	err := ml.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		mc, err := tx.MakeMerkleCommitter(false)
+		if err != nil {
+			return err
+		}
+		trie, err := merkletrie.MakeTrie(mc, trackerdb.TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+		root, err = trie.RootHash()
+		if err != nil {
+			return err
+		}
+		ar, err := tx.MakeAccountsReader()
+		if err != nil {
+			return err
+		}
+		hashRound, err = ar.AccountsHashRound(ctx)
+		return err
+	})
+	require.NoError(t, err)
+	return root, hashRound
+}
+
+// TestCatchpointTrieCommitPipeline checks the merkle trie updates deferred past the accounts commits
+// end up with the same trie as the ones written along the accounts.
+func TestCatchpointTrieCommitPipeline(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, true)}
+	addSinkAndPoolAccounts(accts)
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 10000
+	conf.CatchpointTracking = 1
+	initialBlocksCount := int(conf.MaxAcctLookback)
+
+	mlSync := makeMockLedgerForTracker(t, true, initialBlocksCount, protocol.ConsensusFuture, accts)
+	defer mlSync.Close()
+	ctSync := newCatchpointTracker(t, mlSync, conf, ".")
+	require.Nil(t, ctSync.trieCommits)
+
+	pipelineConf := conf
+	pipelineConf.LedgerCommitPipelineWindow = 2
+	mlPipeline := makeMockLedgerForTracker(t, true, initialBlocksCount, protocol.ConsensusFuture, accts)
+	defer mlPipeline.Close()
+	ctPipeline := newCatchpointTracker(t, mlPipeline, pipelineConf, ".")
+	require.NotNil(t, ctPipeline.trieCommits)
+
+	for i := 1; i < initialBlocksCount; i++ {
+		accts = append(accts, accts[0])
+	}
+
+	lastRound := basics.Round(initialBlocksCount + 40)
+	for i := basics.Round(initialBlocksCount); i < lastRound; i++ {
+		updates, totals := ledgertesting.RandomDeltasBalanced(1, accts[i-1], 0)
+		accts = append(accts, applyPartialDeltas(accts[i-1], updates))
+
+		blk := bookkeeping.Block{
+			BlockHeader: bookkeeping.BlockHeader{
+				Round: i,
+			},
+		}
+		blk.CurrentProtocol = protocol.ConsensusFuture
+
+		for _, ml := range []*mockLedgerForTracker{mlSync, mlPipeline} {
+			delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, updates.Len(), 0)
+			delta.Accts.MergeAccounts(updates)
+			delta.Totals = accumulateTotals(t, protocol.ConsensusCurrentVersion, []map[basics.Address]ledgercore.AccountData{totals}, 0)
+			ml.addBlock(blockEntry{block: blk}, delta)
+			ml.trackers.committedUpTo(i)
+			ml.trackers.waitAccountsWriting()
+		}
+	}
+	ctPipeline.triePending.Wait()
+
+	syncRoot, syncHashRound := persistedTrieState(t, mlSync)
+	pipelineRoot, pipelineHashRound := persistedTrieState(t, mlPipeline)
+	require.False(t, syncRoot.IsZero())
+	require.Equal(t, syncRoot, pipelineRoot)
+	require.Equal(t, syncHashRound, pipelineHashRound)
+	require.Equal(t, mlPipeline.trackers.dbRound, pipelineHashRound)
+	require.Greater(t, pipelineHashRound, basics.Round(initialBlocksCount))
+}
@@ -348,7 +348,9 @@ func (t *txTail) checkDup(proto config.ConsensusParams, current basics.Round, fi
 		for rnd := firstChecked; rnd <= lastChecked; rnd++ {
 			expires, ok := t.recent[rnd].txleases[txl]
 			if ok && current <= expires {
-				return ledgercore.MakeLeaseInLedgerError(txid, txl, false)
+				err := ledgercore.MakeLeaseInLedgerError(txid, txl, false)
+				err.Round, err.Expires = rnd, expires
+				return err
 			}
 		}
 	}
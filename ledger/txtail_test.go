@@ -223,7 +223,9 @@ func TestTxTailLoadFromDisk(t *testing.T) {
 				txn.Txn.FirstValid, txn.Txn.LastValid, txn.Txn.ID(),
 				txl)
 			if r >= ledger.Latest()-testTxTailValidityRange {
-				require.Equal(t, ledgercore.MakeLeaseInLedgerError(txn.Txn.ID(), txl, false), dupResult)
+				expected := ledgercore.MakeLeaseInLedgerError(txn.Txn.ID(), txl, false)
+				expected.Round, expected.Expires = r, txn.Txn.LastValid
+				require.Equal(t, expected, dupResult)
 			} else {
 				require.Equal(t, &errTxTailMissingRound{round: txn.Txn.LastValid}, dupResult)
 			}
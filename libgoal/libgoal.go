@@ -17,6 +17,7 @@
 package libgoal
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -1046,6 +1047,98 @@ func (c *Client) AddParticipationKey(keyfile string) (resp model.PostParticipati
 	return algod.PostParticipationKey(data)
 }
 
+// ExportParticipationKey gets the msgpack encoded secrets of a participation key installed on the node.
+func (c *Client) ExportParticipationKey(participationID string) (secrets []byte, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return
+	}
+	return algod.RawExportParticipationKey(participationID)
+}
+
+// ImportParticipationKey installs participation key secrets exported by another node.
+func (c *Client) ImportParticipationKey(secrets []byte) (resp model.PostParticipationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return
+	}
+	return algod.ImportParticipationKey(secrets)
+}
+
+// ListAPITokens lists the named API tokens of the node.
+func (c *Client) ListAPITokens() (resp model.APITokensResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.ListAPITokens()
+	}
+	return
+}
+
+// CreateAPIToken creates a named API token granted the given scopes.
+func (c *Client) CreateAPIToken(name string, scopes []string) (resp model.APITokenResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.CreateAPIToken(name, scopes)
+	}
+	return
+}
+
+// RevokeAPIToken revokes a named API token.
+func (c *Client) RevokeAPIToken(name string) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.RevokeAPIToken(name)
+}
+
+// CreateSnapshot writes a consistent copy of the data directory of the node into the directory at path, along with
+// its participation keys when withPartKeys is set.
+func (c *Client) CreateSnapshot(path string, withPartKeys bool) (resp model.SnapshotResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.CreateSnapshot(path, withPartKeys)
+	}
+	return
+}
+
+// GetMetrics returns the metrics registered by the node.
+func (c *Client) GetMetrics() (resp model.MetricsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetMetrics()
+	}
+	return
+}
+
+// GetPeers returns the peers the node is connected to.
+func (c *Client) GetPeers() (resp model.PeersResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetPeers()
+	}
+	return
+}
+
+// GetProfile gets the named pprof profile of the node, rendered at the given debug level.
+// The node only serves the profiles when its EnableProfiler config is set.
+func (c *Client) GetProfile(ctx context.Context, name string, debug int) (profile []byte, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetProfile(ctx, name, debug)
+	}
+	return
+}
+
+// GetMetrics gets the metrics of the node, in the prometheus text format.
+func (c *Client) GetMetrics(ctx context.Context) (metrics string, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetMetrics(ctx)
+	}
+	return
+}
+
 // GetParticipationKeys gets the currently installed participation keys.
 func (c *Client) GetParticipationKeys() (resp model.ParticipationKeysResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -1064,6 +1157,42 @@ func (c *Client) GetParticipationKeyByID(id string) (resp model.ParticipationKey
 	return
 }
 
+// GetParticipationSummary gets the participation summary of the installed participation keys.
+func (c *Client) GetParticipationSummary(window uint64) (resp model.ParticipationSummaryResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetParticipationSummary(window)
+	}
+	return
+}
+
+// GetAccountPerformance gets the proposal and vote performance of the accounts tracked by the node.
+func (c *Client) GetAccountPerformance() (resp model.AccountPerformanceResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetAccountPerformance()
+	}
+	return
+}
+
+// ParticipationKeyregTransaction gets the unsigned keyreg transaction registering an installed participation key.
+// The fee and validity rounds default to the node's when 0.
+func (c *Client) ParticipationKeyregTransaction(participationID string, fee, firstValid, lastValid uint64) (tx transactions.Transaction, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return
+	}
+	resp, err := algod.RawParticipationKeyreg(participationID, fee, firstValid, lastValid)
+	if err != nil {
+		return
+	}
+	var keyreg struct {
+		Txn transactions.SignedTxn `codec:"txn"`
+	}
+	err = protocol.DecodeReflect(resp, &keyreg)
+	return keyreg.Txn.Txn, err
+}
+
 // ExportKey exports the private key of the passed account, assuming it's available
 func (c *Client) ExportKey(walletHandle []byte, password, account string) (resp kmdapi.APIV1POSTKeyExportResponse, err error) {
 	kmd, err := c.ensureKmdClient()
@@ -1307,6 +1436,15 @@ func (c *Client) LightBlockHeaderProof(round uint64) (resp model.LightBlockHeade
 	return
 }
 
+// StateProofs returns the state proof covering the given round.
+func (c *Client) StateProofs(round uint64) (resp model.StateProofResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.StateProofs(round)
+	}
+	return
+}
+
 // SetSyncRound sets the sync round on a node w/ EnableFollowMode
 func (c *Client) SetSyncRound(round uint64) (err error) {
 	algod, err := c.ensureAlgodClient()
@@ -1316,6 +1454,15 @@ func (c *Client) SetSyncRound(round uint64) (err error) {
 	return
 }
 
+// AcknowledgeSyncRound acknowledges a round processed by the consumer of a node w/ EnableFollowMode
+func (c *Client) AcknowledgeSyncRound(round uint64) (rep model.GetSyncRoundResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.AcknowledgeSyncRound(round)
+	}
+	return
+}
+
 // GetSyncRound gets the sync round on a node w/ EnableFollowMode
 func (c *Client) GetSyncRound() (rep model.GetSyncRoundResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
@@ -114,6 +115,13 @@ func (c *Client) GenParticipationKeysTo(address string, firstValid, lastValid, k
 		}(partKeyPath)
 	}
 
+	// Generate the voting keys in the key signer of the node, when it has one
+	keySigner, closeKeySigner, err := c.openKeySigner()
+	if err != nil {
+		return
+	}
+	defer closeKeySigner()
+
 	partdb, err := db.MakeErasableAccessor(partKeyPath)
 	if err != nil {
 		return
@@ -124,7 +132,7 @@ func (c *Client) GenParticipationKeysTo(address string, firstValid, lastValid, k
 	}
 
 	// Fill the database with new participation keys
-	newPart, err := account.FillDBWithParticipationKeys(partdb, parsedAddr, firstRound, lastRound, keyDilution)
+	newPart, err := account.FillDBWithParticipationKeysWithSigner(partdb, parsedAddr, firstRound, lastRound, keyDilution, keySigner)
 	part = newPart.Participation
 	partdb.Close()
 
@@ -134,10 +142,38 @@ func (c *Client) GenParticipationKeysTo(address string, firstValid, lastValid, k
 
 	if install {
 		_, err = c.AddParticipationKey(partKeyPath)
+		if err != nil && part.Voting != nil {
+			// the key file is removed, and with it the only reference to the subkeys held by the key signer
+			part.Voting.DestroySignerSubkeys()
+		}
 	}
 	return part, partKeyPath, err
 }
 
+// openKeySigner opens the key signer configured by the ParticipationKeySignerProvider of the node, so that the keys
+// generated for it are held by the same token. It returns a nil signer when the node has none.
+func (c *Client) openKeySigner() (signer crypto.KeySigner, closeSigner func(), err error) {
+	closeSigner = func() {}
+	if c.DataDir() == "" {
+		return
+	}
+	cfg, err := config.LoadConfigFromDisk(c.DataDir())
+	if err != nil && !os.IsNotExist(err) {
+		return
+	}
+	pkcs11Signer, err := account.OpenKeySigner(cfg)
+	if err != nil || pkcs11Signer == nil {
+		return
+	}
+	// the subkeys are destroyed through the global key signer when the key cannot be generated or installed
+	crypto.SetKeySigner(pkcs11Signer)
+	closeSigner = func() {
+		crypto.SetKeySigner(nil)
+		pkcs11Signer.Close()
+	}
+	return pkcs11Signer, closeSigner, nil
+}
+
 // ListParticipationKeys returns the available participation keys,
 // as a response object.
 func (c *Client) ListParticipationKeys() (partKeyFiles model.ParticipationKeysResponse, err error) {
@@ -45,6 +45,23 @@ func (c *Client) CreateWallet(name []byte, password []byte, mdk crypto.MasterDer
 	return []byte(resp.Wallet.ID), nil
 }
 
+// CreateWalletFromMnemonic creates a kmd wallet recovered from a BIP-39
+// mnemonic, deriving its keys at the paths of the given account
+func (c *Client) CreateWalletFromMnemonic(name []byte, password []byte, mnemonic, mnemonicPassphrase string, account uint32) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the wallet
+	resp, err := kmd.CreateWalletFromMnemonic(name, defaultWalletDriver, password, mnemonic, mnemonicPassphrase, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
+
 // GetWalletHandleToken inits the wallet with the given id, returning a wallet handle token
 func (c *Client) GetWalletHandleToken(wid, pw []byte) ([]byte, error) {
 	kmd, err := c.ensureKmdClient()
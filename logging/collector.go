@@ -49,7 +49,7 @@ func CollectAndUploadData(dataDir string, bundleFilename string, targetFolder st
 		}
 		wg := sync.WaitGroup{}
 		wg.Add(1)
-		targetFilename := filepath.Join(targetFolder, path.Base(bundleFilename))
+		targetFilename := path.Join(targetFolder, filepath.Base(bundleFilename))
 		go func() {
 			fmt.Printf("Uploading to s3://%s/%s\n", bucket, targetFilename)
 			err = s3Session.UploadFileStream(targetFilename, pipeReader)
@@ -79,26 +79,26 @@ func collectAndWrite(datadir string, writer io.Writer) error {
 	tw := tar.NewWriter(gw)
 	defer tw.Close()
 
-	logPaths, err := filepath.Glob(path.Join(datadir, "node*.log"))
+	logPaths, err := filepath.Glob(filepath.Join(datadir, "node*.log"))
 	if err != nil {
 		return err
 	}
 	paths := make([]string, 0)
 	paths = append(paths, logPaths...)
 
-	logPaths, err = filepath.Glob(path.Join(datadir, "algod-*.log"))
+	logPaths, err = filepath.Glob(filepath.Join(datadir, "algod-*.log"))
 	if err != nil {
 		return err
 	}
 	paths = append(paths, logPaths...)
 
-	logPaths, err = filepath.Glob(path.Join(datadir, "host*.log"))
+	logPaths, err = filepath.Glob(filepath.Join(datadir, "host*.log"))
 	if err != nil {
 		return err
 	}
 	paths = append(paths, logPaths...)
 
-	cadaverPaths, err := filepath.Glob(path.Join(datadir, "agreement.cdv*"))
+	cadaverPaths, err := filepath.Glob(filepath.Join(datadir, "agreement.cdv*"))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build windows
+// +build windows
+
+package logging
+
+import (
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/windows/svc/eventlog"
+)
+
+// eventLogID is the event identifier of the entries written to the Windows event log.
+const eventLogID = 1
+
+type eventLogHook struct {
+	log *eventlog.Log
+}
+
+// NewEventLogHook creates a hook writing the warnings and errors of a logger to the Windows event
+// log, under the event source name, which needs to have been registered when installing the service.
+func NewEventLogHook(source string) (logrus.Hook, error) {
+	log, err := eventlog.Open(source)
+	if err != nil {
+		return nil, err
+	}
+	return &eventLogHook{log: log}, nil
+}
+
+func (hook *eventLogHook) Levels() []logrus.Level {
+	return []logrus.Level{logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel, logrus.WarnLevel}
+}
+
+func (hook *eventLogHook) Fire(entry *logrus.Entry) error {
+	msg, err := entry.String()
+	if err != nil {
+		return err
+	}
+	if entry.Level == logrus.WarnLevel {
+		return hook.log.Warning(eventLogID, msg)
+	}
+	return hook.log.Error(eventLogID, msg)
+}
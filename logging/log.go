@@ -36,6 +36,7 @@ package logging
 
 import (
 	"io"
+	"os"
 	"runtime"
 	"runtime/debug"
 	"strings"
@@ -75,6 +76,9 @@ const (
 
 const stackPrefix = "[Stack]"
 
+// jsonTimestampFormat is the format of the time of the entries in the JSON formats
+const jsonTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
 var once sync.Once
 
 // Init needs to be called to ensure our logging has been initialized
@@ -143,6 +147,9 @@ type Logger interface {
 	// Sets the logger to JSON Format
 	SetJSONFormatter()
 
+	// Sets the logger to the structured JSON format, whose top level fields are stable across entries
+	SetStructuredFormatter()
+
 	IsLevelEnabled(level Level) bool
 
 	// source adds file, line and function fields to the event
@@ -158,62 +165,122 @@ type Logger interface {
 	Metrics(category telemetryspec.Category, metrics telemetryspec.MetricDetails, details interface{})
 	Event(category telemetryspec.Category, identifier telemetryspec.Event)
 	EventWithDetails(category telemetryspec.Category, identifier telemetryspec.Event, details interface{})
+	// ExportSpans exports trace spans through the telemetry, when it exports to an OTLP collector
+	ExportSpans(spans []Span)
 	GetTelemetrySession() string
 	GetTelemetryGUID() string
 	GetInstanceName() string
 	GetTelemetryURI() string
 	CloseTelemetry()
+
+	// Subsystem returns a logger for the named subsystem, whose entries below Error are dropped
+	// while the subsystem is disabled with SetSubsystemEnabled.
+	Subsystem(name string) Logger
+	// SetSubsystemEnabled enables or disables the logging of a subsystem below Error
+	SetSubsystemEnabled(name string, enabled bool)
+	// DisabledSubsystems returns the disabled subsystems, in increasing order
+	DisabledSubsystems() []string
+
+	// SetAdditionalOutputDirs sets the directories SetAdditionalOutputFile may write to, such as the data and log
+	// directories. No additional output file can be set until they are.
+	SetAdditionalOutputDirs(dirs ...string)
+	// SetAdditionalOutputFile makes the logger write to the file at path as well as to its output,
+	// appending to it. The file must be in one of the directories set with SetAdditionalOutputDirs,
+	// and is created with mode 0600. An empty path stops writing to the current additional file.
+	SetAdditionalOutputFile(path string) error
+	// GetAdditionalOutputFile returns the path of the additional output file, if any
+	GetAdditionalOutputFile() string
+
+	// Fork returns a logger with the fields of this one, writing to its output and telemetry, whose level,
+	// disabled subsystems and additional output file are set independently of this logger's.
+	Fork() Logger
 }
 
 type loggerState struct {
 	telemetry *telemetryState
+
+	mu                 sync.RWMutex
+	disabledSubsystems map[string]bool
+	output             io.Writer
+	additionalOutput   *os.File
+	// additionalOutputDirs are the directories the additional output file may be in
+	additionalOutputDirs []string
 }
 
 type logger struct {
 	entry       *logrus.Entry
 	loggerState *loggerState
+	subsystem   string
 }
 
 func (l logger) With(key string, value interface{}) Logger {
 	return logger{
 		l.entry.WithField(key, value),
 		l.loggerState,
+		l.subsystem,
 	}
 }
 
 func (l logger) Debug(args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Debug(args...)
 }
 
 func (l logger) Debugln(args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Debugln(args...)
 }
 
 func (l logger) Debugf(format string, args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Debugf(format, args...)
 }
 
 func (l logger) Info(args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Info(args...)
 }
 
 func (l logger) Infoln(args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Infoln(args...)
 }
 
 func (l logger) Infof(format string, args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Infof(format, args...)
 }
 
 func (l logger) Warn(args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Warn(args...)
 }
 
 func (l logger) Warnln(args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Warnln(args...)
 }
 
 func (l logger) Warnf(format string, args ...interface{}) {
+	if l.muted() {
+		return
+	}
 	l.source().Warnf(format, args...)
 }
 
@@ -284,6 +351,7 @@ func (l logger) WithFields(fields Fields) Logger {
 	return logger{
 		l.source().WithFields(fields),
 		l.loggerState,
+		l.subsystem,
 	}
 }
 
@@ -296,10 +364,26 @@ func (l logger) SetLevel(lvl Level) {
 }
 
 func (l logger) IsLevelEnabled(level Level) bool {
+	if level > Error && l.muted() {
+		return false
+	}
 	return l.entry.Logger.Level >= logrus.Level(level)
 }
 
 func (l logger) SetOutput(w io.Writer) {
+	l.loggerState.mu.Lock()
+	defer l.loggerState.mu.Unlock()
+	l.loggerState.output = w
+	l.applyOutput()
+}
+
+// applyOutput sets the output of the logger to the output given to SetOutput and the additional
+// output file. The loggerState lock must be held.
+func (l logger) applyOutput() {
+	w := l.loggerState.output
+	if l.loggerState.additionalOutput != nil {
+		w = io.MultiWriter(w, l.loggerState.additionalOutput)
+	}
 	if l.GetTelemetryEnabled() {
 		l.setOutput(l.loggerState.telemetry.wrapOutput(w))
 	} else {
@@ -308,7 +392,7 @@ func (l logger) SetOutput(w io.Writer) {
 }
 
 func (l logger) setOutput(w io.Writer) {
-	l.entry.Logger.Out = w
+	l.entry.Logger.SetOutput(w)
 }
 
 func (l logger) getOutput() io.Writer {
@@ -316,7 +400,7 @@ func (l logger) getOutput() io.Writer {
 }
 
 func (l logger) SetJSONFormatter() {
-	l.entry.Logger.Formatter = &logrus.JSONFormatter{TimestampFormat: "2006-01-02T15:04:05.000000Z07:00"}
+	l.entry.Logger.Formatter = &logrus.JSONFormatter{TimestampFormat: jsonTimestampFormat}
 }
 
 func (l logger) source() *logrus.Entry {
@@ -367,6 +451,7 @@ func NewWrappedLogger(l *logrus.Logger) Logger {
 	out := logger{
 		logrus.NewEntry(l),
 		&loggerState{},
+		"",
 	}
 	formatter := out.entry.Logger.Formatter
 	tf, ok := formatter.(*logrus.TextFormatter)
@@ -376,6 +461,39 @@ func NewWrappedLogger(l *logrus.Logger) Logger {
 	return out
 }
 
+func (l logger) Fork() Logger {
+	base := l.entry.Logger
+	forked := logrus.New()
+	forked.Formatter = base.Formatter
+	forked.Level = base.Level
+	for level, hooks := range base.Hooks {
+		forked.Hooks[level] = append([]logrus.Hook(nil), hooks...)
+	}
+
+	l.loggerState.mu.RLock()
+	defer l.loggerState.mu.RUnlock()
+	state := &loggerState{
+		telemetry:            l.loggerState.telemetry,
+		disabledSubsystems:   make(map[string]bool, len(l.loggerState.disabledSubsystems)),
+		output:               l.loggerState.output,
+		additionalOutputDirs: l.loggerState.additionalOutputDirs,
+	}
+	for name := range l.loggerState.disabledSubsystems {
+		state.disabledSubsystems[name] = true
+	}
+	if state.output == nil {
+		state.output = base.Out
+	}
+
+	out := logger{
+		logrus.NewEntry(forked).WithFields(l.entry.Data),
+		state,
+		l.subsystem,
+	}
+	out.applyOutput()
+	return out
+}
+
 func (l logger) EnableTelemetry(cfg TelemetryConfig) (err error) {
 	if l.loggerState.telemetry != nil || (!cfg.Enable && !cfg.SendToLog) {
 		return nil
@@ -457,6 +575,12 @@ func (l logger) EventWithDetails(category telemetryspec.Category, identifier tel
 	}
 }
 
+func (l logger) ExportSpans(spans []Span) {
+	if l.loggerState.telemetry != nil {
+		l.loggerState.telemetry.exportSpans(spans)
+	}
+}
+
 func (l logger) CloseTelemetry() {
 	if l.loggerState.telemetry != nil {
 		l.loggerState.telemetry.Close()
@@ -19,6 +19,10 @@ package logging
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -34,6 +38,7 @@ TestFileOutputNewLogger -- Tests the input change to Buffer works for a new Logg
 TestSetLevelNewLogger -- Tests that the new level doesn't affect the base logger
 TestWithFieldsNewLogger - Test functionality on a new Logger
 TestSetJSONFormatter - Tests that the output results in JSON Format
+TestSetStructuredFormatter - Tests the schema of the structured JSON Format
 */
 
 func isJSON(s string) bool {
@@ -119,3 +124,166 @@ func TestSetJSONFormatter(t *testing.T) {
 	a.True(isJSON(bufNewLogger.String()))
 
 }
+
+func TestSetStructuredFormatter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	nl := NewLogger()
+	nl.SetOutput(&buf)
+	nl.SetStructuredFormatter()
+	nl.Subsystem(CatchupSubsystem).With(RoundField, 12).With(PeerField, "1.2.3.4:4160").
+		WithFields(Fields{"attempt": 2, "err": errors.New("failed")}).Warnf("fetch %s", "failed")
+	nl.Info("no fields")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	a.Len(lines, 2)
+
+	var entry map[string]interface{}
+	a.NoError(json.Unmarshal(lines[0], &entry))
+	a.Equal("warning", entry["level"])
+	a.Equal("fetch failed", entry["msg"])
+	a.NotEmpty(entry["time"])
+	a.Equal(CatchupSubsystem, entry[SubsystemField])
+	a.Equal(float64(12), entry[RoundField])
+	a.Equal("1.2.3.4:4160", entry[PeerField])
+	a.Equal("log_test.go", entry["file"])
+	a.Equal(map[string]interface{}{"attempt": float64(2), "err": "failed"}, entry[structuredFieldsKey])
+
+	entry = nil
+	a.NoError(json.Unmarshal(lines[1], &entry))
+	a.Equal("no fields", entry["msg"])
+	a.NotContains(entry, structuredFieldsKey)
+	a.NotContains(entry, SubsystemField)
+}
+
+func TestSubsystemLogging(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	nl := NewLogger()
+	nl.SetOutput(&buf)
+	agreement := nl.Subsystem(AgreementSubsystem).With("round", 1)
+	catchup := nl.Subsystem(CatchupSubsystem)
+
+	nl.SetSubsystemEnabled(AgreementSubsystem, false)
+	a.Equal([]string{AgreementSubsystem}, nl.DisabledSubsystems())
+	a.False(agreement.IsLevelEnabled(Info))
+	a.True(agreement.IsLevelEnabled(Error))
+
+	agreement.Info("dropped agreement info")
+	agreement.Warnf("dropped agreement %s", "warning")
+	agreement.Error("kept agreement error")
+	catchup.Info("kept catchup info")
+	a.NotContains(buf.String(), "dropped")
+	a.Contains(buf.String(), "kept agreement error")
+	a.Contains(buf.String(), "kept catchup info")
+
+	nl.SetSubsystemEnabled(AgreementSubsystem, true)
+	a.Empty(nl.DisabledSubsystems())
+	agreement.Info("enabled agreement info")
+	a.Contains(buf.String(), "enabled agreement info")
+}
+
+func TestAdditionalOutputFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	nl := NewLogger()
+	nl.SetOutput(&buf)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "debug.log")
+	a.Error(nl.SetAdditionalOutputFile(path))
+	nl.SetAdditionalOutputDirs(dir)
+	a.NoError(nl.SetAdditionalOutputFile(path))
+	a.Equal(path, nl.GetAdditionalOutputFile())
+	info, err := os.Stat(path)
+	a.NoError(err)
+	if runtime.GOOS != "windows" {
+		a.Equal(os.FileMode(0600), info.Mode().Perm())
+	}
+	nl.Info("written to both outputs")
+
+	// setting the output keeps writing to the additional file
+	var buf2 bytes.Buffer
+	nl.SetOutput(&buf2)
+	nl.Info("written after changing the output")
+
+	a.NoError(nl.SetAdditionalOutputFile(""))
+	a.Empty(nl.GetAdditionalOutputFile())
+	nl.Info("written to the output only")
+
+	data, err := os.ReadFile(path)
+	a.NoError(err)
+	a.Contains(string(data), "written to both outputs")
+	a.Contains(string(data), "written after changing the output")
+	a.NotContains(string(data), "written to the output only")
+	a.Contains(buf.String(), "written to both outputs")
+	a.Contains(buf2.String(), "written to the output only")
+
+	a.Error(nl.SetAdditionalOutputFile(filepath.Join(dir, "missing", "debug.log")))
+	a.Error(nl.SetAdditionalOutputFile("debug.log"))
+
+	// the file must stay in the allowed directories
+	outside := t.TempDir()
+	a.Error(nl.SetAdditionalOutputFile(filepath.Join(outside, "debug.log")))
+	a.Error(nl.SetAdditionalOutputFile(dir + string(filepath.Separator) + ".." + string(filepath.Separator) + filepath.Base(outside) + string(filepath.Separator) + "debug.log"))
+	if runtime.GOOS == "windows" {
+		return
+	}
+	a.NoError(os.Symlink(outside, filepath.Join(dir, "linkdir")))
+	a.Error(nl.SetAdditionalOutputFile(filepath.Join(dir, "linkdir", "debug.log")))
+	a.NoError(os.Symlink(filepath.Join(outside, "target.log"), filepath.Join(dir, "link.log")))
+	a.Error(nl.SetAdditionalOutputFile(filepath.Join(dir, "link.log")))
+	_, err = os.Stat(filepath.Join(outside, "target.log"))
+	a.True(os.IsNotExist(err))
+	a.Empty(nl.GetAdditionalOutputFile())
+}
+
+func TestForkedLogger(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	nl := NewLogger()
+	nl.SetOutput(&buf)
+	nl.SetLevel(Info)
+	nl.SetSubsystemEnabled("agreement", false)
+	dir := t.TempDir()
+	nl.SetAdditionalOutputDirs(dir)
+
+	fl := nl.With("network", "testnet-v1.0").Fork()
+	a.Equal(Info, fl.GetLevel())
+	a.Equal([]string{"agreement"}, fl.DisabledSubsystems())
+
+	// the forked logger writes to the same output, with the fields of the logger it was forked from
+	fl.Info("written by the forked logger")
+	a.Contains(buf.String(), "written by the forked logger")
+	a.Contains(buf.String(), "testnet-v1.0")
+
+	// its level, subsystems and additional output file are its own
+	fl.SetLevel(Debug)
+	fl.SetSubsystemEnabled("agreement", true)
+	fl.SetSubsystemEnabled("network", false)
+	path := filepath.Join(dir, "debug.log")
+	a.NoError(fl.SetAdditionalOutputFile(path))
+	defer fl.SetAdditionalOutputFile("")
+	a.Equal(Info, nl.GetLevel())
+	a.Equal([]string{"agreement"}, nl.DisabledSubsystems())
+	a.Empty(nl.GetAdditionalOutputFile())
+
+	nl.Debug("debug of the logger")
+	fl.Debug("debug of the forked logger")
+	nl.Info("info of the logger")
+	a.NotContains(buf.String(), "debug of the logger")
+	a.Contains(buf.String(), "debug of the forked logger")
+
+	data, err := os.ReadFile(path)
+	a.NoError(err)
+	a.Contains(string(data), "debug of the forked logger")
+	a.NotContains(string(data), "info of the logger")
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// The fields with a stable meaning across the log entries of the node. In the structured format, they are
+// kept at the top level of the JSON object of every entry, along with its time, level, message and source
+// location, while all the other fields are nested under the "fields" key.
+const (
+	// SubsystemField is the subsystem logging the entry, as set by Logger.Subsystem
+	SubsystemField = "subsystem"
+	// RoundField is the round the entry is about
+	RoundField = "round"
+	// PeerField is the address of the network peer the entry is about
+	PeerField = "peer"
+	// EventField identifies the kind of event the entry reports, for filtering without parsing the message
+	EventField = "event"
+)
+
+const structuredFieldsKey = "fields"
+
+var structuredTopLevelFields = map[string]bool{
+	SubsystemField: true,
+	RoundField:     true,
+	PeerField:      true,
+	EventField:     true,
+	"file":         true,
+	"line":         true,
+	"function":     true,
+}
+
+// structuredFormatter formats the entries as single line JSON objects of a stable schema: the time, level
+// and msg keys, the structuredTopLevelFields present in the entry, and the other fields under "fields".
+type structuredFormatter struct{}
+
+func (f *structuredFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	object := make(map[string]interface{}, len(structuredTopLevelFields)+4)
+	fields := make(map[string]interface{})
+	for key, value := range entry.Data {
+		if err, ok := value.(error); ok {
+			// errors are structures, usually with no exported fields
+			value = err.Error()
+		}
+		if structuredTopLevelFields[key] {
+			object[key] = value
+		} else {
+			fields[key] = value
+		}
+	}
+	if len(fields) > 0 {
+		object[structuredFieldsKey] = fields
+	}
+	object["time"] = entry.Time.Format(jsonTimestampFormat)
+	object["level"] = entry.Level.String()
+	object["msg"] = entry.Message
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(object); err != nil {
+		return nil, fmt.Errorf("failed to marshal the log entry to JSON: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (l logger) SetStructuredFormatter() {
+	l.entry.Logger.Formatter = &structuredFormatter{}
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The subsystems whose logging can be disabled at runtime.
+const (
+	AgreementSubsystem = "agreement"
+	CatchupSubsystem   = "catchup"
+	TxnSyncSubsystem   = "txnsync"
+	NetworkSubsystem   = "network"
+)
+
+// Subsystems lists the subsystems whose logging can be disabled at runtime.
+var Subsystems = []string{AgreementSubsystem, CatchupSubsystem, NetworkSubsystem, TxnSyncSubsystem}
+
+// IsSubsystem returns true if name is one of the Subsystems.
+func IsSubsystem(name string) bool {
+	for _, s := range Subsystems {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (l logger) Subsystem(name string) Logger {
+	return logger{
+		l.entry.WithField(SubsystemField, name),
+		l.loggerState,
+		name,
+	}
+}
+
+// muted returns true if the entries below Error of the logger are dropped, its subsystem being disabled.
+func (l logger) muted() bool {
+	if l.subsystem == "" {
+		return false
+	}
+	l.loggerState.mu.RLock()
+	defer l.loggerState.mu.RUnlock()
+	return l.loggerState.disabledSubsystems[l.subsystem]
+}
+
+func (l logger) SetSubsystemEnabled(name string, enabled bool) {
+	l.loggerState.mu.Lock()
+	defer l.loggerState.mu.Unlock()
+	if enabled {
+		delete(l.loggerState.disabledSubsystems, name)
+		return
+	}
+	if l.loggerState.disabledSubsystems == nil {
+		l.loggerState.disabledSubsystems = make(map[string]bool)
+	}
+	l.loggerState.disabledSubsystems[name] = true
+}
+
+func (l logger) DisabledSubsystems() []string {
+	l.loggerState.mu.RLock()
+	defer l.loggerState.mu.RUnlock()
+	disabled := make([]string, 0, len(l.loggerState.disabledSubsystems))
+	for name := range l.loggerState.disabledSubsystems {
+		disabled = append(disabled, name)
+	}
+	sort.Strings(disabled)
+	return disabled
+}
+
+func (l logger) SetAdditionalOutputDirs(dirs ...string) {
+	l.loggerState.mu.Lock()
+	defer l.loggerState.mu.Unlock()
+	l.loggerState.additionalOutputDirs = append([]string(nil), dirs...)
+}
+
+func (l logger) SetAdditionalOutputFile(path string) error {
+	l.loggerState.mu.RLock()
+	dirs := l.loggerState.additionalOutputDirs
+	l.loggerState.mu.RUnlock()
+
+	var f *os.File
+	if path != "" {
+		var err error
+		f, err = openAdditionalOutputFile(path, dirs)
+		if err != nil {
+			return err
+		}
+	}
+
+	l.loggerState.mu.Lock()
+	defer l.loggerState.mu.Unlock()
+	if l.loggerState.output == nil {
+		// the logger still writes to its initial output, which SetOutput was never given
+		l.loggerState.output = l.getOutput()
+	}
+	previous := l.loggerState.additionalOutput
+	l.loggerState.additionalOutput = f
+	l.applyOutput()
+	if previous != nil {
+		return previous.Close()
+	}
+	return nil
+}
+
+// openAdditionalOutputFile opens the additional log file at path for appending, creating it when needed. The path
+// may not go through a parent directory, must be in one of dirs once its symbolic links are resolved, and may not
+// be a symbolic link itself.
+func openAdditionalOutputFile(path string, dirs []string) (*os.File, error) {
+	if !filepath.IsAbs(path) {
+		return nil, fmt.Errorf("the additional log file %s is not an absolute path", path)
+	}
+	for _, elem := range strings.Split(filepath.ToSlash(path), "/") {
+		if elem == ".." {
+			return nil, fmt.Errorf("the additional log file %s may not be given relative to a parent directory", path)
+		}
+	}
+	path = filepath.Clean(path)
+
+	// the directories are compared once their symbolic links are resolved, so that a link out of them is detected
+	dir, err := filepath.EvalSymlinks(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the additional log file %s: %w", path, err)
+	}
+	inDirs := false
+	for _, allowed := range dirs {
+		allowed, err = filepath.EvalSymlinks(allowed)
+		if err != nil {
+			continue
+		}
+		if rel, err := filepath.Rel(allowed, dir); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			inDirs = true
+			break
+		}
+	}
+	if !inDirs {
+		return nil, fmt.Errorf("the additional log file %s must be in the data or log directory of the node", path)
+	}
+
+	if linked, err := os.Lstat(path); err == nil && linked.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("the additional log file %s may not be a symbolic link", path)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the additional log file %s: %w", path, err)
+	}
+	// check that the file opened is still the one at path, rather than a symbolic link created meanwhile
+	opened, err := f.Stat()
+	if err == nil {
+		var linked os.FileInfo
+		linked, err = os.Lstat(path)
+		if err == nil && (linked.Mode()&os.ModeSymlink != 0 || !os.SameFile(opened, linked)) {
+			err = errors.New("it is a symbolic link")
+		}
+	}
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("unable to open the additional log file %s: %w", path, err)
+	}
+	return f, nil
+}
+
+func (l logger) GetAdditionalOutputFile() string {
+	l.loggerState.mu.RLock()
+	defer l.loggerState.mu.RUnlock()
+	if l.loggerState.additionalOutput == nil {
+		return ""
+	}
+	return l.loggerState.additionalOutput.Name()
+}
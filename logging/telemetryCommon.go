@@ -54,6 +54,14 @@ type TelemetryConfig struct {
 	Version            string       `json:"-"`
 	UserName           string
 	Password           string
+
+	// OTLPEndpoint, when set, is the base URL of an OpenTelemetry collector accepting OTLP/HTTP,
+	// which the telemetry is exported to instead of the Elasticsearch endpoint at URI.
+	OTLPEndpoint       string            `json:",omitempty"`
+	OTLPHeaders        map[string]string `json:",omitempty"` // HTTP headers sent to the collector, e.g. for authentication
+	OTLPCACertFile     string            `json:",omitempty"` // PEM certificates of the CAs trusted to authenticate the collector
+	OTLPClientCertFile string            `json:",omitempty"` // PEM certificate authenticating the node to the collector
+	OTLPClientKeyFile  string            `json:",omitempty"` // PEM key of OTLPClientCertFile
 }
 
 // MarshalingTelemetryConfig is used for json serialization of the TelemetryConfig
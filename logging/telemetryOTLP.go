@@ -0,0 +1,600 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	otlpLogsPath       = "/v1/logs"
+	otlpMetricsPath    = "/v1/metrics"
+	otlpTracesPath     = "/v1/traces"
+	otlpScopeName      = "github.com/algorand/go-algorand/logging"
+	otlpServiceName    = "algod"
+	otlpBatchSize      = 64
+	otlpBatchInterval  = 5 * time.Second
+	otlpRequestTimeout = 10 * time.Second
+)
+
+// Span is a timed operation of a trace, as modeled by OpenTelemetry. Spans are exported by ExportSpans
+// when the telemetry exports to an OTLP collector.
+type Span struct {
+	TraceID    [16]byte
+	SpanID     [8]byte
+	ParentID   [8]byte // zero for the root span of the trace
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Attributes Fields
+	Error      string // the reason the operation failed, if it did
+}
+
+// batchedHook is implemented by the telemetry hooks holding events back to send them in batches.
+type batchedHook interface {
+	flush() error
+}
+
+// otlpHook exports the telemetry events to an OpenTelemetry collector over OTLP/HTTP, using the
+// JSON encoding of the protocol. Events are sent in batches, once otlpBatchSize of them are pending
+// or otlpBatchInterval after the first of them was fired. The numeric fields of the metrics
+// carried by the events are additionally exported as gauges, and the spans given to exportSpans
+// as traces, batched the same way.
+type otlpHook struct {
+	client   *http.Client
+	endpoint string
+	headers  map[string]string
+	resource otlpResource
+	levels   []logrus.Level
+
+	mu      deadlock.Mutex
+	records []otlpLogRecord
+	metrics []otlpMetric
+	spans   []otlpSpan
+	timer   *time.Timer
+}
+
+type otlpAnyValue struct {
+	StringValue *string           `json:"stringValue,omitempty"`
+	BoolValue   *bool             `json:"boolValue,omitempty"`
+	IntValue    *string           `json:"intValue,omitempty"` // 64 bit integers are strings in the JSON encoding of OTLP
+	DoubleValue *float64          `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue   `json:"arrayValue,omitempty"`
+	KvlistValue *otlpKeyValueList `json:"kvlistValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+type otlpKeyValueList struct {
+	Values []otlpKeyValue `json:"values"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string         `json:"timeUnixNano"`
+	SeverityNumber int            `json:"severityNumber"`
+	SeverityText   string         `json:"severityText"`
+	Body           otlpAnyValue   `json:"body"`
+	Attributes     []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpMetric struct {
+	Name  string    `json:"name"`
+	Gauge otlpGauge `json:"gauge"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpNumberDataPoint `json:"dataPoints"`
+}
+
+type otlpNumberDataPoint struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	AsDouble     float64        `json:"asDouble"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"` // identifiers are hex encoded in the JSON encoding of OTLP
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusCodeOk     = 1
+	otlpStatusCodeError  = 2
+)
+
+type otlpTracesRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpMetricsRequest struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+// otlpSeverity maps the logrus levels to the severity numbers of the OpenTelemetry log data model.
+var otlpSeverity = map[logrus.Level]int{
+	logrus.PanicLevel: 24,
+	logrus.FatalLevel: 21,
+	logrus.ErrorLevel: 17,
+	logrus.WarnLevel:  13,
+	logrus.InfoLevel:  9,
+	logrus.DebugLevel: 5,
+	logrus.TraceLevel: 1,
+}
+
+// telemetryHookFactory returns the factory of the hook exporting to the endpoint configured in cfg.
+func telemetryHookFactory(cfg TelemetryConfig) hookFactory {
+	if cfg.OTLPEndpoint != "" {
+		return createOTLPHook
+	}
+	return createElasticHook
+}
+
+func createOTLPHook(cfg TelemetryConfig) (hook logrus.Hook, err error) {
+	if cfg.OTLPEndpoint == "" {
+		return nil, nil
+	}
+
+	endpoint, err := url.Parse(cfg.OTLPEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the OTLP endpoint '%s' : %w", cfg.OTLPEndpoint, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("the OTLP endpoint '%s' should be an http or https URL", cfg.OTLPEndpoint)
+	}
+
+	tlsConfig, err := cfg.otlpTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &otlpHook{
+		client:   &http.Client{Transport: transport, Timeout: otlpRequestTimeout},
+		endpoint: strings.TrimSuffix(endpoint.String(), "/"),
+		headers:  cfg.OTLPHeaders,
+		resource: otlpResource{Attributes: cfg.otlpResourceAttributes()},
+		levels:   makeLevels(cfg.MinLogLevel),
+	}, nil
+}
+
+// otlpTLSConfig returns the TLS configuration authenticating the OTLP collector against
+// OTLPCACertFile, and the node against the collector with OTLPClientCertFile, when set.
+func (cfg TelemetryConfig) otlpTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.OTLPCACertFile != "" {
+		pem, err := os.ReadFile(cfg.OTLPCACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the OTLP CA certificate : %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in the OTLP CA certificate file '%s'", cfg.OTLPCACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.OTLPClientCertFile != "" || cfg.OTLPClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.OTLPClientCertFile, cfg.OTLPClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the OTLP client certificate : %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// otlpResourceAttributes describes the node sending the telemetry, following the
+// semantic conventions of OpenTelemetry where they apply.
+func (cfg TelemetryConfig) otlpResourceAttributes() []otlpKeyValue {
+	attributes := []otlpKeyValue{
+		{Key: "service.name", Value: otlpString(otlpServiceName)},
+		{Key: "service.instance.id", Value: otlpString(cfg.GUID)},
+		{Key: "algorand.instance_name", Value: otlpString(cfg.getInstanceName())},
+	}
+	if cfg.Version != "" {
+		attributes = append(attributes, otlpKeyValue{Key: "service.version", Value: otlpString(cfg.Version)})
+	}
+	if cfg.ChainID != "" {
+		attributes = append(attributes, otlpKeyValue{Key: "algorand.chain_id", Value: otlpString(cfg.ChainID)})
+	}
+	if cfg.Name != "" {
+		attributes = append(attributes, otlpKeyValue{Key: "algorand.node_name", Value: otlpString(cfg.Name)})
+	}
+	return attributes
+}
+
+// Levels is required for the logrus hook interface
+func (hook *otlpHook) Levels() []logrus.Level {
+	return hook.levels
+}
+
+// Fire is required for the logrus hook interface. It queues the entry, and sends the pending
+// batch if it is full.
+func (hook *otlpHook) Fire(entry *logrus.Entry) error {
+	record, metrics := otlpConvertEntry(entry)
+
+	hook.mu.Lock()
+	hook.records = append(hook.records, record)
+	hook.metrics = append(hook.metrics, metrics...)
+	full := len(hook.records) >= otlpBatchSize
+	if !full {
+		hook.schedule()
+	}
+	hook.mu.Unlock()
+
+	if full {
+		return hook.flush()
+	}
+	return nil
+}
+
+// exportSpans queues the spans, and sends the pending batch if it is full.
+func (hook *otlpHook) exportSpans(spans []Span) error {
+	converted := make([]otlpSpan, len(spans))
+	for i := range spans {
+		converted[i] = otlpConvertSpan(&spans[i])
+	}
+
+	hook.mu.Lock()
+	hook.spans = append(hook.spans, converted...)
+	full := len(hook.spans) >= otlpBatchSize
+	if !full {
+		hook.schedule()
+	}
+	hook.mu.Unlock()
+
+	if full {
+		return hook.flush()
+	}
+	return nil
+}
+
+// schedule arms the timer sending the pending batch, unless it already is. It must be called with mu held.
+func (hook *otlpHook) schedule() {
+	if hook.timer != nil {
+		return
+	}
+	hook.timer = time.AfterFunc(otlpBatchInterval, func() {
+		if hook.flush() != nil {
+			telemetryErrors.Inc(nil)
+		}
+	})
+}
+
+// flush sends the pending batch to the collector.
+func (hook *otlpHook) flush() error {
+	hook.mu.Lock()
+	records, metrics, spans := hook.records, hook.metrics, hook.spans
+	hook.records, hook.metrics, hook.spans = nil, nil, nil
+	if hook.timer != nil {
+		hook.timer.Stop()
+		hook.timer = nil
+	}
+	hook.mu.Unlock()
+
+	if len(records) > 0 {
+		err := hook.post(otlpLogsPath, otlpLogsRequest{ResourceLogs: []otlpResourceLogs{{
+			Resource:  hook.resource,
+			ScopeLogs: []otlpScopeLogs{{Scope: otlpScope{Name: otlpScopeName}, LogRecords: records}},
+		}}})
+		if err != nil {
+			return err
+		}
+	}
+	if len(metrics) > 0 {
+		err := hook.post(otlpMetricsPath, otlpMetricsRequest{ResourceMetrics: []otlpResourceMetrics{{
+			Resource:     hook.resource,
+			ScopeMetrics: []otlpScopeMetrics{{Scope: otlpScope{Name: otlpScopeName}, Metrics: metrics}},
+		}}})
+		if err != nil {
+			return err
+		}
+	}
+	if len(spans) > 0 {
+		return hook.post(otlpTracesPath, otlpTracesRequest{ResourceSpans: []otlpResourceSpans{{
+			Resource:   hook.resource,
+			ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{Name: otlpScopeName}, Spans: spans}},
+		}}})
+	}
+	return nil
+}
+
+func (hook *otlpHook) post(path string, request interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), otlpRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range hook.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := hook.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to send telemetry to the OTLP collector : %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body) //nolint:errcheck // draining the body so the connection can be reused
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("the OTLP collector at %s rejected the telemetry : %s", hook.endpoint+path, resp.Status)
+	}
+	return nil
+}
+
+// otlpConvertEntry converts a telemetry entry to an OTLP log record, and the metrics it
+// carries, if any, to gauges named after the event and the field of the metric.
+func otlpConvertEntry(entry *logrus.Entry) (otlpLogRecord, []otlpMetric) {
+	timestamp := strconv.FormatInt(entry.Time.UnixNano(), 10)
+	record := otlpLogRecord{
+		TimeUnixNano:   timestamp,
+		SeverityNumber: otlpSeverity[entry.Level],
+		SeverityText:   strings.ToUpper(entry.Level.String()),
+		Body:           otlpString(entry.Message),
+	}
+
+	keys := make([]string, 0, len(entry.Data))
+	for key := range entry.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		record.Attributes = append(record.Attributes, otlpKeyValue{Key: key, Value: otlpValue(entry.Data[key])})
+	}
+
+	details, has := entry.Data["metrics"]
+	if !has {
+		return record, nil
+	}
+	var metrics []otlpMetric
+	prefix := strings.ReplaceAll(strings.TrimPrefix(entry.Message, telemetryPrefix), telemetrySeparator, ".")
+	otlpNumbers(prefix, otlpGeneric(details), func(name string, value float64) {
+		metrics = append(metrics, otlpMetric{
+			Name:  name,
+			Gauge: otlpGauge{DataPoints: []otlpNumberDataPoint{{TimeUnixNano: timestamp, AsDouble: value}}},
+		})
+	})
+	return record, metrics
+}
+
+func otlpConvertSpan(span *Span) otlpSpan {
+	converted := otlpSpan{
+		TraceID:           hex.EncodeToString(span.TraceID[:]),
+		SpanID:            hex.EncodeToString(span.SpanID[:]),
+		Name:              span.Name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+		Status:            otlpStatus{Code: otlpStatusCodeOk},
+	}
+	if span.ParentID != [8]byte{} {
+		converted.ParentSpanID = hex.EncodeToString(span.ParentID[:])
+	}
+	if span.Error != "" {
+		converted.Status = otlpStatus{Code: otlpStatusCodeError, Message: span.Error}
+	}
+
+	keys := make([]string, 0, len(span.Attributes))
+	for key := range span.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		converted.Attributes = append(converted.Attributes, otlpKeyValue{Key: key, Value: otlpValue(span.Attributes[key])})
+	}
+	return converted
+}
+
+// exportSpans hands the spans to the OTLP hook exporting the telemetry, if it does export to an OTLP collector.
+func (t *telemetryState) exportSpans(spans []Span) {
+	async, ok := t.hook.(*asyncTelemetryHook)
+	if !ok {
+		return
+	}
+	otlp, ok := async.exportingHook().(*otlpHook)
+	if ok && otlp.exportSpans(spans) != nil {
+		telemetryErrors.Inc(nil)
+	}
+}
+
+// otlpNumbers calls add for every numeric field of the generic JSON value v, named after
+// the path leading to it.
+func otlpNumbers(name string, v interface{}, add func(name string, value float64)) {
+	switch x := v.(type) {
+	case json.Number:
+		if f, err := x.Float64(); err == nil {
+			add(name, f)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for key := range x {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			otlpNumbers(name+"."+key, x[key], add)
+		}
+	}
+}
+
+// otlpGeneric returns the generic JSON representation of v, as decoded into an interface{}.
+func otlpGeneric(v interface{}) interface{} {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	dec := json.NewDecoder(bytes.NewReader(encoded))
+	dec.UseNumber()
+	var generic interface{}
+	if dec.Decode(&generic) != nil {
+		return string(encoded)
+	}
+	return generic
+}
+
+func otlpString(s string) otlpAnyValue {
+	return otlpAnyValue{StringValue: &s}
+}
+
+// otlpValue converts a field of a telemetry entry to an OTLP value. Structures, such as the
+// details of the events, are converted through their JSON representation.
+func otlpValue(v interface{}) otlpAnyValue {
+	switch x := v.(type) {
+	case nil:
+		return otlpAnyValue{}
+	case json.Number:
+		if i, err := x.Int64(); err == nil {
+			s := strconv.FormatInt(i, 10)
+			return otlpAnyValue{IntValue: &s}
+		}
+		f, _ := x.Float64()
+		return otlpAnyValue{DoubleValue: &f}
+	case error:
+		return otlpString(x.Error())
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for key := range x {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		list := &otlpKeyValueList{Values: []otlpKeyValue{}}
+		for _, key := range keys {
+			list.Values = append(list.Values, otlpKeyValue{Key: key, Value: otlpValue(x[key])})
+		}
+		return otlpAnyValue{KvlistValue: list}
+	case []interface{}:
+		array := &otlpArrayValue{Values: make([]otlpAnyValue, len(x))}
+		for i := range x {
+			array.Values[i] = otlpValue(x[i])
+		}
+		return otlpAnyValue{ArrayValue: array}
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return otlpString(rv.String())
+	case reflect.Bool:
+		b := rv.Bool()
+		return otlpAnyValue{BoolValue: &b}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s := strconv.FormatInt(rv.Int(), 10)
+		return otlpAnyValue{IntValue: &s}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if rv.Uint() > math.MaxInt64 {
+			f := float64(rv.Uint())
+			return otlpAnyValue{DoubleValue: &f}
+		}
+		s := strconv.FormatUint(rv.Uint(), 10)
+		return otlpAnyValue{IntValue: &s}
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			// not representable in JSON
+			return otlpString(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+		return otlpAnyValue{DoubleValue: &f}
+	}
+	return otlpValue(otlpGeneric(v))
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type otlpTestMetrics struct {
+	Round   uint64
+	Elapsed float64
+	Name    string
+}
+
+type otlpTestCollector struct {
+	mu      deadlock.Mutex
+	logs    []otlpLogsRequest
+	metrics []otlpMetricsRequest
+	traces  []otlpTracesRequest
+	headers []http.Header
+}
+
+func (c *otlpTestCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.headers = append(c.headers, r.Header.Clone())
+	dec := json.NewDecoder(r.Body)
+	switch r.URL.Path {
+	case "/otlp" + otlpLogsPath:
+		var req otlpLogsRequest
+		if dec.Decode(&req) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c.logs = append(c.logs, req)
+	case "/otlp" + otlpMetricsPath:
+		var req otlpMetricsRequest
+		if dec.Decode(&req) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c.metrics = append(c.metrics, req)
+	case "/otlp" + otlpTracesPath:
+		var req otlpTracesRequest
+		if dec.Decode(&req) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c.traces = append(c.traces, req)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func otlpTestEntry(message string, fields logrus.Fields) *logrus.Entry {
+	entry := logrus.NewEntry(logrus.New()).WithFields(fields)
+	entry.Time = time.Unix(1700000000, 5)
+	entry.Level = logrus.WarnLevel
+	entry.Message = message
+	return entry
+}
+
+func otlpAttribute(attributes []otlpKeyValue, key string) *otlpAnyValue {
+	for i := range attributes {
+		if attributes[i].Key == key {
+			return &attributes[i].Value
+		}
+	}
+	return nil
+}
+
+func TestOTLPHookBatches(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	collector := &otlpTestCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	cfg := createTelemetryConfig()
+	cfg.Enable = true
+	cfg.ChainID = "test-testnet-v1.0"
+	cfg.Version = "1.2.3"
+	cfg.OTLPEndpoint = server.URL + "/otlp/"
+	cfg.OTLPHeaders = map[string]string{"Authorization": "Bearer token"}
+	require.NotNil(t, telemetryHookFactory(cfg))
+
+	hook, err := createOTLPHook(cfg)
+	require.NoError(t, err)
+
+	// below the batch size, nothing is sent until the batch is flushed
+	for i := 0; i < otlpBatchSize-1; i++ {
+		require.NoError(t, hook.Fire(otlpTestEntry("/Test/Event", logrus.Fields{"index": i})))
+	}
+	collector.mu.Lock()
+	require.Empty(t, collector.logs)
+	collector.mu.Unlock()
+
+	metrics := otlpTestMetrics{Round: 7, Elapsed: 1.5, Name: "ignored"}
+	require.NoError(t, hook.Fire(otlpTestEntry("/Test/TestMetrics", logrus.Fields{"metrics": metrics, "error": errors.New("oops")})))
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	require.Len(t, collector.logs, 1)
+	require.Len(t, collector.metrics, 1)
+	require.Equal(t, "Bearer token", collector.headers[0].Get("Authorization"))
+	require.Equal(t, "application/json", collector.headers[0].Get("Content-Type"))
+
+	resourceLogs := collector.logs[0].ResourceLogs
+	require.Len(t, resourceLogs, 1)
+	resource := resourceLogs[0].Resource.Attributes
+	require.Equal(t, otlpServiceName, *otlpAttribute(resource, "service.name").StringValue)
+	require.Equal(t, cfg.GUID, *otlpAttribute(resource, "service.instance.id").StringValue)
+	require.Equal(t, "1.2.3", *otlpAttribute(resource, "service.version").StringValue)
+	require.Equal(t, "test-testnet-v1.0", *otlpAttribute(resource, "algorand.chain_id").StringValue)
+
+	records := resourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, otlpBatchSize)
+	require.Equal(t, "1700000000000000005", records[0].TimeUnixNano)
+	require.Equal(t, 13, records[0].SeverityNumber)
+	require.Equal(t, "/Test/Event", *records[0].Body.StringValue)
+	require.Equal(t, "1", *otlpAttribute(records[1].Attributes, "index").IntValue)
+
+	last := records[otlpBatchSize-1]
+	require.Equal(t, "oops", *otlpAttribute(last.Attributes, "error").StringValue)
+	details := otlpAttribute(last.Attributes, "metrics").KvlistValue
+	require.NotNil(t, details)
+	require.Equal(t, "7", *otlpAttribute(details.Values, "Round").IntValue)
+	require.Equal(t, 1.5, *otlpAttribute(details.Values, "Elapsed").DoubleValue)
+
+	gauges := collector.metrics[0].ResourceMetrics[0].ScopeMetrics[0].Metrics
+	require.Len(t, gauges, 2)
+	require.Equal(t, "Test.TestMetrics.Elapsed", gauges[0].Name)
+	require.Equal(t, 1.5, gauges[0].Gauge.DataPoints[0].AsDouble)
+	require.Equal(t, "Test.TestMetrics.Round", gauges[1].Name)
+	require.Equal(t, float64(7), gauges[1].Gauge.DataPoints[0].AsDouble)
+}
+
+func TestOTLPHookFlush(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	collector := &otlpTestCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	cfg := createTelemetryConfig()
+	cfg.Enable = true
+	cfg.OTLPEndpoint = server.URL + "/otlp"
+	hook, err := createOTLPHook(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, hook.Fire(otlpTestEntry("/Test/Event", nil)))
+	require.NoError(t, hook.(batchedHook).flush())
+	// nothing left to send
+	require.NoError(t, hook.(batchedHook).flush())
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	require.Len(t, collector.logs, 1)
+	require.Empty(t, collector.metrics)
+	require.Len(t, collector.logs[0].ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+}
+
+func TestOTLPHookSpans(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	collector := &otlpTestCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	cfg := createTelemetryConfig()
+	cfg.Enable = true
+	cfg.OTLPEndpoint = server.URL + "/otlp"
+	hook, err := createOTLPHook(cfg)
+	require.NoError(t, err)
+
+	start := time.Unix(1700000000, 0)
+	root := Span{TraceID: [16]byte{1, 2}, SpanID: [8]byte{3}, Name: "root", Start: start, End: start.Add(time.Second), Attributes: Fields{"round": 5}}
+	child := Span{TraceID: root.TraceID, SpanID: [8]byte{4}, ParentID: root.SpanID, Name: "child", Start: start, End: start.Add(time.Millisecond), Error: "failed"}
+	require.NoError(t, hook.(*otlpHook).exportSpans([]Span{root, child}))
+	require.NoError(t, hook.(batchedHook).flush())
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	require.Empty(t, collector.logs)
+	require.Len(t, collector.traces, 1)
+	spans := collector.traces[0].ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "01020000000000000000000000000000", spans[0].TraceID)
+	require.Equal(t, "0300000000000000", spans[0].SpanID)
+	require.Empty(t, spans[0].ParentSpanID)
+	require.Equal(t, "1700000000000000000", spans[0].StartTimeUnixNano)
+	require.Equal(t, "1700000001000000000", spans[0].EndTimeUnixNano)
+	require.Equal(t, otlpStatusCodeOk, spans[0].Status.Code)
+	require.Equal(t, "5", *otlpAttribute(spans[0].Attributes, "round").IntValue)
+
+	require.Equal(t, spans[0].TraceID, spans[1].TraceID)
+	require.Equal(t, spans[0].SpanID, spans[1].ParentSpanID)
+	require.Equal(t, otlpStatus{Code: otlpStatusCodeError, Message: "failed"}, spans[1].Status)
+}
+
+func TestOTLPHookTLS(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	collector := &otlpTestCollector{}
+	server := httptest.NewTLSServer(collector)
+	defer server.Close()
+
+	cfg := createTelemetryConfig()
+	cfg.Enable = true
+	cfg.OTLPEndpoint = server.URL + "/otlp"
+
+	// the certificate of the test server isn't trusted by default
+	hook, err := createOTLPHook(cfg)
+	require.NoError(t, err)
+	require.NoError(t, hook.Fire(otlpTestEntry("/Test/Event", nil)))
+	require.Error(t, hook.(batchedHook).flush())
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0600))
+	cfg.OTLPCACertFile = caFile
+	hook, err = createOTLPHook(cfg)
+	require.NoError(t, err)
+	require.NoError(t, hook.Fire(otlpTestEntry("/Test/Event", nil)))
+	require.NoError(t, hook.(batchedHook).flush())
+
+	collector.mu.Lock()
+	require.Len(t, collector.logs, 1)
+	collector.mu.Unlock()
+
+	cfg.OTLPCACertFile = filepath.Join(t.TempDir(), "missing.pem")
+	_, err = createOTLPHook(cfg)
+	require.Error(t, err)
+}
+
+func TestOTLPHookConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := createTelemetryConfig()
+	hook, err := createOTLPHook(cfg)
+	require.NoError(t, err)
+	require.Nil(t, hook)
+
+	cfg.OTLPEndpoint = "ftp://collector:4318"
+	_, err = createOTLPHook(cfg)
+	require.Error(t, err)
+}
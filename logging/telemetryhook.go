@@ -180,10 +180,31 @@ func (hook *asyncTelemetryHook) Close() {
 	hook.wg.Add(1)
 	close(hook.quit)
 	hook.wg.Wait()
+	hook.flushBatch()
 }
 
 func (hook *asyncTelemetryHook) Flush() {
 	hook.wg.Wait()
+	hook.flushBatch()
+}
+
+// flushBatch sends the events held back by the exporting hook, if it sends them in batches.
+func (hook *asyncTelemetryHook) flushBatch() {
+	batched, ok := hook.exportingHook().(batchedHook)
+	if ok && batched.flush() != nil {
+		telemetryErrors.Inc(nil)
+	}
+}
+
+// exportingHook returns the hook exporting the events, wrapped by the telemetryFilteredHook, if any.
+func (hook *asyncTelemetryHook) exportingHook() logrus.Hook {
+	tfh, ok := hook.wrappedHook.(*telemetryFilteredHook)
+	if !ok {
+		return nil
+	}
+	hook.Lock()
+	defer hook.Unlock()
+	return tfh.wrappedHook
 }
 
 func (hook *dummyHook) UpdateHookURI(uri string) (err error) {
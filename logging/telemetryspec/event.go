@@ -299,6 +299,10 @@ type PeerConnectionDetails struct {
 	TXCount, MICount, AVCount, PPCount, UNKCount uint64
 	// TCPInfo provides connection measurements from TCP.
 	TCP util.TCPInfo `json:",omitempty"`
+	// ProtocolVersion is the gossip protocol version negotiated with the peer.
+	ProtocolVersion string `json:",omitempty"`
+	// Capabilities are the optional features negotiated with the peer, as announced in the X-Algorand-Peer-Features header.
+	Capabilities []string `json:",omitempty"`
 }
 
 // CatchpointGenerationEvent event
@@ -366,3 +370,144 @@ type BalancesAccountVacuumEventDetails struct {
 	// AfterVacuumSpaceBytes is the number of bytes used by the database after running the vacuuming process.
 	AfterVacuumSpaceBytes uint64
 }
+
+// ParticipationKeyRenewalEvent event
+const ParticipationKeyRenewalEvent Event = "ParticipationKeyRenewal"
+
+// ParticipationKeyRenewalEventDetails is generated when a participation key registered for an online account is about
+// to expire without having been renewed, when its renewal fails, and when its successor gets registered.
+type ParticipationKeyRenewalEventDetails struct {
+	Address         string
+	ParticipationID string
+	LastValid       uint64
+	State           string
+	Successor       string `json:",omitempty"`
+	Error           string `json:",omitempty"`
+}
+
+// DiskSpaceEvent event
+const DiskSpaceEvent Event = "DiskSpace"
+
+// DiskSpaceEventDetails is generated when the disk space left on the data directory crosses one of the thresholds
+// configured for the node, with the protective actions the node takes at the new level.
+type DiskSpaceEventDetails struct {
+	AvailableBytes        uint64
+	Level                 string
+	PreviousLevel         string
+	CatchpointFilesPaused bool
+	BlockIngestionHalted  bool
+}
+
+// WatchdogSnapshot is the state of the node when its watchdog detects an abnormal condition, sent with every
+// watchdog event.
+type WatchdogSnapshot struct {
+	Round              uint64
+	TimeSinceLastRound time.Duration
+	CatchingUp         bool
+	CommittedRound     uint64
+	VoteQueueLength    int
+	VoteQueueCapacity  int
+	TxPoolCount        int
+	OutgoingPeers      int
+	IncomingPeers      int
+}
+
+// SlowRoundEvent event
+const SlowRoundEvent Event = "SlowRound"
+
+// SlowRoundEventDetails is generated when a round took longer than the WatchdogSlowRoundThreshold of the config to be
+// added to the ledger, that is for the node to receive its certificate after the previous round.
+type SlowRoundEventDetails struct {
+	Round     uint64
+	Duration  time.Duration
+	Threshold time.Duration
+	Snapshot  WatchdogSnapshot
+}
+
+// LedgerStallEvent event
+const LedgerStallEvent Event = "LedgerStall"
+
+// LedgerStallEventDetails is generated once per stall when no round was added to the ledger, that is no certificate
+// was received, for the WatchdogStallThreshold of the config. Round is the last round added to the ledger.
+type LedgerStallEventDetails struct {
+	Round     uint64
+	Duration  time.Duration
+	Threshold time.Duration
+	Snapshot  WatchdogSnapshot
+}
+
+// CommitBacklogEvent event
+const CommitBacklogEvent Event = "CommitBacklog"
+
+// CommitBacklogEventDetails is generated when the rounds added to the ledger but not yet committed to its database
+// grow past the WatchdogCommitBacklogThreshold of the config, once until they fall back below it.
+type CommitBacklogEventDetails struct {
+	Backlog   uint64
+	Threshold uint64
+	Snapshot  WatchdogSnapshot
+}
+
+// VoteVerificationSaturatedEvent event
+const VoteVerificationSaturatedEvent Event = "VoteVerificationSaturated"
+
+// VoteVerificationSaturatedEventDetails is generated when the queue of the votes and proposals to verify stayed
+// full for Duration, the agreement then waiting for room to verify the messages it receives. It is generated once
+// until the queue has room again.
+type VoteVerificationSaturatedEventDetails struct {
+	Duration time.Duration
+	Snapshot WatchdogSnapshot
+}
+
+// PartitionRecoveredEvent event
+const PartitionRecoveredEvent Event = "PartitionRecovered"
+
+// PartitionRecoveredEventDetails is generated when the agreement concludes a round in which it entered a period past
+// the one it started the round in. Duration is the time from entering the first of these periods to the conclusion of
+// the round, in which the agreement traversed PeriodsTraversed periods and resynchronized Resynchronizations times.
+// Cause classifies the episode from how it started, without blaming any proposer or voter: no-proposal when the
+// network next-voted the empty value, no-certification when it next-voted a proposal it did not certify in time, and
+// fast-forward when the node lagged behind the network and caught up with a later period. Resolution is certificate
+// when the round concluded with a certificate, and catchup when the ledger caught up with the round.
+type PartitionRecoveredEventDetails struct {
+	Round              uint64
+	FinalPeriod        uint64
+	PeriodsTraversed   uint64
+	Resynchronizations uint64
+	Duration           time.Duration
+	Cause              string
+	Resolution         string
+}
+
+// DeadlockDetectedEvent event
+const DeadlockDetectedEvent Event = "DeadlockDetected"
+
+// DeadlockLock is a lock involved in a potential deadlock, held or waited for by a goroutine.
+type DeadlockLock struct {
+	Goroutine int64
+	Lock      string
+	Waiting   bool
+}
+
+// DeadlockDetectedEventDetails is generated when the deadlock detector reports a potential deadlock, right before
+// the node aborts. Kind is timeout when a lock was waited for longer than the DeadlockDetectionThreshold of the
+// config, recursive when a goroutine locks a lock it already holds, and lock-order when locks are acquired in
+// inconsistent orders. Locks is the acquisition graph of the locks involved, and Report the report of the detector,
+// with the stacks the locks were acquired at.
+type DeadlockDetectedEventDetails struct {
+	Kind   string
+	Locks  []DeadlockLock
+	Report string
+}
+
+// ResourceLimitApproachingEvent event
+const ResourceLimitApproachingEvent Event = "ResourceLimitApproaching"
+
+// ResourceLimitApproachingEventDetails is generated when the usage of a resource of the process, the file
+// descriptors, the memory or the threads, reaches the ResourceUsageWarningPercent of the config of its limit.
+// Budgets are the file descriptors apportioned to the subsystems of the node.
+type ResourceLimitApproachingEventDetails struct {
+	Resource string
+	Used     uint64
+	Limit    uint64
+	Budgets  map[string]uint64
+}
@@ -0,0 +1,316 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netdeploy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+)
+
+// AssetTemplate describes an asset created by a genesis wallet once the network is running
+type AssetTemplate struct {
+	Name          string // Unique name used to refer to the asset; also the default AssetName
+	Creator       string // Name of the genesis wallet creating the asset
+	UnitName      string `json:",omitempty"`
+	AssetName     string `json:",omitempty"`
+	URL           string `json:",omitempty"`
+	Total         uint64
+	Decimals      uint32 `json:",omitempty"`
+	DefaultFrozen bool   `json:",omitempty"`
+}
+
+// ApplicationTemplate describes an application created by a genesis wallet once the network is running.
+// Programs are given either as TEAL source or as a path to a TEAL source file; relative paths are
+// resolved against the working directory when the network is created.
+type ApplicationTemplate struct {
+	Name                string   // Unique name used to refer to the application
+	Creator             string   // Name of the genesis wallet creating the application
+	ApprovalProgram     string   `json:",omitempty"`
+	ApprovalProgramFile string   `json:",omitempty"`
+	ClearProgram        string   `json:",omitempty"`
+	ClearProgramFile    string   `json:",omitempty"`
+	GlobalInts          uint64   `json:",omitempty"`
+	GlobalByteSlices    uint64   `json:",omitempty"`
+	LocalInts           uint64   `json:",omitempty"`
+	LocalByteSlices     uint64   `json:",omitempty"`
+	ExtraPages          uint32   `json:",omitempty"`
+	Args                [][]byte `json:",omitempty"` // Application call arguments, base64 encoded in JSON
+}
+
+// Deployments holds the assets and applications a network still has to create, along with the
+// IDs of those it already created
+type Deployments struct {
+	Assets       []AssetTemplate       `json:",omitempty"`
+	Applications []ApplicationTemplate `json:",omitempty"`
+	// WalletNodes maps each creator wallet to the node holding its root key
+	WalletNodes map[string]string `json:",omitempty"`
+	// Created maps asset and application names to their IDs
+	Created map[string]uint64 `json:",omitempty"`
+}
+
+// walletNodes maps genesis wallet names to the node that owns their root key
+func (t NetworkTemplate) walletNodes() map[string]string {
+	nodes := make(map[string]string)
+	for _, cfg := range t.Nodes {
+		for _, wallet := range cfg.Wallets {
+			if !wallet.ParticipationOnly {
+				nodes[strings.ToUpper(wallet.Name)] = cfg.Name
+			}
+		}
+	}
+	return nodes
+}
+
+func (t NetworkTemplate) validateDeployments() error {
+	walletNodes := t.walletNodes()
+	names := make(map[string]bool)
+	checkEntry := func(kind, name, creator string) error {
+		if name == "" {
+			return fmt.Errorf("invalid template: %s with no Name", kind)
+		}
+		upperName := strings.ToUpper(name)
+		if names[upperName] {
+			return fmt.Errorf("invalid template: duplicate deployment name %s", name)
+		}
+		names[upperName] = true
+		if _, found := walletNodes[strings.ToUpper(creator)]; !found {
+			return fmt.Errorf("invalid template: %s %s creator '%s' is not a wallet assigned to any node", kind, name, creator)
+		}
+		return nil
+	}
+
+	for _, asset := range t.Assets {
+		if err := checkEntry("asset", asset.Name, asset.Creator); err != nil {
+			return err
+		}
+	}
+	for _, app := range t.Applications {
+		if err := checkEntry("application", app.Name, app.Creator); err != nil {
+			return err
+		}
+		if (app.ApprovalProgram == "") == (app.ApprovalProgramFile == "") {
+			return fmt.Errorf("invalid template: application %s must set exactly one of ApprovalProgram or ApprovalProgramFile", app.Name)
+		}
+		if (app.ClearProgram == "") == (app.ClearProgramFile == "") {
+			return fmt.Errorf("invalid template: application %s must set exactly one of ClearProgram or ClearProgramFile", app.Name)
+		}
+	}
+	return nil
+}
+
+// resolveDeployments returns the deployments declared by the template with every program file
+// read into its TEAL source, or nil if the template does not declare any
+func (t NetworkTemplate) resolveDeployments() (*Deployments, error) {
+	if len(t.Assets) == 0 && len(t.Applications) == 0 {
+		return nil, nil
+	}
+
+	deployments := &Deployments{
+		Assets:      t.Assets,
+		WalletNodes: make(map[string]string),
+	}
+	walletNodes := t.walletNodes()
+	for _, asset := range t.Assets {
+		deployments.WalletNodes[asset.Creator] = walletNodes[strings.ToUpper(asset.Creator)]
+	}
+	for _, app := range t.Applications {
+		deployments.WalletNodes[app.Creator] = walletNodes[strings.ToUpper(app.Creator)]
+		var err error
+		app.ApprovalProgram, err = readProgramSource(app.ApprovalProgram, app.ApprovalProgramFile)
+		if err != nil {
+			return nil, fmt.Errorf("application %s: %w", app.Name, err)
+		}
+		app.ClearProgram, err = readProgramSource(app.ClearProgram, app.ClearProgramFile)
+		if err != nil {
+			return nil, fmt.Errorf("application %s: %w", app.Name, err)
+		}
+		app.ApprovalProgramFile = ""
+		app.ClearProgramFile = ""
+		deployments.Applications = append(deployments.Applications, app)
+	}
+	return deployments, nil
+}
+
+func readProgramSource(source, file string) (string, error) {
+	if file == "" {
+		return source, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Deploy creates the assets and applications declared by the network template that were not
+// created yet, records their IDs in network.json and returns the newly created ones. The network
+// must be running, and the creator wallets' root keys must have been imported into their nodes.
+func (n *Network) Deploy(binDir string) (created map[string]uint64, err error) {
+	deployments := n.cfg.Deployments
+	if deployments == nil {
+		return nil, nil
+	}
+	if deployments.Created == nil {
+		deployments.Created = make(map[string]uint64)
+	}
+
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(n.rootDir, genesisFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make(map[string]libgoal.Client)
+	creatorClient := func(wallet string) (client libgoal.Client, wh []byte, sender string, err error) {
+		for _, alloc := range genesis.Allocation {
+			if strings.EqualFold(alloc.Comment, wallet) {
+				sender = alloc.Address
+				break
+			}
+		}
+		if sender == "" {
+			return client, nil, "", fmt.Errorf("wallet %s is not a genesis account", wallet)
+		}
+		nodeName, ok := deployments.WalletNodes[wallet]
+		if !ok {
+			return client, nil, "", fmt.Errorf("wallet %s is not assigned to a node", wallet)
+		}
+		client, ok = clients[nodeName]
+		if !ok {
+			client, err = libgoal.MakeClientWithBinDir(binDir, n.getNodeFullPath(nodeName), "", libgoal.DynamicClient)
+			if err != nil {
+				return
+			}
+			clients[nodeName] = client
+		}
+		wh, err = client.GetUnencryptedWalletHandle()
+		return
+	}
+
+	created = make(map[string]uint64)
+	for _, asset := range deployments.Assets {
+		if _, done := deployments.Created[asset.Name]; done {
+			continue
+		}
+		client, wh, sender, err := creatorClient(asset.Creator)
+		if err != nil {
+			return created, fmt.Errorf("asset %s: %w", asset.Name, err)
+		}
+		assetName := asset.AssetName
+		if assetName == "" {
+			assetName = asset.Name
+		}
+		tx, err := client.MakeUnsignedAssetCreateTx(asset.Total, asset.DefaultFrozen, sender, sender, sender, sender, asset.UnitName, assetName, asset.URL, nil, asset.Decimals)
+		if err != nil {
+			return created, fmt.Errorf("asset %s: %w", asset.Name, err)
+		}
+		txn, err := submitDeployment(client, wh, sender, tx)
+		if err != nil {
+			return created, fmt.Errorf("asset %s: %w", asset.Name, err)
+		}
+		if txn.AssetIndex == nil {
+			return created, fmt.Errorf("asset %s: transaction did not create an asset", asset.Name)
+		}
+		if err = n.recordDeployment(asset.Name, *txn.AssetIndex, created); err != nil {
+			return created, err
+		}
+	}
+
+	for _, app := range deployments.Applications {
+		if _, done := deployments.Created[app.Name]; done {
+			continue
+		}
+		client, wh, sender, err := creatorClient(app.Creator)
+		if err != nil {
+			return created, fmt.Errorf("application %s: %w", app.Name, err)
+		}
+		approval, _, _, err := client.Compile([]byte(app.ApprovalProgram), false)
+		if err != nil {
+			return created, fmt.Errorf("application %s: unable to compile approval program: %w", app.Name, err)
+		}
+		clearProg, _, _, err := client.Compile([]byte(app.ClearProgram), false)
+		if err != nil {
+			return created, fmt.Errorf("application %s: unable to compile clear program: %w", app.Name, err)
+		}
+		globalSchema := basics.StateSchema{NumUint: app.GlobalInts, NumByteSlice: app.GlobalByteSlices}
+		localSchema := basics.StateSchema{NumUint: app.LocalInts, NumByteSlice: app.LocalByteSlices}
+		tx, err := client.MakeUnsignedAppCreateTx(transactions.NoOpOC, approval, clearProg, globalSchema, localSchema, app.Args, nil, nil, nil, nil, app.ExtraPages)
+		if err != nil {
+			return created, fmt.Errorf("application %s: %w", app.Name, err)
+		}
+		txn, err := submitDeployment(client, wh, sender, tx)
+		if err != nil {
+			return created, fmt.Errorf("application %s: %w", app.Name, err)
+		}
+		if txn.ApplicationIndex == nil {
+			return created, fmt.Errorf("application %s: transaction did not create an application", app.Name)
+		}
+		if err = n.recordDeployment(app.Name, *txn.ApplicationIndex, created); err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
+
+func (n *Network) recordDeployment(name string, id uint64, created map[string]uint64) error {
+	n.cfg.Deployments.Created[name] = id
+	created[name] = id
+	return n.Save(n.rootDir)
+}
+
+// submitDeployment signs and broadcasts tx from sender and waits until it is committed
+func submitDeployment(client libgoal.Client, wh []byte, sender string, tx transactions.Transaction) (txn model.PendingTransactionResponse, err error) {
+	tx, err = client.FillUnsignedTxTemplate(sender, 0, 0, 0, tx)
+	if err != nil {
+		return
+	}
+	txid, err := client.SignAndBroadcastTransaction(wh, nil, tx)
+	if err != nil {
+		return
+	}
+
+	stat, err := client.Status()
+	if err != nil {
+		return
+	}
+	for {
+		txn, err = client.PendingTransactionInformation(txid)
+		if err != nil {
+			return
+		}
+		if txn.ConfirmedRound != nil && *txn.ConfirmedRound > 0 {
+			return txn, nil
+		}
+		if txn.PoolError != "" {
+			return txn, fmt.Errorf("transaction %s rejected: %s", txid, txn.PoolError)
+		}
+		if stat.LastRound >= uint64(tx.LastValid) {
+			return txn, fmt.Errorf("transaction %s expired before it could be included in a block", txid)
+		}
+		stat, err = client.WaitForRound(stat.LastRound)
+		if err != nil {
+			return
+		}
+	}
+}
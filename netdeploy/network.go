@@ -45,6 +45,8 @@ type NetworkCfg struct {
 	// They are stored relative to root dir (e.g. "Primary")
 	RelayDirs    []string `json:"RelayDirs,omitempty"`
 	TemplateFile string   `json:"TemplateFile,omitempty"` // Template file used to create the network
+	// Deployments are the template's assets and applications, created once the network is running
+	Deployments *Deployments `json:"Deployments,omitempty"`
 }
 
 // Network represents an instance of a deployed network
@@ -85,6 +87,14 @@ func CreateNetworkFromTemplate(name, rootDir string, templateReader io.Reader, b
 		return n, err
 	}
 
+	n.cfg.Deployments, err = template.resolveDeployments()
+	if err != nil {
+		return n, err
+	}
+	if n.cfg.Deployments != nil && !importKeys {
+		return n, fmt.Errorf("network template assets and applications require importing the root keys")
+	}
+
 	if n.cfg.Name == "" {
 		n.cfg.Name = template.Genesis.NetworkName
 	}
@@ -97,6 +107,10 @@ func CreateNetworkFromTemplate(name, rootDir string, templateReader io.Reader, b
 	if err != nil {
 		return n, err
 	}
+	consensus, err = template.shapeConsensus(consensus)
+	if err != nil {
+		return n, err
+	}
 	template.Consensus = consensus
 	err = template.generateGenesisAndWallets(rootDir, n.cfg.Name, binDir)
 	if err != nil {
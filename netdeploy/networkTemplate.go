@@ -29,10 +29,12 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/gen"
 	"github.com/algorand/go-algorand/libgoal"
 	"github.com/algorand/go-algorand/netdeploy/remote"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util"
 )
 
@@ -41,6 +43,51 @@ type NetworkTemplate struct {
 	Genesis   gen.GenesisData
 	Nodes     []remote.NodeConfigGoal
 	Consensus config.ConsensusProtocols
+
+	// Assets and Applications are created by their genesis wallets once the network starts
+	Assets       []AssetTemplate       `json:",omitempty"`
+	Applications []ApplicationTemplate `json:",omitempty"`
+
+	// BlockPayload overrides the block payload limits of the genesis consensus protocol
+	BlockPayload *BlockPayloadTemplate `json:",omitempty"`
+}
+
+// BlockPayloadTemplate shapes the blocks of a private network. Its limits override the ones of the genesis
+// consensus protocol in the consensus.json deployed to the nodes, leaving the other parameters unchanged.
+type BlockPayloadTemplate struct {
+	MaxTxnBytesPerBlock   int                     `json:",omitempty"` // Zero keeps the limit of the protocol
+	MaxTxnsPerBlockByType map[protocol.TxType]int `json:",omitempty"` // Caps on the transactions of each type
+}
+
+// shapeConsensus returns the consensus protocols with the block payload limits of the template applied to the
+// genesis consensus protocol, or the consensus protocols unchanged when the template has none.
+func (t NetworkTemplate) shapeConsensus(consensus config.ConsensusProtocols) (config.ConsensusProtocols, error) {
+	if t.BlockPayload == nil {
+		return consensus, nil
+	}
+	proto := t.Genesis.ConsensusProtocol
+	if proto == "" {
+		proto = protocol.ConsensusCurrentVersion
+	}
+	params, ok := config.Consensus.Merge(consensus)[proto]
+	if !ok {
+		return nil, fmt.Errorf("invalid template: unknown genesis consensus protocol %s", proto)
+	}
+	if t.BlockPayload.MaxTxnBytesPerBlock != 0 {
+		params.MaxTxnBytesPerBlock = t.BlockPayload.MaxTxnBytesPerBlock
+	}
+	if t.BlockPayload.MaxTxnsPerBlockByType != nil {
+		params.MaxTxnsPerBlockByType = make(map[protocol.TxType]int, len(t.BlockPayload.MaxTxnsPerBlockByType))
+		for txType, maxTxns := range t.BlockPayload.MaxTxnsPerBlockByType {
+			params.MaxTxnsPerBlockByType[txType] = maxTxns
+		}
+	}
+	if err := params.ValidateBlockPayload(); err != nil {
+		return nil, fmt.Errorf("invalid template: BlockPayload: %w", err)
+	}
+	shaped := consensus.DeepCopy()
+	shaped[proto] = params
+	return shaped, nil
 }
 
 var defaultNetworkTemplate = NetworkTemplate{
@@ -185,6 +232,7 @@ func (t NetworkTemplate) Validate() error {
 	// Genesis account names must be unique
 	totalPct := big.NewFloat(float64(0))
 	accounts := make(map[string]bool)
+	mnemonics := make(map[string]bool)
 	for _, wallet := range t.Genesis.Wallets {
 		if wallet.Stake < 0 {
 			return fmt.Errorf("invalid template: negative stake on Genesis account %s", wallet.Name)
@@ -195,6 +243,15 @@ func (t NetworkTemplate) Validate() error {
 			return fmt.Errorf("invalid template: duplicate Genesis account %s", wallet.Name)
 		}
 		accounts[upperAcct] = true
+		if wallet.Mnemonic != "" {
+			if _, err := passphrase.MnemonicToKey(wallet.Mnemonic); err != nil {
+				return fmt.Errorf("invalid template: invalid mnemonic for Genesis account %s: %w", wallet.Name, err)
+			}
+			if _, found := mnemonics[wallet.Mnemonic]; found {
+				return fmt.Errorf("invalid template: Genesis account %s reuses the mnemonic of another account", wallet.Name)
+			}
+			mnemonics[wallet.Mnemonic] = true
+		}
 	}
 
 	totalPctInt, _ := totalPct.Int64()
@@ -232,11 +289,15 @@ func (t NetworkTemplate) Validate() error {
 		if err != nil {
 			return fmt.Errorf("invalid template: unable to decode JSONOverride: %w", err)
 		}
+		err = decodeJSONOverride(string(cfg.ConfigOverrides), &local)
+		if err != nil {
+			return fmt.Errorf("invalid template: unable to decode ConfigOverrides for node %s: %w", cfg.Name, err)
+		}
 	}
 
 	// Follow nodes cannot be relays
 	for _, cfg := range t.Nodes {
-		if cfg.IsRelay && isEnableFollowMode(cfg.ConfigJSONOverride) {
+		if cfg.IsRelay && isEnableFollowMode(cfg) {
 			return fmt.Errorf("invalid template: follower nodes may not be relays")
 		}
 	}
@@ -247,19 +308,20 @@ func (t NetworkTemplate) Validate() error {
 		}
 
 		for _, cfg := range t.Nodes {
-			if !cfg.IsRelay && !isEnableFollowMode(cfg.ConfigJSONOverride) {
+			if !cfg.IsRelay && !isEnableFollowMode(cfg) {
 				return fmt.Errorf("invalid template: devmode configurations may only contain one relay and follower nodes")
 			}
 		}
 	}
 
-	return nil
+	return t.validateDeployments()
 }
 
-func isEnableFollowMode(JSONOverride string) bool {
+func isEnableFollowMode(node remote.NodeConfigGoal) bool {
 	local := config.GetDefaultLocal()
-	// decode error is checked elsewhere
-	_ = decodeJSONOverride(JSONOverride, &local)
+	// decode errors are checked elsewhere
+	_ = decodeJSONOverride(node.ConfigJSONOverride, &local)
+	_ = decodeJSONOverride(string(node.ConfigOverrides), &local)
 	return local.EnableFollowMode
 }
 
@@ -313,6 +375,10 @@ func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes in
 	if err != nil {
 		return err
 	}
+	err = decodeJSONOverride(string(node.ConfigOverrides), &cfg)
+	if err != nil {
+		return err
+	}
 
 	return cfg.SaveToFile(configFile)
 }
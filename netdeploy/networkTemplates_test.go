@@ -28,6 +28,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/gen"
 	"github.com/algorand/go-algorand/netdeploy/remote"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -231,6 +232,138 @@ func TestDevModeValidate(t *testing.T) {
 	})
 }
 
+func TestValidateGenesisMnemonics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon invest"
+
+	tmpl := NetworkTemplate{
+		Genesis: gen.GenesisData{
+			Wallets: []gen.WalletData{
+				{Name: "Wallet1", Stake: 50, Mnemonic: mnemonic},
+				{Name: "Wallet2", Stake: 50},
+			},
+		},
+		Nodes: []remote.NodeConfigGoal{
+			{Name: "Node", Wallets: []remote.NodeWalletData{{Name: "Wallet1"}, {Name: "Wallet2"}}},
+		},
+	}
+	require.NoError(t, tmpl.Validate())
+
+	tmpl.Genesis.Wallets[1].Mnemonic = mnemonic
+	require.ErrorContains(t, tmpl.Validate(), "reuses the mnemonic")
+
+	tmpl.Genesis.Wallets[1].Mnemonic = "abandon abandon"
+	require.ErrorContains(t, tmpl.Validate(), "invalid mnemonic for Genesis account Wallet2")
+}
+
+func TestConfigOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesis := gen.GenesisData{Wallets: []gen.WalletData{{Name: "Wallet1", Stake: 100}}}
+
+	t.Run("applied after ConfigJSONOverride", func(t *testing.T) {
+		t.Parallel()
+		node := remote.NodeConfigGoal{
+			Name:               "Node",
+			ConfigJSONOverride: `{"MaxAcctLookback": 8, "Archival": true}`,
+			ConfigOverrides:    json.RawMessage(`{"MaxAcctLookback": 16, "EnableDeveloperAPI": true}`),
+		}
+		tmpl := NetworkTemplate{Genesis: genesis, Nodes: []remote.NodeConfigGoal{node}}
+		require.NoError(t, tmpl.Validate())
+
+		configFile := filepath.Join(t.TempDir(), config.ConfigFilename)
+		require.NoError(t, createConfigFile(node, configFile, 0, 0))
+		local, err := config.LoadConfigFromDisk(filepath.Dir(configFile))
+		require.NoError(t, err)
+		require.True(t, local.Archival)
+		require.True(t, local.EnableDeveloperAPI)
+		require.Equal(t, uint64(16), local.MaxAcctLookback)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		t.Parallel()
+		tmpl := NetworkTemplate{
+			Genesis: genesis,
+			Nodes:   []remote.NodeConfigGoal{{Name: "Node", ConfigOverrides: json.RawMessage(`{"NoSuchField": 1}`)}},
+		}
+		require.ErrorContains(t, tmpl.Validate(), "unable to decode ConfigOverrides for node Node")
+	})
+
+	t.Run("follower relay", func(t *testing.T) {
+		t.Parallel()
+		tmpl := NetworkTemplate{
+			Genesis: genesis,
+			Nodes:   []remote.NodeConfigGoal{{Name: "Node", IsRelay: true, ConfigOverrides: json.RawMessage(`{"EnableFollowMode": true}`)}},
+		}
+		require.ErrorContains(t, tmpl.Validate(), "follower nodes may not be relays")
+	})
+}
+
+func TestTemplateDeployments(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	makeTemplate := func() NetworkTemplate {
+		return NetworkTemplate{
+			Genesis: gen.GenesisData{
+				Wallets: []gen.WalletData{{Name: "Wallet1", Stake: 50}, {Name: "Wallet2", Stake: 50}},
+			},
+			Nodes: []remote.NodeConfigGoal{
+				{Name: "Primary", IsRelay: true},
+				{Name: "Node", Wallets: []remote.NodeWalletData{{Name: "Wallet1"}, {Name: "Wallet2", ParticipationOnly: true}}},
+			},
+			Assets: []AssetTemplate{{Name: "Token", Creator: "Wallet1", UnitName: "TOK", Total: 1000}},
+			Applications: []ApplicationTemplate{
+				{Name: "Counter", Creator: "wallet1", ApprovalProgram: "#pragma version 8\nint 1", ClearProgram: "#pragma version 8\nint 1"},
+			},
+		}
+	}
+
+	require.NoError(t, makeTemplate().Validate())
+
+	tmpl := makeTemplate()
+	tmpl.Applications[0].Name = "token"
+	require.ErrorContains(t, tmpl.Validate(), "duplicate deployment name token")
+
+	tmpl = makeTemplate()
+	tmpl.Assets[0].Creator = "Wallet2"
+	require.ErrorContains(t, tmpl.Validate(), "creator 'Wallet2' is not a wallet assigned to any node")
+
+	tmpl = makeTemplate()
+	tmpl.Applications[0].ClearProgramFile = "clear.teal"
+	require.ErrorContains(t, tmpl.Validate(), "exactly one of ClearProgram or ClearProgramFile")
+
+	tmpl = makeTemplate()
+	tmpl.Applications[0].ApprovalProgram = ""
+	require.ErrorContains(t, tmpl.Validate(), "exactly one of ApprovalProgram or ApprovalProgramFile")
+
+	tmpl = makeTemplate()
+	approvalFile := filepath.Join(t.TempDir(), "approval.teal")
+	require.NoError(t, os.WriteFile(approvalFile, []byte("#pragma version 8\nint 0"), 0644))
+	tmpl.Applications[0].ApprovalProgram = ""
+	tmpl.Applications[0].ApprovalProgramFile = approvalFile
+	require.NoError(t, tmpl.Validate())
+
+	deployments, err := tmpl.resolveDeployments()
+	require.NoError(t, err)
+	require.Equal(t, tmpl.Assets, deployments.Assets)
+	require.Len(t, deployments.Applications, 1)
+	require.Equal(t, "#pragma version 8\nint 0", deployments.Applications[0].ApprovalProgram)
+	require.Empty(t, deployments.Applications[0].ApprovalProgramFile)
+	require.Equal(t, map[string]string{"Wallet1": "Node", "wallet1": "Node"}, deployments.WalletNodes)
+
+	tmpl.Applications[0].ApprovalProgramFile = filepath.Join(t.TempDir(), "missing.teal")
+	_, err = tmpl.resolveDeployments()
+	require.ErrorContains(t, err, "application Counter")
+
+	deployments, err = NetworkTemplate{}.resolveDeployments()
+	require.NoError(t, err)
+	require.Nil(t, deployments)
+}
+
 type overlayTestStruct struct {
 	A string
 	B string
@@ -251,3 +384,33 @@ func TestJsonOverlay(t *testing.T) {
 	a.Equal("one", after.A)
 	a.Equal("other", after.B)
 }
+
+func TestTemplateBlockPayload(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var tmpl NetworkTemplate
+	err := loadTemplateFromReader(strings.NewReader(`{
+		"Genesis": {"ConsensusProtocol": "future"},
+		"BlockPayload": {"MaxTxnBytesPerBlock": 200000, "MaxTxnsPerBlockByType": {"appl": 50}}
+	}`), &tmpl)
+	require.NoError(t, err)
+
+	consensus, err := tmpl.shapeConsensus(nil)
+	require.NoError(t, err)
+	require.Len(t, consensus, 1)
+	params := consensus[protocol.ConsensusFuture]
+	require.Equal(t, 200000, params.MaxTxnBytesPerBlock)
+	require.Equal(t, map[protocol.TxType]int{protocol.ApplicationCallTx: 50}, params.MaxTxnsPerBlockByType)
+	require.Equal(t, config.Consensus[protocol.ConsensusFuture].MaxTxnNoteBytes, params.MaxTxnNoteBytes)
+	require.Nil(t, config.Consensus[protocol.ConsensusFuture].MaxTxnsPerBlockByType)
+
+	tmpl.BlockPayload.MaxTxnsPerBlockByType = map[protocol.TxType]int{"xfer": 1}
+	_, err = tmpl.shapeConsensus(nil)
+	require.ErrorContains(t, err, "unknown transaction type")
+
+	tmpl.BlockPayload = nil
+	consensus, err = tmpl.shapeConsensus(nil)
+	require.NoError(t, err)
+	require.Nil(t, consensus)
+}
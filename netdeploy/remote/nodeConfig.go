@@ -16,6 +16,10 @@
 
 package remote
 
+import (
+	"encoding/json"
+)
+
 // NodeConfig represents the configuration settings to apply to a single node running on a host
 type NodeConfig struct {
 	Name               string `json:",omitempty"`
@@ -59,4 +63,6 @@ type NodeConfigGoal struct {
 	Wallets            []NodeWalletData
 	DeadlockDetection  int    `json:"-"`
 	ConfigJSONOverride string `json:",omitempty"` // Raw json to merge into config.json after other modifications are complete
+	// ConfigOverrides is a JSON object of config.Local fields to set on this node. It is applied after ConfigJSONOverride.
+	ConfigOverrides json.RawMessage `json:",omitempty"`
 }
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var networkGossipFanout = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_gossip_fanout", Description: "Number of peers the relayed and bulk messages are broadcast to, as tuned to the bandwidth budget."})
+var networkEgressRate = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_egress_bytes_per_second", Description: "Bytes per second sent to the peers, as measured by the gossip fanout tuning."})
+var networkBroadcastFanoutLimited = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_broadcast_fanout_limited_total", Description: "number of broadcasts sent to fewer peers to stay within the bandwidth budget"})
+
+// fanoutTuneInterval is how often the egress is measured and the fanout adjusted
+const fanoutTuneInterval = 2 * time.Second
+
+// fanoutTuner adjusts the number of peers the relayed and bulk messages are broadcast to, so that the egress
+// measured over the peers connections stays within a bandwidth budget. The high priority messages the node
+// originates, such as its own votes, are always broadcast to all the peers.
+type fanoutTuner struct {
+	// budget is the egress allowed, in bytes per second
+	budget uint64
+
+	// minFanout is the number of peers the broadcasts are never limited below
+	minFanout int
+
+	// fanout is the current limit, or -1 when the broadcasts are not limited
+	fanout int32
+
+	lastSent uint64
+	lastTime time.Time
+}
+
+func makeFanoutTuner(budget uint64, minFanout int) *fanoutTuner {
+	if minFanout < 1 {
+		minFanout = 1
+	}
+	return &fanoutTuner{budget: budget, minFanout: minFanout, fanout: -1}
+}
+
+// limit returns the number of peers a relayed or bulk broadcast is sent to, or -1 if it is not limited
+func (ft *fanoutTuner) limit() int {
+	return int(atomic.LoadInt32(&ft.fanout))
+}
+
+// update adjusts the fanout to the egress measured since the previous update, out of the total sent bytes
+// and the number of connected peers. It returns the measured egress, in bytes per second.
+func (ft *fanoutTuner) update(sent uint64, now time.Time, numPeers int) (rate uint64) {
+	elapsed := now.Sub(ft.lastTime)
+	first := ft.lastTime.IsZero()
+	delta := sent - ft.lastSent
+	ft.lastSent, ft.lastTime = sent, now
+	if first || elapsed <= 0 {
+		return 0
+	}
+	rate = uint64(float64(delta) / elapsed.Seconds())
+
+	fanout := ft.limit()
+	switch {
+	case rate > ft.budget:
+		// back off quickly, by a quarter of the peers the broadcasts currently reach
+		if fanout < 0 || fanout > numPeers {
+			fanout = numPeers
+		}
+		step := fanout / 4
+		if step < 1 {
+			step = 1
+		}
+		fanout -= step
+		if fanout < ft.minFanout {
+			fanout = ft.minFanout
+		}
+	case rate < ft.budget/4*3 && fanout >= 0:
+		// recover slowly, one peer at a time, while there is headroom
+		fanout++
+		if fanout >= numPeers {
+			fanout = -1
+		}
+	}
+	atomic.StoreInt32(&ft.fanout, int32(fanout))
+	return rate
+}
+
+// fanoutTuneThread periodically tunes the fanout of the broadcasts to the egress measured over the peers connections
+func (wn *WebsocketNetwork) fanoutTuneThread() {
+	defer wn.wg.Done()
+	ticker := time.NewTicker(fanoutTuneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			numPeers := wn.NumPeers()
+			rate := wn.fanoutTuner.update(atomic.LoadUint64(&wn.sentBytes), now, numPeers)
+			fanout := wn.fanoutTuner.limit()
+			if fanout < 0 {
+				fanout = numPeers
+			}
+			networkGossipFanout.Set(uint64(fanout))
+			networkEgressRate.Set(rate)
+		case <-wn.ctx.Done():
+			return
+		}
+	}
+}
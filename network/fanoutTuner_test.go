@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestFanoutTuner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ft := makeFanoutTuner(1000, 2)
+	require.Equal(t, -1, ft.limit())
+
+	now := time.Now()
+	sent := uint64(0)
+	step := func(bytes uint64, numPeers int) uint64 {
+		now = now.Add(time.Second)
+		sent += bytes
+		return ft.update(sent, now, numPeers)
+	}
+
+	// the first update only sets the baseline
+	require.Zero(t, ft.update(sent, now, 20))
+	require.Equal(t, -1, ft.limit())
+
+	// over budget, the fanout backs off by a quarter of the peers reached
+	require.Equal(t, uint64(5000), step(5000, 20))
+	require.Equal(t, 15, ft.limit())
+	step(5000, 20)
+	require.Equal(t, 12, ft.limit())
+	for i := 0; i < 20; i++ {
+		step(5000, 20)
+	}
+	require.Equal(t, 2, ft.limit())
+
+	// within budget, but without enough headroom, the fanout stays
+	require.Equal(t, uint64(900), step(900, 20))
+	require.Equal(t, 2, ft.limit())
+
+	// with headroom, the fanout recovers one peer at a time, until the broadcasts are no longer limited
+	step(100, 20)
+	require.Equal(t, 3, ft.limit())
+	for i := 0; i < 16; i++ {
+		step(100, 20)
+	}
+	require.Equal(t, 19, ft.limit())
+	step(100, 20)
+	require.Equal(t, -1, ft.limit())
+	step(100, 20)
+	require.Equal(t, -1, ft.limit())
+
+	// fewer peers than the fanout are connected once over budget
+	step(5000, 4)
+	require.Equal(t, 3, ft.limit())
+	step(5000, 4)
+	require.Equal(t, 2, ft.limit())
+
+	require.Equal(t, 1, makeFanoutTuner(1000, 0).minFanout)
+}
@@ -23,6 +23,7 @@ import (
 	"sync/atomic"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 )
 
@@ -335,17 +336,17 @@ func identityVerificationHandler(message IncomingMessage) OutgoingMessage {
 	err := protocol.Decode(message.Data, &msg)
 	if err != nil {
 		networkPeerIdentityError.Inc(nil)
-		peer.net.log.With("err", err).With("remote", peer.OriginAddress()).With("local", localAddr).Warn("peer identity verification could not be decoded, disconnecting")
+		peer.net.log.With("err", err).With(logging.PeerField, peer.OriginAddress()).With("local", localAddr).Warn("peer identity verification could not be decoded, disconnecting")
 		return OutgoingMessage{Action: Disconnect, reason: disconnectBadIdentityData}
 	}
 	if peer.identityChallenge != msg.Msg.ResponseChallenge {
 		networkPeerIdentityError.Inc(nil)
-		peer.net.log.With("remote", peer.OriginAddress()).With("local", localAddr).Warn("peer identity verification challenge does not match, disconnecting")
+		peer.net.log.With(logging.PeerField, peer.OriginAddress()).With("local", localAddr).Warn("peer identity verification challenge does not match, disconnecting")
 		return OutgoingMessage{Action: Disconnect, reason: disconnectBadIdentityData}
 	}
 	if !msg.Verify(peer.identity) {
 		networkPeerIdentityError.Inc(nil)
-		peer.net.log.With("remote", peer.OriginAddress()).With("local", localAddr).Warn("peer identity verification is incorrectly signed, disconnecting")
+		peer.net.log.With(logging.PeerField, peer.OriginAddress()).With("local", localAddr).Warn("peer identity verification is incorrectly signed, disconnecting")
 		return OutgoingMessage{Action: Disconnect, reason: disconnectBadIdentityData}
 	}
 	atomic.StoreUint32(&peer.identityVerified, 1)
@@ -355,7 +356,7 @@ func identityVerificationHandler(message IncomingMessage) OutgoingMessage {
 	peer.net.peersLock.Unlock()
 	if !ok {
 		networkPeerIdentityDisconnect.Inc(nil)
-		peer.net.log.With("remote", peer.OriginAddress()).With("local", localAddr).Warn("peer identity already in use, disconnecting")
+		peer.net.log.With(logging.PeerField, peer.OriginAddress()).With("local", localAddr).Warn("peer identity already in use, disconnecting")
 		return OutgoingMessage{Action: Disconnect, reason: disconnectDuplicateConnection}
 	}
 	return OutgoingMessage{}
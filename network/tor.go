@@ -0,0 +1,230 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/textproto"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// onionAddressSuffix is the domain of the Tor onion services.
+const onionAddressSuffix = ".onion"
+
+// torControlTimeout is the time given to the Tor control port to answer each command.
+const torControlTimeout = 30 * time.Second
+
+// errOnionWithoutTorProxy is returned when dialing an onion address while no Tor proxy is configured.
+var errOnionWithoutTorProxy = errors.New("onion addresses can only be dialed through a Tor proxy, set TorProxyAddress")
+
+// isOnionAddress returns whether the host of a host:port address is a Tor onion service.
+func isOnionAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	return strings.HasSuffix(strings.ToLower(host), onionAddressSuffix)
+}
+
+// torDialer dials the onion addresses through a Tor SOCKS5 proxy, which resolves them. The other addresses are
+// dialed directly, unless all the connections are routed through Tor to keep the node address private.
+type torDialer struct {
+	proxy   proxy.ContextDialer
+	direct  netDialer
+	dialAll bool
+}
+
+// makeTorDialer creates a dialer routing the onion addresses, or all the addresses when dialAll is set, through
+// the Tor SOCKS5 proxy at proxyAddress. An empty proxyAddress leaves the onion addresses undialable.
+func makeTorDialer(proxyAddress string, dialAll bool, direct netDialer) (*torDialer, error) {
+	d := &torDialer{direct: direct, dialAll: dialAll}
+	if proxyAddress == "" {
+		return d, nil
+	}
+	forward := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	socks, err := proxy.SOCKS5("tcp", proxyAddress, nil, forward)
+	if err != nil {
+		return nil, err
+	}
+	contextDialer, ok := socks.(proxy.ContextDialer)
+	if !ok {
+		return nil, fmt.Errorf("the SOCKS5 dialer of %s does not support contexts", proxyAddress)
+	}
+	d.proxy = contextDialer
+	return d, nil
+}
+
+// DialContext connects to the address through the Tor proxy if it is an onion address, or if all the
+// connections go through Tor, and directly otherwise.
+func (d *torDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if d.proxy != nil && (d.dialAll || isOnionAddress(address)) {
+		return d.proxy.DialContext(ctx, network, address)
+	}
+	if isOnionAddress(address) {
+		return nil, errOnionWithoutTorProxy
+	}
+	return d.direct.DialContext(ctx, network, address)
+}
+
+// onionService is an ephemeral onion service published through the Tor control port. Tor removes it once the
+// control connection is closed.
+type onionService struct {
+	conn *textproto.Conn
+	// Address is the host:port address of the onion service.
+	Address string
+}
+
+// publishOnionService connects to the Tor control port at controlAddress and publishes an onion service
+// forwarding the given port to the same port on the local host. The private key of the service is read from
+// keyFile, or written into it on the first run, so that the onion address stays the same across restarts.
+// An empty keyFile gives a new onion address on every call.
+func publishOnionService(controlAddress string, keyFile string, port string) (*onionService, error) {
+	conn, err := net.DialTimeout("tcp", controlAddress, torControlTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the Tor control port %s: %w", controlAddress, err)
+	}
+	svc := &onionService{conn: textproto.NewConn(conn)}
+	address, err := svc.publish(conn, keyFile, port)
+	if err != nil {
+		svc.Close()
+		return nil, err
+	}
+	svc.Address = address
+	return svc, nil
+}
+
+func (svc *onionService) publish(conn net.Conn, keyFile string, port string) (string, error) {
+	err := svc.authenticate(conn)
+	if err != nil {
+		return "", err
+	}
+
+	key := "NEW:ED25519-V3"
+	if keyFile != "" {
+		stored, readErr := os.ReadFile(keyFile)
+		if readErr == nil {
+			key = strings.TrimSpace(string(stored))
+		} else if !os.IsNotExist(readErr) {
+			return "", readErr
+		}
+	}
+	lines, err := svc.command(conn, "ADD_ONION %s Port=%s,127.0.0.1:%s", key, port, port)
+	if err != nil {
+		return "", fmt.Errorf("unable to publish the onion service: %w", err)
+	}
+	var serviceID, privateKey string
+	for _, line := range lines {
+		if v, ok := strings.CutPrefix(line, "ServiceID="); ok {
+			serviceID = v
+		} else if v, ok := strings.CutPrefix(line, "PrivateKey="); ok {
+			privateKey = v
+		}
+	}
+	if serviceID == "" {
+		return "", fmt.Errorf("the Tor control port did not return the onion service id: %v", lines)
+	}
+	if keyFile != "" && privateKey != "" {
+		err = os.WriteFile(keyFile, []byte(privateKey+"\n"), 0600)
+		if err != nil {
+			return "", err
+		}
+	}
+	return net.JoinHostPort(serviceID+onionAddressSuffix, port), nil
+}
+
+// authenticate authenticates the control connection with the cookie of the Tor daemon, or without
+// credentials when it does not require them.
+func (svc *onionService) authenticate(conn net.Conn) error {
+	lines, err := svc.command(conn, "PROTOCOLINFO 1")
+	if err != nil {
+		return fmt.Errorf("unable to query the Tor control port: %w", err)
+	}
+	var methods, cookieFile string
+	for _, line := range lines {
+		auth, ok := strings.CutPrefix(line, "AUTH ")
+		if !ok {
+			continue
+		}
+		for _, field := range strings.Fields(auth) {
+			if v, ok := strings.CutPrefix(field, "METHODS="); ok {
+				methods = v
+			} else if v, ok := strings.CutPrefix(field, "COOKIEFILE="); ok {
+				cookieFile = strings.Trim(v, `"`)
+			}
+		}
+	}
+
+	auth := "AUTHENTICATE"
+	switch {
+	case hasTorAuthMethod(methods, "NULL"):
+	case hasTorAuthMethod(methods, "COOKIE") && cookieFile != "":
+		cookie, readErr := os.ReadFile(cookieFile)
+		if readErr != nil {
+			return fmt.Errorf("unable to read the Tor control cookie: %w", readErr)
+		}
+		auth += " " + hex.EncodeToString(cookie)
+	default:
+		return fmt.Errorf("unsupported Tor control authentication methods %q, enable CookieAuthentication", methods)
+	}
+	_, err = svc.command(conn, auth)
+	if err != nil {
+		return fmt.Errorf("unable to authenticate to the Tor control port: %w", err)
+	}
+	return nil
+}
+
+func hasTorAuthMethod(methods string, method string) bool {
+	for _, m := range strings.Split(methods, ",") {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// command sends a command to the Tor control port and returns the lines of its successful reply.
+func (svc *onionService) command(conn net.Conn, format string, args ...interface{}) ([]string, error) {
+	err := conn.SetDeadline(time.Now().Add(torControlTimeout))
+	if err != nil {
+		return nil, err
+	}
+	err = svc.conn.PrintfLine(format, args...)
+	if err != nil {
+		return nil, err
+	}
+	_, message, err := svc.conn.ReadResponse(250)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(message, "\n"), nil
+}
+
+// Close closes the control connection, which removes the onion service.
+func (svc *onionService) Close() error {
+	return svc.conn.Close()
+}
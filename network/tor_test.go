@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDialer struct {
+	dialed []string
+}
+
+func (d *recordingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.dialed = append(d.dialed, address)
+	return nil, fmt.Errorf("not dialing %s", address)
+}
+
+func TestIsOnionAddress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.True(t, isOnionAddress("abcdef.onion:4160"))
+	require.True(t, isOnionAddress("ABCDEF.ONION"))
+	require.False(t, isOnionAddress("r1.algorand.network:4160"))
+	require.False(t, isOnionAddress("127.0.0.1:4160"))
+}
+
+func TestTorDialer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	direct := &recordingDialer{}
+	d, err := makeTorDialer("", false, direct)
+	require.NoError(t, err)
+	_, err = d.DialContext(context.Background(), "tcp", "abcdef.onion:4160")
+	require.ErrorIs(t, err, errOnionWithoutTorProxy)
+	_, err = d.DialContext(context.Background(), "tcp", "127.0.0.1:4160")
+	require.Error(t, err)
+	require.Equal(t, []string{"127.0.0.1:4160"}, direct.dialed)
+
+	// the proxy is not listening, so the dials through it fail without reaching the direct dialer
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	proxyAddress := listener.Addr().String()
+	listener.Close()
+
+	direct = &recordingDialer{}
+	d, err = makeTorDialer(proxyAddress, false, direct)
+	require.NoError(t, err)
+	_, err = d.DialContext(context.Background(), "tcp", "abcdef.onion:4160")
+	require.Error(t, err)
+	_, err = d.DialContext(context.Background(), "tcp", "127.0.0.1:4160")
+	require.Error(t, err)
+	require.Equal(t, []string{"127.0.0.1:4160"}, direct.dialed)
+
+	direct = &recordingDialer{}
+	d, err = makeTorDialer(proxyAddress, true, direct)
+	require.NoError(t, err)
+	_, err = d.DialContext(context.Background(), "tcp", "127.0.0.1:4160")
+	require.Error(t, err)
+	require.Empty(t, direct.dialed)
+}
+
+// fakeTorControl answers the commands of a Tor control connection, recording them.
+func fakeTorControl(t *testing.T, cookieFile string) (string, chan []string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	commands := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var received []string
+		defer func() { commands <- received }()
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			received = append(received, line)
+			switch {
+			case line == "PROTOCOLINFO 1":
+				fmt.Fprintf(conn, "250-PROTOCOLINFO 1\r\n250-AUTH METHODS=COOKIE,SAFECOOKIE COOKIEFILE=\"%s\"\r\n250-VERSION Tor=\"0.4.8.9\"\r\n250 OK\r\n", cookieFile)
+			case strings.HasPrefix(line, "AUTHENTICATE"):
+				fmt.Fprintf(conn, "250 OK\r\n")
+			case strings.HasPrefix(line, "ADD_ONION NEW:"):
+				fmt.Fprintf(conn, "250-ServiceID=abcdefghij\r\n250-PrivateKey=ED25519-V3:c2VjcmV0\r\n250 OK\r\n")
+			case strings.HasPrefix(line, "ADD_ONION "):
+				fmt.Fprintf(conn, "250-ServiceID=abcdefghij\r\n250 OK\r\n")
+			default:
+				fmt.Fprintf(conn, "510 Unrecognized command\r\n")
+			}
+		}
+	}()
+	return listener.Addr().String(), commands
+}
+
+func TestPublishOnionService(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	cookie := []byte{0x01, 0x02, 0xfe}
+	cookieFile := filepath.Join(dir, "control_auth_cookie")
+	require.NoError(t, os.WriteFile(cookieFile, cookie, 0600))
+	keyFile := filepath.Join(dir, "onion.key")
+
+	controlAddress, commands := fakeTorControl(t, cookieFile)
+	svc, err := publishOnionService(controlAddress, keyFile, "4160")
+	require.NoError(t, err)
+	require.Equal(t, "abcdefghij.onion:4160", svc.Address)
+	require.NoError(t, svc.Close())
+	require.Equal(t, []string{
+		"PROTOCOLINFO 1",
+		"AUTHENTICATE " + hex.EncodeToString(cookie),
+		"ADD_ONION NEW:ED25519-V3 Port=4160,127.0.0.1:4160",
+	}, <-commands)
+	key, err := os.ReadFile(keyFile)
+	require.NoError(t, err)
+	require.Equal(t, "ED25519-V3:c2VjcmV0\n", string(key))
+
+	// the stored key is reused, keeping the same onion address
+	controlAddress, commands = fakeTorControl(t, cookieFile)
+	svc, err = publishOnionService(controlAddress, keyFile, "4160")
+	require.NoError(t, err)
+	require.Equal(t, "abcdefghij.onion:4160", svc.Address)
+	require.NoError(t, svc.Close())
+	require.Equal(t, "ADD_ONION ED25519-V3:c2VjcmV0 Port=4160,127.0.0.1:4160", (<-commands)[2])
+}
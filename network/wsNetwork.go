@@ -50,6 +50,7 @@ import (
 	"github.com/algorand/go-algorand/tools/network/dnssec"
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/resources"
 )
 
 const incomingThreads = 20
@@ -257,7 +258,7 @@ type Tag = protocol.Tag
 
 func highPriorityTag(tags []protocol.Tag) bool {
 	for _, tag := range tags {
-		if tag == protocol.AgreementVoteTag || tag == protocol.ProposalPayloadTag {
+		if tag == protocol.AgreementVoteTag || tag == protocol.ProposalPayloadTag || tag == protocol.ProposalChunkTag {
 			return true
 		}
 	}
@@ -343,6 +344,10 @@ func (nnni *nopeNodeInfo) IsParticipating() bool {
 
 // WebsocketNetwork implements GossipNode
 type WebsocketNetwork struct {
+	// sentBytes is the number of bytes sent to the peers. It is first in the struct to be 64-bit aligned
+	// for atomics support on 32bit platforms.
+	sentBytes uint64
+
 	listener net.Listener
 	server   http.Server
 	router   *mux.Router
@@ -435,6 +440,9 @@ type WebsocketNetwork struct {
 	transport rateLimitingTransport
 	dialer    Dialer
 
+	// onionService is the onion service publishing the gossip endpoint through Tor, if TorControlAddress is set.
+	onionService *onionService
+
 	// messagesOfInterest specifies the message types that this node
 	// wants to receive.  nil means default.  non-nil causes this
 	// map to be sent to new peers as a MsgOfInterest message type.
@@ -472,6 +480,14 @@ type WebsocketNetwork struct {
 	// protocolVersion is an actual version announced as ProtocolVersionHeader
 	protocolVersion string
 
+	// fanoutTuner limits the number of peers the relayed and bulk messages are broadcast to, according to the
+	// GossipBandwidthBudget. It is nil if there is no budget.
+	fanoutTuner *fanoutTuner
+
+	// localCapabilities are the optional features announced as PeerFeaturesHeader. The features of a peer
+	// are the ones both sides announced.
+	localCapabilities peerCapabilities
+
 	// resolveSRVRecords is a function that resolves SRV records for a given service, protocol and name
 	resolveSRVRecords func(service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) (addrs []string, err error)
 }
@@ -489,6 +505,8 @@ type broadcastRequest struct {
 	done        chan struct{}
 	enqueueTime time.Time
 	ctx         context.Context
+	// relayed is set for the messages relayed on behalf of other peers
+	relayed bool
 }
 
 // Address returns a string and whether that is a 'final' address or guessed.
@@ -536,6 +554,10 @@ func (wn *WebsocketNetwork) Broadcast(ctx context.Context, tag protocol.Tag, dat
 // if wait is true then the call blocks until the packet has actually been sent to all neighbors.
 // TODO: add `priority` argument so that we don't have to guess it based on tag
 func (wn *WebsocketNetwork) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	return wn.broadcastArray(ctx, tags, data, wait, except, false)
+}
+
+func (wn *WebsocketNetwork) broadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer, relayed bool) error {
 	if wn.config.DisableNetworking {
 		return nil
 	}
@@ -544,7 +566,7 @@ func (wn *WebsocketNetwork) BroadcastArray(ctx context.Context, tags []protocol.
 		return errBcastInvalidArray
 	}
 
-	request := broadcastRequest{tags: tags, data: data, enqueueTime: time.Now(), ctx: ctx}
+	request := broadcastRequest{tags: tags, data: data, enqueueTime: time.Now(), ctx: ctx, relayed: relayed}
 	if except != nil {
 		request.except = except.(*wsPeer)
 	}
@@ -590,7 +612,7 @@ func (wn *WebsocketNetwork) BroadcastArray(ctx context.Context, tags []protocol.
 // Relay message
 func (wn *WebsocketNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
 	if wn.relayMessages {
-		return wn.Broadcast(ctx, tag, data, wait, except)
+		return wn.broadcastArray(ctx, []protocol.Tag{tag}, [][]byte{data}, wait, except, true)
 	}
 	return nil
 }
@@ -598,7 +620,7 @@ func (wn *WebsocketNetwork) Relay(ctx context.Context, tag protocol.Tag, data []
 // RelayArray relays array of messages
 func (wn *WebsocketNetwork) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
 	if wn.relayMessages {
-		return wn.BroadcastArray(ctx, tags, data, wait, except)
+		return wn.broadcastArray(ctx, tags, data, wait, except, true)
 	}
 	return nil
 }
@@ -736,13 +758,20 @@ func max(numbers ...uint64) (maxNum uint64) {
 func (wn *WebsocketNetwork) setup() {
 	var preferredResolver dnssec.ResolverIf
 	if wn.config.DNSSecurityRelayAddrEnforced() {
-		preferredResolver = dnssec.MakeDefaultDnssecResolver(wn.config.FallbackDNSResolverAddress, wn.log)
+		upstreams := tools_network.SecureUpstreams(wn.config, wn.log)
+		preferredResolver = dnssec.MakeDefaultDnssecResolverWithUpstreams(wn.config.FallbackDNSResolverAddress, upstreams, wn.config.DNSSecurityUpstreamsOnly(), wn.log)
 	}
 	if wn.nodeInfo == nil {
 		wn.nodeInfo = &nopeNodeInfo{}
 	}
 	maxIdleConnsPerHost := int(wn.config.ConnectionsRateLimitingCount)
 	wn.dialer = makeRateLimitingDialer(wn.phonebook, preferredResolver)
+	dialer, err := makeTorDialer(wn.config.TorProxyAddress, wn.config.TorDialAllPeers, wn.dialer.innerDialer)
+	if err != nil {
+		wn.log.Errorf("unable to dial through the Tor proxy %s: %v", wn.config.TorProxyAddress, err)
+	} else {
+		wn.dialer.innerDialer = dialer
+	}
 	wn.transport = makeRateLimitingTransport(wn.phonebook, 10*time.Second, &wn.dialer, maxIdleConnsPerHost)
 
 	wn.upgrader.ReadBufferSize = 4096
@@ -825,6 +854,17 @@ func (wn *WebsocketNetwork) setup() {
 	// set our actual version
 	wn.protocolVersion = ProtocolVersion
 
+	if wn.config.GossipBandwidthBudget > 0 {
+		wn.fanoutTuner = makeFanoutTuner(wn.config.GossipBandwidthBudget, wn.config.GossipAdaptiveFanoutMin)
+	}
+
+	// the features implemented by this node. pfBatchBlockFetch and pfQUIC are only recognized from the peers until
+	// the node implements them.
+	wn.localCapabilities = peerCapabilities{
+		features:      pfCompressedProposal | pfTxSync,
+		txSyncVersion: txSyncProtocolVersion,
+	}
+
 	wn.messagesOfInterestRefresh = make(chan struct{}, 2)
 	wn.messagesOfInterestGeneration = 1 // something nonzero so that any new wsPeer needs updating
 	if wn.relayMessages {
@@ -853,6 +893,9 @@ func (wn *WebsocketNetwork) Start() {
 		// wrap the limited connection listener with a requests tracker listener
 		wn.listener = wn.requestsTracker.Listener(listener)
 		wn.log.Debugf("listening on %s", wn.listener.Addr().String())
+		if wn.config.TorControlAddress != "" {
+			wn.publishOnionService()
+		}
 		wn.throttledOutgoingConnections = int32(wn.config.GossipFanout / 2)
 	} else {
 		// on non-relay, all the outgoing connections are throttled.
@@ -908,6 +951,10 @@ func (wn *WebsocketNetwork) Start() {
 	}
 	wn.wg.Add(1)
 	go wn.broadcastThread()
+	if wn.fanoutTuner != nil {
+		wn.wg.Add(1)
+		go wn.fanoutTuneThread()
+	}
 	if wn.prioScheme != nil {
 		wn.wg.Add(1)
 		go wn.prioWeightRefresh()
@@ -918,6 +965,24 @@ func (wn *WebsocketNetwork) Start() {
 	wn.log.Infof("serving genesisID=%s on %#v with RandomID=%s", wn.GenesisID, wn.PublicAddress(), wn.RandomID)
 }
 
+// publishOnionService publishes the gossip endpoint as a Tor onion service, and announces the onion address to
+// the peers in place of PublicAddress.
+func (wn *WebsocketNetwork) publishOnionService() {
+	_, port, err := net.SplitHostPort(wn.listener.Addr().String())
+	if err != nil {
+		wn.log.Errorf("unable to publish an onion service for %s: %v", wn.listener.Addr().String(), err)
+		return
+	}
+	svc, err := publishOnionService(wn.config.TorControlAddress, wn.config.TorOnionKeyFile, port)
+	if err != nil {
+		wn.log.Errorf("unable to publish an onion service through %s: %v", wn.config.TorControlAddress, err)
+		return
+	}
+	wn.onionService = svc
+	wn.config.PublicAddress = svc.Address
+	wn.log.Infof("gossip endpoint published as the onion service %s", svc.Address)
+}
+
 func (wn *WebsocketNetwork) httpdThread() {
 	defer wn.wg.Done()
 	var err error
@@ -964,6 +1029,10 @@ func (wn *WebsocketNetwork) Stop() {
 		listenAddr = wn.listener.Addr().String()
 	}
 	wn.ctxCancel()
+	if wn.onionService != nil {
+		wn.onionService.Close()
+		wn.onionService = nil
+	}
 	ctx, timeoutCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer timeoutCancel()
 	err := wn.server.Shutdown(ctx)
@@ -1046,6 +1115,20 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 
 // checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	if resources.FDsExhausted() {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "file_descriptor_limit"})
+		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+			telemetryspec.ConnectPeerFailEventDetails{
+				Address:       remoteHost,
+				TelemetryGUID: otherTelemetryGUID,
+				Incoming:      true,
+				InstanceName:  otherInstanceName,
+				Reason:        "File Descriptor Limit",
+			})
+		response.WriteHeader(http.StatusServiceUnavailable)
+		return http.StatusServiceUnavailable
+	}
+
 	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
@@ -1200,7 +1283,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	wn.setHeaders(responseHeader)
 	responseHeader.Set(ProtocolVersionHeader, matchingVersion)
 	responseHeader.Set(GenesisHeader, wn.GenesisID)
-	responseHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	responseHeader.Set(PeerFeaturesHeader, wn.localCapabilities.encode())
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1215,7 +1298,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		peerIDChallenge, peerID, err = wn.identityScheme.VerifyRequestAndAttachResponse(responseHeader, request.Header)
 		if err != nil {
 			networkPeerIdentityError.Inc(nil)
-			wn.log.With("err", err).With("remote", trackedRequest.otherPublicAddr).With("local", localAddr).Warnf("peer (%s) supplied an invalid identity challenge, abandoning peering", trackedRequest.otherPublicAddr)
+			wn.log.With("err", err).With(logging.PeerField, trackedRequest.otherPublicAddr).With("local", localAddr).Warnf("peer (%s) supplied an invalid identity challenge, abandoning peering", trackedRequest.otherPublicAddr)
 			return
 		}
 	}
@@ -1232,6 +1315,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		wn.requestsLogger.SetStatusCode(response, http.StatusSwitchingProtocols)
 	}
 
+	capabilities := wn.localCapabilities.negotiate(decodePeerCapabilities(matchingVersion, request.Header.Get(PeerFeaturesHeader)))
 	peer := &wsPeer{
 		wsPeerCore:        makePeerCore(wn, trackedRequest.otherPublicAddr, wn.GetRoundTripper(), trackedRequest.remoteHost),
 		conn:              conn,
@@ -1244,12 +1328,13 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		identity:          peerID,
 		identityChallenge: peerIDChallenge,
 		identityVerified:  0,
-		features:          decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
+		features:          capabilities.features,
+		txSyncVersion:     capabilities.txSyncVersion,
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
-	wn.log.With("event", "ConnectedIn").With("remote", trackedRequest.otherPublicAddr).With("local", localAddr).Infof("Accepted incoming connection from peer %s", trackedRequest.otherPublicAddr)
+	wn.log.With(logging.EventField, "ConnectedIn").With(logging.PeerField, trackedRequest.otherPublicAddr).With("local", localAddr).Infof("Accepted incoming connection from peer %s", trackedRequest.otherPublicAddr)
 	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerEvent,
 		telemetryspec.PeerEventDetails{
 			Address:       trackedRequest.remoteHost,
@@ -1587,10 +1672,21 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 	start := time.Now()
 	data, dataWithCompression, digests, containsPrioPPTag := wn.preparePeerData(request, prio, peers)
 
+	broadcastLimit := wn.config.BroadcastConnectionsLimit
+	if wn.fanoutTuner != nil && (request.relayed || !prio) {
+		// the peers are sorted by priority, so that a limited broadcast reaches the outgoing peers first
+		if fanout := wn.fanoutTuner.limit(); fanout >= 0 && (broadcastLimit < 0 || fanout < broadcastLimit) {
+			broadcastLimit = fanout
+			if fanout < len(peers) {
+				networkBroadcastFanoutLimited.Inc(nil)
+			}
+		}
+	}
+
 	// first send to all the easy outbound peers who don't block, get them started.
 	sentMessageCount := 0
 	for _, peer := range peers {
-		if wn.config.BroadcastConnectionsLimit >= 0 && sentMessageCount >= wn.config.BroadcastConnectionsLimit {
+		if broadcastLimit >= 0 && sentMessageCount >= broadcastLimit {
 			break
 		}
 		if peer == request.except {
@@ -1941,6 +2037,8 @@ func (wn *WebsocketNetwork) getPeerConnectionTelemetryDetails(now time.Time, pee
 			AVCount:              atomic.LoadUint64(&peer.avMessageCount),
 			PPCount:              atomic.LoadUint64(&peer.ppMessageCount),
 			UNKCount:             atomic.LoadUint64(&peer.unkMessageCount),
+			ProtocolVersion:      peer.version,
+			Capabilities:         peer.capabilities().names(),
 		}
 		if tcpInfo, err := peer.GetUnderlyingConnTCPInfo(); err == nil && tcpInfo != nil {
 			connDetail.TCP = *tcpInfo
@@ -1958,6 +2056,13 @@ func (wn *WebsocketNetwork) getPeerConnectionTelemetryDetails(now time.Time, pee
 	return connectionDetails
 }
 
+// PeerConnections returns a snapshot of the details of the peers we're currently connected to.
+func (wn *WebsocketNetwork) PeerConnections() telemetryspec.PeersConnectionDetails {
+	var peers []*wsPeer
+	peers, _ = wn.peerSnapshot(peers)
+	return wn.getPeerConnectionTelemetryDetails(time.Now(), peers)
+}
+
 // prioWeightRefreshTime controls how often we refresh the weights
 // of connected peers.
 const prioWeightRefreshTime = time.Minute
@@ -2111,6 +2216,21 @@ const PeerFeaturesHeader = "X-Algorand-Peer-Features"
 // supports proposal payload compression with zstd
 const PeerFeatureProposalCompression = "ppzstd"
 
+// PeerFeatureBatchBlockFetch is a value for PeerFeaturesHeader indicating peer
+// serves several blocks per catchup request
+const PeerFeatureBatchBlockFetch = "blkbatch"
+
+// PeerFeatureQUIC is a value for PeerFeaturesHeader indicating peer
+// accepts gossip connections over QUIC
+const PeerFeatureQUIC = "quic"
+
+// PeerFeatureTxSync is a value for PeerFeaturesHeader indicating peer
+// serves the transaction sync protocol, announced as txsync=<version>
+const PeerFeatureTxSync = "txsync"
+
+// txSyncProtocolVersion is the transaction sync protocol version this node announces
+const txSyncProtocolVersion = 1
+
 var websocketsScheme = map[string]string{"http": "ws", "https": "wss"}
 
 var errBadAddr = errors.New("bad address")
@@ -2263,7 +2383,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	// for backward compatibility, include the ProtocolVersion header as well.
 	requestHeader.Set(ProtocolVersionHeader, wn.protocolVersion)
 	// set the features header (comma-separated list)
-	requestHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	requestHeader.Set(PeerFeaturesHeader, wn.localCapabilities.encode())
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
@@ -2279,6 +2399,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
 
 	if err != nil {
+		log := wn.log.With(logging.PeerField, addr)
 		if err == websocket.ErrBadHandshake {
 			// reading here from ioutil is safe only because it came from DialContext above, which already finished reading all the data from the network
 			// and placed it all in a ioutil.NopCloser reader.
@@ -2292,11 +2413,11 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 			// we're guaranteed to have a valid response object.
 			switch response.StatusCode {
 			case http.StatusPreconditionFailed:
-				wn.log.Warnf("ws connect(%s) fail - bad handshake, precondition failed : '%s'", gossipAddr, errString)
+				log.Warnf("ws connect(%s) fail - bad handshake, precondition failed : '%s'", gossipAddr, errString)
 			case http.StatusLoopDetected:
-				wn.log.Infof("ws connect(%s) aborted due to connecting to self", gossipAddr)
+				log.Infof("ws connect(%s) aborted due to connecting to self", gossipAddr)
 			case http.StatusTooManyRequests:
-				wn.log.Infof("ws connect(%s) aborted due to connecting too frequently", gossipAddr)
+				log.Infof("ws connect(%s) aborted due to connecting too frequently", gossipAddr)
 				retryAfterHeader := response.Header.Get(TooManyRequestsRetryAfterHeader)
 				if retryAfter, retryParseErr := strconv.ParseUint(retryAfterHeader, 10, 32); retryParseErr == nil {
 					// we've got a retry-after header.
@@ -2305,10 +2426,10 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 					wn.phonebook.UpdateRetryAfter(addr, retryAfterTime)
 				}
 			default:
-				wn.log.Warnf("ws connect(%s) fail - bad handshake, Status code = %d, Headers = %#v, Body = %s", gossipAddr, response.StatusCode, response.Header, errString)
+				log.Warnf("ws connect(%s) fail - bad handshake, Status code = %d, Headers = %#v, Body = %s", gossipAddr, response.StatusCode, response.Header, errString)
 			}
 		} else {
-			wn.log.Warnf("ws connect(%s) fail: %s", gossipAddr, err)
+			log.Warnf("ws connect(%s) fail: %s", gossipAddr, err)
 		}
 		return
 	}
@@ -2343,7 +2464,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		peerID, idVerificationMessage, err = wn.identityScheme.VerifyResponse(response.Header, idChallenge)
 		if err != nil {
 			networkPeerIdentityError.Inc(nil)
-			wn.log.With("err", err).With("remote", addr).With("local", localAddr).Warn("peer supplied an invalid identity response, abandoning peering")
+			wn.log.With("err", err).With(logging.PeerField, addr).With("local", localAddr).Warn("peer supplied an invalid identity response, abandoning peering")
 			closeEarly("Invalid identity response")
 			return
 		}
@@ -2356,6 +2477,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		atomic.AddInt32(&wn.throttledOutgoingConnections, int32(1))
 	}
 
+	capabilities := wn.localCapabilities.negotiate(decodePeerCapabilities(matchingVersion, response.Header.Get(PeerFeaturesHeader)))
 	peer := &wsPeer{
 		wsPeerCore:                  makePeerCore(wn, addr, wn.GetRoundTripper(), "" /* origin */),
 		conn:                        conn,
@@ -2366,7 +2488,8 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		throttledOutgoingConnection: throttledConnection,
 		version:                     matchingVersion,
 		identity:                    peerID,
-		features:                    decodePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)),
+		features:                    capabilities.features,
+		txSyncVersion:               capabilities.txSyncVersion,
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 
@@ -2379,7 +2502,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		wn.peersLock.Unlock()
 		if !ok {
 			networkPeerIdentityDisconnect.Inc(nil)
-			wn.log.With("remote", addr).With("local", localAddr).Warn("peer deduplicated before adding because the identity is already known")
+			wn.log.With(logging.PeerField, addr).With("local", localAddr).Warn("peer deduplicated before adding because the identity is already known")
 			closeEarly("Duplicate connection")
 			return
 		}
@@ -2387,7 +2510,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
 
-	wn.log.With("event", "ConnectedOut").With("remote", addr).With("local", localAddr).Infof("Made outgoing connection to peer %v", addr)
+	wn.log.With(logging.EventField, "ConnectedOut").With(logging.PeerField, addr).With("local", localAddr).Infof("Made outgoing connection to peer %v", addr)
 	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerEvent,
 		telemetryspec.PeerEventDetails{
 			Address:       justHost(conn.RemoteAddr().String()),
@@ -2403,7 +2526,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	if len(idVerificationMessage) > 0 {
 		sent := peer.writeNonBlock(context.Background(), idVerificationMessage, true, crypto.Digest{}, time.Now())
 		if !sent {
-			wn.log.With("remote", addr).With("local", localAddr).Warn("could not send identity challenge verification")
+			wn.log.With(logging.PeerField, addr).With("local", localAddr).Warn("could not send identity challenge verification")
 		}
 	}
 
@@ -2418,7 +2541,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 				mbytes := append([]byte(protocol.NetPrioResponseTag), resp...)
 				sent := peer.writeNonBlock(context.Background(), mbytes, true, crypto.Digest{}, time.Now())
 				if !sent {
-					wn.log.With("remote", addr).With("local", localAddr).Warnf("could not send priority response to %v", addr)
+					wn.log.With(logging.PeerField, addr).With("local", localAddr).Warnf("could not send priority response to %v", addr)
 				}
 			}
 		}
@@ -2457,7 +2580,7 @@ func NewWebsocketNetwork(log logging.Logger, config config.Local, phonebookAddre
 		GenesisID:         genesisID,
 		NetworkID:         networkID,
 		nodeInfo:          nodeInfo,
-		resolveSRVRecords: tools_network.ReadFromSRV,
+		resolveSRVRecords: tools_network.MakeSRVReader(tools_network.SecureUpstreams(config, log), config.DNSSecurityUpstreamsOnly()),
 	}
 
 	wn.setup()
@@ -2483,7 +2606,7 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 	// first logging, then take the lock and do the actual accounting.
 	// definitely don't change this to do the logging while holding the lock.
 	localAddr, _ := wn.Address()
-	logEntry := wn.log.With("event", "Disconnected").With("remote", peer.rootURL).With("local", localAddr)
+	logEntry := wn.log.With(logging.EventField, "Disconnected").With(logging.PeerField, peer.rootURL).With("local", localAddr)
 	if peer.outgoing && peer.peerMessageDelay > 0 {
 		logEntry = logEntry.With("messageDelay", peer.peerMessageDelay)
 	}
@@ -109,6 +109,7 @@ var defaultSendMessageTags = map[protocol.Tag]bool{
 	protocol.NetIDVerificationTag: true,
 	protocol.PingTag:              true,
 	protocol.PingReplyTag:         true,
+	protocol.ProposalChunkTag:     true,
 	protocol.ProposalPayloadTag:   true,
 	protocol.TopicMsgRespTag:      true,
 	protocol.MsgOfInterestTag:     true,
@@ -260,9 +261,12 @@ type wsPeer struct {
 	// peer version ( this is one of the version supported by the current node and listed in SupportedProtocolVersions )
 	version string
 
-	// peer features derived from the peer version
+	// peer features derived from the peer version, and negotiated with the features this node announced
 	features peerFeatureFlag
 
+	// txSyncVersion is the transaction sync protocol version negotiated with the peer, when features has pfTxSync
+	txSyncVersion uint64
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -782,6 +786,7 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectWriteError
 	}
 	atomic.StoreInt64(&wp.lastPacketTime, time.Now().UnixNano())
+	atomic.AddUint64(&wp.net.sentBytes, uint64(len(msg.data)))
 	networkSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
 	networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
 	networkMessageSentTotal.AddUint64(1, nil)
@@ -1089,6 +1094,11 @@ func (wp *wsPeer) pfProposalCompressionSupported() bool {
 	return wp.features&pfCompressedProposal != 0
 }
 
+// capabilities returns the optional features negotiated with the peer during the handshake
+func (wp *wsPeer) capabilities() peerCapabilities {
+	return peerCapabilities{features: wp.features, txSyncVersion: wp.txSyncVersion}
+}
+
 func (wp *wsPeer) OnClose(f func()) {
 	if wp.closers == nil {
 		wp.closers = []func(){}
@@ -1099,7 +1109,65 @@ func (wp *wsPeer) OnClose(f func()) {
 //msgp:ignore peerFeatureFlag
 type peerFeatureFlag int
 
-const pfCompressedProposal peerFeatureFlag = 1
+const (
+	pfCompressedProposal peerFeatureFlag = 1 << iota
+	pfBatchBlockFetch
+	pfQUIC
+	pfTxSync
+)
+
+// peerFeatureNames lists the PeerFeaturesHeader values of the peer features
+var peerFeatureNames = []struct {
+	flag peerFeatureFlag
+	name string
+}{
+	{pfCompressedProposal, PeerFeatureProposalCompression},
+	{pfBatchBlockFetch, PeerFeatureBatchBlockFetch},
+	{pfQUIC, PeerFeatureQUIC},
+	{pfTxSync, PeerFeatureTxSync},
+}
+
+// peerCapabilities is the set of optional features announced in the PeerFeaturesHeader, along with the
+// versions of the versioned ones.
+type peerCapabilities struct {
+	features peerFeatureFlag
+
+	// txSyncVersion is the transaction sync protocol version, when features has pfTxSync
+	txSyncVersion uint64
+}
+
+// names lists the capabilities as announced in the PeerFeaturesHeader, with the versioned features formatted
+// as name=version.
+func (pc peerCapabilities) names() (names []string) {
+	for _, f := range peerFeatureNames {
+		if pc.features&f.flag == 0 {
+			continue
+		}
+		if f.flag == pfTxSync {
+			names = append(names, fmt.Sprintf("%s=%d", f.name, pc.txSyncVersion))
+			continue
+		}
+		names = append(names, f.name)
+	}
+	return names
+}
+
+// encode returns the PeerFeaturesHeader value announcing the capabilities
+func (pc peerCapabilities) encode() string {
+	return strings.Join(pc.names(), ",")
+}
+
+// negotiate returns the capabilities both sides support, at the lowest version of the versioned ones
+func (pc peerCapabilities) negotiate(other peerCapabilities) peerCapabilities {
+	res := peerCapabilities{features: pc.features & other.features}
+	if res.features&pfTxSync != 0 {
+		res.txSyncVersion = pc.txSyncVersion
+		if other.txSyncVersion < res.txSyncVersion {
+			res.txSyncVersion = other.txSyncVersion
+		}
+	}
+	return res
+}
 
 // versionPeerFeatures defines protocol version when peer features were introduced
 const versionPeerFeatures = "2.2"
@@ -1123,26 +1191,42 @@ func versionToMajorMinor(version string) (int64, int64, error) {
 	return major, minor, nil
 }
 
-func decodePeerFeatures(version string, announcedFeatures string) peerFeatureFlag {
+// decodePeerCapabilities parses the PeerFeaturesHeader value a peer announced. The unknown features, and the
+// versioned ones without a valid version, are ignored so that new features can be rolled out incrementally.
+func decodePeerCapabilities(version string, announcedFeatures string) (pc peerCapabilities) {
 	major, minor, err := versionToMajorMinor(version)
 	if err != nil {
-		return 0
+		return
 	}
 
 	if major < versionPeerFeaturesNum[0] {
-		return 0
+		return
 	}
 	if minor < versionPeerFeaturesNum[1] {
-		return 0
+		return
 	}
 
-	var features peerFeatureFlag
 	parts := strings.Split(announcedFeatures, ",")
 	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if part == PeerFeatureProposalCompression {
-			features |= pfCompressedProposal
+		name, value, versioned := strings.Cut(strings.TrimSpace(part), "=")
+		switch name {
+		case PeerFeatureProposalCompression:
+			pc.features |= pfCompressedProposal
+		case PeerFeatureBatchBlockFetch:
+			pc.features |= pfBatchBlockFetch
+		case PeerFeatureQUIC:
+			pc.features |= pfQUIC
+		case PeerFeatureTxSync:
+			if !versioned {
+				continue
+			}
+			txSyncVersion, err := strconv.ParseUint(value, 10, 64)
+			if err != nil || txSyncVersion == 0 {
+				continue
+			}
+			pc.features |= pfTxSync
+			pc.txSyncVersion = txSyncVersion
 		}
 	}
-	return features
+	return
 }
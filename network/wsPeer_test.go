@@ -186,12 +186,55 @@ func TestVersionToFeature(t *testing.T) {
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
-			f := decodePeerFeatures(test.ver, test.hdr)
+			f := decodePeerCapabilities(test.ver, test.hdr).features
 			require.Equal(t, test.expected, f)
 		})
 	}
 }
 
+func TestPeerCapabilities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		hdr      string
+		expected peerCapabilities
+	}{
+		{"", peerCapabilities{}},
+		{"ppzstd, blkbatch,quic", peerCapabilities{features: pfCompressedProposal | pfBatchBlockFetch | pfQUIC}},
+		{"txsync=2", peerCapabilities{features: pfTxSync, txSyncVersion: 2}},
+		{"ppzstd,txsync=1,future=3", peerCapabilities{features: pfCompressedProposal | pfTxSync, txSyncVersion: 1}},
+		{"txsync", peerCapabilities{}},
+		{"txsync=0", peerCapabilities{}},
+		{"txsync=x,quic", peerCapabilities{features: pfQUIC}},
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			pc := decodePeerCapabilities("2.2", test.hdr)
+			require.Equal(t, test.expected, pc)
+			// the announced capabilities decode back to the same set
+			require.Equal(t, pc, decodePeerCapabilities("2.2", pc.encode()))
+		})
+	}
+	require.Equal(t, peerCapabilities{}, decodePeerCapabilities("2.1", "ppzstd,txsync=1"))
+
+	local := peerCapabilities{features: pfCompressedProposal | pfTxSync, txSyncVersion: 2}
+	require.Equal(t, "ppzstd,txsync=2", local.encode())
+	require.Equal(t, []string{"ppzstd", "txsync=2"}, local.names())
+	require.Empty(t, peerCapabilities{}.names())
+
+	remote := decodePeerCapabilities("2.2", "quic,txsync=1,ppzstd")
+	negotiated := local.negotiate(remote)
+	require.Equal(t, peerCapabilities{features: pfCompressedProposal | pfTxSync, txSyncVersion: 1}, negotiated)
+	require.Equal(t, negotiated, remote.negotiate(local))
+
+	negotiated = local.negotiate(decodePeerCapabilities("2.2", "quic"))
+	require.Equal(t, peerCapabilities{}, negotiated)
+
+	wp := wsPeer{features: negotiated.features | pfQUIC}
+	require.Equal(t, []string{"quic"}, wp.capabilities().names())
+}
+
 func TestPeerReadLoopSwitchAllTags(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
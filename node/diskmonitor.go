@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/util"
+)
+
+// diskMonitorInterval is how often the disk space left on the data directory is checked.
+const diskMonitorInterval = 10 * time.Second
+
+// diskSpaceLevel is how low the disk space left on the data directory is, given the thresholds of the config.
+type diskSpaceLevel int
+
+const (
+	// diskSpaceOK is above all the thresholds.
+	diskSpaceOK diskSpaceLevel = iota
+	// diskSpaceLow is below DiskSpaceWarningThreshold: telemetry warnings are emitted.
+	diskSpaceLow
+	// diskSpaceCritical is below DiskSpaceCatchpointThreshold: catchpoint files are paused.
+	diskSpaceCritical
+	// diskSpaceExhausted is below DiskSpaceHaltThreshold: no block is added to the ledger.
+	diskSpaceExhausted
+)
+
+func (l diskSpaceLevel) String() string {
+	switch l {
+	case diskSpaceOK:
+		return "ok"
+	case diskSpaceLow:
+		return "low"
+	case diskSpaceCritical:
+		return "critical"
+	case diskSpaceExhausted:
+		return "exhausted"
+	}
+	return "unknown"
+}
+
+// diskProtectedLedger is the ledger the disk monitor takes protective actions on.
+type diskProtectedLedger interface {
+	PauseCatchpointFiles(paused bool)
+	HaltBlockIngestion(halted bool)
+}
+
+// diskMonitor tracks the disk space left on the filesystem holding the data directory, and takes
+// protective actions on the ledger as it crosses the thresholds of the config, undoing them once
+// space is freed.
+type diskMonitor struct {
+	dir    string
+	cfg    config.Local
+	ledger diskProtectedLedger
+	log    logging.Logger
+
+	level diskSpaceLevel
+}
+
+func makeDiskMonitor(dir string, cfg config.Local, ledger diskProtectedLedger, log logging.Logger) *diskMonitor {
+	return &diskMonitor{dir: dir, cfg: cfg, ledger: ledger, log: log}
+}
+
+// levelOf returns the level of the given disk space left, in bytes.
+func (m *diskMonitor) levelOf(available uint64) diskSpaceLevel {
+	switch {
+	case available < m.cfg.DiskSpaceHaltThreshold:
+		return diskSpaceExhausted
+	case available < m.cfg.DiskSpaceCatchpointThreshold:
+		return diskSpaceCritical
+	case available < m.cfg.DiskSpaceWarningThreshold:
+		return diskSpaceLow
+	}
+	return diskSpaceOK
+}
+
+// check updates the level from the disk space left on the data directory.
+func (m *diskMonitor) check() {
+	available, _, err := util.GetDiskSpace(m.dir)
+	if err != nil {
+		m.log.Debugf("unable to get the disk space left on %s: %v", m.dir, err)
+		return
+	}
+	m.update(available)
+}
+
+// update moves to the level of the given disk space left, taking or undoing the protective actions
+// of the levels crossed.
+func (m *diskMonitor) update(available uint64) {
+	level := m.levelOf(available)
+	if level == m.level {
+		return
+	}
+
+	details := telemetryspec.DiskSpaceEventDetails{
+		AvailableBytes:        available,
+		Level:                 level.String(),
+		PreviousLevel:         m.level.String(),
+		CatchpointFilesPaused: level >= diskSpaceCritical,
+		BlockIngestionHalted:  level >= diskSpaceExhausted,
+	}
+	m.ledger.PauseCatchpointFiles(details.CatchpointFilesPaused)
+	m.ledger.HaltBlockIngestion(details.BlockIngestionHalted)
+	if level > m.level {
+		m.log.Warnf("disk space left on %s is %s (%d bytes): catchpoint files paused: %v, block ingestion halted: %v",
+			m.dir, level, available, details.CatchpointFilesPaused, details.BlockIngestionHalted)
+	} else {
+		m.log.Infof("disk space left on %s is back to %s (%d bytes): catchpoint files paused: %v, block ingestion halted: %v",
+			m.dir, level, available, details.CatchpointFilesPaused, details.BlockIngestionHalted)
+	}
+	m.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.DiskSpaceEvent, details)
+	m.level = level
+}
+
+// run checks the disk space periodically until done is closed.
+func (m *diskMonitor) run(done <-chan struct{}) {
+	m.check()
+	ticker := time.NewTicker(diskMonitorInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.check()
+		case <-done:
+			return
+		}
+	}
+}
+
+// diskMonitorThread monitors the disk space left on the directory holding the ledger of the node until
+// done is closed.
+func (node *AlgorandFullNode) diskMonitorThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	dir := filepath.Join(node.rootDir, node.genesisID)
+	makeDiskMonitor(dir, node.config, node.ledger, node.log).run(done)
+}
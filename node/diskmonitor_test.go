@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type diskProtectedLedgerMock struct {
+	catchpointFilesPaused bool
+	blockIngestionHalted  bool
+}
+
+func (l *diskProtectedLedgerMock) PauseCatchpointFiles(paused bool) {
+	l.catchpointFilesPaused = paused
+}
+
+func (l *diskProtectedLedgerMock) HaltBlockIngestion(halted bool) {
+	l.blockIngestionHalted = halted
+}
+
+func TestDiskMonitorLevels(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.DiskSpaceWarningThreshold = 300
+	cfg.DiskSpaceCatchpointThreshold = 200
+	cfg.DiskSpaceHaltThreshold = 100
+	ledger := &diskProtectedLedgerMock{}
+	m := makeDiskMonitor(t.TempDir(), cfg, ledger, logging.TestingLog(t))
+
+	steps := []struct {
+		available uint64
+		level     diskSpaceLevel
+		paused    bool
+		halted    bool
+	}{
+		{1000, diskSpaceOK, false, false},
+		{250, diskSpaceLow, false, false},
+		{150, diskSpaceCritical, true, false},
+		{50, diskSpaceExhausted, true, true},
+		// freeing space undoes the protective actions
+		{150, diskSpaceCritical, true, false},
+		{1000, diskSpaceOK, false, false},
+		// and crossing several thresholds at once takes all their actions
+		{0, diskSpaceExhausted, true, true},
+	}
+	for _, step := range steps {
+		m.update(step.available)
+		require.Equal(t, step.level, m.level, "available %d", step.available)
+		require.Equal(t, step.paused, ledger.catchpointFilesPaused, "available %d", step.available)
+		require.Equal(t, step.halted, ledger.blockIngestionHalted, "available %d", step.available)
+	}
+}
+
+func TestDiskMonitorDisabledThresholds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.DiskSpaceWarningThreshold = 0
+	cfg.DiskSpaceCatchpointThreshold = 0
+	cfg.DiskSpaceHaltThreshold = 0
+	ledger := &diskProtectedLedgerMock{}
+	m := makeDiskMonitor(t.TempDir(), cfg, ledger, logging.TestingLog(t))
+
+	m.update(0)
+	require.Equal(t, diskSpaceOK, m.level)
+	require.False(t, ledger.catchpointFilesPaused)
+	require.False(t, ledger.blockIngestionHalted)
+}
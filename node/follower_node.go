@@ -19,6 +19,7 @@ package node
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -34,17 +35,29 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/appwatch"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
+// ErrRoundNotReached is returned when acknowledging a round the ledger of the follower has not reached.
+var ErrRoundNotReached = errors.New("cannot acknowledge a round the ledger has not reached")
+
+var followerConsumerLagGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_follower_consumer_lag_rounds", Description: "number of rounds retained by the follower past its sync round, not yet acknowledged by its consumer"})
+var followerSyncRoundGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_follower_sync_round", Description: "current sync round of the follower"})
+var followerDroppedRoundsCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_follower_dropped_delta_rounds_total", Description: "number of rounds whose state deltas the follower dropped before its consumer acknowledged them"})
+
 // AlgorandFollowerNode implements follower mode/ledger delta APIs and disables participation-related methods
 type AlgorandFollowerNode struct {
 	mu        deadlock.Mutex
@@ -58,6 +71,7 @@ type AlgorandFollowerNode struct {
 	catchupService           *catchup.Service
 	catchpointCatchupService *catchup.CatchpointCatchupService
 	blockService             *rpcs.BlockService
+	appWatcher               *appwatch.Watcher
 
 	rootDir     string
 	genesisID   string
@@ -89,16 +103,21 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 	if node.devMode {
 		log.Warn("Follower running on a devMode network. Must submit txns to a different node.")
 	}
+	if err := catchup.ValidatePeerFilter(cfg); err != nil {
+		log.Errorf("invalid catchup peer lists: %v", err)
+		return nil, err
+	}
 	node.config = cfg
 
 	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, nil)
+	p2pNode, err := network.NewWebsocketNetwork(node.log.Subsystem(logging.NetworkSubsystem), node.config, phonebookAddresses, genesis.ID(), genesis.Network, nil)
 	if err != nil {
 		log.Errorf("could not create websocket node: %v", err)
 		return nil, err
 	}
 	p2pNode.DeregisterMessageInterest(protocol.AgreementVoteTag)
 	p2pNode.DeregisterMessageInterest(protocol.ProposalPayloadTag)
+	p2pNode.DeregisterMessageInterest(protocol.ProposalChunkTag)
 	p2pNode.DeregisterMessageInterest(protocol.VoteBundleTag)
 	node.net = p2pNode
 
@@ -126,14 +145,16 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 		return nil, err
 	}
 
+	node.appWatcher = appwatch.MakeWatcher(appWatchRetainedRounds)
 	blockListeners := []ledgercore.BlockListener{
+		node.appWatcher,
 		node,
 	}
 
 	node.ledger.RegisterBlockListeners(blockListeners)
 	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
-	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, make(chan catchup.PendingUnmatchedCertificate), node.lowPriorityCryptoVerificationPool)
+	node.catchupService = catchup.MakeService(node.log.Subsystem(logging.CatchupSubsystem), node.config, p2pNode, node.ledger, node.catchupBlockAuth, make(chan catchup.PendingUnmatchedCertificate), node.lowPriorityCryptoVerificationPool)
 
 	// Initialize sync round to the latest db round + 1 so that nothing falls out of the cache on Start
 	err = node.SetSyncRound(uint64(node.Ledger().LatestTrackerCommitted() + 1))
@@ -149,7 +170,7 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 	}
 	if catchpointCatchupState != ledger.CatchpointCatchupStateInactive {
 		accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-		node.catchpointCatchupService, err = catchup.MakeResumedCatchpointCatchupService(context.Background(), node, node.log, node.net, accessor, node.config)
+		node.catchpointCatchupService, err = catchup.MakeResumedCatchpointCatchupService(context.Background(), node, node.log.Subsystem(logging.CatchupSubsystem), node.net, accessor, node.config)
 		if err != nil {
 			log.Errorf("unable to create catchpoint catchup service: %v", err)
 			return nil, err
@@ -238,6 +259,11 @@ func (node *AlgorandFollowerNode) BroadcastInternalSignedTxGroup(_ []transaction
 	return fmt.Errorf("cannot broadcast internal signed txn group in sync mode")
 }
 
+// SubmitPrivateTxGroup errors in follower mode
+func (node *AlgorandFollowerNode) SubmitPrivateTxGroup(_ []transactions.SignedTxn) (relays int, err error) {
+	return 0, fmt.Errorf("cannot submit private txns in sync mode")
+}
+
 // Simulate speculatively runs a transaction group against the current
 // blockchain state and returns the effects and/or errors that would result.
 func (node *AlgorandFollowerNode) Simulate(_ simulation.Request) (result simulation.Result, err error) {
@@ -245,6 +271,26 @@ func (node *AlgorandFollowerNode) Simulate(_ simulation.Request) (result simulat
 	return
 }
 
+// CreateSimulationSession errors in follower mode
+func (node *AlgorandFollowerNode) CreateSimulationSession(_ string) (simulation.SessionInfo, error) {
+	return simulation.SessionInfo{}, fmt.Errorf("cannot simulate in data mode")
+}
+
+// SimulateInSession errors in follower mode
+func (node *AlgorandFollowerNode) SimulateInSession(_ string, _ simulation.Request) (simulation.Result, error) {
+	return simulation.Result{}, fmt.Errorf("cannot simulate in data mode")
+}
+
+// SimulationSessionDelta errors in follower mode
+func (node *AlgorandFollowerNode) SimulationSessionDelta(_ string) (ledgercore.StateDelta, error) {
+	return ledgercore.StateDelta{}, fmt.Errorf("cannot simulate in data mode")
+}
+
+// DeleteSimulationSession errors in follower mode
+func (node *AlgorandFollowerNode) DeleteSimulationSession(_ string) error {
+	return fmt.Errorf("cannot simulate in data mode")
+}
+
 // GetPendingTransaction no-ops in follower mode
 func (node *AlgorandFollowerNode) GetPendingTransaction(_ transactions.Txid) (res TxnWithStatus, found bool) {
 	return
@@ -288,6 +334,11 @@ func (node *AlgorandFollowerNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{}
 }
 
+// EstimateFees errors in follower mode
+func (node *AlgorandFollowerNode) EstimateFees(_ []uint64) ([]pools.FeeEstimate, error) {
+	return nil, fmt.Errorf("cannot estimate fees in follower mode")
+}
+
 // GetPendingTxnsFromPool returns an empty array in follower mode.
 func (node *AlgorandFollowerNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn, error) {
 	return []transactions.SignedTxn{}, nil
@@ -313,11 +364,26 @@ func (node *AlgorandFollowerNode) AppendParticipationKeys(_ account.Participatio
 	return fmt.Errorf("cannot append participation keys in follower mode")
 }
 
+// ParticipationKeyRenewalStatus always returns false in follower mode, which does not renew participation keys
+func (node *AlgorandFollowerNode) ParticipationKeyRenewalStatus(_ account.ParticipationID) (ParticipationKeyRenewalStatus, bool) {
+	return ParticipationKeyRenewalStatus{}, false
+}
+
 // InstallParticipationKey returns an error in follower mode
 func (node *AlgorandFollowerNode) InstallParticipationKey(_ []byte) (account.ParticipationID, error) {
 	return account.ParticipationID{}, fmt.Errorf("cannot install participation key in follower mode")
 }
 
+// ExportParticipationKey returns an error in follower mode
+func (node *AlgorandFollowerNode) ExportParticipationKey(_ account.ParticipationID) (account.ParticipationSecrets, error) {
+	return account.ParticipationSecrets{}, fmt.Errorf("cannot export participation key in follower mode")
+}
+
+// ImportParticipationKey returns an error in follower mode
+func (node *AlgorandFollowerNode) ImportParticipationKey(_ account.ParticipationSecrets) (account.ParticipationID, error) {
+	return account.ParticipationID{}, fmt.Errorf("cannot install participation key in follower mode")
+}
+
 // OnNewBlock implements the BlockListener interface so we're notified after each block is written to the ledger
 func (node *AlgorandFollowerNode) OnNewBlock(block bookkeeping.Block, _ ledgercore.StateDelta) {
 	if node.ledger.Latest() > block.Round() {
@@ -327,6 +393,43 @@ func (node *AlgorandFollowerNode) OnNewBlock(block bookkeeping.Block, _ ledgerco
 	node.lastRoundTimestamp = time.Now()
 	node.hasSyncedSinceStartup = true
 	node.syncStatusMu.Unlock()
+
+	node.retainDeltas(block.Round())
+}
+
+// retainDeltas keeps the rounds retained past the sync round within FollowerMaxDeltaRetention once the
+// latest round is written, advancing the sync round when the consumer falls further behind, and updates
+// the consumer lag metrics.
+func (node *AlgorandFollowerNode) retainDeltas(latest basics.Round) {
+	syncRound := node.GetSyncRound()
+	if syncRound == 0 {
+		// without a sync round, no round is retained for a consumer.
+		followerConsumerLagGauge.Set(0)
+		followerSyncRoundGauge.Set(0)
+		return
+	}
+
+	// the deltas are only retained for MaxAcctLookback rounds past the sync round, after which the
+	// node stops fetching blocks.
+	retention := node.config.FollowerMaxDeltaRetention
+	if retention > node.config.MaxAcctLookback {
+		retention = node.config.MaxAcctLookback
+	}
+	// make room for the next round when the retained rounds would otherwise exceed the retention.
+	if retention > 0 && uint64(latest)+1 >= syncRound+retention {
+		newSyncRound := uint64(latest) + 2 - retention
+		err := node.SetSyncRound(newSyncRound)
+		if err != nil {
+			node.log.Warnf("unable to advance the sync round from %d to %d: %v", syncRound, newSyncRound, err)
+		} else {
+			node.log.Infof("consumer is %d rounds behind, advanced the sync round from %d to %d", uint64(latest)+1-syncRound, syncRound, newSyncRound)
+			followerDroppedRoundsCounter.AddUint64(newSyncRound-syncRound, nil)
+			syncRound = newSyncRound
+		}
+	}
+
+	followerConsumerLagGauge.Set(basics.SubSaturate(uint64(latest)+1, syncRound))
+	followerSyncRoundGauge.Set(syncRound)
 }
 
 // StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
@@ -344,7 +447,7 @@ func (node *AlgorandFollowerNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log.Subsystem(logging.CatchupSubsystem), node.net, accessor, node.config)
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -448,6 +551,25 @@ func (node *AlgorandFollowerNode) GetSyncRound() uint64 {
 	return basics.SubSaturate(node.catchupService.GetDisableSyncRound(), node.Config().MaxAcctLookback)
 }
 
+// AcknowledgeRound records that the consumer of the follower processed the given round, advancing the
+// sync round past it. Acknowledging a round behind the sync round leaves it unchanged.
+func (node *AlgorandFollowerNode) AcknowledgeRound(rnd uint64) error {
+	latest := node.ledger.Latest()
+	if basics.Round(rnd) > latest {
+		return ErrRoundNotReached
+	}
+	if rnd < node.GetSyncRound() {
+		return nil
+	}
+	err := node.SetSyncRound(rnd + 1)
+	if err != nil {
+		return err
+	}
+	followerConsumerLagGauge.Set(uint64(latest) - rnd)
+	followerSyncRoundGauge.Set(rnd + 1)
+	return nil
+}
+
 // UnsetSyncRound removes the sync round constraint on the catchup service
 func (node *AlgorandFollowerNode) UnsetSyncRound() {
 	node.catchupService.UnsetDisableSyncRound()
@@ -464,3 +586,74 @@ func (node *AlgorandFollowerNode) SetBlockTimeStampOffset(offset int64) error {
 func (node *AlgorandFollowerNode) GetBlockTimeStampOffset() (*int64, error) {
 	return nil, fmt.Errorf("cannot get block timestamp offset in follower mode")
 }
+
+// AdvanceDevModeRounds returns an error, as blocks are not produced in follower mode.
+func (node *AlgorandFollowerNode) AdvanceDevModeRounds(rounds uint64, timestampOffset *int64) (basics.Round, error) {
+	return 0, fmt.Errorf("cannot advance rounds in follower mode")
+}
+
+// PeerConnections returns the details of the peers the node is connected to.
+func (node *AlgorandFollowerNode) PeerConnections() (telemetryspec.PeersConnectionDetails, error) {
+	return listPeerConnections(node.net)
+}
+
+// StateProofStatus returns the progress of the state proof worker.
+// Follower nodes do not form state proofs.
+func (node *AlgorandFollowerNode) StateProofStatus(maxMissing int) (stateproof.WorkerStatus, error) {
+	return stateproof.WorkerStatus{}, fmt.Errorf("state proofs are not formed in follower mode")
+}
+
+// WatchApplication collects the state changes of an application, from the next committed round on.
+func (node *AlgorandFollowerNode) WatchApplication(appID basics.AppIndex) {
+	node.appWatcher.Watch(appID)
+}
+
+// UnwatchApplication stops collecting the state changes of an application.
+func (node *AlgorandFollowerNode) UnwatchApplication(appID basics.AppIndex) error {
+	return node.appWatcher.Unwatch(appID)
+}
+
+// WatchedApplications returns the applications whose state changes are collected.
+func (node *AlgorandFollowerNode) WatchedApplications() []basics.AppIndex {
+	return node.appWatcher.Watched()
+}
+
+// ApplicationStateChanges returns the state changes of the watched applications in a round.
+func (node *AlgorandFollowerNode) ApplicationStateChanges(rnd basics.Round) (appwatch.RoundChanges, error) {
+	return node.appWatcher.Changes(rnd)
+}
+
+// AccountPerformance returns an error, as accounts do not participate in follower mode.
+func (node *AlgorandFollowerNode) AccountPerformance() ([]AccountPerformance, error) {
+	return nil, fmt.Errorf("account performance is not tracked in follower mode")
+}
+
+// Progressing returns true, as the watchdog does not check the ledger in follower mode.
+func (node *AlgorandFollowerNode) Progressing() bool {
+	return true
+}
+
+// ProposalAssemblies returns an error, as blocks are not proposed in follower mode.
+func (node *AlgorandFollowerNode) ProposalAssemblies() ([]pools.AssemblyReport, error) {
+	return nil, fmt.Errorf("blocks are not proposed in follower mode")
+}
+
+// ReplayRejections returns an error, as transactions are not accepted in follower mode.
+func (node *AlgorandFollowerNode) ReplayRejections() ([]pools.ReplayRejection, error) {
+	return nil, fmt.Errorf("transactions are not accepted in follower mode")
+}
+
+// RegisterAgreementObserver returns an error, as the agreement service does not run in follower mode.
+func (node *AlgorandFollowerNode) RegisterAgreementObserver(o agreement.Observer) (unregister func(), err error) {
+	return nil, fmt.Errorf("agreement does not run in follower mode")
+}
+
+// OptimizeAccountsDatabase requests a background optimization of the accounts database.
+func (node *AlgorandFollowerNode) OptimizeAccountsDatabase() error {
+	return node.ledger.OptimizeAccountsDatabase()
+}
+
+// AccountsDatabaseOptimizeStatus returns the status of the background optimizations of the accounts database.
+func (node *AlgorandFollowerNode) AccountsDatabaseOptimizeStatus() ledger.AccountsDatabaseOptimizeStatus {
+	return node.ledger.AccountsDatabaseOptimizeStatus()
+}
@@ -108,6 +108,62 @@ func TestSyncRound(t *testing.T) {
 	require.Equal(t, uint64(0), node.GetSyncRound())
 }
 
+func TestAcknowledgeRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	node := setupFollowNode(t)
+	b := bookkeeping.Block{
+		BlockHeader: bookkeeping.BlockHeader{
+			Round: 1,
+		},
+	}
+	b.CurrentProtocol = protocol.ConsensusCurrentVersion
+	err := node.Ledger().AddBlock(b, agreement.Certificate{})
+	require.NoError(t, err)
+
+	// Acknowledging a round advances the sync round past it
+	require.NoError(t, node.AcknowledgeRound(1))
+	require.Equal(t, uint64(2), node.GetSyncRound())
+	// Acknowledging an older round leaves it unchanged
+	require.NoError(t, node.AcknowledgeRound(0))
+	require.Equal(t, uint64(2), node.GetSyncRound())
+	// The consumer cannot have processed a round the ledger has not reached
+	require.ErrorIs(t, node.AcknowledgeRound(5), ErrRoundNotReached)
+	require.Equal(t, uint64(2), node.GetSyncRound())
+}
+
+func TestDeltaRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	cfg.DisableNetworking = true
+	cfg.MaxAcctLookback = 8
+	cfg.FollowerMaxDeltaRetention = 4
+	node, err := MakeFollower(logging.Base(), t.TempDir(), cfg, []string{}, followNodeDefaultGenesis())
+	require.NoError(t, err)
+	require.NoError(t, node.SetSyncRound(1))
+
+	// The retained rounds are within the retention
+	node.retainDeltas(2)
+	require.Equal(t, uint64(1), node.GetSyncRound())
+	// The next round would exceed the retention: the oldest round is dropped
+	node.retainDeltas(4)
+	require.Equal(t, uint64(2), node.GetSyncRound())
+	node.retainDeltas(10)
+	require.Equal(t, uint64(8), node.GetSyncRound())
+
+	// The retention is capped by MaxAcctLookback, past which the node would stall
+	node.config.FollowerMaxDeltaRetention = 100
+	node.retainDeltas(15)
+	require.Equal(t, uint64(9), node.GetSyncRound())
+
+	// Without retention, the node waits for the consumer
+	node.config.FollowerMaxDeltaRetention = 0
+	node.retainDeltas(16)
+	require.Equal(t, uint64(9), node.GetSyncRound())
+}
+
 func TestErrors(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -0,0 +1,244 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/util"
+)
+
+// HealthStatus is the health of the node or one of its components.
+type HealthStatus string
+
+const (
+	// HealthOK means the component works as expected.
+	HealthOK HealthStatus = "ok"
+	// HealthDegraded means the component works, but below expectations.
+	HealthDegraded HealthStatus = "degraded"
+	// HealthUnhealthy means the component does not work.
+	HealthUnhealthy HealthStatus = "unhealthy"
+)
+
+// The machine readable reasons of a component degradation.
+const (
+	HealthReasonStatusUnavailable        = "status-unavailable"
+	HealthReasonUnsupportedRound         = "stopped-at-unsupported-round"
+	HealthReasonCatchpointCatchup        = "catchpoint-catchup"
+	HealthReasonCatchingUp               = "catching-up"
+	HealthReasonStalled                  = "stalled"
+	HealthReasonParticipationKeysExpired = "participation-keys-expired"
+	HealthReasonNoPeers                  = "no-peers"
+	HealthReasonFewPeers                 = "few-peers"
+	HealthReasonWritingCatchpoint        = "writing-catchpoint"
+	HealthReasonDiskSpaceUnavailable     = "disk-space-unavailable"
+	HealthReasonLowDiskSpace             = "low-disk-space"
+)
+
+// The names of the components reported by the health report.
+const (
+	HealthComponentLedger        = "ledger"
+	HealthComponentParticipation = "participation"
+	HealthComponentNetwork       = "network"
+	HealthComponentCatchpoint    = "catchpoint"
+	HealthComponentDisk          = "disk"
+)
+
+const (
+	// lowDiskSpaceRatio is the share of available disk space below which the disk is degraded
+	lowDiskSpaceRatio = 0.05
+	// criticalDiskSpaceRatio is the share of available disk space below which the disk is unhealthy
+	criticalDiskSpaceRatio = 0.01
+)
+
+// ComponentHealth is the health of a component of the node.
+type ComponentHealth struct {
+	Name    string                 `json:"name"`
+	Status  HealthStatus           `json:"status"`
+	Reasons []string               `json:"reasons,omitempty"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// HealthReport is the health of the node, which is the worst health of its components.
+type HealthReport struct {
+	Status     HealthStatus      `json:"status"`
+	Components []ComponentHealth `json:"components"`
+}
+
+func makeComponentHealth(name string) ComponentHealth {
+	return ComponentHealth{Name: name, Status: HealthOK, Details: make(map[string]interface{})}
+}
+
+// degrade lowers the status of the component to status, if it is worse, and records the reason.
+func (c *ComponentHealth) degrade(status HealthStatus, reason string) {
+	if status.worseThan(c.Status) {
+		c.Status = status
+	}
+	c.Reasons = append(c.Reasons, reason)
+}
+
+func (s HealthStatus) rank() int {
+	switch s {
+	case HealthOK:
+		return 0
+	case HealthDegraded:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (s HealthStatus) worseThan(other HealthStatus) bool {
+	return s.rank() > other.rank()
+}
+
+func makeHealthReport(components ...ComponentHealth) HealthReport {
+	report := HealthReport{Status: HealthOK, Components: components}
+	for _, c := range components {
+		if c.Status.worseThan(report.Status) {
+			report.Status = c.Status
+		}
+	}
+	return report
+}
+
+// ledgerHealth reports how far behind the network the ledger is.
+func ledgerHealth(s StatusReport, err error) ComponentHealth {
+	c := makeComponentHealth(HealthComponentLedger)
+	if err != nil {
+		c.degrade(HealthUnhealthy, HealthReasonStatusUnavailable)
+		return c
+	}
+
+	timeSinceLastRound := s.TimeSinceLastRound()
+	c.Details["last-round"] = uint64(s.LastRound)
+	c.Details["time-since-last-round-ms"] = timeSinceLastRound.Milliseconds()
+	c.Details["catchup-time-ms"] = s.CatchupTime.Milliseconds()
+
+	if s.StoppedAtUnsupportedRound {
+		c.degrade(HealthUnhealthy, HealthReasonUnsupportedRound)
+	}
+	if s.Catchpoint != "" {
+		c.Details["catchpoint"] = s.Catchpoint
+		c.degrade(HealthDegraded, HealthReasonCatchpointCatchup)
+	} else if s.CatchupTime > 0 {
+		c.degrade(HealthDegraded, HealthReasonCatchingUp)
+	} else if timeSinceLastRound >= agreement.DeadlineTimeout() {
+		c.degrade(HealthDegraded, HealthReasonStalled)
+	}
+	return c
+}
+
+// participationHealth reports whether the node holds participation keys valid for the next round.
+// A node without any participation key is not participating, which is not a degradation.
+func participationHealth(registry account.ParticipationRegistry, s StatusReport) ComponentHealth {
+	c := makeComponentHealth(HealthComponentParticipation)
+
+	records := registry.GetAll()
+	valid := 0
+	for _, record := range records {
+		if record.OverlapsInterval(s.LastRound+1, s.LastRound+1) {
+			valid++
+		}
+	}
+	c.Details["participation-keys"] = len(records)
+	c.Details["valid-participation-keys"] = valid
+	c.Details["participating"] = valid > 0
+
+	if len(records) > 0 && valid == 0 {
+		c.degrade(HealthDegraded, HealthReasonParticipationKeysExpired)
+	}
+	return c
+}
+
+// networkHealth reports the number of outgoing peers against the GossipFanout target.
+func networkHealth(net network.GossipNode, cfg config.Local) ComponentHealth {
+	c := makeComponentHealth(HealthComponentNetwork)
+
+	outgoing := len(net.GetPeers(network.PeersConnectedOut))
+	incoming := len(net.GetPeers(network.PeersConnectedIn))
+	c.Details["outgoing-peers"] = outgoing
+	c.Details["incoming-peers"] = incoming
+	c.Details["target-outgoing-peers"] = cfg.GossipFanout
+
+	if outgoing+incoming == 0 {
+		c.degrade(HealthUnhealthy, HealthReasonNoPeers)
+	} else if outgoing < cfg.GossipFanout {
+		c.degrade(HealthDegraded, HealthReasonFewPeers)
+	}
+	return c
+}
+
+// catchpointHealth reports whether the ledger is writing a catchpoint, which slows down the node.
+func catchpointHealth(ledger *data.Ledger) ComponentHealth {
+	c := makeComponentHealth(HealthComponentCatchpoint)
+	writing := ledger.IsWritingCatchpointDataFile()
+	c.Details["writing-catchpoint"] = writing
+	if writing {
+		c.degrade(HealthDegraded, HealthReasonWritingCatchpoint)
+	}
+	return c
+}
+
+// diskHealth reports the disk space left on the filesystem holding the data directory.
+func diskHealth(dir string) ComponentHealth {
+	c := makeComponentHealth(HealthComponentDisk)
+	available, total, err := util.GetDiskSpace(dir)
+	if err != nil || total == 0 {
+		c.degrade(HealthDegraded, HealthReasonDiskSpaceUnavailable)
+		return c
+	}
+
+	ratio := float64(available) / float64(total)
+	c.Details["available-bytes"] = available
+	c.Details["total-bytes"] = total
+	if ratio < criticalDiskSpaceRatio {
+		c.degrade(HealthUnhealthy, HealthReasonLowDiskSpace)
+	} else if ratio < lowDiskSpaceRatio {
+		c.degrade(HealthDegraded, HealthReasonLowDiskSpace)
+	}
+	return c
+}
+
+// Health reports the health of the components of the node.
+func (node *AlgorandFullNode) Health() HealthReport {
+	s, err := node.Status()
+	components := []ComponentHealth{ledgerHealth(s, err)}
+	if err == nil {
+		components = append(components, participationHealth(node.accountManager.Registry(), s))
+	}
+	components = append(components,
+		networkHealth(node.net, node.config),
+		catchpointHealth(node.ledger),
+		diskHealth(node.rootDir),
+	)
+	return makeHealthReport(components...)
+}
+
+// Health reports the health of the components of the node. A follower node does not participate.
+func (node *AlgorandFollowerNode) Health() HealthReport {
+	s, err := node.Status()
+	return makeHealthReport(
+		ledgerHealth(s, err),
+		networkHealth(node.net, node.config),
+		catchpointHealth(node.ledger),
+		diskHealth(node.rootDir),
+	)
+}
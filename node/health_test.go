@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLedgerHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := ledgerHealth(StatusReport{LastRound: 10, LastRoundTimestamp: time.Now()}, nil)
+	require.Equal(t, HealthOK, c.Status)
+	require.Empty(t, c.Reasons)
+	require.Equal(t, uint64(10), c.Details["last-round"])
+
+	c = ledgerHealth(StatusReport{LastRoundTimestamp: time.Now(), CatchupTime: time.Second}, nil)
+	require.Equal(t, HealthDegraded, c.Status)
+	require.Equal(t, []string{HealthReasonCatchingUp}, c.Reasons)
+
+	c = ledgerHealth(StatusReport{LastRoundTimestamp: time.Now(), Catchpoint: "5000#ABCD"}, nil)
+	require.Equal(t, HealthDegraded, c.Status)
+	require.Equal(t, []string{HealthReasonCatchpointCatchup}, c.Reasons)
+
+	c = ledgerHealth(StatusReport{LastRoundTimestamp: time.Now().Add(-time.Hour)}, nil)
+	require.Equal(t, HealthDegraded, c.Status)
+	require.Equal(t, []string{HealthReasonStalled}, c.Reasons)
+
+	c = ledgerHealth(StatusReport{LastRoundTimestamp: time.Now(), StoppedAtUnsupportedRound: true}, nil)
+	require.Equal(t, HealthUnhealthy, c.Status)
+	require.Equal(t, []string{HealthReasonUnsupportedRound}, c.Reasons)
+
+	c = ledgerHealth(StatusReport{}, errors.New("status error"))
+	require.Equal(t, HealthUnhealthy, c.Status)
+	require.Equal(t, []string{HealthReasonStatusUnavailable}, c.Reasons)
+}
+
+func TestDiskHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := diskHealth(t.TempDir())
+	require.NotContains(t, c.Reasons, HealthReasonDiskSpaceUnavailable)
+	require.NotZero(t, c.Details["total-bytes"])
+
+	c = diskHealth("/this/directory/does/not/exist")
+	require.Equal(t, HealthDegraded, c.Status)
+	require.Equal(t, []string{HealthReasonDiskSpaceUnavailable}, c.Reasons)
+}
+
+func TestHealthReportStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ok := makeComponentHealth("a")
+	degraded := makeComponentHealth("b")
+	degraded.degrade(HealthDegraded, "reason")
+	unhealthy := makeComponentHealth("c")
+	unhealthy.degrade(HealthUnhealthy, "reason")
+	// a later, milder degradation does not improve the status
+	unhealthy.degrade(HealthDegraded, "other-reason")
+	require.Equal(t, HealthUnhealthy, unhealthy.Status)
+	require.Equal(t, []string{"reason", "other-reason"}, unhealthy.Reasons)
+
+	require.Equal(t, HealthOK, makeHealthReport(ok).Status)
+	require.Equal(t, HealthDegraded, makeHealthReport(ok, degraded).Status)
+	require.Equal(t, HealthUnhealthy, makeHealthReport(degraded, unhealthy, ok).Status)
+}
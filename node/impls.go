@@ -19,6 +19,7 @@ package node
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/catchup"
@@ -49,12 +50,15 @@ func (i blockAuthenticatorImpl) Quit() {
 type blockValidatorImpl struct {
 	l                *data.Ledger
 	verificationPool execpool.BacklogPool
+	tracer           *txnTracer
 }
 
 // Validate implements BlockValidator.Validate.
 func (i blockValidatorImpl) Validate(ctx context.Context, e bookkeeping.Block) (agreement.ValidatedBlock, error) {
 	b := &e
+	start := time.Now()
 	lvb, err := i.l.Validate(ctx, *b, i.verificationPool)
+	i.tracer.blockSpan(e, txnSpanValidation, start, time.Now(), err)
 	if err != nil {
 		return nil, err
 	}
@@ -67,26 +71,32 @@ type agreementLedger struct {
 	*data.Ledger
 	UnmatchedPendingCertificates chan catchup.PendingUnmatchedCertificate
 	n                            network.GossipNode
+	tracer                       *txnTracer
 }
 
-func makeAgreementLedger(ledger *data.Ledger, net network.GossipNode) agreementLedger {
+func makeAgreementLedger(ledger *data.Ledger, net network.GossipNode, tracer *txnTracer) agreementLedger {
 	return agreementLedger{
 		Ledger:                       ledger,
 		UnmatchedPendingCertificates: make(chan catchup.PendingUnmatchedCertificate, 1),
 		n:                            net,
+		tracer:                       tracer,
 	}
 }
 
 // EnsureBlock implements agreement.LedgerWriter.EnsureBlock.
 func (l agreementLedger) EnsureBlock(e bookkeeping.Block, c agreement.Certificate) {
+	start := time.Now()
 	l.Ledger.EnsureBlock(&e, c)
+	l.tracer.commit(e, start, time.Now())
 	// let the network know that we've made some progress.
 	l.n.OnNetworkAdvance()
 }
 
 // EnsureValidatedBlock implements agreement.LedgerWriter.EnsureValidatedBlock.
 func (l agreementLedger) EnsureValidatedBlock(ve agreement.ValidatedBlock, c agreement.Certificate) {
+	start := time.Now()
 	l.Ledger.EnsureValidatedBlock(ve.(validatedBlock).vb, c)
+	l.tracer.commit(ve.Block(), start, time.Now())
 	// let the network know that we've made some progress.
 	l.n.OnNetworkAdvance()
 }
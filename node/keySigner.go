@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/pkcs11"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// openKeySigner opens the key signer selected by ParticipationKeySignerProvider, and sets it as the
+// crypto.KeySigner the participation keys sign with. It returns nil when no provider is configured, and fails
+// when the provider cannot hold every key type of the participation keys.
+func openKeySigner(cfg config.Local, log logging.Logger) (*pkcs11.Signer, error) {
+	signer, err := account.OpenKeySigner(cfg)
+	if err != nil || signer == nil {
+		return nil, err
+	}
+	if !signer.Supports(crypto.FalconAlgorithm) {
+		log.Warnf("the state proof keys are held in the participation database, since PKCS#11 token %s does not support falcon keys", cfg.PKCS11TokenLabel)
+	}
+
+	crypto.SetKeySigner(signer)
+	return signer, nil
+}
+
+// closeKeySigner closes the key signer opened by openKeySigner.
+func (node *AlgorandFullNode) closeKeySigner() {
+	if node.keySigner == nil {
+		return
+	}
+	crypto.SetKeySigner(nil)
+	node.keySigner.Close()
+	node.keySigner = nil
+}
@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/algorand/go-algorand/agreement"
@@ -33,6 +34,8 @@ import (
 	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/pkcs11"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
@@ -42,9 +45,11 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/verify"
 	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/appwatch"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/protocol"
@@ -59,6 +64,10 @@ import (
 
 const (
 	participationRegistryFlushMaxWaitDuration = 30 * time.Second
+
+	// appWatchRetainedRounds is the number of recent rounds the state changes of the watched
+	// applications are retained for.
+	appWatchRetainedRounds = 1000
 )
 
 const (
@@ -116,9 +125,13 @@ type AlgorandFullNode struct {
 	ledger *data.Ledger
 	net    network.GossipNode
 
-	transactionPool *pools.TransactionPool
-	txHandler       *data.TxHandler
-	accountManager  *data.AccountManager
+	transactionPool    *pools.TransactionPool
+	simulationSessions *simulation.SessionManager
+	appWatcher         *appwatch.Watcher
+	performanceTracker *performanceTracker
+	txnTracer          *txnTracer
+	txHandler          *data.TxHandler
+	accountManager     *data.AccountManager
 
 	agreementService         *agreement.Service
 	catchupService           *catchup.Service
@@ -147,13 +160,32 @@ type AlgorandFullNode struct {
 	catchupBlockAuth                   blockAuthenticatorImpl
 
 	oldKeyDeletionNotify        chan struct{}
+	partKeyRenewalNotify        chan struct{}
 	monitoringRoutinesWaitGroup sync.WaitGroup
 
+	partKeyRenewer *partKeyRenewer
+
+	// keySigner holds the voting keys of the participation keys generated by the node, when configured
+	keySigner *pkcs11.Signer
+
 	tracer messagetracer.MessageTracer
 
 	stateProofWorker *stateproof.Worker
+
+	// privateTxGroups are the transaction groups submitted privately, forwarded to the privateTxRelays
+	privateTxGroups *privateTxGroups
+	privateTxRelays []client.RestClient
+
+	// shuttingDown is set once the node starts stopping, after which it rejects new writes.
+	shuttingDown atomic.Bool
+
+	// notProgressing is set while the watchdog finds the ledger stalled or its commits backlogged.
+	notProgressing atomic.Bool
 }
 
+// ErrShuttingDown is returned for the writes submitted to the node once it started stopping.
+var ErrShuttingDown = errors.New("node is shutting down")
+
 // TxnWithStatus represents information about a single transaction,
 // in particular, whether it has appeared in some block yet or not,
 // and whether it was kicked out of the txpool due to some error.
@@ -169,6 +201,10 @@ type TxnWithStatus struct {
 	// node's txpool due to an error.
 	PoolError string
 
+	// PoolErrorCode is the code of the reason the transaction was kicked
+	// out of this node's transaction pool, empty along with PoolError.
+	PoolErrorCode pools.RejectionCode
+
 	// ApplyData is the transaction.ApplyData, if committed.
 	ApplyData transactions.ApplyData
 }
@@ -182,10 +218,26 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.genesisID = genesis.ID()
 	node.genesisHash = genesis.Hash()
 	node.devMode = genesis.DevMode
+	if cfg.EnableCreatableHolderIndex && !cfg.Archival {
+		log.Warn("EnableCreatableHolderIndex is only honored on archival nodes")
+		cfg.EnableCreatableHolderIndex = false
+	}
+	if err := catchup.ValidatePeerFilter(cfg); err != nil {
+		log.Errorf("invalid catchup peer lists: %v", err)
+		return nil, err
+	}
 	node.config = cfg
 
+	privateTxRelays, err := parsePrivateTxRelays(cfg.PrivateTxRelays)
+	if err != nil {
+		log.Errorf("invalid PrivateTxRelays: %v", err)
+		return nil, err
+	}
+	node.privateTxGroups = makePrivateTxGroups()
+	node.privateTxRelays = privateTxRelays
+
 	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
+	p2pNode, err := network.NewWebsocketNetwork(node.log.Subsystem(logging.NetworkSubsystem), node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
 	if err != nil {
 		log.Errorf("could not create websocket node: %v", err)
 		return nil, err
@@ -219,9 +271,12 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log)
+	node.simulationSessions = simulation.MakeSessionManager(node.ledger, cfg.EnableDeveloperAPI)
+	node.appWatcher = appwatch.MakeWatcher(appWatchRetainedRounds)
 
 	blockListeners := []ledgercore.BlockListener{
 		node.transactionPool,
+		node.appWatcher,
 		node,
 	}
 
@@ -243,7 +298,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
 	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, p2pNode, node.genesisID)
-	rpcs.RegisterTxService(node.transactionPool, p2pNode, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
+	rpcs.RegisterTxService(publicTxPool{node.transactionPool, node.privateTxGroups}, p2pNode, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
 
 	crashPathname := filepath.Join(genesisDir, config.CrashFilename)
 	crashAccess, err := db.MakeAccessor(crashPathname, false, false)
@@ -252,20 +307,24 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 
-	blockValidator := blockValidatorImpl{l: node.ledger, verificationPool: node.highPriorityCryptoVerificationPool}
-	agreementLedger := makeAgreementLedger(node.ledger, node.net)
+	if cfg.EnableTxnTracing {
+		node.txnTracer = makeTxnTracer(node.log)
+	}
+	blockValidator := blockValidatorImpl{l: node.ledger, verificationPool: node.highPriorityCryptoVerificationPool, tracer: node.txnTracer}
+	agreementLedger := makeAgreementLedger(node.ledger, node.net, node.txnTracer)
 	var agreementClock timers.Clock
 	if node.devMode {
 		agreementClock = timers.MakeFrozenClock()
 	} else {
 		agreementClock = timers.MakeMonotonicClock(time.Now())
 	}
+	agreementLog := log.Subsystem(logging.AgreementSubsystem)
 	agreementParameters := agreement.Parameters{
-		Logger:         log,
+		Logger:         agreementLog,
 		Accessor:       crashAccess,
 		Clock:          agreementClock,
 		Local:          node.config,
-		Network:        gossip.WrapNetwork(node.net, log, cfg),
+		Network:        gossip.WrapNetwork(node.net, agreementLog, cfg),
 		Ledger:         agreementLedger,
 		BlockFactory:   node,
 		BlockValidator: blockValidator,
@@ -280,8 +339,14 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
-	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
-	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
+	node.catchupService = catchup.MakeService(node.log.Subsystem(logging.CatchupSubsystem), node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
+	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.log.Subsystem(logging.TxnSyncSubsystem), node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
+
+	node.keySigner, err = openKeySigner(cfg, node.log)
+	if err != nil {
+		log.Errorf("unable to open the participation key signer: %v", err)
+		return nil, err
+	}
 
 	registry, err := ensureParticipationDB(genesisDir, node.log)
 	if err != nil {
@@ -289,6 +354,8 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 	node.accountManager = data.MakeAccountManager(log, registry)
+	node.performanceTracker = makePerformanceTracker(trackedAccounts(cfg, node.log), node.ledger, node.accountManager, node.log)
+	node.ledger.RegisterBlockListeners([]ledgercore.BlockListener{node.performanceTracker})
 
 	err = node.loadParticipationKeys()
 	if err != nil {
@@ -297,6 +364,8 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 
 	node.oldKeyDeletionNotify = make(chan struct{}, 1)
+	node.partKeyRenewalNotify = make(chan struct{}, 1)
+	node.partKeyRenewer = makePartKeyRenewer(node)
 
 	catchpointCatchupState, err := node.ledger.GetCatchpointCatchupState(context.Background())
 	if err != nil {
@@ -305,7 +374,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 	if catchpointCatchupState != ledger.CatchpointCatchupStateInactive {
 		accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-		node.catchpointCatchupService, err = catchup.MakeResumedCatchpointCatchupService(context.Background(), node, node.log, node.net, accessor, node.config)
+		node.catchpointCatchupService, err = catchup.MakeResumedCatchpointCatchupService(context.Background(), node, node.log.Subsystem(logging.CatchupSubsystem), node.net, accessor, node.config)
 		if err != nil {
 			log.Errorf("unable to create catchpoint catchup service: %v", err)
 			return nil, err
@@ -316,7 +385,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.tracer = messagetracer.NewTracer(log).Init(cfg)
 	gossip.SetTrace(agreementParameters.Network, node.tracer)
 
-	node.stateProofWorker = stateproof.NewWorker(genesisDir, node.log, node.accountManager, node.ledger.Ledger, node.net, node)
+	node.stateProofWorker = stateproof.NewWorker(genesisDir, node.log, node.accountManager, node.ledger.Ledger, node.net, node, cfg)
 
 	return node, err
 }
@@ -365,10 +434,20 @@ func (node *AlgorandFullNode) Start() {
 
 // startMonitoringRoutines starts the internal monitoring routines used by the node.
 func (node *AlgorandFullNode) startMonitoringRoutines() {
-	node.monitoringRoutinesWaitGroup.Add(2)
+	node.monitoringRoutinesWaitGroup.Add(3)
 	go node.txPoolGaugeThread(node.ctx.Done())
 	// Delete old participation keys
 	go node.oldKeyDeletionThread(node.ctx.Done())
+	// Renew the expiring participation keys
+	go node.partKeyRenewalThread(node.ctx.Done())
+
+	// Protect the ledger from the disk running out of space
+	node.monitoringRoutinesWaitGroup.Add(1)
+	go node.diskMonitorThread(node.ctx.Done())
+
+	// Detect slow rounds, ledger stalls and other abnormal conditions
+	node.monitoringRoutinesWaitGroup.Add(1)
+	go node.watchdogThread(node.ctx.Done())
 
 	if node.config.EnableUsageLog {
 		node.monitoringRoutinesWaitGroup.Add(1)
@@ -390,14 +469,36 @@ func (node *AlgorandFullNode) ListeningAddress() (string, bool) {
 	return node.net.Address()
 }
 
-// Stop stops running the node. Once a node is closed, it can never start again.
+// Stop stops running the node. It first stops accepting writes, then drains its in-flight work: the
+// network and the services are stopped, letting the current agreement action complete and persist,
+// the participation registry is flushed, and the account updates being written are committed before
+// the ledger databases are closed. Work still in flight once the ShutdownDeadline of the config
+// elapses is abandoned, as the process is expected to exit. Once a node is closed, it can never start
+// again.
 func (node *AlgorandFullNode) Stop() {
-	node.mu.Lock()
-	defer func() {
-		node.mu.Unlock()
-		node.waitMonitoringRoutines()
+	node.shuttingDown.Store(true)
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		node.stop()
 	}()
 
+	if node.config.ShutdownDeadline <= 0 {
+		<-stopped
+		return
+	}
+	deadline := time.NewTimer(node.config.ShutdownDeadline)
+	defer deadline.Stop()
+	select {
+	case <-stopped:
+	case <-deadline.C:
+		node.log.Warnf("node did not stop within %v, abandoning its in-flight work", node.config.ShutdownDeadline)
+	}
+}
+
+func (node *AlgorandFullNode) stop() {
+	node.mu.Lock()
 	node.net.ClearHandlers()
 	if !node.config.DisableNetworking {
 		node.net.Stop()
@@ -417,7 +518,17 @@ func (node *AlgorandFullNode) Stop() {
 	node.highPriorityCryptoVerificationPool.Shutdown()
 	node.lowPriorityCryptoVerificationPool.Shutdown()
 	node.cryptoPool.Shutdown()
+	node.closeKeySigner()
 	node.cancelCtx()
+	node.mu.Unlock()
+	node.waitMonitoringRoutines()
+
+	// nothing writes to the databases anymore: persist what is in flight and close them.
+	err := node.accountManager.Registry().Flush(participationRegistryFlushMaxWaitDuration)
+	if err != nil {
+		node.log.Warnf("unable to flush the participation registry on shutdown: %v", err)
+	}
+	node.ledger.CloseGracefully()
 }
 
 // note: unlike the other two functions, this accepts a whole filename
@@ -436,8 +547,9 @@ func (node *AlgorandFullNode) Ledger() *data.Ledger {
 	return node.ledger
 }
 
-// writeDevmodeBlock generates a new block for a devmode, and write it to the ledger.
-func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
+// writeDevmodeBlock generates a new block for a devmode, and write it to the ledger. The timestamp of the block is
+// timestampOffset seconds after the one of its previous block, if set.
+func (node *AlgorandFullNode) writeDevmodeBlock(timestampOffset *int64) (err error) {
 	var vb *ledgercore.ValidatedBlock
 	vb, err = node.transactionPool.AssembleDevModeBlock()
 	if err != nil || vb == nil {
@@ -455,15 +567,19 @@ func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
 
 	// Set block timestamp based on offset, if set.
 	// Make sure block timestamp is not greater than MaxInt64.
-	if node.timestampOffset != nil && *node.timestampOffset < math.MaxInt64-prev.TimeStamp {
-		blk.TimeStamp = prev.TimeStamp + *node.timestampOffset
+	if timestampOffset != nil && *timestampOffset < math.MaxInt64-prev.TimeStamp {
+		blk.TimeStamp = prev.TimeStamp + *timestampOffset
 	}
 	blk.BlockHeader.Seed = committee.Seed(prev.Hash())
 	vb2 := ledgercore.MakeValidatedBlock(blk, vb.Delta())
 	vb = &vb2
 
 	// add the newly generated block to the ledger
+	start := time.Now()
 	err = node.ledger.AddValidatedBlock(*vb, agreement.Certificate{Round: vb.Block().Round()})
+	if err == nil {
+		node.txnTracer.commit(blk, start, time.Now())
+	}
 	return err
 }
 
@@ -477,7 +593,7 @@ func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.Sign
 			// if we added the transaction successfully to the transaction pool, then
 			// attempt to generate a block and write it to the ledger.
 			if err == nil {
-				err = node.writeDevmodeBlock()
+				err = node.writeDevmodeBlock(node.timestampOffset)
 			}
 			node.mu.Unlock()
 		}()
@@ -485,6 +601,32 @@ func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.Sign
 	return node.broadcastSignedTxGroup(txgroup)
 }
 
+// AdvanceDevModeRounds produces rounds blocks right away in dev mode, the first one with the transactions pending in
+// the pool. The timestamps of the blocks are offset by timestampOffset seconds from their previous block when set,
+// or else by the block timestamp offset. It returns the round of the last block produced.
+func (node *AlgorandFullNode) AdvanceDevModeRounds(rounds uint64, timestampOffset *int64) (basics.Round, error) {
+	if !node.devMode {
+		return 0, fmt.Errorf("cannot advance rounds when not in dev mode")
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	if timestampOffset == nil {
+		timestampOffset = node.timestampOffset
+	}
+	for i := uint64(0); i < rounds; i++ {
+		latest := node.ledger.Latest()
+		err := node.writeDevmodeBlock(timestampOffset)
+		if err != nil {
+			return node.ledger.Latest(), err
+		}
+		if node.ledger.Latest() == latest {
+			return latest, fmt.Errorf("no block was produced after round %d", latest)
+		}
+	}
+	return node.ledger.Latest(), nil
+}
+
 // BroadcastInternalSignedTxGroup broadcasts a transaction group that has already been signed.
 // It is originated internally, and in DevMode, it will not advance the round.
 func (node *AlgorandFullNode) BroadcastInternalSignedTxGroup(txgroup []transactions.SignedTxn) (err error) {
@@ -493,21 +635,67 @@ func (node *AlgorandFullNode) BroadcastInternalSignedTxGroup(txgroup []transacti
 
 // broadcastSignedTxGroup broadcasts a transaction group that has already been signed.
 func (node *AlgorandFullNode) broadcastSignedTxGroup(txgroup []transactions.SignedTxn) (err error) {
+	if node.shuttingDown.Load() {
+		return ErrShuttingDown
+	}
+
+	start := time.Now()
+	txids := make([]transactions.Txid, len(txgroup))
+	for i := range txgroup {
+		txids[i] = txgroup[i].ID()
+	}
+	traced := node.txnTracer.begin(txids, start)
+	defer func() {
+		end := time.Now()
+		node.txnTracer.span(traced, txnSpanSubmit, start, end, nil, err)
+		if err != nil {
+			node.txnTracer.fail(traced, end, err)
+		}
+	}()
+
+	err = node.admitSignedTxGroup(txgroup, traced)
+	if err != nil {
+		return err
+	}
+	// DevMode nodes do not broadcast txns to the network
+	if node.devMode {
+		return nil
+	}
+	var enc []byte
+	for _, tx := range txgroup {
+		enc = append(enc, protocol.Encode(&tx)...)
+	}
+	stageStart := time.Now()
+	err = node.net.Broadcast(context.TODO(), protocol.TxnTag, enc, false, nil)
+	node.txnTracer.span(traced, txnSpanGossip, stageStart, time.Now(), nil, err)
+	if err != nil {
+		node.log.Infof("failure broadcasting transaction to network: %v - transaction group was %+v", err, txgroup)
+		return err
+	}
+	node.log.Infof("Sent signed tx group with IDs %v", txids)
+	return nil
+}
+
+// admitSignedTxGroup verifies a transaction group that has already been signed, and adds it to the transaction pool.
+func (node *AlgorandFullNode) admitSignedTxGroup(txgroup []transactions.SignedTxn, traced []transactions.Txid) error {
 	lastRound := node.ledger.Latest()
-	var b bookkeeping.BlockHeader
-	b, err = node.ledger.BlockHdr(lastRound)
+	b, err := node.ledger.BlockHdr(lastRound)
 	if err != nil {
 		node.log.Errorf("could not get block header from last round %v: %v", lastRound, err)
 		return err
 	}
 
+	stageStart := time.Now()
 	_, err = verify.TxnGroup(txgroup, &b, node.ledger.VerifiedTransactionCache(), node.ledger)
+	node.txnTracer.span(traced, txnSpanVerify, stageStart, time.Now(), nil, err)
 	if err != nil {
 		node.log.Warnf("malformed transaction: %v", err)
 		return err
 	}
 
+	stageStart = time.Now()
 	err = node.transactionPool.Remember(txgroup)
+	node.txnTracer.span(traced, txnSpanPoolAdmission, stageStart, time.Now(), nil, err)
 	if err != nil {
 		node.log.Infof("rejected by local pool: %v - transaction group was %+v", err, txgroup)
 		return err
@@ -517,22 +705,6 @@ func (node *AlgorandFullNode) broadcastSignedTxGroup(txgroup []transactions.Sign
 	if err != nil {
 		logging.Base().Infof("unable to pin transaction: %v", err)
 	}
-	// DevMode nodes do not broadcast txns to the network
-	if node.devMode {
-		return nil
-	}
-	var enc []byte
-	var txids []transactions.Txid
-	for _, tx := range txgroup {
-		enc = append(enc, protocol.Encode(&tx)...)
-		txids = append(txids, tx.ID())
-	}
-	err = node.net.Broadcast(context.TODO(), protocol.TxnTag, enc, false, nil)
-	if err != nil {
-		node.log.Infof("failure broadcasting transaction to network: %v - transaction group was %+v", err, txgroup)
-		return err
-	}
-	node.log.Infof("Sent signed tx group with IDs %v", txids)
 	return nil
 }
 
@@ -543,6 +715,28 @@ func (node *AlgorandFullNode) Simulate(request simulation.Request) (result simul
 	return simulator.Simulate(request)
 }
 
+// CreateSimulationSession opens a new simulation session pinned at the latest round, on behalf of
+// the API token owner.
+func (node *AlgorandFullNode) CreateSimulationSession(owner string) (simulation.SessionInfo, error) {
+	return node.simulationSessions.Create(owner)
+}
+
+// SimulateInSession speculatively runs a transaction group on top of the state accumulated
+// by a simulation session. Successfully evaluated groups are kept by the session.
+func (node *AlgorandFullNode) SimulateInSession(sessionID string, request simulation.Request) (simulation.Result, error) {
+	return node.simulationSessions.Simulate(sessionID, request)
+}
+
+// SimulationSessionDelta returns the state changes accumulated by a simulation session.
+func (node *AlgorandFullNode) SimulationSessionDelta(sessionID string) (ledgercore.StateDelta, error) {
+	return node.simulationSessions.Delta(sessionID)
+}
+
+// DeleteSimulationSession discards a simulation session.
+func (node *AlgorandFullNode) DeleteSimulationSession(sessionID string) error {
+	return node.simulationSessions.Delete(sessionID)
+}
+
 // ListTxns returns SignedTxns associated with a specific account in a range of Rounds (inclusive).
 // TxnWithStatus returns the round in which a particular transaction appeared,
 // since that information is not part of the SignedTxn itself.
@@ -612,12 +806,13 @@ func (node *AlgorandFullNode) GetPendingTransaction(txID transactions.Txid) (res
 	// appropriate if the transaction isn't found anywhere.
 
 	// Check if it's in the pool or evicted from the pool.
-	tx, txErr, found := node.transactionPool.Lookup(txID)
+	tx, txErr, txErrCode, found := node.transactionPool.Lookup(txID)
 	if found {
 		res = TxnWithStatus{
 			Txn:            tx,
 			ConfirmedRound: 0,
 			PoolError:      txErr,
+			PoolErrorCode:  txErrCode,
 		}
 		found = true
 
@@ -765,6 +960,11 @@ func (node *AlgorandFullNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{Raw: node.transactionPool.FeePerByte()}
 }
 
+// EstimateFees returns the suggested fee per byte for a transaction to be included within each of the given numbers of rounds.
+func (node *AlgorandFullNode) EstimateFees(targets []uint64) ([]pools.FeeEstimate, error) {
+	return node.transactionPool.EstimateFees(targets), nil
+}
+
 // GetPendingTxnsFromPool returns a snapshot of every pending transactions from the node's transaction pool in a slice.
 // Transactions are sorted in decreasing order. If no transactions, returns an empty slice.
 func (node *AlgorandFullNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn, error) {
@@ -799,6 +999,9 @@ func (node *AlgorandFullNode) GetParticipationKey(partKeyID account.Participatio
 
 // RemoveParticipationKey given a participation id, remove the records from the node
 func (node *AlgorandFullNode) RemoveParticipationKey(partKeyID account.ParticipationID) error {
+	if node.shuttingDown.Load() {
+		return ErrShuttingDown
+	}
 
 	// Need to remove the file and then remove the entry in the registry
 	// Let's first get the recorded information from the registry so we can lookup the file
@@ -834,6 +1037,10 @@ func (node *AlgorandFullNode) RemoveParticipationKey(partKeyID account.Participa
 
 // AppendParticipationKeys given a participation id, remove the records from the node
 func (node *AlgorandFullNode) AppendParticipationKeys(partKeyID account.ParticipationID, keys account.StateProofKeys) error {
+	if node.shuttingDown.Load() {
+		return ErrShuttingDown
+	}
+
 	err := node.accountManager.Registry().AppendKeys(partKeyID, keys)
 	if err != nil {
 		return err
@@ -873,6 +1080,10 @@ func createTemporaryParticipationKey(outDir string, partKeyBinary []byte) (strin
 
 // InstallParticipationKey Given a participation key binary stream install the participation key.
 func (node *AlgorandFullNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
+	if node.shuttingDown.Load() {
+		return account.ParticipationID{}, ErrShuttingDown
+	}
+
 	genID := node.GenesisID()
 
 	outDir := filepath.Join(node.rootDir, genID)
@@ -908,12 +1119,64 @@ func (node *AlgorandFullNode) InstallParticipationKey(partKeyBinary []byte) (acc
 		return account.ParticipationID{}, fmt.Errorf("cannot install partkey with missing (zero) parent address")
 	}
 
+	return node.installParticipation(partkey)
+}
+
+// ExportParticipationKey returns the participation key with all of its secrets, so that it can be installed on another node.
+func (node *AlgorandFullNode) ExportParticipationKey(partKeyID account.ParticipationID) (account.ParticipationSecrets, error) {
+	// Flush first, so that the state proof keys appended or deleted lately are exported as they are.
+	err := node.accountManager.Registry().Flush(participationRegistryFlushMaxWaitDuration)
+	if err != nil {
+		return account.ParticipationSecrets{}, err
+	}
+	return node.accountManager.Registry().Export(partKeyID)
+}
+
+// ImportParticipationKey installs a participation key exported by another node.
+func (node *AlgorandFullNode) ImportParticipationKey(secrets account.ParticipationSecrets) (account.ParticipationID, error) {
+	if secrets.Parent == (basics.Address{}) {
+		return account.ParticipationID{}, fmt.Errorf("cannot install partkey with missing (zero) parent address")
+	}
+	if secrets.VRF == nil || secrets.Voting == nil {
+		return account.ParticipationID{}, fmt.Errorf("cannot install partkey with missing VRF or voting secrets")
+	}
+
+	partID, err := node.installParticipation(account.PersistedParticipation{Participation: secrets.Participation()})
+	if err != nil {
+		return account.ParticipationID{}, err
+	}
+	if len(secrets.StateProofSigningKeys) == 0 {
+		return partID, nil
+	}
+	err = node.AppendParticipationKeys(partID, secrets.StateProofSigningKeys)
+	if err != nil {
+		// a key without its state proof keys cannot sign the state proofs, and would keep the import from being retried
+		node.uninstallParticipation(partID)
+		return account.ParticipationID{}, err
+	}
+	return partID, nil
+}
+
+// installParticipation adds the participation key to the registry. The key is removed from the registry again when
+// it cannot be installed completely. On a node with a key signer, only the keys whose voting keys it holds are
+// installed.
+func (node *AlgorandFullNode) installParticipation(partkey account.PersistedParticipation) (id account.ParticipationID, err error) {
+	// the voting keys of a node with a key signer are only ever held by it, so keys generated without it are refused
+	if node.keySigner != nil && (partkey.Voting == nil || !partkey.Voting.HeldBySigner()) {
+		return account.ParticipationID{}, account.ErrParticipationKeyNotHeldBySigner
+	}
+
 	// Tell the AccountManager about the Participation (dupes don't matter) so we ignore the return value
 	// This is ephemeral since we are deleting the file after this function is done
 	added := node.accountManager.AddParticipation(partkey, true)
 	if !added {
 		return account.ParticipationID{}, fmt.Errorf("ParticipationRegistry: cannot register duplicate participation key")
 	}
+	defer func() {
+		if err != nil {
+			node.uninstallParticipation(partkey.ID())
+		}
+	}()
 
 	err = insertStateProofToRegistry(partkey, node)
 	if err != nil {
@@ -928,6 +1191,19 @@ func (node *AlgorandFullNode) InstallParticipationKey(partKeyBinary []byte) (acc
 	return partkey.ID(), nil
 }
 
+// uninstallParticipation removes a participation key which could not be installed completely from the registry.
+func (node *AlgorandFullNode) uninstallParticipation(partKeyID account.ParticipationID) {
+	err := node.accountManager.Registry().Delete(partKeyID)
+	if err != nil {
+		node.log.Warnf("uninstallParticipation: cannot remove participation key %s: %v", partKeyID, err)
+		return
+	}
+	err = node.accountManager.Registry().Flush(participationRegistryFlushMaxWaitDuration)
+	if err != nil {
+		node.log.Warnf("uninstallParticipation: cannot flush the removal of participation key %s: %v", partKeyID, err)
+	}
+}
+
 func (node *AlgorandFullNode) loadParticipationKeys() error {
 	// Generate a list of all potential participation key files
 	genesisDir := filepath.Join(node.rootDir, node.genesisID)
@@ -1045,11 +1321,19 @@ func (node *AlgorandFullNode) OnNewBlock(block bookkeeping.Block, delta ledgerco
 	node.hasSyncedSinceStartup = true
 	node.syncStatusMu.Unlock()
 
+	node.privateTxGroups.expire(block.Round() + 1)
+
 	// Wake up oldKeyDeletionThread(), non-blocking.
 	select {
 	case node.oldKeyDeletionNotify <- struct{}{}:
 	default:
 	}
+
+	// Wake up partKeyRenewalThread(), non-blocking.
+	select {
+	case node.partKeyRenewalNotify <- struct{}{}:
+	default:
+	}
 }
 
 // oldKeyDeletionThread keeps deleting old participation keys.
@@ -1142,7 +1426,7 @@ func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log.Subsystem(logging.CatchupSubsystem), node.net, accessor, node.config)
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -1259,7 +1543,8 @@ func (vb validatedBlock) Block() bookkeeping.Block {
 
 // AssembleBlock implements Ledger.AssembleBlock.
 func (node *AlgorandFullNode) AssembleBlock(round basics.Round) (agreement.ValidatedBlock, error) {
-	deadline := time.Now().Add(node.config.ProposalAssemblyTime)
+	start := time.Now()
+	deadline := start.Add(node.config.ProposalAssemblyTime)
 	lvb, err := node.transactionPool.AssembleBlock(round, deadline)
 	if err != nil {
 		if errors.Is(err, pools.ErrStaleBlockAssemblyRequest) {
@@ -1279,6 +1564,7 @@ func (node *AlgorandFullNode) AssembleBlock(round basics.Round) (agreement.Valid
 		}
 		return nil, err
 	}
+	node.txnTracer.blockSpan(lvb.Block(), txnSpanAssembly, start, time.Now(), nil)
 	return validatedBlock{vb: lvb}, nil
 }
 
@@ -1407,6 +1693,11 @@ func (node *AlgorandFullNode) GetSyncRound() uint64 {
 func (node *AlgorandFullNode) UnsetSyncRound() {
 }
 
+// AcknowledgeRound no-ops
+func (node *AlgorandFullNode) AcknowledgeRound(_ uint64) error {
+	return nil
+}
+
 // SetBlockTimeStampOffset sets a timestamp offset in the block header.
 // This is only available in dev mode.
 func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
@@ -1417,6 +1708,82 @@ func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
 	return fmt.Errorf("cannot set block timestamp offset when not in dev mode")
 }
 
+// peerConnectionsLister is implemented by the networks able to report their connected peers.
+type peerConnectionsLister interface {
+	PeerConnections() telemetryspec.PeersConnectionDetails
+}
+
+// PeerConnections returns the details of the peers the node is connected to.
+func (node *AlgorandFullNode) PeerConnections() (telemetryspec.PeersConnectionDetails, error) {
+	return listPeerConnections(node.net)
+}
+
+// StateProofStatus returns the progress of the state proof worker, reporting at most maxMissing
+// non-signing voters for every state proof.
+func (node *AlgorandFullNode) StateProofStatus(maxMissing int) (stateproof.WorkerStatus, error) {
+	return node.stateProofWorker.Status(maxMissing)
+}
+
+// WatchApplication collects the state changes of an application, from the next committed round on.
+func (node *AlgorandFullNode) WatchApplication(appID basics.AppIndex) {
+	node.appWatcher.Watch(appID)
+}
+
+// UnwatchApplication stops collecting the state changes of an application.
+func (node *AlgorandFullNode) UnwatchApplication(appID basics.AppIndex) error {
+	return node.appWatcher.Unwatch(appID)
+}
+
+// WatchedApplications returns the applications whose state changes are collected.
+func (node *AlgorandFullNode) WatchedApplications() []basics.AppIndex {
+	return node.appWatcher.Watched()
+}
+
+// ApplicationStateChanges returns the state changes of the watched applications in a round.
+func (node *AlgorandFullNode) ApplicationStateChanges(rnd basics.Round) (appwatch.RoundChanges, error) {
+	return node.appWatcher.Changes(rnd)
+}
+
+// AccountPerformance returns the proposal and vote performance of the accounts tracked by the node.
+func (node *AlgorandFullNode) AccountPerformance() ([]AccountPerformance, error) {
+	return node.performanceTracker.Performance(), nil
+}
+
+// ProposalAssemblies returns the reports on the assembly of the most recent blocks the node proposed.
+func (node *AlgorandFullNode) ProposalAssemblies() ([]pools.AssemblyReport, error) {
+	return node.transactionPool.AssemblyReports(), nil
+}
+
+// ReplayRejections returns the most recent transactions the transaction pool rejected as replays of a transaction
+// ID or a lease.
+func (node *AlgorandFullNode) ReplayRejections() ([]pools.ReplayRejection, error) {
+	return node.transactionPool.ReplayRejections(), nil
+}
+
+// RegisterAgreementObserver registers o to be notified of the progress of the agreement service, and returns
+// the function unregistering it.
+func (node *AlgorandFullNode) RegisterAgreementObserver(o agreement.Observer) (unregister func(), err error) {
+	return node.agreementService.RegisterObserver(o), nil
+}
+
+// OptimizeAccountsDatabase requests a background optimization of the accounts database.
+func (node *AlgorandFullNode) OptimizeAccountsDatabase() error {
+	return node.ledger.OptimizeAccountsDatabase()
+}
+
+// AccountsDatabaseOptimizeStatus returns the status of the background optimizations of the accounts database.
+func (node *AlgorandFullNode) AccountsDatabaseOptimizeStatus() ledger.AccountsDatabaseOptimizeStatus {
+	return node.ledger.AccountsDatabaseOptimizeStatus()
+}
+
+func listPeerConnections(net network.GossipNode) (telemetryspec.PeersConnectionDetails, error) {
+	lister, ok := net.(peerConnectionsLister)
+	if !ok {
+		return telemetryspec.PeersConnectionDetails{}, fmt.Errorf("the network does not report its connected peers")
+	}
+	return lister.PeerConnections(), nil
+}
+
 // GetBlockTimeStampOffset gets a timestamp offset.
 // This is only available in dev mode.
 func (node *AlgorandFullNode) GetBlockTimeStampOffset() (*int64, error) {
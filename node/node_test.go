@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/agreement/gossip"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data"
@@ -574,6 +575,8 @@ func TestMaxSizesCorrect(t *testing.T) {
 	require.Equal(t, piSize, protocol.PingTag.MaxMessageSize())
 	pjSize := uint64(network.PingLength)
 	require.Equal(t, pjSize, protocol.PingReplyTag.MaxMessageSize())
+	pcSize := uint64(gossip.ProposalChunkMaxSize())
+	require.Equal(t, pcSize, protocol.ProposalChunkTag.MaxMessageSize())
 	ppSize := uint64(agreement.TransmittedPayloadMaxSize())
 	require.Equal(t, ppSize, protocol.ProposalPayloadTag.MaxMessageSize())
 	spSize := uint64(stateproof.SigFromAddrMaxSize())
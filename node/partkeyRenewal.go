@@ -0,0 +1,430 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	kmdclient "github.com/algorand/go-algorand/daemon/kmd/client"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+// The states of the renewal of a registered participation key.
+const (
+	// PartKeyRenewalExpiring is the state of a key expiring within the renewal lead rounds, while the renewal is disabled.
+	PartKeyRenewalExpiring = "expiring"
+	// PartKeyRenewalSubmitted is the state of a key whose successor registration was submitted to the network.
+	PartKeyRenewalSubmitted = "submitted"
+	// PartKeyRenewalRenewed is the state of a key whose successor got registered.
+	PartKeyRenewalRenewed = "renewed"
+	// PartKeyRenewalFailed is the state of a key whose last renewal attempt failed.
+	PartKeyRenewalFailed = "failed"
+)
+
+const (
+	// partKeyRenewalRetryRounds is the number of rounds between two attempts of a failing renewal.
+	partKeyRenewalRetryRounds = 100
+	// partKeyRenewalTxnValidRounds is the validity period of the renewal keyreg transactions.
+	partKeyRenewalTxnValidRounds = 1000
+
+	// kmdDataDirName and kmdNetFilename locate the kmd instance of the data directory, as the nodecontrol
+	// and kmd server packages do.
+	kmdDataDirName = "kmd-v0.5"
+	kmdNetFilename = "kmd.net"
+)
+
+// ParticipationKeyRenewalStatus is the status of the renewal of a participation key registered for an online account.
+type ParticipationKeyRenewalStatus struct {
+	State string
+	// Successor is the key generated to replace the expiring one.
+	Successor account.ParticipationID
+	// TxID is the keyreg transaction registering the successor.
+	TxID transactions.Txid
+	// Round is the round of the last renewal attempt.
+	Round basics.Round
+	Error string
+
+	txnLastValid basics.Round
+}
+
+// partKeyRenewer generates, installs and registers successors for the participation keys about to expire,
+// and deletes the expired keys.
+type partKeyRenewer struct {
+	node *AlgorandFullNode
+
+	mu     deadlock.Mutex
+	status map[account.ParticipationID]ParticipationKeyRenewalStatus
+}
+
+func makePartKeyRenewer(node *AlgorandFullNode) *partKeyRenewer {
+	return &partKeyRenewer{
+		node:   node,
+		status: make(map[account.ParticipationID]ParticipationKeyRenewalStatus),
+	}
+}
+
+// get returns the renewal status of the given participation key, if it is being renewed.
+func (r *partKeyRenewer) get(id account.ParticipationID) (ParticipationKeyRenewalStatus, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	status, ok := r.status[id]
+	return status, ok
+}
+
+func (r *partKeyRenewer) set(id account.ParticipationID, status ParticipationKeyRenewalStatus) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status[id] = status
+}
+
+func (r *partKeyRenewer) forget(id account.ParticipationID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.status, id)
+}
+
+// registeredPartKeys returns the key of records registered by the account, and its most recent successor.
+func registeredPartKeys(records []account.ParticipationRecord, acct basics.AccountData) (registered, successor *account.ParticipationRecord) {
+	if acct.Status != basics.Online {
+		return nil, nil
+	}
+	for i := range records {
+		rec := &records[i]
+		if rec.Voting != nil && rec.Voting.OneTimeSignatureVerifier == acct.VoteID &&
+			rec.FirstValid == acct.VoteFirstValid && rec.LastValid == acct.VoteLastValid {
+			registered = rec
+			break
+		}
+	}
+	if registered == nil {
+		return nil, nil
+	}
+	for i := range records {
+		rec := &records[i]
+		if rec.LastValid > registered.LastValid && (successor == nil || rec.LastValid > successor.LastValid) {
+			successor = rec
+		}
+	}
+	return registered, successor
+}
+
+// process renews the keys expiring within the lead rounds of the latest round, and deletes the expired ones.
+func (r *partKeyRenewer) process(latest basics.Round) {
+	cfg := r.node.config
+	records := r.node.accountManager.Registry().GetAll()
+
+	byAccount := make(map[basics.Address][]account.ParticipationRecord)
+	for _, rec := range records {
+		byAccount[rec.Account] = append(byAccount[rec.Account], rec)
+	}
+
+	for addr, recs := range byAccount {
+		acct, _, _, err := r.node.ledger.LookupLatest(addr)
+		if err != nil {
+			r.node.log.Warnf("partKeyRenewer: cannot look up account %v: %v", addr, err)
+			continue
+		}
+
+		registered, successor := registeredPartKeys(recs, acct)
+		if registered == nil {
+			continue
+		}
+
+		// the keys whose successor got registered are renewed.
+		for _, rec := range recs {
+			status, ok := r.get(rec.ParticipationID)
+			if ok && status.State == PartKeyRenewalSubmitted && status.Successor == registered.ParticipationID {
+				status.State = PartKeyRenewalRenewed
+				r.set(rec.ParticipationID, status)
+				r.report(rec, status)
+			}
+		}
+
+		if uint64(registered.LastValid) > uint64(latest)+cfg.ParticipationKeyRenewalLeadRounds {
+			continue
+		}
+
+		status, _ := r.get(registered.ParticipationID)
+		if !cfg.EnableParticipationKeyRenewal {
+			if status.State != PartKeyRenewalExpiring {
+				status = ParticipationKeyRenewalStatus{State: PartKeyRenewalExpiring, Round: latest}
+				r.set(registered.ParticipationID, status)
+				r.report(*registered, status)
+			}
+			continue
+		}
+		if status.State == PartKeyRenewalSubmitted && latest <= status.txnLastValid {
+			continue
+		}
+		if status.State == PartKeyRenewalFailed && latest < status.Round+partKeyRenewalRetryRounds {
+			continue
+		}
+
+		status, err = r.renew(*registered, successor, acct, latest)
+		if err != nil {
+			status.State = PartKeyRenewalFailed
+			status.Error = err.Error()
+			r.node.log.Warnf("partKeyRenewer: cannot renew participation key %s of %v: %v", registered.ParticipationID, addr, err)
+		}
+		r.set(registered.ParticipationID, status)
+		r.report(*registered, status)
+	}
+
+	if !cfg.EnableParticipationKeyRenewal {
+		return
+	}
+	for _, rec := range records {
+		if rec.LastValid >= latest {
+			continue
+		}
+		err := r.node.RemoveParticipationKey(rec.ParticipationID)
+		if err != nil && !errors.Is(err, account.ErrParticipationIDNotFound) {
+			r.node.log.Warnf("partKeyRenewer: cannot delete expired participation key %s: %v", rec.ParticipationID, err)
+			continue
+		}
+		r.node.log.Infof("partKeyRenewer: deleted participation key %s of %v, expired at round %d", rec.ParticipationID, rec.Account, rec.LastValid)
+		r.forget(rec.ParticipationID)
+	}
+}
+
+// renew registers a successor to the registered key, generating and installing it unless it already exists.
+func (r *partKeyRenewer) renew(registered account.ParticipationRecord, successor *account.ParticipationRecord, acct basics.AccountData, latest basics.Round) (status ParticipationKeyRenewalStatus, err error) {
+	status.Round = latest
+	if successor == nil {
+		var rec account.ParticipationRecord
+		rec, err = r.generateSuccessor(registered, latest)
+		if err != nil {
+			return status, fmt.Errorf("cannot generate successor key: %w", err)
+		}
+		successor = &rec
+	}
+	status.Successor = successor.ParticipationID
+
+	hdr, err := r.node.ledger.BlockHdr(latest)
+	if err != nil {
+		return status, err
+	}
+	proto := config.Consensus[hdr.CurrentProtocol]
+	txnLife := uint64(partKeyRenewalTxnValidRounds)
+	if proto.MaxTxnLife < txnLife {
+		txnLife = proto.MaxTxnLife
+	}
+
+	tx := transactions.Transaction{
+		Type: protocol.KeyRegistrationTx,
+		Header: transactions.Header{
+			Sender:      registered.Account,
+			FirstValid:  latest + 1,
+			LastValid:   latest + basics.Round(txnLife),
+			GenesisID:   r.node.genesisID,
+			GenesisHash: r.node.genesisHash,
+		},
+		KeyregTxnFields: successor.KeyregTxnFields(proto.EnableStateProofKeyregCheck),
+	}
+	tx.Fee = basics.MulAIntSaturate(r.node.SuggestedFee(), tx.EstimateEncodedSize())
+	if tx.Fee.Raw < proto.MinTxnFee {
+		tx.Fee.Raw = proto.MinTxnFee
+	}
+
+	stx, err := r.sign(tx, acct.AuthAddr)
+	if err != nil {
+		return status, fmt.Errorf("cannot sign keyreg transaction: %w", err)
+	}
+	if stx.Txn.ID() != tx.ID() {
+		return status, fmt.Errorf("the signed keyreg transaction %s differs from transaction %s", stx.Txn.ID(), tx.ID())
+	}
+	err = r.node.BroadcastSignedTxGroup([]transactions.SignedTxn{stx})
+	if err != nil {
+		return status, fmt.Errorf("cannot submit keyreg transaction: %w", err)
+	}
+
+	status.State = PartKeyRenewalSubmitted
+	status.TxID = tx.ID()
+	status.txnLastValid = tx.LastValid
+	return status, nil
+}
+
+// generateSuccessor generates and installs a key valid for ParticipationKeyRenewalValidRounds from the latest round.
+func (r *partKeyRenewer) generateSuccessor(registered account.ParticipationRecord, latest basics.Round) (account.ParticipationRecord, error) {
+	firstValid := latest
+	lastValid := firstValid + basics.Round(r.node.config.ParticipationKeyRenewalValidRounds)
+	keyDilution := registered.KeyDilution
+	if keyDilution == 0 {
+		keyDilution = 1 + uint64(math.Sqrt(float64(lastValid-firstValid)))
+	}
+
+	outDir := filepath.Join(r.node.rootDir, r.node.genesisID)
+	tempFile := filepath.Join(outDir, fmt.Sprintf("tempPartKeyRenewal.%d.bin", crypto.RandUint64()))
+	defer func(name string) {
+		_ = os.Remove(name)
+	}(tempFile)
+
+	partdb, err := db.MakeErasableAccessor(tempFile)
+	if err != nil {
+		return account.ParticipationRecord{}, err
+	}
+	defer partdb.Close()
+
+	r.node.log.Infof("partKeyRenewer: generating participation key of %v for rounds %d to %d", registered.Account, firstValid, lastValid)
+	// the voting keys are generated in the key signer, when the node has one
+	part, err := account.FillDBWithParticipationKeysWithSigner(partdb, registered.Account, firstValid, lastValid, keyDilution, crypto.GetKeySigner())
+	if err != nil {
+		return account.ParticipationRecord{}, err
+	}
+	defer part.Close()
+
+	id, err := r.node.installParticipation(part)
+	if err != nil {
+		part.Voting.DestroySignerSubkeys()
+		return account.ParticipationRecord{}, err
+	}
+	return r.node.GetParticipationKey(id)
+}
+
+// sign signs the transaction with ParticipationKeyRenewalSigner, or with the ParticipationKeyRenewalWallet kmd wallet.
+func (r *partKeyRenewer) sign(tx transactions.Transaction, authAddr basics.Address) (stx transactions.SignedTxn, err error) {
+	cfg := r.node.config
+	switch {
+	case cfg.ParticipationKeyRenewalSigner != "":
+		args := strings.Fields(cfg.ParticipationKeyRenewalSigner)
+		cmd := exec.CommandContext(r.node.ctx, args[0], args[1:]...)
+		cmd.Stdin = bytes.NewReader(protocol.Encode(&tx))
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return stx, fmt.Errorf("%s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+		}
+		err = protocol.Decode(out, &stx)
+		return stx, err
+	case cfg.ParticipationKeyRenewalWallet != "":
+		return r.signWithWallet(tx, authAddr)
+	default:
+		return stx, errors.New("neither ParticipationKeyRenewalSigner nor ParticipationKeyRenewalWallet is configured")
+	}
+}
+
+func (r *partKeyRenewer) signWithWallet(tx transactions.Transaction, authAddr basics.Address) (stx transactions.SignedTxn, err error) {
+	kmdDir := filepath.Join(r.node.rootDir, kmdDataDirName)
+	apiToken, err := tokens.GetAndValidateAPIToken(kmdDir, tokens.KmdTokenFilename)
+	if err != nil {
+		return
+	}
+	sockPath, err := util.GetFirstLineFromFile(filepath.Join(kmdDir, kmdNetFilename))
+	if err != nil {
+		return
+	}
+	kmd, err := kmdclient.MakeKMDClient(sockPath, apiToken)
+	if err != nil {
+		return
+	}
+
+	wallets, err := kmd.ListWallets()
+	if err != nil {
+		return
+	}
+	walletID := ""
+	for _, wallet := range wallets.Wallets {
+		if wallet.Name == r.node.config.ParticipationKeyRenewalWallet {
+			walletID = wallet.ID
+			break
+		}
+	}
+	if walletID == "" {
+		return stx, fmt.Errorf("kmd wallet %s not found", r.node.config.ParticipationKeyRenewalWallet)
+	}
+	handle, err := kmd.InitWallet([]byte(walletID), nil)
+	if err != nil {
+		return
+	}
+	defer kmd.ReleaseWalletHandle([]byte(handle.WalletHandleToken))
+
+	resp, err := kmd.SignTransaction([]byte(handle.WalletHandleToken), nil, crypto.PublicKey(authAddr), tx)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(resp.SignedTransaction, &stx)
+	return
+}
+
+// report sends the telemetry event and log of a renewal status change.
+func (r *partKeyRenewer) report(rec account.ParticipationRecord, status ParticipationKeyRenewalStatus) {
+	details := telemetryspec.ParticipationKeyRenewalEventDetails{
+		Address:         rec.Account.String(),
+		ParticipationID: rec.ParticipationID.String(),
+		LastValid:       uint64(rec.LastValid),
+		State:           status.State,
+		Error:           status.Error,
+	}
+	if !status.Successor.IsZero() {
+		details.Successor = status.Successor.String()
+	}
+	r.node.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.ParticipationKeyRenewalEvent, details)
+
+	switch status.State {
+	case PartKeyRenewalExpiring:
+		r.node.log.Warnf("partKeyRenewer: participation key %s of %v expires at round %d and EnableParticipationKeyRenewal is not set: the account will stop participating unless a new key is registered", rec.ParticipationID, rec.Account, rec.LastValid)
+	case PartKeyRenewalSubmitted:
+		r.node.log.Infof("partKeyRenewer: submitted keyreg transaction %s registering participation key %s of %v", status.TxID, status.Successor, rec.Account)
+	case PartKeyRenewalRenewed:
+		r.node.log.Infof("partKeyRenewer: participation key %s of %v was renewed by %s", rec.ParticipationID, rec.Account, status.Successor)
+	}
+}
+
+// partKeyRenewalThread renews the participation keys as new blocks are written.
+func (node *AlgorandFullNode) partKeyRenewalThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	for {
+		select {
+		case <-done:
+			return
+		case <-node.partKeyRenewalNotify:
+		}
+
+		// The renewal rounds are relative to the latest round, which is meaningless while catching up.
+		node.mu.Lock()
+		catchingUp := node.catchpointCatchupService != nil || node.catchupService.SynchronizingTime() != 0
+		node.mu.Unlock()
+		if catchingUp {
+			continue
+		}
+		node.partKeyRenewer.process(node.ledger.Latest())
+	}
+}
+
+// ParticipationKeyRenewalStatus returns the renewal status of a participation key, if it is being renewed.
+func (node *AlgorandFullNode) ParticipationKeyRenewalStatus(id account.ParticipationID) (ParticipationKeyRenewalStatus, bool) {
+	return node.partKeyRenewer.get(id)
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRegisteredPartKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	makeRecord := func(id byte, first, last basics.Round) account.ParticipationRecord {
+		return account.ParticipationRecord{
+			ParticipationID: account.ParticipationID{id},
+			FirstValid:      first,
+			LastValid:       last,
+			Voting:          crypto.GenerateOneTimeSignatureSecrets(0, 1),
+		}
+	}
+	old := makeRecord(1, 0, 1000)
+	current := makeRecord(2, 900, 2000)
+	next := makeRecord(3, 1900, 3000)
+	latest := makeRecord(4, 1950, 4000)
+	records := []account.ParticipationRecord{old, current, next, latest}
+
+	acct := basics.AccountData{
+		Status:         basics.Online,
+		VoteID:         current.Voting.OneTimeSignatureVerifier,
+		VoteFirstValid: current.FirstValid,
+		VoteLastValid:  current.LastValid,
+	}
+	registered, successor := registeredPartKeys(records, acct)
+	require.NotNil(t, registered)
+	require.Equal(t, current.ParticipationID, registered.ParticipationID)
+	require.NotNil(t, successor)
+	require.Equal(t, latest.ParticipationID, successor.ParticipationID)
+
+	// no successor for the most recent key
+	acct.VoteID = latest.Voting.OneTimeSignatureVerifier
+	acct.VoteFirstValid, acct.VoteLastValid = latest.FirstValid, latest.LastValid
+	registered, successor = registeredPartKeys(records, acct)
+	require.Equal(t, latest.ParticipationID, registered.ParticipationID)
+	require.Nil(t, successor)
+
+	// nothing is registered by offline accounts, or with keys generated elsewhere
+	acct.Status = basics.Offline
+	registered, successor = registeredPartKeys(records, acct)
+	require.Nil(t, registered)
+	require.Nil(t, successor)
+
+	acct.Status = basics.Online
+	acct.VoteID = crypto.GenerateOneTimeSignatureSecrets(0, 1).OneTimeSignatureVerifier
+	registered, successor = registeredPartKeys(records, acct)
+	require.Nil(t, registered)
+	require.Nil(t, successor)
+}
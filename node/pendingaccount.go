@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// pendingAccountAssetsPage is the number of asset holdings looked up at once in the ledger when projecting an account.
+const pendingAccountAssetsPage = 1000
+
+// PendingAccountState is the state of an account projected after the transactions pending in the transaction pool.
+type PendingAccountState struct {
+	// Round is the round of the block the pending transactions are evaluated in.
+	Round basics.Round
+	// Amount is the balance of the account, with its rewards updated to Round.
+	Amount basics.MicroAlgos
+	// MinBalance is the minimum balance the account must keep, given its holdings and applications.
+	MinBalance basics.MicroAlgos
+	// Assets are the holdings of the account, keyed by asset id.
+	Assets map[basics.AssetIndex]basics.AssetHolding
+}
+
+// PendingAccount projects the state of an account after the transactions pending in the transaction pool:
+// its balance, its minimum balance and its asset holdings, including the assets it opts into and excluding
+// the ones it closes out of.
+func (node *AlgorandFullNode) PendingAccount(addr basics.Address) (PendingAccountState, error) {
+	var assets []basics.AssetIndex
+	var next basics.AssetIndex
+	for {
+		resources, _, err := node.ledger.LookupAssets(addr, next, pendingAccountAssetsPage, false)
+		if err != nil {
+			return PendingAccountState{}, err
+		}
+		for _, resource := range resources {
+			if resource.AssetHolding != nil {
+				assets = append(assets, resource.AssetID)
+			}
+		}
+		if len(resources) < pendingAccountAssetsPage {
+			break
+		}
+		next = resources[len(resources)-1].AssetID
+	}
+
+	round, data, holdings, err := node.transactionPool.PendingAccount(addr, assets)
+	if err != nil {
+		return PendingAccountState{}, err
+	}
+	version, err := node.ledger.ConsensusVersion(round)
+	if err != nil {
+		return PendingAccountState{}, err
+	}
+	proto, ok := config.Consensus[version]
+	if !ok {
+		return PendingAccountState{}, fmt.Errorf("unsupported consensus version %s in round %d", version, round)
+	}
+	return PendingAccountState{
+		Round:      round,
+		Amount:     data.MicroAlgos,
+		MinBalance: data.MinBalance(&proto),
+		Assets:     holdings,
+	}, nil
+}
+
+// PendingAccount returns an error, as there is no transaction pool in follower mode.
+func (node *AlgorandFollowerNode) PendingAccount(_ basics.Address) (PendingAccountState, error) {
+	return PendingAccountState{}, fmt.Errorf("cannot project pending accounts in follower mode")
+}
@@ -0,0 +1,232 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-deadlock"
+)
+
+// performanceRetainedRounds is the number of recent rounds an account was selected in which are retained
+// for each tracked account.
+const performanceRetainedRounds = 1000
+
+var performanceProposalsSelectedCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_performance_proposals_selected_total", Description: "number of rounds a tracked account was selected to propose a block in"})
+var performanceProposalsMadeCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_performance_proposals_made_total", Description: "number of blocks agreed on which were proposed by a tracked account"})
+var performanceVotesSelectedCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_performance_votes_selected_total", Description: "number of rounds a tracked account was selected to cast a certificate vote in"})
+var performanceVotesMissedCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_performance_votes_missed_total", Description: "number of rounds a tracked account was selected to cast a certificate vote in whose certificate does not hold its vote"})
+
+// RoundPerformance is the selection of a tracked account in a round, against what the block
+// certificate of the round records of it.
+type RoundPerformance struct {
+	Round            basics.Round
+	ProposerSelected bool
+	Proposed         bool
+	VoterSelected    bool
+	Voted            bool
+}
+
+// AccountPerformance is the record of a tracked account over the rounds the node evaluated its
+// sortition in since it started, that is the rounds it held a participation key of the account for.
+type AccountPerformance struct {
+	Address basics.Address
+
+	// Rounds is the number of rounds the sortition of the account was evaluated in.
+	Rounds uint64
+	// LastRound is the last round the sortition of the account was evaluated in.
+	LastRound basics.Round
+
+	// ProposalsSelected is the number of rounds the account was selected to propose in, and
+	// ProposalsMade the number of agreed blocks it proposed. Several accounts are selected to
+	// propose in every round, so that only the one with the best credential gets its block agreed on.
+	ProposalsSelected uint64
+	ProposalsMade     uint64
+
+	// VotesSelected is the number of rounds the account was selected to cast a certificate vote
+	// in, and VotesMissed the number of those whose certificate does not hold a vote of the account.
+	// A certificate only holds the votes needed to reach the threshold, so late votes count as missed.
+	VotesSelected uint64
+	VotesMissed   uint64
+
+	// Recent holds the most recent rounds the account was selected in, in increasing order.
+	Recent []RoundPerformance
+}
+
+// performanceLedger is the ledger the performance tracker evaluates the sortition of the
+// tracked accounts against.
+type performanceLedger interface {
+	agreement.SortitionLedger
+	BlockCert(basics.Round) (bookkeeping.Block, agreement.Certificate, error)
+}
+
+// participationKeys provides the participation keys the node holds for a round.
+type participationKeys interface {
+	Keys(rnd basics.Round) []account.ParticipationRecordForRound
+}
+
+// performanceTracker records, for each new block, whether the tracked accounts the node holds
+// participation keys of were selected to propose and vote in its round, and whether the block
+// certificate shows them doing so.
+type performanceTracker struct {
+	ledger performanceLedger
+	keys   participationKeys
+	log    logging.Logger
+
+	mu       deadlock.Mutex
+	accounts map[basics.Address]*AccountPerformance
+}
+
+func makePerformanceTracker(tracked []basics.Address, ledger performanceLedger, keys participationKeys, log logging.Logger) *performanceTracker {
+	t := &performanceTracker{
+		ledger:   ledger,
+		keys:     keys,
+		log:      log,
+		accounts: make(map[basics.Address]*AccountPerformance, len(tracked)),
+	}
+	for _, addr := range tracked {
+		t.accounts[addr] = &AccountPerformance{Address: addr}
+	}
+	return t
+}
+
+// trackedAccounts parses the PerformanceTrackedAccounts of the config, logging and skipping the
+// invalid addresses.
+func trackedAccounts(cfg config.Local, log logging.Logger) (tracked []basics.Address) {
+	for _, s := range strings.Split(cfg.PerformanceTrackedAccounts, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		addr, err := basics.UnmarshalChecksumAddress(s)
+		if err != nil {
+			log.Errorf("PerformanceTrackedAccounts: ignoring invalid address %s: %v", s, err)
+			continue
+		}
+		tracked = append(tracked, addr)
+	}
+	return
+}
+
+// OnNewBlock implements the BlockListener interface, evaluating the sortition of the tracked
+// accounts in the round of the block.
+func (t *performanceTracker) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	if len(t.accounts) == 0 {
+		return
+	}
+	rnd := block.Round()
+
+	var keys []account.ParticipationRecordForRound
+	for _, k := range t.keys.Keys(rnd) {
+		if _, ok := t.accounts[k.Account]; ok && k.VRF != nil {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	_, cert, err := t.ledger.BlockCert(rnd)
+	if err != nil {
+		t.log.Warnf("performanceTracker: unable to load the certificate of round %d: %v", rnd, err)
+		return
+	}
+
+	// an account holding several keys for the round is selected with the one it registered
+	selections := make(map[basics.Address]agreement.AccountSelection, len(keys))
+	for _, k := range keys {
+		s, err := cert.Selection(t.ledger, k.Account, k.VRF)
+		if err != nil {
+			t.log.Warnf("performanceTracker: unable to evaluate the sortition of %v in round %d: %v", k.Account, rnd, err)
+			continue
+		}
+		prev, ok := selections[k.Account]
+		if !ok || prev.ProposerWeight+prev.VoterWeight < s.ProposerWeight+s.VoterWeight {
+			selections[k.Account] = s
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for addr, s := range selections {
+		t.record(t.accounts[addr], rnd, s)
+	}
+}
+
+// record adds the selection of an account in a round to its performance.
+func (t *performanceTracker) record(p *AccountPerformance, rnd basics.Round, s agreement.AccountSelection) {
+	labels := map[string]string{"address": p.Address.String()}
+	r := RoundPerformance{
+		Round:            rnd,
+		ProposerSelected: s.ProposerWeight != 0,
+		Proposed:         s.Proposed,
+		VoterSelected:    s.VoterWeight != 0,
+		Voted:            s.Voted,
+	}
+
+	p.Rounds++
+	p.LastRound = rnd
+	if r.ProposerSelected {
+		p.ProposalsSelected++
+		performanceProposalsSelectedCounter.Inc(labels)
+	}
+	if r.Proposed {
+		p.ProposalsMade++
+		performanceProposalsMadeCounter.Inc(labels)
+	}
+	if r.VoterSelected {
+		p.VotesSelected++
+		performanceVotesSelectedCounter.Inc(labels)
+		if !r.Voted {
+			p.VotesMissed++
+			performanceVotesMissedCounter.Inc(labels)
+		}
+	}
+
+	if r.ProposerSelected || r.Proposed || r.VoterSelected {
+		p.Recent = append(p.Recent, r)
+		if len(p.Recent) > performanceRetainedRounds {
+			p.Recent = append(p.Recent[:0], p.Recent[len(p.Recent)-performanceRetainedRounds:]...)
+		}
+	}
+}
+
+// Performance returns the performance of the tracked accounts, ordered by address.
+func (t *performanceTracker) Performance() []AccountPerformance {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	out := make([]AccountPerformance, 0, len(t.accounts))
+	for _, p := range t.accounts {
+		c := *p
+		c.Recent = append([]RoundPerformance(nil), p.Recent...)
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return bytes.Compare(out[i].Address[:], out[j].Address[:]) < 0
+	})
+	return out
+}
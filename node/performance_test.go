@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// performanceLedgerMock holds a single online account, holding all the stake, so that it is
+// selected to propose and vote in every round.
+type performanceLedgerMock struct {
+	addr basics.Address
+	vrf  *crypto.VRFSecrets
+	cert agreement.Certificate
+}
+
+func (l *performanceLedgerMock) Seed(basics.Round) (committee.Seed, error) {
+	return committee.Seed{}, nil
+}
+
+func (l *performanceLedgerMock) LookupAgreement(_ basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
+	if addr != l.addr {
+		return basics.OnlineAccountData{}, nil
+	}
+	return basics.OnlineAccountData{
+		MicroAlgosWithRewards: basics.MicroAlgos{Raw: 1_000_000_000_000},
+		VotingData:            basics.VotingData{SelectionID: l.vrf.PK},
+	}, nil
+}
+
+func (l *performanceLedgerMock) Circulation(basics.Round, basics.Round) (basics.MicroAlgos, error) {
+	return basics.MicroAlgos{Raw: 1_000_000_000_000}, nil
+}
+
+func (l *performanceLedgerMock) ConsensusParams(basics.Round) (config.ConsensusParams, error) {
+	return config.Consensus[protocol.ConsensusCurrentVersion], nil
+}
+
+func (l *performanceLedgerMock) BlockCert(rnd basics.Round) (bookkeeping.Block, agreement.Certificate, error) {
+	cert := l.cert
+	cert.Round = rnd
+	return bookkeeping.Block{}, cert, nil
+}
+
+type participationKeysMock []account.ParticipationRecordForRound
+
+func (k participationKeysMock) Keys(basics.Round) []account.ParticipationRecordForRound {
+	return k
+}
+
+func TestPerformanceTrackerRecord(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address(crypto.Hash([]byte("TestPerformanceTrackerRecord")))
+	untracked := basics.Address{0x1}
+	vrf := crypto.GenerateVRFSecrets()
+	ledger := &performanceLedgerMock{addr: addr, vrf: vrf}
+	keys := participationKeysMock{
+		{ParticipationRecord: account.ParticipationRecord{Account: addr, VRF: crypto.GenerateVRFSecrets()}},
+		{ParticipationRecord: account.ParticipationRecord{Account: addr, VRF: vrf}},
+		{ParticipationRecord: account.ParticipationRecord{Account: untracked, VRF: crypto.GenerateVRFSecrets()}},
+	}
+	// the certificate step
+	ledger.cert.Step = 2
+	tracker := makePerformanceTracker([]basics.Address{addr}, ledger, keys, logging.TestingLog(t))
+
+	blk := func(rnd basics.Round) bookkeeping.Block {
+		return bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+	}
+
+	// the account is selected, but neither proposed the block nor voted in the certificate
+	tracker.OnNewBlock(blk(10), ledgercore.StateDelta{})
+	ledger.cert.Proposal.OriginalProposer = addr
+	tracker.OnNewBlock(blk(11), ledgercore.StateDelta{})
+
+	performance := tracker.Performance()
+	require.Len(t, performance, 1)
+	p := performance[0]
+	require.Equal(t, addr, p.Address)
+	require.Equal(t, uint64(2), p.Rounds)
+	require.Equal(t, basics.Round(11), p.LastRound)
+	require.Equal(t, uint64(2), p.ProposalsSelected)
+	require.Equal(t, uint64(1), p.ProposalsMade)
+	require.Equal(t, uint64(2), p.VotesSelected)
+	require.Equal(t, uint64(2), p.VotesMissed)
+	require.Equal(t, []RoundPerformance{
+		{Round: 10, ProposerSelected: true, VoterSelected: true},
+		{Round: 11, ProposerSelected: true, Proposed: true, VoterSelected: true},
+	}, p.Recent)
+
+	labels := map[string]string{"address": addr.String()}
+	require.Equal(t, uint64(2), performanceProposalsSelectedCounter.GetUint64ValueForLabels(labels))
+	require.Equal(t, uint64(1), performanceProposalsMadeCounter.GetUint64ValueForLabels(labels))
+	require.Equal(t, uint64(2), performanceVotesSelectedCounter.GetUint64ValueForLabels(labels))
+	require.Equal(t, uint64(2), performanceVotesMissedCounter.GetUint64ValueForLabels(labels))
+}
+
+func TestPerformanceTrackerRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address(crypto.Hash([]byte("TestPerformanceTrackerRetention")))
+	tracker := makePerformanceTracker([]basics.Address{addr}, nil, nil, logging.TestingLog(t))
+	p := tracker.accounts[addr]
+	for rnd := basics.Round(1); rnd <= 2*performanceRetainedRounds+2; rnd++ {
+		tracker.record(p, rnd, agreement.AccountSelection{VoterWeight: uint64(rnd % 2)})
+	}
+
+	// only the rounds the account was selected in are retained, the oldest one being dropped
+	require.Equal(t, uint64(2*performanceRetainedRounds+2), p.Rounds)
+	require.Equal(t, uint64(performanceRetainedRounds+1), p.VotesSelected)
+	require.Len(t, p.Recent, performanceRetainedRounds)
+	require.Equal(t, basics.Round(3), p.Recent[0].Round)
+	require.Equal(t, basics.Round(2*performanceRetainedRounds+1), p.Recent[len(p.Recent)-1].Round)
+}
+
+func TestTrackedAccounts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := basics.Address{0x1}
+	b := basics.Address{0x2}
+	cfg := config.GetDefaultLocal()
+	require.Empty(t, trackedAccounts(cfg, logging.TestingLog(t)))
+
+	cfg.PerformanceTrackedAccounts = a.String() + ", not-an-address," + b.String() + ","
+	require.Equal(t, []basics.Address{a, b}, trackedAccounts(cfg, logging.TestingLog(t)))
+}
@@ -0,0 +1,230 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// ErrPrivateTxSubmissionDisabled is returned for the private transaction submissions when EnablePrivateTxSubmission
+// is not set.
+var ErrPrivateTxSubmissionDisabled = errors.New("private transaction submission is not enabled on this node")
+
+// privateTxForwardTimeout is how long a private submission waits for the relays to accept the group. The relays
+// answering later are still counted in the metrics, though not in the result of the submission.
+const privateTxForwardTimeout = 10 * time.Second
+
+// privateTxRelayRequestTimeout bounds the requests to the private relays, so that a relay which stops answering
+// does not hold their goroutines, and their connections, forever.
+const privateTxRelayRequestTimeout = 30 * time.Second
+
+var privateTxForwardedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_private_txn_forwarded_total", Description: "number of private transaction groups accepted by the private relays"})
+var privateTxForwardFailedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_private_txn_forward_failed_total", Description: "number of private transaction groups the private relays failed to accept"})
+
+// privateTxGroups are the transaction groups submitted privately, which are kept out of the pending transactions
+// served to the peers until they expire. Their transactions only become public once included in a block.
+type privateTxGroups struct {
+	mu        deadlock.Mutex
+	lastValid map[transactions.Txid]basics.Round
+}
+
+func makePrivateTxGroups() *privateTxGroups {
+	return &privateTxGroups{lastValid: make(map[transactions.Txid]basics.Round)}
+}
+
+// add remembers a transaction group as private, and returns whether it was not already.
+func (p *privateTxGroups) add(txgroup []transactions.SignedTxn) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.lastValid[txgroup[0].ID()]; ok {
+		return false
+	}
+	for i := range txgroup {
+		p.lastValid[txgroup[i].ID()] = txgroup[i].Txn.LastValid
+	}
+	return true
+}
+
+func (p *privateTxGroups) remove(txgroup []transactions.SignedTxn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := range txgroup {
+		delete(p.lastValid, txgroup[i].ID())
+	}
+}
+
+// contains returns whether any transaction of a group was submitted privately.
+func (p *privateTxGroups) contains(txgroup []transactions.SignedTxn) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := range txgroup {
+		if _, ok := p.lastValid[txgroup[i].ID()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// expire forgets the transactions which can no longer be included from round rnd on.
+func (p *privateTxGroups) expire(rnd basics.Round) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for txid, lastValid := range p.lastValid {
+		if lastValid < rnd {
+			delete(p.lastValid, txid)
+		}
+	}
+}
+
+// publicTxPool is the transaction pool served to the peers syncing their pending transactions, short of the
+// private transaction groups.
+type publicTxPool struct {
+	rpcs.PendingTxAggregate
+	private *privateTxGroups
+}
+
+func (p publicTxPool) PendingTxGroups() [][]transactions.SignedTxn {
+	txgroups := p.PendingTxAggregate.PendingTxGroups()
+	public := txgroups[:0]
+	for _, txgroup := range txgroups {
+		if !p.private.contains(txgroup) {
+			public = append(public, txgroup)
+		}
+	}
+	return public
+}
+
+// parsePrivateTxRelays parses the PrivateTxRelays of the config: a comma-separated list of the REST endpoints of the
+// relays, each one given the API token it granted to the node as the user of its URL, as in
+// https://token@relay.example.com:8080.
+func parsePrivateTxRelays(relays string) ([]client.RestClient, error) {
+	var clients []client.RestClient
+	for _, relay := range strings.Split(relays, ",") {
+		relay = strings.TrimSpace(relay)
+		if relay == "" {
+			continue
+		}
+		// the errors leave the URLs out, lest they log the tokens
+		u, err := url.Parse(relay)
+		if err != nil {
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				err = urlErr.Err
+			}
+			return nil, fmt.Errorf("invalid private transaction relay: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return nil, fmt.Errorf("invalid private transaction relay %s: expected http(s)://token@host:port", u.Host)
+		}
+		if u.User == nil || u.User.Username() == "" {
+			return nil, fmt.Errorf("private transaction relay %s has no API token", u.Host)
+		}
+		token := u.User.Username()
+		u.User = nil
+		clients = append(clients, client.MakeRestClient(*u, token).WithTimeout(privateTxRelayRequestTimeout))
+	}
+	return clients, nil
+}
+
+// SubmitPrivateTxGroup adds a transaction group that has already been signed to the transaction pool without
+// gossiping it, and forwards it to the relays of the PrivateTxRelays of the config, which do the same. The group is
+// kept out of the pending transactions served to the peers, so that it only becomes public once a node holding it
+// proposes it in a block. It returns the number of relays which accepted the group.
+func (node *AlgorandFullNode) SubmitPrivateTxGroup(txgroup []transactions.SignedTxn) (relays int, err error) {
+	if !node.config.EnablePrivateTxSubmission {
+		return 0, ErrPrivateTxSubmissionDisabled
+	}
+	if node.shuttingDown.Load() {
+		return 0, ErrShuttingDown
+	}
+
+	start := time.Now()
+	txids := make([]transactions.Txid, len(txgroup))
+	for i := range txgroup {
+		txids[i] = txgroup[i].ID()
+	}
+	traced := node.txnTracer.begin(txids, start)
+	defer func() {
+		end := time.Now()
+		node.txnTracer.span(traced, txnSpanSubmit, start, end, nil, err)
+		if err != nil {
+			node.txnTracer.fail(traced, end, err)
+		}
+	}()
+
+	// the group is private before entering the pool, lest it be served to a peer in the meantime
+	added := node.privateTxGroups.add(txgroup)
+	err = node.admitSignedTxGroup(txgroup, traced)
+	if err != nil {
+		if added {
+			node.privateTxGroups.remove(txgroup)
+		}
+		return 0, err
+	}
+
+	relays = node.forwardPrivateTxGroup(txgroup)
+	node.log.Infof("Submitted private tx group with IDs %v, accepted by %d of %d private relays", txids, relays, len(node.privateTxRelays))
+	return relays, nil
+}
+
+// forwardPrivateTxGroup sends a transaction group to the private relays, and returns the number of relays which
+// accepted it within privateTxForwardTimeout.
+func (node *AlgorandFullNode) forwardPrivateTxGroup(txgroup []transactions.SignedTxn) int {
+	if len(node.privateTxRelays) == 0 {
+		return 0
+	}
+	results := make(chan error, len(node.privateTxRelays))
+	for _, relay := range node.privateTxRelays {
+		go func(relay client.RestClient) {
+			_, err := relay.SendPrivateTransactionGroup(txgroup)
+			if err != nil {
+				privateTxForwardFailedTotal.Inc(nil)
+				node.log.Infof("private relay failed to accept the tx group with ID %v: %v", txgroup[0].ID(), err)
+			} else {
+				privateTxForwardedTotal.Inc(nil)
+			}
+			results <- err
+		}(relay)
+	}
+
+	accepted := 0
+	timeout := time.NewTimer(privateTxForwardTimeout)
+	defer timeout.Stop()
+	for range node.privateTxRelays {
+		select {
+		case err := <-results:
+			if err == nil {
+				accepted++
+			}
+		case <-timeout.C:
+			return accepted
+		}
+	}
+	return accepted
+}
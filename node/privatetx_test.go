@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type pendingTxGroups [][]transactions.SignedTxn
+
+func (p pendingTxGroups) PendingTxIDs() []transactions.Txid {
+	return nil
+}
+
+func (p pendingTxGroups) PendingTxGroups() [][]transactions.SignedTxn {
+	return append([][]transactions.SignedTxn(nil), p...)
+}
+
+func TestPrivateTxGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txn := func(note byte, lastValid basics.Round) transactions.SignedTxn {
+		var stxn transactions.SignedTxn
+		stxn.Txn.Note = []byte{note}
+		stxn.Txn.LastValid = lastValid
+		return stxn
+	}
+	private := []transactions.SignedTxn{txn(1, 10), txn(2, 20)}
+	public := []transactions.SignedTxn{txn(3, 20)}
+
+	p := makePrivateTxGroups()
+	require.True(t, p.add(private))
+	require.False(t, p.add(private))
+	require.True(t, p.contains(private))
+	require.True(t, p.contains(private[1:]))
+	require.False(t, p.contains(public))
+
+	pool := publicTxPool{pendingTxGroups{private, public}, p}
+	require.Equal(t, [][]transactions.SignedTxn{public}, pool.PendingTxGroups())
+
+	// the transactions expire past their last valid round
+	p.expire(11)
+	require.False(t, p.contains(private[:1]))
+	require.True(t, p.contains(private))
+	p.expire(21)
+	require.False(t, p.contains(private))
+	require.Len(t, pool.PendingTxGroups(), 2)
+
+	require.True(t, p.add(private))
+	p.remove(private)
+	require.False(t, p.contains(private))
+}
+
+func TestParsePrivateTxRelays(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	relays, err := parsePrivateTxRelays("")
+	require.NoError(t, err)
+	require.Empty(t, relays)
+
+	relays, err = parsePrivateTxRelays("https://token1@relay1.example.com:8080, http://token2@10.0.0.1:8080")
+	require.NoError(t, err)
+	require.Len(t, relays, 2)
+
+	for _, relay := range []string{"relay.example.com:8080", "https://relay.example.com:8080", "ftp://token@relay.example.com", "https://token@"} {
+		_, err = parsePrivateTxRelays(relay)
+		require.Error(t, err, relay)
+	}
+}
+
+func TestPrivateTxRelayTimeout(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// a relay which never answers
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	relays, err := parsePrivateTxRelays("http://token@" + srv.Listener.Addr().String())
+	require.NoError(t, err)
+	require.Len(t, relays, 1)
+
+	start := time.Now()
+	_, err = relays[0].WithTimeout(100 * time.Millisecond).SendPrivateTransactionGroup([]transactions.SignedTxn{{}})
+	require.Error(t, err)
+	require.Less(t, time.Since(start), privateTxRelayRequestTimeout)
+}
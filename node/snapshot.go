@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// snapshotConfigFiles are the files of the root of the data directory copied into its snapshots. The API tokens,
+// the addresses and the logs of the node are left out, as they belong to the running node.
+var snapshotConfigFiles = []string{
+	config.GenesisJSONFile,
+	config.ConfigFilename,
+	config.PhonebookFilename,
+	config.ConfigurableConsensusProtocolsFilename,
+}
+
+// isSnapshotDatabase returns whether the database file of the genesis directory is copied into the snapshots
+// of the data directory. The agreement crash database is left out, as it belongs to the running node. The
+// participation registry and the participation key databases are only copied along with the participation keys,
+// since two nodes voting with the same keys may equivocate.
+func isSnapshotDatabase(filename string, withPartKeys bool) bool {
+	if filename == config.ParticipationRegistryFilename || config.IsPartKeyFilename(filename) {
+		return withPartKeys
+	}
+	return filename == config.StateProofFileName
+}
+
+// Snapshot writes a consistent copy of the data directory of the node into dir, which must not exist yet: the
+// ledger databases, copied while the commits of the ledger trackers are paused, the state proof database, the
+// configuration files and, when withPartKeys is set, the participation keys. Unlike a copy of the files of the live
+// SQLite databases, it can be restored into another data directory with goal node snapshot restore. It returns the
+// round of the ledger of the snapshot.
+func (node *AlgorandFullNode) Snapshot(ctx context.Context, dir string, withPartKeys bool) (basics.Round, error) {
+	return snapshotDataDir(ctx, node.log, node.ledger, node.rootDir, node.genesisID, dir, withPartKeys)
+}
+
+// Snapshot writes a consistent copy of the data directory of the node into dir, like the one of a full node.
+func (node *AlgorandFollowerNode) Snapshot(ctx context.Context, dir string, withPartKeys bool) (basics.Round, error) {
+	return snapshotDataDir(ctx, node.log, node.ledger, node.rootDir, node.genesisID, dir, withPartKeys)
+}
+
+func snapshotDataDir(ctx context.Context, log logging.Logger, ledger *data.Ledger, rootDir string, genesisID string, dir string, withPartKeys bool) (round basics.Round, err error) {
+	if _, err = os.Stat(dir); !os.IsNotExist(err) {
+		return 0, fmt.Errorf("snapshot directory %s already exists", dir)
+	}
+	genesisDir := filepath.Join(dir, genesisID)
+	err = os.MkdirAll(genesisDir, 0700)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	start := time.Now()
+	round, err = ledger.Snapshot(ctx, filepath.Join(genesisDir, config.LedgerFilenamePrefix))
+	if err != nil {
+		return 0, err
+	}
+	log.Infof("copied the ledger at round %d into the snapshot %s in %v", round, dir, time.Since(start))
+
+	srcGenesisDir := filepath.Join(rootDir, genesisID)
+	entries, err := os.ReadDir(srcGenesisDir)
+	if err != nil {
+		return 0, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !isSnapshotDatabase(entry.Name(), withPartKeys) {
+			continue
+		}
+		err = backupDatabase(ctx, filepath.Join(srcGenesisDir, entry.Name()), filepath.Join(genesisDir, entry.Name()))
+		if err != nil {
+			return 0, fmt.Errorf("cannot copy %s: %w", entry.Name(), err)
+		}
+	}
+
+	for _, filename := range snapshotConfigFiles {
+		src := filepath.Join(rootDir, filename)
+		if !util.FileExists(src) {
+			continue
+		}
+		_, err = util.CopyFile(src, filepath.Join(dir, filename))
+		if err != nil {
+			return 0, fmt.Errorf("cannot copy %s: %w", filename, err)
+		}
+	}
+
+	if withPartKeys {
+		log.Warnf("copied the participation keys into the snapshot %s: a node restored from it must not run while this one does", dir)
+	}
+
+	manifest := config.SnapshotManifest{
+		GenesisID:         genesisID,
+		Round:             uint64(round),
+		Created:           time.Now().Unix(),
+		ParticipationKeys: withPartKeys,
+	}
+	err = manifest.SaveToDisk(dir)
+	return round, err
+}
+
+// backupDatabase copies the database file src into the new database file dest.
+func backupDatabase(ctx context.Context, src string, dest string) error {
+	acc, err := db.MakeAccessor(src, true, false)
+	if err != nil {
+		return err
+	}
+	defer acc.Close()
+	return acc.Backup(ctx, dest)
+}
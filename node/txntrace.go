@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-deadlock"
+)
+
+// txnTraceTimeout is how long the trace of a transaction waits for the transaction to be committed,
+// before it is exported as failed.
+const txnTraceTimeout = time.Hour
+
+// txnTraceMaxPending bounds the number of transactions traced at once.
+const txnTraceMaxPending = 10000
+
+// The spans of the trace of a transaction. The root span lasts from the submission of the transaction
+// to its commit, and its children are the stages the transaction went through on the node. The block
+// stages are only traced on the nodes they happen on: a node assembles the blocks it proposes, and
+// validates the ones proposed by others.
+const (
+	txnSpanRoot          = "txn"
+	txnSpanSubmit        = "txn.submit"
+	txnSpanVerify        = "txn.verify"
+	txnSpanPoolAdmission = "pool.admission"
+	txnSpanGossip        = "gossip.broadcast"
+	txnSpanAssembly      = "block.assembly"
+	txnSpanValidation    = "block.validation"
+	txnSpanCommit        = "ledger.commit"
+)
+
+var txnTracesDroppedCounter = metrics.MakeCounter(metrics.MetricName{Name: "algod_txn_traces_dropped_total", Description: "number of submitted transactions not traced as too many traces were pending"})
+
+type txnTrace struct {
+	root  logging.Span
+	spans []logging.Span
+}
+
+// txnTracer traces the lifecycle of the transactions submitted to the node, from their submission to
+// their commit to the ledger. The traces are identified by the first 16 bytes of the transaction IDs,
+// and exported through the telemetry once the transactions are committed, or failed.
+// A nil txnTracer traces nothing.
+type txnTracer struct {
+	log logging.Logger
+
+	mu     deadlock.Mutex
+	traces map[transactions.Txid]*txnTrace
+}
+
+func makeTxnTracer(log logging.Logger) *txnTracer {
+	return &txnTracer{
+		log:    log,
+		traces: make(map[transactions.Txid]*txnTrace),
+	}
+}
+
+func newSpanID() (id [8]byte) {
+	crypto.RandBytes(id[:])
+	return
+}
+
+// begin starts the traces of a transaction group submitted at start, and returns the IDs of the
+// transactions it started tracing. The transactions already traced, as submitted before, keep their trace.
+func (t *txnTracer) begin(txids []transactions.Txid, start time.Time) (traced []transactions.Txid) {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, txid := range txids {
+		if _, has := t.traces[txid]; has {
+			continue
+		}
+		if len(t.traces) >= txnTraceMaxPending {
+			txnTracesDroppedCounter.Inc(nil)
+			continue
+		}
+		root := logging.Span{
+			SpanID: newSpanID(),
+			Name:   txnSpanRoot,
+			Start:  start,
+			Attributes: logging.Fields{
+				"algorand.txid":       txid.String(),
+				"algorand.group_size": len(txids),
+			},
+		}
+		copy(root.TraceID[:], txid[:])
+		t.traces[txid] = &txnTrace{root: root}
+		traced = append(traced, txid)
+	}
+	return traced
+}
+
+// span records a stage of the traced transactions among txids, which failed if err is set.
+func (t *txnTracer) span(txids []transactions.Txid, name string, start time.Time, end time.Time, attributes logging.Fields, err error) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, txid := range txids {
+		trace, has := t.traces[txid]
+		if !has {
+			continue
+		}
+		span := logging.Span{
+			TraceID:    trace.root.TraceID,
+			SpanID:     newSpanID(),
+			ParentID:   trace.root.SpanID,
+			Name:       name,
+			Start:      start,
+			End:        end,
+			Attributes: attributes,
+		}
+		if err != nil {
+			span.Error = err.Error()
+		}
+		trace.spans = append(trace.spans, span)
+	}
+}
+
+// fail ends the traces of the transactions among txids, which failed for err, and exports them.
+func (t *txnTracer) fail(txids []transactions.Txid, end time.Time, err error) {
+	if t == nil {
+		return
+	}
+
+	var spans []logging.Span
+	t.mu.Lock()
+	for _, txid := range txids {
+		trace, has := t.traces[txid]
+		if !has {
+			continue
+		}
+		trace.root.End = end
+		trace.root.Error = err.Error()
+		spans = append(spans, trace.root)
+		spans = append(spans, trace.spans...)
+		delete(t.traces, txid)
+	}
+	t.mu.Unlock()
+
+	if len(spans) > 0 {
+		t.log.ExportSpans(spans)
+	}
+}
+
+// blockTxids returns the IDs of the traced transactions of a block, if any.
+func (t *txnTracer) blockTxids(blk bookkeeping.Block) []transactions.Txid {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	pending := len(t.traces)
+	t.mu.Unlock()
+	if pending == 0 {
+		// spare decoding the payset
+		return nil
+	}
+
+	payset, err := blk.DecodePaysetFlat()
+	if err != nil {
+		t.log.Warnf("txnTracer: unable to decode the payset of block %d: %v", blk.Round(), err)
+		return nil
+	}
+	txids := make([]transactions.Txid, len(payset))
+	for i := range payset {
+		txids[i] = payset[i].ID()
+	}
+	return txids
+}
+
+// blockSpan records a stage of the block blk for its traced transactions.
+func (t *txnTracer) blockSpan(blk bookkeeping.Block, name string, start time.Time, end time.Time, err error) {
+	txids := t.blockTxids(blk)
+	if len(txids) == 0 {
+		return
+	}
+	t.span(txids, name, start, end, logging.Fields{logging.RoundField: uint64(blk.Round())}, err)
+}
+
+// commit records the commit of the block blk to the ledger, and exports the traces of its transactions.
+// The traces that waited for longer than txnTraceTimeout are exported as failed.
+func (t *txnTracer) commit(blk bookkeeping.Block, start time.Time, end time.Time) {
+	if t == nil {
+		return
+	}
+	txids := t.blockTxids(blk)
+	t.span(txids, txnSpanCommit, start, end, logging.Fields{logging.RoundField: uint64(blk.Round())}, nil)
+
+	var spans []logging.Span
+	t.mu.Lock()
+	for _, txid := range txids {
+		trace, has := t.traces[txid]
+		if !has {
+			continue
+		}
+		trace.root.End = end
+		trace.root.Attributes[logging.RoundField] = uint64(blk.Round())
+		spans = append(spans, trace.root)
+		spans = append(spans, trace.spans...)
+		delete(t.traces, txid)
+	}
+	for txid, trace := range t.traces {
+		if end.Sub(trace.root.Start) < txnTraceTimeout {
+			continue
+		}
+		trace.root.End = end
+		trace.root.Error = "the transaction was not committed in time"
+		spans = append(spans, trace.root)
+		spans = append(spans, trace.spans...)
+		delete(t.traces, txid)
+	}
+	t.mu.Unlock()
+
+	if len(spans) > 0 {
+		t.log.ExportSpans(spans)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-deadlock"
+)
+
+// spanRecorder records the spans exported through it.
+type spanRecorder struct {
+	logging.Logger
+
+	mu    deadlock.Mutex
+	spans []logging.Span
+}
+
+func (r *spanRecorder) ExportSpans(spans []logging.Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, spans...)
+}
+
+func (r *spanRecorder) exported() []logging.Span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	spans := r.spans
+	r.spans = nil
+	return spans
+}
+
+func makeTracedTxn(t *testing.T, hdr bookkeeping.BlockHeader, note byte) (transactions.Txid, transactions.SignedTxnInBlock) {
+	stxn := transactions.SignedTxn{Txn: transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			FirstValid:  1,
+			LastValid:   100,
+			Note:        []byte{note},
+			GenesisID:   hdr.GenesisID,
+			GenesisHash: hdr.GenesisHash,
+		},
+	}}
+	stib, err := hdr.EncodeSignedTxn(stxn, transactions.ApplyData{})
+	require.NoError(t, err)
+	return stxn.ID(), stib
+}
+
+func TestTxnTracerCommit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	recorder := &spanRecorder{Logger: logging.TestingLog(t)}
+	tracer := makeTxnTracer(recorder)
+
+	blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+		Round:        7,
+		GenesisID:    "test",
+		GenesisHash:  [32]byte{1},
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusCurrentVersion},
+	}}
+	traced, stib := makeTracedTxn(t, blk.BlockHeader, 1)
+	untraced, stib2 := makeTracedTxn(t, blk.BlockHeader, 2)
+	blk.Payset = transactions.Payset{stib, stib2}
+
+	start := time.Now()
+	require.Equal(t, []transactions.Txid{traced}, tracer.begin([]transactions.Txid{traced}, start))
+	// resubmitting keeps the first trace
+	require.Empty(t, tracer.begin([]transactions.Txid{traced}, start))
+
+	tracer.span([]transactions.Txid{traced, untraced}, txnSpanPoolAdmission, start, start.Add(time.Millisecond), nil, nil)
+	tracer.blockSpan(blk, txnSpanValidation, start, start.Add(2*time.Millisecond), nil)
+	require.Empty(t, recorder.exported())
+
+	end := start.Add(time.Second)
+	tracer.commit(blk, start.Add(3*time.Millisecond), end)
+	spans := recorder.exported()
+	require.Len(t, spans, 4)
+
+	root := spans[0]
+	require.Equal(t, txnSpanRoot, root.Name)
+	require.Equal(t, traced[:16], root.TraceID[:])
+	require.Equal(t, end, root.End)
+	require.Empty(t, root.Error)
+	require.Equal(t, traced.String(), root.Attributes["algorand.txid"])
+	require.Equal(t, uint64(7), root.Attributes[logging.RoundField])
+
+	var names []string
+	for _, span := range spans[1:] {
+		require.Equal(t, root.TraceID, span.TraceID)
+		require.Equal(t, root.SpanID, span.ParentID)
+		require.NotEqual(t, root.SpanID, span.SpanID)
+		names = append(names, span.Name)
+	}
+	require.Equal(t, []string{txnSpanPoolAdmission, txnSpanValidation, txnSpanCommit}, names)
+
+	// the trace is done with
+	tracer.commit(blk, end, end)
+	require.Empty(t, recorder.exported())
+	require.Empty(t, tracer.traces)
+}
+
+func TestTxnTracerFailures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	recorder := &spanRecorder{Logger: logging.TestingLog(t)}
+	tracer := makeTxnTracer(recorder)
+
+	rejected := transactions.Txid{1}
+	expired := transactions.Txid{2}
+	start := time.Now()
+	tracer.begin([]transactions.Txid{rejected}, start)
+	tracer.begin([]transactions.Txid{expired}, start.Add(-txnTraceTimeout))
+
+	err := errors.New("rejected by the pool")
+	tracer.span([]transactions.Txid{rejected}, txnSpanPoolAdmission, start, start, nil, err)
+	tracer.fail([]transactions.Txid{rejected}, start, err)
+	spans := recorder.exported()
+	require.Len(t, spans, 2)
+	require.Equal(t, err.Error(), spans[0].Error)
+	require.Equal(t, err.Error(), spans[1].Error)
+
+	// the traces waiting for too long are exported as failed on the next commit
+	tracer.commit(bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: basics.Round(1)}}, start, start)
+	spans = recorder.exported()
+	require.Len(t, spans, 1)
+	require.Equal(t, expired[:16], spans[0].TraceID[:])
+	require.NotEmpty(t, spans[0].Error)
+	require.Empty(t, tracer.traces)
+
+	// a nil tracer traces nothing
+	var disabled *txnTracer
+	require.Nil(t, disabled.begin([]transactions.Txid{rejected}, start))
+	disabled.span([]transactions.Txid{rejected}, txnSpanSubmit, start, start, nil, nil)
+	disabled.commit(bookkeeping.Block{}, start, start)
+}
@@ -0,0 +1,216 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/network"
+)
+
+// watchdogInterval is how often the watchdog checks the state of the node.
+const watchdogInterval = time.Second
+
+// watchdogSaturationChecks is the number of consecutive checks finding the vote verification queue full after
+// which it is considered saturated, as it may be full for an instant under a burst of votes.
+const watchdogSaturationChecks = 3
+
+// watchdogSample is the state of the node the watchdog checks.
+type watchdogSample struct {
+	now time.Time
+	// round is the last round added to the ledger, at roundTime, which is zero when no round was added since startup.
+	round      basics.Round
+	roundTime  time.Time
+	catchingUp bool
+	committed  basics.Round
+
+	voteQueueLength   int
+	voteQueueCapacity int
+	txPoolCount       int
+	outgoingPeers     int
+	incomingPeers     int
+}
+
+func (s watchdogSample) snapshot(since time.Time) telemetryspec.WatchdogSnapshot {
+	return telemetryspec.WatchdogSnapshot{
+		Round:              uint64(s.round),
+		TimeSinceLastRound: s.now.Sub(since),
+		CatchingUp:         s.catchingUp,
+		CommittedRound:     uint64(s.committed),
+		VoteQueueLength:    s.voteQueueLength,
+		VoteQueueCapacity:  s.voteQueueCapacity,
+		TxPoolCount:        s.txPoolCount,
+		OutgoingPeers:      s.outgoingPeers,
+		IncomingPeers:      s.incomingPeers,
+	}
+}
+
+// watchdog detects abnormal conditions of the consensus and the ledger: slow rounds, ledger stalls, a growing
+// backlog of rounds to commit and the saturation of the vote verification queue. It emits a distinct telemetry
+// event for each, once per episode, along with a snapshot of the state of the node.
+type watchdog struct {
+	cfg   config.Local
+	log   logging.Logger
+	start time.Time
+
+	round     basics.Round
+	roundTime time.Time
+	stalled   bool
+
+	backlog    uint64
+	backlogged bool
+
+	fullChecks int
+	fullSince  time.Time
+}
+
+func makeWatchdog(cfg config.Local, log logging.Logger, start time.Time) *watchdog {
+	return &watchdog{cfg: cfg, log: log, start: start}
+}
+
+// check updates the watchdog from a sample of the state of the node, emitting the events of the abnormal conditions
+// it detects.
+func (w *watchdog) check(s watchdogSample) {
+	// a node which has not added any round since startup is stalled since it started
+	lastRoundTime := s.roundTime
+	if lastRoundTime.IsZero() {
+		lastRoundTime = w.start
+	}
+
+	if s.round != w.round {
+		threshold := w.cfg.WatchdogSlowRoundThreshold
+		if threshold > 0 && s.round == w.round+1 && !w.roundTime.IsZero() && !s.roundTime.IsZero() && !s.catchingUp {
+			if duration := s.roundTime.Sub(w.roundTime); duration > threshold {
+				w.log.Warnf("watchdog: round %d took %v to be added to the ledger", s.round, duration)
+				w.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.SlowRoundEvent, telemetryspec.SlowRoundEventDetails{
+					Round:     uint64(s.round),
+					Duration:  duration,
+					Threshold: threshold,
+					Snapshot:  s.snapshot(lastRoundTime),
+				})
+			}
+		}
+		w.round = s.round
+		w.roundTime = s.roundTime
+		w.stalled = false
+	} else if threshold := w.cfg.WatchdogStallThreshold; threshold > 0 && !w.stalled && !s.catchingUp {
+		if duration := s.now.Sub(lastRoundTime); duration > threshold {
+			w.log.Warnf("watchdog: no round added to the ledger for %v since round %d", duration, s.round)
+			w.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.LedgerStallEvent, telemetryspec.LedgerStallEventDetails{
+				Round:     uint64(s.round),
+				Duration:  duration,
+				Threshold: threshold,
+				Snapshot:  s.snapshot(lastRoundTime),
+			})
+			w.stalled = true
+		}
+	}
+
+	var backlog uint64
+	if s.round > s.committed {
+		backlog = uint64(s.round - s.committed)
+	}
+	if threshold := w.cfg.WatchdogCommitBacklogThreshold; threshold > 0 {
+		if !w.backlogged && backlog > threshold && backlog > w.backlog {
+			w.log.Warnf("watchdog: %d rounds added to the ledger are not committed to its database", backlog)
+			w.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.CommitBacklogEvent, telemetryspec.CommitBacklogEventDetails{
+				Backlog:   backlog,
+				Threshold: threshold,
+				Snapshot:  s.snapshot(lastRoundTime),
+			})
+			w.backlogged = true
+		} else if w.backlogged && backlog <= threshold {
+			w.backlogged = false
+		}
+	}
+	w.backlog = backlog
+
+	if s.voteQueueCapacity == 0 || s.voteQueueLength < s.voteQueueCapacity {
+		w.fullChecks = 0
+		return
+	}
+	if w.fullChecks == 0 {
+		w.fullSince = s.now
+	}
+	w.fullChecks++
+	if w.fullChecks == watchdogSaturationChecks {
+		duration := s.now.Sub(w.fullSince)
+		w.log.Warnf("watchdog: the vote verification queue has been full for %v", duration)
+		w.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.VoteVerificationSaturatedEvent, telemetryspec.VoteVerificationSaturatedEventDetails{
+			Duration: duration,
+			Snapshot: s.snapshot(lastRoundTime),
+		})
+	}
+}
+
+// progressing returns whether rounds were added to the ledger and committed to its database as expected at the
+// last check, that is whether the ledger is neither stalled nor backlogged.
+func (w *watchdog) progressing() bool {
+	return !w.stalled && !w.backlogged
+}
+
+// Progressing returns whether the agreement adds rounds to the ledger and the ledger commits them to its database,
+// as last checked by the watchdog: it is false while no round was added for WatchdogStallThreshold, or while more
+// than WatchdogCommitBacklogThreshold rounds wait to be committed.
+func (node *AlgorandFullNode) Progressing() bool {
+	return !node.notProgressing.Load()
+}
+
+// watchdogSample samples the state of the node, returning false when its status is unavailable.
+func (node *AlgorandFullNode) watchdogSample() (watchdogSample, bool) {
+	status, err := node.Status()
+	if err != nil {
+		return watchdogSample{}, false
+	}
+	committed, _ := node.ledger.LatestCommitted()
+	s := watchdogSample{
+		now:           time.Now(),
+		round:         status.LastRound,
+		roundTime:     status.LastRoundTimestamp,
+		catchingUp:    status.CatchupTime > 0 || status.Catchpoint != "",
+		committed:     committed,
+		txPoolCount:   node.transactionPool.PendingCount(),
+		outgoingPeers: len(node.net.GetPeers(network.PeersConnectedOut)),
+		incomingPeers: len(node.net.GetPeers(network.PeersConnectedIn)),
+	}
+	// the agreement verifies the votes and proposals it receives on the high priority pool
+	s.voteQueueLength, s.voteQueueCapacity = node.highPriorityCryptoVerificationPool.BufferSize()
+	return s, true
+}
+
+// watchdogThread checks the state of the node for abnormal conditions until done is closed.
+func (node *AlgorandFullNode) watchdogThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	w := makeWatchdog(node.config, node.log, time.Now())
+	ticker := time.NewTicker(watchdogInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if s, ok := node.watchdogSample(); ok {
+				w.check(s)
+				node.notProgressing.Store(!w.progressing())
+			}
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type watchdogEventsLogger struct {
+	logging.Logger
+	events  []telemetryspec.Event
+	details []interface{}
+}
+
+func (l *watchdogEventsLogger) EventWithDetails(category telemetryspec.Category, identifier telemetryspec.Event, details interface{}) {
+	l.events = append(l.events, identifier)
+	l.details = append(l.details, details)
+}
+
+func makeTestWatchdog(t *testing.T) (*watchdog, *watchdogEventsLogger, time.Time) {
+	cfg := config.GetDefaultLocal()
+	cfg.WatchdogSlowRoundThreshold = 10 * time.Second
+	cfg.WatchdogStallThreshold = time.Minute
+	cfg.WatchdogCommitBacklogThreshold = 100
+	log := &watchdogEventsLogger{Logger: logging.TestingLog(t)}
+	start := time.Unix(1000, 0)
+	return makeWatchdog(cfg, log, start), log, start
+}
+
+func TestWatchdogSlowRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w, log, start := makeTestWatchdog(t)
+	sample := func(round int, roundTime time.Duration) watchdogSample {
+		return watchdogSample{now: start.Add(roundTime), round: basics.Round(round), roundTime: start.Add(roundTime), committed: basics.Round(round)}
+	}
+
+	w.check(sample(10, 0))
+	w.check(sample(11, 3*time.Second))
+	require.Empty(t, log.events)
+	w.check(sample(12, 15*time.Second))
+	require.Equal(t, []telemetryspec.Event{telemetryspec.SlowRoundEvent}, log.events)
+	details := log.details[0].(telemetryspec.SlowRoundEventDetails)
+	require.Equal(t, uint64(12), details.Round)
+	require.Equal(t, 12*time.Second, details.Duration)
+	require.Equal(t, 10*time.Second, details.Threshold)
+	require.Equal(t, uint64(12), details.Snapshot.Round)
+
+	// rounds caught up at once are not timed
+	w.check(sample(20, time.Minute))
+	// nor are rounds added while catching up
+	s := sample(21, 2*time.Minute)
+	s.catchingUp = true
+	w.check(s)
+	require.Len(t, log.events, 1)
+}
+
+func TestWatchdogLedgerStall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w, log, start := makeTestWatchdog(t)
+	s := watchdogSample{now: start, round: 10, roundTime: start, committed: 10}
+	w.check(s)
+	s.now = start.Add(30 * time.Second)
+	w.check(s)
+	require.Empty(t, log.events)
+	require.True(t, w.progressing())
+
+	s.now = start.Add(2 * time.Minute)
+	w.check(s)
+	s.now = start.Add(3 * time.Minute)
+	w.check(s)
+	require.Equal(t, []telemetryspec.Event{telemetryspec.LedgerStallEvent}, log.events)
+	require.False(t, w.progressing())
+	details := log.details[0].(telemetryspec.LedgerStallEventDetails)
+	require.Equal(t, uint64(10), details.Round)
+	require.Equal(t, 2*time.Minute, details.Duration)
+	require.Equal(t, 2*time.Minute, details.Snapshot.TimeSinceLastRound)
+
+	// a new round ends the stall, and the next one is reported again
+	s = watchdogSample{now: start.Add(4 * time.Minute), round: 11, roundTime: start.Add(4 * time.Minute), committed: 11}
+	w.check(s)
+	require.True(t, w.progressing())
+	s.now = start.Add(6 * time.Minute)
+	w.check(s)
+	require.Equal(t, []telemetryspec.Event{telemetryspec.LedgerStallEvent, telemetryspec.LedgerStallEvent}, log.events)
+}
+
+func TestWatchdogStallSinceStartup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w, log, start := makeTestWatchdog(t)
+	w.check(watchdogSample{now: start.Add(30 * time.Second), round: 10, committed: 10})
+	w.check(watchdogSample{now: start.Add(2 * time.Minute), round: 10, committed: 10, catchingUp: true})
+	require.Empty(t, log.events)
+	w.check(watchdogSample{now: start.Add(2 * time.Minute), round: 10, committed: 10})
+	require.Equal(t, []telemetryspec.Event{telemetryspec.LedgerStallEvent}, log.events)
+}
+
+func TestWatchdogCommitBacklog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w, log, start := makeTestWatchdog(t)
+	backlog := func(round, committed int) {
+		w.check(watchdogSample{now: start, round: basics.Round(round), roundTime: start, committed: basics.Round(committed)})
+	}
+
+	backlog(100, 50)
+	require.Empty(t, log.events)
+	backlog(200, 50)
+	backlog(201, 50)
+	require.Equal(t, []telemetryspec.Event{telemetryspec.CommitBacklogEvent}, log.events)
+	require.Equal(t, uint64(150), log.details[0].(telemetryspec.CommitBacklogEventDetails).Backlog)
+	require.False(t, w.progressing())
+
+	// reported again only once the backlog fell below the threshold
+	backlog(202, 200)
+	require.True(t, w.progressing())
+	backlog(400, 200)
+	require.Len(t, log.events, 2)
+}
+
+func TestWatchdogVoteVerificationSaturated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w, log, start := makeTestWatchdog(t)
+	queue := func(at time.Duration, length int) {
+		w.check(watchdogSample{now: start.Add(at), round: 10, roundTime: start.Add(at), committed: 10, voteQueueLength: length, voteQueueCapacity: 16})
+	}
+
+	queue(0, 16)
+	queue(time.Second, 16)
+	queue(2*time.Second, 8)
+	queue(3*time.Second, 16)
+	queue(4*time.Second, 16)
+	require.Empty(t, log.events)
+	queue(5*time.Second, 16)
+	queue(6*time.Second, 16)
+	require.Equal(t, []telemetryspec.Event{telemetryspec.VoteVerificationSaturatedEvent}, log.events)
+	details := log.details[0].(telemetryspec.VoteVerificationSaturatedEventDetails)
+	require.Equal(t, 2*time.Second, details.Duration)
+	require.Equal(t, 16, details.Snapshot.VoteQueueLength)
+}
+
+func TestWatchdogDisabledChecks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	w, log, start := makeTestWatchdog(t)
+	w.cfg.WatchdogSlowRoundThreshold = 0
+	w.cfg.WatchdogStallThreshold = 0
+	w.cfg.WatchdogCommitBacklogThreshold = 0
+	w.check(watchdogSample{now: start, round: 10, roundTime: start, committed: 10})
+	w.check(watchdogSample{now: start.Add(time.Minute), round: 11, roundTime: start.Add(time.Minute), committed: 10})
+	w.check(watchdogSample{now: start.Add(time.Hour), round: 1000, roundTime: start.Add(time.Minute), committed: 10})
+	w.check(watchdogSample{now: start.Add(2 * time.Hour), round: 1000, roundTime: start.Add(time.Minute), committed: 10})
+	require.Empty(t, log.events)
+}
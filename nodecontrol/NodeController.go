@@ -32,6 +32,7 @@ type NodeController struct {
 	algodPidFile       string
 	algodNetFile       string
 	algodNetListenFile string
+	algodAdminNetFile  string
 
 	KMDController
 }
@@ -46,6 +47,7 @@ func MakeNodeController(binDir, algodDataDir string) NodeController {
 		algodPidFile:       filepath.Join(algodDataDir, "algod.pid"),
 		algodNetFile:       filepath.Join(algodDataDir, "algod.net"),
 		algodNetListenFile: filepath.Join(algodDataDir, "algod-listen.net"),
+		algodAdminNetFile:  filepath.Join(algodDataDir, "algod-admin.net"),
 	}
 	nc.SetKMDBinDir(binDir)
 	return nc
@@ -61,8 +61,10 @@ func (e *MissingDataDirError) Error() string {
 // AlgodClient attempts to build a client.RestClient for communication with
 // the algod REST API, but fails if we can't find the net file
 func (nc NodeController) AlgodClient() (algodClient client.RestClient, err error) {
+	admin := true
 	algodAPIToken, err := tokens.GetAndValidateAPIToken(nc.algodDataDir, tokens.AlgodAdminTokenFilename)
 	if err != nil {
+		admin = false
 		algodAPIToken, err = tokens.GetAndValidateAPIToken(nc.algodDataDir, tokens.AlgodTokenFilename)
 		if err != nil {
 			return
@@ -75,6 +77,13 @@ func (nc NodeController) AlgodClient() (algodClient client.RestClient, err error
 		return
 	}
 
+	// With the admin token, prefer the admin listener, which serves every endpoint
+	if admin {
+		if adminURL, adminErr := nc.AdminServerURL(); adminErr == nil {
+			algodURL = adminURL
+		}
+	}
+
 	// Build the client from the URL and API token
 	algodClient = client.MakeRestClient(algodURL, algodAPIToken)
 	return
@@ -96,6 +105,27 @@ func (nc NodeController) ServerURL() (url.URL, error) {
 	return url.URL{Scheme: "http", Host: addr}, nil
 }
 
+// AdminServerURL returns the URL of the admin listener of the node under control, from its
+// algod-admin.net file. The file only exists when algod serves the admin endpoints on a dedicated listener,
+// and holds an https URL when the listener serves TLS.
+func (nc NodeController) AdminServerURL() (url.URL, error) {
+	if len(nc.algodDataDir) == 0 {
+		return url.URL{}, os.ErrNotExist
+	}
+	addr, err := util.GetFirstLineFromFile(nc.algodAdminNetFile)
+	if err != nil {
+		return url.URL{}, err
+	}
+	if strings.HasPrefix(addr, "https:") {
+		u, err := url.Parse(addr)
+		if err != nil {
+			return url.URL{}, err
+		}
+		return *u, nil
+	}
+	return url.URL{Scheme: "http", Host: addr}, nil
+}
+
 // GetHostAddress retrieves the REST address for the node from its algod.net file.
 func (nc NodeController) GetHostAddress() (string, error) {
 	// For now, we want the old behavior to 'just work';
@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package nodecontrol
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/util"
+)
+
+// RestoreSnapshot restores the snapshot of a data directory, taken with goal node snapshot create, into the data
+// directory of the controller, which may not exist yet. The node must be stopped. The configuration files of the
+// snapshot are only copied when the data directory has none, so that a snapshot can be restored into a data
+// directory configured differently. A data directory which already has a ledger is only restored into when
+// overwrite is set, in which case its ledger and agreement databases are removed first. A snapshot taken with the
+// participation keys restores them as well, and the caller should warn that the restored node must not run
+// alongside the original one.
+func (nc NodeController) RestoreSnapshot(snapshotDir string, overwrite bool) (config.SnapshotManifest, error) {
+	manifest, err := config.LoadSnapshotManifest(snapshotDir)
+	if err != nil {
+		return manifest, fmt.Errorf("'%s' is not a complete snapshot: %w", snapshotDir, err)
+	}
+	if nc.algodRunning() {
+		return manifest, fmt.Errorf("the node of '%s' must be stopped to restore a snapshot into it", nc.algodDataDir)
+	}
+
+	if util.FileExists(filepath.Join(nc.algodDataDir, config.GenesisJSONFile)) {
+		genesis, err := nc.GetGenesis()
+		if err != nil {
+			return manifest, err
+		}
+		if genesis.ID() != manifest.GenesisID {
+			return manifest, fmt.Errorf("the snapshot of %s cannot be restored into the data directory of %s", manifest.GenesisID, genesis.ID())
+		}
+	}
+
+	genesisDir := filepath.Join(nc.algodDataDir, manifest.GenesisID)
+	err = os.MkdirAll(genesisDir, 0700)
+	if err != nil {
+		return manifest, err
+	}
+	entries, err := os.ReadDir(genesisDir)
+	if err != nil {
+		return manifest, err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasPrefix(name, config.LedgerFilenamePrefix+".") || strings.HasPrefix(name, config.CrashFilename)) {
+			continue
+		}
+		if !overwrite {
+			return manifest, fmt.Errorf("the data directory '%s' already has a ledger", nc.algodDataDir)
+		}
+		// the agreement state of the node is removed along with its ledger, as it may be ahead of the snapshot
+		err = os.Remove(filepath.Join(genesisDir, name))
+		if err != nil {
+			return manifest, err
+		}
+	}
+
+	snapshotGenesisDir := filepath.Join(snapshotDir, manifest.GenesisID)
+	entries, err = os.ReadDir(snapshotGenesisDir)
+	if err != nil {
+		return manifest, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		dest := filepath.Join(genesisDir, entry.Name())
+		// the journals of a database replaced by the one of the snapshot would be replayed into it
+		for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+			err = os.Remove(dest + suffix)
+			if err != nil && !os.IsNotExist(err) {
+				return manifest, err
+			}
+		}
+		_, err = util.CopyFile(filepath.Join(snapshotGenesisDir, entry.Name()), dest)
+		if err != nil {
+			return manifest, fmt.Errorf("unable to restore '%s' : %w", entry.Name(), err)
+		}
+	}
+
+	entries, err = os.ReadDir(snapshotDir)
+	if err != nil {
+		return manifest, err
+	}
+	for _, entry := range entries {
+		dest := filepath.Join(nc.algodDataDir, entry.Name())
+		if entry.IsDir() || entry.Name() == config.SnapshotManifestFilename || util.FileExists(dest) {
+			continue
+		}
+		_, err = util.CopyFile(filepath.Join(snapshotDir, entry.Name()), dest)
+		if err != nil {
+			return manifest, fmt.Errorf("unable to restore '%s' : %w", entry.Name(), err)
+		}
+	}
+	return manifest, nil
+}
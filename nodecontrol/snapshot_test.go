@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package nodecontrol
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRestoreSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	writeFile := func(path string, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	readFile := func(path string) string {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	snapshotDir := filepath.Join(t.TempDir(), "snapshot")
+	writeFile(filepath.Join(snapshotDir, "test-v1", "ledger.block.sqlite"), "blocks")
+	writeFile(filepath.Join(snapshotDir, "test-v1", "ledger.tracker.sqlite"), "trackers")
+	writeFile(filepath.Join(snapshotDir, config.ConfigFilename), "snapshot config")
+
+	dataDir := filepath.Join(t.TempDir(), "data")
+	nc := MakeNodeController("", dataDir)
+	_, err := nc.RestoreSnapshot(snapshotDir, false)
+	require.ErrorContains(t, err, "not a complete snapshot")
+
+	require.NoError(t, config.SnapshotManifest{GenesisID: "test-v1", Round: 10}.SaveToDisk(snapshotDir))
+	manifest, err := nc.RestoreSnapshot(snapshotDir, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), manifest.Round)
+	require.Equal(t, "blocks", readFile(filepath.Join(dataDir, "test-v1", "ledger.block.sqlite")))
+	require.Equal(t, "trackers", readFile(filepath.Join(dataDir, "test-v1", "ledger.tracker.sqlite")))
+	require.Equal(t, "snapshot config", readFile(filepath.Join(dataDir, config.ConfigFilename)))
+	require.NoFileExists(t, filepath.Join(dataDir, config.SnapshotManifestFilename))
+
+	// the ledger of the data directory is only replaced when overwriting it, and its configuration is kept
+	writeFile(filepath.Join(dataDir, config.ConfigFilename), "node config")
+	writeFile(filepath.Join(dataDir, "test-v1", "ledger.block.sqlite-wal"), "journal")
+	writeFile(filepath.Join(dataDir, "test-v1", config.CrashFilename), "agreement")
+	_, err = nc.RestoreSnapshot(snapshotDir, false)
+	require.ErrorContains(t, err, "already has a ledger")
+	require.FileExists(t, filepath.Join(dataDir, "test-v1", config.CrashFilename))
+
+	_, err = nc.RestoreSnapshot(snapshotDir, true)
+	require.NoError(t, err)
+	require.Equal(t, "blocks", readFile(filepath.Join(dataDir, "test-v1", "ledger.block.sqlite")))
+	require.NoFileExists(t, filepath.Join(dataDir, "test-v1", "ledger.block.sqlite-wal"))
+	require.NoFileExists(t, filepath.Join(dataDir, "test-v1", config.CrashFilename))
+	require.Equal(t, "node config", readFile(filepath.Join(dataDir, config.ConfigFilename)))
+}
@@ -0,0 +1,663 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// The checks below work on the encoding alone, without the types of the
+// objects, so they enforce the rules of the canonical encoding produced by
+// CodecHandle and the msgp generated code:
+//   - integers, lengths and sizes take their shortest encoding, and
+//     non-negative integers are encoded as unsigned integers,
+//   - map keys are sorted, without duplicates,
+//   - fields with zero values are omitted. The encoding does not tell structs
+//     from maps, so a zero value under any map key is reported.
+//   - floats and extension types are not used.
+
+// msgpMaxDepth bounds the nesting of the objects parsed
+const msgpMaxDepth = 1024
+
+var errMsgpTruncated = errors.New("truncated object")
+var errMsgpTooDeep = errors.New("object nested too deep")
+
+// CanonicalError locates the first encoding of a sequence of objects which is
+// not canonical: the index of the object holding it, the offset of its first
+// byte in the sequence, and the path of the field holding it in the object.
+type CanonicalError struct {
+	Object int
+	Offset int
+	Path   string
+	Reason string
+}
+
+func (e *CanonicalError) Error() string {
+	path := e.Path
+	if path == "" {
+		path = "<top>"
+	}
+	return fmt.Sprintf("non-canonical encoding of object %d at byte %d (%s): %s", e.Object, e.Offset, path, e.Reason)
+}
+
+// CheckCanonical checks that b holds one or more msgpack encoded objects in
+// their canonical encoding, as found in transaction files. It returns a
+// *CanonicalError for the first non-canonical encoding, or another error if
+// b is not valid msgpack.
+func CheckCanonical(b []byte) error {
+	objs, err := parseMsgpObjects(b)
+	if err != nil {
+		return err
+	}
+	for i := range objs {
+		err = checkMsgp(b, &objs[i], "")
+		if err != nil {
+			err.(*CanonicalError).Object = i
+			return err
+		}
+	}
+	return nil
+}
+
+// Canonicalize re-encodes the msgpack encoded objects in b canonically,
+// dropping the map entries with zero values. Floats and extension types
+// have no canonical encoding and are rejected.
+func Canonicalize(b []byte) ([]byte, error) {
+	objs, err := parseMsgpObjects(b)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, len(b))
+	for i := range objs {
+		out, err = appendCanonicalMsgp(out, &objs[i], "")
+		if err != nil {
+			err.(*CanonicalError).Object = i
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// DiffEncodings returns the offset of the first byte at which the encodings
+// a and b differ, and the path of the field of a holding it, or -1 if they
+// are identical. The path is empty when the byte is past the end of a, or
+// when a is not valid msgpack.
+func DiffEncodings(a, b []byte) (offset int, path string) {
+	offset = 0
+	for offset < len(a) && offset < len(b) && a[offset] == b[offset] {
+		offset++
+	}
+	if offset == len(a) && offset == len(b) {
+		return -1, ""
+	}
+
+	objs, err := parseMsgpObjects(a)
+	if err != nil {
+		return offset, ""
+	}
+	for i := range objs {
+		if offset >= objs[i].start && offset < objs[i].end {
+			return offset, msgpPathAt(&objs[i], offset, "")
+		}
+	}
+	return offset, ""
+}
+
+type msgpKind int
+
+const (
+	msgpNil msgpKind = iota
+	msgpBool
+	msgpUint
+	msgpInt
+	msgpFloat
+	msgpStr
+	msgpBin
+	msgpArray
+	msgpMap
+	msgpExt
+)
+
+// msgpValue is a parsed msgpack value. The head of a value is its type byte
+// along with its length, size or inline integer.
+type msgpValue struct {
+	kind  msgpKind
+	start int
+	head  int
+	end   int
+
+	b    bool
+	u    uint64
+	i    int64
+	data []byte
+	// The elements of arrays, or the keys and values of maps, alternately
+	elems []msgpValue
+}
+
+func parseMsgpObjects(b []byte) (objs []msgpValue, err error) {
+	for off := 0; off < len(b); {
+		var v msgpValue
+		v, err = parseMsgp(b, off, 0)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, v)
+		off = v.end
+	}
+	return objs, nil
+}
+
+func parseMsgp(b []byte, off int, depth int) (v msgpValue, err error) {
+	if depth > msgpMaxDepth {
+		return v, fmt.Errorf("malformed msgpack at byte %d: %w", off, errMsgpTooDeep)
+	}
+	if off >= len(b) {
+		return v, fmt.Errorf("malformed msgpack at byte %d: %w", off, errMsgpTruncated)
+	}
+
+	v.start = off
+	// need returns the n bytes of the head after the type byte
+	need := func(n int) ([]byte, error) {
+		if len(b)-off-1 < n {
+			return nil, fmt.Errorf("malformed msgpack at byte %d: %w", v.start, errMsgpTruncated)
+		}
+		return b[off+1 : off+1+n], nil
+	}
+	// length reads a length of n bytes following the type byte
+	length := func(n int) (int, error) {
+		buf, err := need(n)
+		if err != nil {
+			return 0, err
+		}
+		switch n {
+		case 1:
+			return int(buf[0]), nil
+		case 2:
+			return int(binary.BigEndian.Uint16(buf)), nil
+		default:
+			return int(binary.BigEndian.Uint32(buf)), nil
+		}
+	}
+
+	t := b[off]
+	var n, hdr int
+	switch {
+	case t <= 0x7f:
+		v.kind, v.u, hdr = msgpUint, uint64(t), 0
+	case t >= 0xe0:
+		v.kind, v.i, hdr = msgpInt, int64(int8(t)), 0
+	case t >= 0x80 && t <= 0x8f:
+		v.kind, n, hdr = msgpMap, int(t&0x0f), 0
+	case t >= 0x90 && t <= 0x9f:
+		v.kind, n, hdr = msgpArray, int(t&0x0f), 0
+	case t >= 0xa0 && t <= 0xbf:
+		v.kind, n, hdr = msgpStr, int(t&0x1f), 0
+	case t == 0xc0:
+		v.kind = msgpNil
+	case t == 0xc2 || t == 0xc3:
+		v.kind, v.b = msgpBool, t == 0xc3
+	case t >= 0xc4 && t <= 0xc6:
+		hdr = 1 << (t - 0xc4)
+		v.kind = msgpBin
+		n, err = length(hdr)
+	case t >= 0xc7 && t <= 0xc9:
+		hdr = 1 << (t - 0xc7)
+		v.kind = msgpExt
+		n, err = length(hdr)
+		hdr++ // the type of the extension
+	case t == 0xca || t == 0xcb:
+		v.kind, n = msgpFloat, 4<<(t-0xca)
+	case t >= 0xcc && t <= 0xcf:
+		hdr = 1 << (t - 0xcc)
+		var buf []byte
+		buf, err = need(hdr)
+		if err == nil {
+			v.kind, v.u = msgpUint, msgpReadUint(buf)
+		}
+	case t >= 0xd0 && t <= 0xd3:
+		hdr = 1 << (t - 0xd0)
+		var buf []byte
+		buf, err = need(hdr)
+		if err == nil {
+			v.kind = msgpInt
+			switch hdr {
+			case 1:
+				v.i = int64(int8(buf[0]))
+			case 2:
+				v.i = int64(int16(binary.BigEndian.Uint16(buf)))
+			case 4:
+				v.i = int64(int32(binary.BigEndian.Uint32(buf)))
+			default:
+				v.i = int64(binary.BigEndian.Uint64(buf))
+			}
+		}
+	case t >= 0xd4 && t <= 0xd8:
+		v.kind, n, hdr = msgpExt, 1<<(t-0xd4), 1
+	case t >= 0xd9 && t <= 0xdb:
+		hdr = 1 << (t - 0xd9)
+		v.kind = msgpStr
+		n, err = length(hdr)
+	case t == 0xdc || t == 0xdd:
+		hdr = 2 << (t - 0xdc)
+		v.kind = msgpArray
+		n, err = length(hdr)
+	case t == 0xde || t == 0xdf:
+		hdr = 2 << (t - 0xde)
+		v.kind = msgpMap
+		n, err = length(hdr)
+	default:
+		err = fmt.Errorf("malformed msgpack at byte %d: invalid type 0x%02x", off, t)
+	}
+	if err != nil {
+		return v, err
+	}
+	v.head = off + 1 + hdr
+	if v.head > len(b) {
+		return v, fmt.Errorf("malformed msgpack at byte %d: %w", off, errMsgpTruncated)
+	}
+
+	switch v.kind {
+	case msgpStr, msgpBin, msgpFloat, msgpExt:
+		if len(b)-v.head < n {
+			return v, fmt.Errorf("malformed msgpack at byte %d: %w", off, errMsgpTruncated)
+		}
+		v.data = b[v.head : v.head+n]
+		v.end = v.head + n
+	case msgpArray, msgpMap:
+		count := n
+		if v.kind == msgpMap {
+			count = 2 * n
+		}
+		// Every element takes at least a byte
+		if len(b)-v.head < count {
+			return v, fmt.Errorf("malformed msgpack at byte %d: %w", off, errMsgpTruncated)
+		}
+		v.elems = make([]msgpValue, count)
+		pos := v.head
+		for i := range v.elems {
+			v.elems[i], err = parseMsgp(b, pos, depth+1)
+			if err != nil {
+				return v, err
+			}
+			pos = v.elems[i].end
+		}
+		v.end = pos
+	default:
+		v.end = v.head
+	}
+	return v, nil
+}
+
+func msgpReadUint(buf []byte) uint64 {
+	switch len(buf) {
+	case 1:
+		return uint64(buf[0])
+	case 2:
+		return uint64(binary.BigEndian.Uint16(buf))
+	case 4:
+		return uint64(binary.BigEndian.Uint32(buf))
+	default:
+		return binary.BigEndian.Uint64(buf)
+	}
+}
+
+// appendMsgpHead appends the canonical head of v to buf
+func appendMsgpHead(buf []byte, v *msgpValue) []byte {
+	switch v.kind {
+	case msgpNil:
+		return append(buf, 0xc0)
+	case msgpBool:
+		if v.b {
+			return append(buf, 0xc3)
+		}
+		return append(buf, 0xc2)
+	case msgpUint:
+		return appendMsgpUint(buf, v.u)
+	case msgpInt:
+		if v.i >= 0 {
+			return appendMsgpUint(buf, uint64(v.i))
+		}
+		switch {
+		case v.i >= -32:
+			return append(buf, byte(int8(v.i)))
+		case v.i >= math.MinInt8:
+			return append(buf, 0xd0, byte(int8(v.i)))
+		case v.i >= math.MinInt16:
+			return binary.BigEndian.AppendUint16(append(buf, 0xd1), uint16(int16(v.i)))
+		case v.i >= math.MinInt32:
+			return binary.BigEndian.AppendUint32(append(buf, 0xd2), uint32(int32(v.i)))
+		default:
+			return binary.BigEndian.AppendUint64(append(buf, 0xd3), uint64(v.i))
+		}
+	case msgpStr:
+		return appendMsgpLength(buf, len(v.data), 0xa0, 32, 0xd9)
+	case msgpBin:
+		return appendMsgpLength(buf, len(v.data), 0, 0, 0xc4)
+	case msgpArray:
+		return appendMsgpLength(buf, len(v.elems), 0x90, 16, 0xdc)
+	case msgpMap:
+		return appendMsgpLength(buf, len(v.elems)/2, 0x80, 16, 0xde)
+	}
+	panic(fmt.Sprintf("no canonical head for msgpack kind %d", v.kind))
+}
+
+func appendMsgpUint(buf []byte, u uint64) []byte {
+	switch {
+	case u <= 0x7f:
+		return append(buf, byte(u))
+	case u <= math.MaxUint8:
+		return append(buf, 0xcc, byte(u))
+	case u <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(buf, 0xcd), uint16(u))
+	case u <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(buf, 0xce), uint32(u))
+	default:
+		return binary.BigEndian.AppendUint64(append(buf, 0xcf), u)
+	}
+}
+
+// appendMsgpLength appends the shortest head for a length n: the fixed form
+// fix|n when n < fixLimit, or else the first of the 1, 2 and 4 byte forms
+// starting at type byte t which fits n. Arrays and maps have no 1 byte form.
+func appendMsgpLength(buf []byte, n int, fix byte, fixLimit int, t byte) []byte {
+	if n < fixLimit {
+		return append(buf, fix|byte(n))
+	}
+	if t != 0xdc && t != 0xde {
+		if n <= math.MaxUint8 {
+			return append(buf, t, byte(n))
+		}
+		t++
+	}
+	if n <= math.MaxUint16 {
+		return binary.BigEndian.AppendUint16(append(buf, t), uint16(n))
+	}
+	return binary.BigEndian.AppendUint32(append(buf, t+1), uint32(n))
+}
+
+func msgpHeadReason(v *msgpValue) string {
+	switch v.kind {
+	case msgpInt:
+		if v.i >= 0 {
+			return "non-negative integer encoded as a signed integer"
+		}
+		return "integer not in its shortest encoding"
+	case msgpUint:
+		return "integer not in its shortest encoding"
+	case msgpStr:
+		return "string length not in its shortest encoding"
+	case msgpBin:
+		return "byte string length not in its shortest encoding"
+	case msgpArray:
+		return "array length not in its shortest encoding"
+	default:
+		return "map size not in its shortest encoding"
+	}
+}
+
+// msgpUnsupported returns why v has no canonical encoding, if it does not
+func msgpUnsupported(v *msgpValue) string {
+	switch v.kind {
+	case msgpFloat:
+		return "floats are not used in canonical encodings"
+	case msgpExt:
+		return "extension types are not used in canonical encodings"
+	}
+	return ""
+}
+
+func checkMsgp(b []byte, v *msgpValue, path string) error {
+	if reason := msgpUnsupported(v); reason != "" {
+		return &CanonicalError{Offset: v.start, Path: path, Reason: reason}
+	}
+	if !bytes.Equal(appendMsgpHead(nil, v), b[v.start:v.head]) {
+		return &CanonicalError{Offset: v.start, Path: path, Reason: msgpHeadReason(v)}
+	}
+
+	switch v.kind {
+	case msgpArray:
+		for i := range v.elems {
+			err := checkMsgp(b, &v.elems[i], fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return err
+			}
+		}
+	case msgpMap:
+		for i := 0; i < len(v.elems); i += 2 {
+			key, val := &v.elems[i], &v.elems[i+1]
+			keyPath := msgpKeyPath(path, key)
+			err := checkMsgp(b, key, keyPath)
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				cmp, ok := compareMsgpKeys(&v.elems[i-2], key)
+				switch {
+				case !ok:
+					return &CanonicalError{Offset: key.start, Path: keyPath, Reason: "map keys of different or unsupported types"}
+				case cmp == 0:
+					return &CanonicalError{Offset: key.start, Path: keyPath, Reason: "duplicate map key"}
+				case cmp > 0:
+					return &CanonicalError{Offset: key.start, Path: keyPath, Reason: "map keys not in sorted order"}
+				}
+			}
+			if msgpIsZero(val) {
+				return &CanonicalError{Offset: key.start, Path: keyPath, Reason: "zero values are omitted"}
+			}
+			err = checkMsgp(b, val, keyPath)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func msgpIsZero(v *msgpValue) bool {
+	switch v.kind {
+	case msgpNil:
+		return true
+	case msgpBool:
+		return !v.b
+	case msgpUint:
+		return v.u == 0
+	case msgpInt:
+		return v.i == 0
+	case msgpStr, msgpBin:
+		return len(v.data) == 0
+	case msgpArray, msgpMap:
+		return len(v.elems) == 0
+	}
+	return false
+}
+
+// compareMsgpKeys orders map keys: strings and byte strings by their bytes,
+// and integers by their values. It returns false for keys which cannot be
+// compared.
+func compareMsgpKeys(a, b *msgpValue) (int, bool) {
+	isInt := func(v *msgpValue) bool { return v.kind == msgpUint || v.kind == msgpInt }
+	switch {
+	case isInt(a) && isInt(b):
+		aNeg, bNeg := a.kind == msgpInt && a.i < 0, b.kind == msgpInt && b.i < 0
+		switch {
+		case aNeg && !bNeg:
+			return -1, true
+		case !aNeg && bNeg:
+			return 1, true
+		case aNeg && bNeg:
+			return compareInts(a.i, b.i), true
+		default:
+			return compareUints(msgpUintValue(a), msgpUintValue(b)), true
+		}
+	case (a.kind == msgpStr || a.kind == msgpBin) && a.kind == b.kind:
+		return bytes.Compare(a.data, b.data), true
+	}
+	return 0, false
+}
+
+func msgpUintValue(v *msgpValue) uint64 {
+	if v.kind == msgpInt {
+		return uint64(v.i)
+	}
+	return v.u
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUints(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// msgpKeyPath is the path of the value under key in the map at path
+func msgpKeyPath(path string, key *msgpValue) string {
+	switch key.kind {
+	case msgpStr:
+		if path == "" {
+			return string(key.data)
+		}
+		return path + "." + string(key.data)
+	case msgpUint:
+		return fmt.Sprintf("%s{%d}", path, key.u)
+	case msgpInt:
+		return fmt.Sprintf("%s{%d}", path, key.i)
+	case msgpBin:
+		return fmt.Sprintf("%s{%x}", path, key.data)
+	}
+	return path + "{?}"
+}
+
+// msgpPathAt returns the path of the innermost field of v holding the byte
+// at offset off
+func msgpPathAt(v *msgpValue, off int, path string) string {
+	switch v.kind {
+	case msgpArray:
+		for i := range v.elems {
+			if off >= v.elems[i].start && off < v.elems[i].end {
+				return msgpPathAt(&v.elems[i], off, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	case msgpMap:
+		for i := 0; i < len(v.elems); i += 2 {
+			key, val := &v.elems[i], &v.elems[i+1]
+			keyPath := msgpKeyPath(path, key)
+			if off >= key.start && off < key.end {
+				return keyPath
+			}
+			if off >= val.start && off < val.end {
+				return msgpPathAt(val, off, keyPath)
+			}
+		}
+	}
+	return path
+}
+
+// msgpZeroEncodings are the canonical encodings of zero values
+var msgpZeroEncodings = [][]byte{{0xc0}, {0xc2}, {0x00}, {0xa0}, {0xc4, 0x00}, {0x90}, {0x80}}
+
+func isMsgpZeroEncoding(enc []byte) bool {
+	for _, zero := range msgpZeroEncodings {
+		if bytes.Equal(enc, zero) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendCanonicalMsgp(buf []byte, v *msgpValue, path string) ([]byte, error) {
+	if reason := msgpUnsupported(v); reason != "" {
+		return nil, &CanonicalError{Offset: v.start, Path: path, Reason: reason}
+	}
+
+	switch v.kind {
+	case msgpArray:
+		buf = appendMsgpHead(buf, v)
+		for i := range v.elems {
+			var err error
+			buf, err = appendCanonicalMsgp(buf, &v.elems[i], fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+
+	case msgpMap:
+		type entry struct {
+			key *msgpValue
+			enc []byte
+		}
+		var entries []entry
+		for i := 0; i < len(v.elems); i += 2 {
+			key, val := &v.elems[i], &v.elems[i+1]
+			keyEnc, err := appendCanonicalMsgp(nil, key, path)
+			if err != nil {
+				return nil, err
+			}
+			valEnc, err := appendCanonicalMsgp(nil, val, msgpKeyPath(path, key))
+			if err != nil {
+				return nil, err
+			}
+			if isMsgpZeroEncoding(valEnc) {
+				continue
+			}
+			entries = append(entries, entry{key: key, enc: append(keyEnc, valEnc...)})
+		}
+
+		sort.SliceStable(entries, func(i, j int) bool {
+			cmp, _ := compareMsgpKeys(entries[i].key, entries[j].key)
+			return cmp < 0
+		})
+		for i := 1; i < len(entries); i++ {
+			key := entries[i].key
+			cmp, ok := compareMsgpKeys(entries[i-1].key, key)
+			if !ok {
+				return nil, &CanonicalError{Offset: key.start, Path: msgpKeyPath(path, key), Reason: "map keys of different or unsupported types"}
+			}
+			if cmp == 0 {
+				return nil, &CanonicalError{Offset: key.start, Path: msgpKeyPath(path, key), Reason: "duplicate map key"}
+			}
+		}
+
+		buf = appendMsgpLength(buf, len(entries), 0x80, 16, 0xde)
+		for _, e := range entries {
+			buf = append(buf, e.enc...)
+		}
+		return buf, nil
+
+	default:
+		buf = appendMsgpHead(buf, v)
+		return append(buf, v.data...), nil
+	}
+}
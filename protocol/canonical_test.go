@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package protocol
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestCheckCanonical(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// {"amt": 5, "fee": 1000, "note": bin("hi"), "txn": {"ids": [1, -1]}}
+	canonical := mustHex(t, "84a3616d7405a3666565cd03e8a46e6f7465c4026869a374786e81a36964739201ff")
+	require.NoError(t, CheckCanonical(canonical))
+	out, err := Canonicalize(canonical)
+	require.NoError(t, err)
+	require.Equal(t, canonical, out)
+
+	tests := []struct {
+		name      string
+		enc       string
+		offset    int
+		path      string
+		reason    string
+		canonical string
+	}{
+		{"long uint", "81a161cc05", 3, "a", "shortest", "81a16105"},
+		{"signed uint", "81a161d005", 3, "a", "signed", "81a16105"},
+		{"long negative int", "81a161d1ffff", 3, "a", "shortest", "81a161ff"},
+		{"long string", "81d9016101", 1, "a", "string length", "81a16101"},
+		{"long byte string", "81a161c5000161", 3, "a", "byte string length", "81a161c40161"},
+		{"long array", "81a161dc000101", 3, "a", "array length", "81a1619101"},
+		{"long map", "de0001a16101", 0, "", "map size", "81a16101"},
+		{"array element", "81a1619201cc02", 5, "a[1]", "shortest", "81a161920102"},
+		{"unsorted keys", "82a16201a16102", 4, "a", "sorted", "82a16102a16201"},
+		{"string keys sorted by bytes", "82a2616101a16201", -1, "", "", ""},
+		{"unsorted integer keys", "820201ff01", 3, "{-1}", "sorted", "82ff010201"},
+		{"zero value", "82a16100a16201", 1, "a", "zero", "81a16201"},
+		{"empty string", "81a161a0", 1, "a", "zero", "80"},
+		{"nested", "81a374786e81a3666565cd0010", 10, "txn.fee", "shortest", "81a374786e81a366656510"},
+		{"recursively empty", "82a16181a16200a16301", 4, "a.b", "zero", "81a16301"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			enc := mustHex(t, test.enc)
+			err := CheckCanonical(enc)
+			if test.offset < 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var cerr *CanonicalError
+			require.True(t, errors.As(err, &cerr), "%v", err)
+			require.Equal(t, test.offset, cerr.Offset)
+			require.Equal(t, test.path, cerr.Path)
+			require.Contains(t, cerr.Reason, test.reason)
+
+			out, err := Canonicalize(enc)
+			require.NoError(t, err)
+			require.Equal(t, test.canonical, hex.EncodeToString(out))
+			require.NoError(t, CheckCanonical(out))
+		})
+	}
+}
+
+func TestCanonicalizeUnsupported(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		enc    string
+		offset int
+	}{
+		{"82a16101a16102", 4},           // duplicate key
+		{"82a16101c4016102", 4},         // keys of different types
+		{"81a161cb3ff0000000000000", 3}, // float
+		{"81a161d40100", 3},             // extension
+	}
+	var cerr *CanonicalError
+	for _, test := range tests {
+		enc := mustHex(t, test.enc)
+		require.True(t, errors.As(CheckCanonical(enc), &cerr), test.enc)
+		require.Equal(t, test.offset, cerr.Offset, test.enc)
+
+		_, err := Canonicalize(enc)
+		require.True(t, errors.As(err, &cerr), "%s: %v", test.enc, err)
+		require.Equal(t, test.offset, cerr.Offset, test.enc)
+	}
+}
+
+func TestCanonicalSequence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	enc := mustHex(t, "81a1610181a161cc05")
+	var cerr *CanonicalError
+	require.True(t, errors.As(CheckCanonical(enc), &cerr))
+	require.Equal(t, 1, cerr.Object)
+	require.Equal(t, 7, cerr.Offset)
+
+	out, err := Canonicalize(enc)
+	require.NoError(t, err)
+	require.Equal(t, "81a1610181a16105", hex.EncodeToString(out))
+
+	// Malformed encodings are not canonicalization errors
+	for _, enc := range []string{"81a161", "81a161cd00", "c1", "9f"} {
+		err := CheckCanonical(mustHex(t, enc))
+		require.Error(t, err, enc)
+		require.False(t, errors.As(err, &cerr), enc)
+	}
+}
+
+func TestDiffEncodings(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := mustHex(t, "82a3616d7405a374786e81a3666565cd03e8")
+	offset, path := DiffEncodings(a, a)
+	require.Equal(t, -1, offset)
+	require.Empty(t, path)
+
+	b := mustHex(t, "82a3616d7405a374786e81a3666565cd03e9")
+	offset, path = DiffEncodings(a, b)
+	require.Equal(t, 17, offset)
+	require.Equal(t, "txn.fee", path)
+
+	offset, path = DiffEncodings(a, b[:3])
+	require.Equal(t, 3, offset)
+	require.Equal(t, "amt", path)
+
+	offset, path = DiffEncodings(a[:10], a)
+	require.Equal(t, 10, offset)
+	require.Empty(t, path)
+}
@@ -38,10 +38,12 @@ const (
 	AuctionParams     HashID = "aP"
 	AuctionSettlement HashID = "aS"
 
+	AbsenceProofLeaf                 HashID = "APL"
 	AgreementSelector                HashID = "AS"
 	BlockHeader256                   HashID = "B256"
 	BlockHeader                      HashID = "BH"
 	BalanceRecord                    HashID = "BR"
+	CatchpointFeed                   HashID = "CF"
 	Credential                       HashID = "CR"
 	Genesis                          HashID = "GE"
 	KeysInMSS                        HashID = "KP"
@@ -65,6 +67,7 @@ const (
 	SignedTxnInBlock                 HashID = "STIB"
 
 	StateProofCoin    HashID = "spc"
+	StateProofKeySeed HashID = "spk"
 	StateProofMessage HashID = "spm"
 	StateProofPart    HashID = "spp"
 	StateProofSig     HashID = "sps"
@@ -35,6 +35,7 @@ const (
 	NetIDVerificationTag Tag = "NI"
 	PingTag              Tag = "pi"
 	PingReplyTag         Tag = "pj"
+	ProposalChunkTag     Tag = "PC"
 	ProposalPayloadTag   Tag = "PP"
 	StateProofSigTag     Tag = "SP"
 	TopicMsgRespTag      Tag = "TS"
@@ -54,7 +55,7 @@ const (
 const AgreementVoteTagMaxSize = 1228
 
 // MsgOfInterestTagMaxSize is the maximum size of a MsgOfInterestTag message
-const MsgOfInterestTagMaxSize = 45
+const MsgOfInterestTagMaxSize = 48
 
 // MsgDigestSkipTagMaxSize is the maximum size of a MsgDigestSkipTag message
 const MsgDigestSkipTagMaxSize = 69
@@ -71,6 +72,11 @@ const PingTagMaxSize = 8
 // PingReplyTagMaxSize is the maximum size of a PingReplyTag message
 const PingReplyTagMaxSize = 8
 
+// ProposalChunkTagMaxSize is the maximum size of a ProposalChunkTag message
+// A proposal chunk carries at most a whole proposal payload plus its header
+// and the Merkle proof of its shard
+const ProposalChunkTagMaxSize = 5248307
+
 // ProposalPayloadTagMaxSize is the maximum size of a ProposalPayloadTag message
 // This value is dominated by the MaxTxnBytesPerBlock
 const ProposalPayloadTagMaxSize = 5247980
@@ -113,6 +119,8 @@ func (tag Tag) MaxMessageSize() uint64 {
 		return PingTagMaxSize
 	case PingReplyTag:
 		return PingReplyTagMaxSize
+	case ProposalChunkTag:
+		return ProposalChunkTagMaxSize
 	case ProposalPayloadTag:
 		return ProposalPayloadTagMaxSize
 	case StateProofSigTag:
@@ -139,6 +147,7 @@ var TagList = []Tag{
 	NetPrioResponseTag,
 	PingTag,
 	PingReplyTag,
+	ProposalChunkTag,
 	ProposalPayloadTag,
 	StateProofSigTag,
 	TopicMsgRespTag,
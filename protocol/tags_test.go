@@ -240,6 +240,7 @@ func TestLockdownTagList(t *testing.T) {
 		NetPrioResponseTag,
 		PingTag,
 		PingReplyTag,
+		ProposalChunkTag,
 		ProposalPayloadTag,
 		StateProofSigTag,
 		TopicMsgRespTag,
@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/DataDog/zstd"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// BlockResponseContentEncoding is the HTTP Content-Encoding of compressed
+// block responses. Clients opt in by listing it in Accept-Encoding.
+const BlockResponseContentEncoding = "zstd"
+
+var blockResponseCacheHits = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_rpcs_compressed_block_cache_hits", Description: "Number of compressed http block responses served from the cache"},
+)
+var blockResponseCacheMisses = metrics.MakeCounter(
+	metrics.MetricName{Name: "algod_rpcs_compressed_block_cache_misses", Description: "Number of compressed http block responses encoded and compressed on request"},
+)
+
+// blockResponseCache holds the compressed block and certificate responses of
+// the most recently requested rounds. Concurrent requests for a round which is
+// not cached yet wait for a single request to produce its response.
+type blockResponseCache struct {
+	mu   deadlock.Mutex
+	size int
+
+	entries map[basics.Round]*blockResponseEntry
+	// order lists the cached rounds, least recently requested first.
+	order []basics.Round
+}
+
+type blockResponseEntry struct {
+	// done is closed once data and err are set.
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+func makeBlockResponseCache(size int) *blockResponseCache {
+	return &blockResponseCache{
+		size:    size,
+		entries: make(map[basics.Round]*blockResponseEntry, size),
+	}
+}
+
+// get returns the cached response for round, calling load to produce it if
+// it is missing. Responses for which load fails are not cached.
+func (c *blockResponseCache) get(round basics.Round, load func() ([]byte, error)) ([]byte, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[round]; ok {
+		c.touch(round)
+		c.mu.Unlock()
+		<-e.done
+		blockResponseCacheHits.Inc(nil)
+		return e.data, e.err
+	}
+
+	e := &blockResponseEntry{done: make(chan struct{})}
+	c.entries[round] = e
+	c.order = append(c.order, round)
+	for len(c.order) > c.size {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.mu.Unlock()
+
+	blockResponseCacheMisses.Inc(nil)
+	e.data, e.err = load()
+	close(e.done)
+
+	if e.err != nil {
+		c.mu.Lock()
+		if c.entries[round] == e {
+			c.remove(round)
+		}
+		c.mu.Unlock()
+	}
+	return e.data, e.err
+}
+
+// touch moves round to the most recently requested end of the order.
+func (c *blockResponseCache) touch(round basics.Round) {
+	c.remove(round)
+	c.order = append(c.order, round)
+}
+
+func (c *blockResponseCache) remove(round basics.Round) {
+	for i, r := range c.order {
+		if r == round {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// acceptsEncoding reports whether the request lists encoding in its
+// Accept-Encoding header with a nonzero quality.
+func acceptsEncoding(request *http.Request, encoding string) bool {
+	for _, header := range request.Header.Values("Accept-Encoding") {
+		for _, part := range strings.Split(header, ",") {
+			fields := strings.Split(part, ";")
+			if !strings.EqualFold(strings.TrimSpace(fields[0]), encoding) {
+				continue
+			}
+			rejected := false
+			for _, param := range fields[1:] {
+				param = strings.ReplaceAll(param, " ", "")
+				if param == "q=0" || strings.HasPrefix(param, "q=0.") && strings.Trim(param[len("q=0."):], "0") == "" {
+					rejected = true
+				}
+			}
+			return !rejected
+		}
+	}
+	return false
+}
+
+// compressBlockBytes compresses an encoded block and certificate for a
+// response with BlockResponseContentEncoding.
+func compressBlockBytes(data []byte) ([]byte, error) {
+	return zstd.Compress(nil, data)
+}
+
+// DecompressBlockBytes reverses the BlockResponseContentEncoding of a block
+// response body, failing if the decompressed block exceeds limit bytes.
+func DecompressBlockBytes(data []byte, limit uint64) ([]byte, error) {
+	r := zstd.NewReader(bytes.NewReader(data))
+	defer r.Close()
+	slurper := network.MakeLimitedReaderSlurper(baseResponseReadingBufferSize, limit)
+	err := slurper.Read(r)
+	if err != nil {
+		return nil, err
+	}
+	return slurper.Bytes(), nil
+}
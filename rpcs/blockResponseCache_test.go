@@ -0,0 +1,221 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBlockResponseCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := makeBlockResponseCache(2)
+	var loads int32
+	load := func(data string) func() ([]byte, error) {
+		return func() ([]byte, error) {
+			atomic.AddInt32(&loads, 1)
+			return []byte(data), nil
+		}
+	}
+
+	data, err := c.get(1, load("one"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("one"), data)
+	data, err = c.get(1, load("other"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("one"), data)
+	require.Equal(t, int32(1), atomic.LoadInt32(&loads))
+
+	// round 1 is the most recently used, so round 2 is evicted first
+	_, err = c.get(2, load("two"))
+	require.NoError(t, err)
+	_, err = c.get(1, load("other"))
+	require.NoError(t, err)
+	_, err = c.get(3, load("three"))
+	require.NoError(t, err)
+	require.Equal(t, []basics.Round{1, 3}, c.order)
+	require.Len(t, c.entries, 2)
+	require.Equal(t, int32(3), atomic.LoadInt32(&loads))
+
+	// failures are not cached
+	failure := errors.New("no block")
+	_, err = c.get(4, func() ([]byte, error) { return nil, failure })
+	require.ErrorIs(t, err, failure)
+	require.Equal(t, []basics.Round{1, 3}, c.order)
+	data, err = c.get(4, load("four"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("four"), data)
+}
+
+func TestBlockResponseCacheConcurrentMiss(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := makeBlockResponseCache(4)
+	var loads int32
+	release := make(chan struct{})
+	load := func() ([]byte, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+		return []byte("block"), nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]byte, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = c.get(7, load)
+		}(i)
+	}
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	for _, r := range results {
+		require.Equal(t, []byte("block"), r)
+	}
+}
+
+func TestAcceptsEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, tc := range []struct {
+		headers []string
+		accept  bool
+	}{
+		{nil, false},
+		{[]string{"gzip"}, false},
+		{[]string{"zstd"}, true},
+		{[]string{"gzip, ZSTD"}, true},
+		{[]string{"gzip", "br, zstd;q=0.5"}, true},
+		{[]string{"zstd;q=0"}, false},
+		{[]string{"zstd; q=0.000"}, false},
+		{[]string{"zstd;q=0.001"}, true},
+		{[]string{"zstdx"}, false},
+	} {
+		request, err := http.NewRequest("GET", "http://localhost/", nil)
+		require.NoError(t, err)
+		for _, h := range tc.headers {
+			request.Header.Add("Accept-Encoding", h)
+		}
+		require.Equal(t, tc.accept, acceptsEncoding(request, BlockResponseContentEncoding), "%v", tc.headers)
+	}
+}
+
+func TestDecompressBlockBytes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	data := []byte(strings.Repeat("block and certificate ", 1000))
+	compressed, err := compressBlockBytes(data)
+	require.NoError(t, err)
+	require.Less(t, len(compressed), len(data))
+
+	out, err := DecompressBlockBytes(compressed, uint64(len(data)))
+	require.NoError(t, err)
+	require.Equal(t, data, out)
+
+	_, err = DecompressBlockBytes(compressed, uint64(len(data)-1))
+	require.ErrorIs(t, err, network.ErrIncomingMsgTooLarge)
+
+	_, err = DecompressBlockBytes(data, uint64(len(data)))
+	require.Error(t, err)
+}
+
+func TestBlockServiceCompressedResponse(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+
+	ledger := makeLedger(t, "l1")
+	defer ledger.Close()
+	addBlock(t, ledger)
+
+	net := &httpTestPeerSource{}
+	cfg := config.GetDefaultLocal()
+	bs := MakeBlockService(log, cfg, ledger, net, "test-genesis-ID")
+	bs.Start()
+	defer bs.Stop()
+
+	nodeA := &basicRPCNode{}
+	nodeA.RegisterHTTPHandler(BlockServiceBlockPath, bs)
+	nodeA.start()
+	defer nodeA.stop()
+
+	parsedURL, err := network.ParseHostOrURL(nodeA.rootURL())
+	require.NoError(t, err)
+	parsedURL.Path = FormatBlockQuery(uint64(1), parsedURL.Path, net)
+	parsedURL.Path = strings.Replace(parsedURL.Path, "{genesisID}", "test-genesis-ID", 1)
+	blockURL := parsedURL.String()
+
+	expected, err := RawBlockBytes(ledger, basics.Round(1))
+	require.NoError(t, err)
+
+	fetch := func(acceptEncoding string) *http.Response {
+		request, err := http.NewRequestWithContext(context.Background(), "GET", blockURL, nil)
+		require.NoError(t, err)
+		if acceptEncoding != "" {
+			request.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		return response
+	}
+
+	hits := blockResponseCacheHits.GetUint64Value()
+	for i := 0; i < 2; i++ {
+		response := fetch(BlockResponseContentEncoding)
+		require.Equal(t, BlockResponseContentEncoding, response.Header.Get("Content-Encoding"))
+		require.Equal(t, "Accept-Encoding", response.Header.Get("Vary"))
+		body, err := ResponseBytes(response, log, 10<<20)
+		require.NoError(t, err)
+		data, err := DecompressBlockBytes(body, 10<<20)
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+	}
+	require.Equal(t, hits+1, blockResponseCacheHits.GetUint64Value())
+
+	response := fetch("gzip")
+	require.Empty(t, response.Header.Get("Content-Encoding"))
+	body, err := ResponseBytes(response, log, 10<<20)
+	require.NoError(t, err)
+	require.Equal(t, expected, body)
+
+	// compressed responses do not hold on to the memory accounting
+	bs.mu.Lock()
+	require.Zero(t, bs.memoryUsed)
+	bs.mu.Unlock()
+}
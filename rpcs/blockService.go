@@ -106,6 +106,9 @@ type BlockService struct {
 	memoryUsed              uint64
 	wsMemoryUsed            uint64
 	memoryCap               uint64
+	// compressedResponses caches compressed HTTP responses; it is nil if
+	// compressed responses are disabled.
+	compressedResponses *blockResponseCache
 }
 
 // EncodedBlockCert defines how GetBlockBytes encodes a block and its certificate
@@ -144,6 +147,9 @@ func MakeBlockService(log logging.Logger, config config.Local, ledger LedgerForB
 		log:                     log,
 		memoryCap:               config.BlockServiceMemCap,
 	}
+	if config.BlockServiceCompressedCacheSize > 0 {
+		service.compressedResponses = makeBlockResponseCache(int(config.BlockServiceCompressedCacheSize))
+	}
 	if service.enableService {
 		net.RegisterHTTPHandler(BlockServiceBlockPath, service)
 	}
@@ -237,7 +243,13 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 		response.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	encodedBlockCert, err := bs.rawBlockBytes(basics.Round(round))
+	compressed := bs.compressedResponses != nil && acceptsEncoding(request, BlockResponseContentEncoding)
+	var encodedBlockCert []byte
+	if compressed {
+		encodedBlockCert, err = bs.compressedBlockBytes(basics.Round(round))
+	} else {
+		encodedBlockCert, err = bs.rawBlockBytes(basics.Round(round))
+	}
 	if err != nil {
 		switch err.(type) {
 		case ledgercore.ErrNoEntry:
@@ -269,11 +281,21 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 	response.Header().Set("Content-Type", BlockResponseContentType)
 	response.Header().Set("Content-Length", strconv.Itoa(len(encodedBlockCert)))
 	response.Header().Set("Cache-Control", blockResponseHasBlockCacheControl)
+	if bs.compressedResponses != nil {
+		response.Header().Set("Vary", "Accept-Encoding")
+	}
+	if compressed {
+		response.Header().Set("Content-Encoding", BlockResponseContentEncoding)
+	}
 	response.WriteHeader(http.StatusOK)
 	_, err = response.Write(encodedBlockCert)
 	if err != nil {
 		bs.log.Warn("http block write failed ", err)
 	}
+	if compressed {
+		// cached responses are shared and not accounted per request
+		return
+	}
 	bs.mu.Lock()
 	defer bs.mu.Unlock()
 	bs.memoryUsed = bs.memoryUsed - uint64(len(encodedBlockCert))
@@ -453,6 +475,23 @@ func (bs *BlockService) rawBlockBytes(round basics.Round) ([]byte, error) {
 	return data, err
 }
 
+// compressedBlockBytes returns the compressed block/cert for a given round,
+// compressing it only if it is not cached already.
+func (bs *BlockService) compressedBlockBytes(round basics.Round) ([]byte, error) {
+	return bs.compressedResponses.get(round, func() ([]byte, error) {
+		data, err := bs.rawBlockBytes(round)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			bs.mu.Lock()
+			defer bs.mu.Unlock()
+			bs.memoryUsed = bs.memoryUsed - uint64(len(data))
+		}()
+		return compressBlockBytes(data)
+	})
+}
+
 func topicBlockBytes(log logging.Logger, dataLedger LedgerForBlockService, round basics.Round, requestType string) (network.Topics, uint64) {
 	blk, cert, err := dataLedger.EncodedBlockCert(round)
 	if err != nil {
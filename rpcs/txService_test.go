@@ -149,7 +149,7 @@ func TestTxSync(t *testing.T) {
 	syncInterval := time.Second
 	syncTimeout := time.Second
 	syncerPool := makeMockPendingTxAggregate(0)
-	syncer := MakeTxSyncer(syncerPool, nodeB, &handler, syncInterval, syncTimeout, config.GetDefaultLocal().TxSyncServeResponseSize)
+	syncer := MakeTxSyncer(logging.Base(), syncerPool, nodeB, &handler, syncInterval, syncTimeout, config.GetDefaultLocal().TxSyncServeResponseSize)
 	// Since syncer is not Started, set the context here
 	syncer.ctx, syncer.cancel = context.WithCancel(context.Background())
 	require.NoError(t, syncer.sync())
@@ -176,7 +176,7 @@ func BenchmarkTxSync(b *testing.B) {
 				syncInterval := time.Second
 				syncTimeout := time.Second
 				syncPool := makeMockPendingTxAggregate(config.GetDefaultLocal().TxPoolSize)
-				syncer := MakeTxSyncer(syncPool, nodeB, &handler, syncInterval, syncTimeout, config.GetDefaultLocal().TxSyncServeResponseSize)
+				syncer := MakeTxSyncer(logging.Base(), syncPool, nodeB, &handler, syncInterval, syncTimeout, config.GetDefaultLocal().TxSyncServeResponseSize)
 				syncer.sync()
 			}
 		}()
@@ -58,15 +58,15 @@ type TxSyncer struct {
 }
 
 // MakeTxSyncer returns a TxSyncer
-func MakeTxSyncer(pool PendingTxAggregate, clientSource network.GossipNode, txHandler data.SolicitedTxHandler, syncInterval time.Duration, syncTimeout time.Duration, serverResponseSize int) *TxSyncer {
+func MakeTxSyncer(log logging.Logger, pool PendingTxAggregate, clientSource network.GossipNode, txHandler data.SolicitedTxHandler, syncInterval time.Duration, syncTimeout time.Duration, serverResponseSize int) *TxSyncer {
 	return &TxSyncer{
 		pool:         pool,
 		clientSource: clientSource,
 		handler:      txHandler,
 		syncInterval: syncInterval,
 		syncTimeout:  syncTimeout,
-		log:          logging.Base(),
-		httpSync:     makeHTTPSync(clientSource, logging.Base(), uint64(serverResponseSize)),
+		log:          log,
+		httpSync:     makeHTTPSync(clientSource, log, uint64(serverResponseSize)),
 	}
 }
 
@@ -462,6 +462,7 @@ func (spw *Worker) builder(latest basics.Round) {
 		stateProofNextRound := newLatestHdr.StateProofTracking[protocol.StateProofBasic].StateProofNextRound
 
 		spw.deleteProverData(&proto, stateProofNextRound)
+		spw.updateMetrics(stateProofNextRound)
 
 		// Broadcast signatures based on the previous block(s) that
 		// were agreed upon.  This ensures that, if we send a signature
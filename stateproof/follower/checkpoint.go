@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package follower
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	errStateProofNotEnabled = errors.New("state proofs are not enabled")
+	errNotAtRightMultiple   = errors.New("voters round is not a multiple of the state proof interval")
+	errNotContiguous        = errors.New("state proof does not follow the checkpoint")
+	errNoCommitment         = errors.New("checkpoint has no verified block headers commitment")
+	errRoundNotCovered      = errors.New("round is not covered by the checkpoint")
+)
+
+// Checkpoint is the trusted state of a state proof follower. It holds what is
+// needed to verify the next state proof of the chain, namely the voters
+// commitment and proven weight it attested to, and the commitment on the light
+// block headers of the rounds covered by the last verified state proof.
+//
+//msgp:ignore Checkpoint
+type Checkpoint struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// LastAttestedRound is the last round covered by the last verified
+	// state proof, or the voters round of a checkpoint made from a header.
+	LastAttestedRound basics.Round `codec:"l"`
+
+	// FirstAttestedRound is the first round covered by the last verified
+	// state proof. It is zero until a state proof was verified.
+	FirstAttestedRound basics.Round `codec:"f"`
+
+	// VotersCommitment and LnProvenWeight describe the voters of the next
+	// state proof.
+	VotersCommitment crypto.GenericDigest `codec:"v"`
+	LnProvenWeight   uint64               `codec:"P"`
+
+	// StrengthTarget is the security parameter of the state proofs.
+	StrengthTarget uint64 `codec:"s"`
+
+	// BlockHeadersCommitment is the vector commitment on the light block
+	// headers of the rounds covered by the last verified state proof.
+	BlockHeadersCommitment crypto.GenericDigest `codec:"b"`
+}
+
+// CheckpointFromVotersHeader makes the Checkpoint to start following the
+// chain of state proofs from a trusted block header, whose voters sign the
+// state proof of the following interval. Typically, this is the header of the
+// first round at which state proofs were enabled.
+func CheckpointFromVotersHeader(votersHdr *bookkeeping.BlockHeader) (Checkpoint, error) {
+	proto := config.Consensus[votersHdr.CurrentProtocol]
+	if proto.StateProofInterval == 0 {
+		return Checkpoint{}, fmt.Errorf("round %d: %w", votersHdr.Round, errStateProofNotEnabled)
+	}
+	if votersHdr.Round%basics.Round(proto.StateProofInterval) != 0 {
+		return Checkpoint{}, fmt.Errorf("round %d, interval %d: %w", votersHdr.Round, proto.StateProofInterval, errNotAtRightMultiple)
+	}
+
+	tracking := votersHdr.StateProofTracking[protocol.StateProofBasic]
+	provenWeight, overflowed := basics.Muldiv(tracking.StateProofOnlineTotalWeight.ToUint64(), uint64(proto.StateProofWeightThreshold), 1<<32)
+	if overflowed {
+		return Checkpoint{}, fmt.Errorf("overflow computing provenWeight[%d]: %d * %d / (1<<32)",
+			votersHdr.Round, tracking.StateProofOnlineTotalWeight.ToUint64(), proto.StateProofWeightThreshold)
+	}
+	lnProvenWeight, err := stateproof.LnIntApproximation(provenWeight)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+
+	return Checkpoint{
+		LastAttestedRound: votersHdr.Round,
+		VotersCommitment:  tracking.StateProofVotersCommitment,
+		LnProvenWeight:    lnProvenWeight,
+		StrengthTarget:    proto.StateProofStrengthTarget,
+	}, nil
+}
+
+// Advance verifies the state proof of the interval following the checkpoint,
+// and returns the checkpoint resulting from it.
+func (cp Checkpoint) Advance(proof *stateproof.StateProof, msg *stateproofmsg.Message) (Checkpoint, error) {
+	if basics.Round(msg.FirstAttestedRound) != cp.LastAttestedRound+1 {
+		return Checkpoint{}, fmt.Errorf("state proof for rounds %d-%d, checkpoint at round %d: %w",
+			msg.FirstAttestedRound, msg.LastAttestedRound, cp.LastAttestedRound, errNotContiguous)
+	}
+
+	verifier := stateproof.MkVerifierWithLnProvenWeight(cp.VotersCommitment, cp.LnProvenWeight, cp.StrengthTarget)
+	err := verifier.Verify(msg.LastAttestedRound, msg.Hash(), proof)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("state proof for rounds %d-%d: %w", msg.FirstAttestedRound, msg.LastAttestedRound, err)
+	}
+
+	return Checkpoint{
+		LastAttestedRound:      basics.Round(msg.LastAttestedRound),
+		FirstAttestedRound:     basics.Round(msg.FirstAttestedRound),
+		VotersCommitment:       msg.VotersCommitment,
+		LnProvenWeight:         msg.LnProvenWeight,
+		StrengthTarget:         cp.StrengthTarget,
+		BlockHeadersCommitment: msg.BlockHeadersCommitment,
+	}, nil
+}
+
+// VerifyLightBlockHeader checks that the light block header of a round of the
+// last verified state proof interval is committed to by the checkpoint.
+func (cp Checkpoint) VerifyLightBlockHeader(hdr *bookkeeping.LightBlockHeader, proof *merklearray.SingleLeafProof) error {
+	if len(cp.BlockHeadersCommitment) == 0 {
+		return errNoCommitment
+	}
+	if hdr.Round < cp.FirstAttestedRound || hdr.Round > cp.LastAttestedRound {
+		return fmt.Errorf("round %d, checkpoint covers rounds %d-%d: %w", hdr.Round, cp.FirstAttestedRound, cp.LastAttestedRound, errRoundNotCovered)
+	}
+
+	elems := map[uint64]crypto.Hashable{uint64(hdr.Round - cp.FirstAttestedRound): hdr}
+	return merklearray.VerifyVectorCommitment(cp.BlockHeadersCommitment, elems, proof.ToProof())
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package follower follows the chain of state proofs from a trusted
+// checkpoint, verifying each of them, so that light clients and bridges can
+// track the light block header commitments of the chain without running a
+// node or reimplementing the verification.
+package follower
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrNotAvailable is returned by a Source when the state proof covering a
+// round has not been committed yet.
+var ErrNotAvailable = errors.New("state proof is not available yet")
+
+// A Source provides the state proofs of the chain.
+type Source interface {
+	// StateProof returns the state proof covering the given round, along
+	// with the message it attests to.
+	StateProof(round basics.Round) (*stateproof.StateProof, *stateproofmsg.Message, error)
+}
+
+// StateProofClient is the subset of the algod REST client used by
+// ClientSource.
+type StateProofClient interface {
+	StateProofs(round uint64) (model.StateProofResponse, error)
+}
+
+// ClientSource is a Source fetching state proofs from the REST API of an
+// algod node. The node does not need to be trusted.
+type ClientSource struct {
+	Client StateProofClient
+}
+
+// StateProof implements the Source interface.
+func (s ClientSource) StateProof(round basics.Round) (*stateproof.StateProof, *stateproofmsg.Message, error) {
+	resp, err := s.Client.StateProofs(uint64(round))
+	if err != nil {
+		var httpErr client.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, nil, ErrNotAvailable
+		}
+		return nil, nil, err
+	}
+
+	var proof stateproof.StateProof
+	err = protocol.Decode(resp.StateProof, &proof)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg := stateproofmsg.Message{
+		BlockHeadersCommitment: resp.Message.BlockHeadersCommitment,
+		VotersCommitment:       resp.Message.VotersCommitment,
+		LnProvenWeight:         resp.Message.LnProvenWeight,
+		FirstAttestedRound:     resp.Message.FirstAttestedRound,
+		LastAttestedRound:      resp.Message.LastAttestedRound,
+	}
+	return &proof, &msg, nil
+}
+
+// A Follower verifies the state proofs of the chain one after the other,
+// starting from a trusted Checkpoint.
+type Follower struct {
+	source     Source
+	checkpoint Checkpoint
+}
+
+// MakeFollower creates a Follower fetching state proofs from source, and
+// trusting checkpoint.
+func MakeFollower(source Source, checkpoint Checkpoint) *Follower {
+	return &Follower{
+		source:     source,
+		checkpoint: checkpoint,
+	}
+}
+
+// Checkpoint returns the latest verified checkpoint.
+func (f *Follower) Checkpoint() Checkpoint {
+	return f.checkpoint
+}
+
+// Next fetches and verifies the state proof following the checkpoint, and
+// advances the checkpoint to it. It returns ErrNotAvailable if that state
+// proof has not been committed yet.
+func (f *Follower) Next() (*stateproofmsg.Message, error) {
+	proof, msg, err := f.source.StateProof(f.checkpoint.LastAttestedRound + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := f.checkpoint.Advance(proof, msg)
+	if err != nil {
+		return nil, err
+	}
+	f.checkpoint = cp
+	return msg, nil
+}
+
+// CatchUp verifies state proofs until the next one is not available yet, or
+// the context is done. onVerified, when not nil, is called with the message of
+// every verified state proof.
+func (f *Follower) CatchUp(ctx context.Context, onVerified func(*stateproofmsg.Message)) error {
+	for ctx.Err() == nil {
+		msg, err := f.Next()
+		if errors.Is(err, ErrNotAvailable) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if onVerified != nil {
+			onVerified(msg)
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package follower
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/stateproofmsg"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testInterval = 256
+const testStrengthTarget = 256
+
+type lightBlockHeaders []bookkeeping.LightBlockHeader
+
+func (b lightBlockHeaders) Length() uint64 {
+	return uint64(len(b))
+}
+
+func (b lightBlockHeaders) Marshal(pos uint64) (crypto.Hashable, error) {
+	return &b[pos], nil
+}
+
+type testChain struct {
+	proofs  map[basics.Round]*stateproof.StateProof
+	msgs    map[basics.Round]*stateproofmsg.Message
+	headers map[basics.Round]*merklearray.Tree
+}
+
+func (c *testChain) StateProof(round basics.Round) (*stateproof.StateProof, *stateproofmsg.Message, error) {
+	first := round - (round-1)%testInterval
+	if c.msgs[first] == nil {
+		return nil, nil, ErrNotAvailable
+	}
+	return c.proofs[first], c.msgs[first], nil
+}
+
+// makeTestChain builds the state proofs of the given number of intervals
+// following round testInterval, all signed by the same voters.
+func makeTestChain(t *testing.T, intervals int) (Checkpoint, *testChain) {
+	a := require.New(t)
+
+	key, err := merklesignature.New(0, uint64(testInterval*(intervals+2)), testInterval)
+	a.NoError(err)
+
+	const totalWeight = 1000000
+	parts := make(basics.ParticipantsArray, 10)
+	for i := range parts {
+		parts[i] = basics.Participant{PK: *key.GetVerifier(), Weight: totalWeight / uint64(len(parts))}
+	}
+	partcom, err := merklearray.BuildVectorCommitmentTree(parts, crypto.HashFactory{HashType: stateproof.HashType})
+	a.NoError(err)
+	provenWeight := uint64(totalWeight / 4)
+	lnProvenWeight, err := stateproof.LnIntApproximation(provenWeight)
+	a.NoError(err)
+
+	cp := Checkpoint{
+		LastAttestedRound: testInterval,
+		VotersCommitment:  partcom.Root(),
+		LnProvenWeight:    lnProvenWeight,
+		StrengthTarget:    testStrengthTarget,
+	}
+	chain := &testChain{
+		proofs:  make(map[basics.Round]*stateproof.StateProof),
+		msgs:    make(map[basics.Round]*stateproofmsg.Message),
+		headers: make(map[basics.Round]*merklearray.Tree),
+	}
+
+	for i := 1; i <= intervals; i++ {
+		first := basics.Round(i*testInterval + 1)
+		last := basics.Round((i + 1) * testInterval)
+
+		hdrs := make(lightBlockHeaders, 0, testInterval)
+		for rnd := first; rnd <= last; rnd++ {
+			hdrs = append(hdrs, bookkeeping.LightBlockHeader{Round: rnd, GenesisHash: crypto.Digest{1}})
+		}
+		hdrTree, err := merklearray.BuildVectorCommitmentTree(hdrs, crypto.HashFactory{HashType: crypto.Sha256})
+		a.NoError(err)
+
+		msg := &stateproofmsg.Message{
+			BlockHeadersCommitment: hdrTree.Root(),
+			VotersCommitment:       partcom.Root(),
+			LnProvenWeight:         lnProvenWeight,
+			FirstAttestedRound:     uint64(first),
+			LastAttestedRound:      uint64(last),
+		}
+		data := msg.Hash()
+
+		sig, err := key.GetSigner(uint64(last)).SignBytes(data[:])
+		a.NoError(err)
+		prover, err := stateproof.MakeProver(data, uint64(last), provenWeight, parts, partcom, testStrengthTarget)
+		a.NoError(err)
+		for pos := range parts {
+			a.NoError(prover.Add(uint64(pos), sig))
+		}
+		proof, err := prover.CreateProof()
+		a.NoError(err)
+
+		chain.proofs[first] = proof
+		chain.msgs[first] = msg
+		chain.headers[first] = hdrTree
+	}
+	return cp, chain
+}
+
+func TestFollowerCatchUp(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cp, chain := makeTestChain(t, 3)
+	f := MakeFollower(chain, cp)
+
+	var verified []basics.Round
+	err := f.CatchUp(context.Background(), func(msg *stateproofmsg.Message) {
+		verified = append(verified, basics.Round(msg.LastAttestedRound))
+	})
+	require.NoError(t, err)
+	require.Equal(t, []basics.Round{2 * testInterval, 3 * testInterval, 4 * testInterval}, verified)
+
+	latest := f.Checkpoint()
+	require.Equal(t, basics.Round(4*testInterval), latest.LastAttestedRound)
+	require.Equal(t, basics.Round(3*testInterval+1), latest.FirstAttestedRound)
+	require.Equal(t, chain.headers[latest.FirstAttestedRound].Root(), latest.BlockHeadersCommitment)
+
+	_, err = f.Next()
+	require.ErrorIs(t, err, ErrNotAvailable)
+}
+
+func TestCheckpointAdvanceRejects(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cp, chain := makeTestChain(t, 2)
+	first := basics.Round(testInterval + 1)
+	second := basics.Round(2*testInterval + 1)
+
+	// a state proof that skips an interval
+	_, err := cp.Advance(chain.proofs[second], chain.msgs[second])
+	require.ErrorIs(t, err, errNotContiguous)
+
+	// a message that was not signed
+	msg := *chain.msgs[first]
+	msg.LnProvenWeight++
+	_, err = cp.Advance(chain.proofs[first], &msg)
+	require.Error(t, err)
+
+	// voters other than the trusted ones
+	other := cp
+	other.VotersCommitment = crypto.GenericDigest(crypto.Hash([]byte("other voters")).ToSlice())
+	_, err = other.Advance(chain.proofs[first], chain.msgs[first])
+	require.Error(t, err)
+
+	next, err := cp.Advance(chain.proofs[first], chain.msgs[first])
+	require.NoError(t, err)
+	_, err = next.Advance(chain.proofs[second], chain.msgs[second])
+	require.NoError(t, err)
+}
+
+func TestCheckpointVerifyLightBlockHeader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cp, chain := makeTestChain(t, 1)
+	first := basics.Round(testInterval + 1)
+
+	hdr := bookkeeping.LightBlockHeader{Round: first + 5, GenesisHash: crypto.Digest{1}}
+	proof, err := chain.headers[first].ProveSingleLeaf(5)
+	require.NoError(t, err)
+	require.ErrorIs(t, cp.VerifyLightBlockHeader(&hdr, proof), errNoCommitment)
+
+	cp, err = cp.Advance(chain.proofs[first], chain.msgs[first])
+	require.NoError(t, err)
+	require.NoError(t, cp.VerifyLightBlockHeader(&hdr, proof))
+
+	hdr.GenesisHash = crypto.Digest{2}
+	require.Error(t, cp.VerifyLightBlockHeader(&hdr, proof))
+
+	hdr = bookkeeping.LightBlockHeader{Round: first - 1}
+	require.ErrorIs(t, cp.VerifyLightBlockHeader(&hdr, proof), errRoundNotCovered)
+}
+
+type testClient struct {
+	chain *testChain
+}
+
+func (c testClient) StateProofs(round uint64) (resp model.StateProofResponse, err error) {
+	proof, msg, err := c.chain.StateProof(basics.Round(round))
+	if err != nil {
+		return resp, client.HTTPError{StatusCode: 404, Status: "404 Not Found"}
+	}
+	resp.StateProof = protocol.Encode(proof)
+	resp.Message.BlockHeadersCommitment = msg.BlockHeadersCommitment
+	resp.Message.VotersCommitment = msg.VotersCommitment
+	resp.Message.LnProvenWeight = msg.LnProvenWeight
+	resp.Message.FirstAttestedRound = msg.FirstAttestedRound
+	resp.Message.LastAttestedRound = msg.LastAttestedRound
+	return resp, nil
+}
+
+func TestClientSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cp, chain := makeTestChain(t, 2)
+	f := MakeFollower(ClientSource{Client: testClient{chain: chain}}, cp)
+	require.NoError(t, f.CatchUp(context.Background(), nil))
+	require.Equal(t, basics.Round(3*testInterval), f.Checkpoint().LastAttestedRound)
+}
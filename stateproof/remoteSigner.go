@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package stateproof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// remoteSignTimeout bounds the time a remote signer is given to sign a message.
+const remoteSignTimeout = 30 * time.Second
+
+// maxRemoteSignatureSize bounds the size of the response of a remote signer.
+const maxRemoteSignatureSize = 64 * 1024
+
+// RemoteSignRequest is the msgpack encoded body posted to a remote signer. The remote signer
+// responds with the msgpack encoded merklesignature.Signature of the message hash by the state
+// proof key of the account for the round.
+//
+//msgp:ignore RemoteSignRequest
+type RemoteSignRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Account     basics.Address         `codec:"a"`
+	Round       basics.Round           `codec:"r"`
+	MessageHash stateproof.MessageHash `codec:"m"`
+}
+
+// remoteSigner delegates the signing of state proof messages to a remote endpoint.
+type remoteSigner struct {
+	url    string
+	client http.Client
+}
+
+func makeRemoteSigner(signerURL string) (*remoteSigner, error) {
+	u, err := url.Parse(signerURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported remote signer scheme %q", u.Scheme)
+	}
+	return &remoteSigner{url: signerURL, client: http.Client{Timeout: remoteSignTimeout}}, nil
+}
+
+// sign asks the remote signer for the signature of hash by the state proof key of account for round.
+func (rs *remoteSigner) sign(ctx context.Context, account basics.Address, round basics.Round, hash stateproof.MessageHash) (merklesignature.Signature, error) {
+	body := protocol.EncodeReflect(&RemoteSignRequest{Account: account, Round: round, MessageHash: hash})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rs.url, bytes.NewReader(body))
+	if err != nil {
+		return merklesignature.Signature{}, err
+	}
+	req.Header.Set("Content-Type", "application/msgpack")
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return merklesignature.Signature{}, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteSignatureSize))
+	if err != nil {
+		return merklesignature.Signature{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return merklesignature.Signature{}, fmt.Errorf("remote signer responded %s: %s", resp.Status, respBody)
+	}
+
+	var sig merklesignature.Signature
+	err = protocol.Decode(respBody, &sig)
+	if err != nil {
+		return merklesignature.Signature{}, fmt.Errorf("remote signer responded with an invalid signature: %w", err)
+	}
+	return sig, nil
+}
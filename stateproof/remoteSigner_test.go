@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package stateproof
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/stateproof"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestMakeRemoteSigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, err := makeRemoteSigner("https://signer.example.com/sign")
+	require.NoError(t, err)
+	_, err = makeRemoteSigner("ftp://signer.example.com/sign")
+	require.Error(t, err)
+	_, err = makeRemoteSigner("://")
+	require.Error(t, err)
+}
+
+func TestWorkerRemoteSigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var keys []account.Participation
+	for i := 0; i < 3; i++ {
+		var parent basics.Address
+		crypto.RandBytes(parent[:])
+		p := newPartKey(t, parent)
+		defer p.Close()
+		keys = append(keys, p.Participation)
+	}
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	round := basics.Round(2 * proto.StateProofInterval)
+	s := newWorkerStubs(t, keys, len(keys))
+	secrets := s.StateProofKeys(round)
+	require.Len(t, secrets, len(keys))
+
+	var requests atomic.Int32
+	var failing atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var req RemoteSignRequest
+		err = protocol.DecodeReflect(body, &req)
+		if err != nil || req.Round != round {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		for _, key := range secrets {
+			if key.Account == req.Account {
+				sig, err := key.StateProofSecrets.SignBytes(req.MessageHash[:])
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				w.Write(protocol.Encode(&sig))
+				return
+			}
+		}
+		http.Error(w, "unknown account", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	w := newTestWorker(t, s)
+	w.signingThreads = 2
+	var err error
+	w.remoteSigner, err = makeRemoteSigner(srv.URL)
+	require.NoError(t, err)
+
+	var hash stateproof.MessageHash
+	crypto.RandBytes(hash[:])
+	verify := func(sigs []sigFromAddr) {
+		require.Len(t, sigs, len(secrets))
+		for i, sfa := range sigs {
+			require.Equal(t, secrets[i].Account, sfa.SignerAddress)
+			require.Equal(t, round, sfa.Round)
+			require.NoError(t, secrets[i].StateProofSecrets.GetVerifier().VerifyBytes(uint64(round), hash[:], &sfa.Sig))
+		}
+	}
+
+	verify(w.signKeys(round, hash, secrets))
+	require.Equal(t, int32(len(secrets)), requests.Load())
+
+	// the worker signs locally when the remote signer fails
+	failing.Store(true)
+	verify(w.signKeys(round, hash, secrets))
+	require.Equal(t, int32(2*len(secrets)), requests.Load())
+}
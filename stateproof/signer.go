@@ -20,10 +20,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/stateproof"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/stateproofmsg"
@@ -146,7 +148,7 @@ func (spw *Worker) getStateProofMessage(round basics.Round) (stateproofmsg.Messa
 func (spw *Worker) signStateProofMessage(message *stateproofmsg.Message, round basics.Round, keys []account.StateProofSecretsForRound) {
 	hashedStateproofMessage := message.Hash()
 
-	sigs := make([]sigFromAddr, 0, len(keys))
+	toSign := make([]account.StateProofSecretsForRound, 0, len(keys))
 
 	for _, key := range keys {
 		if key.FirstValid > round || round > key.LastValid {
@@ -169,19 +171,11 @@ func (spw *Worker) signStateProofMessage(message *stateproofmsg.Message, round b
 			continue
 		}
 
-		sig, err := key.StateProofSecrets.SignBytes(hashedStateproofMessage[:])
-		if err != nil {
-			spw.log.Warnf("spw.signStateProofMessage(%d): StateProofSecrets.Sign: %v", round, err)
-			continue
-		}
-
-		sigs = append(sigs, sigFromAddr{
-			SignerAddress: key.Account,
-			Round:         round,
-			Sig:           sig,
-		})
+		toSign = append(toSign, key)
 	}
 
+	sigs := spw.signKeys(round, hashedStateproofMessage, toSign)
+
 	// any error in handle sig indicates the signature wasn't stored in disk, thus we cannot delete the key.
 	for _, sfa := range sigs {
 		if _, err := spw.handleSig(sfa, nil); err != nil {
@@ -191,3 +185,64 @@ func (spw *Worker) signStateProofMessage(message *stateproofmsg.Message, round b
 		spw.log.Infof("spw.signStateProofMessage(%d): sp message was signed with address %v", round, sfa.SignerAddress)
 	}
 }
+
+// signKeys signs the hashed message with every key. At most spw.signingThreads signatures are
+// computed concurrently, so that signing with many keys doesn't starve the rest of the node.
+func (spw *Worker) signKeys(round basics.Round, hash stateproof.MessageHash, keys []account.StateProofSecretsForRound) []sigFromAddr {
+	threads := spw.signingThreads
+	if threads < 1 {
+		threads = 1
+	}
+
+	results := make([]*sigFromAddr, len(keys))
+	sem := make(chan struct{}, threads)
+	var wg sync.WaitGroup
+	for i := range keys {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			key := &keys[i]
+			sig, err := spw.signKey(round, hash, key)
+			if err != nil {
+				spw.log.Warnf("spw.signStateProofMessage(%d): StateProofSecrets.Sign: %v", round, err)
+				return
+			}
+			results[i] = &sigFromAddr{
+				SignerAddress: key.Account,
+				Round:         round,
+				Sig:           sig,
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	sigs := make([]sigFromAddr, 0, len(keys))
+	for _, sfa := range results {
+		if sfa != nil {
+			sigs = append(sigs, *sfa)
+		}
+	}
+	return sigs
+}
+
+// signKey signs the hashed message with the key, through the remote signer when one is configured.
+// A signature returned by the remote signer is only used once verified with the key.
+func (spw *Worker) signKey(round basics.Round, hash stateproof.MessageHash, key *account.StateProofSecretsForRound) (merklesignature.Signature, error) {
+	if spw.remoteSigner != nil {
+		sig, err := spw.remoteSigner.sign(spw.ctx, key.Account, round, hash)
+		if err == nil {
+			err = key.StateProofSecrets.GetVerifier().VerifyBytes(uint64(round), hash[:], &sig)
+		}
+		if err == nil {
+			return sig, nil
+		}
+		spw.log.Warnf("spw.signKey(%d): remote signer failed for %v, signing locally: %v", round, key.Account, err)
+	}
+
+	return key.StateProofSecrets.SignBytes(hash[:])
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package stateproof
+
+import (
+	"os"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/stateproof/verify"
+	"github.com/algorand/go-algorand/util/metrics"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	nextRoundGauge      = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_next_round", Description: "Next round expected to be attested by a state proof"})
+	pendingProversGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_pending_provers", Description: "Number of state proofs this node is collecting signatures for"})
+	signedWeightGauge   = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_signed_weight", Description: "Signature weight collected for the next expected state proof"})
+	provenWeightGauge   = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_proven_weight", Description: "Signature weight the next expected state proof must exceed"})
+	dbSizeGauge         = metrics.MakeGauge(metrics.MetricName{Name: "algod_stateproof_db_size_bytes", Description: "Size of the state proof database file"})
+)
+
+// MissingParticipant is a voter of a state proof whose signature has not been collected yet.
+type MissingParticipant struct {
+	Address basics.Address
+	Weight  uint64
+}
+
+// ProverStatus describes the signatures collected for a single state proof.
+type ProverStatus struct {
+	// Round is the last round attested by the state proof.
+	Round basics.Round
+	// SignedWeight is the total weight of the signatures collected so far.
+	SignedWeight uint64
+	// ProvenWeight is the weight the signatures must exceed for a proof to be created.
+	ProvenWeight uint64
+	// AcceptableWeight is the signed weight the proof needs in order to be accepted at the current round.
+	AcceptableWeight uint64
+	// TotalWeight is the online weight of all the voters of the state proof.
+	TotalWeight uint64
+	// Participants is the number of voters of the state proof.
+	Participants uint64
+	// Signers is the number of voters whose signature has been collected.
+	Signers uint64
+	// MissingParticipants lists the heaviest voters that have not signed yet, heaviest first.
+	MissingParticipants []MissingParticipant
+}
+
+// WorkerStatus is a snapshot of the worker's progress towards forming state proofs.
+type WorkerStatus struct {
+	// LatestRound is the latest round of the ledger when the snapshot was taken.
+	LatestRound basics.Round
+	// NextStateProofRound is the next round the network expects to be attested by a state proof.
+	NextStateProofRound basics.Round
+	// DBSize is the size in bytes of the state proof database, or 0 if it is kept in memory.
+	DBSize int64
+	// Provers holds the state proofs kept in memory, sorted by round.
+	Provers []ProverStatus
+}
+
+//msgp:ignore MissingParticipant ProverStatus WorkerStatus
+
+// Status returns the worker's current progress. At most maxMissing of the
+// heaviest non-signing voters are reported for every state proof.
+func (spw *Worker) Status(maxMissing int) (WorkerStatus, error) {
+	latest := spw.ledger.Latest()
+	latestHdr, err := spw.ledger.BlockHdr(latest)
+	if err != nil {
+		return WorkerStatus{}, err
+	}
+
+	status := WorkerStatus{
+		LatestRound:         latest,
+		NextStateProofRound: latestHdr.StateProofTracking[protocol.StateProofBasic].StateProofNextRound,
+		DBSize:              spw.dbSize(),
+	}
+
+	spw.mu.Lock()
+	defer spw.mu.Unlock()
+
+	sortedRounds := maps.Keys(spw.provers)
+	slices.Sort(sortedRounds)
+	for _, rnd := range sortedRounds {
+		b := spw.provers[rnd]
+		status.Provers = append(status.Provers, b.status(latest, maxMissing))
+	}
+	return status, nil
+}
+
+func (b *spProver) status(latest basics.Round, maxMissing int) ProverStatus {
+	st := ProverStatus{
+		Round:            basics.Round(b.Round),
+		SignedWeight:     b.SignedWeight(),
+		ProvenWeight:     b.ProvenWeight,
+		AcceptableWeight: verify.AcceptableStateProofWeight(&b.VotersHdr, latest, logging.Base()),
+		TotalWeight:      b.VotersHdr.StateProofTracking[protocol.StateProofBasic].StateProofOnlineTotalWeight.Raw,
+		Participants:     uint64(len(b.Participants)),
+	}
+
+	addrs := make([]basics.Address, len(b.Participants))
+	for addr, pos := range b.AddrToPos {
+		if pos < uint64(len(addrs)) {
+			addrs[pos] = addr
+		}
+	}
+
+	var missing []MissingParticipant
+	for pos, p := range b.Participants {
+		present, err := b.Present(uint64(pos))
+		if err == nil && present {
+			st.Signers++
+			continue
+		}
+		missing = append(missing, MissingParticipant{Address: addrs[pos], Weight: p.Weight})
+	}
+
+	sort.SliceStable(missing, func(i, j int) bool { return missing[i].Weight > missing[j].Weight })
+	if len(missing) > maxMissing {
+		missing = missing[:maxMissing]
+	}
+	st.MissingParticipants = missing
+	return st
+}
+
+func (spw *Worker) dbSize() int64 {
+	if spw.inMemory {
+		return 0
+	}
+	info, err := os.Stat(spw.spDbFileName)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// updateMetrics refreshes the state proof gauges after the worker has processed a new block.
+func (spw *Worker) updateMetrics(stateProofNextRound basics.Round) {
+	nextRoundGauge.Set(uint64(stateProofNextRound))
+	dbSizeGauge.Set(uint64(spw.dbSize()))
+
+	spw.mu.Lock()
+	defer spw.mu.Unlock()
+
+	pendingProversGauge.Set(uint64(len(spw.provers)))
+	if b, ok := spw.provers[stateProofNextRound]; ok {
+		signedWeightGauge.Set(b.SignedWeight())
+		provenWeightGauge.Set(b.ProvenWeight)
+	} else {
+		signedWeightGauge.Set(0)
+		provenWeightGauge.Set(0)
+	}
+}
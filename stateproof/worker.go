@@ -70,26 +70,42 @@ type Worker struct {
 
 	lastCleanupRound basics.Round
 
+	// signingThreads bounds the number of state proof messages signed concurrently.
+	signingThreads int
+	// remoteSigner, when set, is asked for the signatures before signing them with the local keys.
+	remoteSigner *remoteSigner
+
 	// inMemory indicates whether the state proof db should in memory. used for testing.
 	inMemory bool
 }
 
 // NewWorker constructs a new Worker, as used by the node.
-func NewWorker(genesisDir string, log logging.Logger, accts Accounts, ledger Ledger, net Network, txnSender TransactionSender) *Worker {
+func NewWorker(genesisDir string, log logging.Logger, accts Accounts, ledger Ledger, net Network, txnSender TransactionSender, cfg config.Local) *Worker {
 	// Delete the deprecated database file if it exists. This can be removed in future updates since this file should not exist by then.
 	oldCompactCertPath := filepath.Join(genesisDir, "compactcert.sqlite")
 	os.Remove(oldCompactCertPath)
 
 	stateProofPathname := filepath.Join(genesisDir, config.StateProofFileName)
 
+	var signer *remoteSigner
+	if cfg.StateProofRemoteSigner != "" {
+		var err error
+		signer, err = makeRemoteSigner(cfg.StateProofRemoteSigner)
+		if err != nil {
+			log.Errorf("NewWorker: ignoring StateProofRemoteSigner %q: %v", cfg.StateProofRemoteSigner, err)
+		}
+	}
+
 	return &Worker{
-		spDbFileName: stateProofPathname,
-		log:          log,
-		accts:        accts,
-		ledger:       ledger,
-		net:          net,
-		txnSender:    txnSender,
-		inMemory:     false,
+		spDbFileName:   stateProofPathname,
+		log:            log,
+		accts:          accts,
+		ledger:         ledger,
+		net:            net,
+		txnSender:      txnSender,
+		signingThreads: int(cfg.StateProofSigningThreads),
+		remoteSigner:   signer,
+		inMemory:       false,
 	}
 }
 
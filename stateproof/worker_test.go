@@ -720,6 +720,55 @@ func TestWorkerInsufficientSigs(t *testing.T) {
 	}
 }
 
+func TestWorkerStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var keys []account.Participation
+	for i := 0; i < 4; i++ {
+		var parent basics.Address
+		crypto.RandBytes(parent[:])
+		p := newPartKey(t, parent)
+		defer p.Close()
+		keys = append(keys, p.Participation)
+	}
+
+	s := newWorkerStubsWithChannel(t, keys, 10)
+	// only the first two voters are held by this node
+	s.keys = keys[:2]
+	w := newTestWorker(t, s)
+	w.Start()
+	defer w.Stop()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	s.advanceRoundsWithoutStateProof(t, 1)
+
+	for i := 0; i < 2; i++ {
+		_, err := s.waitOnSigWithTimeout(time.Second * 2)
+		require.NoError(t, err)
+	}
+
+	status, err := w.Status(1)
+	require.NoError(t, err)
+	require.Equal(t, s.Latest(), status.LatestRound)
+	require.Equal(t, basics.Round(2*proto.StateProofInterval), status.NextStateProofRound)
+	require.Zero(t, status.DBSize)
+	require.NotEmpty(t, status.Provers)
+
+	prover := status.Provers[0]
+	require.Equal(t, status.NextStateProofRound, prover.Round)
+	require.Equal(t, uint64(4), prover.Participants)
+	require.Equal(t, uint64(2), prover.Signers)
+	require.Equal(t, uint64(2), prover.SignedWeight)
+	require.Equal(t, uint64(10), prover.TotalWeight)
+	require.Less(t, prover.SignedWeight, prover.ProvenWeight)
+
+	// the missing voters are capped by the requested amount
+	require.Len(t, prover.MissingParticipants, 1)
+	missing := prover.MissingParticipants[0].Address
+	require.True(t, missing == keys[2].Parent || missing == keys[3].Parent)
+	require.Equal(t, uint64(1), prover.MissingParticipants[0].Weight)
+}
+
 func TestWorkerRestart(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
@@ -443,3 +443,165 @@ func TestMultisigSignProgram(t *testing.T) {
 	err = crypto.MultisigVerify(logic.Program(program), crypto.Digest(msigAddr), msig)
 	a.NoError(err)
 }
+
+func TestMultisigSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	resp, err := f.Client.GenerateKey([]byte(walletHandleToken))
+	a.NoError(err)
+	pk1 := addrToPK(t, resp.Address)
+	resp, err = f.Client.GenerateKey([]byte(walletHandleToken))
+	a.NoError(err)
+	pk2 := addrToPK(t, resp.Address)
+	pk3 := crypto.PublicKey{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1} // some public key we haven't imported
+
+	// Create a 2-of-3 multisig account from the three public keys
+	resp1, err := f.Client.ImportMultisigAddr([]byte(walletHandleToken), 1, 2, []crypto.PublicKey{pk1, pk2, pk3})
+	a.NoError(err)
+	msigAddr := addrToPK(t, resp1.Address)
+
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     basics.Address(msigAddr),
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+	}
+
+	// Start a session, with the preimage looked up in the wallet
+	resp2, err := f.Client.CreateMultisigSession([]byte(walletHandleToken), tx, 0, 0, nil)
+	a.NoError(err)
+	session := resp2.Session
+	a.Equal(resp1.Address, session.Address)
+	a.Equal(tx.ID().String(), session.TxID)
+	a.Empty(session.Signed)
+	a.Equal([]crypto.PublicKey{pk1, pk2, pk3}, session.Missing)
+	a.False(session.Complete)
+
+	// Add a first signature
+	resp3, err := f.Client.SignMultisigSession([]byte(walletHandleToken), []byte(f.WalletPassword), session.ID, pk1)
+	a.NoError(err)
+	a.Equal([]crypto.PublicKey{pk1}, resp3.Session.Signed)
+	a.False(resp3.Session.Complete)
+
+	// The key which is not in the wallet cannot sign
+	_, err = f.Client.SignMultisigSession([]byte(walletHandleToken), []byte(f.WalletPassword), session.ID, pk3)
+	a.Error(err)
+
+	// Export the partially signed transaction into a new session
+	resp4, err := f.Client.ImportMultisigSession([]byte(walletHandleToken), "", resp3.Session.SignedTransaction)
+	a.NoError(err)
+	a.NotEqual(session.ID, resp4.Session.ID)
+	a.Equal([]crypto.PublicKey{pk1}, resp4.Session.Signed)
+
+	// Complete the new session
+	resp5, err := f.Client.SignMultisigSession([]byte(walletHandleToken), []byte(f.WalletPassword), resp4.Session.ID, pk2)
+	a.NoError(err)
+	a.True(resp5.Session.Complete)
+	a.Equal([]crypto.PublicKey{pk3}, resp5.Session.Missing)
+
+	var stxn transactions.SignedTxn
+	err = protocol.Decode(resp5.Session.SignedTransaction, &stxn)
+	a.NoError(err)
+	a.NoError(crypto.MultisigVerify(tx, crypto.Digest(msigAddr), stxn.Msig))
+
+	// Merge the signatures back into the first session
+	resp6, err := f.Client.ImportMultisigSession([]byte(walletHandleToken), session.ID, resp5.Session.SignedTransaction)
+	a.NoError(err)
+	a.True(resp6.Session.Complete)
+
+	_, err = f.Client.DeleteMultisigSession([]byte(walletHandleToken), session.ID)
+	a.NoError(err)
+	_, err = f.Client.MultisigSessionInfo([]byte(walletHandleToken), session.ID)
+	a.Error(err)
+}
+
+func TestFrostSign(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Split a key between three signers, two of which sign
+	shares, err := crypto.FrostTrustedDealerKeygen(2, 3)
+	a.NoError(err)
+	groupAddr := basics.Address(shares[0].GroupKey).String()
+
+	// Each share lives in a wallet of its own
+	resp, err := f.Client.CreateWallet([]byte("frost"), "sqlite", []byte(f.WalletPassword), crypto.MasterDerivationKey{})
+	a.NoError(err)
+	initResp, err := f.Client.InitWallet([]byte(resp.Wallet.ID), []byte(f.WalletPassword))
+	a.NoError(err)
+	handles := [][]byte{[]byte(walletHandleToken), []byte(initResp.WalletHandleToken)}
+
+	for i, handle := range handles {
+		resp1, err := f.Client.ImportFrostShare(handle, shares[2*i])
+		a.NoError(err)
+		a.Equal(groupAddr, resp1.Address)
+	}
+	resp2, err := f.Client.ListFrostShares(handles[0])
+	a.NoError(err)
+	a.Equal([]string{groupAddr}, resp2.Addresses)
+
+	// A share cannot be imported twice
+	_, err = f.Client.ImportFrostShare(handles[0], shares[0])
+	a.Error(err)
+
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     basics.Address(shares[0].GroupKey),
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+	}
+
+	// First round: every signer commits to its nonces
+	var noncesIDs []string
+	var commitments []kmdapi.APIV1FrostCommitment
+	for _, handle := range handles {
+		resp3, err := f.Client.CommitFrost(handle, groupAddr)
+		a.NoError(err)
+		noncesIDs = append(noncesIDs, resp3.NoncesID)
+		commitments = append(commitments, resp3.Commitment)
+	}
+
+	// Second round: every signer signs its share
+	var sigShares []kmdapi.APIV1FrostSignatureShare
+	for i, handle := range handles {
+		resp4, err := f.Client.SignFrost(handle, []byte(f.WalletPassword), groupAddr, noncesIDs[i], tx, commitments)
+		a.NoError(err)
+		sigShares = append(sigShares, resp4.SignatureShare)
+	}
+
+	// The nonces sign once only
+	_, err = f.Client.SignFrost(handles[0], []byte(f.WalletPassword), groupAddr, noncesIDs[0], tx, commitments)
+	a.Error(err)
+
+	// The shares aggregate into an ed25519 signature of the group key
+	resp5, err := f.Client.AggregateFrost(handles[0], groupAddr, tx, commitments, sigShares)
+	a.NoError(err)
+	var stxn transactions.SignedTxn
+	err = protocol.Decode(resp5.SignedTransaction, &stxn)
+	a.NoError(err)
+	a.True(stxn.AuthAddr.IsZero())
+	a.True(crypto.SignatureVerifier(shares[0].GroupKey).Verify(tx, stxn.Sig))
+
+	// A single share is below the threshold
+	_, err = f.Client.AggregateFrost(handles[0], groupAddr, tx, commitments[:1], sigShares[:1])
+	a.Error(err)
+}
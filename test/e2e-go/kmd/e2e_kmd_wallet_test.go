@@ -17,12 +17,18 @@
 package kmdtest
 
 import (
+	"encoding/hex"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/framework/fixtures"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -80,6 +86,116 @@ func TestWalletCreation(t *testing.T) {
 	a.Error(err)
 }
 
+func TestWalletCreationFromBIP39Mnemonic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	f.Setup(t)
+	defer f.Shutdown()
+
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	password := []byte("password")
+
+	// generateFirstKey creates a wallet from the mnemonic and generates its
+	// first key
+	generateFirstKey := func(name string, mnemonicPassphrase string, account uint32) string {
+		resp, err := f.Client.CreateWalletFromMnemonic([]byte(name), "sqlite", password, mnemonic, mnemonicPassphrase, account)
+		a.NoError(err)
+
+		initResp, err := f.Client.InitWallet([]byte(resp.Wallet.ID), password)
+		a.NoError(err)
+		handle := []byte(initResp.WalletHandleToken)
+
+		// The mnemonic backs up the wallet instead of a master derivation key
+		_, err = f.Client.ExportMasterDerivationKey(handle, password)
+		a.Error(err)
+
+		keyResp, err := f.Client.GenerateKey(handle)
+		a.NoError(err)
+		return keyResp.Address
+	}
+
+	// The same mnemonic derives the same keys
+	addr := generateFirstKey("bip39-a", "", 0)
+	a.Equal(addr, generateFirstKey("bip39-b", "", 0))
+
+	// Other accounts and passphrases derive other keys
+	a.NotEqual(addr, generateFirstKey("bip39-c", "", 1))
+	a.NotEqual(addr, generateFirstKey("bip39-d", "passphrase", 0))
+
+	// Invalid mnemonics are rejected
+	_, err := f.Client.CreateWalletFromMnemonic([]byte("bip39-e"), "sqlite", password, "abandon abandon abandon", "", 0)
+	a.Error(err)
+}
+
+func TestWalletFromBIP39MnemonicARC52(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	f.Setup(t)
+	defer f.Shutdown()
+
+	// The keys of the mnemonic in the test vectors of ARC-52, at
+	// m/44'/283'/0'/0/0 and m/44'/283'/0'/0/1
+	mnemonic := "salon zoo engage submit smile frost later decide wing sight chaos renew lizard rely canal coral scene hobby scare step bus leaf tobacco slice"
+	var pks []crypto.PublicKey
+	for _, h := range []string{
+		"7bda7ac12627b2c259f1df6875d30c10b35f55b33ad2cc8ea2736eaa3ebcfab9",
+		"5bae8828f111064637ac5061bd63bc4fcfe4a833252305f25eeab9c64ecdf519",
+	} {
+		raw, err := hex.DecodeString(h)
+		a.NoError(err)
+		var pk crypto.PublicKey
+		copy(pk[:], raw)
+		pks = append(pks, pk)
+	}
+
+	password := []byte("password")
+	resp, err := f.Client.CreateWalletFromMnemonic([]byte("arc52"), "sqlite", password, mnemonic, "", 0)
+	a.NoError(err)
+	initResp, err := f.Client.InitWallet([]byte(resp.Wallet.ID), password)
+	a.NoError(err)
+	handle := []byte(initResp.WalletHandleToken)
+
+	// The wallet generates the keys of other ARC-52 wallets
+	for _, pk := range pks {
+		keyResp, err := f.Client.GenerateKey(handle)
+		a.NoError(err)
+		a.Equal(basics.Address(pk).String(), keyResp.Address)
+	}
+
+	// Transactions signed with a derived key verify against it
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     basics.Address(pks[0]),
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+	}
+	signResp, err := f.Client.SignTransaction(handle, password, crypto.PublicKey{}, tx)
+	a.NoError(err)
+	var stx transactions.SignedTxn
+	a.NoError(protocol.Decode(signResp.SignedTransaction, &stx))
+	a.True(crypto.SignatureVerifier(pks[0]).Verify(tx, stx.Sig))
+
+	// Derived keys are not ed25519 secret keys, so they cannot be exported
+	exportReq := kmdapi.APIV1POSTKeyExportRequest{
+		WalletHandleToken: string(handle),
+		Address:           basics.Address(pks[0]).String(),
+		WalletPassword:    string(password),
+	}
+	exportResp := kmdapi.APIV1POSTKeyExportResponse{}
+	a.Error(f.Client.DoV1Request(exportReq, &exportResp))
+}
+
 func TestBlankWalletCreation(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	defer fixtures.ShutdownSynchronizedTest(t)
@@ -22,10 +22,12 @@ import (
 	"net"
 
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/tools/network/dnssec"
 )
 
-func readFromSRV(service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) (records []*net.SRV, err error) {
-	log := logging.Base()
+func readFromSRV(service string, protocol string, name string, controller ResolveController) (records []*net.SRV, err error) {
+	log := controller.log
+	fallbackDNSResolverAddress := controller.fallback
 	if name == "" {
 		log.Debug("no dns lookup due to empty name")
 		return
@@ -35,7 +37,13 @@ func readFromSRV(service string, protocol string, name string, fallbackDNSResolv
 		return
 	}
 
-	controller := NewResolveController(secure, fallbackDNSResolverAddress, log)
+	if controller.UpstreamsOnly() {
+		_, records, err = controller.UpstreamResolver().LookupSRV(context.Background(), service, protocol, name)
+		if err != nil {
+			err = fmt.Errorf("ReadFromBootstrap: DNS LookupSRV failed when using upstream resolvers %v: %w", controller.upstreams, err)
+		}
+		return records, err
+	}
 
 	systemResolver := controller.SystemResolver()
 	_, records, sysLookupErr := systemResolver.LookupSRV(context.Background(), service, protocol, name)
@@ -52,6 +60,15 @@ func readFromSRV(service string, protocol string, name string, fallbackDNSResolv
 		}
 
 		if fallbackLookupErr != nil || fallbackDNSResolverAddress == "" {
+			if upstreamResolver := controller.UpstreamResolver(); upstreamResolver != nil {
+				var upstreamLookupErr error
+				_, records, upstreamLookupErr = upstreamResolver.LookupSRV(context.Background(), service, protocol, name)
+				if upstreamLookupErr == nil {
+					return records, nil
+				}
+				log.Infof("ReadFromBootstrap: DNS LookupSRV failed when using upstream resolvers %v: %v", controller.upstreams, upstreamLookupErr)
+			}
+
 			fallbackResolver := controller.DefaultResolver()
 			var defaultLookupErr error
 			_, records, defaultLookupErr = fallbackResolver.LookupSRV(context.Background(), service, protocol, name)
@@ -66,7 +83,20 @@ func readFromSRV(service string, protocol string, name string, fallbackDNSResolv
 
 // ReadFromSRV is a helper to collect SRV addresses for a given name
 func ReadFromSRV(service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) (addrs []string, err error) {
-	records, err := readFromSRV(service, protocol, name, fallbackDNSResolverAddress, secure)
+	return readAddrsFromSRV(service, protocol, name, NewResolveController(secure, fallbackDNSResolverAddress, logging.Base()))
+}
+
+// MakeSRVReader returns a ReadFromSRV equivalent which also queries the given DNSSEC-aware upstreams for the secure lookups,
+// after the system and fallback resolvers, or alone if upstreamsOnly is set.
+func MakeSRVReader(upstreams []dnssec.ResolverAddress, upstreamsOnly bool) func(service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) (addrs []string, err error) {
+	return func(service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) (addrs []string, err error) {
+		controller := NewResolveControllerWithUpstreams(secure, fallbackDNSResolverAddress, upstreams, upstreamsOnly, logging.Base())
+		return readAddrsFromSRV(service, protocol, name, controller)
+	}
+}
+
+func readAddrsFromSRV(service string, protocol string, name string, controller ResolveController) (addrs []string, err error) {
+	records, err := readFromSRV(service, protocol, name, controller)
 	if err != nil {
 		return addrs, err
 	}
@@ -88,7 +118,7 @@ func ReadFromSRV(service string, protocol string, name string, fallbackDNSResolv
 
 // ReadFromSRVPriority is a helper to collect SRV addresses with priorities for a given name
 func ReadFromSRVPriority(service string, protocol string, name string, fallbackDNSResolverAddress string, secure bool) (prioAddrs map[uint16][]string, err error) {
-	records, err := readFromSRV(service, protocol, name, fallbackDNSResolverAddress, secure)
+	records, err := readFromSRV(service, protocol, name, NewResolveController(secure, fallbackDNSResolverAddress, logging.Base()))
 	if err != nil {
 		return prioAddrs, err
 	}
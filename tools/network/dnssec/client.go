@@ -17,8 +17,12 @@
 package dnssec
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/miekg/dns"
@@ -51,8 +55,20 @@ type qsi struct {
 }
 
 // queryServer performs DNS query against provided server with respect of both context and timeout restrictions.
-// If UDP fails then retries with TCP client
+// DNS-over-HTTPS and DNS-over-TLS servers are queried over their transport, others over UDP first, then TCP
+// if the response was truncated.
 func (t qsi) queryServer(ctx context.Context, server ResolverAddress, msg *dns.Msg, timeout time.Duration) (resp *dns.Msg, err error) {
+	switch {
+	case strings.HasPrefix(string(server), dohScheme):
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return queryHTTPS(ctx, dohClient, string(server), msg)
+	case strings.HasPrefix(string(server), dotScheme):
+		address := strings.TrimPrefix(string(server), dotScheme)
+		resp, _, err = (&dns.Client{Net: "tcp-tls", Timeout: timeout}).ExchangeContext(ctx, msg, address)
+		return resp, err
+	}
+
 	for _, netType := range []string{"udp", "tcp"} {
 		if resp, _, err = (&dns.Client{Net: netType, ReadTimeout: timeout}).ExchangeContext(ctx, msg, string(server)); err != nil {
 			return nil, err
@@ -68,6 +84,51 @@ func (t qsi) queryServer(ctx context.Context, server ResolverAddress, msg *dns.M
 	return nil, fmt.Errorf("DNS response for %s is still truncated even after retrying TCP", name)
 }
 
+// dohClient is shared by the DNS-over-HTTPS queries, so that they reuse the connections to the servers
+var dohClient = &http.Client{}
+
+// dohMaxResponseSize is the largest DNS message, as limited by the 16 bits message length of the TCP transport
+const dohMaxResponseSize = 65535
+
+// queryHTTPS performs a DNS-over-HTTPS query, as of RFC 8484, POSTing msg in wire format to url
+func queryHTTPS(ctx context.Context, client *http.Client, url string, msg *dns.Msg) (*dns.Msg, error) {
+	// RFC 8484 section 4.1 recommends a zero id, which makes the responses cache friendly
+	query := msg.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	httpResp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DNS-over-HTTPS server %s responded with status %s", url, httpResp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, dohMaxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	resp := new(dns.Msg)
+	err = resp.Unpack(body)
+	if err != nil {
+		return nil, fmt.Errorf("malformed DNS-over-HTTPS response from %s: %w", url, err)
+	}
+	resp.Id = msg.Id
+	return resp, nil
+}
+
 // query builds a DNS request and tries it against all servers
 func (r *dnsClient) query(ctx context.Context, name string, qtype uint16) (resp *dns.Msg, err error) {
 	name = dns.Fqdn(name)
@@ -18,6 +18,10 @@ package dnssec
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -86,3 +90,59 @@ func TestMockedClient(t *testing.T) {
 	a.Equal(1, len(rr))
 	a.Equal(1, len(rsig))
 }
+
+func TestQueryHTTPS(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := require.New(t)
+
+	var unavailable atomic.Bool
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unavailable.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != dohMediaType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		query := new(dns.Msg)
+		if err = query.Unpack(body); err != nil || query.Id != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		resp := new(dns.Msg)
+		resp.SetReply(query)
+		resp.Answer = []dns.RR{&dns.A{Hdr: dns.RR_Header{Name: query.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: []byte{10, 0, 0, 1}}}
+		packed, err := resp.Pack()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", dohMediaType)
+		w.Write(packed)
+	}))
+	defer srv.Close()
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("example.com.", dns.TypeA)
+	resp, err := queryHTTPS(context.Background(), srv.Client(), srv.URL+"/dns-query", msg)
+	a.NoError(err)
+	a.Equal(msg.Id, resp.Id)
+	a.Equal(1, len(resp.Answer))
+	a.Equal("10.0.0.1", resp.Answer[0].(*dns.A).A.String())
+
+	// the server certificate is not trusted by the default client
+	_, err = queryHTTPS(context.Background(), &http.Client{}, srv.URL+"/dns-query", msg)
+	a.Error(err)
+
+	unavailable.Store(true)
+	_, err = queryHTTPS(context.Background(), srv.Client(), srv.URL+"/dns-query", msg)
+	a.ErrorContains(err, "503")
+}
@@ -17,6 +17,10 @@
 package dnssec
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -41,10 +45,77 @@ const maxTimeout = 5 * time.Second
 // DefaultDnssecAwareNSServers is a list of known public DNSSEC-aware servers
 var DefaultDnssecAwareNSServers = []ResolverAddress{"1.1.1.1:53", "208.67.222.222:53", "8.8.8.8:53", "77.88.8.8:53", "8.26.56.26:53", "180.76.76.76:53"}
 
-// ResolverAddress is ip addr + port as string
+// ResolverAddress is ip addr + port as string, or a DNS-over-TLS (tls://host:port)
+// or DNS-over-HTTPS (https://host/path) server address
 type ResolverAddress string
 
+const dotScheme = "tls://"
+const dohScheme = "https://"
+const dohMediaType = "application/dns-message"
+
 // MakeResolverAddress creates a new ResolverAddress instance from address and port
 func MakeResolverAddress(addr, port string) ResolverAddress {
 	return ResolverAddress(addr + ":" + port)
 }
+
+// ParseResolverAddress validates a DNS server address, which is one of
+// ip:port - plain DNS, over UDP and then TCP. The port defaults to 53
+// tls://host:port - DNS-over-TLS (RFC 7858). The port defaults to 853
+// https://host/path - DNS-over-HTTPS (RFC 8484)
+func ParseResolverAddress(addr string) (ResolverAddress, error) {
+	addr = strings.TrimSpace(addr)
+	switch {
+	case strings.HasPrefix(addr, dohScheme):
+		u, err := url.Parse(addr)
+		if err != nil {
+			return "", err
+		}
+		if u.Host == "" {
+			return "", fmt.Errorf("DNS-over-HTTPS resolver address %s has no host", addr)
+		}
+		return ResolverAddress(addr), nil
+	case strings.HasPrefix(addr, dotScheme):
+		hostport, err := withDefaultPort(strings.TrimPrefix(addr, dotScheme), "853")
+		if err != nil {
+			return "", err
+		}
+		return ResolverAddress(dotScheme + hostport), nil
+	case strings.Contains(addr, "://"):
+		return "", fmt.Errorf("resolver address %s has an unsupported scheme", addr)
+	}
+	hostport, err := withDefaultPort(addr, "53")
+	if err != nil {
+		return "", err
+	}
+	return ResolverAddress(hostport), nil
+}
+
+// ParseResolverAddresses validates a comma separated list of DNS server addresses, see ParseResolverAddress
+func ParseResolverAddresses(addrs string) (servers []ResolverAddress, err error) {
+	for _, addr := range strings.Split(addrs, ",") {
+		if strings.TrimSpace(addr) == "" {
+			continue
+		}
+		server, err := ParseResolverAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
+
+func withDefaultPort(hostport string, port string) (string, error) {
+	if hostport == "" {
+		return "", fmt.Errorf("empty resolver address")
+	}
+	if _, _, err := net.SplitHostPort(hostport); err == nil {
+		return hostport, nil
+	}
+	// bare IPv6 addresses need brackets once joined with the port
+	host := strings.TrimSuffix(strings.TrimPrefix(hostport, "["), "]")
+	if strings.ContainsAny(host, "[]/") {
+		return "", fmt.Errorf("malformed resolver address %s", hostport)
+	}
+	return net.JoinHostPort(host, port), nil
+}
@@ -34,3 +34,40 @@ func TestConfigSystem(t *testing.T) {
 	a.Greater(uint64(tm), uint64(time.Microsecond))
 	a.Less(uint64(tm), uint64(100*time.Second))
 }
+
+func TestParseResolverAddress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := require.New(t)
+	for addr, expected := range map[string]ResolverAddress{
+		"1.1.1.1:53":                       "1.1.1.1:53",
+		"1.1.1.1":                          "1.1.1.1:53",
+		" 8.8.8.8:5353 ":                   "8.8.8.8:5353",
+		"2606:4700:4700::1111":             "[2606:4700:4700::1111]:53",
+		"[2606:4700:4700::1111]:53":        "[2606:4700:4700::1111]:53",
+		"tls://1.1.1.1":                    "tls://1.1.1.1:853",
+		"tls://dns.quad9.net:8853":         "tls://dns.quad9.net:8853",
+		"https://dns.google/dns-query":     "https://dns.google/dns-query",
+		"https://1.1.1.1:443/dns-query?x=": "https://1.1.1.1:443/dns-query?x=",
+	} {
+		parsed, err := ParseResolverAddress(addr)
+		a.NoError(err, addr)
+		a.Equal(expected, parsed)
+	}
+
+	for _, addr := range []string{"", "tls://", "https:///dns-query", "udp://1.1.1.1:53", "1.1.1.1/53"} {
+		_, err := ParseResolverAddress(addr)
+		a.Error(err, addr)
+	}
+
+	servers, err := ParseResolverAddresses("tls://1.1.1.1, https://dns.google/dns-query,,8.8.8.8")
+	a.NoError(err)
+	a.Equal([]ResolverAddress{"tls://1.1.1.1:853", "https://dns.google/dns-query", "8.8.8.8:53"}, servers)
+
+	servers, err = ParseResolverAddresses("")
+	a.NoError(err)
+	a.Empty(servers)
+
+	_, err = ParseResolverAddresses("8.8.8.8,ftp://1.1.1.1")
+	a.Error(err)
+}
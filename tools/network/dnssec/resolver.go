@@ -77,6 +77,19 @@ func MakeDnssecResolver(servers []ResolverAddress, timeout time.Duration) Resolv
 // MakeDefaultDnssecResolver returns a resolver with all possible DNS servers:
 // system, fallback, default
 func MakeDefaultDnssecResolver(fallbackAddress string, log logging.Logger) ResolverIf {
+	return MakeDefaultDnssecResolverWithUpstreams(fallbackAddress, nil, false, log)
+}
+
+// MakeDefaultDnssecResolverWithUpstreams returns a resolver with all possible DNS servers:
+// system, fallback, upstreams, default.
+// If upstreamsOnly is set, the resolver queries the upstreams alone, which allows DNS-over-TLS or
+// DNS-over-HTTPS upstreams to work around local resolvers stripping DNSSEC records or blocked UDP DNS.
+func MakeDefaultDnssecResolverWithUpstreams(fallbackAddress string, upstreams []ResolverAddress, upstreamsOnly bool, log logging.Logger) ResolverIf {
+	if upstreamsOnly && len(upstreams) > 0 {
+		// encrypted upstreams need more time than DefaultTimeout for their handshakes
+		return MakeDnssecResolver(upstreams, maxTimeout)
+	}
+
 	servers, timeout, err := SystemConfig()
 	if err != nil {
 		log.Debugf("retrieving system config failed with %s", err.Error())
@@ -92,6 +105,7 @@ func MakeDefaultDnssecResolver(fallbackAddress string, log logging.Logger) Resol
 	}
 
 	servers = append(servers, MakeResolverAddress(fallback, "53"))
+	servers = append(servers, upstreams...)
 	servers = append(servers, DefaultDnssecAwareNSServers...)
 	return MakeDnssecResolver(servers, timeout)
 }
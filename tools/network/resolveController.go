@@ -20,6 +20,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/tools/network/dnssec"
 )
@@ -36,14 +37,22 @@ var _ dnssec.ResolverIf = &dnssec.Resolver{}
 
 // ResolveController provides a layer of abstaction for a regular, or DNSSEC-aware resolvers
 type ResolveController struct {
-	secure   bool
-	fallback string
-	log      logging.Logger
+	secure        bool
+	fallback      string
+	upstreams     []dnssec.ResolverAddress
+	upstreamsOnly bool
+	log           logging.Logger
 }
 
 // NewResolveController creates a new ResolveController
 func NewResolveController(secure bool, fallbackDNSResolverAddress string, log logging.Logger) ResolveController {
-	return ResolveController{secure, fallbackDNSResolverAddress, log}
+	return ResolveController{secure: secure, fallback: fallbackDNSResolverAddress, log: log}
+}
+
+// NewResolveControllerWithUpstreams creates a new ResolveController which also queries the given DNSSEC-aware upstreams,
+// possibly over DNS-over-TLS or DNS-over-HTTPS. If upstreamsOnly is set, the secure lookups query the upstreams alone.
+func NewResolveControllerWithUpstreams(secure bool, fallbackDNSResolverAddress string, upstreams []dnssec.ResolverAddress, upstreamsOnly bool, log logging.Logger) ResolveController {
+	return ResolveController{secure: secure, fallback: fallbackDNSResolverAddress, upstreams: upstreams, upstreamsOnly: upstreamsOnly, log: log}
 }
 
 // SystemResolver returns a resolver that uses OS-defined DNS servers
@@ -78,6 +87,31 @@ func (c *ResolveController) FallbackResolver() ResolverIf {
 	return &r
 }
 
+// SecureUpstreams returns the DNSSEC-aware upstream DNS servers of the config DNSSecureResolvers.
+// An invalid list is logged and ignored.
+func SecureUpstreams(cfg config.Local, log logging.Logger) []dnssec.ResolverAddress {
+	upstreams, err := dnssec.ParseResolverAddresses(cfg.DNSSecureResolvers)
+	if err != nil {
+		log.Warnf("ignoring DNSSecureResolvers '%s': %v", cfg.DNSSecureResolvers, err)
+		return nil
+	}
+	return upstreams
+}
+
+// UpstreamResolver returns a DNSSEC resolver that uses the upstream DNS servers, or nil if there are none
+// or the lookups are not secure
+func (c *ResolveController) UpstreamResolver() ResolverIf {
+	if !c.secure || len(c.upstreams) == 0 {
+		return nil
+	}
+	return dnssec.MakeDnssecResolver(c.upstreams, dnssec.DefaultTimeout)
+}
+
+// UpstreamsOnly returns true if the secure lookups query the upstream DNS servers alone
+func (c *ResolveController) UpstreamsOnly() bool {
+	return c.secure && c.upstreamsOnly && len(c.upstreams) > 0
+}
+
 // DefaultResolver returns a resolver that uses fallback DNS address
 func (c *ResolveController) DefaultResolver() ResolverIf {
 	if c.secure {
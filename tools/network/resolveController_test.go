@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/tools/network/dnssec"
@@ -121,3 +122,35 @@ func TestRealNamesWithResolver(t *testing.T) {
 		a.Error(err)
 	}
 }
+
+func TestUpstreamResolver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := require.New(t)
+	log := logging.Base()
+
+	cfg := config.GetDefaultLocal()
+	a.Empty(SecureUpstreams(cfg, log))
+	cfg.DNSSecureResolvers = "tls://1.1.1.1, https://dns.google/dns-query"
+	upstreams := SecureUpstreams(cfg, log)
+	a.Equal([]dnssec.ResolverAddress{"tls://1.1.1.1:853", "https://dns.google/dns-query"}, upstreams)
+	cfg.DNSSecureResolvers = "tls://1.1.1.1, ftp://dns.google"
+	a.Empty(SecureUpstreams(cfg, log))
+
+	c := NewResolveController(true, "127.0.0.1", log)
+	a.Nil(c.UpstreamResolver())
+	a.False(c.UpstreamsOnly())
+
+	c = NewResolveControllerWithUpstreams(false, "127.0.0.1", upstreams, true, log)
+	a.Nil(c.UpstreamResolver())
+	a.False(c.UpstreamsOnly())
+
+	c = NewResolveControllerWithUpstreams(true, "127.0.0.1", upstreams, false, log)
+	r := c.UpstreamResolver()
+	a.IsType(&dnssec.Resolver{}, r)
+	a.Equal(upstreams, r.(*dnssec.Resolver).EffectiveResolverDNS())
+	a.False(c.UpstreamsOnly())
+
+	c = NewResolveControllerWithUpstreams(true, "127.0.0.1", upstreams, true, log)
+	a.True(c.UpstreamsOnly())
+}
@@ -64,3 +64,21 @@ func OpenErasablePair(filename string) (p Pair, err error) {
 
 	return
 }
+
+// OpenIncrementalVacuumPair opens the filename with both reading and writing accessors, like OpenPair, with the
+// writing accessor setting the incremental auto_vacuum mode, so that the unused pages of the database can be
+// released with IncrementalVacuum.
+func OpenIncrementalVacuumPair(filename string, memory bool) (p Pair, err error) {
+	p.Rdb, err = MakeAccessor(filename, true, memory)
+	if err != nil {
+		return
+	}
+
+	p.Wdb, err = makeIncrementalVacuumAccessor(filename, memory)
+	if err != nil {
+		p.Rdb.Close()
+		return
+	}
+
+	return
+}
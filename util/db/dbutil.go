@@ -96,6 +96,13 @@ func makeErasableAccessor(dbfilename string, readOnly bool) (Accessor, error) {
 	return makeAccessorImpl(dbfilename, readOnly, false, []string{"_secure_delete=on", "_journal_mode=wal"})
 }
 
+// makeIncrementalVacuumAccessor creates a new Accessor whose connections set the incremental auto_vacuum mode.
+// The mode applies to a database created through the accessor, and to an existing database once it is rewritten
+// with Vacuum; see https://www.sqlite.org/pragma.html#pragma_auto_vacuum
+func makeIncrementalVacuumAccessor(dbfilename string, inMemory bool) (Accessor, error) {
+	return makeAccessorImpl(dbfilename, false, inMemory, []string{"_auto_vacuum=incremental", "_journal_mode=wal"})
+}
+
 func makeAccessorImpl(dbfilename string, readOnly bool, inMemory bool, params []string) (Accessor, error) {
 	var db Accessor
 	db.readOnly = readOnly
@@ -363,6 +370,147 @@ func (db *Accessor) Vacuum(ctx context.Context) (stats VacuumStats, err error) {
 	return
 }
 
+// IncrementalVacuumStats holds the outcome of an incremental vacuum of a database.
+type IncrementalVacuumStats struct {
+	// Incremental is true if the database is in the incremental auto_vacuum mode. Otherwise its free pages
+	// are only released by a full Vacuum, and the other fields are left empty.
+	Incremental bool
+	// PagesBefore is the number of pages in the database before the incremental vacuum
+	PagesBefore uint64
+	// FreePagesBefore is the number of unused pages in the database before the incremental vacuum
+	FreePagesBefore uint64
+	// PagesAfter is the number of pages in the database after the incremental vacuum
+	PagesAfter uint64
+	// FreePagesAfter is the number of unused pages in the database after the incremental vacuum
+	FreePagesAfter uint64
+}
+
+// IncrementalVacuum releases up to maxPages unused pages of the database back to the file system, or all of them if
+// maxPages is 0. Unlike Vacuum, it does not rewrite the database, and only holds the write lock while the released
+// pages are moved, so it can run alongside other transactions. It has no effect unless the database is in the
+// incremental auto_vacuum mode.
+func (db *Accessor) IncrementalVacuum(ctx context.Context, maxPages uint64) (stats IncrementalVacuumStats, err error) {
+	if db.readOnly {
+		return stats, fmt.Errorf("read-only database was used to attempt and perform incremental vacuuming")
+	}
+	stats.Incremental, err = db.IncrementalVacuumEnabled(ctx)
+	if err != nil || !stats.Incremental {
+		return stats, err
+	}
+	stats.PagesBefore, err = db.GetPageCount(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.FreePagesBefore, err = db.GetFreePageCount(ctx)
+	if err != nil {
+		return stats, err
+	}
+	if stats.FreePagesBefore > 0 {
+		// the incremental_vacuum pragma releases one page per step, so the rows need to be read through
+		// rather than executing the statement once.
+		var rows *sql.Rows
+		rows, err = db.Handle.QueryContext(ctx, fmt.Sprintf("PRAGMA incremental_vacuum(%d)", maxPages))
+		if err != nil {
+			return stats, err
+		}
+		for rows.Next() {
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return stats, err
+		}
+	}
+	stats.PagesAfter, err = db.GetPageCount(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.FreePagesAfter, err = db.GetFreePageCount(ctx)
+	return
+}
+
+// IncrementalVacuumEnabled returns true if the database is in the incremental auto_vacuum mode.
+func (db *Accessor) IncrementalVacuumEnabled(ctx context.Context) (bool, error) {
+	var mode int
+	err := db.Handle.QueryRowContext(ctx, "PRAGMA auto_vacuum").Scan(&mode)
+	if err == sql.ErrNoRows {
+		err = fmt.Errorf("sqlite database doesn't support `PRAGMA auto_vacuum`")
+	}
+	// 2 is the incremental auto_vacuum mode.
+	return mode == 2, err
+}
+
+// Optimize refreshes the statistics used by the query planner for the tables whose content changed enough since
+// they were last analyzed; see https://www.sqlite.org/pragma.html#pragma_optimize
+func (db *Accessor) Optimize(ctx context.Context) error {
+	if db.readOnly {
+		return fmt.Errorf("read-only database was used to attempt and perform optimization")
+	}
+	_, err := db.Handle.ExecContext(ctx, "PRAGMA optimize")
+	return err
+}
+
+// Backup copies the database into the new database file filename with the SQLite online backup API. The copy is a
+// consistent snapshot of the database, read within a single read transaction while the other connections keep
+// reading and writing it, and retried for as long as they hold an exclusive lock or until ctx is done.
+func (db *Accessor) Backup(ctx context.Context, filename string) error {
+	if db.inMemory {
+		return fmt.Errorf("in-memory database cannot be backed up to %s", filename)
+	}
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		return fmt.Errorf("backup file %s already exists", filename)
+	}
+	dest, err := MakeAccessor(filename, false, false)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+
+	srcConn, err := db.Handle.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+
+	// the read transaction starts with its first read, and the backup then copies the pages it sees
+	_, err = srcConn.ExecContext(ctx, "BEGIN")
+	if err != nil {
+		return err
+	}
+	defer srcConn.ExecContext(context.Background(), "ROLLBACK")
+	_, err = srcConn.ExecContext(ctx, "SELECT COUNT(*) FROM sqlite_master")
+	if err != nil {
+		return err
+	}
+	destConn, err := dest.Handle.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer destConn.Close()
+
+	return destConn.Raw(func(destDriverConn interface{}) error {
+		return srcConn.Raw(func(srcDriverConn interface{}) error {
+			backup, err := destDriverConn.(*sqlite3.SQLiteConn).Backup("main", srcDriverConn.(*sqlite3.SQLiteConn), "main")
+			if err != nil {
+				return err
+			}
+			defer backup.Close()
+			for {
+				// copying every page in a single step keeps the copy consistent
+				done, err := backup.Step(-1)
+				if err != nil || done {
+					return err
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+		})
+	})
+}
+
 // URI returns the sqlite URI given a db filename as an input.
 func URI(filename string, readOnly bool, memory bool) string {
 	uri := fmt.Sprintf("file:%s?_busy_timeout=%d&_synchronous=full", filename, busy)
@@ -385,6 +533,15 @@ func (db *Accessor) GetPageCount(ctx context.Context) (pageCount uint64, err err
 	return
 }
 
+// GetFreePageCount returns the number of unused pages in the database
+func (db *Accessor) GetFreePageCount(ctx context.Context) (freePageCount uint64, err error) {
+	err = db.Handle.QueryRowContext(ctx, "PRAGMA freelist_count").Scan(&freePageCount)
+	if err == sql.ErrNoRows {
+		err = fmt.Errorf("sqlite database doesn't support `PRAGMA freelist_count`")
+	}
+	return
+}
+
 // GetPageSize returns the number of bytes per database page
 func (db *Accessor) GetPageSize(ctx context.Context) (pageSize uint64, err error) {
 	err = db.Handle.QueryRowContext(ctx, "PRAGMA page_size").Scan(&pageSize)
@@ -583,3 +583,99 @@ func randStringBytes(n uint) string {
 	}
 	return string(b)
 }
+
+func TestIncrementalVacuum(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dbName := filepath.Join(t.TempDir(), "incremental.db")
+	pair, err := OpenIncrementalVacuumPair(dbName, false)
+	require.NoError(t, err)
+	defer pair.Close()
+
+	_, err = pair.Wdb.Handle.Exec("CREATE TABLE foo (a INTEGER PRIMARY KEY, b BLOB)")
+	require.NoError(t, err)
+	for i := 0; i < 200; i++ {
+		_, err = pair.Wdb.Handle.Exec("INSERT INTO foo(a,b) VALUES (?,?)", i, make([]byte, 4096))
+		require.NoError(t, err)
+	}
+	_, err = pair.Wdb.Handle.Exec("DELETE FROM foo")
+	require.NoError(t, err)
+	require.NoError(t, pair.Wdb.Optimize(context.Background()))
+
+	freePages, err := pair.Wdb.GetFreePageCount(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, freePages, uint64(20))
+
+	stats, err := pair.Wdb.IncrementalVacuum(context.Background(), 10)
+	require.NoError(t, err)
+	require.True(t, stats.Incremental)
+	require.Equal(t, freePages, stats.FreePagesBefore)
+	require.Equal(t, freePages-10, stats.FreePagesAfter)
+	require.Less(t, stats.PagesAfter, stats.PagesBefore)
+
+	stats, err = pair.Wdb.IncrementalVacuum(context.Background(), 0)
+	require.NoError(t, err)
+	require.Zero(t, stats.FreePagesAfter)
+
+	_, err = pair.Rdb.IncrementalVacuum(context.Background(), 0)
+	require.Error(t, err)
+
+	// a database that is not in the incremental auto_vacuum mode keeps its free pages.
+	accessor, err := MakeAccessor(filepath.Join(t.TempDir(), "full.db"), false, false)
+	require.NoError(t, err)
+	defer accessor.Close()
+	_, err = accessor.Handle.Exec("CREATE TABLE foo (a INTEGER PRIMARY KEY, b BLOB)")
+	require.NoError(t, err)
+	incremental, err := accessor.IncrementalVacuumEnabled(context.Background())
+	require.NoError(t, err)
+	require.False(t, incremental)
+	stats, err = accessor.IncrementalVacuum(context.Background(), 0)
+	require.NoError(t, err)
+	require.False(t, stats.Incremental)
+}
+
+func TestBackup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	acc, err := MakeAccessor(filepath.Join(dir, "src.db"), false, false)
+	require.NoError(t, err)
+	defer acc.Close()
+
+	err = acc.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err0 := tx.Exec("CREATE TABLE t (a integer)")
+		if err0 != nil {
+			return err0
+		}
+		for i := 0; i < 1000; i++ {
+			_, err0 = tx.Exec("INSERT INTO t (a) VALUES (?)", i)
+			if err0 != nil {
+				return err0
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	backupFile := filepath.Join(dir, "backup.db")
+	require.NoError(t, acc.Backup(context.Background(), backupFile))
+	require.Error(t, acc.Backup(context.Background(), backupFile))
+
+	backup, err := MakeAccessor(backupFile, true, false)
+	require.NoError(t, err)
+	defer backup.Close()
+	var count, sum int
+	err = backup.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRow("SELECT COUNT(*), SUM(a) FROM t").Scan(&count, &sum)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1000, count)
+	require.Equal(t, 999*1000/2, sum)
+
+	mem, err := MakeAccessor("mem.db", false, true)
+	require.NoError(t, err)
+	defer mem.Close()
+	require.Error(t, mem.Backup(context.Background(), filepath.Join(dir, "mem.db")))
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package erasure
+
+import (
+	"errors"
+)
+
+// gfPolynomial is the primitive polynomial x^8 + x^4 + x^3 + x^2 + 1 used
+// to construct GF(2^8).
+const gfPolynomial = 0x11d
+
+var errSingularMatrix = errors.New("matrix is singular")
+
+var (
+	gfExp [510]byte
+	gfLog [256]int
+	// gfMulTable holds the product of every pair of field elements.
+	gfMulTable [256][256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfLog[x] = i
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= gfPolynomial
+		}
+	}
+	for i := 255; i < len(gfExp); i++ {
+		gfExp[i] = gfExp[i-255]
+	}
+
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			gfMulTable[a][b] = gfExp[gfLog[a]+gfLog[b]]
+		}
+	}
+}
+
+func gfMul(a, b byte) byte {
+	return gfMulTable[a][b]
+}
+
+// gfInv returns the multiplicative inverse of a, which must be nonzero.
+func gfInv(a byte) byte {
+	return gfExp[255-gfLog[a]]
+}
+
+func gfPow(a byte, n int) byte {
+	if n == 0 {
+		return 1
+	}
+	if a == 0 {
+		return 0
+	}
+	return gfExp[(gfLog[a]*n)%255]
+}
+
+// mulAdd sets dst[i] ^= c * src[i] for every i.
+func mulAdd(dst, src []byte, c byte) {
+	if c == 0 {
+		return
+	}
+	row := &gfMulTable[c]
+	for i, b := range src {
+		dst[i] ^= row[b]
+	}
+}
+
+// multiplyMatrix returns the product of an r x n and an n x c matrix.
+func multiplyMatrix(a, b [][]byte) [][]byte {
+	out := make([][]byte, len(a))
+	for r := range a {
+		out[r] = make([]byte, len(b[0]))
+		for c := range out[r] {
+			var v byte
+			for k := range b {
+				v ^= gfMul(a[r][k], b[k][c])
+			}
+			out[r][c] = v
+		}
+	}
+	return out
+}
+
+// invertMatrix returns the inverse of the square matrix m using
+// Gauss-Jordan elimination. m is not modified.
+func invertMatrix(m [][]byte) ([][]byte, error) {
+	n := len(m)
+	work := make([][]byte, n)
+	for r := range work {
+		work[r] = make([]byte, 2*n)
+		copy(work[r], m[r])
+		work[r][n+r] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for pivot < n && work[pivot][col] == 0 {
+			pivot++
+		}
+		if pivot == n {
+			return nil, errSingularMatrix
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+
+		inv := gfInv(work[col][col])
+		for c := range work[col] {
+			work[col][c] = gfMul(work[col][c], inv)
+		}
+
+		for r := 0; r < n; r++ {
+			if r == col || work[r][col] == 0 {
+				continue
+			}
+			f := work[r][col]
+			mulAdd(work[r], work[col], f)
+		}
+	}
+
+	out := make([][]byte, n)
+	for r := range out {
+		out[r] = work[r][n:]
+	}
+	return out, nil
+}
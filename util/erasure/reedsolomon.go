@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package erasure implements a systematic Reed-Solomon erasure code over
+// GF(2^8). A payload is split into data shards and extended with parity
+// shards such that any subset of shards as large as the number of data
+// shards is sufficient to recover the payload.
+package erasure
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxShards is the largest total number of shards (data plus parity) a
+// Coder supports.
+const MaxShards = 256
+
+var (
+	// ErrInvalidShardCount is returned when a Coder is configured with an
+	// unsupported number of shards.
+	ErrInvalidShardCount = errors.New("invalid number of shards")
+	// ErrTooFewShards is returned when fewer shards than data shards are
+	// available for reconstruction.
+	ErrTooFewShards = errors.New("too few shards to reconstruct data")
+	// ErrShardSize is returned when the available shards are not all the
+	// size implied by the payload length.
+	ErrShardSize = errors.New("shards have inconsistent sizes")
+)
+
+// Coder encodes and reconstructs payloads with a fixed number of data and
+// parity shards. A Coder is immutable and safe for concurrent use.
+type Coder struct {
+	dataShards   int
+	parityShards int
+
+	// matrix is the (dataShards+parityShards) x dataShards encoding
+	// matrix. Its first dataShards rows form the identity matrix, so the
+	// data shards are the payload itself.
+	matrix [][]byte
+}
+
+// MakeCoder returns a Coder producing dataShards data shards and
+// parityShards parity shards.
+func MakeCoder(dataShards, parityShards int) (*Coder, error) {
+	if dataShards < 1 || parityShards < 0 || dataShards+parityShards > MaxShards {
+		return nil, fmt.Errorf("%w: %d data, %d parity", ErrInvalidShardCount, dataShards, parityShards)
+	}
+
+	total := dataShards + parityShards
+	vandermonde := make([][]byte, total)
+	for r := range vandermonde {
+		vandermonde[r] = make([]byte, dataShards)
+		for c := range vandermonde[r] {
+			vandermonde[r][c] = gfPow(byte(r), c)
+		}
+	}
+
+	// Any dataShards rows of a Vandermonde matrix with distinct evaluation
+	// points are linearly independent. Multiplying by the inverse of the
+	// top square makes the code systematic while preserving that property.
+	topInv, err := invertMatrix(vandermonde[:dataShards])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Coder{
+		dataShards:   dataShards,
+		parityShards: parityShards,
+		matrix:       multiplyMatrix(vandermonde, topInv),
+	}, nil
+}
+
+// DataShards returns the number of data shards.
+func (c *Coder) DataShards() int {
+	return c.dataShards
+}
+
+// ParityShards returns the number of parity shards.
+func (c *Coder) ParityShards() int {
+	return c.parityShards
+}
+
+// TotalShards returns the number of data and parity shards.
+func (c *Coder) TotalShards() int {
+	return c.dataShards + c.parityShards
+}
+
+// ShardSize returns the size of every shard for a payload of the given
+// length.
+func (c *Coder) ShardSize(length int) int {
+	return (length + c.dataShards - 1) / c.dataShards
+}
+
+// Encode splits data into data shards, padding the last one with zeros, and
+// computes the parity shards. The returned slice holds TotalShards shards of
+// ShardSize(len(data)) bytes each.
+func (c *Coder) Encode(data []byte) [][]byte {
+	size := c.ShardSize(len(data))
+	buf := make([]byte, size*c.TotalShards())
+	copy(buf, data)
+
+	shards := make([][]byte, c.TotalShards())
+	for i := range shards {
+		shards[i] = buf[i*size : (i+1)*size : (i+1)*size]
+	}
+
+	for p := c.dataShards; p < len(shards); p++ {
+		for d := 0; d < c.dataShards; d++ {
+			mulAdd(shards[p], shards[d], c.matrix[p][d])
+		}
+	}
+	return shards
+}
+
+// Reconstruct recovers the original payload of the given length from
+// shards. Missing shards are nil; shards must be indexed as returned by
+// Encode. At least DataShards shards must be present.
+func (c *Coder) Reconstruct(shards [][]byte, length int) ([]byte, error) {
+	if len(shards) != c.TotalShards() {
+		return nil, fmt.Errorf("%w: got %d, expected %d", ErrInvalidShardCount, len(shards), c.TotalShards())
+	}
+	size := c.ShardSize(length)
+
+	// Pick the first dataShards shards available, preferring data shards
+	// since they need no decoding.
+	rows := make([]int, 0, c.dataShards)
+	for i, shard := range shards {
+		if shard == nil {
+			continue
+		}
+		if len(shard) != size {
+			return nil, fmt.Errorf("%w: shard %d has %d bytes, expected %d", ErrShardSize, i, len(shard), size)
+		}
+		if len(rows) < c.dataShards {
+			rows = append(rows, i)
+		}
+	}
+	if len(rows) < c.dataShards {
+		return nil, fmt.Errorf("%w: got %d, need %d", ErrTooFewShards, len(rows), c.dataShards)
+	}
+
+	out := make([]byte, size*c.dataShards)
+	if rows[c.dataShards-1] < c.dataShards {
+		// all data shards are present
+		for d := 0; d < c.dataShards; d++ {
+			copy(out[d*size:], shards[d])
+		}
+		return out[:length], nil
+	}
+
+	sub := make([][]byte, c.dataShards)
+	for i, r := range rows {
+		sub[i] = c.matrix[r]
+	}
+	decode, err := invertMatrix(sub)
+	if err != nil {
+		return nil, err
+	}
+
+	for d := 0; d < c.dataShards; d++ {
+		dst := out[d*size : (d+1)*size]
+		if shards[d] != nil {
+			copy(dst, shards[d])
+			continue
+		}
+		for i, r := range rows {
+			mulAdd(dst, shards[r], decode[d][i])
+		}
+	}
+	return out[:length], nil
+}
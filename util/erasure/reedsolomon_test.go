@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package erasure
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGaloisField(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for a := 1; a < 256; a++ {
+		require.Equal(t, byte(1), gfMul(byte(a), gfInv(byte(a))), "a=%d", a)
+		require.Equal(t, byte(0), gfMul(byte(a), 0))
+		require.Equal(t, gfMul(byte(a), byte(a)), gfPow(byte(a), 2))
+	}
+	require.Equal(t, byte(1), gfPow(0, 0))
+	require.Equal(t, byte(0), gfPow(0, 3))
+}
+
+func TestMakeCoderInvalid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, err := MakeCoder(0, 4)
+	require.ErrorIs(t, err, ErrInvalidShardCount)
+	_, err = MakeCoder(4, -1)
+	require.ErrorIs(t, err, ErrInvalidShardCount)
+	_, err = MakeCoder(200, 57)
+	require.ErrorIs(t, err, ErrInvalidShardCount)
+	_, err = MakeCoder(200, 56)
+	require.NoError(t, err)
+}
+
+func TestEncodeSystematic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c, err := MakeCoder(4, 2)
+	require.NoError(t, err)
+
+	data := []byte("0123456789")
+	shards := c.Encode(data)
+	require.Len(t, shards, 6)
+	require.Equal(t, 3, c.ShardSize(len(data)))
+	require.Equal(t, []byte("012"), shards[0])
+	require.Equal(t, []byte("345"), shards[1])
+	require.Equal(t, []byte("678"), shards[2])
+	require.Equal(t, []byte{'9', 0, 0}, shards[3])
+	for _, shard := range shards {
+		require.Len(t, shard, 3)
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, tc := range []struct{ data, parity, length int }{
+		{1, 0, 100},
+		{1, 3, 100},
+		{4, 2, 1},
+		{10, 4, 10000},
+		{16, 4, 65537},
+		{100, 155, 3000},
+	} {
+		c, err := MakeCoder(tc.data, tc.parity)
+		require.NoError(t, err)
+
+		payload := make([]byte, tc.length)
+		_, err = rand.Read(payload)
+		require.NoError(t, err)
+		shards := c.Encode(payload)
+
+		// every shard present
+		out, err := c.Reconstruct(shards, len(payload))
+		require.NoError(t, err)
+		require.Equal(t, payload, out)
+
+		// drop as many shards as there are parity shards, from the front,
+		// so that data shards have to be recovered
+		missing := make([][]byte, len(shards))
+		copy(missing, shards)
+		for i := 0; i < tc.parity; i++ {
+			missing[i] = nil
+		}
+		out, err = c.Reconstruct(missing, len(payload))
+		require.NoError(t, err)
+		require.Equal(t, payload, out)
+
+		// drop every other shard while enough remain
+		missing = make([][]byte, len(shards))
+		copy(missing, shards)
+		for i, dropped := 0, 0; i < len(missing) && dropped < tc.parity; i += 2 {
+			missing[i] = nil
+			dropped++
+		}
+		out, err = c.Reconstruct(missing, len(payload))
+		require.NoError(t, err)
+		require.Equal(t, payload, out)
+
+		if tc.data > 1 {
+			// one shard too few
+			for i := 0; i <= tc.parity; i++ {
+				missing[len(missing)-1-i] = nil
+			}
+			missing[0] = nil
+			_, err = c.Reconstruct(missing, len(payload))
+			require.ErrorIs(t, err, ErrTooFewShards)
+		}
+	}
+}
+
+func TestReconstructErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c, err := MakeCoder(3, 2)
+	require.NoError(t, err)
+	shards := c.Encode([]byte("erasure coded payload"))
+
+	_, err = c.Reconstruct(shards[:4], 21)
+	require.ErrorIs(t, err, ErrInvalidShardCount)
+
+	shards[1] = shards[1][:2]
+	_, err = c.Reconstruct(shards, 21)
+	require.ErrorIs(t, err, ErrShardSize)
+}
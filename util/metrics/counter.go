@@ -79,6 +79,10 @@ func (counter *Counter) addLabels(x uint64, labels map[string]string) {
 
 	// find where we have the same labels.
 	if counterIdx, has := counter.valuesIndices[labelIndex]; !has {
+		// the series without labels is always kept, others are subject to the limit of series.
+		if len(labels) > 0 && !labelSetAllowed(len(counter.values)) {
+			return
+		}
 		// we need to add a new counter.
 		val := &counterValues{
 			counter: x,
@@ -209,6 +213,13 @@ func (counter *Counter) WriteMetric(buf *strings.Builder, parentLabels string) {
 	}
 }
 
+// Describe is part of the Metric interface
+func (counter *Counter) Describe() MetricDescription {
+	counter.Lock()
+	defer counter.Unlock()
+	return MetricDescription{Name: counter.name, Description: counter.description, Type: "counter", Series: len(counter.values)}
+}
+
 // AddMetric adds the metric into the map
 func (counter *Counter) AddMetric(values map[string]float64) {
 	counter.Lock()
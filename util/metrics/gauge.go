@@ -87,6 +87,11 @@ func (gauge *Gauge) WriteMetric(buf *strings.Builder, parentLabels string) {
 	buf.WriteString("\n")
 }
 
+// Describe is part of the Metric interface
+func (gauge *Gauge) Describe() MetricDescription {
+	return MetricDescription{Name: gauge.name, Description: gauge.description, Type: "gauge", Series: 1}
+}
+
 // AddMetric adds the metric into the map
 func (gauge *Gauge) AddMetric(values map[string]float64) {
 	value := atomic.LoadUint64(&gauge.value)
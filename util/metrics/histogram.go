@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the buckets used for latency histograms.
+var DefaultLatencyBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Histogram samples observations, such as request durations, into buckets.
+// Observations are partitioned by the value of a single label.
+type Histogram struct {
+	deadlock.Mutex
+	name        string
+	description string
+	labelName   string
+	buckets     []float64
+	values      map[string]*histogramValues
+}
+
+type histogramValues struct {
+	// counts holds the number of observations falling in each bucket, the last one being +Inf.
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// MakeHistogram creates a new histogram with the provided name and description, partitioned
+// by the label labelName, and using the given increasing bucket upper bounds.
+func MakeHistogram(metric MetricName, labelName string, buckets []float64) *Histogram {
+	h := &Histogram{
+		name:        metric.Name,
+		description: metric.Description,
+		labelName:   labelName,
+		buckets:     buckets,
+		values:      make(map[string]*histogramValues),
+	}
+	h.Register(nil)
+	return h
+}
+
+// Register registers the histogram with the default/specific registry
+func (h *Histogram) Register(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Register(h)
+	} else {
+		reg.Register(h)
+	}
+}
+
+// Deregister deregisters the histogram with the default/specific registry
+func (h *Histogram) Deregister(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Deregister(h)
+	} else {
+		reg.Deregister(h)
+	}
+}
+
+// Observe adds an observation of x for the given label value.
+func (h *Histogram) Observe(labelValue string, x float64) {
+	bucket := sort.SearchFloat64s(h.buckets, x)
+
+	h.Lock()
+	defer h.Unlock()
+	v, has := h.values[labelValue]
+	if !has {
+		if !labelSetAllowed(len(h.values)) {
+			return
+		}
+		v = &histogramValues{counts: make([]uint64, len(h.buckets)+1)}
+		h.values[labelValue] = v
+	}
+	v.counts[bucket]++
+	v.count++
+	v.sum += x
+}
+
+// ObserveSince adds an observation of the seconds elapsed since t for the given label value.
+func (h *Histogram) ObserveSince(labelValue string, t time.Time) {
+	h.Observe(labelValue, time.Since(t).Seconds())
+}
+
+// sortedLabelValues returns the label values observed so far. The caller must hold the lock.
+func (h *Histogram) sortedLabelValues() []string {
+	labelValues := make([]string, 0, len(h.values))
+	for l := range h.values {
+		labelValues = append(labelValues, l)
+	}
+	sort.Strings(labelValues)
+	return labelValues
+}
+
+// WriteMetric writes the metric into the output stream
+func (h *Histogram) WriteMetric(buf *strings.Builder, parentLabels string) {
+	h.Lock()
+	defer h.Unlock()
+
+	buf.WriteString("# HELP ")
+	buf.WriteString(h.name)
+	buf.WriteString(" ")
+	buf.WriteString(h.description)
+	buf.WriteString("\n# TYPE ")
+	buf.WriteString(h.name)
+	buf.WriteString(" histogram\n")
+
+	for _, l := range h.sortedLabelValues() {
+		v := h.values[l]
+		labels := h.labelName + "=\"" + l + "\""
+		if len(parentLabels) > 0 {
+			labels = parentLabels + "," + labels
+		}
+
+		cumulative := uint64(0)
+		for i, count := range v.counts {
+			cumulative += count
+			le := "+Inf"
+			if i < len(h.buckets) {
+				le = strconv.FormatFloat(h.buckets[i], 'g', -1, 64)
+			}
+			buf.WriteString(h.name + "_bucket{" + labels + ",le=\"" + le + "\"} ")
+			buf.WriteString(strconv.FormatUint(cumulative, 10))
+			buf.WriteString("\n")
+		}
+		buf.WriteString(h.name + "_sum{" + labels + "} ")
+		buf.WriteString(strconv.FormatFloat(v.sum, 'g', -1, 64))
+		buf.WriteString("\n")
+		buf.WriteString(h.name + "_count{" + labels + "} ")
+		buf.WriteString(strconv.FormatUint(v.count, 10))
+		buf.WriteString("\n")
+	}
+}
+
+// Describe is part of the Metric interface
+func (h *Histogram) Describe() MetricDescription {
+	h.Lock()
+	defer h.Unlock()
+	return MetricDescription{Name: h.name, Description: h.description, Type: "histogram", Series: len(h.values)}
+}
+
+// AddMetric adds the number and sum of observations of each label value into the map
+func (h *Histogram) AddMetric(values map[string]float64) {
+	h.Lock()
+	defer h.Unlock()
+
+	for l, v := range h.values {
+		suffix := ":" + h.labelName + "=" + l
+		values[sanitizeTelemetryName(h.name+"_count"+suffix)] = float64(v.count)
+		values[sanitizeTelemetryName(h.name+"_sum"+suffix)] = v.sum
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramWriteMetric(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h := MakeHistogram(MetricName{Name: "latency", Description: "request latency"}, "endpoint", []float64{0.1, 1})
+	DefaultRegistry().Deregister(h)
+
+	// an empty histogram only has its header
+	var sb strings.Builder
+	h.WriteMetric(&sb, "")
+	require.Equal(t, "# HELP latency request latency\n# TYPE latency histogram\n", sb.String())
+
+	h.Observe("b", 0.05)
+	h.Observe("b", 0.1)
+	h.Observe("b", 0.5)
+	h.Observe("b", 3)
+	h.Observe("a", 0.5)
+
+	sb.Reset()
+	h.WriteMetric(&sb, `host="h"`)
+	expected := `# HELP latency request latency
+# TYPE latency histogram
+latency_bucket{host="h",endpoint="a",le="0.1"} 0
+latency_bucket{host="h",endpoint="a",le="1"} 1
+latency_bucket{host="h",endpoint="a",le="+Inf"} 1
+latency_sum{host="h",endpoint="a"} 0.5
+latency_count{host="h",endpoint="a"} 1
+latency_bucket{host="h",endpoint="b",le="0.1"} 2
+latency_bucket{host="h",endpoint="b",le="1"} 3
+latency_bucket{host="h",endpoint="b",le="+Inf"} 4
+latency_sum{host="h",endpoint="b"} 3.65
+latency_count{host="h",endpoint="b"} 4
+`
+	require.Equal(t, expected, sb.String())
+
+	result := make(map[string]float64)
+	h.AddMetric(result)
+	require.Equal(t, map[string]float64{
+		"latency_count_endpoint_a": 1,
+		"latency_sum_endpoint_a":   0.5,
+		"latency_count_endpoint_b": 4,
+		"latency_sum_endpoint_b":   3.65,
+	}, result)
+}
@@ -132,4 +132,9 @@ var (
 	TransactionGroupTxSyncRemember = MetricName{Name: "algod_transaction_group_txsync_remember", Description: "Number of transaction groups remembered via txsync"}
 	// TransactionGroupTxSyncAlreadyCommitted "Number of duplicate or error transaction groups received via txsync"
 	TransactionGroupTxSyncAlreadyCommitted = MetricName{Name: "algod_transaction_group_txsync_err_or_committed", Description: "Number of duplicate or error transaction groups received via txsync"}
+
+	// RestRequestDuration "Duration of REST API requests in seconds, per endpoint"
+	RestRequestDuration = MetricName{Name: "algod_rest_request_duration_seconds", Description: "Duration of REST API requests in seconds, per endpoint"}
+	// RestQuotaRejectedTotal "Number of REST API requests rejected for exceeding the quota of their token"
+	RestQuotaRejectedTotal = MetricName{Name: "algod_rest_quota_rejected_total", Description: "Number of REST API requests rejected for exceeding the quota of their token"}
 )
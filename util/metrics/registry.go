@@ -17,7 +17,9 @@
 package metrics
 
 import (
+	"sort"
 	"strings"
+	"sync/atomic"
 
 	"github.com/algorand/go-deadlock"
 )
@@ -39,6 +41,48 @@ func DefaultRegistry() *Registry {
 
 func init() {
 	defaultRegistry = MakeRegistry()
+	labelSetsDroppedCounter = MakeCounter(MetricName{Name: "algod_metrics_label_sets_dropped_total", Description: "observations dropped as they would have added a series past the limit of series of their metric"})
+}
+
+// maxLabelSets is the maximum number of series each metric reports, 0 for no limit.
+var maxLabelSets uint64
+
+// labelSetsDroppedCounter counts the observations dropped by the limit of series, created once
+// the default registry is.
+var labelSetsDroppedCounter *Counter
+
+// SetMaxLabelSets bounds the number of distinct sets of labels, that is of series, each metric
+// reports, so that a label taking too many values, such as a peer address, cannot blow up the
+// metrics. The observations which would add a series past the limit are dropped, and counted by
+// algod_metrics_label_sets_dropped_total. 0 removes the limit.
+func SetMaxLabelSets(n uint64) {
+	atomic.StoreUint64(&maxLabelSets, n)
+}
+
+// MaxLabelSets returns the maximum number of series each metric reports, 0 for no limit.
+func MaxLabelSets() uint64 {
+	return atomic.LoadUint64(&maxLabelSets)
+}
+
+// labelSetAllowed returns whether a metric reporting the given number of series may add another
+// one, counting the dropped observation otherwise.
+func labelSetAllowed(series int) bool {
+	max := atomic.LoadUint64(&maxLabelSets)
+	if max == 0 || uint64(series) < max {
+		return true
+	}
+	labelSetsDroppedCounter.Inc(nil)
+	return false
+}
+
+// MetricSubsystem returns the subsystem of the metric of the given name: the word following the
+// algod_ prefix of the name, such as network for algod_network_sent_bytes_total.
+func MetricSubsystem(name string) string {
+	name = strings.TrimPrefix(name, "algod_")
+	if i := strings.IndexAny(name, "_-{"); i > 0 {
+		return name[:i]
+	}
+	return name
 }
 
 // Register add the given metric to the registry
@@ -60,12 +104,35 @@ func (r *Registry) Deregister(metric Metric) {
 	}
 }
 
+// SetSubsystemEnabled enables or disables reporting the metrics of a subsystem, as returned by
+// MetricSubsystem. The metrics of a disabled subsystem are still collected, but left out of
+// WriteMetrics and AddMetrics.
+func (r *Registry) SetSubsystemEnabled(subsystem string, enabled bool) {
+	r.metricsMu.Lock()
+	defer r.metricsMu.Unlock()
+	if enabled {
+		delete(r.disabledSubsystems, subsystem)
+		return
+	}
+	if r.disabledSubsystems == nil {
+		r.disabledSubsystems = make(map[string]bool)
+	}
+	r.disabledSubsystems[subsystem] = true
+}
+
+// enabled returns whether the metric is reported. The caller must hold metricsMu.
+func (r *Registry) enabled(m Metric) bool {
+	return len(r.disabledSubsystems) == 0 || !r.disabledSubsystems[MetricSubsystem(m.Describe().Name)]
+}
+
 // WriteMetrics will write all the metrics that were registered to this registry
 func (r *Registry) WriteMetrics(buf *strings.Builder, parentLabels string) {
 	r.metricsMu.Lock()
 	defer r.metricsMu.Unlock()
 	for _, m := range r.metrics {
-		m.WriteMetric(buf, parentLabels)
+		if r.enabled(m) {
+			m.WriteMetric(buf, parentLabels)
+		}
 	}
 }
 
@@ -74,6 +141,24 @@ func (r *Registry) AddMetrics(values map[string]float64) {
 	r.metricsMu.Lock()
 	defer r.metricsMu.Unlock()
 	for _, m := range r.metrics {
-		m.AddMetric(values)
+		if r.enabled(m) {
+			m.AddMetric(values)
+		}
+	}
+}
+
+// Describe returns the descriptions of the metrics registered to this registry, sorted by name.
+func (r *Registry) Describe() []MetricDescription {
+	r.metricsMu.Lock()
+	defer r.metricsMu.Unlock()
+	descriptions := make([]MetricDescription, len(r.metrics))
+	for i, m := range r.metrics {
+		descriptions[i] = m.Describe()
+		descriptions[i].Subsystem = MetricSubsystem(descriptions[i].Name)
+		descriptions[i].Enabled = !r.disabledSubsystems[descriptions[i].Subsystem]
 	}
+	sort.SliceStable(descriptions, func(i, j int) bool {
+		return descriptions[i].Name < descriptions[j].Name
+	})
+	return descriptions
 }
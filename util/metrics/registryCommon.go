@@ -29,12 +29,29 @@ type Metric interface {
 	WriteMetric(buf *strings.Builder, parentLabels string)
 	// AddMetric adds metrics to a map, used for reporting in telemetry heartbeat messages.
 	AddMetric(values map[string]float64)
+	// Describe returns the name, description, type and number of series of the metric.
+	Describe() MetricDescription
+}
+
+// MetricDescription describes a registered metric.
+type MetricDescription struct {
+	Name        string
+	Description string
+	// Type is counter, gauge, histogram, tagcounter or runtime.
+	Type string
+	// Subsystem is the subsystem of the metric, see MetricSubsystem.
+	Subsystem string
+	// Series is the number of series the metric reports, that is of distinct sets of labels.
+	Series int
+	// Enabled is false when the subsystem of the metric is disabled in its registry.
+	Enabled bool
 }
 
 // Registry represents a single set of metrics registry
 type Registry struct {
-	metrics   []Metric
-	metricsMu deadlock.Mutex
+	metrics            []Metric
+	metricsMu          deadlock.Mutex
+	disabledSubsystems map[string]bool
 }
 
 var sanitizeTelemetryCharactersRegexp = regexp.MustCompile("(^[^a-zA-Z_]|[^a-zA-Z0-9_-])")
@@ -60,3 +60,88 @@ func TestWriteAdd(t *testing.T) {
 	counter.Deregister(nil)
 	labelCounter.Deregister(nil)
 }
+
+func TestMetricSubsystem(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, "network", MetricSubsystem("algod_network_sent_bytes_total"))
+	require.Equal(t, "ledger", MetricSubsystem("algod_ledger_round"))
+	require.Equal(t, "go", MetricSubsystem("algod_go"))
+	require.Equal(t, "agreement", MetricSubsystem("algod_agreement_{TAG}"))
+	require.Equal(t, "gauge", MetricSubsystem("gauge-name"))
+}
+
+func TestSubsystemEnabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	reg := MakeRegistry()
+	network := MakeCounter(MetricName{Name: "algod_network_test_total", Description: "network counter"})
+	network.Deregister(nil)
+	network.Register(reg)
+	ledger := MakeCounter(MetricName{Name: "algod_ledger_test_total", Description: "ledger counter"})
+	ledger.Deregister(nil)
+	ledger.Register(reg)
+	network.Inc(nil)
+	ledger.Inc(nil)
+
+	reg.SetSubsystemEnabled("network", false)
+	network.Inc(nil)
+
+	results := make(map[string]float64)
+	reg.AddMetrics(results)
+	require.Equal(t, map[string]float64{"algod_ledger_test_total": 1}, results)
+
+	var buf strings.Builder
+	reg.WriteMetrics(&buf, "")
+	require.Contains(t, buf.String(), "algod_ledger_test_total")
+	require.NotContains(t, buf.String(), "algod_network_test_total")
+
+	descriptions := reg.Describe()
+	require.Len(t, descriptions, 2)
+	require.Equal(t, MetricDescription{Name: "algod_ledger_test_total", Description: "ledger counter", Type: "counter", Subsystem: "ledger", Series: 1, Enabled: true}, descriptions[0])
+	require.Equal(t, MetricDescription{Name: "algod_network_test_total", Description: "network counter", Type: "counter", Subsystem: "network", Series: 1, Enabled: false}, descriptions[1])
+
+	// disabled metrics are still collected
+	reg.SetSubsystemEnabled("network", true)
+	results = make(map[string]float64)
+	reg.AddMetrics(results)
+	require.Equal(t, map[string]float64{"algod_ledger_test_total": 1, "algod_network_test_total": 2}, results)
+}
+
+func TestMaxLabelSets(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	SetMaxLabelSets(2)
+	defer SetMaxLabelSets(0)
+	dropped := labelSetsDroppedCounter.GetUint64Value()
+
+	counter := MakeCounter(MetricName{Name: "algod_test_label_sets_total", Description: "counter with labels"})
+	defer counter.Deregister(nil)
+	counter.Inc(map[string]string{"peer": "a"})
+	counter.Inc(map[string]string{"peer": "b"})
+	counter.Inc(map[string]string{"peer": "c"})
+	counter.Inc(map[string]string{"peer": "a"})
+	// the series without labels is not subject to the limit
+	counter.Inc(nil)
+	require.Equal(t, uint64(2), counter.GetUint64ValueForLabels(map[string]string{"peer": "a"}))
+	require.Equal(t, uint64(0), counter.GetUint64ValueForLabels(map[string]string{"peer": "c"}))
+	require.Equal(t, uint64(1), counter.GetUint64Value())
+
+	histogram := MakeHistogram(MetricName{Name: "algod_test_label_sets", Description: "histogram"}, "peer", DefaultLatencyBuckets)
+	defer histogram.Deregister(nil)
+	histogram.Observe("a", 1)
+	histogram.Observe("b", 1)
+	histogram.Observe("c", 1)
+	require.Equal(t, 2, histogram.Describe().Series)
+
+	tagCounter := NewTagCounter("algod_test_label_sets_{TAG}", "tag counter")
+	defer DefaultRegistry().Deregister(tagCounter)
+	tagCounter.Add("a", 1)
+	tagCounter.Add("b", 1)
+	tagCounter.Add("c", 1)
+	require.Equal(t, 2, tagCounter.Describe().Series)
+
+	require.Equal(t, dropped+3, labelSetsDroppedCounter.GetUint64Value())
+}
@@ -117,6 +117,12 @@ func (rm *RuntimeMetrics) WriteMetric(buf *strings.Builder, parentLabels string)
 	}
 }
 
+// Describe is part of the Metric interface. The Go runtime metrics are described as a whole, each
+// sample being a series.
+func (rm *RuntimeMetrics) Describe() MetricDescription {
+	return MetricDescription{Name: "algod_go", Description: "Go runtime metrics", Type: "runtime", Series: len(rm.samples)}
+}
+
 // AddMetric adds runtime metrics to the map used for heartbeat metrics.
 func (rm *RuntimeMetrics) AddMetric(m map[string]float64) {
 	rm.Lock()
@@ -96,6 +96,10 @@ func (tc *TagCounter) Add(tag string, val uint64) {
 		}
 		tc.tagLock.Lock()
 		if _, ok = tc.tags[tag]; !ok {
+			if !labelSetAllowed(len(tc.tags)) {
+				tc.tagLock.Unlock()
+				return
+			}
 			// Still need to add a new tag.
 			// Make a new map so there's never any race.
 			newtags := make(map[string]*uint64, len(tc.tags)+1)
@@ -157,6 +161,15 @@ func (tc *TagCounter) WriteMetric(buf *strings.Builder, parentLabels string) {
 	}
 }
 
+// Describe is part of the Metric interface
+func (tc *TagCounter) Describe() MetricDescription {
+	var series int
+	if tagp := tc.tagptr.Load(); tagp != nil {
+		series = len(tagp.(map[string]*uint64))
+	}
+	return MetricDescription{Name: tc.Name, Description: tc.Description, Type: "tagcounter", Series: series}
+}
+
 // AddMetric is part of the Metric interface
 // Copy the values in this TagCounter out into the string-string map.
 func (tc *TagCounter) AddMetric(values map[string]float64) {
@@ -0,0 +1,302 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package resources manages the limits of the resources of the process: the file descriptors, or the handles on
+// Windows, the memory and the threads. It raises the soft limit of the file descriptors when permitted, apportions
+// them among the subsystems of the node, and watches the usage of the resources to warn before they run out, and
+// shed the optional load when the file descriptors are nearly exhausted.
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"runtime"
+	"runtime/pprof"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// Limits are the limits of the resources of the process. A zero limit is unlimited, or unknown.
+type Limits struct {
+	// FDSoft and FDHard are the soft and hard limits of the open file descriptors, or of the handles on Windows.
+	FDSoft uint64
+	FDHard uint64
+
+	// Memory is the limit of the address space of the process, in bytes.
+	Memory uint64
+
+	// Threads is the limit of the processes and threads of the user running the process, where it applies.
+	Threads uint64
+}
+
+// Usage is the usage of the resources of the process, zero when unknown.
+type Usage struct {
+	OpenFDs uint64
+	Memory  uint64
+	Threads uint64
+}
+
+// Query returns the limits of the resources of the process.
+func Query() (Limits, error) {
+	return queryLimits()
+}
+
+// CurrentUsage returns the current usage of the resources of the process.
+func CurrentUsage() Usage {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	u := Usage{
+		Memory:  ms.Sys,
+		Threads: uint64(pprof.Lookup("threadcreate").Count()),
+	}
+	if fds, err := openFDs(); err == nil {
+		u.OpenFDs = fds
+	}
+	return u
+}
+
+// Subsystem is a subsystem of the node holding file descriptors.
+type Subsystem string
+
+const (
+	// Network is the budget of the gossip network connections.
+	Network Subsystem = "network"
+	// REST is the budget of the REST API connections.
+	REST Subsystem = "rest"
+	// SQLite is the budget of the databases, along with their journals.
+	SQLite Subsystem = "sqlite"
+	// Reserved is the budget of the short-lived descriptors, such as the DNS queries and the outgoing requests.
+	Reserved Subsystem = "reserved"
+)
+
+// Demand is the number of file descriptors a subsystem asks for. Unless they all fit the limit, the demands are
+// reduced down to their minimum.
+type Demand struct {
+	Subsystem Subsystem
+	FDs       uint64
+
+	// Min is the least number of descriptors the subsystem works with: a demand whose minimum is its number of
+	// descriptors is never reduced.
+	Min uint64
+}
+
+// Apportion splits the available file descriptors among the demands. The demands are granted in full when they
+// fit, otherwise the part of each demand past its minimum is reduced in proportion. It fails when even the minimums
+// don't fit.
+func Apportion(available uint64, demands []Demand) (map[Subsystem]uint64, error) {
+	total, minimum, err := sum(demands)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets := make(map[Subsystem]uint64, len(demands))
+	if total <= available {
+		for _, d := range demands {
+			budgets[d.Subsystem] += d.FDs
+		}
+		return budgets, nil
+	}
+	if minimum > available {
+		return nil, fmt.Errorf("%d file descriptors are available, fewer than the %d the node needs at least: raise the limit of the open files of the process, with ulimit -n or LimitNOFILE in its systemd unit, to %d", available, minimum, total)
+	}
+
+	// the spare descriptors past the minimums are shared in proportion of the flexible part of the demands, which
+	// is less than flexible: the product of both never overflows its division.
+	spare, flexible := available-minimum, total-minimum
+	for _, d := range demands {
+		least := minUint64(d.Min, d.FDs)
+		hi, lo := bits.Mul64(d.FDs-least, spare)
+		share, _ := bits.Div64(hi, lo, flexible)
+		budgets[d.Subsystem] += least + share
+	}
+	return budgets, nil
+}
+
+// sum adds up the file descriptors demanded, and their minimums.
+func sum(demands []Demand) (total, minimum uint64, err error) {
+	var overflow, carry uint64
+	for _, d := range demands {
+		total, carry = bits.Add64(total, d.FDs, 0)
+		overflow |= carry
+		// the minimums are no more than the demands, so they only overflow along with them
+		minimum, _ = bits.Add64(minimum, minUint64(d.Min, d.FDs), 0)
+	}
+	if overflow != 0 {
+		return 0, 0, errors.New("the file descriptors demanded by the subsystems overflow")
+	}
+	return total, minimum, nil
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// criticalPercent is the usage of the file descriptors, in percent of their soft limit, past which they are nearly
+// exhausted: the optional load is shed, so that the descriptors left serve the node itself.
+const criticalPercent = 95
+
+var fdsExhausted atomic.Bool
+
+// FDsExhausted reports whether the file descriptors of the process are nearly exhausted, in which case the
+// subsystems shed their optional load, such as the new incoming connections.
+func FDsExhausted() bool {
+	return fdsExhausted.Load()
+}
+
+var openFDsGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_resources_open_fds", Description: "number of file descriptors open by the process"})
+var fdLimitGauge = metrics.MakeGauge(metrics.MetricName{Name: "algod_resources_fd_limit", Description: "soft limit of the file descriptors of the process"})
+
+// Manager raises and apportions the file descriptors of the process, and watches the usage of its resources.
+type Manager struct {
+	log         logging.Logger
+	warnPercent uint64
+
+	limits  Limits
+	budgets map[Subsystem]uint64
+
+	// usage samples the usage of the resources, replaced by the tests
+	usage  func() Usage
+	warned map[string]bool
+}
+
+// MakeManager queries the limits of the resources of the process. The usage of a resource past warnPercent of its
+// limit is logged and reported to telemetry, once until it falls back below it; zero disables the warnings.
+func MakeManager(log logging.Logger, warnPercent uint64) (*Manager, error) {
+	limits, err := queryLimits()
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		log:         log,
+		warnPercent: warnPercent,
+		limits:      limits,
+		usage:       CurrentUsage,
+		warned:      make(map[string]bool),
+	}, nil
+}
+
+// Limits returns the limits of the resources of the process, including the raised soft limit of the file
+// descriptors.
+func (m *Manager) Limits() Limits {
+	return m.limits
+}
+
+// Apportion raises the soft limit of the file descriptors to cover the demands, as far as the hard limit permits,
+// and splits the descriptors among the subsystems, see Apportion.
+func (m *Manager) Apportion(demands []Demand) (map[Subsystem]uint64, error) {
+	total, _, err := sum(demands)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.limits.FDSoft != 0 && total > m.limits.FDSoft {
+		want := total
+		if m.limits.FDHard != 0 && want > m.limits.FDHard {
+			want = m.limits.FDHard
+		}
+		if err := raiseFDSoftLimit(want); err != nil {
+			m.log.Warnf("could not raise the soft limit of the file descriptors from %d to %d: %v", m.limits.FDSoft, want, err)
+		} else {
+			m.limits.FDSoft = want
+		}
+	}
+
+	available := m.limits.FDSoft
+	if available == 0 {
+		available = total
+	}
+	budgets, err := Apportion(available, demands)
+	if err != nil {
+		return nil, err
+	}
+	if total > available {
+		m.log.Warnf("the %d file descriptors available don't cover the %d demanded, the subsystems were granted %v", available, total, budgets)
+	}
+	m.budgets = budgets
+	fdLimitGauge.Set(m.limits.FDSoft)
+	return budgets, nil
+}
+
+// Run watches the usage of the resources every interval, until done is closed.
+func (m *Manager) Run(done <-chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			m.check(m.usage())
+		}
+	}
+}
+
+// check warns of the resources whose usage approaches their limit, and flags the file descriptors as exhausted
+// past criticalPercent of their soft limit.
+func (m *Manager) check(u Usage) {
+	openFDsGauge.Set(u.OpenFDs)
+	m.checkResource("file descriptors", u.OpenFDs, m.limits.FDSoft)
+	m.checkResource("memory", u.Memory, m.limits.Memory)
+	m.checkResource("threads", u.Threads, m.limits.Threads)
+
+	exhausted := m.limits.FDSoft != 0 && u.OpenFDs*100 >= m.limits.FDSoft*criticalPercent
+	if exhausted != fdsExhausted.Swap(exhausted) {
+		if exhausted {
+			m.log.Warnf("%d of the %d file descriptors are open: new incoming connections are refused until some are closed", u.OpenFDs, m.limits.FDSoft)
+		} else {
+			m.log.Infof("%d of the %d file descriptors are open: new incoming connections are accepted again", u.OpenFDs, m.limits.FDSoft)
+		}
+	}
+}
+
+func (m *Manager) checkResource(resource string, used, limit uint64) {
+	if m.warnPercent == 0 || limit == 0 || used == 0 {
+		return
+	}
+	// the limits may be large enough for the percentage of the usage to overflow
+	hi, lo := bits.Mul64(used, 100)
+	percent := uint64(100)
+	if hi < limit {
+		percent, _ = bits.Div64(hi, lo, limit)
+	}
+
+	switch {
+	case percent >= m.warnPercent && !m.warned[resource]:
+		m.warned[resource] = true
+		m.log.Warnf("the usage of the %s reached %d%% of their limit: %d of %d", resource, percent, used, limit)
+		details := telemetryspec.ResourceLimitApproachingEventDetails{
+			Resource: resource,
+			Used:     used,
+			Limit:    limit,
+			Budgets:  make(map[string]uint64, len(m.budgets)),
+		}
+		for subsystem, budget := range m.budgets {
+			details.Budgets[string(subsystem)] = budget
+		}
+		m.log.EventWithDetails(telemetryspec.ApplicationState, telemetryspec.ResourceLimitApproachingEvent, details)
+	case percent < m.warnPercent && m.warned[resource]:
+		m.warned[resource] = false
+		m.log.Infof("the usage of the %s fell back to %d%% of their limit", resource, percent)
+	}
+}
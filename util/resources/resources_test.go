@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestApportion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	demands := []Demand{
+		{Subsystem: Reserved, FDs: 256, Min: 256},
+		{Subsystem: SQLite, FDs: 64, Min: 64},
+		{Subsystem: REST, FDs: 2048, Min: 10},
+		{Subsystem: Network, FDs: 2400, Min: 1},
+	}
+
+	// the demands fitting are granted in full
+	budgets, err := Apportion(4768, demands)
+	require.NoError(t, err)
+	require.Equal(t, map[Subsystem]uint64{Reserved: 256, SQLite: 64, REST: 2048, Network: 2400}, budgets)
+
+	// otherwise the flexible part of the demands shrinks in proportion
+	budgets, err = Apportion(1024, demands)
+	require.NoError(t, err)
+	require.Equal(t, uint64(256), budgets[Reserved])
+	require.Equal(t, uint64(64), budgets[SQLite])
+	require.Equal(t, uint64(10+(2048-10)*(1024-331)/(4768-331)), budgets[REST])
+	require.Equal(t, uint64(1+(2400-1)*(1024-331)/(4768-331)), budgets[Network])
+	var total uint64
+	for _, budget := range budgets {
+		total += budget
+	}
+	require.LessOrEqual(t, total, uint64(1024))
+
+	// down to the minimums
+	budgets, err = Apportion(331, demands)
+	require.NoError(t, err)
+	require.Equal(t, map[Subsystem]uint64{Reserved: 256, SQLite: 64, REST: 10, Network: 1}, budgets)
+
+	_, err = Apportion(330, demands)
+	require.ErrorContains(t, err, "ulimit -n")
+
+	_, err = Apportion(1024, []Demand{{Subsystem: REST, FDs: ^uint64(0)}, {Subsystem: Network, FDs: 1}})
+	require.Error(t, err)
+}
+
+func TestManagerCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	m := &Manager{
+		log:         logging.TestingLog(t),
+		warnPercent: 80,
+		limits:      Limits{FDSoft: 1000, FDHard: 4096, Memory: 1 << 30},
+		budgets:     map[Subsystem]uint64{Reserved: 256, REST: 744},
+		warned:      make(map[string]bool),
+	}
+	defer fdsExhausted.Store(false)
+
+	m.check(Usage{OpenFDs: 500, Memory: 1 << 20, Threads: 100})
+	require.False(t, m.warned["file descriptors"])
+	require.False(t, m.warned["memory"])
+	require.False(t, FDsExhausted())
+
+	m.check(Usage{OpenFDs: 800, Memory: 1 << 29})
+	require.True(t, m.warned["file descriptors"])
+	require.False(t, m.warned["memory"])
+	require.False(t, FDsExhausted())
+
+	m.check(Usage{OpenFDs: 950})
+	require.True(t, m.warned["file descriptors"])
+	require.True(t, FDsExhausted())
+
+	// the warnings and the exhaustion end once the usage falls back
+	m.check(Usage{OpenFDs: 700})
+	require.False(t, m.warned["file descriptors"])
+	require.False(t, FDsExhausted())
+
+	// the threads are not limited
+	m.check(Usage{Threads: 1 << 20})
+	require.False(t, m.warned["threads"])
+}
+
+func TestUsage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	limits, err := Query()
+	require.NoError(t, err)
+	if limits.FDHard != 0 {
+		require.LessOrEqual(t, limits.FDSoft, limits.FDHard)
+	}
+
+	u := CurrentUsage()
+	require.NotZero(t, u.Memory)
+	require.NotZero(t, u.Threads)
+	require.NotZero(t, u.OpenFDs)
+}
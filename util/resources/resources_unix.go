@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows
+// +build !windows
+
+package resources
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/algorand/go-algorand/util"
+)
+
+func queryLimits() (limits Limits, err error) {
+	limits.FDSoft, limits.FDHard, err = util.GetFdLimits()
+	if err != nil {
+		return Limits{}, err
+	}
+	limits.FDSoft, limits.FDHard = unlimited(limits.FDSoft), unlimited(limits.FDHard)
+
+	// the limits of the memory and of the threads only inform the warnings, the node runs without them
+	var rlimit unix.Rlimit
+	if unix.Getrlimit(unix.RLIMIT_AS, &rlimit) == nil {
+		limits.Memory = unlimited(rlimit.Cur)
+	}
+	if unix.Getrlimit(unix.RLIMIT_NPROC, &rlimit) == nil {
+		limits.Threads = unlimited(rlimit.Cur)
+	}
+	return limits, nil
+}
+
+// unlimited maps the infinite limits to zero.
+func unlimited(limit uint64) uint64 {
+	if limit == unix.RLIM_INFINITY {
+		return 0
+	}
+	return limit
+}
+
+func raiseFDSoftLimit(limit uint64) error {
+	return util.SetFdSoftLimit(limit)
+}
+
+// openFDs counts the file descriptors open by the process, listed in /proc/self/fd on Linux and in /dev/fd on the
+// other systems.
+func openFDs() (uint64, error) {
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		entries, err = os.ReadDir("/dev/fd")
+		if err != nil {
+			return 0, err
+		}
+	}
+	// the directory read was listed along with the other descriptors
+	if len(entries) == 0 {
+		return 0, nil
+	}
+	return uint64(len(entries) - 1), nil
+}
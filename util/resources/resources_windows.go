@@ -0,0 +1,48 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package resources
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// maxHandles is the most handles a Windows process may open, which is set by the kernel rather than by a limit of
+// the process.
+const maxHandles = 1 << 24
+
+var procGetProcessHandleCount = windows.NewLazySystemDLL("kernel32.dll").NewProc("GetProcessHandleCount")
+
+func queryLimits() (Limits, error) {
+	// Windows neither limits the memory nor the threads of a process, short of a job object
+	return Limits{FDSoft: maxHandles, FDHard: maxHandles}, nil
+}
+
+func raiseFDSoftLimit(limit uint64) error {
+	return nil
+}
+
+// openFDs counts the handles open by the process.
+func openFDs() (uint64, error) {
+	var count uint32
+	r, _, err := procGetProcessHandleCount.Call(uintptr(windows.CurrentProcess()), uintptr(unsafe.Pointer(&count)))
+	if r == 0 {
+		return 0, err
+	}
+	return uint64(count), nil
+}
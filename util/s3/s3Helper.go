@@ -17,6 +17,8 @@
 package s3
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -145,6 +147,75 @@ func makeS3Session(bucket string) (helper Helper, err error) {
 	return
 }
 
+// MakeS3SessionForEndpoint creates a session to bucket of the S3-compatible object storage at endpoint, such as
+// GCS through its interoperability API. An empty endpoint selects AWS S3, and an empty region the default region.
+// The credentials are read from the shared credentials file credentialsFile when given, or else looked up as
+// usual, e.g. from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+func MakeS3SessionForEndpoint(bucket string, endpoint string, region string, credentialsFile string) (helper Helper, err error) {
+	err = validateS3Bucket(bucket)
+	if err != nil {
+		return
+	}
+	if region == "" {
+		region = getS3Region()
+	}
+
+	awsConfig := &aws.Config{
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		Region:                        aws.String(region),
+	}
+	if endpoint != "" {
+		awsConfig.Endpoint = aws.String(endpoint)
+		awsConfig.S3ForcePathStyle = aws.Bool(true)
+	}
+	if credentialsFile != "" {
+		awsConfig.Credentials = credentials.NewSharedCredentials(credentialsFile, "")
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+		Config:            *awsConfig,
+	})
+	if err != nil {
+		return
+	}
+
+	helper = Helper{
+		session: sess,
+		bucket:  bucket,
+	}
+	return
+}
+
+// PutObject stores data as the object key of the bucket, giving up once ctx is done
+func (helper *Helper) PutObject(ctx context.Context, key string, data []byte) error {
+	svc := s3.New(helper.session)
+	_, err := svc.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(helper.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+// GetObject returns the data of the object key of the bucket, or an error satisfying errors.Is(err, os.ErrNotExist)
+// when there is no such object. It gives up once ctx is done, including while reading the data.
+func (helper *Helper) GetObject(ctx context.Context, key string) ([]byte, error) {
+	svc := s3.New(helper.session)
+	out, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(helper.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, fmt.Errorf("%s: %w", key, os.ErrNotExist)
+		}
+		return nil, err
+	}
+	defer out.Body.Close()
+	return io.ReadAll(out.Body)
+}
+
 // GetLatestPackageVersion returns the latest version details for a given package name (eg node, install, tools)
 func (helper *Helper) GetLatestPackageVersion(channel string, packageName string) (maxVersion uint64, maxVersionName string, err error) {
 	return helper.GetPackageVersion(channel, packageName, 0)
@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// AlgodTokenStoreFilename is the file in the algod datadir holding the named, scoped API tokens
+const AlgodTokenStoreFilename = "algod.tokens.json"
+
+// Scope is a group of endpoints a named API token may be granted access to.
+type Scope string
+
+const (
+	// ScopeRead grants access to the read-only endpoints.
+	ScopeRead Scope = "read"
+	// ScopeSubmit grants access to the transaction submission endpoints, and to the public endpoints which create
+	// state or are costly to compute, such as the simulation and the compilation of programs.
+	ScopeSubmit Scope = "submit"
+	// ScopeParticipation grants access to the participation key management endpoints.
+	ScopeParticipation Scope = "participation"
+	// ScopeAdmin grants access to every endpoint, including the token management ones.
+	ScopeAdmin Scope = "admin"
+)
+
+// ParseScope returns the Scope named by s.
+func ParseScope(s string) (Scope, error) {
+	switch scope := Scope(s); scope {
+	case ScopeRead, ScopeSubmit, ScopeParticipation, ScopeAdmin:
+		return scope, nil
+	default:
+		return "", fmt.Errorf("unknown token scope '%s'", s)
+	}
+}
+
+var tokenNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// ErrTokenNotFound is returned when a named token does not exist in the store.
+var ErrTokenNotFound = errors.New("token not found")
+
+// ErrTokenExists is returned when creating a named token whose name is already in use.
+var ErrTokenExists = errors.New("a token with this name already exists")
+
+// NamedToken is an API token identified by a name and restricted to a set of scopes.
+type NamedToken struct {
+	Name   string  `json:"name"`
+	Token  string  `json:"token"`
+	Scopes []Scope `json:"scopes"`
+}
+
+// HasScope returns true if the token grants the given scope. The admin scope grants every scope.
+func (t NamedToken) HasScope(scope Scope) bool {
+	for _, s := range t.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Store keeps the named API tokens of a daemon, and persists them to a file in its datadir
+// so they can be created and revoked while the daemon is running.
+type Store struct {
+	mu       deadlock.RWMutex
+	filepath string
+	tokens   map[string]NamedToken
+}
+
+// LoadStore reads the token store file from the datadir. A missing file results in an empty store.
+func LoadStore(dataDir, storeFilename string) (*Store, error) {
+	s := &Store{
+		filepath: tokenFilepath(dataDir, storeFilename),
+		tokens:   make(map[string]NamedToken),
+	}
+
+	data, err := os.ReadFile(s.filepath)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stored []NamedToken
+	err = json.Unmarshal(data, &stored)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse token store %s: %w", s.filepath, err)
+	}
+	for _, t := range stored {
+		err = ValidateAPIToken(t.Token)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token '%s' in %s: %w", t.Name, s.filepath, err)
+		}
+		s.tokens[t.Name] = t
+	}
+	return s, nil
+}
+
+// Create generates a new token with the given name and scopes, and persists it.
+func (s *Store) Create(name string, scopes []Scope) (NamedToken, error) {
+	if !tokenNameRegexp.MatchString(name) {
+		return NamedToken{}, fmt.Errorf("invalid token name '%s'", name)
+	}
+	if len(scopes) == 0 {
+		return NamedToken{}, errors.New("a token must have at least one scope")
+	}
+	for _, scope := range scopes {
+		if _, err := ParseScope(string(scope)); err != nil {
+			return NamedToken{}, err
+		}
+	}
+
+	token, err := randomAPIToken()
+	if err != nil {
+		return NamedToken{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tokens[name]; ok {
+		return NamedToken{}, ErrTokenExists
+	}
+	t := NamedToken{Name: name, Token: token, Scopes: append([]Scope(nil), scopes...)}
+	s.tokens[name] = t
+	err = s.save()
+	if err != nil {
+		delete(s.tokens, name)
+		return NamedToken{}, err
+	}
+	return t, nil
+}
+
+// Revoke deletes the named token, which immediately stops being accepted.
+func (s *Store) Revoke(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tokens[name]
+	if !ok {
+		return ErrTokenNotFound
+	}
+	delete(s.tokens, name)
+	err := s.save()
+	if err != nil {
+		s.tokens[name] = t
+		return err
+	}
+	return nil
+}
+
+// List returns the tokens of the store, sorted by name, with their secret omitted.
+func (s *Store) List() []NamedToken {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]NamedToken, 0, len(s.tokens))
+	for _, t := range s.tokens {
+		res = append(res, NamedToken{Name: t.Name, Scopes: t.Scopes})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// Authorize returns true if token is one of the store's tokens and grants the given scope.
+func (s *Store) Authorize(token string, scope Scope) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	authorized := false
+	// Compare against every token in constant time
+	for _, t := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t.Token)) == 1 && t.HasScope(scope) {
+			authorized = true
+		}
+	}
+	return authorized
+}
+
+// save atomically writes the store to disk. The caller must hold s.mu.
+func (s *Store) save() error {
+	stored := make([]NamedToken, 0, len(s.tokens))
+	for _, t := range s.tokens {
+		stored = append(stored, t)
+	}
+	sort.Slice(stored, func(i, j int) bool { return stored[i].Name < stored[j].Name })
+	data, err := json.MarshalIndent(stored, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	tmp := s.filepath + ".tmp"
+	err = os.WriteFile(tmp, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.filepath)
+}
+
+// randomAPIToken returns a new cryptographically secure APIToken.
+func randomAPIToken() (string, error) {
+	tokenBytes := make([]byte, (minimumAPITokenLength+1)/2)
+	_, err := rand.Read(tokenBytes)
+	if err != nil {
+		return "", fmt.Errorf("error reading random bytes: %v", err)
+	}
+	return fmt.Sprintf("%x", tokenBytes), nil
+}
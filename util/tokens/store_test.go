@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTokenStore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := LoadStore(dir, AlgodTokenStoreFilename)
+	require.NoError(t, err)
+	require.Empty(t, store.List())
+
+	reader, err := store.Create("reader", []Scope{ScopeRead})
+	require.NoError(t, err)
+	require.NoError(t, ValidateAPIToken(reader.Token))
+	admin, err := store.Create("admin", []Scope{ScopeAdmin})
+	require.NoError(t, err)
+
+	_, err = store.Create("reader", []Scope{ScopeSubmit})
+	require.ErrorIs(t, err, ErrTokenExists)
+	_, err = store.Create("bad name", []Scope{ScopeRead})
+	require.Error(t, err)
+	_, err = store.Create("noscope", nil)
+	require.Error(t, err)
+	_, err = store.Create("badscope", []Scope{"write"})
+	require.Error(t, err)
+
+	require.True(t, store.Authorize(reader.Token, ScopeRead))
+	require.False(t, store.Authorize(reader.Token, ScopeSubmit))
+	require.True(t, store.Authorize(admin.Token, ScopeParticipation))
+	require.False(t, store.Authorize("unknown", ScopeRead))
+
+	// tokens are listed without their secret
+	list := store.List()
+	require.Len(t, list, 2)
+	require.Equal(t, "admin", list[0].Name)
+	require.Equal(t, "reader", list[1].Name)
+	require.Empty(t, list[1].Token)
+
+	// the store is persisted
+	reloaded, err := LoadStore(dir, AlgodTokenStoreFilename)
+	require.NoError(t, err)
+	require.True(t, reloaded.Authorize(reader.Token, ScopeRead))
+
+	require.NoError(t, store.Revoke("reader"))
+	require.False(t, store.Authorize(reader.Token, ScopeRead))
+	require.ErrorIs(t, store.Revoke("reader"), ErrTokenNotFound)
+
+	reloaded, err = LoadStore(dir, AlgodTokenStoreFilename)
+	require.NoError(t, err)
+	require.False(t, reloaded.Authorize(reader.Token, ScopeRead))
+	require.True(t, reloaded.Authorize(admin.Token, ScopeAdmin))
+}
@@ -17,7 +17,6 @@
 package tokens
 
 import (
-	"crypto/rand"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -64,19 +63,11 @@ func writeAPITokenToDisk(dataDir, tokenFilename, apiToken string) error {
 
 // GenerateAPIToken writes a cryptographically secure APIToken to disk
 func GenerateAPIToken(dataDir, tokenFilename string) (string, error) {
-	// Random bytes will be converted to hex to make token
-	var entropyLen = (minimumAPITokenLength + 1) / 2
-	tokenBytes := make([]byte, entropyLen)
-
-	// From rand.Read docs: "On return, n == len(b) if and only if err == nil."
-	_, err := rand.Read(tokenBytes)
+	hexToken, err := randomAPIToken()
 	if err != nil {
-		return "", fmt.Errorf("error reading random bytes: %v", err)
+		return "", err
 	}
 
-	// Convert random bytes to hex
-	hexToken := fmt.Sprintf("%x", tokenBytes)
-
 	// Ensure we generated a valid token
 	err = ValidateAPIToken(hexToken)
 	if err != nil {
@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// ParseFileMode parses the octal permissions of a file, such as 0660.
+func ParseFileMode(mode string) (os.FileMode, error) {
+	perm, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || perm > 0777 {
+		return 0, fmt.Errorf("invalid file mode %q: expected octal permissions such as 0660", mode)
+	}
+	return os.FileMode(perm), nil
+}
+
+// unixSocketListener is a unix domain socket listener bound at a temporary path and moved to path,
+// which it removes once closed.
+type unixSocketListener struct {
+	*net.UnixListener
+	path string
+}
+
+func (l *unixSocketListener) Addr() net.Addr {
+	return &net.UnixAddr{Name: l.path, Net: "unix"}
+}
+
+func (l *unixSocketListener) Close() error {
+	err := l.UnixListener.Close()
+	os.Remove(l.path)
+	return err
+}
+
+// ListenUnixSocket listens on a unix domain socket at path, whose file has the permissions mode, so that
+// only the users these permissions let write to the socket can connect. A socket file left at path by a
+// listener which did not exit cleanly is replaced, but any other file is left alone.
+func ListenUnixSocket(path string, mode os.FileMode) (net.Listener, error) {
+	err := removeStaleUnixSocket(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// bind the socket in a private directory, where nobody can connect to it before it gets its
+	// permissions, and only then move it to path.
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".sock")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	tmpPath := filepath.Join(dir, "s")
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmpPath, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	listener.SetUnlinkOnClose(false)
+	err = os.Chmod(tmpPath, mode)
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return &unixSocketListener{UnixListener: listener, path: path}, nil
+}
+
+// removeStaleUnixSocket removes the socket file at path unless a listener still accepts connections on it.
+func removeStaleUnixSocket(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a unix domain socket", path)
+	}
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("%s is already in use", path)
+	}
+	return os.Remove(path)
+}
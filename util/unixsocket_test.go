@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseFileMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mode, err := ParseFileMode("0660")
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0660), mode)
+	mode, err = ParseFileMode("600")
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), mode)
+
+	for _, invalid := range []string{"", "rw", "0680", "01777", "-1"} {
+		_, err = ParseFileMode(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestListenUnixSocket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("unix domain socket permissions are not enforced on windows")
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "api.sock")
+	listener, err := ListenUnixSocket(path, 0600)
+	require.NoError(t, err)
+	require.Equal(t, path, listener.Addr().String())
+
+	info, err := os.Lstat(path)
+	require.NoError(t, err)
+	require.NotZero(t, info.Mode()&os.ModeSocket)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// the temporary directory the socket was bound in is gone
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			conn.Close()
+		}
+	}()
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	conn.Close()
+
+	// the socket is in use
+	_, err = ListenUnixSocket(path, 0600)
+	require.Error(t, err)
+
+	require.NoError(t, listener.Close())
+	_, err = os.Lstat(path)
+	require.True(t, os.IsNotExist(err))
+
+	// a socket file left behind is replaced
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	require.NoError(t, err)
+	stale.SetUnlinkOnClose(false)
+	stale.Close()
+	listener, err = ListenUnixSocket(path, 0660)
+	require.NoError(t, err)
+	info, err = os.Lstat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0660), info.Mode().Perm())
+	require.NoError(t, listener.Close())
+
+	// other files are not
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0600))
+	_, err = ListenUnixSocket(path, 0600)
+	require.Error(t, err)
+}
@@ -72,3 +72,14 @@ func GetCurrentProcessTimes() (utime int64, stime int64, err error) {
 	}
 	return
 }
+
+// GetDiskSpace returns the space available to the current user and the total size of the
+// filesystem holding the given path, in bytes.
+func GetDiskSpace(path string) (available uint64, total uint64, err error) {
+	var stat syscall.Statfs_t
+	err = syscall.Statfs(path, &stat)
+	if err != nil {
+		return 0, 0, fmt.Errorf("GetDiskSpace() err: %w", err)
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), uint64(stat.Blocks) * uint64(stat.Bsize), nil
+}
@@ -18,9 +18,12 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"syscall"
 	"time"
+
+	"golang.org/x/sys/windows"
 )
 
 /* misc */
@@ -69,3 +72,17 @@ func filetimeToDuration(ft *syscall.Filetime) time.Duration {
 	n := int64(ft.HighDateTime)<<32 + int64(ft.LowDateTime) // in 100-nanosecond intervals
 	return time.Duration(n * 100)
 }
+
+// GetDiskSpace returns the space available to the current user and the total size of the
+// filesystem holding the given path, in bytes.
+func GetDiskSpace(path string) (available uint64, total uint64, err error) {
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("GetDiskSpace() err: %w", err)
+	}
+	err = windows.GetDiskFreeSpaceEx(pathPtr, &available, &total, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("GetDiskSpace() err: %w", err)
+	}
+	return available, total, nil
+}